@@ -16,6 +16,13 @@ import (
 	"io/ioutil"
 	"os/exec"
 	"io"
+	"strings"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"crypto/ed25519"
+	"crypto/x509"
+	"time"
 )
 
 var usage = template.Must(template.New("").Parse(
@@ -23,6 +30,7 @@ var usage = template.Must(template.New("").Parse(
 
 Usage:
     {{.Binary}} [options] <source-content-path> <target-package-path>
+    {{.Binary}} serve [options] <source-content-path>
 
 Options:
 {{.Options}}
@@ -54,6 +62,12 @@ func main() {
 
 var cli *flag.FlagSet
 
+// stringList is a flag.Value collecting all the values of a repeated option
+type stringList []string
+
+func (l *stringList) String() string     { return strings.Join(*l, ",") }
+func (l *stringList) Set(s string) error { *l = append(*l, s); return nil }
+
 var (
 	disableCompression bool
 	disableHTTPHandler bool
@@ -64,6 +78,20 @@ var (
 	pkgName            string
 	makeBinary         bool
 	help               bool
+	encrypt            stringList
+	encryptionKeyEnv   string
+	encryptionKeyFile  string
+	signingKeyEnv      string
+	signingKeyFile     string
+	verifyOnInit       string
+	watchMode          bool
+	watchDelay         time.Duration
+	checkMode          bool
+	reportFormat       string
+	budgets            stringList
+	maxFileSize        string
+	cacheControl       stringList
+	securityHeaders    stringList
 )
 
 func init() {
@@ -71,13 +99,17 @@ func init() {
 	cli = flag.NewFlagSet(os.Args[0], flag.ContinueOnError)
 	cli.BoolVar(&help, "help", false, "prints help")
 	cli.StringVar(&pkgName, "pkg", "", "package name (if not set, the basename of the <target-package-path> will be used)")
-	cli.BoolVar(&disableCompression, "no-compression", false, "disable compression even for compressible files")
 	cli.BoolVar(&disableHTTPHandler, "no-http-handler", false, "disable http handler API")
 	cli.BoolVar(&enableFS, "fs", false, "enable embedded filesystem API")
 	cli.BoolVar(&enableUnionFS, "union-fs", false, "enable union filesystem API (real fs over embedded, implies -fs)")
 	cli.BoolVar(&enableHTTPFS, "http-fs", false, "enable http.FileSystem API (implies -fs")
 	cli.BoolVar(&enableRawBytes, "raw-bytes", false, "enable raw bytes access API")
+	generationFlags(cli)
 	cli.BoolVar(&makeBinary, "binary", false, "produce self-contained http server binary (<target-package-path> will become the binary name then)")
+	cli.BoolVar(&watchMode, "watch", false, "keep running and regenerate the target whenever source content changes")
+	cli.DurationVar(&watchDelay, "watch-delay", 200*time.Millisecond, "wait for `duration` of quiet after a change before regenerating in -watch mode")
+	cli.BoolVar(&checkMode, "check", false, "do not generate anything, but list differences between the source content and the target package and fail if there are any")
+	cli.StringVar(&reportFormat, "report", "", "print the report on generated package content to stdout in `format` (json)")
 	mimeTypes := [][2]string{
 		{".go", "text/x-golang"}, // Golang extension is due to get into apache /etc/mime.types
 	}
@@ -87,6 +119,13 @@ func init() {
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "serve" {
+		if err := serve(os.Args[2:]); err != nil && err != flag.ErrHelp {
+			fmt.Fprintln(os.Stderr, err.Error())
+			os.Exit(1)
+		}
+		return
+	}
 	err := cli.Parse(os.Args[1:])
 	if err != nil || cli.NArg() != 2 || help {
 		var opts bytes.Buffer
@@ -104,19 +143,179 @@ func main() {
 	}
 	source := cli.Arg(0)
 	target := cli.Arg(1)
+	if checkMode {
+		upToDate, err := check(source, target)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+			os.Exit(2)
+		}
+		if !upToDate {
+			os.Exit(1)
+		}
+		return
+	}
 	if err = do(source, target); err != nil {
 		fmt.Fprintln(os.Stderr, err.Error())
 		os.Exit(1)
 	}
+	if watchMode {
+		err = watch(source, target, watchDelay, func() error { return do(source, target) })
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+			os.Exit(1)
+		}
+	}
 }
 
-func do(source, target string) error {
+// generationFlags defines options affecting content of the generated package,
+// shared by the generator and serve subcommand
+func generationFlags(fs *flag.FlagSet) {
+	fs.BoolVar(&disableCompression, "no-compression", false, "disable compression even for compressible files")
+	fs.Var(&encrypt, "encrypt", "encrypt assets matching `pattern` (may be repeated)")
+	fs.StringVar(&encryptionKeyEnv, "encryption-key-env", "", "read hex-encoded AES key for -encrypt from environment `variable`")
+	fs.StringVar(&encryptionKeyFile, "encryption-key-file", "", "read hex-encoded AES key for -encrypt from `file`")
+	fs.StringVar(&signingKeyEnv, "signing-key-env", "", "sign assets with hex-encoded Ed25519 private key (or seed) from environment `variable`")
+	fs.StringVar(&signingKeyFile, "signing-key-file", "", "sign assets with Ed25519 private key from `file` (PKCS#8 PEM or hex-encoded key or seed)")
+	fs.StringVar(&verifyOnInit, "verify-on-init", "", "verify signed assets at package initialization and either `panic` or refuse to serve them over HTTP (\"refuse\") on failure")
+	fs.Var(&budgets, "budget", "fail if total stored size of all the assets, or assets matching the pattern, exceeds the `[pattern=]size` (may be repeated)")
+	fs.StringVar(&maxFileSize, "max-file-size", "", "fail if stored size of any asset exceeds the `size`")
+	fs.Var(&cacheControl, "cache-control", "make http handler send Cache-Control header `pattern=value` for assets matching the path or \"type:\" MIME type pattern (may be repeated, the first match applies)")
+	fs.Var(&securityHeaders, "security-header", "make http handler send security header `[pattern=]name:value`, such as Content-Security-Policy, for assets matching the pattern instead of the default, an empty value disables the header (may be repeated, the first match applies)")
+}
+
+// options returns generation options set with command line
+func options() (*imbed.Options, error) {
 	var (
-		targetDir string
-		buildDir string
-		flags imbed.ImbedFlag
+		opts imbed.Options
 		err error
 	)
+	if len(encrypt) > 0 {
+		if opts.EncryptionKey, err = readEncryptionKey(); err != nil {
+			return nil, err
+		}
+		opts.Encrypt = encrypt
+	}
+	if signingKeyEnv != "" || signingKeyFile != "" {
+		if opts.SigningKey, err = readSigningKey(); err != nil {
+			return nil, err
+		}
+	}
+	switch verifyOnInit {
+	case "":
+	case "panic":
+		opts.InitCheck = imbed.PanicOnInitCheck
+	case "refuse":
+		opts.InitCheck = imbed.RefuseOnInitCheck
+	default:
+		return nil, fmt.Errorf("-verify-on-init must be either panic or refuse")
+	}
+	for _, b := range budgets {
+		var budget imbed.Budget
+		if i := strings.LastIndex(b, "="); i >= 0 {
+			budget.Pattern = b[:i]
+			b = b[i+1:]
+		}
+		if budget.Limit, err = imbed.ParseSize(b); err != nil {
+			return nil, fmt.Errorf("-budget: %s", err)
+		}
+		opts.Budgets = append(opts.Budgets, budget)
+	}
+	if maxFileSize != "" {
+		budget := imbed.Budget{PerFile: true}
+		if budget.Limit, err = imbed.ParseSize(maxFileSize); err != nil {
+			return nil, fmt.Errorf("-max-file-size: %s", err)
+		}
+		opts.Budgets = append(opts.Budgets, budget)
+	}
+	for _, c := range cacheControl {
+		i := strings.Index(c, "=")
+		if i < 0 {
+			return nil, fmt.Errorf("-cache-control: %q is not pattern=value", c)
+		}
+		opts.CachePolicies = append(opts.CachePolicies, imbed.CachePolicy{Pattern: c[:i], Value: strings.TrimSpace(c[i+1:])})
+	}
+	for _, h := range securityHeaders {
+		header, ok := parseSecurityHeader(h)
+		if !ok {
+			return nil, fmt.Errorf("-security-header: %q is not [pattern=]name:value", h)
+		}
+		opts.SecurityHeaders = append(opts.SecurityHeaders, header)
+	}
+	return &opts, nil
+}
+
+// parseSecurityHeader parses "[pattern=]name:value" security header setting. Since both
+// the pattern and the value may contain ":" and "=", the name is looked up among the
+// known security headers.
+func parseSecurityHeader(s string) (imbed.SecurityHeader, bool) {
+	for j := 0; j < len(s); j++ {
+		if s[j] != ':' {
+			continue
+		}
+		var header imbed.SecurityHeader
+		name := s[:j]
+		if i := strings.LastIndexByte(name, '='); i >= 0 {
+			header.Pattern, name = name[:i], name[i+1:]
+		}
+		for _, known := range imbed.SecurityHeaderNames {
+			if strings.EqualFold(strings.TrimSpace(name), known) {
+				header.Name, header.Value = known, strings.TrimSpace(s[j+1:])
+				return header, true
+			}
+		}
+	}
+	return imbed.SecurityHeader{}, false
+}
+
+// packageName returns name of the generated package
+func packageName(target string) string {
+	if pkgName == "" {
+		return filepath.Base(target)
+	}
+	return pkgName
+}
+
+// packageFlags returns generation flags set with command line
+func packageFlags() imbed.ImbedFlag {
+	return imbed.ImbedFlag(0).Set(imbed.CompressAssets, !disableCompression).
+		Set(imbed.BuildHttpHandlerAPI, !disableHTTPHandler).
+		Set(imbed.BuildFsAPI, enableFS).
+		Set(imbed.BuildHttpFsAPI, enableHTTPFS).
+		Set(imbed.BuildUnionFsAPI, enableUnionFS).
+		Set(imbed.BuildRawBytesAPI, enableRawBytes)
+}
+
+// check reports whether the target package is up to date, listing all the changes
+func check(source, target string) (bool, error) {
+	if makeBinary {
+		return false, fmt.Errorf("-check can not be used with -binary")
+	}
+	opts, err := options()
+	if err != nil {
+		return false, err
+	}
+	changes, err := imbed.Check(source, target, packageName(target), packageFlags(), opts)
+	if err != nil {
+		return false, err
+	}
+	for _, c := range changes {
+		fmt.Println(c.String())
+	}
+	return len(changes) == 0, nil
+}
+
+func do(source, target string) error {
+	var buildDir string
+	switch {
+	case reportFormat != "" && reportFormat != "json":
+		return fmt.Errorf("-report format must be json")
+	case reportFormat != "" && makeBinary:
+		return fmt.Errorf("-report can not be used with -binary")
+	}
+	opts, err := options()
+	if err != nil {
+		return err
+	}
 	if makeBinary {
 		buildDir, err = ioutil.TempDir(os.TempDir(), ".go-imbed")
 		if err != nil {
@@ -124,32 +323,7 @@ func do(source, target string) error {
 			os.Exit(1)
 		}
 		defer rmtree(buildDir)
-		targetDir = filepath.Join(buildDir, "src", "main")
-		pkgName = "main"
-		flags = imbed.BuildMain | imbed.BuildFsAPI | imbed.BuildHttpHandlerAPI | imbed.CompressAssets
-	} else {
-		targetDir = target
-		if pkgName == "" {
-			pkgName = filepath.Base(target)
-		}
-		flags  = imbed.ImbedFlag(0).Set(imbed.CompressAssets, !disableCompression).
-			Set(imbed.BuildHttpHandlerAPI, !disableHTTPHandler).
-			Set(imbed.BuildFsAPI, enableFS).
-			Set(imbed.BuildHttpFsAPI, enableHTTPFS).
-			Set(imbed.BuildUnionFsAPI, enableUnionFS).
-			Set(imbed.BuildRawBytesAPI, enableRawBytes)
-	}
-	err = imbed.Imbed(source, targetDir, pkgName, flags)
-	if err != nil {
-		return err
-	}
-	if makeBinary {
-		cmd := exec.Command("go", "install", "main")
-		cmd.Env = append(os.Environ(), "GOPATH="+buildDir)
-		cmd.Dir = buildDir
-		cmd.Stderr = os.Stderr
-		cmd.Stdout = os.Stdout
-		err = cmd.Run()
+		err = buildBinary(buildDir, source, filepath.Join(buildDir, "bin", "main"), imbed.CompressAssets, opts)
 		if err != nil {
 			return err
 		}
@@ -168,11 +342,97 @@ func do(source, target string) error {
 		}
 		defer dstBin.Close()
 		_, err = io.Copy(dstBin, srcBin)
+		return err
+	}
+	report, err := imbed.ImbedWithReport(source, target, packageName(target), packageFlags(), opts)
+	if err != nil || reportFormat == "" {
+		return err
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(report)
+}
+
+// buildBinary builds self-contained http server binary with the source content
+// embedded, using buildDir as a GOPATH workspace
+func buildBinary(buildDir, source, binary string, flags imbed.ImbedFlag, opts *imbed.Options) error {
+	flags |= imbed.BuildMain | imbed.BuildFsAPI | imbed.BuildHttpHandlerAPI
+	err := imbed.ImbedWithOptions(source, filepath.Join(buildDir, "src", "main"), "main", flags, opts)
+	if err != nil {
+		return err
+	}
+	cmd := exec.Command("go", "build", "-o", binary, "main")
+	cmd.Env = append(os.Environ(), "GOPATH="+buildDir, "GO111MODULE=off")
+	cmd.Dir = buildDir
+	cmd.Stderr = os.Stderr
+	cmd.Stdout = os.Stdout
+	return cmd.Run()
+}
+
+func readEncryptionKey() ([]byte, error) {
+	var key string
+	switch {
+	case encryptionKeyEnv != "" && encryptionKeyFile != "":
+		return nil, fmt.Errorf("only one of -encryption-key-env and -encryption-key-file can be used")
+	case encryptionKeyEnv != "":
+		key = os.Getenv(encryptionKeyEnv)
+		if key == "" {
+			return nil, fmt.Errorf("environment variable %s is not set", encryptionKeyEnv)
+		}
+	case encryptionKeyFile != "":
+		data, err := ioutil.ReadFile(encryptionKeyFile)
 		if err != nil {
-			return err
+			return nil, err
+		}
+		key = string(data)
+	default:
+		return nil, fmt.Errorf("-encrypt requires either -encryption-key-env or -encryption-key-file")
+	}
+	data, err := hex.DecodeString(strings.TrimSpace(key))
+	if err != nil {
+		return nil, fmt.Errorf("encryption key must be hex-encoded: %s", err)
+	}
+	return data, nil
+}
+
+func readSigningKey() (ed25519.PrivateKey, error) {
+	var key string
+	if signingKeyEnv != "" && signingKeyFile != "" {
+		return nil, fmt.Errorf("only one of -signing-key-env and -signing-key-file can be used")
+	} else if signingKeyEnv != "" {
+		key = os.Getenv(signingKeyEnv)
+		if key == "" {
+			return nil, fmt.Errorf("environment variable %s is not set", signingKeyEnv)
+		}
+	} else {
+		data, err := ioutil.ReadFile(signingKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		key = string(data)
+	}
+	if block, _ := pem.Decode([]byte(key)); block != nil {
+		parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		if pk, ok := parsed.(ed25519.PrivateKey); ok {
+			return pk, nil
 		}
+		return nil, fmt.Errorf("signing key is not an Ed25519 private key")
+	}
+	data, err := hex.DecodeString(strings.TrimSpace(key))
+	if err != nil {
+		return nil, fmt.Errorf("signing key must be either PEM or hex-encoded: %s", err)
+	}
+	switch len(data) {
+	case ed25519.SeedSize:
+		return ed25519.NewKeyFromSeed(data), nil
+	case ed25519.PrivateKeySize:
+		return ed25519.PrivateKey(data), nil
+	default:
+		return nil, fmt.Errorf("signing key must be either %d bytes seed or %d bytes private key", ed25519.SeedSize, ed25519.PrivateKeySize)
 	}
-	return nil
 }
 
 func rmtree(name string) {
@@ -1,8309 +1,1046 @@
 // Code generated by go-imbed. DO NOT EDIT.
 
+//go:build !imbed_dev
+// +build !imbed_dev
+
 #include "textflag.h"
 
-DATA ·d+0(SB)/8,$"\x1f\x8b\x08\x00\x00\x00\x00\x00"
-DATA ·d+8(SB)/8,$"\x02\xff\x54\x90\xb9\x4e\xf4\x30"
-DATA ·d+16(SB)/8,$"\x14\x85\x7b\x3f\xc5\xfd\x6f\x9f"
-DATA ·d+24(SB)/8,$"\xf1\xe8\x9f\x29\x90\xb0\xdd\x00"
-DATA ·d+32(SB)/8,$"\x12\x1d\x23\x96\x82\xd2\x24\x27"
-DATA ·d+40(SB)/8,$"\x8b\x70\xec\x4c\x7c\x83\xc8\xdb"
-DATA ·d+48(SB)/8,$"\xa3\x2c\x05\x54\x5e\xee\xf1\xa7"
-DATA ·d+56(SB)/8,$"\xef\xd8\xfc\xbb\x7f\xba\x7b\x7d"
-DATA ·d+64(SB)/8,$"\xbf\x3c\x50\x2b\x7d\x70\xca\x2c"
-DATA ·d+72(SB)/8,$"\x0b\x75\x95\xe5\x65\xc3\x14\x7c"
-DATA ·d+80(SB)/8,$"\x6c\x2c\x23\x16\x6f\x2f\xbc\x4c"
-DATA ·d+88(SB)/8,$"\xe1\x2b\xa7\x88\x88\x4c\x0f\xf1"
-DATA ·d+96(SB)/8,$"\xd4\x8a\x0c\x05\xae\x53\xf7\x65"
-DATA ·d+104(SB)/8,$"\xb9\x4c\x51\x10\xa5\x90\x79\x00"
-DATA ·d+112(SB)/8,$"\xd3\x7e\xb2\x2c\xf8\x16\xbd\xc0"
-DATA ·d+120(SB)/8,$"\x6e\xcb\xd6\x8f\x19\x62\x27\xa9"
-DATA ·d+128(SB)/8,$"\x8b\x1b\xde\x31\xd2\x49\x80\x3b"
-DATA ·d+136(SB)/8,$"\x1f\xcf\x74\xf1\x0d\x28\x26\xa1"
-DATA ·d+144(SB)/8,$"\x3a\x4d\xb1\x32\x7a\x9b\x6c\xa9"
-DATA ·d+152(SB)/8,$"\xd0\xc5\x4f\x1a\x11\x2c\x67\x99"
-DATA ·d+160(SB)/8,$"\x03\x72\x0b\x08\x53\x3b\xa2\xb6"
-DATA ·d+168(SB)/8,$"\xac\xcb\x9c\xf5\x7a\x7d\x28\x73"
-DATA ·d+176(SB)/8,$"\x66\xd2\x4e\x6d\x72\xd1\xf7\xb0"
-DATA ·d+184(SB)/8,$"\xdc\x20\x62\xf4\x92\xc6\x5f\x4e"
-DATA ·d+192(SB)/8,$"\x8f\x53\x93\xe8\x78\x38\xfd\x3f"
-DATA ·d+200(SB)/8,$"\x9c\xb6\xbc\xde\x8a\x29\xf3\x91"
-DATA ·d+208(SB)/8,$"\xaa\xd9\x29\x93\x51\x4a\x97\xe2"
-DATA ·d+216(SB)/8,$"\xfa\x11\x31\xc9\x6a\xc4\x4e\xad"
-DATA ·d+224(SB)/8,$"\x32\xcf\xb8\x4e\xc8\x82\x8a\x86"
-DATA ·d+232(SB)/8,$"\x3f\xc6\x4a\x19\xbd\xbf\x5b\x88"
-DATA ·d+240(SB)/8,$"\x3b\x69\xad\xee\x7e\x02\x00\x00"
-DATA ·d+248(SB)/8,$"\xff\xff\x0d\x23\x18\x8c\x67\x01"
-DATA ·d+256(SB)/8,$"\x00\x00\x00\x00\x00\x00\x00\x00"
-DATA ·d+264(SB)/8,$"\x1f\x8b\x08\x00\x00\x00\x00\x00"
-DATA ·d+272(SB)/8,$"\x02\xff\x9c\x56\x5f\x6f\xa3\xb8"
-DATA ·d+280(SB)/8,$"\x17\x7d\xe7\x53\x5c\xa5\xaa\x3a"
-DATA ·d+288(SB)/8,$"\x23\x41\x02\x24\xad\x52\xf2\x32"
-DATA ·d+296(SB)/8,$"\xfd\x55\xad\x7e\x2b\xed\xbc\x6c"
-DATA ·d+304(SB)/8,$"\xb5\xcf\x2b\x63\x4c\xf0\xd6\xf8"
-DATA ·d+312(SB)/8,$"\xb2\xb6\x69\x93\x19\xe5\xbb\xaf"
-DATA ·d+320(SB)/8,$"\x0c\x86\x40\x42\x32\xd2\x2a\x4f"
-DATA ·d+328(SB)/8,$"\xb1\x7d\xee\x9f\x73\xcf\xbd\x17"
-DATA ·d+336(SB)/8,$"\xef\x1b\x2f\x2b\x54\x06\x6a\x25"
-DATA ·d+344(SB)/8,$"\xbe\x14\xc6\x54\x3a\x59\x2c\x68"
-DATA ·d+352(SB)/8,$"\x26\xe7\x8a\x7c\x6e\xb9\x99\x53"
-DATA ·d+360(SB)/8,$"\x2c\x17\x06\xa5\x7e\xdf\x2f\x5e"
-DATA ·d+368(SB)/8,$"\xb9\x22\xcf\x98\xb1\x45\x34\x8f"
-DATA ·d+376(SB)/8,$"\xc3\xd5\x22\xe3\xda\xa8\x45\xce"
-DATA ·d+384(SB)/8,$"\x15\xf9\x8b\x62\xc6\xe6\x54\xeb"
-DATA ·d+392(SB)/8,$"\xaf\x1b\xcf\x4b\x31\xdb\xc3\x4f"
-DATA ·d+400(SB)/8,$"\x0f\x00\x20\x47\x69\x02\xcd\x7f"
-DATA ·d+408(SB)/8,$"\xb0\x04\xa2\x87\x6a\xb7\x69\x0e"
-DATA ·d+416(SB)/8,$"\x05\x97\x2c\x28\x18\xdf\x16\x26"
-DATA ·d+424(SB)/8,$"\x81\x68\xfe\xb0\x39\x3e\xcd\x49"
-DATA ·d+432(SB)/8,$"\xc9\xc5\x3e\x81\x80\x54\x95\x60"
-DATA ·d+440(SB)/8,$"\x81\xde\x6b\xc3\x4a\x1f\xfe\x27"
-DATA ·d+448(SB)/8,$"\xb8\x7c\xff\x4e\xe8\x5b\xf3\xff"
-DATA ·d+456(SB)/8,$"\x15\xa5\xf1\x61\xf6\xc6\xb6\xc8"
-DATA ·d+464(SB)/8,$"\xe0\xcf\xdf\x66\x3e\xfc\x81\x29"
-DATA ·d+472(SB)/8,$"\x1a\xf4\xe1\xff\x4c\x7c\x30\xc3"
-DATA ·d+480(SB)/8,$"\x29\xf1\xe1\x49\x71\x22\x7c\xd0"
-DATA ·d+488(SB)/8,$"\x44\xea\x40\x33\xc5\x73\x1f\x66"
-DATA ·d+496(SB)/8,$"\x4f\xd6\x28\x3c\xa3\x40\x05\x2f"
-DATA ·d+504(SB)/8,$"\x25\xfe\xcd\x67\x03\x33\x13\x27"
-DATA ·d+512(SB)/8,$"\x6f\xfb\x32\x45\x31\x6b\xe3\xa3"
-DATA ·d+520(SB)/8,$"\x16\x96\xc0\x4d\xbc\xb2\xbf\xf6"
-DATA ·d+528(SB)/8,$"\xac\x24\xbb\xe0\x93\x67\xa6\x48"
-DATA ·d+536(SB)/8,$"\x60\x1d\x86\x5d\x7e\x25\x51\x5b"
-DATA ·d+544(SB)/8,$"\x2e\x13\x08\x6f\x81\xd4\x06\x37"
-DATA ·d+552(SB)/8,$"\xde\xc1\xcb\x11\x0d\x53\x8e\x14"
-DATA ·d+560(SB)/8,$"\xc3\x76\x26\x20\x82\x6f\x65\x02"
-DATA ·d+568(SB)/8,$"\x94\x49\xc3\x94\x7d\x52\x44\x3e"
-DATA ·d+576(SB)/8,$"\x14\xb1\x0f\xc5\xd2\x87\x62\xe5"
-DATA ·d+584(SB)/8,$"\x43\x71\xef\x43\x11\x01\x71\xa0"
-DATA ·d+592(SB)/8,$"\xde\xfd\xc3\xf2\x69\xb5\x1e\x50"
-DATA ·d+600(SB)/8,$"\xf6\xe9\x88\xbc\x0f\xc3\xcd\xd1"
-DATA ·d+608(SB)/8,$"\x7c\xc6\x28\x2a\x62\x38\xca\x04"
-DATA ·d+616(SB)/8,$"\x24\x4a\x76\xf4\x70\x5e\x99\xb8"
-DATA ·d+624(SB)/8,$"\xaf\x4c\x8a\x2a\x63\x2a\x48\xd1"
-DATA ·d+632(SB)/8,$"\x18\x2c\x13\x88\xaa\x1d\x68\x14"
-DATA ·d+640(SB)/8,$"\x3c\x83\x1b\xc6\x98\xb5\x30\x85"
-DATA ·d+648(SB)/8,$"\x8e\x3b\x74\x45\xb2\x8c\xcb\x6d"
-DATA ·d+656(SB)/8,$"\x0f\x0f\xe7\x0f\xac\x6c\x1c\x2f"
-DATA ·d+664(SB)/8,$"\x27\xe4\xb0\x1e\xd3\x35\x40\x2d"
-DATA ·d+672(SB)/8,$"\x1d\x2a\x02\x5d\x12\x21\xce\x28"
-DATA ·d+680(SB)/8,$"\x78\x5c\x3f\x3e\x3e\x3e\x6f\xce"
-DATA ·d+688(SB)/8,$"\x2c\xde\x77\x16\x47\xbc\x48\x54"
-DATA ·d+696(SB)/8,$"\x25\x11\xee\x42\x20\x31\x09\x28"
-DATA ·d+704(SB)/8,$"\x7b\xe3\x62\x46\xcd\x5b\x96\x48"
-DATA ·d+712(SB)/8,$"\xaa\x51\xd4\x86\x39\x12\xb1\x1a"
-DATA ·d+720(SB)/8,$"\x5a\x54\xad\xad\xb8\xa9\xf1\xc1"
-DATA ·d+728(SB)/8,$"\xd3\x8c\x5a\x90\x8b\x2b\x25\xf4"
-DATA ·d+736(SB)/8,$"\x7d\xab\xb0\x96\x59\x02\x37\x79"
-DATA ·d+744(SB)/8,$"\x9e\x9f\x5a\x56\x4c\x10\xc3\x3f"
-DATA ·d+752(SB)/8,$"\x9a\x1a\xdc\x08\x4e\x99\xd4\x6c"
-DATA ·d+760(SB)/8,$"\x82\x90\xb0\x32\xf6\x85\x37\x6f"
-DATA ·d+768(SB)/8,$"\x35\xc1\xb2\xa0\xe2\xd4\xd4\x8a"
-DATA ·d+776(SB)/8,$"\x5d\x95\x8d\x97\x0a\xa4\xef\xff"
-DATA ·d+784(SB)/8,$"\xd4\x68\xba\x77\xae\x88\x82\xe5"
-DATA ·d+792(SB)/8,$"\x26\x81\xe5\xb1\x84\xd9\xfd\x8a"
-DATA ·d+800(SB)/8,$"\xc5\xd1\xe6\x72\x5f\xba\xfa\x25"
-DATA ·d+808(SB)/8,$"\x10\x36\xbf\xb8\x17\x74\x47\x7c"
-DATA ·d+816(SB)/8,$"\x67\xe1\x30\x74\x7a\x5a\x9d\x33"
-DATA ·d+824(SB)/8,$"\x37\x23\x81\x8e\xa0\x76\x68\x5c"
-DATA ·d+832(SB)/8,$"\x40\x1f\xbc\xaa\x4f\xfc\xc8\x6f"
-DATA ·d+840(SB)/8,$"\xd0\x3d\xcb\xc3\x7c\x99\x2f\x4f"
-DATA ·d+848(SB)/8,$"\xc2\x8e\xac\x6e\x26\x14\x15\xb5"
-DATA ·d+856(SB)/8,$"\x7a\xb2\xde\x7c\xa0\xdc\x8c\xc8"
-DATA ·d+864(SB)/8,$"\xef\x26\xce\xcc\xce\x36\xb0\xc3"
-DATA ·d+872(SB)/8,$"\xcd\x8e\x80\xdf\x6b\xca\x33\xfb"
-DATA ·d+880(SB)/8,$"\x57\x6a\x14\xf6\xe4\x3b\x4a\x42"
-DATA ·d+888(SB)/8,$"\xd1\x87\x12\x25\xea\x8a\x50\x36"
-DATA ·d+896(SB)/8,$"\x39\xca\x9a\x04\x4f\x09\x89\xd8"
-DATA ·d+904(SB)/8,$"\x9a\xa6\xec\x42\x6f\xd6\x32\x63"
-DATA ·d+912(SB)/8,$"\xca\x5a\x69\x90\x49\x81\x1f\xfd"
-DATA ·d+920(SB)/8,$"\x98\x38\xa7\xa4\x16\xee\x4a\x70"
-DATA ·d+928(SB)/8,$"\x6d\x02\x6d\xf6\x82\x75\xdd\x3d"
-DATA ·d+936(SB)/8,$"\xec\xbf\xb6\xf2\x4d\x2c\x38\x89"
-DATA ·d+944(SB)/8,$"\xa8\xcb\xb4\x15\x0f\x0a\x10\xdc"
-DATA ·d+952(SB)/8,$"\xbd\x70\xa4\xb5\xe0\xc0\x71\xd6"
-DATA ·d+960(SB)/8,$"\x3c\x48\x04\xd1\x26\xa0\x05\x17"
-DATA ·d+968(SB)/8,$"\xd9\xf8\x6d\xdf\xb2\x2e\xb8\x3e"
-DATA ·d+976(SB)/8,$"\xbe\x2e\x92\xa6\x8b\xc2\xcd\x05"
-DATA ·d+984(SB)/8,$"\xcc\x99\xd7\xd5\xed\xd1\x90\xe0"
-DATA ·d+992(SB)/8,$"\x49\xca\x72\x54\x47\x81\x48\xc3"
-DATA ·d+1000(SB)/8,$"\xa4\x49\xe0\x2e\xb8\x6b\xa1\x19"
-DATA ·d+1008(SB)/8,$"\xd7\x95\x20\xfb\x04\xb8\x6c\xca"
-DATA ·d+1016(SB)/8,$"\xd0\x08\x6b\xcc\x44\xd7\xb6\x8d"
-DATA ·d+1024(SB)/8,$"\x5d\xaf\x16\x73\x8a\x22\x88\xaf"
-DATA ·d+1032(SB)/8,$"\x8c\x93\xe0\x93\xa5\xef\xdc\x58"
-DATA ·d+1040(SB)/8,$"\x9d\xd5\xa5\x0c\x28\xd6\xd6\x65"
-DATA ·d+1048(SB)/8,$"\xec\x2e\x4b\xfc\x31\x7d\x33\xfd"
-DATA ·d+1056(SB)/8,$"\x7c\x6c\x6b\x4b\xaa\x64\xd0\x53"
-DATA ·d+1064(SB)/8,$"\x43\x63\x27\x57\xe7\xa7\x07\xcf"
-DATA ·d+1072(SB)/8,$"\xfb\x56\xb2\x8c\x13\xd0\x54\x31"
-DATA ·d+1080(SB)/8,$"\x26\x81\xc8\x0c\xbe\x94\x5c\x76"
-DATA ·d+1088(SB)/8,$"\xdb\xe7\xde\x6e\x9f\xaf\x2e\xb1"
-DATA ·d+1096(SB)/8,$"\x93\x3c\x2f\xe7\xe5\x1a\xe8\x42"
-DATA ·d+1104(SB)/8,$"\x6e\x83\xdb\xcb\xb0\xab\x39\xfe"
-DATA ·d+1112(SB)/8,$"\x22\xcf\xe9\x5c\xed\xe9\xc1\x3b"
-DATA ·d+1120(SB)/8,$"\x78\x92\x7c\x4c\xcd\xd7\xd7\xf0"
-DATA ·d+1128(SB)/8,$"\x35\x7a\x75\x11\x0c\x08\x88\x8f"
-DATA ·d+1136(SB)/8,$"\xb3\xfa\x44\x68\xa7\xe7\x83\xc9"
-DATA ·d+1144(SB)/8,$"\xde\x38\x49\x72\xae\xb4\x09\x30"
-DATA ·d+1152(SB)/8,$"\x0f\xcc\xbe\xba\x3e\xce\xdc\x64"
-DATA ·d+1160(SB)/8,$"\x55\x24\xe3\xb5\x76\xb2\x9f\xb0"
-DATA ·d+1168(SB)/8,$"\xf0\x8b\x2e\x9e\x42\x8c\xa4\x3e"
-DATA ·d+1176(SB)/8,$"\x31\xef\x46\xe8\x39\xc9\x73\xbe"
-DATA ·d+1184(SB)/8,$"\xeb\x5a\xad\x5f\x33\x39\xdf\xb1"
-DATA ·d+1192(SB)/8,$"\x6c\xb0\xbd\x3a\xe9\xf4\x08\x47"
-DATA ·d+1200(SB)/8,$"\xc9\x19\xf0\xb8\xf9\x5a\xd6\xc9"
-DATA ·d+1208(SB)/8,$"\x78\x91\x74\xe9\x2e\x47\xeb\xf5"
-DATA ·d+1216(SB)/8,$"\x74\xe7\xf6\xdd\x38\x68\x43\x5a"
-DATA ·d+1224(SB)/8,$"\x2b\x6d\xc3\xaf\x90\xb7\xeb\xea"
-DATA ·d+1232(SB)/8,$"\xf2\x47\xcb\xd4\xb6\xbc\xf2\x31"
-DATA ·d+1240(SB)/8,$"\x33\x9e\xfb\x61\xb5\x83\xa8\xff"
-DATA ·d+1248(SB)/8,$"\x0c\x71\x8a\x88\xc2\xf0\x76\x72"
-DATA ·d+1256(SB)/8,$"\x1a\x2c\x2f\x7c\xed\xc4\xc7\x55"
-DATA ·d+1264(SB)/8,$"\xd9\x2c\x71\x47\xc6\xa5\x31\x74"
-DATA ·d+1272(SB)/8,$"\x37\xf2\xb6\xba\xc6\x43\x3f\x90"
-DATA ·d+1280(SB)/8,$"\xaf\x7d\x71\xf4\x5b\xa4\x8f\xbb"
-DATA ·d+1288(SB)/8,$"\x37\x75\xcc\x79\xd4\x0a\xa3\xc5"
-DATA ·d+1296(SB)/8,$"\xe2\x26\x6d\x17\xf4\x50\x82\x13"
-DATA ·d+1304(SB)/8,$"\x7a\x7a\x79\x78\x59\xbf\x3c\x6d"
-DATA ·d+1312(SB)/8,$"\xfe\xe3\x9e\x1a\xb8\x18\xb3\x73"
-DATA ·d+1320(SB)/8,$"\x9a\x7c\xf7\xb4\x59\x20\x9d\xda"
-DATA ·d+1328(SB)/8,$"\x7f\x4e\x91\xdf\x7d\xa3\xfe\x1b"
-DATA ·d+1336(SB)/8,$"\x00\x00\xff\xff\x09\x2a\x81\x4e"
-DATA ·d+1344(SB)/8,$"\x8d\x0c\x00\x00\x00\x00\x00\x00"
-DATA ·d+1352(SB)/8,$"\xff\xd8\xff\xe0\x00\x10\x4a\x46"
-DATA ·d+1360(SB)/8,$"\x49\x46\x00\x01\x01\x00\x00\x48"
-DATA ·d+1368(SB)/8,$"\x00\x48\x00\x00\xff\xe1\x00\x8c"
-DATA ·d+1376(SB)/8,$"\x45\x78\x69\x66\x00\x00\x4d\x4d"
-DATA ·d+1384(SB)/8,$"\x00\x2a\x00\x00\x00\x08\x00\x05"
-DATA ·d+1392(SB)/8,$"\x01\x12\x00\x03\x00\x00\x00\x01"
-DATA ·d+1400(SB)/8,$"\x00\x01\x00\x00\x01\x1a\x00\x05"
-DATA ·d+1408(SB)/8,$"\x00\x00\x00\x01\x00\x00\x00\x4a"
-DATA ·d+1416(SB)/8,$"\x01\x1b\x00\x05\x00\x00\x00\x01"
-DATA ·d+1424(SB)/8,$"\x00\x00\x00\x52\x01\x28\x00\x03"
-DATA ·d+1432(SB)/8,$"\x00\x00\x00\x01\x00\x02\x00\x00"
-DATA ·d+1440(SB)/8,$"\x87\x69\x00\x04\x00\x00\x00\x01"
-DATA ·d+1448(SB)/8,$"\x00\x00\x00\x5a\x00\x00\x00\x00"
-DATA ·d+1456(SB)/8,$"\x00\x00\x00\x48\x00\x00\x00\x01"
-DATA ·d+1464(SB)/8,$"\x00\x00\x00\x48\x00\x00\x00\x01"
-DATA ·d+1472(SB)/8,$"\x00\x03\xa0\x01\x00\x03\x00\x00"
-DATA ·d+1480(SB)/8,$"\x00\x01\x00\x01\x00\x00\xa0\x02"
-DATA ·d+1488(SB)/8,$"\x00\x04\x00\x00\x00\x01\x00\x00"
-DATA ·d+1496(SB)/8,$"\x01\x7a\xa0\x03\x00\x04\x00\x00"
-DATA ·d+1504(SB)/8,$"\x00\x01\x00\x00\x01\x40\x00\x00"
-DATA ·d+1512(SB)/8,$"\x00\x00\xff\xed\x00\x38\x50\x68"
-DATA ·d+1520(SB)/8,$"\x6f\x74\x6f\x73\x68\x6f\x70\x20"
-DATA ·d+1528(SB)/8,$"\x33\x2e\x30\x00\x38\x42\x49\x4d"
-DATA ·d+1536(SB)/8,$"\x04\x04\x00\x00\x00\x00\x00\x00"
-DATA ·d+1544(SB)/8,$"\x38\x42\x49\x4d\x04\x25\x00\x00"
-DATA ·d+1552(SB)/8,$"\x00\x00\x00\x10\xd4\x1d\x8c\xd9"
-DATA ·d+1560(SB)/8,$"\x8f\x00\xb2\x04\xe9\x80\x09\x98"
-DATA ·d+1568(SB)/8,$"\xec\xf8\x42\x7e\xff\xc0\x00\x11"
-DATA ·d+1576(SB)/8,$"\x08\x01\x40\x01\x7a\x03\x01\x22"
-DATA ·d+1584(SB)/8,$"\x00\x02\x11\x01\x03\x11\x01\xff"
-DATA ·d+1592(SB)/8,$"\xc4\x00\x1f\x00\x00\x01\x05\x01"
-DATA ·d+1600(SB)/8,$"\x01\x01\x01\x01\x01\x00\x00\x00"
-DATA ·d+1608(SB)/8,$"\x00\x00\x00\x00\x00\x01\x02\x03"
-DATA ·d+1616(SB)/8,$"\x04\x05\x06\x07\x08\x09\x0a\x0b"
-DATA ·d+1624(SB)/8,$"\xff\xc4\x00\xb5\x10\x00\x02\x01"
-DATA ·d+1632(SB)/8,$"\x03\x03\x02\x04\x03\x05\x05\x04"
-DATA ·d+1640(SB)/8,$"\x04\x00\x00\x01\x7d\x01\x02\x03"
-DATA ·d+1648(SB)/8,$"\x00\x04\x11\x05\x12\x21\x31\x41"
-DATA ·d+1656(SB)/8,$"\x06\x13\x51\x61\x07\x22\x71\x14"
-DATA ·d+1664(SB)/8,$"\x32\x81\x91\xa1\x08\x23\x42\xb1"
-DATA ·d+1672(SB)/8,$"\xc1\x15\x52\xd1\xf0\x24\x33\x62"
-DATA ·d+1680(SB)/8,$"\x72\x82\x09\x0a\x16\x17\x18\x19"
-DATA ·d+1688(SB)/8,$"\x1a\x25\x26\x27\x28\x29\x2a\x34"
-DATA ·d+1696(SB)/8,$"\x35\x36\x37\x38\x39\x3a\x43\x44"
-DATA ·d+1704(SB)/8,$"\x45\x46\x47\x48\x49\x4a\x53\x54"
-DATA ·d+1712(SB)/8,$"\x55\x56\x57\x58\x59\x5a\x63\x64"
-DATA ·d+1720(SB)/8,$"\x65\x66\x67\x68\x69\x6a\x73\x74"
-DATA ·d+1728(SB)/8,$"\x75\x76\x77\x78\x79\x7a\x83\x84"
-DATA ·d+1736(SB)/8,$"\x85\x86\x87\x88\x89\x8a\x92\x93"
-DATA ·d+1744(SB)/8,$"\x94\x95\x96\x97\x98\x99\x9a\xa2"
-DATA ·d+1752(SB)/8,$"\xa3\xa4\xa5\xa6\xa7\xa8\xa9\xaa"
-DATA ·d+1760(SB)/8,$"\xb2\xb3\xb4\xb5\xb6\xb7\xb8\xb9"
-DATA ·d+1768(SB)/8,$"\xba\xc2\xc3\xc4\xc5\xc6\xc7\xc8"
-DATA ·d+1776(SB)/8,$"\xc9\xca\xd2\xd3\xd4\xd5\xd6\xd7"
-DATA ·d+1784(SB)/8,$"\xd8\xd9\xda\xe1\xe2\xe3\xe4\xe5"
-DATA ·d+1792(SB)/8,$"\xe6\xe7\xe8\xe9\xea\xf1\xf2\xf3"
-DATA ·d+1800(SB)/8,$"\xf4\xf5\xf6\xf7\xf8\xf9\xfa\xff"
-DATA ·d+1808(SB)/8,$"\xc4\x00\x1f\x01\x00\x03\x01\x01"
-DATA ·d+1816(SB)/8,$"\x01\x01\x01\x01\x01\x01\x01\x00"
-DATA ·d+1824(SB)/8,$"\x00\x00\x00\x00\x00\x01\x02\x03"
-DATA ·d+1832(SB)/8,$"\x04\x05\x06\x07\x08\x09\x0a\x0b"
-DATA ·d+1840(SB)/8,$"\xff\xc4\x00\xb5\x11\x00\x02\x01"
-DATA ·d+1848(SB)/8,$"\x02\x04\x04\x03\x04\x07\x05\x04"
-DATA ·d+1856(SB)/8,$"\x04\x00\x01\x02\x77\x00\x01\x02"
-DATA ·d+1864(SB)/8,$"\x03\x11\x04\x05\x21\x31\x06\x12"
-DATA ·d+1872(SB)/8,$"\x41\x51\x07\x61\x71\x13\x22\x32"
-DATA ·d+1880(SB)/8,$"\x81\x08\x14\x42\x91\xa1\xb1\xc1"
-DATA ·d+1888(SB)/8,$"\x09\x23\x33\x52\xf0\x15\x62\x72"
-DATA ·d+1896(SB)/8,$"\xd1\x0a\x16\x24\x34\xe1\x25\xf1"
-DATA ·d+1904(SB)/8,$"\x17\x18\x19\x1a\x26\x27\x28\x29"
-DATA ·d+1912(SB)/8,$"\x2a\x35\x36\x37\x38\x39\x3a\x43"
-DATA ·d+1920(SB)/8,$"\x44\x45\x46\x47\x48\x49\x4a\x53"
-DATA ·d+1928(SB)/8,$"\x54\x55\x56\x57\x58\x59\x5a\x63"
-DATA ·d+1936(SB)/8,$"\x64\x65\x66\x67\x68\x69\x6a\x73"
-DATA ·d+1944(SB)/8,$"\x74\x75\x76\x77\x78\x79\x7a\x82"
-DATA ·d+1952(SB)/8,$"\x83\x84\x85\x86\x87\x88\x89\x8a"
-DATA ·d+1960(SB)/8,$"\x92\x93\x94\x95\x96\x97\x98\x99"
-DATA ·d+1968(SB)/8,$"\x9a\xa2\xa3\xa4\xa5\xa6\xa7\xa8"
-DATA ·d+1976(SB)/8,$"\xa9\xaa\xb2\xb3\xb4\xb5\xb6\xb7"
-DATA ·d+1984(SB)/8,$"\xb8\xb9\xba\xc2\xc3\xc4\xc5\xc6"
-DATA ·d+1992(SB)/8,$"\xc7\xc8\xc9\xca\xd2\xd3\xd4\xd5"
-DATA ·d+2000(SB)/8,$"\xd6\xd7\xd8\xd9\xda\xe2\xe3\xe4"
-DATA ·d+2008(SB)/8,$"\xe5\xe6\xe7\xe8\xe9\xea\xf2\xf3"
-DATA ·d+2016(SB)/8,$"\xf4\xf5\xf6\xf7\xf8\xf9\xfa\xff"
-DATA ·d+2024(SB)/8,$"\xdb\x00\x43\x00\x01\x01\x01\x01"
-DATA ·d+2032(SB)/8,$"\x01\x01\x02\x01\x01\x02\x02\x02"
-DATA ·d+2040(SB)/8,$"\x02\x02\x02\x03\x02\x02\x02\x02"
-DATA ·d+2048(SB)/8,$"\x03\x04\x03\x03\x03\x03\x03\x04"
-DATA ·d+2056(SB)/8,$"\x05\x04\x04\x04\x04\x04\x04\x05"
-DATA ·d+2064(SB)/8,$"\x05\x05\x05\x05\x05\x05\x05\x06"
-DATA ·d+2072(SB)/8,$"\x06\x06\x06\x06\x06\x07\x07\x07"
-DATA ·d+2080(SB)/8,$"\x07\x07\x08\x08\x08\x08\x08\x08"
-DATA ·d+2088(SB)/8,$"\x08\x08\x08\x08\xff\xdb\x00\x43"
-DATA ·d+2096(SB)/8,$"\x01\x01\x01\x01\x02\x02\x02\x03"
-DATA ·d+2104(SB)/8,$"\x02\x02\x03\x08\x05\x05\x05\x08"
-DATA ·d+2112(SB)/8,$"\x08\x08\x08\x08\x08\x08\x08\x08"
-DATA ·d+2120(SB)/8,$"\x08\x08\x08\x08\x08\x08\x08\x08"
-DATA ·d+2128(SB)/8,$"\x08\x08\x08\x08\x08\x08\x08\x08"
-DATA ·d+2136(SB)/8,$"\x08\x08\x08\x08\x08\x08\x08\x08"
-DATA ·d+2144(SB)/8,$"\x08\x08\x08\x08\x08\x08\x08\x08"
-DATA ·d+2152(SB)/8,$"\x08\x08\x08\x08\x08\x08\x08\x08"
-DATA ·d+2160(SB)/8,$"\x08\xff\xdd\x00\x04\x00\x18\xff"
-DATA ·d+2168(SB)/8,$"\xda\x00\x0c\x03\x01\x00\x02\x11"
-DATA ·d+2176(SB)/8,$"\x03\x11\x00\x3f\x00\xfc\x0d\xd5"
-DATA ·d+2184(SB)/8,$"\x75\xbf\x08\x58\x2d\xbc\xb7\x8e"
-DATA ·d+2192(SB)/8,$"\x97\x0c\xe4\x9f\xb3\xb9\x12\x49"
-DATA ·d+2200(SB)/8,$"\xc7\x40\xe8\xa7\x80\x7d\xb8\xac"
-DATA ·d+2208(SB)/8,$"\x2d\x6e\xf3\x42\xbe\x8f\xed\x6a"
-DATA ·d+2216(SB)/8,$"\xd2\x23\x4a\xa0\x35\xb8\x8c\xc5"
-DATA ·d+2224(SB)/8,$"\x16\x07\x60\xa8\x40\x38\xf7\xeb"
-DATA ·d+2232(SB)/8,$"\x57\xed\xf4\xbd\x08\x44\xd0\xcf"
-DATA ·d+2240(SB)/8,$"\x6d\x0d\xac\xcf\x29\x8e\x47\x8c"
-DATA ·d+2248(SB)/8,$"\x04\xe0\x76\x2e\x72\xdc\xff\x00"
-DATA ·d+2256(SB)/8,$"\xbc\x29\x9f\xd8\xfa\x46\x9f\x64"
-DATA ·d+2264(SB)/8,$"\x5f\x41\xd3\x23\xb9\x1b\xbe\x6b"
-DATA ·d+2272(SB)/8,$"\x89\xdc\x4e\x01\x07\x9c\x28\x39"
-DATA ·d+2280(SB)/8,$"\xe7\xdc\xd7\xfa\x7f\x5e\xb4\xea"
-DATA ·d+2288(SB)/8,$"\x27\x7e\x5b\x7a\x5d\x9f\xe5\x75"
-DATA ·d+2296(SB)/8,$"\x07\x4a\x9b\x56\x73\xe6\xee\xec"
-DATA ·d+2304(SB)/8,$"\x97\xf9\x7e\x6c\xca\xd3\x2e\x74"
-DATA ·d+2312(SB)/8,$"\x68\xa2\x0b\x63\x6d\x75\x66\xfc"
-DATA ·d+2320(SB)/8,$"\x1f\xb4\x79\x6b\x8c\x0c\x8e\x0b"
-DATA ·d+2328(SB)/8,$"\x13\xc1\xf4\xc5\x4f\x2e\xbe\x89"
-DATA ·d+2336(SB)/8,$"\x2b\x4d\x2b\x5c\xdc\x14\xc0\x06"
-DATA ·d+2344(SB)/8,$"\x66\x20\x1c\xf5\x25\x57\x1d\x6a"
-DATA ·d+2352(SB)/8,$"\x53\x6d\x2e\xad\x68\x22\x96\xd6"
-DATA ·d+2360(SB)/8,$"\xd5\x59\x18\x0d\xd2\xc7\x22\x10"
-DATA ·d+2368(SB)/8,$"\x7d\xd7\x70\x51\x56\xbf\xb1\xee"
-DATA ·d+2376(SB)/8,$"\xf4\x7d\x35\x2e\xed\x9d\x52\x46"
-DATA ·d+2384(SB)/8,$"\x90\x94\x51\x0c\x41\x1b\xb1\xda"
-DATA ·d+2392(SB)/8,$"\xcc\x32\x7d\x81\x22\xa6\x84\xda"
-DATA ·d+2400(SB)/8,$"\x5e\xee\xa9\x6b\x74\xbf\x43\x79"
-DATA ·d+2408(SB)/8,$"\xce\x8d\xfd\xf7\xab\xe8\xdf\xeb"
-DATA ·d+2416(SB)/8,$"\xb9\xcf\xc7\x7b\xe2\x9b\x8b\x86"
-DATA ·d+2424(SB)/8,$"\xb9\x8a\xd5\x16\x07\x7f\x31\x23"
-DATA ·d+2432(SB)/8,$"\x89\x70\x00\xed\xfc\x44\xe3\xf5"
-DATA ·d+2440(SB)/8,$"\xae\x98\xeb\xda\xa5\xa4\x02\x4b"
-DATA ·d+2448(SB)/8,$"\x9b\x58\xb3\x90\xcc\xb1\x0e\x77"
-DATA ·d+2456(SB)/8,$"\x76\x2c\x40\xca\x8e\xfe\x95\x4d"
-DATA ·d+2464(SB)/8,$"\xf4\xdf\x17\x6a\xf1\xc5\x67\x3c"
-DATA ·d+2472(SB)/8,$"\x72\x98\xd8\x72\xcd\x2a\xc0\xc4"
-DATA ·d+2480(SB)/8,$"\x1c\xe3\x25\x01\xc0\x35\xcf\x5c"
-DATA ·d+2488(SB)/8,$"\xf8\x2b\x52\xb9\x65\xd3\x21\x9e"
-DATA ·d+2496(SB)/8,$"\x78\xd5\xdc\xa0\x81\x03\x04\x63"
-DATA ·d+2504(SB)/8,$"\xdf\x2c\xcc\x0b\x7d\x71\x59\xca"
-DATA ·d+2512(SB)/8,$"\x75\xe0\x9a\xa4\xa4\xef\xdf\x4d"
-DATA ·d+2520(SB)/8,$"\xd9\xa2\x8e\x16\xa3\xfd\xeb\x8a"
-DATA ·d+2528(SB)/8,$"\xb7\x6d\x74\xfb\xcd\x87\xb9\xf1"
-DATA ·d+2536(SB)/8,$"\x07\x90\xf7\x5a\x96\xa1\xa6\xda"
-DATA ·d+2544(SB)/8,$"\x87\x6f\x99\xf0\xd1\xed\x0d\xc9"
-DATA ·d+2552(SB)/8,$"\x42\xdb\x79\x24\x7a\x52\xad\xe6"
-DATA ·d+2560(SB)/8,$"\x83\x0c\xb0\xdd\x5b\x6c\x9d\xa3"
-DATA ·d+2568(SB)/8,$"\x5c\x3b\xae\xcc\xe7\xfd\x92\x5b"
-DATA ·d+2576(SB)/8,$"\x24\x1f\x4c\x54\x4b\xe0\xcb\x3d"
-DATA ·d+2584(SB)/8,$"\x33\x1a\x75\xbd\x9b\xea\x37\x41"
-DATA ·d+2592(SB)/8,$"\xc2\xb4\xf7\xac\x16\xde\x11\xc7"
-DATA ·d+2600(SB)/8,$"\x1c\xe7\xb7\x7e\x00\xab\x96\xf0"
-DATA ·d+2608(SB)/8,$"\x69\x76\xf2\x2a\xa4\xd6\x16\xce"
-DATA ·d+2616(SB)/8,$"\x18\xab\xcd\x0c\xef\x34\x6a\x40"
-DATA ·d+2624(SB)/8,$"\xec\xbb\x70\x3f\x0a\x50\x8d\x48"
-DATA ·d+2632(SB)/8,$"\xcb\x95\xb4\x9f\x9b\x6d\xdf\xc9"
-DATA ·d+2640(SB)/8,$"\xbd\x07\x51\xd0\x94\x5b\x83\x6d"
-DATA ·d+2648(SB)/8,$"\x79\x24\xb4\xf4\x4e\xe4\xf2\xdc"
-DATA ·d+2656(SB)/8,$"\xcb\x71\x39\xba\x81\x2d\x4f\x98"
-DATA ·d+2664(SB)/8,$"\xaa\x0f\x9f\x1a\x9d\x87\xe9\x8e"
-DATA ·d+2672(SB)/8,$"\x48\xee\x45\x57\x58\x4e\xa9\x2f"
-DATA ·d+2680(SB)/8,$"\x91\x6e\x32\xe5\x8a\x07\x8b\x72"
-DATA ·d+2688(SB)/8,$"\xc4\xc3\xb9\xc2\xaf\x6e\xe3\x35"
-DATA ·d+2696(SB)/8,$"\xb9\x3c\xc5\xed\xd2\x4d\x25\x26"
-DATA ·d+2704(SB)/8,$"\x9c\x47\xf3\x4d\x3d\xd0\x70\x92"
-DATA ·d+2712(SB)/8,$"\x11\xd3\xcb\x56\xe4\xfe\x23\x15"
-DATA ·d+2720(SB)/8,$"\x87\x7f\x6f\x73\xaa\x5f\xc5\x1c"
-DATA ·d+2728(SB)/8,$"\xcc\x84\xb4\x7b\xfe\x49\x0a\xe3"
-DATA ·d+2736(SB)/8,$"\xf0\x18\xc7\xa5\x7a\x15\xed\x0d"
-DATA ·d+2744(SB)/8,$"\xe5\x7f\xeb\xb9\xc1\x87\x77\xbe"
-DATA ·d+2752(SB)/8,$"\x96\xfd\x3d\x4e\x53\x52\xf0\xd4"
-DATA ·d+2760(SB)/8,$"\x77\x37\x38\xd6\x66\xb9\x95\xc1"
-DATA ·d+2768(SB)/8,$"\xfd\xdc\x4b\x09\xf2\x86\x38\xca"
-DATA ·d+2776(SB)/8,$"\x81\xc1\xfa\x9a\x64\x3a\x7e\x93"
-DATA ·d+2784(SB)/8,$"\x05\xe2\xda\xd9\x5c\x5c\x48\xc0"
-DATA ·d+2792(SB)/8,$"\x6d\x05\xd6\x44\x55\x3f\x41\x90"
-DATA ·d+2800(SB)/8,$"\x7e\x80\x57\x57\x0c\x49\xa7\xea"
-DATA ·d+2808(SB)/8,$"\x5f\x66\x91\xa6\xb6\x61\xde\x29"
-DATA ·d+2816(SB)/8,$"\xd6\x65\x66\x1d\x01\x60\xa4\x0f"
-DATA ·d+2824(SB)/8,$"\x5a\xd5\xb8\x81\x2c\x18\xdc\xdc"
-DATA ·d+2832(SB)/8,$"\x5c\xbc\x97\x20\x92\x8c\x26\x85"
-DATA ·d+2840(SB)/8,$"\x63\xcb\x7a\x90\x01\xcf\xad\x79"
-DATA ·d+2848(SB)/8,$"\x94\xe8\xd2\xbb\x6b\x46\x9e\xbf"
-DATA ·d+2856(SB)/8,$"\xd5\xee\x7a\xb2\xcc\x6a\x5b\x92"
-DATA ·d+2864(SB)/8,$"\xed\xe9\xa7\xf4\x95\xbf\x13\x35"
-DATA ·d+2872(SB)/8,$"\x2d\x75\x9f\x35\x6d\xec\xaf\x18"
-DATA ·d+2880(SB)/8,$"\xa2\x7c\xde\x74\x92\x98\xd4\x12"
-DATA ·d+2888(SB)/8,$"\x39\xc2\xb2\xa1\xfe\x75\xc1\x6a"
-DATA ·d+2896(SB)/8,$"\xbe\x2b\xf8\x7f\x1c\x8f\x1e\xa7"
-DATA ·d+2904(SB)/8,$"\xfe\x9d\x73\x0f\xee\xcb\xaa\x9d"
-DATA ·d+2912(SB)/8,$"\x92\x31\xfe\x2c\xb1\x2a\x57\xd7"
-DATA ·d+2920(SB)/8,$"\xd7\xb5\x75\x5a\x8e\x8b\xe2\x5d"
-DATA ·d+2928(SB)/8,$"\x72\x33\xf6\xd9\xad\xe2\x89\xc6"
-DATA ·d+2936(SB)/8,$"\x58\xc4\x59\x8b\x0f\x79\x0e\x17"
-DATA ·d+2944(SB)/8,$"\xf2\xe2\xb3\xf4\x2f\x03\x5a\x4f"
-DATA ·d+2952(SB)/8,$"\x11\x9a\xc0\xd8\x98\xd4\x64\xde"
-DATA ·d+2960(SB)/8,$"\x15\xf3\x08\xda\x79\xda\x5c\x85"
-DATA ·d+2968(SB)/8,$"\xc8\x3d\x7e\x5a\xe7\xc7\x4a\xbd"
-DATA ·d+2976(SB)/8,$"\x69\x7b\x3c\x32\x4a\xff\x00\xcd"
-DATA ·d+2984(SB)/8,$"\xaf\xdc\x9b\xfc\x4e\x9c\x14\xf0"
-DATA ·d+2992(SB)/8,$"\xd4\xa2\xe7\x89\x9e\xdf\xcb\xa7"
-DATA ·d+3000(SB)/8,$"\xdf\x24\xbf\x04\x50\xd0\x2e\xfc"
-DATA ·d+3008(SB)/8,$"\x0f\x3c\x4f\x76\x91\x88\xce\x41"
-DATA ·d+3016(SB)/8,$"\x1f\xba\xe0\x13\xdb\x27\x38\x03"
-DATA ·d+3024(SB)/8,$"\xdb\x9a\xd5\x5d\x32\x1d\x56\x06"
-DATA ·d+3032(SB)/8,$"\x5b\x28\xee\x25\x00\xe0\xc9\x12"
-DATA ·d+3040(SB)/8,$"\x63\x77\xb0\x67\x20\x2d\x24\x96"
-DATA ·d+3048(SB)/8,$"\x1a\x5e\x9c\xe6\x51\x70\x6f\xee"
-DATA ·d+3056(SB)/8,$"\x33\xb5\x3e\x70\xaa\x98\xf6\x5c"
-DATA ·d+3064(SB)/8,$"\x2e\x7f\x3a\x5d\x46\xfb\x5f\x77"
-DATA ·d+3072(SB)/8,$"\x54\x65\x11\x06\x60\x44\x73\xee"
-DATA ·d+3080(SB)/8,$"\x7d\xdc\x76\x48\xc0\xe9\xf5\xaa"
-DATA ·d+3088(SB)/8,$"\xa5\x49\xd3\xa5\xc9\x5a\x2a\xeb"
-DATA ·d+3096(SB)/8,$"\xb2\xff\x00\x83\x62\xaa\xd4\x73"
-DATA ·d+3104(SB)/8,$"\xa9\xcf\x45\xbd\x7f\x99\xfe\x9b"
-DATA ·d+3112(SB)/8,$"\xfe\x46\x0c\x9a\x16\xad\x63\x39"
-DATA ·d+3120(SB)/8,$"\x8c\x24\xc8\xb2\x36\xc0\x45\xc2"
-DATA ·d+3128(SB)/8,$"\x33\x1f\x62\xe4\xf1\x8e\xf8\x02"
-DATA ·d+3136(SB)/8,$"\xb7\x2d\x74\x18\x66\xd9\x25\xe4"
-DATA ·d+3144(SB)/8,$"\xb2\xbb\xee\xe2\x27\x76\x9b\x69"
-DATA ·d+3152(SB)/8,$"\xf5\xf9\x9b\x69\xfe\x55\x42\x7d"
-DATA ·d+3160(SB)/8,$"\x2b\x5f\x4b\x9f\x3e\xf2\x74\x85"
-DATA ·d+3168(SB)/8,$"\x66\x39\x45\x78\x58\xa0\x51\xd3"
-DATA ·d+3176(SB)/8,$"\xe5\xe1\xb0\x3d\x49\xcf\xad\x5b"
-DATA ·d+3184(SB)/8,$"\x8e\xe7\x49\x72\x62\xd6\x35\xc9"
-DATA ·d+3192(SB)/8,$"\x5d\xd5\x30\xb1\xa6\xd4\x46\x1e"
-DATA ·d+3200(SB)/8,$"\x8b\xc9\xfc\x8d\x61\x47\xd9\xc6"
-DATA ·d+3208(SB)/8,$"\x4f\x9a\x36\xf2\x6d\x23\x6a\xf5"
-DATA ·d+3216(SB)/8,$"\x6a\xce\x37\x8d\x44\xff\x00\xc2"
-DATA ·d+3224(SB)/8,$"\x9f\xfc\x1f\xcc\xd8\x90\xe9\xb6"
-DATA ·d+3232(SB)/8,$"\xad\x0d\x94\x57\x2d\x13\x49\x97"
-DATA ·d+3240(SB)/8,$"\x79\x25\x60\x08\xf6\x1d\x80\xc7"
-DATA ·d+3248(SB)/8,$"\x6c\xe2\xac\x5d\x47\xa2\xc3\x12"
-DATA ·d+3256(SB)/8,$"\xea\x37\x17\x33\x45\x1b\x70\xab"
-DATA ·d+3264(SB)/8,$"\x1a\x15\x32\x1c\x60\xb0\x64\x04"
-DATA ·d+3272(SB)/8,$"\xfd\x4d\x72\xb3\x68\x96\x4f\x27"
-DATA ·d+3280(SB)/8,$"\x9f\xa6\xc1\x75\x3b\xe1\x0c\x52"
-DATA ·d+3288(SB)/8,$"\xb9\xde\xaa\xc4\xf2\x0e\x01\xc8"
-DATA ·d+3296(SB)/8,$"\xc7\xe3\x9a\xbe\xb6\xf8\x9a\x4b"
-DATA ·d+3304(SB)/8,$"\x34\x80\x33\x31\x05\x83\x45\x23"
-DATA ·d+3312(SB)/8,$"\xb2\xb7\x7c\xe4\xfd\xd2\x7e\x9e"
-DATA ·d+3320(SB)/8,$"\xd5\xd8\xf1\xcf\xde\x52\x8a\xf2"
-DATA ·d+3328(SB)/8,$"\xdf\xfe\x01\xc4\xf0\xd1\xbc\x5c"
-DATA ·d+3336(SB)/8,$"\x66\xfc\xf6\xfe\x90\xe9\x25\xd3"
-DATA ·d+3344(SB)/8,$"\xe5\xb7\x68\xb4\xe7\x97\xca\x94"
-DATA ·d+3352(SB)/8,$"\x81\x2b\x3b\x82\xdd\x7a\x33\x12"
-DATA ·d+3360(SB)/8,$"\x4f\xe7\x8a\xb1\x6d\xa6\xbc\x67"
-DATA ·d+3368(SB)/8,$"\xe4\x89\xee\x13\x80\xc7\x25\x80"
-DATA ·d+3376(SB)/8,$"\xeb\xdf\x71\xce\x7b\x0c\xd2\x5b"
-DATA ·d+3384(SB)/8,$"\x34\x36\x10\xcb\x06\xae\x61\x89"
-DATA ·d+3392(SB)/8,$"\x5b\x0f\xb9\x55\x8a\xe4\x0e\x91"
-DATA ·d+3400(SB)/8,$"\x88\xf1\xd7\xa6\x3d\x6b\x2f\x53"
-DATA ·d+3408(SB)/8,$"\xf1\x3e\x8d\x77\x14\x67\x50\xb9"
-DATA ·d+3416(SB)/8,$"\x9e\x3b\x87\x88\xbd\xbc\x6d\xfb"
-DATA ·d+3424(SB)/8,$"\x88\xb9\xe0\x29\x5e\x39\xfe\xf6"
-DATA ·d+3432(SB)/8,$"\x0e\x6b\x9e\x78\xea\x10\x8a\x9d"
-DATA ·d+3440(SB)/8,$"\x69\xa4\xd7\xc8\xd6\x38\x5a\x92"
-DATA ·d+3448(SB)/8,$"\xbc\x69\x45\xb5\xdf\x7f\xc4\xb1"
-DATA ·d+3456(SB)/8,$"\x6d\x0e\x9b\xa7\xb4\x92\x3a\x08"
-DATA ·d+3464(SB)/8,$"\xe5\xde\x7c\xb7\x6d\xb1\x04\x07"
-DATA ·d+3472(SB)/8,$"\xae\x7e\x52\x7e\x95\xa5\x00\xb0"
-DATA ·d+3480(SB)/8,$"\xb3\xb6\x7b\xf8\x3e\xdd\x78\x47"
-DATA ·d+3488(SB)/8,$"\x31\x8b\x65\x6d\xa5\xcf\x62\x49"
-DATA ·d+3496(SB)/8,$"\x18\xfa\x80\x01\xae\x5e\xe3\x5f"
-DATA ·d+3504(SB)/8,$"\x9e\x2b\x3f\x26\xe2\xd2\x1b\x86"
-DATA ·d+3512(SB)/8,$"\x4c\x63\xcb\x8a\x40\x0b\x63\xae"
-DATA ·d+3520(SB)/8,$"\xe9\x19\x3b\x76\x39\xaa\x77\x7e"
-DATA ·d+3528(SB)/8,$"\x24\xf1\x0d\xab\xa5\xba\x5b\xb4"
-DATA ·d+3536(SB)/8,$"\xb1\x08\xfc\xc8\xd2\xdd\xa0\x52"
-DATA ·d+3544(SB)/8,$"\x08\x1d\x1f\x04\xe0\x0f\xa6\x7f"
-DATA ·d+3552(SB)/8,$"\x1a\xe2\xa9\x9f\xe1\xa9\x26\xe1"
-DATA ·d+3560(SB)/8,$"\xd3\xb2\xbb\x57\x3a\xd6\x53\x5e"
-DATA ·d+3568(SB)/8,$"\xad\x93\xfc\xed\x7b\x7e\x3f\x91"
-DATA ·d+3576(SB)/8,$"\xd6\x24\xf7\xd7\x18\x3e\x4d\xdc"
-DATA ·d+3584(SB)/8,$"\x71\x49\x8c\x25\xc9\x42\x5b\xd7"
-DATA ·d+3592(SB)/8,$"\x6e\x5b\x38\xcf\x6c\xf4\xaa\x90"
-DATA ·d+3600(SB)/8,$"\x6b\x0d\x6f\x77\x3c\x73\xc9\x6e"
-DATA ·d+3608(SB)/8,$"\x90\x46\x00\x0c\xa8\x48\x72\x07"
-DATA ·d+3616(SB)/8,$"\x52\xbc\xe0\xf3\xda\xbc\xd3\x46"
-DATA ·d+3624(SB)/8,$"\xf1\xa5\xc6\xab\xa9\xcb\x26\xb7"
-DATA ·d+3632(SB)/8,$"\x6f\x2d\x9b\xc7\x20\x31\xac\x4a"
-DATA ·d+3640(SB)/8,$"\x1c\x1c\x70\x41\x79\x39\xf7\xe0"
-DATA ·d+3648(SB)/8,$"\x57\x67\x06\xb1\xa9\xea\xec\xb7"
-DATA ·d+3656(SB)/8,$"\x97\x52\x4b\x6f\x0c\x2a\xc2\x17"
-DATA ·d+3664(SB)/8,$"\xf2\xa2\x74\xdd\xfe\xca\xf3\x9e"
-DATA ·d+3672(SB)/8,$"\x3a\x96\x1c\x76\xae\x4c\x2f\x12"
-DATA ·d+3680(SB)/8,$"\xc6\xbc\x54\xb0\xed\xdf\x5e\x9f"
-DATA ·d+3688(SB)/8,$"\x7d\xf6\xb2\x3a\x6b\xe4\x93\xa4"
-DATA ·d+3696(SB)/8,$"\xda\xab\x14\x95\xbb\xfe\x5b\xdc"
-DATA ·d+3704(SB)/8,$"\xd5\x6d\x61\x6f\x71\x73\x6d\x24"
-DATA ·d+3712(SB)/8,$"\x91\x02\x1b\xcd\x24\x28\x52\x3b"
-DATA ·d+3720(SB)/8,$"\x60\x90\x46\x3b\xf3\x59\x43\x53"
-DATA ·d+3728(SB)/8,$"\xb7\x5d\xb7\xcb\x24\x97\xca\x5b"
-DATA ·d+3736(SB)/8,$"\xcb\x56\x70\xe2\x33\xd8\xa0\xda"
-DATA ·d+3744(SB)/8,$"\x42\xf1\xf8\x0a\xd4\x82\xfe\xf5"
-DATA ·d+3752(SB)/8,$"\xa2\xfb\x1c\x3e\x6c\xf0\xb7\xcd"
-DATA ·d+3760(SB)/8,$"\x71\xe5\x30\x69\x9c\x01\xc0\x58"
-DATA ·d+3768(SB)/8,$"\x88\xf9\x78\xf7\xac\x6d\x4f\x56"
-DATA ·d+3776(SB)/8,$"\xb4\xbc\x61\x61\x69\x6b\xac\x3c"
-DATA ·d+3784(SB)/8,$"\x44\x6c\xf2\xe5\x11\x01\x91\xd7"
-DATA ·d+3792(SB)/8,$"\x01\x77\xf3\xeb\x91\xf8\xd3\xc6"
-DATA ·d+3800(SB)/8,$"\xe3\xe4\x92\x73\x9e\xbe\x9d\x7c"
-DATA ·d+3808(SB)/8,$"\xba\x06\x0f\x0b\x1b\xf2\xa8\x69"
-DATA ·d+3816(SB)/8,$"\xea\xb6\xf3\xd1\x3b\x96\xae\xfc"
-DATA ·d+3824(SB)/8,$"\x45\xaa\x3d\xb2\xc1\x1d\x98\x89"
-DATA ·d+3832(SB)/8,$"\xb6\x95\x5c\xa1\x07\x00\xf0\x02"
-DATA ·d+3840(SB)/8,$"\xfc\xc3\xf1\xab\xba\x55\x86\xbc"
-DATA ·d+3848(SB)/8,$"\xd6\x8d\x73\x14\x56\xf2\xee\x39"
-DATA ·d+3856(SB)/8,$"\x6f\x3c\x05\x75\x6c\x72\x01\x50"
-DATA ·d+3864(SB)/8,$"\x00\x03\xf3\xf5\xae\x46\x6f\x11"
-DATA ·d+3872(SB)/8,$"\x99\x08\xb2\xb9\xb4\x9a\xef\xec"
-DATA ·d+3880(SB)/8,$"\xcc\x22\x8a\x0b\xf3\x88\xe2\xcf"
-DATA ·d+3888(SB)/8,$"\x60\x76\x28\x63\x91\xc6\x0f\x5a"
-DATA ·d+3896(SB)/8,$"\x59\xa5\xd5\x67\xbd\x31\x5c\x5d"
-DATA ·d+3904(SB)/8,$"\xa5\xa4\x48\x43\x98\xa1\xb5\x0f"
-DATA ·d+3912(SB)/8,$"\x91\xfe\xf3\x12\x70\x7b\x10\x39"
-DATA ·d+3920(SB)/8,$"\xae\x38\xe7\xb0\x73\xf6\x8e\x52"
-DATA ·d+3928(SB)/8,$"\x97\x45\x6b\x2b\x7d\xf6\x47\x5c"
-DATA ·d+3936(SB)/8,$"\xb2\x99\x28\x7b\x38\xc6\x30\xea"
-DATA ·d+3944(SB)/8,$"\xef\x77\x7f\x4b\x6a\x3a\xdc\x1b"
-DATA ·d+3952(SB)/8,$"\x92\x26\x8a\xe0\x49\x71\x23\xb2"
-DATA ·d+3960(SB)/8,$"\xbc\x76\xc9\xbc\x92\xbd\x89\x1d"
-DATA ·d+3968(SB)/8,$"\x31\xd3\x15\x7e\x4b\xdd\x62\x49"
-DATA ·d+3976(SB)/8,$"\x0d\x9c\x56\xcf\x6f\x19\xf9\x65"
-DATA ·d+3984(SB)/8,$"\x12\x2b\x06\x39\xee\x14\x29\xc7"
-DATA ·d+3992(SB)/8,$"\xe7\xf8\xd2\xc9\xe2\x35\xb9\xb8"
-DATA ·d+4000(SB)/8,$"\x60\xda\x98\x45\x88\xa9\x8d\x60"
-DATA ·d+4008(SB)/8,$"\xb4\xdd\x1a\xc8\xbd\x8a\xed\x51"
-DATA ·d+4016(SB)/8,$"\x9e\x38\x39\xe2\xb1\x35\x2b\x5d"
-DATA ·d+4024(SB)/8,$"\x6f\x51\x32\x5f\x43\xa9\x5c\x5b"
-DATA ·d+4032(SB)/8,$"\xc7\x20\x2d\x24\xb0\xa0\x42\xd2"
-DATA ·d+4040(SB)/8,$"\x63\xba\xab\x71\xd4\x0c\xf1\x59"
-DATA ·d+4048(SB)/8,$"\xbc\x64\xa3\x07\xec\x6e\xfb\xdb"
-DATA ·d+4056(SB)/8,$"\x97\xf1\xbb\x76\xfc\x0e\x88\x61"
-DATA ·d+4064(SB)/8,$"\x79\xa5\x6a\x96\x5d\xaf\x7f\xd2"
-DATA ·d+4072(SB)/8,$"\x28\xeb\x74\x7d\x3c\x58\xc8\xf2"
-DATA ·d+4080(SB)/8,$"\xdf\xe9\xfe\x6c\xbb\x41\x8c\xa7"
-DATA ·d+4088(SB)/8,$"\xcc\x73\xea\x55\xd9\x46\x3d\xbb"
-DATA ·d+4096(SB)/8,$"\x55\x6b\xbd\x46\x0b\xeb\xe5\xb3"
-DATA ·d+4104(SB)/8,$"\xbb\xb3\x11\x47\xbc\x1d\xec\x80"
-DATA ·d+4112(SB)/8,$"\x03\x8c\x72\xc1\x5b\x24\x7e\x95"
-DATA ·d+4120(SB)/8,$"\xc8\x5a\x41\xaa\xcb\x65\x05\xae"
-DATA ·d+4128(SB)/8,$"\xeb\x23\x2a\x61\xcc\xa2\xd5\x83"
-DATA ·d+4136(SB)/8,$"\x19\x15\xb9\x26\x45\x70\x49\x18"
-DATA ·d+4144(SB)/8,$"\xc9\xab\xcb\xa1\xf8\x97\x54\xbd"
-DATA ·d+4152(SB)/8,$"\x8f\xfb\x50\xac\xb1\xa9\x25\xe7"
-DATA ·d+4160(SB)/8,$"\x56\x91\x58\xfb\x04\x2d\x9e\x9e"
-DATA ·d+4168(SB)/8,$"\xf5\xa5\x3c\xca\xb4\xe1\x18\x52"
-DATA ·d+4176(SB)/8,$"\xa4\xde\xbd\x93\xdf\xa3\xff\x00"
-DATA ·d+4184(SB)/8,$"\x32\x6a\x60\x69\xc2\x72\x9c\xea"
-DATA ·d+4192(SB)/8,$"\xab\xfc\xff\x00\x0b\xfe\x47\x65"
-DATA ·d+4200(SB)/8,$"\x26\x95\x0d\xaa\x96\xb9\x93\x31"
-DATA ·d+4208(SB)/8,$"\x4e\xc5\xd2\x42\xa4\x74\xe3\x39"
-DATA ·d+4216(SB)/8,$"\x04\x9c\x0f\x42\x78\xac\x6b\xa8"
-DATA ·d+4224(SB)/8,$"\x2e\xad\xb6\xc5\x05\xc3\x95\x0f"
-DATA ·d+4232(SB)/8,$"\xb5\x98\x60\x06\x1d\x47\x21\x79"
-DATA ·d+4240(SB)/8,$"\x1e\xe2\xa9\x5f\xda\x58\x69\x36"
-DATA ·d+4248(SB)/8,$"\x89\x6c\xd1\x49\x24\xe5\xc0\x85"
-DATA ·d+4256(SB)/8,$"\x31\x22\x06\x0d\xd4\x96\xf9\x81"
-DATA ·d+4264(SB)/8,$"\x3f\x87\xe7\x55\x0d\xc0\xb7\xb9"
-DATA ·d+4272(SB)/8,$"\x0d\xf6\x33\x2f\x99\xcb\xab\x3e"
-DATA ·d+4280(SB)/8,$"\xff\x00\x2f\xb0\xc6\x40\xc0\x1d"
-DATA ·d+4288(SB)/8,$"\xb9\x15\xd1\x88\xc6\x45\x2e\x47"
-DATA ·d+4296(SB)/8,$"\x04\x9a\xde\xd7\x76\xfc\x0c\x69"
-DATA ·d+4304(SB)/8,$"\x61\x64\xfd\xf8\xce\xf7\xee\x92"
-DATA ·d+4312(SB)/8,$"\xbf\xe2\x6a\x49\xfe\x9f\x71\x23"
-DATA ·d+4320(SB)/8,$"\x5c\xa5\xbb\xa6\xd3\x98\x63\x26"
-DATA ·d+4328(SB)/8,$"\x38\xc1\xfe\xf9\x24\x8c\x9f\x60"
-DATA ·d+4336(SB)/8,$"\x71\x59\xdf\x62\xd2\xa6\x94\xc8"
-DATA ·d+4344(SB)/8,$"\x5d\x99\x55\x41\x11\xc4\x0a\xed"
-DATA ·d+4352(SB)/8,$"\xf6\xc0\x24\x55\xc9\x85\x8d\xe5"
-DATA ·d+4360(SB)/8,$"\xde\xed\x19\x6e\x65\xb8\x2c\x15"
-DATA ·d+4368(SB)/8,$"\x78\xce\xd6\x5c\x7d\xed\xc0\x20"
-DATA ·d+4376(SB)/8,$"\x51\xcf\x7f\x7a\xab\x7f\xe2\x9b"
-DATA ·d+4384(SB)/8,$"\x8d\x2e\x4f\x26\xee\x7b\x37\x9c"
-DATA ·d+4392(SB)/8,$"\x62\x33\x02\xa0\x79\x8e\x4e\x7e"
-DATA ·d+4400(SB)/8,$"\xe8\xc1\xfc\x71\x59\xfd\x62\x84"
-DATA ·d+4408(SB)/8,$"\x13\x9d\x67\xf3\xdf\xf1\xd3\xee"
-DATA ·d+4416(SB)/8,$"\x34\xa5\x4a\xb4\xdf\x2d\x25\xf2"
-DATA ·d+4424(SB)/8,$"\xdb\xfa\xf5\x1e\xc6\x2b\x98\xfc"
-DATA ·d+4432(SB)/8,$"\x9b\x8b\x79\x14\x01\x83\x2a\xe0"
-DATA ·d+4440(SB)/8,$"\x06\xf4\x3c\xe3\xb7\x7a\xd8\xb5"
-DATA ·d+4448(SB)/8,$"\xd2\xee\x12\x13\x74\x91\x46\xd1"
-DATA ·d+4456(SB)/8,$"\xc7\xf7\x81\x98\xee\xc7\xa1\x3d"
-DATA ·d+4464(SB)/8,$"\x2b\x25\xb5\xb8\xee\x07\xd9\x27"
-DATA ·d+4472(SB)/8,$"\xb3\xbf\x04\x06\x7d\xd1\x5b\xb3"
-DATA ·d+4480(SB)/8,$"\x2e\xfe\xbc\x30\xe8\x3f\xcf\x15"
-DATA ·d+4488(SB)/8,$"\x15\xa4\xd3\xbd\xb3\xdd\xca\xb3"
-DATA ·d+4496(SB)/8,$"\x95\x0c\x1c\x23\x16\x52\x0f\xae"
-DATA ·d+4504(SB)/8,$"\xdc\x31\x3e\xc7\x06\xba\xa9\xe3"
-DATA ·d+4512(SB)/8,$"\x68\xf3\x7b\x8f\x9b\xd3\xfe\x19"
-DATA ·d+4520(SB)/8,$"\x99\x54\xc3\xd5\xe5\xb3\xf7\x7f"
-DATA ·d+4528(SB)/8,$"\xaf\x53\xa2\xd4\xe5\xff\x00\x46"
-DATA ·d+4536(SB)/8,$"\x49\x21\x86\x28\x76\x9f\xde\xef"
-DATA ·d+4544(SB)/8,$"\x75\x77\xc7\x38\x1f\x20\x24\x8c"
-DATA ·d+4552(SB)/8,$"\xff\x00\x93\x5c\xf3\xde\x6a\xf2"
-DATA ·d+4560(SB)/8,$"\x4e\xd0\x5e\xdf\xac\x56\xe2\x30"
-DATA ·d+4568(SB)/8,$"\x42\xc7\x18\x05\x94\xf5\xda\xcd"
-DATA ·d+4576(SB)/8,$"\x92\xbf\x96\x6a\xb4\x37\xd6\xd2"
-DATA ·d+4584(SB)/8,$"\xdd\xc8\xf3\x4b\x75\x01\xff\x00"
-DATA ·d+4592(SB)/8,$"\x58\xea\x43\x3b\xb3\x7f\x09\xf9"
-DATA ·d+4600(SB)/8,$"\xb6\xa8\x00\x7b\x54\x1a\x9f\x88"
-DATA ·d+4608(SB)/8,$"\x5d\x23\x8e\x3b\x65\x95\xd5\x49"
-DATA ·d+4616(SB)/8,$"\x2d\x23\x98\x87\x98\x48\xe8\x30"
-DATA ·d+4624(SB)/8,$"\x0e\x07\xe6\x6b\xcf\xc5\xe6\x0a"
-DATA ·d+4632(SB)/8,$"\xa2\x75\x64\xdf\xa2\xeb\xeb\x6b"
-DATA ·d+4640(SB)/8,$"\x68\x75\x61\xf0\x32\x8d\xa9\xc6"
-DATA ·d+4648(SB)/8,$"\x2b\xd5\xf4\xf4\xbd\xcd\x3b\x73"
-DATA ·d+4656(SB)/8,$"\xa6\xd8\x14\xb5\xb3\xb8\x82\xf4"
-DATA ·d+4664(SB)/8,$"\x02\x37\x24\x52\x65\x97\x3c\x0e"
-DATA ·d+4672(SB)/8,$"\x49\xe3\x3d\x32\x6a\x0b\x9d\x4b"
-DATA ·d+4680(SB)/8,$"\x4c\xb4\xff\x00\x43\x91\x18\xc8"
-DATA ·d+4688(SB)/8,$"\xc8\x37\x7d\xd7\x78\xc9\x6e\x02"
-DATA ·d+4696(SB)/8,$"\xe1\x8f\x1e\xe4\xd5\x0d\x1f\xec"
-DATA ·d+4704(SB)/8,$"\xf7\xe3\xcf\xce\x99\x0c\xf8\x3c"
-DATA ·d+4712(SB)/8,$"\x4a\x37\xbb\x67\xb1\xdd\xb7\xa7"
-DATA ·d+4720(SB)/8,$"\xb5\x74\x56\x71\xe9\x36\x88\x57"
-DATA ·d+4728(SB)/8,$"\x51\x82\x49\x54\x1c\xac\x90\xc3"
-DATA ·d+4736(SB)/8,$"\x1f\xde\x3d\x49\x51\x8f\xc3\x92"
-DATA ·d+4744(SB)/8,$"\x6b\x2c\x34\xab\xce\x29\xc5\x28"
-DATA ·d+4752(SB)/8,$"\xae\xfd\x17\xe6\xee\x55\x7a\x74"
-DATA ·d+4760(SB)/8,$"\xe1\x27\xcc\xdc\x9f\xe3\x7f\xc8"
-DATA ·d+4768(SB)/8,$"\xc9\x9b\x56\x86\x26\xfb\x4d\x9d"
-DATA ·d+4776(SB)/8,$"\xb5\xd4\xac\x8c\xd8\x33\xbf\x96"
-DATA ·d+4784(SB)/8,$"\x8d\x8f\xba\x30\x09\x2b\x8a\x86"
-DATA ·d+4792(SB)/8,$"\xdf\x52\xd6\xbc\x46\xa6\x56\xde"
-DATA ·d+4800(SB)/8,$"\x88\x9b\x58\xab\xa1\x40\xa7\xa1"
-DATA ·d+4808(SB)/8,$"\x03\x24\xee\xf6\x39\xae\x86\x25"
-DATA ·d+4816(SB)/8,$"\x9e\x77\x22\xce\xd0\x85\x3f\x32"
-DATA ·d+4824(SB)/8,$"\xc9\x3c\x85\x59\xc0\x3f\xf3\xcd"
-DATA ·d+4832(SB)/8,$"\x4e\x47\xe4\x29\xf3\x19\x3e\xd7"
-DATA ·d+4840(SB)/8,$"\xe6\xba\x22\x6c\x01\xa5\x8d\x1b"
-DATA ·d+4848(SB)/8,$"\x0d\x8c\x8e\x80\x0c\xf1\xf5\xae"
-DATA ·d+4856(SB)/8,$"\xc7\x46\xab\x6a\x4e\xab\xe5\xea"
-DATA ·d+4864(SB)/8,$"\x92\xb5\xfc\xef\x6b\xe8\x63\xf5"
-DATA ·d+4872(SB)/8,$"\x88\x2f\x71\x53\x5c\xdd\xef\x7b"
-DATA ·d+4880(SB)/8,$"\x7f\x5f\x23\x98\x9f\x4e\xbe\x69"
-DATA ·d+4888(SB)/8,$"\xbe\xcd\x75\xf6\x97\x8d\x87\x30"
-DATA ·d+4896(SB)/8,$"\xe4\xa8\x75\x07\xa1\x0c\x40\xc1"
-DATA ·d+4904(SB)/8,$"\x3e\x95\x30\xf0\xed\xc9\x19\x1a"
-DATA ·d+4912(SB)/8,$"\x7a\x8c\xf3\x8c\x31\xc7\xe3\xbf"
-DATA ·d+4920(SB)/8,$"\x9a\xbb\x69\xad\xac\xd7\xd2\x35"
-DATA ·d+4928(SB)/8,$"\xfc\x17\x52\xaa\x82\x12\x60\x33"
-DATA ·d+4936(SB)/8,$"\x20\x56\xf5\x53\xf3\x72\x7b\x01"
-DATA ·d+4944(SB)/8,$"\x5a\x0d\xae\x69\x48\xc5\x36\x6b"
-DATA ·d+4952(SB)/8,$"\x67\x07\x19\xfb\x2b\x8e\x95\x9d"
-DATA ·d+4960(SB)/8,$"\x18\x60\x67\x79\x4a\x76\xf5\xff"
-DATA ·d+4968(SB)/8,$"\x00\x82\x8a\xad\x88\xc5\x46\xca"
-DATA ·d+4976(SB)/8,$"\x30\xfc\xff\x00\x46\x7f\xff\xd0"
-DATA ·d+4984(SB)/8,$"\xfc\x0e\xd3\xf4\x3d\x3e\xdc\x08"
-DATA ·d+4992(SB)/8,$"\xa6\xb6\xba\x49\x5a\x2f\x3d\x62"
-DATA ·d+5000(SB)/8,$"\x92\x57\x59\x76\xae\x33\xc6\x76"
-DATA ·d+5008(SB)/8,$"\x6d\x1e\xa0\x0e\x69\xf3\x4d\x79"
-DATA ·d+5016(SB)/8,$"\x71\x09\x3a\x6a\xf9\x28\x85\x9e"
-DATA ·d+5024(SB)/8,$"\xe2\x38\x1c\x5c\x4d\x1c\x79\xc0"
-DATA ·d+5032(SB)/8,$"\x67\xf3\x1c\x7f\x9e\x95\x8b\x26"
-DATA ·d+5040(SB)/8,$"\xb1\xff\x00\x09\x26\xa4\xb7\xe1"
-DATA ·d+5048(SB)/8,$"\x59\xed\xe2\xfd\xd6\x67\x73\xe5"
-DATA ·d+5056(SB)/8,$"\x30\x63\xc0\x28\xb9\x69\x0f\x03"
-DATA ·d+5064(SB)/8,$"\x03\x1c\x54\x5a\xae\x8a\xf7\xb7"
-DATA ·d+5072(SB)/8,$"\xde\x4d\xe4\x08\x59\xc6\xd5\xde"
-DATA ·d+5080(SB)/8,$"\x0b\x32\xae\x38\x26\x31\xc8\xc0"
-DATA ·d+5088(SB)/8,$"\xee\xfd\x07\x6a\xff\x00\x4a\xa5"
-DATA ·d+5096(SB)/8,$"\x88\x95\x9f\xb2\xb5\xb6\xed\xf9"
-DATA ·d+5104(SB)/8,$"\x23\xfc\xb1\x8d\x39\x73\xa7\x5d"
-DATA ·d+5112(SB)/8,$"\xeb\xbf\x7b\x79\x5a\xe4\xd0\x5d"
-DATA ·d+5120(SB)/8,$"\x5b\xc9\x6d\x0b\x59\x99\x82\x42"
-DATA ·d+5128(SB)/8,$"\xef\x2f\xdb\x66\x31\xc2\x85\x8f"
-DATA ·d+5136(SB)/8,$"\xa9\x7d\xc0\x91\xdb\x1c\xd5\xf1"
-DATA ·d+5144(SB)/8,$"\xac\x78\x82\xfa\x40\x63\xd4\x92"
-DATA ·d+5152(SB)/8,$"\x78\x46\x51\x95\x15\x24\x42\x7b"
-DATA ·d+5160(SB)/8,$"\x7c\xe3\x0a\x58\x7b\x67\x1d\xe9"
-DATA ·d+5168(SB)/8,$"\xf6\x57\x71\x69\x10\x0d\x1e\x05"
-DATA ·d+5176(SB)/8,$"\x49\x11\x50\xc7\xe7\xbc\x71\x47"
-DATA ·d+5184(SB)/8,$"\x18\x6c\x67\xe4\x24\x82\x0f\xaf"
-DATA ·d+5192(SB)/8,$"\x73\xe9\x51\x3b\x26\x9b\x13\x2f"
-DATA ·d+5200(SB)/8,$"\x91\x6f\x38\x39\x4f\x3e\x35\x2e"
-DATA ·d+5208(SB)/8,$"\x42\xfa\x92\xc5\x47\xe4\x3e\xbe"
-DATA ·d+5216(SB)/8,$"\xb4\xa7\x5e\xca\xfc\xde\xb6\xbe"
-DATA ·d+5224(SB)/8,$"\xff\x00\x93\x25\x46\x32\x6f\xdc"
-DATA ·d+5232(SB)/8,$"\xbf\x6b\xdb\x6f\x9a\xd1\x96\xb4"
-DATA ·d+5240(SB)/8,$"\xff\x00\xed\x8f\x22\xdd\xad\x77"
-DATA ·d+5248(SB)/8,$"\x32\x6c\x91\xc6\xfc\xa6\x49\x27"
-DATA ·d+5256(SB)/8,$"\xab\x37\x03\x07\xa1\x1f\x91\xaa"
-DATA ·d+5264(SB)/8,$"\xeb\xa9\x5b\xbc\x91\xc7\x6f\x70"
-DATA ·d+5272(SB)/8,$"\x3c\xd4\x1e\x63\x21\x28\xe8\x7d"
-DATA ·d+5280(SB)/8,$"\x08\x76\xe0\x9f\xa7\x3e\xa2\xb2"
-DATA ·d+5288(SB)/8,$"\xa7\xd5\xf5\x1b\xad\x20\x7d\xaa"
-DATA ·d+5296(SB)/8,$"\x50\xd0\xec\x22\x34\x31\xe1\x15"
-DATA ·d+5304(SB)/8,$"\xb7\x60\x10\x5a\x46\x0d\xf4\x18"
-DATA ·d+5312(SB)/8,$"\xac\x6b\x55\xb8\xb2\xb5\x8a\xe2"
-DATA ·d+5320(SB)/8,$"\x31\x24\x12\x39\x38\x65\xc6\xf2"
-DATA ·d+5328(SB)/8,$"\x33\xc6\xdc\x6e\x2a\x3d\xf3\x59"
-DATA ·d+5336(SB)/8,$"\xac\xc1\xc9\x46\x30\x4d\xe9\x7d"
-DATA ·d+5344(SB)/8,$"\x7f\x43\xa1\x65\xcb\xde\x94\xed"
-DATA ·d+5352(SB)/8,$"\xbd\x95\xbe\x7b\xdf\x73\xad\xb8"
-DATA ·d+5360(SB)/8,$"\x2d\xa8\xc0\xf6\x8b\x7f\x2c\xa2"
-DATA ·d+5368(SB)/8,$"\x55\xfd\xfe\xd8\xf7\xa9\x00\x8e"
-DATA ·d+5376(SB)/8,$"\x30\x36\xe0\x7f\x3f\xa5\x70\xf0"
-DATA ·d+5384(SB)/8,$"\x6b\x47\x40\x94\xdb\xe9\x96\x91"
-DATA ·d+5392(SB)/8,$"\xa3\xc7\x29\x45\xba\x74\x47\x70"
-DATA ·d+5400(SB)/8,$"\x7f\xdc\x25\x86\x31\xe9\xcd\x74"
-DATA ·d+5408(SB)/8,$"\x17\x50\xdb\x58\xcd\x04\x77\xd1"
-DATA ·d+5416(SB)/8,$"\xca\x90\x90\x59\x58\x48\x85\xe4"
-DATA ·d+5424(SB)/8,$"\x7c\x64\x64\x31\x04\x02\x7b\x91"
-DATA ·d+5432(SB)/8,$"\x5b\x36\xb6\xf6\xc4\xbc\xae\x91"
-DATA ·d+5440(SB)/8,$"\xc4\xce\xe0\x23\x0d\xd3\x10\xbd"
-DATA ·d+5448(SB)/8,$"\x80\xc0\x23\xeb\xc8\x35\x75\x20"
-DATA ·d+5456(SB)/8,$"\xea\x49\x5a\x7c\xad\x69\xa5\xff"
-DATA ·d+5464(SB)/8,$"\x00\x51\xd3\xad\x1a\x50\x69\xc3"
-DATA ·d+5472(SB)/8,$"\x99\x3f\x4f\xd3\x7f\xc6\xc6\x4d"
-DATA ·d+5480(SB)/8,$"\xb5\xfe\xab\x7d\xb7\xcd\x96\xee"
-DATA ·d+5488(SB)/8,$"\x78\xc6\xe2\xf1\x5b\x2c\x51\xa0"
-DATA ·d+5496(SB)/8,$"\x66\xe8\x76\x31\xe7\x1e\x98\xaa"
-DATA ·d+5504(SB)/8,$"\x77\xa6\xf2\x68\x9e\xda\x15\xb9"
-DATA ·d+5512(SB)/8,$"\x66\x73\xfb\xc5\x58\xc4\x60\x1c"
-DATA ·d+5520(SB)/8,$"\x63\xe6\xc6\x32\x40\xe9\x80\x3d"
-DATA ·d+5528(SB)/8,$"\xab\x7e\x6d\x2d\xed\xe6\x79\xae"
-DATA ·d+5536(SB)/8,$"\xee\xc2\xe1\x43\x22\xa4\x2b\x90"
-DATA ·d+5544(SB)/8,$"\xa3\xb0\x7f\xbd\x83\xf4\xac\x9d"
-DATA ·d+5552(SB)/8,$"\x51\x2c\xed\xee\xd2\xda\xce\xf6"
-DATA ·d+5560(SB)/8,$"\xd5\x59\x98\x46\x21\x2a\x5b\x68"
-DATA ·d+5568(SB)/8,$"\x03\x25\x98\xb1\x51\x8c\xfe\x39"
-DATA ·d+5576(SB)/8,$"\xad\x67\x0e\x58\x35\x2f\xc5\xa6"
-DATA ·d+5584(SB)/8,$"\xfe\x5b\x85\x1a\xd1\x94\x97\x25"
-DATA ·d+5592(SB)/8,$"\xbb\xec\xff\x00\x1d\x03\x4b\xf0"
-DATA ·d+5600(SB)/8,$"\xf3\xda\x6d\x96\xfe\xfa\x09\x1a"
-DATA ·d+5608(SB)/8,$"\x4d\xae\x2d\xca\x97\x61\x8e\x80"
-DATA ·d+5616(SB)/8,$"\x86\xca\x82\x3b\xe7\xf1\xa4\xb8"
-DATA ·d+5624(SB)/8,$"\xf0\x9e\xb5\xaa\x23\xde\xc3\x35"
-DATA ·d+5632(SB)/8,$"\xac\x36\xc2\x5d\xa5\x2d\xbc\xbd"
-DATA ·d+5640(SB)/8,$"\xf8\xcf\x19\x55\x4c\x64\x7a\x83"
-DATA ·d+5648(SB)/8,$"\x52\x5e\x5a\xc9\x01\x8e\xed\x2f"
-DATA ·d+5656(SB)/8,$"\xa1\x1b\x00\x1b\x6d\x95\x59\xce"
-DATA ·d+5664(SB)/8,$"\x7a\xe0\x0c\x2f\xeb\x56\x6c\x1e"
-DATA ·d+5672(SB)/8,$"\xe3\x54\x47\x86\x38\x6e\x64\x4c"
-DATA ·d+5680(SB)/8,$"\xe5\xda\x46\x03\x20\x74\xdc\xfb"
-DATA ·d+5688(SB)/8,$"\x95\x14\x7d\x39\xfa\xd6\xcb\x05"
-DATA ·d+5696(SB)/8,$"\x4e\x49\x52\x9d\x3b\x7a\x3f\xf8"
-DATA ·d+5704(SB)/8,$"\x71\x4b\x13\x55\x7e\xfa\x35\x17"
-DATA ·d+5712(SB)/8,$"\xcd\x7e\x9a\x15\x22\xf0\x6d\xa0"
-DATA ·d+5720(SB)/8,$"\xdb\x0e\xa1\x3e\xa1\x79\x17\xaa"
-DATA ·d+5728(SB)/8,$"\xcc\xab\x93\x8e\x85\x40\x07\x8a"
-DATA ·d+5736(SB)/8,$"\xb1\x71\x6f\xe1\xcb\x01\x14\x51"
-DATA ·d+5744(SB)/8,$"\xa4\x4c\x88\xb9\xf2\xe5\x92\x53"
-DATA ·d+5752(SB)/8,$"\x70\x41\x3c\x29\x2a\x4e\x7e\x9d"
-DATA ·d+5760(SB)/8,$"\x2b\x6e\xd6\xde\x0b\x34\x92\x4b"
-DATA ·d+5768(SB)/8,$"\x98\xad\xa3\x48\xfe\x58\xa3\x00"
-DATA ·d+5776(SB)/8,$"\xe0\xf1\xde\x47\x6c\x30\x3f\x43"
-DATA ·d+5784(SB)/8,$"\x5c\xfc\xd7\xec\xf3\x7d\x96\x5b"
-DATA ·d+5792(SB)/8,$"\x57\x08\xe1\x48\x4b\x78\x42\xf1"
-DATA ·d+5800(SB)/8,$"\x9e\xbf\x28\x04\xe7\x3d\x49\xfa"
-DATA ·d+5808(SB)/8,$"\x62\xb5\xab\x82\xa7\x4a\x29\xc2"
-DATA ·d+5816(SB)/8,$"\x09\x3f\x4b\xbf\xeb\xe4\xfd\x0c"
-DATA ·d+5824(SB)/8,$"\xa9\x62\xea\xd5\x6f\x9e\x6d\xa5"
-DATA ·d+5832(SB)/8,$"\xdb\x45\xfd\x7a\x13\xcd\xaf\xc5"
-DATA ·d+5840(SB)/8,$"\x3d\xec\x53\x5b\xe9\xd2\xb2\xc3"
-DATA ·d+5848(SB)/8,$"\x09\x22\xd5\x30\x36\x01\xfd\xe2"
-DATA ·d+5856(SB)/8,$"\x71\x8c\xfa\x9c\xd5\x3b\xcf\x13"
-DATA ·d+5864(SB)/8,$"\x6b\x5a\xd5\x83\x6c\xb6\x8a\xc0"
-DATA ·d+5872(SB)/8,$"\x6f\xca\x3c\xb7\x47\xcc\x52\x3d"
-DATA ·d+5880(SB)/8,$"\x99\x46\x78\xfc\xea\x5b\xcd\x1f"
-DATA ·d+5888(SB)/8,$"\x58\x95\xcc\x7a\x7c\x17\xa1\x9f"
-DATA ·d+5896(SB)/8,$"\x04\xf9\xe5\x62\xc9\x1d\x00\x18"
-DATA ·d+5904(SB)/8,$"\x26\xb1\x87\x84\xb5\x6b\xb9\x4c"
-DATA ·d+5912(SB)/8,$"\xda\xa4\x68\x8a\x06\x5e\x61\xfb"
-DATA ·d+5920(SB)/8,$"\xd2\xa3\xa1\xc1\xea\x2b\x1a\xb1"
-DATA ·d+5928(SB)/8,$"\xc5\xb4\xe0\xdb\xb3\xde\xc9\x25"
-DATA ·d+5936(SB)/8,$"\xf3\x69\x5c\xe8\xa3\x4f\x09\x1b"
-DATA ·d+5944(SB)/8,$"\x54\x76\x4d\x6d\xab\x6f\xee\xbe"
-DATA ·d+5952(SB)/8,$"\xa5\x36\xbb\xd1\x2c\x63\x13\x6a"
-DATA ·d+5960(SB)/8,$"\xf3\x8b\x9b\x91\x9c\xac\xf2\xca"
-DATA ·d+5968(SB)/8,$"\xc8\x73\xfc\x58\x8c\x2a\x63\xdb"
-DATA ·d+5976(SB)/8,$"\x26\x88\x6c\xad\xf5\xa9\xe3\xba"
-DATA ·d+5984(SB)/8,$"\x48\xed\x26\x5b\x71\xb6\x21\x6e"
-DATA ·d+5992(SB)/8,$"\x1a\x25\x8d\x1b\xb6\x4a\xee\x27"
-DATA ·d+6000(SB)/8,$"\xf5\xad\xb7\xb4\xf0\xbe\x8b\x01"
-DATA ·d+6008(SB)/8,$"\xdf\x0a\xcf\x19\x39\x69\x08\x77"
-DATA ·d+6016(SB)/8,$"\x39\xff\x00\x67\x3f\x2f\xe1\x8a"
-DATA ·d+6024(SB)/8,$"\xab\x3f\x88\x34\xeb\x47\x8e\xe2"
-DATA ·d+6032(SB)/8,$"\xda\x5b\x48\xe1\x38\x30\xc4\xb6"
-DATA ·d+6040(SB)/8,$"\xe0\x4a\xa7\xdd\x9f\x92\x47\x7e"
-DATA ·d+6048(SB)/8,$"\xde\x95\xc1\x2c\x1d\x18\x34\xaa"
-DATA ·d+6056(SB)/8,$"\xb4\xed\xd3\x7f\xbd\xbf\xf8\x27"
-DATA ·d+6064(SB)/8,$"\x74\x31\x12\x92\x7e\xc6\x2f\xc9"
-DATA ·d+6072(SB)/8,$"\xbd\x17\xa2\x4b\x6f\xc0\xb1\x7d"
-DATA ·d+6080(SB)/8,$"\x2e\xa3\x0c\xa1\x22\x92\x4b\x58"
-DATA ·d+6088(SB)/8,$"\xb6\x85\x05\x50\x95\x51\xee\x49"
-DATA ·d+6096(SB)/8,$"\x3c\xfe\x1c\xfb\x56\x44\xd1\x08"
-DATA ·d+6104(SB)/8,$"\xd1\xed\xac\xae\xa6\x72\x17\xcd"
-DATA ·d+6112(SB)/8,$"\x7b\x87\x91\x53\x00\xf4\x21\x77"
-DATA ·d+6120(SB)/8,$"\x11\xf8\xe3\x3f\x4a\x86\x4d\x43"
-DATA ·d+6128(SB)/8,$"\x5d\xd4\x61\x9e\x6b\x8b\x8b\x49"
-DATA ·d+6136(SB)/8,$"\xad\x58\xee\x30\x3b\x32\x12\x73"
-DATA ·d+6144(SB)/8,$"\xd7\x62\x63\x71\xf4\xfe\x55\x66"
-DATA ·d+6152(SB)/8,$"\x3f\x0f\x8f\xb0\x9b\xc9\xe0\xb2"
-DATA ·d+6160(SB)/8,$"\x29\xb7\x3b\xe6\x49\x4e\x07\xa8"
-DATA ·d+6168(SB)/8,$"\xc9\x27\x81\xdb\x6d\x55\x57\x2a"
-DATA ·d+6176(SB)/8,$"\xb2\x7c\xb1\xd1\x2e\xbf\xd5\xac"
-DATA ·d+6184(SB)/8,$"\x69\x4a\x2a\x92\x5c\xf2\x57\xf2"
-DATA ·d+6192(SB)/8,$"\xfe\xae\xcc\x9b\x6b\x5d\x29\x6c"
-DATA ·d+6200(SB)/8,$"\x91\x23\x9e\x39\xe4\x46\x7d\x9f"
-DATA ·d+6208(SB)/8,$"\xbc\xf9\x86\x7b\x97\x63\x8c\x67"
-DATA ·d+6216(SB)/8,$"\xd0\x55\x6d\x4b\x4c\xb7\xbc\x68"
-DATA ·d+6224(SB)/8,$"\xa7\xbe\x0f\x24\xea\x17\x68\x89"
-DATA ·d+6232(SB)/8,$"\x0e\x40\xeb\x9f\xcf\xd2\xba\x8f"
-DATA ·d+6240(SB)/8,$"\x0e\x5b\xe9\xab\xbd\x6c\xfc\x94"
-DATA ·d+6248(SB)/8,$"\x66\x38\x46\x31\xe3\xcc\x3f\xec"
-DATA ·d+6256(SB)/8,$"\x99\x01\x20\x7a\x60\x55\xcb\x83"
-DATA ·d+6264(SB)/8,$"\x73\x16\xa2\x23\xb4\xf2\xca\xe4"
-DATA ·d+6272(SB)/8,$"\xe1\x49\x01\x43\x11\x8c\xe0\x60"
-DATA ·d+6280(SB)/8,$"\x9f\xd6\xb9\xd6\x5d\x46\x74\x95"
-DATA ·d+6288(SB)/8,$"\xe2\x9d\xfb\x6a\x6b\x3c\xc6\x71"
-DATA ·d+6296(SB)/8,$"\xac\xd4\x5b\xbf\x9e\x87\x23\x28"
-DATA ·d+6304(SB)/8,$"\x8c\xaa\xa6\xa3\x69\x85\x6c\x18"
-DATA ·d+6312(SB)/8,$"\xda\xf5\x88\x91\xf9\xe4\xf5\xc0"
-DATA ·d+6320(SB)/8,$"\xf6\xc8\x35\xa3\x61\x6f\x61\x14"
-DATA ·d+6328(SB)/8,$"\xcf\x2c\xe8\xac\xe8\xa7\x68\x80"
-DATA ·d+6336(SB)/8,$"\xef\x04\x7a\x31\xc7\x00\x74\xe0"
-DATA ·d+6344(SB)/8,$"\x56\x1c\xfa\x6e\x91\x05\xd3\x49"
-DATA ·d+6352(SB)/8,$"\xaa\x35\xd4\xd7\x5b\xfe\x58\x60"
-DATA ·d+6360(SB)/8,$"\x53\x92\x49\xe8\x73\x9e\xb5\xa5"
-DATA ·d+6368(SB)/8,$"\x26\xa7\xa7\xad\xb6\xcb\xf5\xbb"
-DATA ·d+6376(SB)/8,$"\x8d\xc1\xff\x00\x51\xb9\x03\x7c"
-DATA ·d+6384(SB)/8,$"\xbd\x07\x00\x9c\xfe\x55\x18\x56"
-DATA ·d+6392(SB)/8,$"\xe3\x26\xa7\x64\xd7\xf5\xa9\xd5"
-DATA ·d+6400(SB)/8,$"\x5e\x2e\x49\x72\x5d\xa7\xeb\xf8"
-DATA ·d+6408(SB)/8,$"\x16\x2c\x63\xfb\x1c\x8d\x7e\xd0"
-DATA ·d+6416(SB)/8,$"\xc6\x73\x9d\x96\xc5\x0b\x0f\xc0"
-DATA ·d+6424(SB)/8,$"\xab\x7f\x31\x54\x9f\x53\xd6\x4d"
-DATA ·d+6432(SB)/8,$"\xe0\x9a\x11\x07\xef\x63\x68\xfc"
-DATA ·d+6440(SB)/8,$"\xa8\x60\xde\xdf\xed\x12\xd8\xda"
-DATA ·d+6448(SB)/8,$"\xb9\xfa\xd4\x73\xf8\xae\xce\x18"
-DATA ·d+6456(SB)/8,$"\xc3\x47\x14\xb1\xc5\x92\x1f\xcc"
-DATA ·d+6464(SB)/8,$"\xcc\x8c\xf9\xec\x47\x38\x03\xf5"
-DATA ·d+6472(SB)/8,$"\xa8\x5f\x5b\xd4\xf5\x19\x4b\x68"
-DATA ·d+6480(SB)/8,$"\x10\x5b\xc6\x9c\xfc\xec\x36\x85"
-DATA ·d+6488(SB)/8,$"\xf7\x50\xc7\x6a\xff\x00\x8d\x4d"
-DATA ·d+6496(SB)/8,$"\x6a\xf1\x71\xe5\xa7\x3d\x53\xd9"
-DATA ·d+6504(SB)/8,$"\x6e\x3a\x58\x7a\xb7\xe6\xa9\x0d"
-DATA ·d+6512(SB)/8,$"\x3b\xbd\x91\x6a\xe9\x2e\x74\xd6"
-DATA ·d+6520(SB)/8,$"\x5b\x84\xff\x00\x46\x75\x05\x77"
-DATA ·d+6528(SB)/8,$"\xa0\xf9\x81\x7e\xa4\x84\x3b\x8f"
-DATA ·d+6536(SB)/8,$"\x1f\xec\x8f\xc6\xb1\xad\xef\x26"
-DATA ·d+6544(SB)/8,$"\xba\x91\x95\x0d\xc8\x5c\x7e\xf2"
-DATA ·d+6552(SB)/8,$"\xe2\x2c\xae\xe3\x8c\x02\x46\x3a"
-DATA ·d+6560(SB)/8,$"\x7e\x20\x9a\xb9\x63\x6b\xa9\xd8"
-DATA ·d+6568(SB)/8,$"\xbb\x5c\x5f\xdd\xc2\x43\x12\x58"
-DATA ·d+6576(SB)/8,$"\x6f\x47\x2c\x71\xc8\x00\xfc\xb9"
-DATA ·d+6584(SB)/8,$"\xac\xbb\xbb\xdd\x66\x1d\xc2\xde"
-DATA ·d+6592(SB)/8,$"\x78\xa0\x8b\x79\x70\x8f\xc1\x1f"
-DATA ·d+6600(SB)/8,$"\x5c\x1e\x7e\x95\xc3\x5a\x31\x5e"
-DATA ·d+6608(SB)/8,$"\xf3\x4f\xd3\xfa\x67\x5d\x18\xde"
-DATA ·d+6616(SB)/8,$"\xea\x36\x7e\x7f\xd6\xe6\xac\x3e"
-DATA ·d+6624(SB)/8,$"\x04\xbd\xd4\xe2\xf3\x5f\x50\x68"
-DATA ·d+6632(SB)/8,$"\xe1\x5c\x85\x69\x15\x90\x0e\x73"
-DATA ·d+6640(SB)/8,$"\x85\x07\xa5\x45\x07\x83\xf4\xb4"
-DATA ·d+6648(SB)/8,$"\xba\x61\xab\x6a\x32\x34\x68\xc5"
-DATA ·d+6656(SB)/8,$"\x33\x2b\x15\xdd\x8e\xe3\x83\xc7"
-DATA ·d+6664(SB)/8,$"\xe5\x5c\x0c\xda\xcd\xe5\xcd\xc7"
-DATA ·d+6672(SB)/8,$"\xef\xa7\x96\x69\x37\xe4\xe5\xb6"
-DATA ·d+6680(SB)/8,$"\xa6\x7d\x42\xf4\xad\xb4\xb6\xba"
-DATA ·d+6688(SB)/8,$"\xb9\x8f\x7e\xa7\x78\x18\x13\xfe"
-DATA ·d+6696(SB)/8,$"\xa5\xb7\xc8\xdf\x50\x06\x45\x65"
-DATA ·d+6704(SB)/8,$"\x4e\xbe\x16\x4e\xf4\xa8\x5e\xdd"
-DATA ·d+6712(SB)/8,$"\xdf\xe7\xfd\x33\xb2\xa6\x1b\x15"
-DATA ·d+6720(SB)/8,$"\x04\xfd\xa5\x7b\x27\xb5\x92\xbf"
-DATA ·d+6728(SB)/8,$"\xcb\xfa\x46\x8c\x77\x1a\x0b\x5e"
-DATA ·d+6736(SB)/8,$"\x1b\x6b\x49\xdc\x20\xc0\x5c\xa8"
-DATA ·d+6744(SB)/8,$"\x8f\x27\x3d\x7e\x5c\x9c\x7d\x70"
-DATA ·d+6752(SB)/8,$"\x6b\xb9\xfb\x36\x90\x92\x47\xa6"
-DATA ·d+6760(SB)/8,$"\xcb\x18\x95\x58\x6f\x56\x98\x13"
-DATA ·d+6768(SB)/8,$"\xb9\x7d\x71\x82\x71\xf8\x8a\xe3"
-DATA ·d+6776(SB)/8,$"\x1e\xc7\x4d\xb4\x83\x7e\x99\x68"
-DATA ·d+6784(SB)/8,$"\xae\x48\xc7\x9f\x73\x1b\x27\xcd"
-DATA ·d+6792(SB)/8,$"\xdf\x62\x9e\x4f\xf4\xac\x2b\xbd"
-DATA ·d+6800(SB)/8,$"\x42\xe0\x29\x86\x1b\x49\x63\xc6"
-DATA ·d+6808(SB)/8,$"\x11\xe4\x24\xa9\x6f\xa0\x50\x08"
-DATA ·d+6816(SB)/8,$"\x1f\x8d\x76\x53\xc5\xfd\x5d\x3f"
-DATA ·d+6824(SB)/8,$"\x69\x14\xef\xd9\x3b\x7a\x1c\xb2"
-DATA ·d+6832(SB)/8,$"\xc3\x7b\x76\xb9\x24\xd5\xbb\xb5"
-DATA ·d+6840(SB)/8,$"\xf7\x9d\x7c\x8b\x67\x06\xac\x6c"
-DATA ·d+6848(SB)/8,$"\xff\x00\x71\x08\xce\x3c\xf8\x23"
-DATA ·d+6856(SB)/8,$"\x31\xaa\x8e\xb9\xde\xea\x7a\x0f"
-DATA ·d+6864(SB)/8,$"\x43\x59\x97\x7a\x66\x92\x35\x64"
-DATA ·d+6872(SB)/8,$"\x9f\x48\xbe\xbc\xb8\x70\xf9\x58"
-DATA ·d+6880(SB)/8,$"\x9e\x46\x11\x03\xdf\x93\xf7\xb3"
-DATA ·d+6888(SB)/8,$"\xef\xeb\x5c\x9d\xae\xa4\x21\x72"
-DATA ·d+6896(SB)/8,$"\xb2\xc4\xb2\xbe\xee\x44\xc5\xb2"
-DATA ·d+6904(SB)/8,$"\x06\x3a\x0e\x49\x35\xd4\x5a\x03"
-DATA ·d+6912(SB)/8,$"\x70\xbf\x65\xb5\xb5\x46\x62\xbf"
-DATA ·d+6920(SB)/8,$"\x26\x64\x1f\x2f\xbf\xff\x00\xae"
-DATA ·d+6928(SB)/8,$"\xb8\xe9\x4e\x95\x68\xfb\xd0\xbb"
-DATA ·d+6936(SB)/8,$"\xbd\xd6\xf7\x5e\x47\x55\x5a\x13"
-DATA ·d+6944(SB)/8,$"\xa3\xaa\x9f\x4d\x76\xb3\xf3\x66"
-DATA ·d+6952(SB)/8,$"\xf1\xb5\xd4\x2d\xcf\x9b\xf6\x64"
-DATA ·d+6960(SB)/8,$"\xb3\x2e\x4b\xac\xcc\xe2\x51\xf4"
-DATA ·d+6968(SB)/8,$"\x1c\xf7\xef\x56\x5c\x45\x15\x9a"
-DATA ·d+6976(SB)/8,$"\x89\x19\xae\x15\x86\xf6\xc2\x0c"
-DATA ·d+6984(SB)/8,$"\x64\xfa\x85\x23\xf5\xfc\xab\x9f"
-DATA ·d+6992(SB)/8,$"\xfe\xd0\xb9\x4b\x90\xb7\xe6\xd9"
-DATA ·d+7000(SB)/8,$"\x95\x38\xda\x26\x23\x8e\x9c\x28"
-DATA ·d+7008(SB)/8,$"\x50\x08\x15\x13\x6a\x7a\x6a\xa0"
-DATA ·d+7016(SB)/8,$"\x31\xb6\xd5\x49\x03\x18\xa4\x90"
-DATA ·d+7024(SB)/8,$"\x05\x61\x9e\x70\x41\xdd\x9f\x63"
-DATA ·d+7032(SB)/8,$"\x5e\xaa\xc7\x52\x8d\xff\x00\x5d"
-DATA ·d+7040(SB)/8,$"\xff\x00\xc8\xe1\x96\x16\xa4\xad"
-DATA ·d+7048(SB)/8,$"\x65\xf7\x7f\x4d\x9d\x69\xb8\x90"
-DATA ·d+7056(SB)/8,$"\x29\x92\x15\x9d\x20\x70\x18\x9c"
-DATA ·d+7064(SB)/8,$"\x18\xb9\x03\x00\xf0\x46\x47\xa5"
-DATA ·d+7072(SB)/8,$"\x50\xb9\xbb\xb5\x8b\x37\xb6\x61"
-DATA ·d+7080(SB)/8,$"\xe5\xba\xdc\x73\xb7\x72\x8c\x8e"
-DATA ·d+7088(SB)/8,$"\xa4\xb1\xcf\xe1\x59\x3a\x8d\xf6"
-DATA ·d+7096(SB)/8,$"\x9b\x72\x11\xd1\x65\x75\xec\x90"
-DATA ·d+7104(SB)/8,$"\xce\xc3\xe9\xc6\xd3\xfc\xe9\xaa"
-DATA ·d+7112(SB)/8,$"\x2d\xe5\xc4\xd7\x53\x5c\xc4\x7e"
-DATA ·d+7120(SB)/8,$"\xef\x96\xbb\x8f\x03\xa0\xc0\x19"
-DATA ·d+7128(SB)/8,$"\xfe\x75\x55\x31\x6a\x6f\x96\x09"
-DATA ·d+7136(SB)/8,$"\x3f\x3e\xde\x97\x32\xa5\x85\xe5"
-DATA ·d+7144(SB)/8,$"\xb4\xa5\x7f\xeb\xd3\x73\x7f\x4a"
-DATA ·d+7152(SB)/8,$"\xd5\x74\xfb\x45\x57\x7b\xcf\x2a"
-DATA ·d+7160(SB)/8,$"\x56\x20\xed\x49\x1d\xd8\x67\xd8"
-DATA ·d+7168(SB)/8,$"\x9c\x7d\x72\x2a\xc4\xf7\x91\x4e"
-DATA ·d+7176(SB)/8,$"\xe6\xda\x0b\xc8\x5e\x4c\xe5\x41"
-DATA ·d+7184(SB)/8,$"\xc1\x07\xb8\x0b\xb7\x1d\x3e\xa0"
-DATA ·d+7192(SB)/8,$"\x57\x3d\xa8\xc5\xa6\xb2\xaa\xc7"
-DATA ·d+7200(SB)/8,$"\x01\x62\xd8\x70\x63\x6c\x0c\x77"
-DATA ·d+7208(SB)/8,$"\xce\x54\x1c\xe3\xb1\x15\x46\xda"
-DATA ·d+7216(SB)/8,$"\xdf\xc2\xd6\xb3\x97\x16\xf2\x36"
-DATA ·d+7224(SB)/8,$"\x0e\x5c\x34\xa3\xe5\x07\xa6\x14"
-DATA ·d+7232(SB)/8,$"\x00\x6a\x7e\xb8\xe3\xee\x5e\x29"
-DATA ·d+7240(SB)/8,$"\x7a\xb4\x3f\xa9\xa9\x37\x51\x29"
-DATA ·d+7248(SB)/8,$"\x37\xda\xcb\xfa\x45\x8b\xc8\x74"
-DATA ·d+7256(SB)/8,$"\xfb\x82\x5a\x4b\x75\x9a\x56\x3b"
-DATA ·d+7264(SB)/8,$"\x93\x6a\x77\x3f\x42\x07\xe3\x54"
-DATA ·d+7272(SB)/8,$"\x65\xf0\xea\x15\x13\xdc\x69\xcc"
-DATA ·d+7280(SB)/8,$"\x37\x7c\xc3\x12\x30\x2c\x47\x5c"
-DATA ·d+7288(SB)/8,$"\x0e\x99\xae\x9a\xe4\x97\xd3\xcc"
-DATA ·d+7296(SB)/8,$"\x5a\x3a\xcc\xa8\xe0\x6d\x8c\x05"
-DATA ·d+7304(SB)/8,$"\x04\x86\xe4\x7a\x9e\xde\xb5\xcd"
-DATA ·d+7312(SB)/8,$"\x79\x5e\x24\x9e\x65\xb5\x49\x5e"
-DATA ·d+7320(SB)/8,$"\x26\x5e\x76\x15\x55\x2a\x71\xcf"
-DATA ·d+7328(SB)/8,$"\x3d\x6a\x71\xb4\xa1\x74\xdc\x6f"
-DATA ·d+7336(SB)/8,$"\x7e\xc9\x1d\x38\x4a\xd3\x4b\x49"
-DATA ·d+7344(SB)/8,$"\x5a\xdd\xdb\xfd\x09\x61\xb1\x98"
-DATA ·d+7352(SB)/8,$"\xa3\x43\x6b\x62\x6d\xc6\x7e\x79"
-DATA ·d+7360(SB)/8,$"\x1e\x40\x08\xf4\xe3\x19\xc5\x5d"
-DATA ·d+7368(SB)/8,$"\x8b\x4b\xd5\x16\x46\x96\x59\xa7"
-DATA ·d+7376(SB)/8,$"\x40\x38\x2a\x19\x4f\xd0\x81\xb4"
-DATA ·d+7384(SB)/8,$"\x70\x6a\xb4\xda\x45\xfb\x3a\x36"
-DATA ·d+7392(SB)/8,$"\xa1\x25\xc4\xae\xa3\x2a\xaa\x09"
-DATA ·d+7400(SB)/8,$"\x63\xeb\x90\xdc\x7f\x3a\x65\xbd"
-DATA ·d+7408(SB)/8,$"\xf5\xed\x8c\xc1\x60\x67\x46\x0d"
-DATA ·d+7416(SB)/8,$"\x9d\xb2\xc4\xaf\xf9\x63\x03\xf0"
-DATA ·d+7424(SB)/8,$"\xac\xe9\xf2\xc6\x4b\x99\x35\xfd"
-DATA ·d+7432(SB)/8,$"\x76\x46\x93\x73\x92\x6e\x12\x5f"
-DATA ·d+7440(SB)/8,$"\x8f\xeb\x73\xab\xb5\x4b\x5b\x98"
-DATA ·d+7448(SB)/8,$"\xb7\xca\xd2\x31\x03\x60\x79\xc1"
-DATA ·d+7456(SB)/8,$"\x42\x1b\xb6\xd6\x07\x19\xfa\xd6"
-DATA ·d+7464(SB)/8,$"\x6d\xc6\xa0\x34\xf8\x92\x46\x46"
-DATA ·d+7472(SB)/8,$"\x7c\xe4\x12\x0c\x64\x01\xdb\x2d"
-DATA ·d+7480(SB)/8,$"\x9e\x73\xda\x99\x75\x7b\xaf\x34"
-DATA ·d+7488(SB)/8,$"\x6c\xd7\xeb\x1b\x46\x72\xdb\x5c"
-DATA ·d+7496(SB)/8,$"\x2a\x2b\x64\x77\x1b\xbf\x95\x73"
-DATA ·d+7504(SB)/8,$"\x92\x6a\xf6\x4b\x60\xd6\x92\x47"
-DATA ·d+7512(SB)/8,$"\x62\x63\x72\x15\xa1\x85\x79\x18"
-DATA ·d+7520(SB)/8,$"\xf5\x66\x07\x1f\x41\x5a\xe3\x71"
-DATA ·d+7528(SB)/8,$"\xa9\x69\x4d\xf2\xbf\xeb\xd7\xf5"
-DATA ·d+7536(SB)/8,$"\x38\xf0\x58\x37\x26\xdc\x97\x37"
-DATA ·d+7544(SB)/8,$"\xa3\xd0\xb0\x9a\xb4\x30\xbb\x98"
-DATA ·d+7552(SB)/8,$"\x23\x7f\x3a\x63\xe5\x8d\xce\xb1"
-DATA ·d+7560(SB)/8,$"\x00\xf9\xc8\x2a\x03\x72\x71\xc5"
-DATA ·d+7568(SB)/8,$"\x5d\x1a\xae\xa1\x8f\x9b\x49\xb9"
-DATA ·d+7576(SB)/8,$"\x63\xdd\xbc\xd3\xc9\xf5\xeb\x58"
-DATA ·d+7584(SB)/8,$"\xf1\x6a\x9e\x1e\xb4\x8c\xdc\xc7"
-DATA ·d+7592(SB)/8,$"\xb0\xdc\x60\xaa\xa2\xc6\xb9\x5f"
-DATA ·d+7600(SB)/8,$"\x42\x1b\x03\x18\xa9\x17\xc6\xf7"
-DATA ·d+7608(SB)/8,$"\xea\xa1\x4d\xd3\xf0\x31\xd7\xff"
-DATA ·d+7616(SB)/8,$"\x00\xad\x5e\x35\x3a\xad\x69\x52"
-DATA ·d+7624(SB)/8,$"\xaf\xfe\x03\x6f\xc6\xe7\xaf\x2c"
-DATA ·d+7632(SB)/8,$"\x24\xa4\xef\x4e\x92\xff\x00\xb7"
-DATA ·d+7640(SB)/8,$"\xaf\xf8\x1f\xff\xd1\xfe\x77\xe1"
-DATA ·d+7648(SB)/8,$"\xb6\x6b\xa4\x0f\xa9\xea\x16\x20"
-DATA ·d+7656(SB)/8,$"\x47\x9d\xb0\xa4\x44\x49\xc9\xe4"
-DATA ·d+7664(SB)/8,$"\x85\x04\x63\x1f\x51\xc5\x5f\xb8"
-DATA ·d+7672(SB)/8,$"\x1a\x76\xb0\xc2\xd5\xd6\x36\x86"
-DATA ·d+7680(SB)/8,$"\x35\x24\x4b\x3d\xcf\x90\xaa\x3b"
-DATA ·d+7688(SB)/8,$"\x96\x48\x32\x49\xfa\x9f\xc6\x96"
-DATA ·d+7696(SB)/8,$"\x0f\x07\xc0\x63\x92\x5d\x5e\x49"
-DATA ·d+7704(SB)/8,$"\xaf\x9c\x60\xba\xb4\x85\x4b\xb3"
-DATA ·d+7712(SB)/8,$"\x0e\xaa\xa0\xa8\xe3\xfd\xa3\xf5"
-DATA ·d+7720(SB)/8,$"\x15\x89\x0d\x97\x88\x3e\xd0\xb6"
-DATA ·d+7728(SB)/8,$"\x7a\x3e\x9e\xbb\x11\x99\x82\x45"
-DATA ·d+7736(SB)/8,$"\xb7\x68\x3f\xde\x67\x6c\xfe\x95"
-DATA ·d+7744(SB)/8,$"\xfe\x8e\xce\x32\x82\x5c\xd4\xf4"
-DATA ·d+7752(SB)/8,$"\x97\xce\xff\x00\x9a\x3f\xcc\x08"
-DATA ·d+7760(SB)/8,$"\xd4\xa5\x37\x78\xd4\xd5\x7a\x45"
-DATA ·d+7768(SB)/8,$"\x2f\x4e\xa6\x4c\x97\xb6\x4a\x52"
-DATA ·d+7776(SB)/8,$"\xd7\x45\xb5\xb9\x90\x23\x9c\x6d"
-DATA ·d+7784(SB)/8,$"\x67\x1b\xc9\xe3\x20\xb1\x2c\x3d"
-DATA ·d+7792(SB)/8,$"\xb8\xe0\x54\x4f\xa0\x87\x88\x5c"
-DATA ·d+7800(SB)/8,$"\xdf\xc7\x0d\x8c\x6c\x4e\xd3\x71"
-DATA ·d+7808(SB)/8,$"\x76\xc9\xbb\x9e\xa5\x8a\xb6\x7f"
-DATA ·d+7816(SB)/8,$"\x0a\xf4\x7b\x38\x75\xe5\x43\x6d"
-DATA ·d+7824(SB)/8,$"\x2d\xfb\x43\x34\xa7\x24\xc5\x62"
-DATA ·d+7832(SB)/8,$"\xdf\x2f\x6d\xa2\x63\x9e\x3d\xf1"
-DATA ·d+7840(SB)/8,$"\x55\x1b\x41\x69\x7f\xd2\x75\xcb"
-DATA ·d+7848(SB)/8,$"\xcb\xab\xa7\x67\xf2\xf6\xac\x6a"
-DATA ·d+7856(SB)/8,$"\xee\xc4\x0e\x33\xb8\x0e\x06\x39"
-DATA ·d+7864(SB)/8,$"\xa2\x59\x5b\x6b\x9a\x51\xfc\x12"
-DATA ·d+7872(SB)/8,$"\x4b\xf3\x66\xd1\xcd\xa3\x09\x59"
-DATA ·d+7880(SB)/8,$"\x3f\xc6\x4d\xbf\xc1\x23\x83\xb1"
-DATA ·d+7888(SB)/8,$"\xd4\xfc\x32\xb0\x98\xa1\x6b\x46"
-DATA ·d+7896(SB)/8,$"\x74\x21\x99\xee\x4b\xcc\x8d\xfe"
-DATA ·d+7904(SB)/8,$"\xca\xee\xc7\x1f\x41\xcf\xad\x75"
-DATA ·d+7912(SB)/8,$"\xb6\xbe\x27\xf0\xc8\x88\xdb\x5b"
-DATA ·d+7920(SB)/8,$"\x18\xe6\x91\xd8\x81\x1e\x91\x6e"
-DATA ·d+7928(SB)/8,$"\xf1\xec\xe3\xef\x6e\x27\x27\x9e"
-DATA ·d+7936(SB)/8,$"\xc7\x14\x89\xe1\xef\x0c\x3e\xe4"
-DATA ·d+7944(SB)/8,$"\xd5\xed\xd1\x8a\x12\x06\x59\x54"
-DATA ·d+7952(SB)/8,$"\x2f\x4e\xf1\x0d\xc4\xfb\x13\x52"
-DATA ·d+7960(SB)/8,$"\x5b\xe9\xde\x1f\x18\x5d\x22\xd2"
-DATA ·d+7968(SB)/8,$"\xe4\xa1\x60\x30\x81\x94\xc9\x9e"
-DATA ·d+7976(SB)/8,$"\xf9\xdd\x82\x3f\x1f\xa8\xae\x7a"
-DATA ·d+7984(SB)/8,$"\x55\xea\xd2\x49\x29\x47\xe5\x7b"
-DATA ·d+7992(SB)/8,$"\xfd\xc5\x62\x6b\xe1\xea\xb7\xa4"
-DATA ·d+8000(SB)/8,$"\xff\x00\x0b\x7d\xe3\xad\xf4\xcd"
-DATA ·d+8008(SB)/8,$"\x0a\xee\xe0\xc9\x3d\xaa\xc8\xc5"
-DATA ·d+8016(SB)/8,$"\x47\x9a\xd7\x0c\x15\xc9\xf6\x59"
-DATA ·d+8024(SB)/8,$"\x18\xf5\xfa\xd5\x2b\xdd\x36\xf5"
-DATA ·d+8032(SB)/8,$"\x6f\xd6\x3f\x0e\x69\x4b\x1a\xb6"
-DATA ·d+8040(SB)/8,$"\x55\x4d\xd1\xdc\x9c\x11\xc8\x20"
-DATA ·d+8048(SB)/8,$"\xf3\xfc\xab\xab\x4b\xaf\xb2\xc2"
-DATA ·d+8056(SB)/8,$"\x61\xd2\x9d\xa1\x44\x19\x71\x21"
-DATA ·d+8064(SB)/8,$"\x5e\x8a\x70\xc1\x46\x18\xb1\x3e"
-DATA ·d+8072(SB)/8,$"\x94\x96\x3a\xec\x56\xe4\x0b\xbb"
-DATA ·d+8080(SB)/8,$"\x2b\x9b\xe3\xe6\x66\x29\x9e\x3d"
-DATA ·d+8088(SB)/8,$"\xa3\xd4\xa8\xc6\xcc\x7d\x2b\xd5"
-DATA ·d+8096(SB)/8,$"\x94\xe8\xb8\xa8\xcd\xf2\xf7\x76"
-DATA ·d+8104(SB)/8,$"\x5f\x9e\xac\xf2\xa1\x8b\xac\x9b"
-DATA ·d+8112(SB)/8,$"\x9c\x13\x97\x93\x6f\xfc\xd2\x39"
-DATA ·d+8120(SB)/8,$"\xb9\x2c\xf5\xad\x3e\xe7\xcb\xd4"
-DATA ·d+8128(SB)/8,$"\xad\x6c\xe5\xd9\x82\xfe\x4a\x3c"
-DATA ·d+8136(SB)/8,$"\x8c\x47\x5e\x98\x3f\xcf\x15\x9d"
-DATA ·d+8144(SB)/8,$"\xa8\x6a\xd6\x96\x57\x86\xf6\x0b"
-DATA ·d+8152(SB)/8,$"\x5f\x28\x9c\x18\xa3\xf2\x3c\xb0"
-DATA ·d+8160(SB)/8,$"\x4f\xa8\x2d\x93\x9f\xc3\x15\xe9"
-DATA ·d+8168(SB)/8,$"\x37\x8d\xaa\xeb\x32\xc6\x93\xda"
-DATA ·d+8176(SB)/8,$"\x5c\x69\xd0\x74\xdf\x0d\xd0\x2c"
-DATA ·d+8184(SB)/8,$"\x33\xc8\x05\x14\xfc\xa0\xfa\xb1"
-DATA ·d+8192(SB)/8,$"\xae\x76\xe0\x5d\x78\x72\xec\x5a"
-DATA ·d+8200(SB)/8,$"\x7d\x9e\xdb\xcf\x6f\x9b\xcd\xf3"
-DATA ·d+8208(SB)/8,$"\x0b\x6e\x8c\x1e\xa7\x21\xb3\x9e"
-DATA ·d+8216(SB)/8,$"\xe0\x31\xa8\xc5\x53\x49\x7b\x92"
-DATA ·d+8224(SB)/8,$"\x6a\x17\xdd\xaf\xf8\x09\xff\x00"
-DATA ·d+8232(SB)/8,$"\x5d\x4a\xc2\xe3\x54\xbe\x38\xae"
-DATA ·d+8240(SB)/8,$"\x7e\xc9\xff\x00\xc1\xb7\xea\x71"
-DATA ·d+8248(SB)/8,$"\xf6\x77\xd3\x4e\xca\xee\x67\x89"
-DATA ·d+8256(SB)/8,$"\xcb\x92\xab\x6a\xfb\x99\xb7\x76"
-DATA ·d+8264(SB)/8,$"\xe3\xa9\xcf\xd6\xb6\xae\xae\x6e"
-DATA ·d+8272(SB)/8,$"\xd6\xc4\xc4\xad\x3b\x26\xfd\xfe"
-DATA ·d+8280(SB)/8,$"\x45\xc3\x84\x3e\x66\x30\xc4\xa9"
-DATA ·d+8288(SB)/8,$"\x00\x8f\xce\xba\xc9\xe6\xd1\x9a"
-DATA ·d+8296(SB)/8,$"\x5f\xed\x0b\xa9\xc6\xf6\x1f\x3a"
-DATA ·d+8304(SB)/8,$"\xc5\x0f\x96\x54\xf0\x09\x0b\xe9"
-DATA ·d+8312(SB)/8,$"\xe9\x9a\xce\x4b\x88\xa7\x8c\x41"
-DATA ·d+8320(SB)/8,$"\xa2\xcf\x83\x1b\xb1\x90\xa4\x03"
-DATA ·d+8328(SB)/8,$"\xcc\x23\x93\x97\x66\xce\x01\xef"
-DATA ·d+8336(SB)/8,$"\xcd\x29\x50\x54\xd3\x4e\x77\x6f"
-DATA ·d+8344(SB)/8,$"\xcd\x5f\xf3\x2d\xe3\x55\x49\x2b"
-DATA ·d+8352(SB)/8,$"\x53\xb5\xbd\x6d\xf9\x19\x5a\x87"
-DATA ·d+8360(SB)/8,$"\x30\xa2\xce\x26\x84\x3a\xe1\x21"
-DATA ·d+8368(SB)/8,$"\x60\x25\x66\x23\x92\x03\x13\x81"
-DATA ·d+8376(SB)/8,$"\x91\xd7\x15\x52\xd2\xeb\x59\x89"
-DATA ·d+8384(SB)/8,$"\x7e\xc5\x69\xe6\x47\x13\x39\x66"
-DATA ·d+8392(SB)/8,$"\x78\x5e\x32\xe4\x0e\x46\x48\xeb"
-DATA ·d+8400(SB)/8,$"\x8f\x4c\x60\x7b\xd6\xcd\xff\x00"
-DATA ·d+8408(SB)/8,$"\xd8\x61\xb3\x0d\x22\xb4\x4e\xf8"
-DATA ·d+8416(SB)/8,$"\x0c\xe5\x23\x21\x8e\x73\x80\x40"
-DATA ·d+8424(SB)/8,$"\x27\xfc\xf5\xac\x38\xee\x7c\xab"
-DATA ·d+8432(SB)/8,$"\x43\x35\x8c\x99\xdd\xf2\xbb\x3a"
-DATA ·d+8440(SB)/8,$"\x88\xd4\x28\x39\xc0\x00\x13\x93"
-DATA ·d+8448(SB)/8,$"\xeb\x8a\xce\xa6\x22\x51\x95\xe5"
-DATA ·d+8456(SB)/8,$"\x2b\x59\x74\xde\xdf\xd7\x63\x7a"
-DATA ·d+8464(SB)/8,$"\x4b\x9e\x9b\x4a\x1d\x7a\xed\xfd"
-DATA ·d+8472(SB)/8,$"\x7a\xdc\xb7\x76\xf2\x17\x12\x2c"
-DATA ·d+8480(SB)/8,$"\xf7\xb7\xd2\x45\x84\x89\x26\x56"
-DATA ·d+8488(SB)/8,$"\x21\x47\x5f\xbd\x95\x1d\x7b\x56"
-DATA ·d+8496(SB)/8,$"\x05\xce\xbf\xad\x4b\x22\xa5\xec"
-DATA ·d+8504(SB)/8,$"\xb6\xd6\x70\x05\xfd\xea\x19\x23"
-DATA ·d+8512(SB)/8,$"\x50\xc7\xbf\xdd\x25\x8f\xd2\x9f"
-DATA ·d+8520(SB)/8,$"\xa8\xea\xd7\xb7\x90\x26\xcd\x2a"
-DATA ·d+8528(SB)/8,$"\x67\x56\xca\x23\x6f\x0a\xac\xde"
-DATA ·d+8536(SB)/8,$"\xa4\xe4\x7e\x3c\x55\xab\x69\xa7"
-DATA ·d+8544(SB)/8,$"\xb7\x87\xfb\x3e\xcf\x45\x59\x4b"
-DATA ·d+8552(SB)/8,$"\xb0\x63\x21\x28\xbb\x9b\x1d\x33"
-DATA ·d+8560(SB)/8,$"\x86\x60\xa3\xeb\x9a\xe4\xad\x8c"
-DATA ·d+8568(SB)/8,$"\x53\x9b\xe4\x9c\x92\xf2\x52\xff"
-DATA ·d+8576(SB)/8,$"\x00\x2d\x4e\xea\x18\x64\xa2\xb9"
-DATA ·d+8584(SB)/8,$"\xe0\x9b\xf5\x8f\xf9\xd9\x7d\xc4"
-DATA ·d+8592(SB)/8,$"\xd6\x5e\x21\x86\xee\x48\x62\xb1"
-DATA ·d+8600(SB)/8,$"\x09\x27\x96\xbf\x2c\x91\xa4\xb2"
-DATA ·d+8608(SB)/8,$"\x95\x3e\x98\x39\x50\x4f\x63\x56"
-DATA ·d+8616(SB)/8,$"\xa4\xb8\x6b\x03\x25\xeb\x59\xdd"
-DATA ·d+8624(SB)/8,$"\x00\xe4\x16\x9d\xd2\x1d\xac\xdd"
-DATA ·d+8632(SB)/8,$"\x80\x1c\x85\x3f\xad\x63\x97\xf1"
-DATA ·d+8640(SB)/8,$"\x05\xb4\x8b\x3d\xc4\x56\xf6\x56"
-DATA ·d+8648(SB)/8,$"\xf2\x31\x56\x4f\x3b\x25\xdb\xd1"
-DATA ·d+8656(SB)/8,$"\x00\x52\x31\xf9\x52\x6a\x56\x9a"
-DATA ·d+8664(SB)/8,$"\x84\xff\x00\xe9\x3a\xbb\x41\x18"
-DATA ·d+8672(SB)/8,$"\x89\x91\x56\x44\xf3\x1d\x51\x33"
-DATA ·d+8680(SB)/8,$"\xc7\x40\x13\x27\x8f\xbb\xcd\x25"
-DATA ·d+8688(SB)/8,$"\x99\xbe\x4d\x22\xf9\x96\xd7\x56"
-DATA ·d+8696(SB)/8,$"\x5f\x88\xde\x0a\x3c\xeb\x55\xca"
-DATA ·d+8704(SB)/8,$"\xfb\x3b\xbf\xc0\xd1\xb4\xbd\xba"
-DATA ·d+8712(SB)/8,$"\xd4\xe4\xff\x00\x44\x55\x55\x66"
-DATA ·d+8720(SB)/8,$"\xc0\x91\x55\x43\x03\xe9\x94\x5c"
-DATA ·d+8728(SB)/8,$"\x8a\xbd\xe2\x1d\x3b\x4a\xb4\xb3"
-DATA ·d+8736(SB)/8,$"\x0d\xad\xde\x4f\x19\x93\xe4\x43"
-DATA ·d+8744(SB)/8,$"\x2d\xc9\x3b\x98\x75\x0a\xa7\x03"
-DATA ·d+8752(SB)/8,$"\x1f\xca\xb9\xeb\x17\xb8\xb9\x57"
-DATA ·d+8760(SB)/8,$"\xb5\xb5\xd6\x26\x8e\x35\x60\x08"
-DATA ·d+8768(SB)/8,$"\x85\x02\xc6\x17\xb9\xce\x77\x7e"
-DATA ·d+8776(SB)/8,$"\xb9\xaa\x11\x78\x73\x4b\xb1\x62"
-DATA ·d+8784(SB)/8,$"\x8f\xa8\xdb\x5d\xa8\x72\xca\x25"
-DATA ·d+8792(SB)/8,$"\x6d\xf2\x1c\x9c\xfd\xe2\xa5\xab"
-DATA ·d+8800(SB)/8,$"\x5f\xed\x1a\x95\x29\xb5\x0a\x6a"
-DATA ·d+8808(SB)/8,$"\x4d\xf5\x6d\x24\xbe\x57\x6d\x91"
-DATA ·d+8816(SB)/8,$"\x2c\x24\x63\x34\xdd\x47\x1b\x74"
-DATA ·d+8824(SB)/8,$"\x49\xdd\xfc\xed\xa7\xdc\xcd\x3d"
-DATA ·d+8832(SB)/8,$"\x3a\xf2\xc2\x0d\x3a\x69\x34\x41"
-DATA ·d+8840(SB)/8,$"\x70\xae\xa0\xfe\xfe\x61\xf2\x88"
-DATA ·d+8848(SB)/8,$"\xc0\xc6\x03\x93\x96\x76\x3d\x02"
-DATA ·d+8856(SB)/8,$"\xaf\xe3\xde\xb4\x34\xd4\x76\xd9"
-DATA ·d+8864(SB)/8,$"\x0d\xa8\x45\x8d\xd7\x9d\xaa\xd1"
-DATA ·d+8872(SB)/8,$"\xee\x20\x73\xb9\xd8\x93\x9c\xf5"
-DATA ·d+8880(SB)/8,$"\xc5\x6a\x87\x7b\x3b\x27\xb8\xb3"
-DATA ·d+8888(SB)/8,$"\xb5\x4c\x94\xdc\x26\x55\x69\x03"
-DATA ·d+8896(SB)/8,$"\xb0\x1d\x00\xe0\x0c\x77\xc8\xe6"
-DATA ·d+8904(SB)/8,$"\xb8\xa8\xe6\xb4\x48\xbf\xb4\xf5"
-DATA ·d+8912(SB)/8,$"\x19\x2e\xa3\xba\xc1\x40\xb6\xf1"
-DATA ·d+8920(SB)/8,$"\x90\x87\x77\xae\xd0\x42\xf3\xd7"
-DATA ·d+8928(SB)/8,$"\x1c\xfa\x55\x39\x4e\x92\xa6\xe4"
-DATA ·d+8936(SB)/8,$"\xef\x65\xd3\x44\x10\xb5\x6e\x6e"
-DATA ·d+8944(SB)/8,$"\x55\x6d\x7d\x5b\x7e\x7b\x7f\x5d"
-DATA ·d+8952(SB)/8,$"\x0e\x94\x69\xde\x63\x35\xed\xdd"
-DATA ·d+8960(SB)/8,$"\xc8\x58\x93\x24\xc0\x1c\x00\x17"
-DATA ·d+8968(SB)/8,$"\xa6\x78\x5c\xe3\xea\x73\x4d\xd5"
-DATA ·d+8976(SB)/8,$"\xd3\x44\xb3\xf2\xad\xbc\xe8\xf7"
-DATA ·d+8984(SB)/8,$"\x75\x68\x60\x04\x99\x33\xd8\x2e"
-DATA ·d+8992(SB)/8,$"\x4e\x08\xea\x72\x79\xae\x06\xd5"
-DATA ·d+9000(SB)/8,$"\xf4\xbb\x80\xd0\x5c\xdc\xa4\x92"
-DATA ·d+9008(SB)/8,$"\x86\x05\x9e\xf2\x49\x70\x73\xc6"
-DATA ·d+9016(SB)/8,$"\xd4\xcf\x51\x5b\x76\xd2\x80\x86"
-DATA ·d+9024(SB)/8,$"\xca\xca\xde\xc2\x58\x8b\x03\xb3"
-DATA ·d+9032(SB)/8,$"\x27\x0a\xcb\xd0\x86\x72\x7f\x96"
-DATA ·d+9040(SB)/8,$"\x2b\x3a\x79\x84\xa5\x16\xa1\x4d"
-DATA ·d+9048(SB)/8,$"\x6b\xd5\xb6\xf5\xfc\x3f\x53\x59"
-DATA ·d+9056(SB)/8,$"\xe0\x5c\x66\x9c\xe6\xf4\xf9\x23"
-DATA ·d+9064(SB)/8,$"\x6a\x3b\x6f\x08\xda\xe2\x3b\x8b"
-DATA ·d+9072(SB)/8,$"\x6c\x4e\xdf\xbc\x21\x89\x38\x43"
-DATA ·d+9080(SB)/8,$"\xf7\x49\x19\x07\xdf\x81\x58\x37"
-DATA ·d+9088(SB)/8,$"\xf2\x69\xc2\x66\x7c\xa3\x16\xc6"
-DATA ·d+9096(SB)/8,$"\xd4\x9c\x15\x4f\xc1\x41\x1d\x07"
-DATA ·d+9104(SB)/8,$"\xad\x69\x43\x69\xf6\x7b\xe4\x9d"
-DATA ·d+9112(SB)/8,$"\xa3\x30\x49\x82\x8d\x24\x2e\xdb"
-DATA ·d+9120(SB)/8,$"\x37\x1e\x9b\x8e\xdc\x13\xf8\x52"
-DATA ·d+9128(SB)/8,$"\xad\xd4\xb7\xb3\x9d\x3e\x14\x86"
-DATA ·d+9136(SB)/8,$"\x47\x0e\x47\xda\x1c\x8d\xdc\x7f"
-DATA ·d+9144(SB)/8,$"\x10\xc8\xce\x3f\x2a\xba\xb5\x34"
-DATA ·d+9152(SB)/8,$"\xe5\x94\x52\x7e\x48\x54\x9f\x2c"
-DATA ·d+9160(SB)/8,$"\xb9\xa2\xdc\x97\x5d\x7f\xe1\xf4"
-DATA ·d+9168(SB)/8,$"\x39\xe3\xa3\x99\xb7\x5c\xdc\xea"
-DATA ·d+9176(SB)/8,$"\x5a\x7c\x31\xa9\x01\x10\x46\x00"
-DATA ·d+9184(SB)/8,$"\xc1\xfe\xe0\x1d\x31\xdf\xd6\xba"
-DATA ·d+9192(SB)/8,$"\x55\xb0\xd2\xe7\xb7\x8f\x63\x7d"
-DATA ·d+9200(SB)/8,$"\xbd\x86\x57\xed\x05\x54\x46\xc7"
-DATA ·d+9208(SB)/8,$"\x1d\x39\xeb\x8f\xa5\x45\x24\xf2"
-DATA ·d+9216(SB)/8,$"\x41\x6a\x56\x69\xed\x2e\x24\x6e"
-DATA ·d+9224(SB)/8,$"\x84\x43\x2e\x54\x74\xce\x42\xf3"
-DATA ·d+9232(SB)/8,$"\xfc\xab\x2e\xd3\x58\xbd\xb6\xb5"
-DATA ·d+9240(SB)/8,$"\x64\x8d\xae\x0a\xe4\xe2\x38\xa0"
-DATA ·d+9248(SB)/8,$"\x44\x1c\xf5\xf9\xb2\x58\x0f\xc6"
-DATA ·d+9256(SB)/8,$"\xb1\xa7\x8a\xa5\x09\x38\xb8\xee"
-DATA ·d+9264(SB)/8,$"\xbd\x7e\x5b\xb5\xf8\x1d\x33\x85"
-DATA ·d+9272(SB)/8,$"\x69\xc7\x9a\xfb\x3d\x3a\x7e\x97"
-DATA ·d+9280(SB)/8,$"\x36\x7f\xb1\xff\x00\xb3\x59\x1e"
-DATA ·d+9288(SB)/8,$"\xe6\xda\x08\xa0\x60\x4b\x4b\x21"
-DATA ·d+9296(SB)/8,$"\x40\x07\xd4\x6d\xcf\xeb\x4f\xd5"
-DATA ·d+9304(SB)/8,$"\xaf\xb4\xdd\x36\xc0\x5d\x5b\xc2"
-DATA ·d+9312(SB)/8,$"\x65\x04\x1d\xb3\x5b\x61\xe3\x1e"
-DATA ·d+9320(SB)/8,$"\xb8\x07\x19\x23\xf2\xf6\xae\x42"
-DATA ·d+9328(SB)/8,$"\xef\xc4\x1a\x6c\x5f\xe8\x96\xd6"
-DATA ·d+9336(SB)/8,$"\x76\xd2\xb3\x28\xf3\x66\x93\x32"
-DATA ·d+9344(SB)/8,$"\x37\x7c\xe7\x27\x1f\x8e\x6b\xa1"
-DATA ·d+9352(SB)/8,$"\xb0\xd6\xfc\x29\x73\x14\x36\x53"
-DATA ·d+9360(SB)/8,$"\xa0\xf3\x55\x4e\xfd\x83\x21\x4e"
-DATA ·d+9368(SB)/8,$"\x38\xda\xbc\x8e\x3d\x0d\x69\x43"
-DATA ·d+9376(SB)/8,$"\x17\x09\xf3\x42\x9c\x92\xfe\xbd"
-DATA ·d+9384(SB)/8,$"\x11\x15\x70\x95\x23\xcb\x52\x71"
-DATA ·d+9392(SB)/8,$"\x6f\xbd\xbb\x77\xdd\xd8\xc6\x6f"
-DATA ·d+9400(SB)/8,$"\x14\x58\xcd\x1a\x94\x86\x69\x5b"
-DATA ·d+9408(SB)/8,$"\x8f\x32\x4b\xc6\x04\xfe\x1e\x5e"
-DATA ·d+9416(SB)/8,$"\x3f\xa5\x75\x3a\x15\xdd\x96\xa0"
-DATA ·d+9424(SB)/8,$"\xaf\x2b\x6d\x96\x63\x8d\x91\x2e"
-DATA ·d+9432(SB)/8,$"\xef\x97\xe8\x58\xe3\xf0\xeb\x56"
-DATA ·d+9440(SB)/8,$"\xae\x6d\xf4\x3b\xa6\x45\xb5\xb1"
-DATA ·d+9448(SB)/8,$"\xfb\x43\x6d\x02\x42\x19\x23\x1e"
-DATA ·d+9456(SB)/8,$"\xd9\x01\x49\xcf\xa8\x03\x35\xab"
-DATA ·d+9464(SB)/8,$"\xff\x00\x08\xa6\x88\xf7\x08\x22"
-DATA ·d+9472(SB)/8,$"\x80\xc6\x01\xf9\x8c\x6a\x63\x2a"
-DATA ·d+9480(SB)/8,$"\x71\xd4\xe6\xbd\x1c\x26\x02\xaf"
-DATA ·d+9488(SB)/8,$"\x3d\xdc\xd4\xad\xeb\xfe\x5a\xfe"
-DATA ·d+9496(SB)/8,$"\x27\x06\x33\x1f\x87\xe4\xe5\xe4"
-DATA ·d+9504(SB)/8,$"\x71\xbf\x9f\xfc\x1d\x3f\x03\x2e"
-DATA ·d+9512(SB)/8,$"\xea\xd1\xe6\x88\xb5\xcd\x9a\x6d"
-DATA ·d+9520(SB)/8,$"\x5c\x84\x8a\x16\x50\x5b\xd7\x76"
-DATA ·d+9528(SB)/8,$"\x7e\x63\xda\xb8\xab\xab\x67\xb7"
-DATA ·d+9536(SB)/8,$"\x94\xc6\x9a\x43\x2e\x08\x6d\xaa"
-DATA ·d+9544(SB)/8,$"\x37\xf1\xdb\xa6\x49\xcf\xd6\xbb"
-DATA ·d+9552(SB)/8,$"\x1b\xeb\x4b\xb8\xee\x1c\x59\x08"
-DATA ·d+9560(SB)/8,$"\xdf\x60\xc6\xc9\xa5\x65\xc2\xf4"
-DATA ·d+9568(SB)/8,$"\x3f\x31\x5c\x1f\xc3\x35\x4d\xa4"
-DATA ·d+9576(SB)/8,$"\xbd\x44\x12\xdb\x2c\x70\x14\x01"
-DATA ·d+9584(SB)/8,$"\x77\xf9\xa5\x8e\xe1\xd8\x2a\x80"
-DATA ·d+9592(SB)/8,$"\x48\xfa\xd6\x58\xbe\x59\xb7\xa3"
-DATA ·d+9600(SB)/8,$"\xd3\xcb\xfe\x07\xea\x74\x60\xea"
-DATA ·d+9608(SB)/8,$"\x4a\x09\x6c\xd3\xf3\x6b\xf5\x39"
-DATA ·d+9616(SB)/8,$"\x21\x63\xa7\xc9\x2f\x9f\x73\x02"
-DATA ·d+9624(SB)/8,$"\x5b\xb1\x1f\x32\x91\x32\x3e\x7d"
-DATA ·d+9632(SB)/8,$"\xf3\x9f\xe7\x53\x2c\x9a\x13\x66"
-DATA ·d+9640(SB)/8,$"\x2b\x08\xed\xd1\xcf\x04\xc8\xa6"
-DATA ·d+9648(SB)/8,$"\x53\x9f\x50\x4e\x29\x9a\xd6\xb5"
-DATA ·d+9656(SB)/8,$"\xab\x5a\x45\x14\x73\xc3\x77\x75"
-DATA ·d+9664(SB)/8,$"\x34\x8b\xbd\x8a\x14\xda\xac\x7d"
-DATA ·d+9672(SB)/8,$"\xfe\x6c\x0f\xa9\xac\x19\x7c\x5d"
-DATA ·d+9680(SB)/8,$"\x71\x15\xac\x6b\x73\x69\x23\x26"
-DATA ·d+9688(SB)/8,$"\x57\x26\x17\x48\xdc\x67\xdc\x0c"
-DATA ·d+9696(SB)/8,$"\x9e\x7d\xc0\xef\x5f\x3d\x5b\x37"
-DATA ·d+9704(SB)/8,$"\xc3\xd2\x94\xa0\x96\xab\x7f\x77"
-DATA ·d+9712(SB)/8,$"\xf5\xe8\x7b\xf4\xf0\x35\xea\xa8"
-DATA ·d+9720(SB)/8,$"\xcb\xff\x00\x6e\xff\x00\x3d\xce"
-DATA ·d+9728(SB)/8,$"\xaa\xd6\xea\x78\x2e\x96\x09\x21"
-DATA ·d+9736(SB)/8,$"\x17\x28\x00\x2f\x2d\xbc\x4d\x18"
-DATA ·d+9744(SB)/8,$"\x4c\xf6\x23\xbe\x3d\x71\x5d\x34"
-DATA ·d+9752(SB)/8,$"\x16\x7a\x54\xa5\xb2\x70\x92\x02"
-DATA ·d+9760(SB)/8,$"\x56\x48\xc9\x56\xff\x00\x74\xf2"
-DATA ·d+9768(SB)/8,$"\x7a\x7a\x8a\xe2\x34\xc1\xa0\xea"
-DATA ·d+9776(SB)/8,$"\xac\xd7\x77\x11\x0b\x52\xb9\x54"
-DATA ·d+9784(SB)/8,$"\x33\x49\x26\xee\x9d\x37\x27\x38"
-DATA ·d+9792(SB)/8,$"\xf7\x27\x9a\xbb\x09\xd2\xf4\x68"
-DATA ·d+9800(SB)/8,$"\x3e\xd6\x8d\xe6\x04\x89\x8b\xae"
-DATA ·d+9808(SB)/8,$"\x24\x71\xea\x01\xf3\x98\x28\x53"
-DATA ·d+9816(SB)/8,$"\xc6\x48\x35\xdb\x83\xcd\xd4\x23"
-DATA ·d+9824(SB)/8,$"\xcd\x34\xa5\x17\xae\xfa\xfd\xd6"
-DATA ·d+9832(SB)/8,$"\xb9\xc9\x8b\xc0\x73\xbe\x58\xb9"
-DATA ·d+9840(SB)/8,$"\x29\x2f\x2d\x3e\xfb\x9b\x33\xf8"
-DATA ·d+9848(SB)/8,$"\x5a\xdc\xb9\xfb\x3b\xce\x21\xd9"
-DATA ·d+9856(SB)/8,$"\x96\x26\x40\x79\x3e\x98\xc7\x1f"
-DATA ·d+9864(SB)/8,$"\x53\x5c\xc5\xe7\x85\x6c\x0d\xd2"
-DATA ·d+9872(SB)/8,$"\xd9\xc0\x93\xbb\xc8\x01\x0d\x1b"
-DATA ·d+9880(SB)/8,$"\x17\xfc\x4f\x6f\xd6\xa9\x4f\xe2"
-DATA ·d+9888(SB)/8,$"\x3f\x15\x08\x5a\xf2\x2b\x4b\x08"
-DATA ·d+9896(SB)/8,$"\x49\x3b\x22\xb7\x85\x1e\x42\xfe"
-DATA ·d+9904(SB)/8,$"\x99\x19\xda\x38\xeb\xcf\xe3\x4f"
-DATA ·d+9912(SB)/8,$"\xb1\xf1\xa7\x8d\xa0\xca\x4d\xa5"
-DATA ·d+9920(SB)/8,$"\xc0\xbb\x47\x94\xa7\x6b\x70\xd9"
-DATA ·d+9928(SB)/8,$"\xeb\xb4\x31\xe8\x38\xe0\xd7\x1d"
-DATA ·d+9936(SB)/8,$"\x6c\xe7\x01\x56\x5c\xbe\xc9\xab"
-DATA ·d+9944(SB)/8,$"\xf5\xe5\x6f\xfe\x18\xea\xa3\x96"
-DATA ·d+9952(SB)/8,$"\xe3\xa9\xc7\x9b\xda\x27\xe5\xcd"
-DATA ·d+9960(SB)/8,$"\x6f\xf8\x72\xcc\x1a\x7c\x9a\x41"
-DATA ·d+9968(SB)/8,$"\x17\x1e\x5c\xcf\x0a\x13\xb9\x46"
-DATA ·d+9976(SB)/8,$"\x41\xc0\xed\x9e\x82\xa5\xb7\xbb"
-DATA ·d+9984(SB)/8,$"\x93\x54\x46\xb9\xb4\x2c\x8d\x96"
-DATA ·d+9992(SB)/8,$"\xcc\x01\xc3\x30\x1d\x9b\x71\xcf"
-DATA ·d+10000(SB)/8,$"\xf2\xae\x5b\x54\x87\x59\xd5\x25"
-DATA ·d+10008(SB)/8,$"\x32\x6a\x9a\x90\x55\x97\x28\xd6"
-DATA ·d+10016(SB)/8,$"\x8e\xa5\x21\x4e\x73\x8d\xb9\x24"
-DATA ·d+10024(SB)/8,$"\x9f\xd6\xac\xdb\xc5\xaf\x35\x89"
-DATA ·d+10032(SB)/8,$"\x87\xc3\x93\xcb\x3a\x95\x11\xba"
-DATA ·d+10040(SB)/8,$"\xa5\xaa\x47\x12\xe3\xd3\xcc\x21"
-DATA ·d+10048(SB)/8,$"\x8e\x2b\xcd\x59\x9c\xbd\xa3\x8c"
-DATA ·d+10056(SB)/8,$"\x69\x4b\x97\xb6\x97\x7f\x25\xa9"
-DATA ·d+10064(SB)/8,$"\xdc\xf0\x31\x70\x52\x94\xd3\x97"
-DATA ·d+10072(SB)/8,$"\x7d\x6d\xf7\xd8\xea\xed\x6d\x5e"
-DATA ·d+10080(SB)/8,$"\xf6\x65\x6d\x5c\x3b\xa0\xf9\xa2"
-DATA ·d+10088(SB)/8,$"\xde\x8a\x4e\x31\xea\xbb\x4f\xe4"
-DATA ·d+10096(SB)/8,$"\x2b\x46\x4b\x6d\x3b\x4c\xb4\xfb"
-DATA ·d+10104(SB)/8,$"\x65\xa3\x2a\xee\x5f\xdd\xef\x5c"
-DATA ·d+10112(SB)/8,$"\x1e\x0f\xa0\xe7\x1e\xf5\xe5\x57"
-DATA ·d+10120(SB)/8,$"\x9a\x2f\x8c\x85\xbb\xae\xb5\x14"
-DATA ·d+10128(SB)/8,$"\x73\x48\x51\x92\x39\xa3\xbb\xf2"
-DATA ·d+10136(SB)/8,$"\x4a\xc7\x9e\x06\xc4\x53\xc0\xa8"
-DATA ·d+10144(SB)/8,$"\x74\xdd\x32\x7b\xa9\x99\x6f\xa2"
-DATA ·d+10152(SB)/8,$"\xb8\x55\x68\xc4\x5b\x43\x45\x70"
-DATA ·d+10160(SB)/8,$"\x18\x0f\xe3\x05\xb6\x90\xde\x98"
-DATA ·d+10168(SB)/8,$"\x1c\xfb\xd4\x43\x88\x25\x07\xca"
-DATA ·d+10176(SB)/8,$"\xb0\x8e\xef\xab\xbd\xff\x00\x2f"
-DATA ·d+10184(SB)/8,$"\xd4\xb9\x64\xf1\x94\x79\xde\x25"
-DATA ·d+10192(SB)/8,$"\x59\x74\x56\xb7\xe6\x7a\x25\x85"
-DATA ·d+10200(SB)/8,$"\xac\xd7\x8c\xf0\xde\x2d\xbc\xa0"
-DATA ·d+10208(SB)/8,$"\x29\x68\x9c\x92\x0e\x78\xe0\xe0"
-DATA ·d+10216(SB)/8,$"\x1c\x7f\x9e\x6a\x2f\xf8\x46\xc2"
-DATA ·d+10224(SB)/8,$"\xaa\xad\xc7\xd9\x03\x34\x84\x06"
-DATA ·d+10232(SB)/8,$"\x88\x02\x37\x1e\x40\xcb\x9f\xe9"
-DATA ·d+10240(SB)/8,$"\x5e\x7b\x73\xa7\x45\x60\xb2\xe8"
-DATA ·d+10248(SB)/8,$"\x56\x0b\x1b\x4e\x59\x73\x2d\xe3"
-DATA ·d+10256(SB)/8,$"\x4b\x1c\xe0\x1e\x7d\x00\xc7\xa6"
-DATA ·d+10264(SB)/8,$"\xdc\x03\xd2\xa6\x91\xe0\xd2\x4a"
-DATA ·d+10272(SB)/8,$"\xd9\x32\x59\x5e\x3c\x60\x63\xed"
-DATA ·d+10280(SB)/8,$"\x12\xf9\x32\x92\x78\xc0\x2a\xe4"
-DATA ·d+10288(SB)/8,$"\xbb\x1e\xd9\x5a\xce\x3c\x45\x64"
-DATA ·d+10296(SB)/8,$"\xd5\x5a\x3f\x0e\xee\xfd\x7b\x6d"
-DATA ·d+10304(SB)/8,$"\xa9\xd1\xfd\x91\x3b\xde\x95\x5d"
-DATA ·d+10312(SB)/8,$"\xf5\xb5\xbf\x1d\xf4\x3a\x1b\xab"
-DATA ·d+10320(SB)/8,$"\x3d\x06\xcf\x31\xdd\xc4\xa8\xe3"
-DATA ·d+10328(SB)/8,$"\x87\x96\x46\x51\x83\xf9\x81\x54"
-DATA ·d+10336(SB)/8,$"\x0b\x78\x54\x12\x3e\xd1\x6d\xff"
-DATA ·d+10344(SB)/8,$"\x00\x7f\x17\xfc\x6a\x7d\x03\x47"
-DATA ·d+10352(SB)/8,$"\x89\x9e\x44\xbf\xb4\xb3\x89\xdf"
-DATA ·d+10360(SB)/8,$"\x38\xf3\xaf\xd9\x99\x3b\x9c\xaf"
-DATA ·d+10368(SB)/8,$"\x23\x83\xd7\x15\xd6\x0d\x0b\x03"
-DATA ·d+10376(SB)/8,$"\x0b\x15\x96\x3b\x6d\x73\x8f\xc3"
-DATA ·d+10384(SB)/8,$"\x9a\xeb\xa1\x2a\xb5\xa3\xcf\x1a"
-DATA ·d+10392(SB)/8,$"\x71\x5e\xb7\xbf\xe8\x73\xd7\xa9"
-DATA ·d+10400(SB)/8,$"\x4a\x94\xb9\x25\x56\x57\xf5\x5f"
-DATA ·d+10408(SB)/8,$"\xe6\xcf\xff\xd2\xfe\x7e\xed\xbc"
-DATA ·d+10416(SB)/8,$"\x5b\x34\xd6\xa8\x9a\x9d\xa6\xa0"
-DATA ·d+10424(SB)/8,$"\x77\x83\xb2\x26\xc1\xdf\xc7\x04"
-DATA ·d+10432(SB)/8,$"\x6e\x60\xdd\x7b\xe3\xf3\xae\x8a"
-DATA ·d+10440(SB)/8,$"\x2b\x3d\x6b\x51\xb4\x8e\x3b\x68"
-DATA ·d+10448(SB)/8,$"\x23\xd3\xce\x14\x46\xf7\x0a\x82"
-DATA ·d+10456(SB)/8,$"\x44\x04\x75\x0c\x1c\x64\x9e\xe4"
-DATA ·d+10464(SB)/8,$"\x8c\x7b\x53\xae\x74\x4b\x6b\xdb"
-DATA ·d+10472(SB)/8,$"\x3d\xf6\x9a\xac\x76\xe8\x01\x69"
-DATA ·d+10480(SB)/8,$"\x0b\x3a\xc6\xf9\xef\xf3\x00\x4b"
-DATA ·d+10488(SB)/8,$"\x7b\x02\x6b\x17\x4e\xf0\xb7\x84"
-DATA ·d+10496(SB)/8,$"\x10\x9d\x42\xfa\x59\xef\x64\x8c"
-DATA ·d+10504(SB)/8,$"\x83\x8b\xa9\x98\x96\x07\xab\x28"
-DATA ·d+10512(SB)/8,$"\x27\x00\x0f\x71\x5f\xe9\x37\xb2"
-DATA ·d+10520(SB)/8,$"\xab\x78\xc2\x4f\x9b\xce\xf6\xf9"
-DATA ·d+10528(SB)/8,$"\xe9\x76\x7f\x94\x92\xaf\x86\x71"
-DATA ·d+10536(SB)/8,$"\x75\x21\x1e\x57\x7d\xb9\x5b\xd7"
-DATA ·d+10544(SB)/8,$"\xe6\xd2\x2e\xcf\xe2\xdb\x4b\x38"
-DATA ·d+10552(SB)/8,$"\xe5\xb5\xb8\xd4\x09\x11\x81\xf6"
-DATA ·d+10560(SB)/8,$"\x8b\x84\x0a\xbb\xc8\xc8\x55\x8c"
-DATA ·d+10568(SB)/8,$"\x70\x49\x07\xa9\xe0\x63\x9e\x6b"
-DATA ·d+10576(SB)/8,$"\x97\xd2\xb5\x0b\x1d\x65\x4e\x6e"
-DATA ·d+10584(SB)/8,$"\x27\x21\x73\x95\x8a\xd9\xb7\x38"
-DATA ·d+10592(SB)/8,$"\x3d\x4a\x9c\x63\x3e\x84\x9a\xe9"
-DATA ·d+10600(SB)/8,$"\x2e\xfc\x4f\xe1\x6d\x12\x57\x87"
-DATA ·d+10608(SB)/8,$"\xf7\x3e\x69\x62\x22\x68\xa3\x69"
-DATA ·d+10616(SB)/8,$"\x58\x26\x3a\x96\x39\x00\xe7\xd3"
-DATA ·d+10624(SB)/8,$"\xf3\xa9\xe3\xba\x99\xe3\xfe\xd9"
-DATA ·d+10632(SB)/8,$"\xd2\x5e\x4f\xde\xae\xd6\x0c\xc6"
-DATA ·d+10640(SB)/8,$"\x18\xcb\x1e\xbf\x29\x56\xc8\x3e"
-DATA ·d+10648(SB)/8,$"\xd5\x35\x3d\xfa\x9e\xf5\x5e\x64"
-DATA ·d+10656(SB)/8,$"\xb7\x5d\xbf\x1f\xd0\xba\x6a\x30"
-DATA ·d+10664(SB)/8,$"\x85\xe3\x49\xc7\x9b\x66\xec\xb5"
-DATA ·d+10672(SB)/8,$"\xfb\xbf\xe1\x8a\x30\xd9\x5d\x69"
-DATA ·d+10680(SB)/8,$"\xf6\x21\x62\x4f\x2e\x03\x73\xe6"
-DATA ·d+10688(SB)/8,$"\xc7\xe6\xb0\x12\xc9\xc6\x46\xfc"
-DATA ·d+10696(SB)/8,$"\x67\x71\xc7\x27\x9c\x0a\xb5\x36"
-DATA ·d+10704(SB)/8,$"\xb1\x06\x98\xce\xd3\x49\x6d\x24"
-DATA ·d+10712(SB)/8,$"\xb3\x83\x88\xa2\x85\xa4\xc6\x47"
-DATA ·d+10720(SB)/8,$"\x24\x8d\xd8\x1c\xd6\x78\x7d\x5e"
-DATA ·d+10728(SB)/8,$"\x21\xf6\xcd\x51\xe5\xb9\x88\x13"
-DATA ·d+10736(SB)/8,$"\xfb\xab\x54\x3b\x10\x7a\x64\x00"
-DATA ·d+10744(SB)/8,$"\x3f\x4f\xa9\xa8\x7c\x43\xab\x78"
-DATA ·d+10752(SB)/8,$"\x9a\xe6\xc0\x5a\x68\x1a\x67\xd8"
-DATA ·d+10760(SB)/8,$"\xc4\xb1\x95\x0f\x24\xfe\x59\x6d"
-DATA ·d+10768(SB)/8,$"\xdd\xd4\x29\xc9\x38\xee\x48\xc7"
-DATA ·d+10776(SB)/8,$"\xa5\x12\xc5\x46\x95\x37\x28\x5e"
-DATA ·d+10784(SB)/8,$"\xeb\x65\x66\xdf\xf9\x17\x0a\x12"
-DATA ·d+10792(SB)/8,$"\xab\x56\x31\x9d\x9a\x7b\xbb\xa4"
-DATA ·d+10800(SB)/8,$"\xbf\x1d\xfe\x45\xf9\xda\x7d\x6b"
-DATA ·d+10808(SB)/8,$"\x4a\x5b\x66\x9e\xee\xd1\xc8\x06"
-DATA ·d+10816(SB)/8,$"\x19\x6c\x8a\x81\x90\x4f\x0e\x5f"
-DATA ·d+10824(SB)/8,$"\x3b\x7d\xf0\x4e\x07\x4a\xa1\x26"
-DATA ·d+10832(SB)/8,$"\xa5\x65\xa8\xed\x6d\x41\xa3\xb8"
-DATA ·d+10840(SB)/8,$"\x91\x63\x65\x12\xce\xcd\x3b\x79"
-DATA ·d+10848(SB)/8,$"\x8b\xd7\x09\x18\x41\x92\x71\xb5"
-DATA ·d+10856(SB)/8,$"\xb9\xaf\x3f\xb5\xf0\xe7\x89\xac"
-DATA ·d+10864(SB)/8,$"\x3f\x7f\x7f\xad\x5b\xd9\x8e\x19"
-DATA ·d+10872(SB)/8,$"\xa3\x05\xdc\xa6\x78\xf9\xb7\x74"
-DATA ·d+10880(SB)/8,$"\x3f\x52\x6b\xaf\xb1\x6d\x1c\x5c"
-DATA ·d+10888(SB)/8,$"\x1b\x8d\x43\x56\x8e\xee\x5f\x2c"
-DATA ·d+10896(SB)/8,$"\x82\x91\x4b\x1c\x4a\x71\xc0\xc8"
-DATA ·d+10904(SB)/8,$"\x1f\xca\xbc\x7a\x18\x89\x55\x97"
-DATA ·d+10912(SB)/8,$"\x35\x48\xf2\x37\xbd\xda\xfc\x8f"
-DATA ·d+10920(SB)/8,$"\x5e\xbe\x16\x14\x95\xa1\x53\x9d"
-DATA ·d+10928(SB)/8,$"\x2d\xac\x9f\xe7\x6f\xd4\xd7\x97"
-DATA ·d+10936(SB)/8,$"\xc4\x57\x96\xf1\xa4\x5a\x32\x4c"
-DATA ·d+10944(SB)/8,$"\xa7\x39\x96\x39\x62\xda\x77\x81"
-DATA ·d+10952(SB)/8,$"\x9c\x6d\x2d\x9d\xc7\xb0\x6f\xd6"
-DATA ·d+10960(SB)/8,$"\xb9\x9f\xb5\x1d\x62\xf1\xaf\x5e"
-DATA ·d+10968(SB)/8,$"\xf6\xea\x39\xca\x92\xf6\x86\x32"
-DATA ·d+10976(SB)/8,$"\x92\xaa\x01\xc8\xda\xa9\xb7\xaf"
-DATA ·d+10984(SB)/8,$"\x3d\x71\xdf\x35\x16\xab\xaf\x69"
-DATA ·d+10992(SB)/8,$"\x71\x4a\x86\x0f\x27\xcd\x50\x7e"
-DATA ·d+11000(SB)/8,$"\x6b\x44\xdc\x57\x18\xc3\x1c\x7c"
-DATA ·d+11008(SB)/8,$"\x83\xbf\x62\x4f\x7a\xc7\x93\xc7"
-DATA ·d+11016(SB)/8,$"\x16\xbf\x68\x79\x62\x12\xc6\x5c"
-DATA ·d+11024(SB)/8,$"\x80\x6e\x65\x20\xfc\xbd\xf7\x26"
-DATA ·d+11032(SB)/8,$"\x08\xff\x00\xbe\x45\x65\x8c\xcc"
-DATA ·d+11040(SB)/8,$"\x24\xe5\xef\xd6\xba\x5d\x3f\xcb"
-DATA ·d+11048(SB)/8,$"\x5d\x3e\x66\xf8\x2c\xb9\xa8\xb9"
-DATA ·d+11056(SB)/8,$"\x53\xa3\x66\xfa\xf5\xf9\xe8\xee"
-DATA ·d+11064(SB)/8,$"\xbd\x0f\x4b\x8d\xaf\xad\x14\x4c"
-DATA ·d+11072(SB)/8,$"\xf6\xa1\x8c\x88\xa2\x1c\x37\x99"
-DATA ·d+11080(SB)/8,$"\x29\x3f\xc3\x96\x00\x00\x3f\xfd"
-DATA ·d+11088(SB)/8,$"\x55\x87\x71\x37\x98\xdf\xf1\x30"
-DATA ·d+11096(SB)/8,$"\x82\x30\x91\x64\x5d\x44\x24\x66"
-DATA ·d+11104(SB)/8,$"\x74\x7e\xe7\x69\x60\x18\xe7\x23"
-DATA ·d+11112(SB)/8,$"\xb6\x2b\x3a\xc7\xc5\xd1\xdc\x5b"
-DATA ·d+11120(SB)/8,$"\x4a\xd0\xeb\x4a\x80\x9c\x2c\x61"
-DATA ·d+11128(SB)/8,$"\x5a\x3c\x2e\x7a\x29\x60\xa3\x8f"
-DATA ·d+11136(SB)/8,$"\x61\x5d\x01\xbe\x7b\x4b\x76\x96"
-DATA ·d+11144(SB)/8,$"\xc6\x56\xf3\x26\x1f\x34\xd2\x21"
-DATA ·d+11152(SB)/8,$"\xc4\x9e\xa3\x70\xe3\x1d\x39\xce"
-DATA ·d+11160(SB)/8,$"\x6b\xba\x15\x61\x59\x27\xcf\xa7"
-DATA ·d+11168(SB)/8,$"\x9d\x9f\xe5\xfe\x68\xf3\xe7\x42"
-DATA ·d+11176(SB)/8,$"\xa5\x29\xbe\x68\xeb\xe5\xcc\xbf"
-DATA ·d+11184(SB)/8,$"\x33\x9e\x6d\x1f\xc3\xce\xef\x7b"
-DATA ·d+11192(SB)/8,$"\x0d\xd5\xc0\x84\x7d\xf8\x6f\x88"
-DATA ·d+11200(SB)/8,$"\x8d\x37\x9e\x9c\xaf\x38\xf6\x26"
-DATA ·d+11208(SB)/8,$"\xb4\xcc\xfa\x05\xba\x08\x2d\xae"
-DATA ·d+11216(SB)/8,$"\x55\x95\xa3\x08\xed\x0a\x48\x62"
-DATA ·d+11224(SB)/8,$"\x3c\xf3\xc2\x9f\x98\x03\xe9\x8a"
-DATA ·d+11232(SB)/8,$"\xc3\x36\xfa\xe6\xb5\x70\x3e\xd7"
-DATA ·d+11240(SB)/8,$"\x1a\x3a\xc5\xca\x47\x04\xff\x00"
-DATA ·d+11248(SB)/8,$"\x2f\xae\x59\x70\x49\xfc\x4d\x4d"
-DATA ·d+11256(SB)/8,$"\x77\x7c\xfa\x61\x2f\xa8\x24\x30"
-DATA ·d+11264(SB)/8,$"\x3e\xdc\xc4\x48\xda\xac\x4f\x1c"
-DATA ·d+11272(SB)/8,$"\x05\x53\xcf\xd4\x57\x24\x15\x38"
-DATA ·d+11280(SB)/8,$"\x4a\x4e\x31\x49\x3e\xad\x3f\xf3"
-DATA ·d+11288(SB)/8,$"\xd0\xef\xf6\x72\x93\x8a\x73\x6d"
-DATA ·d+11296(SB)/8,$"\xf6\x4d\x7f\x91\xbb\x73\x79\xe1"
-DATA ·d+11304(SB)/8,$"\x28\x59\x63\xba\xd4\x2d\xed\xc2"
-DATA ·d+11312(SB)/8,$"\x20\x68\x96\x79\x82\xe5\x8f\xac"
-DATA ·d+11320(SB)/8,$"\x78\x0e\x17\xd3\x9a\xe2\x1a\xf5"
-DATA ·d+11328(SB)/8,$"\x35\x1b\xb6\x78\x1a\xe1\xd1\x32"
-DATA ·d+11336(SB)/8,$"\x4b\x40\x8c\x10\xa8\x3c\x15\x0c"
-DATA ·d+11344(SB)/8,$"\x01\x7c\xf5\x07\x24\x53\xae\xcc"
-DATA ·d+11352(SB)/8,$"\x22\x3f\x36\xe1\x60\x7c\x95\xdc"
-DATA ·d+11360(SB)/8,$"\xce\x4c\xc5\x80\xc0\x1f\x33\xfc"
-DATA ·d+11368(SB)/8,$"\xdc\x7a\xe7\xf0\xad\x7b\x7d\x4e"
-DATA ·d+11376(SB)/8,$"\xe6\x1b\x01\x1d\xa5\xc5\xbc\x61"
-DATA ·d+11384(SB)/8,$"\x18\x94\x20\x81\x22\xe4\x60\x98"
-DATA ·d+11392(SB)/8,$"\xcf\xde\xfc\x31\x4e\x78\xda\xd3"
-DATA ·d+11400(SB)/8,$"\x97\x2c\xda\xb2\xd7\x45\xaf\xe2"
-DATA ·d+11408(SB)/8,$"\xec\x6d\x4b\x0f\x0a\x71\xbd\x3b"
-DATA ·d+11416(SB)/8,$"\xb6\xfb\xec\xbf\x03\x3a\x7b\x2d"
-DATA ·d+11424(SB)/8,$"\x48\xc0\x67\x85\x19\x80\xc6\xcf"
-DATA ·d+11432(SB)/8,$"\x32\x74\x84\xfa\xf4\x00\x90\x7d"
-DATA ·d+11440(SB)/8,$"\x40\xab\x90\xc9\x3d\xac\x48\xd7"
-DATA ·d+11448(SB)/8,$"\xf6\xf1\xb1\x64\x6c\x47\x26\xd3"
-DATA ·d+11456(SB)/8,$"\x80\x3a\xed\x24\x6e\x2d\xe9\xc5"
-DATA ·d+11464(SB)/8,$"\x66\xa9\xd2\xae\x6e\xbf\xe3\xfa"
-DATA ·d+11472(SB)/8,$"\xe0\xcc\x99\xf9\xa4\x61\xfb\xd2"
-DATA ·d+11480(SB)/8,$"\xdc\x9c\xb6\x43\x7e\x75\xb7\xe5"
-DATA ·d+11488(SB)/8,$"\xc8\xf2\x6c\xb4\x84\x5d\x1c\x7c"
-DATA ·d+11496(SB)/8,$"\xce\xe4\xb0\x4c\xff\x00\x77\x73"
-DATA ·d+11504(SB)/8,$"\xec\xcf\xe7\x4e\x94\xf7\xaa\x9f"
-DATA ·d+11512(SB)/8,$"\x92\xd6\xff\x00\x82\x5a\x9a\x55"
-DATA ·d+11520(SB)/8,$"\x6b\x95\x41\xaf\xc1\xaf\xc5\xb2"
-DATA ·d+11528(SB)/8,$"\xc4\x03\x42\x96\xd8\xb5\x9e\x99"
-DATA ·d+11536(SB)/8,$"\x36\x17\x3e\x60\xf2\xce\x01\xea"
-DATA ·d+11544(SB)/8,$"\x48\xdd\xb7\x8f\xa9\xae\x6c\x6a"
-DATA ·d+11552(SB)/8,$"\xe3\x4d\x99\x6f\x24\xd3\xed\x96"
-DATA ·d+11560(SB)/8,$"\x05\x24\x4a\x0c\xdf\xc4\xc3\xe5"
-DATA ·d+11568(SB)/8,$"\x1b\x76\x9c\x1f\x5c\x71\xef\x51"
-DATA ·d+11576(SB)/8,$"\xdf\xeb\x92\x5d\xdc\x25\x85\xbb"
-DATA ·d+11584(SB)/8,$"\x4d\x03\xc2\xe5\x16\x08\xd4\xb9"
-DATA ·d+11592(SB)/8,$"\xca\xfd\xe3\x8c\x85\xe7\xda\xae"
-DATA ·d+11600(SB)/8,$"\xcb\xaa\x69\x4d\x67\xbb\x29\x23"
-DATA ·d+11608(SB)/8,$"\xbf\xca\xec\xce\xa8\xc5\x8f\x1c"
-DATA ·d+11616(SB)/8,$"\xb6\x59\xb2\x3d\x28\x96\x35\x4f"
-DATA ·d+11624(SB)/8,$"\xf8\x75\x12\xe5\xf2\x4a\xff\x00"
-DATA ·d+11632(SB)/8,$"\x83\x2a\x9e\x12\x50\xb3\xab\x06"
-DATA ·d+11640(SB)/8,$"\xf9\xbc\xdf\xfc\x31\xa9\xa3\x6b"
-DATA ·d+11648(SB)/8,$"\x90\x4f\x6d\x24\xf6\xd9\x80\xbc"
-DATA ·d+11656(SB)/8,$"\xc8\x43\xa2\x33\x8c\x67\xe6\x19"
-DATA ·d+11664(SB)/8,$"\xcf\x5f\x7c\x62\xa0\xbb\xd5\xb4"
-DATA ·d+11672(SB)/8,$"\xb8\x67\x68\x84\x84\x20\x76\x77"
-DATA ·d+11680(SB)/8,$"\x5b\x99\x01\x50\x49\xce\xee\x08"
-DATA ·d+11688(SB)/8,$"\x19\xeb\xd4\x56\x6e\xa8\xff\x00"
-DATA ·d+11696(SB)/8,$"\x63\x84\x24\xd8\x4c\x28\xc2\x45"
-DATA ·d+11704(SB)/8,$"\x74\xc6\x43\x9f\x4d\xa3\x80\x7b"
-DATA ·d+11712(SB)/8,$"\xe6\xb9\x68\xf5\x7b\x6b\x77\x74"
-DATA ·d+11720(SB)/8,$"\x5b\x19\xee\x03\xfc\xa5\x1d\xcb"
-DATA ·d+11728(SB)/8,$"\x21\xe7\x39\xe3\xad\x15\xb3\x0a"
-DATA ·d+11736(SB)/8,$"\x94\xd2\x84\x9a\xbf\x77\x73\x4c"
-DATA ·d+11744(SB)/8,$"\x3e\x5d\x09\xca\x55\x14\x5e\xbd"
-DATA ·d+11752(SB)/8,$"\x34\xfc\xdb\x37\xb5\xdf\x11\x5a"
-DATA ·d+11760(SB)/8,$"\x5b\xc0\x2e\xac\x98\xea\x48\x54"
-DATA ·d+11768(SB)/8,$"\x6f\x82\x02\xab\x2a\xfb\x84\x74"
-DATA ·d+11776(SB)/8,$"\x03\x68\xee\x41\xac\xdb\xbf\x11"
-DATA ·d+11784(SB)/8,$"\xea\x7a\x6d\xba\xcb\x67\xe1\xad"
-DATA ·d+11792(SB)/8,$"\xed\x22\x70\xce\x55\x99\x41\x1d"
-DATA ·d+11800(SB)/8,$"\x4a\xa7\x1f\xad\x66\xff\x00\x6a"
-DATA ·d+11808(SB)/8,$"\x6a\x91\x86\x13\xdb\x32\xc6\xd2"
-DATA ·d+11816(SB)/8,$"\x33\x2a\x77\xda\x7f\x80\x11\x92"
-DATA ·d+11824(SB)/8,$"\x14\x7a\x64\x55\xab\x3d\x5d\x07"
-DATA ·d+11832(SB)/8,$"\x94\x90\x69\xf1\x48\x23\xcb\x4d"
-DATA ·d+11840(SB)/8,$"\xe6\x70\x5b\x9f\x72\x71\x5e\x47"
-DATA ·d+11848(SB)/8,$"\xb6\xaf\x52\x52\x7e\xd9\xc5\xbd"
-DATA ·d+11856(SB)/8,$"\xac\xb6\xf3\xd5\x36\x7a\xdf\x53"
-DATA ·d+11864(SB)/8,$"\xa5\x08\xc5\x7b\x2e\x6b\x7f\x7b"
-DATA ·d+11872(SB)/8,$"\x7f\xb9\xa4\x5b\x1e\x28\xd7\xe4"
-DATA ·d+11880(SB)/8,$"\x55\x16\x92\xc4\x64\xd8\xac\xa9"
-DATA ·d+11888(SB)/8,$"\x28\xf2\xa2\x3f\xec\x92\x4b\x1f"
-DATA ·d+11896(SB)/8,$"\x62\x31\x56\x6d\x2e\xb5\x29\xef"
-DATA ·d+11904(SB)/8,$"\x7e\xd5\xab\x35\xad\xb9\x05\x8e"
-DATA ·d+11912(SB)/8,$"\xcb\x58\xd5\xe1\x05\xba\xf0\xe3"
-DATA ·d+11920(SB)/8,$"\x77\x3e\xc7\xf0\xad\x2b\xbb\x5b"
-DATA ·d+11928(SB)/8,$"\x24\x02\xf9\x34\xf4\x8d\x5f\x00"
-DATA ·d+11936(SB)/8,$"\x34\x8c\x86\x30\xdd\x4f\xdd\xc9"
-DATA ·d+11944(SB)/8,$"\xc6\x38\xe9\x5c\xfc\x10\xeb\x50"
-DATA ·d+11952(SB)/8,$"\xb8\xbe\x8a\xee\xd5\x88\x73\xe5"
-DATA ·d+11960(SB)/8,$"\x22\xa6\x42\x7d\x33\xc2\x8a\xe9"
-DATA ·d+11968(SB)/8,$"\xab\x0a\xd4\xa4\x9d\x4a\xb2\x9f"
-DATA ·d+11976(SB)/8,$"\xce\xcb\xd7\xa3\xfb\x8c\x29\xba"
-DATA ·d+11984(SB)/8,$"\x33\x83\x54\xe0\xa1\x7f\x47\xfe"
-DATA ·d+11992(SB)/8,$"\x68\xb3\x78\x90\x5b\x5c\x31\x99"
-DATA ·d+12000(SB)/8,$"\xae\x66\x25\xb0\xb2\xac\x63\x6f"
-DATA ·d+12008(SB)/8,$"\x3f\xec\xf1\xfa\x0a\xd9\xb3\x89"
-DATA ·d+12016(SB)/8,$"\x26\x53\x04\xb7\xb6\xcb\xbd\x30"
-DATA ·d+12024(SB)/8,$"\x02\x43\xb4\x86\xe3\x82\x5f\xa1"
-DATA ·d+12032(SB)/8,$"\xf4\xac\xdd\x63\x5b\x88\xda\x1b"
-DATA ·d+12040(SB)/8,$"\x2b\xab\xaf\xde\x3e\x0b\xa5\xa2"
-DATA ·d+12048(SB)/8,$"\x0c\x29\xf5\x2c\x47\x07\xd7\x69"
-DATA ·d+12056(SB)/8,$"\xae\x77\x4d\xf1\x17\xd8\xe4\x2b"
-DATA ·d+12064(SB)/8,$"\x2d\xe3\x95\xd8\x46\xd7\x5d\xe0"
-DATA ·d+12072(SB)/8,$"\x92\x7a\x0f\x97\x23\x3e\xa6\xb5"
-DATA ·d+12080(SB)/8,$"\x75\xa8\xd3\xac\xa2\xe4\xda\x7e"
-DATA ·d+12088(SB)/8,$"\x7f\xf0\x7f\x36\x4c\x70\xf5\x6a"
-DATA ·d+12096(SB)/8,$"\xd2\xba\x5a\xaf\x2f\xf8\x07\x5b"
-DATA ·d+12104(SB)/8,$"\x79\x6f\x0d\x82\x4b\x19\x30\xc8"
-DATA ·d+12112(SB)/8,$"\x43\x04\x06\x0f\x94\xe4\x9c\xf1"
-DATA ·d+12120(SB)/8,$"\x94\xc6\x4f\xd6\x92\x38\x35\xbb"
-DATA ·d+12128(SB)/8,$"\x77\x4b\xe9\x72\x88\x92\x66\x15"
-DATA ·d+12136(SB)/8,$"\x6f\x2d\x5b\xdb\x25\x30\x4e\x7d"
-DATA ·d+12144(SB)/8,$"\x30\x6a\x80\xd6\x03\xdc\x25\xda"
-DATA ·d+12152(SB)/8,$"\xb4\x1b\x30\x15\x94\x33\x2b\x11"
-DATA ·d+12160(SB)/8,$"\xf4\x20\x0f\xe9\x58\xcd\xad\x1b"
-DATA ·d+12168(SB)/8,$"\x8b\xa3\x25\xd4\x2b\x3b\x46\x4f"
-DATA ·d+12176(SB)/8,$"\x96\xca\x4e\xe8\xfd\x36\x85\x05"
-DATA ·d+12184(SB)/8,$"\x73\xfe\xd6\x2a\x6a\x3a\x69\xf3"
-DATA ·d+12192(SB)/8,$"\x29\xbf\x2e\xdf\x3d\xee\x54\x28"
-DATA ·d+12200(SB)/8,$"\xd4\x6b\x95\xc7\xd7\xfa\xd2\xc7"
-DATA ·d+12208(SB)/8,$"\x5e\x3c\x45\xad\xcb\x0b\xac\x10"
-DATA ·d+12216(SB)/8,$"\x47\x01\x24\x8c\x48\xb8\x24\xfa"
-DATA ·d+12224(SB)/8,$"\xfc\xce\x0e\x45\x49\x63\x79\xab"
-DATA ·d+12232(SB)/8,$"\x5a\xdc\xcf\x16\x9f\xe7\x4b\x14"
-DATA ·d+12240(SB)/8,$"\x80\x48\x05\xd4\xc6\x4f\x28\x60"
-DATA ·d+12248(SB)/8,$"\x02\x03\x93\xea\x33\x9c\x0f\x4e"
-DATA ·d+12256(SB)/8,$"\x95\xc8\x42\x86\xd2\x5f\xb5\xcb"
-DATA ·d+12264(SB)/8,$"\x02\x45\xfc\x71\x9b\xb2\x73\xf4"
-DATA ·d+12272(SB)/8,$"\xc1\x3d\xff\x00\x0a\xb3\x3e\xb9"
-DATA ·d+12280(SB)/8,$"\x65\x3b\x34\xd7\x76\xb6\xc2\x26"
-DATA ·d+12288(SB)/8,$"\x21\x0b\x6f\x60\x1b\xe9\xcb\x67"
-DATA ·d+12296(SB)/8,$"\xfe\xf9\xe9\x5a\x2c\x6c\xd5\xa7"
-DATA ·d+12304(SB)/8,$"\x3a\x8e\xeb\xf2\xfb\x89\x78\x38"
-DATA ·d+12312(SB)/8,$"\x49\x4a\x10\xa6\xac\xf7\xf5\xfb"
-DATA ·d+12320(SB)/8,$"\xff\x00\x52\x2d\x4a\xf6\x5b\x5c"
-DATA ·d+12328(SB)/8,$"\x9b\x81\x71\x20\xdc\x70\x61\x78"
-DATA ·d+12336(SB)/8,$"\xe4\x0a\x07\x6c\x96\x18\xfc\x05"
-DATA ·d+12344(SB)/8,$"\x41\x6f\xaa\x4c\xf2\x79\x3a\x7a"
-DATA ·d+12352(SB)/8,$"\x08\xe4\x6f\x95\x64\xba\x0c\xec"
-DATA ·d+12360(SB)/8,$"\x38\xeb\xe5\x81\x81\xff\x00\x02"
-DATA ·d+12368(SB)/8,$"\x6f\xc2\xb5\x74\xad\x57\xc1\xf6"
-DATA ·d+12376(SB)/8,$"\x3a\x6c\x17\xba\x89\x79\xee\xbe"
-DATA ·d+12384(SB)/8,$"\xdb\x32\xcf\x67\x6f\x14\x50\x5b"
-DATA ·d+12392(SB)/8,$"\x8b\x6f\x21\x84\x0f\x1d\xc3\x6f"
-DATA ·d+12400(SB)/8,$"\x94\xcc\x27\x2a\x59\x4c\x5b\x0c"
-DATA ·d+12408(SB)/8,$"\x63\x1b\xb2\x6b\x87\x5d\x46\x27"
-DATA ·d+12416(SB)/8,$"\x73\xcb\xca\x58\xee\x6d\xfd\x0b"
-DATA ·d+12424(SB)/8,$"\x9e\xa7\x80\x70\x3d\xab\xc7\x9e"
-DATA ·d+12432(SB)/8,$"\x3a\xab\x95\xd4\xec\xbb\x7f\xc1"
-DATA ·d+12440(SB)/8,$"\x5a\xff\x00\x5d\x4f\x66\x39\x7c"
-DATA ·d+12448(SB)/8,$"\x14\x6d\x6b\xff\x00\x5d\xb6\x3d"
-DATA ·d+12456(SB)/8,$"\x1c\xe9\x56\x30\xdb\xee\x96\xfc"
-DATA ·d+12464(SB)/8,$"\x19\xf1\xb9\xfe\xd3\x86\x21\xcf"
-DATA ·d+12472(SB)/8,$"\x4c\x6e\xfb\xab\x8e\x80\x0a\xe5"
-DATA ·d+12480(SB)/8,$"\xf5\x19\xaf\xed\x26\x55\x6b\x86"
-DATA ·d+12488(SB)/8,$"\x9f\x68\xc8\x4b\x78\x17\xcb\x3b"
-DATA ·d+12496(SB)/8,$"\x4f\x23\x7b\x85\x53\xf9\x9c\xfa"
-DATA ·d+12504(SB)/8,$"\x55\x63\xff\x00\x09\x1d\xec\x0d"
-DATA ·d+12512(SB)/8,$"\x36\x9b\x05\xbc\x08\x06\x1e\x46"
-DATA ·d+12520(SB)/8,$"\x90\x63\x03\xaf\x0c\x07\x4e\xf8"
-DATA ·d+12528(SB)/8,$"\x19\xac\x69\x63\xd4\x6d\xae\x56"
-DATA ·d+12536(SB)/8,$"\xf6\xee\x58\x24\x6c\x02\xab\xbf"
-DATA ·d+12544(SB)/8,$"\x7a\x8c\x7a\x28\xce\x3f\x2a\xac"
-DATA ·d+12552(SB)/8,$"\x56\x32\x52\x8f\x25\x28\x72\xae"
-DATA ·d+12560(SB)/8,$"\xfa\xfe\xbb\x91\x83\xc2\x72\xcb"
-DATA ·d+12568(SB)/8,$"\x9a\xa4\xd4\x9f\x6d\x3f\xa4\x74"
-DATA ·d+12576(SB)/8,$"\xd0\x6a\x5a\x7b\xcd\x6f\x32\xce"
-DATA ·d+12584(SB)/8,$"\x10\x6d\xdf\x28\x84\x3a\xbe\xde"
-DATA ·d+12592(SB)/8,$"\xe1\xb6\x01\xf3\x0f\x4c\x0c\x54"
-DATA ·d+12600(SB)/8,$"\xc9\xa8\xe9\x97\x17\xcf\x8b\x68"
-DATA ·d+12608(SB)/8,$"\x5e\x05\xc2\xac\x9a\x94\x9b\x64"
-DATA ·d+12616(SB)/8,$"\x3d\xf8\x42\xa7\x82\x3d\x4e\x6b"
-DATA ·d+12624(SB)/8,$"\x8a\xb9\xf1\x16\xa4\xe5\xa3\x8a"
-DATA ·d+12632(SB)/8,$"\x45\x8f\x7b\x86\x62\x22\x00\x12"
-DATA ·d+12640(SB)/8,$"\xbc\x8f\xf3\xde\xaf\xc3\xa8\xf8"
-DATA ·d+12648(SB)/8,$"\x93\x52\x85\xc3\xa4\x32\xa6\x36"
-DATA ·d+12656(SB)/8,$"\xee\x8d\x40\x65\xc7\x4c\x67\x38"
-DATA ·d+12664(SB)/8,$"\xac\x61\x8b\x6f\xdd\xbd\xff\x00"
-DATA ·d+12672(SB)/8,$"\xed\xd5\xaf\xe2\xff\x00\x23\xaa"
-DATA ·d+12680(SB)/8,$"\x79\x7f\xda\x7a\x69\xfc\xcf\xfc"
-DATA ·d+12688(SB)/8,$"\x91\xd8\x41\x79\xa2\xcb\x34\xb3"
-DATA ·d+12696(SB)/8,$"\xce\x8a\x04\xb2\x16\x32\xc2\xc0"
-DATA ·d+12704(SB)/8,$"\x33\x10\x30\x00\x79\x1b\x24\x0c"
-DATA ·d+12712(SB)/8,$"\x76\x18\xc5\x73\x13\xf8\x92\xe2"
-DATA ·d+12720(SB)/8,$"\x2b\xd3\x06\x9f\x77\x32\x44\x53"
-DATA ·d+12728(SB)/8,$"\xe6\x11\x32\x48\xdb\xfd\x43\xca"
-DATA ·d+12736(SB)/8,$"\xa0\x71\xc7\x00\x63\xde\xaa\x2d"
-DATA ·d+12744(SB)/8,$"\xae\x9d\x3a\x05\xba\xb6\x9b\xcd"
-DATA ·d+12752(SB)/8,$"\x3f\x21\x95\xa5\x1b\x41\xf6\x00"
-DATA ·d+12760(SB)/8,$"\x66\xad\xaf\x87\x34\x67\xc2\x15"
-DATA ·d+12768(SB)/8,$"\x9b\x76\x39\xc9\x01\x41\xf5\xce"
-DATA ·d+12776(SB)/8,$"\x49\xaa\xf6\x55\x67\x15\xc8\xd4"
-DATA ·d+12784(SB)/8,$"\x6d\xdb\x47\xfa\x11\x15\x42\x0d"
-DATA ·d+12792(SB)/8,$"\xb9\xdd\xfa\xd9\xa3\xa7\xd3\x6e"
-DATA ·d+12800(SB)/8,$"\xad\x66\xb7\x59\xfc\xc3\x2d\xd2"
-DATA ·d+12808(SB)/8,$"\xa8\x56\x9e\xfe\x2f\x36\x47\x1f"
-DATA ·d+12816(SB)/8,$"\xec\xaa\x6d\x45\xc0\x35\xa2\x96"
-DATA ·d+12824(SB)/8,$"\xf3\xc3\x28\x36\xfb\xa6\x32\x80"
-DATA ·d+12832(SB)/8,$"\xab\x72\x9b\x89\x38\xec\x71\xb7"
-DATA ·d+12840(SB)/8,$"\x81\xe8\x05\x72\x11\x69\x2b\xa7"
-DATA ·d+12848(SB)/8,$"\x48\x66\xb7\xd5\x20\x89\xd3\x95"
-DATA ·d+12856(SB)/8,$"\x88\x9f\xe6\x4e\x45\x21\xf1\x06"
-DATA ·d+12864(SB)/8,$"\xb3\x14\x8c\x2e\x24\x79\x14\x9c"
-DATA ·d+12872(SB)/8,$"\xfe\xe9\x80\x07\x1e\xe3\x18\xfc"
-DATA ·d+12880(SB)/8,$"\xab\xd7\xa1\x88\x85\x38\xaf\x6b"
-DATA ·d+12888(SB)/8,$"\x1b\x3e\xeb\xf5\xdf\x5f\x95\xcf"
-DATA ·d+12896(SB)/8,$"\x3a\xbe\x0e\x55\x24\xdd\x17\x75"
-DATA ·d+12904(SB)/8,$"\xd9\xff\x00\x4b\x6f\xb8\xef\x26"
-DATA ·d+12912(SB)/8,$"\xd0\xa2\x74\xf3\x75\x06\x90\x36"
-DATA ·d+12920(SB)/8,$"\xdc\xb1\x10\x89\x08\x5f\x5c\xb1"
-DATA ·d+12928(SB)/8,$"\xc8\xfc\xab\x9d\xbd\xd2\x34\x7b"
-DATA ·d+12936(SB)/8,$"\x6b\x7f\xb5\xda\x4d\x2d\xcb\x2e"
-DATA ·d+12944(SB)/8,$"\x40\x40\xca\xac\x4f\x1c\x05\x5f"
-DATA ·d+12952(SB)/8,$"\x9b\xbf\x6a\x82\xd3\xc4\x76\x2d"
-DATA ·d+12960(SB)/8,$"\x1a\xbc\x89\x2c\x52\x63\x63\x17"
-DATA ·d+12968(SB)/8,$"\x2c\xe4\x01\xdf\x77\x39\x1e\xa2"
-DATA ·d+12976(SB)/8,$"\xa1\x4b\xaf\x0c\x41\x37\x9f\x6e"
-DATA ·d+12984(SB)/8,$"\xf8\x91\xb9\x2e\x83\xe6\x0d\x9e"
-DATA ·d+12992(SB)/8,$"\x79\x6c\x62\xbb\x6b\xe2\x28\xca"
-DATA ·d+13000(SB)/8,$"\x2a\x54\xed\xaf\x73\x97\x0d\x46"
-DATA ·d+13008(SB)/8,$"\xbc\x24\xd4\xdb\xd3\xb2\x26\xb1"
-DATA ·d+13016(SB)/8,$"\xb0\xb1\xb8\xb7\xfb\x7d\xec\x21"
-DATA ·d+13024(SB)/8,$"\x02\xb7\xcc\xd2\x09\x0c\x87\x1e"
-DATA ·d+13032(SB)/8,$"\x80\xb0\xce\x3e\x95\x8f\x75\xfd"
-DATA ·d+13040(SB)/8,$"\xaf\x73\x28\xb8\xd2\xad\xe3\x8d"
-DATA ·d+13048(SB)/8,$"\x5b\xe5\xdc\xe4\x86\x5c\x13\x81"
-DATA ·d+13056(SB)/8,$"\xe5\xe1\xba\xfd\x45\x6d\xcf\x7f"
-DATA ·d+13064(SB)/8,$"\x7b\xe5\x4c\x34\xb1\x3c\xa8\x57"
-DATA ·d+13072(SB)/8,$"\x68\x79\x25\x48\xd4\x8f\x5e\xe7"
-DATA ·d+13080(SB)/8,$"\x8e\xbd\xbe\xb5\x89\x2f\x9b\xa6"
-DATA ·d+13088(SB)/8,$"\x36\x6f\x24\x37\x2e\xca\xbb\x62"
-DATA ·d+13096(SB)/8,$"\x59\x1d\x4a\xee\x19\x0c\x5b\x1c"
-DATA ·d+13104(SB)/8,$"\x8f\xe7\x5e\x3e\x29\x53\x71\x8c"
-DATA ·d+13112(SB)/8,$"\x13\x6b\xbb\x4b\x7f\xc3\xf2\x3d"
-DATA ·d+13120(SB)/8,$"\x7c\x2c\xa6\x9b\x93\xb3\xbe\xc9"
-DATA ·d+13128(SB)/8,$"\xeb\xa7\xe1\xf8\x97\x21\xd6\xbe"
-DATA ·d+13136(SB)/8,$"\xc0\x0c\x7a\xc4\x7a\x7b\x4d\xc8"
-DATA ·d+13144(SB)/8,$"\xda\x20\xcb\x31\x1d\x3b\x60\x64"
-DATA ·d+13152(SB)/8,$"\xd6\x88\x69\x24\x1e\x61\xf0\xbd"
-DATA ·d+13160(SB)/8,$"\xa1\x2d\xce\x7e\xd0\xa3\xaf\xb0"
-DATA ·d+13168(SB)/8,$"\x15\xcd\xb2\xe8\xd7\xd3\x16\x69"
-DATA ·d+13176(SB)/8,$"\xa3\x53\xb7\xe5\x46\xc8\x39\xc7"
-DATA ·d+13184(SB)/8,$"\x3d\x4e\x0f\xe7\x5a\xeb\x65\x2e"
-DATA ·d+13192(SB)/8,$"\xd1\xb2\xf9\x02\xe3\x81\xe5\xf6"
-DATA ·d+13200(SB)/8,$"\xab\xa3\xed\x1a\x6b\xda\x68\xb6"
-DATA ·d+13208(SB)/8,$"\xb7\x2d\xfe\x77\x4f\xf4\x15\x6a"
-DATA ·d+13216(SB)/8,$"\x70\xba\x7c\x9a\xf5\xf8\x92\xf9"
-DATA ·d+13224(SB)/8,$"\x59\xa3\xff\xd3\xfe\x7f\x75\x7f"
-DATA ·d+13232(SB)/8,$"\x10\xe9\xac\xff\x00\x67\x7f\xb1"
-DATA ·d+13240(SB)/8,$"\x42\x30\x33\x0d\xa4\x62\x79\x01"
-DATA ·d+13248(SB)/8,$"\xee\x77\x17\xc0\xf7\xe6\x92\xd3"
-DATA ·d+13256(SB)/8,$"\xc6\xf3\x69\x6a\x90\xda\xd9\x4a"
-DATA ·d+13264(SB)/8,$"\x62\x0c\x18\x97\x16\xf1\x16\x1d"
-DATA ·d+13272(SB)/8,$"\xfa\x17\x6f\xcc\xd5\x41\xe3\x4f"
-DATA ·d+13280(SB)/8,$"\x0d\x1b\x67\x46\xd3\x52\x17\x2c"
-DATA ·d+13288(SB)/8,$"\x44\x22\x37\x89\x15\x53\xb6\x46"
-DATA ·d+13296(SB)/8,$"\x0b\x13\xf8\xd6\x4d\xcf\x85\x86"
-DATA ·d+13304(SB)/8,$"\xbe\x52\x5b\x99\x2e\x6d\x60\x9f"
-DATA ·d+13312(SB)/8,$"\x2c\x98\x00\x06\x1d\xf0\x49\x4c"
-DATA ·d+13320(SB)/8,$"\xe3\xeb\x5f\xe8\x75\x4c\x7c\xdb"
-DATA ·d+13328(SB)/8,$"\xf6\x94\x2a\x5e\x5e\x5a\x7e\x67"
-DATA ·d+13336(SB)/8,$"\xf9\x6b\x4b\x09\x49\x2f\x67\x8b"
-DATA ·d+13344(SB)/8,$"\xa5\xcb\x1f\x3d\x7e\xeb\x3b\xfe"
-DATA ·d+13352(SB)/8,$"\x63\x75\xcf\x88\x5a\x1c\xf3\x20"
-DATA ·d+13360(SB)/8,$"\xf2\x24\xf3\x83\xe6\x48\xd3\xcb"
-DATA ·d+13368(SB)/8,$"\x92\x30\x3e\xbf\x36\x0f\xd4\x56"
-DATA ·d+13376(SB)/8,$"\x4c\x9e\x31\xd1\x2e\x1c\x98\x5a"
-DATA ·d+13384(SB)/8,$"\xe9\x66\x23\x6e\xc7\x94\x4b\xc7"
-DATA ·d+13392(SB)/8,$"\x7e\x46\x36\xfe\x03\xf2\xae\xa1"
-DATA ·d+13400(SB)/8,$"\x3e\x1d\x78\x57\x46\xb4\x59\xda"
-DATA ·d+13408(SB)/8,$"\x0b\xab\x90\xcc\x40\x24\x2b\x6e"
-DATA ·d+13416(SB)/8,$"\x23\x83\xf7\x5b\x8a\xa9\xa8\x58"
-DATA ·d+13424(SB)/8,$"\xea\xf6\x0a\x2d\xf4\x9d\x19\xac"
-DATA ·d+13432(SB)/8,$"\xd7\xae\xe7\x8d\x50\x9f\x4e\xd9"
-DATA ·d+13440(SB)/8,$"\xfd\x6b\x8a\xa5\x3c\x5d\xdc\xeb"
-DATA ·d+13448(SB)/8,$"\x4d\x6b\xd1\x26\xd9\xe8\x50\xaf"
-DATA ·d+13456(SB)/8,$"\x97\xd9\x53\xa3\x17\x65\xd5\xb4"
-DATA ·d+13464(SB)/8,$"\x97\xe3\xa9\x96\x35\xf9\x16\x2c"
-DATA ·d+13472(SB)/8,$"\xda\xab\x80\xbe\x80\x91\xf8\x07"
-DATA ·d+13480(SB)/8,$"\x72\x4f\xe5\x5c\xc6\xad\xe3\x52"
-DATA ·d+13488(SB)/8,$"\x7f\xd1\xec\xe2\xbb\x73\xc7\xcf"
-DATA ·d+13496(SB)/8,$"\x2a\x2e\x54\x8f\xee\xa8\xdc\x07"
-DATA ·d+13504(SB)/8,$"\x3e\x9d\x6b\xaf\xb4\xd0\xbc\x6f"
-DATA ·d+13512(SB)/8,$"\xab\xa9\xbb\x96\xd6\x44\x88\x1d"
-DATA ·d+13520(SB)/8,$"\xa6\x56\x21\x86\x49\xe0\x0e\x99"
-DATA ·d+13528(SB)/8,$"\xcd\x75\x10\x78\x72\xfa\xd9\xc5"
-DATA ·d+13536(SB)/8,$"\xa6\xb1\x22\x44\x18\x7c\xdb\x76"
-DATA ·d+13544(SB)/8,$"\x82\x07\xa1\xda\x70\x4f\xe3\x52"
-DATA ·d+13552(SB)/8,$"\xa8\x62\xaa\xc3\x96\x32\x69\x79"
-DATA ·d+13560(SB)/8,$"\xa3\x57\x8e\xc2\x52\x97\xbf\x18"
-DATA ·d+13568(SB)/8,$"\xc9\xf9\x3b\xfe\x47\x85\x5c\x6a"
-DATA ·d+13576(SB)/8,$"\x87\xc4\x12\x87\xd6\x0c\x87\x8d"
-DATA ·d+13584(SB)/8,$"\xa5\xe4\x4d\xaa\xb8\xf6\x4c\x66"
-DATA ·d+13592(SB)/8,$"\xb6\xac\xf4\x7d\x31\xe3\x53\xa2"
-DATA ·d+13600(SB)/8,$"\x26\xad\x7a\x54\x75\x8a\x05\x8a"
-DATA ·d+13608(SB)/8,$"\x10\xfe\x9b\x8e\x73\xf9\x57\xa9"
-DATA ·d+13616(SB)/8,$"\xc9\xa0\xf8\x52\xcf\x2f\x79\x73"
-DATA ·d+13624(SB)/8,$"\x1c\xc5\x79\x58\x8b\x20\xcb\x7f"
-DATA ·d+13632(SB)/8,$"\xb5\xb0\x33\x11\xf5\x3f\x85\x62"
-DATA ·d+13640(SB)/8,$"\xde\x78\x93\x6d\xba\xda\xa6\xa1"
-DATA ·d+13648(SB)/8,$"\xa6\xd8\xc2\x8d\xc4\x70\xa3\x89"
-DATA ·d+13656(SB)/8,$"\x1b\xf1\xc6\x7f\x2c\x57\x23\xca"
-DATA ·d+13664(SB)/8,$"\xfd\x9d\xe5\x5e\x6a\xff\x00\x2b"
-DATA ·d+13672(SB)/8,$"\xfe\x2e\xc7\x7c\x73\x97\x51\x28"
-DATA ·d+13680(SB)/8,$"\xe1\xa0\xd2\xf9\xdb\xee\x4a\xef"
-DATA ·d+13688(SB)/8,$"\xf0\x32\x63\xd0\xb5\xb8\xd0\xc5"
-DATA ·d+13696(SB)/8,$"\x7f\xa4\x5d\x5c\x30\xf9\x96\x19"
-DATA ·d+13704(SB)/8,$"\x5c\x2c\x4a\x0f\x46\x72\x0e\xef"
-DATA ·d+13712(SB)/8,$"\xc3\x02\xac\xc1\x6b\xad\xdb\x15"
-DATA ·d+13720(SB)/8,$"\x9a\x4b\x6d\x26\xd9\x10\x12\x20"
-DATA ·d+13728(SB)/8,$"\x65\x0a\x84\x0e\xec\xef\x96\x3f"
-DATA ·d+13736(SB)/8,$"\x85\x41\x61\xab\x68\xb1\xbf\x95"
-DATA ·d+13744(SB)/8,$"\x15\xdb\xcb\x2b\x93\xb8\x47\x0e"
-DATA ·d+13752(SB)/8,$"\xe2\x7f\x17\x72\x4f\xe3\x53\x4b"
-DATA ·d+13760(SB)/8,$"\x16\x91\xad\x33\x5a\xdd\xdd\xc3"
-DATA ·d+13768(SB)/8,$"\x6c\xb8\xdd\x89\x25\x89\x5d\xbd"
-DATA ·d+13776(SB)/8,$"\xb6\xa0\xce\x7d\xb3\x5b\xd2\x9d"
-DATA ·d+13784(SB)/8,$"\x3b\x7b\xb2\xd7\xd5\x6f\xeb\x63"
-DATA ·d+13792(SB)/8,$"\x3a\x93\xa9\x7b\xce\x36\x5e\x92"
-DATA ·d+13800(SB)/8,$"\xfc\xae\x5b\xb9\xf1\x4d\xbd\xf4"
-DATA ·d+13808(SB)/8,$"\x5e\x5d\xdd\xce\x8d\x6f\x2e\xed"
-DATA ·d+13816(SB)/8,$"\x8a\xd6\xc9\xf2\xa8\xef\x97\x6c"
-DATA ·d+13824(SB)/8,$"\x91\x9e\x9c\x2e\x3e\x95\xcd\x2a"
-DATA ·d+13832(SB)/8,$"\xdc\xea\x2e\xff\x00\xd9\x9a\xe4"
-DATA ·d+13840(SB)/8,$"\x24\x97\x39\x41\x29\x8f\x3c\x63"
-DATA ·d+13848(SB)/8,$"\x39\x03\x9f\x4a\xb7\x67\xe1\x5f"
-DATA ·d+13856(SB)/8,$"\x04\x32\x67\xed\x53\x6e\x5c\x9c"
-DATA ·d+13864(SB)/8,$"\x9b\x60\xf1\x03\xfe\xd3\x02\x18"
-DATA ·d+13872(SB)/8,$"\x8a\x56\xb4\xf0\xd5\xa1\x0d\x65"
-DATA ·d+13880(SB)/8,$"\x73\x14\x92\xae\x73\x2c\x70\xac"
-DATA ·d+13888(SB)/8,$"\x31\x20\x1e\xa4\x92\x49\xa9\x7e"
-DATA ·d+13896(SB)/8,$"\xda\x6d\x4a\xb5\xad\xe5\x22\xe9"
-DATA ·d+13904(SB)/8,$"\x4f\x0f\x0b\xc6\x87\x35\xfc\xe3"
-DATA ·d+13912(SB)/8,$"\xa7\xe2\xbf\x52\xd5\xb7\x84\x75"
-DATA ·d+13920(SB)/8,$"\xe8\x2d\x7c\xfb\xaf\xb1\xcb\x09"
-DATA ·d+13928(SB)/8,$"\x6c\x33\x7d\xa5\x99\xb3\xf9\xa8"
-DATA ·d+13936(SB)/8,$"\x5c\xfa\x91\x59\x09\x6f\x6f\x1d"
-DATA ·d+13944(SB)/8,$"\xe0\xfb\x22\x69\xf1\x9c\x10\x0b"
-DATA ·d+13952(SB)/8,$"\x99\x66\xc1\xf5\x2e\xb9\xc1\x15"
-DATA ·d+13960(SB)/8,$"\x7a\xce\xc3\x45\xbe\xbb\x31\xc5"
-DATA ·d+13968(SB)/8,$"\x7d\x12\x49\x9f\xde\x4b\x13\x6f"
-DATA ·d+13976(SB)/8,$"\x57\xfa\xee\xc2\xff\x00\x3a\xed"
-DATA ·d+13984(SB)/8,$"\xbf\xb1\x92\xc2\xdc\x28\xbe\x10"
-DATA ·d+13992(SB)/8,$"\x5b\xb1\xdb\xe6\x43\x1a\xf9\xb2"
-DATA ·d+14000(SB)/8,$"\x13\xea\x7a\xe3\xe9\x5d\x98\x7c"
-DATA ·d+14008(SB)/8,$"\x3c\x67\x1e\x68\x2b\x25\xe6\x9f"
-DATA ·d+14016(SB)/8,$"\xf9\x58\xe5\xad\x98\x4a\x9c\xad"
-DATA ·d+14024(SB)/8,$"\x51\xea\xfc\x9a\xff\x00\x3b\x9e"
-DATA ·d+14032(SB)/8,$"\x77\x2d\xb4\x17\x2c\x7e\xd9\x25"
-DATA ·d+14040(SB)/8,$"\x8e\xec\x64\xe1\x9c\xbb\x03\xfd"
-DATA ·d+14048(SB)/8,$"\xd0\x40\x39\xfc\x6a\x76\xd0\xb4"
-DATA ·d+14056(SB)/8,$"\xd8\x48\x11\xc1\x7b\x1a\x30\xca"
-DATA ·d+14064(SB)/8,$"\xb8\x2a\x92\x1f\x71\xc9\xe3\x3d"
-DATA ·d+14072(SB)/8,$"\x39\xae\xc1\xb4\x9b\x2b\x59\x77"
-DATA ·d+14080(SB)/8,$"\xd9\x44\xb3\xaa\x92\x64\x92\x66"
-DATA ·d+14088(SB)/8,$"\x70\xc7\xb1\xcf\x55\x5c\x7a\xf5"
-DATA ·d+14096(SB)/8,$"\xa5\xb6\x82\x0b\xdb\xb2\xca\x2d"
-DATA ·d+14104(SB)/8,$"\xad\xe2\xf9\x42\xe2\x62\x43\x63"
-DATA ·d+14112(SB)/8,$"\x83\xb9\x89\x52\x7e\xa0\x62\xb6"
-DATA ·d+14120(SB)/8,$"\x86\x0d\x3b\xa9\x24\xff\x00\xae"
-DATA ·d+14128(SB)/8,$"\xe2\x96\x64\xed\x78\x5e\xcb\xfa"
-DATA ·d+14136(SB)/8,$"\xd8\xf3\x58\x2d\xec\x53\x50\xf2"
-DATA ·d+14144(SB)/8,$"\xee\x6c\xae\x59\x02\xf4\xb9\x62"
-DATA ·d+14152(SB)/8,$"\xc7\x77\xbe\xd0\x31\x5a\xd1\xe8"
-DATA ·d+14160(SB)/8,$"\x7e\x1a\xd4\x25\xf2\x51\x43\xce"
-DATA ·d+14168(SB)/8,$"\xc3\x09\x04\x2e\xcb\x83\xea\xcc"
-DATA ·d+14176(SB)/8,$"\xc7\x00\x7d\x33\x5d\xee\xb4\x96"
-DATA ·d+14184(SB)/8,$"\x96\x50\x0b\x48\x23\xb7\x9d\x50"
-DATA ·d+14192(SB)/8,$"\x8d\xe2\x36\x69\x0c\xb9\xf4\xd9"
-DATA ·d+14200(SB)/8,$"\x8f\xd6\xb9\xcb\xed\x22\xc3\x56"
-DATA ·d+14208(SB)/8,$"\x56\xb7\xb5\x0d\x6d\xb5\x77\x6c"
-DATA ·d+14216(SB)/8,$"\xb7\x42\xa3\x8e\x0e\xe2\xc0\x10"
-DATA ·d+14224(SB)/8,$"\x07\x7a\xc7\xea\xf0\xa7\x7a\x7a"
-DATA ·d+14232(SB)/8,$"\x49\xef\xff\x00\x0c\xcd\x61\x99"
-DATA ·d+14240(SB)/8,$"\xba\x8a\x35\x35\x8a\xef\x7f\xcf"
-DATA ·d+14248(SB)/8,$"\xa9\xcf\xdf\x68\x10\xe9\xb3\x24"
-DATA ·d+14256(SB)/8,$"\x16\xd2\xdb\xdb\xce\x40\x03\xca"
-DATA ·d+14264(SB)/8,$"\x46\x77\x24\xfa\x1e\x7f\x3c\x8a"
-DATA ·d+14272(SB)/8,$"\xb9\x2f\x87\xf5\x88\x6e\x13\x50"
-DATA ·d+14280(SB)/8,$"\xb9\xba\xcc\xac\x12\x26\x37\xdf"
-DATA ·d+14288(SB)/8,$"\x22\xb0\x8d\x02\xa8\x08\xa1\x72"
-DATA ·d+14296(SB)/8,$"\x15\x40\xc9\xcf\x3c\x64\x93\x51"
-DATA ·d+14304(SB)/8,$"\x5a\xe8\x5a\x0c\x72\x05\xb8\x87"
-DATA ·d+14312(SB)/8,$"\x51\x97\x69\xfd\xe4\xe8\xc7\xca"
-DATA ·d+14320(SB)/8,$"\x6c\xf4\xc0\xc0\xe0\xfa\x93\x50"
-DATA ·d+14328(SB)/8,$"\xbc\xfa\x9d\x88\x92\xd1\x74\xe9"
-DATA ·d+14336(SB)/8,$"\x1e\xdb\x7e\x62\x50\xcf\x91\xb4"
-DATA ·d+14344(SB)/8,$"\xe4\x12\x39\x02\xb9\x55\x28\x5f"
-DATA ·d+14352(SB)/8,$"\x9e\x50\xb7\x6b\x5d\xd8\xf4\x21"
-DATA ·d+14360(SB)/8,$"\x88\xa8\xd7\x24\x27\x77\xd6\xf6"
-DATA ·d+14368(SB)/8,$"\x57\xfc\x7f\x53\x4a\xde\xc6\xea"
-DATA ·d+14376(SB)/8,$"\xe6\x46\x88\xea\x31\x64\x7c\x86"
-DATA ·d+14384(SB)/8,$"\x2b\x31\xe6\x9e\x79\xc6\xd2\x7f"
-DATA ·d+14392(SB)/8,$"\x90\xa8\x3f\xb3\x5a\x69\xbe\xcc"
-DATA ·d+14400(SB)/8,$"\xb7\x57\x4c\xdd\x36\x9b\x7d\xb9"
-DATA ·d+14408(SB)/8,$"\xc1\xe3\x91\xff\x00\xd6\xaa\x76"
-DATA ·d+14416(SB)/8,$"\xba\x9d\xf3\x5c\x13\x73\xa7\x5f"
-DATA ·d+14424(SB)/8,$"\x36\xc5\x3b\x55\x5c\x65\x07\x5c"
-DATA ·d+14432(SB)/8,$"\x93\xb5\x4e\x2b\x45\x35\x9b\x8d"
-DATA ·d+14440(SB)/8,$"\x32\x35\x9e\x0b\x5b\x85\x24\x64"
-DATA ·d+14448(SB)/8,$"\xef\xf9\xc9\x19\xc9\xe3\xa1\x15"
-DATA ·d+14456(SB)/8,$"\xd7\x46\xb4\x25\xf1\x2b\x7f\xe0"
-DATA ·d+14464(SB)/8,$"\x57\xfb\x8c\x2a\xc2\xaa\x76\x8d"
-DATA ·d+14472(SB)/8,$"\x9f\xfe\x02\x32\x48\xf4\xab\x28"
-DATA ·d+14480(SB)/8,$"\x9e\x09\xa6\xb5\x79\x8e\x06\x65"
-DATA ·d+14488(SB)/8,$"\x7c\x15\xf5\x05\x72\x57\xf9\xd5"
-DATA ·d+14496(SB)/8,$"\x48\xbc\x43\xa3\xb5\xab\x59\xce"
-DATA ·d+14504(SB)/8,$"\x2d\xb7\xa9\xf9\x4c\x67\x6f\x3f"
-DATA ·d+14512(SB)/8,$"\x53\xfc\x85\x61\x4d\x7f\xa5\x5f"
-DATA ·d+14520(SB)/8,$"\x3b\x35\xc4\x68\x0b\x1e\x51\x63"
-DATA ·d+14528(SB)/8,$"\xda\xe2\xaf\x5e\x68\x5a\x76\x91"
-DATA ·d+14536(SB)/8,$"\xa2\xd9\xf8\xb2\xff\x00\x44\xd4"
-DATA ·d+14544(SB)/8,$"\xdf\x4e\xbe\xb8\xb8\xb5\xb4\xbe"
-DATA ·d+14552(SB)/8,$"\x91\x0a\xda\x4f\x3d\xb6\xdf\x3a"
-DATA ·d+14560(SB)/8,$"\x38\xd8\xe7\x73\x47\xb8\x6f\x5c"
-DATA ·d+14568(SB)/8,$"\xe4\x64\x57\x35\x4c\xc3\x95\xde"
-DATA ·d+14576(SB)/8,$"\x0e\x29\x7c\xff\x00\xc8\xec\xa3"
-DATA ·d+14584(SB)/8,$"\x97\xf3\xa6\xa5\x19\x37\xbe\x96"
-DATA ·d+14592(SB)/8,$"\xff\x00\x80\x56\x9f\x53\xd0\x5a"
-DATA ·d+14600(SB)/8,$"\x41\x12\xc2\xe7\x18\xdc\x88\x58"
-DATA ·d+14608(SB)/8,$"\x6e\xcf\xa9\xc1\xcf\xe1\x8c\x52"
-DATA ·d+14616(SB)/8,$"\xc5\x67\x67\xa8\xb9\x33\x5b\xdb"
-DATA ·d+14624(SB)/8,$"\x45\x1a\xb6\x15\x52\x5f\x2d\x88"
-DATA ·d+14632(SB)/8,$"\x1d\x09\x07\x24\xfe\x34\xe8\x7c"
-DATA ·d+14640(SB)/8,$"\x61\xa2\x5b\x3a\x1b\x7b\x49\xed"
-DATA ·d+14648(SB)/8,$"\xa3\x19\x21\x78\x51\x9f\x5e\x39"
-DATA ·d+14656(SB)/8,$"\xfd\x6b\x47\xfe\x12\x0b\x1b\xe9"
-DATA ·d+14664(SB)/8,$"\x52\x48\x26\x88\xcb\xb4\x92\x60"
-DATA ·d+14672(SB)/8,$"\x04\xb4\x7e\xc7\x70\x2b\xf8\xd3"
-DATA ·d+14680(SB)/8,$"\xa5\x56\x8c\x95\xe5\x55\x3f\x24"
-DATA ·d+14688(SB)/8,$"\xbf\xcc\xd6\x74\xeb\x53\x56\x8d"
-DATA ·d+14696(SB)/8,$"\x36\xbc\xdb\xff\x00\x2d\x0b\x0d"
-DATA ·d+14704(SB)/8,$"\xe1\xfd\x42\x04\x3f\x63\xf2\x3c"
-DATA ·d+14712(SB)/8,$"\xbe\xa0\x10\x25\x03\x1e\x8d\x9c"
-DATA ·d+14720(SB)/8,$"\x53\xda\xca\xfa\x31\xe5\x98\x2c"
-DATA ·d+14728(SB)/8,$"\x8b\x95\xfb\xdb\xd4\x60\xfa\x80"
-DATA ·d+14736(SB)/8,$"\xb9\xfd\x69\xcf\xab\xe9\xf0\xc5"
-DATA ·d+14744(SB)/8,$"\x1c\x52\xde\x24\xaf\x30\x20\xb1"
-DATA ·d+14752(SB)/8,$"\xdb\xbe\x31\xdb\x6e\x06\x09\xff"
-DATA ·d+14760(SB)/8,$"\x00\x7b\x8a\xa7\x65\x7b\x05\x93"
-DATA ·d+14768(SB)/8,$"\x4f\x04\x37\x1f\xbd\x95\x49\x79"
-DATA ·d+14776(SB)/8,$"\x66\x11\xbb\x46\x4f\x64\x0a\x42"
-DATA ·d+14784(SB)/8,$"\x91\xef\x8e\x3d\x2b\xa6\x75\xa8"
-DATA ·d+14792(SB)/8,$"\xc2\x69\x41\x7d\xcd\x1e\x7c\x69"
-DATA ·d+14800(SB)/8,$"\xd6\x92\x72\x96\xfe\x8f\xfa\xfc"
-DATA ·d+14808(SB)/8,$"\xc9\xdf\xc3\xd0\x38\x37\x1a\x9a"
-DATA ·d+14816(SB)/8,$"\xc9\x2f\x19\x91\x60\x5c\x0c\x0e"
-DATA ·d+14824(SB)/8,$"\xe5\x8e\x76\x8f\x7a\xaf\x16\x9b"
-DATA ·d+14832(SB)/8,$"\x20\xdb\x36\x8f\xf6\x48\x62\x2a"
-DATA ·d+14840(SB)/8,$"\xd8\x2b\x27\x98\xe3\x69\xef\xc1"
-DATA ·d+14848(SB)/8,$"\x39\xfc\x31\x5a\x96\x30\x58\x4d"
-DATA ·d+14856(SB)/8,$"\x03\xa1\xd4\x64\x49\x19\x42\x3b"
-DATA ·d+14864(SB)/8,$"\xc8\xea\x43\x67\xa6\x70\x32\x07"
-DATA ·d+14872(SB)/8,$"\xb5\x61\x4f\x6d\xf6\x66\x29\x69"
-DATA ·d+14880(SB)/8,$"\xa8\xcb\x09\x39\x49\x3b\xa9\xf5"
-DATA ·d+14888(SB)/8,$"\x1c\x01\xc7\xd6\xae\x51\x56\x53"
-DATA ·d+14896(SB)/8,$"\x8c\x3f\x15\x72\xa9\x56\x95\xdc"
-DATA ·d+14904(SB)/8,$"\x1c\xff\x00\x07\x61\xb2\x3e\xb3"
-DATA ·d+14912(SB)/8,$"\xa7\xa7\xda\x96\x3b\x6b\x82\xd9"
-DATA ·d+14920(SB)/8,$"\xdd\x34\xe3\x76\xdf\xa6\xec\x0c"
-DATA ·d+14928(SB)/8,$"\x8a\xc6\x9f\xc5\x92\x00\x62\x92"
-DATA ·d+14936(SB)/8,$"\xce\xce\x57\xe9\xe6\xed\xd8\xc1"
-DATA ·d+14944(SB)/8,$"\x87\x19\xf9\x78\x3f\x4a\xb6\x34"
-DATA ·d+14952(SB)/8,$"\x45\x91\x4b\x49\x74\x66\x01\x7a"
-DATA ·d+14960(SB)/8,$"\xa9\x18\xcf\xae\x0f\x27\xdf\x15"
-DATA ·d+14968(SB)/8,$"\x9b\x71\xe1\xe9\xe5\x4d\xf0\x65"
-DATA ·d+14976(SB)/8,$"\xb0\x78\xda\x8d\xf9\x92\x78\xfc"
-DATA ·d+14984(SB)/8,$"\xab\xcd\xc4\xbc\x45\xbd\xc4\xd7"
-DATA ·d+14992(SB)/8,$"\xdc\xcf\x4b\x0d\xf5\x77\xf1\xd9"
-DATA ·d+15000(SB)/8,$"\xfd\xe8\x96\xeb\xc7\x32\x1b\x43"
-DATA ·d+15008(SB)/8,$"\x6e\xb0\x2f\x9c\x08\xc9\x28\xaa"
-DATA ·d+15016(SB)/8,$"\x9c\x75\xe0\x72\x4d\x56\xb5\xf1"
-DATA ·d+15024(SB)/8,$"\x8e\xa0\x2d\xfc\x98\xad\xe3\x39"
-DATA ·d+15032(SB)/8,$"\x24\xef\x52\x50\xf3\xec\x38\xe2"
-DATA ·d+15040(SB)/8,$"\xa3\x93\xc2\xda\x9a\x8f\x32\x74"
-DATA ·d+15048(SB)/8,$"\x76\x04\xe7\x68\x15\x72\xcf\xc3"
-DATA ·d+15056(SB)/8,$"\x17\xfb\x37\x88\x81\x3d\xb6\x8d"
-DATA ·d+15064(SB)/8,$"\xe4\x29\xf5\x19\xe0\x7b\x9a\xe2"
-DATA ·d+15072(SB)/8,$"\x8b\xc7\x39\x2b\xdd\x7c\x8e\xf9"
-DATA ·d+15080(SB)/8,$"\x2c\x1a\x8f\x4f\xbc\xcb\x22\xc9"
-DATA ·d+15088(SB)/8,$"\xf3\x71\x70\x92\xbb\xb7\x2f\x9f"
-DATA ·d+15096(SB)/8,$"\xde\x0e\x7b\x8e\x45\x41\x33\xe8"
-DATA ·d+15104(SB)/8,$"\x01\x77\x43\x6f\x71\x21\x1d\x37"
-DATA ·d+15112(SB)/8,$"\x49\xb7\x9f\xa6\x2b\xad\x1e\x1b"
-DATA ·d+15120(SB)/8,$"\xb8\xb3\x51\x75\x7a\xed\x1a\xf5"
-DATA ·d+15128(SB)/8,$"\xda\x9c\x48\xc0\xff\x00\x74\x0c"
-DATA ·d+15136(SB)/8,$"\xd3\x55\xb4\xa1\x21\x89\xe3\x95"
-DATA ·d+15144(SB)/8,$"\x18\xf0\x92\x5c\x2a\xe4\x7b\x9c"
-DATA ·d+15152(SB)/8,$"\x0c\x93\x5a\x4f\x03\x3d\xa6\x92"
-DATA ·d+15160(SB)/8,$"\xbf\x73\x28\xe6\x10\x7f\x0d\xdd"
-DATA ·d+15168(SB)/8,$"\xbb\x6c\x71\x96\xda\xd4\x48\xe1"
-DATA ·d+15176(SB)/8,$"\x7e\xc2\x89\x1e\x79\xcb\xb1\x6f"
-DATA ·d+15184(SB)/8,$"\xaf\x51\x5b\x32\xea\xba\x5c\xf0"
-DATA ·d+15192(SB)/8,$"\x15\xb5\x8e\x6f\x31\xb8\x6d\x91"
-DATA ·d+15200(SB)/8,$"\x83\xcf\xe2\x71\xf8\xe0\xd7\x41"
-DATA ·d+15208(SB)/8,$"\x27\x85\x27\xd4\x21\x5b\x9b\x67"
-DATA ·d+15216(SB)/8,$"\x59\xe3\x38\x07\x9d\xa0\x7d\x47"
-DATA ·d+15224(SB)/8,$"\x03\xf5\xae\x63\x52\xf0\xfe\xa3"
-DATA ·d+15232(SB)/8,$"\xa7\x6e\x66\xb1\x94\x85\xe0\xed"
-DATA ·d+15240(SB)/8,$"\xe9\xf5\xe0\xd6\x73\xc2\xe2\x68"
-DATA ·d+15248(SB)/8,$"\xc5\xe9\x78\xbe\xb6\xff\x00\x23"
-DATA ·d+15256(SB)/8,$"\xa2\x18\xbc\x35\x59\x24\x9d\x9f"
-DATA ·d+15264(SB)/8,$"\x6b\xff\x00\x99\x66\x27\x92\x64"
-DATA ·d+15272(SB)/8,$"\x10\xbc\xcf\x08\x07\x85\x11\x1d"
-DATA ·d+15280(SB)/8,$"\xdd\x3b\x91\xc5\x45\x2e\x80\xf2"
-DATA ·d+15288(SB)/8,$"\x12\x61\xd4\x37\x2e\x72\x43\x2e"
-DATA ·d+15296(SB)/8,$"\xd3\xcd\x63\xa5\xe5\xcc\x4b\x89"
-DATA ·d+15304(SB)/8,$"\xe0\x96\x15\x3c\x00\xa0\xe0\x8f"
-DATA ·d+15312(SB)/8,$"\xce\x95\xe4\x96\xf0\x0f\xb3\xc2"
-DATA ·d+15320(SB)/8,$"\xfc\xf1\xf2\x17\xc9\xfa\xf5\xac"
-DATA ·d+15328(SB)/8,$"\x55\x68\x35\x67\x1b\xfd\xe6\xfe"
-DATA ·d+15336(SB)/8,$"\xc2\x71\x77\x4e\xdf\x73\x24\x10"
-DATA ·d+15344(SB)/8,$"\x5a\x59\x12\x59\xde\x59\x15\xb1"
-DATA ·d+15352(SB)/8,$"\x85\x00\xae\x3d\x73\x83\xfc\xaa"
-DATA ·d+15360(SB)/8,$"\xfd\xbe\xa1\xe1\xf8\xd9\x63\xbb"
-DATA ·d+15368(SB)/8,$"\x8e\x10\xc7\x82\xdf\x38\x23\xfe"
-DATA ·d+15376(SB)/8,$"\xf9\xa6\xdb\x68\x1a\xb4\xca\xe4"
-DATA ·d+15384(SB)/8,$"\xab\xa0\x55\x2c\x44\xea\xdd\x07"
-DATA ·d+15392(SB)/8,$"\x5f\x9a\xb6\x2c\x34\xc7\x68\x16"
-DATA ·d+15400(SB)/8,$"\x56\xb8\x85\x41\x04\xaa\x49\xf2"
-DATA ·d+15408(SB)/8,$"\x9e\x3b\x0c\x9c\xd6\x98\x7c\x3d"
-DATA ·d+15416(SB)/8,$"\x56\xfd\xd8\xdb\xd5\x7f\x9b\x33"
-DATA ·d+15424(SB)/8,$"\xc4\xd6\xa5\x6f\x7a\x57\xf4\xff"
-DATA ·d+15432(SB)/8,$"\x00\x86\x2a\xc1\xa9\x5b\x1b\x92"
-DATA ·d+15440(SB)/8,$"\x2c\xa2\xf2\x22\x53\x81\x2c\x65"
-DATA ·d+15448(SB)/8,$"\xdd\x9b\x1d\xf1\xef\xc7\x04\x8c"
-DATA ·d+15456(SB)/8,$"\x0f\xca\x8b\xfd\x5c\x5c\x59\xcf"
-DATA ·d+15464(SB)/8,$"\x6a\x6c\xd5\x6e\x18\x42\x20\xb9"
-DATA ·d+15472(SB)/8,$"\x8c\xb4\x66\x2d\x8c\x4c\xa4\xa0"
-DATA ·d+15480(SB)/8,$"\x5c\x49\xe6\x2e\x14\x12\x46\xcc"
-DATA ·d+15488(SB)/8,$"\x64\x67\x38\xad\xb6\xd1\x2c\x59"
-DATA ·d+15496(SB)/8,$"\x84\xd1\x8b\x88\xdc\xe7\x79\x8c"
-DATA ·d+15504(SB)/8,$"\xee\x04\xfe\x15\x6d\xec\xe5\x81"
-DATA ·d+15512(SB)/8,$"\x50\x59\x6e\xb9\x27\xa4\x72\x06"
-DATA ·d+15520(SB)/8,$"\xcf\x1d\x46\xd1\x93\x5e\x8d\x4c"
-DATA ·d+15528(SB)/8,$"\x05\x79\x41\xc6\x52\xd3\xc8\xe2"
-DATA ·d+15536(SB)/8,$"\x86\x3e\x94\x66\xa5\x08\xeb\xe7"
-DATA ·d+15544(SB)/8,$"\xff\x00\x04\xf3\x24\x6d\x4c\xc4"
-DATA ·d+15552(SB)/8,$"\x2d\xc4\xb2\xb2\x9e\x0a\x13\xb7"
-DATA ·d+15560(SB)/8,$"\x39\xeb\x93\x5a\xd6\x76\x5a\x9e"
-DATA ·d+15568(SB)/8,$"\x38\x81\xcc\x43\x9c\xe1\x4e\x36"
-DATA ·d+15576(SB)/8,$"\xf3\xf7\x8e\x2b\xaf\x8e\x72\x07"
-DATA ·d+15584(SB)/8,$"\xd8\x04\x11\x45\x33\xb7\x27\x9d"
-DATA ·d+15592(SB)/8,$"\xc9\xdb\xab\x0c\x7e\x1d\x2b\x63"
-DATA ·d+15600(SB)/8,$"\xcc\x71\x62\xb0\xca\x40\x20\x66"
-DATA ·d+15608(SB)/8,$"\x42\xea\x5f\x20\x77\x3b\x4e\x3e"
-DATA ·d+15616(SB)/8,$"\xbc\x01\x4b\x0b\x95\x42\xfa\xcd"
-DATA ·d+15624(SB)/8,$"\xe9\xfd\x5b\x52\xf1\x39\xa4\xad"
-DATA ·d+15632(SB)/8,$"\xca\xa0\x95\xff\x00\xab\xe8\x73"
-DATA ·d+15640(SB)/8,$"\xd2\xe9\xf2\xbc\x21\x27\xd3\xe0"
-DATA ·d+15648(SB)/8,$"\x7d\xdf\x30\xc3\x00\x7f\x52\x6b"
-DATA ·d+15656(SB)/8,$"\x18\x68\x84\x0c\x7d\x90\x7e\x28"
-DATA ·d+15664(SB)/8,$"\x3f\xc6\xba\x5b\xd9\x33\x18\x81"
-DATA ·d+15672(SB)/8,$"\xe4\xf3\x81\x5d\xc8\xf0\x21\x11"
-DATA ·d+15680(SB)/8,$"\xfa\xe0\x8c\x8c\xf4\xe2\xb0\x7e"
-DATA ·d+15688(SB)/8,$"\xd7\x39\xe4\xe9\xf3\x37\xfb\x5e"
-DATA ·d+15696(SB)/8,$"\x60\x19\xf7\xc1\xe9\x5b\xe2\xdd"
-DATA ·d+15704(SB)/8,$"\x04\xd2\x77\xfe\xbd\x11\x95\x0a"
-DATA ·d+15712(SB)/8,$"\xb5\xad\x74\xff\x00\xaf\x9b\x3f"
-DATA ·d+15720(SB)/8,$"\xff\xd4\xfc\x16\xb9\x97\xe1\x66"
-DATA ·d+15728(SB)/8,$"\x81\xfb\xdb\x7b\x04\x86\x6e\xfe"
-DATA ·d+15736(SB)/8,$"\x5e\x6e\x1c\x1f\x62\xcd\xb7\xf4"
-DATA ·d+15744(SB)/8,$"\x35\xe7\x9a\xdf\x8f\xae\xaf\x65"
-DATA ·d+15752(SB)/8,$"\x10\xe8\x76\x9a\x84\x8c\x09\x08"
-DATA ·d+15760(SB)/8,$"\xf2\x30\x50\x3d\x30\xa9\xd2\xbd"
-DATA ·d+15768(SB)/8,$"\x7e\xd7\xc2\x7a\x16\xb6\x92\x4b"
-DATA ·d+15776(SB)/8,$"\x1d\x84\xd1\xbe\xc2\xc8\x96\x97"
-DATA ·d+15784(SB)/8,$"\x0b\x3a\x83\xfe\xd1\xcf\x02\xaa"
-DATA ·d+15792(SB)/8,$"\x45\xa4\xf8\x53\x4e\x8e\x48\xf5"
-DATA ·d+15800(SB)/8,$"\x78\x6f\x64\x93\x03\x62\xc4\xe7"
-DATA ·d+15808(SB)/8,$"\x03\xd4\x9c\x1e\x6b\xfd\x10\xc5"
-DATA ·d+15816(SB)/8,$"\xe1\x71\x55\x15\xa9\x4a\x30\x83"
-DATA ·d+15824(SB)/8,$"\xea\x97\xfc\x0f\xd0\xff\x00\x27"
-DATA ·d+15832(SB)/8,$"\x70\x59\x8e\x16\x9b\x72\xa9\x19"
-DATA ·d+15840(SB)/8,$"\x54\x92\xfe\x67\x7f\xd7\xf5\x3c"
-DATA ·d+15848(SB)/8,$"\x5a\xc2\xf3\xc5\xe9\x39\xb9\xbc"
-DATA ·d+15856(SB)/8,$"\x58\x23\x67\x6d\xc7\xed\x0f\x2c"
-DATA ·d+15864(SB)/8,$"\x8e\xcc\x79\xc9\x44\x3c\x9a\xef"
-DATA ·d+15872(SB)/8,$"\xdb\x5f\xf8\x81\x78\xa6\x29\x6d"
-DATA ·d+15880(SB)/8,$"\x62\x96\x38\x00\x90\xb4\x90\x14"
-DATA ·d+15888(SB)/8,$"\x28\x0f\x46\xc3\x92\x40\x35\x5f"
-DATA ·d+15896(SB)/8,$"\x53\x96\xce\xce\x4f\x33\x41\xd3"
-DATA ·d+15904(SB)/8,$"\x2e\xd5\xb2\x71\x26\xd1\xb8\x7f"
-DATA ·d+15912(SB)/8,$"\xc0\x9b\x35\xc5\xcc\x35\x4b\xe9"
-DATA ·d+15920(SB)/8,$"\x8b\x5e\xc2\xe0\xb7\x79\x0b\xb3"
-DATA ·d+15928(SB)/8,$"\x7e\x24\x57\x8c\xe7\x2c\x3a\x71"
-DATA ·d+15936(SB)/8,$"\x8d\x49\x49\xf9\x6d\xf9\x7e\x87"
-DATA ·d+15944(SB)/8,$"\xd1\xb9\xd3\xc5\x5a\x72\xa5\x15"
-DATA ·d+15952(SB)/8,$"\xda\xf6\xbf\xe7\xfa\x9b\x77\x9f"
-DATA ·d+15960(SB)/8,$"\x12\x61\xb6\x26\x2d\x46\xc2\x3b"
-DATA ·d+15968(SB)/8,$"\xd9\x0f\x1c\xcc\xf1\x05\xfa\x05"
-DATA ·d+15976(SB)/8,$"\x04\x1a\xe3\xef\xfc\x77\x3c\xb9"
-DATA ·d+15984(SB)/8,$"\x31\xe9\x11\xdb\xa9\x3c\x14\x91"
-DATA ·d+15992(SB)/8,$"\xe6\x3f\x86\xfa\xdd\x3a\x16\xba"
-DATA ·d+16000(SB)/8,$"\xa3\x16\xcc\xa1\x7a\xed\x53\x8c"
-DATA ·d+16008(SB)/8,$"\xe7\xfd\xe0\x6a\x84\xde\x1c\xd7"
-DATA ·d+16016(SB)/8,$"\x18\xfc\xf6\xf7\x0f\x9e\x43\x70"
-DATA ·d+16024(SB)/8,$"\x46\x4f\xb8\x18\xae\x0c\x46\x23"
-DATA ·d+16032(SB)/8,$"\x1f\x53\x5b\xbb\x7a\x2f\xcf\x73"
-DATA ·d+16040(SB)/8,$"\xd1\xc1\xd3\xcb\xe9\xec\x95\xff"
-DATA ·d+16048(SB)/8,$"\x00\xc4\xff\x00\x2b\xd8\xe3\x64"
-DATA ·d+16056(SB)/8,$"\xf1\x84\x2a\xe7\x7c\x37\x00\x8f"
-DATA ·d+16064(SB)/8,$"\x40\xa0\x0a\x8d\xf5\xfd\x57\x51"
-DATA ·d+16072(SB)/8,$"\x5c\x1b\x71\x2c\x5f\xdc\x70\x5b"
-DATA ·d+16080(SB)/8,$"\x03\xfe\x02\x45\x75\xbf\xf0\x83"
-DATA ·d+16088(SB)/8,$"\x78\x8a\xe4\xe2\x2b\x56\xe7\xfb"
-DATA ·d+16096(SB)/8,$"\xcb\xfe\x15\x63\x4f\xf8\x5d\xe2"
-DATA ·d+16104(SB)/8,$"\x23\x28\x33\x30\xb4\x00\xf5\x63"
-DATA ·d+16112(SB)/8,$"\x82\x4f\xb2\x83\x92\x6b\xce\x58"
-DATA ·d+16120(SB)/8,$"\x3c\xc2\x6e\xcd\x36\xbd\x0f\x5a"
-DATA ·d+16128(SB)/8,$"\x39\x9e\x5d\x4d\x73\x73\x45\x35"
-DATA ·d+16136(SB)/8,$"\xe7\x7f\xc8\xf2\xab\x8d\x3e\x5b"
-DATA ·d+16144(SB)/8,$"\x9e\x56\xdb\xc9\x24\x7d\xc8\xe3"
-DATA ·d+16152(SB)/8,$"\x2a\x0f\xe3\x9c\xd5\x5f\xf8\x43"
-DATA ·d+16160(SB)/8,$"\xb5\x75\x8c\xdd\x2c\x7e\x5a\x0e"
-DATA ·d+16168(SB)/8,$"\x54\x96\x00\x9f\xc3\xad\x7d\x4f"
-DATA ·d+16176(SB)/8,$"\xa3\xf8\x67\xc3\x96\xbf\x26\xa3"
-DATA ·d+16184(SB)/8,$"\x2a\x5d\x4e\x08\xda\xd3\x10\xa9"
-DATA ·d+16192(SB)/8,$"\x9e\xe0\x81\xdb\xdf\x35\xa3\xaa"
-DATA ·d+16200(SB)/8,$"\xda\x78\x5d\x6e\x44\xd2\x47\x03"
-DATA ·d+16208(SB)/8,$"\xaa\xf2\x56\x07\x2c\x98\xfe\xe8"
-DATA ·d+16216(SB)/8,$"\x03\xbf\xd4\x63\xde\xbb\x63\xc1"
-DATA ·d+16224(SB)/8,$"\xcd\xc3\x9e\xa4\xec\xce\x15\xc7"
-DATA ·d+16232(SB)/8,$"\xed\x4f\x92\x95\x36\xff\x00\xaf"
-DATA ·d+16240(SB)/8,$"\x3f\xd4\xf9\x22\xda\x6f\x15\xd8"
-DATA ·d+16248(SB)/8,$"\x48\x22\xb3\x9a\xe2\x4d\xad\x81"
-DATA ·d+16256(SB)/8,$"\x14\x64\xba\x8f\xaa\xf2\x0f\xe3"
-DATA ·d+16264(SB)/8,$"\x5d\xb6\x9f\xe2\x6f\x13\xdc\xbf"
-DATA ·d+16272(SB)/8,$"\xd9\xef\x65\x31\x90\x31\xe5\x5b"
-DATA ·d+16280(SB)/8,$"\xdb\xc1\xe6\x1f\xae\x57\x03\xea"
-DATA ·d+16288(SB)/8,$"\x4d\x7d\x01\x25\xdf\x82\x75\x18"
-DATA ·d+16296(SB)/8,$"\x44\x90\xc5\xf6\x78\x97\xe4\x16"
-DATA ·d+16304(SB)/8,$"\xc2\x22\x09\xf5\x66\x23\x0b\x93"
-DATA ·d+16312(SB)/8,$"\xd3\x1c\xd7\x23\xad\xea\x1e\x0e"
-DATA ·d+16320(SB)/8,$"\x89\xb1\xa5\xe8\xc8\x58\x7c\xaa"
-DATA ·d+16328(SB)/8,$"\x93\x01\x14\x5e\xcc\x58\x7c\xed"
-DATA ·d+16336(SB)/8,$"\xf4\x38\x14\xbf\xb1\x1e\x1d\x5d"
-DATA ·d+16344(SB)/8,$"\x62\x74\xed\xaf\xe5\xbf\xe4\x6c"
-DATA ·d+16352(SB)/8,$"\xb8\x9e\x38\x97\xca\xf0\x96\x97"
-DATA ·d+16360(SB)/8,$"\x7b\x2f\xc7\x6f\xeb\xa9\xc9\xda"
-DATA ·d+16368(SB)/8,$"\xff\x00\xa6\x5b\x84\xbe\xbb\xb5"
-DATA ·d+16376(SB)/8,$"\x08\x01\xc0\xbb\x9d\x10\xa0\xf4"
-DATA ·d+16384(SB)/8,$"\x3b\x0a\xa9\x3f\x40\x4d\x67\x4c"
-DATA ·d+16392(SB)/8,$"\x3c\x2b\x66\xad\xb5\x24\xbd\x6c"
-DATA ·d+16400(SB)/8,$"\xff\x00\xad\xb6\xb9\x63\x18\x3e"
-DATA ·d+16408(SB)/8,$"\x84\x30\x19\x1e\xe0\x9a\xd3\x9b"
-DATA ·d+16416(SB)/8,$"\x50\xd3\x2e\x26\x12\x49\xe1\xed"
-DATA ·d+16424(SB)/8,$"\x3c\x76\xfd\xd4\xe5\x5b\xf0\xc8"
-DATA ·d+16432(SB)/8,$"\xad\x9b\x4d\x37\xc3\x0a\xfb\xbc"
-DATA ·d+16440(SB)/8,$"\x42\x44\x1b\x94\xb2\x5b\x89\xf7"
-DATA ·d+16448(SB)/8,$"\x32\xfa\x02\x55\x78\x15\xd5\xcd"
-DATA ·d+16456(SB)/8,$"\xce\xb9\x20\xd7\xab\x4f\xf5\x48"
-DATA ·d+16464(SB)/8,$"\x8a\xb5\xf9\x3d\xe9\xc6\x4a\xfd"
-DATA ·d+16472(SB)/8,$"\x13\x5f\xfb\x6b\xb9\xc7\x0d\x7b"
-DATA ·d+16480(SB)/8,$"\x41\x84\xfe\xf3\x4d\xb6\x08\x0f"
-DATA ·d+16488(SB)/8,$"\xdd\x96\x67\xd9\xf5\x38\xc1\x63"
-DATA ·d+16496(SB)/8,$"\xf8\xd4\x17\x5a\xd7\x83\x7c\xe5"
-DATA ·d+16504(SB)/8,$"\x6b\x97\x49\x06\xf1\x98\xad\xed"
-DATA ·d+16512(SB)/8,$"\x8a\x80\x38\xc8\x0c\xec\x49\xc5"
-DATA ·d+16520(SB)/8,$"\x6e\xcf\x1f\x81\x16\x76\x4f\x2a"
-DATA ·d+16528(SB)/8,$"\x07\x6c\xe1\x16\x39\x32\xc4\x9e"
-DATA ·d+16536(SB)/8,$"\xe3\x70\x51\xc7\xbd\x69\xcf\xa1"
-DATA ·d+16544(SB)/8,$"\xf8\x4e\xd3\x4c\x37\xb2\x41\x12"
-DATA ·d+16552(SB)/8,$"\xc8\x79\x10\xcc\xd8\x72\xbd\xf0"
-DATA ·d+16560(SB)/8,$"\x57\x38\xfa\xe2\xb1\x97\xb6\x94"
-DATA ·d+16568(SB)/8,$"\x5d\xa7\x1d\x3f\xad\xb4\x36\x78"
-DATA ·d+16576(SB)/8,$"\x9a\x11\xe5\x6e\x9c\xd5\xf4\xfe"
-DATA ·d+16584(SB)/8,$"\xb7\xfd\x0c\x66\xf1\x1e\x80\xe7"
-DATA ·d+16592(SB)/8,$"\xec\xbe\x1a\x86\x68\x23\x61\x86"
-DATA ·d+16600(SB)/8,$"\x8d\xbf\xd6\x48\x47\xfb\x4a\x0e"
-DATA ·d+16608(SB)/8,$"\xdf\xc0\xd6\x94\x37\xde\x2c\x11"
-DATA ·d+16616(SB)/8,$"\xab\xe9\x70\x47\x2a\xb1\x0b\x95"
-DATA ·d+16624(SB)/8,$"\x72\xc0\x7a\x03\x8d\xbd\xab\x9c"
-DATA ·d+16632(SB)/8,$"\xb9\xf1\x17\x84\x34\xc1\x1d\xc6"
-DATA ·d+16640(SB)/8,$"\x8f\x6a\x0c\xc1\xc1\x91\x5d\x37"
-DATA ·d+16648(SB)/8,$"\x85\x00\x74\x52\xcc\x73\xcf\xb5"
-DATA ·d+16656(SB)/8,$"\x6b\xd8\xf8\xb3\x4b\xd4\xc9\xb6"
-DATA ·d+16664(SB)/8,$"\x82\x3b\x85\x32\x9f\x9c\x4a\x0e"
-DATA ·d+16672(SB)/8,$"\x3d\x84\x61\x41\xdb\xf9\xe2\xab"
-DATA ·d+16680(SB)/8,$"\x0f\x98\xc5\xcf\x96\x55\x2c\xff"
-DATA ·d+16688(SB)/8,$"\x00\xbb\xa7\xe8\x4d\x7c\x24\xa3"
-DATA ·d+16696(SB)/8,$"\x14\xe9\xd1\x6d\x7f\x7b\x57\xf9"
-DATA ·d+16704(SB)/8,$"\x91\xea\x7a\xc7\x8a\x75\x07\x6b"
-DATA ·d+16712(SB)/8,$"\x67\x66\x85\xf2\xbb\x85\xa7\xca"
-DATA ·d+16720(SB)/8,$"\x41\x5e\x9f\x31\xed\xec\x38\xaa"
-DATA ·d+16728(SB)/8,$"\x09\x79\xe3\x5d\x2a\x46\xf3\xee"
-DATA ·d+16736(SB)/8,$"\xe7\x22\x4f\x99\x8b\xa9\x95\xc8"
-DATA ·d+16744(SB)/8,$"\xef\xb4\x90\x0e\x7d\x6b\xba\x8d"
-DATA ·d+16752(SB)/8,$"\xae\x8e\xdb\x78\x96\x7c\xb1\xd9"
-DATA ·d+16760(SB)/8,$"\xb5\x8c\x6a\x84\xf4\xe7\x6f\xcc"
-DATA ·d+16768(SB)/8,$"\xbc\x7b\x54\x57\x5e\x1a\xd4\x13"
-DATA ·d+16776(SB)/8,$"\xf7\x57\xf7\xce\xb9\xe7\xc8\x01"
-DATA ·d+16784(SB)/8,$"\x98\x60\xfb\x9c\x13\xf8\xf1\x5e"
-DATA ·d+16792(SB)/8,$"\x9c\xf0\xd5\x2a\x3f\x68\xa7\x26"
-DATA ·d+16800(SB)/8,$"\xfb\xdf\xfe\x18\xe4\xa7\x98\x51"
-DATA ·d+16808(SB)/8,$"\x82\xf6\x72\x8c\x52\xed\x6b\xbf"
-DATA ·d+16816(SB)/8,$"\xd4\xe2\x23\xf1\x0c\x04\x12\x8d"
-DATA ·d+16824(SB)/8,$"\x78\xb2\x07\x0c\xef\xd7\x71\x1d"
-DATA ·d+16832(SB)/8,$"\x32\x4e\x70\x33\xda\xba\xf8\x7c"
-DATA ·d+16840(SB)/8,$"\x5e\x35\x3b\x78\xe2\x9f\xca\x92"
-DATA ·d+16848(SB)/8,$"\x40\xc0\xa8\x42\xc1\x8b\x0e\xe5"
-DATA ·d+16856(SB)/8,$"\x40\x23\x91\xe9\x8a\x55\xf0\xc5"
-DATA ·d+16864(SB)/8,$"\x9c\x92\x29\x57\x90\x3e\x0f\xce"
-DATA ·d+16872(SB)/8,$"\x8f\x86\xc0\xe3\x90\x32\xa0\x0f"
-DATA ·d+16880(SB)/8,$"\xa5\x31\xee\x34\x7d\x19\xdf\x4e"
-DATA ·d+16888(SB)/8,$"\xba\x37\x26\x76\x2a\x63\xf2\x98"
-DATA ·d+16896(SB)/8,$"\x06\x1c\x67\x2c\x54\x1f\xf3\xd6"
-DATA ·d+16904(SB)/8,$"\xaa\x85\x6a\x94\x6f\xcf\x34\x93"
-DATA ·d+16912(SB)/8,$"\x22\xbc\xf0\xf5\xac\xa9\xc1\xb6"
-DATA ·d+16920(SB)/8,$"\x8d\x59\xe0\xd6\x35\x29\x9a\x6b"
-DATA ·d+16928(SB)/8,$"\x78\xac\x9d\x03\x73\x14\xfb\x54"
-DATA ·d+16936(SB)/8,$"\xaf\xd4\x91\xfd\x6b\xd1\x75\x9d"
-DATA ·d+16944(SB)/8,$"\x6b\x53\x9b\xe0\xde\x91\xe1\xdd"
-DATA ·d+16952(SB)/8,$"\x76\x52\x34\xdb\x6d\x7b\x51\xbd"
-DATA ·d+16960(SB)/8,$"\xb3\xd3\x55\xc3\xda\xc1\x71\x34"
-DATA ·d+16968(SB)/8,$"\x51\xa4\xd2\xc6\xb9\xda\x1d\xc0"
-DATA ·d+16976(SB)/8,$"\xc3\x9c\xf3\x5e\x64\xf2\x69\xac"
-DATA ·d+16984(SB)/8,$"\xb1\xf9\x0b\xba\x69\x08\x55\x79"
-DATA ·d+16992(SB)/8,$"\xcc\x92\x32\xfb\x12\x14\x0c\xfd"
-DATA ·d+17000(SB)/8,$"\x6b\xa0\xf1\x25\x96\xb0\x3e\x1b"
-DATA ·d+17008(SB)/8,$"\x68\xb6\xf6\xda\x5a\x4c\x56\xf2"
-DATA ·d+17016(SB)/8,$"\xfa\x43\x90\x77\x43\xca\x28\x61"
-DATA ·d+17024(SB)/8,$"\x86\x1c\xb8\xe4\x8f\xce\x8c\x6e"
-DATA ·d+17032(SB)/8,$"\x25\xa9\x53\x71\x57\xd5\xef\x77"
-DATA ·d+17040(SB)/8,$"\xd1\xf9\x0b\x06\xae\xa5\x1e\x6e"
-DATA ·d+17048(SB)/8,$"\x5f\xc3\xaa\xf3\xf9\x1c\x35\xce"
-DATA ·d+17056(SB)/8,$"\x8f\xa0\x4f\x3b\x31\xb6\xb7\x62"
-DATA ·d+17064(SB)/8,$"\xa3\x2d\x97\x69\x14\x71\x9c\x0d"
-DATA ·d+17072(SB)/8,$"\xa0\xf4\xf4\x19\xac\x6b\xd8\xbc"
-DATA ·d+17080(SB)/8,$"\x31\x2c\x21\xa0\x86\x08\x48\xff"
-DATA ·d+17088(SB)/8,$"\x00\x58\x5c\x33\x71\xd8\x00\x00"
-DATA ·d+17096(SB)/8,$"\x0b\x8a\xaf\x1f\xfc\x24\x76\xcc"
-DATA ·d+17104(SB)/8,$"\x22\x1e\x60\xda\x0a\xaa\xc8\x39"
-DATA ·d+17112(SB)/8,$"\x8c\x75\x38\x1d\x05\x57\xb8\x92"
-DATA ·d+17120(SB)/8,$"\x17\x91\xa3\xd5\xe4\x89\xdf\xb7"
-DATA ·d+17128(SB)/8,$"\xfa\x3e\xe3\xfa\x30\x00\xfe\x15"
-DATA ·d+17136(SB)/8,$"\xcf\x3a\xd7\x5a\xd2\x49\xbe\xeb"
-DATA ·d+17144(SB)/8,$"\xf5\x3d\x4c\x3d\x16\x9d\xbd\xab"
-DATA ·d+17152(SB)/8,$"\x7e\x8d\xfe\x45\x69\xf5\x3d\x27"
-DATA ·d+17160(SB)/8,$"\xcd\x6b\x7b\x18\x44\x99\x5c\x3e"
-DATA ·d+17168(SB)/8,$"\xc5\xd9\xf8\x27\x56\xc6\x2b\x25"
-DATA ·d+17176(SB)/8,$"\x74\xe8\xdd\x4c\xd0\x59\x2f\x07"
-DATA ·d+17184(SB)/8,$"\x86\x69\xc8\x3c\xff\x00\xb3\x90"
-DATA ·d+17192(SB)/8,$"\x6b\x6a\x3b\x6f\x0f\xca\xd8\x5b"
-DATA ·d+17200(SB)/8,$"\xff\x00\xb3\xba\x9c\xa8\x48\x0a"
-DATA ·d+17208(SB)/8,$"\x92\x31\xfd\xe5\x06\xb5\x5b\x40"
-DATA ·d+17216(SB)/8,$"\xb2\x89\xa2\x69\x58\xcb\xe6\x29"
-DATA ·d+17224(SB)/8,$"\x68\xd9\x58\xbb\x9c\x1e\xdb\x46"
-DATA ·d+17232(SB)/8,$"\x7f\x02\x2b\x9a\x54\xa5\x53\xde"
-DATA ·d+17240(SB)/8,$"\x95\xad\xf2\xff\x00\x82\xce\xe5"
-DATA ·d+17248(SB)/8,$"\x8a\x8d\x2b\x28\xdf\x5e\xf7\x38"
-DATA ·d+17256(SB)/8,$"\xc4\xb4\xd6\xe2\xf9\x8d\xbb\x95"
-DATA ·d+17264(SB)/8,$"\xe8\x73\xc0\x1f\x8e\x45\x6d\xd9"
-DATA ·d+17272(SB)/8,$"\x6b\x4d\x0b\x79\x73\x12\xb2\x03"
-DATA ·d+17280(SB)/8,$"\xc1\x40\xae\x41\x1f\x87\xf5\x35"
-DATA ·d+17288(SB)/8,$"\xda\xc7\xa7\xda\xc1\x10\x17\x32"
-DATA ·d+17296(SB)/8,$"\x13\x26\xed\xab\x1c\xf1\x96\x7d"
-DATA ·d+17304(SB)/8,$"\xb8\xce\x48\x18\x3f\x4a\xd3\x48"
-DATA ·d+17312(SB)/8,$"\x6e\xd0\x95\x6f\xb2\x44\xac\xa7"
-DATA ·d+17320(SB)/8,$"\x0e\xb1\x15\x2a\x3d\xc1\x03\x15"
-DATA ·d+17328(SB)/8,$"\xe9\xe1\xb2\xe9\x45\xa7\x4e\xa7"
-DATA ·d+17336(SB)/8,$"\xe4\xce\x0c\x4e\x6b\x19\x2e\x59"
-DATA ·d+17344(SB)/8,$"\x42\xff\x00\x87\xe8\xce\x65\xec"
-DATA ·d+17352(SB)/8,$"\xa6\x2d\xf6\xfc\x5c\x4b\xce\x43"
-DATA ·d+17360(SB)/8,$"\x1c\xa9\x52\x7d\x8a\x80\x3e\x99"
-DATA ·d+17368(SB)/8,$"\x34\xe5\xf1\x2d\xed\xa3\x1f\xb4"
-DATA ·d+17376(SB)/8,$"\x5a\xcc\xe1\x78\x0c\xc9\x93\xbb"
-DATA ·d+17384(SB)/8,$"\x18\x07\xe5\x05\x6b\x4e\xf1\xde"
-DATA ·d+17392(SB)/8,$"\xdc\xf9\x16\xec\xf2\x99\x10\xa9"
-DATA ·d+17400(SB)/8,$"\x55\x94\x95\x7c\x7f\xb3\xf3\x0e"
-DATA ·d+17408(SB)/8,$"\x7d\xeb\x87\x36\x42\x4b\xb1\x24"
-DATA ·d+17416(SB)/8,$"\x8d\xf6\x2d\xa7\xe7\x8f\xcc\x6f"
-DATA ·d+17424(SB)/8,$"\x9f\x6f\x63\x8c\x29\xfe\x7e\xf4"
-DATA ·d+17432(SB)/8,$"\xf1\x58\x89\xd2\x92\x54\x77\x7b"
-DATA ·d+17440(SB)/8,$"\xff\x00\x5a\x91\x85\x8d\x3a\xc9"
-DATA ·d+17448(SB)/8,$"\xba\xdb\x74\xfe\xbf\xe0\x12\x2d"
-DATA ·d+17456(SB)/8,$"\xe6\xab\x7f\x75\x25\xec\x76\x8b"
-DATA ·d+17464(SB)/8,$"\x86\x39\x06\x79\x0a\xe3\x1e\x8b"
-DATA ·d+17472(SB)/8,$"\x90\x3f\x0c\x56\x9a\xc5\xe2\x51"
-DATA ·d+17480(SB)/8,$"\x85\x4b\x75\x89\x4a\xe4\xb2\x6c"
-DATA ·d+17488(SB)/8,$"\x90\xe3\xd7\x9c\xf5\xf4\xa4\x5d"
-DATA ·d+17496(SB)/8,$"\x49\x2c\xa4\xff\x00\x46\x28\x0f"
-DATA ·d+17504(SB)/8,$"\xf0\x04\x8b\x78\x23\xa7\x25\xcf"
-DATA ·d+17512(SB)/8,$"\xf2\x14\xb7\x36\x1a\xbe\xaf\x74"
-DATA ·d+17520(SB)/8,$"\x19\xe5\x9a\x37\x38\x01\x00\x5c"
-DATA ·d+17528(SB)/8,$"\x60\xf7\xc0\x38\xc7\xad\x73\x46"
-DATA ·d+17536(SB)/8,$"\x84\x5c\x5b\xbb\x93\xf2\xb7\xfc"
-DATA ·d+17544(SB)/8,$"\x3f\xe4\x74\x3a\x8e\xeb\x44\x95"
-DATA ·d+17552(SB)/8,$"\xbc\xdb\xfc\xc9\x6c\xb5\xfb\x9d"
-DATA ·d+17560(SB)/8,$"\x36\x48\x52\xf5\xa4\x55\x5e\x3c"
-DATA ·d+17568(SB)/8,$"\xb7\x8a\x3e\x4f\xf7\x83\x00\x0e"
-DATA ·d+17576(SB)/8,$"\x3d\xb8\xfa\xd6\xf5\xde\xa1\xf6"
-DATA ·d+17584(SB)/8,$"\xf8\x84\x76\x0e\xf2\xbe\xe1\x29"
-DATA ·d+17592(SB)/8,$"\x56\x48\xd8\x31\xe3\xbe\x72\x06"
-DATA ·d+17600(SB)/8,$"\x6b\xcf\xb5\xad\x1e\x1f\x0e\x91"
-DATA ·d+17608(SB)/8,$"\x26\xbd\x78\x23\xf3\x1b\x11\x14"
-DATA ·d+17616(SB)/8,$"\x52\x59\xbe\x88\xb9\x3f\xd2\xb5"
-DATA ·d+17624(SB)/8,$"\x34\xdd\x29\x60\x89\x2f\xd1\x96"
-DATA ·d+17632(SB)/8,$"\xea\x39\x06\x52\x58\x1c\x97\x3e"
-DATA ·d+17640(SB)/8,$"\xbc\x76\x3e\xb9\xaa\xc3\x63\xaa"
-DATA ·d+17648(SB)/8,$"\xc2\x4f\x0f\x2e\x9b\xa6\xee\xd7"
-DATA ·d+17656(SB)/8,$"\xe2\xff\x00\xc8\x9c\x46\x0a\x8c"
-DATA ·d+17664(SB)/8,$"\xe3\x1c\x4c\x3e\x4e\xd6\x4c\xd6"
-DATA ·d+17672(SB)/8,$"\x94\x6b\xf2\x4c\x1f\x6c\x71\xc9"
-DATA ·d+17680(SB)/8,$"\x92\x7f\x76\xc4\x31\xf5\xe0\x60"
-DATA ·d+17688(SB)/8,$"\x7e\x95\xa1\xf6\xbd\x6a\xd9\x7c"
-DATA ·d+17696(SB)/8,$"\xcb\xd2\x76\x28\xf9\xda\x46\x00"
-DATA ·d+17704(SB)/8,$"\x8e\x3a\x73\xfe\x35\x91\x73\xab"
-DATA ·d+17712(SB)/8,$"\x6b\x91\x48\xb2\x43\x03\x22\xaa"
-DATA ·d+17720(SB)/8,$"\xed\x2d\x79\x26\x48\x52\x79\xc7"
-DATA ·d+17728(SB)/8,$"\x4e\x3f\x3a\xcc\xd3\x6d\x74\xeb"
-DATA ·d+17736(SB)/8,$"\x9b\xf9\xaf\x35\x59\xfe\xd3\xe6"
-DATA ·d+17744(SB)/8,$"\x74\x8a\x59\x57\x6a\x60\xf0\x48"
-DATA ·d+17752(SB)/8,$"\xce\x3b\xfe\x15\xda\xf1\xce\x33"
-DATA ·d+17760(SB)/8,$"\x51\xa5\x7d\x7b\xbb\x2f\xcb\x5f"
-DATA ·d+17768(SB)/8,$"\x92\x30\xfa\xa7\x34\x1c\xaa\x25"
-DATA ·d+17776(SB)/8,$"\x65\xdb\x57\xe9\xb9\xb9\xad\xde"
-DATA ·d+17784(SB)/8,$"\xda\x5c\x69\x98\xf3\x2d\xed\xa6"
-DATA ·d+17792(SB)/8,$"\x25\x0a\x4e\x5d\x24\xe3\x38\x3f"
-DATA ·d+17800(SB)/8,$"\x7b\x39\x24\x74\xc0\xeb\x56\xa3"
-DATA ·d+17808(SB)/8,$"\xd2\x1a\x1f\xf8\xfc\xbd\x9d\xad"
-DATA ·d+17816(SB)/8,$"\xca\x66\x16\x8d\x16\x36\x0f\xdf"
-DATA ·d+17824(SB)/8,$"\x25\x11\x49\x1f\x5a\xa3\x2c\xfa"
-DATA ·d+17832(SB)/8,$"\x73\x45\x23\xc5\xf6\x68\x8c\x67"
-DATA ·d+17840(SB)/8,$"\x23\x6a\xa1\xf3\x0f\x60\xbb\x5b"
-DATA ·d+17848(SB)/8,$"\x1c\x7e\x18\xac\xd8\x6d\x93\x53"
-DATA ·d+17856(SB)/8,$"\x86\x59\x6e\xaf\x70\x55\xb0\x23"
-DATA ·d+17864(SB)/8,$"\xe7\x71\xf7\x03\x3f\xfd\x6a\x89"
-DATA ·d+17872(SB)/8,$"\xd5\x8d\x4a\xbc\xd2\x57\x6f\x6b"
-DATA ·d+17880(SB)/8,$"\x3b\x2d\x2f\xfd\x6c\x5c\x21\x28"
-DATA ·d+17888(SB)/8,$"\x52\xe5\x4e\xca\xfa\xe9\x7d\xed"
-DATA ·d+17896(SB)/8,$"\xeb\xf9\x90\x4f\xa5\xe9\xf1\x29"
-DATA ·d+17904(SB)/8,$"\x65\xd4\x65\x0c\x7e\xf4\x77\x19"
-DATA ·d+17912(SB)/8,$"\x23\xe8\x49\x63\xfc\xa8\x1a\x8f"
-DATA ·d+17920(SB)/8,$"\x98\xbf\x67\x8e\xf2\xc0\x8d\xbb"
-DATA ·d+17928(SB)/8,$"\x47\xee\xce\x41\xfa\x91\xda\xa9"
-DATA ·d+17936(SB)/8,$"\x2d\x9e\x9a\xb3\x2b\x4e\xf8\x19"
-DATA ·d+17944(SB)/8,$"\xda\xfe\x74\xa3\xa7\x62\x15\x01"
-DATA ·d+17952(SB)/8,$"\x3f\x5c\x91\x5d\x0b\xdb\x59\x4d"
-DATA ·d+17960(SB)/8,$"\xa6\x98\x74\xab\x71\xe7\x06\x0d"
-DATA ·d+17968(SB)/8,$"\xb9\x86\xe8\xf6\xf5\xe3\x95\x27"
-DATA ·d+17976(SB)/8,$"\xeb\xfa\x57\x3e\x1d\x37\xcc\xe1"
-DATA ·d+17984(SB)/8,$"\x1b\x5b\xb5\xf5\x3b\x6a\x34\xb9"
-DATA ·d+17992(SB)/8,$"\x54\xdd\xef\xd7\x4b\x22\x8c\x8d"
-DATA ·d+18000(SB)/8,$"\x76\xd6\xaa\xeb\x36\x9e\x00\x23"
-DATA ·d+18008(SB)/8,$"\x98\x5d\x55\xc3\x0f\x63\x8e\x6a"
-DATA ·d+18016(SB)/8,$"\x93\xdd\xea\xd0\xdc\x07\x0b\x24"
-DATA ·d+18024(SB)/8,$"\x8e\x32\x44\x91\x47\x1c\x87\x27"
-DATA ·d+18032(SB)/8,$"\xb8\x23\x07\x9f\xad\x68\xcb\xa7"
-DATA ·d+18040(SB)/8,$"\xac\xf6\xb1\xbc\xe6\x3b\x76\x4d"
-DATA ·d+18048(SB)/8,$"\xcd\x27\x91\x12\x61\xc1\xe8\x46"
-DATA ·d+18056(SB)/8,$"\xf0\xf8\xc7\xb1\xe6\xaa\x5c\x6b"
-DATA ·d+18064(SB)/8,$"\x96\x7a\x20\x45\xb4\x92\x79\x70"
-DATA ·d+18072(SB)/8,$"\x41\x60\xcd\x8f\xcb\x0a\x73\xf8"
-DATA ·d+18080(SB)/8,$"\x8a\xaa\xb2\xd1\x4e\x57\x4b\xbd"
-DATA ·d+18088(SB)/8,$"\xf5\xfb\xb5\x65\x51\x5f\x66\x9a"
-DATA ·d+18096(SB)/8,$"\x4d\xfa\x7e\xbe\x66\x5d\xcd\xde"
-DATA ·d+18104(SB)/8,$"\xa5\x27\x3a\x8d\x94\xf2\x20\x39"
-DATA ·d+18112(SB)/8,$"\x2c\xc1\x93\xf1\x3c\xe0\x7e\x75"
-DATA ·d+18120(SB)/8,$"\x5a\xcb\x5a\xd4\x64\x32\xc7\xa5"
-DATA ·d+18128(SB)/8,$"\x5a\xdb\xf9\x7b\x40\x74\x57\xc9"
-DATA ·d+18136(SB)/8,$"\x00\x9e\xf8\x24\x9a\xd7\xff\x00"
-DATA ·d+18144(SB)/8,$"\x84\xf4\xde\x4d\xe5\xdd\x2a\xa4"
-DATA ·d+18152(SB)/8,$"\x4c\x42\x90\x1b\x70\x0a\x78\x38"
-DATA ·d+18160(SB)/8,$"\xca\xe4\x9f\xae\x05\x2c\xb7\xda"
-DATA ·d+18168(SB)/8,$"\x51\xf9\xe0\xb8\x86\x14\x07\x2b"
-DATA ·d+18176(SB)/8,$"\x12\xc6\xc8\x76\xf4\xe1\xd6\xb9"
-DATA ·d+18184(SB)/8,$"\x65\x55\x54\x92\x74\xab\x6d\xbd"
-DATA ·d+18192(SB)/8,$"\xd2\x4c\xe9\x8c\x25\x15\xcb\x56"
-DATA ·d+18200(SB)/8,$"\x8f\xa6\xb7\x5f\x70\xc6\x17\xf0"
-DATA ·d+18208(SB)/8,$"\xda\x8f\xb6\x64\xa8\x1b\xd6\x08"
-DATA ·d+18216(SB)/8,$"\x89\x93\xf5\x1c\x8a\x17\xc4\x76"
-DATA ·d+18224(SB)/8,$"\x4a\xa1\x5a\x29\xf2\x06\x0f\x2f"
-DATA ·d+18232(SB)/8,$"\xff\x00\xc5\x55\x69\x6e\xed\x36"
-DATA ·d+18240(SB)/8,$"\xac\x90\xde\xca\xff\x00\x36\x02"
-DATA ·d+18248(SB)/8,$"\xc6\xa3\x81\xea\x49\x20\xd6\x88"
-DATA ·d+18256(SB)/8,$"\x9e\x4f\xe0\xd4\x23\xc7\x6c\xc3"
-DATA ·d+18264(SB)/8,$"\xce\x3d\xf8\xae\x95\x57\xa2\x97"
-DATA ·d+18272(SB)/8,$"\xe4\xff\x00\x53\x25\x0b\xab\xca"
-DATA ·d+18280(SB)/8,$"\x3f\x9a\xfd\x0f\xff\xd5\xfc\x45"
-DATA ·d+18288(SB)/8,$"\xb7\xf1\x3f\x83\xaf\xa3\xfb\x2d"
-DATA ·d+18296(SB)/8,$"\xd4\xf7\x30\xba\xf0\x42\xcc\x5b"
-DATA ·d+18304(SB)/8,$"\xf4\x3d\x6a\x46\x8f\x43\x23\xcc"
-DATA ·d+18312(SB)/8,$"\xb1\xfb\x7d\xd9\x3c\x8f\xdd\xb0"
-DATA ·d+18320(SB)/8,$"\xfd\x78\xa9\x3c\x3f\x63\xa8\xe9"
-DATA ·d+18328(SB)/8,$"\xf7\x7f\x64\xb8\xd2\x34\xf7\x99"
-DATA ·d+18336(SB)/8,$"\xf1\x86\xbb\x2a\xbb\x73\xdc\x6c"
-DATA ·d+18344(SB)/8,$"\x50\x07\xe2\x6b\xa9\xba\xbc\xb9"
-DATA ·d+18352(SB)/8,$"\xd3\xe5\x68\xae\xec\xd1\x93\x3b"
-DATA ·d+18360(SB)/8,$"\x8c\xf6\x72\xed\x45\xcf\x60\xce"
-DATA ·d+18368(SB)/8,$"\x40\xe3\xe9\x5f\xe9\x5e\x17\x13"
-DATA ·d+18376(SB)/8,$"\x27\x49\x3a\xd6\x56\xed\x1d\xbf"
-DATA ·d+18384(SB)/8,$"\x4f\xc0\xff\x00\x1a\xf1\x91\x8c"
-DATA ·d+18392(SB)/8,$"\x2a\x38\xd1\xbb\xbe\xbf\x12\xb3"
-DATA ·d+18400(SB)/8,$"\xf4\xd2\xe7\x0c\x26\xd7\x66\x7f"
-DATA ·d+18408(SB)/8,$"\x22\xc6\xde\x5b\x35\xff\x00\x9e"
-DATA ·d+18416(SB)/8,$"\x8c\xea\x08\xff\x00\xbe\xcd\x54"
-DATA ·d+18424(SB)/8,$"\xbe\xf0\xc6\xa3\x3c\x7e\x6d\xf6"
-DATA ·d+18432(SB)/8,$"\xad\x3c\xcc\x46\x4c\x70\xc7\x93"
-DATA ·d+18440(SB)/8,$"\xc7\xb8\xe2\xbd\x6a\x2b\xe9\x2e"
-DATA ·d+18448(SB)/8,$"\x6c\x65\x86\xd1\x5a\xee\x37\x5d"
-DATA ·d+18456(SB)/8,$"\x9b\x99\xf1\xb3\x23\xfb\xeb\x93"
-DATA ·d+18464(SB)/8,$"\x9f\x71\x8a\xe6\x5f\xc2\x37\xf2"
-DATA ·d+18472(SB)/8,$"\x29\x33\x42\x23\x43\x9c\x98\xe4"
-DATA ·d+18480(SB)/8,$"\x69\x18\xfb\x16\x90\x9a\xd2\xbe"
-DATA ·d+18488(SB)/8,$"\x05\xd4\x4a\x4a\xf2\x5d\xf5\x4b"
-DATA ·d+18496(SB)/8,$"\xee\x5a\x0b\x0d\x9d\xaa\x72\x71"
-DATA ·d+18504(SB)/8,$"\x9d\xa1\x6f\x47\x7f\xbf\x53\xcc"
-DATA ·d+18512(SB)/8,$"\x6c\x2d\xb5\x5b\x69\x8f\xf6\x5c"
-DATA ·d+18520(SB)/8,$"\x85\x47\x77\xba\x54\xe8\x3b\x92"
-DATA ·d+18528(SB)/8,$"\xe7\x03\xdf\x35\xd7\x69\xb1\xdd"
-DATA ·d+18536(SB)/8,$"\xcf\x23\x8d\x72\x5d\xa8\x83\x76"
-DATA ·d+18544(SB)/8,$"\xe5\x87\x62\x38\xff\x00\x61\x90"
-DATA ·d+18552(SB)/8,$"\x7c\xdf\xf0\x1a\xb7\x73\xa2\xda"
-DATA ·d+18560(SB)/8,$"\x59\x29\x8a\x7d\x32\x0b\xae\x06"
-DATA ·d+18568(SB)/8,$"\x4d\xdc\xd1\x24\x78\xfa\x2e\x0d"
-DATA ·d+18576(SB)/8,$"\x4e\x7c\x55\x73\x63\xa7\x8b\x05"
-DATA ·d+18584(SB)/8,$"\xb7\xd3\xfc\xa8\xc6\xd8\x63\xb7"
-DATA ·d+18592(SB)/8,$"\xb9\x7c\x44\x3d\x00\x51\x8e\x3d"
-DATA ·d+18600(SB)/8,$"\xeb\x92\x8d\x29\xd2\x9d\xea\x4a"
-DATA ·d+18608(SB)/8,$"\xc9\x74\x57\x7f\x23\xd3\xc4\xe2"
-DATA ·d+18616(SB)/8,$"\x5d\x78\xde\x94\x6e\xdf\x5f\x77"
-DATA ·d+18624(SB)/8,$"\xfc\xdb\xfc\x8e\x53\x57\xd5\xe2"
-DATA ·d+18632(SB)/8,$"\x95\x5a\xde\xde\x5d\x4a\x38\xd1"
-DATA ·d+18640(SB)/8,$"\x8f\x94\x90\x40\x71\xce\x39\xcb"
-DATA ·d+18648(SB)/8,$"\x1c\xfd\x32\x2b\x96\xb6\xb8\xf1"
-DATA ·d+18656(SB)/8,$"\x7c\x12\x17\xd1\x85\xe3\xe7\xa3"
-DATA ·d+18664(SB)/8,$"\x4f\x00\x76\xfd\x54\xe2\xb5\x8f"
-DATA ·d+18672(SB)/8,$"\xc4\xcd\x77\x4b\x98\xb9\xb6\x33"
-DATA ·d+18680(SB)/8,$"\xaf\x20\x23\xa8\x2b\x8f\xf8\x08"
-DATA ·d+18688(SB)/8,$"\x07\xf5\xaa\x76\xde\x39\xf1\x16"
-DATA ·d+18696(SB)/8,$"\xa2\xaf\x69\x69\x6b\x79\x1a\x39"
-DATA ·d+18704(SB)/8,$"\xcb\x25\xbe\x4f\x3e\xbc\x92\x6b"
-DATA ·d+18712(SB)/8,$"\xcc\xa9\x8e\xa1\x52\x7a\xce\x49"
-DATA ·d+18720(SB)/8,$"\xf9\x27\xff\x00\x05\x1e\xc5\x0c"
-DATA ·d+18728(SB)/8,$"\x36\x22\x14\xed\xec\xa2\xd7\x9b"
-DATA ·d+18736(SB)/8,$"\x56\xfc\xae\x54\xd4\xb5\x6d\x55"
-DATA ·d+18744(SB)/8,$"\x0f\x99\xe2\xc0\x38\xe9\xe7\x22"
-DATA ·d+18752(SB)/8,$"\xf4\xf5\xf2\xd3\x6d\x72\x93\x78"
-DATA ·d+18760(SB)/8,$"\xde\x1b\x0b\xbf\x37\x42\x82\x36"
-DATA ·d+18768(SB)/8,$"\x61\xd1\xa5\x87\x6a\x83\xec\xa0"
-DATA ·d+18776(SB)/8,$"\x9c\xfe\x26\xbb\x2b\x6b\x08\x2f"
-DATA ·d+18784(SB)/8,$"\xee\x16\x29\xe1\x96\x49\x24\x7c"
-DATA ·d+18792(SB)/8,$"\x05\x95\xa2\x5d\xcc\x7b\x64\x9c"
-DATA ·d+18800(SB)/8,$"\x8a\xe9\x6f\x74\x79\x2c\x61\xfb"
-DATA ·d+18808(SB)/8,$"\x2d\x9d\x96\x95\x62\xe0\xf3\x34"
-DATA ·d+18816(SB)/8,$"\xf3\x2c\xad\xf4\xc9\x05\x47\x3e"
-DATA ·d+18824(SB)/8,$"\x95\xc7\x5a\x95\x7a\x89\xba\x55"
-DATA ·d+18832(SB)/8,$"\x2d\x6f\x56\xfe\xfd\x0f\x42\x18"
-DATA ·d+18840(SB)/8,$"\xba\x14\xda\x8d\x4a\x7b\xf4\xba"
-DATA ·d+18848(SB)/8,$"\x51\xfb\xb5\x67\x9d\x7f\xc2\x63"
-DATA ·d+18856(SB)/8,$"\x75\xe2\x11\xff\x00\x13\xbb\x5b"
-DATA ·d+18864(SB)/8,$"\xa7\xda\xbf\x7e\xdc\x2a\x29\xf4"
-DATA ·d+18872(SB)/8,$"\xf9\x3e\x5c\xfe\x06\x96\x2d\x66"
-DATA ·d+18880(SB)/8,$"\xce\xd6\x17\x44\xb0\x9a\x69\x0a"
-DATA ·d+18888(SB)/8,$"\x11\x12\xca\xcb\xb5\x1b\xb3\x6d"
-DATA ·d+18896(SB)/8,$"\x19\x27\x1e\x86\xb5\xb5\x2b\x8d"
-DATA ·d+18904(SB)/8,$"\x42\xd9\x4a\x5f\x5f\x69\x7b\x47"
-DATA ·d+18912(SB)/8,$"\x25\xe3\x5f\x30\xff\x00\xe3\xa3"
-DATA ·d+18920(SB)/8,$"\x15\xc6\x49\xe2\xab\x78\x12\x4f"
-DATA ·d+18928(SB)/8,$"\xb3\x5c\x49\x34\xae\x70\x53\xcb"
-DATA ·d+18936(SB)/8,$"\x28\xb8\xed\x8d\xc4\xe3\xe8\x05"
-DATA ·d+18944(SB)/8,$"\x79\x75\xb1\xf3\xa4\xf9\x6a\xcf"
-DATA ·d+18952(SB)/8,$"\x5f\x3e\x5b\x9e\xa6\x1b\x0b\x0a"
-DATA ·d+18960(SB)/8,$"\x8b\xf7\x74\xf4\xf2\x72\xb1\xaf"
-DATA ·d+18968(SB)/8,$"\xa7\x8f\x10\x5f\xb1\xfd\xcd\x84"
-DATA ·d+18976(SB)/8,$"\x0f\xb8\x36\xe0\xa1\x0f\x1e\x87"
-DATA ·d+18984(SB)/8,$"\x06\xab\xdd\xf8\x33\xc4\x37\xd7"
-DATA ·d+18992(SB)/8,$"\x04\xb5\xed\xb2\xf9\x9f\x7c\x42"
-DATA ·d+19000(SB)/8,$"\x3c\xd7\x39\xf5\x3d\x73\x58\x36"
-DATA ·d+19008(SB)/8,$"\x9a\xdf\x89\xcc\x9e\x62\x23\x4c"
-DATA ·d+19016(SB)/8,$"\xac\x77\x08\xbe\x60\x3e\x9d\x6a"
-DATA ·d+19024(SB)/8,$"\x5d\x53\xc5\xfe\x35\xbe\x9e\x18"
-DATA ·d+19032(SB)/8,$"\xec\x2c\x66\xd3\xbc\x81\xb5\x56"
-DATA ·d+19040(SB)/8,$"\xcc\x48\xa1\xcf\xab\x80\x7e\x63"
-DATA ·d+19048(SB)/8,$"\xef\x5c\xbf\x5c\xa0\xe9\xfe\xf2"
-DATA ·d+19056(SB)/8,$"\x12\x97\xdf\xa9\xdf\x0c\x36\x25"
-DATA ·d+19064(SB)/8,$"\x55\xb5\x19\x42\x3a\x79\x69\xf7"
-DATA ·d+19072(SB)/8,$"\xea\x5c\x9f\xe0\xfd\xf8\x80\xdc"
-DATA ·d+19080(SB)/8,$"\xa4\x68\x53\x20\x3c\xb7\x4d\x82"
-DATA ·d+19088(SB)/8,$"\x09\xfe\x22\xa0\x70\x2b\x94\x7f"
-DATA ·d+19096(SB)/8,$"\x87\x96\x16\xb3\x03\x7f\x38\xde"
-DATA ·d+19104(SB)/8,$"\x4f\xfa\xab\x72\x5d\xfe\xbd\x78"
-DATA ·d+19112(SB)/8,$"\xfc\x4d\x75\x7a\x5f\x89\x7c\x75"
-DATA ·d+19120(SB)/8,$"\x65\x13\xc7\xa9\xe9\xfe\x7a\x48"
-DATA ·d+19128(SB)/8,$"\x72\xed\x31\x94\x16\xfa\xf3\x5a"
-DATA ·d+19136(SB)/8,$"\x91\xf8\xd6\xf2\x2d\xf0\xb6\x99"
-DATA ·d+19144(SB)/8,$"\x14\x70\xb0\x3b\x11\x41\x21\x5b"
-DATA ·d+19152(SB)/8,$"\xd4\xe0\x0c\xfd\x0d\x6b\xf5\x7c"
-DATA ·d+19160(SB)/8,$"\x04\xb9\x65\xec\xdc\x5f\x66\x9f"
-DATA ·d+19168(SB)/8,$"\xfc\x0f\xcc\xb8\x63\xb3\x38\x73"
-DATA ·d+19176(SB)/8,$"\x42\x55\x23\x2f\x38\xb5\xfe\x6c"
-DATA ·d+19184(SB)/8,$"\xc0\x7f\x0b\x78\x3e\xdd\x11\x6c"
-DATA ·d+19192(SB)/8,$"\x92\x79\x5c\x0f\x9d\xd9\x01\xda"
-DATA ·d+19200(SB)/8,$"\x71\xeb\xbb\x6d\x4b\x20\xf0\xc6"
-DATA ·d+19208(SB)/8,$"\x98\x36\xdb\x58\x5e\x4a\xf8\x3b"
-DATA ·d+19216(SB)/8,$"\x76\xa9\x52\x4f\xfc\x03\x76\x7f"
-DATA ·d+19224(SB)/8,$"\xcf\x35\xd3\x27\x89\x35\x4b\xa2"
-DATA ·d+19232(SB)/8,$"\x3f\xd2\x1a\xd1\x3a\x16\x58\x51"
-DATA ·d+19240(SB)/8,$"\x40\x1f\x40\x09\xac\xfb\xf4\x8a"
-DATA ·d+19248(SB)/8,$"\x42\x6e\x23\xd5\x67\xb8\x93\x07"
-DATA ·d+19256(SB)/8,$"\xe5\x0f\xe5\x9e\x7d\xbd\xeb\xbe"
-DATA ·d+19264(SB)/8,$"\x54\x69\xa5\xcd\x46\x29\x7c\x97"
-DATA ·d+19272(SB)/8,$"\xf9\x9c\xf0\xc5\x55\x6d\x2a\xf3"
-DATA ·d+19280(SB)/8,$"\x6f\xe6\xff\x00\x34\x91\x88\x9e"
-DATA ·d+19288(SB)/8,$"\x24\xd6\x6d\x08\x82\x3d\x1e\xf9"
-DATA ·d+19296(SB)/8,$"\x6d\xfe\xf3\x34\xaa\x19\xb3\xea"
-DATA ·d+19304(SB)/8,$"\x06\x00\x1e\xdd\xea\x94\xde\x23"
-DATA ·d+19312(SB)/8,$"\x93\x28\xef\x0d\xf9\x85\x54\xab"
-DATA ·d+19320(SB)/8,$"\x9b\xa9\x55\x0e\x7d\x17\x03\x27"
-DATA ·d+19328(SB)/8,$"\xe8\x2a\x59\xdf\xc5\x36\xeb\xbe"
-DATA ·d+19336(SB)/8,$"\xc7\xcf\x58\xf1\xcb\x84\x69\x49"
-DATA ·d+19344(SB)/8,$"\xfc\x58\xe0\x7e\x02\xac\x5a\x6a"
-DATA ·d+19352(SB)/8,$"\xf7\xb2\x6d\x6f\x11\x47\x24\xeb"
-DATA ·d+19360(SB)/8,$"\xd0\x24\xc8\x15\x88\x1d\x87\x3f"
-DATA ·d+19368(SB)/8,$"\x28\x3e\xa3\x9a\xf3\xdc\xe7\x26"
-DATA ·d+19376(SB)/8,$"\xe0\xe4\xd7\xaa\x56\xfc\x0f\x4f"
-DATA ·d+19384(SB)/8,$"\x92\x9a\x8a\x9a\xa6\x9f\xa3\x77"
-DATA ·d+19392(SB)/8,$"\xfc\x4e\x4e\x7f\x16\xda\x5c\xdc"
-DATA ·d+19400(SB)/8,$"\x1d\x90\xdf\x65\x4e\x22\xc6\x42"
-DATA ·d+19408(SB)/8,$"\xed\xf4\x65\x24\xe7\xeb\x52\x8f"
-DATA ·d+19416(SB)/8,$"\x16\x68\x2b\x1a\xa6\xf7\xde\x4f"
-DATA ·d+19424(SB)/8,$"\xef\x14\x2b\x21\x03\xb8\xde\x0e"
-DATA ·d+19432(SB)/8,$"\x79\xf6\xe2\xbb\x1b\xe3\xe1\xa3"
-DATA ·d+19440(SB)/8,$"\x69\xf3\x2c\x71\x12\x78\x8e\x1c"
-DATA ·d+19448(SB)/8,$"\x92\x01\x3c\x82\x4e\x73\x81\xe9"
-DATA ·d+19456(SB)/8,$"\x54\xb4\xfb\x9f\x01\x7d\xa0\x47"
-DATA ·d+19464(SB)/8,$"\x79\x6a\x8a\x8a\xad\x89\xcc\x4c"
-DATA ·d+19472(SB)/8,$"\xcc\xc7\xb6\x7e\x5c\x63\xf3\xae"
-DATA ·d+19480(SB)/8,$"\x67\x42\x71\x97\x2b\xad\x1d\x7b"
-DATA ·d+19488(SB)/8,$"\x9d\x71\xc4\xd1\x70\xe6\x54\x25"
-DATA ·d+19496(SB)/8,$"\xa7\x63\x56\xd2\xff\x00\xc3\xba"
-DATA ·d+19504(SB)/8,$"\xa5\xa6\x34\xcb\xc0\x9e\x4e\x4a"
-DATA ·d+19512(SB)/8,$"\xc7\xc0\x90\x03\xc9\xf9\x9c\x9d"
-DATA ·d+19520(SB)/8,$"\xe3\xd7\xbd\x7a\x15\xfb\x49\x3f"
-DATA ·d+19528(SB)/8,$"\x80\x3c\x3f\x74\x58\x13\x3c\x9a"
-DATA ·d+19536(SB)/8,$"\x9b\x19\x1d\xca\xee\xd9\x32\xaf"
-DATA ·d+19544(SB)/8,$"\x03\x80\x4d\x70\x96\xb2\x68\xd7"
-DATA ·d+19552(SB)/8,$"\x11\x93\xa1\xdb\xab\xe3\xe6\xc4"
-DATA ·d+19560(SB)/8,$"\x31\x2a\x85\xfa\xb1\x03\xf5\x15"
-DATA ·d+19568(SB)/8,$"\xea\x5e\x28\x4b\xd8\x7c\x01\xe1"
-DATA ·d+19576(SB)/8,$"\x76\x8d\x23\xf9\xad\xef\xe5\x70"
-DATA ·d+19584(SB)/8,$"\xdc\xe0\x35\xc0\xe4\x6c\xda\x30"
-DATA ·d+19592(SB)/8,$"\x2b\xd8\x4e\x4a\xa5\x16\x9a\x7b"
-DATA ·d+19600(SB)/8,$"\xed\xe9\xfe\x47\x89\x2e\x5e\x5a"
-DATA ·d+19608(SB)/8,$"\x8b\x55\xb6\x92\xb5\xd6\xbe\x47"
-DATA ·d+19616(SB)/8,$"\x94\xcb\x75\x7f\x13\xb5\xe4\x37"
-DATA ·d+19624(SB)/8,$"\x10\x48\xe8\x46\xc8\xa1\x72\x65"
-DATA ·d+19632(SB)/8,$"\x38\x3d\x4a\x80\x78\x1d\xf3\x54"
-DATA ·d+19640(SB)/8,$"\xee\xbc\x4e\x35\x87\x48\x6f\x55"
-DATA ·d+19648(SB)/8,$"\x77\x23\x7e\xfc\x79\x0c\x5f\x1d"
-DATA ·d+19656(SB)/8,$"\x8f\xdd\x40\x33\xdb\x26\xa0\x43"
-DATA ·d+19664(SB)/8,$"\xaa\x4d\x09\x8e\x38\xe2\x0a\x59"
-DATA ·d+19672(SB)/8,$"\xbe\x65\x87\x2c\xdf\x4e\x49\xfa"
-DATA ·d+19680(SB)/8,$"\xf3\x4f\x8b\x4e\xbc\x10\xb7\x95"
-DATA ·d+19688(SB)/8,$"\x24\xd6\xe9\x2e\x23\x69\x5c\xa0"
-DATA ·d+19696(SB)/8,$"\x0f\x8e\x71\xb4\x81\x90\x3f\x1a"
-DATA ·d+19704(SB)/8,$"\xc2\xad\x5c\x4c\x9d\xa1\x2b\x45"
-DATA ·d+19712(SB)/8,$"\xee\x9f\xfc\x39\xd7\x0a\x54\x56"
-DATA ·d+19720(SB)/8,$"\xb3\x5a\xad\x9a\xff\x00\x86\x34"
-DATA ·d+19728(SB)/8,$"\xb4\xa9\xb4\xeb\x57\x67\xf2\xe3"
-DATA ·d+19736(SB)/8,$"\x4b\x7d\xe1\x0d\xcc\xc3\x6a\xee"
-DATA ·d+19744(SB)/8,$"\x3c\x01\xf2\x61\x4f\x3f\xed\x62"
-DATA ·d+19752(SB)/8,$"\xb2\xf5\x79\xe5\xb5\xbf\x74\xb1"
-DATA ·d+19760(SB)/8,$"\xb8\xd2\xe3\x5d\xf8\x5c\xc9\x19"
-DATA ·d+19768(SB)/8,$"\x6c\x63\xfd\xad\xd8\xcf\xb1\xac"
-DATA ·d+19776(SB)/8,$"\xfb\x9f\x0c\xa0\x87\xe4\x9d\xa7"
-DATA ·d+19784(SB)/8,$"\xf2\xce\xe0\x8b\x19\xe3\xdf\x68"
-DATA ·d+19792(SB)/8,$"\x1b\x71\x55\xe6\xb1\xbf\x7d\xb0"
-DATA ·d+19800(SB)/8,$"\x6a\x13\x42\x91\x28\xc6\x18\x79"
-DATA ·d+19808(SB)/8,$"\x2d\x81\xec\x01\x27\xf1\xa9\x94"
-DATA ·d+19816(SB)/8,$"\xf1\x11\x8f\x2b\x8f\xf5\xf2\x36"
-DATA ·d+19824(SB)/8,$"\xa5\x0a\x2e\x5c\xea\x57\xf2\xfd"
-DATA ·d+19832(SB)/8,$"\x75\xff\x00\x80\x6a\xd9\xac\x1a"
-DATA ·d+19840(SB)/8,$"\xa9\x7b\x1b\xb9\x5a\x69\x08\xf3"
-DATA ·d+19848(SB)/8,$"\x26\x68\x32\xee\xc3\xfd\xf4\x60"
-DATA ·d+19856(SB)/8,$"\x47\xa0\x03\xa5\x67\x5c\x49\x7b"
-DATA ·d+19864(SB)/8,$"\xa3\x49\x23\x59\xe9\x93\x98\x01"
-DATA ·d+19872(SB)/8,$"\xc1\x9e\x79\x5c\x31\x55\xe9\xd5"
-DATA ·d+19880(SB)/8,$"\xc9\xfc\x33\x56\xad\xec\xfc\x18"
-DATA ·d+19888(SB)/8,$"\xa8\x9e\x75\xd4\xae\xca\x48\x0f"
-DATA ·d+19896(SB)/8,$"\x22\x6d\x88\x1f\x42\xc1\x41\x6a"
-DATA ·d+19904(SB)/8,$"\xd4\xb6\x36\x82\x75\x4b\x3b\xe8"
-DATA ·d+19912(SB)/8,$"\x02\x34\x5d\x0b\x24\x6a\x08\x3f"
-DATA ·d+19920(SB)/8,$"\x75\x81\x04\x9c\x8f\x4a\xda\x18"
-DATA ·d+19928(SB)/8,$"\x65\x24\x9b\x69\x4b\xbc\x5a\x6f"
-DATA ·d+19936(SB)/8,$"\xd0\xa7\x88\x51\x6d\x24\xda\xec"
-DATA ·d+19944(SB)/8,$"\xd3\x4b\xe5\xb7\xe2\x73\x69\xe2"
-DATA ·d+19952(SB)/8,$"\x8b\x42\x8e\xd2\x5a\xdc\x2b\x3f"
-DATA ·d+19960(SB)/8,$"\x41\x11\xc8\xc7\xd7\x93\xd6\xb0"
-DATA ·d+19968(SB)/8,$"\xa6\xbb\x7b\xd9\x57\xcd\x12\x8e"
-DATA ·d+19976(SB)/8,$"\xca\x88\x84\x91\xf4\xff\x00\xf5"
-DATA ·d+19984(SB)/8,$"\x57\xa4\xea\x3e\x0a\x80\xdd\x47"
-DATA ·d+19992(SB)/8,$"\xa8\xc4\x03\x5b\xc8\x80\x98\xe3"
-DATA ·d+20000(SB)/8,$"\x3e\x4f\x3d\xc8\x63\xd7\x3d\x46"
-DATA ·d+20008(SB)/8,$"\x78\xac\x8d\x56\xc6\x3b\x07\x13"
-DATA ·d+20016(SB)/8,$"\x06\x85\x61\x4f\xbb\x1d\xc4\xaa"
-DATA ·d+20024(SB)/8,$"\xb2\x03\xeb\x5a\x54\xa1\x5d\x45"
-DATA ·d+20032(SB)/8,$"\xaa\x8f\x45\xe9\xaf\x99\x54\x31"
-DATA ·d+20040(SB)/8,$"\xd8\x67\x25\xec\x56\xaf\xfa\xb1"
-DATA ·d+20048(SB)/8,$"\x8b\x05\xda\xdb\xc2\xcb\x20\x70"
-DATA ·d+20056(SB)/8,$"\x48\xe7\xe5\x64\x3c\xf7\xc2\x80"
-DATA ·d+20064(SB)/8,$"\x0d\x24\x1a\x8e\x8d\x6a\xe5\x95"
-DATA ·d+20072(SB)/8,$"\x63\x42\x78\x2c\x18\x16\xc8\xe7"
-DATA ·d+20080(SB)/8,$"\x00\x76\xa4\x96\xca\xed\x20\xfb"
-DATA ·d+20088(SB)/8,$"\x5c\x26\x19\x12\x56\x38\xdd\xfb"
-DATA ·d+20096(SB)/8,$"\xc1\x93\x8f\xba\x8b\x58\x6f\xa3"
-DATA ·d+20104(SB)/8,$"\x4f\x2c\x99\x68\x20\x66\x27\x18"
-DATA ·d+20112(SB)/8,$"\x78\xce\xef\xd7\x18\xa8\x95\x5a"
-DATA ·d+20120(SB)/8,$"\xf0\x49\xc2\x37\xf9\x1d\xf4\xa1"
-DATA ·d+20128(SB)/8,$"\x46\x77\x53\x95\xae\x3f\x50\xbc"
-DATA ·d+20136(SB)/8,$"\xd4\x75\x6b\xc5\x70\x91\x38\x8c"
-DATA ·d+20144(SB)/8,$"\x82\x9b\x87\x23\xd0\x97\x19\xe7"
-DATA ·d+20152(SB)/8,$"\xeb\x56\xce\xab\x2c\x84\x49\x0d"
-DATA ·d+20160(SB)/8,$"\xbc\x52\x5c\x67\x0d\x2a\x90\xa8"
-DATA ·d+20168(SB)/8,$"\x0f\x39\xdc\x14\x13\x9c\xf7\xe0"
-DATA ·d+20176(SB)/8,$"\x1a\x83\xfb\x0e\xea\xdd\x0a\x98"
-DATA ·d+20184(SB)/8,$"\x03\xe3\x8f\x2e\x35\x3b\x7f\xe0"
-DATA ·d+20192(SB)/8,$"\x4d\x91\xfa\x55\x1b\xed\x3a\xe6"
-DATA ·d+20200(SB)/8,$"\x30\x26\x5d\x24\xc6\x8a\x77\x48"
-DATA ·d+20208(SB)/8,$"\x1a\x76\xda\xc3\xd8\x64\x10\x07"
-DATA ·d+20216(SB)/8,$"\xe3\x5c\x15\x21\x5e\x3c\xd3\x69"
-DATA ·d+20224(SB)/8,$"\xa6\xf5\x7a\x7f\xc0\xd0\xef\xa6"
-DATA ·d+20232(SB)/8,$"\xa9\x4d\x28\x2b\x59\x6d\xaf\xfc"
-DATA ·d+20240(SB)/8,$"\x14\x76\x52\x6a\x5a\x7a\x5a\x2f"
-DATA ·d+20248(SB)/8,$"\xf6\xd2\xa3\xcb\x2b\x6d\x58\xa3"
-DATA ·d+20256(SB)/8,$"\x0e\xfb\x8f\xa2\x31\x1d\xbb\xe4"
-DATA ·d+20264(SB)/8,$"\xd4\x17\x3e\x1f\xd1\xe0\x9b\xed"
-DATA ·d+20272(SB)/8,$"\xa9\x1c\x6f\x13\x05\x71\x6a\xa1"
-DATA ·d+20280(SB)/8,$"\x77\x8f\x51\x9c\xf6\xeb\x8c\xd7"
-DATA ·d+20288(SB)/8,$"\x9f\x23\x45\x78\xdf\xb8\x81\xf3"
-DATA ·d+20296(SB)/8,$"\xd0\x79\x77\x3b\x94\x63\xd0\x1c"
-DATA ·d+20304(SB)/8,$"\xd5\xd1\x1d\xb3\x93\x0d\xdd\xcb"
-DATA ·d+20312(SB)/8,$"\x44\xc8\x7e\xe3\x32\xb8\xfa\x76"
-DATA ·d+20320(SB)/8,$"\xfc\x8d\x6b\xfd\xa9\xed\x17\xef"
-DATA ·d+20328(SB)/8,$"\x22\x9a\x5b\x3f\xf3\x6e\xd7\xfc"
-DATA ·d+20336(SB)/8,$"\x8c\xff\x00\xb2\xdd\x37\xee\x4d"
-DATA ·d+20344(SB)/8,$"\xa7\xd5\x7f\x92\xe9\xf9\xf9\x9a"
-DATA ·d+20352(SB)/8,$"\x97\x37\x1a\x74\x27\xca\xb1\xd1"
-DATA ·d+20360(SB)/8,$"\xe7\xb9\x67\x2c\xb1\xb1\x91\x23"
-DATA ·d+20368(SB)/8,$"\x5c\xff\x00\xb5\x86\x27\x03\xe8"
-DATA ·d+20376(SB)/8,$"\x2b\x57\x40\xd3\x8b\xa9\x1a\xc5"
-DATA ·d+20384(SB)/8,$"\xad\xbc\x2d\xb3\x82\x03\x84\x73"
-DATA ·d+20392(SB)/8,$"\xe8\x0b\x31\xc8\xfc\xab\x2d\x3c"
-DATA ·d+20400(SB)/8,$"\x2f\x1a\x01\x31\x9d\x31\x8c\x8c"
-DATA ·d+20408(SB)/8,$"\x30\xdf\x9f\x4c\x67\x02\xb7\xf4"
-DATA ·d+20416(SB)/8,$"\xc7\xbb\xd3\x62\x13\xdc\x49\x33"
-DATA ·d+20424(SB)/8,$"\x06\x38\x53\x33\xee\x8c\xe3\xa0"
-DATA ·d+20432(SB)/8,$"\xc7\x7f\xa6\x6b\x5c\x15\x19\xfb"
-DATA ·d+20440(SB)/8,$"\x65\x56\xae\xcb\x65\xee\xdb\xe7"
-DATA ·d+20448(SB)/8,$"\x65\xa9\x96\x32\xac\x3d\x93\xa7"
-DATA ·d+20456(SB)/8,$"\x47\x77\xd7\x5b\xfc\xae\xf4\x32"
-DATA ·d+20464(SB)/8,$"\xf5\x18\x92\xdf\x30\xd8\xc7\x14"
-DATA ·d+20472(SB)/8,$"\x44\x72\x25\x12\xae\x7f\x0c\xf0"
-DATA ·d+20480(SB)/8,$"\x2b\x92\xd4\xb5\xad\x42\xdc\x0f"
-DATA ·d+20488(SB)/8,$"\xf4\x8b\x86\x91\x46\x04\x8b\x2a"
-DATA ·d+20496(SB)/8,$"\xb0\x5f\x61\xb4\x57\xa6\x41\x05"
-DATA ·d+20504(SB)/8,$"\xf4\x17\x05\x8a\x25\xca\xc8\x09"
-DATA ·d+20512(SB)/8,$"\x08\xec\xaa\x88\x18\xf4\x5c\xab"
-DATA ·d+20520(SB)/8,$"\x1e\xfc\x56\x45\xe5\xc6\x8d\x64"
-DATA ·d+20528(SB)/8,$"\xce\x6f\xa1\x70\x4a\xe5\x4c\x76"
-DATA ·d+20536(SB)/8,$"\xff\x00\x22\xe4\xe3\x0c\xed\x90"
-DATA ·d+20544(SB)/8,$"\xdf\x86\x0d\x18\xf4\xdf\xbd\xcd"
-DATA ·d+20552(SB)/8,$"\xc9\x7f\xeb\xd0\xac\x05\x64\x9f"
-DATA ·d+20560(SB)/8,$"\x2f\x27\x39\xe6\x90\x6a\xb7\x9e"
-DATA ·d+20568(SB)/8,$"\x77\xcb\x71\x39\x2c\x48\x21\x49"
-DATA ·d+20576(SB)/8,$"\x6c\x93\xd7\x20\xe6\xba\xa8\x20"
-DATA ·d+20584(SB)/8,$"\xd5\x6f\x6d\xf7\x82\xec\x14\xf2"
-DATA ·d+20592(SB)/8,$"\xf2\x46\x06\x3d\xb7\x13\xfd\x2a"
-DATA ·d+20600(SB)/8,$"\x94\x9a\xed\xad\xcc\xad\x6d\x15"
-DATA ·d+20608(SB)/8,$"\x8f\xd9\xd5\x7a\x4b\xe6\x44\x80"
-DATA ·d+20616(SB)/8,$"\x8f\xaa\xe5\x8f\xd0\x62\x88\xb5"
-DATA ·d+20624(SB)/8,$"\xcb\x14\x8f\xc9\x94\xc0\xc7\x76"
-DATA ·d+20632(SB)/8,$"\x3f\x7d\x96\x00\x76\xe8\xdc\xff"
-DATA ·d+20640(SB)/8,$"\x00\x9e\xb5\xe3\xe1\x31\x34\xd5"
-DATA ·d+20648(SB)/8,$"\xd3\xa8\xda\xf9\xfe\xa7\xbb\x5a"
-DATA ·d+20656(SB)/8,$"\x95\x49\xed\x4e\xcf\xe4\x5a\x8e"
-DATA ·d+20664(SB)/8,$"\xc5\x2e\x25\x31\x23\x5b\x0c\x63"
-DATA ·d+20672(SB)/8,$"\x00\xba\x96\x39\xad\xbb\x48\xbc"
-DATA ·d+20680(SB)/8,$"\xb5\xf2\x84\x30\xb3\x29\xc7\xf0"
-DATA ·d+20688(SB)/8,$"\xc8\xc7\xf0\x52\x78\xaa\x30\xdf"
-DATA ·d+20696(SB)/8,$"\xc3\x3a\x83\x0d\xc5\xb6\x31\x91"
-DATA ·d+20704(SB)/8,$"\xe5\xc5\xe5\xaf\x1d\xb7\x1f\xf1"
-DATA ·d+20712(SB)/8,$"\xad\x2b\x3f\x13\xd8\xdb\x33\xb3"
-DATA ·d+20720(SB)/8,$"\x9b\x78\xc3\x28\x21\xf0\x32\x49"
-DATA ·d+20728(SB)/8,$"\xeb\xc8\xff\x00\x0a\xf7\x70\xd5"
-DATA ·d+20736(SB)/8,$"\x30\xf1\x6a\xf2\x3c\x6c\x5a\xac"
-DATA ·d+20744(SB)/8,$"\xd3\x51\x8b\x76\xf5\x2c\xcf\x69"
-DATA ·d+20752(SB)/8,$"\xa2\xad\xbf\x99\xa9\xc3\x6f\x16"
-DATA ·d+20760(SB)/8,$"\xd6\x19\x67\xcc\x7c\x1e\xc7\xb6"
-DATA ·d+20768(SB)/8,$"\x47\xd6\xb1\x8d\xcf\x81\x33\xf2"
-DATA ·d+20776(SB)/8,$"\x5e\x5b\x63\xb6\x1f\xb5\x79\xf6"
-DATA ·d+20784(SB)/8,$"\xb4\xfa\x4c\xf7\xf2\x5e\xc1\x34"
-DATA ·d+20792(SB)/8,$"\xd7\x93\x4b\x27\x98\xf2\x4a\xa4"
-DATA ·d+20800(SB)/8,$"\xc6\xa7\x18\xc2\x29\xe0\x0c\x7a"
-DATA ·d+20808(SB)/8,$"\x0a\xa8\x20\xb9\xed\x6e\x3f\xef"
-DATA ·d+20816(SB)/8,$"\xda\xff\x00\x85\x78\x58\x9e\x20"
-DATA ·d+20824(SB)/8,$"\xac\xa6\xd5\x2a\x30\xb7\x9d\xdf"
-DATA ·d+20832(SB)/8,$"\xa6\xd6\x47\xaf\x43\x22\x8b\x8a"
-DATA ·d+20840(SB)/8,$"\x72\xad\x2f\xcb\xf3\xd4\xff\xd6"
-DATA ·d+20848(SB)/8,$"\xfe\x7d\x66\xf1\xbf\x85\x2f\xae"
-DATA ·d+20856(SB)/8,$"\xcc\xb7\x92\x5c\x45\x1f\x53\xb6"
-DATA ·d+20864(SB)/8,$"\x47\x23\x27\xb0\x1b\x45\x69\xa7"
-DATA ·d+20872(SB)/8,$"\x8e\x7c\x16\x2d\x04\x51\xab\xcc"
-DATA ·d+20880(SB)/8,$"\x01\xc8\xf3\xae\x9b\x04\xf6\xf9"
-DATA ·d+20888(SB)/8,$"\x00\x34\xf8\xfe\x0c\xf8\x7e\x6c"
-DATA ·d+20896(SB)/8,$"\x47\x7b\xae\x69\x10\x06\xe0\xfe"
-DATA ·d+20904(SB)/8,$"\xf4\x90\xbf\x5a\xa3\x7d\xf0\x3b"
-DATA ·d+20912(SB)/8,$"\x4e\xb0\xcc\xfa\x4d\xdc\x7a\x84"
-DATA ·d+20920(SB)/8,$"\x5d\x7c\xcb\x79\x50\x21\xfc\xce"
-DATA ·d+20928(SB)/8,$"\x7f\x4a\xfe\xe8\xa3\x2c\xd6\xed"
-DATA ·d+20936(SB)/8,$"\xf2\x46\x5d\xf6\x6f\xf0\x67\xf9"
-DATA ·d+20944(SB)/8,$"\x4d\x2a\xf9\x2c\x9a\x87\xb4\x92"
-DATA ·d+20952(SB)/8,$"\xf9\x3b\x7d\xfa\x7e\x67\x4f\xa4"
-DATA ·d+20960(SB)/8,$"\x7c\x43\xbf\xb9\x22\xd7\x4b\xb8"
-DATA ·d+20968(SB)/8,$"\xd1\xac\x62\x4c\x95\x2e\xcd\x96"
-DATA ·d+20976(SB)/8,$"\xe3\xb9\x6c\xe4\xfa\x71\x5d\x14"
-DATA ·d+20984(SB)/8,$"\x5a\x3c\x9e\x20\xb0\x1a\xbe\xb3"
-DATA ·d+20992(SB)/8,$"\xa9\x5d\x4b\x09\xce\x7e\xc3\x2e"
-DATA ·d+21000(SB)/8,$"\x4a\xf3\x8e\x63\xc7\x1e\xd5\xf3"
-DATA ·d+21008(SB)/8,$"\xfe\xb1\xf0\xea\xe2\xc1\x04\xf2"
-DATA ·d+21016(SB)/8,$"\x06\x44\x63\xd3\x72\xb6\x07\xe1"
-DATA ·d+21024(SB)/8,$"\x9a\xe6\xad\xec\xe1\xd3\x5f\x3b"
-DATA ·d+21032(SB)/8,$"\xee\x14\x11\xd6\x17\x2b\xfc\xba"
-DATA ·d+21040(SB)/8,$"\xd3\x87\x12\xe2\x68\xbf\x67\x8a"
-DATA ·d+21048(SB)/8,$"\xa5\x75\xfe\x2b\x1b\xff\x00\xab"
-DATA ·d+21056(SB)/8,$"\x58\x3a\xb1\xf6\xb8\x2a\xbc\xaf"
-DATA ·d+21064(SB)/8,$"\xca\x29\xbf\xbe\xf7\x3e\x92\x9f"
-DATA ·d+21072(SB)/8,$"\xc1\x7e\x0f\x88\x6d\x13\xea\xcc"
-DATA ·d+21080(SB)/8,$"\x4f\x23\xed\x4b\xe5\xaf\xe6\x54"
-DATA ·d+21088(SB)/8,$"\xd6\x6c\x96\x56\xda\x43\x19\x6d"
-DATA ·d+21096(SB)/8,$"\x43\x48\x8b\xc6\xd1\x2e\xec\x8f"
-DATA ·d+21104(SB)/8,$"\xc1\x4e\x6b\xcb\xec\xef\xd2\x42"
-DATA ·d+21112(SB)/8,$"\xa0\xea\x9a\x92\x01\xc0\x12\x16"
-DATA ·d+21120(SB)/8,$"\x23\xf5\x26\xba\xfb\x39\x16\x04"
-DATA ·d+21128(SB)/8,$"\x37\x10\x6b\x1e\x5b\x8e\x40\x78"
-DATA ·d+21136(SB)/8,$"\x89\x63\xf8\xe3\x15\xdf\x43\x36"
-DATA ·d+21144(SB)/8,$"\xa5\x3d\x55\x24\x9f\xaa\x3c\xfa"
-DATA ·d+21152(SB)/8,$"\xb9\x65\x58\x69\x52\xb3\x92\xec"
-DATA ·d+21160(SB)/8,$"\xd3\xfd\x11\xa3\xa9\xf8\xc2\xfd"
-DATA ·d+21168(SB)/8,$"\xe1\xf2\x74\xdb\x48\x61\xdb\xc1"
-DATA ·d+21176(SB)/8,$"\x64\x59\x3c\xc6\xed\xcb\x60\x7e"
-DATA ·d+21184(SB)/8,$"\x98\xab\x36\xbe\x3e\xd7\xda\xd5"
-DATA ·d+21192(SB)/8,$"\x2d\xaf\x2d\x64\x89\x10\x60\x35"
-DATA ·d+21200(SB)/8,$"\xa2\xec\x62\x3b\xe4\xb6\x49\x27"
-DATA ·d+21208(SB)/8,$"\xd4\x9a\xd4\xb2\xf1\x3e\x99\x15"
-DATA ·d+21216(SB)/8,$"\xba\xc9\xa9\x6b\x57\x05\x98\xfd"
-DATA ·d+21224(SB)/8,$"\xcb\x6b\x62\xec\xb8\xf5\x23\x8a"
-DATA ·d+21232(SB)/8,$"\xa9\xa8\x3c\xba\xa6\x7f\xb2\x75"
-DATA ·d+21240(SB)/8,$"\xb7\xda\xdd\xa6\x8d\xe2\x6c\x7e"
-DATA ·d+21248(SB)/8,$"\x2a\x6b\xb5\x54\x93\x97\xb6\xa5"
-DATA ·d+21256(SB)/8,$"\x59\xb9\x35\xb2\xb7\xf9\x9c\x89"
-DATA ·d+21264(SB)/8,$"\x53\xb2\xa5\x52\x82\x51\x4f\x77"
-DATA ·d+21272(SB)/8,$"\xcd\xfe\x46\x04\xba\xc7\x83\xb7"
-DATA ·d+21280(SB)/8,$"\x79\x97\xc2\x64\x94\xf2\xcb\x2d"
-DATA ·d+21288(SB)/8,$"\xa8\x38\xfa\x95\x22\xb2\xee\xfc"
-DATA ·d+21296(SB)/8,$"\x51\xe1\xc0\x9b\x6c\xed\x20\x90"
-DATA ·d+21304(SB)/8,$"\x76\x7f\x29\x94\xfd\x30\xcc\x45"
-DATA ·d+21312(SB)/8,$"\x47\x7b\xa0\xd9\xc2\xd9\xd4\xaf"
-DATA ·d+21320(SB)/8,$"\x84\xac\x47\x58\xf7\x31\x3e\xdd"
-DATA ·d+21328(SB)/8,$"\x2b\x0e\x68\xad\xec\xbf\x79\x65"
-DATA ·d+21336(SB)/8,$"\x69\x35\xc8\x1d\xd9\x48\x15\xe4"
-DATA ·d+21344(SB)/8,$"\x56\xc6\x57\x8b\x77\x49\x7e\x7f"
-DATA ·d+21352(SB)/8,$"\x99\xef\xe1\xf0\xd8\x79\x5a\xd2"
-DATA ·d+21360(SB)/8,$"\x93\xf9\xd9\x7e\x85\x0b\xaf\x15"
-DATA ·d+21368(SB)/8,$"\x68\x71\xb3\x1b\x9d\x3a\x29\x4e"
-DATA ·d+21376(SB)/8,$"\x36\xf5\x2b\x8f\x70\x14\xe3\x3f"
-DATA ·d+21384(SB)/8,$"\xa5\x41\x6b\xe3\xad\x1a\xd8\x00"
-DATA ·d+21392(SB)/8,$"\x34\xf4\x5d\xa4\x1c\xf9\x4a\xd9"
-DATA ·d+21400(SB)/8,$"\xc7\xa9\x35\x7a\x3f\x11\xc3\x09"
-DATA ·d+21408(SB)/8,$"\xdb\x71\xe1\xbb\x49\x78\xeb\x21"
-DATA ·d+21416(SB)/8,$"\x90\x1f\xcc\x54\xf6\x5e\x21\x82"
-DATA ·d+21424(SB)/8,$"\xda\x4f\x36\x5f\x0d\x41\x22\xe7"
-DATA ·d+21432(SB)/8,$"\x21\x49\x21\x40\xfc\x8d\x78\xbf"
-DATA ·d+21440(SB)/8,$"\x58\x9f\x3d\xfd\xaa\x5f\xf6\xe3"
-DATA ·d+21448(SB)/8,$"\xff\x00\x23\xdc\xf6\x11\xe4\xb7"
-DATA ·d+21456(SB)/8,$"\xb1\x6f\xfe\xdf\x5f\xe6\x69\xc1"
-DATA ·d+21464(SB)/8,$"\xf1\x72\x18\xa4\x12\x59\xd9\x5b"
-DATA ·d+21472(SB)/8,$"\xa3\x93\xc3\x61\x97\x69\xf5\x0a"
-DATA ·d+21480(SB)/8,$"\x32\x31\xf8\x56\xc4\x7f\x11\x74"
-DATA ·d+21488(SB)/8,$"\xd5\x90\x5e\x6a\xc9\x6c\xfb\x9f"
-DATA ·d+21496(SB)/8,$"\x7b\x9b\x51\x2a\xc8\x0f\xb9\x27"
-DATA ·d+21504(SB)/8,$"\x6e\x7d\xc5\x73\x9a\x8f\x8f\x6e"
-DATA ·d+21512(SB)/8,$"\x9e\x1f\x23\x4f\xd1\xac\x6c\x47"
-DATA ·d+21520(SB)/8,$"\x6f\x2a\x10\xe7\xf1\x66\x15\xc6"
-DATA ·d+21528(SB)/8,$"\x35\xb6\xbf\xac\x4a\x1d\xe1\x92"
-DATA ·d+21536(SB)/8,$"\x6c\xff\x00\x0a\x47\xc6\x3d\x80"
-DATA ·d+21544(SB)/8,$"\x02\xba\xea\x67\x78\x88\xb5\xc9"
-DATA ·d+21552(SB)/8,$"\x57\x9d\xff\x00\x86\xc7\x34\x32"
-DATA ·d+21560(SB)/8,$"\x5c\x2c\xe2\xdd\x5a\x5e\xcf\xfe"
-DATA ·d+21568(SB)/8,$"\xde\xbb\xfb\xcf\xa0\xe5\xf8\x91"
-DATA ·d+21576(SB)/8,$"\xe1\x19\xd0\x1d\x32\x7b\xc4\x99"
-DATA ·d+21584(SB)/8,$"\x87\x28\xaf\xd0\x9e\xc5\xa4\xcf"
-DATA ·d+21592(SB)/8,$"\xe9\x56\xad\x75\x0f\x0e\x6a\x50"
-DATA ·d+21600(SB)/8,$"\xf9\x9a\x95\xf4\x30\x39\xce\x5e"
-DATA ·d+21608(SB)/8,$"\x43\xbc\x9c\xf4\xfb\xa4\x62\xbc"
-DATA ·d+21616(SB)/8,$"\x87\x49\xf0\xdd\x94\x53\x2a\xeb"
-DATA ·d+21624(SB)/8,$"\x68\xf0\x92\x09\x54\xc8\x53\x9e"
-DATA ·d+21632(SB)/8,$"\xa3\x79\xe7\x02\xbb\x5d\x37\x56"
-DATA ·d+21640(SB)/8,$"\x96\x15\x68\xec\xf4\x9b\x17\xb7"
-DATA ·d+21648(SB)/8,$"\x46\xdb\xe6\x9c\xb1\xc9\xe3\xef"
-DATA ·d+21656(SB)/8,$"\x9f\x7f\x6a\xfa\x5c\x2e\x79\x59"
-DATA ·d+21664(SB)/8,$"\xd9\xe2\x12\x57\xec\x9f\xe4\x7c"
-DATA ·d+21672(SB)/8,$"\xde\x33\x25\xa1\x4e\x2e\x38\x69"
-DATA ·d+21680(SB)/8,$"\x49\xf9\xb6\xbf\x37\xb8\xed\x6f"
-DATA ·d+21688(SB)/8,$"\x4b\xd3\x56\x45\xfb\x35\xc4\xf7"
-DATA ·d+21696(SB)/8,$"\xa9\x23\x6d\x47\x8d\x0b\x47\xcf"
-DATA ·d+21704(SB)/8,$"\xa8\x40\xcc\x07\xd6\xb9\x8b\xdb"
-DATA ·d+21712(SB)/8,$"\x2d\x36\xc9\x8b\xdb\x5c\x5b\xb3"
-DATA ·d+21720(SB)/8,$"\x29\x38\x31\x89\x10\x13\xe9\xf3"
-DATA ·d+21728(SB)/8,$"\x29\xdd\xfa\x52\x6b\xd1\x78\xa2"
-DATA ·d+21736(SB)/8,$"\xeb\x24\x4b\x63\x68\x92\x1e\x04"
-DATA ·d+21744(SB)/8,$"\x1f\x2b\x7e\x68\xa4\xd6\x5e\x8f"
-DATA ·d+21752(SB)/8,$"\xe1\x4f\x12\x33\xfd\xae\x1d\x52"
-DATA ·d+21760(SB)/8,$"\xc9\x36\xb0\x0c\x66\x91\x9c\xfd"
-DATA ·d+21768(SB)/8,$"\x42\xba\xf3\xf8\x57\x8f\x8a\xcc"
-DATA ·d+21776(SB)/8,$"\x65\x3a\xce\x34\xe1\x7f\xeb\xa6"
-DATA ·d+21784(SB)/8,$"\xbf\x99\xee\x60\xf0\xca\x14\x14"
-DATA ·d+21792(SB)/8,$"\xea\xd6\xdb\xfa\xe8\xbf\x23\xa1"
-DATA ·d+21800(SB)/8,$"\xd2\xef\xb5\x9d\x59\x7c\xbb\x7b"
-DATA ·d+21808(SB)/8,$"\xa9\x03\x01\x80\xb0\xc0\xc1\xba"
-DATA ·d+21816(SB)/8,$"\x75\xce\x0e\x40\xf6\xe6\xb3\xa7"
-DATA ·d+21824(SB)/8,$"\xd0\x3c\x6b\x73\x14\x97\x36\x92"
-DATA ·d+21832(SB)/8,$"\x09\x0a\x1d\x81\x65\x8c\x99\x18"
-DATA ·d+21840(SB)/8,$"\x9e\xa4\x06\x5f\xd6\xba\x39\x75"
-DATA ·d+21848(SB)/8,$"\xc8\xb4\x88\xbe\xcd\xa8\x6a\xd3"
-DATA ·d+21856(SB)/8,$"\x4b\x2b\x60\x07\xb2\x85\x15\x81"
-DATA ·d+21864(SB)/8,$"\xcf\x6e\x01\xfc\x72\x2b\x9f\xb9"
-DATA ·d+21872(SB)/8,$"\xf1\x1e\x99\xa7\xdc\x99\xb6\xc3"
-DATA ·d+21880(SB)/8,$"\x7a\xf2\x3e\xe9\xe5\xbc\xbc\x78"
-DATA ·d+21888(SB)/8,$"\xdc\x1f\x5d\xb1\xb3\x82\xd5\xa6"
-DATA ·d+21896(SB)/8,$"\x2a\xbc\x6d\xcb\x52\xaf\x33\xeb"
-DATA ·d+21904(SB)/8,$"\xab\x4d\x7d\xdc\xc3\xc3\x42\xb3"
-DATA ·d+21912(SB)/8,$"\x6d\xd2\xa6\xad\xd3\x4b\xa7\xf7"
-DATA ·d+21920(SB)/8,$"\xf2\x9e\x6d\x7d\x67\xe3\xe8\x6f"
-DATA ·d+21928(SB)/8,$"\xbe\xc5\xa8\x47\xce\x79\x8d\xb1"
-DATA ·d+21936(SB)/8,$"\x13\x0f\x43\x8c\x8f\xe7\x5d\x4e"
-DATA ·d+21944(SB)/8,$"\x9f\xe1\xdd\x42\x4b\x2f\xb5\x4d"
-DATA ·d+21952(SB)/8,$"\x68\xe5\xa3\x61\xbd\xdd\xcb\x60"
-DATA ·d+21960(SB)/8,$"\x7b\xc6\x30\x48\xe7\xa8\x35\x45"
-DATA ·d+21968(SB)/8,$"\x75\xed\x27\x4f\xf1\x3b\x78\x92"
-DATA ·d+21976(SB)/8,$"\xce\x59\xbc\xe3\x09\x81\x86\x7e"
-DATA ·d+21984(SB)/8,$"\xd0\x40\x27\xf8\x1a\x62\x4a\xfa"
-DATA ·d+21992(SB)/8,$"\x74\xa8\xf5\x2f\x88\x52\x07\x47"
-DATA ·d+22000(SB)/8,$"\xb0\x6b\xd7\x78\x8e\xf8\xda\x69"
-DATA ·d+22008(SB)/8,$"\x0a\xe1\xbe\x8a\x70\x7f\x11\x5f"
-DATA ·d+22016(SB)/8,$"\x3b\x86\xaf\x46\x1c\xd3\xae\xde"
-DATA ·d+22024(SB)/8,$"\xee\xda\xdf\x4e\x8f\x63\xea\x2b"
-DATA ·d+22032(SB)/8,$"\xc3\x13\x51\x42\x14\xa9\xa5\xa2"
-DATA ·d+22040(SB)/8,$"\xbb\xb5\xbd\x74\xb9\xdb\xdc\xcb"
-DATA ·d+22048(SB)/8,$"\x79\xa4\x43\x0c\x50\xc0\x8b\x21"
-DATA ·d+22056(SB)/8,$"\x5d\xdb\xe0\x8d\x14\xf3\x8c\x65"
-DATA ·d+22064(SB)/8,$"\x7e\x76\x1f\x89\xfc\x2b\xd2\xbc"
-DATA ·d+22072(SB)/8,$"\x7d\x75\x2d\xa7\x84\x7c\x1d\x1d"
-DATA ·d+22080(SB)/8,$"\xca\xbb\xc9\x26\x81\x2c\xe6\x23"
-DATA ·d+22088(SB)/8,$"\x03\x61\xda\x49\xc9\xc9\x08\x46"
-DATA ·d+22096(SB)/8,$"\x0e\x47\x23\x18\xef\x5f\x2d\x37"
-DATA ·d+22104(SB)/8,$"\x8a\x35\xad\x46\x6f\x3a\xf8\x19"
-DATA ·d+22112(SB)/8,$"\x41\x38\x2b\x21\x60\x71\xd7\x8c"
-DATA ·d+22120(SB)/8,$"\x11\x5f\x49\xfc\x53\xf1\x0f\x88"
-DATA ·d+22128(SB)/8,$"\xb4\x2d\x0f\xc0\x4b\xa2\x46\xa1"
-DATA ·d+22136(SB)/8,$"\xdf\xc0\xb6\x37\x27\xcc\x06\x4c"
-DATA ·d+22144(SB)/8,$"\x79\xd2\x4a\x7f\x89\xb1\xdb\xa9"
-DATA ·d+22152(SB)/8,$"\xc9\xae\xaa\x79\xac\x27\x56\xf1"
-DATA ·d+22160(SB)/8,$"\x94\x94\x56\xdd\x7b\x2d\x11\x84"
-DATA ·d+22168(SB)/8,$"\xf2\x9a\x94\xe9\x7b\xc9\x39\x37"
-DATA ·d+22176(SB)/8,$"\xfe\x6f\x7d\xff\x00\x03\xc9\xde"
-DATA ·d+22184(SB)/8,$"\xce\xfb\x57\x4f\xb6\x2d\x84\xb1"
-DATA ·d+22192(SB)/8,$"\x60\x1e\x1e\x49\x3c\xb6\xc7\x38"
-DATA ·d+22200(SB)/8,$"\x04\x81\x8c\xf6\xa6\xac\x56\x96"
-DATA ·d+22208(SB)/8,$"\xf6\xcd\xaa\x5f\xd9\x4d\x0b\xc6"
-DATA ·d+22216(SB)/8,$"\xa7\x6d\xba\x62\x5c\x7a\x11\x97"
-DATA ·d+22224(SB)/8,$"\x07\x77\xb1\x18\xae\x47\x54\xf1"
-DATA ·d+22232(SB)/8,$"\x3f\x8c\x35\x07\x22\xe8\x40\xdd"
-DATA ·d+22240(SB)/8,$"\xf1\x12\x81\x8c\xff\x00\xbb\x8a"
-DATA ·d+22248(SB)/8,$"\xe7\x13\x59\xf1\x72\x6f\x8e\x06"
-DATA ·d+22256(SB)/8,$"\x52\xa7\x86\x8e\x55\x0e\x3f\x02"
-DATA ·d+22264(SB)/8,$"\x41\x3f\xad\x45\x6c\xd6\x9a\x95"
-DATA ·d+22272(SB)/8,$"\xe3\xcc\xfd\x52\xdf\xbd\x8e\xbc"
-DATA ·d+22280(SB)/8,$"\x36\x4f\x56\x50\xf7\x9c\x57\xcd"
-DATA ·d+22288(SB)/8,$"\xfd\xd7\x3d\x8f\x4e\xd5\xae\xae"
-DATA ·d+22296(SB)/8,$"\x00\x8b\xcc\x25\x24\x4d\xed\x18"
-DATA ·d+22304(SB)/8,$"\x0d\x24\xa8\x3d\x18\x2a\x28\x07"
-DATA ·d+22312(SB)/8,$"\x3c\x10\x1b\xf1\xac\xd3\xe1\x36"
-DATA ·d+22320(SB)/8,$"\xd4\x26\x93\x51\x12\x5f\x09\x0a"
-DATA ·d+22328(SB)/8,$"\xb6\x51\x7c\xe4\x45\x1c\xe7\x1f"
-DATA ·d+22336(SB)/8,$"\x36\x0f\xd7\xad\x79\xc5\x8e\xb9"
-DATA ·d+22344(SB)/8,$"\x7d\x6e\xe7\xce\xb5\xbd\x86\x43"
-DATA ·d+22352(SB)/8,$"\xfc\x56\xac\xc3\x35\xe9\x1a\x6f"
-DATA ·d+22360(SB)/8,$"\x88\x2e\xda\xd3\xcb\x9e\x4b\xc8"
-DATA ·d+22368(SB)/8,$"\x58\x90\x57\xed\x8c\xa1\x08\xf5"
-DATA ·d+22376(SB)/8,$"\x20\xf3\x5d\x38\x4c\x56\x1f\x11"
-DATA ·d+22384(SB)/8,$"\x68\xe2\x9b\x95\xb6\xb9\xcd\x8a"
-DATA ·d+22392(SB)/8,$"\xc1\xd7\xc3\xb7\x3c\x35\x95\xfb"
-DATA ·d+22400(SB)/8,$"\x75\xfc\xca\x71\x5a\x4e\xb6\x8a"
-DATA ·d+22408(SB)/8,$"\xd3\x40\xb3\x46\x91\x91\x14\xb3"
-DATA ·d+22416(SB)/8,$"\xcc\xc1\x8e\xd1\x80\xb9\x20\x00"
-DATA ·d+22424(SB)/8,$"\x3d\xc8\x26\xa3\xb1\x98\xe9\x53"
-DATA ·d+22432(SB)/8,$"\x7d\xa1\xad\x6d\x26\x21\x30\x62"
-DATA ·d+22440(SB)/8,$"\xf3\x15\x91\xf2\x7a\x03\xb5\x4e"
-DATA ·d+22448(SB)/8,$"\x7d\x0f\x15\xa1\xa8\xd9\xea\x1a"
-DATA ·d+22456(SB)/8,$"\xba\xf9\xc9\x7d\x6f\x2b\x36\x15"
-DATA ·d+22464(SB)/8,$"\x23\x88\x06\xe9\xc0\xea\xa2\xb0"
-DATA ·d+22472(SB)/8,$"\x6d\xbc\x25\xa9\x5d\xde\xcd\x69"
-DATA ·d+22480(SB)/8,$"\x3d\xec\x0a\xcc\x3f\x78\x8e\x36"
-DATA ·d+22488(SB)/8,$"\x2b\x00\x3b\x39\x52\xb5\x2e\x85"
-DATA ·d+22496(SB)/8,$"\x4a\x73\x8a\xc3\xc5\xf9\x5d\xaf"
-DATA ·d+22504(SB)/8,$"\xf8\x3f\x89\x54\x71\x34\xa7\x09"
-DATA ·d+22512(SB)/8,$"\x3a\xf3\x5e\x7a\x32\x5d\x6f\x5a"
-DATA ·d+22520(SB)/8,$"\xd5\x6e\xa1\x8f\xcb\x49\xe1\x8d"
-DATA ·d+22528(SB)/8,$"\x5d\x8c\x71\xd9\xe7\x72\x83\xd5"
-DATA ·d+22536(SB)/8,$"\x4f\xce\x14\x81\x55\x75\x5f\x12"
-DATA ·d+22544(SB)/8,$"\xf8\x86\x7d\x06\xde\x0d\x1e\xcd"
-DATA ·d+22552(SB)/8,$"\xae\x8e\x3f\x78\xd7\x2c\xa5\xd5"
-DATA ·d+22560(SB)/8,$"\x49\xea\x42\xb1\x20\x0e\xc5\x8f"
-DATA ·d+22568(SB)/8,$"\xb6\x2b\x6e\x6b\x5f\xec\x65\x4b"
-DATA ·d+22576(SB)/8,$"\x0d\x42\xde\x17\xca\xe1\x36\xb4"
-DATA ·d+22584(SB)/8,$"\x64\xed\x1c\x65\x4a\xf2\x71\xdb"
-DATA ·d+22592(SB)/8,$"\xa0\xac\xd1\x73\xa7\x58\xe9\xb3"
-DATA ·d+22600(SB)/8,$"\x4d\x73\x2b\x4b\x20\xcf\xfa\x24"
-DATA ·d+22608(SB)/8,$"\x79\x88\xb1\x1d\x07\x24\x8d\xd5"
-DATA ·d+22616(SB)/8,$"\x18\xa5\x55\x39\xfb\x4a\xf6\x6d"
-DATA ·d+22624(SB)/8,$"\x6b\x6b\x5d\x5b\xfa\xe8\x8e\x9c"
-DATA ·d+22632(SB)/8,$"\x3b\xa4\xe3\x17\x0a\x57\x49\xe9"
-DATA ·d+22640(SB)/8,$"\xbd\x9d\xff\x00\xae\xe7\x2d\xa6"
-DATA ·d+22648(SB)/8,$"\x6a\x7e\x3c\xd2\xf5\x53\x70\xf0"
-DATA ·d+22656(SB)/8,$"\xc6\xf0\x48\x08\x54\x70\xa3\x0d"
-DATA ·d+22664(SB)/8,$"\x8f\xf6\x98\x31\x23\xd6\xb7\x23"
-DATA ·d+22672(SB)/8,$"\xd7\xbc\x55\x24\x8a\xd7\x96\x51"
-DATA ·d+22680(SB)/8,$"\x4b\x06\xe3\xbc\xc5\x84\x62\x49"
-DATA ·d+22688(SB)/8,$"\xe8\x4b\x3f\x6f\xa5\x31\xaf\x9a"
-DATA ·d+22696(SB)/8,$"\xf3\x4f\x53\x75\xa4\x5a\xa4\xec"
-DATA ·d+22704(SB)/8,$"\xbf\x22\xcf\x72\xed\x2f\x03\x38"
-DATA ·d+22712(SB)/8,$"\x0b\x8f\x97\x23\xa6\x4d\x60\xdb"
-DATA ·d+22720(SB)/8,$"\xdc\xf8\xa8\xdf\xe2\xd2\x1b\x6b"
-DATA ·d+22728(SB)/8,$"\x75\x50\x64\x65\xb9\x75\xc6\x00"
-DATA ·d+22736(SB)/8,$"\xe8\x18\x1c\xe7\xd8\xd7\x9d\x4f"
-DATA ·d+22744(SB)/8,$"\x17\x2a\x09\x46\x9d\x7a\x92\x4d"
-DATA ·d+22752(SB)/8,$"\xff\x00\x5b\xa3\xd3\x95\x18\xd6"
-DATA ·d+22760(SB)/8,$"\xe6\x94\xe8\xc2\x2d\x69\xab\x5f"
-DATA ·d+22768(SB)/8,$"\x7e\x8d\x97\x3f\xb6\x2d\x2e\xb5"
-DATA ·d+22776(SB)/8,$"\x47\xb0\xb9\x17\x76\xe0\x12\xf3"
-DATA ·d+22784(SB)/8,$"\xb3\x0c\x47\xd7\xa2\xed\xce\x38"
-DATA ·d+22792(SB)/8,$"\xf5\xe2\xb6\x13\xfb\x16\x40\xb0"
-DATA ·d+22800(SB)/8,$"\xe9\x92\xde\xed\x9b\x89\x24\x8c"
-DATA ·d+22808(SB)/8,$"\xf9\x81\xbd\x97\x19\x3f\xad\x72"
-DATA ·d+22816(SB)/8,$"\xba\xac\x9a\x97\xda\xa1\x9a\x59"
-DATA ·d+22824(SB)/8,$"\xed\x20\x1f\xf2\xd5\x63\x56\xdc"
-DATA ·d+22832(SB)/8,$"\xd9\xe7\xe5\x66\x04\x67\xdf\x91"
-DATA ·d+22840(SB)/8,$"\x5a\x5a\x7e\xab\xa1\x3b\x4d\x69"
-DATA ·d+22848(SB)/8,$"\x77\x62\x5d\x55\x7c\xcf\xb4\xa1"
-DATA ·d+22856(SB)/8,$"\x56\x2e\xc7\xb7\x01\x47\x1f\xce"
-DATA ·d+22864(SB)/8,$"\xba\xf0\xb9\xc5\x47\x52\x50\xa8"
-DATA ·d+22872(SB)/8,$"\xf7\xea\xd5\xbe\x5a\x5f\xf2\x1e"
-DATA ·d+22880(SB)/8,$"\x23\x07\x1e\x55\x3a\x77\xf4\x4f"
-DATA ·d+22888(SB)/8,$"\xf1\xd6\xd7\xfc\x46\xce\xcb\x65"
-DATA ·d+22896(SB)/8,$"\x3f\x95\xa5\xdb\x5c\x44\x8a\x30"
-DATA ·d+22904(SB)/8,$"\x65\x98\xb8\x39\xf5\x20\x60\x2e"
-DATA ·d+22912(SB)/8,$"\x7d\x2a\x48\xef\x52\x5c\xc4\x24"
-DATA ·d+22920(SB)/8,$"\x8a\x22\x7e\xf3\x33\x39\x07\x1d"
-DATA ·d+22928(SB)/8,$"\xf8\xe6\xb3\xad\x6e\xb4\x15\xbd"
-DATA ·d+22936(SB)/8,$"\x55\xf2\xae\x70\x0e\x23\x4f\x30"
-DATA ·d+22944(SB)/8,$"\xa0\x52\x7d\x7e\x60\x08\xad\x24"
-DATA ·d+22952(SB)/8,$"\xb4\xd5\xa7\xdc\xd2\xce\x2e\x36"
-DATA ·d+22960(SB)/8,$"\x36\x7c\xc6\x31\x47\x81\xe9\x92"
-DATA ·d+22968(SB)/8,$"\xa4\x74\xad\xa8\x57\x9c\xae\x94"
-DATA ·d+22976(SB)/8,$"\x7e\x4b\xfa\x5f\xa9\x55\x29\xa5"
-DATA ·d+22984(SB)/8,$"\x65\x27\x67\xe7\xbf\xeb\xfd\x6c"
-DATA ·d+22992(SB)/8,$"\x5b\x83\xfb\x5e\x16\x2f\x62\xb1"
-DATA ·d+23000(SB)/8,$"\x4e\x11\x72\x5d\x06\xd4\x50\x7b"
-DATA ·d+23008(SB)/8,$"\xe4\x8f\xd4\xd5\x04\xbe\xf9\xd6"
-DATA ·d+23016(SB)/8,$"\xd2\xed\x84\xbf\xc7\xe5\xc7\x21"
-DATA ·d+23024(SB)/8,$"\x94\x8f\x52\x42\x64\xf1\xef\x4e"
-DATA ·d+23032(SB)/8,$"\xba\xd7\xdd\xe0\x6b\x2b\xc8\xa2"
-DATA ·d+23040(SB)/8,$"\x65\x1f\x2a\x32\x48\x07\x1d\xc1"
-DATA ·d+23048(SB)/8,$"\x3d\x1b\x9f\x61\x5c\x75\xff\x00"
-DATA ·d+23056(SB)/8,$"\x8a\x2e\x4f\x97\x0d\xa6\xeb\x51"
-DATA ·d+23064(SB)/8,$"\x18\x2a\xa2\xdc\xf9\x79\xcf\x1f"
-DATA ·d+23072(SB)/8,$"\x36\xcc\x64\xfd\x68\xc7\xe6\x6a"
-DATA ·d+23080(SB)/8,$"\x9a\xe6\x83\xf9\x3f\xea\xdf\x89"
-DATA ·d+23088(SB)/8,$"\xa6\x0f\x01\x52\xad\xd4\xa3\xfd"
-DATA ·d+23096(SB)/8,$"\x7e\xbf\x71\xd8\x5d\xcf\x07\x97"
-DATA ·d+23104(SB)/8,$"\x9d\x3c\x4b\x3a\x30\xce\x6d\x21"
-DATA ·d+23112(SB)/8,$"\x76\xc6\xde\xbf\x3b\x10\xbf\xaf"
-DATA ·d+23120(SB)/8,$"\xe5\x5c\x85\xc6\xa1\x69\x35\xc4"
-DATA ·d+23128(SB)/8,$"\x76\xb0\x41\xa9\xc8\xf2\x1f\xdd"
-DATA ·d+23136(SB)/8,$"\xc6\x23\x0b\xc7\xa9\xea\x3f\x1c"
-DATA ·d+23144(SB)/8,$"\xd4\xe7\xc6\x7a\x97\xd9\x92\x06"
-DATA ·d+23152(SB)/8,$"\xd8\xfb\x06\x3c\xc9\x07\x98\xc7"
-DATA ·d+23160(SB)/8,$"\xeb\x93\x83\xf9\x55\x11\xe2\x5d"
-DATA ·d+23168(SB)/8,$"\x40\xc8\x7f\x7a\x0e\x48\x7c\x01"
-DATA ·d+23176(SB)/8,$"\xb0\x64\x74\xc6\xde\x95\xe5\xe3"
-DATA ·d+23184(SB)/8,$"\x71\xee\xaa\x5c\xb5\x2c\xbb\x5b"
-DATA ·d+23192(SB)/8,$"\xfe\x09\xe8\x60\xf0\x52\x85\xef"
-DATA ·d+23200(SB)/8,$"\x0f\xc7\xfe\x01\xbf\xe4\x5b\xda"
-DATA ·d+23208(SB)/8,$"\x5b\x33\xfd\x83\xcd\x93\xf8\x5e"
-DATA ·d+23216(SB)/8,$"\x49\x4c\x6a\xbe\xa1\xc9\x1c\xfe"
-DATA ·d+23224(SB)/8,$"\x02\xb2\x1f\x50\x58\x9c\x79\xb6"
-DATA ·d+23232(SB)/8,$"\x1a\x6b\xbe\x31\xb9\x64\x92\x41"
-DATA ·d+23240(SB)/8,$"\xcf\xae\x40\xe9\xe9\x5a\x71\x78"
-DATA ·d+23248(SB)/8,$"\xaf\x52\xbd\x91\x13\x50\x91\x55"
-DATA ·d+23256(SB)/8,$"\x01\xea\xc3\xe5\x1c\x77\xe0\xe7"
-DATA ·d+23264(SB)/8,$"\xf5\xad\x53\xa8\x78\x59\xae\xc2"
-DATA ·d+23272(SB)/8,$"\x5d\x32\x1d\xca\x4f\xda\x21\x87"
-DATA ·d+23280(SB)/8,$"\x0a\x1b\xb6\x07\x5f\xc7\x15\xd4"
-DATA ·d+23288(SB)/8,$"\xa9\xa9\xeb\x1a\xdc\xb6\xee\x92"
-DATA ·d+23296(SB)/8,$"\xfd\x3f\x33\x27\x52\x74\xee\xa5"
-DATA ·d+23304(SB)/8,$"\x4e\xfe\x8d\x95\xec\x74\xcb\x5b"
-DATA ·d+23312(SB)/8,$"\xb8\x5a\x59\x6d\xad\x98\x31\xf9"
-DATA ·d+23320(SB)/8,$"\x5d\x11\x80\x19\xe8\x30\x49\x23"
-DATA ·d+23328(SB)/8,$"\xd4\xf1\x59\x57\xfe\x05\xd5\x8b"
-DATA ·d+23336(SB)/8,$"\xef\xb1\x8e\x09\x51\x97\x7a\x98"
-DATA ·d+23344(SB)/8,$"\x8f\xca\x07\xfb\xc6\xb5\x95\xf4"
-DATA ·d+23352(SB)/8,$"\xc9\x8e\xe8\x24\xf3\x1b\x77\xcb"
-DATA ·d+23360(SB)/8,$"\xb1\x8a\x6d\x1f\x40\x17\x93\xeb"
-DATA ·d+23368(SB)/8,$"\xcd\x45\x26\xb9\x71\xa6\x11\x14"
-DATA ·d+23376(SB)/8,$"\x66\xe3\xcb\x3f\x79\x4b\x8f\xe6"
-DATA ·d+23384(SB)/8,$"\x3b\xd7\x64\xa1\x43\x91\x2a\xbb"
-DATA ·d+23392(SB)/8,$"\x77\x5b\x9c\xf1\xaf\x88\x52\x72"
-DATA ·d+23400(SB)/8,$"\xa5\xf7\x3f\xf8\x73\x1d\xbc\x2d"
-DATA ·d+23408(SB)/8,$"\xe3\x0b\x70\xb1\xc3\x6e\x91\x36"
-DATA ·d+23416(SB)/8,$"\x3f\x84\x82\xc4\x63\xd4\x93\xfc"
-DATA ·d+23424(SB)/8,$"\xaa\x23\xa3\xf8\xbb\x3c\x93\x9f"
-DATA ·d+23432(SB)/8,$"\x79\x53\xff\x00\x8a\xad\x81\xe2"
-DATA ·d+23440(SB)/8,$"\x6d\x2d\xbe\x5b\xb6\xbd\x43\xd3"
-DATA ·d+23448(SB)/8,$"\xef\xf9\x80\xfd\x46\x41\xa7\x0d"
-DATA ·d+23456(SB)/8,$"\x43\xc3\x07\x9c\xc9\xff\x00\x7e"
-DATA ·d+23464(SB)/8,$"\xdf\xff\x00\x8a\xac\xfd\x86\x13"
-DATA ·d+23472(SB)/8,$"\xa5\x47\xff\x00\x81\x7f\xc0\x3a"
-DATA ·d+23480(SB)/8,$"\xa1\x8a\xc6\x2f\x8a\x9a\x7f\xf6"
-DATA ·d+23488(SB)/8,$"\xeb\x7f\xa9\xff\xd7\xfe\x73\xaf"
-DATA ·d+23496(SB)/8,$"\x21\xd4\xad\xdf\xf7\xf6\xcb\xbb"
-DATA ·d+23504(SB)/8,$"\xfd\xb1\xfe\x15\x91\x24\xd7\xd1"
-DATA ·d+23512(SB)/8,$"\x3e\x42\x98\x95\xbb\x02\x40\x3f"
-DATA ·d+23520(SB)/8,$"\x95\x7d\x7b\x7d\xe0\x9f\x0f\x5a"
-DATA ·d+23528(SB)/8,$"\x91\x33\xdd\xa4\xaa\x07\xcc\xaf"
-DATA ·d+23536(SB)/8,$"\x1e\xee\x3d\x7a\xf5\xa8\xfc\xaf"
-DATA ·d+23544(SB)/8,$"\x86\xfa\x45\xbf\x9b\x79\x68\x6f"
-DATA ·d+23552(SB)/8,$"\x1c\x70\x04\x31\x6d\x5c\xfb\x9a"
-DATA ·d+23560(SB)/8,$"\xfe\xe7\xad\xc2\xf5\xe9\xdf\xda"
-DATA ·d+23568(SB)/8,$"\x54\xe5\xf5\xff\x00\x80\x7f\x91"
-DATA ·d+23576(SB)/8,$"\xd8\x7e\x3c\xa2\xe2\xb9\x28\x39"
-DATA ·d+23584(SB)/8,$"\xfa\x69\xf9\x9f\x27\x5b\xdf\xea"
-DATA ·d+23592(SB)/8,$"\xb1\x67\xec\xef\x21\x56\xff\x00"
-DATA ·d+23600(SB)/8,$"\x96\x64\xb1\x1f\x91\xad\x48\x35"
-DATA ·d+23608(SB)/8,$"\x5b\x98\x5b\x32\xdb\x9d\xdd\x0e"
-DATA ·d+23616(SB)/8,$"\x50\x30\x3f\x9d\x7b\xb4\xbe\x28"
-DATA ·d+23624(SB)/8,$"\xf0\xd2\x48\xd2\x58\xf8\x74\x29"
-DATA ·d+23632(SB)/8,$"\x0d\x98\xdb\x04\xae\x3d\xc0\x00"
-DATA ·d+23640(SB)/8,$"\x9a\xc8\xd5\xf5\x8d\x6f\x51\x41"
-DATA ·d+23648(SB)/8,$"\x1a\xd8\x5a\x43\x1f\xa7\x93\x8d"
-DATA ·d+23656(SB)/8,$"\xa3\xdc\x9e\x45\x61\x4f\x2f\x51"
-DATA ·d+23664(SB)/8,$"\x57\xf6\xf7\xf9\x3b\x7d\xec\xf4"
-DATA ·d+23672(SB)/8,$"\x9e\x7d\x3a\xb2\x57\xc2\xf2\xa7"
-DATA ·d+23680(SB)/8,$"\xde\x49\x7e\x5b\x9e\x7d\x0e\xbf"
-DATA ·d+23688(SB)/8,$"\xa4\x5e\xff\x00\xa3\x6b\x50\xc7"
-DATA ·d+23696(SB)/8,$"\x06\x4f\xcb\x2a\x46\x01\x1f\x5e"
-DATA ·d+23704(SB)/8,$"\x95\xa8\xd6\xde\x03\x45\xf3\x61"
-DATA ·d+23712(SB)/8,$"\xbd\xd4\x64\x70\x3e\xe5\xbd\xb0"
-DATA ·d+23720(SB)/8,$"\xc7\xe6\x5a\xa6\xba\xf0\xd7\x87"
-DATA ·d+23728(SB)/8,$"\x6e\xa2\x69\x35\x1d\x5b\x4f\xb6"
-DATA ·d+23736(SB)/8,$"\x90\x0d\xde\x50\x6d\xdb\xb3\xfd"
-DATA ·d+23744(SB)/8,$"\xd3\x82\x33\x5c\xaa\x78\x8a\xd7"
-DATA ·d+23752(SB)/8,$"\xc3\x63\xca\xf0\xec\x52\xcb\x2f"
-DATA ·d+23760(SB)/8,$"\xfc\xf7\x39\x50\x3e\x80\x75\xfc"
-DATA ·d+23768(SB)/8,$"\x6a\x6b\x62\xbd\x9a\xbd\x6e\x56"
-DATA ·d+23776(SB)/8,$"\xbb\xee\xff\x00\x07\xf9\x9d\x54"
-DATA ·d+23784(SB)/8,$"\xa3\x1a\xdf\xee\xfc\xc9\xf6\xd9"
-DATA ·d+23792(SB)/8,$"\x7d\xed\x7e\x47\x48\x92\xd8\x46"
-DATA ·d+23800(SB)/8,$"\x01\xd2\xc5\xed\xbb\x93\xf2\xcf"
-DATA ·d+23808(SB)/8,$"\x70\x11\x17\x8e\xe7\x00\x91\x5a"
-DATA ·d+23816(SB)/8,$"\xe6\xe7\xe2\x24\xb0\x79\xd1\xdf"
-DATA ·d+23824(SB)/8,$"\xc5\x71\x10\x3f\xf2\xee\xea\xe4"
-DATA ·d+23832(SB)/8,$"\x7e\x18\xeb\x5e\x5d\x2f\x8c\xbe"
-DATA ·d+23840(SB)/8,$"\x21\xce\xfb\x0a\x28\x40\x72\x08"
-DATA ·d+23848(SB)/8,$"\x4e\x4e\x7d\x72\x79\x15\xd5\xe9"
-DATA ·d+23856(SB)/8,$"\xfa\xef\x89\xb5\x36\x04\xcd\x0d"
-DATA ·d+23864(SB)/8,$"\xa3\x6d\x03\x30\x44\x23\x2c\x40"
-DATA ·d+23872(SB)/8,$"\xef\xb4\x1e\x7d\xe8\xc2\xe6\xd4"
-DATA ·d+23880(SB)/8,$"\xe7\x27\x15\xcc\xbd\x34\x2b\x17"
-DATA ·d+23888(SB)/8,$"\x96\xd6\x84\x79\xa4\xe0\xfd\x5f"
-DATA ·d+23896(SB)/8,$"\x31\xd0\xae\xb1\xaa\xce\x04\x5a"
-DATA ·d+23904(SB)/8,$"\x8c\x6b\x2b\x93\x8d\xf7\x30\x8e"
-DATA ·d+23912(SB)/8,$"\xbe\x9b\xb6\x8a\x26\xbf\xf1\xee"
-DATA ·d+23920(SB)/8,$"\x95\x22\x8b\x2b\x78\xd1\x18\x02"
-DATA ·d+23928(SB)/8,$"\xa0\xa2\xe0\x8f\x6c\x1e\x6b\xa5"
-DATA ·d+23936(SB)/8,$"\xb5\x4d\x7a\x15\x17\x72\xc3\x25"
-DATA ·d+23944(SB)/8,$"\xf9\x51\x96\x69\xd8\x98\xd8\x0f"
-DATA ·d+23952(SB)/8,$"\x6c\x55\x58\xb5\xdd\x56\x4d\x45"
-DATA ·d+23960(SB)/8,$"\x8c\xd1\x95\x80\xf1\xf6\x48\xe4"
-DATA ·d+23968(SB)/8,$"\x84\x2a\x63\xae\xd7\x64\x07\xe9"
-DATA ·d+23976(SB)/8,$"\x9c\xd7\xa9\x88\xae\xe3\x08\xfb"
-DATA ·d+23984(SB)/8,$"\xd2\xbf\xf5\xbb\xff\x00\x82\x78"
-DATA ·d+23992(SB)/8,$"\x54\x6a\x46\x53\x76\x84\x5a\x5b"
-DATA ·d+24000(SB)/8,$"\xf5\xfb\x97\x4f\x9f\xde\x73\xf2"
-DATA ·d+24008(SB)/8,$"\x8f\x18\x6b\x70\x19\x6f\x16\xd6"
-DATA ·d+24016(SB)/8,$"\x0d\xa3\x25\xd8\x22\x63\xf3\x35"
-DATA ·d+24024(SB)/8,$"\xc4\xdd\x68\x37\xb0\xce\xaa\x75"
-DATA ·d+24032(SB)/8,$"\x9b\x10\x5c\xf0\xbe\x6a\xf3\xec"
-DATA ·d+24040(SB)/8,$"\x00\x35\xea\x1a\xc4\x0f\xab\xac"
-DATA ·d+24048(SB)/8,$"\xb6\x33\x34\x4b\x6f\x26\x37\xac"
-DATA ·d+24056(SB)/8,$"\xf7\x76\xef\x8c\xf4\xce\xc5\x43"
-DATA ·d+24064(SB)/8,$"\xfa\xd7\x01\x79\xe1\xa9\xf4\x84"
-DATA ·d+24072(SB)/8,$"\x12\xe8\x77\x3a\x79\x23\x8c\x40"
-DATA ·d+24080(SB)/8,$"\x11\xd8\x7d\x49\xcd\x79\x18\xc7"
-DATA ·d+24088(SB)/8,$"\x5a\x4d\x3b\x73\x2e\xee\x5a\xfd"
-DATA ·d+24096(SB)/8,$"\xda\x1e\xee\x59\x89\x84\x7d\xd5"
-DATA ·d+24104(SB)/8,$"\x25\x16\xfa\x72\xab\x7d\xfa\x9d"
-DATA ·d+24112(SB)/8,$"\x1d\x94\xff\x00\xd8\x82\x2f\xb5"
-DATA ·d+24120(SB)/8,$"\x5d\x25\xcb\x3f\xca\xa9\x6b\x17"
-DATA ·d+24128(SB)/8,$"\x98\x49\x1d\xbe\x55\x6f\xe8\x2a"
-DATA ·d+24136(SB)/8,$"\x5f\x11\xfc\x4a\xb5\xd3\xa4\x10"
-DATA ·d+24144(SB)/8,$"\x4f\x92\xc8\x3f\xd4\xc3\xb5\xbe"
-DATA ·d+24152(SB)/8,$"\x6e\xc1\x8a\x61\x47\xfd\xf4\xc7"
-DATA ·d+24160(SB)/8,$"\xda\xbc\xaa\xea\x0f\x19\x34\x85"
-DATA ·d+24168(SB)/8,$"\x86\x26\xf6\x51\x8c\x8e\xff\x00"
-DATA ·d+24176(SB)/8,$"\x76\xab\xc3\x6f\xab\xde\xb7\x97"
-DATA ·d+24184(SB)/8,$"\x71\x63\x2a\xb1\xe3\x2a\x8c\x79"
-DATA ·d+24192(SB)/8,$"\xfc\xab\x97\xfb\x6f\x10\xa2\xe9"
-DATA ·d+24200(SB)/8,$"\xc2\xf1\x5d\x34\xfd\x75\x3b\xa1"
-DATA ·d+24208(SB)/8,$"\x92\x61\xa7\x35\x52\xb3\x52\xf4"
-DATA ·d+24216(SB)/8,$"\x76\xfc\x34\x34\x75\x0f\x88\x96"
-DATA ·d+24224(SB)/8,$"\xda\xbc\xac\xd7\x1a\x5c\x38\x2b"
-DATA ·d+24232(SB)/8,$"\x84\x26\x77\x42\xbe\xfc\x75\xfc"
-DATA ·d+24240(SB)/8,$"\x6b\x8e\xba\xd5\x75\x69\xf3\xf6"
-DATA ·d+24248(SB)/8,$"\x3c\xa2\x03\x95\x40\xdb\x80\xfc"
-DATA ·d+24256(SB)/8,$"\x78\xaf\x49\xd3\x3e\x12\xea\x5a"
-DATA ·d+24264(SB)/8,$"\xc3\x2a\x4a\xd6\xf6\xe1\xb9\x02"
-DATA ·d+24272(SB)/8,$"\x79\x51\x1f\x1e\xa1\x09\xc9\xab"
-DATA ·d+24280(SB)/8,$"\xf1\x7c\x09\x79\x25\x03\xcf\x99"
-DATA ·d+24288(SB)/8,$"\x17\x27\x32\xb0\x58\xd3\xf0\x2e"
-DATA ·d+24296(SB)/8,$"\xc3\x3f\x80\xae\x6a\x94\x33\x0a"
-DATA ·d+24304(SB)/8,$"\xca\xef\x5b\xfa\x2f\xc8\xf4\xe8"
-DATA ·d+24312(SB)/8,$"\x66\xb9\x46\x1f\xdc\x4d\x2b\x7a"
-DATA ·d+24320(SB)/8,$"\xb3\xc8\xed\xa7\xf1\x59\x8c\x88"
-DATA ·d+24328(SB)/8,$"\xee\x21\x89\x4f\x04\x3b\x2e\x4f"
-DATA ·d+24336(SB)/8,$"\xe1\xd4\x55\x09\x92\xe9\xa5\xcd"
-DATA ·d+24344(SB)/8,$"\xc5\xea\xee\xce\x4f\x96\xec\xc7"
-DATA ·d+24352(SB)/8,$"\xf2\xe2\xbd\xe2\x5f\x81\x3a\x76"
-DATA ·d+24360(SB)/8,$"\x9a\x44\xda\x8d\xdb\x6d\x2b\xb8"
-DATA ·d+24368(SB)/8,$"\x16\x9e\x25\x66\xff\x00\x75\x72"
-DATA ·d+24376(SB)/8,$"\xc4\xfe\x75\x5b\xfe\x11\xdf\x08"
-DATA ·d+24384(SB)/8,$"\xe9\x51\x9f\xdd\xab\x36\x4e\x3c"
-DATA ·d+24392(SB)/8,$"\xd9\xa2\xe8\x3d\x76\x6e\xfc\xaa"
-DATA ·d+24400(SB)/8,$"\x16\x57\x5e\xf6\xaa\xf9\x7d\x5b"
-DATA ·d+24408(SB)/8,$"\x7f\x92\x3a\x61\xc5\x18\x49\x6b"
-DATA ·d+24416(SB)/8,$"\x87\xf7\x9f\x92\x4b\xf3\x3c\x4a"
-DATA ·d+24424(SB)/8,$"\xdf\xc3\xb7\xda\xab\x94\xb7\x79"
-DATA ·d+24432(SB)/8,$"\xe4\x27\x81\xbe\x32\x41\xfc\x79"
-DATA ·d+24440(SB)/8,$"\xad\xc8\xfe\x1c\x6b\x96\xaf\xfe"
-DATA ·d+24448(SB)/8,$"\x9c\x85\x33\xfc\x21\x46\x7f\x52"
-DATA ·d+24456(SB)/8,$"\x2b\xd9\x74\xff\x00\x14\xf8\x46"
-DATA ·d+24464(SB)/8,$"\xc6\x23\x6e\xa9\x32\xcc\x33\x83"
-DATA ·d+24472(SB)/8,$"\x69\x26\x14\x0f\xc4\x1e\x69\xd2"
-DATA ·d+24480(SB)/8,$"\xde\xcb\xac\x46\xcd\x67\x1c\xd7"
-DATA ·d+24488(SB)/8,$"\x68\xc3\x04\x5c\xc6\x92\x71\xee"
-DATA ·d+24496(SB)/8,$"\xec\xa6\xbd\x8a\x5c\x3d\x84\x74"
-DATA ·d+24504(SB)/8,$"\xf9\x95\x4b\xcf\xf0\xf9\xf5\x38"
-DATA ·d+24512(SB)/8,$"\x67\xc4\xd8\xc7\x53\x5a\x7c\xb1"
-DATA ·d+24520(SB)/8,$"\xf3\xfe\xac\x79\xd5\x87\x81\x20"
-DATA ·d+24528(SB)/8,$"\x7c\x1b\xd8\xee\x54\x0e\x8d\x1a"
-DATA ·d+24536(SB)/8,$"\x82\x4f\xe0\x08\xad\xbb\x9f\x03"
-DATA ·d+24544(SB)/8,$"\xf8\x4a\xdc\x26\xed\xc0\x74\x76"
-DATA ·d+24552(SB)/8,$"\xbb\x95\x63\x62\xc7\xa6\x14\x12"
-DATA ·d+24560(SB)/8,$"\x7f\xad\x55\xd6\xae\x35\x35\x78"
-DATA ·d+24568(SB)/8,$"\xd6\xd9\x2d\x6c\xcc\x2a\x55\x44"
-DATA ·d+24576(SB)/8,$"\x31\xef\x3e\xf9\x3c\x8f\xd3\x8a"
-DATA ·d+24584(SB)/8,$"\xe3\xa6\xb8\xf1\x3d\xcc\x83\x6b"
-DATA ·d+24592(SB)/8,$"\x09\x98\x10\x55\x84\x4b\x91\xf9"
-DATA ·d+24600(SB)/8,$"\x0c\xd7\x2e\x22\xbd\x0a\x37\x82"
-DATA ·d+24608(SB)/8,$"\xa2\x9b\x5f\xd7\xf5\xa1\xbd\x17"
-DATA ·d+24616(SB)/8,$"\x89\xc4\x35\x3f\x6f\xca\xbd\x7f"
-DATA ·d+24624(SB)/8,$"\xe1\xff\x00\x33\xd6\x2f\xbe\x19"
-DATA ·d+24632(SB)/8,$"\x5c\xe9\x5a\x63\x4f\xa3\x59\xdb"
-DATA ·d+24640(SB)/8,$"\x89\xca\xf9\x8a\xf7\xef\xbe\x30"
-DATA ·d+24648(SB)/8,$"\xb8\xeb\x8c\xfe\x59\xaf\x63\xfd"
-DATA ·d+24656(SB)/8,$"\xa2\x7c\x35\x7f\xa6\xa7\x82\x2d"
-DATA ·d+24664(SB)/8,$"\x2e\xd6\x16\x68\xfe\x1b\xe8\x45"
-DATA ·d+24672(SB)/8,$"\xbc\x89\x1a\x34\xf3\x24\x59\x99"
-DATA ·d+24680(SB)/8,$"\xb6\x85\x56\x05\x4f\x6a\xf9\x5f"
-DATA ·d+24688(SB)/8,$"\x51\xd7\xbc\x79\x25\xb1\x93\x58"
-DATA ·d+24696(SB)/8,$"\x32\x05\x58\x98\x2b\x4a\x81\x72"
-DATA ·d+24704(SB)/8,$"\x02\xf0\x32\xc0\x67\xf3\xaf\xbd"
-DATA ·d+24712(SB)/8,$"\xff\x00\x6c\x8d\x17\x59\xf8\xa5"
-DATA ·d+24720(SB)/8,$"\xe2\x7f\x86\xde\x03\xf8\x47\xa5"
-DATA ·d+24728(SB)/8,$"\xea\x77\xd7\xba\x6f\xc2\x3f\x0d"
-DATA ·d+24736(SB)/8,$"\x47\xa9\xda\xe8\xb6\x33\xdd\x4d"
-DATA ·d+24744(SB)/8,$"\x2d\xdd\xc0\x9a\x55\x92\x4f\xb3"
-DATA ·d+24752(SB)/8,$"\x09\x0b\x22\xc6\xe8\xa9\x23\x85"
-DATA ·d+24760(SB)/8,$"\xda\x32\x09\xe3\x34\xf1\x99\x8e"
-DATA ·d+24768(SB)/8,$"\x19\xbb\xd0\xa7\x25\xa6\xda\x25"
-DATA ·d+24776(SB)/8,$"\xf2\x5f\xd3\x3a\xf2\xdc\xa3\x18"
-DATA ·d+24784(SB)/8,$"\xe9\xcb\xdb\x56\x8c\x9d\xfb\x36"
-DATA ·d+24792(SB)/8,$"\xff\x00\x33\xe2\xa8\x34\x58\xe4"
-DATA ·d+24800(SB)/8,$"\x8c\x18\xac\xda\x49\x00\xc9\xf2"
-DATA ·d+24808(SB)/8,$"\x99\x9b\x03\xeb\x85\x3f\xa1\xaa"
-DATA ·d+24816(SB)/8,$"\x1a\xa6\xa1\x67\x6b\x0f\xd9\xd5"
-DATA ·d+24824(SB)/8,$"\x63\xb5\x71\x8d\xce\x50\x33\xe4"
-DATA ·d+24832(SB)/8,$"\x76\x01\x8d\x65\x6a\xdf\x0d\xfc"
-DATA ·d+24840(SB)/8,$"\x7b\xe1\xad\x4e\xfb\x4c\xd7\x2d"
-DATA ·d+24848(SB)/8,$"\xe7\xb4\xb9\xd3\xae\xa4\xb1\xbf"
-DATA ·d+24856(SB)/8,$"\x8e\x67\xd8\xf6\xf3\xc6\xc5\x1e"
-DATA ·d+24864(SB)/8,$"\x29\x41\x3f\x2b\x06\x04\x63\x3f"
-DATA ·d+24872(SB)/8,$"\x98\xe6\xa8\x27\x83\xde\xea\x65"
-DATA ·d+24880(SB)/8,$"\x59\x27\x50\xec\xdf\x28\x67\x07"
-DATA ·d+24888(SB)/8,$"\xf9\x12\x69\x7d\x66\xb7\x2f\x2a"
-DATA ·d+24896(SB)/8,$"\xa6\xe2\xdf\x7b\x7f\xc0\x17\xd5"
-DATA ·d+24904(SB)/8,$"\xe8\xc5\xf3\x54\xad\x74\xbb\x5f"
-DATA ·d+24912(SB)/8,$"\xfc\xd9\x79\x75\xcb\x59\x41\x8e"
-DATA ·d+24920(SB)/8,$"\x7b\xeb\xc9\x89\x03\x25\xa2\x09"
-DATA ·d+24928(SB)/8,$"\x0a\xe0\xf1\x84\x8c\x8e\x47\xa9"
-DATA ·d+24936(SB)/8,$"\xcd\x4d\x77\x6b\x6e\xe8\x75\x39"
-DATA ·d+24944(SB)/8,$"\x6f\x23\x94\xb0\xe0\x44\x8a\xa4"
-DATA ·d+24952(SB)/8,$"\x9f\x46\x05\x0e\x2b\x62\x5f\x08"
-DATA ·d+24960(SB)/8,$"\xbe\x88\xc1\x6f\xef\x99\x7e\x50"
-DATA ·d+24968(SB)/8,$"\x71\x6a\xbe\x66\x07\xe7\x8a\x92"
-DATA ·d+24976(SB)/8,$"\xd5\x86\xdf\x22\xcf\x52\x2e\x33"
-DATA ·d+24984(SB)/8,$"\xc4\x77\x10\x2c\x60\x0f\x76\xc1"
-DATA ·d+24992(SB)/8,$"\xae\xec\x3e\x06\xae\xb1\xaa\xbf"
-DATA ·d+25000(SB)/8,$"\x1d\x7f\x33\x1a\xb8\xea\x5a\x4e"
-DATA ·d+25008(SB)/8,$"\x8b\x76\xf4\xd3\xff\x00\x49\xd0"
-DATA ·d+25016(SB)/8,$"\xe2\xc7\xc4\x09\x34\xc4\xfb\x3a"
-DATA ·d+25024(SB)/8,$"\xe9\xf0\xca\x03\x7f\xac\x76\x28"
-DATA ·d+25032(SB)/8,$"\xd8\x1e\xe8\x2b\x59\xfe\x2f\xe9"
-DATA ·d+25040(SB)/8,$"\xe6\xdd\xbe\xdf\x65\x71\x14\xac"
-DATA ·d+25048(SB)/8,$"\x3e\x57\xb6\x75\xd8\x3f\x0c\x57"
-DATA ·d+25056(SB)/8,$"\x45\xac\x59\xda\x69\x96\xd1\xdc"
-DATA ·d+25064(SB)/8,$"\xa6\xeb\xb9\x27\x66\x47\x8e\xd4"
-DATA ·d+25072(SB)/8,$"\x43\x20\x53\xea\x4b\x95\x20\x1f"
-DATA ·d+25080(SB)/8,$"\xa5\x71\xed\xa2\xe9\x7a\x93\x32"
-DATA ·d+25088(SB)/8,$"\xdc\x47\x79\x6d\x37\x70\x6d\xbc"
-DATA ·d+25096(SB)/8,$"\xc5\x23\xd7\x74\x5b\x87\x15\x9d"
-DATA ·d+25104(SB)/8,$"\x5c\x46\x2e\x84\xdd\x28\x56\x57"
-DATA ·d+25112(SB)/8,$"\xed\x6f\xd4\xeb\xc3\x43\x03\x5e"
-DATA ·d+25120(SB)/8,$"\x0a\xac\xe8\x3f\x54\xdf\xe5\xff"
-DATA ·d+25128(SB)/8,$"\x00\x00\xc5\xb7\xf1\xaf\x83\xa4"
-DATA ·d+25136(SB)/8,$"\x0c\xf7\x7f\xda\x4b\x21\x62\x40"
-DATA ·d+25144(SB)/8,$"\x42\x00\x23\xb7\xcc\xb8\x6f\xe9"
-DATA ·d+25152(SB)/8,$"\x59\x97\x1a\xb4\xd2\x86\x97\x41"
-DATA ·d+25160(SB)/8,$"\x85\xd4\x1e\x93\xe3\xcc\x93\xfe"
-DATA ·d+25168(SB)/8,$"\xfb\x6e\x47\xe1\x5d\x01\xf0\x16"
-DATA ·d+25176(SB)/8,$"\x8d\x2b\x9f\xb3\xc8\x2e\x4a\x0f"
-DATA ·d+25184(SB)/8,$"\x9e\x35\x1e\x53\x2f\xbe\x1b\x9f"
-DATA ·d+25192(SB)/8,$"\xd2\xb2\x25\xb3\xd1\xf4\xcb\x86"
-DATA ·d+25200(SB)/8,$"\x86\xd7\xed\x20\x0e\x06\xf7\x55"
-DATA ·d+25208(SB)/8,$"\x04\xfa\x10\x3d\x2b\xcc\xad\x2c"
-DATA ·d+25216(SB)/8,$"\x4b\x8a\x75\x1a\x4b\xba\xdf\xef"
-DATA ·d+25224(SB)/8,$"\xd4\xf6\xa8\x54\xc2\x73\x3f\x60"
-DATA ·d+25232(SB)/8,$"\xa4\xdf\x67\xb7\xdd\xa1\xc4\x5c"
-DATA ·d+25240(SB)/8,$"\xb6\xba\xad\xe6\x49\x35\xc6\xf7"
-DATA ·d+25248(SB)/8,$"\x3c\xb1\x73\x93\xf5\xe6\x99\x63"
-DATA ·d+25256(SB)/8,$"\x75\xab\x5a\xb9\xf2\x64\x4d\xe7"
-DATA ·d+25264(SB)/8,$"\x80\x24\x40\xc3\xf5\x06\xbd\xbf"
-DATA ·d+25272(SB)/8,$"\xc3\xf6\xde\x15\xd4\x62\x09\x3c"
-DATA ·d+25280(SB)/8,$"\x28\xf2\xe3\x69\x41\x2e\xff\x00"
-DATA ·d+25288(SB)/8,$"\x9b\xbf\x50\xb5\x26\xb1\xe1\xfd"
-DATA ·d+25296(SB)/8,$"\x2f\x4d\x95\x63\xc2\x44\x1d\x77"
-DATA ·d+25304(SB)/8,$"\xaf\x05\x94\x7d\x5b\x07\x07\xdb"
-DATA ·d+25312(SB)/8,$"\x35\x71\xc9\x27\xec\xfd\xb4\x6a"
-DATA ·d+25320(SB)/8,$"\xe9\xea\x69\x3e\x25\x8f\xb4\xfa"
-DATA ·d+25328(SB)/8,$"\xbd\x4a\x3a\xfa\x2d\x4f\x2d\xb7"
-DATA ·d+25336(SB)/8,$"\xf1\xa4\x96\x31\x8b\x7d\x5b\x4e"
-DATA ·d+25344(SB)/8,$"\xb5\x9c\x06\x25\x8e\x08\xdc\x3d"
-DATA ·d+25352(SB)/8,$"\x31\xd2\xb5\x12\xef\xc3\x1a\xe6"
-DATA ·d+25360(SB)/8,$"\x3f\xb3\xa4\x36\x12\xf7\x8d\xb7"
-DATA ·d+25368(SB)/8,$"\x22\xfe\x07\x25\x4f\xe9\x5e\x91"
-DATA ·d+25376(SB)/8,$"\x26\x8d\x0c\x5a\x78\x96\xfe\xde"
-DATA ·d+25384(SB)/8,$"\xce\x58\x5d\x70\x93\x42\xa0\x91"
-DATA ·d+25392(SB)/8,$"\xf5\x20\x7e\x84\x57\x3c\x74\x4d"
-DATA ·d+25400(SB)/8,$"\x25\x6d\x09\xb5\xb7\xf3\x5b\x8e"
-DATA ·d+25408(SB)/8,$"\x4c\x6e\xa9\xf8\xb2\x96\xe6\xbb"
-DATA ·d+25416(SB)/8,$"\xde\x0a\xbd\x34\x94\xa4\xa4\xbd"
-DATA ·d+25424(SB)/8,$"\x35\xfb\xf7\x39\x21\x9a\x61\xaa"
-DATA ·d+25432(SB)/8,$"\x3f\x72\x0e\x2f\xba\x7a\x7d\xcf"
-DATA ·d+25440(SB)/8,$"\x43\x97\x97\x48\xf1\x0d\x8a\x0b"
-DATA ·d+25448(SB)/8,$"\x85\xb5\x17\xb1\x75\xf3\x08\x0e"
-DATA ·d+25456(SB)/8,$"\x0f\xfc\x09\x6a\x8c\xbe\x2b\xbc"
-DATA ·d+25464(SB)/8,$"\x5f\xf4\x7d\x46\xce\x21\xc6\x04"
-DATA ·d+25472(SB)/8,$"\x6d\xb9\x70\x3f\x03\x5d\x61\xbd"
-DATA ·d+25480(SB)/8,$"\xd7\x34\xf8\xd5\xfc\x39\x67\x6f"
-DATA ·d+25488(SB)/8,$"\x0b\xaf\xdf\x91\xe4\x96\x55\x23"
-DATA ·d+25496(SB)/8,$"\xd3\xca\x0a\xb5\xe7\xba\x8e\xa5"
-DATA ·d+25504(SB)/8,$"\xe3\xab\xbd\x43\xed\x33\xdc\xac"
-DATA ·d+25512(SB)/8,$"\x8a\x33\x98\x7e\xcc\xde\x50\x27"
-DATA ·d+25520(SB)/8,$"\xb6\x18\x02\x31\xee\x4d\x79\xd8"
-DATA ·d+25528(SB)/8,$"\xec\x74\xa8\x5a\x34\x94\xf5\xdf"
-DATA ·d+25536(SB)/8,$"\x4d\x3f\x1b\x33\xd4\xc0\x51\x75"
-DATA ·d+25544(SB)/8,$"\xef\xed\xb9\x6d\xeb\x67\xf8\x5d"
-DATA ·d+25552(SB)/8,$"\x1d\x6d\xaa\xf8\x5f\x59\xc2\xcb"
-DATA ·d+25560(SB)/8,$"\x6a\x22\x90\xf4\xf2\x89\x23\x3e"
-DATA ·d+25568(SB)/8,$"\x99\x1b\xbf\x95\x37\x52\xd0\xb4"
-DATA ·d+25576(SB)/8,$"\x1b\x0f\x26\x16\x8e\x59\x5e\x46"
-DATA ·d+25584(SB)/8,$"\xc6\xd4\x19\x6f\xc4\x9e\x9f\x8e"
-DATA ·d+25592(SB)/8,$"\x2a\xcd\x95\xa7\x88\x35\x48\x11"
-DATA ·d+25600(SB)/8,$"\xe3\xbd\x30\x24\x29\xba\x5f\xb9"
-DATA ·d+25608(SB)/8,$"\x10\xc8\x1c\x8d\xd1\xe0\xe3\xd3"
-DATA ·d+25616(SB)/8,$"\x9c\xd6\x7a\xdd\xdb\x41\x7c\xcd"
-DATA ·d+25624(SB)/8,$"\x6f\x71\x1e\xe4\x23\x74\xb0\x8f"
-DATA ·d+25632(SB)/8,$"\x30\xe4\xfb\x92\xd9\xfc\xcd\x6d"
-DATA ·d+25640(SB)/8,$"\x3c\x5a\x94\x17\x34\x12\x6f\xab"
-DATA ·d+25648(SB)/8,$"\xb2\x7e\x76\x5d\xfd\x4c\x63\x09"
-DATA ·d+25656(SB)/8,$"\x29\xb5\x4e\xa3\x76\xdd\x26\xdd"
-DATA ·d+25664(SB)/8,$"\xbe\x63\xf4\xfb\x2b\x63\x77\x25"
-DATA ·d+25672(SB)/8,$"\xb7\xf6\x74\xd8\x41\x81\xf7\x18"
-DATA ·d+25680(SB)/8,$"\xee\xf5\x38\xcf\xf3\xad\xa7\xb7"
-DATA ·d+25688(SB)/8,$"\xd3\x67\x2b\x1c\x36\x56\xe6\x61"
-DATA ·d+25696(SB)/8,$"\x95\x11\x63\xa3\x83\xc0\x72\x46"
-DATA ·d+25704(SB)/8,$"\x7f\x20\x6a\x04\x7b\x9b\x58\x8c"
-DATA ·d+25712(SB)/8,$"\xd6\xb2\x44\xde\x6e\x7e\x69\x37"
-DATA ·d+25720(SB)/8,$"\x86\x24\x7b\x2f\x4a\xad\x67\xa6"
-DATA ·d+25728(SB)/8,$"\xdc\x2d\xec\x72\x49\x73\x6d\x1c"
-DATA ·d+25736(SB)/8,$"\xac\xdb\x95\xa2\x70\x5d\x4b\x75"
-DATA ·d+25744(SB)/8,$"\x3c\xf3\xf9\x9a\xeb\xa6\xe5\x08"
-DATA ·d+25752(SB)/8,$"\xa8\xc7\x5b\xf9\x2e\xbd\xb4\x5a"
-DATA ·d+25760(SB)/8,$"\x9c\xb3\x92\x93\x72\x94\xad\x6f"
-DATA ·d+25768(SB)/8,$"\x37\xf3\x19\x73\x7b\xa7\x6d\x31"
-DATA ·d+25776(SB)/8,$"\x5c\x5b\x69\xd1\x34\x67\x63\x6d"
-DATA ·d+25784(SB)/8,$"\x32\x36\x7e\x83\x68\x1f\x95\x65"
-DATA ·d+25792(SB)/8,$"\xb6\x91\xa7\x4d\x07\x9f\x6f\xf6"
-DATA ·d+25800(SB)/8,$"\x69\x0b\x2e\x42\xf9\xa5\x4a\xf3"
-DATA ·d+25808(SB)/8,$"\xd0\x82\x33\x9f\xc2\xb6\xf5\x3d"
-DATA ·d+25816(SB)/8,$"\x3e\xf2\x2b\x89\x23\xbd\xbe\x8d"
-DATA ·d+25824(SB)/8,$"\xfc\xa5\xdd\xba\x55\x4d\xa5\x7a"
-DATA ·d+25832(SB)/8,$"\xe4\x12\x4f\x5f\x4e\xb4\xdd\x2d"
-DATA ·d+25840(SB)/8,$"\x1d\x95\xc4\x72\x44\xa0\x29\x20"
-DATA ·d+25848(SB)/8,$"\xa8\xc9\xeb\xe8\x17\x8f\xce\xb1"
-DATA ·d+25856(SB)/8,$"\x92\xbd\x47\x1a\x8e\xff\x00\x75"
-DATA ·d+25864(SB)/8,$"\xff\x00\x03\x7a\x55\xad\x4f\x9a"
-DATA ·d+25872(SB)/8,$"\x9f\xe6\xec\x57\xb5\xf0\xd6\x83"
-DATA ·d+25880(SB)/8,$"\x7e\x76\x0f\xb5\x42\xe3\x82\x91"
-DATA ·d+25888(SB)/8,$"\xfc\xf9\xfa\x1e\x33\xf8\xd6\x46"
-DATA ·d+25896(SB)/8,$"\xb1\x65\xa4\xe8\x33\x2c\x37\x52"
-DATA ·d+25904(SB)/8,$"\x88\x8b\x72\xb1\x5c\xbe\x59\x87"
-DATA ·d+25912(SB)/8,$"\xfb\x80\x67\x1f\x4a\xd4\xbe\xd7"
-DATA ·d+25920(SB)/8,$"\xb5\x8d\x21\x5e\x58\x44\x33\xdb"
-DATA ·d+25928(SB)/8,$"\x9c\x2c\x68\xb3\x14\x91\x88\xef"
-DATA ·d+25936(SB)/8,$"\xb7\x6b\x05\x1f\xef\x11\x59\x30"
-DATA ·d+25944(SB)/8,$"\xea\xb7\x72\xdd\x4b\xab\xc9\x65"
-DATA ·d+25952(SB)/8,$"\x06\xf6\x50\x0b\x49\x30\xdf\x8e"
-DATA ·d+25960(SB)/8,$"\xc3\x3b\x4f\xe9\x5c\xd8\x8c\x5d"
-DATA ·d+25968(SB)/8,$"\x05\x15\x4e\x9c\x6d\x2e\xee\x2f"
-DATA ·d+25976(SB)/8,$"\x6f\x96\xe7\x5d\x1a\x55\xee\xea"
-DATA ·d+25984(SB)/8,$"\x54\x97\x34\x7b\x29\x2d\xfb\x6b"
-DATA ·d+25992(SB)/8,$"\xb7\xdc\x66\xbd\xe6\x86\x15\x1a"
-DATA ·d+26000(SB)/8,$"\x58\xd0\x2c\x8e\x10\x48\x88\xc0"
-DATA ·d+26008(SB)/8,$"\xe4\xf4\xc2\x36\x1b\x1f\x41\x5b"
-DATA ·d+26016(SB)/8,$"\x06\xdb\x4e\x07\x18\x7f\xfc\x07"
-DATA ·d+26024(SB)/8,$"\x92\xb7\xe6\xba\x8e\xeb\xcb\x9a"
-DATA ·d+26032(SB)/8,$"\xe2\x08\xd6\x12\x06\xf9\x42\xac"
-DATA ·d+26040(SB)/8,$"\xa5\x7f\x01\xc9\xfc\xab\x9c\x92"
-DATA ·d+26048(SB)/8,$"\xf3\x46\x0e\xc3\xec\xf2\x1e\x4f"
-DATA ·d+26056(SB)/8,$"\x22\x1c\x67\xf5\xa2\x14\xa7\xbb"
-DATA ·d+26064(SB)/8,$"\x9a\x6b\xa7\xbb\x62\xe5\x5d\x34"
-DATA ·d+26072(SB)/8,$"\xad\x19\x5f\xc9\xdf\xf4\x3f\xff"
-DATA ·d+26080(SB)/8,$"\xd0\xfc\x4a\x93\xc5\x32\x6a\x77"
-DATA ·d+26088(SB)/8,$"\xd1\xc5\x70\x23\x2a\xf8\x12\x30"
-DATA ·d+26096(SB)/8,$"\x9d\x94\xe0\xf5\xc7\xee\xcf\x3f"
-DATA ·d+26104(SB)/8,$"\x95\x76\x32\xe9\xfa\x35\xb2\x4a"
-DATA ·d+26112(SB)/8,$"\xfa\x1a\xcf\x75\x71\x1a\x82\x91"
-DATA ·d+26120(SB)/8,$"\x5d\x3a\xa2\x61\xba\x1c\x90\x37"
-DATA ·d+26128(SB)/8,$"\x7e\x7c\x1a\xe1\x60\xf1\xdd\x84"
-DATA ·d+26136(SB)/8,$"\xcc\x1e\x1b\x68\x61\x8f\x77\x56"
-DATA ·d+26144(SB)/8,$"\x54\xe3\x3f\x4e\x4d\x75\x36\xda"
-DATA ·d+26152(SB)/8,$"\xc5\xb6\xa4\xa9\x15\x8a\xda\xb9"
-DATA ·d+26160(SB)/8,$"\x3f\x2e\xc7\xe3\x3c\xe7\x23\x27"
-DATA ·d+26168(SB)/8,$"\x8f\xf2\x6b\xfb\xf7\x2e\xc6\x42"
-DATA ·d+26176(SB)/8,$"\x51\x77\xaf\xed\x1b\xf2\xd7\xe4"
-DATA ·d+26184(SB)/8,$"\x7f\x8c\xd8\xfc\x35\x48\x72\xda"
-DATA ·d+26192(SB)/8,$"\x93\x82\x5d\x2f\xa7\xce\xdf\xd7"
-DATA ·d+26200(SB)/8,$"\x99\x91\x73\x37\x8f\x2e\xe3\x30"
-DATA ·d+26208(SB)/8,$"\xe9\x90\x18\x58\x2e\x5b\x31\x82"
-DATA ·d+26216(SB)/8,$"\x46\x39\x38\xe7\x93\xf4\x1c\xd7"
-DATA ·d+26224(SB)/8,$"\x9e\x6a\x3a\x6f\x8a\x75\x96\x55"
-DATA ·d+26232(SB)/8,$"\xd7\x14\xcb\x8c\xed\x94\x66\x22"
-DATA ·d+26240(SB)/8,$"\xd9\x3d\x06\x78\x26\xbd\xff\x00"
-DATA ·d+26248(SB)/8,$"\x4d\xb4\xbe\x81\x7c\xe8\xad\xf8"
-DATA ·d+26256(SB)/8,$"\x8d\xb6\xee\x8e\x61\xb8\x7e\xbc"
-DATA ·d+26264(SB)/8,$"\x0f\x4e\x6b\xa2\x4d\x37\x49\xbc"
-DATA ·d+26272(SB)/8,$"\xbb\x48\xb0\xab\x26\x7c\xc0\x82"
-DATA ·d+26280(SB)/8,$"\x63\x23\xee\x27\xa8\x5c\x71\xf4"
-DATA ·d+26288(SB)/8,$"\x35\x9d\x7c\xaa\x35\x77\xaa\xfd"
-DATA ·d+26296(SB)/8,$"\x19\xc9\x4f\x89\xfe\xaa\xdf\x2d"
-DATA ·d+26304(SB)/8,$"\x18\xe9\xd5\x6f\xf9\xdc\xf9\xcf"
-DATA ·d+26312(SB)/8,$"\x49\xf0\x16\x87\x13\x7d\x8f\x56"
-DATA ·d+26320(SB)/8,$"\xd3\xb5\x17\x95\xc8\xc3\x44\xe1"
-DATA ·d+26328(SB)/8,$"\x97\xeb\x8e\xb5\xd6\xf8\x8b\xe1"
-DATA ·d+26336(SB)/8,$"\xc6\x99\xe1\x88\xe0\x96\x38\xef"
-DATA ·d+26344(SB)/8,$"\x3c\xb9\x8b\x79\xa2\x67\x8a\x11"
-DATA ·d+26352(SB)/8,$"\x0a\xae\x30\x5b\x39\x24\x93\xd0"
-DATA ·d+26360(SB)/8,$"\x01\x9f\x5a\xf5\x3d\x6f\xc3\x97"
-DATA ·d+26368(SB)/8,$"\x4b\x0c\x83\x4b\xbb\x96\x3c\xf3"
-DATA ·d+26376(SB)/8,$"\xf2\x82\x1b\x23\x8c\x64\x1a\xf0"
-DATA ·d+26384(SB)/8,$"\x7d\x7e\xc2\xfa\xde\xed\xa1\xd5"
-DATA ·d+26392(SB)/8,$"\x1d\x8b\x01\x9d\xf7\x05\x89\x3e"
-DATA ·d+26400(SB)/8,$"\xe0\xf2\x2b\x87\x17\x82\xa7\x87"
-DATA ·d+26408(SB)/8,$"\xa7\xca\xa1\x7f\x3d\x0f\x47\x2c"
-DATA ·d+26416(SB)/8,$"\xcf\xab\x63\x6a\xc6\xa2\xaf\x65"
-DATA ·d+26424(SB)/8,$"\xd6\x3a\xeb\xa7\xe1\xf2\x18\x75"
-DATA ·d+26432(SB)/8,$"\xaf\x0c\xd9\xdb\x2d\xbc\x50\x47"
-DATA ·d+26440(SB)/8,$"\x39\x62\x42\xc9\x28\x92\x52\xa4"
-DATA ·d+26448(SB)/8,$"\x74\xcb\x0c\x0c\x7d\x33\x59\x67"
-DATA ·d+26456(SB)/8,$"\x58\x8d\x1b\xcc\xb9\x8a\xdd\x49"
-DATA ·d+26464(SB)/8,$"\x00\xed\x86\xd9\xb6\x8f\xa3\x34"
-DATA ·d+26472(SB)/8,$"\x87\x23\xf0\x15\x05\xad\xc6\x9f"
-DATA ·d+26480(SB)/8,$"\x90\x88\xe1\x09\xea\x89\x16\x47"
-DATA ·d+26488(SB)/8,$"\x3c\x75\x3c\x7f\x3c\x57\x47\x6f"
-DATA ·d+26496(SB)/8,$"\xa1\x69\x33\x40\x92\xa6\xa5\x2c"
-DATA ·d+26504(SB)/8,$"\x64\xf2\xea\xeb\x94\x0d\xe8\x00"
-DATA ·d+26512(SB)/8,$"\xe7\x8e\xf5\xe4\xfb\x59\xd6\x92"
-DATA ·d+26520(SB)/8,$"\xe5\x96\xab\xb6\x87\xd2\xca\x74"
-DATA ·d+26528(SB)/8,$"\xa8\x2f\x79\x3d\x7b\xdd\xfe\x86"
-DATA ·d+26536(SB)/8,$"\x9c\xbe\x23\xf0\xad\xfa\x47\x6f"
-DATA ·d+26544(SB)/8,$"\x61\x35\xc5\x81\x64\x0a\xe8\xa5"
-DATA ·d+26552(SB)/8,$"\x93\x73\x63\x9e\x72\x41\xcf\xa5"
-DATA ·d+26560(SB)/8,$"\x60\xea\xff\x00\x0a\xad\x75\x02"
-DATA ·d+26568(SB)/8,$"\xd7\x9a\x64\xe6\xe7\x27\x76\xc9"
-DATA ·d+26576(SB)/8,$"\xd9\x91\xc8\xf5\x00\xf0\x7f\x03"
-DATA ·d+26584(SB)/8,$"\x5a\xcf\xa1\xe8\x73\x43\xe5\x4d"
-DATA ·d+26592(SB)/8,$"\x74\xa7\xb9\x22\x1c\xf1\xdf\x9c"
-DATA ·d+26600(SB)/8,$"\x71\xf8\xd6\xd6\x8b\xa3\xe9\x9a"
-DATA ·d+26608(SB)/8,$"\x62\x38\xb9\xd4\x5d\xed\xc0\x1b"
-DATA ·d+26616(SB)/8,$"\x16\x47\x28\xa3\x3f\xc5\xd7\x8f"
-DATA ·d+26624(SB)/8,$"\xa1\xe9\x5e\xa4\xa5\x2a\x96\x86"
-DATA ·d+26632(SB)/8,$"\x22\x09\xf9\xdf\xfe\x09\xe3\x47"
-DATA ·d+26640(SB)/8,$"\x17\xf5\x75\xed\x30\x95\x24\x9f"
-DATA ·d+26648(SB)/8,$"\x66\xaf\x7f\xc1\x33\xc2\xef\x7c"
-DATA ·d+26656(SB)/8,$"\x03\x63\x65\x29\x8e\xfe\xce\xf2"
-DATA ·d+26664(SB)/8,$"\x26\xfe\xf2\xa7\x1f\x86\x71\xfc"
-DATA ·d+26672(SB)/8,$"\xe8\xd3\xfc\x35\xa6\x86\xdb\x33"
-DATA ·d+26680(SB)/8,$"\x4a\x83\xa0\x04\xe0\xe0\x7a\x83"
-DATA ·d+26688(SB)/8,$"\x5f\x52\xdc\x6b\x9a\x5b\x69\xff"
-DATA ·d+26696(SB)/8,$"\x00\x60\xd3\xe3\x87\x50\x61\xca"
-DATA ·d+26704(SB)/8,$"\x37\x9e\x25\x07\xdb\x0e\x7b\x7d"
-DATA ·d+26712(SB)/8,$"\x41\xf7\xa8\xbf\xb4\x3e\x1d\x5f"
-DATA ·d+26720(SB)/8,$"\x40\xb1\x6a\xf6\xf1\x59\x5d\x46"
-DATA ·d+26728(SB)/8,$"\x98\x68\xa4\x57\x8f\x73\x7f\xb2"
-DATA ·d+26736(SB)/8,$"\x41\xda\x55\xbb\x7c\xd5\x94\x72"
-DATA ·d+26744(SB)/8,$"\x4c\x37\x3d\xe3\x35\xf3\xdb\xef"
-DATA ·d+26752(SB)/8,$"\xef\xf2\x3a\xa3\xc6\xb8\xc7\x4f"
-DATA ·d+26760(SB)/8,$"\xdf\xa5\x27\xaf\x4d\x5f\xad\xb7"
-DATA ·d+26768(SB)/8,$"\x47\x80\x5b\xae\x89\xa4\xc8\xb3"
-DATA ·d+26776(SB)/8,$"\x5b\x5d\xcd\x0c\xa9\xd0\x96\x3c"
-DATA ·d+26784(SB)/8,$"\x1f\x51\xc5\x5c\x3a\xc6\xa1\x74"
-DATA ·d+26792(SB)/8,$"\x5e\xe2\x39\xe3\x97\x7f\x2c\x4a"
-DATA ·d+26800(SB)/8,$"\x1c\xb7\xa9\xc6\x40\x24\x7d\x2b"
-DATA ·d+26808(SB)/8,$"\x47\xc4\x53\x68\x57\x97\x4b\x6b"
-DATA ·d+26816(SB)/8,$"\xa1\x69\xf6\xcc\x1f\x92\xd3\x5c"
-DATA ·d+26824(SB)/8,$"\x3b\x48\xa7\x38\x61\xb1\x15\x86"
-DATA ·d+26832(SB)/8,$"\xde\xe3\xe6\x24\xd5\x9b\x2f\x0b"
-DATA ·d+26840(SB)/8,$"\x47\x6f\x70\x8c\x90\xdb\x80\xa4"
-DATA ·d+26848(SB)/8,$"\x11\x9b\x79\x9c\x1f\xfb\xea\x54"
-DATA ·d+26856(SB)/8,$"\xc7\xe3\x5e\x65\x4a\xed\xc9\xd2"
-DATA ·d+26864(SB)/8,$"\xa5\x1b\xa5\xd9\x34\xbf\x1b\x1e"
-DATA ·d+26872(SB)/8,$"\xc2\xaf\x05\x18\xd5\xac\xed\x27"
-DATA ·d+26880(SB)/8,$"\xde\xcf\xf2\xb9\xe7\xb2\x68\x2f"
-DATA ·d+26888(SB)/8,$"\xaa\x4d\xe6\xcb\x77\x21\x2c\x77"
-DATA ·d+26896(SB)/8,$"\x04\x8a\x0e\x79\xf4\x00\x57\x53"
-DATA ·d+26904(SB)/8,$"\x07\xc2\x6d\x5e\xfe\xd3\xed\x11"
-DATA ·d+26912(SB)/8,$"\xdd\x6a\x31\xa2\x0e\x1a\xeb\xf7"
-DATA ·d+26920(SB)/8,$"\x68\xbf\xf7\xd1\x1c\x7d\x2b\xd3"
-DATA ·d+26928(SB)/8,$"\x2f\x3c\x6b\x7f\xe1\xd0\xb0\xe9"
-DATA ·d+26936(SB)/8,$"\xf6\x56\x70\xf9\x7f\x2b\x30\x89"
-DATA ·d+26944(SB)/8,$"\x41\x39\xe8\x73\xc9\x1f\x99\xaf"
-DATA ·d+26952(SB)/8,$"\x35\xd4\x3e\x21\xa6\xa3\x79\xe4"
-DATA ·d+26960(SB)/8,$"\xea\xd7\x53\x99\x3f\xe5\x98\x91"
-DATA ·d+26968(SB)/8,$"\x73\x1f\xd3\x39\xe3\xf2\xad\xdd"
-DATA ·d+26976(SB)/8,$"\x1c\x1d\x2f\xe2\xbd\x5f\xc8\xca"
-DATA ·d+26984(SB)/8,$"\x96\x67\x9a\x57\xfe\x04\x52\x8a"
-DATA ·d+26992(SB)/8,$"\xf9\xbf\xb9\x23\x06\xeb\xc1\x49"
-DATA ·d+27000(SB)/8,$"\xa7\x82\xd7\xba\x8a\xce\x3d\x81"
-DATA ·d+27008(SB)/8,$"\x63\xf8\x55\x48\x3c\x11\xa0\x6a"
-DATA ·d+27016(SB)/8,$"\x4c\x14\xdd\xa4\x4c\x4e\x7f\xd2"
-DATA ·d+27024(SB)/8,$"\x0f\x96\x98\xfa\xff\x00\x4a\xe8"
-DATA ·d+27032(SB)/8,$"\xe3\xf1\x0a\xdc\xc8\x6c\x65\xb7"
-DATA ·d+27040(SB)/8,$"\x8d\xfb\xa3\x01\x90\xd9\xef\xc7"
-DATA ·d+27048(SB)/8,$"\x4a\xfa\xaf\xf6\x54\xff\x00\x82"
-DATA ·d+27056(SB)/8,$"\x7d\xfe\xd1\x1f\xb7\x27\x8b\x13"
-DATA ·d+27064(SB)/8,$"\x42\xf8\x39\xa3\x99\x34\xd8\xa6"
-DATA ·d+27072(SB)/8,$"\xdb\xa9\x78\x9a\xe8\xb4\x5a\x26"
-DATA ·d+27080(SB)/8,$"\x9e\xbf\xc4\x67\xba\xc6\x19\xc0"
-DATA ·d+27088(SB)/8,$"\xe9\x0c\x45\xe5\x27\x00\x85\x04"
-DATA ·d+27096(SB)/8,$"\xb0\xe5\xc4\xd7\xc3\x53\x83\xa9"
-DATA ·d+27104(SB)/8,$"\xca\x9a\x5d\xdb\xfc\x5d\xcf\xa0"
-DATA ·d+27112(SB)/8,$"\xc9\xe8\xe6\x18\x9a\x91\xa1\x16"
-DATA ·d+27120(SB)/8,$"\xd4\xa5\xb5\x92\x7f\x82\x47\xce"
-DATA ·d+27128(SB)/8,$"\x1f\x0d\xbf\x67\xbd\x4f\xe2\x7f"
-DATA ·d+27136(SB)/8,$"\x8f\x34\xaf\x86\x9f\x0c\x62\xb8"
-DATA ·d+27144(SB)/8,$"\xd7\xf5\xfd\x6a\xed\x2c\xb4\xed"
-DATA ·d+27152(SB)/8,$"\x2f\x4a\x8c\xc8\xf2\xc8\xc4\x64"
-DATA ·d+27160(SB)/8,$"\x96\xc6\x12\x34\x07\x74\x92\x37"
-DATA ·d+27168(SB)/8,$"\xc9\x1a\x65\x98\x80\x2b\xfa\xa6"
-DATA ·d+27176(SB)/8,$"\xf0\x77\xfc\x10\x3f\xf6\x48\xf8"
-DATA ·d+27184(SB)/8,$"\x77\xa4\x78\x2f\xc3\x5f\xb4\xde"
-DATA ·d+27192(SB)/8,$"\xa7\xe2\xd6\xd4\xf5\xab\x28\xb4"
-DATA ·d+27200(SB)/8,$"\xfb\xfd\x7f\xc3\x1a\xac\x96\x5a"
-DATA ·d+27208(SB)/8,$"\x77\xf6\xec\x8c\xce\x2d\xda\x12"
-DATA ·d+27216(SB)/8,$"\xac\x16\x39\x01\x10\xc1\x26\x47"
-DATA ·d+27224(SB)/8,$"\x98\xe8\x15\x86\xf9\x17\x3f\xae"
-DATA ·d+27232(SB)/8,$"\x5f\xf0\x4f\x7f\xf8\x25\xc7\xc0"
-DATA ·d+27240(SB)/8,$"\xbf\xf8\x27\x8f\x82\x4d\xdf\x87"
-DATA ·d+27248(SB)/8,$"\x21\x1e\x21\xf1\x86\xa3\x00\x83"
-DATA ·d+27256(SB)/8,$"\xc4\x5e\x33\xd4\x63\x58\x6e\x65"
-DATA ·d+27264(SB)/8,$"\x8c\x9d\xc2\x0b\x48\x8e\xe1\x6d"
-DATA ·d+27272(SB)/8,$"\x66\x8c\x7f\xd5\x23\x17\x7f\xbd"
-DATA ·d+27280(SB)/8,$"\x23\xbb\xf3\x5f\x56\x7c\x7b\xf0"
-DATA ·d+27288(SB)/8,$"\x35\xb7\x8f\xbc\x1f\x75\xa4\xdd"
-DATA ·d+27296(SB)/8,$"\x16\x58\xae\x22\xdc\xbb\x49\x6d"
-DATA ·d+27304(SB)/8,$"\xb3\x27\x53\xf2\x95\x24\x10\x03"
-DATA ·d+27312(SB)/8,$"\x70\x41\xe4\xe0\x82\x01\x1f\x99"
-DATA ·d+27320(SB)/8,$"\x66\xbc\x68\xb1\x15\x15\x2c\x2c"
-DATA ·d+27328(SB)/8,$"\x79\x23\xdd\x77\xef\xaf\xf4\xcf"
-DATA ·d+27336(SB)/8,$"\xea\xae\x0a\xf0\xaf\xea\x74\x1e"
-DATA ·d+27344(SB)/8,$"\x23\x32\x97\xb4\xa8\xed\xa3\xd9"
-DATA ·d+27352(SB)/8,$"\x2e\xbb\x75\xfc\x8f\xcc\x8d\x13"
-DATA ·d+27360(SB)/8,$"\xfe\x0d\xc3\xff\x00\x82\x75\x5b"
-DATA ·d+27368(SB)/8,$"\xf9\x72\x5c\x4b\xf1\x06\xf2\x37"
-DATA ·d+27376(SB)/8,$"\xc1\x41\x2f\x88\xa5\x55\x20\x8c"
-DATA ·d+27384(SB)/8,$"\x8c\x18\x91\x49\x07\xeb\x5e\xdf"
-DATA ·d+27392(SB)/8,$"\xe1\xaf\xf8\x37\xf3\xfe\x09\x81"
-DATA ·d+27400(SB)/8,$"\xa2\xb2\xcb\x71\xe0\x9d\x57\x51"
-DATA ·d+27408(SB)/8,$"\x31\xb0\xe3\x53\xf1\x0e\xa9\x3a"
-DATA ·d+27416(SB)/8,$"\xb1\xc7\xf1\x21\xb8\x0a\x7e\x84"
-DATA ·d+27424(SB)/8,$"\x57\xdb\x9f\xb3\x97\xc4\x9d\x7f"
-DATA ·d+27432(SB)/8,$"\x56\xf0\xd4\x7e\x16\xf1\xa8\x6f"
-DATA ·d+27440(SB)/8,$"\xed\x3d\x2b\xfd\x0e\xe6\x57\xe5"
-DATA ·d+27448(SB)/8,$"\xa6\xd9\xc0\x94\x9e\x37\x33\x0c"
-DATA ·d+27456(SB)/8,$"\x31\x61\x80\xc7\xe6\xc0\xce\x07"
-DATA ·d+27464(SB)/8,$"\xd9\x9a\x7c\x82\x68\xc0\x27\x93"
-DATA ·d+27472(SB)/8,$"\xc6\x7f\xaf\xe3\x5f\x03\x99\x67"
-DATA ·d+27480(SB)/8,$"\x99\x9d\x19\x38\xcb\x11\x2f\x93"
-DATA ·d+27488(SB)/8,$"\xb1\xfa\xc6\x07\x83\x32\x69\xc6"
-DATA ·d+27496(SB)/8,$"\xff\x00\x54\x8b\xf5\x57\xfc\xcf"
-DATA ·d+27504(SB)/8,$"\x80\xfe\x1c\xff\x00\xc1\x2b\x3f"
-DATA ·d+27512(SB)/8,$"\xe0\x9e\x9f\x0b\xa6\x12\xf8\x3b"
-DATA ·d+27520(SB)/8,$"\xe0\xef\x81\x61\x94\x60\x19\xee"
-DATA ·d+27528(SB)/8,$"\xf4\xe8\xef\x64\x39\xf5\x6b\x9f"
-DATA ·d+27536(SB)/8,$"\x30\x9a\xe3\x3e\x13\x68\x9e\x17"
-DATA ·d+27544(SB)/8,$"\xf8\x7f\xfb\x30\xfc\x47\xf1\x15"
-DATA ·d+27552(SB)/8,$"\x8e\x9b\x61\x67\x1e\x9d\xe2\xef"
-DATA ·d+27560(SB)/8,$"\x1b\x38\x16\xd6\xe9\x10\x8e\xc7"
-DATA ·d+27568(SB)/8,$"\x4f\xba\x9d\x63\x89\x76\x01\x84"
-DATA ·d+27576(SB)/8,$"\x44\x45\x54\x5f\xba\xa3\x00\x0e"
-DATA ·d+27584(SB)/8,$"\x2b\xf5\x3e\x38\x80\xeb\x83\x83"
-DATA ·d+27592(SB)/8,$"\x9e\x95\xf9\x27\xf1\xb3\xc4\x91"
-DATA ·d+27600(SB)/8,$"\x7c\x35\xff\x00\x82\x71\x7c\x5b"
-DATA ·d+27608(SB)/8,$"\xf1\x14\xb8\xdf\x3e\xad\xe3\x08"
-DATA ·d+27616(SB)/8,$"\xa1\x52\x76\xee\x97\x50\xd5\xa7"
-DATA ·d+27624(SB)/8,$"\x85\x17\x3f\xed\x6f\x15\xc5\x95"
-DATA ·d+27632(SB)/8,$"\x66\x38\x9c\x5d\x68\x53\x95\x47"
-DATA ·d+27640(SB)/8,$"\x26\xe7\x05\xab\x6f\x76\xcf\x4f"
-DATA ·d+27648(SB)/8,$"\x1d\x94\x60\xf0\x74\x6a\x55\xa7"
-DATA ·d+27656(SB)/8,$"\x46\x31\xb4\x64\xdd\x92\xe8\x91"
-DATA ·d+27664(SB)/8,$"\xfc\xfe\xfc\x7b\xf0\xbf\xec\xcd"
-DATA ·d+27672(SB)/8,$"\xf1\x89\xa0\xf8\x51\xfb\x45\x78"
-DATA ·d+27680(SB)/8,$"\x5d\xb5\x59\x34\xab\x2b\x7d\x26"
-DATA ·d+27688(SB)/8,$"\xd3\xc6\x7e\x1e\x98\x58\xf8\x8a"
-DATA ·d+27696(SB)/8,$"\xd2\x6b\x6b\x1b\x74\x9e\x51\x39"
-DATA ·d+27704(SB)/8,$"\xcc\x77\x8a\x26\x66\x7f\x2e\xe4"
-DATA ·d+27712(SB)/8,$"\x30\x3d\xf3\x5f\x9e\xfa\xb7\xfc"
-DATA ·d+27720(SB)/8,$"\x12\x46\x3f\x14\xdc\x47\x27\xec"
-DATA ·d+27728(SB)/8,$"\xbd\xf1\x3f\xc3\x1e\x26\x8a\x64"
-DATA ·d+27736(SB)/8,$"\x69\xa2\xf0\xef\x8e\x52\x5f\x0d"
-DATA ·d+27744(SB)/8,$"\x6a\xe8\xa8\x1b\x7f\xef\x63\x59"
-DATA ·d+27752(SB)/8,$"\x6d\x66\xc1\x46\xc9\x45\x8c\x00"
-DATA ·d+27760(SB)/8,$"\x32\x7d\x6b\xb2\xb2\xf1\x9e\xb1"
-DATA ·d+27768(SB)/8,$"\xe2\x1d\x69\xf5\x8b\xe9\x0b\x4e"
-DATA ·d+27776(SB)/8,$"\xcd\x73\x70\xf2\x13\x9d\xcf\x31"
-DATA ·d+27784(SB)/8,$"\xc9\x38\xf7\xda\x3e\x95\xec\xba"
-DATA ·d+27792(SB)/8,$"\x1d\xcc\x1a\x34\xb3\x39\x12\xb1"
-DATA ·d+27800(SB)/8,$"\xb9\x83\xec\x70\xb4\x4e\x00\x10"
-DATA ·d+27808(SB)/8,$"\xcb\x12\x34\xf9\x07\x9c\x33\x00"
-DATA ·d+27816(SB)/8,$"\xad\x8c\x70\x4f\x3d\x6b\xfa\xda"
-DATA ·d+27824(SB)/8,$"\x8e\x44\xe9\xc2\x16\x9f\xbd\x14"
-DATA ·d+27832(SB)/8,$"\x97\x7f\xcf\xf4\xb1\xfc\xa7\x8f"
-DATA ·d+27840(SB)/8,$"\x9e\x1b\x15\x39\x7b\x5a\x29\xa6"
-DATA ·d+27848(SB)/8,$"\xef\xa6\x8f\xf0\xea\x7e\x54\x7c"
-DATA ·d+27856(SB)/8,$"\x50\xfd\x81\xff\x00\x6c\x8f\x81"
-DATA ·d+27864(SB)/8,$"\xac\xf7\x5e\x32\xf8\x35\xae\xdd"
-DATA ·d+27872(SB)/8,$"\xda\x2b\x65\x75\x3d\x0d\x1f\x5b"
-DATA ·d+27880(SB)/8,$"\xb3\x75\xc6\x43\x2c\x9a\x7b\x4e"
-DATA ·d+27888(SB)/8,$"\x76\xe3\x9c\xb2\x8a\xf9\x03\x55"
-DATA ·d+27896(SB)/8,$"\xf1\xfb\xe8\x37\x0f\xa6\x6b\x1a"
-DATA ·d+27904(SB)/8,$"\x15\xbe\x9d\x2a\xf0\xf6\xba\x8e"
-DATA ·d+27912(SB)/8,$"\xeb\x69\x01\xf7\x8e\x74\x47\xfd"
-DATA ·d+27920(SB)/8,$"\x2b\xfa\x73\xf0\x27\x8e\x3c\x6f"
-DATA ·d+27928(SB)/8,$"\xe0\xa1\x1b\xf8\x43\x58\xd6\x6c"
-DATA ·d+27936(SB)/8,$"\x25\x44\xf2\xad\xe3\xb7\xbf\xb8"
-DATA ·d+27944(SB)/8,$"\x48\x63\x45\x3f\x79\xa3\xdf\xb1"
-DATA ·d+27952(SB)/8,$"\x98\x8f\xba\x08\xc0\x1d\x6b\xe9"
-DATA ·d+27960(SB)/8,$"\x4b\x4f\x88\xfe\x38\xd6\xfc\x23"
-DATA ·d+27968(SB)/8,$"\x7d\xe3\x4f\x8d\xba\xb5\x9c\xda"
-DATA ·d+27976(SB)/8,$"\x2d\x8d\xb3\x4b\x2d\xde\xaf\xa6"
-DATA ·d+27984(SB)/8,$"\x58\x5c\x4c\xca\xbc\x00\xaf\x24"
-DATA ·d+27992(SB)/8,$"\x04\xb3\xc8\x7e\x58\xd7\x04\xbb"
-DATA ·d+28000(SB)/8,$"\x1f\x4a\xd2\xa4\xb1\x94\x74\x55"
-DATA ·d+28008(SB)/8,$"\x16\xbe\x5b\xbf\x4b\x3f\xcc\xf1"
-DATA ·d+28016(SB)/8,$"\x2a\x70\x3e\x5b\x88\x7c\xcd\x3d"
-DATA ·d+28024(SB)/8,$"\x35\xd5\xbb\x25\xeb\x75\xf9\x1f"
-DATA ·d+28032(SB)/8,$"\xc5\x5d\xc6\xa5\x0d\xec\xe6\x6f"
-DATA ·d+28040(SB)/8,$"\xb3\xc4\x51\x9b\xe6\x50\x55\xb1"
-DATA ·d+28048(SB)/8,$"\x9e\x78\x65\xda\x47\x15\xd3\x41"
-DATA ·d+28056(SB)/8,$"\x6b\xf0\xfd\xe3\x49\x04\xf7\xca"
-DATA ·d+28064(SB)/8,$"\xec\xa3\x72\x0c\x7c\xad\xdf\x1c"
-DATA ·d+28072(SB)/8,$"\xf2\x2b\xf4\xa3\xfe\x0a\x05\xf0"
-DATA ·d+28080(SB)/8,$"\x60\x78\xc7\x45\xf0\x37\xed\x31"
-DATA ·d+28088(SB)/8,$"\xf0\xf3\x4d\x8e\xdb\x43\xf1\x45"
-DATA ·d+28096(SB)/8,$"\x96\xa3\xe1\xc9\xf4\xcb\x28\x92"
-DATA ·d+28104(SB)/8,$"\x28\xf4\xed\x5f\x44\xbb\x94\xcd"
-DATA ·d+28112(SB)/8,$"\x11\xf2\xc2\x86\x37\x31\xca\x67"
-DATA ·d+28120(SB)/8,$"\x0c\x57\x3f\x2b\x73\x8d\xa0\x7e"
-DATA ·d+28128(SB)/8,$"\x6b\x0f\x87\x1a\xd4\x29\xe6\x5f"
-DATA ·d+28136(SB)/8,$"\x4b\x15\xb8\x1d\x9d\xb2\x7f\x21"
-DATA ·d+28144(SB)/8,$"\x5e\x74\x70\x98\x8a\x55\x65\x1f"
-DATA ·d+28152(SB)/8,$"\x66\xaa\x5b\xab\xd9\x3e\xbd\xac"
-DATA ·d+28160(SB)/8,$"\x7c\x86\x65\x53\x0b\x4d\xc6\x0e"
-DATA ·d+28168(SB)/8,$"\xb7\x2e\x97\x56\x7b\xa7\xb7\x72"
-DATA ·d+28176(SB)/8,$"\x94\x16\xea\xd7\x04\x69\x92\x3a"
-DATA ·d+28184(SB)/8,$"\x31\xe1\x59\xdd\x39\x19\xe0\x1d"
-DATA ·d+28192(SB)/8,$"\xc4\x7b\x71\x4d\xd5\xfc\x14\xb3"
-DATA ·d+28200(SB)/8,$"\xd9\x36\xa3\xaa\xc5\x13\xe1\x8f"
-DATA ·d+28208(SB)/8,$"\x1f\x32\xb1\x1e\xb9\x4d\xc3\x1f"
-DATA ·d+28216(SB)/8,$"\x4a\x7a\xe9\xd7\x9a\x34\xc3\xca"
-DATA ·d+28224(SB)/8,$"\x8d\xae\x94\x1e\xaa\xa4\x66\xb6"
-DATA ·d+28232(SB)/8,$"\xdb\x52\xd2\xe6\x11\xc7\xa9\xdb"
-DATA ·d+28240(SB)/8,$"\xdc\x2c\x67\xfd\x62\xbc\xe8\xa3"
-DATA ·d+28248(SB)/8,$"\x3f\xec\x82\x7f\xa5\x28\x46\x97"
-DATA ·d+28256(SB)/8,$"\x24\x95\x57\x67\xd9\xec\x63\x2c"
-DATA ·d+28264(SB)/8,$"\x65\x58\x4e\x32\xa1\xaa\xee\xb7"
-DATA ·d+28272(SB)/8,$"\xfc\xcf\x21\x93\xc3\xd7\x10\x65"
-DATA ·d+28280(SB)/8,$"\xb4\x6b\x65\x00\xa8\xe9\x26\xf2"
-DATA ·d+28288(SB)/8,$"\x3f\x3c\x11\xf9\x55\x15\xff\x00"
-DATA ·d+28296(SB)/8,$"\x84\xa6\xde\x6f\x2c\x45\x29\x62"
-DATA ·d+28304(SB)/8,$"\x70\xaa\xc3\x70\x3f\x4e\xf5\xeb"
-DATA ·d+28312(SB)/8,$"\x5a\x96\x91\xa5\xc4\xde\x76\x85"
-DATA ·d+28320(SB)/8,$"\x6b\x9d\xdc\xa9\xf3\xd1\xd8\xe7"
-DATA ·d+28328(SB)/8,$"\xd5\x50\xd6\x64\xbe\x26\xf1\x1e"
-DATA ·d+28336(SB)/8,$"\x9d\x0f\xd9\x2e\xad\x27\x54\x03"
-DATA ·d+28344(SB)/8,$"\x00\xa4\x63\x38\xf6\x38\xcf\xeb"
-DATA ·d+28352(SB)/8,$"\x5e\x6b\xc1\x42\x17\xbd\x5b\x7f"
-DATA ·d+28360(SB)/8,$"\x87\x6f\xd0\xf7\x28\xe6\xb5\x2a"
-DATA ·d+28368(SB)/8,$"\x2f\x76\x9a\x95\xff\x00\x9b\x7f"
-DATA ·d+28376(SB)/8,$"\xcd\x98\x56\xa3\xc6\x4c\xa6\x19"
-DATA ·d+28384(SB)/8,$"\xb1\x66\x8d\xd5\x9d\x18\x0f\xc8"
-DATA ·d+28392(SB)/8,$"\xe6\xa6\xb5\x83\x59\x45\x62\x2f"
-DATA ·d+28400(SB)/8,$"\xd8\x6d\x3f\x76\x25\x00\xb7\xb8"
-DATA ·d+28408(SB)/8,$"\x1c\xfe\xb5\x9d\x2f\x89\xef\x1b"
-DATA ·d+28416(SB)/8,$"\xe4\x2b\x37\x94\x3f\xe5\x94\xac"
-DATA ·d+28424(SB)/8,$"\x40\x07\xf9\x7e\x95\x5a\x1b\xe9"
-DATA ·d+28432(SB)/8,$"\x6e\x64\xc4\x11\xec\xc9\xfb\xca"
-DATA ·d+28440(SB)/8,$"\xdc\x8a\xde\x18\xaa\x5a\x25\x26"
-DATA ·d+28448(SB)/8,$"\xdf\xa9\xa5\x4a\x55\x2c\xdb\x84"
-DATA ·d+28456(SB)/8,$"\x57\xa2\x43\x2f\xb5\xdb\xab\x19"
-DATA ·d+28464(SB)/8,$"\x4c\xae\xb7\xb3\x32\xfd\xd3\x3e"
-DATA ·d+28472(SB)/8,$"\xd0\x9f\xa6\x2b\x9b\xd4\xfc\x65"
-DATA ·d+28480(SB)/8,$"\xe2\x1b\xf6\x32\xc9\x1a\xec\x65"
-DATA ·d+28488(SB)/8,$"\x0b\xb1\x1e\x40\xbc\x74\xf9\x43"
-DATA ·d+28496(SB)/8,$"\x63\x3f\x85\x7a\x0c\xda\x67\x8a"
-DATA ·d+28504(SB)/8,$"\xa6\x45\x85\xee\x12\x45\x65\x2c"
-DATA ·d+28512(SB)/8,$"\xa8\x42\x96\xda\x3b\x8e\xe6\xb9"
-DATA ·d+28520(SB)/8,$"\x5d\x4f\xc3\xba\x85\xb7\xcf\x2c"
-DATA ·d+28528(SB)/8,$"\x2a\x41\xe4\x95\xc8\xcf\xd2\xb8"
-DATA ·d+28536(SB)/8,$"\xb1\xb4\x71\x33\x4e\xce\x5c\xa8"
-DATA ·d+28544(SB)/8,$"\xf4\xb2\xfc\x4e\x19\x34\xe4\x93"
-DATA ·d+28552(SB)/8,$"\x66\x35\x8e\xa1\xae\x6a\x20\xc1"
-DATA ·d+28560(SB)/8,$"\x65\x1c\x64\xf0\x4c\x72\x46\x33"
-DATA ·d+28568(SB)/8,$"\xf8\x56\xc4\x7e\x1f\xf1\xb1\x71"
-DATA ·d+28576(SB)/8,$"\x20\xb6\x82\x33\xd3\x27\x6e\xd3"
-DATA ·d+28584(SB)/8,$"\xf5\x52\x48\xae\x3e\x6b\x8f\xb3"
-DATA ·d+28592(SB)/8,$"\x3e\x1a\x37\xca\x9f\xba\x09\x1f"
-DATA ·d+28600(SB)/8,$"\xad\x49\x07\x88\x2e\x21\x20\x2c"
-DATA ·d+28608(SB)/8,$"\xb7\x30\x8f\xf6\x64\x24\x8f\xc2"
-DATA ·d+28616(SB)/8,$"\xbc\xea\x58\xaa\x6a\xca\xab\x6e"
-DATA ·d+28624(SB)/8,$"\xdd\x9d\xbf\x43\xd8\x9e\x1e\xab"
-DATA ·d+28632(SB)/8,$"\xd6\x82\x8a\xbf\x75\x7f\xd4\xec"
-DATA ·d+28640(SB)/8,$"\x8e\x83\xe2\x98\xf9\x32\xd8\x5b"
-DATA ·d+28648(SB)/8,$"\x37\xac\x6c\x10\xfe\x43\x22\xb2"
-DATA ·d+28656(SB)/8,$"\x2e\xf4\xdf\x18\x5b\x4b\xe7\x79"
-DATA ·d+28664(SB)/8,$"\xeb\x72\x40\x0a\x30\xc1\x8e\x3d"
-DATA ·d+28672(SB)/8,$"\x3b\x71\xed\x55\xcf\x89\x35\xf6"
-DATA ·d+28680(SB)/8,$"\x88\x08\x27\x96\xe1\x3a\x8d\xea"
-DATA ·d+28688(SB)/8,$"\xa7\xf3\xc8\xa5\x87\xc5\x7a\xf2"
-DATA ·d+28696(SB)/8,$"\xc5\xe5\x5b\x4a\xf1\x90\xd8\xf9"
-DATA ·d+28704(SB)/8,$"\x02\xe4\x1f\xca\xba\x2a\x62\x30"
-DATA ·d+28712(SB)/8,$"\xcf\x6e\x65\xf3\xfe\x91\xcb\x4a"
-DATA ·d+28720(SB)/8,$"\x86\x26\xee\xfc\x8c\xb7\xfd\xb3"
-DATA ·d+28728(SB)/8,$"\xe2\xf8\x14\xc5\x3d\x8d\xb1\x4c"
-DATA ·d+28736(SB)/8,$"\x61\xfc\xc8\x00\x07\xeb\x55\xe0"
-DATA ·d+28744(SB)/8,$"\xf1\x94\xb6\x37\x4b\x23\xc6\x59"
-DATA ·d+28752(SB)/8,$"\xd4\x9c\x2c\x52\xb0\x51\xbb\x82"
-DATA ·d+28760(SB)/8,$"\x31\xc8\xef\x5c\xf5\xcd\xfd\xf4"
-DATA ·d+28768(SB)/8,$"\xa0\xad\xfc\xd7\x52\xa9\x3b\x8a"
-DATA ·d+28776(SB)/8,$"\x93\xd4\xff\x00\x2a\x82\x35\xb5"
-DATA ·d+28784(SB)/8,$"\x70\x5e\x28\xd8\x63\x91\xe6\x75"
-DATA ·d+28792(SB)/8,$"\xae\x09\x63\xe7\x74\xe3\x27\xf3"
-DATA ·d+28800(SB)/8,$"\xb1\xe8\xc3\x01\x4e\xcf\x9e\x0b"
-DATA ·d+28808(SB)/8,$"\xe5\x75\xfa\x9d\xbd\xf7\x8a\xb4"
-DATA ·d+28816(SB)/8,$"\xed\x65\x04\x5f\x63\x4b\x36\xda"
-DATA ·d+28824(SB)/8,$"\x06\xf0\x77\x6e\xc0\xef\x91\xd6"
-DATA ·d+28832(SB)/8,$"\xb8\xeb\xd6\x10\xb6\xe4\x93\x78"
-DATA ·d+28840(SB)/8,$"\xe7\xa7\x6a\xd5\xd3\xf5\x08\x2c"
-DATA ·d+28848(SB)/8,$"\xd4\xad\xc5\xb4\x53\x02\x30\x43"
-DATA ·d+28856(SB)/8,$"\x0f\x5e\xfe\xd8\xad\x6d\x15\xb4"
-DATA ·d+28864(SB)/8,$"\x59\xae\xc4\x9a\xb2\x81\x11\x3f"
-DATA ·d+28872(SB)/8,$"\x75\x15\x7a\x1f\x5e\xff\x00\x95"
-DATA ·d+28880(SB)/8,$"\x74\xd4\xab\x2c\x44\xa2\xa5\x35"
-DATA ·d+28888(SB)/8,$"\x77\xf2\xb7\xae\xc7\x25\x38\xc2"
-DATA ·d+28896(SB)/8,$"\x82\x97\x2c\x1d\x97\xce\xff\x00"
-DATA ·d+28904(SB)/8,$"\xa9\xc8\xd9\xf8\x93\xc8\x7d\x92"
-DATA ·d+28912(SB)/8,$"\x47\x1c\x9c\xfd\xe7\x07\xfa\x62"
-DATA ·d+28920(SB)/8,$"\xb7\x3f\xe1\x26\xb2\x3c\xb4\x31"
-DATA ·d+28928(SB)/8,$"\xe4\xf5\xc1\xff\x00\xeb\x57\x49"
-DATA ·d+28936(SB)/8,$"\xe2\x0d\x3b\xc2\x36\x8f\xe7\xba"
-DATA ·d+28944(SB)/8,$"\xdc\x34\x25\x82\xf9\xeb\x11\x54"
-DATA ·d+28952(SB)/8,$"\x19\xe9\xdf\x27\xf2\xac\x23\xa7"
-DATA ·d+28960(SB)/8,$"\x78\x53\x3f\xeb\xe3\x1e\xc7\xcc"
-DATA ·d+28968(SB)/8,$"\xff\x00\xe2\x6a\xe5\x1a\x94\x64"
-DATA ·d+28976(SB)/8,$"\xe9\xba\xf1\xd3\xcf\xfc\xcd\x95"
-DATA ·d+28984(SB)/8,$"\x7c\x3d\x54\xaa\x7b\x29\x2b\xf9"
-DATA ·d+28992(SB)/8,$"\x1f\xff\xd1\xfc\x55\xd5\x7e\x0d"
-DATA ·d+29000(SB)/8,$"\x69\x10\x4c\x6d\x97\x50\x90\xb8"
-DATA ·d+29008(SB)/8,$"\x1e\x63\x00\x22\x27\x69\xf5\xf9"
-DATA ·d+29016(SB)/8,$"\xc1\xc8\xfa\x66\xb1\xad\x7c\x21"
-DATA ·d+29024(SB)/8,$"\x1e\x83\x6c\x26\xb3\xbb\xf3\x02"
-DATA ·d+29032(SB)/8,$"\x38\x55\x73\xb2\x29\x0e\x79\xe0"
-DATA ·d+29040(SB)/8,$"\x31\x27\xf1\xe9\xef\x5e\x8f\x7d"
-DATA ·d+29048(SB)/8,$"\x7d\xa2\xa6\x9e\xd1\x41\xa4\x89"
-DATA ·d+29056(SB)/8,$"\x65\x78\x44\x77\x0c\xd1\x24\x21"
-DATA ·d+29064(SB)/8,$"\x1b\x3c\xed\x76\xc0\xc9\xe9\x90"
-DATA ·d+29072(SB)/8,$"\x33\x8a\xf3\x2b\xdf\x86\xc6\xfa"
-DATA ·d+29080(SB)/8,$"\xc5\xee\xec\xae\x64\x87\xcd\x39"
-DATA ·d+29088(SB)/8,$"\x48\xa2\x06\x7d\xc0\x9f\x98\x33"
-DATA ·d+29096(SB)/8,$"\x82\xa5\x42\xe3\x9e\x39\xe9\xef"
-DATA ·d+29104(SB)/8,$"\x5f\xd7\xf8\xba\xb4\x94\xb9\xe9"
-DATA ·d+29112(SB)/8,$"\x52\x4b\xef\x3f\xc7\x3c\xbb\x32"
-DATA ·d+29120(SB)/8,$"\xad\x52\x1c\x98\xba\xed\x2f\x34"
-DATA ·d+29128(SB)/8,$"\x9d\xfe\x4b\x6f\xb8\xea\xc7\x88"
-DATA ·d+29136(SB)/8,$"\x63\x55\x83\x4d\xb2\xb9\x62\x04"
-DATA ·d+29144(SB)/8,$"\xca\xd3\x49\x73\x22\xed\x52\x3b"
-DATA ·d+29152(SB)/8,$"\xef\x4e\x08\xec\x6b\x69\xe6\xbb"
-DATA ·d+29160(SB)/8,$"\xbd\x98\x0b\x5d\x4a\xc4\xbe\xec"
-DATA ·d+29168(SB)/8,$"\x87\x8d\x72\xf9\x3e\x84\xe7\x9a"
-DATA ·d+29176(SB)/8,$"\xf2\x51\xe1\x79\x74\xdb\x93\x69"
-DATA ·d+29184(SB)/8,$"\x65\x6d\x79\x34\x71\x36\xf6\x64"
-DATA ·d+29192(SB)/8,$"\x57\x95\x01\x03\x1c\xe3\x2a\x45"
-DATA ·d+29200(SB)/8,$"\x5e\x6d\x12\xdf\x53\xbb\x86\x5b"
-DATA ·d+29208(SB)/8,$"\x79\x16\x29\xc8\x27\x26\x27\x80"
-DATA ·d+29216(SB)/8,$"\xab\x64\x92\xed\x8c\x86\x3d\xb3"
-DATA ·d+29224(SB)/8,$"\xd8\x60\x73\x5d\x18\x4c\x66\x2d"
-DATA ·d+29232(SB)/8,$"\xdd\x4a\x9d\xfe\x76\x36\xaf\x95"
-DATA ·d+29240(SB)/8,$"\xe1\xbe\x28\x4f\x4d\xef\x64\xff"
-DATA ·d+29248(SB)/8,$"\x00\xe1\x8e\xee\xf2\xf7\x59\xb4"
-DATA ·d+29256(SB)/8,$"\x01\x5f\x52\x95\xbc\xb6\xe4\x8e"
-DATA ·d+29264(SB)/8,$"\x07\x3d\x80\xed\x50\xbe\xad\xa8"
-DATA ·d+29272(SB)/8,$"\x33\x47\x2c\xd7\x22\x75\x03\x98"
-DATA ·d+29280(SB)/8,$"\x64\x8c\x32\x93\xeb\x92\x47\xe5"
-DATA ·d+29288(SB)/8,$"\x5c\xc4\xda\x71\xb0\xb8\x5b\x1b"
-DATA ·d+29296(SB)/8,$"\xdb\xfd\xf1\xb1\x05\x98\xc4\xca"
-DATA ·d+29304(SB)/8,$"\x4f\xa9\xcb\x63\x24\x7b\x9a\xa1"
-DATA ·d+29312(SB)/8,$"\x7d\xe2\x9f\x0f\x69\x90\xbe\x9f"
-DATA ·d+29320(SB)/8,$"\x13\x6e\x9c\xa9\x3b\xdc\x12\x38"
-DATA ·d+29328(SB)/8,$"\x3d\x70\x0f\x6a\xed\x9e\x22\x54"
-DATA ·d+29336(SB)/8,$"\xe2\xe5\x56\x5c\xab\xb5\xee\x72"
-DATA ·d+29344(SB)/8,$"\x52\xcb\x3d\xa3\x4a\x94\x79\x9f"
-DATA ·d+29352(SB)/8,$"\x94\x6d\xfa\x1d\x2e\xa7\xaa\x5f"
-DATA ·d+29360(SB)/8,$"\x5d\x4a\xb7\x4b\x64\x84\x0e\x77"
-DATA ·d+29368(SB)/8,$"\x24\x31\xa0\xe7\x8e\x46\x48\xc7"
-DATA ·d+29376(SB)/8,$"\xd6\xb0\x6f\xb5\x5d\x66\xff\x00"
-DATA ·d+29384(SB)/8,$"\x10\xcc\x96\xea\x11\xb8\xf2\x94"
-DATA ·d+29392(SB)/8,$"\x46\x57\xf2\x3c\xfe\x75\xce\xa7"
-DATA ·d+29400(SB)/8,$"\x88\xee\x9e\x42\xc5\xe5\xdf\xb3"
-DATA ·d+29408(SB)/8,$"\x0e\x40\x43\x90\x7b\xf3\xd3\x8e"
-DATA ·d+29416(SB)/8,$"\x2a\xb9\xf1\x55\xd4\x45\x66\x68"
-DATA ·d+29424(SB)/8,$"\xee\x1c\x06\x2a\xac\x24\x03\xf0"
-DATA ·d+29432(SB)/8,$"\x20\x03\x9f\xd2\xbc\xf9\xe7\xb4"
-DATA ·d+29440(SB)/8,$"\xff\x00\x99\xea\x7b\x94\x32\x59"
-DATA ·d+29448(SB)/8,$"\xc5\x2b\x41\x68\x74\x8b\xe0\xfb"
-DATA ·d+29456(SB)/8,$"\xdb\xa8\x85\xd3\xce\xf0\xb3\x10"
-DATA ·d+29464(SB)/8,$"\x42\x31\x2a\x5f\x8c\xf3\x93\xf9"
-DATA ·d+29472(SB)/8,$"\x55\x7b\xff\x00\x09\xb5\x9c\x40"
-DATA ·d+29480(SB)/8,$"\xcd\x33\x21\x24\x37\x0d\xbf\x91"
-DATA ·d+29488(SB)/8,$"\xce\x79\xc0\xfe\x75\x40\xf8\xf2"
-DATA ·d+29496(SB)/8,$"\xe2\x42\x12\x58\xbc\xc0\xb8\x2b"
-DATA ·d+29504(SB)/8,$"\xf6\xc3\xe6\xec\x20\x63\x8e\x3a"
-DATA ·d+29512(SB)/8,$"\x7f\x2a\x9a\xe6\xe4\xeb\xb6\x91"
-DATA ·d+29520(SB)/8,$"\x32\x5d\xa9\x93\x2d\xe7\x46\x40"
-DATA ·d+29528(SB)/8,$"\x11\xe0\x91\xb4\x26\x06\xef\x63"
-DATA ·d+29536(SB)/8,$"\xfa\x57\x3c\xf1\x78\x69\x2b\x51"
-DATA ·d+29544(SB)/8,$"\x57\x91\xac\x21\x8c\x84\x93\xad"
-DATA ·d+29552(SB)/8,$"\x25\x14\x56\xbe\x7f\x05\x43\x6f"
-DATA ·d+29560(SB)/8,$"\x1b\xdd\x5c\x03\x3a\x1c\xb9\x88"
-DATA ·d+29568(SB)/8,$"\x61\x8f\x1d\xc0\xa9\x63\xf1\xa6"
-DATA ·d+29576(SB)/8,$"\x80\x96\xc6\x3d\x3a\x25\x9a\x50"
-DATA ·d+29584(SB)/8,$"\xb8\x46\xba\x8f\x78\x04\x70\x39"
-DATA ·d+29592(SB)/8,$"\x3d\x7f\x2a\xb1\x6f\xe0\x28\xe7"
-DATA ·d+29600(SB)/8,$"\x8c\x3d\xe4\x90\x85\x51\x91\xb8"
-DATA ·d+29608(SB)/8,$"\x1c\x80\x7a\xfd\xec\x0f\xc2\xb4"
-DATA ·d+29616(SB)/8,$"\x47\x81\xf4\x58\xa4\xc6\x97\x78"
-DATA ·d+29624(SB)/8,$"\x51\xc8\x2c\xcb\x71\x10\x54\x3f"
-DATA ·d+29632(SB)/8,$"\xee\x95\x24\x0c\xfa\x13\x4a\x9b"
-DATA ·d+29640(SB)/8,$"\xc5\xa7\xee\x45\x47\xf3\xfc\xca"
-DATA ·d+29648(SB)/8,$"\xa9\x88\xc0\xb5\x69\xd4\x94\x9a"
-DATA ·d+29656(SB)/8,$"\xfb\xbf\x23\x84\x8f\xe2\x1f\x8b"
-DATA ·d+29664(SB)/8,$"\x45\xcb\x1b\x18\xed\x21\x7f\xef"
-DATA ·d+29672(SB)/8,$"\x41\x0a\xab\x01\xeb\x9e\x71\x4e"
-DATA ·d+29680(SB)/8,$"\x9f\x51\xf1\x96\xb7\x27\xfa\x55"
-DATA ·d+29688(SB)/8,$"\xe3\x3b\x92\x06\xc7\x6e\xfe\x84"
-DATA ·d+29696(SB)/8,$"\x80\x3f\x9d\x77\x57\x1e\x11\xb9"
-DATA ·d+29704(SB)/8,$"\xb7\x88\x5f\x58\xcd\x0c\x8e\x72"
-DATA ·d+29712(SB)/8,$"\x24\x28\xbc\xa1\x1f\x7b\x72\x9e"
-DATA ·d+29720(SB)/8,$"\x76\x9f\x71\x8f\xa5\x67\x2f\x86"
-DATA ·d+29728(SB)/8,$"\x63\x6f\x33\xcb\x12\x70\x32\x16"
-DATA ·d+29736(SB)/8,$"\x36\x3b\x54\x9e\xe0\xf3\x58\xd4"
-DATA ·d+29744(SB)/8,$"\xa7\x8a\x4f\x96\x4d\xbf\x46\x75"
-DATA ·d+29752(SB)/8,$"\x43\x32\xc1\x7c\x74\xe0\x97\x9d"
-DATA ·d+29760(SB)/8,$"\xb5\xfc\x4e\x62\x3d\x1f\xc5\x76"
-DATA ·d+29768(SB)/8,$"\xf1\x87\xbb\x86\x4c\x64\x8c\x2b"
-DATA ·d+29776(SB)/8,$"\x82\x0f\xe0\x49\xa8\x1a\xdf\x4d"
-DATA ·d+29784(SB)/8,$"\xba\x5f\xb3\x5e\x03\x69\x70\xa4"
-DATA ·d+29792(SB)/8,$"\x95\x59\xd0\x84\x7c\x74\xc3\x76"
-DATA ·d+29800(SB)/8,$"\x3f\x5a\xeb\x5f\x4a\xd5\xed\x1b"
-DATA ·d+29808(SB)/8,$"\x6a\xdc\xce\xc8\x17\x71\x59\x9c"
-DATA ·d+29816(SB)/8,$"\x1f\xe5\xcf\xe9\x4d\xb9\x7d\x56"
-DATA ·d+29824(SB)/8,$"\xd5\x7c\xdb\x94\x69\x57\x70\xc3"
-DATA ·d+29832(SB)/8,$"\xf0\xe3\x23\x9f\x43\x5b\x3a\x16"
-DATA ·d+29840(SB)/8,$"\xd2\x57\x57\xef\xa8\xe3\x98\x39"
-DATA ·d+29848(SB)/8,$"\x3d\x1a\xf2\xb6\x87\xbd\xfe\xc8"
-DATA ·d+29856(SB)/8,$"\xdf\x12\xbe\x1a\xfc\x35\xf8\xe7"
-DATA ·d+29864(SB)/8,$"\xe1\xed\x6f\xe3\xcf\x82\x74\x1f"
-DATA ·d+29872(SB)/8,$"\x16\xf8\x41\x6f\xe1\x87\x5d\x87"
-DATA ·d+29880(SB)/8,$"\x52\x8d\xae\x12\x0b\x39\x1c\x2b"
-DATA ·d+29888(SB)/8,$"\xdd\xa4\x68\xc3\x7b\x5b\x7f\xad"
-DATA ·d+29896(SB)/8,$"\x68\xdb\x70\x92\x30\xe9\x8c\x95"
-DATA ·d+29904(SB)/8,$"\xaf\xf4\x88\xf8\x4f\xa8\xf8\x3e"
-DATA ·d+29912(SB)/8,$"\xd3\xc3\xb6\x9e\x1f\xf0\xdd\x86"
-DATA ·d+29920(SB)/8,$"\x9d\xa6\xe9\x6b\x14\x67\x4e\x5d"
-DATA ·d+29928(SB)/8,$"\x12\x38\xd7\x4c\x92\x27\x50\xd1"
-DATA ·d+29936(SB)/8,$"\x98\x55\x15\x42\x6f\x42\xad\xb1"
-DATA ·d+29944(SB)/8,$"\xd4\x31\x07\x82\xc3\x9a\xff\x00"
-DATA ·d+29952(SB)/8,$"\x2f\x8b\x08\x6e\xef\x27\x0f\x6d"
-DATA ·d+29960(SB)/8,$"\x11\x8b\x03\x70\x61\xf2\x83\xf8"
-DATA ·d+29968(SB)/8,$"\x57\xf5\x7f\xff\x00\x04\x99\xfd"
-DATA ·d+29976(SB)/8,$"\xa8\xbe\x27\xea\x7f\xb3\x4a\xdb"
-DATA ·d+29984(SB)/8,$"\xf8\x7a\xee\x5b\xaf\x12\xfc\x32"
-DATA ·d+29992(SB)/8,$"\xd4\x20\xd2\x75\x5d\x2b\x52\x9c"
-DATA ·d+30000(SB)/8,$"\xbf\xf6\xaf\x86\xaf\xd8\xbe\x99"
-DATA ·d+30008(SB)/8,$"\x32\x97\xe1\x1a\xd9\xc4\x96\x63"
-DATA ·d+30016(SB)/8,$"\x27\x69\x08\xbf\x77\x23\x1f\x33"
-DATA ·d+30024(SB)/8,$"\xc4\xd9\x1c\xf1\x11\x8b\x52\xd7"
-DATA ·d+30032(SB)/8,$"\x65\x7d\xbc\xbf\xcb\xa9\xfb\xb7"
-DATA ·d+30040(SB)/8,$"\x82\x7c\x63\x47\x0b\x89\xa9\x83"
-DATA ·d+30048(SB)/8,$"\xa9\x05\x79\x7b\xca\xdb\xf9\xfe"
-DATA ·d+30056(SB)/8,$"\x1a\xd9\x5b\xaf\x53\xfa\xb9\x36"
-DATA ·d+30064(SB)/8,$"\x4f\x10\x52\x83\x6a\x6d\xc6\xc1"
-DATA ·d+30072(SB)/8,$"\xf3\x42\xe0\xf5\xc6\x79\x53\xea"
-DATA ·d+30080(SB)/8,$"\x0e\x47\xa5\x72\xba\x97\x87\xd6"
-DATA ·d+30088(SB)/8,$"\x6b\x76\xb7\x8d\x70\xa4\x61\x54"
-DATA ·d+30096(SB)/8,$"\x0e\x06\x3a\x7e\x95\x99\xf0\x2f"
-DATA ·d+30104(SB)/8,$"\xf6\x80\xf8\x7f\xf1\xcf\x47\x4f"
-DATA ·d+30112(SB)/8,$"\xec\xc2\xda\x7e\xad\x1a\x0f\xb6"
-DATA ·d+30120(SB)/8,$"\xe8\xb7\xa3\xcb\xb8\x85\x80\xe7"
-DATA ·d+30128(SB)/8,$"\x0a\xdf\x78\x7d\x33\xf9\x57\xbd"
-DATA ·d+30136(SB)/8,$"\xcd\xa3\x40\x63\xc2\xa8\x27\xda"
-DATA ·d+30144(SB)/8,$"\xbf\x17\x9e\x26\xa6\x16\xa3\xa5"
-DATA ·d+30152(SB)/8,$"\x5a\x2d\x49\x1f\xd9\x54\xa8\x46"
-DATA ·d+30160(SB)/8,$"\xbd\x35\x3a\x52\xba\x67\xc2\x27"
-DATA ·d+30168(SB)/8,$"\x49\x5f\x87\x1e\x3f\xfb\x74\xdb"
-DATA ·d+30176(SB)/8,$"\xc5\x9e\xaa\xc3\xcb\x6c\x7c\xb1"
-DATA ·d+30184(SB)/8,$"\x5c\xa8\xf9\xa3\xcf\xa4\x83\xe6"
-DATA ·d+30192(SB)/8,$"\x5f\x7c\x8f\x4a\xfa\xf7\xc3\x5a"
-DATA ·d+30200(SB)/8,$"\xe5\xbc\xf0\xc7\x22\x30\x20\xe0"
-DATA ·d+30208(SB)/8,$"\x66\xb8\xaf\x88\xde\x11\xb4\xd6"
-DATA ·d+30216(SB)/8,$"\x34\x89\xf4\xed\x40\x32\xa3\x8d"
-DATA ·d+30224(SB)/8,$"\xcb\x24\x7c\x3c\x6e\x87\x29\x22"
-DATA ·d+30232(SB)/8,$"\x1e\xcc\xa7\x04\x57\xc8\x7e\x03"
-DATA ·d+30240(SB)/8,$"\xf8\x9b\xe3\x2d\x02\xf2\x5f\x0d"
-DATA ·d+30248(SB)/8,$"\x78\xc6\xde\x3b\x5b\xeb\x77\x7c"
-DATA ·d+30256(SB)/8,$"\x88\xa4\xf3\x52\x68\xd5\x8e\xc9"
-DATA ·d+30264(SB)/8,$"\xa3\x6c\x2e\x77\xa6\x1c\xae\x32"
-DATA ·d+30272(SB)/8,$"\xa4\x91\xdb\x35\xed\xca\x87\xd7"
-DATA ·d+30280(SB)/8,$"\x69\xa9\x2d\xd1\xe5\x2c\x43\xc2"
-DATA ·d+30288(SB)/8,$"\x54\xb4\xb6\x3f\x50\x11\x95\xd5"
-DATA ·d+30296(SB)/8,$"\x4a\xe3\x1d\x6b\xf0\xdb\xfe\x0a"
-DATA ·d+30304(SB)/8,$"\x13\xac\xdb\xe9\x7f\xf0\x4f\x89"
-DATA ·d+30312(SB)/8,$"\x3c\x2f\x21\xc4\x9e\x23\xf1\xed"
-DATA ·d+30320(SB)/8,$"\xc4\x4a\xbd\x4b\x22\xea\xb7\x17"
-DATA ·d+30328(SB)/8,$"\x4f\x81\xdf\xe5\x4a\xfd\x71\xf0"
-DATA ·d+30336(SB)/8,$"\x37\xc4\x1b\x1d\x6f\x4d\x57\x77"
-DATA ·d+30344(SB)/8,$"\x5d\xe0\x72\xb9\xc1\xce\x33\xde"
-DATA ·d+30352(SB)/8,$"\xbf\x0e\x3f\x6c\xdb\xf9\xfe\x21"
-DATA ·d+30360(SB)/8,$"\xfc\x38\xf8\x6f\xe0\xf9\x23\x92"
-DATA ·d+30368(SB)/8,$"\xe6\x39\x8d\xff\x00\x8a\x62\xb6"
-DATA ·d+30376(SB)/8,$"\xb4\x89\xe4\x31\xb3\xcd\x24\x5b"
-DATA ·d+30384(SB)/8,$"\x89\x50\x73\xb8\xca\xdd\x78\x00"
-DATA ·d+30392(SB)/8,$"\x0c\x77\xa8\xe0\xbc\xae\x50\xcc"
-DATA ·d+30400(SB)/8,$"\xe9\xaa\xbb\x46\x49\xfd\xc9\xb2"
-DATA ·d+30408(SB)/8,$"\x38\xc3\x31\x8c\xf2\xea\x9e\xcd"
-DATA ·d+30416(SB)/8,$"\xeb\x28\xb5\xf7\xd9\x1f\x89\x7e"
-DATA ·d+30424(SB)/8,$"\x17\xf8\x2c\xd2\xa8\x96\xdd\x4e"
-DATA ·d+30432(SB)/8,$"\x5b\xe7\x21\x87\x40\x6b\xd2\x93"
-DATA ·d+30440(SB)/8,$"\xe1\x2e\xad\x11\x42\xb1\xb1\x0a"
-DATA ·d+30448(SB)/8,$"\x31\xd2\xbf\x4e\x7e\x16\x7e\xce"
-DATA ·d+30456(SB)/8,$"\xda\xbd\xe6\x97\xfd\xa1\x2d\x9c"
-DATA ·d+30464(SB)/8,$"\xa8\xb8\x0b\xfb\xe8\xca\x90\x3e"
-DATA ·d+30472(SB)/8,$"\x8c\x07\x5e\xd5\xea\x3e\x2a\xf8"
-DATA ·d+30480(SB)/8,$"\x5b\xe1\x3f\x87\xba\x05\xd7\x8a"
-DATA ·d+30488(SB)/8,$"\xbc\x73\x3d\xa6\x95\x61\x65\x6e"
-DATA ·d+30496(SB)/8,$"\xd7\x77\x52\xdc\x48\x81\xc4\x4a"
-DATA ·d+30504(SB)/8,$"\x3f\x86\x20\x77\xb9\x24\x80\x02"
-DATA ·d+30512(SB)/8,$"\xa9\x24\x90\x2b\xfa\x32\xaf\x17"
-DATA ·d+30520(SB)/8,$"\x47\x9f\x91\x3b\xb3\xf0\xaa\x5c"
-DATA ·d+30528(SB)/8,$"\x2a\xf9\x39\xe5\xa2\x3f\x34\xbc"
-DATA ·d+30536(SB)/8,$"\x19\xf0\xbe\x3b\x68\x64\xd6\xbc"
-DATA ·d+30544(SB)/8,$"\x59\x24\x56\x5a\x6d\x8c\x2d\x77"
-DATA ·d+30552(SB)/8,$"\xa8\x5e\x5d\x1d\x91\x41\x02\x0c"
-DATA ·d+30560(SB)/8,$"\xb3\xbb\x1e\x00\x1f\xcf\xd7\xa5"
-DATA ·d+30568(SB)/8,$"\x7e\x5c\x7e\xd6\x5f\xb5\x3c\x9f"
-DATA ·d+30576(SB)/8,$"\x18\x7c\x40\x9e\x16\xf0\x37\x9b"
-DATA ·d+30584(SB)/8,$"\x69\xe1\x4d\x22\x42\x96\x10\x60"
-DATA ·d+30592(SB)/8,$"\xa1\xba\x75\xe3\xed\x52\xaf\x5d"
-DATA ·d+30600(SB)/8,$"\xcd\xfc\x00\xfd\xc5\xff\x00\x68"
-DATA ·d+30608(SB)/8,$"\x9c\x7b\xaf\xed\xb5\xfb\x4f\x6b"
-DATA ·d+30616(SB)/8,$"\xbf\x15\xe5\x6f\x04\xf8\x36\xde"
-DATA ·d+30624(SB)/8,$"\x7d\x23\xc2\x10\xb8\x92\x2b\x73"
-DATA ·d+30632(SB)/8,$"\xf2\xdc\xea\x32\x47\xf7\x67\xbb"
-DATA ·d+30640(SB)/8,$"\xc7\x40\x0f\xfa\xb8\x79\x09\xd4"
-DATA ·d+30648(SB)/8,$"\xe5\xfa\x7e\x60\x49\x68\xe4\xe3"
-DATA ·d+30656(SB)/8,$"\x38\x04\x7c\xd9\x3c\x9f\x5a\xfd"
-DATA ·d+30664(SB)/8,$"\x47\x85\x32\x89\x54\x9c\x71\x38"
-DATA ·d+30672(SB)/8,$"\x9d\xfa\x2e\xdf\xf0\x4f\xc4\xbc"
-DATA ·d+30680(SB)/8,$"\x40\xe2\x3f\x67\x4e\x58\x4c\x26"
-DATA ·d+30688(SB)/8,$"\xdd\x5f\x7f\x25\xe5\xf9\x9f\x63"
-DATA ·d+30696(SB)/8,$"\xfe\xcc\x9e\x2f\xf0\x2f\xc4\xff"
-DATA ·d+30704(SB)/8,$"\x00\x05\x6b\xff\x00\xb1\xdf\xc6"
-DATA ·d+30712(SB)/8,$"\x4b\x88\xac\x74\x3f\x18\x3c\x77"
-DATA ·d+30720(SB)/8,$"\xde\x16\xd7\xee\xb9\x8f\xc3\xde"
-DATA ·d+30728(SB)/8,$"\x2e\x87\xe4\xb3\xbb\xc9\xfb\xb0"
-DATA ·d+30736(SB)/8,$"\xdc\xe4\x41\x70\x37\x00\x41\xe7"
-DATA ·d+30744(SB)/8,$"\x3b\xc9\xaf\xc6\x9f\x8e\x5a\x4f"
-DATA ·d+30752(SB)/8,$"\xc4\x7f\x87\x3f\x13\x35\x6f\x85"
-DATA ·d+30760(SB)/8,$"\xbe\x36\xd2\xe5\xd1\x75\x8d\x02"
-DATA ·d+30768(SB)/8,$"\xfa\x4d\x33\x51\xd2\xdd\x9f\x11"
-DATA ·d+30776(SB)/8,$"\x5c\x45\xd5\x97\xee\xf9\x91\xc8"
-DATA ·d+30784(SB)/8,$"\xb8\x92\x29\x3a\x49\x1b\x2b\x0e"
-DATA ·d+30792(SB)/8,$"\x0d\x7d\xa9\x6b\xa5\xcd\x70\xeb"
-DATA ·d+30800(SB)/8,$"\x65\x67\x13\x4b\x2c\xf2\x2c\x10"
-DATA ·d+30808(SB)/8,$"\xc0\x81\x9d\xe6\x79\x0e\xd5\x44"
-DATA ·d+30816(SB)/8,$"\x45\x04\xbb\x31\x21\x42\x80\x4b"
-DATA ·d+30824(SB)/8,$"\x13\x80\x09\xaf\x4b\xfd\xaa\x3c"
-DATA ·d+30832(SB)/8,$"\x79\xe1\xfd\x67\xc2\xba\x5f\x80"
-DATA ·d+30840(SB)/8,$"\x7f\x69\x88\x6c\xb5\x4f\x88\x1a"
-DATA ·d+30848(SB)/8,$"\x05\xb0\xb0\xb0\xd5\xac\x6e\x12"
-DATA ·d+30856(SB)/8,$"\x3d\x67\x4b\xd3\x8c\x24\xc1\x67"
-DATA ·d+30864(SB)/8,$"\xaf\x5c\x82\xe9\x77\x24\x4c\x43"
-DATA ·d+30872(SB)/8,$"\x45\x01\xcc\xf1\x27\xfa\xc9\x06"
-DATA ·d+30880(SB)/8,$"\xf2\x0f\x2f\x88\x39\x0d\x2e\x5f"
-DATA ·d+30888(SB)/8,$"\x6b\x0a\xea\x3c\xdb\xc5\xf5\x7d"
-DATA ·d+30896(SB)/8,$"\xd7\xea\x7c\x8f\x0b\x66\xf1\x95"
-DATA ·d+30904(SB)/8,$"\x2e\x5c\x45\x1e\x69\x53\xf8\x65"
-DATA ·d+30912(SB)/8,$"\xe4\xfa\x3f\xd3\xee\x47\xe3\xba"
-DATA ·d+30920(SB)/8,$"\xbf\x88\xcc\xbe\x6b\x6e\x3c\xe7"
-DATA ·d+30928(SB)/8,$"\x1b\x4e\x2b\x44\x0f\x10\xc7\x1b"
-DATA ·d+30936(SB)/8,$"\x48\x12\x45\x0f\x92\xcc\xa8\xbc"
-DATA ·d+30944(SB)/8,$"\xe7\xae\x78\x35\xee\xb1\x43\x69"
-DATA ·d+30952(SB)/8,$"\x02\x63\xc3\xfa\xc5\x95\xc1\x6c"
-DATA ·d+30960(SB)/8,$"\xe6\xde\x50\xa5\x97\xf3\x06\xb9"
-DATA ·d+30968(SB)/8,$"\x0d\x4e\x2d\x49\x66\xd9\xab\xc7"
-DATA ·d+30976(SB)/8,$"\x24\x4a\x4f\xfa\xeb\x4e\x06\x3e"
-DATA ·d+30984(SB)/8,$"\x9d\x0f\xe7\x5f\x92\xff\x00\x63"
-DATA ·d+30992(SB)/8,$"\xc6\x0a\xfc\xcf\xf3\xfc\x4f\xa5"
-DATA ·d+31000(SB)/8,$"\xa1\xc4\x5e\xd2\x76\xf6\x69\x7d"
-DATA ·d+31008(SB)/8,$"\xe9\xfd\xcd\x23\xca\xad\xee\x2e"
-DATA ·d+31016(SB)/8,$"\xe2\x98\x35\xcc\x70\x4c\x40\xc6"
-DATA ·d+31024(SB)/8,$"\xe9\x21\x1b\x87\xe2\x31\x5d\x9c"
-DATA ·d+31032(SB)/8,$"\x7e\x20\xd6\x2f\x2c\xc5\x9c\x21"
-DATA ·d+31040(SB)/8,$"\x63\x55\xe8\x02\xf4\xfc\xeb\x50"
-DATA ·d+31048(SB)/8,$"\xf8\x4b\x47\xd5\x10\xbd\x96\xaa"
-DATA ·d+31056(SB)/8,$"\x5e\x42\x32\x23\x95\x42\x38\xf6"
-DATA ·d+31064(SB)/8,$"\xc1\xeb\xf8\x1a\xa4\xde\x18\xf1"
-DATA ·d+31072(SB)/8,$"\x1d\xab\x6c\x48\x64\x75\x3f\xc5"
-DATA ·d+31080(SB)/8,$"\xb3\x6e\x7f\x1a\xbc\x3e\x1e\xad"
-DATA ·d+31088(SB)/8,$"\x2b\xd9\x68\xfb\x5b\xf4\x3a\x6b"
-DATA ·d+31096(SB)/8,$"\xe6\x18\x7a\xcd\x6a\x94\x97\x7b"
-DATA ·d+31104(SB)/8,$"\xaf\xcc\xaf\x05\xbf\x89\xae\x94"
-DATA ·d+31112(SB)/8,$"\xda\xc5\x89\x94\xf5\x08\x8a\xcc"
-DATA ·d+31120(SB)/8,$"\x4e\x3b\x64\x56\x05\xf7\x87\x2e"
-DATA ·d+31128(SB)/8,$"\xe1\x7d\x97\x52\xfd\x9c\xe7\x0c"
-DATA ·d+31136(SB)/8,$"\x92\x80\xa5\x7d\xb8\x39\xfd\x2b"
-DATA ·d+31144(SB)/8,$"\x6e\xeb\x4a\xf1\x4c\x6b\xfb\xb0"
-DATA ·d+31152(SB)/8,$"\xf1\xb0\xfb\xbb\x1d\x41\xe7\xe8"
-DATA ·d+31160(SB)/8,$"\x41\xae\x62\x7d\x1b\xc6\x57\x0f"
-DATA ·d+31168(SB)/8,$"\x89\x04\xee\x73\xd7\xcc\x1f\xa9"
-DATA ·d+31176(SB)/8,$"\xdd\x45\x6a\xf0\xe5\xb4\x62\xd9"
-DATA ·d+31184(SB)/8,$"\xdb\x83\x8c\xaf\x7f\x69\x14\xbf"
-DATA ·d+31192(SB)/8,$"\xae\xe6\xa5\xa7\x87\xef\x6d\x64"
-DATA ·d+31200(SB)/8,$"\x5b\x8b\x6b\xb7\x64\x63\xb4\x4a"
-DATA ·d+31208(SB)/8,$"\x92\x05\x41\x81\xc8\xcb\x30\x3f"
-DATA ·d+31216(SB)/8,$"\x5e\x2a\x7d\x46\xee\xe3\x4c\x75"
-DATA ·d+31224(SB)/8,$"\x8e\xf2\x49\xe4\xdd\xd1\x93\x64"
-DATA ·d+31232(SB)/8,$"\xab\xf8\x90\xd5\x9f\x6b\xe0\x2d"
-DATA ·d+31240(SB)/8,$"\x62\xec\x66\x79\xfc\x8f\x5f\x34"
-DATA ·d+31248(SB)/8,$"\x39\x1f\x98\xcd\x54\xd4\xbe\x18"
-DATA ·d+31256(SB)/8,$"\x6a\x76\xa0\x48\xd2\x23\x82\x38"
-DATA ·d+31264(SB)/8,$"\xc3\xec\x04\x7a\x8c\x91\x50\x96"
-DATA ·d+31272(SB)/8,$"\x2a\x34\xef\x46\x0d\x7c\xf4\xfb"
-DATA ·d+31280(SB)/8,$"\x8d\xe3\x53\x0b\x3a\x89\x55\xac"
-DATA ·d+31288(SB)/8,$"\x9f\xc8\xeb\x61\x7f\x0b\xcf\x6a"
-DATA ·d+31296(SB)/8,$"\x5f\x50\x8d\x89\xdb\xc3\x84\xe8"
-DATA ·d+31304(SB)/8,$"\x4f\xaa\xa9\x24\xfe\x15\x87\x3f"
-DATA ·d+31312(SB)/8,$"\x87\x2d\x4d\xa8\xd4\xad\xad\xed"
-DATA ·d+31320(SB)/8,$"\x24\xb7\x76\x22\x36\x26\x75\x27"
-DATA ·d+31328(SB)/8,$"\x1c\x13\xb4\xae\x45\x72\x96\xfe"
-DATA ·d+31336(SB)/8,$"\x1e\x5b\x49\x42\x4d\x2c\x71\xb0"
-DATA ·d+31344(SB)/8,$"\x39\xe6\x74\x07\x9e\x98\xe6\xbd"
-DATA ·d+31352(SB)/8,$"\x4f\x4d\xd3\xf5\xcb\x4b\x70\xe3"
-DATA ·d+31360(SB)/8,$"\xcf\x9a\x11\xd0\x48\xa5\xd0\x0f"
-DATA ·d+31368(SB)/8,$"\xaf\x35\xd3\x86\x53\xc5\x3e\x5a"
-DATA ·d+31376(SB)/8,$"\xb0\xd9\x74\xb5\xfe\x7e\x46\x18"
-DATA ·d+31384(SB)/8,$"\xb7\x1c\x2a\x72\xa5\x57\x77\xd5"
-DATA ·d+31392(SB)/8,$"\xd9\x7c\x8a\xbe\x1c\xb6\xf0\xac"
-DATA ·d+31400(SB)/8,$"\x08\xc3\x54\x92\x28\x18\x9c\x2a"
-DATA ·d+31408(SB)/8,$"\x44\xcd\x8c\x77\xce\xfe\x6a\x1f"
-DATA ·d+31416(SB)/8,$"\x12\x7f\x67\xdb\x87\x97\xc3\xb1"
-DATA ·d+31424(SB)/8,$"\xdb\xc8\x40\xcc\x6a\x53\x79\x72"
-DATA ·d+31432(SB)/8,$"\x7b\x17\x12\x0c\x7d\x76\xd6\xf3"
-DATA ·d+31440(SB)/8,$"\x69\xda\x4e\xa2\xa6\x4b\xbd\x28"
-DATA ·d+31448(SB)/8,$"\xb7\x1b\x4b\xc1\x91\x83\xeb\x93"
-DATA ·d+31456(SB)/8,$"\xc0\xae\x5e\xf3\xc2\x1a\x42\x33"
-DATA ·d+31464(SB)/8,$"\x4d\x04\x17\xf1\x83\xce\x01\x53"
-DATA ·d+31472(SB)/8,$"\xfa\xd7\x6e\x32\x8d\x48\xd0\x54"
-DATA ·d+31480(SB)/8,$"\x60\xd7\xf8\x93\x69\x9e\x6e\x1b"
-DATA ·d+31488(SB)/8,$"\x13\x4e\x58\x8f\x6d\x29\x4b\xd1"
-DATA ·d+31496(SB)/8,$"\xd9\xaf\xce\xe2\x03\xab\xea\x5a"
-DATA ·d+31504(SB)/8,$"\x01\x11\xe9\x16\xf1\xdd\xb0\x5f"
-DATA ·d+31512(SB)/8,$"\x99\x5e\x39\x48\x1d\xf6\x86\x20"
-DATA ·d+31520(SB)/8,$"\x64\xfb\x9c\x8a\xe1\xec\x85\xc8"
-DATA ·d+31528(SB)/8,$"\xd4\x05\x96\xa9\xa7\xcb\x0b\x13"
-DATA ·d+31536(SB)/8,$"\x83\x25\xc4\x68\x88\xbe\xf9\x4f"
-DATA ·d+31544(SB)/8,$"\xf1\x35\xbb\x17\x87\x20\xd4\x25"
-DATA ·d+31552(SB)/8,$"\x16\x7a\x60\xb9\x86\x56\x3c\x34"
-DATA ·d+31560(SB)/8,$"\xf2\x0f\xd0\x03\x58\xf3\x78\x4b"
-DATA ·d+31568(SB)/8,$"\x57\x37\xbf\x61\x9e\xe6\x42\xe0"
-DATA ·d+31576(SB)/8,$"\x95\x1e\x6c\x52\xed\xcf\xfb\xd8"
-DATA ·d+31584(SB)/8,$"\x22\xbe\x67\x19\x46\xac\x9c\x26"
-DATA ·d+31592(SB)/8,$"\xa6\xdd\xb4\xe9\x67\xf8\x2d\x4f"
-DATA ·d+31600(SB)/8,$"\xa8\xc1\xd4\xa5\x05\x52\x0d\xa5"
-DATA ·d+31608(SB)/8,$"\x7d\x7a\xdd\x7e\x2c\xe8\x6d\xbc"
-DATA ·d+31616(SB)/8,$"\x33\x35\xcb\x66\x08\xed\xa5\xe3"
-DATA ·d+31624(SB)/8,$"\x0c\x52\x66\xf9\x4f\xb8\x1d\x7f"
-DATA ·d+31632(SB)/8,$"\x4a\xab\x02\xff\x00\xc2\x39\x73"
-DATA ·d+31640(SB)/8,$"\x28\xd7\x6c\xe2\x46\x04\xf9\x5e"
-DATA ·d+31648(SB)/8,$"\x56\xd6\x04\x76\x21\x8b\x13\x9f"
-DATA ·d+31656(SB)/8,$"\x6c\x55\xcb\x0d\x27\x53\xf0\x95"
-DATA ·d+31664(SB)/8,$"\xcf\x9b\xa9\xb3\x95\x2a\x51\xca"
-DATA ·d+31672(SB)/8,$"\x11\xf7\x4f\x51\xcf\x6f\xc6\xb7"
-DATA ·d+31680(SB)/8,$"\x45\xc7\xc3\x9d\x56\x36\x8c\xb6"
-DATA ·d+31688(SB)/8,$"\xc9\xca\xe5\x44\x99\xe5\xbd\x3d"
-DATA ·d+31696(SB)/8,$"\x2b\xd8\xa3\x86\x76\x8c\xf9\xf9"
-DATA ·d+31704(SB)/8,$"\x26\xba\x36\xad\xf9\x7e\xa7\x9b"
-DATA ·d+31712(SB)/8,$"\x53\x1b\x25\x26\x9a\x73\x83\xea"
-DATA ·d+31720(SB)/8,$"\x93\xba\xfc\x7f\x43\xcf\xb5\xaf"
-DATA ·d+31728(SB)/8,$"\x17\xd8\xdf\x8f\x26\x0b\x97\x84"
-DATA ·d+31736(SB)/8,$"\x6e\x52\x58\xaa\x34\x9f\x2f\xa3"
-DATA ·d+31744(SB)/8,$"\xf3\xb4\x7d\x05\x50\x6d\x43\x4b"
-DATA ·d+31752(SB)/8,$"\x90\x99\x24\xbb\x05\x98\xee\x62"
-DATA ·d+31760(SB)/8,$"\x64\x3c\x93\xd7\xf8\x6b\x5b\x5d"
-DATA ·d+31768(SB)/8,$"\xf0\x14\x16\xe7\xce\x8a\x5f\x91"
-DATA ·d+31776(SB)/8,$"\xb9\xc1\x88\xb0\x03\xea\x80\x8a"
-DATA ·d+31784(SB)/8,$"\xe6\xbf\xe1\x0a\xb7\xff\x00\x9f"
-DATA ·d+31792(SB)/8,$"\xb8\x7f\xf1\xf1\xfd\x2b\xc3\xc6"
-DATA ·d+31800(SB)/8,$"\x7d\x61\xd4\x6e\x69\x37\xea\x7d"
-DATA ·d+31808(SB)/8,$"\x3e\x0e\x78\x49\x52\x5e\xcd\xb4"
-DATA ·d+31816(SB)/8,$"\xbd\x0f\xff\xd2\xfc\x55\xb7\xd6"
-DATA ·d+31824(SB)/8,$"\x20\xb2\xbc\xb7\xbb\xb4\x26\x18"
-DATA ·d+31832(SB)/8,$"\xe5\x7c\x34\x9b\x5a\xe2\x3e\x08"
-DATA ·d+31840(SB)/8,$"\xf9\x8b\xb6\x57\x85\xcf\xf0\xe4"
-DATA ·d+31848(SB)/8,$"\x7a\x56\xc7\x88\x7c\x7d\xe2\x4d"
-DATA ·d+31856(SB)/8,$"\x17\xc4\x7f\xd9\x7a\xfd\xba\x25"
-DATA ·d+31864(SB)/8,$"\xb2\x22\x95\x16\x2a\x23\x90\xdb"
-DATA ·d+31872(SB)/8,$"\xe4\xb2\x99\x32\x8a\x1c\xb0\x39"
-DATA ·d+31880(SB)/8,$"\x24\x0c\xd7\x9d\x41\xab\x6b\x76"
-DATA ·d+31888(SB)/8,$"\xba\x4c\xa9\x36\xb3\x67\x6b\x6e"
-DATA ·d+31896(SB)/8,$"\xa3\x74\x56\xce\xbf\xb9\x96\x57"
-DATA ·d+31904(SB)/8,$"\xfe\x16\x44\x40\x06\x07\xde\xdd"
-DATA ·d+31912(SB)/8,$"\xc6\x6b\xce\x35\xaf\x13\x6a\x17"
-DATA ·d+31920(SB)/8,$"\x13\xb6\xb5\xa8\xb1\xb8\x92\x19"
-DATA ·d+31928(SB)/8,$"\x11\x5e\xe2\x71\xbc\xe5\x87\x18"
-DATA ·d+31936(SB)/8,$"\x8b\x04\x6d\xe3\xae\x3d\x3e\x95"
-DATA ·d+31944(SB)/8,$"\xfd\x05\x1e\x22\xc6\x73\x5e\x53"
-DATA ·d+31952(SB)/8,$"\x68\xff\x00\x24\x70\x3c\x39\x1a"
-DATA ·d+31960(SB)/8,$"\xb5\x1b\x94\x14\x92\xba\xeb\x7b"
-DATA ·d+31968(SB)/8,$"\xf4\xb3\xb7\xf9\x9e\x99\xae\xfc"
-DATA ·d+31976(SB)/8,$"\x42\xfe\xd9\xbc\x68\xed\x8b\xc4"
-DATA ·d+31984(SB)/8,$"\x1d\x48\x56\xb6\x13\xe1\x71\xc6"
-DATA ·d+31992(SB)/8,$"\x0a\x29\x5e\xbc\x7a\xd7\x9a\xdf"
-DATA ·d+32000(SB)/8,$"\xf8\xbb\xc4\xba\x55\xd7\x91\x65"
-DATA ·d+32008(SB)/8,$"\x34\x0b\xcf\x94\xde\x74\x6d\x1c"
-DATA ·d+32016(SB)/8,$"\x8a\x47\x76\x2c\x5b\x39\x1d\xeb"
-DATA ·d+32024(SB)/8,$"\x66\x2f\x1a\xe8\xae\xcb\x15\xfd"
-DATA ·d+32032(SB)/8,$"\x94\xca\xd3\x80\x0b\xe9\xee\xab"
-DATA ·d+32040(SB)/8,$"\xbd\x09\xc7\x2a\x80\x1c\x9f\x7e"
-DATA ·d+32048(SB)/8,$"\x9e\x95\xd1\x42\xfe\x0a\x86\xed"
-DATA ·d+32056(SB)/8,$"\x97\x4c\xd1\x2e\xe1\xc8\x0a\x2e"
-DATA ·d+32064(SB)/8,$"\x26\x02\x59\x33\xdd\xb1\x9c\xfd"
-DATA ·d+32072(SB)/8,$"\x78\x38\xaf\x62\x18\xb9\x55\x6a"
-DATA ·d+32080(SB)/8,$"\x6f\x12\x9b\x3d\x9c\x3e\x1e\x18"
-DATA ·d+32088(SB)/8,$"\x45\xec\xa5\x85\x6e\x3e\x76\x6b"
-DATA ·d+32096(SB)/8,$"\xef\xbf\xe4\x73\xd3\x6b\xbe\x26"
-DATA ·d+32104(SB)/8,$"\xd6\xac\x86\x6d\x9e\xf6\x3e\x37"
-DATA ·d+32112(SB)/8,$"\x08\x8c\x8e\x73\xef\xc0\x3c\x76"
-DATA ·d+32120(SB)/8,$"\x35\x8b\x34\x3f\x61\xb8\x54\xd4"
-DATA ·d+32128(SB)/8,$"\xad\xda\x35\xdb\xb9\x4c\xd1\xb2"
-DATA ·d+32136(SB)/8,$"\xb1\xcf\xf0\x82\xeb\x9e\x2b\xd6"
-DATA ·d+32144(SB)/8,$"\xad\x6e\xb5\xdb\x57\x36\xd6\xcf"
-DATA ·d+32152(SB)/8,$"\x78\x91\x3f\x2a\x36\x46\x9d\xb8"
-DATA ·d+32160(SB)/8,$"\x27\x69\x0d\xc7\x5f\xe9\x5a\x9e"
-DATA ·d+32168(SB)/8,$"\x1a\xf8\x69\xe2\x4f\x8a\xf7\x72"
-DATA ·d+32176(SB)/8,$"\x78\x66\xd7\x57\x7b\x47\x82\xd2"
-DATA ·d+32184(SB)/8,$"\x7b\xd9\x9a\xed\xca\xf9\x91\xc3"
-DATA ·d+32192(SB)/8,$"\x82\xca\xa3\xca\x63\xbf\x07\x20"
-DATA ·d+32200(SB)/8,$"\x12\x01\x1d\x48\xae\xaa\xd3\x9b"
-DATA ·d+32208(SB)/8,$"\x57\x77\x97\xad\xac\x72\xd1\xcd"
-DATA ·d+32216(SB)/8,$"\x69\x52\x94\x94\xd4\x61\x1f\x26"
-DATA ·d+32224(SB)/8,$"\xff\x00\x1b\x23\xc3\x6e\x60\xbd"
-DATA ·d+32232(SB)/8,$"\x10\x41\x79\x2c\x77\x09\x6f\x74"
-DATA ·d+32240(SB)/8,$"\xee\x21\xba\x68\xd8\x43\x3f\x96"
-DATA ·d+32248(SB)/8,$"\x40\x60\x8c\x06\x0e\xdc\xe0\x81"
-DATA ·d+32256(SB)/8,$"\x9c\x71\xeb\x5b\x29\x71\x77\x69"
-DATA ·d+32264(SB)/8,$"\x19\x6b\x08\xa5\x80\x89\x02\x09"
-DATA ·d+32272(SB)/8,$"\x08\x2d\x1b\xff\x00\xdf\x5b\x78"
-DATA ·d+32280(SB)/8,$"\xcf\x6c\x57\x41\xa8\x7c\x3c\xb7"
-DATA ·d+32288(SB)/8,$"\xf0\xd6\x6d\xb5\x2d\x4a\x69\xe2"
-DATA ·d+32296(SB)/8,$"\x85\x98\x46\x16\x42\x5e\x32\x4f"
-DATA ·d+32304(SB)/8,$"\xce\xca\x88\x76\x82\xc4\x0c\xe3"
-DATA ·d+32312(SB)/8,$"\xa8\x1e\xd5\xcf\xe8\x7a\x17\x86"
-DATA ·d+32320(SB)/8,$"\x92\x59\x27\x94\xbe\xf1\x99\x22"
-DATA ·d+32328(SB)/8,$"\x92\xf2\x66\xd9\x8c\xe3\xe5\x45"
-DATA ·d+32336(SB)/8,$"\xe7\x77\xbe\xee\x2b\x8a\xaf\x35"
-DATA ·d+32344(SB)/8,$"\x3f\x7a\xd6\x3d\x3f\xaf\xe1\x6a"
-DATA ·d+32352(SB)/8,$"\xc1\xd4\x83\x72\x8f\xa3\xb3\xfc"
-DATA ·d+32360(SB)/8,$"\xbf\xae\x87\x45\x65\xe2\x1d\x4c"
-DATA ·d+32368(SB)/8,$"\xe6\xeb\xc4\x56\x91\x47\x1b\x86"
-DATA ·d+32376(SB)/8,$"\x5c\x98\x42\xb0\x60\x7a\x8e\x07"
-DATA ·d+32384(SB)/8,$"\xe5\xe9\x5d\x0c\xfa\xee\x9f\x05"
-DATA ·d+32392(SB)/8,$"\xb7\x97\x6f\x3d\xbd\xb1\x99\x42"
-DATA ·d+32400(SB)/8,$"\xc8\x04\x0c\x92\x30\x3d\x8f\x1d"
-DATA ·d+32408(SB)/8,$"\xfd\x45\x70\xda\xc4\x96\x3a\x6d"
-DATA ·d+32416(SB)/8,$"\xc2\xda\xc7\x73\x16\xa6\x7c\x91"
-DATA ·d+32424(SB)/8,$"\xb9\x6d\x7f\x7b\xb9\xf9\x0d\xdf"
-DATA ·d+32432(SB)/8,$"\x3c\x0c\x7c\xc7\x19\xf7\xeb\x5b"
-DATA ·d+32440(SB)/8,$"\x36\xf3\x47\x3a\xb5\xef\x88\x74"
-DATA ·d+32448(SB)/8,$"\xdb\xb4\x02\x12\x51\x93\xf7\x4e"
-DATA ·d+32456(SB)/8,$"\xd8\xe7\x70\x46\x6f\x98\x0f\x60"
-DATA ·d+32464(SB)/8,$"\x71\x57\x43\x1f\x88\x7e\xed\x38"
-DATA ·d+32472(SB)/8,$"\xf3\x7d\xe7\x93\x8a\xc0\x42\x56"
-DATA ·d+32480(SB)/8,$"\xa8\xa2\xd2\x7b\x25\xfe\x4c\xe9"
-DATA ·d+32488(SB)/8,$"\xe2\xd4\x92\xe6\x75\xb5\x7b\xf8"
-DATA ·d+32496(SB)/8,$"\xc0\x94\x33\x85\x91\x11\x51\x36"
-DATA ·d+32504(SB)/8,$"\x8c\xf5\xce\x47\x03\xa6\x39\x3e"
-DATA ·d+32512(SB)/8,$"\xf5\xad\x6f\xab\xc7\x26\x90\x0a"
-DATA ·d+32520(SB)/8,$"\xcc\x64\x4d\xe7\x32\xc7\x13\x29"
-DATA ·d+32528(SB)/8,$"\x23\xd8\xb0\x19\x03\xb8\x15\xc0"
-DATA ·d+32536(SB)/8,$"\x68\x77\xfe\x07\xd4\x35\x06\x69"
-DATA ·d+32544(SB)/8,$"\xa4\x48\xd4\x6d\x11\xc1\x1d\xa3"
-DATA ·d+32552(SB)/8,$"\x49\x89\x31\x80\xa4\x82\x0f\x3d"
-DATA ·d+32560(SB)/8,$"\xf9\xae\x93\xfb\x53\x40\xd2\x35"
-DATA ·d+32568(SB)/8,$"\x5f\x2a\xe2\x39\x16\x13\x91\x18"
-DATA ·d+32576(SB)/8,$"\xf3\x90\x04\x5c\xff\x00\xcf\x36"
-DATA ·d+32584(SB)/8,$"\xc8\x07\xfd\x93\xda\xbd\x28\xe2"
-DATA ·d+32592(SB)/8,$"\xea\x69\xcd\x35\xf7\xff\x00\xc1"
-DATA ·d+32600(SB)/8,$"\x3c\x6c\x6e\x5c\x93\xe4\x54\xdd"
-DATA ·d+32608(SB)/8,$"\xd5\x9e\xc9\x7f\xc3\x94\xa7\x83"
-DATA ·d+32616(SB)/8,$"\x5e\x05\xae\x2c\xa3\x67\xfd\xdf"
-DATA ·d+32624(SB)/8,$"\xee\xae\x21\x93\x04\x9c\xf3\xb8"
-DATA ·d+32632(SB)/8,$"\x37\x5c\xf7\x1d\xbd\x6b\x38\x7f"
-DATA ·d+32640(SB)/8,$"\xc2\x51\x6e\x9b\x5d\x26\x41\x81"
-DATA ·d+32648(SB)/8,$"\xbb\x38\x19\x07\xa7\xdd\xed\xf8"
-DATA ·d+32656(SB)/8,$"\x57\x69\x3e\xa7\xa5\xdf\x2c\x76"
-DATA ·d+32664(SB)/8,$"\xee\x6f\x22\x96\x52\x92\xc1\x03"
-DATA ·d+32672(SB)/8,$"\xc0\xea\x76\xb1\x20\x9d\xc9\xf7"
-DATA ·d+32680(SB)/8,$"\x97\x6f\x23\x03\x93\x59\xda\xd4"
-DATA ·d+32688(SB)/8,$"\x9f\x0e\xfe\xc7\x14\x70\x5e\x5c"
-DATA ·d+32696(SB)/8,$"\xdc\x4e\x00\x8f\x60\x25\x1c\xbb"
-DATA ·d+32704(SB)/8,$"\x1e\x42\x29\x21\xf6\x8e\xd9\xfa"
-DATA ·d+32712(SB)/8,$"\x53\x55\x13\x6e\xf5\x36\xf3\xff"
-DATA ·d+32720(SB)/8,$"\x00\x80\x55\x2c\x45\x4e\x68\xc2"
-DATA ·d+32728(SB)/8,$"\x74\x77\xed\x1b\xdb\xf4\x46\x76"
-DATA ·d+32736(SB)/8,$"\x96\x1e\x2b\x69\x64\xd4\x6e\x44"
-DATA ·d+32744(SB)/8,$"\x4d\x19\x56\x58\x0c\x6c\xd2\x49"
-DATA ·d+32752(SB)/8,$"\x93\x82\x43\x60\xa8\x20\x73\xcf"
-DATA ·d+32760(SB)/8,$"\x5e\xdc\xd7\x43\x0d\xe4\x71\x20"
-DATA ·d+32768(SB)/8,$"\x5b\x08\xae\x95\xc1\xc9\x94\x44"
-DATA ·d+32776(SB)/8,$"\xf8\x21\xbb\x0e\xc7\xf2\xaf\x3f"
-DATA ·d+32784(SB)/8,$"\xb0\xf1\x96\x8d\xa4\xdd\x62\xc2"
-DATA ·d+32792(SB)/8,$"\xd1\x58\xfd\xd8\xda\x42\x5d\xbf"
-DATA ·d+32800(SB)/8,$"\x1d\xc0\x81\xf9\x57\x5d\xa8\x7c"
-DATA ·d+32808(SB)/8,$"\x5b\x92\xe5\x54\x1b\x4b\x89\x24"
-DATA ·d+32816(SB)/8,$"\x42\xb8\x9e\x36\xe0\x22\xf0\x02"
-DATA ·d+32824(SB)/8,$"\x04\x40\x07\xe9\x4d\xe6\xf4\xa2"
-DATA ·d+32832(SB)/8,$"\xac\xaa\x7e\xa1\x8c\xcb\x31\x53"
-DATA ·d+32840(SB)/8,$"\x92\xf6\x74\x6e\x9f\x9a\x5f\x81"
-DATA ·d+32848(SB)/8,$"\xd3\xc3\xab\xce\x23\xf3\x16\xda"
-DATA ·d+32856(SB)/8,$"\x68\xca\x21\xcb\x79\x61\x5f\x1f"
-DATA ·d+32864(SB)/8,$"\x88\xcd\x7d\xa7\xff\x00\x04\xdc"
-DATA ·d+32872(SB)/8,$"\xfd\xa8\x7c\x1f\xfb\x36\xfe\xd2"
-DATA ·d+32880(SB)/8,$"\x83\xc6\xff\x00\x13\xae\xb5\x4f"
-DATA ·d+32888(SB)/8,$"\xf8\x46\x75\x3f\x0d\xea\xde\x1d"
-DATA ·d+32896(SB)/8,$"\xd7\x84\x51\xcb\x7d\x2c\x90\xdd"
-DATA ·d+32904(SB)/8,$"\x46\xb2\x5b\x04\xb7\x8b\x25\x9e"
-DATA ·d+32912(SB)/8,$"\x2b\x88\x91\x94\xe3\xe5\x1b\xb9"
-DATA ·d+32920(SB)/8,$"\x19\x39\xfc\xe2\x8b\xc7\xf7\x3a"
-DATA ·d+32928(SB)/8,$"\xa4\xea\x9f\x65\x9b\xcc\x66\xc3"
-DATA ·d+32936(SB)/8,$"\x06\xe1\xb6\x8f\x53\xd7\x00\x7a"
-DATA ·d+32944(SB)/8,$"\xf1\x58\x77\xbe\x21\xbf\x4b\xa6"
-DATA ·d+32952(SB)/8,$"\x11\x45\x1a\x2b\x37\xcb\x9f\x9b"
-DATA ·d+32960(SB)/8,$"\x1e\xe7\x15\xc9\x89\xcd\x69\x54"
-DATA ·d+32968(SB)/8,$"\x4e\x12\x9b\x68\xf5\x78\x72\x86"
-DATA ·d+32976(SB)/8,$"\x2b\x2f\xc5\x43\x13\x4e\x2a\x32"
-DATA ·d+32984(SB)/8,$"\x8e\xba\xbf\xc3\xbe\xa7\xf7\x55"
-DATA ·d+32992(SB)/8,$"\xf0\x43\xe3\x87\xc1\x4f\x8e\xb0"
-DATA ·d+33000(SB)/8,$"\xdb\xeb\xbf\x05\xfc\x59\xa6\x6a"
-DATA ·d+33008(SB)/8,$"\x97\x91\xc6\xb3\x01\xa7\x5c\x79"
-DATA ·d+33016(SB)/8,$"\x3a\x95\xa9\xc0\x24\x4b\x6b\x26"
-DATA ·d+33024(SB)/8,$"\xc9\xd0\xa9\xea\x8e\x9c\x57\xe8"
-DATA ·d+33032(SB)/8,$"\xc7\x86\x3f\x6b\x9f\x88\x5e\x07"
-DATA ·d+33040(SB)/8,$"\xb5\x8f\x4d\xf1\xae\x91\x2e\xbf"
-DATA ·d+33048(SB)/8,$"\x1e\x0c\x71\x5e\xd8\x9d\xb3\x02"
-DATA ·d+33056(SB)/8,$"\xa3\x23\xed\x03\x04\xaf\x1f\xc4"
-DATA ·d+33064(SB)/8,$"\x41\x1e\xad\x5f\xe6\x99\xa4\xf8"
-DATA ·d+33072(SB)/8,$"\xdf\x53\xd1\xf5\x2b\x7d\x67\x4f"
-DATA ·d+33080(SB)/8,$"\x9a\x7b\x7b\xdb\x63\x98\x6e\x6d"
-DATA ·d+33088(SB)/8,$"\xe4\x68\x25\x8c\xf3\xcc\x72\xc6"
-DATA ·d+33096(SB)/8,$"\x44\x89\xc1\xc6\x55\x85\x7e\x99"
-DATA ·d+33104(SB)/8,$"\xfc\x03\xff\x00\x82\xc0\xfe\xd4"
-DATA ·d+33112(SB)/8,$"\x3f\x08\x16\xd7\x44\xd5\x35\x88"
-DATA ·d+33120(SB)/8,$"\xfc\x55\xa6\xda\x84\x8d\xb4\xff"
-DATA ·d+33128(SB)/8,$"\x00\x14\xc4\x6f\x24\x58\x94\xf2"
-DATA ·d+33136(SB)/8,$"\xa9\x7f\x19\x4b\xa5\x24\x70\x1a"
-DATA ·d+33144(SB)/8,$"\x43\x31\x1e\x84\x71\x5c\xb8\xcc"
-DATA ·d+33152(SB)/8,$"\xa3\x2c\xcc\x23\xcb\x29\xab\xf6"
-DATA ·d+33160(SB)/8,$"\x96\x9f\x74\x97\xeb\x63\xfa\x3f"
-DATA ·d+33168(SB)/8,$"\x86\xfc\x68\xab\x87\xfe\x3d\x27"
-DATA ·d+33176(SB)/8,$"\x1f\x38\xea\xbe\x71\x7f\xa5\xcf"
-DATA ·d+33184(SB)/8,$"\xf4\x0b\xd2\x3f\x68\xbf\x84\x3f"
-DATA ·d+33192(SB)/8,$"\x11\x6c\xbc\x9b\x6d\x5a\xde\xce"
-DATA ·d+33200(SB)/8,$"\xe4\x28\xdf\x69\x7e\x04\x6e\xac"
-DATA ·d+33208(SB)/8,$"\x47\x4c\x92\x54\xfe\x75\xe0\x7f"
-DATA ·d+33216(SB)/8,$"\x16\x7c\x01\x77\xad\x2a\xeb\xda"
-DATA ·d+33224(SB)/8,$"\x33\xa4\xb1\xc2\x32\xf7\x16\xe5"
-DATA ·d+33232(SB)/8,$"\x19\x96\x21\x9c\x48\xac\x0e\x32"
-DATA ·d+33240(SB)/8,$"\x99\xc9\x07\xaa\xe4\x7a\x57\xf3"
-DATA ·d+33248(SB)/8,$"\xc7\xf0\x8b\xfe\x0b\x53\xfb\x24"
-DATA ·d+33256(SB)/8,$"\xfc\x48\x61\x6b\xf1\xdf\xc2\xda"
-DATA ·d+33264(SB)/8,$"\xbf\x87\xa5\x0a\x59\xee\xed\x22"
-DATA ·d+33272(SB)/8,$"\x8f\x5a\xb4\x23\x8f\x94\x3a\x08"
-DATA ·d+33280(SB)/8,$"\xee\xd3\x27\xb9\x84\x8c\x77\xcf"
-DATA ·d+33288(SB)/8,$"\x15\xf7\xb6\x81\xf1\xf7\xf6\x40"
-DATA ·d+33296(SB)/8,$"\xf8\x85\x6f\x67\xa7\xfc\x21\xf8"
-DATA ·d+33304(SB)/8,$"\x8b\xa5\x59\xc3\x34\x66\xe6\xdf"
-DATA ·d+33312(SB)/8,$"\x4e\xf0\xb6\xb3\x73\xf6\xe6\x92"
-DATA ·d+33320(SB)/8,$"\x6e\x71\x2c\x02\x58\xf1\x20\xe9"
-DATA ·d+33328(SB)/8,$"\xb6\x43\x91\xd3\x68\xaf\x9f\xc2"
-DATA ·d+33336(SB)/8,$"\xf0\x7c\xb0\xd5\x2d\x41\xb6\xbf"
-DATA ·d+33344(SB)/8,$"\xf0\x25\xf7\xc6\xe7\xeb\x34\x3c"
-DATA ·d+33352(SB)/8,$"\x47\xc1\xe6\x10\xb4\x6a\xc1\xbf"
-DATA ·d+33360(SB)/8,$"\x5e\x59\x7d\xd2\x3e\xc6\xf0\xd6"
-DATA ·d+33368(SB)/8,$"\xad\xe5\x78\x8e\x0f\x85\xfa\xea"
-DATA ·d+33376(SB)/8,$"\x2e\xa5\x25\xfc\x66\x5b\x99\x10"
-DATA ·d+33384(SB)/8,$"\x37\xd8\xc5\xa9\xf9\x49\x50\x08"
-DATA ·d+33392(SB)/8,$"\xdc\xd3\x1f\x95\x78\xc0\xf9\x88"
-DATA ·d+33400(SB)/8,$"\xce\xda\xf3\xdb\x5d\x03\x54\xf8"
-DATA ·d+33408(SB)/8,$"\xbf\xe3\x50\xba\xc6\xa3\x1e\x8d"
-DATA ·d+33416(SB)/8,$"\xe0\x9f\x0b\xd8\xc1\xa6\xda\x5d"
-DATA ·d+33424(SB)/8,$"\x48\x82\x0b\x79\x24\x5c\x89\x21"
-DATA ·d+33432(SB)/8,$"\x8b\x77\x96\x03\x02\x54\x29\x46"
-DATA ·d+33440(SB)/8,$"\xc7\x50\x41\x38\xaf\x1b\xf8\x33"
-DATA ·d+33448(SB)/8,$"\xe1\x6f\x12\x69\x9e\x3b\xd5\x7f"
-DATA ·d+33456(SB)/8,$"\xe1\x19\xf1\x4f\x8b\xef\xf4\xf9"
-DATA ·d+33464(SB)/8,$"\x02\x5e\xdc\x9f\x15\xea\x11\x13"
-DATA ·d+33472(SB)/8,$"\x04\x51\xc6\xd9\x10\x44\xb1\x6e"
-DATA ·d+33480(SB)/8,$"\x01\x0a\x9d\xa0\xc8\x76\x06\xe0"
-DATA ·d+33488(SB)/8,$"\x0e\xfd\xb5\xbe\xbb\x7d\xe2\x9f"
-DATA ·d+33496(SB)/8,$"\x0c\x69\x3a\xa4\xb7\xd7\x36\x36"
-DATA ·d+33504(SB)/8,$"\xba\x5c\x2d\x6d\xa6\xe4\xc4\x62"
-DATA ·d+33512(SB)/8,$"\x8c\x47\xc2\xb3\xb4\xa1\xc1\x76"
-DATA ·d+33520(SB)/8,$"\xc0\xcb\x30\x52\x4e\x4b\x1c\xd7"
-DATA ·d+33528(SB)/8,$"\xa5\x3c\xaa\x71\xa8\xf9\x37\xb2"
-DATA ·d+33536(SB)/8,$"\xd6\xcf\x4b\xf9\x5b\x7e\x83\xfe"
-DATA ·d+33544(SB)/8,$"\xd6\x8d\x4a\x71\xf6\x8b\x4b\xde"
-DATA ·d+33552(SB)/8,$"\xd7\x5a\xa5\xe7\xff\x00\x04\xf6"
-DATA ·d+33560(SB)/8,$"\x1d\x47\xe2\x2e\x83\xa2\xdd\xdb"
-DATA ·d+33568(SB)/8,$"\xf8\x2f\xe1\x47\x86\x6f\xde\x19"
-DATA ·d+33576(SB)/8,$"\xcc\x8b\xff\x00\x09\x26\xb4\x5b"
-DATA ·d+33584(SB)/8,$"\xca\x89\x21\x01\x64\xb9\x6b\x79"
-DATA ·d+33592(SB)/8,$"\x99\x25\x78\x51\x8e\x14\x9d\xac"
-DATA ·d+33600(SB)/8,$"\xc7\xa0\x23\x9a\xfc\xaa\xf8\xf3"
-DATA ·d+33608(SB)/8,$"\xe1\x4f\x1d\x7c\x4f\xf1\xd5\xe6"
-DATA ·d+33616(SB)/8,$"\x9d\x6f\x7f\x16\xb9\x05\xb4\xad"
-DATA ·d+33624(SB)/8,$"\x0c\x8a\x25\xfb\x2d\xc0\x08\xc4"
-DATA ·d+33632(SB)/8,$"\x6f\x4b\x59\x42\x6e\x8c\xf6\x65"
-DATA ·d+33640(SB)/8,$"\x2d\x9f\xa6\x2b\xe9\xdf\x1d\x7c"
-DATA ·d+33648(SB)/8,$"\x72\xf8\x4f\x79\x64\x96\xff\x00"
-DATA ·d+33656(SB)/8,$"\x11\xbc\x7f\x67\x0b\xda\x23\x6d"
-DATA ·d+33664(SB)/8,$"\x6b\xad\x76\xd2\xdd\x1f\x3c\xb4"
-DATA ·d+33672(SB)/8,$"\x72\x18\xcf\xca\xc3\xa2\x1e\x87"
-DATA ·d+33680(SB)/8,$"\xa1\xec\x6b\xe0\x4f\x1f\xfe\xdb"
-DATA ·d+33688(SB)/8,$"\xff\x00\xb2\x86\x83\xa9\x4d\x15"
-DATA ·d+33696(SB)/8,$"\x9f\x8c\x7c\x33\x14\xa5\x18\x5d"
-DATA ·d+33704(SB)/8,$"\x5e\xc7\x78\xfa\xb5\xcc\xcb\x9e"
-DATA ·d+33712(SB)/8,$"\x88\x13\x7a\xac\x80\x7c\xb9\x00"
-DATA ·d+33720(SB)/8,$"\x12\x3a\xf4\xaf\xb1\xe1\x8c\x8e"
-DATA ·d+33728(SB)/8,$"\xb5\x39\xf3\xd2\xa4\xdb\xef\x66"
-DATA ·d+33736(SB)/8,$"\xf5\xef\x77\xfa\x1f\x23\xc4\x99"
-DATA ·d+33744(SB)/8,$"\xed\x2a\xb1\x50\x9d\x54\x97\x64"
-DATA ·d+33752(SB)/8,$"\xd2\xd3\xb5\x97\xeb\x73\xe6\x5f"
-DATA ·d+33760(SB)/8,$"\x8c\x7f\x02\xbc\x57\xa7\x5c\xcb"
-DATA ·d+33768(SB)/8,$"\xa3\x6a\x3a\x16\xaa\x93\x79\x48"
-DATA ·d+33776(SB)/8,$"\xf0\xaf\xd9\x25\xdc\xcb\x26\x76"
-DATA ·d+33784(SB)/8,$"\xb0\x21\x4a\xb0\xc8\xc7\x06\xbc"
-DATA ·d+33792(SB)/8,$"\x3b\x49\xfd\x8f\x7c\x63\xa9\x5d"
-DATA ·d+33800(SB)/8,$"\xc3\x6f\xe2\x03\x1e\x98\x25\x75"
-DATA ·d+33808(SB)/8,$"\x48\xed\x94\x0b\x8b\xf9\x1d\xba"
-DATA ·d+33816(SB)/8,$"\x2a\x40\x84\x85\x27\xb6\xf6\xf7"
-DATA ·d+33824(SB)/8,$"\xc5\x7a\xcf\x8c\xbf\xe0\xa6\x7f"
-DATA ·d+33832(SB)/8,$"\xb3\xd6\x97\xa6\xb6\x9d\xa2\x6b"
-DATA ·d+33840(SB)/8,$"\x1a\xe6\xa0\x10\x30\x54\xb6\xb2"
-DATA ·d+33848(SB)/8,$"\xb9\x0b\xcf\x27\x69\x93\x62\x80"
-DATA ·d+33856(SB)/8,$"\x4f\xa6\x06\x79\xaf\xcf\x3f\xda"
-DATA ·d+33864(SB)/8,$"\x73\xf6\xfc\xbb\xf1\xdf\x82\x23"
-DATA ·d+33872(SB)/8,$"\xf0\x57\xc0\x46\xd6\x34\xb9\xb5"
-DATA ·d+33880(SB)/8,$"\x34\x9d\x3c\x49\xad\x5d\x28\xb4"
-DATA ·d+33888(SB)/8,$"\xbe\x5b\x72\xc0\x47\x65\x60\xd1"
-DATA ·d+33896(SB)/8,$"\x48\xcd\x1c\x52\xa6\x4d\xcc\xdb"
-DATA ·d+33904(SB)/8,$"\x96\x46\xe2\x35\xc2\x92\x6b\xf4"
-DATA ·d+33912(SB)/8,$"\x4c\x5f\x13\xd4\xcb\x70\xd7\xac"
-DATA ·d+33920(SB)/8,$"\xd4\x64\xb6\x4b\x7f\xbb\xb1\xf9"
-DATA ·d+33928(SB)/8,$"\x26\x61\x82\xcb\xab\xce\xee\x57"
-DATA ·d+33936(SB)/8,$"\x4f\xbe\xdf\x81\xd4\xfe\xd0\x1f"
-DATA ·d+33944(SB)/8,$"\xb5\x3f\xc2\x4f\xd9\xc2\x4b\xcf"
-DATA ·d+33952(SB)/8,$"\x83\x7f\xb3\x26\xfb\x9f\x17\xa9"
-DATA ·d+33960(SB)/8,$"\x7d\x37\xc4\x5e\x3f\x8d\x96\xe1"
-DATA ·d+33968(SB)/8,$"\xb4\xf7\xc1\x59\xac\xb4\xa9\x88"
-DATA ·d+33976(SB)/8,$"\x2a\xd7\x20\x92\x93\xdd\x44\x3c"
-DATA ·d+33984(SB)/8,$"\xb8\x4e\xe8\xa1\x2d\x2a\xbb\x47"
-DATA ·d+33992(SB)/8,$"\xf8\xfd\x2e\x88\xb7\x17\xad\x2c"
-DATA ·d+34000(SB)/8,$"\x5e\x79\x79\x64\x79\x64\x33\x31"
-DATA ·d+34008(SB)/8,$"\xde\xcf\x23\x17\x77\x76\x6c\x96"
-DATA ·d+34016(SB)/8,$"\x66\x62\x59\x99\x89\x66\x62\x49"
-DATA ·d+34024(SB)/8,$"\x24\x92\x6b\x7f\x48\xf8\x45\xe3"
-DATA ·d+34032(SB)/8,$"\x49\x20\x4b\xbb\x3b\x51\x1c\x08"
-DATA ·d+34040(SB)/8,$"\xaa\xb0\x8c\x85\x55\x00\x61\x42"
-DATA ·d+34048(SB)/8,$"\x04\xe0\x00\x30\x00\x1d\x31\x53"
-DATA ·d+34056(SB)/8,$"\xde\xf8\x5b\xc6\x76\x11\x79\x77"
-DATA ·d+34064(SB)/8,$"\x09\x3b\xb1\x3b\x4e\xe4\x27\x00"
-DATA ·d+34072(SB)/8,$"\x1e\xcc\x47\x3f\xce\xbf\x29\xc4"
-DATA ·d+34080(SB)/8,$"\xe6\xf8\xdc\x64\x9d\x6c\x54\x1b"
-DATA ·d+34088(SB)/8,$"\x4f\x6d\x34\x3c\xba\xf9\x96\x0a"
-DATA ·d+34096(SB)/8,$"\x13\x74\x70\x75\xd2\x5f\xcb\x7f"
-DATA ·d+34104(SB)/8,$"\xd1\x68\x3f\x44\xb2\xf0\xcc\x12"
-DATA ·d+34112(SB)/8,$"\x01\xae\x46\xe3\x6f\x26\x58\x88"
-DATA ·d+34120(SB)/8,$"\x56\x04\x77\xcf\xd2\xb6\xf5\xaf"
-DATA ·d+34128(SB)/8,$"\x19\xd8\xcd\xa5\xc9\xa2\xe9\x37"
-DATA ·d+34136(SB)/8,$"\x4e\x88\x24\x0d\x0c\xcb\xbd\xa6"
-DATA ·d+34144(SB)/8,$"\x5c\x76\xcf\x0a\x41\x1d\x41\xc8"
-DATA ·d+34152(SB)/8,$"\xaf\x2f\x6f\x0a\x78\x9e\xee\xe1"
-DATA ·d+34160(SB)/8,$"\xa2\xb8\x59\x51\xb7\x67\x12\x82"
-DATA ·d+34168(SB)/8,$"\x38\x3d\xeb\xae\xd2\xfe\x11\xdf"
-DATA ·d+34176(SB)/8,$"\xce\xa6\xea\x4b\x98\x02\x0e\xbf"
-DATA ·d+34184(SB)/8,$"\xbe\x45\xc1\x1f\x53\x9f\xd2\x8a"
-DATA ·d+34192(SB)/8,$"\x55\xeb\x54\x8b\xa5\x4e\x9d\x93"
-DATA ·d+34200(SB)/8,$"\xf9\x68\x79\xf8\xba\x58\x28\x4f"
-DATA ·d+34208(SB)/8,$"\xdb\x57\xc4\x5d\xad\xb5\xba\xb9"
-DATA ·d+34216(SB)/8,$"\x94\x35\xdd\x61\xdd\x76\x3d\xd3"
-DATA ·d+34224(SB)/8,$"\x10\x41\x12\x24\x08\xcc\x7f\x5a"
-DATA ·d+34232(SB)/8,$"\xc9\xf1\x14\xd0\xea\x53\x0b\xad"
-DATA ·d+34240(SB)/8,$"\x66\xe7\x55\xf3\x06\xd1\xf3\xc6"
-DATA ·d+34248(SB)/8,$"\xca\xbf\x2f\xb6\xfd\xbf\xa5\x7a"
-DATA ·d+34256(SB)/8,$"\x32\x68\x0f\xa5\xc2\xd6\xd1\x5e"
-DATA ·d+34264(SB)/8,$"\xa0\xe4\x8f\x94\x86\x62\x47\xa6"
-DATA ·d+34272(SB)/8,$"\x05\x73\x57\x9a\x56\xbf\x1b\x06"
-DATA ·d+34280(SB)/8,$"\xb5\xbd\x49\x94\xf5\x8a\x52\xa7"
-DATA ·d+34288(SB)/8,$"\xa7\x4e\x0d\x72\x62\x72\xe7\x25"
-DATA ·d+34296(SB)/8,$"\x6a\x97\x7e\x57\x7f\xe6\x75\x60"
-DATA ·d+34304(SB)/8,$"\xb3\x2a\x32\x9f\x34\x2c\xbc\xf6"
-DATA ·d+34312(SB)/8,$"\xfd\x0f\x3c\x37\x76\x9a\x55\xd7"
-DATA ·d+34320(SB)/8,$"\xda\x74\xc8\x67\x05\x71\x9c\xb2"
-DATA ·d+34328(SB)/8,$"\x81\xc7\xb1\x53\x56\xee\x7c\x6b"
-DATA ·d+34336(SB)/8,$"\x7d\x76\x9e\x50\xb6\xb7\x19\xe0"
-DATA ·d+34344(SB)/8,$"\x96\x8d\x58\x91\xef\x80\x2b\xa3"
-DATA ·d+34352(SB)/8,$"\x7d\x62\x4d\x30\x37\xf6\x8e\x9d"
-DATA ·d+34360(SB)/8,$"\x04\xc3\x04\x16\x8c\x7e\x7d\x2b"
-DATA ·d+34368(SB)/8,$"\x2e\xd6\xf8\x5e\xcb\xe6\x68\x4d"
-DATA ·d+34376(SB)/8,$"\x6f\x04\xcd\x8f\xdd\xcf\x1f\x19"
-DATA ·d+34384(SB)/8,$"\xf4\x0c\x73\x58\xd1\xa3\x08\x27"
-DATA ·d+34392(SB)/8,$"\x18\x3b\x5f\xa5\x8f\x72\x35\x23"
-DATA ·d+34400(SB)/8,$"\x3f\x7a\xa5\x2b\xdb\xab\x77\x32"
-DATA ·d+34408(SB)/8,$"\x2c\xfc\x43\x0a\x48\x05\xf5\x99"
-DATA ·d+34416(SB)/8,$"\x2b\xd0\xfd\x9c\x94\xc7\x3d\x70"
-DATA ·d+34424(SB)/8,$"\x72\x2b\xb1\x87\x54\xd0\x6f\x48"
-DATA ·d+34432(SB)/8,$"\x64\x91\xe0\x65\xc9\x56\x96\x22"
-DATA ·d+34440(SB)/8,$"\xc4\x7d\x4f\x34\xd7\xb9\xf8\x85"
-DATA ·d+34448(SB)/8,$"\x16\x52\xe2\xda\x09\x14\x7f\x12"
-DATA ·d+34456(SB)/8,$"\xc2\x84\x1f\x7c\xa8\xad\xbd\x1d"
-DATA ·d+34464(SB)/8,$"\x7c\x4d\x75\x93\xa8\x58\x46\xf1"
-DATA ·d+34472(SB)/8,$"\x11\x9c\xed\x51\x80\x3f\xcf\x7a"
-DATA ·d+34480(SB)/8,$"\xf6\xb0\x14\xb9\x5f\x2a\x57\xbf"
-DATA ·d+34488(SB)/8,$"\x78\x9e\x76\x63\x56\x94\x93\x9a"
-DATA ·d+34496(SB)/8,$"\xb2\xb7\x69\xff\x00\x9a\x2b\x36"
-DATA ·d+34504(SB)/8,$"\x8b\xe1\x2d\x54\xf9\xfa\xbd\xd5"
-DATA ·d+34512(SB)/8,$"\x95\xc6\xd5\x2c\xa1\x4e\x1c\x9f"
-DATA ·d+34520(SB)/8,$"\x41\xf7\x79\x38\xae\x8b\xfe\x12"
-DATA ·d+34528(SB)/8,$"\x7b\xcb\x88\x12\xca\xc6\xcf\x53"
-DATA ·d+34536(SB)/8,$"\x11\xa2\x85\xf3\xbc\xd8\xdc\x91"
-DATA ·d+34544(SB)/8,$"\xef\x86\x6c\xfb\x66\xb9\xdb\xbb"
-DATA ·d+34552(SB)/8,$"\x0f\x0a\xdc\xdc\xf9\x72\x49\x6d"
-DATA ·d+34560(SB)/8,$"\x0b\x93\x8d\xb2\x31\xc0\xf6\xdc"
-DATA ·d+34568(SB)/8,$"\x06\x31\x4f\xbc\xf0\xed\x8e\x80"
-DATA ·d+34576(SB)/8,$"\x56\x74\x9a\x48\x98\x8d\xc8\xd6"
-DATA ·d+34584(SB)/8,$"\xf9\x23\x1e\xb9\xc6\x0d\x7a\x14"
-DATA ·d+34592(SB)/8,$"\x5f\x2b\x94\xe8\x35\x1e\x8d\xc6"
-DATA ·d+34600(SB)/8,$"\xd7\x7e\xb7\xb9\xe6\xcd\xd3\x9a"
-DATA ·d+34608(SB)/8,$"\x8c\x2a\xdd\xbe\x8a\x49\xd9\x7a"
-DATA ·d+34616(SB)/8,$"\x5a\xc6\x1d\xdf\x8f\x34\xfd\x3a"
-DATA ·d+34624(SB)/8,$"\xe6\x4d\x1a\xee\xf3\x50\x85\x95"
-DATA ·d+34632(SB)/8,$"\xb1\x24\x4f\x18\x2b\x9f\x71\x9e"
-DATA ·d+34640(SB)/8,$"\x6b\xac\xb6\x86\x03\x60\x6e\xa3"
-DATA ·d+34648(SB)/8,$"\x29\x29\x90\x65\x11\x15\x00\x39"
-DATA ·d+34656(SB)/8,$"\xee\xcc\xa1\xf1\xf9\x66\xb8\xbb"
-DATA ·d+34664(SB)/8,$"\xdb\x39\xf5\x99\xb7\x5b\xc9\x0b"
-DATA ·d+34672(SB)/8,$"\x3b\x1c\x6f\x9a\x34\x56\xc9\xf5"
-DATA ·d+34680(SB)/8,$"\x24\x77\xf7\xa4\x3e\x13\xf1\x4e"
-DATA ·d+34688(SB)/8,$"\x9c\xbe\x7d\xc4\x0b\xe5\x7f\xcf"
-DATA ·d+34696(SB)/8,$"\x58\x94\x63\xfe\xfa\x43\xc5\x79"
-DATA ·d+34704(SB)/8,$"\x74\xf1\x78\xd5\x51\xce\xa4\x79"
-DATA ·d+34712(SB)/8,$"\xe3\xd3\x4d\xbe\x7a\xfe\x47\xa7"
-DATA ·d+34720(SB)/8,$"\x5b\x0d\x85\xe5\x8c\x61\x3e\x49"
-DATA ·d+34728(SB)/8,$"\x75\xd7\x7f\xc8\xec\xed\xb4\xe8"
-DATA ·d+34736(SB)/8,$"\x6e\xac\x6e\x35\x0d\x55\x3e\xcf"
-DATA ·d+34744(SB)/8,$"\x15\xb2\x87\x06\x27\x62\x4f\x63"
-DATA ·d+34752(SB)/8,$"\x90\xa8\x08\x03\xae\x6b\x90\x59"
-DATA ·d+34760(SB)/8,$"\xfc\x33\x71\x30\xfb\x16\xa2\x23"
-DATA ·d+34768(SB)/8,$"\x38\x39\x3b\xdb\x3f\xa8\xcd\x5d"
-DATA ·d+34776(SB)/8,$"\x4b\xbd\x2e\xc6\x05\x9e\xe1\xda"
-DATA ·d+34784(SB)/8,$"\x49\x57\x83\x16\xe6\x2a\x06\x3a"
-DATA ·d+34792(SB)/8,$"\xfc\xd9\x07\xf1\x15\x9a\x97\x1a"
-DATA ·d+34800(SB)/8,$"\x4d\xe7\xcf\x0d\xdc\xb0\x1e\xa5"
-DATA ·d+34808(SB)/8,$"\x51\xc2\x9f\xe4\x01\xaa\xc4\x63"
-DATA ·d+34816(SB)/8,$"\x25\x78\x47\x4f\x3b\xef\xf7\xab"
-DATA ·d+34824(SB)/8,$"\x22\x30\xd4\x3e\x29\x3e\x6b\x74"
-DATA ·d+34832(SB)/8,$"\xb6\xcb\xef\xb9\xda\xe9\x5a\x74"
-DATA ·d+34840(SB)/8,$"\x17\xd0\x3f\xd9\xee\xd2\xfc\x46"
-DATA ·d+34848(SB)/8,$"\x32\xd1\x38\xc9\x00\xf7\x05\xb1"
-DATA ·d+34856(SB)/8,$"\xfc\xeb\x9a\xd4\x34\x7b\x09\x65"
-DATA ·d+34864(SB)/8,$"\x61\x15\xa9\xb7\x2b\xfc\x4b\x24"
-DATA ·d+34872(SB)/8,$"\x5c\x9f\xf7\x70\x4d\x46\xf1\xb5"
-DATA ·d+34880(SB)/8,$"\xa6\x26\x5b\xbb\xcd\xbf\xdf\x55"
-DATA ·d+34888(SB)/8,$"\x67\x19\x3e\xa5\x49\xcd\x6a\xd9"
-DATA ·d+34896(SB)/8,$"\x78\x9a\xc2\x29\x04\x1a\x94\xf3"
-DATA ·d+34904(SB)/8,$"\xca\x39\xc3\x3d\xb4\x84\xe7\xeb"
-DATA ·d+34912(SB)/8,$"\xb0\xd7\x72\xc4\xd1\x9c\x54\x6a"
-DATA ·d+34920(SB)/8,$"\x49\x45\xaf\xeb\xad\xd9\x8a\xa7"
-DATA ·d+34928(SB)/8,$"\x56\x9c\xa5\x3a\x37\x95\xfd\x6f"
-DATA ·d+34936(SB)/8,$"\xf8\x59\x1c\x8c\xfa\xb5\x9e\x92"
-DATA ·d+34944(SB)/8,$"\xa2\xde\xe6\x32\xe0\x0e\x4c\xec"
-DATA ·d+34952(SB)/8,$"\xc4\x1f\x6c\x2a\x9f\xe7\x5c\xb3"
-DATA ·d+34960(SB)/8,$"\xeb\xd0\xb3\x96\x5f\xb0\xe0\x92"
-DATA ·d+34968(SB)/8,$"\x47\xfa\x3c\xdf\xfc\x72\xbd\x42"
-DATA ·d+34976(SB)/8,$"\xe7\xc6\x76\x8b\x30\xb6\x9b\x4a"
-DATA ·d+34984(SB)/8,$"\x6b\x84\xc6\x44\xaa\xac\xc0\x7b"
-DATA ·d+34992(SB)/8,$"\x61\x91\x71\x55\x4e\xb9\xe1\xf2"
-DATA ·d+35000(SB)/8,$"\x49\xfe\xc7\x6e\x79\xfb\x83\xfc"
-DATA ·d+35008(SB)/8,$"\x6b\x86\xae\x26\x84\xdf\xbb\x89"
-DATA ·d+35016(SB)/8,$"\xdb\xfb\xac\xf6\x28\xd5\x94\x63"
-DATA ·d+35024(SB)/8,$"\xef\xe1\x5d\xdf\x66\xbf\x57\xa1"
-DATA ·d+35032(SB)/8,$"\xff\xd3\xfc\x57\xf0\x6f\xc1\xfb"
-DATA ·d+35040(SB)/8,$"\x8f\x14\xd8\x2f\x89\xbc\x43\x7e"
-DATA ·d+35048(SB)/8,$"\x6c\xf4\x49\x59\xfc\xeb\xb9\x21"
-DATA ·d+35056(SB)/8,$"\x2e\x64\x91\x06\x4c\x71\x85\x63"
-DATA ·d+35064(SB)/8,$"\xca\xf0\x7e\x6c\x77\xe7\x8c\x57"
-DATA ·d+35072(SB)/8,$"\x9b\x7f\x65\x7c\x3a\xb5\xb9\xbe"
-DATA ·d+35080(SB)/8,$"\xff\x00\x84\x9a\x6d\x4a\xf5\xd1"
-DATA ·d+35088(SB)/8,$"\xd4\x5a\xdc\xd9\xc9\x04\x2a\xf1"
-DATA ·d+35096(SB)/8,$"\x21\x39\x25\x24\x05\xb2\x46\x36"
-DATA ·d+35104(SB)/8,$"\x8c\xf5\xeb\x5f\x44\xa4\xde\x23"
-DATA ·d+35112(SB)/8,$"\xba\xbd\x36\x5a\x8d\xae\x8b\x29"
-DATA ·d+35120(SB)/8,$"\xb8\x46\x04\xb1\x10\x82\x3a\x12"
-DATA ·d+35128(SB)/8,$"\x42\xe1\x71\xc7\x27\x04\x7e\x35"
-DATA ·d+35136(SB)/8,$"\xd3\xaf\x87\x3f\x67\x1d\x27\x40"
-DATA ·d+35144(SB)/8,$"\x06\xef\x44\x8b\x51\xd6\x67\x6f"
-DATA ·d+35152(SB)/8,$"\x32\x4b\x85\xba\xb8\x8e\x1b\x62"
-DATA ·d+35160(SB)/8,$"\x06\x3c\xa8\xe1\x6f\x92\x5e\x79"
-DATA ·d+35168(SB)/8,$"\x32\x13\x8c\x70\xa3\xbd\x7f\x52"
-DATA ·d+35176(SB)/8,$"\x3c\x86\x1e\xf2\x6d\x3f\xc2\xdf"
-DATA ·d+35184(SB)/8,$"\x8d\x8f\xf1\xf3\x0f\xc5\x33\x84"
-DATA ·d+35192(SB)/8,$"\xe5\x2a\xf2\x7c\xaf\x65\x1b\x7e"
-DATA ·d+35200(SB)/8,$"\x6a\xcf\xe6\x7c\xcf\x6d\x37\xc3"
-DATA ·d+35208(SB)/8,$"\x4d\x29\x5a\xfb\xc2\x11\x5e\x5c"
-DATA ·d+35216(SB)/8,$"\x07\x1f\x34\x57\x71\x79\x37\x38"
-DATA ·d+35224(SB)/8,$"\xef\x89\x15\x99\x07\x6c\xe3\x19"
-DATA ·d+35232(SB)/8,$"\xf4\xa8\x2c\x2f\x2f\xb5\x0d\x6e"
-DATA ·d+35240(SB)/8,$"\xd5\xfc\x4d\xa7\xac\x36\xb1\xe0"
-DATA ·d+35248(SB)/8,$"\xdc\x9b\x06\x0b\x71\x3c\x3b\xb2"
-DATA ·d+35256(SB)/8,$"\x53\xcc\xc3\x28\x90\x8e\x14\x9e"
-DATA ·d+35264(SB)/8,$"\x01\xc6\x45\x7a\x9d\xf7\x93\x01"
-DATA ·d+35272(SB)/8,$"\x37\x7a\x68\xb2\xb3\xb7\xce\x7c"
-DATA ·d+35280(SB)/8,$"\x88\x23\x86\x24\x70\xbc\x61\x9e"
-DATA ·d+35288(SB)/8,$"\x4c\xe4\xfd\x39\xab\x50\xf8\xaa"
-DATA ·d+35296(SB)/8,$"\xfd\xe3\x5b\x39\xe1\x8e\x2b\x2f"
-DATA ·d+35304(SB)/8,$"\x99\x02\x97\x49\x86\x48\xe0\x84"
-DATA ·d+35312(SB)/8,$"\xda\x33\xee\x41\xa8\xa7\xc3\xd8"
-DATA ·d+35320(SB)/8,$"\x7b\x39\x56\xac\xa2\xd6\xc9\x5b"
-DATA ·d+35328(SB)/8,$"\xef\xd1\x9b\x4f\x35\x9c\x94\xaa"
-DATA ·d+35336(SB)/8,$"\x52\xa4\xe7\x7e\xae\x4e\xeb\xf2"
-DATA ·d+35344(SB)/8,$"\xfc\x0f\x2e\xb8\xd4\xf1\xe2\x17"
-DATA ·d+35352(SB)/8,$"\xba\xd1\xac\xe7\xd3\x6d\x52\x6d"
-DATA ·d+35360(SB)/8,$"\xf0\x5b\xf9\x92\x4a\xd1\x03\xd8"
-DATA ·d+35368(SB)/8,$"\xc8\x06\x4f\x1d\x37\x67\xeb\x5d"
-DATA ·d+35376(SB)/8,$"\xd6\x87\xe2\x1d\x42\x59\x65\x12"
-DATA ·d+35384(SB)/8,$"\x83\x02\xc9\x20\x32\x5c\x4d\x87"
-DATA ·d+35392(SB)/8,$"\x8c\x86\x3b\x77\xb7\xcb\xbb\x1e"
-DATA ·d+35400(SB)/8,$"\xa3\x15\xeb\x5e\x13\xd4\x35\xcb"
-DATA ·d+35408(SB)/8,$"\x4b\xc8\x1f\x49\x16\x10\x69\xeb"
-DATA ·d+35416(SB)/8,$"\x0b\x8f\x30\x62\x26\x90\x67\x2d"
-DATA ·d+35424(SB)/8,$"\xe6\x2c\x80\xc6\x18\x13\xc6\x5b"
-DATA ·d+35432(SB)/8,$"\x8e\xd5\x77\xc5\xda\x9a\xeb\xfe"
-DATA ·d+35440(SB)/8,$"\x56\x9d\xa7\x5f\x59\x2d\xc9\x3b"
-DATA ·d+35448(SB)/8,$"\x96\x4f\x32\x19\xbc\xc6\x00\x9d"
-DATA ·d+35456(SB)/8,$"\xa3\x6a\x80\x31\x8e\x9c\xf1\xcd"
-DATA ·d+35464(SB)/8,$"\x75\xe0\xbd\xa5\x29\xfe\xea\xa3"
-DATA ·d+35472(SB)/8,$"\x71\xf4\xff\x00\x36\x8f\x03\x35"
-DATA ·d+35480(SB)/8,$"\xcc\x14\xdc\x21\x3a\x1f\x3b\xbd"
-DATA ·d+35488(SB)/8,$"\x17\x9e\x8c\xe7\xb5\x5f\x86\xd2"
-DATA ·d+35496(SB)/8,$"\x6a\x31\x7d\x9e\xde\x7d\x32\xfe"
-DATA ·d+35504(SB)/8,$"\x69\x14\x79\x11\xda\x87\x67\x67"
-DATA ·d+35512(SB)/8,$"\x5c\xe0\xc7\x80\x0e\x1b\xe9\x5e"
-DATA ·d+35520(SB)/8,$"\x39\x69\xf0\x7e\x79\xf5\x5f\xb0"
-DATA ·d+35528(SB)/8,$"\x6a\x5a\x5d\xf3\x5d\x86\x24\xc5"
-DATA ·d+35536(SB)/8,$"\xf3\xc4\xa0\x75\x3b\x9d\x82\x85"
-DATA ·d+35544(SB)/8,$"\x03\x1d\x49\xab\xd7\xd6\x5f\x1c"
-DATA ·d+35552(SB)/8,$"\xde\xfd\xe0\x5d\x4d\x2f\xad\x40"
-DATA ·d+35560(SB)/8,$"\xdd\xe5\xa1\x8a\xdf\x91\xc0\x08"
-DATA ·d+35568(SB)/8,$"\x51\x0e\x0a\xf7\x1c\x57\x69\xe0"
-DATA ·d+35576(SB)/8,$"\xbf\x86\x9f\x17\xaf\xb4\x4d\x43"
-DATA ·d+35584(SB)/8,$"\x58\xd7\x3c\x59\x67\xa0\xc1\x0d"
-DATA ·d+35592(SB)/8,$"\xa1\x9e\x4b\x78\xef\x15\x2e\x26"
-DATA ·d+35600(SB)/8,$"\x87\x76\x08\x2a\x80\x16\x19\x23"
-DATA ·d+35608(SB)/8,$"\x8c\xe4\xd7\x6d\x5c\xe2\x1c\xc9"
-DATA ·d+35616(SB)/8,$"\x56\xc2\xe8\xfe\xd4\xb6\xff\x00"
-DATA ·d+35624(SB)/8,$"\x2f\xc4\xef\xc0\x52\xa9\x85\xa3"
-DATA ·d+35632(SB)/8,$"\x27\xf5\xf8\x3b\xec\xa3\x7b\xfd"
-DATA ·d+35640(SB)/8,$"\xcb\x5f\x5d\x3e\x67\x9a\x4d\xe1"
-DATA ·d+35648(SB)/8,$"\x4f\x0b\xe8\xba\xb0\xb3\xb7\x83"
-DATA ·d+35656(SB)/8,$"\x4f\x86\x0b\x53\xfb\xdb\xd9\x19"
-DATA ·d+35664(SB)/8,$"\xdf\x7b\x31\xcb\x6f\x2a\x3e\x6d"
-DATA ·d+35672(SB)/8,$"\xbd\x33\xcf\x1d\x33\x5b\xba\xb7"
-DATA ·d+35680(SB)/8,$"\x88\xbc\x27\x04\xf0\xb6\x8b\x1f"
-DATA ·d+35688(SB)/8,$"\xdb\xcc\x3c\x47\x35\xa8\x60\xf2"
-DATA ·d+35696(SB)/8,$"\x3e\x3a\xa9\x9d\xb2\xb8\xe8\x38"
-DATA ·d+35704(SB)/8,$"\x15\x9f\x7b\xf0\x4e\xed\x7c\x35"
-DATA ·d+35712(SB)/8,$"\x75\xe2\x0d\x1f\x5e\xfb\x4c\x50"
-DATA ·d+35720(SB)/8,$"\x5c\xa4\x73\xa4\xd6\xcf\xe5\xab"
-DATA ·d+35728(SB)/8,$"\x38\xdd\x99\x0e\xfe\x09\xff\x00"
-DATA ·d+35736(SB)/8,$"\x74\xfa\xfb\x57\x49\xa7\x78\xef"
-DATA ·d+35744(SB)/8,$"\xe2\x0f\x84\x52\x3b\xfd\x37\x5d"
-DATA ·d+35752(SB)/8,$"\xd2\xb4\xb1\x2c\x09\xa7\xce\xb6"
-DATA ·d+35760(SB)/8,$"\x96\x4a\xa5\xa2\x89\x42\x86\x0b"
-DATA ·d+35768(SB)/8,$"\xe4\x11\x9f\x52\x3e\x62\x79\x26"
-DATA ·d+35776(SB)/8,$"\xbc\x5c\x46\x22\xac\x53\x95\x18"
-DATA ·d+35784(SB)/8,$"\xfd\xd6\xb7\xe1\xa1\xe8\xb5\x4b"
-DATA ·d+35792(SB)/8,$"\x10\xe0\xd5\x67\x51\xad\x2c\xf9"
-DATA ·d+35800(SB)/8,$"\x97\xdf\x74\xdf\xe4\x73\x76\xde"
-DATA ·d+35808(SB)/8,$"\x28\xd7\x74\x8f\xb4\x5f\x4f\x61"
-DATA ·d+35816(SB)/8,$"\xfd\x9e\x1c\xee\x56\xd4\x56\x27"
-DATA ·d+35824(SB)/8,$"\xf3\x16\x41\x8c\x2a\x20\x25\xc9"
-DATA ·d+35832(SB)/8,$"\xc1\xe3\x8f\xad\x63\xcb\xac\x5a"
-DATA ·d+35840(SB)/8,$"\x5e\xcb\x16\xa3\x2f\xd9\xed\x9d"
-DATA ·d+35848(SB)/8,$"\x21\x2a\x7e\xc8\xb8\x2b\xcf\x71"
-DATA ·d+35856(SB)/8,$"\xb5\x4a\x92\x79\xe7\x38\xf5\xa7"
-DATA ·d+35864(SB)/8,$"\xf8\xcf\xc5\x1a\xd6\xbb\x65\x34"
-DATA ·d+35872(SB)/8,$"\x26\x7f\xed\x89\xe7\x7f\xdd\xdc"
-DATA ·d+35880(SB)/8,$"\x49\x0f\x92\xca\xec\x41\x67\x52"
-DATA ·d+35888(SB)/8,$"\x76\x9c\x9c\x60\x1c\x0e\xa7\x8a"
-DATA ·d+35896(SB)/8,$"\xf2\xe8\xf4\x5d\x45\x6f\x66\xbe"
-DATA ·d+35904(SB)/8,$"\xb0\x89\xc6\xf3\x89\x12\xe6\x53"
-DATA ·d+35912(SB)/8,$"\x94\x27\xb1\x46\xcf\xbf\xa5\x73"
-DATA ·d+35920(SB)/8,$"\x46\xa5\x55\xef\x38\x3d\x7b\xab"
-DATA ·d+35928(SB)/8,$"\x1e\xae\x07\x2b\xa7\x38\xba\xb3"
-DATA ·d+35936(SB)/8,$"\x4a\x0f\xd5\xfd\xda\xbb\xfd\xe8"
-DATA ·d+35944(SB)/8,$"\xfa\x9f\xc3\xff\x00\x15\x46\xa3"
-DATA ·d+35952(SB)/8,$"\x0e\x9d\xa3\xf8\x9d\x11\xb4\xfb"
-DATA ·d+35960(SB)/8,$"\x2b\x8f\x3c\xc4\x63\x64\x6b\x96"
-DATA ·d+35968(SB)/8,$"\x20\x80\xb2\x5c\x45\x8b\x8c\x00"
-DATA ·d+35976(SB)/8,$"\x78\xda\xf8\x5c\x70\x2b\xca\x3e"
-DATA ·d+35984(SB)/8,$"\x27\x4d\xa1\x6b\x7e\x21\x9e\xf2"
-DATA ·d+35992(SB)/8,$"\x73\xa6\xdb\xaa\xc0\x12\xd2\x0d"
-DATA ·d+36000(SB)/8,$"\x36\x2b\x8e\x80\x65\x1d\xda\x52"
-DATA ·d+36008(SB)/8,$"\x4b\xee\xcf\xcc\x4e\x0d\x72\xe9"
-DATA ·d+36016(SB)/8,$"\xf0\xfb\xe2\xff\x00\x88\x34\x98"
-DATA ·d+36024(SB)/8,$"\xa7\xf0\x76\x89\x63\x3f\x92\xdb"
-DATA ·d+36032(SB)/8,$"\x64\x99\x27\x32\x4b\x2b\x9e\x41"
-DATA ·d+36040(SB)/8,$"\x31\x99\x14\x2e\x07\x00\x28\xae"
-DATA ·d+36048(SB)/8,$"\x1d\x3c\x39\xf1\x5a\xca\xf4\x7f"
-DATA ·d+36056(SB)/8,$"\x6a\x5f\xa5\xb2\x89\x02\x4b\x1a"
-DATA ·d+36064(SB)/8,$"\x05\x00\x8e\x84\x12\xc0\x81\xe9"
-DATA ·d+36072(SB)/8,$"\xcd\x74\x52\xc3\xfb\x54\xfd\xa5"
-DATA ·d+36080(SB)/8,$"\x39\x5b\xca\xdf\xe6\x76\x65\xd9"
-DATA ·d+36088(SB)/8,$"\x06\x1a\x95\x47\x5e\x9e\x26\x29"
-DATA ·d+36096(SB)/8,$"\xeb\xa5\xf5\xfc\x13\xdc\xd0\x8f"
-DATA ·d+36104(SB)/8,$"\xc0\xfa\xa5\xdc\xab\x0e\x99\x75"
-DATA ·d+36112(SB)/8,$"\x25\xc6\x54\x31\xf2\x60\x94\xe3"
-DATA ·d+36120(SB)/8,$"\x23\x38\x25\x87\x50\x3b\xf4\xae"
-DATA ·d+36128(SB)/8,$"\xbf\x4c\xf8\x71\xe2\xed\x3a\x28"
-DATA ·d+36136(SB)/8,$"\xb5\xcf\xb3\xdf\xbd\xb1\xfb\xed"
-DATA ·d+36144(SB)/8,$"\x12\x17\x62\x54\xf3\x94\x23\x03"
-DATA ·d+36152(SB)/8,$"\x8e\xbc\x57\xa6\x69\xd1\xf8\x93"
-DATA ·d+36160(SB)/8,$"\xc3\x57\x10\x59\x6a\x30\xc9\xa7"
-DATA ·d+36168(SB)/8,$"\x49\x7d\x6a\x64\xb6\x13\x5f\x40"
-DATA ·d+36176(SB)/8,$"\x91\xce\x00\xc2\x90\xeb\xbc\x63"
-DATA ·d+36184(SB)/8,$"\xb9\x18\x04\xd7\x45\x69\xe3\x5f"
-DATA ·d+36192(SB)/8,$"\x0d\xe8\x1a\x5c\x7a\xcd\xbe\xa1"
-DATA ·d+36200(SB)/8,$"\xab\xae\xa5\x03\xa3\x6a\x16\x96"
-DATA ·d+36208(SB)/8,$"\x8a\xc0\x84\x50\x0b\x04\x71\x94"
-DATA ·d+36216(SB)/8,$"\x60\xcd\x91\x9e\xc0\x64\xe7\x38"
-DATA ·d+36224(SB)/8,$"\xa3\xea\x18\x2a\x72\x4a\xaf\x32"
-DATA ·d+36232(SB)/8,$"\x6f\x6b\xad\x3f\x33\xc9\xc7\xf1"
-DATA ·d+36240(SB)/8,$"\x0e\x3a\x5e\xe5\x28\xa9\x26\xed"
-DATA ·d+36248(SB)/8,$"\xa6\xb7\xbe\x9b\xaf\x3e\xba\xf9"
-DATA ·d+36256(SB)/8,$"\x9c\x54\x16\x3e\x0c\xbe\xd4\x16"
-DATA ·d+36264(SB)/8,$"\x3d\x52\xd6\xf2\x22\x14\x42\x6d"
-DATA ·d+36272(SB)/8,$"\xda\x29\xba\xf5\x06\x4e\x54\xe0"
-DATA ·d+36280(SB)/8,$"\x1e\x70\x17\xf4\xaf\x2f\xd7\xf4"
-DATA ·d+36288(SB)/8,$"\xad\x2b\x45\x92\x78\x60\xbf\x96"
-DATA ·d+36296(SB)/8,$"\x56\xde\xc6\x18\xcc\x26\x31\xb3"
-DATA ·d+36304(SB)/8,$"\x39\x1b\x49\x2c\xcd\xb7\xb9\xc5"
-DATA ·d+36312(SB)/8,$"\x4b\xf1\x0b\xe3\x61\xf1\x4f\x88"
-DATA ·d+36320(SB)/8,$"\x9e\xe2\xd3\x45\x93\x4c\xf3\xa4"
-DATA ·d+36328(SB)/8,$"\x56\x51\x24\x8f\x2b\xc8\xad\xd1"
-DATA ·d+36336(SB)/8,$"\xd5\x48\xc1\x2d\xd7\x3d\x2b\x03"
-DATA ·d+36344(SB)/8,$"\x47\xf8\x83\x65\x1c\x57\x40\xdc"
-DATA ·d+36352(SB)/8,$"\x3b\xce\x00\x45\x68\xd4\x8d\xab"
-DATA ·d+36360(SB)/8,$"\xdc\x38\x55\x3f\x30\xc7\x63\x5e"
-DATA ·d+36368(SB)/8,$"\x46\x3f\x11\x81\x84\xad\x09\xb7"
-DATA ·d+36376(SB)/8,$"\xf2\x3d\xac\xb3\x25\xcc\x28\xc3"
-DATA ·d+36384(SB)/8,$"\xdb\x4e\x2e\xcd\x2f\x76\xe9\xdb"
-DATA ·d+36392(SB)/8,$"\xe7\x67\xfe\x66\x34\x9a\x86\x98"
-DATA ·d+36400(SB)/8,$"\x65\x30\x3d\xd2\x07\x52\x3e\x62"
-DATA ·d+36408(SB)/8,$"\xaf\x86\x63\x8e\x14\x10\x0e\x47"
-DATA ·d+36416(SB)/8,$"\x7c\xd5\xcb\x4b\xbb\x4b\x5b\x81"
-DATA ·d+36424(SB)/8,$"\x2d\xd1\xf3\x11\x89\x65\x50\x1b"
-DATA ·d+36432(SB)/8,$"\x93\xf8\x01\xc7\xe3\x5d\xe5\xb7"
-DATA ·d+36440(SB)/8,$"\x88\xfc\x15\x7f\x24\x77\xbe\x35"
-DATA ·d+36448(SB)/8,$"\xd4\x75\x1b\x97\x96\xcb\xca\x49"
-DATA ·d+36456(SB)/8,$"\xac\xac\x7a\xa8\xce\xcc\xc8\xe9"
-DATA ·d+36464(SB)/8,$"\xc0\x07\xef\xb2\x8c\xe3\xbd\x70"
-DATA ·d+36472(SB)/8,$"\x5e\x25\xd2\xb5\x2d\x2f\x4f\x2d"
-DATA ·d+36480(SB)/8,$"\xa2\xc1\x77\xf6\x26\x91\x44\x37"
-DATA ·d+36488(SB)/8,$"\x12\x23\x49\x24\xd8\xc1\x62\x1b"
-DATA ·d+36496(SB)/8,$"\xa0\x19\x3d\x17\xa0\xc6\x6b\xe6"
-DATA ·d+36504(SB)/8,$"\xea\x63\x23\x29\x3b\x2f\xc0\xf7"
-DATA ·d+36512(SB)/8,$"\x29\x35\x39\x2a\x55\x20\xe0\xdf"
-DATA ·d+36520(SB)/8,$"\x7d\x13\xf4\x6d\x2f\xc8\xeb\xc4"
-DATA ·d+36528(SB)/8,$"\xa3\x51\x49\x36\x08\x48\x8c\x60"
-DATA ·d+36536(SB)/8,$"\x18\xa7\x5f\xdd\x8c\x64\x02\x8a"
-DATA ·d+36544(SB)/8,$"\x3a\x9a\x92\xdf\x48\xd7\x2e\x71"
-DATA ·d+36552(SB)/8,$"\x71\x69\x34\xf2\x2c\x58\x0c\xa2"
-DATA ·d+36560(SB)/8,$"\x2f\x39\x10\x9e\x80\xee\x52\x06"
-DATA ·d+36568(SB)/8,$"\x7d\x73\xf4\xaf\x0f\x9f\x47\xd7"
-DATA ·d+36576(SB)/8,$"\xb5\x3d\x44\xdc\xf8\x75\x24\x92"
-DATA ·d+36584(SB)/8,$"\xd5\x04\x6f\x2c\x81\x33\x26\xe2"
-DATA ·d+36592(SB)/8,$"\x39\x4c\x02\x31\xcf\x4f\xce\xbe"
-DATA ·d+36600(SB)/8,$"\xa6\xd0\x34\xdd\x07\xec\x6f\x7d"
-DATA ·d+36608(SB)/8,$"\x7b\x7b\xab\x59\xcc\x51\x91\xb4"
-DATA ·d+36616(SB)/8,$"\xd8\x2d\x98\xdb\x89\x02\xaa\x46"
-DATA ·d+36624(SB)/8,$"\xd3\x4f\x21\xc3\x97\xcb\x16\xc2"
-DATA ·d+36632(SB)/8,$"\xe5\x40\xc6\x49\x35\x18\x7c\xda"
-DATA ·d+36640(SB)/8,$"\xad\x19\xa9\x42\x4d\x79\xae\x9e"
-DATA ·d+36648(SB)/8,$"\x5a\x1c\x79\xc6\x0b\xea\x90\xe7"
-DATA ·d+36656(SB)/8,$"\xa5\x34\xef\xd2\xd7\xb7\xaa\xd7"
-DATA ·d+36664(SB)/8,$"\xf2\x2e\xeb\x7f\x1a\xbf\x68\x1b"
-DATA ·d+36672(SB)/8,$"\xfb\xb5\xb8\xb9\xf1\x9f\x8b\x24"
-DATA ·d+36680(SB)/8,$"\x74\xb5\x8e\xca\x38\x13\x58\xbc"
-DATA ·d+36688(SB)/8,$"\x52\x21\x89\x76\xac\x68\x23\x98"
-DATA ·d+36696(SB)/8,$"\x7c\xa1\x78\xc1\xe7\xd4\x9a\xf3"
-DATA ·d+36704(SB)/8,$"\x7d\x43\xc4\x5a\x8e\xaa\xa2\x1d"
-DATA ·d+36712(SB)/8,$"\x7f\x51\xd5\xae\x5c\xe1\xde\x0d"
-DATA ·d+36720(SB)/8,$"\x42\xe6\x49\x71\x82\x71\x95\x79"
-DATA ·d+36728(SB)/8,$"\x1f\xf9\x71\x5c\xf7\x89\xb4\xdd"
-DATA ·d+36736(SB)/8,$"\x2a\xda\xe8\x4d\x02\x49\x6d\x70"
-DATA ·d+36744(SB)/8,$"\x8c\x59\x26\x55\x20\x15\x3d\x3b"
-DATA ·d+36752(SB)/8,$"\xe7\xe9\xcd\x73\x17\x97\xef\x0c"
-DATA ·d+36760(SB)/8,$"\xab\x3a\x62\xe2\x40\x39\x67\x3c"
-DATA ·d+36768(SB)/8,$"\x85\x19\xed\xce\x47\x35\xf6\x1f"
-DATA ·d+36776(SB)/8,$"\xeb\xe6\x65\x28\x28\x2a\xed\x5b"
-DATA ·d+36784(SB)/8,$"\xb6\x9f\x8d\xae\x7a\x14\x27\x56"
-DATA ·d+36792(SB)/8,$"\xb5\x38\xa7\x51\xea\xbd\x3f\xe0"
-DATA ·d+36800(SB)/8,$"\x1e\x94\x7c\x1f\x73\xaa\x5a\x49"
-DATA ·d+36808(SB)/8,$"\x79\xa2\xdb\x47\x0a\x2a\xee\xf9"
-DATA ·d+36816(SB)/8,$"\x12\x2d\xd8\xc7\x50\x48\x04\xe7"
-DATA ·d+36824(SB)/8,$"\xd8\x57\x01\x32\x5e\xdb\xc8\x6d"
-DATA ·d+36832(SB)/8,$"\xe5\x91\xb0\xa4\xf4\x71\x90\xbe"
-DATA ·d+36840(SB)/8,$"\xf8\xf7\xf6\xad\x6d\x2f\x5e\x5b"
-DATA ·d+36848(SB)/8,$"\x9b\x27\x9e\x56\xb5\x82\x40\xa5"
-DATA ·d+36856(SB)/8,$"\x60\x17\x0c\xfb\x9b\x8e\x59\x72"
-DATA ·d+36864(SB)/8,$"\x08\x07\x3c\x0d\xbf\x95\x66\x4b"
-DATA ·d+36872(SB)/8,$"\x35\xf5\xb4\x2b\x3e\x94\x22\xdd"
-DATA ·d+36880(SB)/8,$"\x8d\xd8\x61\xb5\xa4\x56\xfd\x33"
-DATA ·d+36888(SB)/8,$"\xf8\xe2\xbc\xfa\xdc\x53\x8d\xa8"
-DATA ·d+36896(SB)/8,$"\xfd\xea\xf2\xfb\xd9\x9e\x16\x96"
-DATA ·d+36904(SB)/8,$"\x22\x12\x9c\x6a\xca\xfd\x17\xfc"
-DATA ·d+36912(SB)/8,$"\x38\xd7\x8f\x4f\x78\x94\x4d\x74"
-DATA ·d+36920(SB)/8,$"\x81\x8e\x0b\x2e\xf2\xac\xdc\xf2"
-DATA ·d+36928(SB)/8,$"\x31\x8e\x83\xd6\xb1\xbc\x41\x0f"
-DATA ·d+36936(SB)/8,$"\x99\x2c\x69\xa5\x29\x2d\xb4\x79"
-DATA ·d+36944(SB)/8,$"\xa0\x16\x0c\x07\xae\x1b\xae\x7d"
-DATA ·d+36952(SB)/8,$"\x6a\xdc\x16\xfe\x23\xd6\x27\x12"
-DATA ·d+36960(SB)/8,$"\xbd\xad\xbd\xbb\x2f\xca\x1a\x44"
-DATA ·d+36968(SB)/8,$"\xf3\x24\xc7\x72\x14\x54\x13\x68"
-DATA ·d+36976(SB)/8,$"\x9e\x2a\xbb\x97\xec\x5a\x45\xc3"
-DATA ·d+36984(SB)/8,$"\x4d\x21\x56\x67\x90\x0d\x80\x2a"
-DATA ·d+36992(SB)/8,$"\xfd\xe0\x48\x1d\x07\xd6\xbc\xba"
-DATA ·d+37000(SB)/8,$"\x98\x87\x2b\xb9\xbb\xb6\x7b\x58"
-DATA ·d+37008(SB)/8,$"\x78\x42\x9c\x95\xe6\xae\xbe\xef"
-DATA ·d+37016(SB)/8,$"\xc1\x7e\xa5\x0b\x4d\x63\xc5\x1e"
-DATA ·d+37024(SB)/8,$"\x1b\x54\xbb\xd3\xa7\xbc\xb7\x8c"
-DATA ·d+37032(SB)/8,$"\x31\x06\x44\x62\x23\x1e\xcc\xa7"
-DATA ·d+37040(SB)/8,$"\xe5\x27\x1e\xd5\xea\xda\x07\xc4"
-DATA ·d+37048(SB)/8,$"\x0d\x6a\x18\x1d\xee\xcd\xb5\xc2"
-DATA ·d+37056(SB)/8,$"\xc8\x81\x65\x69\x22\xda\xc1\x49"
-DATA ·d+37064(SB)/8,$"\xcf\x05\x48\xe4\xfa\x81\x9a\xa5"
-DATA ·d+37072(SB)/8,$"\xe0\x6f\x87\x7e\x2f\xf1\x1e\x9e"
-DATA ·d+37080(SB)/8,$"\x60\x8a\xea\x19\xe0\x66\x60\xf6"
-DATA ·d+37088(SB)/8,$"\xea\xe2\x43\xbe\x31\xc9\x20\x8c"
-DATA ·d+37096(SB)/8,$"\xf0\x3b\x8a\x5b\xef\x0a\x9b\x69"
-DATA ·d+37104(SB)/8,$"\xd2\xcc\x4c\x1d\x98\x88\xc0\x0c"
-DATA ·d+37112(SB)/8,$"\x3b\x77\x39\xc6\x05\x7a\x58\x0a"
-DATA ·d+37120(SB)/8,$"\x93\xb7\x32\x96\x9d\x8f\x17\x34"
-DATA ·d+37128(SB)/8,$"\xc6\x60\x2b\xd4\x96\x1e\x4a\x2e"
-DATA ·d+37136(SB)/8,$"\x6b\x76\x97\x4f\x3e\xbf\x89\xdf"
-DATA ·d+37144(SB)/8,$"\xdd\xf8\xe2\xc7\x51\xd1\xce\x9d"
-DATA ·d+37152(SB)/8,$"\x32\x2c\x6f\x82\xd0\xaa\x26\xe2"
-DATA ·d+37160(SB)/8,$"\xce\x71\xf7\x98\xfc\xc4\x63\xdf"
-DATA ·d+37168(SB)/8,$"\x8a\xf1\xed\x53\x49\xbe\x92\x61"
-DATA ·d+37176(SB)/8,$"\x34\x08\xc0\x33\x10\x3e\x56\xdc"
-DATA ·d+37184(SB)/8,$"\x46\x78\x3c\x7a\xd6\xc5\xf7\x84"
-DATA ·d+37192(SB)/8,$"\x75\x81\x7d\x1d\x8c\x04\x49\x3b"
-DATA ·d+37200(SB)/8,$"\x30\x48\x9e\xd5\xb7\x64\x7f\x0f"
-DATA ·d+37208(SB)/8,$"\xca\x48\x38\x6f\x7f\xc2\xb0\xae"
-DATA ·d+37216(SB)/8,$"\x6e\xfc\x4b\xa7\xdc\x1b\x4d\x56"
-DATA ·d+37224(SB)/8,$"\x69\xa1\x68\x48\x53\x1b\xfc\xa7"
-DATA ·d+37232(SB)/8,$"\x00\xf7\xaf\x7a\xbe\x6f\x2a\x96"
-DATA ·d+37240(SB)/8,$"\x55\x0e\x2c\xaf\x01\x46\x8d\xfe"
-DATA ·d+37248(SB)/8,$"\xab\x34\xef\xad\x99\xbf\xa6\x7c"
-DATA ·d+37256(SB)/8,$"\x24\xf1\x8e\xa2\x56\xea\xc3\xe5"
-DATA ·d+37264(SB)/8,$"\x05\x77\xaf\x9c\xea\x99\x1e\xc0"
-DATA ·d+37272(SB)/8,$"\xb1\x35\xb9\x0f\x83\x2d\xf4\xcc"
-DATA ·d+37280(SB)/8,$"\x5b\x78\xbd\xfe\xcf\xb0\x12\xe6"
-DATA ·d+37288(SB)/8,$"\x20\x19\xcf\xa7\x27\x8e\x4d\x79"
-DATA ·d+37296(SB)/8,$"\x6d\xd4\xf6\xe7\x37\xbf\x6d\xbc"
-DATA ·d+37304(SB)/8,$"\x59\x72\x30\xb1\x93\xb7\xf3\xdd"
-DATA ·d+37312(SB)/8,$"\xd3\xf0\xad\x5d\x27\xc6\xd3\xdb"
-DATA ·d+37320(SB)/8,$"\x47\xe5\x5d\xda\xdb\xde\x21\xca"
-DATA ·d+37328(SB)/8,$"\x83\x3e\x59\xb3\xdb\x1c\xf1\xf5"
-DATA ·d+37336(SB)/8,$"\xaa\xa3\x8f\xa1\x0d\x6c\xfe\xff"
-DATA ·d+37344(SB)/8,$"\x00\xd2\xc7\x6e\x27\x03\x8f\xa9"
-DATA ·d+37352(SB)/8,$"\x1b\xaa\x89\xa5\xd1\x47\x95\xaf"
-DATA ·d+37360(SB)/8,$"\x46\xdb\xbf\xdc\x5d\x16\xda\x0c"
-DATA ·d+37368(SB)/8,$"\x97\xc6\x14\xb8\x31\x45\x93\x87"
-DATA ·d+37376(SB)/8,$"\x32\xc6\xc3\x1f\xee\x80\x0f\x34"
-DATA ·d+37384(SB)/8,$"\xdd\x47\x4b\xf0\x51\x90\x98\x67"
-DATA ·d+37392(SB)/8,$"\x3f\x78\x03\x20\x4d\x9c\x8e\xe0"
-DATA ·d+37400(SB)/8,$"\x73\xf9\x56\x49\xf1\x06\x9b\x3c"
-DATA ·d+37408(SB)/8,$"\xc5\xbe\xc7\x6e\x39\x3c\x29\x03"
-DATA ·d+37416(SB)/8,$"\xf5\x03\x3f\xad\x63\x5d\xea\x50"
-DATA ·d+37424(SB)/8,$"\x79\xe1\xe2\x5f\x29\x0b\xf2\xb9"
-DATA ·d+37432(SB)/8,$"\xdd\xc7\xa7\xbd\x69\x4f\x31\x82"
-DATA ·d+37440(SB)/8,$"\x4d\x59\x3b\xf7\x3b\xe1\x84\xad"
-DATA ·d+37448(SB)/8,$"\x29\x26\xdc\xa3\x63\x4e\x6b\xb6"
-DATA ·d+37456(SB)/8,$"\xb5\x56\x86\xca\xe2\x6d\x8b\xd1"
-DATA ·d+37464(SB)/8,$"\x81\x3c\xfb\x63\xae\x2b\x8c\xb8"
-DATA ·d+37472(SB)/8,$"\xd6\x3c\x48\x4b\xac\x1a\x84\x91"
-DATA ·d+37480(SB)/8,$"\xa0\xec\x5b\x6f\xe1\x91\xcd\x74"
-DATA ·d+37488(SB)/8,$"\x37\x1b\x01\xf3\x6c\xd8\x3c\x67"
-DATA ·d+37496(SB)/8,$"\xee\xb0\xfe\x13\xe8\x47\x38\xae"
-DATA ·d+37504(SB)/8,$"\x56\xe6\xe5\xa7\x9d\xa1\x9d\x0e"
-DATA ·d+37512(SB)/8,$"\x32\x46\xe0\xbf\x37\xe5\xdc\x56"
-DATA ·d+37520(SB)/8,$"\x55\x71\x72\x6a\xca\x4d\x1e\xe6"
-DATA ·d+37528(SB)/8,$"\x5d\x45\x26\xdc\xa0\x9f\xaa\x46"
-DATA ·d+37536(SB)/8,$"\x6d\x9c\x9a\x84\x93\x89\x64\xba"
-DATA ·d+37544(SB)/8,$"\x90\x9c\xe7\x87\x27\x9f\xce\xbd"
-DATA ·d+37552(SB)/8,$"\x13\x4e\xbc\xb5\xbf\x21\x35\x1b"
-DATA ·d+37560(SB)/8,$"\x99\x14\xf6\x0c\x77\x01\xed\xcd"
-DATA ·d+37568(SB)/8,$"\x72\x83\xc1\x9a\xd3\xa7\xdb\x6c"
-DATA ·d+37576(SB)/8,$"\xed\xee\x8c\x58\xc8\x91\x51\xb1"
-DATA ·d+37584(SB)/8,$"\xf8\x56\x3d\xcd\x9e\xb7\x6a\xb9"
-DATA ·d+37592(SB)/8,$"\x42\xec\x07\x50\xeb\x83\x58\xe1"
-DATA ·d+37600(SB)/8,$"\xb1\x75\x28\x3b\xb8\x5d\x3f\x53"
-DATA ·d+37608(SB)/8,$"\xd2\xc5\xd3\xa3\x89\x5c\xb0\xa8"
-DATA ·d+37616(SB)/8,$"\x93\x5e\x87\xb5\xc9\xe1\xbb\x51"
-DATA ·d+37624(SB)/8,$"\x6e\x5d\x25\x28\x31\x90\xf2\xed"
-DATA ·d+37632(SB)/8,$"\x54\x20\x7b\x9c\x7a\xd6\x6c\x7e"
-DATA ·d+37640(SB)/8,$"\x19\xbf\x32\x96\xd2\x6f\x95\x86"
-DATA ·d+37648(SB)/8,$"\x3e\x7f\xb2\xb3\xca\xab\x9e\xcd"
-DATA ·d+37656(SB)/8,$"\xb0\x30\x1f\x43\x5e\x33\x26\xbf"
-DATA ·d+37664(SB)/8,$"\xae\xb5\xb8\xb4\x79\x41\x55\x39"
-DATA ·d+37672(SB)/8,$"\x58\xe5\x50\xe0\x1e\x99\xc3\x67"
-DATA ·d+37680(SB)/8,$"\xf4\xad\xbd\x03\xc7\x9a\xde\x95"
-DATA ·d+37688(SB)/8,$"\x75\xbe\x18\x6d\x63\x93\x1b\x44"
-DATA ·d+37696(SB)/8,$"\xb0\x46\xb1\xb1\x1e\x87\x68\xae"
-DATA ·d+37704(SB)/8,$"\xaf\xed\xca\x52\xaa\x92\x87\x2a"
-DATA ·d+37712(SB)/8,$"\xf9\xdc\xf3\xdf\x0e\xe2\xe9\x52"
-DATA ·d+37720(SB)/8,$"\x73\x55\x14\x9f\x6d\x0f\x5e\xbc"
-DATA ·d+37728(SB)/8,$"\xf0\x7c\x76\xfa\x7f\xdb\xf5\x78"
-DATA ·d+37736(SB)/8,$"\xda\x41\xd1\x4d\xba\x8d\xce\x7e"
-DATA ·d+37744(SB)/8,$"\x8c\x45\x79\xe4\x90\x78\x7e\x39"
-DATA ·d+37752(SB)/8,$"\x70\xa2\xee\x3c\xf1\xb5\xe2\x57"
-DATA ·d+37760(SB)/8,$"\x23\xea\x11\x89\xaa\xda\x8f\x89"
-DATA ·d+37768(SB)/8,$"\xb5\x0d\x5b\x22\xe6\xe6\xe5\x77"
-DATA ·d+37776(SB)/8,$"\x72\x54\x96\xda\x4f\xd3\xa5\x73"
-DATA ·d+37784(SB)/8,$"\x6d\xa6\x09\x9b\x31\x3e\xf6\xc6"
-DATA ·d+37792(SB)/8,$"\x79\xc8\x3f\x85\x5e\x61\x8e\x84"
-DATA ·d+37800(SB)/8,$"\xe4\xbe\xaf\x1f\xbc\x79\x76\x0a"
-DATA ·d+37808(SB)/8,$"\xb4\x60\xd6\x22\xa7\xdd\xa2\x5f"
-DATA ·d+37816(SB)/8,$"\xa1\xec\x1a\x04\x6a\xb2\x6f\xd1"
-DATA ·d+37824(SB)/8,$"\xde\x19\xdf\x1f\x71\x4b\x24\xa0"
-DATA ·d+37832(SB)/8,$"\x7f\xba\x70\x6b\xad\xbc\xd6\xe4"
-DATA ·d+37840(SB)/8,$"\xb3\x50\x9a\xbc\x32\xc5\x9e\x70"
-DATA ·d+37848(SB)/8,$"\xd2\xaa\x13\x8f\x4d\xe4\x66\xbc"
-DATA ·d+37856(SB)/8,$"\x0e\xda\x2d\x72\xc9\x73\xb6\x75"
-DATA ·d+37864(SB)/8,$"\x00\xfc\xa4\x16\xe0\x56\x9d\xc4"
-DATA ·d+37872(SB)/8,$"\x3a\xbe\xa6\xa3\x2d\x70\xce\x3b"
-DATA ·d+37880(SB)/8,$"\x4a\x4b\x7f\x3e\x6b\xd2\xc3\x67"
-DATA ·d+37888(SB)/8,$"\x53\x8d\x2e\x55\x0f\x7b\xf0\xfe"
-DATA ·d+37896(SB)/8,$"\xbe\x67\x06\x2b\x22\xa7\x3a\xbc"
-DATA ·d+37904(SB)/8,$"\xd3\xa9\xee\xfe\x3f\x83\x4b\xf0"
-DATA ·d+37912(SB)/8,$"\x3b\x0d\x7d\x34\x2b\xe8\x0d\xef"
-DATA ·d+37920(SB)/8,$"\xdb\x6d\x20\xdc\x48\xdb\x3c\xaa"
-DATA ·d+37928(SB)/8,$"\x5b\x27\xfd\xd2\x73\xf5\xe9\x5c"
-DATA ·d+37936(SB)/8,$"\x28\xf0\xbc\x2c\x37\x0d\x53\x4d"
-DATA ·d+37944(SB)/8,$"\x20\xf2\x0f\xda\x40\xac\xd9\x25"
-DATA ·d+37952(SB)/8,$"\xd7\x74\xfc\xda\xcf\x6d\x6f\x22"
-DATA ·d+37960(SB)/8,$"\x1f\xf9\xed\x1a\xb1\xc7\xe5\x54"
-DATA ·d+37968(SB)/8,$"\x4c\x30\x13\x9f\xec\xfb\x7e\x7d"
-DATA ·d+37976(SB)/8,$"\x01\xff\x00\x1a\xf1\xf1\x99\xad"
-DATA ·d+37984(SB)/8,$"\x59\xce\xee\x9a\xff\x00\xc9\x8f"
-DATA ·d+37992(SB)/8,$"\x73\x05\x80\x74\xa1\xcb\x0a\xba"
-DATA ·d+38000(SB)/8,$"\x7f\xdb\xa7\xff\xd4\xfc\x51\xd6"
-DATA ·d+38008(SB)/8,$"\xae\x3f\x68\x20\x61\xba\xf1\x2d"
-DATA ·d+38016(SB)/8,$"\xbd\x9d\x8a\xc9\xb6\xdd\x24\xf2"
-DATA ·d+38024(SB)/8,$"\x25\x90\x14\x5c\x90\xa2\x46\x0e"
-DATA ·d+38032(SB)/8,$"\x58\x7a\x01\x9c\x7a\x0a\xe1\x6e"
-DATA ·d+38040(SB)/8,$"\xb4\x5f\x8a\xf7\xcd\x39\x5d\x58"
-DATA ·d+38048(SB)/8,$"\x4a\x81\x4b\x2b\x04\x96\x1c\xfb"
-DATA ·d+38056(SB)/8,$"\x2a\x98\xc3\x64\x77\xaf\x72\x4f"
-DATA ·d+38064(SB)/8,$"\x13\xdc\x69\x77\x2b\xa3\xdc\x44"
-DATA ·d+38072(SB)/8,$"\x6d\x8c\x6e\xb3\xad\xa4\x8c\x67"
-DATA ·d+38080(SB)/8,$"\x0f\x22\x02\x18\xb4\x87\x70\x04"
-DATA ·d+38088(SB)/8,$"\x67\x8c\x61\x73\xc9\xce\x05\x60"
-DATA ·d+38096(SB)/8,$"\x5f\xfc\x54\x11\x4e\x62\x28\x6d"
-DATA ·d+38104(SB)/8,$"\x97\x1e\x64\x70\x87\xda\x19\x89"
-DATA ·d+38112(SB)/8,$"\xc1\x1b\xce\xe2\xc4\xfd\x71\xed"
-DATA ·d+38120(SB)/8,$"\x5f\xbc\xe0\xb2\xc9\x36\xa3\x3a"
-DATA ·d+38128(SB)/8,$"\xae\x9a\xbd\x97\xf9\xd9\x1f\xe4"
-DATA ·d+38136(SB)/8,$"\x64\xb3\x8c\x55\xf9\xa8\xe1\xa0"
-DATA ·d+38144(SB)/8,$"\xdb\x49\xdf\x95\x7d\xda\xdf\xef"
-DATA ·d+38152(SB)/8,$"\xb9\xe6\x5e\x1c\xd3\x3c\x79\xa4"
-DATA ·d+38160(SB)/8,$"\xe9\xdb\x9a\x5d\x5b\x54\xb8\xef"
-DATA ·d+38168(SB)/8,$"\x04\x71\x31\x89\x55\xf2\x33\x99"
-DATA ·d+38176(SB)/8,$"\x14\x10\x78\xe2\xaa\xdd\x68\x3e"
-DATA ·d+38184(SB)/8,$"\x32\xbc\xf1\x1c\x1a\xad\xd5\xad"
-DATA ·d+38192(SB)/8,$"\xad\x90\xb7\xd9\x88\x66\x91\x5f"
-DATA ·d+38200(SB)/8,$"\x7e\x06\x73\x20\xe7\x2e\x73\xce"
-DATA ·d+38208(SB)/8,$"\x40\xf4\xaf\x7b\xd3\x35\x15\xf1"
-DATA ·d+38216(SB)/8,$"\x30\xb7\xbd\xd4\x6d\xb7\xdb\xf9"
-DATA ·d+38224(SB)/8,$"\xcd\x0d\xc2\xad\xdf\x96\xa5\x40"
-DATA ·d+38232(SB)/8,$"\x27\x86\x0c\xbf\x32\x8e\x40\xc6"
-DATA ·d+38240(SB)/8,$"\x09\xe3\xbd\x79\xb5\xcd\x9c\x1a"
-DATA ·d+38248(SB)/8,$"\x87\x89\x25\xb0\xbc\xbc\x96\x1b"
-DATA ·d+38256(SB)/8,$"\x7b\x86\x22\x26\xba\x89\xe6\xf3"
-DATA ·d+38264(SB)/8,$"\x80\x19\x59\x15\x20\xcb\x31\xc2"
-DATA ·d+38272(SB)/8,$"\xf5\x1d\x86\x7d\x6b\xdb\xc4\xf0"
-DATA ·d+38280(SB)/8,$"\xfd\x2c\x35\xe7\x52\xb7\x3a\xf5"
-DATA ·d+38288(SB)/8,$"\x7b\x7c\xc7\x82\xce\x6b\x54\xa9"
-DATA ·d+38296(SB)/8,$"\x37\xec\xd4\x64\xef\x7d\x2f\x6e"
-DATA ·d+38304(SB)/8,$"\xe9\x24\xd2\xfc\x2e\x6d\xc3\xf0"
-DATA ·d+38312(SB)/8,$"\xd3\x5f\xf1\x0c\x3e\x7c\x90\x5b"
-DATA ·d+38320(SB)/8,$"\x3a\xdd\xb0\x68\x44\x31\x18\xc2"
-DATA ·d+38328(SB)/8,$"\x94\xe4\xaa\x36\xe2\xaa\x0f\x71"
-DATA ·d+38336(SB)/8,$"\x8e\x6b\xa1\x5f\x84\xde\x29\xd0"
-DATA ·d+38344(SB)/8,$"\x6c\x65\xbc\x96\x4b\x4b\x48\x66"
-DATA ·d+38352(SB)/8,$"\x8f\xec\xd1\xdd\xad\xd2\x03\x09"
-DATA ·d+38360(SB)/8,$"\x07\x39\x51\x82\xc0\x9e\x84\xe3"
-DATA ·d+38368(SB)/8,$"\x9a\xf1\xd9\x75\x2d\x1a\xdb\x52"
-DATA ·d+38376(SB)/8,$"\x78\xac\xef\x96\xe2\x36\x6d\x82"
-DATA ·d+38384(SB)/8,$"\xf3\x4d\x92\x45\x56\x38\xe9\xe5"
-DATA ·d+38392(SB)/8,$"\xc8\x15\xb8\xf4\xaa\xd2\x5f\x69"
-DATA ·d+38400(SB)/8,$"\xf2\x5d\xc5\x77\xe6\x35\xcc\x71"
-DATA ·d+38408(SB)/8,$"\xa1\x8d\xca\xa1\x66\x2f\x83\xb5"
-DATA ·d+38416(SB)/8,$"\xa4\x50\x4f\x72\x37\x64\x8c\xf4"
-DATA ·d+38424(SB)/8,$"\xc5\x78\xb2\xc7\x53\xc3\xd5\xbf"
-DATA ·d+38432(SB)/8,$"\xd5\xdb\x8f\xf8\x99\x75\xb0\x58"
-DATA ·d+38440(SB)/8,$"\x99\x47\x97\x9e\xcf\xd3\x4f\xeb"
-DATA ·d+38448(SB)/8,$"\xd4\xf5\x4d\x5f\x52\x7b\x0b\xa6"
-DATA ·d+38456(SB)/8,$"\xcc\x29\x78\xcc\x4b\x34\xd0\x5c"
-DATA ·d+38464(SB)/8,$"\xb2\x79\x85\x71\x9d\xa1\x36\x2f"
-DATA ·d+38472(SB)/8,$"\xbf\xaf\x73\x9a\xb3\xa3\xf8\xf7"
-DATA ·d+38480(SB)/8,$"\xc3\xd6\xb0\x48\x2d\xac\x03\x5c"
-DATA ·d+38488(SB)/8,$"\x18\x9e\x19\xe5\xbe\x43\x76\x90"
-DATA ·d+38496(SB)/8,$"\x06\xe3\x74\x4a\xdf\x28\x90\x7f"
-DATA ·d+38504(SB)/8,$"\x0b\xb6\x48\xed\x5e\x3d\xfd\xa8"
-DATA ·d+38512(SB)/8,$"\xba\x16\xa2\xb2\x4f\x11\x8e\xe4"
-DATA ·d+38520(SB)/8,$"\x43\x19\x02\xfa\xdf\xc9\xb7\x69"
-DATA ·d+38528(SB)/8,$"\x08\xfd\xe1\x55\x7c\x91\xc7\xdd"
-DATA ·d+38536(SB)/8,$"\xc1\xc1\x35\x43\x52\xd7\x96\xda"
-DATA ·d+38544(SB)/8,$"\xd2\x4d\x42\x14\xb2\x88\xc8\xd9"
-DATA ·d+38552(SB)/8,$"\x68\xa0\x97\x6b\xb8\xc6\x43\x32"
-DATA ·d+38560(SB)/8,$"\x82\xcb\xd7\x9c\x13\xf8\x52\xa9"
-DATA ·d+38568(SB)/8,$"\x9c\xe1\xeb\x5a\x37\x94\x57\x66"
-DATA ·d+38576(SB)/8,$"\xdb\x46\x70\xe1\xa6\xda\x8b\x57"
-DATA ·d+38584(SB)/8,$"\x7a\x59\xa7\x6b\xfc\xb6\x3d\xae"
-DATA ·d+38592(SB)/8,$"\xdb\x54\x87\xc6\x56\xf1\xe8\x52"
-DATA ·d+38600(SB)/8,$"\xeb\x8f\x6d\x6f\x18\xc4\x36\xd7"
-DATA ·d+38608(SB)/8,$"\x17\x06\x18\x72\x3b\xec\xd8\xca"
-DATA ·d+38616(SB)/8,$"\x5b\x8e\xad\xd6\xb8\x8f\x1e\xf8"
-DATA ·d+38624(SB)/8,$"\x0b\x53\xb7\xb1\x87\x7d\xda\x4f"
-DATA ·d+38632(SB)/8,$"\x04\x4f\x21\x85\x6d\xe7\x85\xc1"
-DATA ·d+38640(SB)/8,$"\x24\xfc\xcc\x59\x49\xe4\xfb\x81"
-DATA ·d+38648(SB)/8,$"\xc7\xad\x72\x3a\x46\x87\xa9\xea"
-DATA ·d+38656(SB)/8,$"\x5a\x50\xbb\xbe\x86\xd2\x49\x26"
-DATA ·d+38664(SB)/8,$"\xdb\x2d\xac\xd0\xdd\xf9\x33\xa2"
-DATA ·d+38672(SB)/8,$"\xf5\x21\xb6\x29\xc8\x3e\x8d\x9c"
-DATA ·d+38680(SB)/8,$"\x7e\x34\xb7\xf6\xba\xdd\xa5\xba"
-DATA ·d+38688(SB)/8,$"\xfd\x92\xdd\x66\x9c\x65\x4a\x5c"
-DATA ·d+38696(SB)/8,$"\x49\x1b\x92\x33\xfd\xf2\x32\x6b"
-DATA ·d+38704(SB)/8,$"\xde\xc1\xe3\x6e\x92\x58\x6f\x75"
-DATA ·d+38712(SB)/8,$"\x75\x5f\x99\xd7\x47\x2f\x54\x2b"
-DATA ·d+38720(SB)/8,$"\xc7\xd8\xd7\x5a\x3d\x9d\xbf\x33"
-DATA ·d+38728(SB)/8,$"\xca\xf5\xbb\x9f\x12\x69\xbe\x55"
-DATA ·d+38736(SB)/8,$"\xb4\x6b\x34\x66\xdc\x6e\x8c\x39"
-DATA ·d+38744(SB)/8,$"\xc8\x1c\xe7\x25\xb3\xcf\xb7\x3f"
-DATA ·d+38752(SB)/8,$"\x4a\xb3\xa4\x6a\x5a\x95\xfc\x13"
-DATA ·d+38760(SB)/8,$"\x80\xe2\x7b\x99\xd4\x99\xd1\x3e"
-DATA ·d+38768(SB)/8,$"\x7c\x44\x31\x97\xf9\xfe\xe9\x1c"
-DATA ·d+38776(SB)/8,$"\xf4\x35\xb2\xfe\x2b\xd4\xec\xef"
-DATA ·d+38784(SB)/8,$"\x26\x6f\x11\xdc\x2c\x2b\xe5\x35"
-DATA ·d+38792(SB)/8,$"\xbb\x5b\xc7\x0a\x49\x12\x2b\x0c"
-DATA ·d+38800(SB)/8,$"\x6d\xe4\x1e\xdc\xe7\xb1\xe9\x5e"
-DATA ·d+38808(SB)/8,$"\x6d\xa9\x5c\x68\x26\xe4\xdc\xc3"
-DATA ·d+38816(SB)/8,$"\x7d\x34\xa8\xc0\x02\x91\x0d\xb9"
-DATA ·d+38824(SB)/8,$"\x00\x60\x03\xcf\xf4\xae\xe9\x65"
-DATA ·d+38832(SB)/8,$"\xb5\xab\xc6\xee\x6a\xde\x72\x5f"
-DATA ·d+38840(SB)/8,$"\x96\xe7\xe8\xd8\x3c\x3b\xa9\x1e"
-DATA ·d+38848(SB)/8,$"\x49\x52\x57\xde\xe9\x36\x9f\x97"
-DATA ·d+38856(SB)/8,$"\xf4\xce\xe6\x79\xaf\x35\x3d\x26"
-DATA ·d+38864(SB)/8,$"\xe6\x7d\x36\x18\x64\x68\x20\x42"
-DATA ·d+38872(SB)/8,$"\x2e\xad\xe6\x16\xd7\x70\x04\x27"
-DATA ·d+38880(SB)/8,$"\x12\x05\x91\x80\x65\x3c\x6f\xdb"
-DATA ·d+38888(SB)/8,$"\xcf\xe3\x5c\x75\xa6\xaf\xa9\xb4"
-DATA ·d+38896(SB)/8,$"\x72\x49\x77\xab\x43\x22\xc5\x18"
-DATA ·d+38904(SB)/8,$"\x05\x2e\xe7\xd9\x23\x96\xe3\x2a"
-DATA ·d+38912(SB)/8,$"\xab\xbf\xcc\x23\xd3\xb0\xae\x33"
-DATA ·d+38920(SB)/8,$"\x54\xf1\x3e\x89\xf6\x51\x6f\xa7"
-DATA ·d+38928(SB)/8,$"\xc6\x6d\xa4\x56\xcb\xdc\x4f\x1a"
-DATA ·d+38936(SB)/8,$"\x4d\x91\xee\xac\x47\xe6\x0d\x71"
-DATA ·d+38944(SB)/8,$"\xf7\x1a\xf4\xd2\x11\xe4\xea\x7a"
-DATA ·d+38952(SB)/8,$"\x73\x0f\xee\xcb\xa7\xb0\xfd\x63"
-DATA ·d+38960(SB)/8,$"\x90\x57\x95\x89\xc9\xe9\xd1\x9d"
-DATA ·d+38968(SB)/8,$"\xa3\x59\xbf\x46\xad\xf8\xb4\xff"
-DATA ·d+38976(SB)/8,$"\x00\x03\xec\x72\xde\x1c\xe7\x83"
-DATA ·d+38984(SB)/8,$"\x8c\xa0\x96\xbd\x53\x6f\xef\x49"
-DATA ·d+38992(SB)/8,$"\xd8\xfa\x23\xc3\x3e\x23\xf0\x9a"
-DATA ·d+39000(SB)/8,$"\xdf\x5b\x2f\x8c\x6f\xa2\xba\xd3"
-DATA ·d+39008(SB)/8,$"\xe3\x95\x1a\xe6\xde\x18\x25\xf3"
-DATA ·d+39016(SB)/8,$"\xde\x25\x20\xb2\x47\x2e\xd1\xb1"
-DATA ·d+39024(SB)/8,$"\x88\xc8\x0d\xd0\x1a\xe8\x75\x1f"
-DATA ·d+39032(SB)/8,$"\x8c\x3e\x03\xb5\x9e\xf6\x2f\x0e"
-DATA ·d+39040(SB)/8,$"\x68\x52\x41\x68\xf2\x96\xd3\xc4"
-DATA ·d+39048(SB)/8,$"\xfa\x8d\xc4\x92\xc1\x18\x39\x50"
-DATA ·d+39056(SB)/8,$"\xcc\x46\x58\x9e\xa7\x27\xad\x7c"
-DATA ·d+39064(SB)/8,$"\xb6\xbe\x25\xb2\x81\x00\x78\xec"
-DATA ·d+39072(SB)/8,$"\xa5\x7e\xec\x82\x78\x81\xfc\x0e"
-DATA ·d+39080(SB)/8,$"\xef\xe7\x5a\xda\x6f\x8a\xbc\x2a"
-DATA ·d+39088(SB)/8,$"\xee\x5f\x58\xb5\x01\x76\xf0\x2d"
-DATA ·d+39096(SB)/8,$"\x5d\xdc\xe7\xe8\xe1\x47\xeb\x5d"
-DATA ·d+39104(SB)/8,$"\x98\x6a\x78\x59\xce\x2a\xa5\x5b"
-DATA ·d+39112(SB)/8,$"\xdb\xf9\xb6\xfb\xd5\xcc\x71\x7c"
-DATA ·d+39120(SB)/8,$"\x15\x0d\x65\x38\x39\x2e\xc9\xdb"
-DATA ·d+39128(SB)/8,$"\xaf\x65\x6d\x4f\x63\xf1\x2f\xc5"
-DATA ·d+39136(SB)/8,$"\x74\xf1\x7f\xd9\xe7\xd6\x62\xb0"
-DATA ·d+39144(SB)/8,$"\x92\xe2\xd6\x04\xb5\x8a\x75\xf3"
-DATA ·d+39152(SB)/8,$"\x03\x79\x2b\x92\x15\x82\x90\xa7"
-DATA ·d+39160(SB)/8,$"\x00\xe3\x3d\x4d\x79\xf4\xde\x31"
-DATA ·d+39168(SB)/8,$"\xbe\x8b\xe5\xd1\xec\xfc\xd8\x7a"
-DATA ·d+39176(SB)/8,$"\x49\x1d\xba\x32\x21\xf6\x3b\x79"
-DATA ·d+39184(SB)/8,$"\x3f\x9d\x73\x57\xfe\x22\xf0\x0c"
-DATA ·d+39192(SB)/8,$"\xe3\x6d\xad\x95\xea\x36\x0e\x4e"
-DATA ·d+39200(SB)/8,$"\xf0\x3f\x43\x9a\xe3\x13\x59\xd5"
-DATA ·d+39208(SB)/8,$"\x6d\x91\xa5\xb0\x77\x85\x77\x7c"
-DATA ·d+39216(SB)/8,$"\x86\x37\xc3\xfd\x7e\xbe\xe2\xb9"
-DATA ·d+39224(SB)/8,$"\x33\xdc\x3e\x1d\x45\xc2\x8f\x2b"
-DATA ·d+39232(SB)/8,$"\xf4\xbf\xfc\x03\xd2\xca\xb8\x62"
-DATA ·d+39240(SB)/8,$"\x9a\x82\x8c\x69\x38\xa5\xd2\x5b"
-DATA ·d+39248(SB)/8,$"\x7e\x0c\xf7\x8b\x5d\x6a\x59\x20"
-DATA ·d+39256(SB)/8,$"\x10\x2e\x93\x34\xaa\xdb\x42\x88"
-DATA ·d+39264(SB)/8,$"\x00\x8d\x81\x63\xf7\x47\x27\xf0"
-DATA ·d+39272(SB)/8,$"\xe3\xad\x7b\x6c\xbe\x3c\xd4\x74"
-DATA ·d+39280(SB)/8,$"\xbf\x87\xab\xe0\x0b\x4b\x5d\x53"
-DATA ·d+39288(SB)/8,$"\x4d\xd4\x34\xfb\x99\xee\xc9\xbd"
-DATA ·d+39296(SB)/8,$"\x91\x59\xfc\x89\x13\xf7\x71\xf9"
-DATA ·d+39304(SB)/8,$"\x5b\x00\xdd\xbb\xf8\x95\xb2\xd9"
-DATA ·d+39312(SB)/8,$"\x1c\x71\x5f\x16\x5b\x78\xcb\xc4"
-DATA ·d+39320(SB)/8,$"\xb6\x6d\x11\x7b\xeb\x88\x98\x9c"
-DATA ·d+39328(SB)/8,$"\x00\x30\x58\xee\xfa\x83\x8c\xfa"
-DATA ·d+39336(SB)/8,$"\xd7\x69\xe1\x8d\x3f\x51\xf1\x65"
-DATA ·d+39344(SB)/8,$"\xf1\x92\xf7\x58\x1a\x7c\xad\x30"
-DATA ·d+39352(SB)/8,$"\x3e\x7d\xd4\x8e\x5c\xb6\x7e\xfe"
-DATA ·d+39360(SB)/8,$"\x79\xe9\xd4\xe4\xd7\xc0\xce\x95"
-DATA ·d+39368(SB)/8,$"\x77\x67\x2b\x24\x8e\x0c\xdb\x85"
-DATA ·d+39376(SB)/8,$"\x69\x28\xf3\xe2\x6d\xcb\x17\x75"
-DATA ·d+39384(SB)/8,$"\xbb\xd5\x79\x6b\xf8\x1d\x6c\x73"
-DATA ·d+39392(SB)/8,$"\x5f\x5c\x2c\xf7\xd7\xcf\x04\x32"
-DATA ·d+39400(SB)/8,$"\x04\x44\x78\xb9\xde\x19\xb0\x32"
-DATA ·d+39408(SB)/8,$"\xb1\xe5\x4e\x7f\xbd\xe9\x51\xeb"
-DATA ·d+39416(SB)/8,$"\x1a\xcd\xe2\x5b\xa6\x9c\xc2\x68"
-DATA ·d+39424(SB)/8,$"\x41\xc3\x3a\xbc\x92\x62\x5e\x78"
-DATA ·d+39432(SB)/8,$"\x7d\x8c\x40\xe4\x74\xc0\xfc\x69"
-DATA ·d+39440(SB)/8,$"\x9e\x37\xf0\xb7\xfc\x23\x57\xf6"
-DATA ·d+39448(SB)/8,$"\xf7\x7a\x67\x89\xe0\xd7\xae\x98"
-DATA ·d+39456(SB)/8,$"\x79\x93\x33\x40\xe8\x23\x70\x78"
-DATA ·d+39464(SB)/8,$"\x5d\xf2\x6e\x59\x38\xee\x38\xaf"
-DATA ·d+39472(SB)/8,$"\x64\xb1\xf0\xe7\x8e\x7c\x49\xe1"
-DATA ·d+39480(SB)/8,$"\x4b\x6d\x77\xc5\x5a\x1d\xc5\xd6"
-DATA ·d+39488(SB)/8,$"\x9d\xa7\xc6\xa2\xf1\xe0\xdb\xe6"
-DATA ·d+39496(SB)/8,$"\xc1\xe6\x31\x48\x80\x12\x98\xca"
-DATA ·d+39504(SB)/8,$"\x6f\xc6\x42\x2b\x11\x8e\x40\xae"
-DATA ·d+39512(SB)/8,$"\x5a\xb5\x7d\x9c\x3d\xa3\x76\xbf"
-DATA ·d+39520(SB)/8,$"\x75\xd7\xe6\x70\xe2\x2a\x52\x87"
-DATA ·d+39528(SB)/8,$"\xb3\xac\xad\x28\xbf\xfb\x76\xda"
-DATA ·d+39536(SB)/8,$"\x69\x65\x24\xb4\xf5\x38\xb7\xf0"
-DATA ·d+39544(SB)/8,$"\x36\xbf\x25\x84\x53\xe9\x4b\x25"
-DATA ·d+39552(SB)/8,$"\xc9\x10\x09\xee\x0c\x88\xd1\x18"
-DATA ·d+39560(SB)/8,$"\x87\x76\x60\x19\x8b\x2f\xb9\x02"
-DATA ·d+39568(SB)/8,$"\xbd\x97\xe1\x6f\x83\x35\xdb\x1d"
-DATA ·d+39576(SB)/8,$"\x0a\xf7\xc7\xf6\xfa\xce\x9f\x6c"
-DATA ·d+39584(SB)/8,$"\x96\xb0\x4c\xb6\xa3\xc9\x88\xad"
-DATA ·d+39592(SB)/8,$"\xcc\xd1\x01\xba\x35\x7b\x97\x8d"
-DATA ·d+39600(SB)/8,$"\x1b\x19\xf9\x8a\x06\x20\xf1\x8c"
-DATA ·d+39608(SB)/8,$"\x91\x5c\x26\xa7\xe2\xcd\x7b\x5b"
-DATA ·d+39616(SB)/8,$"\x8c\xb7\x86\xa2\xd4\xe4\xfb\x10"
-DATA ·d+39624(SB)/8,$"\x16\x8b\xe6\x09\x04\xce\xa7\x19"
-DATA ·d+39632(SB)/8,$"\x85\xb2\xac\x1c\x80\x07\x01\x89"
-DATA ·d+39640(SB)/8,$"\xf4\xaa\xf6\xda\xff\x00\x88\x6e"
-DATA ·d+39648(SB)/8,$"\xec\x85\x9e\xa7\xa7\x5d\xc7\x3d"
-DATA ·d+39656(SB)/8,$"\xbe\xff\x00\x20\x5d\xc3\x2b\x88"
-DATA ·d+39664(SB)/8,$"\x81\x3f\x30\x8d\x5d\x40\x8c\x92"
-DATA ·d+39672(SB)/8,$"\x32\xc4\x2f\x24\x73\x59\x47\x15"
-DATA ·d+39680(SB)/8,$"\x56\x4a\xee\x36\xb9\xf2\x98\xf8"
-DATA ·d+39688(SB)/8,$"\x62\xea\xd0\x70\xa9\x6b\x5f\x5d"
-DATA ·d+39696(SB)/8,$"\xaf\x6e\xcd\x79\xe9\xe8\x6c\xf8"
-DATA ·d+39704(SB)/8,$"\x92\x3b\xff\x00\x10\x24\x3a\xf6"
-DATA ·d+39712(SB)/8,$"\xb5\xe7\xdc\x6f\xe2\x3f\x2a\xd5"
-DATA ·d+39720(SB)/8,$"\x62\x40\xb9\x1b\xdb\xf7\x68\xa3"
-DATA ·d+39728(SB)/8,$"\x80\x78\x6d\xde\xdd\x4e\x2a\xf7"
-DATA ·d+39736(SB)/8,$"\x81\x3c\x3f\xac\xca\xb1\x5c\xe9"
-DATA ·d+39744(SB)/8,$"\x3a\x5d\xce\xb4\x6e\x25\x6b\x38"
-DATA ·d+39752(SB)/8,$"\x6c\x56\x35\x59\x24\x70\xbb\x8b"
-DATA ·d+39760(SB)/8,$"\x01\x20\x21\x09\x50\x48\x61\xe8"
-DATA ·d+39768(SB)/8,$"\x4f\x6a\xb2\x75\x0f\x0c\x78\x57"
-DATA ·d+39776(SB)/8,$"\x48\x8f\x58\xd6\x6f\xaf\xae\x2e"
-DATA ·d+39784(SB)/8,$"\xd8\x79\x53\x69\x52\x24\xa1\xa6"
-DATA ·d+39792(SB)/8,$"\x4c\x93\xba\x39\x83\x6c\x04\x1c"
-DATA ·d+39800(SB)/8,$"\x02\xac\x9c\x7e\xb5\x46\x4f\x1e"
-DATA ·d+39808(SB)/8,$"\x78\xbb\x53\x8c\xeb\x45\x3f\xb0"
-DATA ·d+39816(SB)/8,$"\x74\xcb\x24\xf3\xe2\x67\x57\x89"
-DATA ·d+39824(SB)/8,$"\x9b\x6b\x65\x44\x6c\x0a\xee\x94"
-DATA ·d+39832(SB)/8,$"\x1e\xe0\x0e\xbd\x73\x5b\xa9\xca"
-DATA ·d+39840(SB)/8,$"\x77\x6b\x47\xe7\x7f\xe9\x9e\x73"
-DATA ·d+39848(SB)/8,$"\xa7\x89\x95\x25\x0a\x70\x4a\x17"
-DATA ·d+39856(SB)/8,$"\x49\x37\xa2\xdf\x64\x95\x9f\xdc"
-DATA ·d+39864(SB)/8,$"\x60\x5f\x25\xe6\x9b\x33\xea\xf7"
-DATA ·d+39872(SB)/8,$"\xf6\x8e\x20\x86\xe9\x7e\xd9\x62"
-DATA ·d+39880(SB)/8,$"\x2e\x4b\x23\xc6\x78\x31\xb3\x46"
-DATA ·d+39888(SB)/8,$"\x32\x1b\x3e\x83\x03\xbf\x5a\x5b"
-DATA ·d+39896(SB)/8,$"\x89\x2c\x34\xbd\x4d\x75\x0b\x74"
-DATA ·d+39904(SB)/8,$"\x92\x05\x28\xdf\x65\xb7\x9b\x32"
-DATA ·d+39912(SB)/8,$"\xb0\xdd\xfe\xc6\x14\x1f\x63\x8a"
-DATA ·d+39920(SB)/8,$"\xe2\x6e\xbe\x27\x6a\xde\x28\xd4"
-DATA ·d+39928(SB)/8,$"\xa5\x96\x71\x71\x79\x24\xd2\x9f"
-DATA ·d+39936(SB)/8,$"\x9a\x4c\xe2\x5d\xe7\xaf\x0a\x4e"
-DATA ·d+39944(SB)/8,$"\xff\x00\x5c\xe6\xa7\x86\x2d\x67"
-DATA ·d+39952(SB)/8,$"\x5b\xf3\x34\xeb\xab\xeb\xdb\x49"
-DATA ·d+39960(SB)/8,$"\xed\x65\x12\xbd\xb5\xd7\x03\x0b"
-DATA ·d+39968(SB)/8,$"\xc2\x04\x99\x06\xe5\x00\x71\xd8"
-DATA ·d+39976(SB)/8,$"\x57\xa9\x42\x94\xa4\xfd\xc8\xdd"
-DATA ·d+39984(SB)/8,$"\x9e\xec\xb2\xaa\xb0\x8a\xfa\xcd"
-DATA ·d+39992(SB)/8,$"\xa1\x65\xad\xae\xf4\x76\xe9\xbf"
-DATA ·d+40000(SB)/8,$"\xdf\xe8\x7a\xdc\x11\xcb\x60\xb6"
-DATA ·d+40008(SB)/8,$"\xd7\x52\x1d\x4e\xde\x1b\x84\xdf"
-DATA ·d+40016(SB)/8,$"\x73\x05\xbb\x45\x6e\x71\xd8\xed"
-DATA ·d+40024(SB)/8,$"\x04\xb1\x1f\xef\x60\xfb\x56\x76"
-DATA ·d+40032(SB)/8,$"\xaa\xfe\x19\xb4\xb8\x5d\x45\xec"
-DATA ·d+40040(SB)/8,$"\xb5\x17\x59\x9b\x10\xc9\x3d\xc2"
-DATA ·d+40048(SB)/8,$"\x32\xbe\xcf\xbd\xf3\x00\x79\xf4"
-DATA ·d+40056(SB)/8,$"\xc0\x15\x5f\xc7\x16\xc3\x47\xfe"
-DATA ·d+40064(SB)/8,$"\xce\xb8\x80\x25\xb5\xbd\xd5\x88"
-DATA ·d+40072(SB)/8,$"\x9a\x26\x17\xc2\xf5\x9f\x04\x82"
-DATA ·d+40080(SB)/8,$"\xd3\x10\x15\x92\x50\x78\x21\x87"
-DATA ·d+40088(SB)/8,$"\x4c\x71\x5c\x8d\xa5\xdc\xed\x73"
-DATA ·d+40096(SB)/8,$"\x6f\x72\x25\x84\xaa\xc8\x1a\x29"
-DATA ·d+40104(SB)/8,$"\x25\x21\x94\x12\xd9\x24\x06\xe3"
-DATA ·d+40112(SB)/8,$"\x19\xeb\x5e\xdd\x39\xa5\x1b\x38"
-DATA ·d+40120(SB)/8,$"\xea\x78\x34\xf2\xe6\xff\x00\x7d"
-DATA ·d+40128(SB)/8,$"\xcd\xa6\xbb\x3d\xf7\xea\xba\x9e"
-DATA ·d+40136(SB)/8,$"\xb5\xa3\x5d\x04\x0e\x74\xcb\x4b"
-DATA ·d+40144(SB)/8,$"\x84\x05\x42\x92\x83\x7e\xd5\x27"
-DATA ·d+40152(SB)/8,$"\x24\xee\x63\xc1\x18\xe0\x8a\xd1"
-DATA ·d+40160(SB)/8,$"\xba\xd2\xed\x7c\x71\x71\x3c\x3a"
-DATA ·d+40168(SB)/8,$"\x8c\x46\x39\x12\x48\xe2\x5b\xe7"
-DATA ·d+40176(SB)/8,$"\x01\x04\x46\x53\x81\xbf\x07\x18"
-DATA ·d+40184(SB)/8,$"\xfa\xe4\x11\xe9\x5c\x46\xb3\xe2"
-DATA ·d+40192(SB)/8,$"\xab\x7b\xbd\x6a\xe2\xd2\xe6\xce"
-DATA ·d+40200(SB)/8,$"\x29\xe5\x82\x7f\x2e\xe2\xe6\xc2"
-DATA ·d+40208(SB)/8,$"\x49\x65\x8d\x8e\xde\xa1\x42\xe0"
-DATA ·d+40216(SB)/8,$"\x0c\x9e\x3f\x2a\xf5\xaf\x05\x7c"
-DATA ·d+40224(SB)/8,$"\x44\xd0\xb4\x67\xbc\x7b\xbd\x1e"
-DATA ·d+40232(SB)/8,$"\xff\x00\x50\x91\xec\x85\xa5\xa3"
-DATA ·d+40240(SB)/8,$"\xc5\x0a\x2c\x51\xb6\x30\x19\x96"
-DATA ·d+40248(SB)/8,$"\x5c\x1d\xeb\xce\x08\x3c\xd5\xcf"
-DATA ·d+40256(SB)/8,$"\x14\xa5\x1e\x54\x8f\x98\xcc\x70"
-DATA ·d+40264(SB)/8,$"\x98\xaa\x31\xf6\xf4\x69\x3e\x7b"
-DATA ·d+40272(SB)/8,$"\x69\xaa\xbf\x4e\xad\xfe\x7d\x3a"
-DATA ·d+40280(SB)/8,$"\x1c\x24\x7f\x09\x2d\x3c\xf9\x92"
-DATA ·d+40288(SB)/8,$"\x4b\xc1\x76\xf0\xb1\x56\x4d\x38"
-DATA ·d+40296(SB)/8,$"\x2e\x19\x47\x01\xb2\xcd\x8c\x1f"
-DATA ·d+40304(SB)/8,$"\x61\x5e\x4f\xe2\xaf\x0d\xda\xe9"
-DATA ·d+40312(SB)/8,$"\xb7\xa6\xdb\x4e\x8e\xe4\x00\x7e"
-DATA ·d+40320(SB)/8,$"\x61\x23\x0c\x8f\x51\xd8\x1a\xfa"
-DATA ·d+40328(SB)/8,$"\x12\xdb\xc6\x13\x68\xd3\x30\x6d"
-DATA ·d+40336(SB)/8,$"\x16\x45\x79\x63\xf2\x94\x7e\xe5"
-DATA ·d+40344(SB)/8,$"\x64\x90\x91\x9e\x71\xc8\xce\x78"
-DATA ·d+40352(SB)/8,$"\xe7\xd6\xbc\xb6\xef\xc3\x9e\x29"
-DATA ·d+40360(SB)/8,$"\xd7\xaf\x7e\x6b\x58\x20\x12\x49"
-DATA ·d+40368(SB)/8,$"\xbb\xcb\x0e\xce\x50\x1f\x7c\x11"
-DATA ·d+40376(SB)/8,$"\x9c\x7a\x66\xb6\xc3\xe1\xea\x4d"
-DATA ·d+40384(SB)/8,$"\x38\xa8\x3b\xfa\x1e\xae\x4d\x99"
-DATA ·d+40392(SB)/8,$"\x63\x21\x59\xcf\x19\x51\x28\xa5"
-DATA ·d+40400(SB)/8,$"\xde\x3a\xfd\xcc\xf3\x0d\x2f\x55"
-DATA ·d+40408(SB)/8,$"\xb4\xd3\x0c\x91\x5c\x5a\xa5\xcc"
-DATA ·d+40416(SB)/8,$"\x43\xa1\x6e\x18\x7b\x82\x72\x33"
-DATA ·d+40424(SB)/8,$"\xfc\xab\xb4\xd1\xef\xfc\x21\xa9"
-DATA ·d+40432(SB)/8,$"\xc9\xb2\xe6\x18\x62\x94\x30\xdb"
-DATA ·d+40440(SB)/8,$"\x0c\xc1\x84\x6c\x0f\x52\xce\x9c"
-DATA ·d+40448(SB)/8,$"\x8a\xe8\x22\xf0\xce\x91\xa5\x06"
-DATA ·d+40456(SB)/8,$"\xb6\x97\x51\xd3\x90\xc6\xad\x23"
-DATA ·d+40464(SB)/8,$"\x05\x85\xee\x1c\x13\xd0\x32\x94"
-DATA ·d+40472(SB)/8,$"\x3f\xca\xba\x7b\x2f\x0e\xf8\x4a"
-DATA ·d+40480(SB)/8,$"\x6b\x78\x65\x5b\xdd\x36\x17\xc8"
-DATA ·d+40488(SB)/8,$"\x91\xa6\x7b\x77\x5c\xb1\xfe\xec"
-DATA ·d+40496(SB)/8,$"\x4f\x8c\x73\xc6\x46\x6b\x78\x2a"
-DATA ·d+40504(SB)/8,$"\x90\xb4\x6e\xbf\x03\xd9\xc7\xe6"
-DATA ·d+40512(SB)/8,$"\x98\x59\xc7\x99\x29\x2b\xf5\x57"
-DATA ·d+40520(SB)/8,$"\xff\x00\x2f\xf8\x27\x71\xa1\x78"
-DATA ·d+40528(SB)/8,$"\x7b\xc0\x06\xd3\xc9\xd7\x34\xab"
-DATA ·d+40536(SB)/8,$"\x68\x99\xd9\xa3\x8a\x74\xbb\x2f"
-DATA ·d+40544(SB)/8,$"\x1b\x1d\xa0\x82\x01\xe4\x81\x90"
-DATA ·d+40552(SB)/8,$"\x79\xc5\x70\x1e\x32\xf0\x66\xa7"
-DATA ·d+40560(SB)/8,$"\xa2\x88\x65\xf0\xcd\x9d\xa6\xe0"
-DATA ·d+40568(SB)/8,$"\x0a\xb5\xcc\x4e\xa7\x71\xce\x47"
-DATA ·d+40576(SB)/8,$"\xcb\x9f\x97\x03\xbe\x6b\x57\x50"
-DATA ·d+40584(SB)/8,$"\xb7\x8d\x35\x79\x2c\x93\x50\xfb"
-DATA ·d+40592(SB)/8,$"\x53\x49\xe5\xc8\xe9\x04\x44\x16"
-DATA ·d+40600(SB)/8,$"\x05\x71\xc1\x90\x2e\xd3\x80\x31"
-DATA ·d+40608(SB)/8,$"\x8e\x07\x6a\xe4\x3e\x20\x7c\x52"
-DATA ·d+40616(SB)/8,$"\xbf\xd4\x67\x4d\x23\x4e\x92\x41"
-DATA ·d+40624(SB)/8,$"\x0d\x9c\x2b\x6a\x82\x13\x14\x72"
-DATA ·d+40632(SB)/8,$"\x28\x4e\x3e\x69\x55\x37\x33\x9f"
-DATA ·d+40640(SB)/8,$"\xe2\x39\xfc\x6b\xd8\xaf\x98\x51"
-DATA ·d+40648(SB)/8,$"\x8d\x2b\x49\x6b\xe5\x63\xe4\xb2"
-DATA ·d+40656(SB)/8,$"\x9c\x26\x2e\x78\xa8\xce\x8d\x47"
-DATA ·d+40664(SB)/8,$"\x24\xf5\x6a\x5c\xd6\x5d\xb4\x6e"
-DATA ·d+40672(SB)/8,$"\xfa\x9c\x8c\xd7\x5f\x10\x4d\xc9"
-DATA ·d+40680(SB)/8,$"\x93\x54\x91\xe1\x04\x7c\xa8\x80"
-DATA ·d+40688(SB)/8,$"\x05\xfc\x14\x7e\xb5\xd0\x58\x3d"
-DATA ·d+40696(SB)/8,$"\xa6\xab\x71\x15\x86\xa5\x2c\x03"
-DATA ·d+40704(SB)/8,$"\xcd\xff\x00\x5a\xd2\x29\x18\x1e"
-DATA ·d+40712(SB)/8,$"\xf8\xe7\x23\xaf\x06\xb8\x28\x3e"
-DATA ·d+40720(SB)/8,$"\x20\x6a\x1a\x7b\x14\x76\xbf\x60"
-DATA ·d+40728(SB)/8,$"\x79\x30\xdd\xce\xb3\x2e\x7b\x63"
-DATA ·d+40736(SB)/8,$"\x7a\x13\xf9\x1a\xd8\xd6\x3e\x25"
-DATA ·d+40744(SB)/8,$"\x3d\xdd\xa5\xbb\xda\xe8\x7a\x7c"
-DATA ·d+40752(SB)/8,$"\x2f\x10\x3f\xe9\x30\xef\xdf\x29"
-DATA ·d+40760(SB)/8,$"\x3c\x8d\xe3\x3b\x32\x3b\x60\x0a"
-DATA ·d+40768(SB)/8,$"\xe3\xc1\xe6\x50\xfb\x4d\xaf\x27"
-DATA ·d+40776(SB)/8,$"\xa9\xf7\x18\x8c\xa7\x17\x75\x15"
-DATA ·d+40784(SB)/8,$"\x4d\x2b\xf5\x8d\x95\xbf\xaf\x99"
-DATA ·d+40792(SB)/8,$"\xa3\xe2\xbf\x84\xf1\xc4\x64\x9e"
-DATA ·d+40800(SB)/8,$"\xdd\xe2\x74\xdb\xe6\x07\x88\xe7"
-DATA ·d+40808(SB)/8,$"\x0a\x7a\x76\xc9\xfc\x6b\xc7\xee"
-DATA ·d+40816(SB)/8,$"\xb4\x29\xf4\x87\x0d\x19\xf9\x0f"
-DATA ·d+40824(SB)/8,$"\x21\x80\xc8\xcd\x75\xd0\x7c\x51"
-DATA ·d+40832(SB)/8,$"\xf1\x4c\xae\x73\x04\x32\x0c\x61"
-DATA ·d+40840(SB)/8,$"\x83\x16\x23\x1e\x87\x9a\xbf\x73"
-DATA ·d+40848(SB)/8,$"\xf1\x1c\xdf\xe1\x6e\x34\x2b\x44"
-DATA ·d+40856(SB)/8,$"\xc0\xc3\xf9\x1b\x95\x5f\xdc\x83"
-DATA ·d+40864(SB)/8,$"\x9e\x7b\xf1\x4f\x11\x3c\x1c\xfd"
-DATA ·d+40872(SB)/8,$"\xe8\xbe\x57\xe8\x7a\x78\x2f\xed"
-DATA ·d+40880(SB)/8,$"\x6c\x3c\x54\x2b\xa5\x51\x7a\xa3"
-DATA ·d+40888(SB)/8,$"\x06\xc7\xc6\xb1\xd8\x0f\xb3\xea"
-DATA ·d+40896(SB)/8,$"\xba\x6c\x17\xb1\xf6\x72\x36\xb8"
-DATA ·d+40904(SB)/8,$"\x1e\xc4\x71\xf9\xd6\x84\x9a\xde"
-DATA ·d+40912(SB)/8,$"\x81\x72\x05\xc6\x89\x69\xf6\x77"
-DATA ·d+40920(SB)/8,$"\xc9\xdc\xb2\xfc\xc3\x3e\xdc\x56"
-DATA ·d+40928(SB)/8,$"\x6c\x9e\x25\x86\x0b\x81\x3b\xe9"
-DATA ·d+40936(SB)/8,$"\x70\x15\x1f\x37\x97\x2e\x40\x23"
-DATA ·d+40944(SB)/8,$"\xb7\x60\x4d\x5e\xb5\xf1\xa5\x92"
-DATA ·d+40952(SB)/8,$"\x82\xdf\xd9\x96\x7c\xf6\x0b\x9f"
-DATA ·d+40960(SB)/8,$"\xd0\xe6\x8a\x58\xd5\x6e\x47\x51"
-DATA ·d+40968(SB)/8,$"\x7f\xe0\x3f\xa9\xd1\x57\x07\x26"
-DATA ·d+40976(SB)/8,$"\xb9\xe1\x41\xfc\xa5\xa7\xdd\x73"
-DATA ·d+40984(SB)/8,$"\x9b\x9b\x5d\xd4\x95\x99\x1f\x00"
-DATA ·d+40992(SB)/8,$"\xe4\xf4\x1d\x3f\xa5\x50\x3e\x20"
-DATA ·d+41000(SB)/8,$"\xd6\xd1\xf7\xf9\xdc\x67\x18\xc0"
-DATA ·d+41008(SB)/8,$"\xe9\x5d\x75\xde\xb7\xa5\xea\x11"
-DATA ·d+41016(SB)/8,$"\xf1\x64\x91\x36\xec\xe6\x23\xf2"
-DATA ·d+41024(SB)/8,$"\x91\xe8\x54\xff\x00\x3a\xe6\xda"
-DATA ·d+41032(SB)/8,$"\xfa\xda\x2b\x82\x25\x88\xb2\x02"
-DATA ·d+41040(SB)/8,$"\x4e\xc5\x00\x1c\x7d\x6b\x19\x55"
-DATA ·d+41048(SB)/8,$"\x92\xda\xa9\xe8\x50\x71\x94\x6d"
-DATA ·d+41056(SB)/8,$"\x2a\x16\xfb\x88\xae\x75\xc9\xae"
-DATA ·d+41064(SB)/8,$"\x63\x1f\x6a\x50\xc3\xa0\x2a\x39"
-DATA ·d+41072(SB)/8,$"\x15\x84\x75\x16\xc9\xc2\x9c\x66"
-DATA ·d+41080(SB)/8,$"\xbb\xa8\x3c\x45\xe1\x25\x90\x09"
-DATA ·d+41088(SB)/8,$"\xad\x2e\x07\xa8\x24\x11\x9f\xd2"
-DATA ·d+41096(SB)/8,$"\xb7\x86\xb5\xf0\xed\x80\x63\x68"
-DATA ·d+41104(SB)/8,$"\x06\x79\xc6\x1b\xfc\x6b\x64\xd3"
-DATA ·d+41112(SB)/8,$"\xd5\xd6\x44\xcb\x10\xe9\xe9\x1c"
-DATA ·d+41120(SB)/8,$"\x2c\xbe\x5f\xf0\xe7\xff\xd5\xfc"
-DATA ·d+41128(SB)/8,$"\x28\xf1\x2e\xbf\xfd\x86\xcd\x1e"
-DATA ·d+41136(SB)/8,$"\x9f\x1e\xd8\x9c\x11\x24\x17\x7b"
-DATA ·d+41144(SB)/8,$"\x65\x60\x85\x0c\x6e\xcd\x2e\x37"
-DATA ·d+41152(SB)/8,$"\x06\xcf\xdd\x1b\x7d\x0f\xbd\x71"
-DATA ·d+41160(SB)/8,$"\x1a\xde\xbf\xa9\xeb\xd0\x35\xc5"
-DATA ·d+41168(SB)/8,$"\xc7\xd9\x21\x89\x55\x64\x46\x8e"
-DATA ·d+41176(SB)/8,$"\x18\xe2\x24\x00\x17\x0f\xb0\x2e"
-DATA ·d+41184(SB)/8,$"\xd2\x30\x32\x71\x83\xd4\xf5\xaa"
-DATA ·d+41192(SB)/8,$"\x3a\xee\x97\x35\xfd\xbc\xb7\x56"
-DATA ·d+41200(SB)/8,$"\xf0\x5d\x30\x32\x32\x83\x75\x38"
-DATA ·d+41208(SB)/8,$"\x55\x32\x93\x9d\xc5\x4f\x25\x54"
-DATA ·d+41216(SB)/8,$"\x71\xd3\x15\x5b\x4f\xd3\x7c\x4a"
-DATA ·d+41224(SB)/8,$"\x34\xd0\x8b\x33\xdc\xca\x80\x00"
-DATA ·d+41232(SB)/8,$"\x91\x22\xb3\x14\x1f\x78\x33\xb1"
-DATA ·d+41240(SB)/8,$"\xc8\x00\x1e\xc2\xbf\x5f\x8e\x5e"
-DATA ·d+41248(SB)/8,$"\xeb\x7b\xf4\x29\xb4\xdf\x53\xfc"
-DATA ·d+41256(SB)/8,$"\xbe\xc2\x61\xe8\xd3\xa5\x19\x39"
-DATA ·d+41264(SB)/8,$"\xab\xa7\xd4\xd1\x27\x4f\xd3\xec"
-DATA ·d+41272(SB)/8,$"\xad\x3c\xd5\x9c\x1b\xa5\x6f\x37"
-DATA ·d+41280(SB)/8,$"\xcc\xd8\xcb\x20\x53\x82\xd1\x24"
-DATA ·d+41288(SB)/8,$"\x59\x62\x07\xae\x31\x5d\x95\xa6"
-DATA ·d+41296(SB)/8,$"\x97\xa9\x5a\x58\xc5\x23\xcf\x0d"
-DATA ·d+41304(SB)/8,$"\xa5\xd5\xbc\xcb\x34\x37\x86\x67"
-DATA ·d+41312(SB)/8,$"\x8d\x61\x88\x29\x29\x1a\x04\x0d"
-DATA ·d+41320(SB)/8,$"\xfb\xc2\x4e\x39\x23\x8e\x0f\x7a"
-DATA ·d+41328(SB)/8,$"\xe3\xaf\x7c\x4b\x27\x86\x23\x3a"
-DATA ·d+41336(SB)/8,$"\x55\xfa\xda\x24\x93\x27\x9b\x23"
-DATA ·d+41344(SB)/8,$"\xc0\xeb\x24\xed\x8e\x04\x52\xc8"
-DATA ·d+41352(SB)/8,$"\x0e\x55\x01\xe7\x68\xf5\xce\x0d"
-DATA ·d+41360(SB)/8,$"\x43\x6f\xa9\xcb\xa8\x58\xb6\xa3"
-DATA ·d+41368(SB)/8,$"\xf6\x9b\x78\xed\x11\xd4\x32\x34"
-DATA ·d+41376(SB)/8,$"\xe1\x41\x66\xe3\x2a\x84\x7c\xcc"
-DATA ·d+41384(SB)/8,$"\x3d\x7b\x57\x76\x1b\x0f\xec\xbd"
-DATA ·d+41392(SB)/8,$"\xcc\x4b\x72\x7d\x35\x0a\xf8\x6a"
-DATA ·d+41400(SB)/8,$"\xd6\x8d\x48\x46\xc9\xeb\x7e\xfe"
-DATA ·d+41408(SB)/8,$"\x9f\xd3\x3d\xb5\x3e\x18\x68\x7e"
-DATA ·d+41416(SB)/8,$"\x29\xd2\xff\x00\xb6\xf4\xad\x37"
-DATA ·d+41424(SB)/8,$"\xc4\x17\x57\xb7\x4c\x86\xf6\xf2"
-DATA ·d+41432(SB)/8,$"\x2b\x8b\x41\x69\xb8\x7d\xf6\x8d"
-DATA ·d+41440(SB)/8,$"\x54\xa3\xee\xf4\xc8\x02\xbd\xf5"
-DATA ·d+41448(SB)/8,$"\xd3\xc2\x1a\x77\xc3\x05\xf0\xcf"
-DATA ·d+41456(SB)/8,$"\x82\xf4\x7b\xc5\xd7\x1e\xf4\xc3"
-DATA ·d+41464(SB)/8,$"\x3b\xce\xbb\xa4\x92\x18\xe2\xc2"
-DATA ·d+41472(SB)/8,$"\xb2\x43\x12\xe1\xd9\xce\x49\x25"
-DATA ·d+41480(SB)/8,$"\x89\xdd\x5f\x2c\x78\x43\x45\xd7"
-DATA ·d+41488(SB)/8,$"\x67\xbb\x85\xf4\xfd\x6c\x2c\x17"
-DATA ·d+41496(SB)/8,$"\x44\x46\xb1\xf9\xcb\x14\x1b\xcb"
-DATA ·d+41504(SB)/8,$"\x61\x56\x42\xaa\x70\x0f\xf7\xb1"
-DATA ·d+41512(SB)/8,$"\xf8\xd7\xdd\x7e\x19\x7b\xcf\x82"
-DATA ·d+41520(SB)/8,$"\x77\x70\xdc\xf8\xab\x52\xd3\x34"
-DATA ·d+41528(SB)/8,$"\x6d\x5e\x06\x9a\xcf\x50\xd3\xd7"
-DATA ·d+41536(SB)/8,$"\xcd\xd6\x64\x9e\x22\x36\xc8\x0f"
-DATA ·d+41544(SB)/8,$"\x96\xa6\xde\x33\x24\x6c\x4c\x6e"
-DATA ·d+41552(SB)/8,$"\x09\xda\x48\x24\x1c\x11\x5e\xa7"
-DATA ·d+41560(SB)/8,$"\xb1\xc2\x25\x18\xb5\x24\xd6\xca"
-DATA ·d+41568(SB)/8,$"\xea\xcf\xd4\xf8\x8c\xf3\x13\x52"
-DATA ·d+41576(SB)/8,$"\x6d\x52\x75\x13\x8f\xfd\xbd\x75"
-DATA ·d+41584(SB)/8,$"\x6f\xeb\xd0\xf8\xb7\xc6\x36\xda"
-DATA ·d+41592(SB)/8,$"\x9e\x9c\xd0\x3a\x26\xa1\x32\x08"
-DATA ·d+41600(SB)/8,$"\x7c\xcb\xbb\x7d\x42\xde\x47\x3e"
-DATA ·d+41608(SB)/8,$"\x6e\x70\x51\x19\x86\x76\x63\xe6"
-DATA ·d+41616(SB)/8,$"\x3b\x8f\xcb\xeb\xda\xb8\x98\x5f"
-DATA ·d+41624(SB)/8,$"\x4b\xba\x80\xf8\x7b\x59\xb0\x86"
-DATA ·d+41632(SB)/8,$"\x55\x11\x7e\xe1\xd2\xdf\x6c\xea"
-DATA ·d+41640(SB)/8,$"\xed\xca\xfe\xf7\x70\x66\xfc\x73"
-DATA ·d+41648(SB)/8,$"\x5f\x5c\xc7\x73\x79\x79\xa9\xdc"
-DATA ·d+41656(SB)/8,$"\x5c\xe9\x50\xdf\xbf\x98\x65\x11"
-DATA ·d+41664(SB)/8,$"\xc5\x2c\x91\x04\xf2\x24\x5c\x28"
-DATA ·d+41672(SB)/8,$"\x7f\x2f\xe7\x62\x38\xdd\x85\xda"
-DATA ·d+41680(SB)/8,$"\x7e\x95\xf3\x7f\x8c\x6e\x3c\x75"
-DATA ·d+41688(SB)/8,$"\xa1\x5d\xd9\x6a\x77\x5a\x76\x95"
-DATA ·d+41696(SB)/8,$"\x14\xbe\x71\xda\xc0\xab\x39\xda"
-DATA ·d+41704(SB)/8,$"\x78\x18\xce\x48\xed\xd3\xde\xbb"
-DATA ·d+41712(SB)/8,$"\x6a\x60\x30\x71\xba\xaa\x9d\xd7"
-DATA ·d+41720(SB)/8,$"\x95\x8d\xb2\x5c\xca\xa5\x44\xa9"
-DATA ·d+41728(SB)/8,$"\xa4\x93\x7d\x79\xba\xfd\xdf\x7f"
-DATA ·d+41736(SB)/8,$"\xe6\x54\xd2\x3e\x06\x78\xda\xee"
-DATA ·d+41744(SB)/8,$"\x7f\xb1\xe9\x36\x17\x8a\x59\x7c"
-DATA ·d+41752(SB)/8,$"\xc5\x8d\xee\x52\x09\x0a\x9e\x57"
-DATA ·d+41760(SB)/8,$"\x68\x7e\x01\x3e\x99\xe6\xb8\x0f"
-DATA ·d+41768(SB)/8,$"\x13\x5b\xeb\x9a\x0d\xb4\xd6\x73"
-DATA ·d+41776(SB)/8,$"\x5a\x5c\x19\x23\x67\x8e\x44\x9e"
-DATA ·d+41784(SB)/8,$"\x7d\xec\x31\xc3\xf0\xb8\xc8\x1d"
-DATA ·d+41792(SB)/8,$"\x33\x5e\xcd\x6f\xf1\x97\xe3\xe5"
-DATA ·d+41800(SB)/8,$"\xdd\xb1\xf0\xef\x86\xa1\xd0\x5a"
-DATA ·d+41808(SB)/8,$"\x79\x65\xe1\x94\xa3\x5d\x90\x78"
-DATA ·d+41816(SB)/8,$"\xd9\xbe\x56\xe9\xed\xd7\xde\xbe"
-DATA ·d+41824(SB)/8,$"\x75\xf1\xf7\x88\xbe\x22\xdb\x59"
-DATA ·d+41832(SB)/8,$"\x5e\x69\x9e\x21\xb7\xf2\xef\x62"
-DATA ·d+41840(SB)/8,$"\x9d\xa4\x68\xe2\x85\x0c\xa8\xc4"
-DATA ·d+41848(SB)/8,$"\x61\x83\x67\xe6\x18\xc7\x20\x75"
-DATA ·d+41856(SB)/8,$"\xf7\xaf\x33\x0f\x8b\xc0\xc1\xb7"
-DATA ·d+41864(SB)/8,$"\x38\xc9\x7e\x3f\x33\xeb\x72\x9c"
-DATA ·d+41872(SB)/8,$"\x2e\x3a\xb5\x7a\x6a\xb4\xa0\xee"
-DATA ·d+41880(SB)/8,$"\xfb\xeb\xfd\x77\x3c\x92\xf6\xdb"
-DATA ·d+41888(SB)/8,$"\x51\xbf\x8f\xec\xfe\x44\x88\x9f"
-DATA ·d+41896(SB)/8,$"\xc2\xb9\x20\x0f\xa9\xe6\xb0\x66"
-DATA ·d+41904(SB)/8,$"\xf0\xc4\xdf\x79\xa6\x86\x22\xdc"
-DATA ·d+41912(SB)/8,$"\x60\xc8\x2b\x85\xbc\xf1\x77\x88"
-DATA ·d+41920(SB)/8,$"\x6e\x98\xc7\x71\x77\x30\x03\x82"
-DATA ·d+41928(SB)/8,$"\x83\xe4\xfd\x06\x2b\x32\x64\xbe"
-DATA ·d+41936(SB)/8,$"\xbe\x52\x22\x46\x1d\x0e\xf1\x9c"
-DATA ·d+41944(SB)/8,$"\xfd\x79\x35\xed\xc7\x3d\xc2\x3b"
-DATA ·d+41952(SB)/8,$"\x2a\x34\x1b\xfc\x3f\x03\xf7\x8c"
-DATA ·d+41960(SB)/8,$"\x26\x47\x5e\x09\x73\x4d\x45\x79"
-DATA ·d+41968(SB)/8,$"\x2f\xf3\x3a\xcd\x43\xc2\x92\x4e"
-DATA ·d+41976(SB)/8,$"\x09\x17\x56\xc7\x1c\xe3\x76\x4f"
-DATA ·d+41984(SB)/8,$"\x1f\xd6\xb8\x5b\x9d\x34\xd9\x4a"
-DATA ·d+41992(SB)/8,$"\xde\x69\x2d\x8e\x54\x2f\xdd\x3e"
-DATA ·d+42000(SB)/8,$"\xd9\x15\x5c\x41\xab\xdb\x4c\xae"
-DATA ·d+42008(SB)/8,$"\x09\x0c\x3a\xee\x19\xfd\x0d\x68"
-DATA ·d+42016(SB)/8,$"\x2c\x7a\x84\xf9\x49\xa6\x1b\x8f"
-DATA ·d+42024(SB)/8,$"\x52\xdd\x07\xd0\x01\x8a\xf3\xab"
-DATA ·d+42032(SB)/8,$"\xce\x15\xf5\x8d\x07\x16\x7d\x3e"
-DATA ·d+42040(SB)/8,$"\x1a\x9d\x5a\x4a\xce\xb2\x92\x1f"
-DATA ·d+42048(SB)/8,$"\x67\x7b\xa5\x10\x12\xf2\x37\x4e"
-DATA ·d+42056(SB)/8,$"\x30\x49\x27\x19\xff\x00\xeb\xd7"
-DATA ·d+42064(SB)/8,$"\x4f\x05\x9e\x8d\xe4\xf9\xd0\x97"
-DATA ·d+42072(SB)/8,$"\x24\x0c\x85\xc7\x5f\xa7\x4a\xe3"
-DATA ·d+42080(SB)/8,$"\x67\xd3\xe7\x4c\x64\xf9\xc4\x1c"
-DATA ·d+42088(SB)/8,$"\xed\xc1\xe6\x9c\x66\xbf\x81\x37"
-DATA ·d+42096(SB)/8,$"\x45\x1c\x9c\x73\xb7\xa5\x69\x87"
-DATA ·d+42104(SB)/8,$"\xc4\x7b\x25\x6a\x94\xd3\xf9\x0b"
-DATA ·d+42112(SB)/8,$"\x13\x85\x55\x1a\x74\xea\x3f\x4b"
-DATA ·d+42120(SB)/8,$"\x9d\xed\xbf\x8a\x3c\x3b\x6f\x11"
-DATA ·d+42128(SB)/8,$"\x49\x2c\x52\x46\x1c\x06\x90\xed"
-DATA ·d+42136(SB)/8,$"\x27\xdf\x03\xf9\x56\x7e\xa1\xe2"
-DATA ·d+42144(SB)/8,$"\x5d\x3a\xfe\x3f\xb3\xdb\x5b\xa5"
-DATA ·d+42152(SB)/8,$"\xa9\xdd\xc4\x91\x8c\x91\xf8\xe4"
-DATA ·d+42160(SB)/8,$"\xe6\xb9\x18\xee\xa7\x39\x6b\xb8"
-DATA ·d+42168(SB)/8,$"\xd8\x0c\xe4\x70\x00\xfa\x7a\xd4"
-DATA ·d+42176(SB)/8,$"\x33\xdc\xda\xab\x6e\x8d\x18\xf4"
-DATA ·d+42184(SB)/8,$"\x03\x3e\xf5\xd1\x5f\x37\x9c\xa3"
-DATA ·d+42192(SB)/8,$"\xca\xda\xb7\xa5\x8c\x61\x93\x52"
-DATA ·d+42200(SB)/8,$"\x8c\xf9\x92\x6d\xfa\x9a\xcb\x3d"
-DATA ·d+42208(SB)/8,$"\x9a\x4a\xf3\xc9\x70\xe6\x50\x30"
-DATA ·d+42216(SB)/8,$"\xa5\x97\x23\x1e\x98\xfe\x55\x5c"
-DATA ·d+42224(SB)/8,$"\x6a\x57\x64\xfe\xea\x65\xd8\x1b"
-DATA ·d+42232(SB)/8,$"\x38\x2b\x8e\x0f\x4f\x5e\x69\xf6"
-DATA ·d+42240(SB)/8,$"\xb7\x76\xfb\x76\x84\x56\x24\x60"
-DATA ·d+42248(SB)/8,$"\x7e\x3d\xb1\xde\xad\x4b\x6f\xf6"
-DATA ·d+42256(SB)/8,$"\x86\xd9\x12\xa4\x6c\x07\xcd\xc0"
-DATA ·d+42264(SB)/8,$"\x1e\xd5\xe5\xce\x9f\x34\x7d\xd7"
-DATA ·d+42272(SB)/8,$"\xf7\x1d\x35\x23\x18\xb7\xcd\x1f"
-DATA ·d+42280(SB)/8,$"\xbc\xad\x1b\xde\x4d\x2e\x4d\xc9"
-DATA ·d+42288(SB)/8,$"\xc6\x31\xc0\xe0\x67\xe9\x5f\x49"
-DATA ·d+42296(SB)/8,$"\x7c\x21\xb6\xf1\x46\xb9\x73\x75"
-DATA ·d+42304(SB)/8,$"\x6c\xfe\x21\x48\x20\xf2\x25\xb8"
-DATA ·d+42312(SB)/8,$"\x92\x3b\xd9\xe4\x58\xa4\x78\x10"
-DATA ·d+42320(SB)/8,$"\x11\x85\xf9\x95\xa5\x6e\x04\x60"
-DATA ·d+42328(SB)/8,$"\xa9\x24\x8e\xa0\x0a\xf9\xe2\xd2"
-DATA ·d+42336(SB)/8,$"\xc2\x78\x4b\x6d\x78\xd8\x96\x27"
-DATA ·d+42344(SB)/8,$"\x00\xff\x00\x5a\xf7\xef\x87\x7a"
-DATA ·d+42352(SB)/8,$"\xb6\x9b\xa3\xe9\x53\x1d\x5e\xd9"
-DATA ·d+42360(SB)/8,$"\xee\x1e\x41\xe4\x82\x92\x61\x7c"
-DATA ·d+42368(SB)/8,$"\xe5\xcb\x6e\x6e\x33\x8d\x84\x0c"
-DATA ·d+42376(SB)/8,$"\x03\xeb\x5e\x56\x2b\x05\x09\x47"
-DATA ·d+42384(SB)/8,$"\xde\x4e\xff\x00\x27\xfa\x9f\x2b"
-DATA ·d+42392(SB)/8,$"\xc5\x35\x9f\xd5\xe6\xa9\x45\x36"
-DATA ·d+42400(SB)/8,$"\xfc\x97\xea\x7b\xc4\x17\x7e\x38"
-DATA ·d+42408(SB)/8,$"\xf1\x4d\xbd\xb6\x9f\x6d\xad\xf8"
-DATA ·d+42416(SB)/8,$"\x6e\xdd\x6c\xa3\x32\x5a\x7f\x6a"
-DATA ·d+42424(SB)/8,$"\xdd\x5b\x69\xec\xa4\x9e\xa3\x7a"
-DATA ·d+42432(SB)/8,$"\x85\x32\xf1\xd7\x2c\x73\xcd\x5f"
-DATA ·d+42440(SB)/8,$"\xd5\x7e\x1b\x7c\x6c\xf0\xb2\xc1"
-DATA ·d+42448(SB)/8,$"\xf1\x3a\xf7\x57\xb1\x8e\xd6\x67"
-DATA ·d+42456(SB)/8,$"\x36\xd1\x3e\x99\xae\x45\x7a\xf2"
-DATA ·d+42464(SB)/8,$"\x4a\xe8\x5b\x2c\xb1\x33\x3e\x1b"
-DATA ·d+42472(SB)/8,$"\x07\x27\x68\x5c\xf1\xd4\xd7\x84"
-DATA ·d+42480(SB)/8,$"\xcd\xaf\x04\xb5\xf2\xcc\x8a\xca"
-DATA ·d+42488(SB)/8,$"\xc4\x79\x51\xbc\x4d\x2c\xb1\xaf"
-DATA ·d+42496(SB)/8,$"\x4f\x94\xb8\xda\xa0\xf6\xea\x4d"
-DATA ·d+42504(SB)/8,$"\x75\x5e\x12\x96\x7f\x36\xdb\x4f"
-DATA ·d+42512(SB)/8,$"\x8e\xda\xe5\x64\xde\xcd\x69\x3e"
-DATA ·d+42520(SB)/8,$"\xc0\xf9\x91\x87\xca\x19\x7a\x04"
-DATA ·d+42528(SB)/8,$"\xf5\x27\x18\xf5\xaf\x33\xea\xea"
-DATA ·d+42536(SB)/8,$"\x0f\x9a\x55\x12\x8f\x6b\x6b\xf8"
-DATA ·d+42544(SB)/8,$"\x3f\xd0\xfc\xd2\xbe\x1e\x74\x68"
-DATA ·d+42552(SB)/8,$"\xfe\xee\x94\x6f\xad\xf4\xfe\xbf"
-DATA ·d+42560(SB)/8,$"\x23\xaa\xd1\xbc\x13\xaf\x6a\x9b"
-DATA ·d+42568(SB)/8,$"\xee\x7c\x49\xa6\x69\xd7\xf0\xce"
-DATA ·d+42576(SB)/8,$"\x92\x34\x77\x17\xb7\x93\xe9\xf3"
-DATA ·d+42584(SB)/8,$"\x89\x4f\x3b\xd6\x56\x8c\x2b\x6d"
-DATA ·d+42592(SB)/8,$"\x38\xca\xe7\x9e\x99\x15\xea\xfe"
-DATA ·d+42600(SB)/8,$"\x24\xf8\x61\xe1\xbf\x07\xfc\x32"
-DATA ·d+42608(SB)/8,$"\x87\x59\xd4\x35\x3d\x52\xda\xec"
-DATA ·d+42616(SB)/8,$"\x2c\x6b\x73\xe1\xfb\x6b\xfb\x6d"
-DATA ·d+42624(SB)/8,$"\x42\xde\xe2\x29\x32\x1a\x78\x24"
-DATA ·d+42632(SB)/8,$"\xf2\xe4\x11\x80\x40\xdf\x1b\x10"
-DATA ·d+42640(SB)/8,$"\xc3\x39\x04\x8e\x6b\x17\x5a\xd5"
-DATA ·d+42648(SB)/8,$"\xfe\x26\xeb\x57\x6d\x6d\x77\x73"
-DATA ·d+42656(SB)/8,$"\x71\x34\x31\x5b\x05\xf2\x2d\xb7"
-DATA ·d+42664(SB)/8,$"\x08\x6d\x37\xa8\xda\x58\x0e\x11"
-DATA ·d+42672(SB)/8,$"\xf3\xcb\x0e\x41\xf6\xeb\x49\xaa"
-DATA ·d+42680(SB)/8,$"\x78\xc3\xc7\x1f\x0d\xec\xec\x34"
-DATA ·d+42688(SB)/8,$"\xef\x17\x4b\xa7\xf8\x82\xda\xe0"
-DATA ·d+42696(SB)/8,$"\x8f\xb3\xda\xa4\xc2\x0b\xa8\x5c"
-DATA ·d+42704(SB)/8,$"\xf2\x85\xbe\x46\x0c\x9c\x72\xac"
-DATA ·d+42712(SB)/8,$"\x36\xb0\x38\x35\xdf\xec\xb0\xf2"
-DATA ·d+42720(SB)/8,$"\xe5\xa8\xd3\x7d\x56\xd6\xff\x00"
-DATA ·d+42728(SB)/8,$"\x3f\xb8\xf8\xf9\x66\x78\xda\x95"
-DATA ·d+42736(SB)/8,$"\x29\xd1\xa5\x38\x26\xdd\xf9\x53"
-DATA ·d+42744(SB)/8,$"\x77\x69\x6a\xed\x7b\xc5\x3f\x92"
-DATA ·d+42752(SB)/8,$"\xf2\x3c\x73\xfe\x10\xbd\x6e\xda"
-DATA ·d+42760(SB)/8,$"\xca\x3f\x16\xd8\xa5\xcf\xf6\x79"
-DATA ·d+42768(SB)/8,$"\x21\x92\x27\x75\x9a\x52\x3b\x60"
-DATA ·d+42776(SB)/8,$"\x42\x01\x1c\x77\x3d\x3b\xd6\x4b"
-DATA ·d+42784(SB)/8,$"\x6a\x56\xfa\xb5\xb4\x9a\xc5\xc2"
-DATA ·d+42792(SB)/8,$"\x08\x52\x26\x55\x2f\xe7\x6e\x94"
-DATA ·d+42800(SB)/8,$"\x0c\xf0\x19\x46\x19\xba\x57\xd3"
-DATA ·d+42808(SB)/8,$"\x97\xb0\x58\xf8\xa2\xc6\x3f\x17"
-DATA ·d+42816(SB)/8,$"\x5d\x78\x6f\x4a\x73\x2c\x8d\x15"
-DATA ·d+42824(SB)/8,$"\xfc\x36\xb6\xd3\x69\x22\xd5\x9f"
-DATA ·d+42832(SB)/8,$"\x84\x79\x2e\x2d\xa4\x16\xff\x00"
-DATA ·d+42840(SB)/8,$"\x31\x07\x68\xc8\xce\x3a\x57\x1d"
-DATA ·d+42848(SB)/8,$"\x6f\xe0\xaf\x81\xf6\xb6\x33\xb6"
-DATA ·d+42856(SB)/8,$"\xb5\xa9\xdd\xc0\xd3\xc4\xc2\xdc"
-DATA ·d+42864(SB)/8,$"\xe8\xd6\xdf\xda\x10\xad\xce\x72"
-DATA ·d+42872(SB)/8,$"\xb1\x34\xee\xd1\x12\x3d\x4a\xef"
-DATA ·d+42880(SB)/8,$"\x1e\xb9\xef\xee\x61\x1d\x69\xc1"
-DATA ·d+42888(SB)/8,$"\xfb\x35\x7e\xfd\x7f\xe1\x8f\x5e"
-DATA ·d+42896(SB)/8,$"\x9e\x75\x09\xdd\xd4\x8b\x72\xbd"
-DATA ·d+42904(SB)/8,$"\x95\x96\xde\x5a\x3d\x76\x7e\xbd"
-DATA ·d+42912(SB)/8,$"\x8f\x20\x5d\x41\x75\xc8\xec\xf4"
-DATA ·d+42920(SB)/8,$"\x6d\x45\xe0\xb9\x2c\xd9\x56\xb8"
-DATA ·d+42928(SB)/8,$"\x22\x13\x1e\x39\x0b\xe6\x9c\x10"
-DATA ·d+42936(SB)/8,$"\x31\xef\xf4\x19\xe2\xbd\x17\x4b"
-DATA ·d+42944(SB)/8,$"\xf8\x7e\x8b\x2c\xc6\xd2\x1d\x38"
-DATA ·d+42952(SB)/8,$"\xd8\xa0\x32\x49\x14\x7a\x88\x94"
-DATA ·d+42960(SB)/8,$"\x85\x93\xe5\x28\x18\xa9\x19\xc9"
-DATA ·d+42968(SB)/8,$"\xcf\x20\x1c\x0e\xb9\xaa\x96\x1f"
-DATA ·d+42976(SB)/8,$"\x0d\xfc\x27\xe2\x46\x9e\xd3\x43"
-DATA ·d+42984(SB)/8,$"\xd4\x2e\xee\x27\x8d\xc3\x48\x85"
-DATA ·d+42992(SB)/8,$"\x51\x64\x5c\xf4\x53\x10\x19\xe8"
-DATA ·d+43000(SB)/8,$"\x72\x09\xc0\xeb\x54\xed\xbe\x07"
-DATA ·d+43008(SB)/8,$"\xae\x8f\x1c\x17\x37\x33\xdd\xc2"
-DATA ·d+43016(SB)/8,$"\xf2\xbb\xac\xb2\x4a\x55\x22\xdc"
-DATA ·d+43024(SB)/8,$"\x32\x57\x86\x38\xe4\x0e\x7e\xbc"
-DATA ·d+43032(SB)/8,$"\x56\x72\x93\x6e\xd5\x29\x5c\xcb"
-DATA ·d+43040(SB)/8,$"\x15\x99\x61\xac\xe9\xc2\xbb\xa6"
-DATA ·d+43048(SB)/8,$"\xfb\x38\xbd\xdf\x5d\xd5\x8f\x53"
-DATA ·d+43056(SB)/8,$"\xf0\xb7\x82\x7e\x21\x69\x9a\x44"
-DATA ·d+43064(SB)/8,$"\xab\xe1\x4d\x33\x4e\xb9\xf2\xa5"
-DATA ·d+43072(SB)/8,$"\x91\x5e\x71\x79\x10\x0a\x55\x4b"
-DATA ·d+43080(SB)/8,$"\x72\xb2\x32\xef\x6c\x03\xb4\xe4"
-DATA ·d+43088(SB)/8,$"\xe7\xb6\x4e\x2b\x92\xf0\xa7\x88"
-DATA ·d+43096(SB)/8,$"\xf5\x48\x75\x7f\xb5\x4b\xe5\xc8"
-DATA ·d+43104(SB)/8,$"\xee\x4e\x4d\xc9\x26\x22\x71\xf3"
-DATA ·d+43112(SB)/8,$"\x37\x04\x74\xea\x36\xf2\x3d\x2a"
-DATA ·d+43120(SB)/8,$"\xc0\xd0\xbc\x0f\xa3\x69\x50\x1d"
-DATA ·d+43128(SB)/8,$"\x55\xb5\x27\xbf\x9e\x35\x9e\x78"
-DATA ·d+43136(SB)/8,$"\x90\xa1\xb3\x81\xc3\xef\x8d\x49"
-DATA ·d+43144(SB)/8,$"\x8c\x37\x9c\x0a\x60\x91\x95\x00"
-DATA ·d+43152(SB)/8,$"\xf0\x79\xc8\xae\xbf\x49\xba\xf0"
-DATA ·d+43160(SB)/8,$"\xdf\x89\x64\x6b\x66\xba\x9a\xe1"
-DATA ·d+43168(SB)/8,$"\x8b\x33\x0b\x3b\x7b\x20\x12\x57"
-DATA ·d+43176(SB)/8,$"\x3c\xf2\xd8\x29\x18\x4e\x73\x80"
-DATA ·d+43184(SB)/8,$"\x09\xad\x29\xce\x8c\x21\x7e\x57"
-DATA ·d+43192(SB)/8,$"\x06\x7c\x96\x61\x25\xfb\xcf\x69"
-DATA ·d+43200(SB)/8,$"\x0e\x6e\x6b\xdd\xf2\xb5\xa2\xee"
-DATA ·d+43208(SB)/8,$"\xee\xd3\xfc\x0f\x2f\xf1\x96\xbb"
-DATA ·d+43216(SB)/8,$"\xac\xe8\x1e\x30\x59\xa6\x9e\xca"
-DATA ·d+43224(SB)/8,$"\xfe\xda\x60\xde\x51\xb7\x19\xb7"
-DATA ·d+43232(SB)/8,$"\xdc\xe0\x15\x05\x9c\xff\x00\x0e"
-DATA ·d+43240(SB)/8,$"\x79\x07\x04\x1a\xc0\x93\xc4\xde"
-DATA ·d+43248(SB)/8,$"\x25\xb9\x8c\xc7\xa0\x24\x8c\xeb"
-DATA ·d+43256(SB)/8,$"\x20\x8d\xc3\x00\x58\xbb\x71\x98"
-DATA ·d+43264(SB)/8,$"\xb0\x48\x1b\x4f\x00\x75\xaf\x48"
-DATA ·d+43272(SB)/8,$"\xf1\x8d\xbe\x83\xa3\xdc\x4f\xe1"
-DATA ·d+43280(SB)/8,$"\xad\x1e\x7b\x61\x72\xf1\xa2\xcd"
-DATA ·d+43288(SB)/8,$"\xa6\xca\xa8\xe4\x3c\x9e\xb2\x1e"
-DATA ·d+43296(SB)/8,$"\x39\x04\x10\x01\xc8\xae\x1b\x54"
-DATA ·d+43304(SB)/8,$"\xb0\x9a\xc2\xe6\xdf\xc1\xd3\x3c"
-DATA ·d+43312(SB)/8,$"\x69\x35\xbc\x81\x7e\xd4\xaf\x88"
-DATA ·d+43320(SB)/8,$"\x63\x0e\xa1\xb0\xa3\xfd\x96\xc8"
-DATA ·d+43328(SB)/8,$"\xdc\x71\x93\x5c\xf8\x9c\xc2\xac"
-DATA ·d+43336(SB)/8,$"\x76\xa9\x65\xe4\xcf\xa0\xcb\xbd"
-DATA ·d+43344(SB)/8,$"\x8b\xa5\x4d\xca\x92\xe6\x4b\xaa"
-DATA ·d+43352(SB)/8,$"\xb5\xd7\x76\xbf\xca\xe6\x5f\x89"
-DATA ·d+43360(SB)/8,$"\xa7\xf1\x27\x87\x25\xb6\x83\xc5"
-DATA ·d+43368(SB)/8,$"\x36\x5a\x8d\x80\xb9\x8c\x62\x6b"
-DATA ·d+43376(SB)/8,$"\x95\x90\xf9\xb9\xcf\xcc\x0b\x61"
-DATA ·d+43384(SB)/8,$"\x30\x4f\x6d\xdf\x85\x73\x3a\x75"
-DATA ·d+43392(SB)/8,$"\xf0\xd2\xfc\x55\x6d\x27\x8b\x55"
-DATA ·d+43400(SB)/8,$"\x1a\x39\xa3\x55\xb4\x7b\x80\x22"
-DATA ·d+43408(SB)/8,$"\x8e\x35\x3d\x09\x6c\x36\x01\xf5"
-DATA ·d+43416(SB)/8,$"\xe6\xbd\x62\xc6\x5b\xcb\xcd\x1e"
-DATA ·d+43424(SB)/8,$"\x79\x75\x0d\x5a\x69\x92\x39\x7c"
-DATA ·d+43432(SB)/8,$"\xa8\xf4\xe9\x64\x32\x2b\xe3\x01"
-DATA ·d+43440(SB)/8,$"\x99\x13\x94\x51\x9f\x41\xf5\x35"
-DATA ·d+43448(SB)/8,$"\xa5\xe2\xad\x17\xc3\xd6\x36\x36"
-DATA ·d+43456(SB)/8,$"\x96\x1a\x95\xb5\xbc\xad\x2c\x62"
-DATA ·d+43464(SB)/8,$"\x5c\x38\x6f\xdc\xb3\x1e\x22\x20"
-DATA ·d+43472(SB)/8,$"\x60\x07\xe8\x48\x1d\x8f\x35\xe4"
-DATA ·d+43480(SB)/8,$"\xfd\x63\x9b\x56\x74\x53\xce\xa8"
-DATA ·d+43488(SB)/8,$"\xc1\xfd\x5d\xd3\xbb\x77\x4d\xc7"
-DATA ·d+43496(SB)/8,$"\x4f\xba\xfa\xfe\x2d\x1e\x45\xac"
-DATA ·d+43504(SB)/8,$"\x5e\xf8\x8b\x4e\xd4\xe5\x99\x6d"
-DATA ·d+43512(SB)/8,$"\xe6\x90\x3b\x10\x93\xb9\x69\xc1"
-DATA ·d+43520(SB)/8,$"\x07\xa6\xd7\xf4\xc6\x05\x62\xdd"
-DATA ·d+43528(SB)/8,$"\xbe\xa9\xab\x05\xb4\x9e\xc9\xd6"
-DATA ·d+43536(SB)/8,$"\x76\x38\x42\x88\xc1\xdb\xf0\xc7"
-DATA ·d+43544(SB)/8,$"\xf3\xaf\x4b\xbb\xd7\xf4\xbb\x43"
-DATA ·d+43552(SB)/8,$"\x35\xb2\x68\x7a\x6d\xa8\x8c\xab"
-DATA ·d+43560(SB)/8,$"\x20\x05\xcb\x8e\xc1\x72\x92\x0e"
-DATA ·d+43568(SB)/8,$"\xb5\xdd\xea\x6d\xf0\xce\xdf\x42"
-DATA ·d+43576(SB)/8,$"\x9f\xc4\x0c\xd7\x76\xda\xa3\xda"
-DATA ·d+43584(SB)/8,$"\x40\x96\xda\x54\x73\x49\x34\x33"
-DATA ·d+43592(SB)/8,$"\x49\x23\x11\x2c\x8f\x24\x84\x84"
-DATA ·d+43600(SB)/8,$"\x55\x4c\x14\x5c\xf2\x6b\xa2\x15"
-DATA ·d+43608(SB)/8,$"\xd3\x76\xd8\xdd\x66\xde\xcb\x91"
-DATA ·d+43616(SB)/8,$"\x7d\x59\xdd\xe8\x9a\xfd\x6c\xff"
-DATA ·d+43624(SB)/8,$"\x00\xcd\x1e\x01\x37\x82\x6f\x8c"
-DATA ·d+43632(SB)/8,$"\x2b\x6d\xe6\x44\xf7\x19\xfd\xe4"
-DATA ·d+43640(SB)/8,$"\x31\xb3\x4a\xc9\xcf\x76\x50\x57"
-DATA ·d+43648(SB)/8,$"\x3e\xc0\x9a\xd9\xb6\xf0\xe6\xad"
-DATA ·d+43656(SB)/8,$"\xe1\xa8\x54\x6a\xf6\x77\x7e\x54"
-DATA ·d+43664(SB)/8,$"\xcd\xe6\xc2\xb7\x30\xc9\x0a\x49"
-DATA ·d+43672(SB)/8,$"\x91\x8c\x8c\x81\xc0\xf5\x06\xbd"
-DATA ·d+43680(SB)/8,$"\xa3\xe1\x8f\x8e\xfc\x37\xe1\x5d"
-DATA ·d+43688(SB)/8,$"\x46\xdb\x58\xd7\x74\xb9\xc4\x23"
-DATA ·d+43696(SB)/8,$"\x11\xa4\xb6\x82\x39\x8c\x7b\x4e"
-DATA ·d+43704(SB)/8,$"\x19\x9e\x13\x82\x4e\x08\xc6\x0f"
-DATA ·d+43712(SB)/8,$"\x3d\xab\xd6\xbf\x68\x2f\x8d\x5e"
-DATA ·d+43720(SB)/8,$"\x00\xf8\x83\x36\x97\x2f\x87\x3e"
-DATA ·d+43728(SB)/8,$"\xd7\x35\xdd\x93\x44\x5e\xe2\xe2"
-DATA ·d+43736(SB)/8,$"\xd0\xdb\xc3\x24\x51\x8c\x08\xf6"
-DATA ·d+43744(SB)/8,$"\x3f\x41\x8e\x02\xe3\x00\xe3\x3e"
-DATA ·d+43752(SB)/8,$"\xb5\xda\xb9\x63\xd7\x5f\x9f\xf5"
-DATA ·d+43760(SB)/8,$"\xf8\x9e\x1e\x33\x8a\x73\x35\x8f"
-DATA ·d+43768(SB)/8,$"\xa7\x82\xfa\x93\x95\x29\x5e\xf3"
-DATA ·d+43776(SB)/8,$"\x56\xb2\x6b\xf2\xfb\xef\xe4\x7c"
-DATA ·d+43784(SB)/8,$"\xc1\x63\xe0\x79\xf5\x7b\x43\xa8"
-DATA ·d+43792(SB)/8,$"\xb4\xd1\x43\x19\x4f\x30\x3e\xdd"
-DATA ·d+43800(SB)/8,$"\xde\x63\x67\x94\xdd\xd9\xbd\x37"
-DATA ·d+43808(SB)/8,$"\x70\x6b\x8a\xd5\x24\x97\x4a\xb8"
-DATA ·d+43816(SB)/8,$"\x68\x74\xf2\x44\xf1\x8c\x00\x63"
-DATA ·d+43824(SB)/8,$"\x20\xee\xee\x46\xe1\xe9\xdb\x15"
-DATA ·d+43832(SB)/8,$"\xee\x56\x1a\x66\xaf\xa8\xc9\x6d"
-DATA ·d+43840(SB)/8,$"\xf6\xf0\xfa\x5c\x53\x23\xbe\xfd"
-DATA ·d+43848(SB)/8,$"\xa1\x56\x42\x5b\x29\x93\x0f\x03"
-DATA ·d+43856(SB)/8,$"\x0b\xc6\x7a\x9e\xf5\xd4\xde\x78"
-DATA ·d+43864(SB)/8,$"\x43\x4b\xd3\x51\x65\xf8\x8a\xf7"
-DATA ·d+43872(SB)/8,$"\x36\xf1\x49\x6f\xbe\xde\x44\xb6"
-DATA ·d+43880(SB)/8,$"\x1f\x34\x6e\xa7\x6c\x91\xee\xc3"
-DATA ·d+43888(SB)/8,$"\x10\xc4\x70\x73\x80\x73\x8e\x6b"
-DATA ·d+43896(SB)/8,$"\xb1\x7b\xf6\xb2\xb1\x51\xe2\x78"
-DATA ·d+43904(SB)/8,$"\xd1\xab\x6a\xd2\xe7\xbe\xc9\x6b"
-DATA ·d+43912(SB)/8,$"\xd6\xdd\xac\xfe\xf3\xe2\x6b\xbb"
-DATA ·d+43920(SB)/8,$"\xfd\x42\x48\xbc\x8b\xc0\x08\x27"
-DATA ·d+43928(SB)/8,$"\x71\xf9\x40\x23\xf1\xeb\x58\xf1"
-DATA ·d+43936(SB)/8,$"\x43\x79\x75\x27\x97\x6a\x1b\x71"
-DATA ·d+43944(SB)/8,$"\xe8\x13\xf9\x57\xd4\xd7\xde\x16"
-DATA ·d+43952(SB)/8,$"\xf0\xfd\xfc\xd1\x42\xca\x8a\x2e"
-DATA ·d+43960(SB)/8,$"\xd5\x44\x12\xdc\x5c\x14\x62\x58"
-DATA ·d+43968(SB)/8,$"\x9f\x9b\x01\x40\x3d\x30\x72\x38"
-DATA ·d+43976(SB)/8,$"\xcd\x5a\xf1\xc7\x80\x2d\x3c\x27"
-DATA ·d+43984(SB)/8,$"\x6b\x1f\x88\x7c\x2c\xfa\x6b\xb2"
-DATA ·d+43992(SB)/8,$"\x34\x71\x5c\xe9\x86\x69\x5a\xea"
-DATA ·d+44000(SB)/8,$"\x26\x07\x05\x94\xb0\xdb\x32\x37"
-DATA ·d+44008(SB)/8,$"\x72\x98\xdb\x9e\x95\x8d\x4c\x14"
-DATA ·d+44016(SB)/8,$"\xd6\xac\xfb\x1a\x3c\x65\x86\xe6"
-DATA ·d+44024(SB)/8,$"\x85\x25\x0b\x4a\x5b\x5f\x63\xe7"
-DATA ·d+44032(SB)/8,$"\xab\x2d\x23\x51\x90\xf9\x3a\x90"
-DATA ·d+44040(SB)/8,$"\xf2\x19\x57\x21\x64\xf9\x59\xbd"
-DATA ·d+44048(SB)/8,$"\x30\x0f\x3f\x9d\x7a\x1c\x9f\x08"
-DATA ·d+44056(SB)/8,$"\xf5\x80\x61\x49\x48\x86\x79\xe2"
-DATA ·d+44064(SB)/8,$"\x5b\x88\xa2\x9b\xa3\x44\x7a\x3e"
-DATA ·d+44072(SB)/8,$"\xe1\xc0\x1f\x5c\x7b\x57\xbd\xbf"
-DATA ·d+44080(SB)/8,$"\x8c\x3e\x12\x58\x78\x7e\xc6\xdb"
-DATA ·d+44088(SB)/8,$"\xc4\x5a\x42\x89\x9e\x32\x2f\x20"
-DATA ·d+44096(SB)/8,$"\xb6\x89\xa3\x5f\x30\x74\x2b\x36"
-DATA ·d+44104(SB)/8,$"\x59\x88\x3d\x73\xfa\x57\x9e\x6b"
-DATA ·d+44112(SB)/8,$"\x9e\x0d\xd6\xa7\x68\xb5\x8f\x0b"
-DATA ·d+44120(SB)/8,$"\x3d\xb4\x76\x57\x4b\xbe\xd6\xdd"
-DATA ·d+44128(SB)/8,$"\xef\x90\xcc\xe8\x38\xc0\xdf\xb4"
-DATA ·d+44136(SB)/8,$"\x9c\x1e\xd5\xbd\x17\x15\x14\xe7"
-DATA ·d+44144(SB)/8,$"\xfe\x4c\xf9\xf8\xf1\x56\x2a\xbd"
-DATA ·d+44152(SB)/8,$"\x5b\x38\x3a\x0a\xed\x27\x24\xad"
-DATA ·d+44160(SB)/8,$"\x2f\x47\x7f\xeb\xb9\xc4\x9f\x86"
-DATA ·d+44168(SB)/8,$"\x42\xcd\xc2\xea\xf0\xb3\x03\xc1"
-DATA ·d+44176(SB)/8,$"\x2a\xe8\xa3\x9f\xf6\xb9\xfd\x69"
-DATA ·d+44184(SB)/8,$"\x3f\xe1\x01\xf0\xe8\xe3\xec\xb7"
-DATA ·d+44192(SB)/8,$"\xdf\x83\xa7\xf8\xd3\x1e\x6f\x10"
-DATA ·d+44200(SB)/8,$"\x58\xdd\xff\x00\x67\x5f\x29\xb4"
-DATA ·d+44208(SB)/8,$"\x90\xf0\x4d\xc9\x24\x7e\x60\xe3"
-DATA ·d+44216(SB)/8,$"\x15\x74\x6a\x37\x2a\x02\x9b\xab"
-DATA ·d+44224(SB)/8,$"\x3c\x8e\x0f\xef\xa4\x1f\xd6\xb7"
-DATA ·d+44232(SB)/8,$"\x8d\x7a\x0f\x68\xb3\xb7\xdb\xe3"
-DATA ·d+44240(SB)/8,$"\x1e\xae\xb7\xdd\x7b\x7e\x67\xff"
-DATA ·d+44248(SB)/8,$"\xd6\xfe\x7b\xbc\x4d\x6d\xa8\x68"
-DATA ·d+44256(SB)/8,$"\xfa\xc5\xd5\x99\x68\x65\xfb\x31"
-DATA ·d+44264(SB)/8,$"\x22\x39\xa1\x93\xcd\x8e\x40\xbf"
-DATA ·d+44272(SB)/8,$"\xdc\x6e\x31\x91\xd3\x8c\xf6\xe0"
-DATA ·d+44280(SB)/8,$"\xd7\x17\xa8\xfc\x57\xbe\xfd\xc6"
-DATA ·d+44288(SB)/8,$"\x9f\xa8\x4a\x6d\x9e\xd5\x96\x38"
-DATA ·d+44296(SB)/8,$"\x82\xc4\x8a\x02\x75\x63\xf2\x85"
-DATA ·d+44304(SB)/8,$"\x62\xde\xe4\xe4\xfa\xd7\xe8\x36"
-DATA ·d+44312(SB)/8,$"\x8f\xf0\x0f\xc1\x5e\x3e\xf0\x63"
-DATA ·d+44320(SB)/8,$"\x43\xad\x5d\xc5\x69\xab\x5c\x58"
-DATA ·d+44328(SB)/8,$"\xcb\xa8\xd9\xde\xe9\x13\x89\x55"
-DATA ·d+44336(SB)/8,$"\xdd\x55\xa4\xf2\xe7\x8e\x56\xc3"
-DATA ·d+44344(SB)/8,$"\xfc\xd9\x52\xca\x55\xb3\x5f\x15"
-DATA ·d+44352(SB)/8,$"\x5c\xfc\x2d\x6f\x08\x4c\x9a\xa7"
-DATA ·d+44360(SB)/8,$"\xc4\xbb\x0f\x36\xce\x3b\xcf\xb2"
-DATA ·d+44368(SB)/8,$"\x35\x9c\x52\xa0\x9d\xf0\x09\x2d"
-DATA ·d+44376(SB)/8,$"\xe6\xab\x30\x8c\x82\x30\xa1\x87"
-DATA ·d+44384(SB)/8,$"\x3c\xf6\xe6\xbf\x56\xc1\x71\x3d"
-DATA ·d+44392(SB)/8,$"\x7a\xd0\x97\xb0\x9e\x9d\xb6\xbf"
-DATA ·d+44400(SB)/8,$"\xa7\x73\xfc\xc9\xca\xe5\x84\x94"
-DATA ·d+44408(SB)/8,$"\xa5\x4f\x17\x0f\x7a\x3d\x3a\xdf"
-DATA ·d+44416(SB)/8,$"\xf2\x2e\x59\xea\x1f\x0b\xb5\xab"
-DATA ·d+44424(SB)/8,$"\xa5\x82\xca\xd2\x39\xe4\x79\x95"
-DATA ·d+44432(SB)/8,$"\x14\xdc\x3a\x45\x24\xa9\x8e\x5a"
-DATA ·d+44440(SB)/8,$"\x40\x33\xb4\xe7\x92\x46\x70\x3b"
-DATA ·d+44448(SB)/8,$"\xf1\x53\x5a\x6a\x31\xdf\x58\xcb"
-DATA ·d+44456(SB)/8,$"\xa5\xc2\xb6\xeb\x15\x9c\xf2\x25"
-DATA ·d+44464(SB)/8,$"\xb5\xb9\xf9\xc6\x18\x9c\xba\xb3"
-DATA ·d+44472(SB)/8,$"\x28\x07\xd3\xaf\x4e\xd5\x81\x67"
-DATA ·d+44480(SB)/8,$"\xa9\x78\x54\x48\xaf\x0d\xa5\xba"
-DATA ·d+44488(SB)/8,$"\x42\x27\xc8\xb3\x95\xa6\x05\xd1"
-DATA ·d+44496(SB)/8,$"\x49\xc2\x34\xe8\x36\xa0\x7e\xf8"
-DATA ·d+44504(SB)/8,$"\xce\x0f\x7a\x8b\xc4\x1e\x33\xf1"
-DATA ·d+44512(SB)/8,$"\x6c\xd7\xf2\xdd\xe8\xf0\x59\xe8"
-DATA ·d+44520(SB)/8,$"\x96\x65\xf1\x05\x95\x9e\xd1\x14"
-DATA ·d+44528(SB)/8,$"\x5d\x00\x5d\xec\x8d\x23\x9c\xf2"
-DATA ·d+44536(SB)/8,$"\x59\x8e\x4f\x5a\xdd\x62\x31\x33"
-DATA ·d+44544(SB)/8,$"\x97\x2b\x8d\xbc\xff\x00\xad\x4d"
-DATA ·d+44552(SB)/8,$"\xff\x00\xb2\x95\x46\xd5\x36\xd5"
-DATA ·d+44560(SB)/8,$"\xbf\x99\xb7\x67\xe5\xf9\x1e\xd1"
-DATA ·d+44568(SB)/8,$"\xf0\xe7\x48\xd6\x3c\x5e\x65\xb7"
-DATA ·d+44576(SB)/8,$"\xd3\x2d\xb4\x5d\x26\x3b\x40\x65"
-DATA ·d+44584(SB)/8,$"\x9e\xee\xfa\xf2\x38\x62\x90\x01"
-DATA ·d+44592(SB)/8,$"\x8c\x1f\x31\x86\x18\xf5\xf9\x45"
-DATA ·d+44600(SB)/8,$"\x2f\x88\x35\x6f\x0c\xb5\xb4\x96"
-DATA ·d+44608(SB)/8,$"\xac\x63\x49\x59\x1d\x6e\x1a\xce"
-DATA ·d+44616(SB)/8,$"\x50\xd0\x89\x17\xfd\x5c\xaa\xd9"
-DATA ·d+44624(SB)/8,$"\xce\x49\xe0\xe3\xa8\xed\x5e\x03"
-DATA ·d+44632(SB)/8,$"\xa4\xf8\xbe\xca\xe6\xe3\xcd\xf1"
-DATA ·d+44640(SB)/8,$"\xe5\xed\xd5\xfc\x61\x8b\x1b\x5b"
-DATA ·d+44648(SB)/8,$"\x79\x14\x29\x90\xf6\xc1\xc0\x07"
-DATA ·d+44656(SB)/8,$"\x8e\x5b\x1f\x4a\xef\x2d\xfe\x29"
-DATA ·d+44664(SB)/8,$"\x7c\x30\xb0\xb1\x6b\x7b\x3f\x0a"
-DATA ·d+44672(SB)/8,$"\x99\x67\x66\xdd\x6b\x2b\xc8\x1e"
-DATA ·d+44680(SB)/8,$"\x48\xdd\x1b\x20\xf2\xb8\x71\xea"
-DATA ·d+44688(SB)/8,$"\x36\xd5\xf2\x5a\xf1\xad\x5f\x4e"
-DATA ·d+44696(SB)/8,$"\xc9\x6c\x79\x78\xce\x15\x92\xad"
-DATA ·d+44704(SB)/8,$"\xed\x14\x1d\xd7\x6d\x9f\xcd\xbf"
-DATA ·d+44712(SB)/8,$"\xcc\xf5\xbf\x04\x6a\xcb\xe1\x2d"
-DATA ·d+44720(SB)/8,$"\x51\x2d\x7f\xb5\x25\x9d\xda\x3c"
-DATA ·d+44728(SB)/8,$"\x45\x15\xaf\xef\x55\xde\x40\x08"
-DATA ·d+44736(SB)/8,$"\x8d\xd8\x81\xb1\x4e\x7e\xf2\x92"
-DATA ·d+44744(SB)/8,$"\x47\xe9\x5d\x5c\xba\x27\x86\xbc"
-DATA ·d+44752(SB)/8,$"\x4b\x78\xba\x77\x8d\x21\x4b\xad"
-DATA ·d+44760(SB)/8,$"\x41\xee\x88\x73\x0c\xae\x22\x8a"
-DATA ·d+44768(SB)/8,$"\xd7\x6e\x44\xa0\xa2\xe4\x95\xe4"
-DATA ·d+44776(SB)/8,$"\x36\x17\x9a\xf1\x9d\x3b\xe2\x6a"
-DATA ·d+44784(SB)/8,$"\x58\x5b\x49\x69\xaa\x59\xcb\x0f"
-DATA ·d+44792(SB)/8,$"\x9a\x1a\xef\x4e\xb8\xb3\xb6\x8d"
-DATA ·d+44800(SB)/8,$"\x6e\x2d\x6e\x64\xf5\xe0\x66\x27"
-DATA ·d+44808(SB)/8,$"\xc6\x19\x4e\x3d\x54\x83\x49\x6d"
-DATA ·d+44816(SB)/8,$"\x1f\x88\xa6\xd0\x23\xf1\x4e\xad"
-DATA ·d+44824(SB)/8,$"\x29\xb6\x79\xcc\x91\x0f\x3a\x42"
-DATA ·d+44832(SB)/8,$"\x8c\xcb\x92\x1b\x63\x37\x19\xf6"
-DATA ·d+44840(SB)/8,$"\xc8\xe6\xba\x29\x63\x29\x52\x72"
-DATA ·d+44848(SB)/8,$"\x71\x9b\x97\x6b\xec\xfd\x0f\x23"
-DATA ·d+44856(SB)/8,$"\x13\x94\x4d\x4d\x54\xf8\x7c\xd6"
-DATA ·d+44864(SB)/8,$"\xff\x00\xf0\x7c\xec\x7a\x3e\xab"
-DATA ·d+44872(SB)/8,$"\xf0\x3b\xc2\x51\xb5\xdd\xdf\x86"
-DATA ·d+44880(SB)/8,$"\xfc\x45\x0b\xa5\xb3\x66\x3b\x99"
-DATA ·d+44888(SB)/8,$"\x06\xe4\x79\x00\x07\x64\x32\x9e"
-DATA ·d+44896(SB)/8,$"\x7c\xc1\xd3\x04\x0e\x47\x51\x5c"
-DATA ·d+44904(SB)/8,$"\xaf\x89\xbc\x11\xe2\x3f\x1f\x4b"
-DATA ·d+44912(SB)/8,$"\x3d\xfd\xce\xb5\xa7\xdd\x5d\xbd"
-DATA ·d+44920(SB)/8,$"\xa4\x6a\xcf\x71\x09\x86\xe6\x4f"
-DATA ·d+44928(SB)/8,$"\x23\x0a\x82\x32\x3e\x56\x93\x00"
-DATA ·d+44936(SB)/8,$"\x65\xb2\x3a\xd7\x6d\xe1\x2b\xeb"
-DATA ·d+44944(SB)/8,$"\xbd\x07\xc3\x52\x69\x33\x68\xb6"
-DATA ·d+44952(SB)/8,$"\xd7\x11\x6a\x6c\x24\x4b\xb8\xee"
-DATA ·d+44960(SB)/8,$"\xa0\x8e\x02\x81\x40\x2c\x11\x9b"
-DATA ·d+44968(SB)/8,$"\xef\x83\xd7\x91\xcd\x6c\x6b\xba"
-DATA ·d+44976(SB)/8,$"\x3e\x91\x67\xa9\xcf\x26\xa3\x63"
-DATA ·d+44984(SB)/8,$"\x1d\xbd\xac\x56\x32\xdc\xe9\xe9"
-DATA ·d+44992(SB)/8,$"\x07\xfa\xdf\x33\x01\x80\x91\x91"
-DATA ·d+45000(SB)/8,$"\xe4\x43\xd4\x65\x81\xc0\x5c\x1e"
-DATA ·d+45008(SB)/8,$"\xb5\xcc\xb3\xac\x3c\xdc\xd5\x4a"
-DATA ·d+45016(SB)/8,$"\x16\x92\x7a\x3e\xeb\xd3\x51\xe2"
-DATA ·d+45024(SB)/8,$"\xa5\x8b\xc3\x4e\x35\x63\x53\x9d"
-DATA ·d+45032(SB)/8,$"\x5b\xa2\xbb\x5e\xb7\xff\x00\x86"
-DATA ·d+45040(SB)/8,$"\x3f\x30\xb5\xaf\x0b\xda\xe8\x1a"
-DATA ·d+45048(SB)/8,$"\x83\x41\xe2\x48\xae\xe2\x71\x21"
-DATA ·d+45056(SB)/8,$"\xdc\x85\x76\xbe\x01\xe4\xfc\xfe"
-DATA ·d+45064(SB)/8,$"\xf5\x75\x2f\x3e\x18\x20\x46\x45"
-DATA ·d+45072(SB)/8,$"\xd5\xd5\xc0\x3b\x8e\xe8\x88\xcf"
-DATA ·d+45080(SB)/8,$"\xb0\xaf\xa1\xfe\x28\xda\xda\xfc"
-DATA ·d+45088(SB)/8,$"\x41\x51\x75\xa9\xc7\x69\x6b\xa9"
-DATA ·d+45096(SB)/8,$"\x9b\x99\x03\x4f\x04\xaf\x20\xfb"
-DATA ·d+45104(SB)/8,$"\x3e\x06\x37\x92\x36\x97\x24\x67"
-DATA ·d+45112(SB)/8,$"\x8e\xd9\xaf\x8d\xf5\xbf\x0d\x5e"
-DATA ·d+45120(SB)/8,$"\xd9\x6a\x52\xd9\xd9\x8f\x3d\x51"
-DATA ·d+45128(SB)/8,$"\x8e\xc2\x01\xf9\x87\xb6\x2b\xe8"
-DATA ·d+45136(SB)/8,$"\x30\xf9\xca\xa7\xfc\x28\x2f\xb8"
-DATA ·d+45144(SB)/8,$"\xfd\xc3\x87\x33\x08\x66\x34\x92"
-DATA ·d+45152(SB)/8,$"\xc4\x54\x70\x92\xdd\x27\xa7\xdf"
-DATA ·d+45160(SB)/8,$"\xb3\x3b\x29\xef\xb4\xab\x9c\xff"
-DATA ·d+45168(SB)/8,$"\x00\x67\x82\xc9\xce\x0c\xc0\x67"
-DATA ·d+45176(SB)/8,$"\xe8\x7a\xd7\x1d\x79\xf6\xf4\x9b"
-DATA ·d+45184(SB)/8,$"\x75\xa1\x45\xe3\x9c\x01\xfc\xa8"
-DATA ·d+45192(SB)/8,$"\x87\x43\xd7\x3c\xa0\xb3\xda\xde"
-DATA ·d+45200(SB)/8,$"\xaa\x82\x03\x04\x8d\x86\xde\xfc"
-DATA ·d+45208(SB)/8,$"\xe6\xbb\xbb\x6f\x86\xda\xad\xed"
-DATA ·d+45216(SB)/8,$"\x9f\x9c\xb7\x89\x14\xcc\x00\x8a"
-DATA ·d+45224(SB)/8,$"\xda\x70\xcb\x2b\x83\xce\x42\xe0"
-DATA ·d+45232(SB)/8,$"\xf0\x07\x39\x24\x0a\xf4\xa3\x8f"
-DATA ·d+45240(SB)/8,$"\x75\xfd\xe9\x68\xfc\xb4\x3d\xe7"
-DATA ·d+45248(SB)/8,$"\x53\x0d\x86\xd6\x55\x53\x5e\x7a"
-DATA ·d+45256(SB)/8,$"\x9c\x35\xbe\xa3\xe2\x07\xc2\x13"
-DATA ·d+45264(SB)/8,$"\x1c\x99\xfe\x1d\x98\xfe\x55\x77"
-DATA ·d+45272(SB)/8,$"\x76\xb1\x06\x25\xbc\xb3\x6d\xa4"
-DATA ·d+45280(SB)/8,$"\xe3\x70\x43\x8f\x6c\x53\x75\x9f"
-DATA ·d+45288(SB)/8,$"\x0b\x78\x97\x41\xb9\x78\x6f\xc3"
-DATA ·d+45296(SB)/8,$"\x23\x82\x33\x82\x31\x92\x38\xc6"
-DATA ·d+45304(SB)/8,$"\x3d\x7a\xd5\x7b\x4f\x12\x78\x8f"
-DATA ·d+45312(SB)/8,$"\x4e\x6f\x9e\xee\x6c\x7f\x74\x61"
-DATA ·d+45320(SB)/8,$"\x87\xe2\x0e\x6a\xa1\x98\x72\x3e"
-DATA ·d+45328(SB)/8,$"\x5a\xce\x5f\x99\xe8\x4e\x11\xab"
-DATA ·d+45336(SB)/8,$"\x05\x53\x0f\xca\xd7\xcf\xf4\x26"
-DATA ·d+45344(SB)/8,$"\xb9\xb0\xb7\xbc\x43\x30\x57\x56"
-DATA ·d+45352(SB)/8,$"\x3d\x54\x8c\x7d\x78\x35\x8b\x36"
-DATA ·d+45360(SB)/8,$"\x98\x07\xca\xe1\x71\xd5\x0b\x62"
-DATA ·d+45368(SB)/8,$"\xba\xb8\xfc\x65\x65\x70\xe6\x3b"
-DATA ·d+45376(SB)/8,$"\xe8\xc8\x63\x9c\xb2\x8c\x72\x7e"
-DATA ·d+45384(SB)/8,$"\xbd\xaa\xac\xcb\x6f\xa8\xc6\x5a"
-DATA ·d+45392(SB)/8,$"\x0c\xf4\x39\x3d\x47\x3e\xfc\xe2"
-DATA ·d+45400(SB)/8,$"\xaf\x15\x3c\x34\xa3\xcd\x4e\x57"
-DATA ·d+45408(SB)/8,$"\x66\x34\x6b\x57\xa6\xed\x52\x2e"
-DATA ·d+45416(SB)/8,$"\x28\xe4\x52\x28\x20\x97\x33\x44"
-DATA ·d+45424(SB)/8,$"\x03\x0e\x84\x55\xe6\xbb\xb6\x18"
-DATA ·d+45432(SB)/8,$"\x31\x47\x8e\xc4\x9a\x35\x0f\x0f"
-DATA ·d+45440(SB)/8,$"\xeb\x8e\x8b\x2c\x50\x4c\xf1\xee"
-DATA ·d+45448(SB)/8,$"\xc2\x48\x11\x88\x27\xf2\xc1\xfc"
-DATA ·d+45456(SB)/8,$"\xea\xad\xa6\x93\xaf\x5b\x4a\x77"
-DATA ·d+45464(SB)/8,$"\xdb\x4a\x5d\x7e\x61\x95\x23\x8e"
-DATA ·d+45472(SB)/8,$"\xd9\xce\x2b\xe6\xe7\x89\x94\x2f"
-DATA ·d+45480(SB)/8,$"\xa1\xec\x7b\x4a\x53\x8f\x3f\xb4"
-DATA ·d+45488(SB)/8,$"\x5f\x79\xad\x0a\xc7\x3c\xbb\x63"
-DATA ·d+45496(SB)/8,$"\x57\x7c\x9e\x02\xf3\xd7\xd0\x77"
-DATA ·d+45504(SB)/8,$"\xaf\x7e\xf0\x37\x84\x2d\xf5\x6d"
-DATA ·d+45512(SB)/8,$"\x04\x0d\x42\x49\x2c\xd3\xed\x97"
-DATA ·d+45520(SB)/8,$"\x05\x95\xa1\x63\xba\x48\xa3\x18"
-DATA ·d+45528(SB)/8,$"\x8c\x33\x60\x2b\x30\xec\x7a\x75"
-DATA ·d+45536(SB)/8,$"\xae\xb7\xe0\x8e\x93\x17\x86\xf4"
-DATA ·d+45544(SB)/8,$"\x0b\xed\x6f\xc4\xf6\x9e\x1b\xbf"
-DATA ·d+45552(SB)/8,$"\xfe\xd2\xb0\x7b\x7b\x7b\x5b\x99"
-DATA ·d+45560(SB)/8,$"\xd5\xaf\x2c\x9f\x7e\x45\xc4\x61"
-DATA ·d+45568(SB)/8,$"\x48\x31\x48\x7f\x87\x39\xca\xf6"
-DATA ·d+45576(SB)/8,$"\x15\xe8\xed\xf1\xaa\x6f\x0b\x78"
-DATA ·d+45584(SB)/8,$"\x7a\x6d\x23\xc3\xed\x1f\x95\x79"
-DATA ·d+45592(SB)/8,$"\x71\x19\xbc\x85\xa6\x6b\x98\x5f"
-DATA ·d+45600(SB)/8,$"\x66\x14\x3b\xa4\xd9\x42\x47\x03"
-DATA ·d+45608(SB)/8,$"\x76\x32\x00\x03\x35\xe4\x62\x33"
-DATA ·d+45616(SB)/8,$"\x07\x27\xee\x2b\xfe\x16\x3f\x2f"
-DATA ·d+45624(SB)/8,$"\xe2\x4c\xf2\xac\xeb\x4f\x0b\x86"
-DATA ·d+45632(SB)/8,$"\xa6\xef\x16\xac\xef\xa3\xd0\xf2"
-DATA ·d+45640(SB)/8,$"\xfd\x23\x4e\xf8\x5f\x73\x1b\x4d"
-DATA ·d+45648(SB)/8,$"\x73\xe2\x0b\xab\x69\xda\x3f\x96"
-DATA ·d+45656(SB)/8,$"\x03\x6e\x5c\x6e\x07\x01\x4c\x98"
-DATA ·d+45664(SB)/8,$"\xf4\xe7\x3d\x3b\x55\x67\xb5\xd0"
-DATA ·d+45672(SB)/8,$"\xf4\x4b\xa6\xbb\xf0\xb6\xbc\xb7"
-DATA ·d+45680(SB)/8,$"\x7e\x63\x35\xbb\xc6\x22\x60\xf8"
-DATA ·d+45688(SB)/8,$"\x61\x9e\x72\x40\x00\x9e\x31\x8e"
-DATA ·d+45696(SB)/8,$"\xb5\xd4\x43\xe2\x7d\x2b\xc4\x32"
-DATA ·d+45704(SB)/8,$"\x4e\xd7\xba\x2d\x9d\xcb\x41\x1b"
-DATA ·d+45712(SB)/8,$"\x4b\x71\x73\x1f\x96\x82\x38\x54"
-DATA ·d+45720(SB)/8,$"\xe0\xb8\xc2\xae\x58\x7a\x06\xaf"
-DATA ·d+45728(SB)/8,$"\x53\xf8\x69\xf0\xc3\x4d\xbc\x4f"
-DATA ·d+45736(SB)/8,$"\xb5\x5b\xe8\xdf\xda\x77\x13\x79"
-DATA ·d+45744(SB)/8,$"\x93\x5b\x45\xe4\xee\x44\x8e\x12"
-DATA ·d+45752(SB)/8,$"\x09\x92\x41\x29\x40\xa8\x41\xc9"
-DATA ·d+45760(SB)/8,$"\xc6\x78\xa4\xa7\x0b\x7e\xf2\x2b"
-DATA ·d+45768(SB)/8,$"\xe6\xcf\x92\xc4\x63\x9e\x1d\x4a"
-DATA ·d+45776(SB)/8,$"\x75\xdc\xb6\xd5\x37\x06\xbf\x24"
-DATA ·d+45784(SB)/8,$"\xf6\xee\x78\x8e\x99\xf1\x6f\xc7"
-DATA ·d+45792(SB)/8,$"\xbe\x1a\x82\xeb\xc3\xba\x2b\xe9"
-DATA ·d+45800(SB)/8,$"\xef\x06\xab\x6d\x25\x9c\xd1\x43"
-DATA ·d+45808(SB)/8,$"\x6c\x8f\x3a\xa3\xe0\x33\x09\x18"
-DATA ·d+45816(SB)/8,$"\x16\x49\x38\xce\xf0\x77\x0e\x70"
-DATA ·d+45824(SB)/8,$"\x6b\xe9\x8b\x2b\x4d\x3f\xe3\x56"
-DATA ·d+45832(SB)/8,$"\x99\xfd\xa9\xf1\x36\xe3\xc1\xda"
-DATA ·d+45840(SB)/8,$"\x64\xda\x5e\x97\x84\xb9\x5b\x0b"
-DATA ·d+45848(SB)/8,$"\xa5\xbe\x9e\x3b\x75\x50\xb1\x95"
-DATA ·d+45856(SB)/8,$"\xb1\x06\x39\x24\x60\x06\x19\xd4"
-DATA ·d+45864(SB)/8,$"\x01\xc9\x63\xc9\xaf\x3f\xf1\xad"
-DATA ·d+45872(SB)/8,$"\xe7\xc3\xef\x0a\xea\x71\xda\x5c"
-DATA ·d+45880(SB)/8,$"\xdb\xda\xe9\xba\x88\x88\x47\x34"
-DATA ·d+45888(SB)/8,$"\x76\xd6\xe2\x24\x75\x56\xe1\x95"
-DATA ·d+45896(SB)/8,$"\xb7\x31\x6d\xfc\xee\x23\x15\xaf"
-DATA ·d+45904(SB)/8,$"\xf0\xe3\x46\xd6\xbe\x2b\x5d\xdd"
-DATA ·d+45912(SB)/8,$"\xdb\xfc\x3a\xb3\x82\xe6\x6b\x78"
-DATA ·d+45920(SB)/8,$"\x4c\xae\x80\x49\x12\x80\xc7\x84"
-DATA ·d+45928(SB)/8,$"\x52\xcb\xb0\x12\x7a\x02\x47\xb6"
-DATA ·d+45936(SB)/8,$"\x71\x57\x5a\x8e\x0e\xfc\xf5\x24"
-DATA ·d+45944(SB)/8,$"\xa3\xe9\xb9\xe6\x62\xb1\xd1\x9d"
-DATA ·d+45952(SB)/8,$"\x28\x62\xb0\xd4\x39\x2f\xaf\x35"
-DATA ·d+45960(SB)/8,$"\xad\x7b\xf5\xba\xef\xe4\x4b\xac"
-DATA ·d+45968(SB)/8,$"\xfe\xd2\xb0\x1f\x87\xcd\xf0\x87"
-DATA ·d+45976(SB)/8,$"\x4f\xf0\xa2\xe8\xda\x24\xb7\x36"
-DATA ·d+45984(SB)/8,$"\xf7\x3a\x8d\xed\xb5\xf5\xd5\xf8"
-DATA ·d+45992(SB)/8,$"\xbd\x92\xd4\x96\x81\xe4\xb7\x60"
-DATA ·d+46000(SB)/8,$"\x12\x1f\x2c\x9c\x2a\xa6\xe0\xbc"
-DATA ·d+46008(SB)/8,$"\xf2\x73\x5e\x7f\xa6\xcb\xe1\x0d"
-DATA ·d+46016(SB)/8,$"\x62\xf5\x6f\x34\x48\xec\x65\x85"
-DATA ·d+46024(SB)/8,$"\x88\x79\xa1\x82\x66\x8a\x58\xd8"
-DATA ·d+46032(SB)/8,$"\xff\x00\xd3\x3d\xcb\xd3\xe8\x08"
-DATA ·d+46040(SB)/8,$"\xaf\xad\x75\x88\xfe\x0a\x7f\xc2"
-DATA ·d+46048(SB)/8,$"\x88\xd4\x6f\x75\x7d\x47\xc5\x76"
-DATA ·d+46056(SB)/8,$"\xde\x3a\xb5\x95\x6d\xac\x74\x84"
-DATA ·d+46064(SB)/8,$"\xd3\x6c\x2e\x74\x8b\x96\x18\x0c"
-DATA ·d+46072(SB)/8,$"\x24\xba\x45\x6b\x94\x20\x86\xc9"
-DATA ·d+46080(SB)/8,$"\xce\x38\x07\x15\xf1\x7e\x97\xe0"
-DATA ·d+46088(SB)/8,$"\x4b\x6f\x16\xea\x6d\x1f\x89\xac"
-DATA ·d+46096(SB)/8,$"\xe2\xd1\x12\x3b\x94\xb7\xbb\xd6"
-DATA ·d+46104(SB)/8,$"\x6f\xcb\xda\x5b\xda\x19\x18\x01"
-DATA ·d+46112(SB)/8,$"\xf6\x89\x02\xb1\x87\x82\x0e\xe2"
-DATA ·d+46120(SB)/8,$"\xb8\x03\xad\x65\x42\x74\xa7\x19"
-DATA ·d+46128(SB)/8,$"\xcf\x0d\x52\x4b\xbd\xd3\x57\xd3"
-DATA ·d+46136(SB)/8,$"\xa7\x72\xf0\xf4\xe8\xd5\xa6\xda"
-DATA ·d+46144(SB)/8,$"\x8f\xb2\xff\x00\x04\xaf\xab\xee"
-DATA ·d+46152(SB)/8,$"\xb4\x6d\xb7\xd9\xff\x00\x91\xeb"
-DATA ·d+46160(SB)/8,$"\x9a\x69\x8f\xc3\x97\x52\xeb\x5e"
-DATA ·d+46168(SB)/8,$"\x15\x9e\x58\x2f\xe7\x22\x29\x1d"
-DATA ·d+46176(SB)/8,$"\x5d\x84\x9e\x4a\x7f\x7e\x46\x3d"
-DATA ·d+46184(SB)/8,$"\x32\x7e\x51\xfa\xd6\xad\xf7\x8c"
-DATA ·d+46192(SB)/8,$"\x75\x40\xc3\x4f\xbf\xd4\x65\x9a"
-DATA ·d+46200(SB)/8,$"\xcc\x97\x95\xa1\x9e\x57\x31\x17"
-DATA ·d+46208(SB)/8,$"\x11\xfc\xac\xc3\x6b\x28\x6d\xdf"
-DATA ·d+46216(SB)/8,$"\x37\x19\x3c\x63\xa1\xaf\x3c\x79"
-DATA ·d+46224(SB)/8,$"\xbe\x18\x78\x0b\xc5\x97\xbe\x14"
-DATA ·d+46232(SB)/8,$"\xd6\xfc\x43\x79\xa9\x68\xb6\xf7"
-DATA ·d+46240(SB)/8,$"\x2d\x6d\x67\xe2\x1d\x36\x24\xb9"
-DATA ·d+46248(SB)/8,$"\x67\x50\xbb\xb7\xbc\x01\xc3\x3a"
-DATA ·d+46256(SB)/8,$"\x76\x57\x8c\xfc\xdc\x1c\x73\x5d"
-DATA ·d+46264(SB)/8,$"\x4f\x89\x6d\x3e\x1c\x5e\xf8\x76"
-DATA ·d+46272(SB)/8,$"\x4d\x53\xc2\x3e\x2f\x49\xf3\x08"
-DATA ·d+46280(SB)/8,$"\xfe\xcf\xb6\xb8\xd3\xef\x74\xe9"
-DATA ·d+46288(SB)/8,$"\x0c\xa0\xe7\x70\x32\x46\xf1\xb6"
-DATA ·d+46296(SB)/8,$"\x3b\x82\x71\xe8\x6b\x29\xc9\xf3"
-DATA ·d+46304(SB)/8,$"\x29\xcd\x4b\x6d\x1a\x5a\xfd\xfd"
-DATA ·d+46312(SB)/8,$"\x0f\x22\xa6\x47\x5e\x15\x97\xb6"
-DATA ·d+46320(SB)/8,$"\x4e\x51\x7a\x29\x34\xda\xf4\xdb"
-DATA ·d+46328(SB)/8,$"\xf3\x2f\xf8\x07\xc4\x7a\x3e\x83"
-DATA ·d+46336(SB)/8,$"\x75\x73\x7f\xe2\xbb\x2b\x7d\x76"
-DATA ·d+46344(SB)/8,$"\xd2\xf2\xc9\x13\x4f\xb4\x78\x15"
-DATA ·d+46352(SB)/8,$"\x6e\x6d\xee\xa3\x66\x71\x3c\x02"
-DATA ·d+46360(SB)/8,$"\x74\x11\xc9\x11\xc0\x59\xb3\x92"
-DATA ·d+46368(SB)/8,$"\x46\x05\x7b\xff\x00\x81\x7e\x1f"
-DATA ·d+46376(SB)/8,$"\xfe\xd0\xbe\x3b\xb7\xd2\xfc\x53"
-DATA ·d+46384(SB)/8,$"\xe0\x4f\x07\x4b\x35\xad\xd0\x0f"
-DATA ·d+46392(SB)/8,$"\x61\x74\xd7\xd0\x5b\xd8\x3a\x11"
-DATA ·d+46400(SB)/8,$"\xfe\xab\x6c\x4c\x91\x85\x3d\xc1"
-DATA ·d+46408(SB)/8,$"\xc3\x76\x35\xf1\xd2\xde\x7c\x4a"
-DATA ·d+46416(SB)/8,$"\xb6\xd1\xb4\xbb\xbd\x57\x50\x85"
-DATA ·d+46424(SB)/8,$"\xa0\xd2\xae\x9e\x4d\x39\xa3\xdb"
-DATA ·d+46432(SB)/8,$"\x27\x94\xa5\xb7\x31\x44\xda\x19"
-DATA ·d+46440(SB)/8,$"\xd5\xb2\x48\x1c\xf3\xd8\x57\xdd"
-DATA ·d+46448(SB)/8,$"\xbf\xb2\x2f\xed\xab\x67\xf0\x32"
-DATA ·d+46456(SB)/8,$"\xcb\x59\xf0\x77\x8e\x34\x86\xd4"
-DATA ·d+46464(SB)/8,$"\x74\x6b\xdd\x4e\xe3\x58\x81\x6d"
-DATA ·d+46472(SB)/8,$"\x61\x90\x5c\x79\xb3\xa7\xcc\x15"
-DATA ·d+46480(SB)/8,$"\x18\x2c\x48\x8e\xf9\x90\x82\xc3"
-DATA ·d+46488(SB)/8,$"\x0c\x4d\x79\x99\xcd\x7c\x65\x15"
-DATA ·d+46496(SB)/8,$"\xed\x70\x94\x9d\x4b\xb5\x74\xdb"
-DATA ·d+46504(SB)/8,$"\xbd\xb5\xd5\x7a\x69\xa5\xce\xac"
-DATA ·d+46512(SB)/8,$"\x56\x45\x47\x11\x4e\x7e\xd2\x49"
-DATA ·d+46520(SB)/8,$"\x49\x2d\x37\x71\x76\x7b\x3b\x35"
-DATA ·d+46528(SB)/8,$"\xd3\xad\xbb\xee\x75\x7a\x9f\xfc"
-DATA ·d+46536(SB)/8,$"\x13\xd3\xf6\xa2\xf1\x2e\x2e\xf5"
-DATA ·d+46544(SB)/8,$"\x1d\x0b\xc1\xd6\x73\xce\xc5\xd2"
-DATA ·d+46552(SB)/8,$"\x2b\x09\x8c\x12\xc2\xec\x33\x83"
-DATA ·d+46560(SB)/8,$"\x20\x47\x0b\x92\x07\x0c\x76\x67"
-DATA ·d+46568(SB)/8,$"\xb8\xe6\xbe\x20\xf8\x9d\xf0\x9f"
-DATA ·d+46576(SB)/8,$"\xe2\x47\x82\xb5\xb9\xfc\x3f\xe3"
-DATA ·d+46584(SB)/8,$"\x61\x35\x86\xa9\xa5\x8f\x32\xea"
-DATA ·d+46592(SB)/8,$"\xd7\x53\x6b\x59\x99\x60\x7e\x3c"
-DATA ·d+46600(SB)/8,$"\xc4\x96\x26\x21\x94\xb1\x01\x50"
-DATA ·d+46608(SB)/8,$"\xe4\x90\x43\x0a\xfd\x54\xf1\x6f"
-DATA ·d+46616(SB)/8,$"\xfc\x15\xe7\x50\xbf\xd0\xe1\xd3"
-DATA ·d+46624(SB)/8,$"\xb4\x1f\x07\x69\xd6\xcb\x6e\xc4"
-DATA ·d+46632(SB)/8,$"\x45\x71\x3e\xf7\x62\xb8\xc6\x1d"
-DATA ·d+46640(SB)/8,$"\x50\xa2\x73\xdc\x03\x8a\xfc\xd8"
-DATA ·d+46648(SB)/8,$"\xf1\xdf\x8e\x6c\xbe\x3e\xf8\xbf"
-DATA ·d+46656(SB)/8,$"\x5b\xf1\xd7\x8e\x64\xbf\x87\x50"
-DATA ·d+46664(SB)/8,$"\xd6\x5c\x4c\x21\xd3\x85\xba\x5a"
-DATA ·d+46672(SB)/8,$"\x20\x8d\x76\xa8\x61\x28\xde\xaa"
-DATA ·d+46680(SB)/8,$"\x14\x01\x95\x6c\xf1\x5d\x5c\x2f"
-DATA ·d+46688(SB)/8,$"\x85\xcd\xf1\x55\x26\xb1\x78\x75"
-DATA ·d+46696(SB)/8,$"\x08\xaf\x87\x6b\xfc\xf5\x61\x38"
-DATA ·d+46704(SB)/8,$"\x43\x0b\x4e\x9b\x52\xbb\xfb\x4e"
-DATA ·d+46712(SB)/8,$"\xda\x2f\x44\xd5\xcf\x0f\xb2\xf0"
-DATA ·d+46720(SB)/8,$"\xf6\xa4\x9a\xb1\xd3\x75\x7b\xad"
-DATA ·d+46728(SB)/8,$"\x2a\xd2\xeb\xec\x02\xea\x2f\x2d"
-DATA ·d+46736(SB)/8,$"\x9e\xcd\xe4\x0c\x37\x7e\xec\x0c"
-DATA ·d+46744(SB)/8,$"\xab\xbe\x07\x23\x80\x49\x1c\x76"
-DATA ·d+46752(SB)/8,$"\xa8\x25\x7b\xdb\x5d\x5a\x7b\xad"
-DATA ·d+46760(SB)/8,$"\x6e\x4b\xe8\xa1\xdc\xc6\x7b\x8d"
-DATA ·d+46768(SB)/8,$"\x41\x0c\xce\x38\x18\x32\x03\x9e"
-DATA ·d+46776(SB)/8,$"\x4f\x4e\x0f\xa7\x4a\xfa\x67\xc2"
-DATA ·d+46784(SB)/8,$"\xda\x3f\x82\x34\x2d\x15\xbf\xb5"
-DATA ·d+46792(SB)/8,$"\x3c\x47\x24\x3a\x88\xb1\x68\x2c"
-DATA ·d+46800(SB)/8,$"\x6d\xce\x8e\xd7\xad\xf6\x76\x7d"
-DATA ·d+46808(SB)/8,$"\xcc\xaf\x77\x9c\x42\x57\x03\x61"
-DATA ·d+46816(SB)/8,$"\xdb\xc0\x25\x45\x79\x4d\x86\xb5"
-DATA ·d+46824(SB)/8,$"\xf0\xd3\x52\xf1\x05\xd6\x9b\xac"
-DATA ·d+46832(SB)/8,$"\x5f\x6b\xaa\xf7\xcc\x7e\xd7\x3c"
-DATA ·d+46840(SB)/8,$"\x76\x2a\x6c\x9d\x94\xee\x51\x87"
-DATA ·d+46848(SB)/8,$"\x0c\x40\xc8\xe0\xee\xc8\xeb\x5f"
-DATA ·d+46856(SB)/8,$"\x4e\xb2\xe5\x1a\xde\xca\x6f\xde"
-DATA ·d+46864(SB)/8,$"\xbe\xc6\x34\xb1\xf5\x2b\xf3\x54"
-DATA ·d+46872(SB)/8,$"\x54\xf9\xa1\x6d\xd2\xf3\xd5\xad"
-DATA ·d+46880(SB)/8,$"\x34\xfb\xde\xa5\x4b\x8d\x47\x41"
-DATA ·d+46888(SB)/8,$"\xd0\xb4\x84\xb6\xfb\x1d\xd4\xef"
-DATA ·d+46896(SB)/8,$"\x2c\x44\x07\x82\xde\xd2\xd4\x48"
-DATA ·d+46904(SB)/8,$"\x8d\xcf\xcc\x40\x92\x43\xd7\xa9"
-DATA ·d+46912(SB)/8,$"\x35\xe3\xb3\x6a\x7a\x15\xe5\xfc"
-DATA ·d+46920(SB)/8,$"\x76\x51\xe9\x57\x56\x8c\x58\x33"
-DATA ·d+46928(SB)/8,$"\xcc\xb3\xab\xaa\xb0\xf9\x7e\xeb"
-DATA ·d+46936(SB)/8,$"\x2a\x80\xbd\xfd\xbd\x2b\xea\x5d"
-DATA ·d+46944(SB)/8,$"\x2f\xc0\x9e\x09\xbd\xbf\xe2\xe6"
-DATA ·d+46952(SB)/8,$"\x5b\xc7\x3b\x8c\x2b\x23\x28\xc0"
-DATA ·d+46960(SB)/8,$"\x5e\x54\x6d\xe8\xcb\x8f\x43\x91"
-DATA ·d+46968(SB)/8,$"\xe8\x6b\x77\x52\xb5\xd1\x3c\x37"
-DATA ·d+46976(SB)/8,$"\xa7\x46\x9a\x69\xd3\xae\x2e\xae"
-DATA ·d+46984(SB)/8,$"\x23\x92\x2b\x9b\x0b\x8b\x37\x0f"
-DATA ·d+46992(SB)/8,$"\x6e\x03\x60\x60\xc8\x4e\xf2\x47"
-DATA ·d+47000(SB)/8,$"\xcc\x1e\x3c\x01\xd0\x9c\xd7\xa9"
-DATA ·d+47008(SB)/8,$"\x1c\xba\x9d\x39\x2a\x55\x5b\x8b"
-DATA ·d+47016(SB)/8,$"\x7b\x68\xff\x00\xcb\x4f\x99\xe5"
-DATA ·d+47024(SB)/8,$"\x60\xf8\x86\x9d\x37\x3e\x4a\x6e"
-DATA ·d+47032(SB)/8,$"\x4f\x76\xdc\xad\xe5\xb7\x5b\x7a"
-DATA ·d+47040(SB)/8,$"\x1f\x2d\xdc\xdd\xbc\x00\xda\x36"
-DATA ·d+47048(SB)/8,$"\xef\x2d\x49\x54\x2a\xea\x7e\x5f"
-DATA ·d+47056(SB)/8,$"\x40\x4b\x05\x20\xf5\xe3\x07\x9e"
-DATA ·d+47064(SB)/8,$"\xbc\x56\x05\xcd\xcd\xde\xad\xaa"
-DATA ·d+47072(SB)/8,$"\x35\x83\x4a\x20\xb6\x5c\xcd\x14"
-DATA ·d+47080(SB)/8,$"\xbe\x68\x6f\x28\x81\xd5\x82\x86"
-DATA ·d+47088(SB)/8,$"\xe7\xd1\x41\x24\xd7\xd5\x72\xe9"
-DATA ·d+47096(SB)/8,$"\x9a\x45\xfe\x85\x36\x93\x61\x6d"
-DATA ·d+47104(SB)/8,$"\xe5\x4f\x6d\x0f\xda\x63\x68\x17"
-DATA ·d+47112(SB)/8,$"\xe4\x77\x8c\xe4\x96\xc8\x39\x6c"
-DATA ·d+47120(SB)/8,$"\x1e\x33\xe9\x5e\x3d\x73\xa8\x78"
-DATA ·d+47128(SB)/8,$"\xde\x0f\x2f\x51\xba\xd3\x6f\xf6"
-DATA ·d+47136(SB)/8,$"\xcc\x0a\x7d\xa8\xc5\xb2\x39\x15"
-DATA ·d+47144(SB)/8,$"\x5b\x07\x05\x54\x29\x00\xf0\x6b"
-DATA ·d+47152(SB)/8,$"\xb3\xfb\x29\x41\xb6\xaa\xa6\xbe"
-DATA ·d+47160(SB)/8,$"\x6c\xf4\x32\x7c\xfe\x15\x94\xa5"
-DATA ·d+47168(SB)/8,$"\x0a\x56\x6b\x4d\x5d\xbe\x6b\x73"
-DATA ·d+47176(SB)/8,$"\xb1\xf0\x6e\xbf\xf1\x1e\xef\x58"
-DATA ·d+47184(SB)/8,$"\xb1\xb5\xb3\xd5\xe2\xb3\x79\x9a"
-DATA ·d+47192(SB)/8,$"\x0b\x28\x27\xb9\x86\x08\x84\xb9"
-DATA ·d+47200(SB)/8,$"\x6d\xaa\xfc\x01\xb7\x82\x4e\x58"
-DATA ·d+47208(SB)/8,$"\x67\x03\x26\xbd\xd3\xc6\xdf\x09"
-DATA ·d+47216(SB)/8,$"\xf5\xd4\xf1\x73\xdb\xfc\x40\x9f"
-DATA ·d+47224(SB)/8,$"\xc4\x1e\x23\x96\x08\x50\xbd\xf9"
-DATA ·d+47232(SB)/8,$"\x8c\x45\x62\x8b\x20\x26\x30\x8f"
-DATA ·d+47240(SB)/8,$"\x36\xd5\x65\x61\xca\x90\x31\xe9"
-DATA ·d+47248(SB)/8,$"\xeb\x5e\x23\xa6\x78\x07\xc5\x5e"
-DATA ·d+47256(SB)/8,$"\x33\xbd\x84\xac\x11\x5b\xdb\x4b"
-DATA ·d+47264(SB)/8,$"\x13\x79\x37\x1a\x8d\xca\x5a\xac"
-DATA ·d+47272(SB)/8,$"\x78\x04\xee\x8e\x56\x65\xc0\x1d"
-DATA ·d+47280(SB)/8,$"\x47\x3c\xfe\x95\xf4\x4f\x8e\x3f"
-DATA ·d+47288(SB)/8,$"\x6a\x7f\x8a\x1a\xc6\x9f\x0e\x87"
-DATA ·d+47296(SB)/8,$"\xe3\x69\xb4\xeb\x7b\x8b\x0b\x04"
-DATA ·d+47304(SB)/8,$"\xd3\x86\xbb\xa3\x44\xb3\xcb\x76"
-DATA ·d+47312(SB)/8,$"\x96\xe9\x88\xbc\xf6\x1b\x94\x8c"
-DATA ·d+47320(SB)/8,$"\xf1\x95\x5f\x94\x33\x10\x06\x48"
-DATA ·d+47328(SB)/8,$"\xaf\x33\x12\xb1\x14\xb1\x14\xa7"
-DATA ·d+47336(SB)/8,$"\x42\x8a\xa9\x0d\x54\x9f\x67\xa5"
-DATA ·d+47344(SB)/8,$"\x9e\xfb\x5a\xf7\xeb\x7b\x1f\x3f"
-DATA ·d+47352(SB)/8,$"\x98\xe1\xe9\x56\x8c\xa7\x86\x71"
-DATA ·d+47360(SB)/8,$"\x85\x6d\xb4\x49\x68\xfa\x26\x95"
-DATA ·d+47368(SB)/8,$"\xdb\xdb\xaa\x47\x17\xaa\xfc\x33"
-DATA ·d+47376(SB)/8,$"\xf0\x5f\xfc\x22\xba\x97\x89\xf5"
-DATA ·d+47384(SB)/8,$"\x5b\x6d\x66\xd4\x69\x6d\x6f\x0c"
-DATA ·d+47392(SB)/8,$"\x30\xd9\x98\xa4\x8d\xfc\xcc\x8e"
-DATA ·d+47400(SB)/8,$"\x18\x93\xb7\x18\x24\xe3\x70\xcf"
-DATA ·d+47408(SB)/8,$"\x4c\x66\xbc\x7f\x50\xbc\xf0\x75"
-DATA ·d+47416(SB)/8,$"\xec\x90\x59\x78\x4f\x4f\xd6\xf5"
-DATA ·d+47424(SB)/8,$"\x2b\xb0\xe3\x72\x5f\xdd\xda\xac"
-DATA ·d+47432(SB)/8,$"\x78\x1d\x76\x81\x1e\xe0\x7f\x12"
-DATA ·d+47440(SB)/8,$"\x2b\xca\x3c\x49\xe3\xdf\x1d\xf8"
-DATA ·d+47448(SB)/8,$"\x8e\xd9\x34\xdb\xeb\xcb\x89\xed"
-DATA ·d+47456(SB)/8,$"\xed\xe5\x79\x05\xbc\x4f\xf2\x21"
-DATA ·d+47464(SB)/8,$"\x90\x82\x5b\x62\x63\x77\xae\xe2"
-DATA ·d+47472(SB)/8,$"\x09\x1e\xd5\x2f\x87\x74\xab\x7b"
-DATA ·d+47480(SB)/8,$"\xe9\x23\xba\x9b\x57\xb3\xb7\x8c"
-DATA ·d+47488(SB)/8,$"\xed\xde\xc7\xef\x21\x3e\xdc\x1e"
-DATA ·d+47496(SB)/8,$"\x3b\x9e\x95\xea\xd6\xc7\xd1\x69"
-DATA ·d+47504(SB)/8,$"\xaa\xfe\xef\x6b\x2d\xbe\xf3\xd4"
-DATA ·d+47512(SB)/8,$"\xc0\xf0\xf5\x7c\x35\x17\x53\x17"
-DATA ·d+47520(SB)/8,$"\x57\x99\xeb\x65\xef\x34\xbb\x6d"
-DATA ·d+47528(SB)/8,$"\xaf\xe2\x8e\xce\xef\x49\xfb\x74"
-DATA ·d+47536(SB)/8,$"\xd7\x1a\x80\xd3\xdb\x4f\x89\x19"
-DATA ·d+47544(SB)/8,$"\x62\xbd\x91\xae\x54\xc5\x16\xf6"
-DATA ·d+47552(SB)/8,$"\xc1\xca\xb2\xaa\x81\xc1\xe0\x7e"
-DATA ·d+47560(SB)/8,$"\x75\xe8\x56\x7e\x06\xf0\xa6\xb1"
-DATA ·d+47568(SB)/8,$"\x6f\x0c\x5a\x6f\x8a\x34\x64\x18"
-DATA ·d+47576(SB)/8,$"\xe2\x26\x58\xed\xc0\x24\x7d\xd6"
-DATA ·d+47584(SB)/8,$"\x92\x30\xd9\x04\xf1\xbb\x81\xeb"
-DATA ·d+47592(SB)/8,$"\x5c\xb6\x99\xaa\xf8\x57\xfb\x26"
-DATA ·d+47600(SB)/8,$"\xea\xd9\x35\x88\xef\x75\x59\x59"
-DATA ·d+47608(SB)/8,$"\xad\xd0\x3e\xfb\x7b\x79\x23\xe8"
-DATA ·d+47616(SB)/8,$"\x4b\x3b\x86\x46\x38\xfb\xb9\xc6"
-DATA ·d+47624(SB)/8,$"\x48\xe4\xf4\x35\xe6\x3e\x20\xf0"
-DATA ·d+47632(SB)/8,$"\xf5\xa6\x83\x6c\x2d\xa1\x29\x01"
-DATA ·d+47640(SB)/8,$"\x25\x25\x05\x55\x64\x2a\xac\x3a"
-DATA ·d+47648(SB)/8,$"\x17\x0e\xf8\xe7\x3d\xba\x57\x93"
-DATA ·d+47656(SB)/8,$"\x4f\x13\x4a\xf1\xad\x0b\xb5\x7d"
-DATA ·d+47664(SB)/8,$"\xac\x95\xff\x00\x04\xcd\x25\x85"
-DATA ·d+47672(SB)/8,$"\xa9\x55\xba\x13\xa8\xe0\xd3\xd3"
-DATA ·d+47680(SB)/8,$"\x4b\xa6\xad\xe6\xdf\xdf\xfe\x47"
-DATA ·d+47688(SB)/8,$"\xae\xc1\xa7\x68\xf6\xd3\x5c\xd8"
-DATA ·d+47696(SB)/8,$"\x4d\x19\xb9\x68\x78\xb8\x31\xce"
-DATA ·d+47704(SB)/8,$"\x02\x28\x1d\xd4\x2f\x0d\xf5\xaf"
-DATA ·d+47712(SB)/8,$"\x3d\xba\x87\x40\x82\xe6\x48\x1b"
-DATA ·d+47720(SB)/8,$"\xcb\x42\x92\x32\x14\x69\xdc\x15"
-DATA ·d+47728(SB)/8,$"\x20\xe3\x04\x79\x5c\x11\xe9\x59"
-DATA ·d+47736(SB)/8,$"\x3a\x56\x87\xa8\x68\xb1\xff\x00"
-DATA ·d+47744(SB)/8,$"\x6e\xcb\x7b\x14\x13\x70\xd1\x5b"
-DATA ·d+47752(SB)/8,$"\xc8\x77\x19\xc1\x1c\x7c\xa3\x3d"
-DATA ·d+47760(SB)/8,$"\x73\xc0\xaf\x45\x11\xf8\xba\xe8"
-DATA ·d+47768(SB)/8,$"\x0b\x97\xd1\xef\x1c\xc9\xfb\xc2"
-DATA ·d+47776(SB)/8,$"\xe6\x17\x3b\x8b\x73\x9c\xe3\xbd"
-DATA ·d+47784(SB)/8,$"\x7d\x03\xc7\xc2\xa6\xb0\xa4\x92"
-DATA ·d+47792(SB)/8,$"\x39\x2a\xe1\x9d\x17\xee\xd6\xe6"
-DATA ·d+47800(SB)/8,$"\xbf\x5b\xdb\x55\xbf\x53\xff\xd7"
-DATA ·d+47808(SB)/8,$"\xfc\x61\xf1\x26\xa1\xf0\xeb\x40"
-DATA ·d+47816(SB)/8,$"\xd7\xd3\xc3\xfa\x16\x99\x24\xb2"
-DATA ·d+47824(SB)/8,$"\x42\x83\xed\x37\x2c\xaa\xe5\x9f"
-DATA ·d+47832(SB)/8,$"\xb0\x64\x8d\x56\x28\xf1\xfd\xd4"
-DATA ·d+47840(SB)/8,$"\xe7\xd4\xd7\xad\xc3\xe1\x0d\x33"
-DATA ·d+47848(SB)/8,$"\x4b\xf0\x68\xf1\x77\x89\x34\xbb"
-DATA ·d+47856(SB)/8,$"\x5b\xab\x0b\xc0\xb7\x51\x49\x15"
-DATA ·d+47864(SB)/8,$"\xed\xa3\xa2\x81\x85\x2a\xf6\x51"
-DATA ·d+47872(SB)/8,$"\xb2\xc8\x1d\x87\xa6\xd6\x02\xbe"
-DATA ·d+47880(SB)/8,$"\x0c\x97\xc6\xd7\x4f\x20\x98\x47"
-DATA ·d+47888(SB)/8,$"\x6f\x02\x89\x4b\x6c\x8b\x97\x6f"
-DATA ·d+47896(SB)/8,$"\xab\x9e\xbf\x52\x3a\xd7\x5d\x69"
-DATA ·d+47904(SB)/8,$"\x6b\x75\xe3\xfb\x33\x63\x64\xd1"
-DATA ·d+47912(SB)/8,$"\x43\xa8\xac\xab\x1a\x40\xf7\x09"
-DATA ·d+47920(SB)/8,$"\x0c\xcc\xcc\xc1\x12\x34\x85\x86"
-DATA ·d+47928(SB)/8,$"\x5e\x49\x09\xe3\x3f\x5e\x05\x7e"
-DATA ·d+47936(SB)/8,$"\xf3\x88\xce\x30\x18\x6a\x50\x8a"
-DATA ·d+47944(SB)/8,$"\xb3\xb6\xef\x97\xf5\x6f\x4f\x5f"
-DATA ·d+47952(SB)/8,$"\xc8\xff\x00\x2e\x7f\xd5\xea\x9e"
-DATA ·d+47960(SB)/8,$"\xd1\x4e\x57\x4b\xaf\xbd\x7f\xc2"
-DATA ·d+47968(SB)/8,$"\xdf\x93\xfb\xcf\xaf\x7e\x20\x78"
-DATA ·d+47976(SB)/8,$"\x23\xe0\xd7\x86\xfc\x2d\x6f\xe2"
-DATA ·d+47984(SB)/8,$"\x4b\x8d\x37\xed\x47\x5b\xd2\x85"
-DATA ·d+47992(SB)/8,$"\xe5\xa5\x86\x9f\x73\x77\x60\xd6"
-DATA ·d+48000(SB)/8,$"\x92\x17\xf2\x56\x29\x86\xfb\x84"
-DATA ·d+48008(SB)/8,$"\x90\xb1\xf9\x83\x23\x1f\xee\xe0"
-DATA ·d+48016(SB)/8,$"\x1a\xf8\xb6\x04\xf0\xd7\xdb\x66"
-DATA ·d+48024(SB)/8,$"\xd2\xec\x2d\xe7\x98\x4a\xfb\x6d"
-DATA ·d+48032(SB)/8,$"\xed\xde\x53\x3b\x2b\x9e\x02\xa9"
-DATA ·d+48040(SB)/8,$"\x23\x2d\xd7\x80\x06\x4d\x7d\x3d"
-DATA ·d+48048(SB)/8,$"\x71\x79\xe3\xaf\x81\xda\x3d\xa5"
-DATA ·d+48056(SB)/8,$"\xaf\x8d\xed\x8d\x91\xb6\x86\xe2"
-DATA ·d+48064(SB)/8,$"\xca\x18\xee\xbc\xa9\xe3\x30\xde"
-DATA ·d+48072(SB)/8,$"\x0d\xb2\xa1\x44\x95\x86\xec\xae"
-DATA ·d+48080(SB)/8,$"\xf5\x60\xa0\x86\x1c\x63\x3c\xfc"
-DATA ·d+48088(SB)/8,$"\xe7\x15\x8f\xc3\xdd\x12\xfa\x07"
-DATA ·d+48096(SB)/8,$"\x82\x2d\x46\x48\xee\x4a\xde\xda"
-DATA ·d+48104(SB)/8,$"\xc9\x19\x55\x24\x06\x38\xda\xc1"
-DATA ·d+48112(SB)/8,$"\x83\x21\x04\x1e\x3e\xf0\xeb\x8e"
-DATA ·d+48120(SB)/8,$"\x95\xe6\xe1\x33\x4a\x3c\x8a\x53"
-DATA ·d+48128(SB)/8,$"\xe4\x92\xef\xcd\xbf\xc9\x5e\xdf"
-DATA ·d+48136(SB)/8,$"\xd5\xce\xdc\xbd\x55\xe6\xa8\x97"
-DATA ·d+48144(SB)/8,$"\x3c\x93\x6f\x96\xcb\x4f\xd3\xa6"
-DATA ·d+48152(SB)/8,$"\xbf\xe4\x73\x7f\xf0\x8f\xf8\x64"
-DATA ·d+48160(SB)/8,$"\x5f\x96\xbb\xb3\x91\x64\xb5\xe6"
-DATA ·d+48168(SB)/8,$"\x68\xd9\x4c\x6c\xbd\x82\x1d\xc1"
-DATA ·d+48176(SB)/8,$"\x58\x13\xd4\x71\x5a\x36\xfa\x8d"
-DATA ·d+48184(SB)/8,$"\x84\x1a\x14\x36\xda\x7d\x8f\x93"
-DATA ·d+48192(SB)/8,$"\x1b\x2f\x9e\x6f\x5d\x5a\x46\x6d"
-DATA ·d+48200(SB)/8,$"\xbd\x59\x58\xe4\x72\x0e\x31\x8a"
-DATA ·d+48208(SB)/8,$"\xee\xaf\x35\x8f\x85\xda\xa2\x44"
-DATA ·d+48216(SB)/8,$"\xd6\x5a\x75\xf5\xbd\xda\x89\x3e"
-DATA ·d+48224(SB)/8,$"\xd5\x79\x25\xd3\x4f\xe7\x64\xfc"
-DATA ·d+48232(SB)/8,$"\xb8\x8d\x86\xd4\x0a\x38\x23\x71"
-DATA ·d+48240(SB)/8,$"\xdd\x9c\x9c\x1a\xc6\xd4\x26\xf8"
-DATA ·d+48248(SB)/8,$"\x63\x26\xaa\xfa\x5e\x90\x2f\x63"
-DATA ·d+48256(SB)/8,$"\xb6\x45\x0a\x2d\xa7\xbe\x68\x63"
-DATA ·d+48264(SB)/8,$"\x04\x0c\xb1\xfd\xe6\x00\x27\x39"
-DATA ·d+48272(SB)/8,$"\x18\xce\x2a\x67\x57\x0b\x29\x7b"
-DATA ·d+48280(SB)/8,$"\x68\x54\x87\xdf\xb7\xc8\xed\xad"
-DATA ·d+48288(SB)/8,$"\x88\xab\xfc\x1a\xb4\xe6\xf6\x7b"
-DATA ·d+48296(SB)/8,$"\x68\x62\x69\x3a\xc4\x5a\xb5\xd3"
-DATA ·d+48304(SB)/8,$"\x5b\xdb\x3a\xe3\x60\x3f\xe9\x31"
-DATA ·d+48312(SB)/8,$"\xb2\x2e\xd1\xe9\x21\x1f\x8f\xb5"
-DATA ·d+48320(SB)/8,$"\x76\xb6\x3f\x14\x2f\xf4\x7b\x68"
-DATA ·d+48328(SB)/8,$"\x6d\xbc\x45\x6b\x1e\xb5\xa4\xc7"
-DATA ·d+48336(SB)/8,$"\x75\x25\x8c\xa8\xb3\x15\x20\x0e"
-DATA ·d+48344(SB)/8,$"\x5b\x64\xa8\x79\x1b\x4f\xca\x18"
-DATA ·d+48352(SB)/8,$"\x75\xfe\x20\x2b\xc1\xf5\xcb\xfb"
-DATA ·d+48360(SB)/8,$"\x2b\x9d\x42\x58\x74\x08\x64\x86"
-DATA ·d+48368(SB)/8,$"\xcf\xee\xc6\x92\x4f\xf6\x87\x60"
-DATA ·d+48376(SB)/8,$"\x3b\x92\x38\x39\xfa\x62\xbb\xef"
-DATA ·d+48384(SB)/8,$"\x0f\xf8\x77\x4d\xbc\xd2\xb7\x49"
-DATA ·d+48392(SB)/8,$"\xa8\x5e\x40\x5f\x26\x7b\x65\xb7"
-DATA ·d+48400(SB)/8,$"\xda\xa5\x87\xdd\xd9\xce\x09\xc7"
-DATA ·d+48408(SB)/8,$"\xf1\x60\x7a\x1a\xbc\x4e\x0b\xeb"
-DATA ·d+48416(SB)/8,$"\x69\x45\x59\xdb\xb6\x9f\x8e\xe2"
-DATA ·d+48424(SB)/8,$"\xaf\x87\xa1\x45\x2a\xb5\x76\x7d"
-DATA ·d+48432(SB)/8,$"\x1a\xbf\xdf\x63\xf4\x93\xe1\x4d"
-DATA ·d+48440(SB)/8,$"\x9d\xbf\xc7\x29\x74\xfd\x23\xc1"
-DATA ·d+48448(SB)/8,$"\x76\xfa\x5d\xa6\x93\xaa\xc8\xf6"
-DATA ·d+48456(SB)/8,$"\x56\x52\xd9\xda\x05\xbf\xb0\xba"
-DATA ·d+48464(SB)/8,$"\xb4\x0a\xd1\xc6\x11\xd8\xc6\x5c"
-DATA ·d+48472(SB)/8,$"\x83\xbc\x0d\xfb\x5c\x64\x9e\x01"
-DATA ·d+48480(SB)/8,$"\xaf\x1c\xf8\xf3\x7c\xbf\x0e\xf5"
-DATA ·d+48488(SB)/8,$"\xad\x5a\x0d\x53\x51\xd4\x35\x49"
-DATA ·d+48496(SB)/8,$"\x23\xb7\xb8\xb4\x86\xea\xe7\x4a"
-DATA ·d+48504(SB)/8,$"\xb7\xb4\x73\x35\xc1\xdb\x10\x67"
-DATA ·d+48512(SB)/8,$"\x88\x94\xcc\x91\x21\x6f\x90\xfc"
-DATA ·d+48520(SB)/8,$"\xab\xd7\xef\x0c\xf9\xdf\xec\xd5"
-DATA ·d+48528(SB)/8,$"\x7f\xa1\x78\x4b\xc6\x8f\xa2\xdc"
-DATA ·d+48536(SB)/8,$"\xf8\xd7\xc6\xde\x16\xbc\x5b\xe8"
-DATA ·d+48544(SB)/8,$"\xe4\xb3\xb9\xf0\xad\x84\x5a\x80"
-DATA ·d+48552(SB)/8,$"\x9d\x59\x0a\xb6\x2d\x9f\x27\xcd"
-DATA ·d+48560(SB)/8,$"\x01\x99\x72\x08\x18\x24\x66\xaf"
-DATA ·d+48568(SB)/8,$"\xfc\x57\xf0\x3e\xa1\xe2\xdf\x1e"
-DATA ·d+48576(SB)/8,$"\x5d\xc9\x7b\xad\xdd\xde\x69\xb7"
-DATA ·d+48584(SB)/8,$"\xc5\x75\x0d\x2c\xeb\x91\x8b\x3b"
-DATA ·d+48592(SB)/8,$"\x97\x99\xa3\x58\x83\x5d\x5b\x03"
-DATA ·d+48600(SB)/8,$"\x98\x4b\x46\x80\x80\x4b\x00\xb8"
-DATA ·d+48608(SB)/8,$"\x1c\x66\xbf\x31\xc3\x70\xfe\x33"
-DATA ·d+48616(SB)/8,$"\x0d\x98\x4e\x9c\x6f\xc8\xb5\x77"
-DATA ·d+48624(SB)/8,$"\x4d\xea\xdf\x77\xe5\x6d\x99\xd5"
-DATA ·d+48632(SB)/8,$"\x98\xd4\xc3\x4d\x2a\x95\x9d\xd4"
-DATA ·d+48640(SB)/8,$"\x96\x96\xd3\x4d\x2c\xb4\xb5\xfa"
-DATA ·d+48648(SB)/8,$"\xee\x8f\x96\x6e\xad\x65\xbe\xd1"
-DATA ·d+48656(SB)/8,$"\xe7\xd5\x05\xdd\xac\x4b\x13\xaa"
-DATA ·d+48664(SB)/8,$"\x28\x77\xdc\xec\x18\x80\x70\x14"
-DATA ·d+48672(SB)/8,$"\x67\xe5\xcf\x35\xdf\x7c\x1c\xf0"
-DATA ·d+48680(SB)/8,$"\x4e\x93\x7d\xaa\xc6\x3c\xb3\x24"
-DATA ·d+48688(SB)/8,$"\xef\x27\x94\x0c\xa9\xe5\xac\x8e"
-DATA ·d+48696(SB)/8,$"\xc0\x95\x25\x9c\xe5\x4f\xa2\x90"
-DATA ·d+48704(SB)/8,$"\x32\x39\xce\x2b\x0a\xf3\x44\xd7"
-DATA ·d+48712(SB)/8,$"\xf4\x1b\x64\x87\x51\x82\xcd\xe1"
-DATA ·d+48720(SB)/8,$"\xb6\x2f\x13\x0b\x19\x20\xfd\xe1"
-DATA ·d+48728(SB)/8,$"\x70\x51\x48\x94\x13\x21\xeb\x90"
-DATA ·d+48736(SB)/8,$"\xd8\xe3\xd3\x9c\xd7\x23\xa4\xea"
-DATA ·d+48744(SB)/8,$"\xda\xaf\x87\x66\xb9\xb3\xba\xf3"
-DATA ·d+48752(SB)/8,$"\x12\x2f\x32\x39\x2d\x8d\xa8\x91"
-DATA ·d+48760(SB)/8,$"\x77\x3a\x8c\x01\x26\x4b\x16\x50"
-DATA ·d+48768(SB)/8,$"\x73\xc8\x3d\x79\xc1\x15\xf4\x78"
-DATA ·d+48776(SB)/8,$"\xcc\x45\x48\xd3\x6a\x11\xd7\xb9"
-DATA ·d+48784(SB)/8,$"\xdd\x87\xc3\x29\xd2\x94\x21\x2d"
-DATA ·d+48792(SB)/8,$"\x3b\x79\x7f\x5e\x67\xd5\x7a\xc6"
-DATA ·d+48800(SB)/8,$"\xaf\xa8\x69\x77\x72\xe9\xaa\xf0"
-DATA ·d+48808(SB)/8,$"\xc7\x1c\x1b\xa1\x74\x6b\x6f\xb5"
-DATA ·d+48816(SB)/8,$"\x3e\xe5\x3b\x0b\x6e\x8d\x4c\x64"
-DATA ·d+48824(SB)/8,$"\x75\xc6\x09\xe2\xbc\x73\xc5\xfe"
-DATA ·d+48832(SB)/8,$"\x12\x9e\xc9\x60\xd4\x45\xcd\x8e"
-DATA ·d+48840(SB)/8,$"\xa1\xa6\xdd\x6e\x2b\x75\x61\x31"
-DATA ·d+48848(SB)/8,$"\x53\x1b\x83\x82\x93\x46\xca\xb2"
-DATA ·d+48856(SB)/8,$"\x44\xc3\xa0\x56\x00\x7a\x13\x5c"
-DATA ·d+48864(SB)/8,$"\x3d\xd4\x36\x50\x22\xf8\x86\xc3"
-DATA ·d+48872(SB)/8,$"\x50\x9a\x2b\x92\x76\xf9\x2f\x79"
-DATA ·d+48880(SB)/8,$"\x26\x54\xb7\x39\xf2\xc2\xaa\xb9"
-DATA ·d+48888(SB)/8,$"\x4e\x47\x51\x9e\x33\x46\xa3\xa5"
-DATA ·d+48896(SB)/8,$"\x7c\x53\xf1\xec\x57\x1a\xe7\x86"
-DATA ·d+48904(SB)/8,$"\x74\x4d\x6f\x59\x86\xdd\xe3\x59"
-DATA ·d+48912(SB)/8,$"\xb5\x04\xb3\x92\x55\x65\x18\x50"
-DATA ·d+48920(SB)/8,$"\xb3\xcc\x8a\x02\x8c\x83\xf7\x8f"
-DATA ·d+48928(SB)/8,$"\xb0\xa8\xc1\xe6\x75\x20\xe2\x9b"
-DATA ·d+48936(SB)/8,$"\xd3\xa9\xc9\x47\x23\x8a\x9f\x34"
-DATA ·d+48944(SB)/8,$"\x25\x6f\x5d\x2d\xfe\x7e\x47\x97"
-DATA ·d+48952(SB)/8,$"\xf8\x83\xc3\xfe\x1b\xd5\xb5\x79"
-DATA ·d+48960(SB)/8,$"\x2c\x74\xfb\xbb\xb8\xf7\x81\xb1"
-DATA ·d+48968(SB)/8,$"\x9d\x84\x8a\xce\x38\xf9\x43\x10"
-DATA ·d+48976(SB)/8,$"\x71\xf8\xd6\x6d\xef\xec\xf3\xf1"
-DATA ·d+48984(SB)/8,$"\x37\x4f\x8a\x3d\x52\x1d\x3e\xe6"
-DATA ·d+48992(SB)/8,$"\x7b\x49\xb2\xf1\xdd\xac\x12\x18"
-DATA ·d+49000(SB)/8,$"\x99\x47\x70\xca\x18\x7d\x46\x78"
-DATA ·d+49008(SB)/8,$"\xaf\xaf\x2c\x3c\x5d\xae\xeb\x3a"
-DATA ·d+49016(SB)/8,$"\x4e\x9f\xe1\x4f\x88\x3e\x19\xf0"
-DATA ·d+49024(SB)/8,$"\x6e\x9d\xfd\x96\x8e\x3e\xd6\x2d"
-DATA ·d+49032(SB)/8,$"\xd6\xd3\x53\xb9\x0f\xf3\x06\xba"
-DATA ·d+49040(SB)/8,$"\x94\x4a\xc6\x62\x80\x61\x06\xd1"
-DATA ·d+49048(SB)/8,$"\x81\xc6\x2b\x56\xff\x00\xe2\xc4"
-DATA ·d+49056(SB)/8,$"\xde\x1f\x82\x0d\x07\x46\x69\xaf"
-DATA ·d+49064(SB)/8,$"\xb4\xd8\xcf\x9b\xe5\x96\x92\xde"
-DATA ·d+49072(SB)/8,$"\xd8\xb1\x39\x28\x17\xa9\x19\x3c"
-DATA ·d+49080(SB)/8,$"\xe3\x1b\xab\xea\xf0\x98\x9a\x55"
-DATA ·d+49088(SB)/8,$"\xda\x52\xbd\xce\xba\x9c\x59\x98"
-DATA ·d+49096(SB)/8,$"\xe1\x66\xa8\xe1\xa2\x9a\x4b\x5b"
-DATA ·d+49104(SB)/8,$"\xbb\xaf\x93\xb2\x3e\x2c\x97\xe0"
-DATA ·d+49112(SB)/8,$"\xed\xec\x76\xbf\x68\xd5\x52\xee"
-DATA ·d+49120(SB)/8,$"\x36\x55\x05\x92\x38\x58\xae\x0f"
-DATA ·d+49128(SB)/8,$"\xb9\x03\x06\xbe\x99\xf0\x47\xec"
-DATA ·d+49136(SB)/8,$"\xc7\xf0\x96\x1d\x3a\xcb\x58\xf1"
-DATA ·d+49144(SB)/8,$"\xf7\xc4\x8b\x5f\x0c\x41\x77\x2b"
-DATA ·d+49152(SB)/8,$"\x1f\xb3\x4f\xa5\x5c\x5f\xdd\x2c"
-DATA ·d+49160(SB)/8,$"\x28\xa3\xe7\x64\xb5\x7c\x8d\xec"
-DATA ·d+49168(SB)/8,$"\x48\x40\x39\x23\xe6\xc6\x38\xae"
-DATA ·d+49176(SB)/8,$"\xce\xd3\xc5\x16\x3a\xb5\xcc\xcf"
-DATA ·d+49184(SB)/8,$"\xab\x5a\x4b\xf6\x69\x30\x51\x34"
-DATA ·d+49192(SB)/8,$"\xf9\x64\x45\xb7\x2d\xc6\x41\x39"
-DATA ·d+49200(SB)/8,$"\xdd\xd7\x38\x2d\x8a\xf5\x4f\x18"
-DATA ·d+49208(SB)/8,$"\x7c\x36\xd0\xf4\x3d\x26\x1d\x4a"
-DATA ·d+49216(SB)/8,$"\xd6\x09\xb6\x4c\x89\x14\x46\xf6"
-DATA ·d+49224(SB)/8,$"\x14\xb8\x46\x93\xae\xe2\x13\xe6"
-DATA ·d+49232(SB)/8,$"\xc1\x1d\x41\x07\x8e\x98\xc5\x4e"
-DATA ·d+49240(SB)/8,$"\x69\x52\x8a\x9d\x3a\x14\xea\x38"
-DATA ·d+49248(SB)/8,$"\x4a\x5e\x86\xf0\xe2\x7c\xca\xa5"
-DATA ·d+49256(SB)/8,$"\x29\x54\xab\xf0\xf9\x7f\xc1\x45"
-DATA ·d+49264(SB)/8,$"\xed\x4b\xc7\xdf\x0a\x7c\x19\xa6"
-DATA ·d+49272(SB)/8,$"\x6a\x9a\x57\x86\x6e\xa4\xd6\xa6"
-DATA ·d+49280(SB)/8,$"\x96\x38\x20\xd3\x2f\x6c\x2c\x44"
-DATA ·d+49288(SB)/8,$"\x76\x29\x6b\x02\x92\xd0\x05\x9b"
-DATA ·d+49296(SB)/8,$"\x7c\x9b\x9f\x23\x2e\xbb\x73\xce"
-DATA ·d+49304(SB)/8,$"\x71\xd0\xfc\x7f\xe1\xed\x1b\x48"
-DATA ·d+49312(SB)/8,$"\xf1\x20\xd4\x7c\x57\xe2\xf9\xd2"
-DATA ·d+49320(SB)/8,$"\x38\xac\xa3\x13\xcd\x04\x44\x7d"
-DATA ·d+49328(SB)/8,$"\xae\xe2\x49\x9f\x6a\xc3\x02\x13"
-DATA ·d+49336(SB)/8,$"\x8c\xb3\x75\x24\x61\x14\x64\x8a"
-DATA ·d+49344(SB)/8,$"\xfa\x7a\xe3\xc0\x5e\x18\xd6\x0c"
-DATA ·d+49352(SB)/8,$"\x9a\x8f\xc2\x9d\x73\x46\xb4\xbf"
-DATA ·d+49360(SB)/8,$"\xb2\x8b\xed\x12\xe9\x97\x37\x82"
-DATA ·d+49368(SB)/8,$"\xda\xe5\x64\x45\xcb\xac\x3e\x68"
-DATA ·d+49376(SB)/8,$"\x51\x26\x4f\xdd\x1d\x6b\xc5\x75"
-DATA ·d+49384(SB)/8,$"\x9f\x0a\xe9\xfe\x2d\xb9\x91\xbc"
-DATA ·d+49392(SB)/8,$"\x72\xb3\x69\x7a\xac\xe4\x98\xb5"
-DATA ·d+49400(SB)/8,$"\x2b\x78\x43\xdb\xca\xd8\x00\x09"
-DATA ·d+49408(SB)/8,$"\xd6\x3c\x04\x19\x1c\xc9\x1f\x39"
-DATA ·d+49416(SB)/8,$"\x39\x2b\x5e\x83\xc8\x6a\xd2\x84"
-DATA ·d+49424(SB)/8,$"\xea\x50\xad\xcf\xcd\x6b\xbb\x5e"
-DATA ·d+49432(SB)/8,$"\xdd\x36\xe9\xfa\x79\x9f\x27\x92"
-DATA ·d+49440(SB)/8,$"\xe3\xa8\xa9\x72\xd4\x8b\x85\xf4"
-DATA ·d+49448(SB)/8,$"\xde\xce\xd7\x6f\xe7\x7b\xee\x8e"
-DATA ·d+49456(SB)/8,$"\x37\xc4\xdf\x0e\xb5\x9f\x0f\xe9"
-DATA ·d+49464(SB)/8,$"\xf6\x1a\xe6\xa9\xe1\x1b\xdd\x2e"
-DATA ·d+49472(SB)/8,$"\x0b\xa3\xe7\x59\xdd\xcd\x77\xb9"
-DATA ·d+49480(SB)/8,$"\xa7\x47\x39\x88\x80\x18\xe3\x6f"
-DATA ·d+49488(SB)/8,$"\x1d\x00\x27\xda\xb1\xf4\x1d\x13"
-DATA ·d+49496(SB)/8,$"\x44\xf8\x85\xe2\x16\xd2\xad\x74"
-DATA ·d+49504(SB)/8,$"\xab\x9b\x9d\x42\x38\x6e\x2e\x2e"
-DATA ·d+49512(SB)/8,$"\x6d\xac\xee\x51\x64\xb8\xfb\x3c"
-DATA ·d+49520(SB)/8,$"\x7b\xd8\xc6\x1c\x05\x79\x00\x53"
-DATA ·d+49528(SB)/8,$"\x94\x3f\x7f\xb1\x06\xb0\x66\xf8"
-DATA ·d+49536(SB)/8,$"\x65\xe2\xd8\x2e\x05\x96\x9b\x2c"
-DATA ·d+49544(SB)/8,$"\x97\x27\x7b\x18\x5e\x09\xc3\xa1"
-DATA ·d+49552(SB)/8,$"\x20\xf6\x19\xe3\xf4\xaa\x76\x5a"
-DATA ·d+49560(SB)/8,$"\x27\xc4\x9f\x05\x78\x9a\x2d\x62"
-DATA ·d+49568(SB)/8,$"\xc6\xd2\xe2\xdb\x55\xb2\x95\x2e"
-DATA ·d+49576(SB)/8,$"\x22\x95\x22\x70\xdb\xd7\x9c\x95"
-DATA ·d+49584(SB)/8,$"\x1c\x1f\x72\x38\x20\x90\x7a\xd7"
-DATA ·d+49592(SB)/8,$"\x85\x5d\xe2\x5d\x29\x46\x7c\xae"
-DATA ·d+49600(SB)/8,$"\x7a\xed\xa7\xa6\xed\x9f\xa2\xd0"
-DATA ·d+49608(SB)/8,$"\xfa\xa4\xea\x73\x42\xaf\xbb\x6d"
-DATA ·d+49616(SB)/8,$"\x2e\xdd\xfe\x67\xa6\x5d\x7c\x38"
-DATA ·d+49624(SB)/8,$"\xd5\x34\x36\xb1\xf1\x47\xc3\x99"
-DATA ·d+49632(SB)/8,$"\x93\x5a\xd2\xaf\xc1\x8e\xd6\xe6"
-DATA ·d+49640(SB)/8,$"\xd9\x4f\x97\x71\xbd\x41\x9a\xca"
-DATA ·d+49648(SB)/8,$"\xe2\x0c\x97\x8e\x44\xce\xc6\x56"
-DATA ·d+49656(SB)/8,$"\x5c\x86\x19\x1e\x95\xef\x7e\x14"
-DATA ·d+49664(SB)/8,$"\xf1\x8f\x89\xf4\x3d\x2e\xdb\xc2"
-DATA ·d+49672(SB)/8,$"\x9e\x24\xf0\xfe\xae\xe3\x4e\x59"
-DATA ·d+49680(SB)/8,$"\x2f\xf4\xbb\x91\x2b\xc1\x71\x15"
-DATA ·d+49688(SB)/8,$"\xa6\x46\x43\xf2\x85\xd6\x3e\x41"
-DATA ·d+49696(SB)/8,$"\x65\x6d\xc4\x75\x07\x9a\xf0\x5d"
-DATA ·d+49704(SB)/8,$"\x22\x6f\x89\x77\xfe\x28\xb8\xf1"
-DATA ·d+49712(SB)/8,$"\x77\x86\x2d\x2e\x34\x4b\x67\xbf"
-DATA ·d+49720(SB)/8,$"\x96\xf2\x66\xb4\x6f\x96\x0b\xa9"
-DATA ·d+49728(SB)/8,$"\x14\x17\xd9\xf7\x5f\x05\xfe\x60"
-DATA ·d+49736(SB)/8,$"\x3f\x87\x38\x06\xb7\xb5\x8d\x41"
-DATA ·d+49744(SB)/8,$"\x77\x59\xbe\xaf\xe3\x36\xd6\xa7"
-DATA ·d+49752(SB)/8,$"\x16\xc2\x52\x2d\x1e\x49\x66\xb7"
-DATA ·d+49760(SB)/8,$"\x90\xe7\x30\xe2\x72\x07\x0c\x79"
-DATA ·d+49768(SB)/8,$"\x0a\x40\x1c\xd7\xcc\x56\xc2\x62"
-DATA ·d+49776(SB)/8,$"\x6b\xb5\x0a\x89\x73\x2e\xa9\xbb"
-DATA ·d+49784(SB)/8,$"\xfc\xd2\xd2\xff\x00\xd2\x3c\x5c"
-DATA ·d+49792(SB)/8,$"\xf2\x8d\x1a\xb6\xa7\x07\xcf\x1d"
-DATA ·d+49800(SB)/8,$"\x2f\xa5\xda\x7f\xe2\x4f\xa7\x9d"
-DATA ·d+49808(SB)/8,$"\xb4\x3d\x33\xe2\xbf\x8d\x3e\x14"
-DATA ·d+49816(SB)/8,$"\x7c\x62\xb6\x82\xea\xe7\x4c\xb8"
-DATA ·d+49824(SB)/8,$"\x8f\x50\xb3\x6d\xd1\x5c\xe9\xd1"
-DATA ·d+49832(SB)/8,$"\x4b\x3d\xbc\xec\x06\x0a\xb6\x55"
-DATA ·d+49840(SB)/8,$"\x76\x31\x1c\x92\xbb\x81\x38\x2c"
-DATA ·d+49848(SB)/8,$"\x38\xaf\x2f\xf0\x67\x8c\x75\xe6"
-DATA ·d+49856(SB)/8,$"\xbb\x83\x43\xf0\xcd\xf3\x45\x6f"
-DATA ·d+49864(SB)/8,$"\x3c\xe6\x27\xb1\x91\x9a\xcc\x21"
-DATA ·d+49872(SB)/8,$"\xfb\x89\xb8\x6e\x54\x90\x90\x72"
-DATA ·d+49880(SB)/8,$"\x59\xbe\xef\x5c\xe2\xae\xf8\x63"
-DATA ·d+49888(SB)/8,$"\xc6\x8f\xa0\xe9\x48\xda\x18\xbc"
-DATA ·d+49896(SB)/8,$"\x6b\xa7\x49\x22\x9d\x35\x19\x6d"
-DATA ·d+49904(SB)/8,$"\xe4\xb4\x49\xa6\x18\xf3\x21\x8a"
-DATA ·d+49912(SB)/8,$"\x32\x18\x70\x48\xfd\xe1\x3f\x4a"
-DATA ·d+49920(SB)/8,$"\xdc\xd1\xbe\x14\xf8\xbb\xc4\xc9"
-DATA ·d+49928(SB)/8,$"\x16\xa0\xba\x66\x9f\x73\x24\x8c"
-DATA ·d+49936(SB)/8,$"\x7c\xd9\x6e\x6e\xc2\x42\xf1\x7d"
-DATA ·d+49944(SB)/8,$"\xdf\xde\x22\x14\x31\xaa\xe3\xaa"
-DATA ·d+49952(SB)/8,$"\xee\xdd\x93\x9e\x95\xd5\x4f\x87"
-DATA ·d+49960(SB)/8,$"\xeb\xfb\x3b\x4e\xe9\xdf\x47\x27"
-DATA ·d+49968(SB)/8,$"\xfe\x76\x67\x9f\x46\x8d\x1a\x2a"
-DATA ·d+49976(SB)/8,$"\x54\x22\x97\x22\x5b\x5f\x45\xe8"
-DATA ·d+49984(SB)/8,$"\xad\x63\xdb\x5d\x3e\x03\xfc\x0f"
-DATA ·d+49992(SB)/8,$"\xb6\x17\x47\xc4\xda\x75\xee\xba"
-DATA ·d+50000(SB)/8,$"\x40\xfb\x7a\x5b\xd8\xdc\x6a\xd0"
-DATA ·d+50008(SB)/8,$"\xda\xba\xb8\x25\x20\xba\x0d\x0d"
-DATA ·d+50016(SB)/8,$"\xbc\x8f\x20\xc8\x94\xed\x28\xa3"
-DATA ·d+50024(SB)/8,$"\xe5\x07\x92\x6b\x95\xf1\x2f\xed"
-DATA ·d+50032(SB)/8,$"\x3d\xe1\xbd\x72\xfe\x5b\xdd\x27"
-DATA ·d+50040(SB)/8,$"\x40\xd6\x56\xde\xf2\xd5\xf4\xfb"
-DATA ·d+50048(SB)/8,$"\xfb\x9f\xb4\xa4\x09\x7d\x6f\x30"
-DATA ·d+50056(SB)/8,$"\x2b\x2c\x06\x1f\x29\xa3\x58\x4f"
-DATA ·d+50064(SB)/8,$"\x0c\x88\xa5\xb6\x95\x1c\xf2\x6b"
-DATA ·d+50072(SB)/8,$"\x93\xf1\x1f\xc0\x17\x16\x6d\xa8"
-DATA ·d+50080(SB)/8,$"\xf8\xbb\x51\xf0\xfe\x9d\xe4\x21"
-DATA ·d+50088(SB)/8,$"\x58\xac\xad\xe7\x9a\xe9\xdf\x9f"
-DATA ·d+50096(SB)/8,$"\xbb\x1f\x96\xaf\xce\x3a\x03\x81"
-DATA ·d+50104(SB)/8,$"\x81\xd6\xbc\xf3\x47\xf8\x3f\x7f"
-DATA ·d+50112(SB)/8,$"\xac\x94\xfb\x26\xb3\x6d\x6e\x85"
-DATA ·d+50120(SB)/8,$"\x99\x21\xfe\xd0\x86\x48\x31\x1a"
-DATA ·d+50128(SB)/8,$"\x0c\xee\x44\x90\x80\x00\xfd\x4f"
-DATA ·d+50136(SB)/8,$"\x4a\xf6\xf2\xec\x91\xc6\xd1\xc4"
-DATA ·d+50144(SB)/8,$"\x54\xe7\x7b\xee\x97\xe5\xfd\x77"
-DATA ·d+50152(SB)/8,$"\x3c\xba\x58\x5c\x12\x4e\xbc\xae"
-DATA ·d+50160(SB)/8,$"\x9e\xd7\x77\xb7\xcb\xbf\xe5\xd8"
-DATA ·d+50168(SB)/8,$"\xe2\xac\xfe\x10\x78\x03\x5e\xd5"
-DATA ·d+50176(SB)/8,$"\x9e\xfa\x2d\x41\x74\xc8\x23\x0f"
-DATA ·d+50184(SB)/8,$"\x37\xd8\xe5\xb8\x57\x9e\x5c\x64"
-DATA ·d+50192(SB)/8,$"\xa2\x44\x3c\xbc\x16\x27\x00\x8c"
-DATA ·d+50200(SB)/8,$"\x8c\x72\x6b\x4f\x53\xd3\x75\xeb"
-DATA ·d+50208(SB)/8,$"\x08\x6d\x24\xbf\x2b\x21\x23\xcb"
-DATA ·d+50216(SB)/8,$"\xb0\x0c\xdb\xad\x99\x07\x00\x23"
-DATA ·d+50224(SB)/8,$"\x29\x08\xdb\x31\xc8\x38\xc3\x75"
-DATA ·d+50232(SB)/8,$"\xe7\x9a\xf4\x0f\xf8\x55\xbe\x12"
-DATA ·d+50240(SB)/8,$"\xf0\xe6\x93\x2e\xa3\x7d\xe2\x1b"
-DATA ·d+50248(SB)/8,$"\x6b\xab\x85\x9a\x3b\x44\xb5\xb0"
-DATA ·d+50256(SB)/8,$"\x65\x26\x53\x31\xc6\xe5\x72\x79"
-DATA ·d+50264(SB)/8,$"\x51\x9c\x90\x00\xfd\x2b\xe8\xef"
-DATA ·d+50272(SB)/8,$"\x81\xab\xfb\x3b\x69\xfa\x55\xc6"
-DATA ·d+50280(SB)/8,$"\x9d\xf1\x42\x5f\x17\xdd\x59\x7d"
-DATA ·d+50288(SB)/8,$"\xa5\x6c\xe3\x5b\x18\xec\xda\xc9"
-DATA ·d+50296(SB)/8,$"\x65\x90\x94\x79\x8b\xcf\x97\x8c"
-DATA ·d+50304(SB)/8,$"\x67\x82\xc9\xce\x01\x3e\x95\xf5"
-DATA ·d+50312(SB)/8,$"\x53\xc3\xc6\x84\x1d\x4a\x6e\xf6"
-DATA ·d+50320(SB)/8,$"\xe9\xd7\x4d\xed\xdc\x58\x8e\x21"
-DATA ·d+50328(SB)/8,$"\x73\xe5\xf7\xdc\xd2\xb2\x57\x5d"
-DATA ·d+50336(SB)/8,$"\xfc\xde\xba\xf7\x77\x3e\x3c\xd3"
-DATA ·d+50344(SB)/8,$"\xf4\xef\x0c\x5a\x48\xa9\xe2\x9b"
-DATA ·d+50352(SB)/8,$"\xd5\xb5\xbb\xdc\x65\x94\x18\xc9"
-DATA ·d+50360(SB)/8,$"\x52\x38\x3b\x0f\x96\x0e\x03\x63"
-DATA ·d+50368(SB)/8,$"\xa8\x3c\x75\xae\xb0\xf8\xfe\xce"
-DATA ·d+50376(SB)/8,$"\xf7\xc3\x92\x78\x37\x45\xb4\x86"
-DATA ·d+50384(SB)/8,$"\x63\x71\x3a\xbc\x52\xc1\x1c\x92"
-DATA ·d+50392(SB)/8,$"\xdc\x06\x43\x90\x14\x81\x93\x9f"
-DATA ·d+50400(SB)/8,$"\xee\x9c\xd7\xd4\xff\x00\x1a\xef"
-DATA ·d+50408(SB)/8,$"\xbf\x65\x0f\x85\xd6\x76\xba\x8f"
-DATA ·d+50416(SB)/8,$"\x84\x3c\x3d\xa7\xeb\xf7\x42\xdd"
-DATA ·d+50424(SB)/8,$"\x04\xc6\xde\x7b\x88\xa2\x8a\x64"
-DATA ·d+50432(SB)/8,$"\xe2\x40\xd1\x5d\x06\x67\xc9\xe4"
-DATA ·d+50440(SB)/8,$"\x3e\x4a\x91\xd0\x01\x5f\x31\x3f"
-DATA ·d+50448(SB)/8,$"\xed\x05\xe1\xad\x77\x53\x32\xf8"
-DATA ·d+50456(SB)/8,$"\x5e\xc1\x34\x04\x85\x92\x68\x7e"
-DATA ·d+50464(SB)/8,$"\xdf\xab\xcd\x09\x2b\x8f\x99\x51"
-DATA ·d+50472(SB)/8,$"\x2d\x20\x45\x52\x0f\x39\x0d\x90"
-DATA ·d+50480(SB)/8,$"\x38\x19\xa9\xaf\xc5\x54\x21\x46"
-DATA ·d+50488(SB)/8,$"\x33\xf6\x57\xba\xf3\x5f\xe7\xf9"
-DATA ·d+50496(SB)/8,$"\x13\x80\xcb\x6a\x63\xaf\x55\x46"
-DATA ·d+50504(SB)/8,$"\x4d\x27\xdd\x59\x3f\x25\xbd\x8f"
-DATA ·d+50512(SB)/8,$"\x47\xf8\x69\xaa\x78\x32\xef\xc3"
-DATA ·d+50520(SB)/8,$"\x97\x7a\x0d\xff\x00\x87\x34\xf6"
-DATA ·d+50528(SB)/8,$"\xd5\x20\xdf\x3a\xea\x37\x1b\xc2"
-DATA ·d+50536(SB)/8,$"\xb2\xaa\xfc\xb0\xba\x13\x98\xa4"
-DATA ·d+50544(SB)/8,$"\x66\xc8\x0d\xdf\xa7\x5a\xc1\xf1"
-DATA ·d+50552(SB)/8,$"\xf5\x86\xb3\xe1\x2b\x35\xd4\x35"
-DATA ·d+50560(SB)/8,$"\x5d\x0e\xca\xde\x19\x13\xcd\x4b"
-DATA ·d+50568(SB)/8,$"\xfd\x36\x66\x9e\xda\x5d\xfc\x84"
-DATA ·d+50576(SB)/8,$"\xdc\x3e\x54\x6f\xf6\x0e\x0e\x78"
-DATA ·d+50584(SB)/8,$"\xc5\x7b\xe7\xc3\x4f\xda\x4b\xc3"
-DATA ·d+50592(SB)/8,$"\xdf\x17\x7c\x39\x3f\x85\xbc\x6d"
-DATA ·d+50600(SB)/8,$"\xe1\x2b\x9b\xa9\x74\xa4\x31\xda"
-DATA ·d+50608(SB)/8,$"\xea\x9a\x4d\xca\x7d\xaa\x39\x6e"
-DATA ·d+50616(SB)/8,$"\x19\x22\x80\x35\xc4\xfb\x25\x96"
-DATA ·d+50624(SB)/8,$"\x39\x1c\x9c\x46\xe5\xc1\x3e\xd9"
-DATA ·d+50632(SB)/8,$"\xc6\xff\x00\x83\x3e\x27\xeb\x3f"
-DATA ·d+50640(SB)/8,$"\x01\xbc\x6f\xad\xda\xdb\xb6\x93"
-DATA ·d+50648(SB)/8,$"\x0d\xdd\xce\x99\x3e\x91\x2a\x6b"
-DATA ·d+50656(SB)/8,$"\x0b\x00\x8c\xa4\x84\x79\x91\xcd"
-DATA ·d+50664(SB)/8,$"\xb1\xae\x23\x2c\xb8\xf9\x03\x64"
-DATA ·d+50672(SB)/8,$"\xa1\xe8\x45\x76\x65\x19\xf2\x6a"
-DATA ·d+50680(SB)/8,$"\x5e\xca\xd7\x7d\x1b\xbd\xaf\xd9"
-DATA ·d+50688(SB)/8,$"\xbe\x9e\x47\xcb\xe6\x18\x49\x51"
-DATA ·d+50696(SB)/8,$"\xc6\x4e\x35\x61\xae\x9a\x5d\xea"
-DATA ·d+50704(SB)/8,$"\xad\xd2\xcd\xa5\xf7\x1f\x13\x68"
-DATA ·d+50712(SB)/8,$"\x1e\x3e\xd5\xf4\xe5\x9b\x55\xb2"
-DATA ·d+50720(SB)/8,$"\x48\x65\x59\xe3\x28\xc9\x23\x1e"
-DATA ·d+50728(SB)/8,$"\x63\xce\x06\x48\x3c\x10\x4f\x4e"
-DATA ·d+50736(SB)/8,$"\x7e\x95\xd3\x3e\xb3\xa6\xcf\x73"
-DATA ·d+50744(SB)/8,$"\xa7\xff\x00\x6e\x5b\x5a\xc7\x6b"
-DATA ·d+50752(SB)/8,$"\x76\x22\x49\x58\xa1\xdd\x03\xb3"
-DATA ·d+50760(SB)/8,$"\xed\xfd\xe1\x56\x0a\xd8\xfb\xdc"
-DATA ·d+50768(SB)/8,$"\xe7\xe5\xce\x46\x6b\x8c\xf1\xe5"
-DATA ·d+50776(SB)/8,$"\xb6\x81\x71\xab\xad\xe5\x86\x9e"
-DATA ·d+50784(SB)/8,$"\x2c\xa2\x99\x39\x6b\x69\xdb\xc9"
-DATA ·d+50792(SB)/8,$"\x66\x3d\xc9\x03\x19\xf5\xae\xc7"
-DATA ·d+50800(SB)/8,$"\xe1\xc7\xfc\x21\x56\xda\xa4\x3a"
-DATA ·d+50808(SB)/8,$"\x4e\xb1\x0b\xbd\x84\xce\xbe\x7a"
-DATA ·d+50816(SB)/8,$"\xdb\xa8\x9c\x2b\xae\x4b\x6d\x8e"
-DATA ·d+50824(SB)/8,$"\x50\x43\x3e\x3e\x6f\x95\x72\x3d"
-DATA ·d+50832(SB)/8,$"\x2a\xf3\x7c\x0d\x69\xcd\xd4\x9c"
-DATA ·d+50840(SB)/8,$"\x92\x76\xdf\xfe\x1b\xf4\x3d\x59"
-DATA ·d+50848(SB)/8,$"\x50\xa2\xa1\x1c\x45\x3a\x6e\xef"
-DATA ·d+50856(SB)/8,$"\xb5\xb4\x5f\x7f\xe9\xf2\x35\x75"
-DATA ·d+50864(SB)/8,$"\x0f\x0c\x5d\xdc\x9b\x9d\x57\x41"
-DATA ·d+50872(SB)/8,$"\x11\x34\x16\x77\xa5\x52\xde\xd6"
-DATA ·d+50880(SB)/8,$"\x54\x70\x51\x33\xfb\xc8\xc3\x1f"
-DATA ·d+50888(SB)/8,$"\xb9\x91\xeb\xca\xd7\x32\xde\x29"
-DATA ·d+50896(SB)/8,$"\x5b\x97\xb5\xbb\x86\x47\x4f\xb3"
-DATA ·d+50904(SB)/8,$"\xb1\x12\x1b\x89\x83\xc6\xac\x5b"
-DATA ·d+50912(SB)/8,$"\x38\x8a\x36\x24\x85\xeb\x80\x0f"
-DATA ·d+50920(SB)/8,$"\x5a\xfa\x43\x54\xf8\x45\xfb\x39"
-DATA ·d+50928(SB)/8,$"\xe9\x1e\x3f\xb0\xb7\x9b\x5d\xd7"
-DATA ·d+50936(SB)/8,$"\xe5\xd1\x2f\xcc\x72\x4b\xa8\x5a"
-DATA ·d+50944(SB)/8,$"\xe9\xe9\x0c\xd6\x66\xe0\x1c\xb7"
-DATA ·d+50952(SB)/8,$"\x93\x29\xd9\x32\x42\xf8\xf3\x15"
-DATA ·d+50960(SB)/8,$"\x55\x0b\xa1\xca\x0d\xca\x41\xe6"
-DATA ·d+50968(SB)/8,$"\xfe\x31\x7e\xce\x5e\x3a\xf8\x33"
-DATA ·d+50976(SB)/8,$"\xe3\x0b\xef\x04\xcd\x6b\x0f\x99"
-DATA ·d+50984(SB)/8,$"\x6d\x72\x25\xb6\xd6\x2c\xed\xc1"
-DATA ·d+50992(SB)/8,$"\x86\xfa\xd0\x00\x73\x18\xc9\xf2"
-DATA ·d+51000(SB)/8,$"\xa5\x65\x60\xe0\x37\x7c\xaf\x1c"
-DATA ·d+51008(SB)/8,$"\x1a\xf9\xe7\x9a\x56\xa7\x25\x4e"
-DATA ·d+51016(SB)/8,$"\xa5\x64\xee\x93\x5d\xed\xb6\xcf"
-DATA ·d+51024(SB)/8,$"\x77\xf8\xa3\x86\x85\x3a\x15\x63"
-DATA ·d+51032(SB)/8,$"\xa3\x6d\x5d\xab\xec\xbe\xfd\x53"
-DATA ·d+51040(SB)/8,$"\xf2\xff\x00\x80\x63\xaf\xc4\x25"
-DATA ·d+51048(SB)/8,$"\xf0\x92\x1f\x10\x68\xda\x55\x8d"
-DATA ·d+51056(SB)/8,$"\xea\x4f\x3c\x90\x49\x69\x7c\x65"
-DATA ·d+51064(SB)/8,$"\x94\x22\xb8\xca\x0e\x24\x42\x41"
-DATA ·d+51072(SB)/8,$"\xed\xc8\x19\x1c\xd7\x23\x67\xa1"
-DATA ·d+51080(SB)/8,$"\x5b\xdd\x58\x4b\xab\xf8\xcb\x55"
-DATA ·d+51088(SB)/8,$"\xff\x00\x84\x7d\x65\xb9\x8d\xad"
-DATA ·d+51096(SB)/8,$"\x2c\xd2\x5b\x77\x8b\xca\x91\x89"
-DATA ·d+51104(SB)/8,$"\x76\x2b\xe7\xb3\x20\x41\xca\x82"
-DATA ·d+51112(SB)/8,$"\xb9\x6e\x9d\x6b\xcf\xfc\x57\xab"
-DATA ·d+51120(SB)/8,$"\x7d\x85\x6e\x2d\xad\x6f\xb5\x0d"
-DATA ·d+51128(SB)/8,$"\x55\x89\xf2\x5a\x3b\xdb\x29\x2d"
-DATA ·d+51136(SB)/8,$"\xc3\x41\xfd\xe2\x1b\xb2\x9c\xf1"
-DATA ·d+51144(SB)/8,$"\xed\x5e\x61\x73\xae\x68\x9a\x47"
-DATA ·d+51152(SB)/8,$"\x88\xe3\xb8\x9e\xc2\xda\xee\x18"
-DATA ·d+51160(SB)/8,$"\x36\xc6\x2d\xa2\x66\x87\xcc\xde"
-DATA ·d+51168(SB)/8,$"\xb9\x12\x13\xd7\x1d\xb1\x5e\xa6"
-DATA ·d+51176(SB)/8,$"\x07\x3d\x93\x57\x9d\x6d\x3c\xe2"
-DATA ·d+51184(SB)/8,$"\xd7\xe9\x73\xb3\x03\xc2\x7c\x9c"
-DATA ·d+51192(SB)/8,$"\xfc\x8b\xde\x7d\x55\x9b\x69\x74"
-DATA ·d+51200(SB)/8,$"\xd5\xd9\x5f\xbd\x8f\xa6\xae\xb4"
-DATA ·d+51208(SB)/8,$"\xdf\x03\x6a\x50\xeb\x7a\x9e\xa9"
-DATA ·d+51216(SB)/8,$"\x73\x25\xe2\x5b\xb5\xbf\xf6\x36"
-DATA ·d+51224(SB)/8,$"\xab\x6c\xaf\x0c\x53\x91\x27\xef"
-DATA ·d+51232(SB)/8,$"\x14\x42\x9c\xab\xca\x87\xe4\x66"
-DATA ·d+51240(SB)/8,$"\x18\x42\x0f\x5c\xd7\x2a\xd3\xf8"
-DATA ·d+51248(SB)/8,$"\xce\xf5\x9e\xdf\x44\x83\x5c\xfe"
-DATA ·d+51256(SB)/8,$"\xcd\x92\x32\xb0\x7e\xed\x2e\x1d"
-DATA ·d+51264(SB)/8,$"\xb1\x9d\xaa\x58\x8e\x9e\xbe\x95"
-DATA ·d+51272(SB)/8,$"\xe5\xb7\xdf\x10\x34\xdb\x08\x16"
-DATA ·d+51280(SB)/8,$"\x0b\x7b\x01\x14\x9f\x3f\xee\xd5"
-DATA ·d+51288(SB)/8,$"\x47\xf1\x1c\xaf\x50\x49\x2b\xd2"
-DATA ·d+51296(SB)/8,$"\x91\x35\xfd\x4d\xe3\x86\xe4\xc9"
-DATA ·d+51304(SB)/8,$"\x25\xa0\xde\x1a\x4f\x25\x8c\x4e"
-DATA ·d+51312(SB)/8,$"\x57\xaf\x18\xc6\x31\x5a\x51\x5a"
-DATA ·d+51320(SB)/8,$"\xf3\x47\x12\xdb\x7d\xbf\xc8\xd6"
-DATA ·d+51328(SB)/8,$"\x96\x4f\x5e\x09\x4a\x54\xf4\x5b"
-DATA ·d+51336(SB)/8,$"\x73\x6a\xb4\xeb\x65\xdc\xea\xbc"
-DATA ·d+51344(SB)/8,$"\x27\xe0\xcf\x15\x6b\x3e\x2c\xb2"
-DATA ·d+51352(SB)/8,$"\xd2\x3c\x5f\xa6\xde\xd8\x89\xae"
-DATA ·d+51360(SB)/8,$"\x11\x96\x74\x85\xe3\x7f\x2f\xcc"
-DATA ·d+51368(SB)/8,$"\x1e\x67\x96\xf8\x00\xed\x07\x27"
-DATA ·d+51376(SB)/8,$"\xae\x2b\xdc\x7e\x27\xfc\x1b\xf8"
-DATA ·d+51384(SB)/8,$"\x77\xe1\x5f\x88\x77\x5e\x1e\xf0"
-DATA ·d+51392(SB)/8,$"\x84\xfa\x7e\xa7\x07\xc8\x96\x97"
-DATA ·d+51400(SB)/8,$"\x72\xde\x0f\x9c\xc8\x09\x32\x48"
-DATA ·d+51408(SB)/8,$"\x46\x15\x48\x20\x82\x0e\x47\x43"
-DATA ·d+51416(SB)/8,$"\x9a\xf2\x69\xbc\x79\xad\xde\x5b"
-DATA ·d+51424(SB)/8,$"\x5b\xe9\xc2\xf2\xfe\x4b\x3b\x67"
-DATA ·d+51432(SB)/8,$"\xf3\x23\x7b\xa9\x24\x70\xa4\xf2"
-DATA ·d+51440(SB)/8,$"\x4a\xe4\x9d\xb9\xef\x82\x33\xde"
-DATA ·d+51448(SB)/8,$"\xba\xab\x5f\x88\x36\x7e\x20\xdf"
-DATA ·d+51456(SB)/8,$"\xa5\xeb\x16\x9a\x5b\x44\x24\x59"
-DATA ·d+51464(SB)/8,$"\xc5\xc4\x36\x76\xf0\x4d\x28\x5c"
-DATA ·d+51472(SB)/8,$"\x00\x0b\xa2\x0c\x81\xce\x47\x19"
-DATA ·d+51480(SB)/8,$"\x27\x2d\x9a\x31\x58\x4a\xd2\x94"
-DATA ·d+51488(SB)/8,$"\x2b\xaa\x89\x25\xba\xb6\xff\x00"
-DATA ·d+51496(SB)/8,$"\xf0\xc7\x16\x2f\x11\x8a\x93\x75"
-DATA ·d+51504(SB)/8,$"\x3e\x15\x6d\xa2\xde\x9a\xef\xf3"
-DATA ·d+51512(SB)/8,$"\xfc\x07\xf8\x25\x3c\x11\xab\xea"
-DATA ·d+51520(SB)/8,$"\xeb\xa4\x6a\xcd\x0d\x8a\x44\x5a"
-DATA ·d+51528(SB)/8,$"\x38\x4e\xc3\x3a\x16\x56\xc1\xf9"
-DATA ·d+51536(SB)/8,$"\xc1\x28\xaa\x5b\x3f\x31\x52\x2b"
-DATA ·d+51544(SB)/8,$"\xb7\xbc\xf1\x05\xff\x00\x85\x4d"
-DATA ·d+51552(SB)/8,$"\xd6\x97\x3d\x85\xac\x57\xb1\x6e"
-DATA ·d+51560(SB)/8,$"\xfb\x0c\xd7\x53\x98\xd2\x2f\x9b"
-DATA ·d+51568(SB)/8,$"\x9c\x24\x31\x6d\x9b\x7a\x71\x86"
-DATA ·d+51576(SB)/8,$"\x20\x73\x91\xcd\x77\xda\xde\xb9"
-DATA ·d+51584(SB)/8,$"\xf0\xe3\xc1\xfe\x1c\xb1\xb4\x87"
-DATA ·d+51592(SB)/8,$"\x48\x6d\x5e\xed\xa0\x8e\xf2\x49"
-DATA ·d+51600(SB)/8,$"\x2d\xe1\x7b\x26\xbb\x92\x45\x6c"
-DATA ·d+51608(SB)/8,$"\xc6\x25\x85\xd8\xa8\x8b\x2a\x37"
-DATA ·d+51616(SB)/8,$"\x60\x13\x82\x0a\x8c\x83\x5e\x03"
-DATA ·d+51624(SB)/8,$"\xa9\x0d\x5d\xf5\x09\x60\xd6\xb4"
-DATA ·d+51632(SB)/8,$"\x3b\x8b\x09\xc2\x79\x82\xca\xea"
-DATA ·d+51640(SB)/8,$"\x50\x48\x56\xe8\x4b\x12\x5b\xf1"
-DATA ·d+51648(SB)/8,$"\x27\x34\x3c\x65\x09\xd3\x70\x95"
-DATA ·d+51656(SB)/8,$"\x4b\x7f\x85\x6b\xf8\x9f\x37\x49"
-DATA ·d+51664(SB)/8,$"\xfb\x7a\x9e\xd9\xd2\xbc\x7b\x49"
-DATA ·d+51672(SB)/8,$"\xa7\xaf\x92\xba\x76\x7d\x6e\x99"
-DATA ·d+51680(SB)/8,$"\x81\xa0\xfc\x45\xd7\x3f\xb5\xe4"
-DATA ·d+51688(SB)/8,$"\xb1\xd6\x34\x1d\x3a\x2b\x6b\xa9"
-DATA ·d+51696(SB)/8,$"\x11\x5a\x6b\x38\x99\x9a\x36\xee"
-DATA ·d+51704(SB)/8,$"\xd9\x73\x90\x3d\xd7\x8f\x6a\xef"
-DATA ·d+51712(SB)/8,$"\x7f\xe1\x3b\xd5\xa2\xfd\xdc\x17"
-DATA ·d+51720(SB)/8,$"\x13\xaa\x2f\xca\x80\x49\xc0\x51"
-DATA ·d+51728(SB)/8,$"\xd0\x0f\x9f\xd2\xb2\x4d\x97\x80"
-DATA ·d+51736(SB)/8,$"\x17\x43\x95\x75\xd8\xb5\x63\xab"
-DATA ·d+51744(SB)/8,$"\x93\xb6\xda\xd7\x41\x5f\x32\x14"
-DATA ·d+51752(SB)/8,$"\xe3\x3b\xa7\x9a\xe3\x6c\x78\xec"
-DATA ·d+51760(SB)/8,$"\x56\x3c\xb7\x43\xd2\xbc\x3a\xe3"
-DATA ·d+51768(SB)/8,$"\x7a\x4e\xe8\xa9\x20\x01\xc8\x01"
-DATA ·d+51776(SB)/8,$"\xcc\x5b\x86\x0f\x7c\x1e\xbe\xb5"
-DATA ·d+51784(SB)/8,$"\xe8\xe4\xf9\x9d\x0a\x34\xdc\x25"
-DATA ·d+51792(SB)/8,$"\xcd\x3b\x75\x71\x4b\xfe\x1c\xf7"
-DATA ·d+51800(SB)/8,$"\xaa\xe4\xb4\x31\x52\xf6\x8a\x97"
-DATA ·d+51808(SB)/8,$"\x27\x92\xd5\x3f\x3d\x5b\xfd\x0f"
-DATA ·d+51816(SB)/8,$"\xff\xd0\xfe\x60\xf4\x1f\x0d\x78"
-DATA ·d+51824(SB)/8,$"\xd7\x5a\xd4\xff\x00\xb3\x74\xf8"
-DATA ·d+51832(SB)/8,$"\x22\x79\x4a\xee\x2a\x30\x87\x1d"
-DATA ·d+51840(SB)/8,$"\xce\x64\x60\xa0\x57\xa6\x59\xa6"
-DATA ·d+51848(SB)/8,$"\xad\xe1\x0b\x87\xb5\x92\xe2\x58"
-DATA ·d+51856(SB)/8,$"\xe7\xba\x1b\x2f\x08\x9e\x27\x38"
-DATA ·d+51864(SB)/8,$"\x5c\x15\xf9\x80\x6e\x41\x03\xbf"
-DATA ·d+51872(SB)/8,$"\xe3\x5e\x51\x6d\xe1\x7b\xfb\xfd"
-DATA ·d+51880(SB)/8,$"\x5d\xb4\xe8\xae\x64\xbb\x95\xa4"
-DATA ·d+51888(SB)/8,$"\xf2\xd8\x05\x6b\x87\x27\xd0\x2a"
-DATA ·d+51896(SB)/8,$"\xa1\xce\x7d\x05\x7a\x7e\x99\xe0"
-DATA ·d+51904(SB)/8,$"\x6b\xff\x00\x0f\xea\xb1\xc1\x24"
-DATA ·d+51912(SB)/8,$"\x91\x2f\x90\x08\xb9\x5b\xbb\x79"
-DATA ·d+51920(SB)/8,$"\x50\x42\xac\x31\x99\x17\x66\xe5"
-DATA ·d+51928(SB)/8,$"\x6e\x7e\x56\x1c\x83\x5f\xa6\x55"
-DATA ·d+51936(SB)/8,$"\xd3\x58\xd0\xe6\x5f\x79\xfe\x7e"
-DATA ·d+51944(SB)/8,$"\x66\x3c\x92\xb7\x35\x54\xbc\x92"
-DATA ·d+51952(SB)/8,$"\xf2\xd3\xf1\x3d\xdb\x44\x9a\xf1"
-DATA ·d+51960(SB)/8,$"\xfc\x3f\x2e\xb7\xaf\xe8\x96\x1a"
-DATA ·d+51968(SB)/8,$"\x8a\x59\x3b\x19\xf5\x5b\x1b\x98"
-DATA ·d+51976(SB)/8,$"\x2d\x2f\xe4\x49\x71\xff\x00\x1f"
-DATA ·d+51984(SB)/8,$"\x02\x40\xec\xe3\x9c\x6e\x40\x4f"
-DATA ·d+51992(SB)/8,$"\xb5\x70\x37\x77\xfe\x16\xf1\x45"
-DATA ·d+52000(SB)/8,$"\xda\xe9\xf3\xe9\x11\x08\xcb\x99"
-DATA ·d+52008(SB)/8,$"\x2d\xa4\xb5\xba\x71\x24\x43\xbe"
-DATA ·d+52016(SB)/8,$"\x64\x31\xfc\xf9\xc7\x52\x06\x0d"
-DATA ·d+52024(SB)/8,$"\x77\x5f\x10\x7c\x22\xda\x37\x86"
-DATA ·d+52032(SB)/8,$"\x74\xaf\x08\x0b\x5d\x26\x28\xa4"
-DATA ·d+52040(SB)/8,$"\x92\x2d\x46\x6d\x5e\x23\x73\x35"
-DATA ·d+52048(SB)/8,$"\xc4\xab\x8c\x24\x6c\xd3\x47\x1f"
-DATA ·d+52056(SB)/8,$"\x92\xa7\x25\x88\x50\x41\xe0\x67"
-DATA ·d+52064(SB)/8,$"\x8a\xf4\xcf\x02\xfc\x0f\xd2\x3c"
-DATA ·d+52072(SB)/8,$"\x51\xa3\xea\x7a\xc6\x99\xad\xf8"
-DATA ·d+52080(SB)/8,$"\x66\xda\xdf\x4e\xb7\x49\xe1\x5b"
-DATA ·d+52088(SB)/8,$"\xbb\xb8\xe1\xf3\x46\x76\xca\x0b"
-DATA ·d+52096(SB)/8,$"\x2b\xb3\x21\x52\x47\x32\x20\x0d"
-DATA ·d+52104(SB)/8,$"\xbb\x8c\x00\x4d\x67\x82\xf6\x16"
-DATA ·d+52112(SB)/8,$"\x7f\x5a\x8f\xb3\x4d\xe9\x7b\xfe"
-DATA ·d+52120(SB)/8,$"\x87\xc5\x56\xad\x64\xe7\x47\xde"
-DATA ·d+52128(SB)/8,$"\x92\xfe\x5b\xd9\x7e\x9f\x23\xc1"
-DATA ·d+52136(SB)/8,$"\xbc\x29\xf0\xea\xeb\x57\xd5\xed"
-DATA ·d+52144(SB)/8,$"\xf4\x85\xb3\xd4\xaf\x22\x94\xb0"
-DATA ·d+52152(SB)/8,$"\x64\x82\xe1\x12\x45\x4e\x58\x11"
-DATA ·d+52160(SB)/8,$"\x28\x43\x8e\x39\xc1\x07\xda\xbd"
-DATA ·d+52168(SB)/8,$"\x3f\xc4\x9f\x01\x35\xcf\x87\x7a"
-DATA ·d+52176(SB)/8,$"\x15\xde\xb5\xad\x69\x36\xf6\x8b"
-DATA ·d+52184(SB)/8,$"\xe5\xa5\xe5\x9a\x6a\xf7\x10\xc9"
-DATA ·d+52192(SB)/8,$"\x70\x60\x9b\x84\x01\x40\xdc\x5b"
-DATA ·d+52200(SB)/8,$"\x8c\xb6\x54\x1c\xfa\x0a\xd7\xf0"
-DATA ·d+52208(SB)/8,$"\x86\x9d\xa7\x5d\x69\xed\xe2\x6b"
-DATA ·d+52216(SB)/8,$"\x4d\x5f\x4a\x17\x16\xb3\x3f\x99"
-DATA ·d+52224(SB)/8,$"\x67\x3a\x46\xe4\x00\x31\x96\x59"
-DATA ·d+52232(SB)/8,$"\x24\x81\xb0\xca\x78\x28\x58\x11"
-DATA ·d+52240(SB)/8,$"\xd0\xd1\xe3\x6f\x88\x7a\x36\xb9"
-DATA ·d+52248(SB)/8,$"\xa2\x7f\x66\xea\x4f\x63\x61\x25"
-DATA ·d+52256(SB)/8,$"\xb6\xc8\xad\x93\xc3\xf6\x91\xc4"
-DATA ·d+52264(SB)/8,$"\xaf\x14\x4a\x71\xbe\x77\x32\x4a"
-DATA ·d+52272(SB)/8,$"\x4f\x24\x81\xbb\x19\xeb\xeb\x5e"
-DATA ·d+52280(SB)/8,$"\xde\x0f\x24\x84\xa7\x2f\xab\xaf"
-DATA ·d+52288(SB)/8,$"\x5d\xd2\xf5\xd5\x7e\x47\x95\x98"
-DATA ·d+52296(SB)/8,$"\xe2\x73\x09\x4a\x3e\xd2\xa5\xa3"
-DATA ·d+52304(SB)/8,$"\xa6\x8b\x57\xe9\xa3\xf4\x3c\x06"
-DATA ·d+52312(SB)/8,$"\x7f\x8a\x3a\xcc\x77\x90\x59\x69"
-DATA ·d+52320(SB)/8,$"\x5a\x0c\x63\x38\x56\x7d\x3e\x35"
-DATA ·d+52328(SB)/8,$"\x8e\x33\x81\x83\xb8\x49\x1c\x87"
-DATA ·d+52336(SB)/8,$"\x9e\xb9\x07\xde\xbe\x8a\xd0\x34"
-DATA ·d+52344(SB)/8,$"\x4f\x31\x2d\x2f\x3c\x78\xd7\x1a"
-DATA ·d+52352(SB)/8,$"\x2a\xdd\x5b\xb5\xdc\x49\x7f\x61"
-DATA ·d+52360(SB)/8,$"\x6f\x75\x0c\x91\x12\x7f\xd6\xa4"
-DATA ·d+52368(SB)/8,$"\x68\x93\x60\x9e\x98\x20\xe3\x9a"
-DATA ·d+52376(SB)/8,$"\xf9\xae\xf7\x5e\xd7\x3c\x19\xad"
-DATA ·d+52384(SB)/8,$"\x36\xa1\x1d\xc3\xea\x16\xb7\x16"
-DATA ·d+52392(SB)/8,$"\x19\x8d\xa6\x0c\xeb\x87\x20\xae"
-DATA ·d+52400(SB)/8,$"\x76\x95\xda\xcb\x9f\x5c\x76\xaa"
-DATA ·d+52408(SB)/8,$"\x90\xfc\x59\xf1\x75\xc5\xcf\xfa"
-DATA ·d+52416(SB)/8,$"\x2b\x59\xa4\xb2\xa8\xc5\xc2\x5b"
-DATA ·d+52424(SB)/8,$"\x2b\xca\x15\x78\xc8\x92\x76\x93"
-DATA ·d+52432(SB)/8,$"\x85\x1c\x71\xd8\x60\x53\x55\xb1"
-DATA ·d+52440(SB)/8,$"\x14\xea\x25\x6b\xaf\xb8\xf6\x2b"
-DATA ·d+52448(SB)/8,$"\xe5\x2e\xac\x13\xc3\xc6\x31\x5d"
-DATA ·d+52456(SB)/8,$"\x5e\xad\xfc\xef\x7b\x1f\x64\xeb"
-DATA ·d+52464(SB)/8,$"\x97\x5f\x0b\x7c\x17\x35\x8f\x8a"
-DATA ·d+52472(SB)/8,$"\x7e\x10\x6a\x56\x17\x5a\x9c\x22"
-DATA ·d+52480(SB)/8,$"\x07\x95\xad\xd2\x58\xed\x63\x7d"
-DATA ·d+52488(SB)/8,$"\xd9\x74\x58\xae\xc1\x91\x08\x23"
-DATA ·d+52496(SB)/8,$"\x70\x2a\xcc\x07\x4e\x87\x15\xd3"
-DATA ·d+52504(SB)/8,$"\x5c\xf8\x63\xc2\x3f\xb4\x6e\xbf"
-DATA ·d+52512(SB)/8,$"\x06\xad\xe2\x2f\x10\x6b\x7a\x25"
-DATA ·d+52520(SB)/8,$"\xf5\xc4\x91\xdb\x5d\xde\xde\x4c"
-DATA ·d+52528(SB)/8,$"\x75\xab\x2d\xf2\x10\xb1\x10\x4f"
-DATA ·d+52536(SB)/8,$"\x93\x2c\x71\x0c\x80\xf8\xdc\xa8"
-DATA ·d+52544(SB)/8,$"\x39\xe7\x06\xbe\x6b\xd4\x34\xfd"
-DATA ·d+52552(SB)/8,$"\x63\xc4\xfe\x10\xd3\xfc\x71\x15"
-DATA ·d+52560(SB)/8,$"\xcd\xce\xb1\x79\x7b\x79\x2a\x6b"
-DATA ·d+52568(SB)/8,$"\x04\x34\x28\xc8\xea\x02\xa3\x18"
-DATA ·d+52576(SB)/8,$"\xe3\x50\x70\xca\x07\x23\x02\xbe"
-DATA ·d+52584(SB)/8,$"\x80\xbd\xd5\xfe\x1d\x78\x5b\x48"
-DATA ·d+52592(SB)/8,$"\xd2\xb4\xe8\xa1\x11\x5d\x2d\xae"
-DATA ·d+52600(SB)/8,$"\xeb\xe3\x6b\xcc\x42\xe1\x8e\x17"
-DATA ·d+52608(SB)/8,$"\x69\xe0\xe5\x71\xf3\x39\x38\xc9"
-DATA ·d+52616(SB)/8,$"\xc0\xa7\x3c\x5a\xf6\x54\xe2\xaa"
-DATA ·d+52624(SB)/8,$"\xfe\xf1\xf5\x5a\xf7\xdf\x45\xa2"
-DATA ·d+52632(SB)/8,$"\xf4\x3c\x99\xd2\x54\x6b\x49\xf2"
-DATA ·d+52640(SB)/8,$"\xe8\xaf\xa7\x6f\xbe\xef\x5e\x83"
-DATA ·d+52648(SB)/8,$"\x7c\x47\xfb\x2a\x37\x83\xfc\x55"
-DATA ·d+52656(SB)/8,$"\x07\x81\xbc\x4b\x6f\xa6\x5f\x4f"
-DATA ·d+52664(SB)/8,$"\x10\xb9\xb3\x5d\x43\x54\xbf\x36"
-DATA ·d+52672(SB)/8,$"\x1a\x7c\x9e\x43\x1c\x4f\x0d\xc0"
-DATA ·d+52680(SB)/8,$"\x1b\x66\x49\x00\xf9\x39\x1c\x9c"
-DATA ·d+52688(SB)/8,$"\x1c\x1e\x2b\xcc\xbc\x1d\xfb\x3f"
-DATA ·d+52696(SB)/8,$"\xe9\xfe\x29\xf1\xfd\xbf\x85\xec"
-DATA ·d+52704(SB)/8,$"\x74\x38\xa4\xb8\xb8\x9e\x42\xb6"
-DATA ·d+52712(SB)/8,$"\xd0\x4b\x76\x90\x91\x0a\x97\x70"
-DATA ·d+52720(SB)/8,$"\x65\x56\x6c\x20\x55\xe1\xb2\x0f"
-DATA ·d+52728(SB)/8,$"\x35\xe9\x9e\x18\xf1\x8e\xb7\xae"
-DATA ·d+52736(SB)/8,$"\x68\x92\x78\x77\x59\xf3\x6e\x2d"
-DATA ·d+52744(SB)/8,$"\x61\x2d\x0c\x4a\xb1\xee\xb6\x31"
-DATA ·d+52752(SB)/8,$"\x1c\xa6\x73\x82\x10\x95\x3c\xf4"
-DATA ·d+52760(SB)/8,$"\xf9\xbd\xcd\x79\x4f\x88\x66\xd1"
-DATA ·d+52768(SB)/8,$"\xbc\x30\x6e\xb5\x7f\x0a\xea\x72"
-DATA ·d+52776(SB)/8,$"\xe9\xf7\x56\x66\x09\x21\x92\xc6"
-DATA ·d+52784(SB)/8,$"\xe8\xc5\x3a\xc8\xd3\xac\x6e\x09"
-DATA ·d+52792(SB)/8,$"\x5d\xb2\x71\x19\x24\xaa\x9f\x7e"
-DATA ·d+52800(SB)/8,$"\x99\xae\x1c\x45\x5c\x44\x23\x28"
-DATA ·d+52808(SB)/8,$"\xb6\xae\xfc\x9e\x9f\x9f\xe4\x46"
-DATA ·d+52816(SB)/8,$"\x0e\x75\xa5\x52\x54\xe1\x56\x5f"
-DATA ·d+52824(SB)/8,$"\xe7\x77\xf7\x75\xff\x00\x80\x7a"
-DATA ·d+52832(SB)/8,$"\x87\x8d\x7e\x07\xf8\x43\xc3\xba"
-DATA ·d+52840(SB)/8,$"\x5c\x96\xbe\x01\x0b\x61\xa8\x88"
-DATA ·d+52848(SB)/8,$"\x96\xee\xe1\x9a\x59\x2e\xc5\xbc"
-DATA ·d+52856(SB)/8,$"\xa5\xd9\x7c\xbf\xb5\x2a\xbe\x08"
-DATA ·d+52864(SB)/8,$"\x3c\xe3\xa9\x53\xf3\x74\xaf\x94"
-DATA ·d+52872(SB)/8,$"\x7c\x17\x71\x7d\xa1\xf8\xba\xef"
-DATA ·d+52880(SB)/8,$"\x49\xd5\x3c\x53\x6b\x63\x6b\xa8"
-DATA ·d+52888(SB)/8,$"\xc6\xc8\x33\x77\x3b\x5b\x3c\xa1"
-DATA ·d+52896(SB)/8,$"\xb2\xfb\xd6\x2f\xbb\xf3\x10\xdf"
-DATA ·d+52904(SB)/8,$"\x32\xf3\x9c\x8e\xf5\xed\xba\x7f"
-DATA ·d+52912(SB)/8,$"\xc4\x2d\x36\xfb\x45\xd4\x05\xe6"
-DATA ·d+52920(SB)/8,$"\xbb\x7f\x0e\xa1\x79\xa8\x47\x3a"
-DATA ·d+52928(SB)/8,$"\xa8\x53\x11\x78\xfc\xbc\x48\xfe"
-DATA ·d+52936(SB)/8,$"\x6c\x2a\xb9\x39\x01\x4e\x79\xef"
-DATA ·d+52944(SB)/8,$"\x9e\x2b\xe4\x3d\x55\x6e\x74\xe9"
-DATA ·d+52952(SB)/8,$"\x26\xbd\x36\xd2\xdb\xb3\x16\x3e"
-DATA ·d+52960(SB)/8,$"\x6c\x67\x76\xee\x7a\x6e\x19\x1c"
-DATA ·d+52968(SB)/8,$"\xf5\x24\x1e\x6b\xc3\xc2\x61\xeb"
-DATA ·d+52976(SB)/8,$"\x56\x85\x48\x56\x9e\xdd\x5c\x75"
-DATA ·d+52984(SB)/8,$"\x67\xd9\xe5\xb8\x55\x09\x4a\x9d"
-DATA ·d+52992(SB)/8,$"\x4b\xfb\xeb\xf9\x93\xfb\xaf\xaa"
-DATA ·d+53000(SB)/8,$"\xd5\x1f\x46\x78\xc3\xe1\xf6\xb1"
-DATA ·d+53008(SB)/8,$"\xe0\x9b\xf8\xe5\xd6\x20\x8a\xee"
-DATA ·d+53016(SB)/8,$"\xcb\x56\xb4\x37\x76\x17\xfe\x1a"
-DATA ·d+53024(SB)/8,$"\xbd\xb6\x99\x67\x88\xe1\x64\x74"
-DATA ·d+53032(SB)/8,$"\x91\xf9\xc8\x6e\x4a\x38\x46\x07"
-DATA ·d+53040(SB)/8,$"\xdb\x9a\xf0\x9b\xcd\x72\xe6\xd9"
-DATA ·d+53048(SB)/8,$"\x92\x2d\x1e\xee\xfe\x37\x59\x30"
-DATA ·d+53056(SB)/8,$"\x56\xec\xa1\x91\x80\x3c\x7c\xbf"
-DATA ·d+53064(SB)/8,$"\x77\x26\xba\x3f\x05\x78\x87\xc3"
-DATA ·d+53072(SB)/8,$"\xf7\x92\x2e\x8b\xe3\x8f\xb4\xde"
-DATA ·d+53080(SB)/8,$"\x69\xd7\x5e\x58\x98\x41\xb4\x4f"
-DATA ·d+53088(SB)/8,$"\x11\x66\x0a\x64\xf3\x08\x3f\x32"
-DATA ·d+53096(SB)/8,$"\x02\x0a\x93\x9c\x81\xb4\xf1\x8a"
-DATA ·d+53104(SB)/8,$"\xd4\x7f\x08\xe8\x77\x7e\x2b\x9f"
-DATA ·d+53112(SB)/8,$"\x48\xd3\xee\x6e\x75\x0f\xb2\x4a"
-DATA ·d+53120(SB)/8,$"\xd0\x24\xad\x18\xf2\xe5\x58\xce"
-DATA ·d+53128(SB)/8,$"\x23\x6f\x32\x13\xd1\x87\x3c\xf4"
-DATA ·d+53136(SB)/8,$"\x3c\x0a\xe3\x95\x1f\x67\x38\xaa"
-DATA ·d+53144(SB)/8,$"\xd2\xdb\xaa\xdb\xfa\xf2\x3a\xa9"
-DATA ·d+53152(SB)/8,$"\x51\x8c\x14\xe3\x6e\x65\xf8\xfa"
-DATA ·d+53160(SB)/8,$"\x5b\xcb\xd3\x5d\xd1\x9d\xa3\xde"
-DATA ·d+53168(SB)/8,$"\xc3\x7b\xa5\x41\x68\xff\x00\x6d"
-DATA ·d+53176(SB)/8,$"\xb0\x89\xae\x76\xcd\xaa\x43\x7a"
-DATA ·d+53184(SB)/8,$"\x91\x82\xd2\x12\xf2\x86\x46\x50"
-DATA ·d+53192(SB)/8,$"\xac\x36\x8e\x02\xf4\x38\xc5\x7d"
-DATA ·d+53200(SB)/8,$"\x6f\xe0\x2f\x11\xfc\x1a\xbb\xd3"
-DATA ·d+53208(SB)/8,$"\x2e\x74\x7d\x43\xc6\xfb\xa5\x64"
-DATA ·d+53216(SB)/8,$"\x11\x18\xf5\x18\x25\x95\xa6\x4e"
-DATA ·d+53224(SB)/8,$"\xe1\x65\x60\x91\xab\x0e\x83\x04"
-DATA ·d+53232(SB)/8,$"\x93\xea\x2b\x8c\xf0\x97\xc2\xef"
-DATA ·d+53240(SB)/8,$"\x88\x3e\x14\xd1\x9f\xe2\x3a\x78"
-DATA ·d+53248(SB)/8,$"\x41\x35\xaf\x0d\x43\x28\x17\x97"
-DATA ·d+53256(SB)/8,$"\x51\x2c\xab\x02\x9d\xfb\x7c\xb9"
-DATA ·d+53264(SB)/8,$"\x25\xe5\xe3\x90\x96\xf9\x4e\xd6"
-DATA ·d+53272(SB)/8,$"\x3c\xe7\x18\xaf\x58\xf1\xe7\x82"
-DATA ·d+53280(SB)/8,$"\xbe\x0b\x78\x9b\xc2\x76\x1e\x36"
-DATA ·d+53288(SB)/8,$"\xf0\xf6\x8d\x75\xe1\xfd\x3c\xce"
-DATA ·d+53296(SB)/8,$"\xf6\x57\x2b\xaa\x5f\x5b\x59\xdd"
-DATA ·d+53304(SB)/8,$"\x34\x80\x17\x6d\x92\x3f\xee\xe6"
-DATA ·d+53312(SB)/8,$"\x78\xc7\x5c\x28\xdc\x30\x58\x2b"
-DATA ·d+53320(SB)/8,$"\x71\x5e\x6e\x37\x15\x4d\x54\xe4"
-DATA ·d+53328(SB)/8,$"\xbd\xf5\xb6\x8e\xfa\xef\x67\xd5"
-DATA ·d+53336(SB)/8,$"\x7a\x6d\xd8\xe6\xab\x52\x58\x85"
-DATA ·d+53344(SB)/8,$"\x17\x41\x3b\x2e\xdd\xfd\x3a\xeb"
-DATA ·d+53352(SB)/8,$"\xf7\x0c\x83\xe1\x17\xc3\xef\x8e"
-DATA ·d+53360(SB)/8,$"\x57\x9f\xd8\x7a\x16\x9f\x7d\x79"
-DATA ·d+53368(SB)/8,$"\xf2\x03\x26\xad\x6d\x2c\x59\x83"
-DATA ·d+53376(SB)/8,$"\xca\xc0\x54\x09\x0a\xca\x36\xb0"
-DATA ·d+53384(SB)/8,$"\xe7\x74\x9d\x3d\x6b\xc6\xbc\x75"
-DATA ·d+53392(SB)/8,$"\xfb\x3c\xa7\xc3\x9f\x16\x1f\x0b"
-DATA ·d+53400(SB)/8,$"\x59\xeb\xda\xa4\xa8\x22\x57\x96"
-DATA ·d+53408(SB)/8,$"\x1b\xbd\x39\xe4\xfb\x2b\xb9\x05"
-DATA ·d+53416(SB)/8,$"\x54\xbd\xbc\x92\x46\xc0\xa9\x53"
-DATA ·d+53424(SB)/8,$"\xe6\x0d\xa3\x9e\x54\x63\x15\xda"
-DATA ·d+53432(SB)/8,$"\x78\x73\xe1\xef\xec\xe9\xa5\x5c"
-DATA ·d+53440(SB)/8,$"\x89\x7c\x31\xf1\x86\x6f\x03\xdd"
-DATA ·d+53448(SB)/8,$"\xf9\x84\x4f\x36\xac\x15\xee\x08"
-DATA ·d+53456(SB)/8,$"\xea\x7c\xb9\x74\xd6\xfd\xec\x67"
-DATA ·d+53464(SB)/8,$"\xf8\x55\x80\xf7\xaf\x18\xd1\xf4"
-DATA ·d+53472(SB)/8,$"\xbf\x85\xf6\x5e\x28\xd4\x1e\xe3"
-DATA ·d+53480(SB)/8,$"\xc6\x37\xda\xcb\x5f\xdc\x24\x96"
-DATA ·d+53488(SB)/8,$"\x8f\x6a\x8d\xa7\x19\x24\x32\x11"
-DATA ·d+53496(SB)/8,$"\xfe\x93\x73\x30\x08\x9b\x94\x67"
-DATA ·d+53504(SB)/8,$"\x21\x58\x73\xcf\x43\x5f\x47\x1e"
-DATA ·d+53512(SB)/8,$"\x29\x71\x8f\xb9\x74\xe3\x6d\x12"
-DATA ·d+53520(SB)/8,$"\x95\xdf\xe4\x79\xf9\x7e\x49\x55"
-DATA ·d+53528(SB)/8,$"\x4a\x69\xa6\xe0\xf6\x4f\x4d\x74"
-DATA ·d+53536(SB)/8,$"\xd9\xbd\x3f\x53\xe9\xef\x87\xdf"
-DATA ·d+53544(SB)/8,$"\x02\x3c\x27\x1c\xf6\xd1\xeb\xf0"
-DATA ·d+53552(SB)/8,$"\xcf\xae\xea\xd7\x87\xcb\xd3\x74"
-DATA ·d+53560(SB)/8,$"\xcb\x39\xed\xf4\xe1\x73\xb0\x34"
-DATA ·d+53568(SB)/8,$"\x8d\x1c\xb3\x4d\x20\x29\x94\x04"
-DATA ·d+53576(SB)/8,$"\x20\x50\x33\x8e\x49\xe9\x5e\x13"
-DATA ·d+53584(SB)/8,$"\xe3\x2f\x13\x5e\x43\xe2\x09\x05"
-DATA ·d+53592(SB)/8,$"\x8d\x8e\x91\xa0\xda\x5b\x33\x5b"
-DATA ·d+53600(SB)/8,$"\x0b\x0b\x41\x15\xcc\xa0\x01\xb5"
-DATA ·d+53608(SB)/8,$"\xc3\x34\x81\x8b\x37\xab\x74\xcf"
-DATA ·d+53616(SB)/8,$"\x4a\xf7\x0d\x7b\xc4\xdf\x11\x3c"
-DATA ·d+53624(SB)/8,$"\x5b\xe1\xa3\xa4\x78\x36\x0f\x0a"
-DATA ·d+53632(SB)/8,$"\x69\x9e\x1f\x47\x5b\x7b\xbb\x59"
-DATA ·d+53640(SB)/8,$"\xf5\x6b\x1b\x99\x2e\x25\xb6\x5f"
-DATA ·d+53648(SB)/8,$"\x24\x34\x92\x5c\x11\x33\x31\x03"
-DATA ·d+53656(SB)/8,$"\x3b\x94\x05\x39\xcf\xa1\xaf\x8f"
-DATA ·d+53664(SB)/8,$"\x3c\x51\xf0\xab\xe2\x29\xd6\x4c"
-DATA ·d+53672(SB)/8,$"\x50\x69\x2b\x72\x4c\xa0\x2c\x9a"
-DATA ·d+53680(SB)/8,$"\x41\x4b\xc8\xc9\x97\x95\x51\xf6"
-DATA ·d+53688(SB)/8,$"\x66\x93\x2f\xd8\x01\xc9\xf4\xaf"
-DATA ·d+53696(SB)/8,$"\x43\x23\xe2\x59\x54\x72\xab\x8a"
-DATA ·d+53704(SB)/8,$"\xa3\xcc\xfa\x2b\xda\xde\xab\x60"
-DATA ·d+53712(SB)/8,$"\xa9\x95\xa8\xce\xca\xaf\x25\xd6"
-DATA ·d+53720(SB)/8,$"\xae\xd7\xd7\xc9\xbb\x5b\xe4\x8f"
-DATA ·d+53728(SB)/8,$"\x53\xd5\xfe\x20\xcb\xae\xf8\x6f"
-DATA ·d+53736(SB)/8,$"\xfe\x11\x91\x27\x9b\x1a\xc4\xb1"
-DATA ·d+53744(SB)/8,$"\xed\xba\x8d\x53\x0a\xa7\x20\x29"
-DATA ·d+53752(SB)/8,$"\x8b\x60\x3c\xf6\x22\xbc\x6b\x59"
-DATA ·d+53760(SB)/8,$"\xf0\xe6\x93\xa8\x5b\x46\x74\x13"
-DATA ·d+53768(SB)/8,$"\x1d\x9d\xce\xc0\x2e\x2d\xa6\x56"
-DATA ·d+53776(SB)/8,$"\x45\x59\x14\x64\xbc\x72\x05\x3f"
-DATA ·d+53784(SB)/8,$"\x2b\x1e\x36\xb0\x0c\x09\xf4\xac"
-DATA ·d+53792(SB)/8,$"\x0d\x73\x5a\xf1\x27\x83\xb4\x7b"
-DATA ·d+53800(SB)/8,$"\xaf\x04\x6a\x7a\x30\x8a\xef\xce"
-DATA ·d+53808(SB)/8,$"\xd9\x3b\x6a\x1a\x74\xa9\x7b\x0c"
-DATA ·d+53816(SB)/8,$"\xc8\xd8\x28\xaf\x20\x59\x22\xda"
-DATA ·d+53824(SB)/8,$"\x78\x65\x23\x39\xe3\x02\xb9\xbb"
-DATA ·d+53832(SB)/8,$"\x2f\x10\x6b\x7a\x4c\x6a\x5a\xe0"
-DATA ·d+53840(SB)/8,$"\xac\x8e\x8a\x1a\xda\x63\xb6\x0f"
-DATA ·d+53848(SB)/8,$"\x9b\xa3\x31\x6c\x9c\xe7\x9c\x74"
-DATA ·d+53856(SB)/8,$"\xaf\xaa\xc3\xe7\x74\xa5\x17\x08"
-DATA ·d+53864(SB)/8,$"\xd2\xf6\x7d\xad\x6b\x3f\x95\x8d"
-DATA ·d+53872(SB)/8,$"\x28\x64\x75\xa9\x3f\x68\xaa\xf3"
-DATA ·d+53880(SB)/8,$"\x37\xde\xe6\x85\xa5\x96\xbd\xa0"
-DATA ·d+53888(SB)/8,$"\xdd\x5d\x5c\xdf\xe9\xed\x74\x5a"
-DATA ·d+53896(SB)/8,$"\x05\x64\x96\xd6\x40\xc6\x09\x8f"
-DATA ·d+53904(SB)/8,$"\x08\xed\x83\x86\x5e\xcc\xac\x3f"
-DATA ·d+53912(SB)/8,$"\x5a\xd6\xd4\xbc\x29\x75\xe2\xcb"
-DATA ·d+53920(SB)/8,$"\x48\xb5\x7b\x3d\x42\x5b\x67\x7c"
-DATA ·d+53928(SB)/8,$"\x42\xff\x00\xda\x1b\xe3\x87\xcb"
-DATA ·d+53936(SB)/8,$"\x24\x97\xd9\x3a\xee\x4d\xaa\xdf"
-DATA ·d+53944(SB)/8,$"\x78\x11\xdf\x9e\x6b\xe8\xff\x00"
-DATA ·d+53952(SB)/8,$"\x07\xf8\x57\x55\xf1\x6f\xc3\x5f"
-DATA ·d+53960(SB)/8,$"\xf8\x4f\xb5\x1d\x3d\x74\xef\xb1"
-DATA ·d+53968(SB)/8,$"\x5c\x47\x1d\xad\xed\xe1\x92\xde"
-DATA ·d+53976(SB)/8,$"\x0d\x49\xb9\x76\x16\xf3\x2a\x6c"
-DATA ·d+53984(SB)/8,$"\x93\x68\x5c\x60\xe3\x3d\x01\xcd"
-DATA ·d+53992(SB)/8,$"\x7b\xe0\xfd\xbb\xe6\xba\xf0\x07"
-DATA ·d+54000(SB)/8,$"\xfc\x2a\x9f\x17\xf8\x7b\x41\xd5"
-DATA ·d+54008(SB)/8,$"\x6d\xad\x40\x5d\x3f\x59\xb5\x57"
-DATA ·d+54016(SB)/8,$"\xb5\xbd\xb3\x2b\xc0\x75\x6d\xa4"
-DATA ·d+54024(SB)/8,$"\x37\x38\x0c\x24\xe1\x87\x0d\x91"
-DATA ·d+54032(SB)/8,$"\x5f\x39\x8c\xab\xed\x2a\xfe\xea"
-DATA ·d+54040(SB)/8,$"\x7c\xd6\xdf\x5d\xbe\x4e\xc7\x7f"
-DATA ·d+54048(SB)/8,$"\xd6\xdc\x57\xb4\xe4\xb4\x96\x9e"
-DATA ·d+54056(SB)/8,$"\xbf\x75\xd7\xde\x7e\x7d\xdb\xc5"
-DATA ·d+54064(SB)/8,$"\xe2\x6f\x0d\x69\xef\x69\x25\xa5"
-DATA ·d+54072(SB)/8,$"\x9c\x8d\x09\xd9\x15\xdd\xb5\xdc"
-DATA ·d+54080(SB)/8,$"\x32\x41\x26\x38\xdc\x02\x90\x4e"
-DATA ·d+54088(SB)/8,$"\x47\xaf\x5a\xf6\xbf\x01\xfc\x33"
-DATA ·d+54096(SB)/8,$"\xd3\xbc\x4f\x0d\xc5\xc7\xc4\x26"
-DATA ·d+54104(SB)/8,$"\x53\x77\xe7\xc1\x6d\x62\x96\x77"
-DATA ·d+54112(SB)/8,$"\x70\x7d\x91\x7c\xd2\x0b\x33\xf9"
-DATA ·d+54120(SB)/8,$"\x01\xa4\x2c\x14\x82\xa0\x1c\x1e"
-DATA ·d+54128(SB)/8,$"\x84\x8c\x1a\xe7\xfc\x4f\xe2\x6d"
-DATA ·d+54136(SB)/8,$"\x43\xc5\xb3\x49\xe2\x88\xec\x54"
-DATA ·d+54144(SB)/8,$"\xc6\x23\x7b\x7f\xb6\x1b\x28\xa6"
-DATA ·d+54152(SB)/8,$"\x86\x44\x39\xc8\x95\x62\xdc\x80"
-DATA ·d+54160(SB)/8,$"\x81\x9c\x60\x02\x07\x4a\xe4\xef"
-DATA ·d+54168(SB)/8,$"\xfc\x5f\x37\x8b\xa1\xb2\xd4\xcd"
-DATA ·d+54176(SB)/8,$"\x85\x84\x26\xc9\x7c\x88\xae\x74"
-DATA ·d+54184(SB)/8,$"\x84\x16\xbe\x62\x60\x1c\x48\xa3"
-DATA ·d+54192(SB)/8,$"\x21\xf2\x46\x77\x70\x47\x38\xeb"
-DATA ·d+54200(SB)/8,$"\x45\x6c\xbe\x6d\x72\x53\xad\x76"
-DATA ·d+54208(SB)/8,$"\xfb\x6f\xa7\xcc\xe0\x54\xb9\xf9"
-DATA ·d+54216(SB)/8,$"\xaa\xba\x6a\x36\xdd\xf6\x7d\xb6"
-DATA ·d+54224(SB)/8,$"\xeb\xf2\x3e\xaa\xd4\xb4\x2f\x81"
-DATA ·d+54232(SB)/8,$"\x7a\x7f\x86\xee\xdf\x55\xd6\x2d"
-DATA ·d+54240(SB)/8,$"\xb4\x77\xfb\x49\x83\x47\xb0\xd3"
-DATA ·d+54248(SB)/8,$"\xa3\x17\xd7\x05\xa0\x3b\x43\x5c"
-DATA ·d+54256(SB)/8,$"\x42\xa4\x86\xdc\xc0\x90\xdb\x95"
-DATA ·d+54264(SB)/8,$"\x8e\x70\x4d\x7c\xc1\x0d\xb7\x82"
-DATA ·d+54272(SB)/8,$"\xec\x35\xa6\xd2\x2e\x35\x9b\xe6"
-DATA ·d+54280(SB)/8,$"\xb2\x8e\x45\x78\x24\xb3\xb2\xf9"
-DATA ·d+54288(SB)/8,$"\xb0\xdd\x41\x8e\x67\x56\x4c\x1e"
-DATA ·d+54296(SB)/8,$"\xc5\x88\x3d\x8d\x3e\xf3\xc4\x7a"
-DATA ·d+54304(SB)/8,$"\x75\xb6\x96\x6d\xb4\x78\xa1\x85"
-DATA ·d+54312(SB)/8,$"\xe5\x81\xa0\xbf\x92\xf6\x28\xde"
-DATA ·d+54320(SB)/8,$"\x59\x03\xf2\x58\x3a\xe7\x76\x0f"
-DATA ·d+54328(SB)/8,$"\x1f\x74\x30\x18\x3d\x6b\xc7\xa7"
-DATA ·d+54336(SB)/8,$"\xd4\xb4\x9b\xc9\x66\xd5\x1e\xf2"
-DATA ·d+54344(SB)/8,$"\x5b\x8b\xb5\x8c\x46\xed\xe6\x3b"
-DATA ·d+54352(SB)/8,$"\x16\x6c\xf0\x0b\x36\x09\x00\x74"
-DATA ·d+54360(SB)/8,$"\x18\xc7\xbd\x71\x2a\xf5\x23\xee"
-DATA ·d+54368(SB)/8,$"\x62\x6f\xae\x9d\xbf\x24\xff\x00"
-DATA ·d+54376(SB)/8,$"\x33\x4c\xbf\x23\x83\x84\xdd\x26"
-DATA ·d+54384(SB)/8,$"\xe4\xb7\xef\xaf\xe0\x7d\xa5\xa9"
-DATA ·d+54392(SB)/8,$"\xe8\xff\x00\x02\x3c\x53\xa0\xc1"
-DATA ·d+54400(SB)/8,$"\x61\xe3\x7d\x6f\xc4\xe9\x05\xa2"
-DATA ·d+54408(SB)/8,$"\x62\xd6\xfc\xe8\xf1\x48\x5d\x4e"
-DATA ·d+54416(SB)/8,$"\x07\x92\xd3\x45\x72\xc1\x14\x8f"
-DATA ·d+54424(SB)/8,$"\xba\x64\x53\xb5\xb0\x79\x19\x07"
-DATA ·d+54432(SB)/8,$"\xcf\x3c\x31\xfb\x32\x7c\x1c\xd6"
-DATA ·d+54440(SB)/8,$"\x3c\x5b\xa9\x6a\xfa\x16\xb5\x71"
-DATA ·d+54448(SB)/8,$"\xae\x68\x70\x5b\x33\x59\x47\x95"
-DATA ·d+54456(SB)/8,$"\xb5\xb9\x86\xe4\xb0\x11\x45\xa8"
-DATA ·d+54464(SB)/8,$"\x46\xc7\x72\xc6\x4e\xe5\x2f\x16"
-DATA ·d+54472(SB)/8,$"\x54\x90\x0e\x42\x93\x5f\x3a\x37"
-DATA ·d+54480(SB)/8,$"\x8e\x2f\x2d\x2c\x7c\xab\x8b\xb9"
-DATA ·d+54488(SB)/8,$"\xde\x1c\xec\x6b\x48\x47\x18\xfe"
-DATA ·d+54496(SB)/8,$"\x46\xba\x4d\x3a\x2b\x1f\x15\x69"
-DATA ·d+54504(SB)/8,$"\x7e\x7d\x84\xab\x01\x68\xce\xe0"
-DATA ·d+54512(SB)/8,$"\x15\x94\xa0\x5e\x3e\x66\x3c\x7c"
-DATA ·d+54520(SB)/8,$"\xdd\xf8\xe7\xbd\x76\xe1\x78\x6a"
-DATA ·d+54528(SB)/8,$"\xa5\x58\xdf\x0f\x55\xae\xd7\xb3"
-DATA ·d+54536(SB)/8,$"\x5f\xa0\xea\xd3\xaf\x87\xa5\x24"
-DATA ·d+54544(SB)/8,$"\xaa\x4a\x0a\x5a\x5d\x5f\xa9\xeb"
-DATA ·d+54552(SB)/8,$"\x32\x7d\xab\xe0\x97\x8a\xaf\xa6"
-DATA ·d+54560(SB)/8,$"\xd2\x2c\x3e\xcd\x75\x67\x25\x9d"
-DATA ·d+54568(SB)/8,$"\xcd\xaa\xc6\xcb\x35\x8a\xcd\x04"
-DATA ·d+54576(SB)/8,$"\xab\x34\x62\x52\x8e\xcb\x22\x30"
-DATA ·d+54584(SB)/8,$"\x51\xf2\xe7\xfd\xe1\xcd\x71\x1f"
-DATA ·d+54592(SB)/8,$"\xb4\xda\xf8\x4f\xc5\x7f\x10\x27"
-DATA ·d+54600(SB)/8,$"\xf1\xe7\x85\xae\xad\x23\x93\x54"
-DATA ·d+54608(SB)/8,$"\xb9\x37\xb7\xb6\x76\xff\x00\xbb"
-DATA ·d+54616(SB)/8,$"\x89\x26\xb9\xc4\xb2\x22\x8d\xc4"
-DATA ·d+54624(SB)/8,$"\x05\x0e\x5b\x1b\x72\xb8\xc0\x1d"
-DATA ·d+54632(SB)/8,$"\x31\x58\x7e\x1b\xf0\x84\x7a\x9d"
-DATA ·d+54640(SB)/8,$"\xbb\xb5\xb1\xb0\xbb\xb9\x81\x0d"
-DATA ·d+54648(SB)/8,$"\xd4\x5a\x6e\xc6\x41\x78\x15\x4e"
-DATA ·d+54656(SB)/8,$"\xf1\x13\x03\x81\x20\x51\xb8\x02"
-DATA ·d+54664(SB)/8,$"\xbf\x36\x38\xe6\xb8\x8f\x19\x6a"
-DATA ·d+54672(SB)/8,$"\xce\xeb\x15\xfd\xdd\x9d\xbd\xbd"
-DATA ·d+54680(SB)/8,$"\xb5\xdc\x21\x20\x85\xdd\xe7\x8d"
-DATA ·d+54688(SB)/8,$"\xa2\x8c\xe1\x82\xb4\x98\x31\xb0"
-DATA ·d+54696(SB)/8,$"\x65\xe5\x70\x31\xdb\x8a\xe5\x7c"
-DATA ·d+54704(SB)/8,$"\x39\x5e\x8d\x49\x49\xc9\xb7\xd4"
-DATA ·d+54712(SB)/8,$"\xec\xcb\x70\xea\x55\xe1\x52\x32"
-DATA ·d+54720(SB)/8,$"\xbb\x8e\x97\xea\xfd\x6f\x7b\xf7"
-DATA ·d+54728(SB)/8,$"\xf2\x34\xfc\x2d\xe1\xfd\x43\xc4"
-DATA ·d+54736(SB)/8,$"\xb6\xd3\x69\x29\x3a\x32\x91\xe6"
-DATA ·d+54744(SB)/8,$"\xc5\x2a\x31\xc8\xd8\x32\x63\x78"
-DATA ·d+54752(SB)/8,$"\xc7\xcd\xc0\xce\xd7\x5c\xe7\x1d"
-DATA ·d+54760(SB)/8,$"\x2b\x4d\x62\xbb\xf0\xdb\xbc\xd6"
-DATA ·d+54768(SB)/8,$"\xf7\x31\x4a\xb6\xac\x16\x3b\x8b"
-DATA ·d+54776(SB)/8,$"\x79\x0b\x62\x68\xff\x00\x84\x93"
-DATA ·d+54784(SB)/8,$"\x82\x18\x11\x8c\xe3\x1f\x85\x79"
-DATA ·d+54792(SB)/8,$"\xd0\xd4\x2c\x11\x6d\x75\x5f\x0a"
-DATA ·d+54800(SB)/8,$"\x5f\xcd\x0d\xdc\x2e\x14\xe9\xee"
-DATA ·d+54808(SB)/8,$"\x0e\xe8\xf1\xc8\x68\x26\x5c\x6e"
-DATA ·d+54816(SB)/8,$"\x52\x78\x20\x90\xc3\xde\xbb\xa8"
-DATA ·d+54824(SB)/8,$"\xb5\x7f\x1e\x4b\xac\x5b\x6a\xb0"
-DATA ·d+54832(SB)/8,$"\xe9\x5a\x70\xb8\x49\x63\xba\x05"
-DATA ·d+54840(SB)/8,$"\xed\x77\x47\x2b\xc4\x77\x6e\x9a"
-DATA ·d+54848(SB)/8,$"\x22\xc5\x5b\x77\x46\xc8\xf9\x87"
-DATA ·d+54856(SB)/8,$"\x51\x5d\xd8\x6a\xf8\xc8\xc3\xdc"
-DATA ·d+54864(SB)/8,$"\x87\x3a\xd7\x46\x75\xe3\xb0\x4e"
-DATA ·d+54872(SB)/8,$"\x33\xf7\xaa\x24\x9f\x47\xa6\xbf"
-DATA ·d+54880(SB)/8,$"\xf0\x7f\xa4\x7a\x24\x5f\x12\x3c"
-DATA ·d+54888(SB)/8,$"\x4d\x71\xaf\x78\x7f\x52\xd6\x74"
-DATA ·d+54896(SB)/8,$"\x19\x56\xea\xd2\x5b\x6b\xbd\x3a"
-DATA ·d+54904(SB)/8,$"\x4b\xd2\xd1\xc5\x76\x23\x97\x7a"
-DATA ·d+54912(SB)/8,$"\x1e\xaa\xac\x8d\xf7\x72\xa7\x18"
-DATA ·d+54920(SB)/8,$"\xe8\x45\x7b\xaf\xc5\xaf\xdb\xcb"
-DATA ·d+54928(SB)/8,$"\xc6\x1f\x10\x3c\x65\x7b\xa9\xf8"
-DATA ·d+54936(SB)/8,$"\xd2\xd3\x40\xb3\xd4\x2e\x93\xec"
-DATA ·d+54944(SB)/8,$"\xf7\x4a\x90\x4b\x24\x31\x03\xfc"
-DATA ·d+54952(SB)/8,$"\x4b\x0a\xb1\x1b\x87\xf7\xc3\x1a"
-DATA ·d+54960(SB)/8,$"\xf9\xcf\xc7\xdf\x11\x3e\x26\x78"
-DATA ·d+54968(SB)/8,$"\xf1\x2d\x34\x8f\x88\x0d\xa7\xd9"
-DATA ·d+54976(SB)/8,$"\xd9\x6e\x67\x4f\xb0\x47\x12\x47"
-DATA ·d+54984(SB)/8,$"\x08\x73\xbb\xe5\x86\x21\x84\x5c"
-DATA ·d+54992(SB)/8,$"\x80\x42\x80\x3d\xeb\xa2\xf8\x75"
-DATA ·d+55000(SB)/8,$"\xe0\x9f\x87\x92\xeb\x36\xb6\xff"
-DATA ·d+55008(SB)/8,$"\x00\x10\xb5\x9b\x4d\x77\x45\x90"
-DATA ·d+55016(SB)/8,$"\xb4\x37\x09\x6f\x63\x71\x04\xd6"
-DATA ·d+55024(SB)/8,$"\xc7\x6e\x55\xda\x40\xa2\x55\x50"
-DATA ·d+55032(SB)/8,$"\x48\x01\x94\x10\xc7\x23\x80\x09"
-DATA ·d+55040(SB)/8,$"\xaf\x0e\xb5\x0a\xb5\x65\x19\x57"
-DATA ·d+55048(SB)/8,$"\xa0\xb9\x92\x69\x2b\x37\xa7\x93"
-DATA ·d+55056(SB)/8,$"\x4d\x7d\xd6\x32\xa5\x83\xc1\xc2"
-DATA ·d+55064(SB)/8,$"\x9d\xe7\x0b\x6b\x7b\x46\x5a\x6f"
-DATA ·d+55072(SB)/8,$"\xd2\xea\xdf\x26\xd1\x4a\xc6\xf3"
-DATA ·d+55080(SB)/8,$"\x5a\xd5\xae\x5b\xc4\x9a\x67\x8b"
-DATA ·d+55088(SB)/8,$"\x7c\x29\x3a\xc0\x4c\xb2\x7d\x9b"
-DATA ·d+55096(SB)/8,$"\x50\x06\x48\x90\x37\x08\x61\x98"
-DATA ·d+55104(SB)/8,$"\x16\x50\x4e\x30\x9c\xf5\xf7\xae"
-DATA ·d+55112(SB)/8,$"\x9f\x43\xf1\x47\x83\xec\xf5\xf6"
-DATA ·d+55120(SB)/8,$"\xbb\xbf\xf1\x97\x85\x22\xb8\xb9"
-DATA ·d+55128(SB)/8,$"\xb7\x92\x09\x97\xec\x4d\x7a\x8c"
-DATA ·d+55136(SB)/8,$"\xae\x79\x8c\xa8\x88\x05\x6c\xe7"
-DATA ·d+55144(SB)/8,$"\x18\x1c\x1a\xf2\x0f\x0c\xf8\x6f"
-DATA ·d+55152(SB)/8,$"\x47\xd2\xbc\x5a\xd3\x68\x9a\x3e"
-DATA ·d+55160(SB)/8,$"\x9f\xa8\x43\x65\x76\xf3\xc7\x05"
-DATA ·d+55168(SB)/8,$"\xe4\x5e\x60\x92\x2c\x95\xf9\xc1"
-DATA ·d+55176(SB)/8,$"\x20\xe0\xaf\x04\x80\x0f\x71\x8a"
-DATA ·d+55184(SB)/8,$"\xf6\x03\x75\xf0\x8e\xfa\x16\xb3"
-DATA ·d+55192(SB)/8,$"\xd3\xbc\x15\x79\x71\x7e\xc5\xe6"
-DATA ·d+55200(SB)/8,$"\x58\xa3\xb9\x95\x96\x00\x4f\xfa"
-DATA ·d+55208(SB)/8,$"\xb8\xcc\x2a\xc1\x94\x63\x87\x70"
-DATA ·d+55216(SB)/8,$"\x1c\x03\xce\x78\x35\xee\x7b\x2a"
-DATA ·d+55224(SB)/8,$"\xd8\x58\xc1\xd4\x84\x6d\x2e\xcb"
-DATA ·d+55232(SB)/8,$"\x55\xe4\xee\xca\xc6\x60\x30\x75"
-DATA ·d+55240(SB)/8,$"\xa7\x35\x4a\x32\x76\xff\x00\x0f"
-DATA ·d+55248(SB)/8,$"\xf9\x7f\x91\xd3\xf8\x67\xe0\xee"
-DATA ·d+55256(SB)/8,$"\x9d\xa8\xdf\x43\x75\xa0\xeb\x5a"
-DATA ·d+55264(SB)/8,$"\x26\xbf\x6f\x1e\xcf\x3f\x4a\xbc"
-DATA ·d+55272(SB)/8,$"\x65\xb4\x94\x2e\xe0\xa1\x52\x4b"
-DATA ·d+55280(SB)/8,$"\x90\xa7\x1c\x81\xbb\x3c\x55\x69"
-DATA ·d+55288(SB)/8,$"\x75\xef\x86\x9e\x03\xf8\x88\x5f"
-DATA ·d+55296(SB)/8,$"\x5e\xf0\x4d\x8d\xad\xc6\x9d\x78"
-DATA ·d+55304(SB)/8,$"\x27\xfe\xcb\xbc\x9a\xea\xfd\x77"
-DATA ·d+55312(SB)/8,$"\xc6\x72\x21\x68\xa7\x71\x19\x8f"
-DATA ·d+55320(SB)/8,$"\x03\x3b\x8e\xfd\xfd\x8e\x2b\x9c"
-DATA ·d+55328(SB)/8,$"\xd4\x3c\x5d\xf0\x70\xe9\x6d\x05"
-DATA ·d+55336(SB)/8,$"\xc7\x85\x75\xad\x36\xed\x22\x54"
-DATA ·d+55344(SB)/8,$"\x65\xfe\xd9\x79\x96\x47\x55\x21"
-DATA ·d+55352(SB)/8,$"\x9b\xcb\x9e\xdf\x80\xcd\xcf\x0c"
-DATA ·d+55360(SB)/8,$"\x70\x3a\x7a\x56\x5d\xee\xb9\xa5"
-DATA ·d+55368(SB)/8,$"\x78\x93\xc2\x76\x7a\x2b\xea\x3a"
-DATA ·d+55376(SB)/8,$"\x4c\xf3\xd9\xa1\x91\x26\xd4\x6f"
-DATA ·d+55384(SB)/8,$"\x10\x4d\x10\x6e\x3c\xb4\x76\x0a"
-DATA ·d+55392(SB)/8,$"\xc1\x54\x0c\xed\x27\x83\xd2\xbb"
-DATA ·d+55400(SB)/8,$"\xb0\xd8\xcc\x16\x22\x6f\x96\xf1"
-DATA ·d+55408(SB)/8,$"\xe9\x67\x65\xf9\x3b\x1e\x3d\x7c"
-DATA ·d+55416(SB)/8,$"\xab\x17\x45\x27\x55\x39\xa7\xdb"
-DATA ·d+55424(SB)/8,$"\x4f\xd1\x7e\xb7\x3d\xfb\xc6\x5f"
-DATA ·d+55432(SB)/8,$"\xb4\x66\x95\xe3\x5d\x1d\x2e\x46"
-DATA ·d+55440(SB)/8,$"\x81\x24\x50\xdb\x86\x58\x22\xb5"
-DATA ·d+55448(SB)/8,$"\xf2\x8e\x3c\xc3\xd0\xac\x51\x01"
-DATA ·d+55456(SB)/8,$"\xb4\xf6\xcf\x41\xd2\xbc\x22\xdf"
-DATA ·d+55464(SB)/8,$"\xc7\x1f\x0e\x75\x2b\xb9\xa6\xd7"
-DATA ·d+55472(SB)/8,$"\xf4\xbb\xcb\x49\x0c\x64\x5a\xa5"
-DATA ·d+55480(SB)/8,$"\xad\xae\x23\x69\x3a\x13\x23\x37"
-DATA ·d+55488(SB)/8,$"\x3c\x7a\x80\x33\xde\xbc\xe5\x6e"
-DATA ·d+55496(SB)/8,$"\xb6\x44\xf1\xc7\x7d\xa6\xb6\x5d"
-DATA ·d+55504(SB)/8,$"\x03\x44\x67\x0e\x59\x90\xf5\x50"
-DATA ·d+55512(SB)/8,$"\x3d\x47\x15\xd2\xda\x4b\x7f\x6d"
-DATA ·d+55520(SB)/8,$"\x3a\x6a\xb0\xdb\x44\x8d\x78\xaf"
-DATA ·d+55528(SB)/8,$"\x6a\xa6\x7b\x49\x6e\x20\x64\x1f"
-DATA ·d+55536(SB)/8,$"\x2b\xcb\x1c\x6a\xc9\xba\x48\xc9"
-DATA ·d+55544(SB)/8,$"\xfe\x1e\x95\xd5\x5b\x1b\x57\x0f"
-DATA ·d+55552(SB)/8,$"\x4f\xd8\x61\x24\xb9\x63\xd3\x47"
-DATA ·d+55560(SB)/8,$"\xad\xef\xd5\xf5\x3c\x78\x64\x38"
-DATA ·d+55568(SB)/8,$"\x68\xca\xf5\x29\xbd\x7a\xa9\x4a"
-DATA ·d+55576(SB)/8,$"\x3a\x76\xfc\xfa\x1d\xef\x86\x7e"
-DATA ·d+55584(SB)/8,$"\x22\x7c\x55\xb1\xd1\xe6\xd5\xfe"
-DATA ·d+55592(SB)/8,$"\x1e\x47\x61\x2d\xbe\x9d\x6f\x25"
-DATA ·d+55600(SB)/8,$"\xcd\xe2\x69\xe1\x5f\x51\xb3\x85"
-DATA ·d+55608(SB)/8,$"\xc9\x0c\xf7\xaa\xd1\xb3\x08\xbf"
-DATA ·d+55616(SB)/8,$"\xdb\xc3\x28\x07\xa8\x02\xb1\x6f"
-DATA ·d+55624(SB)/8,$"\xfc\x79\xe3\xad\x6b\x40\x7b\xcb"
-DATA ·d+55632(SB)/8,$"\x3d\x46\xfe\xca\xe2\x47\x69\x1a"
-DATA ·d+55640(SB)/8,$"\xdc\x34\x60\xa9\x3f\xc4\xac\x8a"
-DATA ·d+55648(SB)/8,$"\xa1\xfa\x7d\xde\x08\xe3\x8c\x57"
-DATA ·d+55656(SB)/8,$"\x1d\xa1\xdd\xfc\x48\xf0\x9b\xdf"
-DATA ·d+55664(SB)/8,$"\x69\xfe\x1e\xd5\xaf\x7e\xcf\x77"
-DATA ·d+55672(SB)/8,$"\x1f\x97\x7a\xae\x5e\xd6\x3b\x98"
-DATA ·d+55680(SB)/8,$"\x57\x90\xac\xb2\x38\x2c\x87\x3c"
-DATA ·d+55688(SB)/8,$"\xa3\x64\x7a\x83\xc5\x52\xd4\x35"
-DATA ·d+55696(SB)/8,$"\xbf\x1a\xdd\xc2\x57\x5a\xb6\x57"
-DATA ·d+55704(SB)/8,$"\x85\x71\x29\x9a\x3e\x0a\xaa\x1e"
-DATA ·d+55712(SB)/8,$"\x3a\x0d\xa3\x1d\xd8\xf1\x5a\xf0"
-DATA ·d+55720(SB)/8,$"\xd3\xe5\xab\x37\x8e\xac\xa5\x7e"
-DATA ·d+55728(SB)/8,$"\x9c\xa9\x5b\xb6\xa9\xef\xf2\x3b"
-DATA ·d+55736(SB)/8,$"\xf1\xb9\x66\x15\x55\x8b\xc2\x50"
-DATA ·d+55744(SB)/8,$"\x8a\xd7\x7b\xdd\xbe\xfa\x35\xa9"
-DATA ·d+55752(SB)/8,$"\xc4\x4d\x0e\xbb\xa9\x5e\x15\xf1"
-DATA ·d+55760(SB)/8,$"\x2e\xa3\xae\x5f\xc8\xcc\x77\xc1"
-DATA ·d+55768(SB)/8,$"\x23\x6c\x0d\x93\xc8\x20\x00\x45"
-DATA ·d+55776(SB)/8,$"\x4c\x7c\x0b\xa0\xe4\xe7\x46\xbe"
-DATA ·d+55784(SB)/8,$"\xce\x79\xcc\x8f\x9f\xe7\x5e\x85"
-DATA ·d+55792(SB)/8,$"\xfd\xb7\x7f\x7f\x60\x4d\xec\x72"
-DATA ·d+55800(SB)/8,$"\x98\xdb\x6a\x24\xa4\x7c\xeb\x83"
-DATA ·d+55808(SB)/8,$"\xc7\xef\x30\x4e\x3b\x1c\x1c\xe2"
-DATA ·d+55816(SB)/8,$"\xb2\xff\x00\xb4\xa4\x5f\x95\x2d"
-DATA ·d+55824(SB)/8,$"\xaf\xf6\x8e\x07\xfa\x6a\xf4\xff"
-DATA ·d+55832(SB)/8,$"\x00\xbe\x6b\xec\xa9\x60\xb2\xf8"
-DATA ·d+55840(SB)/8,$"\xdf\x9e\x9a\x95\xfa\xb5\x7f\xce"
-DATA ·d+55848(SB)/8,$"\xe7\x54\xb3\x1c\x4d\xed\x1f\x77"
-DATA ·d+55856(SB)/8,$"\xd3\x45\xf8\x58\xff\xd1\xfe\x78"
-DATA ·d+55864(SB)/8,$"\x74\xbf\x18\xff\x00\xc2\x33\xa1"
-DATA ·d+55872(SB)/8,$"\x85\xd1\xe0\xb8\x80\xb3\xfc\xfa"
-DATA ·d+55880(SB)/8,$"\x96\x95\x12\xdb\xdd\x4b\xbd\x36"
-DATA ·d+55888(SB)/8,$"\x90\xd7\x0c\x7c\xc6\x56\x07\xa2"
-DATA ·d+55896(SB)/8,$"\x05\x5c\xd5\xdf\x85\x1e\x24\xb1"
-DATA ·d+55904(SB)/8,$"\x1e\x29\x7b\x78\x27\x9b\xec\x9a"
-DATA ·d+55912(SB)/8,$"\x8d\xad\xcd\xae\xab\x0e\xa0\x0b"
-DATA ·d+55920(SB)/8,$"\x2f\xd9\xa4\x5f\x9f\xe6\x18\x6d"
-DATA ·d+55928(SB)/8,$"\xc3\x01\x81\xe7\x04\x66\xb8\x9d"
-DATA ·d+55936(SB)/8,$"\x47\xc3\x71\x78\x18\x5c\xe8\xda"
-DATA ·d+55944(SB)/8,$"\x94\xb6\x36\x57\x51\xde\x88\xc0"
-DATA ·d+55952(SB)/8,$"\x4b\x91\x77\xa8\x15\x60\x1c\x6e"
-DATA ·d+55960(SB)/8,$"\x58\x9c\xc6\x91\xa8\xee\x09\x25"
-DATA ·d+55968(SB)/8,$"\xb8\xeb\x5e\xb1\xe1\x6f\x80\xde"
-DATA ·d+55976(SB)/8,$"\x3d\xd6\x5a\x6b\xab\xfb\x93\xa4"
-DATA ·d+55984(SB)/8,$"\xc7\xb4\x47\x05\xc2\xc5\x2c\x82"
-DATA ·d+55992(SB)/8,$"\xeb\xcc\x1f\x31\x46\xdd\x10\x54"
-DATA ·d+56000(SB)/8,$"\xda\x7e\x62\xc4\x67\x91\x83\xd6"
-DATA ·d+56008(SB)/8,$"\xbe\xf9\xf1\x73\x84\x5c\x1c\x63"
-DATA ·d+56016(SB)/8,$"\xcb\xe5\xdb\xf5\x3f\xcf\xfc\x4e"
-DATA ·d+56024(SB)/8,$"\x5b\x43\x95\xce\x17\x5c\xdd\x5d"
-DATA ·d+56032(SB)/8,$"\xb5\x76\xed\xe4\x5b\xf1\x8e\x93"
-DATA ·d+56040(SB)/8,$"\x6b\xaa\xf8\x86\xca\xdb\xc1\xef"
-DATA ·d+56048(SB)/8,$"\xab\x6b\xfe\x19\xb1\x8c\x46\xb2"
-DATA ·d+56056(SB)/8,$"\x5e\xdc\x7d\x90\xcd\x1a\x0c\xca"
-DATA ·d+56064(SB)/8,$"\x15\x14\xf9\x88\x8b\xfc\x25\xc6"
-DATA ·d+56072(SB)/8,$"\x78\xcf\x00\x81\x5e\x51\xaa\xda"
-DATA ·d+56080(SB)/8,$"\x5a\x6b\xbe\x39\x8e\xc3\xe1\x76"
-DATA ·d+56088(SB)/8,$"\x8f\x3d\xa4\x17\x33\x28\xd3\xe3"
-DATA ·d+56096(SB)/8,$"\x9a\xe0\x5c\x4a\xc1\x30\x49\x33"
-DATA ·d+56104(SB)/8,$"\x10\x14\xf2\xa7\xd7\x19\xea\x6b"
-DATA ·d+56112(SB)/8,$"\x77\xc6\x70\xcd\xf0\x32\xf2\xdb"
-DATA ·d+56120(SB)/8,$"\x47\xbb\x9a\xca\x6d\x4b\x50\xb5"
-DATA ·d+56128(SB)/8,$"\x69\x56\xee\xd6\xe9\x6e\x60\x85"
-DATA ·d+56136(SB)/8,$"\x0b\x98\xf1\x88\xc0\x02\x4f\x97"
-DATA ·d+56144(SB)/8,$"\x25\x58\x9c\x0a\xb5\x16\x9b\xf0"
-DATA ·d+56152(SB)/8,$"\xd3\x5e\x98\x49\x6f\x37\x88\x34"
-DATA ·d+56160(SB)/8,$"\xbb\xa1\x87\xb5\xbc\x33\x47\x73"
-DATA ·d+56168(SB)/8,$"\x6c\x25\x6e\x49\x28\x88\x8e\x8a"
-DATA ·d+56176(SB)/8,$"\x4f\x75\x3c\x7a\x1a\xce\xb6\x75"
-DATA ·d+56184(SB)/8,$"\x5e\x71\x6f\xa3\x5a\x59\x5f\xef"
-DATA ·d+56192(SB)/8,$"\x38\x70\x58\x09\x52\x84\x67\x28"
-DATA ·d+56200(SB)/8,$"\xbe\x5d\x52\x7d\xfb\xbb\x5f\xbf"
-DATA ·d+56208(SB)/8,$"\x7f\xb8\xea\xf4\x98\xbc\x4d\x73"
-DATA ·d+56216(SB)/8,$"\x78\xbe\x22\xd0\x66\x92\xd9\x66"
-DATA ·d+56224(SB)/8,$"\x9a\x65\x8e\xe8\x1b\x45\x76\x8a"
-DATA ·d+56232(SB)/8,$"\xdc\xf9\x72\xa9\x8e\x66\xd8\x9b"
-DATA ·d+56240(SB)/8,$"\x1b\x38\xdf\x82\xd8\xc8\xe3\x9a"
-DATA ·d+56248(SB)/8,$"\x9b\x5c\xd4\xfe\x22\x3d\xbc\xf2"
-DATA ·d+56256(SB)/8,$"\xd9\x78\x96\xca\xf2\xde\x17\xf2"
-DATA ·d+56264(SB)/8,$"\x6f\x2d\xee\x22\xb5\x59\xe1\x01"
-DATA ·d+56272(SB)/8,$"\xb6\xe4\x28\x52\x1c\x77\x0c\x8d"
-DATA ·d+56280(SB)/8,$"\xcf\x6c\xd5\x2f\x0e\xf8\x03\xc1"
-DATA ·d+56288(SB)/8,$"\x5a\x8e\x95\xf6\xed\x72\xff\x00"
-DATA ·d+56296(SB)/8,$"\x4e\x82\xfb\x4e\x95\x2d\xe7\xb6"
-DATA ·d+56304(SB)/8,$"\x78\x1a\x69\x2f\xd5\xb7\x6d\x92"
-DATA ·d+56312(SB)/8,$"\x29\x70\x63\x1b\x40\x19\xc8\x25"
-DATA ·d+56320(SB)/8,$"\x81\xec\x78\xac\xdb\xef\x05\xff"
-DATA ·d+56328(SB)/8,$"\x00\xc2\x71\x14\xfe\x30\xb3\x8a"
-DATA ·d+56336(SB)/8,$"\x7b\x88\xed\x59\x60\xb8\x91\x21"
-DATA ·d+56344(SB)/8,$"\x10\x5b\xc6\x63\xfb\x8a\xfb\x40"
-DATA ·d+56352(SB)/8,$"\x54\xf9\x79\xf9\xb9\x22\xb9\xb2"
-DATA ·d+56360(SB)/8,$"\xac\xfb\x17\x1a\x8e\x15\x2a\x5d"
-DATA ·d+56368(SB)/8,$"\x77\xda\xde\x47\x2d\x6c\x25\x0d"
-DATA ·d+56376(SB)/8,$"\x5c\x63\xa7\x67\x14\xff\x00\xe0"
-DATA ·d+56384(SB)/8,$"\xff\x00\x91\xf4\x36\x99\xf1\x1e"
-DATA ·d+56392(SB)/8,$"\xef\xc1\xb2\xd8\xe8\x56\x97\xb1"
-DATA ·d+56400(SB)/8,$"\xcf\xcc\x13\x69\xd2\x49\xa5\xc3"
-DATA ·d+56408(SB)/8,$"\x34\x66\x5c\x0d\xee\x56\x47\xdb"
-DATA ·d+56416(SB)/8,$"\x80\xc3\x68\x1d\x49\xf4\x15\xeb"
-DATA ·d+56424(SB)/8,$"\x7e\x3d\xf8\xc5\xf0\x67\xe2\x0d"
-DATA ·d+56432(SB)/8,$"\xbd\xb5\xa7\xc4\x3d\x07\x45\xb3"
-DATA ·d+56440(SB)/8,$"\xf1\x54\x76\x6f\x6f\x7b\xac\x68"
-DATA ·d+56448(SB)/8,$"\x96\x56\x96\xb0\x5c\xee\x7d\xc2"
-DATA ·d+56456(SB)/8,$"\x7b\x8b\x28\xb7\x0f\xb4\x01\xf2"
-DATA ·d+56464(SB)/8,$"\x19\x30\xa5\x47\x4c\x93\x9a\xf9"
-DATA ·d+56472(SB)/8,$"\x97\xc1\xfe\x2c\xd0\xb4\x0d\x38"
-DATA ·d+56480(SB)/8,$"\x68\xbe\x2e\xd0\xad\xb5\xb8\x25"
-DATA ·d+56488(SB)/8,$"\x0e\x96\x2d\xa9\x5c\xcf\x15\xb5"
-DATA ·d+56496(SB)/8,$"\x9b\xcb\xb4\x19\xed\xc4\x0c\xa3"
-DATA ·d+56504(SB)/8,$"\xcd\xe3\x00\xb6\x40\xe4\x81\x9a"
-DATA ·d+56512(SB)/8,$"\xf7\x3d\x77\x45\xf8\x67\x3e\xeb"
-DATA ·d+56520(SB)/8,$"\x5f\x16\xc5\x0c\x1a\x7d\xec\x46"
-DATA ·d+56528(SB)/8,$"\x6d\x22\x61\x3c\xb2\x09\x23\xc7"
-DATA ·d+56536(SB)/8,$"\x1e\x50\x67\x46\x0b\xbc\x15\x0e"
-DATA ·d+56544(SB)/8,$"\xc7\x3c\x77\xaf\x52\x78\x9c\x44"
-DATA ·d+56552(SB)/8,$"\x66\x9d\x58\xda\x4b\xad\xef\x7f"
-DATA ·d+56560(SB)/8,$"\xc7\x5f\x4b\x1f\x32\xe8\x53\x85"
-DATA ·d+56568(SB)/8,$"\xe5\xcc\xdc\x3a\xd9\x6a\x9f\x97"
-DATA ·d+56576(SB)/8,$"\xf5\xa9\xe4\xd2\x6a\x3e\x06\xf8"
-DATA ·d+56584(SB)/8,$"\x6b\xe2\x1f\xed\x8f\x00\xeb\xfa"
-DATA ·d+56592(SB)/8,$"\x37\x88\xec\xa5\xb3\x47\xbc\xd2"
-DATA ·d+56600(SB)/8,$"\x35\x8b\x57\x30\xb3\x64\xab\xdb"
-DATA ·d+56608(SB)/8,$"\xb8\x62\x8d\xbd\x06\x19\x1d\x08"
-DATA ·d+56616(SB)/8,$"\xfc\xc7\x3e\x95\xa6\x78\x47\xe1"
-DATA ·d+56624(SB)/8,$"\xef\x8a\x35\xdf\x0e\x43\xa6\x59"
-DATA ·d+56632(SB)/8,$"\x5d\xe8\xd6\xda\xd2\x88\xda\xee"
-DATA ·d+56640(SB)/8,$"\xe2\xf2\x5b\xab\x45\x2e\xc1\x64"
-DATA ·d+56648(SB)/8,$"\x9d\xa4\x99\x55\xbc\x91\x8f\x99"
-DATA ·d+56656(SB)/8,$"\x49\x65\xed\x9c\xd7\x91\xf8\xc3"
-DATA ·d+56664(SB)/8,$"\xf6\x65\xd0\x6e\xef\x61\x93\x40"
-DATA ·d+56672(SB)/8,$"\x92\x58\x6f\x26\x74\x8e\x0b\x0b"
-DATA ·d+56680(SB)/8,$"\xd4\xd9\xbc\xb0\x0c\x08\x73\xb0"
-DATA ·d+56688(SB)/8,$"\x91\x83\xc9\x63\x8e\xfb\xb1\x5e"
-DATA ·d+56696(SB)/8,$"\xad\xe1\xbb\x4b\xa9\xa1\xd0\xbe"
-DATA ·d+56704(SB)/8,$"\x13\x7c\x42\x77\xb6\xbc\xd1\xef"
-DATA ·d+56712(SB)/8,$"\x5a\xc7\x4a\x8a\xfa\x16\x2b\xf6"
-DATA ·d+56720(SB)/8,$"\x77\x07\x7d\xb4\x77\xb0\x16\xf2"
-DATA ·d+56728(SB)/8,$"\x06\xe0\x42\x3b\x89\x15\x49\xca"
-DATA ·d+56736(SB)/8,$"\x82\x38\xac\xb3\x0a\xd2\x94\x39"
-DATA ·d+56744(SB)/8,$"\xa9\x27\x76\xb5\x6b\xf0\x76\xd8"
-DATA ·d+56752(SB)/8,$"\xe8\x9d\x4a\x33\x94\x2d\x27\x2b"
-DATA ·d+56760(SB)/8,$"\x3d\x53\x56\xd3\xb5\xf7\x7e\x5b"
-DATA ·d+56768(SB)/8,$"\xe9\x73\xd2\x34\x9d\x43\xc0\x3e"
-DATA ·d+56776(SB)/8,$"\x1e\x9a\xfe\x4f\x85\x9e\x37\xd0"
-DATA ·d+56784(SB)/8,$"\x2c\xaf\x2d\x55\xac\xee\x34\xdf"
-DATA ·d+56792(SB)/8,$"\x14\x2c\x70\xdb\xea\x1b\x58\xa8"
-DATA ·d+56800(SB)/8,$"\x6b\x08\x84\x44\x3b\x1c\x6e\xcc"
-DATA ·d+56808(SB)/8,$"\xa4\x15\x07\x70\x63\x5e\x1d\xf1"
-DATA ·d+56816(SB)/8,$"\x47\xc4\x9a\xfd\xed\xf5\xed\xc7"
-DATA ·d+56824(SB)/8,$"\x8f\xb4\xfd\x3b\x53\xd5\xd5\x55"
-DATA ·d+56832(SB)/8,$"\xe6\x9e\xc8\x40\xe4\xab\xa8\xd9"
-DATA ·d+56840(SB)/8,$"\x27\x99\x11\xda\xc0\xae\x02\xb0"
-DATA ·d+56848(SB)/8,$"\xe8\x38\x3c\xe6\xbc\x73\xe2\xa7"
-DATA ·d+56856(SB)/8,$"\xc1\xed\x77\xe1\x97\xc4\x3b\xef"
-DATA ·d+56864(SB)/8,$"\x0f\x6a\x49\xb5\xad\xee\x10\xb7"
-DATA ·d+56872(SB)/8,$"\xd9\xae\x12\xe8\x79\x32\x8d\xe8"
-DATA ·d+56880(SB)/8,$"\x03\x40\xce\xae\xbb\x7a\x90\x73"
-DATA ·d+56888(SB)/8,$"\xea\x14\xe4\x0c\xb8\x4f\x8a\xe1"
-DATA ·d+56896(SB)/8,$"\xb7\x9b\x55\xf0\xdd\x82\x9d\x39"
-DATA ·d+56904(SB)/8,$"\x5d\xad\xe7\xb8\xb9\x22\x35\x53"
-DATA ·d+56912(SB)/8,$"\x80\x59\x63\xc9\xcf\x4e\x70\x7a"
-DATA ·d+56920(SB)/8,$"\xf6\xaf\x5f\x24\xcd\xa5\x86\xe4"
-DATA ·d+56928(SB)/8,$"\x6e\x3c\xd7\xeb\xfe\x7d\xfe\xe3"
-DATA ·d+56936(SB)/8,$"\x0c\x6e\x4f\x0a\xea\x2e\x2e\xcb"
-DATA ·d+56944(SB)/8,$"\x7b\x3f\x77\x5e\xa9\x75\x47\x73"
-DATA ·d+56952(SB)/8,$"\xa0\xcb\xe1\x7b\xe6\x8b\x52\xb7"
-DATA ·d+56960(SB)/8,$"\x91\x2c\x26\x29\xe5\xca\xd1\x86"
-DATA ·d+56968(SB)/8,$"\x8d\xd5\x49\xee\x8e\x02\x31\x07"
-DATA ·d+56976(SB)/8,$"\xb8\xae\xbb\xc0\x9e\x19\xf0\x77"
-DATA ·d+56984(SB)/8,$"\x89\x3f\xb5\xdf\xc5\x96\xd7\x6c"
-DATA ·d+56992(SB)/8,$"\xd6\xe9\x24\xa9\x35\xbc\xb0\x25"
-DATA ·d+57000(SB)/8,$"\xbc\xc8\x80\xb1\x2e\x0b\xe4\x12"
-DATA ·d+57008(SB)/8,$"\x32\x37\x28\xc8\x3d\x8d\x78\x26"
-DATA ·d+57016(SB)/8,$"\x89\xa5\xf8\xab\xc4\x6f\x26\x9f"
-DATA ·d+57024(SB)/8,$"\xa2\xda\x4b\x71\x34\x44\xbb\xc5"
-DATA ·d+57032(SB)/8,$"\x85\x19\x47\x6d\xa1\x94\x96\x00"
-DATA ·d+57040(SB)/8,$"\xfb\x7f\x8d\x76\xd6\x5e\x10\xd6"
-DATA ·d+57048(SB)/8,$"\x2c\x47\x97\x36\x91\x7d\x3b\x20"
-DATA ·d+57056(SB)/8,$"\xd9\x3a\x4d\x80\x57\xfb\xca\x15"
-DATA ·d+57064(SB)/8,$"\x48\x38\xc7\xe3\x5e\xde\x21\xd3"
-DATA ·d+57072(SB)/8,$"\xc5\x5d\x4a\xaa\x83\x7d\x74\x44"
-DATA ·d+57080(SB)/8,$"\x4b\x07\x52\x9b\x69\x27\x25\xd1"
-DATA ·d+57088(SB)/8,$"\x6f\x6f\xf8\x06\xff\x00\xc5\x4f"
-DATA ·d+57096(SB)/8,$"\x02\x68\x5e\x12\x59\x35\xaf\x86"
-DATA ·d+57104(SB)/8,$"\x9a\xdd\x8e\xad\xa5\x7d\x91\xa4"
-DATA ·d+57112(SB)/8,$"\x8d\x72\xb0\xdc\xc4\xdb\x47\xcb"
-DATA ·d+57120(SB)/8,$"\x24\x2c\x15\xb7\x2e\x70\x71\x9e"
-DATA ·d+57128(SB)/8,$"\x79\xc5\x78\x35\xa1\x9a\x7b\x78"
-DATA ·d+57136(SB)/8,$"\x16\x22\xb9\x32\xc9\x2c\x92\xb2"
-DATA ·d+57144(SB)/8,$"\xab\xfe\xe9\x07\xce\x4e\x46\xee"
-DATA ·d+57152(SB)/8,$"\x4f\x6e\xe7\x9a\xf7\x8d\x3b\xc3"
-DATA ·d+57160(SB)/8,$"\x3e\x21\xd7\xa4\x5b\x6f\x0e\xe8"
-DATA ·d+57168(SB)/8,$"\x1a\x94\xbe\x7d\xc0\xb7\xb4\x8e"
-DATA ·d+57176(SB)/8,$"\xce\xe8\x4a\x1a\xe3\x03\x11\xf9"
-DATA ·d+57184(SB)/8,$"\x17\x08\xcb\xec\x7e\x61\x81\xde"
-DATA ·d+57192(SB)/8,$"\xba\x4f\x1a\xfc\x12\x93\xe1\xfd"
-DATA ·d+57200(SB)/8,$"\xf5\xdc\x9e\x29\xd0\x35\xa3\x73"
-DATA ·d+57208(SB)/8,$"\x6e\x83\xed\xb6\x86\xe2\xd2\xcb"
-DATA ·d+57216(SB)/8,$"\xec\x37\x6d\xcb\xc4\xe2\x09\x5b"
-DATA ·d+57224(SB)/8,$"\x04\x0e\x40\x00\x8c\x75\xaf\x9c"
-DATA ·d+57232(SB)/8,$"\xc4\x52\x74\xe6\xb0\xb2\xa9\xcd"
-DATA ·d+57240(SB)/8,$"\x2f\x4d\xfc\xfb\x7e\x36\x3d\xac"
-DATA ·d+57248(SB)/8,$"\x16\x16\x6a\x92\x9f\x46\xfb\xae"
-DATA ·d+57256(SB)/8,$"\xdb\x6f\x7d\x3c\xff\x00\x13\xb0"
-DATA ·d+57264(SB)/8,$"\xf0\x17\x88\xef\x2f\x3c\x23\x6f"
-DATA ·d+57272(SB)/8,$"\xa6\x41\x7b\x7b\x79\x60\xfb\xde"
-DATA ·d+57280(SB)/8,$"\xcb\x41\x37\x73\x5a\x59\x64\xb7"
-DATA ·d+57288(SB)/8,$"\x95\xe6\xdc\xa2\xb0\x44\xf9\x88"
-DATA ·d+57296(SB)/8,$"\x60\xc4\x73\xeb\x5c\x7f\xed\x51"
-DATA ·d+57304(SB)/8,$"\x3f\xc6\x7f\x15\x78\x5f\x42\x8f"
-DATA ·d+57312(SB)/8,$"\xc4\x5a\x57\x87\x21\xb2\xb1\x8a"
-DATA ·d+57320(SB)/8,$"\x58\xf4\xd5\xf0\xd5\xfa\x5e\x44"
-DATA ·d+57328(SB)/8,$"\x0a\xf0\xc2\x64\x59\x0e\xd7\x18"
-DATA ·d+57336(SB)/8,$"\xdd\x97\x40\x5b\xb3\x1e\x95\xe3"
-DATA ·d+57344(SB)/8,$"\x9f\xf0\x90\x5b\x5e\x5b\xb6\x99"
-DATA ·d+57352(SB)/8,$"\xa4\x68\x68\xd6\x89\xc3\x2d\xdd"
-DATA ·d+57360(SB)/8,$"\xf4\xd3\xab\x03\xc9\x0c\xb9\x55"
-DATA ·d+57368(SB)/8,$"\x1c\xf6\xa7\xe8\xde\x21\xb5\xfe"
-DATA ·d+57376(SB)/8,$"\xda\x86\xc7\xc3\x9a\x36\x84\xb7"
-DATA ·d+57384(SB)/8,$"\x48\x14\x7d\x82\xde\xd4\x49\x1c"
-DATA ·d+57392(SB)/8,$"\xc5\x0e\xe0\x1d\x77\x61\xb9\xeb"
-DATA ·d+57400(SB)/8,$"\x90\x78\xaf\x23\x09\xc2\x1e\xcf"
-DATA ·d+57408(SB)/8,$"\x14\xb1\x55\x52\x4d\x6b\x76\xb5"
-DATA ·d+57416(SB)/8,$"\xff\x00\xd2\xb7\xf5\x4c\xf7\x29"
-DATA ·d+57424(SB)/8,$"\xe2\x1e\x1e\x9b\x54\xa7\x77\xdb"
-DATA ·d+57432(SB)/8,$"\x46\x97\x96\x88\xe2\xfe\x1e\xf8"
-DATA ·d+57440(SB)/8,$"\x72\xf2\x39\x15\x0c\x66\xff\x00"
-DATA ·d+57448(SB)/8,$"\x5c\x9b\x26\x0b\x5e\x08\x88\x63"
-DATA ·d+57456(SB)/8,$"\x3c\x81\x9c\xb6\x39\xda\x01\x26"
-DATA ·d+57464(SB)/8,$"\xbd\xe3\x46\xf8\x11\xe2\x0f\x18"
-DATA ·d+57472(SB)/8,$"\x5e\xc3\x79\xe3\x2b\x3b\x8d\x1e"
-DATA ·d+57480(SB)/8,$"\xda\x65\x67\xb9\xbe\xbf\xc5\xa4"
-DATA ·d+57488(SB)/8,$"\x71\xc1\x19\xf9\x9c\x2b\x81\x96"
-DATA ·d+57496(SB)/8,$"\xc0\x6d\x8b\x8e\x4f\x15\xe9\x3e"
-DATA ·d+57504(SB)/8,$"\x1b\xf1\x2f\xc7\xaf\x15\x5d\x9d"
-DATA ·d+57512(SB)/8,$"\x07\xc2\xf6\xf6\x3a\x4c\x91\x48"
-DATA ·d+57520(SB)/8,$"\xe0\x5b\xe9\x30\x5a\xd9\xbc\x45"
-DATA ·d+57528(SB)/8,$"\xb9\x72\xd2\x91\xe6\x24\x6a\x3b"
-DATA ·d+57536(SB)/8,$"\x86\x1d\x3a\x9a\xf3\x0f\x10\x78"
-DATA ·d+57544(SB)/8,$"\x96\xe9\x34\x19\x2e\xfc\x45\xe2"
-DATA ·d+57552(SB)/8,$"\xdb\xcb\xd9\x1e\xee\x4b\x79\xe1"
-DATA ·d+57560(SB)/8,$"\xf0\xfc\x6b\x3b\x31\x85\xb7\x7c"
-DATA ·d+57568(SB)/8,$"\xf7\x53\xa0\x56\x62\x46\x55\x41"
-DATA ·d+57576(SB)/8,$"\x24\xaf\xcc\x0e\x38\xaf\xa5\x79"
-DATA ·d+57584(SB)/8,$"\x95\x2a\x4f\xdf\x92\x6f\xb2\x69"
-DATA ·d+57592(SB)/8,$"\xb3\xc8\xc4\x46\xa5\x5a\x92\x6e"
-DATA ·d+57600(SB)/8,$"\xa7\x2b\x6f\xa5\xff\x00\x0f\x4e"
-DATA ·d+57608(SB)/8,$"\xc7\xb8\xc1\xf0\x9b\xf6\x5d\xd2"
-DATA ·d+57616(SB)/8,$"\x75\xf6\xd1\x65\xd5\xb5\x49\x6c"
-DATA ·d+57624(SB)/8,$"\x8c\x78\xb6\xd4\x2e\x2e\x16\x2c"
-DATA ·d+57632(SB)/8,$"\xb3\xc6\x18\x02\x91\xa6\x54\x02"
-DATA ·d+57640(SB)/8,$"\x0e\x4b\xe0\x72\x39\xe0\xd7\x95"
-DATA ·d+57648(SB)/8,$"\x6a\x3e\x2d\xf0\xf7\xc2\x3b\x98"
-DATA ·d+57656(SB)/8,$"\xb5\x2f\x86\xd7\xb7\xc3\xc9\xbf"
-DATA ·d+57664(SB)/8,$"\x32\x43\x76\x64\xb7\x9e\x58\xda"
-DATA ·d+57672(SB)/8,$"\x1c\x10\xc3\x66\xdc\x05\x6d\xa5"
-DATA ·d+57680(SB)/8,$"\x43\x8d\xd9\xcf\x50\x0d\x70\x1e"
-DATA ·d+57688(SB)/8,$"\x14\xf8\x99\xa7\xf8\x93\x52\x1a"
-DATA ·d+57696(SB)/8,$"\x7a\xd9\x6b\xb7\xa8\xce\x76\xdc"
-DATA ·d+57704(SB)/8,$"\xdd\xc3\x14\xf7\x43\x7f\x0c\xd9"
-DATA ·d+57712(SB)/8,$"\x81\x41\xce\x3a\x1e\x48\xe9\x9c"
-DATA ·d+57720(SB)/8,$"\x57\x5b\xf1\x33\xc3\xba\x87\x81"
-DATA ·d+57728(SB)/8,$"\x25\xb6\x3a\x21\x86\xf1\x2f\xe0"
-DATA ·d+57736(SB)/8,$"\x09\x69\x6d\x7d\x68\xb1\xc8\xa0"
-DATA ·d+57744(SB)/8,$"\xe3\x93\x6c\xec\x77\x48\x0f\x47"
-DATA ·d+57752(SB)/8,$"\x60\x0e\x79\x02\xb8\xeb\xe6\xd4"
-DATA ·d+57760(SB)/8,$"\xe6\xd4\x64\xd5\x9f\x4b\x7e\x7f"
-DATA ·d+57768(SB)/8,$"\xf0\xc7\x2d\x3c\x3b\xa3\x57\xd9"
-DATA ·d+57776(SB)/8,$"\xc9\xbe\x6e\xf7\xdf\xee\xbd\xbf"
-DATA ·d+57784(SB)/8,$"\xaf\x22\xa7\xc4\x5f\x8d\xff\x00"
-DATA ·d+57792(SB)/8,$"\x10\x7e\x2b\xcf\x3f\x8c\x3c\x7f"
-DATA ·d+57800(SB)/8,$"\x0d\xd6\xab\x36\xa3\x2a\x4d\x71"
-DATA ·d+57808(SB)/8,$"\xa9\xdd\xc7\x14\x45\xe6\x51\x81"
-DATA ·d+57816(SB)/8,$"\x2f\xee\x30\x77\x9f\xe2\x6e\x37"
-DATA ·d+57824(SB)/8,$"\x63\x91\x5e\x53\xa9\xdf\xff\x00"
-DATA ·d+57832(SB)/8,$"\x66\xdc\xb5\x9b\xc9\x0c\x92\x19"
-DATA ·d+57840(SB)/8,$"\x09\x45\xd4\x62\x12\x20\xde\x32"
-DATA ·d+57848(SB)/8,$"\x4e\x03\x12\x7a\xf1\xcf\x06\x9b"
-DATA ·d+57856(SB)/8,$"\x1e\xb9\xe2\xf8\xe0\x8e\xc6\xdd"
-DATA ·d+57864(SB)/8,$"\x1a\x34\x99\x42\xca\x12\xdb\xec"
-DATA ·d+57872(SB)/8,$"\xc1\xd8\x7d\xe0\xdf\x2f\xcd\x8c"
-DATA ·d+57880(SB)/8,$"\x72\x7b\xd6\x36\xb3\xe1\xf7\xb5"
-DATA ·d+57888(SB)/8,$"\x58\xee\x99\x65\xb6\x98\x15\x92"
-DATA ·d+57896(SB)/8,$"\x55\xb8\x52\x04\xcf\xc8\xc2\xb6"
-DATA ·d+57904(SB)/8,$"\x7a\x37\xae\x38\xae\xda\x79\x8d"
-DATA ·d+57912(SB)/8,$"\x28\xa8\xaa\x30\xbf\xca\xdf\x75"
-DATA ·d+57920(SB)/8,$"\x8e\xa8\x52\xe6\xa8\xd4\xdd\x95"
-DATA ·d+57928(SB)/8,$"\xfb\xdf\xef\x3e\x90\xf8\x4d\xae"
-DATA ·d+57936(SB)/8,$"\xf8\x6b\x54\xb2\x93\x4a\xf8\xb7"
-DATA ·d+57944(SB)/8,$"\xe2\xa8\xec\x34\xdb\x7b\x39\x8d"
-DATA ·d+57952(SB)/8,$"\x8d\x9c\x5a\x73\x7d\x98\xdd\x81"
-DATA ·d+57960(SB)/8,$"\xfb\xa4\x94\xc6\x49\x65\x3d\xc0"
-DATA ·d+57968(SB)/8,$"\x2b\x91\xd4\xd1\xad\xdc\x7c\x29"
-DATA ·d+57976(SB)/8,$"\xd3\x3e\x15\xcf\x69\x75\x36\x81"
-DATA ·d+57984(SB)/8,$"\xae\xca\xf3\xc6\x64\x5b\x2d\x3e"
-DATA ·d+57992(SB)/8,$"\x68\x6f\xd6\x43\xf7\x54\x4e\xce"
-DATA ·d+58000(SB)/8,$"\x8b\x24\x70\xe7\x1b\x99\x38\xe7"
-DATA ·d+58008(SB)/8,$"\x04\xd7\x93\xf8\x3f\xc5\xbe\x11"
-DATA ·d+58016(SB)/8,$"\xf0\xaf\x8f\xad\xb5\x8f\x1e\x68"
-DATA ·d+58024(SB)/8,$"\x36\xfa\x96\x94\x10\xc4\x34\x3d"
-DATA ·d+58032(SB)/8,$"\x5e\xea\x58\xe3\xc1\xda\xc0\xfd"
-DATA ·d+58040(SB)/8,$"\xa1\x11\x88\x03\x90\x31\xeb\xcd"
-DATA ·d+58048(SB)/8,$"\x6d\xc3\x37\x81\xfe\x21\x6a\xba"
-DATA ·d+58056(SB)/8,$"\xf1\xd1\x57\x4c\xf0\xd4\x3f\x24"
-DATA ·d+58064(SB)/8,$"\xda\x4e\x9d\x2c\xb3\xce\xd0\xb3"
-DATA ·d+58072(SB)/8,$"\x36\x1b\xcb\x79\x4c\x4d\x2c\x3b"
-DATA ·d+58080(SB)/8,$"\x7b\x31\x24\x1e\x47\x15\xe0\xd5"
-DATA ·d+58088(SB)/8,$"\xcc\xe1\x2a\xee\x96\x22\x9c\xd4"
-DATA ·d+58096(SB)/8,$"\x5b\xbf\x4b\x5f\xb7\x47\xa9\xd9"
-DATA ·d+58104(SB)/8,$"\x57\x03\x29\x43\xdb\xda\xdc\xab"
-DATA ·d+58112(SB)/8,$"\x5b\x5d\x75\xec\xaf\xf7\xa3\xc7"
-DATA ·d+58120(SB)/8,$"\xa5\x5b\xdd\x2a\xdd\xe2\xf0\xd5"
-DATA ·d+58128(SB)/8,$"\xe4\xb6\x76\x97\x6d\x0c\xf2\x5b"
-DATA ·d+58136(SB)/8,$"\x5b\xee\xdd\x23\x26\x42\x31\x62"
-DATA ·d+58144(SB)/8,$"\xcc\x32\x32\x46\x73\xd3\xb5\x67"
-DATA ·d+58152(SB)/8,$"\xf8\x8a\x57\xb0\x8e\x39\xf6\xdc"
-DATA ·d+58160(SB)/8,$"\x46\xc1\x57\x75\xc4\x6c\xdb\x58"
-DATA ·d+58168(SB)/8,$"\x0e\xbb\xd9\x70\x03\x1e\xdc\x55"
-DATA ·d+58176(SB)/8,$"\x9d\x56\xe2\xca\xca\x49\x34\xbb"
-DATA ·d+58184(SB)/8,$"\x86\xb1\xbd\x68\x32\x1a\xe3\x4f"
-DATA ·d+58192(SB)/8,$"\x2d\x1a\x01\x8e\x4b\xef\xe0\x92"
-DATA ·d+58200(SB)/8,$"\x7b\x0e\x4e\x33\xef\x5c\x9b\x5f"
-DATA ·d+58208(SB)/8,$"\xdc\xdd\xe9\x50\x58\xdd\x62\x78"
-DATA ·d+58216(SB)/8,$"\xa5\x19\xff\x00\x49\x56\x3b\x42"
-DATA ·d+58224(SB)/8,$"\x9f\x94\xab\x2f\x3c\x01\xf7\x8f"
-DATA ·d+58232(SB)/8,$"\xe7\x5e\xf4\xa5\x4d\x47\x97\x0f"
-DATA ·d+58240(SB)/8,$"\x05\xa9\xd5\x46\x15\x9c\xa3\x52"
-DATA ·d+58248(SB)/8,$"\x72\x7f\xe6\xad\xf8\xfc\xcb\x30"
-DATA ·d+58256(SB)/8,$"\x78\x9f\x48\xd5\x81\x82\xfe\x3d"
-DATA ·d+58264(SB)/8,$"\x4f\x0c\xc5\xb7\x46\xb1\xcf\xd3"
-DATA ·d+58272(SB)/8,$"\xa0\x1e\x60\xcf\x1e\xb9\xcd\x5f"
-DATA ·d+58280(SB)/8,$"\xd3\xf4\xaf\x0d\xfd\xa5\x2e\x67"
-DATA ·d+58288(SB)/8,$"\xb5\xf9\xa4\xf9\xbf\x7c\xa6\x1e"
-DATA ·d+58296(SB)/8,$"\x3d\x7e\x42\x41\xcf\xd3\xf2\xac"
-DATA ·d+58304(SB)/8,$"\x5d\x37\xc1\x09\x20\x5b\xe9\x6f"
-DATA ·d+58312(SB)/8,$"\x04\x6a\x63\x69\x17\x7e\x49\xeb"
-DATA ·d+58320(SB)/8,$"\xc0\x04\x9c\x02\xde\xa4\x71\xde"
-DATA ·d+58328(SB)/8,$"\xbd\xb7\xc0\x96\xff\x00\x0c\xc5"
-DATA ·d+58336(SB)/8,$"\xb1\x6d\x66\xd6\x77\x96\xde\x6c"
-DATA ·d+58344(SB)/8,$"\xc9\x79\x0e\xac\xb0\xa0\x03\xa8"
-DATA ·d+58352(SB)/8,$"\x44\x28\x4b\x1e\x72\x48\xe3\x1d"
-DATA ·d+58360(SB)/8,$"\x2b\x4c\x35\x29\xc9\x7e\xf6\x49"
-DATA ·d+58368(SB)/8,$"\x2f\x5f\xf8\x72\xb1\xf8\xdc\x3d"
-DATA ·d+58376(SB)/8,$"\x38\x7f\xb3\xb6\xed\xbd\xbf\xa4"
-DATA ·d+58384(SB)/8,$"\x63\x69\xf6\x3e\x1b\xb4\xbb\x78"
-DATA ·d+58392(SB)/8,$"\x5b\x4f\x77\xd8\xbb\xb7\xc7\x2e"
-DATA ·d+58400(SB)/8,$"\xc5\x64\x23\x3c\x6f\x4c\x82\x33"
-DATA ·d+58408(SB)/8,$"\xd0\xe3\x3d\xab\x8b\xf1\x5e\x92"
-DATA ·d+58416(SB)/8,$"\xf6\xda\xdd\xbd\x8e\xe9\xa3\xd3"
-DATA ·d+58424(SB)/8,$"\x66\x50\xf6\xe1\x0e\xcd\xaa\x79"
-DATA ·d+58432(SB)/8,$"\x21\xcf\x76\x07\x83\x9e\x6b\xea"
-DATA ·d+58440(SB)/8,$"\x7d\x13\x4b\xf8\x61\x34\x6d\x3e"
-DATA ·d+58448(SB)/8,$"\x91\x3e\x9d\x76\xb2\xb1\x76\x85"
-DATA ·d+58456(SB)/8,$"\xe5\x6b\x97\x38\x3f\x78\x16\x03"
-DATA ·d+58464(SB)/8,$"\x20\x7a\x8c\xf3\x5e\xb1\x61\xe2"
-DATA ·d+58472(SB)/8,$"\x1f\x87\xd7\x1a\x2a\xf8\x53\x5f"
-DATA ·d+58480(SB)/8,$"\xb1\xb6\xbb\xd1\x6f\x6f\x21\x9e"
-DATA ·d+58488(SB)/8,$"\xf8\xdc\xa0\x89\xa1\x64\x20\x19"
-DATA ·d+58496(SB)/8,$"\xe0\x96\x23\xbd\x0e\xd2\x54\x80"
-DATA ·d+58504(SB)/8,$"\x7f\x0a\xfa\xa8\x2a\x74\xb0\xe9"
-DATA ·d+58512(SB)/8,$"\xc6\x57\x92\xed\xfd\x23\xe0\x68"
-DATA ·d+58520(SB)/8,$"\xf1\x54\x96\x26\xde\xcd\xdb\xad"
-DATA ·d+58528(SB)/8,$"\xef\x75\xe9\x7b\xeb\x73\xe0\xd8"
-DATA ·d+58536(SB)/8,$"\xae\x23\xd3\x70\xda\x23\x2c\x0f"
-DATA ·d+58544(SB)/8,$"\x6f\x22\xa1\x91\xc1\x9a\x4c\xb6"
-DATA ·d+58552(SB)/8,$"\x36\xb2\xb6\xe5\xdb\x8e\xbb\x87"
-DATA ·d+58560(SB)/8,$"\x4f\x6a\xd0\xd5\xfc\x63\x17\x84"
-DATA ·d+58568(SB)/8,$"\x56\x39\xf5\x68\xee\xae\x90\xcc"
-DATA ·d+58576(SB)/8,$"\x5b\x11\x5b\xab\x43\x2b\x82\x77"
-DATA ·d+58584(SB)/8,$"\xb1\x90\x86\x04\x93\x82\x48\xe4"
-DATA ·d+58592(SB)/8,$"\xf5\x35\xd7\x7c\x4f\xf8\x5d\x7b"
-DATA ·d+58600(SB)/8,$"\xe0\xbd\x68\xeb\x5e\x0a\x29\xa9"
-DATA ·d+58608(SB)/8,$"\x78\x6e\xee\xe2\x51\xa5\xdf\x41"
-DATA ·d+58616(SB)/8,$"\x3a\xcf\x24\x6a\x1f\x0b\x14\xe0"
-DATA ·d+58624(SB)/8,$"\x05\x65\x6c\x75\xca\x85\x23\x90"
-DATA ·d+58632(SB)/8,$"\x7a\xd7\x2f\xa3\xe9\x7a\xd5\xd6"
-DATA ·d+58640(SB)/8,$"\x85\x79\xa3\xdc\xc0\x1f\x4b\x2a"
-DATA ·d+58648(SB)/8,$"\x2f\x6e\x5f\xcf\x40\xd6\x8e\x17"
-DATA ·d+58656(SB)/8,$"\x61\x74\x04\xfc\xd9\x07\x1b\x7a"
-DATA ·d+58664(SB)/8,$"\xb7\x4e\xa2\xbe\x77\x31\xc6\xd5"
-DATA ·d+58672(SB)/8,$"\xbf\x37\x35\xff\x00\x0f\xe9\x9f"
-DATA ·d+58680(SB)/8,$"\x63\x84\xa9\x46\x51\x85\x47\x66"
-DATA ·d+58688(SB)/8,$"\x9e\xba\xe8\xfe\x6e\xd7\xbf\x97"
-DATA ·d+58696(SB)/8,$"\xfc\x39\xce\x43\xf1\x7b\x40\xb0"
-DATA ·d+58704(SB)/8,$"\xd5\x22\xd6\xb4\x7d\x26\xf2\x09"
-DATA ·d+58712(SB)/8,$"\xa3\xcc\xa6\xe3\x65\xb4\x91\x79"
-DATA ·d+58720(SB)/8,$"\x83\x38\x22\x36\x40\xa3\xd3\x9c"
-DATA ·d+58728(SB)/8,$"\xd5\xb8\x7e\x2a\x78\xe7\x51\x05"
-DATA ·d+58736(SB)/8,$"\xfe\xcd\x7f\x32\xcf\x90\x07\x9c"
-DATA ·d+58744(SB)/8,$"\xb1\x16\xdd\xc9\xe2\x35\x1e\xbd"
-DATA ·d+58752(SB)/8,$"\x07\x6a\xd7\xf0\x3f\x84\x3e\x17"
-DATA ·d+58760(SB)/8,$"\x69\x82\x7b\x4d\x5a\xfc\xde\x49"
-DATA ·d+58768(SB)/8,$"\x80\x91\x4e\x1f\xfd\x0c\x21\x3c"
-DATA ·d+58776(SB)/8,$"\xb1\x84\x01\x23\xb0\x1f\xc2\x48"
-DATA ·d+58784(SB)/8,$"\x19\xaf\x43\xbd\xd7\xfc\x5b\xe2"
-DATA ·d+58792(SB)/8,$"\x8f\x10\x09\x7c\x35\x2a\x26\x96"
-DATA ·d+58800(SB)/8,$"\x92\xc5\xa7\x5b\x3d\x85\xbd\xbd"
-DATA ·d+58808(SB)/8,$"\xb2\x88\xa2\x1b\x47\x97\x1a\x04"
-DATA ·d+58816(SB)/8,$"\x3f\x77\x2d\xcb\x6e\x3d\xcd\x79"
-DATA ·d+58824(SB)/8,$"\x49\xc9\xa7\x27\x27\xa6\xf7\xbb"
-DATA ·d+58832(SB)/8,$"\x3b\x33\x0c\x76\x15\xd4\xe4\xa5"
-DATA ·d+58840(SB)/8,$"\x43\x6e\xae\xe9\x7c\xbc\xcf\x3d"
-DATA ·d+58848(SB)/8,$"\xd3\xfc\x70\xe2\xcd\xed\x35\x94"
-DATA ·d+58856(SB)/8,$"\x8a\xd6\xdd\x8f\x36\x90\x81\x2c"
-DATA ·d+58864(SB)/8,$"\x92\x48\x99\xda\x58\x94\x56\xc6"
-DATA ·d+58872(SB)/8,$"\x4e\x0e\x1c\x7e\x35\xcf\x5d\xea"
-DATA ·d+58880(SB)/8,$"\x1f\x16\xbc\x6f\x65\x06\x99\xa1"
-DATA ·d+58888(SB)/8,$"\xe9\x5a\x8a\xe9\xd6\xad\x23\x20"
-DATA ·d+58896(SB)/8,$"\xb1\xb5\x73\x92\x4e\x5c\x99\xd9"
-DATA ·d+58904(SB)/8,$"\x4b\x37\x6e\x37\x1c\x0e\x2b\xf6"
-DATA ·d+58912(SB)/8,$"\xd3\xc2\x7e\x0a\xf8\xcd\xf0\xf7"
-DATA ·d+58920(SB)/8,$"\xe1\x8b\xb6\x81\xe0\x4f\x86\xb6"
-DATA ·d+58928(SB)/8,$"\xb6\x88\x82\xd6\x6f\x11\xdf\x28"
-DATA ·d+58936(SB)/8,$"\x17\xfa\x9d\xbb\x47\xb8\xbb\x79"
-DATA ·d+58944(SB)/8,$"\x92\xf9\x45\xdb\x76\xe7\x61\x21"
-DATA ·d+58952(SB)/8,$"\x23\xda\xbe\x0c\xf8\xa8\x35\x8f"
-DATA ·d+58960(SB)/8,$"\x0c\x5d\xc4\xd6\xbe\x31\xd2\x2d"
-DATA ·d+58968(SB)/8,$"\x2d\x75\x38\xe3\xbc\xbd\xb3\xf0"
-DATA ·d+58976(SB)/8,$"\x83\x2e\xa3\x2d\x9c\xf1\x82\x9b"
-DATA ·d+58984(SB)/8,$"\x66\x54\x0b\xe5\x8c\xf3\xb5\x58"
-DATA ·d+58992(SB)/8,$"\xb3\x64\x31\x24\x57\x91\x94\x67"
-DATA ·d+59000(SB)/8,$"\x50\xc4\x4a\x7f\x56\x6a\x4d\x6d"
-DATA ·d+59008(SB)/8,$"\x6b\x3d\x3e\x5b\x7f\x5b\x1c\x90"
-DATA ·d+59016(SB)/8,$"\xc6\x3a\x55\x14\x65\x87\xb2\x6b"
-DATA ·d+59024(SB)/8,$"\x76\x9d\xaf\xd1\x5a\xdf\x73\x3e"
-DATA ·d+59032(SB)/8,$"\x39\xf0\x75\x8e\xb0\x75\x3b\x8f"
-DATA ·d+59040(SB)/8,$"\x0c\xdf\x45\x15\xae\xa9\x3a\x85"
-DATA ·d+59048(SB)/8,$"\x5f\xed\x89\x3e\xc4\x54\x92\x3e"
-DATA ·d+59056(SB)/8,$"\x45\x69\x08\xf9\x9f\xa7\x3e\xb5"
-DATA ·d+59064(SB)/8,$"\xed\xb6\xbf\x13\x1f\xe1\xa5\xc9"
-DATA ·d+59072(SB)/8,$"\xf0\xc5\xae\xb5\xa7\xe9\x96\xeb"
-DATA ·d+59080(SB)/8,$"\x3e\xcb\x8b\xdd\x3a\xc1\xe5\xb9"
-DATA ·d+59088(SB)/8,$"\x6f\xe0\x90\x6c\x52\xcc\x51\x70"
-DATA ·d+59096(SB)/8,$"\x78\x2e\x09\x3c\xf7\xe3\x96\xb6"
-DATA ·d+59104(SB)/8,$"\xf8\xab\xe0\x9d\x03\x5c\x6d\x63"
-DATA ·d+59112(SB)/8,$"\x5b\xd1\xae\x3c\x7f\x71\x86\x43"
-DATA ·d+59120(SB)/8,$"\xff\x00\x09\x31\x64\xb7\x6c\x8d"
-DATA ·d+59128(SB)/8,$"\xa1\x8a\x21\x69\x32\xa3\xa0\x63"
-DATA ·d+59136(SB)/8,$"\x5e\x7b\xaf\xf8\xb2\x2d\x4a\xf6"
-DATA ·d+59144(SB)/8,$"\x1b\xcb\x2d\x16\xdb\x48\x22\x41"
-DATA ·d+59152(SB)/8,$"\x22\xec\x0e\xd1\xc6\xac\xb8\x29"
-DATA ·d+59160(SB)/8,$"\x1a\x4d\xc0\x52\x70\x7d\xba\x0e"
-DATA ·d+59168(SB)/8,$"\x2b\xd4\xc5\xe0\x31\x95\xb9\xbe"
-DATA ·d+59176(SB)/8,$"\x24\xbc\xf4\xd7\xd6\xff\x00\x9a"
-DATA ·d+59184(SB)/8,$"\x3d\x3f\xab\xc6\xa3\x4e\xad\x34"
-DATA ·d+59192(SB)/8,$"\xaf\xbe\xab\xfc\x9b\x3e\x8a\xb5"
-DATA ·d+59200(SB)/8,$"\xf8\x87\xfb\x39\xad\xac\xed\x35"
-DATA ·d+59208(SB)/8,$"\xe6\xa7\xe2\x0d\x45\xf1\x35\xae"
-DATA ·d+59216(SB)/8,$"\xed\x10\xdb\x97\x2b\xc0\x89\xde"
-DATA ·d+59224(SB)/8,$"\x69\x0a\xaa\x39\x3c\x9e\xa3\xb1"
-DATA ·d+59232(SB)/8,$"\x06\xb1\xa7\xf0\xe5\xc7\x89\xec"
-DATA ·d+59240(SB)/8,$"\xb5\x5d\x4e\x6f\x0b\xd8\xe8\x36"
-DATA ·d+59248(SB)/8,$"\xf7\x8b\x09\x82\xf6\xe5\xbe\xc7"
-DATA ·d+59256(SB)/8,$"\x15\xb1\x5c\x00\xb6\xf1\xc8\x1c"
-DATA ·d+59264(SB)/8,$"\xca\x5d\x7b\x28\x24\xf5\xa6\xe8"
-DATA ·d+59272(SB)/8,$"\x5f\x1c\x6f\xf4\x8f\x1f\x78\x73"
-DATA ·d+59280(SB)/8,$"\xe2\xd4\x1e\x19\xf0\xdd\xcd\xef"
-DATA ·d+59288(SB)/8,$"\x87\x99\x22\x86\x46\xb7\x10\x58"
-DATA ·d+59296(SB)/8,$"\xdf\x66\x3d\xae\xb7\xb0\x12\xc9"
-DATA ·d+59304(SB)/8,$"\x29\x6c\x9f\x9f\xe5\x21\xb0\x46"
-DATA ·d+59312(SB)/8,$"\x70\x05\x75\xbf\x1c\xbf\x69\xcf"
-DATA ·d+59320(SB)/8,$"\x89\x7f\x10\xae\xe7\xbe\xf1\x0e"
-DATA ·d+59328(SB)/8,$"\x8f\xe1\x7b\x74\xb9\x8c\x4d\x0b"
-DATA ·d+59336(SB)/8,$"\xda\xa7\xda\x5a\x30\x46\xc5\x3e"
-DATA ·d+59344(SB)/8,$"\x64\x65\x8c\x6d\xc6\x0e\xdd\xa4"
-DATA ·d+59352(SB)/8,$"\xf4\xe2\xbe\x56\x54\xf1\xd4\xab"
-DATA ·d+59360(SB)/8,$"\x42\x0a\x93\x77\xd5\xb7\x38\xab"
-DATA ·d+59368(SB)/8,$"\x6d\xd1\x6e\x72\xc7\x26\x5b\x50"
-DATA ·d+59376(SB)/8,$"\x93\x57\xee\xe5\x2b\xbd\xf4\xbd"
-DATA ·d+59384(SB)/8,$"\x95\xb6\xe9\xf2\xd3\x5f\x98\xa3"
-DATA ·d+59392(SB)/8,$"\xf0\xad\xe6\x9f\x3e\xcb\x59\xec"
-DATA ·d+59400(SB)/8,$"\x5f\x69\xca\xf9\x84\x46\x5c\x83"
-DATA ·d+59408(SB)/8,$"\x8c\x00\x40\x20\xfb\x60\x57\x4a"
-DATA ·d+59416(SB)/8,$"\x6e\x35\xd6\x31\xe9\xd7\x56\x12"
-DATA ·d+59424(SB)/8,$"\x2a\xae\x70\x61\x56\x4e\xb8\xce"
-DATA ·d+59432(SB)/8,$"\xd6\xf9\x97\x9c\x73\x8c\x67\xbd"
-DATA ·d+59440(SB)/8,$"\x77\x7e\x0a\xbe\xd0\xfe\x20\x6a"
-DATA ·d+59448(SB)/8,$"\xf6\xe7\xc6\x3a\xee\x8f\xa2\xde"
-DATA ·d+59456(SB)/8,$"\x39\x8a\x2f\xb7\xde\xc5\x37\x91"
-DATA ·d+59464(SB)/8,$"\x18\x3c\x64\xb0\x40\xb0\xaa\xfb"
-DATA ·d+59472(SB)/8,$"\x29\x27\xd7\xd7\xe9\x98\xe2\xfd"
-DATA ·d+59480(SB)/8,$"\x9d\x34\x96\x9b\x43\xd7\xbc\x4f"
-DATA ·d+59488(SB)/8,$"\x7d\xae\x5e\x26\x50\x5e\xd9\x86"
-DATA ·d+59496(SB)/8,$"\xb5\xd3\x19\x17\xfe\x78\x4f\x2a"
-DATA ·d+59504(SB)/8,$"\xb4\x84\x9f\xef\x38\x0b\xe8\x3d"
-DATA ·d+59512(SB)/8,$"\x7e\xbb\x09\x85\xa3\x3e\x69\x54"
-DATA ·d+59520(SB)/8,$"\xa9\x6b\x6f\xdf\xee\xea\x7c\xf6"
-DATA ·d+59528(SB)/8,$"\x71\x5f\x13\x4a\xa5\x3a\x75\x69"
-DATA ·d+59536(SB)/8,$"\x5e\x4f\xae\xb6\xfb\xff\x00\xce"
-DATA ·d+59544(SB)/8,$"\xc7\xcb\xde\x1a\xf1\xc5\xf7\x84"
-DATA ·d+59552(SB)/8,$"\x0c\xaf\x6e\xba\x7c\x72\x42\xd0"
-DATA ·d+59560(SB)/8,$"\x5e\x5a\x1d\x5e\xda\x59\x8b\xbc"
-DATA ·d+59568(SB)/8,$"\x64\xe4\x20\x19\x8c\x76\x0c\x18"
-DATA ·d+59576(SB)/8,$"\x61\xab\x03\x56\xf8\xdd\x1e\xa7"
-DATA ·d+59584(SB)/8,$"\xac\x4b\xae\xeb\x7a\x4e\x9d\x72"
-DATA ·d+59592(SB)/8,$"\xf2\xbb\x4a\xf1\xdb\x07\x82\x07"
-DATA ·d+59600(SB)/8,$"\xde\xc4\xb6\x6d\xf0\xc3\x69\x27"
-DATA ·d+59608(SB)/8,$"\xee\x81\x81\xdb\x15\xef\x5e\x3f"
-DATA ·d+59616(SB)/8,$"\xf8\x7f\xf0\x93\xc3\xb7\x31\xdd"
-DATA ·d+59624(SB)/8,$"\xe8\xde\x22\xba\xba\x56\x58\xee"
-DATA ·d+59632(SB)/8,$"\x2d\xec\x63\x87\xed\x72\x79\x72"
-DATA ·d+59640(SB)/8,$"\x8f\x98\x35\xc4\x72\x35\xb6\xe5"
-DATA ·d+59648(SB)/8,$"\xf4\xc0\x3e\xc0\xd6\x97\x80\x66"
-DATA ·d+59656(SB)/8,$"\xf0\xbc\xa8\x74\xbf\x11\x47\x05"
-DATA ·d+59664(SB)/8,$"\xf6\x91\xe6\xab\x4c\xfa\x95\x84"
-DATA ·d+59672(SB)/8,$"\x52\xc9\x0c\x7b\xbe\x67\x40\x99"
-DATA ·d+59680(SB)/8,$"\x91\x5f\x61\xe0\x06\xc6\x47\xbd"
-DATA ·d+59688(SB)/8,$"\x5b\xc2\xe0\xe4\x9b\xa1\x5b\x99"
-DATA ·d+59696(SB)/8,$"\xdf\xad\xe2\xff\x00\x13\xcf\x95"
-DATA ·d+59704(SB)/8,$"\x2c\x3d\x19\x7b\x6a\xf8\x7b\xb7"
-DATA ·d+59712(SB)/8,$"\xd5\x3f\xf2\xbf\xe6\x7c\x4b\xae"
-DATA ·d+59720(SB)/8,$"\xeb\xc3\xc4\xfa\xb3\x5e\xe8\x5a"
-DATA ·d+59728(SB)/8,$"\x65\xad\x8c\x7d\x0d\xa5\x9d\xd4"
-DATA ·d+59736(SB)/8,$"\x89\x19\xc0\xc7\xcb\xe6\xb7\xcb"
-DATA ·d+59744(SB)/8,$"\xee\x38\x1e\xd5\x1a\xeb\xde\x1c"
-DATA ·d+59752(SB)/8,$"\x8d\x44\x73\xe9\xba\xa6\xf5\x18"
-DATA ·d+59760(SB)/8,$"\x7d\xb7\xe3\x1b\x87\x5c\x70\x78"
-DATA ·d+59768(SB)/8,$"\xcf\xbd\x7e\xb1\xf8\xc7\xe0\x27"
-DATA ·d+59776(SB)/8,$"\xec\x35\xaa\xf8\xe2\x48\x3c\x35"
-DATA ·d+59784(SB)/8,$"\xe2\x1d\x1f\x4f\xf0\xd4\xb6\xf1"
-DATA ·d+59792(SB)/8,$"\x0b\x5f\x11\xdf\x6a\x33\x25\xeb"
-DATA ·d+59800(SB)/8,$"\x3c\x88\xbb\x99\xec\x5c\x15\x89"
-DATA ·d+59808(SB)/8,$"\x95\x89\xca\x38\x00\x63\x07\x8a"
-DATA ·d+59816(SB)/8,$"\xf9\x37\x53\xf8\x03\xe0\xc8\x35"
-DATA ·d+59824(SB)/8,$"\x2b\x88\x34\x9d\x7f\xc1\x57\x16"
-DATA ·d+59832(SB)/8,$"\x89\x3c\x89\x6b\x70\xda\xd5\xb0"
-DATA ·d+59840(SB)/8,$"\x32\xc4\x18\x84\x72\x07\x00\xb2"
-DATA ·d+59848(SB)/8,$"\xe0\xf1\x5c\xb2\x51\x4f\x96\x55"
-DATA ·d+59856(SB)/8,$"\x24\xad\xeb\xd7\xfa\xd5\x74\x3e"
-DATA ·d+59864(SB)/8,$"\x92\x8e\x6d\x42\x54\xe3\x6a\x52"
-DATA ·d+59872(SB)/8,$"\xb2\xd3\x4b\xbd\xbb\xb4\xf5\xf5"
-DATA ·d+59880(SB)/8,$"\x7b\x9f\xff\xd2\xfc\x35\xf8\x61"
-DATA ·d+59888(SB)/8,$"\xa4\x68\xb0\x6a\x37\xd7\xc3\x4e"
-DATA ·d+59896(SB)/8,$"\xd0\x2c\x7f\xb1\x6d\x85\xdc\x7a"
-DATA ·d+59904(SB)/8,$"\x6e\xa1\x76\xdf\x6d\xb9\x64\xfd"
-DATA ·d+59912(SB)/8,$"\xe1\x36\x31\x32\xb1\xb8\x7f\xe2"
-DATA ·d+59920(SB)/8,$"\x28\x36\xe0\x2b\x7c\xdc\x62\xbe"
-DATA ·d+59928(SB)/8,$"\x83\xf8\x9d\xa5\x58\x6b\x7a\x73"
-DATA ·d+59936(SB)/8,$"\x78\xc7\xc6\xda\xd5\x9d\xcd\x8b"
-DATA ·d+59944(SB)/8,$"\x58\x42\x74\x7b\x0b\x29\x5e\xc2"
-DATA ·d+59952(SB)/8,$"\xda\x4b\xb9\x10\x48\x12\xe1\x65"
-DATA ·d+59960(SB)/8,$"\xdf\x31\x88\x20\x20\xca\xa0\x02"
-DATA ·d+59968(SB)/8,$"\xdd\x88\x35\xea\x7f\x02\xbc\x61"
-DATA ·d+59976(SB)/8,$"\xf0\x61\x7e\x28\x78\x5f\x4a\xd3"
-DATA ·d+59984(SB)/8,$"\xbc\x31\xad\x49\x79\xaa\xd9\xea"
-DATA ·d+59992(SB)/8,$"\x5a\x2c\xd6\xbb\x52\x56\xbd\x92"
-DATA ·d+60000(SB)/8,$"\xe6\x0c\xdb\xb2\xad\xca\x48\xe8"
-DATA ·d+60008(SB)/8,$"\xaa\xc1\xfc\xcf\x29\x43\x95\x24"
-DATA ·d+60016(SB)/8,$"\xe7\xe5\xaf\xcb\xaf\x1b\x4b\x69"
-DATA ·d+60024(SB)/8,$"\xa7\xbd\xf5\xbe\xba\x26\xba\x96"
-DATA ·d+60032(SB)/8,$"\x09\xa4\xb7\x8f\xca\x94\x2a\xa9"
-DATA ·d+60040(SB)/8,$"\x85\x8a\xb7\x0c\x09\xd8\xac\x08"
-DATA ·d+60048(SB)/8,$"\x50\x31\xc7\xa5\x7d\x12\xc3\xd2"
-DATA ·d+60056(SB)/8,$"\x95\x67\x27\xa3\x85\xb4\xb5\xf7"
-DATA ·d+60064(SB)/8,$"\xeb\x1d\x7a\x34\xf5\xbe\xc7\xf9"
-DATA ·d+60072(SB)/8,$"\xe3\x4d\x55\xad\x28\x38\xb6\x94"
-DATA ·d+60080(SB)/8,$"\x96\xb6\xeb\x66\xd3\x5e\x8f\x4d"
-DATA ·d+60088(SB)/8,$"\x37\xf3\x3d\x73\xc5\x5e\x2c\xd1"
-DATA ·d+60096(SB)/8,$"\xfe\x26\x41\x2f\x85\xfe\x1f\x78"
-DATA ·d+60104(SB)/8,$"\x57\x40\x87\x4a\xb6\x6d\xcb\x02"
-DATA ·d+60112(SB)/8,$"\x20\x33\x4f\x90\xb9\x71\x3c\x98"
-DATA ·d+60120(SB)/8,$"\x93\x20\xa8\x00\x2f\x03\x93\xde"
-DATA ·d+60128(SB)/8,$"\xbc\x67\x5c\xd3\x5f\x4d\x81\x2d"
-DATA ·d+60136(SB)/8,$"\xaf\xb4\x7f\xec\xed\xac\x40\xfb"
-DATA ·d+60144(SB)/8,$"\x22\x7d\x9d\x99\x54\x7f\x13\x23"
-DATA ·d+60152(SB)/8,$"\xf2\x7f\xfd\x75\xc2\xc5\x70\x1a"
-DATA ·d+60160(SB)/8,$"\xd6\xdf\x54\xd0\xfe\xc2\xac\xa4"
-DATA ·d+60168(SB)/8,$"\x47\x25\xac\xec\x15\x99\x08\xc1"
-DATA ·d+60176(SB)/8,$"\x62\xdc\x36\xe2\x78\xe0\xd7\x69"
-DATA ·d+60184(SB)/8,$"\xe2\x43\xa1\x4b\xe1\xcd\x3e\x68"
-DATA ·d+60192(SB)/8,$"\xb5\x40\xb7\xc6\x24\x92\xe2\xc9"
-DATA ·d+60200(SB)/8,$"\x9d\xa5\x8a\x19\x88\x21\xa3\x57"
-DATA ·d+60208(SB)/8,$"\x27\x9d\xbc\x61\xb2\x33\x9e\x83"
-DATA ·d+60216(SB)/8,$"\x15\xf5\x8b\x37\xc1\xd9\x52\xa9"
-DATA ·d+60224(SB)/8,$"\x4d\xdf\xbe\xe7\xa1\x1c\xa3\x11"
-DATA ·d+60232(SB)/8,$"\x42\x49\x53\x95\xa2\xde\xda\xdd"
-DATA ·d+60240(SB)/8,$"\x37\xf3\xfd\x0f\x46\xf0\xfd\xa5"
-DATA ·d+60248(SB)/8,$"\xf2\x68\xf6\xbe\x2d\xb8\x82\xd2"
-DATA ·d+60256(SB)/8,$"\xe4\x5d\x16\x48\x77\x48\x1e\xee"
-DATA ·d+60264(SB)/8,$"\x73\x07\x06\x45\x82\x37\x0e\x19"
-DATA ·d+60272(SB)/8,$"\x71\x82\x48\x01\x80\xcf\xbd\x76"
-DATA ·d+60280(SB)/8,$"\x56\x9a\x7c\x7a\xa5\xcb\x4b\xe2"
-DATA ·d+60288(SB)/8,$"\x9b\xa7\xb3\x7b\x81\xe6\x90\xdb"
-DATA ·d+60296(SB)/8,$"\x6d\xe2\x24\x2e\x70\xc1\x0b\x31"
-DATA ·d+60304(SB)/8,$"\x63\xc0\x1b\xba\x9f\xd3\xc2\xfc"
-DATA ·d+60312(SB)/8,$"\x35\x26\xb3\x63\x68\xb6\x5a\x3d"
-DATA ·d+60320(SB)/8,$"\x94\x4a\xc8\xc2\x43\xa8\xa4\x4c"
-DATA ·d+60328(SB)/8,$"\x6e\x4e\x1b\x3b\x44\x8a\xa4\xed"
-DATA ·d+60336(SB)/8,$"\x39\xc1\x52\x40\xc7\xaf\x4a\xbd"
-DATA ·d+60344(SB)/8,$"\xa0\x69\x5a\xc4\x3a\x8c\x89\x79"
-DATA ·d+60352(SB)/8,$"\x75\xa5\xac\xaf\x3f\xda\xd5\xb5"
-DATA ·d+60360(SB)/8,$"\x07\x28\xe9\x26\x41\x07\xa2\x96"
-DATA ·d+60368(SB)/8,$"\x03\xfb\xb8\xaf\x17\x19\x8d\x84"
-DATA ·d+60376(SB)/8,$"\x64\xf9\x20\xad\xa5\xaf\xfe\x47"
-DATA ·d+60384(SB)/8,$"\x9d\x5b\x2d\xe6\xe6\x9c\x64\xd3"
-DATA ·d+60392(SB)/8,$"\xd6\xfd\xdf\xdd\xaa\x3d\x42\xd7"
-DATA ·d+60400(SB)/8,$"\x52\xf0\xdc\x97\xcf\x65\xe4\x5b"
-DATA ·d+60408(SB)/8,$"\xa4\x70\x05\x89\xae\x35\x19\xde"
-DATA ·d+60416(SB)/8,$"\x35\xde\x73\xcc\x60\x6e\x3b\x50"
-DATA ·d+60424(SB)/8,$"\xf5\x39\x1c\xf4\xaf\x48\xf8\x57"
-DATA ·d+60432(SB)/8,$"\x75\xe2\x0d\x4f\xc5\x9a\x4f\x83"
-DATA ·d+60440(SB)/8,$"\xec\xb5\x47\xd4\xed\xc5\xf0\x93"
-DATA ·d+60448(SB)/8,$"\x16\x11\x3d\xdd\xbc\x8a\xc7\x7c"
-DATA ·d+60456(SB)/8,$"\x8a\x91\x15\x24\xe4\x0c\x37\x03"
-DATA ·d+60464(SB)/8,$"\x23\x27\x8a\xf0\x7f\x16\x26\x97"
-DATA ·d+60472(SB)/8,$"\x6b\xbe\xfb\x58\x98\xde\xc5\x2b"
-DATA ·d+60480(SB)/8,$"\x6e\x95\x2c\xc0\x8a\x09\x24\xce"
-DATA ·d+60488(SB)/8,$"\xec\x92\x59\x9b\x00\xf7\x04\x7d"
-DATA ·d+60496(SB)/8,$"\x2b\x5f\xc2\x9f\x15\x3c\x79\x67"
-DATA ·d+60504(SB)/8,$"\x75\x61\x27\x80\xee\x25\xd1\xc5"
-DATA ·d+60512(SB)/8,$"\x83\xb3\xd9\x4b\x6a\x44\x42\x27"
-DATA ·d+60520(SB)/8,$"\x20\xe5\x8e\x72\x5b\x20\x90\x77"
-DATA ·d+60528(SB)/8,$"\x70\x47\x04\x62\xb6\xab\x1a\xd8"
-DATA ·d+60536(SB)/8,$"\xea\x52\x8d\x34\xb5\x56\xe8\xac"
-DATA ·d+60544(SB)/8,$"\xda\xe8\x70\x61\xf0\x51\xa2\xe3"
-DATA ·d+60552(SB)/8,$"\x52\x4b\x44\xd5\xfe\x5d\xd3\xfc"
-DATA ·d+60560(SB)/8,$"\xb4\x3e\xa4\xf8\xb1\xe3\x6f\x8b"
-DATA ·d+60568(SB)/8,$"\xde\x02\xf8\x97\xaa\x6b\xff\x00"
-DATA ·d+60576(SB)/8,$"\x0f\x1a\x4b\x6f\x0a\xdd\x09\x20"
-DATA ·d+60584(SB)/8,$"\xb0\x36\xeb\x1c\x96\xe2\x14\x50"
-DATA ·d+60592(SB)/8,$"\xb7\x11\x47\x14\x9b\xda\x11\xf3"
-DATA ·d+60600(SB)/8,$"\x15\x71\x85\x3c\xe3\x3c\x57\x03"
-DATA ·d+60608(SB)/8,$"\xaa\xf8\x33\x56\xd6\x7c\x3b\x69"
-DATA ·d+60616(SB)/8,$"\xf1\x1d\xb5\xad\x3a\x77\xbe\x13"
-DATA ·d+60624(SB)/8,$"\xcf\x6d\xe1\x7b\x76\x13\x6a\x68"
-DATA ·d+60632(SB)/8,$"\x22\x60\xa2\x41\xb1\x9d\x44\x6c"
-DATA ·d+60640(SB)/8,$"\x4f\xcb\xe6\x14\x23\xd3\x1c\xd6"
-DATA ·d+60648(SB)/8,$"\x66\x9f\xe3\x6f\x10\xe9\xba\x23"
-DATA ·d+60656(SB)/8,$"\xe9\xd7\xfe\x22\xb1\xbf\xfb\x5b"
-DATA ·d+60664(SB)/8,$"\x4a\xb2\x5a\x35\xaf\xda\x64\x0b"
-DATA ·d+60672(SB)/8,$"\x72\xb9\x93\x6c\xaa\x46\xdf\x9b"
-DATA ·d+60680(SB)/8,$"\x9c\x01\x8d\xd8\xaf\xab\x7f\x67"
-DATA ·d+60688(SB)/8,$"\xaf\x8e\x9e\x03\x36\x97\x7f\x02"
-DATA ·d+60696(SB)/8,$"\x3e\x34\xe9\x5e\x11\xd4\x3c\x3d"
-DATA ·d+60704(SB)/8,$"\x7f\xa4\xdd\xda\xe9\x1a\xee\xaf"
-DATA ·d+60712(SB)/8,$"\xa5\xdb\xc1\x75\xa5\x6b\x02\xd9"
-DATA ·d+60720(SB)/8,$"\x8d\x95\xcd\xc4\xea\x04\xa5\x24"
-DATA ·d+60728(SB)/8,$"\x75\xdb\x26\x58\x0f\x98\x64\x0e"
-DATA ·d+60736(SB)/8,$"\x4d\x76\x61\xf2\x5c\x6e\x1e\x0a"
-DATA ·d+60744(SB)/8,$"\x51\x9f\x2d\xad\x17\x6d\x6e\xbb"
-DATA ·d+60752(SB)/8,$"\xbf\xcf\xef\x3c\xda\x98\x8c\x3c"
-DATA ·d+60760(SB)/8,$"\x55\xa3\x1e\x67\xba\xe9\x7f\xbd"
-DATA ·d+60768(SB)/8,$"\x6f\xd2\xe7\xc1\xdf\x15\xac\x26"
-DATA ·d+60776(SB)/8,$"\xf0\xaf\xd9\x8c\x57\xd6\xb2\x5d"
-DATA ·d+60784(SB)/8,$"\x46\x88\xb7\x6d\x6d\xb4\x85\x76"
-DATA ·d+60792(SB)/8,$"\xcf\x01\x83\x1d\xa7\x69\xf9\xb8"
-DATA ·d+60800(SB)/8,$"\xc1\x39\xae\x52\x7f\x89\x37\x97"
-DATA ·d+60808(SB)/8,$"\x5a\x32\x69\xd2\x43\x6f\x1c\x60"
-DATA ·d+60816(SB)/8,$"\x22\x35\xdd\xbe\xe8\xa5\x94\x28"
-DATA ·d+60824(SB)/8,$"\xc0\xf3\x82\x93\x1c\xae\xbf\xc2"
-DATA ·d+60832(SB)/8,$"\xec\xbb\x87\x4c\xd7\x43\xa0\x78"
-DATA ·d+60840(SB)/8,$"\x9f\x43\xf0\x8c\x52\x27\x8c\x7e"
-DATA ·d+60848(SB)/8,$"\x1d\xe9\xb7\xd7\x2c\x3c\xc7\x59"
-DATA ·d+60856(SB)/8,$"\xae\xef\x62\x5d\xce\x09\x53\x94"
-DATA ·d+60864(SB)/8,$"\x25\x59\x06\x72\xbb\x1b\x6b\xfb"
-DATA ·d+60872(SB)/8,$"\x8e\x2b\xc3\xbc\x6f\xe2\x5f\x0a"
-DATA ·d+60880(SB)/8,$"\xdb\x6b\xcd\xa8\x78\x7f\x4e\xb8"
-DATA ·d+60888(SB)/8,$"\xd1\xe1\x94\x29\x4b\x48\xe4\x37"
-DATA ·d+60896(SB)/8,$"\x2a\x8d\x8f\x9b\xe7\x90\x2f\xcb"
-DATA ·d+60904(SB)/8,$"\x9e\x42\xe3\x23\xd4\xd7\x5c\xe1"
-DATA ·d+60912(SB)/8,$"\x15\x04\xaa\x54\xe6\x69\xbe\x96"
-DATA ·d+60920(SB)/8,$"\x3e\x93\x07\x87\xf6\xb5\x95\x35"
-DATA ·d+60928(SB)/8,$"\x49\xab\x6c\xd3\x8b\xbe\x9e\x4d"
-DATA ·d+60936(SB)/8,$"\x9d\xf6\x9f\xae\xe9\x7a\x65\xcb"
-DATA ·d+60944(SB)/8,$"\x1b\xc8\x75\x19\x26\xb9\x52\x96"
-DATA ·d+60952(SB)/8,$"\xcd\x61\x79\xe4\xed\x03\x9d\xae"
-DATA ·d+60960(SB)/8,$"\xb1\xa9\xea\xdc\x90\x06\x1b\xaf"
-DATA ·d+60968(SB)/8,$"\x15\xd7\x78\x4f\x4d\xb5\xd6\x35"
-DATA ·d+60976(SB)/8,$"\x65\xd3\xff\x00\xb4\x35\xbd\x37"
-DATA ·d+60984(SB)/8,$"\x50\x90\xe6\x28\x6f\xa3\x95\x91"
-DATA ·d+60992(SB)/8,$"\xb3\xd3\x0e\x8c\x1f\x3d\x3f\x84"
-DATA ·d+61000(SB)/8,$"\x8a\xf3\xb3\xa0\xf8\x93\xc2\x7a"
-DATA ·d+61008(SB)/8,$"\x9c\x57\x57\xf0\x5f\x69\x97\xb1"
-DATA ·d+61016(SB)/8,$"\xc6\x64\x82\xf1\x4c\x5b\x63\x89"
-DATA ·d+61024(SB)/8,$"\xb0\xd1\xef\x8c\x33\xe1\xc8\x3f"
-DATA ·d+61032(SB)/8,$"\x74\x93\x8e\x3e\x95\xb9\x7b\xe3"
-DATA ·d+61040(SB)/8,$"\xff\x00\x88\x3a\xca\x26\xab\xa9"
-DATA ·d+61048(SB)/8,$"\x5c\x5f\x5e\x25\xa4\x38\x5b\xb0"
-DATA ·d+61056(SB)/8,$"\x52\x20\xa5\x8f\x50\xe0\x2b\x2f"
-DATA ·d+61064(SB)/8,$"\x4e\x00\x3d\x6b\xcd\xab\x3c\x43"
-DATA ·d+61072(SB)/8,$"\x9f\x36\x1e\xd6\xee\xda\x7f\x83"
-DATA ·d+61080(SB)/8,$"\x4d\x34\x76\xd4\xa5\x17\xee\xca"
-DATA ·d+61088(SB)/8,$"\x5a\xff\x00\x5d\x6f\xa1\xf7\x8f"
-DATA ·d+61096(SB)/8,$"\xec\xf7\xaa\xfc\x72\xd0\x3c\x55"
-DATA ·d+61104(SB)/8,$"\xf6\x6f\x04\xe9\xb3\x6a\x57\x0b"
-DATA ·d+61112(SB)/8,$"\x6f\x3e\x93\x18\xd4\x74\xf3\x73"
-DATA ·d+61120(SB)/8,$"\x66\x0c\xa4\xf9\xb2\x2d\xc6\xcf"
-DATA ·d+61128(SB)/8,$"\x2e\x19\x01\x5d\xcb\x2b\x3f\xca"
-DATA ·d+61136(SB)/8,$"\x47\x20\xe3\x15\x7f\xe2\x5c\x5a"
-DATA ·d+61144(SB)/8,$"\xf6\xb0\xb6\xba\xbe\xb7\x63\x67"
-DATA ·d+61152(SB)/8,$"\xe2\xd8\xf5\x18\x13\xce\xbe\xd3"
-DATA ·d+61160(SB)/8,$"\xa4\x8b\x50\xb9\x82\xe5\x7e\x51"
-DATA ·d+61168(SB)/8,$"\x14\xd2\xab\x16\xde\x3a\xb1\x48"
-DATA ·d+61176(SB)/8,$"\xb6\x15\xe0\x1a\xf1\x1f\x83\x5f"
-DATA ·d+61184(SB)/8,$"\xb4\xbf\xc5\xff\x00\x00\x69\x2d"
-DATA ·d+61192(SB)/8,$"\xa5\xf8\x4b\x5e\xbb\x8a\xc2\x60"
-DATA ·d+61200(SB)/8,$"\x65\x7b\x4b\xbf\x2a\x48\xdb\xcd"
-DATA ·d+61208(SB)/8,$"\xcf\x98\x31\x20\xde\x33\x92\x72"
-DATA ·d+61216(SB)/8,$"\x0f\x3d\xeb\xa9\xd7\xbe\x32\xf8"
-DATA ·d+61224(SB)/8,$"\x0e\x2d\x1e\xf7\xc4\x1a\x1d\x8a"
-DATA ·d+61232(SB)/8,$"\x69\xba\xec\xf8\x54\x3a\x4c\xd1"
-DATA ·d+61240(SB)/8,$"\x22\x43\x38\xe7\xcc\x21\x9c\x16"
-DATA ·d+61248(SB)/8,$"\x8c\x37\xce\xab\xb4\x95\x24\x8c"
-DATA ·d+61256(SB)/8,$"\x91\x5f\x1b\x98\x66\x18\xf9\x62"
-DATA ·d+61264(SB)/8,$"\xe5\xfb\x98\xc7\xa7\x34\x37\x7e"
-DATA ·d+61272(SB)/8,$"\xbb\x59\x7a\x5c\xed\xa3\x92\xc5"
-DATA ·d+61280(SB)/8,$"\x52\x51\x75\x1c\xf5\xbd\x9a\xd1"
-DATA ·d+61288(SB)/8,$"\x7e\x3a\xfa\xee\x79\x9e\xbf\xa0"
-DATA ·d+61296(SB)/8,$"\x69\x96\x77\xad\x2d\xc2\x4d\x22"
-DATA ·d+61304(SB)/8,$"\x23\x45\x23\xc0\x2c\xa7\x86\x29"
-DATA ·d+61312(SB)/8,$"\x11\xf2\x09\x79\x49\xf9\x55\x38"
-DATA ·d+61320(SB)/8,$"\xce\xd5\x25\xb9\xc1\xae\x4d\x7c"
-DATA ·d+61328(SB)/8,$"\x49\xe3\x2f\x0e\xde\xa6\x81\xa2"
-DATA ·d+61336(SB)/8,$"\xde\xda\x5a\xda\x5c\x30\x79\x2d"
-DATA ·d+61344(SB)/8,$"\xf4\xd9\x6d\xe2\x6b\x88\x9d\xb2"
-DATA ·d+61352(SB)/8,$"\xf1\xfd\xa5\x94\x48\x08\xc0\xc1"
-DATA ·d+61360(SB)/8,$"\xdc\xad\xeb\x5e\x07\xf1\x07\xc6"
-DATA ·d+61368(SB)/8,$"\x7e\x2d\x9e\xf4\x41\xac\x5c\xea"
-DATA ·d+61376(SB)/8,$"\x3a\x84\x4e\x1a\x57\x69\x27\x91"
-DATA ·d+61384(SB)/8,$"\x1e\x36\x62\x49\xdd\x1a\xe3\x00"
-DATA ·d+61392(SB)/8,$"\xe7\x23\x8c\x57\x9f\xae\xb5\x2d"
-DATA ·d+61400(SB)/8,$"\xdd\xb6\x24\x32\x8e\x4f\x91\x24"
-DATA ·d+61408(SB)/8,$"\xec\x25\x65\x50\x46\x73\x9e\xfd"
-DATA ·d+61416(SB)/8,$"\x70\x7a\xd7\xb1\x3c\xae\xac\xa2"
-DATA ·d+61424(SB)/8,$"\xbd\xb5\x7e\x6f\xbd\xdb\xf2\xfb"
-DATA ·d+61432(SB)/8,$"\xce\xcc\x16\x51\x51\xd3\x75\x7a"
-DATA ·d+61440(SB)/8,$"\x79\x75\xf5\xd5\xbf\xc8\xfb\xf6"
-DATA ·d+61448(SB)/8,$"\xcb\x44\xf8\xdf\xe1\x7f\x15\xda"
-DATA ·d+61456(SB)/8,$"\xf8\xa3\x40\xb2\xd5\xae\xae\xac"
-DATA ·d+61464(SB)/8,$"\xaf\x62\xba\xb7\xba\x46\x86\xec"
-DATA ·d+61472(SB)/8,$"\xb5\xb1\x3b\xbc\xb9\x1a\x29\x25"
-DATA ·d+61480(SB)/8,$"\x62\x0e\x40\xde\x31\xc6\x77\x62"
-DATA ·d+61488(SB)/8,$"\xbd\x0b\xf6\x80\xf1\x0f\x88\x9b"
-DATA ·d+61496(SB)/8,$"\xc6\x90\xeb\xde\x1f\xd1\xbc\x37"
-DATA ·d+61504(SB)/8,$"\x0d\xbe\xab\x65\x1c\xe2\xce\xf7"
-DATA ·d+61512(SB)/8,$"\x4a\x8e\x2b\xf8\xae\x22\x05\x65"
-DATA ·d+61520(SB)/8,$"\xf3\x21\x93\x63\x17\xe4\x1d\xf8"
-DATA ·d+61528(SB)/8,$"\xf9\xb3\x9c\xd7\xe7\x6f\x87\x3c"
-DATA ·d+61536(SB)/8,$"\x7d\xe3\x1d\x26\x31\xa7\xe8\xb7"
-DATA ·d+61544(SB)/8,$"\x57\x36\xbf\xbc\x55\xc5\xbc\xac"
-DATA ·d+61552(SB)/8,$"\xb9\x0f\xd5\xb2\x85\x48\xe3\xb8"
-DATA ·d+61560(SB)/8,$"\xe3\xb9\xaf\x56\xb6\xd7\x3c\x5b"
-DATA ·d+61568(SB)/8,$"\xa6\x3b\x58\x5c\x5c\x3d\xf4\xb3"
-DATA ·d+61576(SB)/8,$"\x86\x74\xfb\x64\xe5\xd9\x1c\x8d"
-DATA ·d+61584(SB)/8,$"\xa4\xa4\xa7\x71\x39\xcf\x46\xe3"
-DATA ·d+61592(SB)/8,$"\xd7\x8e\x9c\x72\xcb\xb1\x14\xa4"
-DATA ·d+61600(SB)/8,$"\xaa\x5d\x3b\x7a\xea\xbf\xaf\x33"
-DATA ·d+61608(SB)/8,$"\xcf\xc4\xe5\xb1\x52\x8c\x27\x3b"
-DATA ·d+61616(SB)/8,$"\xe8\xed\xa7\xdf\x7d\x59\xe9\x70"
-DATA ·d+61624(SB)/8,$"\xfc\x4d\xf1\xcf\x88\xe3\xb0\xd2"
-DATA ·d+61632(SB)/8,$"\x6e\xb5\x11\xa6\x59\xd8\xdb\xca"
-DATA ·d+61640(SB)/8,$"\x05\x94\x03\xec\xb1\x46\xad\x82"
-DATA ·d+61648(SB)/8,$"\xf2\x15\x89\x0b\x05\x18\xe7\x25"
-DATA ·d+61656(SB)/8,$"\x87\x3c\x63\x34\xef\x13\x2f\x87"
-DATA ·d+61664(SB)/8,$"\xbc\x5d\xa3\x1f\x12\x30\x81\xa4"
-DATA ·d+61672(SB)/8,$"\xb6\xb9\x06\x49\xb4\xeb\x82\x08"
-DATA ·d+61680(SB)/8,$"\x4d\xb8\x04\xc3\x81\x8c\x91\x9d"
-DATA ·d+61688(SB)/8,$"\xe0\x64\xe7\xb5\x79\xaf\x85\x3c"
-DATA ·d+61696(SB)/8,$"\x53\xae\xd8\xcf\x78\xfe\x12\x86"
-DATA ·d+61704(SB)/8,$"\xe5\x2e\xae\x6d\xc4\x77\x76\xd0"
-DATA ·d+61712(SB)/8,$"\xda\xad\xf4\x32\xc7\x1b\x8d\xec"
-DATA ·d+61720(SB)/8,$"\x9c\x17\x8e\x35\x18\x27\x1b\x81"
-DATA ·d+61728(SB)/8,$"\xe9\xd3\x9a\xe7\xef\x6e\x5f\xc4"
-DATA ·d+61736(SB)/8,$"\x5a\xd3\xea\x5a\x84\xb6\x9f\x6a"
-DATA ·d+61744(SB)/8,$"\x95\x89\xf2\xe3\x55\xb5\x40\xdd"
-DATA ·d+61752(SB)/8,$"\xd4\x20\x50\x01\xf6\xaf\x7b\x0b"
-DATA ·d+61760(SB)/8,$"\x9a\x4b\x0d\x53\xde\xc2\x46\x31"
-DATA ·d+61768(SB)/8,$"\x5d\x7d\xef\xf3\x5f\xd7\x43\xce"
-DATA ·d+61776(SB)/8,$"\x9e\x4c\xaa\x6b\x4e\xa3\xbf\x6f"
-DATA ·d+61784(SB)/8,$"\x77\xfc\x99\xda\xdf\x69\x89\x77"
-DATA ·d+61792(SB)/8,$"\xa2\xb5\xe6\x8f\xa8\x5f\xc5\x2c"
-DATA ·d+61800(SB)/8,$"\x65\x7c\xc4\xde\x7c\x96\x8d\xc9"
-DATA ·d+61808(SB)/8,$"\x08\xca\x78\xd8\xe1\xbb\x1e\x48"
-DATA ·d+61816(SB)/8,$"\xe9\x4d\xf0\xa5\xcf\x87\x74\xcb"
-DATA ·d+61824(SB)/8,$"\x7d\x4e\xcb\xe2\x27\x87\x57\x54"
-DATA ·d+61832(SB)/8,$"\xb9\xb9\x89\x2e\x2c\x75\xa9\xcc"
-DATA ·d+61840(SB)/8,$"\xd2\x9b\x71\xfc\x6b\x20\x8d\xc3"
-DATA ·d+61848(SB)/8,$"\x18\xd9\x48\x2a\xc9\xca\x1e\xa0"
-DATA ·d+61856(SB)/8,$"\x8a\xf2\x6d\x6b\x4b\xd7\xee\xa5"
-DATA ·d+61864(SB)/8,$"\xfe\xcf\x21\xad\xed\xf2\xa6\x46"
-DATA ·d+61872(SB)/8,$"\xdd\x96\x5c\x0d\xdc\xa8\xe3\x1c"
-DATA ·d+61880(SB)/8,$"\x8e\xe6\xba\x3d\x0e\x07\xfb\x7c"
-DATA ·d+61888(SB)/8,$"\x1a\x47\x87\x97\x55\xd7\xb5\x09"
-DATA ·d+61896(SB)/8,$"\xb1\x6f\x0d\xac\x65\xb0\x49\xc2"
-DATA ·d+61904(SB)/8,$"\x29\x50\xa0\xf1\xc9\x18\x3e\xb5"
-DATA ·d+61912(SB)/8,$"\xee\x43\x15\x4a\xb2\xba\x56\x77"
-DATA ·d+61920(SB)/8,$"\xbe\x86\x55\x69\x4e\x9d\x17\x1e"
-DATA ·d+61928(SB)/8,$"\x7d\x1a\xdb\x54\xd7\x9f\x35\xd5"
-DATA ·d+61936(SB)/8,$"\xbf\x21\x4d\xcd\x9d\x9c\x26\xcc"
-DATA ·d+61944(SB)/8,$"\x40\x8f\x67\x2a\x48\xd0\x66\x7f"
-DATA ·d+61952(SB)/8,$"\x36\x33\x8e\x41\xf9\xd4\x1c\x8e"
-DATA ·d+61960(SB)/8,$"\x83\xa1\x3f\x5c\xd7\xa1\xf8\x07"
-DATA ·d+61968(SB)/8,$"\xe1\x3d\xa7\x8d\x96\xda\xe9\x23"
-DATA ·d+61976(SB)/8,$"\x83\xcd\x92\x64\x86\xde\xd2\x47"
-DATA ·d+61984(SB)/8,$"\x31\x46\xe0\xcb\xb1\xb7\x4e\xc7"
-DATA ·d+61992(SB)/8,$"\xcb\x8c\xa8\x04\xf5\x07\xb5\x6d"
-DATA ·d+62000(SB)/8,$"\xf8\x73\xe1\x73\x68\xba\xd5\xbd"
-DATA ·d+62008(SB)/8,$"\x8f\xc4\xfb\x8d\x2b\x49\x16\x9a"
-DATA ·d+62016(SB)/8,$"\x87\x91\x73\x63\x72\xc6\x6d\x42"
-DATA ·d+62024(SB)/8,$"\x17\x72\x17\x12\xdb\xc2\x18\xed"
-DATA ·d+62032(SB)/8,$"\x19\xce\x37\x75\xe0\xe0\xe6\xbd"
-DATA ·d+62040(SB)/8,$"\xbb\xc4\x9f\xb3\xef\x86\xbc\x19"
-DATA ·d+62048(SB)/8,$"\x73\x2e\x95\xa1\x6b\xa9\xa8\x69"
-DATA ·d+62056(SB)/8,$"\x96\xe8\x92\xfd\xa1\x6d\xe4\x44"
-DATA ·d+62064(SB)/8,$"\xb8\x8e\x72\x4a\xca\x6d\xa6\x91"
-DATA ·d+62072(SB)/8,$"\xc4\x4e\xdc\x82\xa7\xa1\x19\xcf"
-DATA ·d+62080(SB)/8,$"\x20\x0c\x3f\xb5\x53\xaa\xe9\x55"
-DATA ·d+62088(SB)/8,$"\xd7\xb5\xd7\xdf\xa9\xcb\x99\xd6"
-DATA ·d+62096(SB)/8,$"\x85\x3a\x4a\x54\x1b\x57\x7b\xa3"
-DATA ·d+62104(SB)/8,$"\xc5\x7c\x5f\xf0\x37\x45\xb3\xb4"
-DATA ·d+62112(SB)/8,$"\x9a\xf2\xf5\xd6\xca\x3b\x5b\xf6"
-DATA ·d+62120(SB)/8,$"\x89\xa2\xb6\x63\x70\xae\x10\xed"
-DATA ·d+62128(SB)/8,$"\x65\x05\x1c\x06\xeb\xb8\xb7\x71"
-DATA ·d+62136(SB)/8,$"\xe8\x2b\xce\xfc\x51\xaa\xf8\x72"
-DATA ·d+62144(SB)/8,$"\x0f\x22\xd2\xd6\x5d\xff\x00\x67"
-DATA ·d+62152(SB)/8,$"\x88\x45\x6f\x17\x90\x23\x85\x4e"
-DATA ·d+62160(SB)/8,$"\xe0\x77\x36\x1d\x8b\x12\x06\x0e"
-DATA ·d+62168(SB)/8,$"\x4e\x39\xc0\xc5\x7d\x0b\xe2\x6b"
-DATA ·d+62176(SB)/8,$"\xef\x86\xfa\x2f\x86\x16\xce\xee"
-DATA ·d+62184(SB)/8,$"\xfb\x52\x45\x80\xbc\x66\x0b\x34"
-DATA ·d+62192(SB)/8,$"\x62\xfb\x5c\xa8\x66\x79\x0e\x41"
-DATA ·d+62200(SB)/8,$"\xca\x80\x38\x23\x81\x8e\x95\xe0"
-DATA ·d+62208(SB)/8,$"\xba\xbd\xb7\x86\xef\x90\xeb\xbe"
-DATA ·d+62216(SB)/8,$"\x14\xf0\xd5\xc4\xda\x3a\x28\x44"
-DATA ·d+62224(SB)/8,$"\xb8\xd4\x27\x32\x4c\x08\x1c\xb4"
-DATA ·d+62232(SB)/8,$"\x8b\x0c\xbb\x54\x1f\xf7\x71\xda"
-DATA ·d+62240(SB)/8,$"\xae\x96\x2b\x07\x4e\x1c\xed\x5b"
-DATA ·d+62248(SB)/8,$"\xb2\x4c\x9c\x0a\xad\x5b\x95\x56"
-DATA ·d+62256(SB)/8,$"\x95\xed\xd5\xed\x7d\x8e\xc7\xc2"
-DATA ·d+62264(SB)/8,$"\xde\x33\xf0\x64\xd6\x88\x26\xd5"
-DATA ·d+62272(SB)/8,$"\x3f\xb0\xef\x11\xc6\xf2\x2c\x21"
-DATA ·d+62280(SB)/8,$"\xbb\xb5\x2a\x7a\x80\x06\x5c\xa9"
-DATA ·d+62288(SB)/8,$"\x1d\x41\x51\xf8\xf5\xae\x7a\x6d"
-DATA ·d+62296(SB)/8,$"\x6f\xe1\x74\xe2\x45\xb8\xb0\xb7"
-DATA ·d+62304(SB)/8,$"\xbe\xdb\x7a\x45\xba\x58\x29\xb3"
-DATA ·d+62312(SB)/8,$"\x90\xc4\xa7\xef\x2c\x80\x32\x90"
-DATA ·d+62320(SB)/8,$"\x46\x0a\xa3\xc6\x01\xe4\x13\x8a"
-DATA ·d+62328(SB)/8,$"\xe2\xf4\xc9\xec\x2c\x65\xfb\x75"
-DATA ·d+62336(SB)/8,$"\xc6\x95\x60\x63\x70\xbb\x16\x48"
-DATA ·d+62344(SB)/8,$"\x06\x3e\x52\x79\x00\xe7\xaf\x70"
-DATA ·d+62352(SB)/8,$"\x4f\x22\xb4\x9f\xc4\xda\x55\xf4"
-DATA ·d+62360(SB)/8,$"\xe6\x0b\x7d\x36\xde\xc2\x54\x21"
-DATA ·d+62368(SB)/8,$"\xd6\xea\x28\x98\x63\x77\x39\x20"
-DATA ·d+62376(SB)/8,$"\x10\x0f\xa0\xf4\xae\x7c\x2e\x33"
-DATA ·d+62384(SB)/8,$"\x07\x3a\x92\xe7\xa9\x2e\x57\xe9"
-DATA ·d+62392(SB)/8,$"\xfa\x6a\x77\xe6\x18\x55\x41\x7b"
-DATA ·d+62400(SB)/8,$"\xb1\xd5\x79\xbf\xc7\x7f\xf3\x3b"
-DATA ·d+62408(SB)/8,$"\x1d\x73\x52\x8b\x4a\x91\x13\xc0"
-DATA ·d+62416(SB)/8,$"\x2b\x09\xd3\xf7\xa3\xa4\x97\x11"
-DATA ·d+62424(SB)/8,$"\xa4\x17\x51\xc8\xca\xa5\xa3\x95"
-DATA ·d+62432(SB)/8,$"\x1c\x8d\xa1\x58\x95\xdc\xa4\xa3"
-DATA ·d+62440(SB)/8,$"\x63\x2a\x71\xc5\x76\x5e\x39\xf8"
-DATA ·d+62448(SB)/8,$"\x93\xf0\x37\x50\xd3\x74\x7d\x0f"
-DATA ·d+62456(SB)/8,$"\x42\xb1\xd4\x6c\xee\x48\x1f\xda"
-DATA ·d+62464(SB)/8,$"\x9a\x96\xa1\xaa\x0b\x84\x79\x4a"
-DATA ·d+62472(SB)/8,$"\xe2\x54\x16\xf0\x44\xb1\xc7\x19"
-DATA ·d+62480(SB)/8,$"\x71\x94\x60\xc5\xca\xf0\xc3\x3c"
-DATA ·d+62488(SB)/8,$"\xd7\x98\x69\x57\x1a\x96\xab\x3b"
-DATA ·d+62496(SB)/8,$"\xe9\xd7\x37\x3a\x8e\xf8\xc8\x5b"
-DATA ·d+62504(SB)/8,$"\xbb\xc3\x6f\x6f\xe5\xaa\xb7\xcc"
-DATA ·d+62512(SB)/8,$"\x76\x1e\x70\x48\xe4\x12\xa7\x9a"
-DATA ·d+62520(SB)/8,$"\x26\xb0\xd0\xae\x7c\x54\xb1\xdb"
-DATA ·d+62528(SB)/8,$"\x40\xf3\xc7\x04\x00\x99\x61\x88"
-DATA ·d+62536(SB)/8,$"\x4f\x32\x9e\x7e\x72\x5c\x79\x61"
-DATA ·d+62544(SB)/8,$"\x98\x64\x9f\x94\x01\xdb\xb5\x75"
-DATA ·d+62552(SB)/8,$"\xd0\xcd\x30\xb4\x1f\x3c\x6e\xec"
-DATA ·d+62560(SB)/8,$"\xf6\xe8\xfa\x6b\xfd\x6e\x7c\xe4"
-DATA ·d+62568(SB)/8,$"\xb0\x54\x27\x57\xd9\xda\xf2\xb6"
-DATA ·d+62576(SB)/8,$"\xf1\xdf\x5f\x37\xa7\xa7\x5b\x7a"
-DATA ·d+62584(SB)/8,$"\x9e\x94\x82\xc3\x40\xd6\x0c\x57"
-DATA ·d+62592(SB)/8,$"\x43\x48\xd6\x2c\xa4\xd8\x8c\xcb"
-DATA ·d+62600(SB)/8,$"\x71\x3f\x95\x86\x51\xb1\xcf\xef"
-DATA ·d+62608(SB)/8,$"\x15\x0e\x17\x85\xc1\xc6\x6b\x87"
-DATA ·d+62616(SB)/8,$"\xd5\xf4\xd1\x3e\xaf\x35\xb6\x99"
-DATA ·d+62624(SB)/8,$"\x2d\x86\x81\x6f\x12\xb6\x2f\x5e"
-DATA ·d+62632(SB)/8,$"\x23\x70\x93\x2e\x7e\xe9\x65\x0d"
-DATA ·d+62640(SB)/8,$"\xb5\x8e\x33\xc9\xfa\x1a\xe3\x27"
-DATA ·d+62648(SB)/8,$"\xf0\xe7\x89\xaf\x7c\x49\xf6\x49"
-DATA ·d+62656(SB)/8,$"\x6f\x24\xd0\x74\xd8\xa1\x33\xa5"
-DATA ·d+62664(SB)/8,$"\xd6\xa0\xec\x23\x30\xfb\xae\x7e"
-DATA ·d+62672(SB)/8,$"\x76\x7e\x02\xa8\xe4\x93\xd8\x02"
-DATA ·d+62680(SB)/8,$"\x69\xde\x0b\xf1\x56\xa5\x24\xed"
-DATA ·d+62688(SB)/8,$"\x69\xe0\xc5\xbd\xd4\x75\x36\x91"
-DATA ·d+62696(SB)/8,$"\x45\xbe\x2d\x95\xe6\x56\x07\xe5"
-DATA ·d+62704(SB)/8,$"\xd8\x91\x46\xec\xe0\xfa\x10\x47"
-DATA ·d+62712(SB)/8,$"\xbd\x75\xd5\xe2\x8f\x6d\xcd\x08"
-DATA ·d+62720(SB)/8,$"\x47\x95\xbd\x74\xff\x00\x82\xcf"
-DATA ·d+62728(SB)/8,$"\x4b\x09\x90\x4e\x9d\xb1\x0e\x5c"
-DATA ·d+62736(SB)/8,$"\xd6\xb2\x77\xe9\xeb\x65\x6d\x3d"
-DATA ·d+62744(SB)/8,$"\x0f\xba\x7e\x09\xf8\x63\xc3\x3f"
-DATA ·d+62752(SB)/8,$"\x10\xf4\x8b\xad\x53\xe2\x04\xda"
-DATA ·d+62760(SB)/8,$"\x06\xa5\xa5\xc1\xff\x00\x12\xf6"
-DATA ·d+62768(SB)/8,$"\x6d\x02\xdf\x6e\xa9\xf6\xa7\x8f"
-DATA ·d+62776(SB)/8,$"\x74\x52\xb4\x31\x00\x1d\x17\x1f"
-DATA ·d+62784(SB)/8,$"\x31\x38\xfa\xf1\x5f\x35\x78\xe6"
-DATA ·d+62792(SB)/8,$"\xd3\xc1\x7f\x0d\x3c\x4f\x24\xfe"
-DATA ·d+62800(SB)/8,$"\x0a\xd6\x8c\xc8\xf2\x00\xfb\x22"
-DATA ·d+62808(SB)/8,$"\xdb\x0c\xdf\x29\x04\xba\x9c\x94"
-DATA ·d+62816(SB)/8,$"\x75\xc9\x5c\x9e\x48\xf5\xaf\x53"
-DATA ·d+62824(SB)/8,$"\xbd\xf8\x39\xf1\xfb\xc0\xf7\xfa"
-DATA ·d+62832(SB)/8,$"\x07\xc4\x5f\x89\x7e\x1c\xb5\x16"
-DATA ·d+62840(SB)/8,$"\xd3\x40\xb3\x8d\x1c\xeb\xd0\xd9"
-DATA ·d+62848(SB)/8,$"\xea\x92\x5b\x4a\x1c\x89\x24\xb7"
-DATA ·d+62856(SB)/8,$"\x88\x24\xf0\xa6\x7e\x60\x1b\x91"
-DATA ·d+62864(SB)/8,$"\x8c\x15\x00\x91\x5f\x3e\xfc\x6b"
-DATA ·d+62872(SB)/8,$"\xbb\x7f\x0e\x6b\x53\x9d\x3f\x49"
-DATA ·d+62880(SB)/8,$"\xb7\x84\x11\x19\xb8\xb9\x87\xca"
-DATA ·d+62888(SB)/8,$"\x93\x73\xb2\x00\x4b\xf9\x1f\xbb"
-DATA ·d+62896(SB)/8,$"\x20\x1e\x84\x1e\x4f\x24\x0c\xd7"
-DATA ·d+62904(SB)/8,$"\x99\x2c\xc9\xd4\x6e\x4e\x4e\x7a"
-DATA ·d+62912(SB)/8,$"\xf5\x51\x56\xf2\xb2\xba\xd0\xe4"
-DATA ·d+62920(SB)/8,$"\xa3\x92\x7f\xb7\xa4\xaa\x7b\xb3"
-DATA ·d+62928(SB)/8,$"\x5b\x29\x69\xea\xee\x95\xef\xba"
-DATA ·d+62936(SB)/8,$"\xb2\x3e\x8e\xf0\xb7\xed\x89\xe3"
-DATA ·d+62944(SB)/8,$"\x2f\x0c\x7c\x33\x3e\x09\x12\x2e"
-DATA ·d+62952(SB)/8,$"\xb1\x04\x21\x3e\xcd\x6f\x79\xab"
-DATA ·d+62960(SB)/8,$"\xdf\x2a\x42\x38\x52\x3e\xc3\x24"
-DATA ·d+62968(SB)/8,$"\x8f\x6f\x32\xec\x01\x31\xf2\xe0"
-DATA ·d+62976(SB)/8,$"\x00\x46\x3a\x57\xc4\xff\x00\x11"
-DATA ·d+62984(SB)/8,$"\x6f\x7c\x5f\x7d\xac\x3e\xbd\x7f"
-DATA ·d+62992(SB)/8,$"\xa7\xbd\x8a\xde\x83\x74\x91\xda"
-DATA ·d+63000(SB)/8,$"\xab\x45\x17\x96\xc4\x80\x62\x5c"
-DATA ·d+63008(SB)/8,$"\x91\xb0\x11\xd0\x12\x01\xc8\xeb"
-DATA ·d+63016(SB)/8,$"\x5c\x56\xa1\x7b\x14\x36\xdb\x20"
-DATA ·d+63024(SB)/8,$"\x58\x9a\x49\xd1\x59\xe7\x90\x86"
-DATA ·d+63032(SB)/8,$"\xc8\xf4\x1d\x31\x8f\xce\xba\xbd"
-DATA ·d+63040(SB)/8,$"\x2b\xc7\x17\x9a\xa7\x87\x17\xc2"
-DATA ·d+63048(SB)/8,$"\x9a\x8d\xbc\xd7\xb6\xb6\xaf\x24"
-DATA ·d+63056(SB)/8,$"\x96\x52\x46\xd8\x92\xd6\x59\x58"
-DATA ·d+63064(SB)/8,$"\x34\x85\x09\xc8\x65\x90\x80\x59"
-DATA ·d+63072(SB)/8,$"\x58\x7d\xee\x41\xce\x72\x60\xb0"
-DATA ·d+63080(SB)/8,$"\x74\xe9\x4b\x9f\x0e\xb5\x77\xbd"
-DATA ·d+63088(SB)/8,$"\x9e\xd7\xd7\x43\xea\x29\x60\x23"
-DATA ·d+63096(SB)/8,$"\x46\xf5\x21\x4f\xae\xb7\xfe\xad"
-DATA ·d+63104(SB)/8,$"\xe8\x78\x1c\x9e\x22\xd5\x6c\xf5"
-DATA ·d+63112(SB)/8,$"\x93\x74\x92\xbc\x70\xbb\xee\xca"
-DATA ·d+63120(SB)/8,$"\x1c\x30\x03\xd4\x77\x60\x79\xe6"
-DATA ·d+63128(SB)/8,$"\xbe\x8d\xf8\x7b\xf1\x53\xc7\x8d"
-DATA ·d+63136(SB)/8,$"\xa5\xdf\xc1\x67\xe2\x8b\xeb\x19"
-DATA ·d+63144(SB)/8,$"\x5e\x07\x98\x83\xf6\x55\xb7\x95"
-DATA ·d+63152(SB)/8,$"\x80\xe4\x4b\x24\xe0\xe0\x15\x18"
-DATA ·d+63160(SB)/8,$"\xc0\xe4\x9e\x00\xcd\x60\xcd\xf0"
-DATA ·d+63168(SB)/8,$"\x87\xc2\x5e\x20\xd6\x20\xb7\xb4"
-DATA ·d+63176(SB)/8,$"\xd7\x22\xb1\xb6\xba\x8d\x66\x9e"
-DATA ·d+63184(SB)/8,$"\x6d\x46\x09\x03\x40\xe7\x87\x56"
-DATA ·d+63192(SB)/8,$"\x8a\x2d\xec\x59\x4f\x4d\x87\x0c"
-DATA ·d+63200(SB)/8,$"\x0f\x18\xa3\x5f\xf8\x2d\xe1\x5f"
-DATA ·d+63208(SB)/8,$"\x09\xdc\x9b\x01\xe2\x44\xd4\x2d"
-DATA ·d+63216(SB)/8,$"\xe3\x8c\x5c\x4f\xe5\xe9\x77\x10"
-DATA ·d+63224(SB)/8,$"\xa6\xcd\xdc\x7f\xae\x23\x20\x8e"
-DATA ·d+63232(SB)/8,$"\x77\x74\xec\x79\xae\x5c\xc3\x11"
-DATA ·d+63240(SB)/8,$"\x5a\xaf\xee\xbd\xa3\x5d\x6c\xff"
-DATA ·d+63248(SB)/8,$"\x00\xe0\xd8\xfb\x29\x62\xb0\x33"
-DATA ·d+63256(SB)/8,$"\x84\x67\x2a\x7a\xff\x00\x85\xbf"
-DATA ·d+63264(SB)/8,$"\xd1\x9c\xde\xb1\xe3\x0d\x7f\xc4"
-DATA ·d+63272(SB)/8,$"\x10\xa5\xc7\x9b\x01\x69\xb2\xd2"
-DATA ·d+63280(SB)/8,$"\x66\x30\x37\x80\x7e\xf0\x45\x38"
-DATA ·d+63288(SB)/8,$"\xe7\xe8\x3e\x95\xde\x7c\x31\xfb"
-DATA ·d+63296(SB)/8,$"\x37\x8f\x75\xcb\x7f\x06\x78\x93"
-DATA ·d+63304(SB)/8,$"\x57\xd4\x34\xeb\x6b\x99\x04\x79"
-DATA ·d+63312(SB)/8,$"\xb2\x80\xce\x37\x0c\xf2\xd1\x21"
-DATA ·d+63320(SB)/8,$"\x04\xf4\xe3\xd2\xaf\xf8\x3a\x6f"
-DATA ·d+63328(SB)/8,$"\x06\xf8\x6b\x5d\x92\xe3\xfb\x2e"
-DATA ·d+63336(SB)/8,$"\x5d\x72\x26\x4e\x60\x79\xbe\xc9"
-DATA ·d+63344(SB)/8,$"\x2a\xf0\x36\x6d\x92\x35\x95\x8a"
-DATA ·d+63352(SB)/8,$"\xfa\xae\x07\x07\xad\x61\xea\x7e"
-DATA ·d+63360(SB)/8,$"\x3d\xf1\x8d\x95\xfc\xd1\x69\x52"
-DATA ·d+63368(SB)/8,$"\x36\x91\x6b\x2c\xd2\xc8\xd1\xd8"
-DATA ·d+63376(SB)/8,$"\xaa\xae\x3c\xce\xab\xbc\xaf\x9b"
-DATA ·d+63384(SB)/8,$"\xc0\xc0\x19\x6f\xca\xba\xf0\x58"
-DATA ·d+63392(SB)/8,$"\x2a\xca\x2a\x5c\xa9\xfe\x5f\x82"
-DATA ·d+63400(SB)/8,$"\x3e\x7f\x1d\x5e\x38\x87\x2a\x14"
-DATA ·d+63408(SB)/8,$"\xa3\xc8\xad\x74\xf4\xba\xf9\x6b"
-DATA ·d+63416(SB)/8,$"\xaf\x93\x3d\x5a\xcb\xe0\x66\x8f"
-DATA ·d+63424(SB)/8,$"\x06\xaf\x7d\x36\x87\xad\xdc\xcf"
-DATA ·d+63432(SB)/8,$"\x73\xa4\x24\xf3\x27\x95\x12\x21"
-DATA ·d+63440(SB)/8,$"\xb8\x89\x46\x76\xc0\xcc\x08\x92"
-DATA ·d+63448(SB)/8,$"\x46\x38\x1b\x41\xf5\xf4\xae\x4a"
-DATA ·d+63456(SB)/8,$"\xef\x5a\x8f\xc4\x3e\x30\x87\x4f"
-DATA ·d+63464(SB)/8,$"\xd0\xb4\x1f\x11\x5b\x5b\x96\x8a"
-DATA ·d+63472(SB)/8,$"\x38\x6d\x2e\xe4\x12\xca\x27\x61"
-DATA ·d+63480(SB)/8,$"\xb5\x86\x48\x55\x2b\x23\xf2\xa1"
-DATA ·d+63488(SB)/8,$"\xb9\x04\x91\x92\x00\xae\x5f\xc2"
-DATA ·d+63496(SB)/8,$"\x51\x5d\xea\x91\x4e\xf3\xea\xb1"
-DATA ·d+63504(SB)/8,$"\xdb\xc5\x69\x17\x9b\xb2\xe9\xd8"
-DATA ·d+63512(SB)/8,$"\xb4\xbb\xb8\x50\x88\xc4\x02\x73"
-DATA ·d+63520(SB)/8,$"\xc9\x3c\xed\x1c\xe0\xd6\x96\xbb"
-DATA ·d+63528(SB)/8,$"\x32\x5b\xc0\xb2\xf8\x7a\x6f\xb6"
-DATA ·d+63536(SB)/8,$"\x44\xd1\x89\x26\x92\x52\x51\xa2"
-DATA ·d+63544(SB)/8,$"\x91\x4e\x08\x04\x7d\xef\x50\x78"
-DATA ·d+63552(SB)/8,$"\xeb\xd2\xb7\x96\x5b\x4a\x52\xf7"
-DATA ·d+63560(SB)/8,$"\xeb\x34\xfd\x34\x5f\x35\xb9\xe3"
-DATA ·d+63568(SB)/8,$"\xd6\xae\xb9\xfd\x85\x55\xcc\xd2"
-DATA ·d+63576(SB)/8,$"\x4a\xee\xea\xdf\xa6\xbd\x8f\x52"
-DATA ·d+63584(SB)/8,$"\x93\xc1\x1a\xb6\xa5\xa8\x4f\xe1"
-DATA ·d+63592(SB)/8,$"\x8d\x61\x21\xd2\x1a\x5b\x73\x2b"
-DATA ·d+63600(SB)/8,$"\xb6\xb1\x14\x90\x11\x18\xe5\x5d"
-DATA ·d+63608(SB)/8,$"\x03\x28\x01\x89\x1f\x2b\x03\xcf"
-DATA ·d+63616(SB)/8,$"\x38\xae\xb3\xc0\x9a\x5f\xc3\x8f"
-DATA ·d+63624(SB)/8,$"\x0f\xc5\x71\xa4\xf8\x86\x5b\xc8"
-DATA ·d+63632(SB)/8,$"\x24\x9a\xe1\x15\x7c\x41\xa4\x6a"
-DATA ·d+63640(SB)/8,$"\x13\xc2\x61\x84\x11\x96\x7b\x37"
-DATA ·d+63648(SB)/8,$"\xc4\x72\xae\xdc\x9d\xa4\x86\xcf"
-DATA ·d+63656(SB)/8,$"\x1e\xf5\xf3\x1e\x87\xe2\x7d\x4a"
-DATA ·d+63664(SB)/8,$"\x0d\x48\x58\xea\x77\x77\x25\x42"
-DATA ·d+63672(SB)/8,$"\xab\x1d\xb2\x19\x18\x31\x39\xd9"
-DATA ·d+63680(SB)/8,$"\x96\xdc\x4e\xd0\x3a\x0e\x95\xf4"
-DATA ·d+63688(SB)/8,$"\xc4\x3e\x26\xb6\xb4\xb1\x8f\x54"
-DATA ·d+63696(SB)/8,$"\xd1\xf4\xcb\x4f\x14\x99\x2c\xa4"
-DATA ·d+63704(SB)/8,$"\xdf\xa6\x6a\xa4\x46\x91\x87\x52"
-DATA ·d+63712(SB)/8,$"\x0b\x28\x38\x25\x87\x0c\xbb\x08"
-DATA ·d+63720(SB)/8,$"\x6c\x8e\xb5\x9b\xc1\xc3\xe1\x94"
-DATA ·d+63728(SB)/8,$"\xef\xd9\xaf\x23\xe6\x73\x6c\x25"
-DATA ·d+63736(SB)/8,$"\x7a\x13\x85\x08\xe9\x09\x79\xdb"
-DATA ·d+63744(SB)/8,$"\x7f\x36\x72\x7f\x16\x3c\x49\xf0"
-DATA ·d+63752(SB)/8,$"\xfa\x08\x3f\xb1\x3c\x23\xe3\x1b"
-DATA ·d+63760(SB)/8,$"\xdf\x11\xc6\x25\x78\x14\x6b\x1a"
-DATA ·d+63768(SB)/8,$"\x7b\x22\xaa\x28\x3b\x3c\xb9\xa4"
-DATA ·d+63776(SB)/8,$"\xdc\x30\x5b\x18\xd8\x45\x78\x3a"
-DATA ·d+63784(SB)/8,$"\x38\x08\x01\x8a\x1e\x00\xe9\x1a"
-DATA ·d+63792(SB)/8,$"\x91\xf9\x86\xe6\xb7\xb5\x8f\x86"
-DATA ·d+63800(SB)/8,$"\xbe\x30\xf2\x6d\xb5\x2b\xcd\x23"
-DATA ·d+63808(SB)/8,$"\x57\x8d\x67\x4d\xc6\x24\xb0\x96"
-DATA ·d+63816(SB)/8,$"\x22\x59\x7f\xb8\xcc\xb8\x6c\x0e"
-DATA ·d+63824(SB)/8,$"\xe0\x9f\x5a\xe6\x7f\xe1\x03\xf1"
-DATA ·d+63832(SB)/8,$"\x29\xe4\x78\x7f\x52\x20\xf4\x26"
-DATA ·d+63840(SB)/8,$"\x29\x39\xad\x96\x17\x95\x5a\x12"
-DATA ·d+63848(SB)/8,$"\x72\xf3\x3e\xab\x05\x0c\x35\x28"
-DATA ·d+63856(SB)/8,$"\x28\x42\xa7\xad\xda\xdf\xf0\x3f"
-DATA ·d+63864(SB)/8,$"\xff\xd9\x00\x00\x00\x00\x00\x00"
-DATA ·d+63872(SB)/8,$"\x1f\x8b\x08\x00\x00\x00\x00\x00"
-DATA ·d+63880(SB)/8,$"\x02\xff\xd4\x59\xdb\x72\xdb\x36"
-DATA ·d+63888(SB)/8,$"\xb7\xbe\xae\x9e\x62\x95\xdd\x93"
-DATA ·d+63896(SB)/8,$"\xb1\x67\x24\x31\x71\xd2\x1c\x6c"
-DATA ·d+63904(SB)/8,$"\x89\x7b\x64\x59\xb6\xd9\xca\x94"
-DATA ·d+63912(SB)/8,$"\x46\xa2\x9b\x9d\x4b\x88\x5c\x24"
-DATA ·d+63920(SB)/8,$"\x11\x83\x00\x0b\x80\x56\xd4\x3d"
-DATA ·d+63928(SB)/8,$"\x9d\xe9\xfc\xcf\x90\xcb\xff\xe9"
-DATA ·d+63936(SB)/8,$"\xf2\x24\xff\x00\xa4\x4e\x3e\xc8"
-DATA ·d+63944(SB)/8,$"\x72\xd3\x9b\xff\xc6\x22\x80\x75"
-DATA ·d+63952(SB)/8,$"\xfc\xd6\x02\xb0\xb0\xdc\xf9\xf1"
-DATA ·d+63960(SB)/8,$"\x6c\xd4\x0f\x3f\x8d\x07\x90\xe9"
-DATA ·d+63968(SB)/8,$"\x9c\x79\x8d\x8e\xf9\x01\x1a\x77"
-DATA ·d+63976(SB)/8,$"\x1d\xf3\xe1\x00\x23\x3c\xed\x3a"
-DATA ·d+63984(SB)/8,$"\xc8\x5b\xd7\x53\xc7\xac\x22\x89"
-DATA ·d+63992(SB)/8,$"\xbd\x06\x00\x40\x27\x47\x4d\x20"
-DATA ·d+64000(SB)/8,$"\xd3\xba\x68\xe1\xef\x25\xbd\xed"
-DATA ·d+64008(SB)/8,$"\x3a\x91\xe0\x1a\xb9\x6e\xe9\x45"
-DATA ·d+64016(SB)/8,$"\x81\x0e\xd4\xa3\xae\xa3\xf1\x8b"
-DATA ·d+64024(SB)/8,$"\x76\x8d\xb0\x93\x28\x23\x52\xa1"
-DATA ·d+64032(SB)/8,$"\xee\x96\x3a\x69\xbd\x77\x36\xc5"
-DATA ·d+64040(SB)/8,$"\x70\x92\x63\xd7\xb9\xa5\x38\x2f"
-DATA ·d+64048(SB)/8,$"\x84\xd4\x1b\xcc\x73\x1a\xeb\xac"
-DATA ·d+64056(SB)/8,$"\x1b\xe3\x2d\x8d\xb0\x65\x07\x8e"
-DATA ·d+64064(SB)/8,$"\x5b\x33\x6a\xaa\x19\x7a\xa9\x68"
-DATA ·d+64072(SB)/8,$"\xd1\x7c\x86\x31\xc4\x98\x8b\x8e"
-DATA ·d+64080(SB)/8,$"\x5b\x4d\xde\x93\x1c\xa3\x8a\x24"
-DATA ·d+64088(SB)/8,$"\x2d\x34\x15\x7c\x43\xf8\x03\x26"
-DATA ·d+64096(SB)/8,$"\x90\x52\x67\x42\x3e\x40\xc3\x28"
-DATA ·d+64104(SB)/8,$"\xbf\x01\x89\xac\xeb\x28\xbd\x60"
-DATA ·d+64112(SB)/8,$"\xa8\x32\x44\xed\x40\x26\x31\xe9"
-DATA ·d+64120(SB)/8,$"\x3a\x91\x52\xae\x9d\x6d\x47\x4a"
-DATA ·d+64128(SB)/8,$"\x39\xe0\xde\x17\x9b\x22\x47\x49"
-DATA ·d+64136(SB)/8,$"\xf4\x96\xe4\xcb\x32\x15\xf0\xb2"
-DATA ·d+64144(SB)/8,$"\xfd\xfa\xa8\xfd\xda\xf2\x74\xdc"
-DATA ·d+64152(SB)/8,$"\x0a\xdc\x46\x67\x26\xe2\x45\x2d"
-DATA ·d+64160(SB)/8,$"\x43\x61\x64\x8c\xb6\x01\xb9\x42"
-DATA ·d+64168(SB)/8,$"\x5e\xd6\xe6\xd8\x35\x43\x8e\x72"
-DATA ·d+64176(SB)/8,$"\x3d\x51\x4d\xbe\xba\x0b\x49\xf6"
-DATA ·d+64184(SB)/8,$"\xea\x0e\x49\xe1\x75\xdc\x62\x43"
-DATA ·d+64192(SB)/8,$"\x8e\x7b\x57\x50\x87\x93\xdb\xf5"
-DATA ·d+64200(SB)/8,$"\x68\x9b\x99\xd4\x3e\x9b\xc0\xab"
-DATA ·d+64208(SB)/8,$"\x63\xd7\x4d\xa9\xce\xca\x59\x3b"
-DATA ·d+64216(SB)/8,$"\x12\xb9\x9b\x4a\x31\x67\x28\xdd"
-DATA ·d+64224(SB)/8,$"\xa5\x7e\x07\x34\x91\x29\xea\xae"
-DATA ·d+64232(SB)/8,$"\x33\x63\x84\xdf\x38\x6b\xc3\x94"
-DATA ·d+64240(SB)/8,$"\x28\x65\x84\xaa\xe3\x92\x07\xd4"
-DATA ·d+64248(SB)/8,$"\x74\xdc\x2d\xf5\xfb\x18\xf3\xd3"
-DATA ·d+64256(SB)/8,$"\x4a\xe5\x4a\xc7\xc3\xb2\xef\x70"
-DATA ·d+64264(SB)/8,$"\xa1\xd6\x94\xa7\x2d\xa5\x89\xd4"
-DATA ·d+64272(SB)/8,$"\x86\xf9\xa2\x9a\x80\x69\x35\xb1"
-DATA ·d+64280(SB)/8,$"\x8f\x0c\xa1\x33\x94\x2d\x2d\x04"
-DATA ·d+64288(SB)/8,$"\x53\x8e\x37\x32\x03\x40\xa3\x3f"
-DATA ·d+64296(SB)/8,$"\x36\x72\xec\xf4\x3e\x52\x22\x89"
-DATA ·d+64304(SB)/8,$"\x31\xd5\xaa\x45\x78\xdc\xd2\x19"
-DATA ·d+64312(SB)/8,$"\xe1\x37\xca\xf1\xfa\xd5\x1c\x10"
-DATA ·d+64320(SB)/8,$"\x1e\x43\x68\xe7\xf6\x91\x44\x5a"
-DATA ·d+64328(SB)/8,$"\xdc\x6c\x95\x82\x46\xba\x94\xe8"
-DATA ·d+64336(SB)/8,$"\x78\xbf\x94\x4a\x03\x01\x33\xd9"
-DATA ·d+64344(SB)/8,$"\x84\x02\x49\x84\x49\xc9\xa0\x5e"
-DATA ·d+64352(SB)/8,$"\xde\x47\x20\xa3\x11\x72\x85\x8e"
-DATA ·d+64360(SB)/8,$"\x37\xac\x3e\x9e\x0c\x5a\xc7\xad"
-DATA ·d+64368(SB)/8,$"\x93\xb6\x1a\xde\xf9\xb3\x95\xd1"
-DATA ·d+64376(SB)/8,$"\xeb\xb0\x35\x1a\x9b\x19\xfc\x54"
-DATA ·d+64384(SB)/8,$"\x60\x3b\x2a\x27\x8c\x6d\x90\xd9"
-DATA ·d+64392(SB)/8,$"\xb3\xca\x3b\x25\xd1\x0d\x68\x01"
-DATA ·d+64400(SB)/8,$"\xa1\x28\x2c\x95\x5b\x93\xd9\x2d"
-DATA ·d+64408(SB)/8,$"\xb0\xb6\xb9\x61\x76\x40\x24\x62"
-DATA ·d+64416(SB)/8,$"\xdc\x90\x69\x87\x40\x15\x10\x50"
-DATA ·d+64424(SB)/8,$"\x34\x2f\x18\xc2\x85\xa8\xd3\x14"
-DATA ·d+64432(SB)/8,$"\x56\x9b\xd7\xc8\xb6\xc1\x85\x19"
-DATA ·d+64440(SB)/8,$"\xe5\x44\x2e\x40\x62\x9d\xc9\x40"
-DATA ·d+64448(SB)/8,$"\xb9\x16\x86\x05\xbf\x60\x54\x6a"
-DATA ·d+64456(SB)/8,$"\x32\x63\xd8\xb6\x9b\xac\xd1\xc9"
-DATA ·d+64464(SB)/8,$"\x8e\xac\xab\xf3\x6c\xe1\x78\x1f"
-DATA ·d+64472(SB)/8,$"\xb3\x45\xc7\xcd\x8e\xbc\x1d\x26"
-DATA ·d+64480(SB)/8,$"\x44\x24\x47\x28\x0b\x20\xc6\x94"
-DATA ·d+64488(SB)/8,$"\x4c\x30\x1a\x93\x05\x28\x1a\x23"
-DATA ·d+64496(SB)/8,$"\x14\x52\x7c\xc6\x48\x43\x22\x24"
-DATA ·d+64504(SB)/8,$"\x10\xb8\x45\xb9\x58\x9a\x1a\x11"
-DATA ·d+64512(SB)/8,$"\x85\x20\x92\x8d\xc4\x23\x30\x19"
-DATA ·d+64520(SB)/8,$"\x4c\x43\xe8\x8d\x7d\x88\x45\x54"
-DATA ·d+64528(SB)/8,$"\xe6\xc8\x35\xa9\x50\x37\x76\xea"
-DATA ·d+64536(SB)/8,$"\x0c\x37\x0c\x05\x9a\x93\x14\xdb"
-DATA ·d+64544(SB)/8,$"\x10\x66\x28\x11\x88\xc4\xc7\xb2"
-DATA ·d+64552(SB)/8,$"\xbb\x60\xc8\xf5\xc2\x28\x5a\xe5"
-DATA ·d+64560(SB)/8,$"\xb5\x35\x66\xad\xb6\x86\x85\x28"
-DATA ·d+64568(SB)/8,$"\x85\xba\xc6\x64\x43\x0f\x1c\xcc"
-DATA ·d+64576(SB)/8,$"\x33\x1a\x65\x10\x31\x24\x92\x2d"
-DATA ·d+64584(SB)/8,$"\x40\x65\x62\xae\xcc\x21\x65\x32"
-DATA ·d+64592(SB)/8,$"\xdc\x08\x52\x22\x47\x9d\x19\x41"
-DATA ·d+64600(SB)/8,$"\xf3\x8c\x68\x03\x27\x23\xd1\x8d"
-DATA ·d+64608(SB)/8,$"\x02\xa2\xad\xc9\xb9\x30\x7e\x1c"
-DATA ·d+64616(SB)/8,$"\x36\x61\x56\x6a\xc8\x70\xd1\x84"
-DATA ·d+64624(SB)/8,$"\x79\xb6\x00\x2e\x34\x50\x7e\x8b"
-DATA ·d+64632(SB)/8,$"\x5c\x03\xe1\xd6\x5e\x98\x67\x88"
-DATA ·d+64640(SB)/8,$"\xec\x7f\xe1\x14\x0d\x6e\xaa\x09"
-DATA ·d+64648(SB)/8,$"\x4a\x97\x49\x72\xd7\xbe\x55\xc8"
-DATA ·d+64656(SB)/8,$"\xea\x28\x27\x94\x21\x28\xc4\x1c"
-DATA ·d+64664(SB)/8,$"\x63\xe3\x1f\x94\x9c\xa0\xd2\x19"
-DATA ·d+64672(SB)/8,$"\x6a\x1a\x99\xb8\xe7\xcb\x80\x3e"
-DATA ·d+64680(SB)/8,$"\x3b\xc7\xef\x1e\x32\x8f\xa6\xfa"
-DATA ·d+64688(SB)/8,$"\x1e\xa7\xd1\xf7\x26\x7e\x9d\x8c"
-DATA ·d+64696(SB)/8,$"\x94\x2b\x4d\x18\xb3\x29\xe1\x78"
-DATA ·d+64704(SB)/8,$"\xfe\xc6\x68\x95\x9e\x12\xab\x04"
-DATA ·d+64712(SB)/8,$"\x85\x88\x11\xa5\xba\x8e\xa9\x03"
-DATA ·d+64720(SB)/8,$"\x4a\x92\x62\xcb\x5c\xe7\x8e\xf7"
-DATA ·d+64728(SB)/8,$"\x3f\x90\x0a\x48\x51\x43\xab\x84"
-DATA ·d+64736(SB)/8,$"\x1d\x57\x40\xa3\x4e\xeb\x8e\x6b"
-DATA ·d+64744(SB)/8,$"\x04\xae\x0d\x28\x15\x49\xd1\xf1"
-DATA ·d+64752(SB)/8,$"\xae\xcd\xcf\x52\xa5\x30\xd5\x07"
-DATA ·d+64760(SB)/8,$"\xa3\x5e\xa7\x58\x5a\x04\x0f\x6e"
-DATA ·d+64768(SB)/8,$"\x91\xe3\x7a\x63\xad\x4c\xf4\xf6"
-DATA ·d+64776(SB)/8,$"\x33\x65\xdb\x96\x8e\xcb\xa8\x91"
-DATA ·d+64784(SB)/8,$"\x52\x29\xec\xc5\xb1\xd9\xf9\x66"
-DATA ·d+64792(SB)/8,$"\x93\x44\xcb\x1b\x1a\xb4\x44\x34"
-DATA ·d+64800(SB)/8,$"\xa8\x56\xb7\x18\x14\x24\xba\x21"
-DATA ·d+64808(SB)/8,$"\x29\xde\x57\xaf\x64\xd4\xf8\xf6"
-DATA ·d+64816(SB)/8,$"\xf5\xeb\xb7\xaf\x7f\x7d\xfb\xfa"
-DATA ·d+64824(SB)/8,$"\x17\x2c\x44\x29\x6b\x52\x8b\xfc"
-DATA ·d+64832(SB)/8,$"\xb7\xaf\xff\xae\x57\x0c\x71\x3b"
-DATA ·d+64840(SB)/8,$"\x15\xf5\xec\x92\x5e\x51\x8d\xab"
-DATA ·d+64848(SB)/8,$"\x10\xad\x89\x2b\x5b\x36\x16\xfe"
-DATA ·d+64856(SB)/8,$"\xb5\xcd\xb5\x2c\x37\x1e\x60\xa5"
-DATA ·d+64864(SB)/8,$"\x3c\xc6\x2f\x6d\x93\x19\x1b\x8b"
-DATA ·d+64872(SB)/8,$"\x4b\xc6\x37\x2f\xdf\x54\x4b\x4f"
-DATA ·d+64880(SB)/8,$"\xa2\x91\x8a\x56\x7d\xf2\x21\x44"
-DATA ·d+64888(SB)/8,$"\x22\xcf\x2d\x24\xa2\x8e\x49\xed"
-DATA ·d+64896(SB)/8,$"\xcb\xf2\xd4\x3a\x30\x67\x12\x5f"
-DATA ·d+64904(SB)/8,$"\x40\xb5\xff\x2e\x44\xb5\x9b\x28"
-DATA ·d+64912(SB)/8,$"\xaf\xa9\x37\x30\xa9\x39\x0e\x97"
-DATA ·d+64920(SB)/8,$"\x30\xc6\xf4\x76\x99\x62\x19\x4d"
-DATA ·d+64928(SB)/8,$"\x33\x46\xd3\x4c\x3b\x9e\x41\xb7"
-DATA ·d+64936(SB)/8,$"\xf2\xd1\x94\x95\x4c\xc8\xe3\x9f"
-DATA ·d+64944(SB)/8,$"\x92\xf7\xc9\xfb\xe4\xe8\x64\x46"
-DATA ·d+64952(SB)/8,$"\xa2\x9b\x54\x8a\x92\xc7\xad\x7a"
-DATA ·d+64960(SB)/8,$"\xe1\xe8\xdd\xd1\xfb\xa3\xa3\x93"
-DATA ·d+64968(SB)/8,$"\x56\x2e\xfe\x68\x69\x32\x6b\x29"
-DATA ·d+64976(SB)/8,$"\xfa\x07\x1e\xbf\x39\x69\x89\xcd"
-DATA ·d+64984(SB)/8,$"\xd1\xfa\xd3\x79\x24\xaf\x53\xe1"
-DATA ·d+64992(SB)/8,$"\x40\x4c\x34\x69\x55\x15\x6f\x2a"
-DATA ·d+65000(SB)/8,$"\x1c\xaf\xda\xcb\x05\xe1\x77\x4c"
-DATA ·d+65008(SB)/8,$"\x79\xf7\xf3\xbb\x57\x3f\xa3\xe3"
-DATA ·d+65016(SB)/8,$"\xb9\x6e\x2a\x8e\x57\x18\xad\xab"
-DATA ·d+65024(SB)/8,$"\x1b\xaa\x8d\xef\x1a\x25\x27\xcc"
-DATA ·d+65032(SB)/8,$"\xb5\xf1\xed\xb8\x46\x8c\xb7\x4b"
-DATA ·d+65040(SB)/8,$"\x58\x4d\xb2\x71\x88\xdc\xa7\x4d"
-DATA ·d+65048(SB)/8,$"\x3e\x1c\xbd\x7d\x77\xe4\x78\x2b"
-DATA ·d+65056(SB)/8,$"\x2c\x2b\x96\x5d\xa4\xed\x76\xbb"
-DATA ·d+65064(SB)/8,$"\x26\xab\x0f\xae\xad\xa8\xc7\xf4"
-DATA ·d+65072(SB)/8,$"\xf6\x4e\xec\x27\x25\x87\x8d\x5d"
-DATA ·d+65080(SB)/8,$"\x55\xfb\x76\x3f\x80\x26\x7c\xdb"
-DATA ·d+65088(SB)/8,$"\x9c\xf6\x98\x82\x52\x99\x23\x8b"
-DATA ·d+65096(SB)/8,$"\xea\xff\xe6\xf8\x3e\x03\x66\xf2"
-DATA ·d+65104(SB)/8,$"\x16\x8f\x8e\xd0\xf1\x72\x42\xf9"
-DATA ·d+65112(SB)/8,$"\x92\xae\xf1\x64\xfc\x68\x6e\x9e"
-DATA ·d+65120(SB)/8,$"\x39\x4b\xfa\x83\xf5\x85\xf0\x00"
-DATA ·d+65128(SB)/8,$"\x0f\xbe\x8d\x67\xef\xde\x38\xde"
-DATA ·d+65136(SB)/8,$"\x8b\x9f\x5e\xbf\x39\xe1\x68\x9e"
-DATA ·d+65144(SB)/8,$"\x52\xba\xb0\x83\xcd\xb8\xee\xc5"
-DATA ·d+65152(SB)/8,$"\x9d\xe4\xfa\xef\x31\x6e\x84\xdf"
-DATA ·d+65160(SB)/8,$"\xdd\x4a\xec\x7b\xe2\x0e\x1f\x77"
-DATA ·d+65168(SB)/8,$"\xfe\xed\xdb\xf8\x03\x26\x8e\x97"
-DATA ·d+65176(SB)/8,$"\x94\x3c\x7a\x26\xa4\x07\x87\xf0"
-DATA ·d+65184(SB)/8,$"\xff\x56\xee\x0f\xbb\x18\x0c\x30"
-DATA ·d+65192(SB)/8,$"\x35\x43\x7b\x17\xdd\x25\xe1\x31"
-DATA ·d+65200(SB)/8,$"\xc3\xf3\xb5\x19\x07\x4f\x02\xe0"
-DATA ·d+65208(SB)/8,$"\x6e\x3a\xda\xdc\x69\xb6\x81\x65"
-DATA ·d+65216(SB)/8,$"\x1f\x2b\xa6\x28\x6f\xf1\x32\x0c"
-DATA ·d+65224(SB)/8,$"\xc7\x35\xf1\xe1\xe3\x0e\x2e\x91"
-DATA ·d+65232(SB)/8,$"\xa3\xc9\x3e\xb8\xa1\x94\xfb\x1c"
-DATA ·d+65240(SB)/8,$"\x0c\xc7\xdd\x7d\x84\xed\x8b\xe9"
-DATA ·d+65248(SB)/8,$"\x90\x2a\x8d\xbc\xc7\x63\xeb\xd6"
-DATA ·d+65256(SB)/8,$"\xde\xb8\x1e\x7f\x78\xf9\xe1\xd5"
-DATA ·d+65264(SB)/8,$"\xd3\xd8\x2e\x01\xe0\x94\x2d\xd1"
-DATA ·d+65272(SB)/8,$"\x3a\xf9\x27\x30\xf8\x71\x27\x06"
-DATA ·d+65280(SB)/8,$"\xf7\xb5\x2e\xb3\x70\x67\x1a\x26"
-DATA ·d+65288(SB)/8,$"\xb9\xde\x07\xb1\xb1\xa4\x5c\x33"
-DATA ·d+65296(SB)/8,$"\xbe\x0b\xaa\xfb\xde\x1c\xda\xdb"
-DATA ·d+65304(SB)/8,$"\xe1\x87\x1f\xfe\xb4\x76\xfc\xb9"
-DATA ·d+65312(SB)/8,$"\xfb\x48\xef\xb8\xa6\xa4\x7a\x76"
-DATA ·d+65320(SB)/8,$"\xc1\xba\x55\xf3\x3f\x56\xac\xee"
-DATA ·d+65328(SB)/8,$"\xf7\xec\xfd\xae\x72\xb5\x53\x78"
-DATA ·d+65336(SB)/8,$"\xeb\x27\xc9\xfa\xed\x51\x95\x18"
-DATA ·d+65344(SB)/8,$"\x56\x85\x91\x11\x09\x6e\xaa\x7c"
-DATA ·d+65352(SB)/8,$"\x09\x33\x4c\x84\xbd\x54\x88\xb4"
-DATA ·d+65360(SB)/8,$"\x55\x73\x75\x11\xed\xac\x20\xcb"
-DATA ·d+65368(SB)/8,$"\x65\xc5\xb9\xa3\xa9\xf1\x59\x23"
-DATA ·d+65376(SB)/8,$"\x96\x73\xe4\xa6\x8e\x9c\x51\x6e"
-DATA ·d+65384(SB)/8,$"\x6e\x10\xfb\x16\xad\xbf\x2b\xfb"
-DATA ·d+65392(SB)/8,$"\x2d\xda\x4f\x08\x92\xe4\x66\xc1"
-DATA ·d+65400(SB)/8,$"\x98\x6b\xeb\xba\x1b\xc7\xab\x7e"
-DATA ·d+65408(SB)/8,$"\xf7\x67\xcf\x3f\xd3\x99\x12\xdc"
-DATA ·d+65416(SB)/8,$"\x45\x15\x39\x1e\xaa\x68\x7f\xce"
-DATA ·d+65424(SB)/8,$"\x0b\x44\xa9\x7f\x11\x19\x31\x3e"
-DATA ·d+65432(SB)/8,$"\xb4\x25\x8d\xd0\x38\x60\x3f\xf6"
-DATA ·d+65440(SB)/8,$"\x17\x92\x8a\x59\x99\x24\x84\x09"
-DATA ·d+65448(SB)/8,$"\xd7\xdc\x03\xb2\xba\x1b\xe5\x06"
-DATA ·d+65456(SB)/8,$"\xbf\x5b\xfe\x9d\x5c\x7b\xa8\xef"
-DATA ·d+65464(SB)/8,$"\xf1\x58\xca\xed\xdf\x23\xf9\xde"
-DATA ·d+65472(SB)/8,$"\xb4\xbb\x32\xa5\x6c\xa5\xc2\x3c"
-DATA ·d+65480(SB)/8,$"\x73\xb4\x78\x4e\xc2\x68\xc1\xd5"
-DATA ·d+65488(SB)/8,$"\xcd\xc2\xf1\x02\x7a\x43\x35\x81"
-DATA ·d+65496(SB)/8,$"\xb1\x14\x37\xa2\x10\xb7\xab\xd7"
-DATA ·d+65504(SB)/8,$"\xb2\x79\xc9\x3e\xcd\xef\x9e\x53"
-DATA ·d+65512(SB)/8,$"\x49\xfa\x22\x46\xc7\x9b\xa1\xd2"
-DATA ·d+65520(SB)/8,$"\x90\x98\xb7\x48\x22\x78\xf5\xfc"
-DATA ·d+65528(SB)/8,$"\x2f\xa4\x48\x25\xc9\x73\x94\xea"
-DATA ·d+65536(SB)/8,$"\x89\x10\xce\xe7\xf3\xf6\x67\xd4"
-DATA ·d+65544(SB)/8,$"\x33\x49\x28\x57\x46\xbe\xe3\xfd"
-DATA ·d+65552(SB)/8,$"\x82\xfa\xd4\x0e\x77\x9b\x74\x8f"
-DATA ·d+65560(SB)/8,$"\xd3\x4d\x85\x5b\x5b\x73\x21\xc0"
-DATA ·d+65568(SB)/8,$"\x3f\x1b\x3c\x27\x7b\xb2\x32\x15"
-DATA ·d+65576(SB)/8,$"\x54\xb8\xe6\xc7\xf1\x2e\x4b\xf3"
-DATA ·d+65584(SB)/8,$"\x72\xa8\x95\xd7\x8f\x2e\x5b\x34"
-DATA ·d+65592(SB)/8,$"\xd7\xf5\xa6\xd9\xb8\xc8\x53\xca"
-DATA ·d+65600(SB)/8,$"\x71\x3f\xf1\x04\xa5\xc8\xca\x99"
-DATA ·d+65608(SB)/8,$"\xe3\x8d\xc9\x2d\x32\xf8\x95\xf0"
-DATA ·d+65616(SB)/8,$"\x85\xca\x70\x0d\xf9\x1e\xbc\xd6"
-DATA ·d+65624(SB)/8,$"\xb2\x56\xd5\x4f\x89\x45\xd4\xd2"
-DATA ·d+65632(SB)/8,$"\x19\xe6\xe6\xfa\x7b\x60\xf6\xbb"
-DATA ·d+65640(SB)/8,$"\x93\xfe\x6e\x8b\xee\xb1\x84\x7f"
-DATA ·d+65648(SB)/8,$"\x76\x2b\xef\xfb\xba\x03\x9d\x84"
-DATA ·d+65656(SB)/8,$"\xa6\xa5\x5c\x15\xce\x11\x9a\x42"
-DATA ·d+65664(SB)/8,$"\x0f\xe3\x0d\x3b\xb7\x3b\x7e\x34"
-DATA ·d+65672(SB)/8,$"\x4f\x41\xc9\xa8\xeb\xd8\xfe\x91"
-DATA ·d+65680(SB)/8,$"\x72\xb7\xcd\x6d\x7f\x2e\x52\x07"
-DATA ·d+65688(SB)/8,$"\x08\xd3\x5d\xa7\x2f\x05\xd1\x94"
-DATA ·d+65696(SB)/8,$"\x34\xe1\xe8\xe5\xab\xd7\xab\xf6"
-DATA ·d+65704(SB)/8,$"\xf8\x06\x38\x09\x4d\x23\x52\xac"
-DATA ·d+65712(SB)/8,$"\xc1\xab\xb7\xe1\xea\x7b\x4b\xc0"
-DATA ·d+65720(SB)/8,$"\xc3\xfd\xca\x87\x3a\x92\x85\x57"
-DATA ·d+65728(SB)/8,$"\xcb\x77\xef\x2a\x68\xd8\xa9\xd2"
-DATA ·d+65736(SB)/8,$"\xb6\x24\x9e\x1d\xbe\x55\x43\xf4"
-DATA ·d+65744(SB)/8,$"\xb1\xb8\x3d\xd4\x31\xfd\x07\x6e"
-DATA ·d+65752(SB)/8,$"\x42\xb8\xf2\x43\xa8\x45\xc2\xc1"
-DATA ·d+65760(SB)/8,$"\x95\x1f\x1e\x2e\x3b\x11\x5e\x5f"
-DATA ·d+65768(SB)/8,$"\x14\x0b\x69\x5e\x56\xf0\x22\x12"
-DATA ·d+65776(SB)/8,$"\xc5\xe2\xc4\x00\xf5\x0e\x7a\x0c"
-DATA ·d+65784(SB)/8,$"\xbf\xe0\x02\x02\x42\xe3\x85\xe0"
-DATA ·d+65792(SB)/8,$"\xe6\x08\xaa\xc9\xc7\x28\x73\xaa"
-DATA ·d+65800(SB)/8,$"\x94\x75\x48\x81\xb9\x63\x67\x0b"
-DATA ·d+65808(SB)/8,$"\x48\x25\xe1\x1a\xe3\x66\x75\xd4"
-DATA ·d+65816(SB)/8,$"\x88\x04\xa2\x8c\xc8\x14\x9b\xc6"
-DATA ·d+65824(SB)/8,$"\x3c\x73\x1e\x16\x28\x95\xe0\x20"
-DATA ·d+65832(SB)/8,$"\x66\x9a\x50\x5e\xf5\x18\x8d\x2e"
-DATA ·d+65840(SB)/8,$"\x10\x49\x43\x67\x54\x81\x12\x89"
-DATA ·d+65848(SB)/8,$"\x9e\x9b\xab\xda\x9c\xc5\x44\x29"
-DATA ·d+65856(SB)/8,$"\x11\x51\xa2\x31\xbe\xd3\x7f\x4c"
-DATA ·d+65864(SB)/8,$"\x28\x43\x05\x07\xe6\xc4\x79\xc1"
-DATA ·d+65872(SB)/8,$"\xe2\xdf\x4b\x71\x32\xad\xf9\x5e"
-DATA ·d+65880(SB)/8,$"\x48\x3b\x3c\xb4\x0a\x63\x24\x0c"
-DATA ·d+65888(SB)/8,$"\x28\x6f\x18\xba\x25\x01\xcc\xa9"
-DATA ·d+65896(SB)/8,$"\xce\x44\xa9\x41\xa2\xd2\x92\xda"
-DATA ·d+65904(SB)/8,$"\x80\x34\x81\xf2\x88\x95\xb6\xea"
-DATA ·d+65912(SB)/8,$"\x58\x2e\x33\x9a\xd3\x5a\x9b\x61"
-DATA ·d+65920(SB)/8,$"\xb7\xb8\x98\x52\xa1\x51\x2a\x6c"
-DATA ·d+65928(SB)/8,$"\x5a\x9b\x9b\x90\x8b\x98\x26\xe6"
-DATA ·d+65936(SB)/8,$"\x17\xad\x8b\x45\x39\x63\x54\x65"
-DATA ·d+65944(SB)/8,$"\x4d\x88\xa9\x11\x3d\x2b\x35\x36"
-DATA ·d+65952(SB)/8,$"\x41\x99\x49\x8b\x76\xd3\xf8\xe4"
-DATA ·d+65960(SB)/8,$"\x9a\xb3\x0a\x19\x33\x12\x28\xaa"
-DATA ·d+65968(SB)/8,$"\xca\xef\xb5\x75\x96\xc6\x98\x5e"
-DATA ·d+65976(SB)/8,$"\x18\x70\x75\x0d\x97\x2d\x51\xe6"
-DATA ·d+65984(SB)/8,$"\x99\xc8\x61\xcb\x13\xaa\x20\x29"
-DATA ·d+65992(SB)/8,$"\x25\xa7\x2a\xb3\xcd\x46\x88\x05"
-DATA ·d+66000(SB)/8,$"\x28\xd1\x6c\xa8\x72\x66\x3b\xbc"
-DATA ·d+66008(SB)/8,$"\x75\x8b\x36\x11\x8c\x89\xb9\x71"
-DATA ·d+66016(SB)/8,$"\x2d\x12\x3c\xa6\xc6\x23\xb5\xea"
-DATA ·d+66024(SB)/8,$"\x3e\xd9\x8c\x20\x33\x71\x8b\xd6"
-DATA ·d+66032(SB)/8,$"\xa5\x2a\xfa\x5c\x68\x1a\x55\x11"
-DATA ·d+66040(SB)/8,$"\xb0\x31\x29\xd6\x81\xae\x97\x54"
-DATA ·d+66048(SB)/8,$"\x46\x18\x83\x19\xd6\xb8\x61\x0c"
-DATA ·d+66056(SB)/8,$"\x94\x03\x61\xac\xb1\xf4\x4a\x1a"
-DATA ·d+66064(SB)/8,$"\xbf\x95\x26\x5c\x53\xc2\xc0\x3c"
-DATA ·d+66072(SB)/8,$"\x53\x8d\x5a\xdb\xf4\xdd\xf0\xa0"
-DATA ·d+66080(SB)/8,$"\xbd\x36\xe3\x72\x00\xd3\xd1\x79"
-DATA ·d+66088(SB)/8,$"\xf8\xb1\x37\x19\x80\x3f\x85\xf1"
-DATA ·d+66096(SB)/8,$"\x64\xf4\x9b\x7f\x36\x38\x5b\x86"
-DATA ·d+66104(SB)/8,$"\xb7\x37\x05\x7f\x5a\xc7\xb6\x09"
-DATA ·d+66112(SB)/8,$"\x1f\xfd\xf0\x72\x74\x1d\xc2\xc7"
-DATA ·d+66120(SB)/8,$"\xde\x64\xd2\x0b\xc2\x4f\x30\x3a"
-DATA ·d+66128(SB)/8,$"\x87\x5e\xf0\x09\x7e\xf5\x83\xb3"
-DATA ·d+66136(SB)/8,$"\x26\x0c\xfe\x6f\x3c\x19\x4c\xa7"
-DATA ·d+66144(SB)/8,$"\x30\x9a\x34\xfc\xab\xf1\xd0\x1f"
-DATA ·d+66152(SB)/8,$"\x9c\x35\xc1\x0f\xfa\xc3\xeb\x33"
-DATA ·d+66160(SB)/8,$"\x3f\xb8\x80\xd3\xeb\x10\x82\x51"
-DATA ·d+66168(SB)/8,$"\x08\x43\xff\xca\x0f\x07\x67\x10"
-DATA ·d+66176(SB)/8,$"\x8e\xc0\x28\xaf\x45\xf9\x83\xa9"
-DATA ·d+66184(SB)/8,$"\x11\x76\x35\x98\xf4\x2f\x7b\x41"
-DATA ·d+66192(SB)/8,$"\xd8\x3b\xf5\x87\x7e\xf8\xa9\x09"
-DATA ·d+66200(SB)/8,$"\xe7\x7e\x18\x0c\xa6\xd3\xc6\xf9"
-DATA ·d+66208(SB)/8,$"\x68\x02\x3d\x18\xf7\x26\xa1\xdf"
-DATA ·d+66216(SB)/8,$"\xbf\x1e\xf6\x26\x30\xbe\x9e\x8c"
-DATA ·d+66224(SB)/8,$"\x47\xd3\x01\xf4\x82\x33\x08\x46"
-DATA ·d+66232(SB)/8,$"\x81\x1f\x9c\x4f\xfc\xe0\x62\x70"
-DATA ·d+66240(SB)/8,$"\x35\x08\xc2\x36\xf8\x01\x04\x23"
-DATA ·d+66248(SB)/8,$"\x18\xfc\x36\x08\x42\x98\x5e\xf6"
-DATA ·d+66256(SB)/8,$"\x86\x43\xab\xaa\x77\x1d\x5e\x8e"
-DATA ·d+66264(SB)/8,$"\x26\xd6\xbe\xfe\x68\xfc\x69\xe2"
-DATA ·d+66272(SB)/8,$"\x5f\x5c\x86\x70\x39\x1a\x9e\x0d"
-DATA ·d+66280(SB)/8,$"\x26\x53\x38\x1d\xc0\xd0\xef\x9d"
-DATA ·d+66288(SB)/8,$"\x0e\x07\x60\x55\x05\x9f\xa0\x3f"
-DATA ·d+66296(SB)/8,$"\xec\xf9\x57\x4d\x38\xeb\x5d\xf5"
-DATA ·d+66304(SB)/8,$"\x2e\x8c\x75\x13\x18\x85\x97\x83"
-DATA ·d+66312(SB)/8,$"\x89\x25\xab\xad\xfb\x78\x39\x30"
-DATA ·d+66320(SB)/8,$"\x53\x0d\x3f\x80\x5e\x00\xbd\x7e"
-DATA ·d+66328(SB)/8,$"\xe8\x8f\x02\xe3\x46\x7f\x14\x84"
-DATA ·d+66336(SB)/8,$"\x93\x5e\x3f\x6c\x42\x38\x9a\x84"
-DATA ·d+66344(SB)/8,$"\x2b\xd6\x8f\xfe\x74\xd0\x84\xde"
-DATA ·d+66352(SB)/8,$"\xc4\x9f\x1a\x40\xce\x27\xa3\xab"
-DATA ·d+66360(SB)/8,$"\x26\x18\x38\x47\xe7\x86\xc4\x0f"
-DATA ·d+66368(SB)/8,$"\x1a\xfd\x51\x10\x0c\x2a\x29\x06"
-DATA ·d+66376(SB)/8,$"\x6a\xd8\x8a\xce\x68\x62\xc7\xd7"
-DATA ·d+66384(SB)/8,$"\xd3\xc1\xda\x96\xb3\x41\x6f\xe8"
-DATA ·d+66392(SB)/8,$"\x07\x17\x53\xe3\xf1\x26\xf1\x93"
-DATA ·d+66400(SB)/8,$"\xcd\xee\x8e\x5b\xfd\x97\xb2\x63"
-DATA ·d+66408(SB)/8,$"\xff\xb5\xeb\xc1\x7f\x02\x00\x00"
-DATA ·d+66416(SB)/8,$"\xff\xff\x13\xfc\x00\x07\x48\x1e"
-DATA ·d+66424(SB)/8,$"\x00\x00\x00\x00\x00\x00\x00\x00"
-GLOBL ·d(SB),RODATA,$66432
+DATA ·d+0(SB)/64,$"\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xffT\x90\xb9N\xf40\x14\x85{?\xc5\xfdo\x9f\xf1\xe8\x9f)\x90\xb0\xdd\x00\x12\x1d#\x96\x82\xd2$'\x8bp\xecL|\x83\xc8\xdb\xa3,\x05T^\xee\xf1\xa7\xef\xd8\xfc\xbb\x7f\xba{}"
+DATA ·d+64(SB)/64,$"\xbf<P+}p\xca,\x0bu\x95\xe5e\xc3\x14|l,#\x16o/\xbcL\xe1+\xa7\x88\x88L\x0f\xf1\xd4\x8a\x0c\x05\xaeS\xf7e\xb9LQ\x10\xa5\x90y\x00\xd3~\xb2,\xf8\x16\xbd\xc0n\xcb\xd6\x8f\x19b'\xa9"
+DATA ·d+128(SB)/64,$"\x8b\x1b\xde1\xd2I\x80;\x1f\xcft\xf1\x0d(&\xa1:M\xb12z\x9bl\xa9\xd0\xc5O\x1a\x11,g\x99\x03r\x0b\x08S;\xa2\xb6\xac\xcb\x9c\xf5z}(sf\xd2Nmr\xd1\xf7\xb0\xdc b\xf4\x92\xc6_N"
+DATA ·d+192(SB)/64,$"\x8fS\x93\xe8x8\xfd?\x9c\xb6\xbc\xde\x8a)\xf3\x91\xaa\xd9)\x93QJ\x97\xe2\xfa\x111\xc9j\xc4N\xad2\xcf\xb8N\xc8\x82\x8a\x86?\xc6J\x19\xbd\xbf[\x88;i\xad\xee~\x00\x00\x00\xff\xff\x03\x00\x0d#\x18\x8c"
+DATA ·d+256(SB)/64,$"g\x01\x00\x00\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9cVMo\xa3H\x13\xbe\xf3+J\x89F3#\x81\x0d\xd8\x89\x12|\x99\xbc\xa3D\xefJ;\x97\x8d\xf6\xbcj\x9a\xc2\xf4\xa6\xe9b\xbb\x9b\xc4\x1e\xcb\xff}\xc5\xa7\x01c"
+DATA ·d+320(SB)/64,$"\x8f\xb4\xe2Dw}>\xf5TU;\xdfD^\x90\xb6Pj\xf9%\xb3\xb60\xd1r\xc9\x13\xb5\xd0\xecc+\xec\x82S\xbe\xb4\xa4\xcc\xdb~\xf9\x224\xfbN\x09.\x83E\xe8\xaf\x97\x890V/S\xa1\xd9_\x9c\x12\x5c"
+DATA ·d+384(SB)/64,$"pc\xben\x1c'\xa6d\x0f\x07\x07\x00 %e=#~b\x04\xc1}\xb1\xdb\xd4\x87R(\xf42\x14\xdb\xccF\x10,\xee7'\xd1\x94\xe5B\xee#\xf0XQH\xf4\xcc\xdeX\xcc]\xf8\x9f\x14\xea\xed\x07\xe3\xaf\xf5"
+DATA ·d+448(SB)/64,$"\xff\x0b)\xeb\xc2\xcd+n\x09\xe1\xcf\xdfn\x5c\xf8\x83b\xb2\xe4\xc2\xffQ\xbe\xa3\x15\x9c\xb9\xf0\xa4\x05\x93.\x18\xa6\x8cgP\x8b\xd4\x85\x9b\xa7\xca(|'I\x1a\x9es\xfa[\xdc\x0c\xcc\xcc\x9c\xbc\xee\xf3\x98\xe4M\x13"
+DATA ·d+512(SB)/64,$"\x1f\xaf\xd4\x22\xb8\x0d\xd7\xd5\xd7\x9c\xe5l\xe7}\x88\xc4f\x11<\xf8~\x97_\xce\xf4V\xa8\x08\xfcO\xc0JK\x1b\xe7\xe8\xa4D\x16u\x0b\x8a\xc5\x9d\xf5\x98\x14[\x15\x01GeQW\x22Y\xe0B\x16\xba\x90\xad\x5c\xc8"
+DATA ·d+576(SB)/64,$"\xd6.dw.d\x0108\x8c\xdd\xdf\xaf\x9e\xd6\x0f\x03\xc8>Z \xef|\x7fs2\x9f '\xcd\xac \x15\x81\x22\x85'\x0f\xe7\x95\x09\xfb\xca\xc4\xa4\x13\xd4^L\xd6R\x1eAP\xec\xc0\x90\x14\x09\xdc\x22bea"
+DATA ·d+640(SB)/64,$"N;\xec\xb4\x0b\x96$Bm{u\x7fq\x8fy\xedx5C\x87\x871\x5c\x03\xadU\xab\x15\x80\xc9\x99\x94g\x10<><>>~\xdf\x9cY\xbc+v\x83\xc3\x0e\x17E:g\xb2\xbd\x90\xc4l\x04\xba\xbaic&"
+DATA ·d+704(SB)/64,$"#\x1a\x94XlH\x96\x16[\x10\xa9\x18Z\xd4\x8d\xad\xb0\xae\xf1\xd11\xc8+\xa56\xae\x98\xf1\xb7\xad\xa6R%\x11\xdc\xa6i:\xb5\xacQ2+\xde\xeb\x1a\xdcJ\xc1Q\x19\x9c\x01\xc4/l%\xe1,\x1aN`\xe2\x15"
+DATA ·d+768(SB)/64,$"\x82\xdbR\xe3U\xda8\xb1$\xfe\xf6OI\xb6\x93k\x8b(1\xb5\x11\xacN%L\xee\xd6\x18\x06\x9b\xcb}\xd9\xd6/\x02\xbf\xfe\xc2\x9e\xd0\x1d\xf0\x9d\x85\xe3\xd0\xe9\xb4:gnF\x04\x1d\xa9VC\xe3\x82\xf6\xd1)4"
+DATA ·d+832(SB)/64,$"\x9e\xe1\xebub\xa9\x9f\xae\xd2\xd5$\xec\x00\xf3\xe6d\xc2\xa8\xa0\xe1S\xe5\xcd\x05.\xec\x08\xfcn\xe2\xdcT\xb3\x0d\xaa\xe1V\x8d\x80\xdfK.\x92\xeaW\x19\x92\xd5\xc9\x0fR\x8c\x93\x0b9)2\x05\xe38;\xca\xea\x04"
+DATA ·d+896(SB)/64,$"\xa7\x80\x04\xf8\xc0c\xbc\xd0\x9b\xa5JPWVj\xcd(\xa3w\xd4\x13\xfd\x13$\xa5l\xaf\xa40\xd63v/\xb1\xeb\xeea\xff5\x95\xafc\xa1Y\x8d2\x8f\x1b\xf2\x90\x04)\xe00\x04\xadQ\xf6Z\xccj\x81H2"
+DATA ·d+960(SB)/64,$"c=\x9e\x09\x99\xc0a\x0e`\xbf\x0d\xae\x8f\xaf\x8b\xa4\xee\x22\x7fsA\xe7\xcc\xeb\xfa\xd3\xc9\x90\x14Q\x8c)\xe9\x13A\x94Ee#\xf8\xec}nT\x13a\x0a\xc9\xf6\x11\x08U\x97\xa1&\xd6\x18\x89\xaemk\xbbN)"
+DATA ·d+1024(SB)/64,$"\x17\x9c\xa4\x17^\x19'\xde\x07\xc6o\xc2V<+s\xe5q*+\x97a{\x99\xd3\xcf\xf9\x9by\xf1\xb1\xad-+\xa2AO\x0d\x8dM\xae\xceO\x8f\x8e\xf3-\xc7D00\x5c#*`*\x81/\xb9P\xdd\xf6\xb9\xab"
+DATA ·d+1088(SB)/64,$"\xb6\xcf\xd76\xb1I\x9e\x97\xf3ZmN\x02\xe7\xb9\x0dn/\xab]\xcd\xf1\x17y\xce\xe7\x0a\x00pt\x8e\x8eb\xefs\xf3\xf5\xc5\x7f\x09^\xda\x08\x06\x00\x84\xa7Y=!\xda\xf4|0\xd9k'Q*\xb4\xb1\x1e\xa5\x9e"
+DATA ·d+1152(SB)/64,$"\xdd\x17\xd7\xc7Y;Y5KDiZ\xda\xcfX\xf8E\x17\xcfi\x8c\xa8>3\xefF\xda\x0b\x96\xa6b\x07\x87\xc9\x9aI\xc5\x0e\x93\xc1\xf6\xea\xa8\xd3k\xb4\x90\xc0\xe1\xe2\xe6\xab\x85{\x08&\xe9\xae\x8a\xdd\xf9\xf2\xe8\xd1"
+DATA ·d+1216(SB)/64,$"\xed\xbbq\xd0\x86\xbc\xd4\xa6\x0a\xbf \xd1\xac\xab\xcb\x8f\x96\xb9my\xe513\x9e\xfb~\xb1\x83\xa0\x7f\x86\xb4\x8c\x08|\xff\xd3\xec4X]x\xed\x84\xa7UY/\xf1\x16\x8cKc\xe8\xf3\xc8\xdb\xfa\x1a\x0e\xfd@\xbe\xf6"
+DATA ·d+1280(SB)/64,$"\xe2\xe8\xb7H\x1fwo\xea\x94\xf3\xa8\x15F\x8b\xa5\x9d\xb4]\xd0C\x0a\xce\xf0\xe9\xf9\xfe\xf9\xe1\xf9i\xf3\x1f\xf7\xd4\xc0\xc5\x18\x9di\xf2\x9d\xa8d\x03\xb6\x1f\xe6\xc0\xef\xde\xa8\xff\x02\x00\x00\xff\xff\x03\x00\x09*\x81N\x8d"
+DATA ·d+1344(SB)/64,$"\x0c\x00\x00\xff\xd8\xff\xe0\x00\x10JFIF\x00\x01\x01\x00\x00H\x00H\x00\x00\xff\xe1\x00\x8cExif\x00\x00MM\x00*\x00\x00\x00\x08\x00\x05\x01\x12\x00\x03\x00\x00\x00\x01\x00\x01\x00\x00\x01\x1a\x00\x05\x00\x00\x00\x01\x00"
+DATA ·d+1408(SB)/64,$"\x00\x00J\x01\x1b\x00\x05\x00\x00\x00\x01\x00\x00\x00R\x01(\x00\x03\x00\x00\x00\x01\x00\x02\x00\x00\x87i\x00\x04\x00\x00\x00\x01\x00\x00\x00Z\x00\x00\x00\x00\x00\x00\x00H\x00\x00\x00\x01\x00\x00\x00H\x00\x00\x00\x01\x00\x03\xa0\x01\x00"
+DATA ·d+1472(SB)/64,$"\x03\x00\x00\x00\x01\x00\x01\x00\x00\xa0\x02\x00\x04\x00\x00\x00\x01\x00\x00\x01z\xa0\x03\x00\x04\x00\x00\x00\x01\x00\x00\x01@\x00\x00\x00\x00\xff\xed\x008Photoshop 3.0\x008BIM\x04\x04\x00\x00\x00"
+DATA ·d+1536(SB)/64,$"\x00\x00\x008BIM\x04%\x00\x00\x00\x00\x00\x10\xd4\x1d\x8c\xd9\x8f\x00\xb2\x04\xe9\x80\x09\x98\xec\xf8B~\xff\xc0\x00\x11\x08\x01@\x01z\x03\x01\x22\x00\x02\x11\x01\x03\x11\x01\xff\xc4\x00\x1f\x00\x00\x01\x05\x01\x01\x01\x01\x01\x01"
+DATA ·d+1600(SB)/64,$"\x00\x00\x00\x00\x00\x00\x00\x00\x01\x02\x03\x04\x05\x06\x07\x08\x09\x0a\x0b\xff\xc4\x00\xb5\x10\x00\x02\x01\x03\x03\x02\x04\x03\x05\x05\x04\x04\x00\x00\x01}\x01\x02\x03\x00\x04\x11\x05\x12!1A\x06\x13Qa\x07\x22q\x142\x81\x91\xa1\x08"
+DATA ·d+1664(SB)/64,$"#B\xb1\xc1\x15R\xd1\xf0$3br\x82\x09\x0a\x16\x17\x18\x19\x1a%&'()*456789:CDEFGHIJSTUVWXYZcdefghijstuvwxy"
+DATA ·d+1728(SB)/64,$"z\x83\x84\x85\x86\x87\x88\x89\x8a\x92\x93\x94\x95\x96\x97\x98\x99\x9a\xa2\xa3\xa4\xa5\xa6\xa7\xa8\xa9\xaa\xb2\xb3\xb4\xb5\xb6\xb7\xb8\xb9\xba\xc2\xc3\xc4\xc5\xc6\xc7\xc8\xc9\xca\xd2\xd3\xd4\xd5\xd6\xd7\xd8\xd9\xda\xe1\xe2\xe3\xe4\xe5\xe6\xe7\xe8\xe9\xea"
+DATA ·d+1792(SB)/64,$"\xf1\xf2\xf3\xf4\xf5\xf6\xf7\xf8\xf9\xfa\xff\xc4\x00\x1f\x01\x00\x03\x01\x01\x01\x01\x01\x01\x01\x01\x01\x00\x00\x00\x00\x00\x00\x01\x02\x03\x04\x05\x06\x07\x08\x09\x0a\x0b\xff\xc4\x00\xb5\x11\x00\x02\x01\x02\x04\x04\x03\x04\x07\x05\x04\x04\x00\x01\x02w"
+DATA ·d+1856(SB)/64,$"\x00\x01\x02\x03\x11\x04\x05!1\x06\x12AQ\x07aq\x13\x222\x81\x08\x14B\x91\xa1\xb1\xc1\x09#3R\xf0\x15br\xd1\x0a\x16$4\xe1%\xf1\x17\x18\x19\x1a&'()*56789:CDEFGH"
+DATA ·d+1920(SB)/64,$"IJSTUVWXYZcdefghijstuvwxyz\x82\x83\x84\x85\x86\x87\x88\x89\x8a\x92\x93\x94\x95\x96\x97\x98\x99\x9a\xa2\xa3\xa4\xa5\xa6\xa7\xa8\xa9\xaa\xb2\xb3\xb4\xb5\xb6\xb7\xb8\xb9\xba\xc2\xc3"
+DATA ·d+1984(SB)/64,$"\xc4\xc5\xc6\xc7\xc8\xc9\xca\xd2\xd3\xd4\xd5\xd6\xd7\xd8\xd9\xda\xe2\xe3\xe4\xe5\xe6\xe7\xe8\xe9\xea\xf2\xf3\xf4\xf5\xf6\xf7\xf8\xf9\xfa\xff\xdb\x00C\x00\x01\x01\x01\x01\x01\x01\x02\x01\x01\x02\x02\x02\x02\x02\x02\x03\x02\x02\x02\x02\x03\x04\x03\x03\x03"
+DATA ·d+2048(SB)/64,$"\x03\x03\x04\x05\x04\x04\x04\x04\x04\x04\x05\x05\x05\x05\x05\x05\x05\x05\x06\x06\x06\x06\x06\x06\x07\x07\x07\x07\x07\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\xff\xdb\x00C\x01\x01\x01\x01\x02\x02\x02\x03\x02\x02\x03\x08\x05\x05\x05\x08\x08\x08\x08\x08\x08"
+DATA ·d+2112(SB)/64,$"\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\x08\xff\xdd\x00\x04\x00\x18\xff\xda\x00\x0c\x03\x01\x00\x02\x11\x03\x11\x00?\x00"
+DATA ·d+2176(SB)/64,$"\xfc\x0d\xd5u\xbf\x08X-\xbc\xb7\x8e\x97\x0c\xe4\x9f\xb3\xb9\x12I\xc7@\xe8\xa7\x80}\xb8\xac-n\xf3B\xbe\x8f\xedj\xd2#J\xa05\xb8\x8c\xc5\x16\x07`\xa8@8\xf7\xebW\xed\xf4\xbd\x08D\xd0\xcfm\x0d\xac\xcf)"
+DATA ·d+2240(SB)/64,$"\x8eG\x8c\x04\xe0v.r\xdc\xff\x00\xbc)\x9f\xd8\xfaF\x9fd_A\xd3#\xb9\x1b\xbek\x89\xdcN\x01\x07\x9c(9\xe7\xdc\xd7\xfa\x7f^\xb4\xea'~[z]\x9f\xe5u\x07J\x9bVs\xe6\xee\xec\x97\xf9~l\xca"
+DATA ·d+2304(SB)/64,$"\xd3.th\xa2\x0bcmuf\xfc\x1f\xb4yk\x8c\x0c\x8e\x0b\x13\xc1\xf4\xc5O.\xbe\x89+M+\x5c\xdc\x14\xc0\x06f \x1c\xf5%W\x1djSm.\xadh\x22\x96\xd6\xd5Y\x18\x0d\xd2\xc7\x22\x10}\xd7pQV"
+DATA ·d+2368(SB)/64,$"\xbf\xb1\xee\xf4}5.\xed\x9dRF\x90\x94Q\x0cA\x1b\xb1\xda\xcc2}\x81\x22\xa6\x84\xda^\xee\xa9kt\xbfCy\xce\x8d\xfd\xf7\xab\xe8\xdf\xeb\xb9\xcf\xc7{\xe2\x9b\x8b\x86\xb9\x8a\xd5\x16\x07\x7f1#\x89p\x00\xed\xfc"
+DATA ·d+2432(SB)/64,$"D\xe3\xf5\xae\x98\xeb\xda\xa5\xa4\x02K\x9bX\xb3\x90\xcc\xb1\x0ewv,@\xca\x8e\xfe\x95M\xf4\xdf\x17j\xf1\xc5g<r\x98\xd8r\xcd*\xc0\xc4\x1c\xe3%\x01\xc05\xcf\x5c\xf8+R\xb9e\xd3!\x9ex\xd5\xdc\xa0\x81"
+DATA ·d+2496(SB)/64,$"\x03\x04c\xdf,\xcc\x0b}qY\xcau\xe0\x9a\xa4\xa4\xef\xdfM\xd9\xa2\x8e\x16\xa3\xfd\xeb\x8a\xb7mt\xfb\xcd\x87\xb9\xf1\x07\x90\xf7Z\x96\xa1\xa6\xda\x87o\x99\xf0\xd1\xed\x0d\xc9B\xdby$zR\xad\xe6\x83\x0c\xb0\xdd["
+DATA ·d+2560(SB)/64,$"l\x9d\xa3\x5c;\xae\xcc\xe7\xfd\x92[$\x1fLTK\xe0\xcb=3\x1au\xbd\x9b\xea7A\xc2\xb4\xf7\xac\x16\xde\x11\xc7\x1c\xe7\xb7~\x00\xab\x96\xf0iv\xf2*\xa4\xd6\x16\xce\x18\xab\xcd\x0c\xef4j@\xec\xbbp?\x0a"
+DATA ·d+2624(SB)/64,$"P\x8dH\xcb\x95\xb4\x9f\x9bm\xdf\xc9\xbd\x07Q\xd0\x94[\x83my$\xb4\xf4N\xe4\xf2\xdc\xcbq9\xba\x81-O\x98\xaa\x0f\x9f\x1a\x9d\x87\xe9\x8eH\xeeEWXN\xa9/\x91n2\xe5\x8a\x07\x8br\xc4\xc3\xb9\xc2\xaf"
+DATA ·d+2688(SB)/64,$"n\xe35\xb9<\xc5\xed\xd2M%&\x9cG\xf3M=\xd0p\x92\x11\xd3\xcbV\xe4\xfe#\x15\x87\x7fos\xaa_\xc5\x1c\xcc\x84\xb4{\xfeI\x0a\xe3\xf0\x18\xc7\xa5z\x15\xed\x0d\xe5\x7f\xeb\xb9\xc1\x87w\xbe\x96\xfd=NS"
+DATA ·d+2752(SB)/64,$"R\xf0\xd4w78\xd6f\xb9\x95\xc1\xfd\xdcK\x09\xf2\x868\xca\x81\xc1\xfa\x9ad:~\x93\x05\xe2\xda\xd9\x5c\x5cH\xc0m\x05\xd6DU?A\x90~\x80WW\x0cI\xa7\xea_f\x91\xa6\xb6a\xde)\xd6ef\x1d\x01"
+DATA ·d+2816(SB)/64,$"`\xa4\x0fZ\xd5\xb8\x81,\x18\xdc\xdc\x5c\xbc\x97 \x92\x8c&\x85c\xcbz\x90\x01\xcf\xady\x94\xe8\xd2\xbbkF\x9e\xbf\xd5\xeez\xb2\xccj[\x92\xed\xe9\xa7\xf4\x95\xbf\x135-u\x9f5m\xec\xaf\x18\xa2|\xdet\x92"
+DATA ·d+2880(SB)/64,$"\x98\xd4\x129\xc2\xb2\xa1\xfeu\xc1j\xbe+\xf8\x7f\x1c\x8f\x1e\xa7\xfe\x9ds\x0f\xee\xcb\xaa\x9d\x921\xfe,\xb1*W\xd7\xd7\xb5uZ\x8e\x8b\xe2]r3\xf6\xd9\xad\xe2\x89\xc6X\xc4Y\x8b\x0fy\x0e\x17\xf2\xe2\xb3\xf4/"
+DATA ·d+2944(SB)/64,$"\x03ZO\x11\x9a\xc0\xd8\x98\xd4d\xde\x15\xf3\x08\xday\xda\x5c\x85\xc8=~Z\xe7\xc7J\xbdi{<2J\xff\x00\xcd\xaf\xdc\x9b\xfcN\x9c\x14\xf0\xd4\xa2\xe7\x89\x9e\xdf\xcb\xa7\xdf$\xbf\x04P\xd0.\xfc\x0f<Ov\x91"
+DATA ·d+3008(SB)/64,$"\x88\xceA\x1f\xba\xe0\x13\xdb'8\x03\xdb\x9a\xd5]2\x1dV\x06[(\xee%\x00\xe0\xc9\x12cw\xb0g -$\x96\x1a^\x9c\xe6Qpo\xee3\xb5>p\xaa\x98\xf6\x5c.\x7f:]F\xfb_wTe\x11\x06`"
+DATA ·d+3072(SB)/64,$"Ds\xee}\xdcvH\xc0\xe9\xf5\xaa\xa5I\xd3\xa5\xc9Z*\xeb\xb2\xff\x00\x83b\xaa\xd4s\xa9\xcfE\xbd\x7f\x99\xfe\x9b\xfeF\x0c\x9a\x16\xadc9\x8c$\xc8\xb26\xc0E\xc23\x1fb\xe4\xf1\x8e\xf8\x02\xb7-t\x18f"
+DATA ·d+3136(SB)/64,$"\xd9%\xe4\xb2\xbb\xee\xe2'v\x9bi\xf5\xf9\x9bi\xfeUB}+_K\x9f>\xf2t\x85f9ExX\xa0Q\xd3\xe5\xe1\xb0=I\xcf\xad[\x8e\xe7Irb\xd65\xc9]\xd50\xb1\xa6\xd4F\x1e\x8b\xc9\xfc\x8da"
+DATA ·d+3200(SB)/64,$"G\xd9\xc6O\x9a6\xf2m#j\xf5j\xce7\x8dD\xff\x00\xc2\x9f\xfc\x1f\xcc\xd8\x90\xe9\xb6\xad\x0d\x94W-\x13I\x97y%`\x08\xf6\x1d\x80\xc7l\xe2\xac]G\xa2\xc3\x12\xea7\x173E\x1bp\xab\x1a\x152\x1c`"
+DATA ·d+3264(SB)/64,$"\xb0d\x04\xfdMr\xb3h\x96O'\x9f\xa6\xc1u;\xe1\x0cR\xb9\xde\xaa\xc4\xf2\x0e\x01\xc8\xc7\xe3\x9a\xbe\xb6\xf8\x9aK4\x8031\x05\x83E#\xb2\xb7|\xe4\xfd\xd2~\x9e\xd5\xd8\xf1\xcf\xdeR\x8a\xf2\xdf\xfe\x01\xc4\xf0"
+DATA ·d+3328(SB)/64,$"\xd1\xbc\x5cf\xfc\xf6\xfe\x90\xe9%\xd3\xe5\xb7h\xb4\xe7\x97\xca\x94\x81+;\x82\xddz3\x12O\xe7\x8a\xb1m\xa6\xbcg\xe4\x89\xee\x13\x80\xc7%\x80\xeb\xdfq\xce{\x0c\xd2[46\x10\xcb\x06\xaea\x89[\x0f\xb9U\x8a"
+DATA ·d+3392(SB)/64,$"\xe4\x0e\x91\x88\xf1\xd7\xa6=k/S\xf1>\x8dw\x14gP\xb9\x9e;\x87\x88\xbd\xbcm\xfb\x88\xb9\xe0)^9\xfe\xf6\x0ek\x9ex\xea\x10\x8a\x9di\xa4\xd7\xc8\xd68Z\x92\xbciE\xb5\xdf\x7f\xc4\xb1m\x0e\x9b\xa7\xb4"
+DATA ·d+3456(SB)/64,$"\x92:\x08\xe5\xde|\xb7m\xb1\x04\x07\xae~R~\x95\xa5\x00\xb0\xb3\xb6{\xf8>\xddxG1\x8bem\xa5\xcfbI\x18\xfa\x80\x01\xae^\xe3_\x9e+?&\xe2\xd2\x1b\x86Lc\xcb\x8a@\x0bc\xae\xe9\x19;v9"
+DATA ·d+3520(SB)/64,$"\xaaw~$\xf1\x0d\xab\xa5\xba[\xb4\xb1\x08\xfc\xc8\xd2\xdd\xa0R\x08\x1d\x1f\x04\xe0\x0f\xa6\x7f\x1a\xe2\xa9\x9f\xe1\xa9&\xe1\xd3\xb2\xbbW:\xd6S^\xad\x93\xfc\xed{~?\x91\xd6$\xf7\xd7\x18>M\xdcqI\x8c%\xc9"
+DATA ·d+3584(SB)/64,$"B[\xd7n[8\xcfl\xf4\xaa\x90k\x0dow<s\xc9n\x90F\x00\x0c\xa8Hr\x07R\xbc\xe0\xf3\xda\xbc\xd3F\xf1\xa5\xc6\xab\xa9\xcb&\xb7o-\x9b\xc7 1\xacJ\x1c\x1cpAy9\xf7\xe0Wg\x06\xb1\xa9"
+DATA ·d+3648(SB)/64,$"\xea\xec\xb7\x97RKo\x0c*\xc2\x17\xf2\xa2t\xdd\xfe\xca\xf3\x9e:\x96\x1cv\xaeL/\x12\xc6\xbcT\xb0\xed\xdf^\x9f}\xf6\xb2:k\xe4\x93\xa4\xda\xab\x14\x95\xbb\xfe[\xdc\xd5maoqsm$\x91\x02\x1b\xcd$"
+DATA ·d+3712(SB)/64,$"(R;`\x90F;\xf3YCS\xb7]\xb7\xcb$\x97\xca[\xcbVp\xe23\xd8\xa0\xdaB\xf1\xf8\x0a\xd4\x82\xfe\xf5\xa2\xfb\x1c>l\xf0\xb7\xcdq\xe50i\x9c\x01\xc0X\x88\xf9x\xf7\xacmOV\xb4\xbcaai"
+DATA ·d+3776(SB)/64,$"k\xac<Dl\xf2\xe5\x11\x01\x91\xd7\x01w\xf3\xeb\x91\xf8\xd3\xc6\xe3\xe4\x92s\x9e\xbe\x9d|\xba\x06\x0f\x0b\x1b\xf2\xa8i\xea\xb6\xf3\xd1;\x96\xae\xfcE\xaa=\xb2\xc1\x1d\x98\x89\xb6\x95\x5c\xa1\x07\x00\xf0\x02\xfc\xc3\xf1\xab\xba"
+DATA ·d+3840(SB)/64,$"U\x86\xbc\xd6\x8ds\x14V\xf2\xee9o<\x05ulr\x01P\x00\x03\xf3\xf5\xaeFo\x11\x99\x08\xb2\xb9\xb4\x9a\xef\xec\xcc\x22\x8a\x0b\xf3\x88\xe2\xcf`v(c\x91\xc6\x0fZY\xa5\xd5g\xbd1\x5c]\xa5\xa4HC\x98"
+DATA ·d+3904(SB)/64,$"\xa1\xb5\x0f\x91\xfe\xf3\x12p{\x109\xae8\xe7\xb0s\xf6\x8eR\x97Ek+}\xf6G\x5c\xb2\x99({8\xc60\xea\xefw\x7fKj:\xdc\x1b\x92&\x8a\xe0Iq#\xb2\xbcv\xc9\xbc\x92\xbd\x89\x1d1\xd3\x15~K"
+DATA ·d+3968(SB)/64,$"\xddbI\x0d\x9cV\xcfo\x19\xf9e\x12+\x069\xee\x14)\xc7\xe7\xf8\xd2\xc9\xe25\xb9\xb8`\xda\x98E\x88\xa9\x8d`\xb4\xdd\x1a\xc8\xbd\x8a\xedQ\x9e89\xe2\xb15+]oQ2_C\xa9\x5c[\xc7 -$\xb0"
+DATA ·d+4032(SB)/64,$"\xa0B\xd2c\xba\xabq\xd4\x0c\xf1Y\xbcd\xa3\x07\xecn\xfb\xdb\x97\xf1\xbbv\xfc\x0e\x88ay\xa5j\x96]\xaf\x7f\xd2(\xebt}<X\xc8\xf2\xdf\xe9\xfel\xbbA\x8c\xa7\xccs\xeaU\xd9F=\xbbUk\xbdF\x0b"
+DATA ·d+4096(SB)/64,$"\xeb\xe5\xb3\xbb\xb3\x11G\xbc\x1d\xec\x80\x03\x8cr\xc1[$~\x95\xc8ZA\xaa\xcbe\x05\xae\xeb#*a\xcc\xa2\xd5\x83\x19\x15\xb9&EpI\x18\xc9\xab\xcb\xa1\xf8\x97T\xbd\x8f\xfbP\xac\xb1\xa9%\xe7V\x91X\xfb\x04"
+DATA ·d+4160(SB)/64,$"-\x9e\x9e\xf5\xa5<\xca\xb4\xe1\x18R\xa4\xde\xbd\x93\xdf\xa3\xff\x002j`i\xc2r\x9c\xea\xab\xfc\xff\x00\x0b\xfeGe&\x95\x0d\xaa\x96\xb9\x931N\xc5\xd2B\xa4t\xe39\x04\x9c\x0fBx\xack\xa8.\xad\xb6\xc5\x05"
+DATA ·d+4224(SB)/64,$"\xc3\x95\x0f\xb5\x98`\x06\x1dG!y\x1e\xe2\xa9_\xdaXi6\x89l\xd1I$\xe5\xc0\x851\x22\x06\x0d\xd4\x96\xf9\x81?\x87\xe7U\x0d\xc0\xb7\xb9\x0d\xf63/\x99\xcb\xab>\xff\x00/\xb0\xc6@\xc0\x1d\xb9\x15\xd1\x88\xc6"
+DATA ·d+4288(SB)/64,$"E.G\x04\x9a\xde\xd7v\xfc\x0ciad\xfd\xf8\xce\xf7\xee\x92\xbf\xe2jI\xfe\x9fq#\x5c\xa5\xbb\xa6\xd3\x98c&8\xc1\xfe\xf9$\x8c\x9f`qY\xdfb\xd2\xa6\x94\xc8]\x99UA\x11\xc4\x0a\xed\xf6\xc0$U\xc9"
+DATA ·d+4352(SB)/64,$"\x85\x8d\xe5\xde\xed\x19ne\xb8,\x15x\xce\xd6\x5c}\xed\xc0 Q\xcf\x7fz\xab\x7f\xe2\x9b\x8d.O&\xee{7\x9cb3\x02\xa0y\x8eN~\xe8\xc1\xfcqY\xfdb\x84\x13\x9dg\xf3\xdf\xf1\xd3\xee4\xa5J\xb4\xdf"
+DATA ·d+4416(SB)/64,$"-%\xf2\xdb\xfa\xf5\x1e\xc6+\x98\xfc\x9b\x8by\x14\x01\x83*\xe0\x06\xf4<\xe3\xb7z\xd8\xb5\xd2\xee\x12\x13t\x91F\xd1\xc7\xf7\x81\x98\xee\xc7\xa1=+%\xb5\xb8\xee\x07\xd9'\xb3\xbf\x04\x06}\xd1[\xb3.\xfe\xbc0\xe8"
+DATA ·d+4480(SB)/64,$"?\xcf\x15\x15\xa4\xd3\xbd\xb3\xdd\xca\xb3\x95\x0c\x1c#\x16R\x0f\xae\xdc1>\xc7\x06\xba\xa9\xe3h\xf3{\x8f\x9b\xd3\xfe\x19\x99T\xc3\xd5\xe5\xb3\xf7\x7f\xafS\xa2\xd4\xe5\xff\x00FI!\x86(v\x9f\xde\xefuw\xc78\x1f"
+DATA ·d+4544(SB)/64,$" $\x8c\xff\x00\x93\x5c\xf3\xdej\xf2N\xd0^\xdf\xacV\xe20B\xc7\x18\x05\x94\xf5\xda\xcd\x92\xbf\x96j\xb47\xd6\xd2\xdd\xc8\xf3Ku\x01\xff\x00X\xeaC;\xb3\x7f\x09\xf9\xb6\xa8\x00{T\x1a\x9f\x88]#\x8e;e"
+DATA ·d+4608(SB)/64,$"\x95\xd5I-#\x98\x87\x98H\xe80\x0e\x07\xe6k\xcf\xc5\xe6\x0a\xa2ud\xdf\xa2\xeb\xebkhua\xf02\x8d\xa9\xc6+\xd5\xf4\xf4\xbd\xcd;s\xa6\xd8\x14\xb5\xb3\xb8\x82\xf4\x027$Re\x97<\x0eI\xe3=2j"
+DATA ·d+4672(SB)/64,$"\x0b\x9dKL\xb4\xff\x00C\x91\x18\xc8\xc87}\xd7x\xc9n\x02\xe1\x8f\x1e\xe4\xd5\x0d\x1f\xec\xf7\xe3\xcf\xce\x99\x0c\xf8<J7\xbbg\xb1\xdd\xb7\xa7\xb5tVq\xe96\x88WQ\x82IT\x1c\xac\x90\xc3\x1f\xde=IQ"
+DATA ·d+4736(SB)/64,$"\x8f\xc3\x92k,4\xab\xce)\xc5(\xae\xfd\x17\xe6\xeeUzt\xe1'\xcc\xdc\x9f\xe3\x7f\xc8\xc9\x9bV\x86&\xfbM\x9d\xb5\xd4\xac\x8c\xd83\xbf\x96\x8d\x8f\xba0\x09+\x8a\x86\xdfR\xd6\xbcF\xa6V\xde\x88\x9bX\xab\xa1"
+DATA ·d+4800(SB)/64,$"@\xa7\xa1\x03$\xee\xf69\xae\x86%\x9ew\x22\xce\xd0\x85?2\xc9<\x85Y\xc0?\xf3\xcdNG\xe4)\xf3\x19>\xd7\xe6\xba\x22l\x01\xa5\x8d\x1b\x0d\x8c\x8e\x80\x0c\xf1\xf5\xae\xc7F\xabjN\xab\xe5\xea\x92\xb5\xfc\xefk"
+DATA ·d+4864(SB)/64,$"\xe8c\xf5\x88/qS\x5c\xdd\xef{\x7f_#\x98\x9fN\xbei\xbe\xcdu\xf6\x97\x8d\x870\xe4\xa8u\x07\xa1\x0c@\xc1>\x950\xf0\xed\xc9\x19\x1az\x8c\xf3\x8c1\xc7\xe3\xbf\x9a\xbbi\xad\xac\xd7\xd25\xfc\x17R\xaa\x82"
+DATA ·d+4928(SB)/64,$"\x12`3 V\xf5S\xf3r{\x01Z\x0d\xaeiH\xc56kg\x07\x19\xfb+\x8e\x95\x9d\x18`gyJv\xf5\xff\x00\x82\x8a\xad\x88\xc5F\xca0\xfc\xff\x00F\x7f\xff\xd0\xfc\x0e\xd3\xf4=>\xdc\x08\xa6\xb6\xbaIZ"
+DATA ·d+4992(SB)/64,$"/=b\x92WYv\xae3\xc6vm\x1e\xa0\x0ei\xf3Myq\x09:j\xf9(\x85\x9e\xe28\x1c\x5cM\x1cy\xc0g\xf3\x1c\x7f\x9e\x95\x8b&\xb1\xff\x00\x09&\xa4\xb7\xe1Y\xed\xe2\xfd\xd6gs\xe50c\xc0(\xb9"
+DATA ·d+5056(SB)/64,$"i\x0f\x03\x03\x1cTZ\xae\x8a\xf7\xb7\xdeM\xe4\x08Y\xc6\xd5\xde\x0b2\xae8&1\xc8\xc0\xee\xfd\x07j\xff\x00J\xa5\x88\x95\x9f\xb2\xb5\xb6\xed\xf9#\xfc\xb1\x8d9s\xa7]\xeb\xbf{yZ\xe4\xd0][\xc9m\x0bY"
+DATA ·d+5120(SB)/64,$"\x99\x82B\xef/\xdbf1\xc2\x85\x8f\xa9}\xc0\x91\xdb\x1c\xd5\xf1\xacx\x82\xfa@c\xd4\x92xFQ\x95\x15$B{|\xe3\x0aX{g\x1d\xe9\xf6Wqi\x10\x0d\x1e\x05I\x11P\xc7\xe7\xbcqG\x18lg\xe4$"
+DATA ·d+5184(SB)/64,$"\x82\x0f\xafs\xe9Q;&\x9b\x13/\x91o89O>5.B\xfa\x92\xc5G\xe4>\xbe\xb4\xa7^\xca\xfc\xde\xb6\xbe\xff\x00\x93%F2o\xdc\xbfk\xdbo\x9a\xd1\x96\xb4\xff\x00\xed\x8f\x22\xdd\xadw2l\x91\xc6\xfc"
+DATA ·d+5248(SB)/64,$"\xa6I'\xab7\x03\x07\xa1\x1f\x91\xaa\xeb\xa9[\xbc\x91\xc7op<\xd4\x1ec!(\xe8}\x08v\xe0\x9f\xa7>\xa2\xb2\xa7\xd5\xf5\x1b\xad }\xaaP\xd0\xec\x2241\xe1\x15\xb7`\x10ZF\x0d\xf4\x18\xackU\xb8\xb2"
+DATA ·d+5312(SB)/64,$"\xb5\x8a\xe21$\x1298e\xc6\xf23\xc6\xdcn*=\xf3Y\xac\xc1\xc9F0M\xe9}\x7fC\xa1e\xcb\xde\x94\xed\xbd\x95\xbe{\xdfs\xad\xb8-\xa8\xc0\xf6\x8b\x7f,\xa2U\xfd\xfe\xd8\xf7\xa9\x00\x8e06\xe0\x7f?"
+DATA ·d+5376(SB)/64,$"\xa5p\xf0kG@\x94\xdb\xe9\x96\x91\xa3\xc7)E\xbatGp\x7f\xdc%\x861\xe9\xcdt\x17P\xdbX\xcd\x04w\xd1\xca\x90\x90YXH\x85\xe4|dd1\x04\x02{\x91[6\xb6\xf6\xc4\xbc\xae\x91\xc4\xce\xe0#\x0d"
+DATA ·d+5440(SB)/64,$"\xd3\x10\xbd\x80\xc0#\xeb\xc85u \xeaIZ|\xadi\xa5\xff\x00Q\xd3\xad\x1aPi\xc3\x99?O\xd3\x7f\xc6\xc6M\xb5\xfe\xab}\xb7\xcd\x96\xeex\xc6\xe2\xf1[,Q\xa0f\xe8v1\xe7\x1e\x98\xaaw\xa6\xf2h\x9e"
+DATA ·d+5504(SB)/64,$"\xda\x15\xb9fs\xfb\xc5X\xc4`\x1cc\xe6\xc62@\xe9\x80=\xab~m-\xed\xe6y\xae\xee\xc2\xe1C\x22\xa4+\x90\xa3\xb0\x7f\xbd\x83\xf4\xac\x9dQ,\xed\xee\xd2\xda\xce\xf6\xd5Y\x98F!*[h\x03%\x98\xb1Q"
+DATA ·d+5568(SB)/64,$"\x8c\xfe9\xadg\x0eX5/\xc5\xa6\xfe[\x85\x1a\xd1\x94\x97%\xbb\xec\xff\x00\x1d\x03K\xf0\xf3\xdam\x96\xfe\xfa\x09\x1aM\xae-\xca\x97a\x8e\x80\x86\xca\x82;\xe7\xf1\xa4\xb8\xf0\x9e\xb5\xaa#\xde\xc35\xac6\xc2]\xa5"
+DATA ·d+5632(SB)/64,$"-\xbc\xbd\xf8\xcf\x19ULdz\x83R^Z\xc9\x01\x8e\xed/\xa1\x1b\x00\x1bm\x95Y\xcez\xe0\x0c/\xebVl\x1e\xe3TG\x868ndL\xe5\xdaF\x03 t\xdc\xfb\x95\x14}9\xfa\xd6\xcb\x05NIR\x9d;"
+DATA ·d+5696(SB)/64,$"z?\xf8qK\x13U~\xfa5\x17\xcd~\x9a\x15\x22\xf0m\xa0\xdb\x0e\xa1>\xa1y\x17\xaa\xcc\xab\x93\x8e\x85@\x07\x8a\xb1qo\xe1\xcb\x01\x14Q\xa4L\x88\xb9\xf2\xe5\x92SpA<)*N~\x9d+n\xd6\xde\x0b"
+DATA ·d+5760(SB)/64,$"4\x92K\x98\xad\xa3H\xfeX\xa3\x00\xe0\xf1\xdeGl0?C\x5c\xfc\xd7\xec\xf3}\x96[W\x08\xe1HKxB\xf1\x9e\xbf(\x04\xe7=I\xfab\xb5\xab\x82\xa7J)\xc2\x09?K\xbf\xeb\xe4\xfd\x0c\xa9b\xea\xd5o"
+DATA ·d+5824(SB)/64,$"\x9em\xa5\xdbE\xfdz\x13\xcd\xaf\xc5=\xecS[\xe9\xd2\xb2\xc3\x09\x22\xd506\x01\xfd\xe2q\x8c\xfa\x9c\xd5;\xcf\x13kZ\xd5\x83l\xb6\x8a\xc0o\xca<\xb7G\xccR=\x99Fx\xfc\xea[\xcd\x1fX\x95\xccz|"
+DATA ·d+5888(SB)/64,$"\x17\xa1\x9f\x04\xf9\xe5b\xc9\x1d\x00\x18&\xb1\x87\x84\xb5k\xb9L\xda\xa4h\x8a\x06^a\xfb\xd2\xa3\xa1\xc1\xea+\x1a\xb1\xc5\xb4\xe0\xdb\xb3\xde\xc9%\xf3i\x5c\xe8\xa3O\x09\x1bTvMm\xabo\xee\xbe\xa56\xbb\xd1,"
+DATA ·d+5952(SB)/64,$"c\x13j\xf3\x8b\x9b\x91\x9c\xac\xf2\xca\xc8s\xfcX\x8c*c\xdb&\x88l\xad\xf5\xa9\xe3\xbaH\xed&[q\xb6!n\x1a%\x8d\x1b\xb6J\xee'\xf5\xad\xb7\xb4\xf0\xbe\x8b\x01\xdf\x0a\xcf\x199i\x08w9\xff\x00g?"
+DATA ·d+6016(SB)/64,$"/\xe1\x8a\xab?\x884\xebG\x8e\xe2\xda[H\xe180\xc4\xb6\xe0J\xa7\xdd\x9f\x92G~\xde\x95\xc1,\x1d\x184\xaa\xb4\xed\xd3\x7f\xbd\xbf\xf8't1\x12\x92~\xc6/\xc9\xbd\x17\xa2Ko\xc0\xb1}.\xa3\x0c\xa1\x22"
+DATA ·d+6080(SB)/64,$"\x92KX\xb6\x85\x05P\x95Q\xeeI<\xfe\x1c\xfbVD\xd1\x08\xd1\xed\xac\xae\xa6r\x17\xcd{\x87\x91S\x00\xf4!w\x11\xf8\xe3?J\x86MC]\xd4a\x9ek\x8b\x8bI\xadX\xee0;2\x12s\xd7bcq\xf4"
+DATA ·d+6144(SB)/64,$"\xfeUf?\x0f\x8f\xb0\x9b\xc9\xe0\xb2)\xb7;\xe6IN\x07\xa8\xc9'\x81\xdbmUW*\xb2|\xb1\xd1.\xbf\xd5\xaciJ*\x92\x5c\xf2W\xf2\xfe\xae\xcc\x9bk])l\x91#\x9e9\xe4F}\x9f\xbc\xf9\x86{\x97"
+DATA ·d+6208(SB)/64,$"c\x8cg\xd0UmKL\xb7\xbch\xa7\xbe\x0f$\xea\x17h\x89\x0e@\xeb\x9f\xcf\xd2\xba\x8f\x0e[\xe9\xab\xbdl\xfc\x94f8F1\xe3\xcc?\xec\x99\x01 z`U\xcb\x83s\x16\xa2#\xb4\xf2\xca\xe4\xe1I\x01C\x11"
+DATA ·d+6272(SB)/64,$"\x8c\xe0`\x9f\xd6\xb9\xd6]Ft\x95\xe2\x9d\xfbjk<\xc6q\xac\xd4[\xbf\x9e\x87#(\x8c\xaa\xa6\xa3i\x85l\x18\xda\xf5\x88\x91\xf9\xe4\xf5\xc0\xf6\xc85\xa3aoa\x14\xcf,\xe8\xac\xe8\xa7h\x80\xef\x04z1\xc7"
+DATA ·d+6336(SB)/64,$"\x00t\xe0V\x1c\xfan\x91\x05\xd3I\xaa5\xd4\xd7[\xfeX`S\x92I\xe8s\x9e\xb5\xa5&\xa7\xa7\xad\xb6\xcb\xf5\xbb\x8d\xc1\xff\x00Q\xb9\x03|\xbd\x07\x00\x9c\xfeU\x18V\xe3&\xa7d\xd7\xf5\xa9\xd5^.Ir]"
+DATA ·d+6400(SB)/64,$"\xa7\xeb\xf8\x16,c\xfb\x1c\x8d~\xd0\xc6s\x9d\x96\xc5\x0b\x0f\xc0\xab\x7f1T\x9fS\xd6M\xe0\x9a\x11\x07\xefch\xfc\xa8`\xde\xdf\xed\x12\xd8\xda\xb9\xfa\xd4s\xf8\xae\xce\x18\xc3G\x14\xb1\xc5\x92\x1f\xcc\xcc\x8c\xf9\xecG"
+DATA ·d+6464(SB)/64,$"8\x03\xf5\xa8_[\xd4\xf5\x19Kh\x10[\xc6\x9c\xfc\xec6\x85\xf7P\xc7j\xff\x00\x8dMj\xf1q\xe5\xa7=S\xd9n:Xz\xb7\xe6\xa9\x0d;\xbd\x91j\xe9.t\xd6[\x84\xff\x00Fu\x05w\xa0\xf9\x81~\xa4"
+DATA ·d+6528(SB)/64,$"\x84;\x8f\x1f\xec\x8f\xc6\xb1\xad\xef&\xba\x91\x95\x0d\xc8\x5c~\xf2\xe2,\xae\xe3\x8c\x02F:~ \x9a\xb9ck\xa9\xd8\xbb\x5c_\xdd\xc2C\x12XoG,q\xc8\x00\xfc\xb9\xac\xbb\xbb\xddf\x1d\xc2\xdex\xa0\x8byp"
+DATA ·d+6592(SB)/64,$"\x8f\xc1\x1f\x5c\x1e~\x95\xc3Z1^\xf3O\xd3\xfag]\x18\xde\xea6~\x7f\xd6\xe6\xac>\x04\xbd\xd4\xe2\xf3_Ph\xe1\x5c\x85i\x15\x90\x0es\x85\x07\xa5E\x07\x83\xf4\xb4\xbaa\xabj24h\xc53+\x15\xdd\x8e"
+DATA ·d+6656(SB)/64,$"\xe3\x83\xc7\xe5\x5c\x0c\xda\xcd\xe5\xcd\xc7\xef\xa7\x96i7\xe4\xe5\xb6\xa6}B\xf4\xad\xb4\xb6\xba\xb9\x8f~\xa7x\x18\x13\xfe\xa5\xb7\xc8\xdfP\x06EeN\xbe\x16N\xf4\xa8^\xdd\xdf\xe7\xfd3\xb2\xa6\x1b\x15\x04\xfd\xa5{'"
+DATA ·d+6720(SB)/64,$"\xb5\x92\xbf\xcb\xfaF\x8cw\x1a\x0b^\x1bkI\xdc \xc0\x5c\xa8\x8f'=~\x5c\x9c}pk\xb9\xfb6\x90\x92G\xa6\xcb\x18\x95XoV\x98\x13\xb9}q\x82q\xf8\x8a\xe3\x1e\xc7M\xb4\x83~\x99h\xaeH\xc7\x9fs"
+DATA ·d+6784(SB)/64,$"\x1b'\xcd\xdfb\x9eO\xf4\xac+\xbdB\xe0)\x86\x1bIc\xc6\x11\xe4$\xa9o\xa0P\x08\x1f\x8dvS\xc5\xfd]?i\x14\xef\xd9;z\x1c\xb2\xc3{v\xb9$\xd5\xbb\xb5\xf7\x9d|\x8bg\x06\xacl\xff\x00q\x08\xce"
+DATA ·d+6848(SB)/64,$"<\xf8#1\xaa\x8e\xb9\xde\xeaz\x0fCY\x97zf\x925d\x9fH\xbe\xbc\xb8p\xf9X\x9eF\x11\x03\xdf\x93\xf7\xb3\xef\xeb\x5c\x9d\xae\xa4!r\xb2\xc4\xb2\xbe\xeeD\xc5\xb2\x06:\x0eI5\xd4Z\x03p\xbfe\xb5\xb5"
+DATA ·d+6912(SB)/64,$"Fb\xbf&d\x1f/\xbf\xff\x00\xae\xb8\xe9N\x95h\xfb\xd0\xbb\xbd\xd6\xf7^GUZ\x13\xa3\xaa\x9fMv\xb3\xf3f\xf1\xb5\xd4-\xcf\x9b\xf6d\xb3.K\xac\xcc\xe2Q\xf4\x1c\xf7\xefV\x5cE\x15\x9a\x89\x19\xae\x15\x86"
+DATA ·d+6976(SB)/64,$"\xf6\xc2\x0cd\xfa\x85#\xf5\xfc\xab\x9f\xfe\xd0\xb9K\x90\xb7\xe6\xd9\x958\xda&#\x8e\x9c(P\x08\x15\x13jzj\xa01\xb6\xd5I\x03\x18\xa4\x90\x05a\x9epA\xdd\x9fc^\xaa\xc7R\x8d\xff\x00]\xff\x00\xc8\xe1\x96"
+DATA ·d+7040(SB)/64,$"\x16\xa4\xade\xf7\x7fM\x9di\xb8\x90)\x92\x15\x9d p\x18\x9c\x18\xb9\x03\x00\xf0FG\xa5P\xb9\xbb\xb5\x8b7\xb6a\xe5\xba\xdcs\xb7r\x8c\x8e\xa4\xb1\xcf\xe1Y:\x8d\xf6\x9br\x11\xd1eu\xec\x90\xce\xc3\xe9\xc6\xd3"
+DATA ·d+7104(SB)/64,$"\xfc\xe9\xaa-\xe5\xc4\xd7S\x5c\xc4~\xef\x96\xbb\x8f\x03\xa0\xc0\x19\xfeuU1jo\x96\x09?>\xde\x972\xa5\x85\xe5\xb4\xa5\x7f\xeb\xd3s\x7fJ\xd5t\xfbEW{\xcf*V \xedI\x1d\xd8g\xd8\x9c}r*\xc4"
+DATA ·d+7168(SB)/64,$"\xf7\x91N\xe6\xda\x0b\xc8^L\xe5A\xc1\x07\xb8\x0b\xb7\x1d>\xa0W=\xa8\xc5\xa6\xb2\xaa\xc7\x01b\xd8pcl\x0cw\xceT\x1c\xe3\xb1\x15F\xda\xdf\xc2\xd6\xb3\x97\x16\xf26\x0e\x5c4\xa3\xe5\x07\xa6\x14\x00j~\xb8\xe3"
+DATA ·d+7232(SB)/64,$"\xee^)z\xb4?\xa9\xa97Q)7\xda\xcb\xfaE\x8b\xc8t\xfb\x82ZKu\x9aV;\x93jw?B\x07\xe3Te\xf0\xea\x15\x13\xdci\xcc7|\xc3\x120,G\x5c\x0e\x99\xae\x9a\xe4\x97\xd3\xccZ:\xcc\xa8\xe0"
+DATA ·d+7296(SB)/64,$"m\x8c\x05\x04\x86\xe4z\x9e\xde\xb5\xcdy^$\x9ee\xb5I^&^v\x15U*q\xcf=jq\xb4\xa1t\xdco~\xc9\x1d8J\xd3KIZ\xdd\xdb\xfd\x09a\xb1\x98\xa3Ckbm\xc6~y\x1e@\x08\xf4\xe3"
+DATA ·d+7360(SB)/64,$"\x19\xc5]\x8bK\xd5\x16F\x96Y\xa7@8*\x19O\xd0\x81\xb4pj\xb4\xdaE\xfb:6\xa1%\xc4\xae\xa3*\xaa\x09c\xeb\x90\xdc\x7f:e\xbd\xf5\xed\x8c\xc1`gF\x0d\x9d\xb2\xc4\xaf\xf9c\x03\xf0\xac\xe9\xf2\xc6K"
+DATA ·d+7424(SB)/64,$"\x995\xfdvF\x93s\x92n\x12_\x8f\xebs\xab\xb5K[\x98\xb7\xca\xd21\x03`y\xc1B\x1b\xb6\xd6\x07\x19\xfa\xd6m\xc6\xa04\xf8\x92FF|\xe4\x12\x0cd\x01\xdb-\x9es\xda\x99u{\xaf4l\xd7\xeb\x1bF"
+DATA ·d+7488(SB)/64,$"r\xdb\x5c*+dw\x1b\xbf\x95s\x92j\xf6K`\xd6\x92Gbcr\x15\xa1\x85y\x18\xf5f\x07\x1fAZ\xe3q\xa9iM\xf2\xbf\xeb\xd7\xf58\xf0X7&\xdc\x977\xa3\xd0\xb0\x9a\xb40\xbb\x98#\x7f:c\xe5"
+DATA ·d+7552(SB)/64,$"\x8d\xce\xb1\x00\xf9\xc8*\x03rq\xc5]\x1a\xae\xa1\x8f\x9bI\xb9c\xdd\xbc\xd3\xc9\xf5\xebX\xf1j\x9e\x1e\xb4\x8c\xdc\xc7\xb0\xdc`\xaa\xa2\xc6\xb9_B\x1b\x03\x18\xa9\x17\xc6\xf7\xea\xa1M\xd3\xf01\xd7\xff\x00\xad^5:"
+DATA ·d+7616(SB)/64,$"\xadiR\xaf\xfe\x03o\xc6\xe7\xaf,$\xa4\xefN\x92\xff\x00\xb7\xaf\xf8\x1f\xff\xd1\xfew\xe1\xb6k\xa4\x0f\xa9\xea\x16 G\x9d\xb0\xa4DI\xc9\xe4\x85\x04c\x1fQ\xc5_\xb8\x1av\xb0\xc2\xd5\xd66\x865$K=\xcf"
+DATA ·d+7680(SB)/64,$"\x90\xaa;\x96H2I\xfa\x9f\xc6\x96\x0f\x07\xc0c\x92]^I\xaf\x9c`\xba\xb4\x85K\xb3\x0e\xaa\xa0\xa8\xe3\xfd\xa3\xf5\x15\x89\x0d\x97\x88>\xd0\xb6z>\x9e\xbb\x11\x99\x82E\xb7h?\xdegl\xfe\x95\xfe\x8e\xce2\x82"
+DATA ·d+7744(SB)/64,$"\x5c\xd4\xf4\x97\xce\xff\x00\x9a?\xcc\x08\xd4\xa57x\xd4\xd5zE/N\xa6L\x97\xb6JR\xd7E\xb5\xb9\x90#\x9cmg\x1b\xc9\xe3 \xb1,=\xb8\xe0TO\xa0\x87\x88\x5c\xdf\xc7\x0d\x8clN\xd3qv\xc9\xbb\x9e\xa5"
+DATA ·d+7808(SB)/64,$"\x8a\xb6\x7f\x0a\xf4{8u\xe5Cm-\xfbC4\xa7$\xc5b\xdf/m\xa2c\x9e=\xf1U\x1bAi\x7f\xd2u\xcb\xcb\xab\xa7g\xf2\xf6\xacj\xee\xc4\x0e3\xb8\x0e\x069\xa2Y[k\x9aQ\xfc\x12K\xf3f\xd1\xcd"
+DATA ·d+7872(SB)/64,$"\xa3\x09Y?\xc6M\xbf\xc1#\x83\xb1\xd4\xfc2\xb0\x98\xa1kFt!\x99\xeeK\xcc\x8d\xfe\xca\xee\xc7\x1fA\xcf\xadu\xb6\xbe'\xf0\xc8\x88\xdb[\x18\xe6\x91\xd8\x81\x1e\x91n\xf1\xec\xe3\xefn''\x9e\xc7\x14\x89\xe1\xef"
+DATA ·d+7936(SB)/64,$"\x0c>\xe4\xd5\xed\xd1\x8a\x12\x06YT/N\xf1\x0d\xc4\xfb\x13R[\xe9\xde\x1f\x18]\x22\xd2\xe4\xa1`0\x81\x94\xc9\x9e\xf9\xdd\x82?\x1f\xa8\xaezU\xea\xd2I)G\xe5{\xfd\xc5bk\xe1\xea\xb7\xa4\xff\x00\x0b}\xe3"
+DATA ·d+8000(SB)/64,$"\xad\xf4\xcd\x0a\xee\xe0\xc9=\xaa\xc8\xc5G\x9a\xd7\x0c\x15\xc9\xf6Y\x18\xf5\xfa\xd5+\xdd6\xf5o\xd6?\x0eiK\x1a\xb6UM\xd1\xdc\x9c\x11\xc8 \xf3\xfc\xab\xabK\xaf\xb2\xc2a\xd2\x9d\xa1D\x19q!^\x8ap\xc1F"
+DATA ·d+8064(SB)/64,$"\x18\xb1>\x94\x96:\xecV\xe4\x0b\xbb+\x9b\xe3\xe6f)\x9e=\xa3\xd4\xa8\xc6\xcc}+\xd5\x94\xe8\xb8\xa8\xcd\xf2\xf7v_\x9e\xac\xf2\xa1\x8b\xac\x9b\x9c\x13\x97\x93o\xfc\xd29\xb9,\xf5\xad>\xe7\xcb\xd4\xadl\xe5\xd9\x82"
+DATA ·d+8128(SB)/64,$"\xfeJ<\x8cG^\x98?\xcf\x15\x9d\xa8j\xd6\x96W\x86\xf6\x0b_(\x9c\x18\xa3\xf2<\xb0O\xa8-\x93\x9f\xc3\x15\xe97\x8d\xaa\xeb2\xc6\x93\xda\x5ci\xd0t\xdf\x0d\xd0,3\xc8\x05\x14\xfc\xa0\xfa\xb1\xaev\xe0]x"
+DATA ·d+8192(SB)/64,$"r\xecZ}\x9e\xdb\xcfo\x9b\xcd\xf3\x0bn\x8c\x1e\xa7!\xb3\x9e\xe01\xa8\xc5SI{\x92j\x17\xdd\xaf\xf8\x09\xff\x00]J\xc2\xe3T\xbe8\xae~\xc9\xff\x00\xc1\xb7\xeaq\xf6w\xd3N\xca\xeeg\x89\xcb\x92\xabj\xfb"
+DATA ·d+8256(SB)/64,$"\x99\xb7v\xe3\xa9\xcf\xd6\xb6\xae\xaen\xd6\xc4\xc4\xad;&\xfd\xfeE\xc3\x84>f0\xc4\xa9\x00\x8f\xce\xba\xc9\xe6\xd1\x9a_\xed\x0b\xa9\xc6\xf6\x1f:\xc5\x0f\x96T\xf0\x09\x0b\xe9\xe9\x9a\xceK\x88\xa7\x8cA\xa2\xcf\x83\x1b\xb1"
+DATA ·d+8320(SB)/64,$"\x90\xa4\x03\xcc#\x93\x97f\xce\x01\xef\xcd)PT\xd3Nwo\xcd_\xf3-\xe3UI+S\xb5\xbdm\xf9\x19Z\x870\xa2\xce&\x84:\xe1!`%f#\x92\x03\x13\x81\x91\xd7\x15R\xd2\xebY\x89~\xc5i\xe6G"
+DATA ·d+8384(SB)/64,$"\x139fx^2\xe4\x0eFH\xeb\x8fL`{\xd6\xcd\xff\x00\xd8a\xb3\x0d\x22\xb4N\xf8\x0c\xe5#!\x8es\x80@'\xfc\xf5\xac8\xee|\xabC5\x8c\x99\xdd\xf2\xbb:\x88\xd4(9\xc0\x00\x13\x93\xeb\x8a\xce\xa6\x22"
+DATA ·d+8448(SB)/64,$"Q\x95\xe5+Yt\xde\xdf\xd7czK\x9e\x9bJ\x1dz\xed\xfdz\xdc\xb7v\xf2\x17\x12,\xf7\xb7\xd2E\x84\x89&V!G_\xbd\x95\x1d{V\x05\xce\xbf\xadK\x22\xa5\xec\xb6\xd6p\x05\xfd\xea\x19#P\xc7\xbf\xdd%"
+DATA ·d+8512(SB)/64,$"\x8f\xd2\x9f\xa8\xea\xd7\xb7\x90&\xcd*gV\xca#o\x0a\xac\xde\xa4\xe4~<U\xabi\xa7\xb7\x87\xfb>\xcfEYK\xb0c!(\xbb\x9b\x1d3\x86`\xa3\xeb\x9a\xe4\xad\x8cS\x9b\xe4\x9c\x92\xf2R\xff\x00-N\xea\x18"
+DATA ·d+8576(SB)/64,$"d\xa2\xb9\xe0\x9b\xf5\x8f\xf9\xd9}\xc4\xd6^!\x86\xeeHb\xb1\x09'\x96\xbf,\x91\xa4\xb2\x95>\x989POcV\xa4\xb8k\x03%\xebY\xdd\x00\xe4\x16\x9d\xd2\x1d\xac\xdd\x80\x1c\x85?\xadc\x97\xf1\x05\xb4\x8b=\xc4"
+DATA ·d+8640(SB)/64,$"V\xf6V\xf21VO;%\xdb\xd1\x00R1\xf9RjV\x9a\x84\xff\x00\xe9:\xbbA\x18\x89\x91VD\xf3\x1dQ3\xc7@\x13'\x8f\xbb\xcd%\x99\xbeM\x22\xf9\x96\xd7V_\x88\xde\x0a<\xebU\xca\xfb;\xbf\xc0\xd1"
+DATA ·d+8704(SB)/64,$"\xb4\xbd\xba\xd4\xe4\xff\x00DUUf\xc0\x91UC\x03\xe9\x94\x5c\x8a\xbd\xe2\x1d;J\xb4\xb3\x0d\xad\xdeO\x19\x93\xe4C-\xc9;\x98u\x0a\xa7\x03\x1f\xca\xb9\xeb\x17\xb8\xb9W\xb5\xb5\xd6&\x8e5`\x08\x85\x02\xc6\x17\xb9"
+DATA ·d+8768(SB)/64,$"\xcew~\xb9\xaa\x11xsK\xb1b\x8f\xa8\xdb]\xa8r\xca%m\xf2\x1c\x9c\xfd\xe2\xa5\xab_\xed\x1a\x95)\xb5\x0ajM\xf5m$\xbeWm\x91,$c4\xddG\x1btI\xdd\xfc\xed\xa7\xdc\xcd=:\xf2\xc2\x0d:"
+DATA ·d+8832(SB)/64,$"i4Ap\xae\xa0\xfe\xfea\xf2\x88\xc0\xc6\x03\x93\x96v=\x02\xaf\xe3\xde\xb44\xd4v\xd9\x0d\xa8E\x8d\xd7\x9d\xaa\xd1\xee s\xb9\xd8\x93\x9c\xf5\xc5j\x87{;'\xb8\xb3\xb5L\x94\xdc&Ui\x03\xb0\x1d\x00\xe0\x0c"
+DATA ·d+8896(SB)/64,$"w\xc8\xe6\xb8\xa8\xe6\xb4H\xbf\xb4\xf5\x19.\xa3\xba\xc1@\xb6\xf1\x90\x87w\xae\xd0B\xf3\xd7\x1c\xfaU9N\x92\xa6\xe4\xefe\xd3D\x10\xb5nnUm}[~{\x7f]\x0e\x94i\xdec5\xed\xdd\xc8X\x93$\xc0"
+DATA ·d+8960(SB)/64,$"\x1c\x00\x17\xa6x\x5c\xe3\xeasM\xd5\xd3D\xb3\xf2\xad\xbc\xe8\xf7uh`\x04\x993\xd8.N\x08\xeary\xae\x06\xd5\xf4\xbb\x80\xd0\x5c\xdc\xa4\x92\x86\x05\x9e\xf2Ips\xc6\xd4\xcfQ[v\xd2\x80\x86\xca\xca\xde\xc2X"
+DATA ·d+9024(SB)/64,$"\x8b\x03\xb3'\x0a\xcb\xd0\x86r\x7f\x96+:y\x84\xa5\x16\xa1Mk\xd5\xb6\xf5\xfc?SY\xe0\x5cf\x9c\xe6\xf4\xf9#j;o\x08\xda\xe2;\x8blN\xdf\xbc!\x898C\xf7I\x19\x07\xdf\x81X7\xf2i\xc2f|"
+DATA ·d+9088(SB)/64,$"\xa3\x16\xc6\xd4\x9c\x15O\xc1A\x1d\x07\xadiCi\xf6{\xe4\x9d\xa30I\x82\x8d$.\xdb7\x1e\x9b\x8e\xdc\x13\xf8R\xad\xd4\xb7\xb3\x9d>\x14\x86G\x0eG\xda\x1c\x8d\xdc\x7f\x10\xc8\xce?*\xba\xb54\xe5\x94R~H"
+DATA ·d+9152(SB)/64,$"T\x9f,\xb9\xa2\xdc\x97]\x7f\xe1\xf49\xe3\xa3\x99\xb7\x5c\xdc\xeaZ|1\xa9\x01\x10F\x00\xc1\xfe\xe0\x1d1\xdf\xd6\xbaU\xb0\xd2\xe7\xb7\x8fc}\xbd\x86W\xed\x05TF\xc7\x1d9\xeb\x8f\xa5E$\xf2AjVi\xed"
+DATA ·d+9216(SB)/64,$".$n\x84C.Tt\xceB\xf3\xfc\xab.\xd3X\xbd\xb6\xb5d\x8d\xae\x0a\xe4\xe28\xa0D\x1c\xf5\xf9\xb2X\x0f\xc6\xb1\xa7\x8a\xa5\x098\xb8\xee\xbd~[\xb5\xf8\x1d3\x85i\xc7\x9a\xfb=:~\x976\x7f\xb1\xff\x00"
+DATA ·d+9280(SB)/64,$"\xb3Y\x1e\xe6\xda\x08\xa0`KK!@\x07\xd4m\xcf\xebO\xd5\xaf\xb4\xdd6\xc0][\xc2e\x04\x1d\xb3[a\xe3\x1e\xb8\x07\x19#\xf2\xf6\xaeB\xef\xc4\x1al_\xe8\x96\xd6v\xd2\xb3(\xf3f\x9327|\xe7'\x1f"
+DATA ·d+9344(SB)/64,$"\x8ek\xa1\xb0\xd6\xfc)s\x146S\xa0\xf3UN\xfd\x83!N8\xda\xbc\x8e=\x0diC\x17\x09\xf3B\x9c\x92\xfe\xbd\x11\x15p\x95#\xcbRqo\xbd\xbbw\xdd\xd8\xc6o\x14X\xcd\x1a\x94\x86i[\x8f2K\xc6\x04"
+DATA ·d+9408(SB)/64,$"\xfe\x1e^?\xa5u:\x15\xdd\x96\xa0\xaf+m\x96c\x8d\x91.\xef\x97\xe8X\xe3\xf0\xebV\xaem\xf4;\xa6E\xb5\xb1\xfbCm\x02B\x19#\x1e\xd9\x01I\xcf\xa8\x035\xab\xff\x00\x08\xa6\x88\xf7\x08\x22\x80\xc6\x01\xf9\x8c"
+DATA ·d+9472(SB)/64,$"jc*q\xd4\xe6\xbd\x1c&\x02\xaf=\xdc\xd4\xad\xeb\xfeZ\xfe'\x063\x1f\x87\xe4\xe5\xe4q\xbf\x9f\xfc\x1d?\x03.\xea\xd1\xe6\x88\xb5\xcd\x9am\x5c\x84\x8a\x16P[\xd7v~c\xda\xb8\xab\xabg\xb7\x94\xc6\x9aC."
+DATA ·d+9536(SB)/64,$"\x08m\xaa7\xf1\xdb\xa6I\xcf\xd6\xbb\x1b\xebK\xb8\xee\x1cY\x08\xdf`\xc6\xc9\xa5e\xc2\xf4?1\x5c\x1f\xc35M\xa4\xbdD\x12\xdb,p\x14\x01w\xf9\xa5\x8e\xe1\xd8*\x80H\xfa\xd6X\xbeY\xb7\xa3\xd3\xcb\xfe\x07\xea"
+DATA ·d+9600(SB)/64,$"t`\xeaJ\x09l\xd3\xf3k\xf59!c\xa7\xc9/\x9fs\x02[\xb1\x1f2\x912>}\xf3\x9f\xe7S,\x9a\x13f+\x08\xed\xd1\xcf\x04\xc8\xa6S\x9fPN)\x9a\xd6\xb5\xabZE\x14s\xc3wu4\x8b\xbd\x8a\x14"
+DATA ·d+9664(SB)/64,$"\xda\xac}\xfel\x0f\xa9\xac\x19|]q\x15\xacksi#&W&\x17H\xdcg\xdc\x0c\x9e}\xc0\xef_=[7\xc3\xd2\x94\xa0\x96\xab\x7fw\xf5\xe8{\xf4\xf05\xea\xa8\xcb\xff\x00n\xff\x00=\xce\xaa\xd6\xeax."
+DATA ·d+9728(SB)/64,$"\x96\x09!\x17(\x00/-\xbcM\x18L\xf6#\xbe=q]4\x16zT\xa5\xb2p\x92\x02VH\xc9V\xff\x00t\xf2zz\x8a\xe24\xc1\xa0\xea\xac\xd7w\x11\x0bR\xb9T3I&\xee\x9d7'8\xf7'\x9a\xbb\x09"
+DATA ·d+9792(SB)/64,$"\xd2\xf4h>\xd6\x8d\xe6\x04\x89\x8b\xae$q\xea\x01\xf3\x98(S\xc6H5\xdb\x83\xcd\xd4#\xcd4\xa5\x17\xae\xfa\xfd\xd6\xb9\xc9\x8b\xc0s\xbeX\xb9)/->\xfb\x9b3\xf8Z\xdc\xb9\xfb;\xce!\xd9\x96&@y>"
+DATA ·d+9856(SB)/64,$"\x98\xc7\x1fS\x5c\xc5\xe7\x85l\x0d\xd2\xd9\xc0\x93\xbb\xc8\x01\x0d\x1b\x17\xfcOo\xd6\xa9O\xe2?\x15\x08Z\xf2+K\x08I;\x22\xb7\x85\x1eB\xfe\x99\x19\xda8\xeb\xcf\xe3O\xb1\xf1\xa7\x8d\xa0\xcaM\xa5\xc0\xbbG\x94\xa7"
+DATA ·d+9920(SB)/64,$"kp\xd9\xeb\xb41\xe88\xe0\xd7\x1dl\xe7\x01V\x5c\xbe\xc9\xab\xf5\xe5o\xfe\x18\xea\xa3\x96\xe3\xa9\xc7\x9b\xda'\xe5\xcdo\xf8r\xcc\x1a|\x9aA\x17\x1e\x5c\xcf\x0a\x13\xb9FA\xc0\xed\x9e\x82\xa5\xb7\xbb\x93TF\xb9\xb4"
+DATA ·d+9984(SB)/64,$",\x8d\x96\xcc\x01\xc30\x1d\x9bq\xcf\xf2\xae[T\x87Y\xd5%2j\x9a\x90U\x97(\xd6\x8e\xa5!Ns\x8d\xb9$\x9f\xd6\xac\xdb\xc5\xaf5\x89\x87\xc3\x93\xcb:\x95\x11\xba\xa5\xaaG\x12\xe3\xd3\xcc!\x8e+\xcdY\x9c"
+DATA ·d+10048(SB)/64,$"\xbd\xa3\x8ciK\x97\xb6\x97\x7f%\xa9\xdc\xf01pR\x94\xd3\x97}m\xf7\xd8\xea\xedm^\xf6em\x5c;\xa0\xf9\xa2\xde\x8aN1\xea\xbbO\xe4+FKm;L\xb4\xfbe\xa3*\xee_\xdd\xef\x5c\x1e\x0f\xa0\xe7\x1e"
+DATA ·d+10112(SB)/64,$"\xf5\xe5W\x9a/\x8c\x85\xbb\xae\xb5\x14sHQ\x929\xa3\xbb\xf2J\xc7\x9e\x06\xc4S\xc0\xa8t\xdd2{\xa9\x99o\xa2\xb8Uh\xc4[CEp\x18\x0f\xe3\x05\xb6\x90\xde\x98\x1c\xfb\xd4C\x88%\x07\xca\xb0\x8e\xef\xab\xbd"
+DATA ·d+10176(SB)/64,$"\xff\x00/\xd4\xb9d\xf1\x94y\xde%YtV\xb7\xe6z%\x85\xac\xd7\x8c\xf0\xde-\xbc\xa0)h\x9c\x92\x0ex\xe0\xe0\x1c\x7f\x9ej/\xf8F\xc2\xaa\xad\xc7\xd9\x034\x84\x06\x88\x027\x1e@\xcb\x9f\xe9^{s\xa7E"
+DATA ·d+10240(SB)/64,$"`\xb2\xe8V\x0b\x1bNYs-\xe3K\x1c\xe0\x1e}\x00\xc7\xa6\xdc\x03\xd2\xa6\x91\xe0\xd2J\xd92Y^<`c\xed\x12\xf92\x92x\xc0*\xe4\xbb\x1e\xd9Z\xce<Ed\xd5Z?\x0e\xee\xfd{m\xa9\xd1\xfd\x91;"
+DATA ·d+10304(SB)/64,$"\xde\x95]\xf5\xb5\xbf\x1d\xf4:\x1b\xab=\x06\xcf1\xdd\xc4\xa8\xe3\x87\x96FQ\x83\xf9\x81T\x0bxT\x12>\xd1m\xff\x00\x7f\x17\xfcj}\x03G\x89\x9eD\xbf\xb4\xb3\x89\xdf8\xf3\xaf\xd9\x99;\x9c\xaf#\x83\xd7\x15\xd6"
+DATA ·d+10368(SB)/64,$"\x0d\x0b\x03\x0b\x15\x96;ms\x8f\xc3\x9a\xeb\xa1*\xb5\xa3\xcf\x1aq^\xb7\xbf\xe8s\xd7\xa9J\x94\xb9%VW\xf5_\xe6\xcf\xff\xd2\xfe~\xed\xbc[4\xd6\xa8\x9a\x9d\xa6\xa0w\x83\xb2&\xc1\xdf\xc7\x04n`\xdd{\xe3"
+DATA ·d+10432(SB)/64,$"\xf3\xae\x8a+=kQ\xb4\x8e;h#\xd3\xce\x14F\xf7\x0a\x82D\x04u\x0c\x1cd\x9e\xe4\x8c{S\xaetKk\xdb=\xf6\x9a\xacv\xe8\x01i\x0b:\xc6\xf9\xef\xf3\x00K{\x02k\x17N\xf0\xb7\x84\x10\x9dB\xfaY"
+DATA ·d+10496(SB)/64,$"\xefd\x8c\x83\x8b\xa9\x98\x96\x07\xab('\x00\x0fq_\xe97\xb2\xabx\xc2O\x9b\xce\xf6\xf9\xe9v\x7f\x94\x92\xaf\x86qu!\x1eW}\xb9[\xd7\xe6\xd2.\xcf\xe2\xdbK8\xe5\xb5\xb8\xd4\x09\x11\x81\xf6\x8b\x84\x0a\xbb\xc8"
+DATA ·d+10560(SB)/64,$"\xc8U\x8cpI\x07\xa9\xe0c\x9ek\x97\xd2\xb5\x0b\x1deNn'!s\x95\x8a\xd9\xb78=J\x9cc>\x84\x9a\xe9.\xfcO\xe1m\x12W\x87\xf7>ib\x22h\xa3iX&:\x969\x00\xe7\xd3\xf3\xa9\xe3\xba\x99"
+DATA ·d+10624(SB)/64,$"\xe3\xfe\xd9\xd2^O\xde\xae\xd6\x0c\xc6\x18\xcb\x1e\xbf)V\xc8>\xd55=\xfa\x9e\xf5^d\xb7]\xbf\x1f\xd0\xbaj0\x85\xe3I\xc7\x9bf\xec\xb5\xfb\xbf\xe1\x8a0\xd9]i\xf6!bO.\x03s\xe6\xc7\xe6\xb0\x12\xc9"
+DATA ·d+10688(SB)/64,$"\xc6F\xfcgq\xc7'\x9c\x0a\xb56\xb1\x06\x98\xce\xd3Im$\xb3\x83\x88\xa2\x85\xa4\xc6G$\x8d\xd8\x1c\xd6x}^!\xf6\xcdQ\xe5\xb9\x88\x13\xfb\xabT;\x10zd\x00?O\xa9\xa8|C\xabx\x9a\xe6\xc0Zh"
+DATA ·d+10752(SB)/64,$"\x1ag\xd8\xc4\xb1\x95\x0f$\xfeYm\xdd\xd4)\xc98\xeeH\xc7\xa5\x12\xc5F\x957(^\xebef\xdf\xf9\x17\x0a\x12\xabV1\x9d\x9a{\xbb\xa4\xbf\x1d\xfeE\xf9\xda}kJ[f\x9e\xee\xd1\xc8\x06\x19l\x8a\x81\x90"
+DATA ·d+10816(SB)/64,$"O\x0e_;}\xf0N\x07J\xa1&\xa5e\xa8\xedmA\xa3\xb8\x91ce\x12\xce\xcd;y\x8b\xd7\x09\x18A\x92q\xb5\xb9\xaf?\xb5\xf0\xe7\x89\xac?\x7f\x7f\xad[\xd9\x8e\x19\xa3\x05\xdc\xa6x\xf9\xb7t?Rk\xaf\xb1"
+DATA ·d+10880(SB)/64,$"m\x1c\x5c\x1b\x8dCV\x8e\xee_,\x82\x91K\x1cJq\xc0\xc8\x1f\xca\xbcz\x18\x89U\x975H\xf27\xbd\xda\xfc\x8f^\xbe\x16\x14\x95\xa1S\x9d-\xac\x9f\xe7o\xd4\xd7\x97\xc4W\x96\xf1\xa4Z2L\xa79\x969b"
+DATA ·d+10944(SB)/64,$"\xdaw\x81\x9cm-\x9d\xc7\xb0o\xd6\xb9\x9f\xb5\x1db\xf1\xaf^\xf6\xea9\xca\x92\xf6\x862\x92\xaa\x01\xc8\xda\xa9\xb7\xaf=q\xdf5\x16\xab\xafiqJ\x86\x0f'\xcdP~kD\xdcW\x18\xc3\x1c|\x83\xbfbOz"
+DATA ·d+11008(SB)/64,$"\xc7\x93\xc7\x16\xbfhyb\x12\xc6\x5c\x80ne \xfc\xbd\xf7&\x08\xff\x00\xbeEe\x8c\xcc$\xe5\xef\xd6\xba]?\xcb]>f\xf8,\xb9\xa8\xb9S\xa3f\xfa\xf5\xf9\xe8\xee\xbd\x0fK\x8d\xaf\xad\x14L\xf6\xa1\x8c\x88\xa2"
+DATA ·d+11072(SB)/64,$"\x1c7\x99)?\xc3\x96\x00\x00?\xfdU\x87q7\x98\xdf\xf10\x820\x91d]D$ft~\xe7i`\x18\xe7#\xb6+:\xc7\xc5\xd1\xdc[J\xd0\xebJ\x80\x9c,aZ<.z)`\xa3\x8fa]\x01\xbe{"
+DATA ·d+11136(SB)/64,$"Kv\x96\xc6V\xf3&\x1f4\xd2!\xc4\x9e\xa3p\xe3\x1d9\xcek\xba\x15aY'\xcf\xa7\x9d\x9f\xe5\xfeh\xf3\xe7B\xa5)\xbeh\xeb\xe5\xcc\xbf3\x9em\x1f\xc3\xce\xef{\x0d\xd5\xc0\x84}\xf8o\x88\x8d7\x9e\x9c\xaf"
+DATA ·d+11200(SB)/64,$"8\xf6&\xb4\xcc\xfa\x05\xba\x08-\xaeU\x95\xa3\x08\xed\x0aHb<\xf3\xc2\x9f\x98\x03\xe9\x8a\xc36\xfa\xe6\xb5p>\xd7\x1a:\xc5\xcaG\x04\xff\x00/\xaeYpI\xfcMMw|\xfaa/\xa8$0>\xdc\xc4H\xda"
+DATA ·d+11264(SB)/64,$"\xacO\x1c\x05S\xcf\xd4W$\x158JN1I>\xad?\xf3\xd0\xef\xf6r\x93\x8asm\xf6M\x7f\x91\xbbsy\xe1(Yc\xba\xd4-\xed\xc2 h\x96y\x82\xe5\x8f\xacx\x0e\x17\xd3\x9a\xe2\x1a\xf55\x1b\xb6x\x1a"
+DATA ·d+11328(SB)/64,$"\xe1\xd12K@\x8c\x10\xa8<\x15\x0c\x01|\xf5\x07$S\xae\xcc\x22?6\xe1`|\x95\xdc\xceL\xc5\x80\xc0\x1f3\xfc\xdcz\xe7\xf0\xad{}N\xe6\x1b\x01\x1d\xa5\xc5\xbca\x18\x94 \x81\x22\xe4`\x98\xcf\xde\xfc1N"
+DATA ·d+11392(SB)/64,$"x\xda\xd3\x97,\xda\xb2\xd7E\xaf\xe2\xecmK\x0f\x0aq\xbd;\xb6\xfb\xec\xbf\x03:{-H\xc0g\x85\x19\x80\xc6\xcf2t\x84\xfa\xf4\x00\x90}@\xab\x90\xc9=\xacH\xd7\xf6\xf1\xb1dlG&\xd3\x80:\xed$n"
+DATA ·d+11456(SB)/64,$"-\xe9\xc5f\xa9\xd2\xaen\xbf\xe3\xfa\xe0\xcc\x99\xf9\xa4a\xfb\xd2\xdc\x9c\xb6C~u\xb7\xe5\xc8\xf2l\xb4\x84]\x1c|\xce\xe4\xb0L\xff\x00ws\xec\xcf\xe7N\x94\xf7\xaa\x9f\x92\xd6\xff\x00\x82Z\x9aUk\x95A\xaf\xc1"
+DATA ·d+11520(SB)/64,$"\xaf\xc5\xb2\xc4\x03B\x96\xd8\xb5\x9e\x996\x17>`\xf2\xce\x01\xeaH\xdd\xb7\x8f\xa9\xaelj\xe3M\x99o$\xd3\xed\x96\x05$J\x0c\xdf\xc4\xc3\xe5\x1bv\x9c\x1f\x5cq\xefQ\xdf\xeb\x92]\xdc%\x85\xbbM\x03\xc2\xe5\x16"
+DATA ·d+11584(SB)/64,$"\x08\xd4\xb9\xca\xfd\xe3\x8c\x85\xe7\xda\xae\xcb\xaaiMg\xbb)#\xbf\xca\xec\xce\xa8\xc5\x8f\x1c\xb6Y\xb2=(\x965O\xf8u\x12\xe5\xf2J\xff\x00\x83*\x9e\x12P\xb3\xab\x06\xf9\xbc\xdf\xfc1\xa9\xa3k\x90Om$\xf6"
+DATA ·d+11648(SB)/64,$"\xd9\x80\xbc\xc8C\xa23\x8cg\xe6\x19\xcf_|b\xa0\xbb\xd5\xb4\xb8gh\x84\x84 vw[\x99\x01PI\xce\xee\x08\x19\xeb\xd4Vn\xa8\xff\x00c\x84$\xd8L(\xc2Et\xc6C\x9fM\xa3\x80{\xe6\xb9h\xf5{"
+DATA ·d+11712(SB)/64,$"kwt[\x19\xee\x03\xfc\xa5\x1d\xcb!\xe79\xe3\xad\x15\xb3\x0a\x94\xd2\x84\x9a\xbfwsL>]\x09\xcaU\x14^\xbd4\xfc\xdb7\xb5\xdf\x11Z[\xc0.\xac\x98\xeaHTo\x82\x02\xab*\xfb\x84t\x03h\xeeA\xac"
+DATA ·d+11776(SB)/64,$"\xdb\xbf\x11\xeazm\xba\xcbg\xe1\xad\xed\x22p\xceU\x99A\x1dJ\xa7\x1f\xadf\xff\x00jj\x91\x86\x13\xdb2\xc6\xd23*w\xda\x7f\x80\x11\x92\x14zdU\xab=]\x07\x94\x90i\xf1H#\xcbM\xe6p[\x9fr"
+DATA ·d+11840(SB)/64,$"q^G\xb6\xafRR~\xd9\xc5\xbd\xac\xb6\xf3\xd56z\xdfS\xa5\x08\xc5{.k\x7f{\x7f\xb9\xa4[\x1e(\xd7\xe4U\x16\x92\xc4d\xd8\xac\xa9(\xf2\xa2?\xec\x92K\x1fb1Vm.\xb5)\xef~\xd5\xab5\xad"
+DATA ·d+11904(SB)/64,$"\xb9\x05\x8e\xcbX\xd5\xe1\x05\xba\xf0\xe3w>\xc7\xf0\xad+\xbb[$\x02\xf94\xf4\x8d_\x004\x8c\x860\xddO\xdd\xc9\xc68\xe9\x5c\xfc\x10\xebP\xb8\xbe\x8a\xee\xd5\x88s\xe5\x22\xa6B}3\xc2\x8a\xe9\xab\x0a\xd4\xa4\x9d"
+DATA ·d+11968(SB)/64,$"J\xb2\x9f\xce\xcb\xd7\xa3\xfb\x8c)\xba3\x83T\xe0\xa1\x7fG\xfeh\xb3x\x90[\x5c1\x99\xaef%\xb0\xb2\xacco?\xec\xf1\xfa\x0a\xd9\xb3\x89&S\x04\xb7\xb6\xcb\xbd0\x02C\xb4\x86\xe3\x82_\xa1\xf4\xac\xddc["
+DATA ·d+12032(SB)/64,$"\x88\xda\x1b+\xab\xaf\xde>\x0b\xa5\xa2\x0c)\xf5,G\x07\xd7i\xaewM\xf1\x17\xd8\xe4+-\xe3\x95\xd8F\xd7]\xe0\x92z\x0f\x97#>\xa6\xb5u\xa8\xd3\xac\xa2\xe4\xda~\x7f\xf0\x7f6Lp\xf5j\xd2\xbaZ\xaf/"
+DATA ·d+12096(SB)/64,$"\xf8\x07[yo\x0d\x82K\x190\xc8C\x04\x06\x0f\x94\xe4\x9c\xf1\x94\xc6O\xd6\x9285\xbbwK\xe9r\x88\x92f\x15o-[\xdb%0N}0j\x80\xd6\x03\xdc%\xda\xb4\x1b0\x15\x943+\x11\xf4 \x0f\xe9X"
+DATA ·d+12160(SB)/64,$"\xcd\xad\x1b\x8b\xa3%\xd4+;FO\x96\xcaN\xe8\xfd6\x85\x05s\xfe\xd6*j:i\xf3)\xbf.\xdf=\xeeT(\xd4k\x95\xc7\xd7\xfa\xd2\xc7^<E\xad\xcb\x0b\xac\x10G\x01$\x8cH\xb8$\xfa\xfc\xce\x0eEI"
+DATA ·d+12224(SB)/64,$"cy\xabZ\xdc\xcf\x16\x9f\xe7K\x14\x80H\x05\xd4\xc6O(`\x02\x03\x93\xea3\x9c\x0fN\x95\xc8B\x86\xd2_\xb5\xcb\x02E\xfcq\x9b\xb2s\xf4\xc1=\xff\x00\x0a\xb3>\xb9e;4\xd7v\xb6\xc2&!\x0bo`\x1b"
+DATA ·d+12288(SB)/64,$"\xe9\xcbg\xfe\xf9\xe9Z,l\xd5\xa7:\x8e\xeb\xf2\xfb\x89x8IJ\x10\xa6\xac\xf7\xf5\xfb\xff\x00R-J\xf6[\x5c\x9b\x81q \xdcpax\xe4\x0a\x07l\x96\x18\xfc\x05Ao\xaaL\xf2y:z\x08\xe4o\x95d"
+DATA ·d+12352(SB)/64,$"\xba\x0c\xec8\xeb\xe5\x81\x81\xff\x00\x02o\xc2\xb5t\xadW\xc1\xf6:l\x17\xba\x89y\xee\xbe\xdb2\xcfgo\x14P[\x8bo!\x84\x0f\x1d\xc3o\x94\xcc'*YL[\x0cc\x1b\xb2k\x87]F's\xcb\xcaX\xee"
+DATA ·d+12416(SB)/64,$"m\xfd\x0b\x9e\xa7\x80p=\xab\xc7\x9e:\xab\x95\xd4\xec\xbb\x7f\xc1Z\xff\x00]Of9|\x14mk\xff\x00]\xb6=\x1c\xe9V0\xdb\xee\x96\xfc\x19\xf1\xb9\xfe\xd3\x86!\xcfLn\xfb\xab\x8e\x80\x0a\xe5\xf5\x19\xaf\xed&"
+DATA ·d+12480(SB)/64,$"Uk\x86\x9fh\xc8Kx\x17\xcb;O#{\x85S\xf9\x9c\xfaUc\xff\x00\x09\x1d\xec\x0d6\x9b\x05\xbc\x08\x06\x1eF\x90c\x03\xaf\x0c\x07N\xf8\x19\xacic\xd4m\xaeV\xf6\xeeX$l\x02\xab\xbfz\x8cz(\xce"
+DATA ·d+12544(SB)/64,$"?*\xacV2R\x8f%(r\xae\xfa\xfe\xbb\x91\x83\xc2r\xcb\x9a\xa4\xd4\x9fm?\xa4t\xd0jZ{\xcdo2\xce\x10m\xdf(\x84:\xbe\xde\xe1\xb6\x01\xf3\x0fL\x0cT\xc9\xa8\xe9\x97\x17\xcf\x8bh^\x05\xc2\xac\x9a"
+DATA ·d+12608(SB)/64,$"\x94\x9bd=\xf8B\xa7\x82=Nk\x8a\xb9\xf1\x16\xa4\xe5\xa3\x8aE\x8f{\x86b\x22\x00\x12\xbc\x8f\xf3\xde\xaf\xc3\xa8\xf8\x93R\x85\xc3\xa42\xa66\xee\x8d@e\xc7Lg8\xaca\x8bo\xdd\xbd\xff\x00\xed\xd5\xaf\xe2\xff"
+DATA ·d+12672(SB)/64,$"\x00#\xaay\x7f\xdazi\xfc\xcf\xfc\x91\xd8Ay\xa2\xcb4\xb3\xce\x8a\x04\xb2\x162\xc2\xc03\x100\x00y\x1b$\x0cv\x18\xc5s\x13\xf8\x92\xe2+\xd3\x06\x9fw2DS\xe6\x112H\xdb\xfdC\xca\xa0q\xc7\x00c"
+DATA ·d+12736(SB)/64,$"\xde\xaa-\xae\x9d:\x05\xba\xb6\x9b\xcd?!\x95\xa5\x1bA\xf6\x00f\xad\xaf\x874g\xc2\x15\x9bv9\xc9\x01A\xf5\xceI\xaa\xf6Ug\x15\xc8\xd4m\xdbG\xfa\x11\x15B\x0d\xb9\xdd\xfa\xd9\xa3\xa7\xd3n\xadf\xb7Y\xfc"
+DATA ·d+12800(SB)/64,$"\xc3-\xd2\xa8V\x9e\xfe/6G\x1f\xec\xaamE\xc05\xa2\x96\xf3\xc3(6\xfb\xa62\x80\xabr\x9b\x898\xecq\xb7\x81\xe8\x05r\x11i+\xa7Hf\xb7\xd5 \x89\xd3\x95\x88\x9f\xe6NE!\xf1\x06\xb3\x14\x8c.$"
+DATA ·d+12864(SB)/64,$"y\x14\x9c\xfe\xe9\x80\x07\x1e\xe3\x18\xfc\xab\xd7\xa1\x88\x858\xafk\x1b>\xeb\xf5\xdf_\x95\xcf:\xbe\x0eU$\xdd\x17u\xd9\xff\x00Ko\xb8\xef&\xd0\xa2t\xf3u\x06\x906\xdc\xb1\x10\x89\x08_\x5c\xb1\xc8\xfc\xab\x9d\xbd"
+DATA ·d+12928(SB)/64,$"\xd24{k\x7f\xb5\xdaM-\xcb.@@\xca\xacO\x1c\x05_\x9b\xbfj\x82\xd3\xc4v-\x1a\xbc\x89,Rcc\x17,\xe4\x01\xdfw9\x1e\xa2\xa1K\xaf\x0cA7\x9fn\xf8\x91\xb9.\x83\xe6\x0d\x9eylb\xbbk"
+DATA ·d+12992(SB)/64,$"\xe2(\xca*T\xed\xafs\x97\x0dF\xbc$\xd4\xdb\xd3\xb2&\xb1\xb0\xb1\xb8\xb7\xfb}\xec!\x02\xb7\xcc\xd2\x09\x0c\x87\x1e\x80\xb0\xce>\x95\x8fu\xfd\xafs(\xb8\xd2\xad\xe3\x8d[\xe5\xdc\xe4\x86\x5c\x13\x81\xe5\xe1\xba\xfdE"
+DATA ·d+13056(SB)/64,$"m\xcf\x7f{\xe5L4\xb1<\xa8Why%H\xd4\x8f^\xe7\x8e\xbd\xbe\xb5\x89/\x9b\xa66o$7.\xca\xbbbY\x1dJ\xee\x19\x0c[\x1c\x8f\xe7^>)Sq\x8c\x13k\xbbK\x7f\xc3\xf2=|,\xa6\x9b\x93"
+DATA ·d+13120(SB)/64,$"\xb3\xbe\xc9\xeb\xa7\xe1\xf8\x97!\xd6\xbe\xc0\x0cz\xc4z{M\xc8\xda \xcb1\x1d;`d\xd6\x88i$\x1ea\xf0\xbd\xa1-\xce~\xd0\xa3\xaf\xb0\x15\xcd\xb2\xe8\xd7\xd3\x16i\xa3S\xb7\xe5F\xc89\xc7=N\x0f\xe7Z"
+DATA ·d+13184(SB)/64,$"\xebe.\xd1\xb2\xf9\x02\xe3\x81\xe5\xf6\xab\xa3\xed\x1ak\xdah\xb6\xb7-\xfewO\xf4\x15jp\xba|\x9a\xf5\xf8\x92\xf9Y\xa3\xff\xd3\xfe\x7fu\x7f\x10\xe9\xac\xff\x00g\x7f\xb1B03\x0d\xa4by\x01\xeew\x17\xc0\xf7"
+DATA ·d+13248(SB)/64,$"\xe6\x92\xd3\xc6\xf3ij\x90\xda\xd9Jb\x0c\x18\x97\x16\xf1\x16\x1d\xfa\x17o\xcc\xd5A\xe3O\x0d\x1bgF\xd3R\x17,D\x227\x89\x15S\xb6F\x0b\x13\xf8\xd6M\xcf\x85\x86\xbeR[\x99.m`\x9f,\x98\x00\x06\x1d"
+DATA ·d+13312(SB)/64,$"\xf0IL\xe3\xeb_\xe8uL|\xdb\xf6\x94*^^Z~g\xf9kK\x09I/g\x8b\xa5\xcb\x1f=~\xeb;\xfecu\xcf\x88Z\x1c\xf3 \xf2$\xf3\x83\xe6H\xd3\xcb\x920>\xbf6\x0f\xd4VL\x9e1\xd1."
+DATA ·d+13376(SB)/64,$"\x1c\x98Z\xe9f#n\xc7\x94K\xc7~F6\xfe\x03\xf2\xae\xa1>\x1dxWF\xb4Y\xda\x0b\xab\x90\xcc@$+n#\x83\xf7[\x8a\xa9\xa8X\xea\xf6\x0a-\xf4\x9d\x19\xac\xd7\xae\xe7\x8dP\x9fN\xd9\xfdk\x8a\xa5<"
+DATA ·d+13440(SB)/64,$"]\xdc\xebMk\xd1&\xd9\xe8P\xaf\x97\xd9S\xa3\x17e\xd5\xb4\x97\xe3\xa9\x965\xf9\x16,\xda\xab\x80\xbe\x80\x91\xf8\x07rO\xe5\x5c\xc6\xad\xe3R\x7f\xd1\xec\xe2\xbbs\xc7\xcf*.T\x8f\xee\xa8\xdc\x07>\x9dk\xaf\xb4"
+DATA ·d+13504(SB)/64,$"\xd0\xbco\xab\xa9\xbb\x96\xd6D\x88\x1d\xa6V!\x86I\xe0\x0e\x99\xcdu\x10xr\xfa\xd9\xc5\xa6\xb1\x22D\x18|\xdbv\x82\x07\xa1\xdapO\xe3R\xa8b\xaa\xc3\x962iy\xa3W\x8e\xc2R\x97\xbf\x18\xc9\xf9;\xfeG"
+DATA ·d+13568(SB)/64,$"\x85\x5cj\x87\xc4\x12\x87\xd6\x0c\x87\x8d\xa5\xe4M\xaa\xb8\xf6Lf\xb6\xac\xf4}1\xe3S\xa2&\xadzTu\x8a\x05\x8a\x10\xfe\x9b\x8es\xf9W\xa9\xc9\xa0\xf8R\xcf/ys\x1c\xc5yX\x8b \xcb\x7f\xb5\xb03\x11\xf5"
+DATA ·d+13632(SB)/64,$"?\x85b\xdex\x93m\xba\xda\xa6\xa1\xa6\xd8\xc2\x8d\xc4p\xa3\x89\x1b\xf1\xc6\x7f,W#\xca\xfd\x9d\xe5^j\xff\x00+\xfe.\xc7|s\x97Q(\xe1\xa0\xd2\xf9\xdb\xeeJ\xef\xf02c\xd0\xb5\xb8\xd0\xc5\x7f\xa4]\x5c0"
+DATA ·d+13696(SB)/64,$"\xf9\x96\x19\x5c,J\x0fFr\x0e\xef\xc3\x02\xac\xc1k\xad\xdb\x15\x9aKm&\xd9\x10\x12 e\x0a\x84\x0e\xec\xef\x96?\x85Aa\xabh\xb1\xbf\x95\x15\xdb\xcb+\x93\xb8G\x0e\xe2\x7f\x17rO\xe3SK\x16\x91\xad3Z"
+DATA ·d+13760(SB)/64,$"\xdd\xdd\xc3l\xb8\xdd\x89%\x89]\xbd\xb6\xa0\xce}\xb3[\xd2\x9d;{\xb2\xd7\xd5o\xebc:\x93\xa9{\xce6^\x92\xfc\xae[\xb9\xf1M\xbd\xf4^]\xdd\xce\x8do.\xed\x8a\xd6\xc9\xf2\xa8\xef\x97l\x91\x9e\x9c.>"
+DATA ·d+13824(SB)/64,$"\x95\xcd*\xdc\xea.\xff\x00\xd9\x9a\xe4$\x979A)\x8f<c9\x03\x9fJ\xb7g\xe1_\x042g\xedSn\x5c\x9c\x9b`\xf1\x03\xfe\xd3\x02\x18\x8aV\xb4\xf0\xd5\xa1\x0des\x14\x92\xaes,p\xac1 \x1e\xa4\x92"
+DATA ·d+13888(SB)/64,$"I\xa9~\xdamJ\xb5\xad\xe5\x22\xe9O\x0f\x0b\xc6\x875\xfc\xe3\xa7\xe2\xbfR\xd5\xb7\x84u\xe8-|\xfb\xaf\xb1\xcb\x09l3}\xa5\x99\xb3\xf9\xa8\x5c\xfa\x91Y\x09oo\x1d\xe0\xfb\x22i\xf1\x9c\x10\x0b\x99f\xc1\xf5."
+DATA ·d+13952(SB)/64,$"\xb9\xc1\x15z\xce\xc3E\xbe\xbb1\xc5}\x12I\x9f\xdeK\x13oW\xfa\xee\xc2\xff\x00:\xed\xbf\xb1\x92\xc2\xdc(\xbe\x10[\xb1\xdb\xe6C\x1a\xf9\xb2\x13\xeaz\xe3\xe9]\x98|<g\x1eh+%\xe6\x9f\xf9X\xe5\xad\x98"
+DATA ·d+14016(SB)/64,$"J\x9c\xadQ\xea\xfc\x9a\xff\x00;\x9ew-\xb4\x17,~\xd9%\x8e\xecd\xe1\x9c\xbb\x03\xfd\xd0@9\xfcjv\xd0\xb4\xd8H\x11\xc1{\x1a0\xca\xb8*\x92\x1fq\xc9\xe3=9\xae\xc1\xb4\x9b+Yw\xd9D\xb3\xaa\x92"
+DATA ·d+14080(SB)/64,$"d\x92fp\xc7\xb1\xcfU\x5cz\xf5\xa5\xb6\x82\x0b\xdb\xb2\xca-\xad\xe2\xf9B\xe2bCc\x83\xb9\x89R~\xa0b\xb6\x86\x0d;\xa9$\xff\x00\xae\xe2\x96d\xedx^\xcb\xfa\xd8\xf3X-\xecSP\xf2\xeel\xaeY\x02"
+DATA ·d+14144(SB)/64,$"\xf4\xb9b\xc7w\xbe\xd01Z\xd1\xe8~\x1a\xd4%\xf2QC\xce\xc3\x09\x04.\xcb\x83\xea\xcc\xc7\x00}3]\xee\xb4\x96\x96P\x0bH#\xb7\x9dP\x8d\xe26i\x0c\xb9\xf4\xd9\x8f\xd6\xb9\xcb\xed\x22\xc3VV\xb7\xb5\x0dm"
+DATA ·d+14208(SB)/64,$"\xb5wl\xb7B\xa3\x8e\x0e\xe2\xc0\x10\x07z\xc7\xea\xf0\xa7zzI\xef\xff\x00\x0c\xcda\x99\xba\x8a55\x8a\xef\x7f\xcf\xa9\xcf\xdfh\x10\xe9\xb3$\x16\xd2\xdb\xdb\xce@\x03\xcaFw$\xfa\x1e\x7f<\x8a\xb9/\x87\xf5\x88"
+DATA ·d+14272(SB)/64,$"n\x13P\xb9\xba\xcc\xac\x12&7\xdf\x22\xb0\x8d\x02\xa8\x08\xa1r\x15@\xc9\xcf<d\x93QZ\xe8Z\x0cr\x05\xb8\x87Q\x97i\xfd\xe4\xe8\xc7\xcal\xf4\xc0\xc0\xe0\xfa\x93P\xbc\xfa\x9d\x88\x92\xd1t\xe9\x1e\xdb~bP"
+DATA ·d+14336(SB)/64,$"\xcf\x91\xb4\xe4\x129\x02\xb9U(_\x9eP\xb7k]\xd8\xf4!\x88\xa8\xd7$'w\xd6\xf6W\xfc\x7fSJ\xde\xc6\xea\xe6F\x88\xea1d|\x86+1\xe6\x9ey\xc6\xd2\x7f\x90\xa8?\xb3Zi\xbe\xcc\xb7WL\xdd6"
+DATA ·d+14400(SB)/64,$"\x9b}\xb9\xc1\xe3\x91\xff\x00\xd6\xaav\xba\x9d\xf3\x5c\x13s\xa7_6\xc5;U\x5ce\x07\x5c\x93\xb5N+E5\x9b\x8d25\x9e\x0b[\x85$d\xef\xf9\xc9\x19\xc9\xe3\xa1\x15\xd7F\xb4%\xf1+\x7f\xe0W\xfb\x8c*\xc2"
+DATA ·d+14464(SB)/64,$"\xaav\x8d\x9f\xfe\x022H\xf4\xab(\x9e\x09\xa6\xb5y\x8e\x06e|\x15\xf5\x05rW\xf9\xd5H\xbcC\xa3\xb5\xabY\xce-\xb7\xa9\xf9Lgo?S\xfc\x85aM\x7f\xa5_;5\xc4h\x0b\x1eQc\xda\xe2\xaf^h"
+DATA ·d+14528(SB)/64,$"Zv\x91\xa2\xd9\xf8\xb2\xff\x00D\xd4\xdfN\xbe\xb8\xb8\xb5\xb4\xbe\x91\x0a\xdaO=\xb6\xdf:8\xd8\xe7sG\xb8o\x5c\xe4dW5L\xc3\x95\xde\x0e)|\xff\x00\xc8\xec\xa3\x97\xf3\xa6\xa5\x197\xbe\x96\xff\x00\x80V\x9f"
+DATA ·d+14592(SB)/64,$"S\xd0ZA\x12\xc2\xe7\x18\xdc\x88Xn\xcf\xa9\xc1\xcf\xe1\x8cR\xc5gg\xa8\xb93[\xdbE\x1a\xb6\x15R_-\x88\x1d\x09\x07$\xfe4\xe8|a\xa2[:\x1b{I\xed\xa3\x19!xQ\x9f^9\xfdkG\xfe\x12"
+DATA ·d+14656(SB)/64,$"\x0b\x1b\xe9RH&\x88\xcb\xb4\x92`\x04\xb4~\xc7p+\xf8\xd3\xa5V\x8c\x95\xe5U?$\xbf\xcc\xd6t\xebSV\x8d6\xbc\xdb\xff\x00-\x0b\x0d\xe1\xfdB\x04?c\xf2<\xbe\xa0\x10%\x03\x1e\x8d\x9cS\xda\xca\xfa1"
+DATA ·d+14720(SB)/64,$"\xe5\x98,\x8b\x95\xfb\xdb\xd4`\xfa\x80\xb9\xfdi\xcf\xab\xe9\xf0\xc5\x1cR\xde$\xaf0 \xb1\xdb\xbe1\xdbn\x06\x09\xff\x00{\x8a\xa7e{\x05\x93O\x047\x1f\xbd\x95Iyf\x11\xbbFOd\x0aB\x91\xef\x8e=+"
+DATA ·d+14784(SB)/64,$"\xa6u\xa8\xc2iA}\xcd\x1e|i\xd6\x92r\x96\xfe\x8f\xfa\xfc\xc9\xdf\xc3\xd087\x1a\x9a\xc9/\x19\x91`\x5c\x0c\x0e\xe5\x8ev\x8fz\xaf\x16\x9b \xdb6\x8f\xf6Hb*\xd8+'\x98\xe3i\xef\xc19\xfc1Z\x96"
+DATA ·d+14848(SB)/64,$"0XM\x03\xa1\xd4dI\x19B;\xc8\xeaCg\xa6p2\x07\xb5aOm\xf6f)i\xa8\xcb\x099I;\xa9\xf5\x1c\x01\xc7\xd6\xaeQVS\x8c?\x15r\xa9V\x95\xdc\x1c\xff\x00\x07a\xb2>\xb3\xa7\xa7\xda\x96;"
+DATA ·d+14912(SB)/64,$"k\x82\xd9\xdd4\xe3v\xdf\xa6\xec\x0c\x8a\xc6\x9f\xc5\x92\x00b\x92\xce\xceW\xe9\xe6\xed\xd8\xc1\x87\x19\xf9x?J\xb64E\x91KItf\x01z\xa9\x18\xcf\xae\x0f'\xdf\x15\x9bq\xe1\xe9\xe5M\xf0e\xb0x\xda\x8d\xf9"
+DATA ·d+14976(SB)/64,$"\x92x\xfc\xab\xcd\xc4\xbcE\xbd\xc4\xd7\xdc\xcfK\x0d\xf5w\xf1\xd9\xfd\xe8\x96\xeb\xc72\x1bCn\xb0/\x9c\x08\xc9(\xaa\x9cu\xe0rMV\xb5\xf1\x8e\xa0-\xfc\x98\xad\xe39$\xefRP\xf3\xec8\xe2\xa3\x93\xc2\xda\x9a"
+DATA ·d+15040(SB)/64,$"\x8f2tv\x04\xe7h\x15r\xcf\xc3\x17\xfb7\x88\x81=\xb6\x8d\xe4)\xf5\x19\xe0{\x9a\xe2\x8b\xc79+\xdd|\x8e\xf9,\x1a\x8fO\xbc\xcb\x22\xc9\xf3qp\x92\xbb\xb7/\x9f\xde\x0e{\x8eEA3\xe8\x01wCoq"
+DATA ·d+15104(SB)/64,$"!\x1d7I\xb7\x9f\xa6+\xad\x1e\x1b\xb8\xb3Quz\xed\x1a\xf5\xda\x9cH\xc0\xff\x00t\x0c\xd3U\xb4\xa1!\x89\xe3\x95\x18\xf0\x92\x5c*\xe4{\x9c\x0c\x93ZO\x03=\xa6\x92\xbfs(\xe6\x10\x7f\x0d\xdd\xbblq\x96\xda"
+DATA ·d+15168(SB)/64,$"\xd4H\xe1~\xc2\x89\x1ey\xcb\xb1o\xafQ[2\xea\xba\x5c\xf0\x15\xb5\x8eo1\xb8m\x91\x83\xcf\xe2q\xf8\xe0\xd7A'\x85'\xd4![\x9bgY\xe38\x07\x9d\xa0}G\x03\xf5\xaecR\xf0\xfe\xa3\xa7nf\xb1\x94"
+DATA ·d+15232(SB)/64,$"\x85\xe0\xed\xe9\xf5\xe0\xd6s\xc2\xe2h\xc5\xe9x\xbe\xb6\xff\x00#\xa2\x18\xbc5Y$\x9d\x9fk\xff\x00\x99f'\x92d\x10\xbc\xcf\x08\x07\x85\x11\x1d\xdd;\x91\xc5E.\x80\xf2\x12a\xd47.rC.\xd3\xcdc\xa5\xe5"
+DATA ·d+15296(SB)/64,$"\xccK\x89\xe0\x96\x15<\x00\xa0\xe0\x8f\xce\x95\xe4\x96\xf0\x0f\xb3\xc2\xfc\xf1\xf2\x17\xc9\xfa\xf5\xacUh5g\x1b\xfd\xe6\xfe\xc2qwN\xdfs$\x10ZY\x12Y\xdeY\x15\xb1\x85\x00\xae=s\x83\xfc\xaa\xfd\xbe\xa1\xe1\xf8"
+DATA ·d+15360(SB)/64,$"\xd9c\xbb\x8e\x10\xc7\x82\xdf8#\xfe\xf9\xa6\xdbh\x1a\xb4\xca\xe4\xab\xa0U,D\xea\xdd\x07_\x9a\xb6,4\xc7h\x16V\xb8\x85A\x04\xaaI\xf2\x9e;\x0c\x9c\xd6\x98|=V\xfd\xd8\xdb\xd5\x7f\x9b3\xc4\xd6\xa5oz"
+DATA ·d+15424(SB)/64,$"W\xf4\xff\x00\x86*\xc1\xa9[\x1b\x92,\xa2\xf2\x22S\x81,e\xdd\x9b\x1d\xf1\xef\xc7\x04\x8c\x0f\xca\x8b\xfd\x5c\x5cY\xcfjl\xd5n\x18B \xb9\x8c\xb4f-\x8cL\xa4\xa0\x5cI\xe6.\x14\x12F\xccdg8\xad\xb6"
+DATA ·d+15488(SB)/64,$"\xd1,Y\x84\xd1\x8b\x88\xdc\xe7y\x8c\xee\x04\xfe\x15m\xec\xe5\x81PYn\xb9'\xa4r\x06\xcf\x1dF\xd1\x93^\x8dL\x05yA\xc6R\xd3\xc8\xe2\x86>\x94f\xa5\x08\xeb\xe7\xff\x00\x04\xf3$mL\xc4-\xc4\xb2\xb2\x9e"
+DATA ·d+15552(SB)/64,$"\x0a\x13\xb79\xeb\x93Z\xd6vZ\x9e8\x81\xccC\x9c\xe1N6\xf3\xf7\x8e+\xaf\x8er\x07\xd8\x04\x11E3\xb7'\x9d\xc9\xdb\xab\x0c~\x1d+c\xccqb\xb0\xca@ fB\xea_ w;N>\xbc\x01K\x0b\x95"
+DATA ·d+15616(SB)/64,$"B\xfa\xcd\xe9\xfd[R\xf19\xa4\xad\xca\xa0\x95\xff\x00\xab\xe8s\xd2\xe9\xf2\xbc!'\xd3\xe0}\xdf0\xc3\x00\x7fRk\x18h\x84\x0c}\x90~(?\xc6\xba[\xd93\x18\x81\xe4\xf3\x81]\xc8\xf0!\x11\xfa\xe0\x8c\x8c\xf4"
+DATA ·d+15680(SB)/64,$"\xe2\xb0~\xd79\xe4\xe9\xf37\xfb^`\x19\xf7\xc1\xe9[\xe2\xdd\x04\xd2w\xfe\xbd\x11\x95\x0a\xb5\xadt\xff\x00\xaf\x9b?\xff\xd4\xfc\x16\xb9\x97\xe1f\x81\xfb\xdb{\x04\x86n\xfe^n\x1c\x1fb\xcd\xb7\xf45\xe7\x9a\xdf\x8f"
+DATA ·d+15744(SB)/64,$"\xae\xafe\x10\xe8v\x9a\x84\x8c\x09\x08\xf20P=0\xa9\xd2\xbd~\xd7\xc2z\x16\xb6\x92K\x1d\x84\xd1\xbe\xc2\xc8\x96\x97\x0b:\x83\xfe\xd1\xcf\x02\xaaE\xa4\xf8SN\x8eH\xf5xod\x93\x03b\xc4\xe7\x03\xd4\x9c\x1ek"
+DATA ·d+15808(SB)/64,$"\xfd\x10\xc5\xe1qU\x15\xa9J0\x83\xea\x97\xfc\x0f\xd0\xff\x00'pY\x8e\x16\x9br\xa9\x19T\x92\xfeg\x7f\xd7\xf5<Z\xc2\xf3\xc5\xe99\xb9\xbcX#gm\xc7\xed\x0f,\x8e\xccy\xc9D<\x9a\xef\xdb_\xf8\x81x"
+DATA ·d+15872(SB)/64,$"\xa6)mb\x968\x00\x90\xb4\x90\x14(\x0fF\xc3\x92@5_S\x96\xce\xceO3A\xd3.\xd5\xb2q&\xd1\xb8\x7f\xc0\x9b5\xc5\xcc5K\xe9\x8b^\xc2\xe0\xb7y\x0b\xb3~$W\x8c\xe7,:q\x8dII\xf9m"
+DATA ·d+15936(SB)/64,$"\xf9~\x87\xd1\xb9\xd3\xc5Zr\xa5\x15\xda\xf6\xbf\xe7\xfa\x9bw\x9f\x12a\xb6&-F\xc2;\xd9\x0f\x1c\xcc\xf1\x05\xfa\x05\x04\x1a\xe3\xef\xfcw<\xb91\xe9\x11\xdb\xa9<\x14\x91\xe6?\x86\xfa\xdd:\x16\xba\xa3\x16\xcc\xa1z"
+DATA ·d+16000(SB)/64,$"\xedS\x8c\xe7\xfd\xe0j\x84\xde\x1c\xd7\x18\xfc\xf6\xf7\x0f\x9eCpFO\xb8\x18\xae\x0cF#\x1fS[\xbbz/\xcfs\xd1\xc1\xd3\xcb\xe9\xec\x95\xff\x00\xc4\xff\x00+\xd8\xe3d\xf1\x84*\xe7|7\x00\x8f@\xa0\x0a\x8d\xf5"
+DATA ·d+16064(SB)/64,$"\xfdWQ\x5c\x1bq,_\xdcp[\x03\xfe\x02Eu\xbf\xf0\x83x\x8a\xe4\xe2+V\xe7\xfb\xcb\xfe\x15cO\xf8]\xe2#(30\xb4\x00\xf5c\x82O\xb2\x83\x92k\xceX<\xc2n\xcd6\xbd\x0fZ9\x9e]Ms"
+DATA ·d+16128(SB)/64,$"sE5\xe7\x7f\xc8\xf2\xab\x8d>[\x9eV\xdb\xc9$}\xc8\xe3*\x0f\xe3\x9c\xd5_\xf8C\xb5u\x8c\xdd,~Z\x0eT\x96\x00\x9f\xc3\xad}O\xa3\xf8g\xc3\x96\xbf&\xa3*]N\x08\xda\xd3\x10\xa9\x9e\xe0\x81\xdb\xdf"
+DATA ·d+16192(SB)/64,$"5\xa3\xaa\xdax]nD\xd2G\x03\xaa\xf2V\x07,\x98\xfe\xe8\x03\xbf\xd4c\xde\xbbc\xc1\xcd\xc3\x9e\xa4\xec\xce\x15\xc7\xedO\x92\x956\xff\x00\xaf?\xd4\xf9\x22\xdao\x15\xd8H\x22\xb3\x9a\xe2M\xad\x81\x14d\xba\x8f\xaa"
+DATA ·d+16256(SB)/64,$"\xf2\x0f\xe3]\xb6\x9f\xe2o\x13\xdc\xbf\xd9\xefe1\x901\xe5[\xdb\xc1\xe6\x1f\xaeW\x03\xeaM}\x01%\xdf\x82u\x18D\x90\xc5\xf6x\x97\xe4\x16\xc2\x22\x09\xf5f#\x0b\x93\xd3\x1c\xd7#\xad\xea\x1e\x0e\x89\xb1\xa5\xe8\xc8"
+DATA ·d+16320(SB)/64,$"X|\xaa\x93\x01\x14^\xccX|\xed\xf48\x14\xbf\xb1\x1e\x1d]bt\xed\xaf\xe5\xbf\xe4l\xb8\x9e8\x97\xca\xf0\x96\x97{/\xc7o\xeb\xa9\xc9\xda\xff\x00\xa6[\x84\xbe\xbb\xb5\x08\x01\xc0\xbb\x9d\x10\xa0\xf4;\x0a\xa9?@"
+DATA ·d+16384(SB)/64,$"MgL<+f\xad\xb5$\xbdl\xff\x00\xad\xb6\xb9c\x18>\x840\x19\x1e\xe0\x9a\xd3\x9bP\xd3.&\x12I\xe1\xed<v\xfd\xd4\xe5[\xf0\xc8\xad\x9bM7\xc3\x0a\xfb\xbcBD\x1b\x94\xb2[\x89\xf72\xfa\x02Ux"
+DATA ·d+16448(SB)/64,$"\x15\xd5\xcd\xce\xb9 \xd7\xabO\xf5H\x8a\xb5\xf9=\xe9\xc6J\xfd\x13_\xfbk\xb9\xc7\x0d{A\x84\xfe\xf3M\xb6\x08\x0f\xdd\x96g\xd9\xf58\xc1c\xf8\xd4\x17Z\xd7\x83|\xe5k\x97I\x06\xf1\x98\xad\xed\x8a\x808\xc8\x0c"
+DATA ·d+16512(SB)/64,$"\xecI\xc5n\xcf\x1f\x81\x16vO*\x07l\xe1\x1692\xc4\x9e\xe3pQ\xc7\xbdi\xcf\xa1\xf8N\xd3L7\xb2A\x12\xc8y\x10\xcc\xd8r\xbd\xf0W8\xfa\xe2\xb1\x97\xb6\x94]\xa7\x1d?\xad\xb46x\x9a\x11\xe5n\x9c"
+DATA ·d+16576(SB)/64,$"\xd5\xf4\xfe\xb7\xfd\x0cf\xf1\x1e\x80\xe7\xec\xbe\x1a\x86h#a\x86\x8d\xbf\xd6HG\xfbJ\x0e\xdf\xc0\xd6\x947\xde,\x11\xab\xe9pG*\xb1\x0b\x95r\xc0z\x03\x8d\xbd\xab\x9c\xb9\xf1\x17\x844\xc1\x1d\xc6\x8fj\x0c\xc1\xc1"
+DATA ·d+16640(SB)/64,$"\x91]7\x85\x00tR\xccs\xcf\xb5k\xd8\xf8\xb3K\xd4\xc9\xb6\x82;\x852\x9f\x9cJ\x0e=\x84aA\xdb\xf9\xe2\xab\x0f\x98\xc5\xcf\x96U,\xff\x00\xbb\xa7\xe8M|$\xa3\x14\xe9\xd1m\x7f{W\xf9\x91\xeaz\xc7\x8a"
+DATA ·d+16704(SB)/64,$"u\x07kgf\x85\xf2\xbb\x85\xa7\xcaA^\x9f1\xed\xec8\xaa\x09y\xe3]*F\xf3\xee\xe7\x22O\x99\x8b\xa9\x95\xc8\xef\xb4\x90\x0e}k\xba\x8d\xae\x8e\xdbx\x96|\xb1\xd9\xb5\x8cj\x84\xf4\xe7o\xcc\xbc{TW^"
+DATA ·d+16768(SB)/64,$"\x1a\xd4\x13\xf7W\xf7\xce\xb9\xe7\xc8\x01\x98`\xfb\x9c\x13\xf8\xf1^\x9c\xf0\xd5*?h\xa7&\xfb\xdf\xfe\x18\xe4\xa7\x98Q\x82\xf6r\x8cR\xedk\xbf\xd4\xe2#\xf1\x0c\x04\x12\x8dx\xb2\x07\x0c\xef\xd7q\x1d2Np3\xda"
+DATA ·d+16832(SB)/64,$"\xba\xf8|^5;x\xe2\x9f\xca\x92@\xc0\xa8B\xc1\x8b\x0e\xe5@#\x91\xe9\x8aU\xf0\xc5\x9c\x92)W\x90>\x0f\xce\x8f\x86\xc0\xe3\x902\xa0\x0f\xa51\xee4}\x19\xdfN\xba7&v*c\xf2\x98\x06\x1cg,T"
+DATA ·d+16896(SB)/64,$"\x1f\xf3\xd6\xaa\x85j\x94o\xcf4\x93\x22\xbc\xf0\xf5\xac\xa9\xc1\xb6\x8dY\xe0\xd65)\x9akx\xac\x9d\x03s\x14\xfbT\xaf\xd4\x91\xfdk\xd1u\x9dkS\x9b\xe0\xde\x91\xe1\xddvR4\xdbm{Q\xbd\xb3\xd3U\xc3\xda"
+DATA ·d+16960(SB)/64,$"\xc1q4Q\xa4\xd2\xc6\xb9\xda\x1d\xc0\xc3\x9c\xf3^d\xf2i\xac\xb1\xf9\x0b\xbai\x08Uy\xcc\x922\xfb\x12\x14\x0c\xfdk\xa0\xf1%\x96\xb0>\x1bh\xb6\xf6\xdaZLV\xf2\xfaC\x90wC\xca(a\x86\x1c\xb8\xe4\x8f"
+DATA ·d+17024(SB)/64,$"\xce\x8cn%\xa9SqW\xd5\xefw\xd1\xf9\x0b\x06\xae\xa5\x1en_\xc3\xaa\xf3\xf9\x1c5\xce\x8f\xa0O;1\xb6\xb7b\xa3-\x97i\x14q\x9c\x0d\xa0\xf4\xf4\x19\xack\xd8\xbc1,!\xa0\x86\x08H\xff\x00X\x5c3q"
+DATA ·d+17088(SB)/64,$"\xd8\x00\x00\x0b\x8a\xaf\x1f\xfc$v\xcc\x22\x1e`\xda\x0a\xaa\xc89\x8cu8\x1d\x05W\xb8\x92\x17\x91\xa3\xd5\xe4\x89\xdf\xb7\xfa>\xe3\xfa0\x00\xfe\x15\xcf:\xd7Z\xd2I\xbe\xeb\xf5=L=\x16\x9d\xbd\xab~\x8d\xfeEi"
+DATA ·d+17152(SB)/64,$"\xf5='\xcdk{\x18D\x99\x5c>\xc5\xd9\xf8'V\xc6+%t\xe8\xddL\xd0Y/\x07\x86i\xc8<\xff\x00\xb3\x90kj;o\x0f\xca\xd8[\xff\x00\xb3\xba\x9c\xa8H\x0a\x921\xfd\xe5\x06\xb5[@\xb2\x89\xa2iX"
+DATA ·d+17216(SB)/64,$"\xcb\xe6)h\xd9X\xbb\x9c\x1e\xdbF\x7f\x02+\x9aT\xa5S\xde\x95\xad\xf2\xff\x00\x82\xce\xe5\x8a\x8d+(\xdf^\xf78\xc4\xb4\xd6\xe2\xf9\x8d\xbb\x95\xe8s\xc0\x1f\x8eEm\xd9kM\x0bys\x12\xb2\x03\xc1@\xaeA\x1f"
+DATA ·d+17280(SB)/64,$"\x87\xf55\xda\xc7\xa7\xda\xc1\x10\x172\x13&\xed\xab\x1c\xf1\x96}\xb8\xceH\x18?J\xd3Hn\xd0\x95o\xb2D\xac\xa7\x0e\xb1\x15*=\xc1\x03\x15\xe9\xe1\xb2\xe9E\xa7N\xa7\xe4\xce\x0cNk\x19.YB\xff\x00\x87\xe8"
+DATA ·d+17344(SB)/64,$"\xcee\xec\xa6-\xf6\xfc\x5cK\xceC\x1c\xa9R}\x8a\x80>\x994\xe5\xf1-\xed\xa3\x1f\xb4Z\xcc\xe1x\x0c\xc9\x93\xbb\x18\x07\xe5\x05kN\xf1\xde\xdc\xf9\x16\xec\xf2\x99\x10\xa9U\x94\x95|\x7f\xb3\xf3\x0e}\xeb\x876B"
+DATA ·d+17408(SB)/64,$"K\xb1$\x8d\xf6-\xa7\xe7\x8f\xcco\x9foc\x8c)\xfe~\xf4\xf1X\x89\xd2\x92Tw{\xff\x00Z\x91\x85\x8d:\xc9\xba\xdbt\xfe\xbf\xe0\x12-\xe6\xab\x7fu%\xecv\x8b\x869\x06y\x0a\xe3\x1e\x8b\x90?\x0cV\x9a"
+DATA ·d+17472(SB)/64,$"\xc5\xe2Q\x85Ku\x89J\xe4\xb2l\x90\xe3\xd7\x9c\xf5\xf4\xa4]I,\xa4\xff\x00F(\x0f\xf0\x04\x8bx#\xa7%\xcf\xf2\x14\xb76\x1a\xbe\xaft\x19\xe5\x9a78\x01\x00\x5c`\xf7\xc08\xc7\xadsF\x84\x5c[\xbb\x93"
+DATA ·d+17536(SB)/64,$"\xf2\xb7\xfc?\xe4t:\x8e\xebD\x95\xbc\xdb\xfc\xc9l\xb5\xfb\x9d6HR\xf5\xa4U^<\xb7\x8a>O\xf7\x83\x00\x0e=\xb8\xfa\xd6\xf5\xde\xa1\xf6\xf8\x84v\x0e\xf2\xbe\xe1)VH\xd81\xe3\xber\x06k\xcf\xb5\xad\x1e"
+DATA ·d+17600(SB)/64,$"\x1f\x0e\x91&\xbdx#\xf3\x1b\x11\x14RY\xbe\x88\xb9?\xd2\xb54\xdd)`\x89/\xd1\x96\xea9\x06RX\x1c\x97>\xbcv>\xb9\xaa\xc3c\xaa\xc2O\x0f.\x9b\xa6\xee\xd7\xe2\xff\x00\xc8\x9cF\x0a\x8c\xe3\x1cL>N"
+DATA ·d+17664(SB)/64,$"\xd6L\xd6\x94k\xf2L\x1flq\xc9\x92\x7fv\xc41\xf5\xe0`~\x95\xa1\xf6\xbdj\xd9|\xcb\xd2v(\xf9\xdaF\x00\x8e:s\xfe5\x91s\xabk\x91H\xb2C\x03\x22\xaa\xed-y&HRy\xc7N?:\xcc\xd3"
+DATA ·d+17728(SB)/64,$"mt\xeb\x9b\xf9\xaf5Y\xfe\xd3\xe6t\x8aYWj`\xf0H\xce;\xfe\x15\xda\xf1\xce3Q\xa5}{\xbb/\xcb_\x920\xfa\xa74\x1c\xaa%e\xdbW\xe9\xb9\xb9\xad\xde\xda\x5ci\x98\xf3-\xed\xa6%\x0aN]$"
+DATA ·d+17792(SB)/64,$"\xe38?{9$t\xc0\xebV\xa3\xd2\x1a\x1f\xf8\xfc\xbd\x9d\xad\xcaf\x16\x8d\x166\x0f\xdf%\x11I\x1fZ\xa3,\xfasE#\xc5\xf6h\x8cg#j\xa1\xf3\x0f`\xbb[\x1c~\x18\xac\xd8m\x93S\x86Yn\xafp"
+DATA ·d+17856(SB)/64,$"U\xb0#\xe7q\xf7\x03?\xfdj\x89\xd5\x8dJ\xbc\xd2Wok;-/\xfdl\x5c!(R\xe5N\xca\xfa\xe9}\xed\xeb\xf9\x90O\xa5\xe9\xf1)e\xd4e\x0c~\xf4w\x19#\xe8Ic\xfc\xa8\x1a\x8f\x98\xbfg\x8e\xf2"
+DATA ·d+17920(SB)/64,$"\xc0\x8d\xbbG\xee\xceA\xfa\x91\xda\xa9-\x9e\x9a\xb3+N\xf8\x19\xda\xfet\xa3\xa7b\x15\x01?\x5c\x91]\x0b\xdbYM\xa6\x98t\xabq\xe7\x06\x0d\xb9\x86\xe8\xf6\xf5\xe3\x95'\xeb\xfaW>\x1d7\xcc\xe1\x1b[\xb5\xf5;"
+DATA ·d+17984(SB)/64,$"j4\xb9T\xdd\xef\xd7K\x22\x8c\x8dv\xd6\xaa\xeb6\x9e\x00#\x98]U\xc3\x0fc\x8ej\x93\xdd\xea\xd0\xdc\x07\x0b$\x8e2D\x91G\x1c\x87'\xb8#\x07\x9f\xadh\xcb\xa7\xac\xf6\xb1\xbc\xe6;vM\xcd'\x91\x12a"
+DATA ·d+18048(SB)/64,$"\xc1\xe8F\xf0\xf8\xc7\xb1\xe6\xaa\x5ck\x96z E\xb4\x92ypA`\xcd\x8f\xcb\x0as\xf8\x8a\xaa\xb2\xd1NWK\xbd\xf5\xfb\xb5eQ_f\x9aM\xfa~\xbef]\xcd\xde\xa5':\x8d\x94\xf2 9,\xc1\x93\xf1<"
+DATA ·d+18112(SB)/64,$"\xe0~uZ\xcbZ\xd4d2\xc7\xa5Z\xdb\xf9{@tW\xc9\x00\x9e\xf8$\x9a\xd7\xff\x00\x84\xf4\xdeM\xe5\xdd*\xa4LB\x90\x1bp\x0ax8\xca\xe4\x9f\xae\x05,\xb7\xdaQ\xf9\xe0\xb8\x86\x14\x07+\x12\xc6\xc8v\xf4"
+DATA ·d+18176(SB)/64,$"\xe1\xd6\xb9eUT\x92t\xabm\xbd\xd2L\xe9\x8c%\x15\xcbV\x8f\xa6\xb7_p\xc6\x17\xf0\xda\x8f\xb6d\xa8\x1b\xd6\x08\x89\x93\xf5\x1c\x8a\x17\xc4vJ\xa1Z)\xf2\x06\x0f/\xff\x00\xc5Uin\xed6\xac\x90\xde\xca\xff"
+DATA ·d+18240(SB)/64,$"\x006\x02\xc6\xa3\x81\xeaI \xd6\x88\x9eO\xe0\xd4#\xc7l\xc3\xce=\xf8\xae\x95W\xa2\x97\xe4\xff\x00S%\x0b\xab\xca?\x9a\xfd\x0f\xff\xd5\xfcE\xb7\xf1?\x83\xaf\xa3\xfb-\xd4\xf70\xba\xf0B\xcc[\xf4=jF\x8f"
+DATA ·d+18304(SB)/64,$"C#\xcc\xb1\xfb}\xd9<\x8f\xdd\xb0\xfdx\xa9<?c\xa8\xe9\xf7\x7fd\xb8\xd24\xf7\x99\xf1\x86\xbb*\xbbs\xdclP\x07\xe2k\xa9\xba\xbc\xb9\xd3\xe5h\xae\xec\xd1\x93;\x8c\xf6r\xedE\xcf`\xce@\xe3\xe9_\xe9"
+DATA ·d+18368(SB)/64,$"^\x17\x13'I:\xd6V\xed\x1d\xbfO\xc0\xff\x00\x1a\xf1\x91\x8c*8\xd1\xbb\xbe\xbf\x12\xb3\xf4\xd2\xe7\x0c&\xd7f\x7f\x22\xc6\xde[5\xff\x00\x9e\x8c\xea\x08\xff\x00\xbe\xcdT\xbe\xf0\xc6\xa3<~m\xf6\xad<\xccFL"
+DATA ·d+18432(SB)/64,$"p\xc7\x93\xc7\xb8\xe2\xbdj+\xe9.le\x86\xd1Z\xee7]\x9b\x99\xf1\xb3#\xfb\xeb\x93\x9fq\x8a\xe6_\xc27\xf2)3B#C\x9c\x98\xe4i\x18\xfb\x16\x90\x9a\xd2\xbe\x05\xd4JJ\xf2]\xf5K\xeeZ\x0b\x0d\x9d"
+DATA ·d+18496(SB)/64,$"\xaarq\x9d\xa1oG\x7f\xbfS\xccl-\xb5[i\x8f\xf6\x5c\x85Gw\xbaT\xe8;\x92\xe7\x03\xdf5\xd7i\xb1\xdd\xcf#\x8dr]\xa8\x83v\xe5\x87b8\xff\x00a\x90|\xdf\xf0\x1a\xb7s\xa2\xdaY)\x8a}2"
+DATA ·d+18560(SB)/64,$"\x0b\xae\x06M\xdc\xd1$x\xfa.\x0dN|Usc\xa7\x8b\x05\xb7\xd3\xfc\xa8\xc6\xd8c\xb7\xb9|D=\x00Q\x8e=\xeb\x92\x8d)\xd2\x9d\xeaJ\xc9tW\x7f#\xd3\xc4\xe2]x\xde\x94n\xdf_w\xfc\xdb\xfc\x8eS"
+DATA ·d+18624(SB)/64,$"W\xd5\xe2\x95Z\xde\xde]J8\xd1\x8f\x94\x90@q\xce9\xcb\x1c\xfd2+\x96\xb6\xb8\xf1|\x12\x17\xd1\x85\xe3\xe7\xa3O\x00v\xfdT\xe2\xb5\x8f\xc4\xcdwK\x98\xb9\xb63\xaf #\xa8+\x8f\xf8\x08\x07\xf5\xaav\xde"
+DATA ·d+18688(SB)/64,$"9\xf1\x16\xa2\xafiiky\x1a9\xcb%\xbeO>\xbc\x92k\xcc\xa9\x8e\xa1Rz\xceI\xf9'\xff\x00\x05\x1e\xc5\x0c6\x22\x14\xed\xec\xa2\xd7\x9bV\xfc\xaeT\xd4\xb5mU\x0f\x99\xe2\xc08\xe9\xe7\x22\xf4\xf5\xf2\xd3m"
+DATA ·d+18752(SB)/64,$"r\x93x\xde\x1b\x0b\xbf7B\x826a\xd1\xa5\x87j\x83\xec\xa0\x9c\xfe&\xbb+k\x08/\xee\x16)\xe1\x96I$|\x05\x95\xa2]\xcc{d\x9c\x8a\xe9oty,a\xfb-\x9d\x96\x95b\xe0\xf34\xf3,\xad\xf4\xc9"
+DATA ·d+18816(SB)/64,$"\x05G>\x95\xc7Z\x95z\x89\xbaU-oV\xfe\xfd\x0fB\x18\xba\x14\xda\x8dJ{\xf4\xbaQ\xfb\xb5g\x9d\x7f\xc2cu\xe2\x11\xff\x00\x13\xbb[\xa7\xda\xbf~\xdc*)\xf4\xf9>\x5c\xfe\x06\x96-f\xce\xd6\x17D\xb0"
+DATA ·d+18880(SB)/64,$"\x9ai\x0a\x11\x12\xca\xcb\xb5\x1b\xb3m\x19'\x1e\x86\xb5\xb5+\x8dB\xd9J__i{G%\xe3_0\xff\x00\xe3\xa3\x15\xc6I\xe2\xabx\x12O\xb3\x5cI4\xaepS\xcb(\xb8\xed\x8d\xc4\xe3\xe8\x05yu\xb1\xf3\xa4"
+DATA ·d+18944(SB)/64,$"\xf9j\xcf_>[\x9e\xa6\x1b\x0b\x0a\x8b\xf7t\xf4\xf2r\xb1\xaf\xa7\x8f\x10_\xb1\xfd\xcd\x84\x0f\xb86\xe0\xa1\x0f\x1e\x87\x06\xab\xdd\xf83\xc47\xd7\x04\xb5\xed\xb2\xf9\x9f|B<\xd79\xf5=sX6\x9a\xdf\x89\xcc\x9e"
+DATA ·d+19008(SB)/64,$"b#L\xacw\x08\xbe`>\x9dj]S\xc5\xfe5\xbe\x9e\x18\xec,f\xd3\xbc\x81\xb5V\xccH\xa1\xcf\xab\x80~c\xef\x5c\xbf\x5c\xa0\xe9\xfe\xf2\x12\x97\xdf\xa9\xdf\x0c6%U\xb5\x19B:yi\xf7\xea\x5c\x9f\xe0\xfd"
+DATA ·d+19072(SB)/64,$"\xf8\x80\xdc\xa4hS <\xb7M\x82\x09\xfe\x22\xa0p+\x94\x7f\x87\x96\x16\xb3\x03\x7f8\xdeO\xfa\xabr]\xfe\xbdx\xfcMuz_\x89|ue\x13\xc7\xa9\xe9\xfezHr\xed1\x94\x16\xfa\xf3Z\x91\xf8\xd6\xf2-"
+DATA ·d+19136(SB)/64,$"\xf0\xb6\x99\x14p\xb0;\x11A![\xd4\xe0\x0c\xfd\x0dk\xf5|\x04\xb9e\xec\xdc_f\x9f\xfc\x0f\xcc\xb8c\xb38sBU#/8\xb5\xfel\xc0\x7f\x0bx>\xdd\x11l\x92y\x5c\x0f\x9d\xd9\x01\xdaq\xeb\xbbmK"
+DATA ·d+19200(SB)/64,$" \xf0\xc6\x986\xdbX^J\xf8;v\xa9RO\xfc\x03v\x7f\xcf5\xd3'\x895K\xa2?\xd2\x1a\xd1:\x16XQ@\x1f@\x09\xac\xfb\xf4\x8aBn#\xd5g\xb8\x93\x07\xe5\x0f\xe5\x9e}\xbd\xeb\xbeTi\xa5\xcdF"
+DATA ·d+19264(SB)/64,$")|\x97\xf9\x9c\xf0\xc5Um*\xf3o\xe6\xff\x004\x91\x88\x9e$\xd6m\x08\x82=\x1e\xf9m\xfe\xf34\xaa\x19\xb3\xea\x06\x00\x1e\xdd\xea\x94\xde#\x93(\xef\x0d\xf9\x85T\xab\x9b\xa9U\x0e}\x17\x03'\xe8*Y\xdf\xc5"
+DATA ·d+19328(SB)/64,$"6\xeb\xbe\xc7\xcfX\xf1\xcb\x84iI\xfcX\xe0~\x02\xacZj\xf7\xb2mo\x11G$\xeb\xd0$\xc8\x15\x88\x1d\x87?(>\xa3\x9a\xf3\xdc\xe7&\xe0\xe4\xd7\xaaV\xfc\x0fO\x92\x9a\x8a\x9a\xa6\x9f\xa3w\xfcNN\x7f\x16"
+DATA ·d+19392(SB)/64,$"\xda\x5c\xdc\x1d\x90\xdfeN\x22\xc6B\xed\xf4e$\xe7\xebR\x8f\x16h+\x1a\xa6\xf7\xdeO\xef\x14+!\x03\xb8\xde\x0ey\xf6\xe2\xbb\x1b\xe3\xe1\xa3i\xf3,q\x12x\x8e\x1c\x92\x01<\x82Ns\x81\xe9T\xb4\xfb\x9f\x01"
+DATA ·d+19456(SB)/64,$"}\xa0Gyj\x8a\x8a\xad\x89\xccL\xcc\xc7\xb6~\x5cc\xf3\xaegBq\x97+\xad\x1d{\x9dq\xc4\xd1p\xe6T%\xa7cV\xd2\xff\x00\xc3\xba\xa5\xa64\xcb\xc0\x9eNJ\xc7\xc0\x90\x03\xc9\xf9\x9c\x9d\xe3\xd7\xbdz\x15"
+DATA ·d+19520(SB)/64,$"\xfbI?\x80<?tX\x13<\x9a\x9b\x19\x1d\xca\xee\xd92\xaf\x03\x80Mp\x96\xb2h\xd7\x11\x93\xa1\xdb\xab\xe3\xe6\xc41*\x85\xfa\xb1\x03\xf5\x15\xea^(K\xd8|\x01\xe1v\x8d#\xf9\xad\xef\xe5p\xdc\xe05\xc0\xe4"
+DATA ·d+19584(SB)/64,$"l\xda0+\xd8NJ\xa5\x16\x9a{\xed\xe9\xfeG\x89.^Z\x8bU\xb6\x92\xb5\xd6\xbeG\x94\xcbu\x7f\x13\xb5\xe47\x10H\xe8F\xc8\xa1re8=J\x80x\x1d\xf3T\xee\xbcN5\x87HoUw#~\xfcy"
+DATA ·d+19648(SB)/64,$"\x0c_\x1d\x8f\xdd@3\xdb&\xa0C\xaaM\x09\x8e8\xe2\x0aY\xbee\x87,\xdfNI\xfa\xf3O\x8bN\xbc\x10\xb7\x95$\xd6\xe9.#i\x5c\xa0\x0f\x8eq\xb4\x81\x90?\x1a\xc2\xad\x5cL\x9d\xa1+E\xee\x9f\xfc9\xd7"
+DATA ·d+19712(SB)/64,$"\x0aTV\xb3Z\xad\x9a\xff\x00\x864\xb4\xa9\xb4\xebWg\xf2\xe3K}\xe1\x0d\xcc\xc3j\xee<\x01\xf2aO?\xedb\xb2\xf5y\xe5\xb5\xbft\xb1\xb8\xd2\xe3]\xf8\x5c\xc9\x19lc\xfd\xad\xd8\xcf\xb1\xac\xfb\x9f\x0c\xa0\x87"
+DATA ·d+19776(SB)/64,$"\xe4\x9d\xa7\xf2\xce\xe0\x8b\x19\xe3\xdfh\x1bqU\xe6\xb1\xbf}\xb0j\x13B\x91(\xc6\x18y-\x81\xec\x01'\xf1\xa9\x94\xf1\x11\x8f+\x8f\xf5\xf26\xa5\x0a.\x5c\xeaW\xf2\xfdu\xff\x00\x80j\xd9\xac\x1a\xa9{\x1b\xb9Z"
+DATA ·d+19840(SB)/64,$"i\x08\xf3&h2\xee\xc3\xfd\xf4`G\xa0\x03\xa5g\x5cI{\xa3I#Y\xe9\x93\x98\x01\xc1\x9ey\x5c1U\xe9\xd5\xc9\xfc3V\xad\xec\xfc\x18\xa8\x9eu\xd4\xae\xcaH\x0f\x22m\x88\x1fB\xc1Aj\xd4\xb66\x82u"
+DATA ·d+19904(SB)/64,$"K;\xe8\x024]\x0b$j\x08?u\x81\x04\x9c\x8fJ\xda\x18e$\x9biK\xbcZo\xd0\xa7\x88Qm$\xda\xec\xd3K\xe5\xb7\xe2si\xe2\x8bB\x8e\xd2Z\xdc+?A\x11\xc8\xc7\xd7\x93\xd6\xb0\xa6\xbb{\xd9W"
+DATA ·d+19968(SB)/64,$"\xcd\x12\x8e\xca\x88\x84\x91\xf4\xff\x00\xf5W\xa4\xea>\x0a\x80\xddG\xa8\xc4\x03[\xc8\x80\x98\xe3>O=\xc8c\xd7=Fx\xac\x8dV\xc6;\x07\x13\x06\x85aO\xbb\x1d\xc4\xaa\xb2\x03\xebZT\xa1]E\xaa\x8fE\xe9\xaf"
+DATA ·d+20032(SB)/64,$"\x99T1\xd8g%\xecV\xaf\xfa\xb1\x8b\x05\xda\xdb\xc2\xcb pH\xe7\xe5d<\xf7\xc2\x80\x0d$\x1a\x8e\x8dj\xe5\x95cBx,\x18\x16\xc8\xe7\x00v\xa4\x96\xca\xed \xfb\x5c&\x19\x12V8\xdd\xfb\xc1\x93\x8f\xba\x8b"
+DATA ·d+20096(SB)/64,$"Xo\xa3O,\x99h f'\x18x\xce\xef\xd7\x18\xa8\x95Z\xf0I\xc27\xf9\x1d\xf4\xa1FwS\x95\xae?P\xbc\xd4uk\xc5p\x918\x8c\x82\x9b\x87#\xd0\x97\x19\xe7\xebV\xce\xab,\x84I\x0d\xbcR\x5cg\x0d"
+DATA ·d+20160(SB)/64,$"*\x90\xa8\x0f9\xdc\x14\x13\x9c\xf7\xe0\x1a\x83\xfb\x0e\xea\xdd\x0a\x98\x03\xe3\x8f.5;\x7f\xe0M\x91\xfaU\x1b\xed:\xe60&]$\xc6\x8awH\x1av\xda\xc3\xd8d\x10\x07\xe3\x5c\x15!^<\xd3i\xa6\xf5z\x7f\xc0"
+DATA ·d+20224(SB)/64,$"\xd0\xef\xa6\xa9M(+Ym\xaf\xfc\x14vRjZzZ/\xf6\xd2\xa3\xcb+mX\xa3\x0e\xfb\x8f\xa21\x1d\xbb\xe4\xd4\x17>\x1f\xd1\xe0\x9b\xed\xa9\x1co\x13\x05qj\xa1w\x8fQ\x9c\xf6\xeb\x8c\xd7\x9f#Ex\xdf"
+DATA ·d+20288(SB)/64,$"\xb8\x81\xf3\xd0yw;\x94c\xd0\x1c\xd5\xd1\x1d\xb3\x93\x0d\xdd\xcbD\xc8~\xe32\xb8\xfav\xfc\x8dk\xfd\xa9\xed\x17\xef\x22\x9a[?\xf3n\xd7\xfc\x8c\xff\x00\xb2\xdd7\xeeM\xa7\xd5\x7f\x92\xe9\xf9\xf9\x9a\x977\x1at'"
+DATA ·d+20352(SB)/64,$"\xca\xb1\xd1\xe7\xb9g,\xb1\xb1\x91#\x5c\xff\x00\xb5\x86'\x03\xe8+W@\xd3\x8b\xa9\x1a\xc5\xad\xbc-\xb3\x82\x03\x84s\xe8\x0b1\xc8\xfc\xab-</\x1a\x011\x9d1\x8c\x8c0\xdf\x9fLg\x02\xb7\xf4\xc7\xbb\xd3b\x13"
+DATA ·d+20416(SB)/64,$"\xdcI3\x068S3\xee\x8c\xe3\xa0\xc7\x7f\xa6k\x5c\x15\x19\xfbeV\xae\xcbe\xee\xdb\xe7e\xa9\x962\xac=\x93\xa7Gw\xd7[\xfc\xae\xf42\xf5\x18\x92\xdf0\xd8\xc7\x14Dr%\x12\xae\x7f\x0c\xf0+\x92\xd4\xb5\xad"
+DATA ·d+20480(SB)/64,$"B\xdc\x0f\xf4\x8b\x86\x91F\x04\x8b*\xb0_a\xb4W\xa6A\x05\xf4\x17\x05\x8a%\xca\xc8\x09\x08\xec\xaa\x88\x18\xf4\x5c\xab\x1e\xfcVE\xe5\xc6\x8dd\xceo\xa1pJ\xe5Lv\xff\x00\x22\xe4\xe3\x0c\xed\x90\xdf\x86\x0d\x18\xf4"
+DATA ·d+20544(SB)/64,$"\xdf\xbd\xcd\xc9\x7f\xeb\xd0\xac\x05d\x9f/'9\xe6\x90j\xb7\x9ew\xcbq9,H!Il\x93\xd7 \xe6\xba\xa8 \xd5om\xf7\x82\xec\x14\xf2\xf2F\x06=\xb7\x13\xfd*\x94\x9a\xed\xad\xcc\xadm\x15\x8f\xd9\xd5zK"
+DATA ·d+20608(SB)/64,$"\xe6D\x80\x8f\xaa\xe5\x8f\xd0b\x88\xb5\xcb\x14\x8f\xc9\x94\xc0\xc7v?}\x96\x00v\xe8\xdc\xff\x00\x9e\xb5\xe3\xe114\xd5\xd3\xa8\xda\xf9\xfe\xa7\xbbZ\x95I\xedN\xcf\xe4Z\x8e\xc5.%1#[\x0cc\x00\xba\x969\xad"
+DATA ·d+20672(SB)/64,$"\xbbH\xbc\xb5\xf2\x840\xb3)\xc7\xf0\xc8\xc7\xf0Rx\xaa0\xdf\xc3:\x83\x0d\xc5\xb61\x91\xe5\xc5\xe5\xaf\x1d\xb7\x1f\xf1\xad+?\x13\xd8\xdb3\xb3\x9bx\xc3(!\xf02I\xeb\xc8\xff\x00\x0a\xf7p\xd50\xf1j\xf2<"
+DATA ·d+20736(SB)/64,$"lZ\xac\xd3Q\x8bv\xf5,\xcfi\xa2\xad\xbf\x99\xa9\xc3o\x16\xd6\x19g\xcc|\x1e\xc7\xb6G\xd6\xb1\x8d\xcf\x813\xf2^[c\xb6\x1f\xb5y\xf6\xb4\xfaL\xf7\xf2^\xc14\xd7\x93K'\x98\xf2J\xa4\xc6\xa7\x18\xc2)"
+DATA ·d+20800(SB)/64,$"\xe0\x0cz\x0a\xa8 \xb9\xedn?\xef\xda\xff\x00\x85xX\x9e \xac\xa6\xd5*0\xb7\x9d\xdf\xa6\xd6G\xafC\x22\x8b\x8ar\xad/\xcb\xf3\xd4\xff\xd6\xfe}f\xf1\xbf\x85/\xae\xcc\xb7\x92\x5cE\x1fS\xb6G#'\xb0\x1b"
+DATA ·d+20864(SB)/64,$"Ei\xa7\x8e|\x16-\x04Q\xab\xcc\x01\xc8\xf3\xae\x9b\x04\xf6\xf9\x004\xf8\xfe\x0c\xf8~lG{\xaei\x10\x06\xe0\xfe\xf4\x90\xbfZ\xa3}\xf0;N\xb0\xcc\xfaM\xdcz\x84]|\xcbyP!\xfc\xce\x7fJ\xfe\xe8\xa3"
+DATA ·d+20928(SB)/64,$",\xd6\xed\xf2F]\xf6o\xf0g\xf9M*\xf9,\x9a\x87\xb4\x92\xf9;}\xfa~gO\xa4|C\xbf\xb9\x22\xd7K\xb8\xd1\xacbL\x95.\xcd\x96\xe3\xb9l\xe4\xfaq]\x14Z<\x9e \xb0\x1a\xbe\xb3\xa9]K\x09\xce"
+DATA ·d+20992(SB)/64,$"~\xc3.J\xf3\x8ec\xc7\x1e\xd5\xf3\xfe\xb1\xf0\xea\xe2\xc1\x04\xf2\x06Dc\xd3r\xb6\x07\xe1\x9a\xe6\xad\xec\xe1\xd3_;\xee\x14\x11\xd6\x17+\xfc\xba\xd3\x87\x12\xe2h\xbfg\x8a\xa5u\xfe+\x1b\xff\x00\xabX:\xb1\xf6\xb8"
+DATA ·d+21056(SB)/64,$"*\xbc\xaf\xca)\xbf\xbe\xf7>\x92\x9f\xc1~\x0f\x88m\x13\xea\xccO#\xedK\xe5\xaf\xe6T\xd6l\x96V\xdaC\x19mCH\x8b\xc6\xd1.\xec\x8f\xc1Nk\xcb\xec\xef\xd2B\xa0\xea\x9a\x92\x01\xc0\x12\x16#\xf5&\xba\xfb"
+DATA ·d+21120(SB)/64,$"9\x16\x047\x10k\x1e[\x8e@x\x89c\xf8\xe3\x15\xdfC6\xa5=U$\x9f\xaa<\xfa\xb9eXiR\xb3\x92\xec\xd3\xfd\x11\xa3\xa9\xf8\xc2\xfd\xe1\xf2t\xdbHa\xdb\xc1dY<\xc6\xed\xcb`~\x98\xab6\xbe>"
+DATA ·d+21184(SB)/64,$"\xd7\xda\xd5-\xaf-d\x89\x10`5\xa2\xecb;\xe4\xb6I'\xd4\x9a\xd4\xb2\xf1>\x99\x15\xba\xc9\xa9kW\x05\x98\xfd\xcbkb\xec\xb8\xf5#\x8a\xa9\xa8<\xba\xa6\x7f\xb2u\xb7\xda\xdd\xa6\x8d\xe2l~*k\xb5T\x93"
+DATA ·d+21248(SB)/64,$"\x97\xb6\xa5Y\xb95\xb2\xb7\xf9\x9c\x89S\xb2\xa5R\x82QOw\xcd\xfeF\x04\xba\xc7\x83\xb7y\x97\xc2d\x94\xf2\xcb-\xa88\xfa\x95\x22\xb2\xee\xfcQ\xe1\xc0\x9bl\xed \x90v\x7f)\x94\xfd0\xccEG{\xa0\xd9\xc2"
+DATA ·d+21312(SB)/64,$"\xd9\xd4\xaf\x84\xacGX\xf71>\xdd+\x0eh\xad\xec\xbfyei5\xc8\x1d\xd9H\x15\xe4V\xc6W\x8bwI~\x7f\x99\xef\xe1\xf0\xd8yZ\xd2\x93\xf9\xd9~\x85\x0b\xaf\x15hq\xb3\x1b\x9d:)N6\xf5+\x8fp"
+DATA ·d+21376(SB)/64,$"\x14\xe3?\xa5Ak\xe3\xad\x1a\xd8\x004\xf4]\xa4\x1c\xf9J\xd9\xc7\xa95z?\x11\xc3\x09\xdbq\xe1\xbbIx\xeb!\x90\x1f\xccT\xf6^!\x82\xdaO6_\x0dA\x22\xe7!I!@\xfc\x8dx\xbfX\x9f=\xfd\xaa"
+DATA ·d+21440(SB)/64,$"_\xf6\xe3\xff\x00#\xdc\xf6\x11\xe4\xb7\xb1o\xfe\xdf_\xe6i\xc1\xf1r\x18\xa4\x12Y\xd9[\xa3\x93\xc3a\x97i\xf5\x0a21\xf8V\xc4\x7f\x11t\xd5\x90^j\xc9l\xfb\x9f{\x9bQ*\xc8\x0f\xb9'n}\xc5s\x9a"
+DATA ·d+21504(SB)/64,$"\x8f\x8fn\x9e\x1f#O\xd1\xaclGo*\x10\xe7\xf1f\x15\xc65\xb6\xbf\xacJ\x1d\xe1\x92l\xff\x00\x0aG\xc6=\x80\x02\xba\xeagx\x88\xb5\xc9W\x9d\xff\x00\x86\xc742\x5c,\xe2\xddZ^\xcf\xfe\xde\xbb\xfb\xcf\xa0"
+DATA ·d+21568(SB)/64,$"\xe5\xf8\x91\xe1\x19\xd0\x1d2{\xc4\x99\x87(\xaf\xd0\x9e\xc5\xa4\xcf\xe9V\xadu\x0f\x0ejP\xf9\x9a\x95\xf409\xce^C\xbc\x9c\xf4\xfb\xa4b\xbc\x87I\xf0\xdd\x94S*\xebh\xf0\x92\x09T\xc8S\x9e\xa3y\xe7\x02\xbb"
+DATA ·d+21632(SB)/64,$"]7V\x96\x15h\xec\xf4\x9b\x17\xb7F\xdb\xe6\x9c\xb1\xc9\xe3\xef\x9f\x7fj\xfa\x5c.yY\xd9\xe2\x12W\xec\x9f\xe4|\xde3%\xa1N.8iI\xf9\xb6\xbf7\xb8\xedoK\xd3VE\xfb5\xc4\xf7\xa9#mG\x8d"
+DATA ·d+21696(SB)/64,$"\x0bG\xcf\xa8@\xcc\x07\xd6\xb9\x8b\xdb-6\xc9\x8b\xdb\x5c[\xb3)81\x89\x10\x13\xe9\xf3)\xdd\xfaRk\xd1x\xa2\xeb$Kch\x92\x1e\x04\x1f+~h\xa4\xd6^\x8f\xe1O\x123\xfd\xae\x1dR\xc96\xb0\x0cf"
+DATA ·d+21760(SB)/64,$"\x91\x9c\xfdB\xba\xf3\xf8W\x8f\x8a\xcce:\xce4\xe1\x7f\xeb\xa6\xbf\x99\xee`\xf0\xca\x14\x14\xea\xd6\xdb\xfa\xe8\xbf#\xa1\xd2\xef\xb5\x9dY|\xbb{\xa9\x03\x01\x80\xb0\xc0\xc1\xbau\xce\x0e@\xf6\xe6\xb3\xa7\xd0<ks\x14"
+DATA ·d+21824(SB)/64,$"\x976\x92\x09\x0a\x1d\x81e\x8c\x99\x18\x9e\xa4\x06_\xd6\xba9u\xc8\xb4\x88\xbe\xcd\xa8j\xd3K+`\x07\xb2\x85\x15\x81\xcfn\x01\xfcr+\x9f\xb9\xf1\x1e\x99\xa7\xdc\x99\xb6\xc3z\xf2>\xe9\xe5\xbc\xbcx\xdc\x1f]\xb1\xb3"
+DATA ·d+21888(SB)/64,$"\x82\xd5\xa6*\xbcm\xcbR\xaf3\xeb\xabM}\xdc\xc3\xc3B\xb3m\xd2\xa6\xad\xd3K\xa7\xf7\xf2\x9em}g\xe3\xe8o\xbe\xc5\xa8G\xcey\x8d\xb1\x13\x0fC\x8c\x8f\xe7]N\x9f\xe1\xddBK/\xb5Mh\xe5\xa3a\xbd"
+DATA ·d+21952(SB)/64,$"\xdd\xcb`{\xc60H\xe7\xa85Eu\xed'O\xf1;x\x92\xceY\xbc\xe3\x09\x81\x86~\xd0@'\xf8\x1abJ\xfat\xa8\xf5/\x88R\x07G\xb0k\xd7x\x8e\xf8\xdai\x0a\xe1\xbe\x8ap\x7f\x11_;\x86\xafF\x1c"
+DATA ·d+22016(SB)/64,$"\xd3\xae\xde\xee\xda\xdfN\x8fc\xea+\xc3\x13QB\x14\xa9\xa5\xa2\xbb\xb5\xbdt\xb9\xdb\xdc\xcby\xa4C\x0cP\xc0\x8b!]\xdb\xe0\x8d\x14\xf3\x8ce~v\x1f\x89\xfc+\xd2\xbc}u-\xa7\x84|\x1d\x1d\xca\xbb\xc9&\x81"
+DATA ·d+22080(SB)/64,$",\xe6#\x03a\xdaI\xc9\xc9\x08F\x0eG#\x18\xef_-7\x8a5\xadFo:\xf8\x19A8+!`q\xd7\x8c\x11_I\xfcS\xf1\x0f\x88\xb4-\x0f\xc0K\xa2F\xa1\xdf\xc0\xb67'\xcc\x06Ly\xd2J\x7f\x89"
+DATA ·d+22144(SB)/64,$"\xb1\xdb\xa9\xc9\xae\xaay\xac'V\xf1\x94\x94V\xdd{-\x11\x84\xf2\x9a\x94\xe9{\xc997\xfeo}\xff\x00\x03\xc9\xde\xce\xfbWO\xb6-\x84\xb1`\x1e\x1eI<\xb6\xc78\x04\x81\x8c\xf6\xa6\xacV\x96\xf6\xcd\xaa_\xd9"
+DATA ·d+22208(SB)/64,$"M\x0b\xc6\xa7m\xbab\x5cz\x11\x97\x07w\xb1\x18\xaeGT\xf1?\x8c5\x07\x22\xe8@\xdd\xf1\x12\x81\x8c\xff\x00\xbb\x8a\xe7\x13Y\xf1ro\x8e\x06R\xa7\x86\x8eU\x0e?\x02A?\xadEl\xd6\x9a\x95\xe3\xcc\xfdR\xdf"
+DATA ·d+22272(SB)/64,$"\xbd\x8e\xbc6OVP\xf7\x9cW\xcd\xfd\xd7=\x8fN\xd5\xae\xae\x00\x8b\xcc%$M\xed\x18\x0d$\xa8=\x18*(\x07<\x10\x1b\xf1\xac\xd3\xe16\xd4&\x93Q\x12_\x09\x0a\xb6Q|\xe4E\x1c\xe7\x1f6\x0f\xd7\xady"
+DATA ·d+22336(SB)/64,$"\xc5\x8e\xb9}n\xe7\xce\xb5\xbd\x86C\xfcV\xac\xc35\xe9\x1ao\x88.\xda\xd3\xcb\x9eK\xc8X\x90W\xed\x8c\xa1\x08\xf5 \xf3]8LV\x1f\x11h\xe2\x9b\x95\xb6\xb9\xcd\x8a\xc1\xd7\xc3\xb7<5\x95\xfbu\xfc\xcaqZ"
+DATA ·d+22400(SB)/64,$"N\xb6\x8a\xd3@\xb3F\x91\x91\x14\xb3\xcc\xc1\x8e\xd1\x80\xb9 \x00=\xc8&\xa3\xb1\x98\xe9S}\xa1\xadm&!0b\xf3\x15\x91\xf2z\x03\xb5N}\x0f\x15\xa1\xa8\xd9\xea\x1a\xba\xf9\xc9}o+6\x15#\x88\x06\xe9\xc0"
+DATA ·d+22464(SB)/64,$"\xea\xa2\xb0m\xbc%\xa9]\xde\xcdi=\xec\x0a\xcc?x\x8e6+\x00;9R\xb5.\x85Js\x8a\xc3\xc5\xf9]\xaf\xf8?\x89Tq4\xa7\x09:\xf3^z2]oZ\xd5n\xa1\x8f\xcbI\xe1\x8d]\x8cq\xd9\xe7"
+DATA ·d+22528(SB)/64,$"r\x83\xd5O\xce\x14\x81Uu_\x12\xf8\x86}\x06\xde\x0d\x1e\xcd\xae\x8e?x\xd7,\xa5\xd5I\xeaB\xb1 \x0e\xc5\x8f\xb6+nk_\xeceK\x0dB\xde\x17\xca\xe16\xb4d\xed\x1ceJ\xf2q\xdb\xa0\xac\xd1s\xa7"
+DATA ·d+22592(SB)/64,$"X\xe9\xb3Ms+K \xcf\xfa$y\x88\xb1\x1d\x07$\x8d\xd5\x18\xa5U9\xfbJ\xf6mkk][\xfa\xe8\x8e\x9c;\xa4\xe3\x17\x0aWI\xe9\xbd\x9d\xff\x00\xae\xe7-\xa6j~<\xd2\xf5Sp\xf0\xc6\xf0H\x08T"
+DATA ·d+22656(SB)/64,$"p\xa3\x0d\x8f\xf6\x981#\xd6\xb7#\xd7\xbcU$\x8a\xd7\x96QK\x06\xe3\xbc\xc5\x84bI\xe8K?o\xa51\xaf\x9a\xf3OSu\xa4Z\xa4\xec\xbf\x22\xcfr\xed/\x038\x0b\x8f\x97#\xa6M`\xdb\xdc\xf8\xa8\xdf\xe2"
+DATA ·d+22720(SB)/64,$"\xd2\x1bkuPde\xb9u\xc6\x00\xe8\x18\x1c\xe7\xd8\xd7\x9dO\x17*\x09F\x9dz\x92M\xff\x00[\xa3\xd3\x95\x18\xd6\xe6\x94\xe8\xc2-i\xab_~\x8d\x97?\xb6-.\xb5G\xb0\xb9\x17v\xe0\x12\xf3\xb3\x0cG\xd7\xa2"
+DATA ·d+22784(SB)/64,$"\xed\xce8\xf5\xe2\xb6\x13\xfb\x16@\xb0\xe9\x92\xde\xed\x9b\x89$\x8c\xf9\x81\xbd\x97\x19?\xadr\xba\xac\x9a\x97\xda\xa1\x9aY\xed \x1f\xf2\xd5cV\xdc\xd9\xe7\xe5f\x04g\xdf\x91ZZ~\xab\xa1;Miwb]U|"
+DATA ·d+22848(SB)/64,$"\xcf\xb4\xa1V.\xc7\xb7\x01G\x1f\xce\xba\xf0\xb9\xc5GRP\xa8\xf7\xea\xd5\xbeZ_\xf2\x1e#\x07\x1eU:w\xf4O\xf1\xd6\xd7\xfcF\xce\xcbe?\x95\xa5\xdb\x5cD\x8a0e\x98\xb89\xf5 `.}*H\xefR"
+DATA ·d+22912(SB)/64,$"\x5c\xc4$\x8a\x22~\xf339\x07\x1d\xf8\xe6\xb3\xadn\xb4\x15\xbdU\xf2\xaep\x0e#O0\xa0R}~`\x08\xad$\xb4\xd5\xa7\xdc\xd2\xce.66|\xc61G\x81\xe9\x92\xa4t\xad\xa8W\x9c\xae\x94~K\xfa_\xa9"
+DATA ·d+22976(SB)/64,$"U)\xa5e'g\xe7\xbf\xeb\xfdl[\x83\xfb^\x16/b\xb1N\x11r]\x06\xd4P{\xe4\x8f\xd4\xd5\x04\xbe\xf9\xd6\xd2\xed\x84\xbf\xc7\xe5\xc7!\x94\x8fRBd\xf1\xefN\xba\xd7\xdd\xe0k+\xc8\xa2e\x1f*2H"
+DATA ·d+23040(SB)/64,$"\x07\x1d\xc1=\x1b\x9fa\x5cu\xff\x00\x8a.O\x97\x0d\xa6\xebQ\x18*\xa2\xdc\xf9y\xcf\x1f6\xccd\xfdh\xc7\xe6j\x9a\xe6\x83\xf9?\xea\xdf\x89\xa6\x0f\x01R\xad\xd4\xa3\xfd~\xbfq\xd8]\xcf\x07\x97\x9d<K:0"
+DATA ·d+23104(SB)/64,$"\xcem!v\xc6\xde\xbf;\x10\xbf\xaf\xe5\x5c\x85\xc6\xa1i5\xc4v\xb0A\xa9\xc8\xf2\x1f\xdd\xc6#\x0b\xc7\xa9\xea?\x1c\xd4\xe7\xc6z\x97\xd9\x92\x06\xd8\xfb\x06<\xc9\x07\x98\xc7\xeb\x93\x83\xf9U\x11\xe2]@\xc8\x7fz\x0e"
+DATA ·d+23168(SB)/64,$"H|\x01\xb0dt\xc6\xde\x95\xe5\xe3q\xee\xaa\x5c\xb5,\xbb[\xfe\x09\xe8`\xf0R\x85\xef\x0f\xc7\xfe\x01\xbf\xe4[\xda[3\xfd\x83\xcd\x93\xf8^ILj\xbe\xa1\xc9\x1c\xfe\x02\xb2\x1fPX\x9cy\xb6\x1ak\xbe1\xb9"
+DATA ·d+23232(SB)/64,$"d\x92A\xcf\xae@\xe9\xe9Zqx\xafR\xbd\x91\x13P\x91U\x01\xea\xc3\xe5\x1cw\xe0\xe7\xf5\xadS\xa8xY\xae\xc2]2\x1d\xcaO\xda!\x87\x0a\x1b\xb6\x07_\xc7\x15\xd4\xa9\xa9\xeb\x1a\xdc\xb6\xee\x92\xfd?3'R"
+DATA ·d+23296(SB)/64,$"t\xee\xa5N\xfe\x8d\x95\xect\xcb[\xb8ZYm\xad\x981\xf9]\x11\x80\x19\xe80I#\xd4\xf1YW\xfe\x05\xd5\x8b\xef\xb1\x8e\x09Q\x97z\x98\x8f\xca\x07\xfb\xc6\xb5\x95\xf4\xc9\x8e\xe8$\xf3\x1bw\xcb\xb1\x8am\x1f@"
+DATA ·d+23360(SB)/64,$"\x17\x93\xeb\xcdE&\xb9q\xa6\x11\x14f\xe3\xcb?yK\x8f\xe6;\xd7d\xa1C\x91*\xbbw[\x9c\xf1\xaf\x88Rr\xa5\xf7?\xf8s\x1d\xbc-\xe3\x0bp\xb1\xc3n\x916?\x84\x82\xc4c\xd4\x93\xfc\xaa#\xa3\xf8\xbb"
+DATA ·d+23424(SB)/64,$"<\x93\x9fyS\xff\x00\x8a\xad\x81\xe2m-\xbe[\xb6\xbdC\xd3\xef\xf9\x80\xfdFA\xa7\x0dC\xc3\x07\x9c\xc9\xff\x00~\xdf\xff\x00\x8a\xac\xfd\x86\x13\xa5G\xff\x00\x81\x7f\xc0:\xa1\x8a\xc6/\x8a\x9a\x7f\xf6\xeb\x7f\xa9\xff\xd7"
+DATA ·d+23488(SB)/64,$"\xfes\xaf!\xd4\xad\xdf\xf7\xf6\xcb\xbb\xfd\xb1\xfe\x15\x91$\xd7\xd1>B\x98\x95\xbb\x02@?\x95}{}\xe0\x9f\x0fZ\x913\xdd\xa4\xaa\x07\xcc\xaf\x1e\xee=z\xf5\xa8\xfc\xaf\x86\xfaE\xbf\x9byho\x1cp\x041m"
+DATA ·d+23552(SB)/64,$"\x5c\xfb\x9a\xfe\xe7\xad\xc2\xf5\xe9\xdf\xdaT\xe5\xf5\xff\x00\x80\x7f\x91\xd8~<\xa2\xe2\xb9(9\xfai\xf9\x9f'[\xdf\xea\xb1g\xec\xef!V\xff\x00\x96d\xb1\x1f\x91\xadH5[\x98[2\xdb\x9d\xdd\x0eP0?\x9d{"
+DATA ·d+23616(SB)/64,$"\xb4\xbe(\xf0\xd2H\xd2X\xf8t)\x0d\x98\xdb\x04\xae=\xc0\x00\x9a\xc8\xd5\xf5\x8doQA\x1a\xd8ZC\x1f\xa7\x93\x8d\xa3\xdc\x9eEaO/QW\xf6\xf7\xf9;}\xec\xf4\x9e}:\xb2W\xc2\xf2\xa7\xdeI~[\x9e"
+DATA ·d+23680(SB)/64,$"}\x0e\xbf\xa4^\xff\x00\xa3kP\xc7\x06O\xcb*F\x01\x1f^\x95\xa8\xd6\xde\x03E\xf3a\xbd\xd4dp>\xe5\xbd\xb0\xc7\xe6Z\xa6\xba\xf0\xd7\x87n\xa2i5\x1d[O\xb6\x90\x0d\xdePm\xdb\xb3\xfd\xd3\x823\x5c\xaa"
+DATA ·d+23744(SB)/64,$"x\x8a\xd7\xc3c\xca\xf0\xecR\xcb/\xfc\xf79P>\x80u\xfcjkb\xbd\x9a\xbdnV\xbb\xee\xff\x00\x07\xf9\x9dT\xa3\x1a\xdf\xee\xfc\xc9\xf6\xd9}\xed~GH\x92\xd8F\x01\xd2\xc5\xed\xbb\x93\xf2\xcfp\x11\x17\x8e\xe7"
+DATA ·d+23808(SB)/64,$"\x00\x91Z\xe6\xe7\xe2$\xb0y\xd1\xdf\xc5q\x10?\xf2\xee\xea\xe4~\x18\xeb^]/\x8c\xbe!\xce\xfb\x0a(@r\x08NN}ry\x15\xd5\xe9\xfa\xef\x89\xb56\x04\xcd\x0d\xa3m\x030D#,@\xef\xb4\x1e}\xe8"
+DATA ·d+23872(SB)/64,$"\xc2\xe6\xd4\xe7'\x15\xcc\xbd4+\x17\x96\xd6\x84y\xa4\xe0\xfd_1\xd0\xae\xb1\xaa\xce\x04Z\x8ck+\x93\x8d\xf70\x8e\xbe\x9b\xb6\x8a&\xbf\xf1\xee\x95\x22\x8b+x\xd1\x18\x02\xa0\xa2\xe0\x8fl\x1ek\xa5\xb5Mz\x15\x17"
+DATA ·d+23936(SB)/64,$"r\xc3%\xf9Q\x96i\xd8\x98\xd8\x0flUX\xb5\xddVME\x8c\xd1\x95\x80\xf1\xf6H\xe4\x84*c\xae\xd7d\x07\xe9\x9c\xd7\xa9\x88\xae\xe3\x08\xfb\xd2\xbf\xf5\xbb\xff\x00\x82xTjFSv\x84Z[\xf5\xfb\x97O\x9f"
+DATA ·d+24000(SB)/64,$"\xdes\xf2\x8f\x18kp\x19o\x16\xd6\x0d\xa3%\xd8\x22c\xf35\xc4\xddh7\xb0\xce\xaau\x9b\x10\x5c\xf0\xbej\xf3\xec\x005\xea\x1a\xc4\x0f\xab\xac\xb634Ko&7\xac\xf7v\xef\x8c\xf4\xce\xc5C\xfa\xd7\x01y\xe1"
+DATA ·d+24064(SB)/64,$"\xa9\xf4\x84\x12\xe8w:y#\x8c@\x11\xd8}I\xcdy\x18\xc7ZM;s.\xeeZ\xfd\xda\x1e\xeeY\x89\x84}\xd5%\x16\xfar\xab}\xfa\x9d\x1d\x94\xff\x00\xd8\x82/\xb5]%\xcb?\xca\xa9k\x17\x98I\x1d\xbeU"
+DATA ·d+24128(SB)/64,$"o\xe8*_\x11\xfcJ\xb5\xd3\xa4\x10O\x92\xc8?\xd4\xc3\xb5\xben\xc1\x8aaG\xfd\xf4\xc7\xda\xbc\xaa\xea\x0f\x194\x85\x86&\xf6Q\x8c\x8e\xff\x00v\xab\xc3o\xab\xde\xb7\x97qc*\xb1\xe3*\x8cy\xfc\xab\x97\xfbo"
+DATA ·d+24192(SB)/64,$"\x10\xa2\xe9\xc2\xf1]4\xfdu;\xa1\x92a\xa75R\xb3R\xf4v\xfc44u\x0f\x88\x96\xda\xbc\xac\xd7\x1a\x5c8+\x84&wB\xbe\xfcu\xfck\x8e\xba\xd5ui\xf3\xf6<\xa2\x03\x95@\xdb\x80\xfcx\xafI\xd3>"
+DATA ·d+24256(SB)/64,$"\x12\xeaZ\xc3*J\xd6\xf6\xe1\xb9\x02yQ\x1f\x1e\xa1\x09\xc9\xab\xf1|\x09y%\x03\xcf\x99\x17'2\xb0X\xd3\xf0.\xc3?\x80\xaej\x943\x0a\xca\xef[\xfa/\xc8\xf4\xe8f\xb9F\x1f\xdcM+z\xb3\xc8\xed\xa7\xf1"
+DATA ·d+24320(SB)/64,$"Y\x8c\x88\xee!\x89O\x04;.O\xe1\xd4U\x09\x92\xe9\xa5\xcd\xc5\xea\xee\xceO\x96\xec\xc7\xf2\xe2\xbd\xe2_\x81:v\x9aD\xda\x8d\xdbm+\xb8\x16\x9e%f\xff\x00ur\xc4\xfeu[\xfe\x11\xdf\x08\xe9Q\x9f\xdd\xab"
+DATA ·d+24384(SB)/64,$"6N<\xd9\xa2\xe8=vn\xfc\xaa\x16W^\xf6\xaa\xf9}[\x7f\x92:a\xc5\x18Ik\x87\xf7\x9f\x92K\xf3<J\xdf\xc3\xb7\xda\xab\x94\xb7y\xe4'\x81\xbe2A\xfcy\xad\xc8\xfe\x1ck\x96\xaf\xfe\x9c\x853\xfc!"
+DATA ·d+24448(SB)/64,$"F\x7fR+\xd9t\xff\x00\x14\xf8F\xc6#n\xa92\xcc3\x83i&\x14\x0f\xc4\x1ei\xd2\xde\xcb\xacF\xcdg\x1c\xd7h\xc3\x04\x5c\xc6\x92q\xee\xec\xa6\xbd\x8a\x5c=\x84t\xf9\x95K\xcf\xf0\xf9\xf58g\xc4\xd8\xc7S"
+DATA ·d+24512(SB)/64,$"Z|\xb1\xf3\xfe\xacy\xd5\x87\x81 |\x1b\xd8\xeeT\x0e\x8d\x1a\x82O\xe0\x08\xad\xbb\x9f\x03\xf8J\xdc&\xed\xc0tv\xbb\x95cb\xc7\xa6\x14\x12\x7f\xadU\xd6\xae55x\xd6\xd9-l\xcc*UD1\xef>\xf9<"
+DATA ·d+24576(SB)/64,$"\x8f\xd3\x8a\xe3\xa6\xb8\xf1=\xcc\x83k\x09\x98\x10U\x84K\x91\xf9\x0c\xd7.\x22\xbd\x0a7\x82\xa2\x9b_\xd7\xf5\xa1\xbd\x17\x89\xc45?o\xca\xbd\x7f\xe1\xff\x003\xd6/\xbe\x19\x5c\xe9ZcO\xa3Y\xdb\x89\xca\xf9\x8a\xf7"
+DATA ·d+24640(SB)/64,$"\xef\xbe0\xb8\xeb\x8c\xfeY\xafc\xfd\xa2|5\x7f\xa6\xa7\x82-.\xd6\x16h\xfe\x1b\xe8E\xbc\x89\x1a4\xf3$Y\x99\xb6\x85V\x05Oj\xf9_Q\xd7\xbcy%\xb1\x93X2\x05X\x98+J\x81r\x02\xf02\xc0g"
+DATA ·d+24704(SB)/64,$"\xf3\xaf\xbd\xff\x00l\x8d\x17Y\xf8\xa5\xe2\x7f\x86\xde\x03\xf8G\xa5\xeaw\xd7\xbao\xc2?\x0dG\xa9\xda\xe8\xb63\xddM-\xdd\xc0\x9aU\x92O\xb3\x09\x0b\x22\xc6\xe8\xa9#\x85\xda2\x09\xe34\xf1\x99\x8e\x19\xbb\xd0\xa7%"
+DATA ·d+24768(SB)/64,$"\xa6\xda%\xf2_\xd3:\xf2\xdc\xa3\x18\xe9\xcb\xdbV\x8c\x9d\xfb6\xff\x003\xe2\xa84X\xe4\x8c\x18\xac\xdaI\x00\xc9\xf2\x99\x9b\x03\xeb\x85?\xa1\xaa\x1a\xa6\xa1gk\x0f\xd9\xd5c\xb5q\x8d\xceP3\xe4v\x01\x8dej"
+DATA ·d+24832(SB)/64,$"\xdf\x0d\xfc{\xe1\xadN\xfbL\xd7-\xe7\xb4\xb9\xd3\xae\xa4\xb1\xbf\x8eg\xd8\xf6\xf3\xc6\xc5\x1e)A?+\x06\x04c?\x98\xe6\xa8'\x83\xde\xeaeY'P\xec\xdf(g\x07\xf9\x12i}f\xb7/*\xa6\xe2\xdf{\x7f"
+DATA ·d+24896(SB)/64,$"\xc0\x17\xd5\xe8\xc5\xf3T\xadt\xbb_\xfc\xd9yu\xcbYA\x8e{\xeb\xc9\x89\x03%\xa2\x09\x0a\xe0\xf1\x84\x8c\x8eG\xa9\xcdMwkn\xe8u9o#\x94\xb0\xe0D\x8a\xa4\x9fF\x05\x0e+b_\x08\xbe\x88\xc1o\xef"
+DATA ·d+24960(SB)/64,$"\x99~Pqj\xbef\x07\xe7\x8a\x92\xd5\x86\xdf\x22\xcfR.3\xc4w\x10,`\x0fv\xc1\xae\xec>\x06\xae\xb1\xaa\xbf\x1d\x7f3\x1a\xb8\xeaZN\x8bv\xf4\xd3\xff\x00I\xd0\xe2\xc7\xc4\x094\xc4\xfb:\xe9\xf0\xca\x03\x7f"
+DATA ·d+25024(SB)/64,$"\xacv(\xd8\x1e\xe8+Y\xfe/\xe9\xe6\xdd\xbe\xdfeq\x14\xac>W\xb6u\xd8?\x0cWE\xacY\xdai\x96\xd1\xdc\xa6\xeb\xb9'fG\x8e\xd4C S\xeaK\x95 \x1f\xa5q\xed\xa2\xe9z\x932\xdcGym7"
+DATA ·d+25088(SB)/64,$"pm\xbc\xc5#\xd7t[\x87\x15\x9d\x5cF.\x84\xdd(VW\xedo\xd4\xeb\xc3C\x03^\x0a\xac\xe8?T\xdf\xe5\xff\x00\x00\xc5\xb7\xf1\xaf\x83\xa4\x0c\xf7\x7f\xdaK!b@B\x00#\xb7\xcc\xb8o\xe9Y\x97\x1a\xb4\xd2"
+DATA ·d+25152(SB)/64,$"\x86\x97A\x85\xd4\x1e\x93\xe3\xcc\x93\xfe\xfbnG\xe1]\x01\xf0\x16\x8d+\x9f\xb3\xc8.J\x0f\x9e5\x1eS/\xbe\x1b\x9f\xd2\xb2%\xb3\xd1\xf4\xcb\x86\x86\xd7\xed \x0e\x06\xf7U\x04\xfa\x10=+\xcc\xad,K\x8au\x1aK"
+DATA ·d+25216(SB)/64,$"\xba\xdf\xef\xd4\xf6\xa8T\xc2s?`\xa4\xdfg\xb7\xdd\xa1\xc4\x5c\xb6\xba\xad\xe6I5\xc6\xf7<\xb1s\x93\xf5\xe6\x99cu\xabZ\xb9\xf2dM\xe7\x80$@\xc3\xf5\x06\xbd\xbf\xc3\xf6\xde\x15\xd4b\x09<(\xf2\xe3iA"
+DATA ·d+25280(SB)/64,$".\xff\x00\x9b\xbfP\xb5&\xb1\xe1\xfd/M\x95c\xc2D\x1dw\xaf\x05\x94}[\x07\x07\xdb5q\xc9'\xec\xfd\xb4j\xe9\xeai>%\x8f\xb4\xfa\xbdJ:\xfa-O-\xb7\xf1\xa4\x961\x8b}[N\xb5\x9c\x06%\x8e"
+DATA ·d+25344(SB)/64,$"\x08\xdc=1\xd2\xb5\x12\xef\xc3\x1a\xe6?\xb3\xa46\x12\xf7\x8d\xb7\x22\xfe\x07%O\xe9^\x91&\x8d\x0cZx\x96\xfe\xde\xceX]p\x93B\xa0\x91\xf5 ~\x84W<tM%m\x09\xb5\xb7\xf3[\x8eLn\xa9\xf8\xb2"
+DATA ·d+25408(SB)/64,$"\x96\xe6\xbb\xde\x0a\xbd4\x94\xa4\xa4\xbd5\xfb\xf79!\x9aa\xaa?r\x0e/\xbaz}\xcfC\x97\x97H\xf1\x0d\x8a\x0b\x85\xb5\x17\xb1u\xf3\x08\x0e\x0f\xfc\x09j\x8c\xbe+\xbc_\xf4}F\xce!\xc6\x04m\xb9p?\x03"
+DATA ·d+25472(SB)/64,$"]a\xbd\xd74\xf8\xd5\xfc9go\x0b\xaf\xdf\x91\xe4\x96U#\xd3\xca\x0a\xb5\xe7\xba\x8e\xa5\xe3\xab\xbdC\xed3\xdc\xac\x8a3\x98~\xcc\xdeP'\xb6\x18\x021\xeeMy\xd8\xect\xa8Z4\x94\xf5\xdfM?\x1b3\xd4"
+DATA ·d+25536(SB)/64,$"\xc0Qu\xef\xed\xb9m\xebg\xf8]\x1dm\xaa\xf8_Y\xc2\xcbj\x22\x90\xf4\xf2\x89#>\x99\x1b\xbf\x957R\xd0\xb4\x1b\x0f&\x16\x8eY^F\xc6\xd4\x19o\xc4\x9e\x9f\x8e*\xcd\x95\xa7\x885H\x11\xe3\xbd0$)"
+DATA ·d+25600(SB)/64,$"\xba_\xb9\x10\xc8\x1c\x8d\xd1\xe0\xe3\xd3\x9c\xd6z\xdd\xdbA|\xcdoq\x1e\xe4#t\xb0\x8f0\xe4\xfb\x92\xd9\xfc\xcdm<Z\x94\x174\x12o\xab\xb2~v]\xfdLc\x09)\xb5N\xa3v\xdd&\xdd\xbec\xf4\xfb+"
+DATA ·d+25664(SB)/64,$"cw%\xb7\xf6t\xd8A\x81\xf7\x18\xee\xf58\xcf\xf3\xad\xa7\xb7\xd3g+\x1c6V\xe6a\x95\x11c\xa3\x83\xc0rF\x7f j\x04{\x9bX\x8c\xd6\xb2D\xden~i7\x86${/J\xadg\xa6\xdc-\xecrI"
+DATA ·d+25728(SB)/64,$"sm\x1c\xac\xdb\x95\xa2p]Ku<\xf3\xf9\x9a\xeb\xa6\xe5\x08\xa8\xc7[\xf9.\xbd\xb4Z\x9c\xb3\x92\x93r\x94\xado7\xf3\x19s{\xa7m1\x5c[i\xd14gcm26~\x83h\x1f\x95e\xb6\x91\xa7M\x07"
+DATA ·d+25792(SB)/64,$"\x9fo\xf6i\x0b.B\xf9\xa5J\xf3\xd0\x823\x9f\xc2\xb6\xf5=>\xf2+\x89#\xbd\xbe\x8d\xfc\xa5\xdd\xbaUM\xa5z\xe4\x12O_N\xb4\xdd-\x1d\x95\xc4rD\xa0) \xa8\xc9\xeb\xe8\x17\x8f\xce\xb1\x92\xbdG\x1a\x8e"
+DATA ·d+25856(SB)/64,$"\xff\x00u\xff\x00\x03zU\xadO\x9a\x9f\xe6\xecW\xb5\xf0\xd6\x83~v\x0f\xb5B\xe3\x82\x91\xfc\xf9\xfa\x1e3\xf8\xd6F\xb1e\xa4\xe83,7R\x88\x8br\xb1\x5c\xbeY\x87\xfb\x80g\x1fJ\xd4\xbe\xd7\xb5\x8d!^X"
+DATA ·d+25920(SB)/64,$"D3\xdb\x9c,h\xb3\x14\x91\x88\xef\xb7k\x05\x1f\xef\x11Y0\xea\xb7r\xddK\xab\xc9e\x06\xf6P\x0bI0\xdf\x8e\xc3;O\xe9\x5c\xd8\x8c]\x05\x15N\x9cm.\xee/o\x96\xe7]\x1aU\xee\xeaT\x974{)"
+DATA ·d+25984(SB)/64,$"-\xfbk\xb7\xdcf\xbd\xe6\x86\x15\x1aX\xd0,\x8e\x10H\x88\xc0\xe4\xf4\xc26\x1b\x1fA[\x06\xdbN\x07\x18\x7f\xfc\x07\x92\xb7\xe6\xba\x8e\xeb\xcb\x9a\xe2\x08\xd6\x12\x06\xf9B\xac\xa5\x7f\x01\xc9\xfc\xab\x9c\x92\xf3F\x0e\xc3\xec"
+DATA ·d+26048(SB)/64,$"\xf2\x1eO\x22\x1cg\xf5\xa2\x14\xa7\xbb\x9ak\xa7\xbbb\xe5]4\xad\x19_\xc9\xdf\xf4?\xff\xd0\xfcJ\x93\xc52jw\xd1\xc5p#*\xf8\x120\x9d\x94\xe0\xf5\xc7\xee\xcf?\x95v2\xe9\xfa5\xb2J\xfa\x1a\xcfuq"
+DATA ·d+26112(SB)/64,$"\x1a\x82\x91]:\xa2a\xba\x1c\x907~|\x1a\xe1`\xf1\xdd\x84\xcc\x1e\x1bha\x8fwVT\xe3?NMu6\xda\xc5\xb6\xa4\xa9\x15\x8a\xda\xb9?.\xc7\xe3<\xe7#'\x8f\xf2k\xfb\xf7.\xc6BQw\xaf\xed\x1b"
+DATA ·d+26176(SB)/64,$"\xf2\xd7\xe4\x7f\x8c\xd8\xfc5Hr\xda\x93\x82]/\xa7\xce\xdf\xd7\x99\x91s7\x8f.\xe30\xe9\x90\x18X.[1\x82F98\xe7\x93\xf4\x1c\xd7\x9ej:o\x8au\x96U\xd7\x14\xcb\x8c\xed\x94f\x22\xd9=\x06x&"
+DATA ·d+26240(SB)/64,$"\xbd\xff\x00M\xb4\xbe\x81|\xe8\xad\xf8\x8d\xb6\xee\x8ea\xb8~\xbc\x0fNk\xa2M7I\xbc\xbbH\xb0\xab&|\xc0\x82c#\xee'\xa8\x5cq\xf45\x9d|\xaa5w\xaa\xfd\x19\xc9O\x89\xfe\xaa\xdf-\x18\xe9\xd5o\xf9"
+DATA ·d+26304(SB)/64,$"\xdc\xf9\xcfI\xf0\x16\x87\x13}\x8fV\xd3\xb5\x17\x95\xc8\xc3D\xe1\x97\xeb\x8e\xb5\xd6\xf8\x8b\xe1\xc6\x99\xe1\x88\xe0\x968\xef<\xb9\x8by\xa2g\x8a\x11\x0a\xae0[9$\x93\xd0\x01\x9fZ\xf5=o\xc3\x97K\x0c\x83K\xbb"
+DATA ·d+26368(SB)/64,$"\x96<\xf3\xf2\x82\x1b#\x8cd\x1a\xf0}~\xc2\xfa\xde\xed\xa1\xd5\x1d\x8b\x01\x9d\xf7\x05\x89>\xe0\xf2+\x87\x17\x82\xa7\x87\xa7\xca\xa1\x7f=\x0fG,\xcf\xabcj\xc6\xa2\xafe\xd6:\xeb\xa7\xe1\xf2\x18u\xaf\x0c\xd9\xdb-"
+DATA ·d+26432(SB)/64,$"\xbcPG9bB\xc9(\x92R\xa4t\xcb\x0c\x0c}3YgX\x8d\x1b\xcc\xb9\x8a\xddI\x00\xed\x86\xd9\xb6\x8f\xa34\x87#\xf0\x15\x05\xad\xc6\x9f\x90\x88\xe1\x09\xea\x89\x16G<u<\x7f<WGo\xa1i3@\x92"
+DATA ·d+26496(SB)/64,$"\xa6\xa5,d\xf2\xea\xeb\x94\x0d\xe8\x00\xe7\x8e\xf5\xe4\xfbY\xd6\x92\xe5\x96\xab\xb6\x87\xd2\xcat\xa8/y={\xdd\xfe\x86\x9c\xbe#\xf0\xad\xfaGoa5\xc5\x81d\x0a\xe8\xa5\x93sc\x9erA\xcf\xa5`\xea\xff\x00\x0a"
+DATA ·d+26560(SB)/64,$"\xadu\x02\xd7\x9ad\xe6\xe7'v\xc9\xd9\x91\xc8\xf5\x00\xf0\x7f\x03Z\xcf\xa1\xe8sC\xe5Mt\xa7\xb9\x22\x1c\xf1\xdf\x9cq\xf8\xd6\xd6\x8b\xa3\xe9\x9ab8\xb9\xd4]\xed\xc0\x1b\x16G(\xa3?\xc5\xd7\x8f\xa1\xe9^\xa4\xa5"
+DATA ·d+26624(SB)/64,$"*\x96\x86\x22\x09\xf9\xdf\xfe\x09\xe3G\x17\xf5u\xed0\x95$\x9ff\xaf\x7f\xc13\xc2\xef|\x03ce)\x8e\xfe\xce\xf2&\xfe\xf2\xa7\x1f\x86q\xfc\xe8\xd3\xfc5\xa6\x86\xdb3J\x83\xa0\x04\xe0\xe0z\x83_R\xdck\x9a"
+DATA ·d+26688(SB)/64,$"[i\xff\x00`\xd3\xe3\x87Pa\xca7\x9e%\x07\xdb\x0e{}A\xf7\xa8\xbf\xb4>\x1d_@\xb1j\xf6\xf1Y]F\x98h\xa4W\x8fs\x7f\xb2A\xdaU\xbb|\xd5\x94rL7=\xe35\xf3\xdb\xef\xef\xf2:\xa3\xc6"
+DATA ·d+26752(SB)/64,$"\xb8\xc7O\xdf\xa5'\xafM_\xad\xb7G\x80[\xae\x89\xa4\xc8\xb3[]\xcd\x0c\xa9\xd0\x96<\x1fQ\xc5\x5c:\xc6\xa1t^\xe29\xe3\x97\x7f,J\x1c\xb7\xa9\xc6@$}+G\xc4ShW\x97Kk\xa1i\xf6\xcc\x1f"
+DATA ·d+26816(SB)/64,$"\x92\xd3\x5c;H\xa78a\xb1\x15\x86\xde\xe3\xe6$\xd5\x9b/\x0bGop\x8c\x90\xdb\x80\xa4\x11\x9by\x9c\x1f\xfb\xeaT\xc7\xe3^eJ\xed\xc9\xd2\xa5\x1b\xa5\xd94\xbf\x1b\x1e\xc2\xaf\x05\x18\xd5\xac\xed'\xde\xcf\xf2\xb9\xe7"
+DATA ·d+26880(SB)/64,$"\xb2h/\xaaM\xe6\xcbw!,w\x04\x8a\x0ey\xf4\x00WS\x07\xc2m^\xfe\xd3\xed\x11\xddj1\xa2\x0e\x1a\xeb\xf7h\xbf\xf7\xd1\x1c}+\xd3/<k\x7f\xe1\xd0\xb0\xe9\xf6Vp\xf9\x7f+0\x89A9\xe8s\xc9"
+DATA ·d+26944(SB)/64,$"\x1f\x99\xaf5\xd4>!\xa6\xa3y\xe4\xea\xd7S\x99?\xe5\x98\x91s\x1f\xd39\xe3\xf2\xad\xdd\x1c\x1d/\xe2\xbd_\xc8\xca\x96g\x9aW\xfe\x04R\x8a\xf9\xbf\xb9#\x06\xeb\xc1I\xa7\x82\xd7\xba\x8a\xce=\x81c\xf8UH<"
+DATA ·d+27008(SB)/64,$"\x11\xa0jL\x14\xdd\xa4LN\x7f\xd2\x0f\x96\x98\xfa\xff\x00J\xe8\xe3\xf1\x0a\xdc\xc8le\xb7\x8d\xfb\xa3\x01\x90\xd9\xef\xc7J\xfa\xaf\xf6T\xff\x00\x82}\xfe\xd1\x1f\xb7'\x8b\x13B\xf89\xa3\x994\xd8\xa6\xdb\xa9x\x9a\xe8"
+DATA ·d+27072(SB)/64,$"\xb4Z&\x9e\xbf\xc4g\xba\xc6\x19\xc0\xe9\x0cE\xe5'\x00\x85\x04\xb0\xe5\xc4\xd7\xc3S\x83\xa9\xca\x9a]\xdb\xfc]\xcf\xa0\xc9\xe8\xe6\x18\x9a\x91\xa1\x16\xd4\xa5\xb5\x92\x7f\x82G\xce\x1f\x0d\xbfg\xbdO\xe2\x7f\x8f4\xaf\x86\x9f"
+DATA ·d+27136(SB)/64,$"\x0cb\xb8\xd7\xf5\xfdj\xed,\xb4\xed/J\x8c\xc8\xf2\xc8\xc4d\x96\xc6\x124\x07t\x927\xc9\x1ae\x98\x80+\xfa\xa6\xf0w\xfc\x10?\xf6H\xf8w\xa4x/\xc3_\xb4\xde\xa7\xe2\xd6\xd4\xf5\xab(\xb4\xfb\xfd\x7f\xc3\x1a"
+DATA ·d+27200(SB)/64,$"\xac\x96Zw\xf6\xec\x8c\xce-\xda\x12\xac\x169\x01\x10\xc1&G\x98\xe8\x15\x86\xf9\x17?\xae_\xf0O\x7f\xf8%\xc7\xc0\xbf\xf8'\x8f\x82M\xdf\x87!\x1e!\xf1\x86\xa3\x00\x83\xc4^3\xd4cXne\x8c\x9d\xc2\x0bH"
+DATA ·d+27264(SB)/64,$"\x8e\xe1mf\x8c\x7f\xd5#\x17\x7f\xbd#\xbb\xf3_V|{\xf05\xb7\x8f\xbc\x1fu\xa4\xdd\x16X\xae\x22\xdc\xbbIm\xb3'S\xf2\x95$\x10\x03pA\xe4\xe0\x82\x01\x1f\x99f\xbch\xb1\x15\x15,,y#\xddw\xef"
+DATA ·d+27328(SB)/64,$"\xaf\xf4\xcf\xea\xae\x0a\xf0\xaf\xeat\x1e#2\x97\xb4\xa8\xed\xa3\xd9.\xbbu\xfc\x8f\xcc\x8d\x13\xfe\x0d\xc3\xff\x00\x82u[\xf9r\x5cK\xf1\x06\xf27\xc1A/\x88\xa5U \x8c\x8c\x18\x91I\x07\xeb^\xdf\xe1\xaf\xf87\xf3"
+DATA ·d+27392(SB)/64,$"\xfe\x09\x81\xa2\xb2\xcbq\xe0\x9dWQ1\xb0\xe3S\xf1\x0e\xa9:\xb1\xc7\xf1!\xb8\x0a~\x84W\xdb\x9f\xb3\x97\xc4\x9d\x7fV\xf0\xd4~\x16\xf1\xa8o\xed=+\xfd\x0e\xe6W\xe5\xa6\xd9\xc0\x94\x9e73\x0c1a\x80\xc7\xe6"
+DATA ·d+27456(SB)/64,$"\xc0\xce\x07\xd9\x9a|\x82h\xc0'\x93\xc6\x7f\xaf\xe3_\x03\x99g\x99\x9d\x198\xcb\x11/\x93\xb1\xfa\xc6\x07\x832i\xc6\xff\x00T\x8b\xf5W\xfc\xcf\x80\xfe\x1c\xff\x00\xc1+?\xe0\x9e\x9f\x0b\xa6\x12\xf8;\xe0\xef\x81a\x94"
+DATA ·d+27520(SB)/64,$"`\x19\xee\xf4\xe8\xefd9\xf5k\x9f0\x9a\xe3>\x13h\x9e\x17\xf8\x7f\xfb0\xfcG\xf1\x15\x8e\x9bag\x1e\x9d\xe2\xef\x1b8\x16\xd6\xe9\x10\x8e\xc7O\xba\x9dc\x89v\x01\x84DET_\xba\xa3\x00\x0e+\xf5>8\x80"
+DATA ·d+27584(SB)/64,$"\xeb\x83\x83\x9e\x95\xf9'\xf1\xb3\xc4\x91|5\xff\x00\x82q|[\xf1\x14\xb8\xdf>\xad\xe3\x08\xa1Rv\xee\x97P\xd5\xa7\x85\x17?\xedo\x15\xc5\x95f8\x9c]hS\x95G&\xe7\x05\xabov\xcfO\x1d\x94`\xf0t"
+DATA ·d+27648(SB)/64,$"jU\xa7F1\xb4d\xdd\x92\xe8\x91\xfc\xfe\xfc{\xf0\xbf\xec\xcd\xf1\x89\xa0\xf8Q\xfbEx]\xb5Y4\xab+}&\xd3\xc6~\x1e\x98X\xf8\x8a\xd2kk\x1bt\x9eQ9\xccw\x8a&f\x7f.\xe40=\xf3_\x9e"
+DATA ·d+27712(SB)/64,$"\xfa\xb7\xfc\x12F?\x14\xdcG'\xec\xbd\xf1?\xc3\x1e&\x8adi\xa2\xf0\xef\x8eR_\x0dj\xe8\xa8\x1b\x7f\xefcYmf\xc1F\xc9E\x8c\x002}k\xb2\xb2\xf1\x9e\xb1\xe2\x1di\xf5\x8b\xe9\x0bN\xcdsp\xf2\x13"
+DATA ·d+27776(SB)/64,$"\x9d\xcf1\xc98\xf7\xda>\x95\xec\xba\x1d\xcc\x1a4\xb39\x12\xb1\xb9\x83\xecp\xb4N\x00\x10\xcb\x124\xf9\x07\x9c3\x00\xad\x8cpO=k\xfa\xda\x8eD\xe9\xc2\x16\x9f\xbd\x14\x97\x7f\xcf\xf4\xb1\xfc\xa7\x8f\x9e\x1b\x159{"
+DATA ·d+27840(SB)/64,$"Z)\xa6\xef\xa6\x8f\xf0\xea~T|P\xfd\x81\xff\x00l\x8f\x81\xac\xf7^2\xf85\xae\xdd\xda+eu=\x0d\x1f[\xb3u\xc6C,\x9a{Nv\xe3\x9c\xb2\x8a\xf9\x03U\xf1\xfb\xe87\x0f\xa6k\x1a\x15\xbe\x9d*\xf0"
+DATA ·d+27904(SB)/64,$"\xf6\xba\x8e\xebi\x01\xf7\x8etG\xfd+\xfas\xf0'\x8e<o\xe0\xa1\x1b\xf8CX\xd6l%D\xf2\xad\xe3\xb7\xbf\xb8HcE?y\xa3\xdf\xb1\x98\x8f\xba\x08\xc0\x1dk\xe9KO\x88\xfe8\xd6\xfc#}\xe3O\x8d\xba"
+DATA ·d+27968(SB)/64,$"\xb5\x9c\xda-\x8d\xb3K-\xde\xaf\xa6X\x5cL\xca\xbc\x00\xaf$\x04\xb3\xc8~X\xd7\x04\xbb\x1fJ\xd2\xa4\xb1\x94tU\x16\xbe[\xbfK?\xcc\xf1*p>[\x88|\xcd=5\xd5\xbb%\xebu\xf9\x1f\xc5]\xc6\xa5\x0d"
+DATA ·d+28032(SB)/64,$"\xec\xe6o\xb3\xc4Q\x9b\xe6PU\xb1\x9exe\xdaG\x15\xd3Ak\xf0\xfd\xe3I\x04\xf7\xca\xec\xa3r\x0c|\xad\xdf\x1c\xf2+\xf4\xa3\xfe\x0a\x05\xf0`x\xc7E\xf07\xed1\xf0\xf3M\x8e\xdbC\xf1E\x96\xa3\xe1\xc9\xf4"
+DATA ·d+28096(SB)/64,$"\xcb(\x92(\xf4\xed_D\xbb\x94\xcd\x11\xf2\xc2\x8671\xcag\x0cW?+s\x8d\xa0~k\x0f\x87\x1a\xd4)\xe6_K\x15\xb8\x1d\x9d\xb2\x7f!^tp\x98\x8aUe\x1ff\xaa[\xab\xd9>\xbd\xac|\x86eS\x0b"
+DATA ·d+28160(SB)/64,$"M\xc6\x0e\xb7.\x97V{\xa7\xb7r\x94\x16\xea\xd7\x04i\x92:1\xe1Y\xdd9\x19\xe0\x1d\xc4{qM\xd5\xfc\x14\xb3\xd96\xa3\xaa\xc5\x13\xe1\x8f\x1f2\xb1\x1e\xb9M\xc3\x1fJz\xe9\xd7\x9a4\xc3\xca\x8d\xae\x94\x1e\xaa"
+DATA ·d+28224(SB)/64,$"\xa4f\xb6\xdbR\xd2\xe6\x11\xc7\xa9\xdb\xdc,g\xfdb\xbc\xe8\xa3?\xec\x82\x7f\xa5(F\x97$\x95Wg\xd9\xecc,eXN2\xa1\xaa\xee\xb7\xfc\xcf!\x93\xc3\xd7\x10e\xb4ke\x00\xa8\xe9&\xf2?<\x11\xf9U"
+DATA ·d+28288(SB)/64,$"\x15\xff\x00\x84\xa6\xdeo,E)bp\xaa\xc3p?N\xf5\xebZ\x96\x91\xa5\xc4\xdev\x85k\x9d\xdc\xa9\xf3\xd1\xd8\xe7\xd5P\xd6d\xbe&\xf1\x1e\x9d\x0f\xd9.\xad'T\x03\x00\xa4c8\xf68\xcf\xeb^k\xc1B\x17"
+DATA ·d+28352(SB)/64,$"\xbd[\x7f\x87o\xd0\xf7(\xe6\xb5*/v\x9a\x95\xff\x00\x9b\x7f\xcd\x98V\xa3\xc6L\xa6\x19\xb1f\x8d\xd5\x9d\x18\x0f\xc8\xe6\xa6\xb5\x83YEb/\xd8m?v%\x00\xb7\xb8\x1c\xfe\xb5\x9d/\x89\xef\x1b\xe4+7\x94?"
+DATA ·d+28416(SB)/64,$"\xe5\x94\xac@\x07\xf9~\x95Z\x1b\xe9nd\xc4\x11\xec\xc9\xfb\xca\xdc\x8a\xde\x18\xaaZ%&\xdf\xa9\xa5JU,\xdb\x84W\xa2C/\xb5\xdb\xab\x19L\xae\xb7\xb32\xfd\xd3>\xd0\x9f\xa6+\x9b\xd4\xfce\xe2\x1b\xf62\xc9"
+DATA ·d+28480(SB)/64,$"\x1a\xece\x0b\xb1\x1e@\xbct\xf9Cc?\x85z\x0c\xdag\x8a\xa6E\x85\xee\x12Ee,\xa8B\x96\xda;\x8e\xe6\xb9]O\xc3\xba\x85\xb7\xcf,*A\xe4\x95\xc8\xcf\xd2\xb8\xb1\xb4q3N\xce\x5c\xa8\xf4\xb2\xfcN\x19"
+DATA ·d+28544(SB)/64,$"4\xe4\x93f5\x8e\xa1\xaej \xc1e\x1cd\xf0LrF3\xf8V\xc4~\x1f\xf1\xb1q \xb6\x823\xd3'n\xd3\xf5RH\xae>k\x8f\xb3>\x1a7\xca\x9f\xba\x09\x1f\xadI\x07\x88.! ,\xb70\x8f\xf6d"
+DATA ·d+28608(SB)/64,$"$\x8f\xc2\xbc\xeaX\xaaj\xca\xabn\xdd\x9d\xbfC\xd8\x9e\x1e\xab\xd6\x82\x8a\xbfu\x7f\xd4\xec\x8e\x83\xe2\x98\xf92\xd8[7\xacl\x10\xfeC\x22\xb2.\xf4\xdf\x18[K\xe7y\xebr@\x0a0\xc1\x8e=;q\xedU\xcf"
+DATA ·d+28672(SB)/64,$"\x895\xf6\x88\x08'\x96\xe1:\x8d\xea\xa7\xf3\xc8\xa5\x87\xc5z\xf2\xc5\xe5[J\xf1\x90\xd8\xf9\x02\xe4\x1f\xca\xba*b0\xcfne\xf3\xfe\x91\xcbJ\x86&\xee\xfc\x8c\xb7\xfd\xb3\xe2\xf8\x14\xc5=\x8d\xb1La\xfc\xc8\x00\x07"
+DATA ·d+28736(SB)/64,$"\xebU\xe0\xf1\x94\xb67K#\xc6Y\xd4\x9c,R\xb0Q\xbb\x821\xc8\xef\x5c\xf5\xcd\xfd\xf4\xa0\xad\xfc\xd7R\xa9;\x8a\x93\xd4\xff\x00*\x825\xb5p^(\xd8c\x91\xe6u\xae\x09c\xe7t\xe3'\xf3\xb1\xe8\xc3\x01N"
+DATA ·d+28800(SB)/64,$"\xcf\x9e\x0b\xe5u\xfa\x9d\xbd\xf7\x8a\xb4\xede\x04_cK6\xda\x06\xf0wn\xc0\xef\x91\xd6\xb8\xeb\xd6\x10\xb6\xe4\x93x\xe7\xa7j\xd5\xd3\xf5\x08,\xd4\xad\xc5\xb4S\x020C\x0f^\xfe\xd8\xadm\x15\xb4Y\xae\xc4\x9a\xb2"
+DATA ·d+28864(SB)/64,$"\x81\x11?u\x15z\x1f^\xff\x00\x95t\xd4\xab,D\xa2\xa55w\xf2\xb7\xae\xc7%8\xc2\x82\x97,\x1d\x97\xce\xff\x00\xa9\xc8\xd9\xf8\x93\xc8}\x92G\x1c\x9c\xfd\xe7\x07\xfab\xb7?\xe1&\xb2<\xb41\xe4\xf5\xc1\xff\x00"
+DATA ·d+28928(SB)/64,$"\xebWI\xe2\x0d;\xc26\x8f\xe7\xba\xdc4%\x82\xf9\xeb\x11T\x19\xe9\xdf'\xf2\xac#\xa7xS?\xeb\xe3\x1e\xc7\xcc\xff\x00\xe2j\xe5\x1a\x94d\xe9\xba\xf1\xd3\xcf\xfc\xcd\x95|=T\xaa{)+\xf9\x1f\xff\xd1\xfcU"
+DATA ·d+28992(SB)/64,$"\xd5~\x0di\x10Lm\x97P\x90\xb8\x1ec\x00\x22'i\xf5\xf9\xc1\xc8\xfaf\xb1\xad|!\x1e\x83l&\xb3\xbb\xf3\x028Us\xb2)\x0ey\xe01'\xf1\xe9\xef^\x8f}}\xa2\xa6\x9e\xd1A\xa4\x89exDw\x0c"
+DATA ·d+29056(SB)/64,$"\xd1$!\x1b<\xedv\xc0\xc9\xe9\x903\x8a\xf3+\xdf\x86\xc6\xfa\xc5\xee\xec\xaed\x87\xcd9H\xa2\x06}\xc0\x9f\x983\x82\xa5B\xe3\x9e9\xe9\xef_\xd7\xf8\xba\xb4\x94\xb9\xe9RK\xef?\xc7<\xbb2\xadR\x1c\x98\xba"
+DATA ·d+29120(SB)/64,$"\xed/4\x9d\xfeKo\xb8\xea\xc7\x88cU\x83M\xb2\xb9b\x04\xca\xd3Is\x22\xedR;\xefN\x08\xecki\xe6\xbb\xbd\x98\x0b]J\xc4\xbe\xec\x87\x8dr\xf9>\x84\xe7\x9a\xf2Q\xe1yt\xdb\x93iemy4q"
+DATA ·d+29184(SB)/64,$"6\xf6dW\x95\x01\x03\x1c\xe3*E^m\x12\xdfS\xbb\x86[y\x16)\xc8'&'\x80\xabd\x92\xed\x8c\x86=\xb3\xd8`s]\x18Lf-\xddJ\x9d\xfev6\xaf\x95\xe1\xbe(OM\xefd\xff\x00\xe1\x8e\xee\xf2"
+DATA ·d+29248(SB)/64,$"\xf7Y\xb4\x01_R\x95\xbc\xb6\xe4\x8e\x07=\x80\xedP\xbe\xad\xa83G,\xd7\x22u\x03\x98d\x8c2\x93\xeb\x92G\xe5\x5c\xc4\xdaq\xb0\xb8[\x1b\xdb\xfd\xf1\xb1\x05\x98\xc4\xcaO\xa9\xcbc${\x9a\xa1}\xe2\x9f\x0fi"
+DATA ·d+29312(SB)/64,$"\x90\xbe\x9f\x13n\x9c\xa9;\xdc\x128=p\x0fj\xed\x9e\x22T\xe2\xe5V\x5c\xab\xb5\xeerR\xcb=\xa3J\x94y\x9f\x94m\xfa\x1d.\xa7\xaa_]J\xb7Kd\x84\x0ew$1\xa0\xe7\x8eFH\xc7\xd6\xb0o\xb5]"
+DATA ·d+29376(SB)/64,$"f\xff\x00\x10\xcc\x96\xea\x11\xb8\xf2\x94FW\xf2<\xfeu\xce\xa7\x88\xee\x9eB\xc5\xe5\xdf\xb3\x0e@C\x90{\xf3\xd3\x8e*\xb9\xf1U\xd4Efh\xee\x1c\x06*\xac$\x03\xf0 \x03\x9f\xd2\xbc\xf9\xe7\xb4\xff\x00\x99\xea{"
+DATA ·d+29440(SB)/64,$"\x942Y\xc5+Aht\x8b\xe0\xfb\xdb\xa8\x85\xd3\xce\xf0\xb3\x10B1*_\x8c\xf3\x93\xf9U{\xff\x00\x09\xb5\x9c@\xcd3!$7\x0d\xbf\x91\xcey\xc0\xfeu@\xf8\xf2\xe2B\x12X\xbc\xc0\xb8+\xf6\xc3\xe6\xec "
+DATA ·d+29504(SB)/64,$"c\x8e:\x7f*\x9a\xe6\xe4\xeb\xb6\x912]\xa9\x93-\xe7F@\x11\xe0\x91\xb4&\x06\xefc\xfaW<\xf1xi+QW\x91\xac!\x8c\x84\x93\xad%\x14V\xbe\x7f\x05Co\x1b\xdd\x5c\x03:\x1c\xb9\x88a\x8f\x1d\xc0\xa9"
+DATA ·d+29568(SB)/64,$"c\xf1\xa6\x80\x96\xc6=:%\x9aP\xb8F\xba\x8fx\x04p9=\x7f*\xb1o\xe0(\xe7\x8c=\xe4\x90\x85Q\x91\xb8\x1c\x80z\xfd\xec\x0f\xc2\xb4G\x81\xf4X\xa4\xc6\x97xQ\xc8,\xcbq\x10T?\xee\x95$\x0c\xfa"
+DATA ·d+29632(SB)/64,$"\x13J\x9b\xc5\xa7\xeeEG\xf3\xfc\xca\xa9\x88\xc0\xb5i\xd4\x94\x9a\xfb\xbf#\x84\x8f\xe2\x1f\x8bE\xcb\x1b\x18\xed!\x7f\xefA\x0a\xab\x01\xeb\x9eqN\x9fQ\xf1\x96\xb7'\xfaU\xe3;\x92\x06\xc7n\xfe\x84\x80?\x9dwW"
+DATA ·d+29696(SB)/64,$"\x1e\x11\xb9\xb7\x88_X\xcd\x0c\x8er$(\xbc\xa1\x1f{r\x9ev\x9fq\x8f\xa5g/\x86co3\xcb\x12p2\x166;T\x9e\xe0\xf3X\xd4\xa7\x8aO\x96M\xbfFuC2\xc1|t\xe0\x97\x9d\xb5\xfcNb="
+DATA ·d+29760(SB)/64,$"\x1f\xc5v\xf1\x87\xbb\x86Ld\x8c+\x82\x0f\xe0I\xa8\x1a\xdfM\xba_\xb3^\x03ip\xa4\x95Y\xd0\x84|t\xc3v?Z\xeb_J\xd5\xed\x1bj\xdc\xce\xc8\x17qY\x9c\x1f\xe5\xcf\xe9M\xb9}V\xd5|\xdb\x94i"
+DATA ·d+29824(SB)/64,$"Wp\xc3\xf0\xe3#\x9fC[:\x16\xd2WW\xef\xa8\xe3\x989=\x1a\xf2\xb6\x87\xbd\xfe\xc8\xdf\x12\xbe\x1a\xfc5\xf8\xe7\xe1\xedo\xe3\xcf\x82t\x1f\x16\xf8Ao\xe1\x87]\x87R\x8d\xae\x12\x0b9\x1c+\xdd\xa4h\xc3{"
+DATA ·d+29888(SB)/64,$"[\x7f\xadh\xdbp\x920\xe9\x8c\x95\xaf\xf4\x88\xf8O\xa8\xf8>\xd3\xc3\xb6\x9e\x1f\xf0\xdd\x86\x9d\xa6\xe9k\x14gN]\x128\xd7L\x92'P\xd1\x98U\x15BoB\xad\xb1\xd41\x07\x82\xc3\x9a\xff\x00/\x8b\x08n\xef"
+DATA ·d+29952(SB)/64,$"'\x0fm\x11\x8b\x03pa\xf2\x83\xf8W\xf5\x7f\xff\x00\x04\x99\xfd\xa8\xbe'\xea\x7f\xb3J\xdb\xf8z\xee[\xaf\x12\xfc2\xd4 \xd2u]+R\x9c\xbf\xf6\xaf\x86\xaf\xd8\xbe\x992\x97\xe1\x1a\xd9\xc4\x96c'i\x08\xbfw"
+DATA ·d+30016(SB)/64,$"#\x1f3\xc4\xd9\x1c\xf1\x11\x8bR\xd7e}\xbc\xbf\xcb\xa9\xfb\xb7\x82|cG\x0b\x89\xa9\x83\xa9\x05y{\xca\xdb\xf9\xfe\x1a\xd9[\xafS\xfa\xb96O\x10R\x83jm\xc6\xc1\xf3B\xe0\xf5\xc6yS\xea\x0eG\xa5r\xba"
+DATA ·d+30080(SB)/64,$"\x97\x87\xd6kv\xb7\x8dp\xa4aT\x0e\x06:~\x95\x99\xf0/\xf6\x80\xf8\x7f\xf1\xcfGO\xec\xc2\xda~\xad\x1a\x0f\xb6\xe8\xb7\xa3\xcb\xb8\x85\x80\xe7\x0a\xdfx}3\xf9W\xbd\xcd\xa3@c\xc2\xa8'\xda\xbf\x17\x9e&\xa6"
+DATA ·d+30144(SB)/64,$"\x16\xa3\xa5Z-I\x1f\xd9T\xa8F\xbd5:R\xbag\xc2'I_\x87\x1e?\xfbt\xdb\xc5\x9e\xaa\xc3\xcbl|\xb1\x5c\xa8\xf9\xa3\xcf\xa4\x83\xe6_|\x8fJ\xfa\xf7\xc3Z\xe5\xbc\xf0\xc7\x220 \xe0f\xb8\xaf\x88\xde"
+DATA ·d+30208(SB)/64,$"\x11\xb4\xd64\x89\xf4\xed@2\xa3\x8d\xcb$|<n\x87)\x22\x1e\xcc\xa7\x04W\xc8~\x03\xf8\x9b\xe3-\x02\xf2_\x0dx\xc6\xde;[\xebw|\x88\xa4\xf3Rh\xd5\x8e\xc9\xa3l.w\xa6\x1c\xae2\xa4\x91\xdb5\xed"
+DATA ·d+30272(SB)/64,$"\xca\x87\xd7i\xa9-\xd1\xe5,C\xc2T\xb4\xb6?P\x11\x95\xd5J\xe3\x1dk\xf0\xdb\xfe\x0a\x13\xac\xdb\xe9\x7f\xf0O\x89</!\xc4\x9e#\xf1\xed\xc4J\xbdK\x22\xea\xb7\x17O\x81\xdf\xe5J\xfdq\xf07\xc4\x1b\x1do"
+DATA ·d+30336(SB)/64,$"MWw]\xe0r\xb9\xc1\xce3\xde\xbf\x0e?l\xdb\xf9\xfe!\xfc8\xf8o\xe0\xf9#\x92\xe69\x8d\xff\x00\x8ab\xb6\xb4\x89\xe41\xb3\xcd$[\x89Ps\xb8\xca\xddx\x00\x0cw\xa8\xe0\xbc\xaeP\xcc\xe9\xaa\xbbFI"
+DATA ·d+30400(SB)/64,$"\xfd\xc9\xb28\xc31\x8c\xf2\xea\x9e\xcd\xeb(\xb5\xf7\xd9\x1f\x89~\x17\xf8,\xd2\xa8\x96\xddN[\xe7!\x87@k\xd2\x93\xe1.\xad\x11B\xb1\xb1\x0a1\xd2\xbfN~\x16~\xce\xda\xbd\xe6\x97\xfd\xa1-\x9c\xa8\xb8\x0b\xfb\xe8"
+DATA ·d+30464(SB)/64,$"\xca\x90>\x8c\x07^\xd5\xea>*\xf8[\xe1?\x87\xba\x05\xd7\x8a\xbcs=\xa6\x95aen\xd7wR\xdcH\x81\xc4J?\x86 w\xb9$\x80\x02\xa9$\x90+\xfa2\xaf\x17G\x9f\x91;\xb3\xf0\xaa\x5c*\xf99\xe5\xa2"
+DATA ·d+30528(SB)/64,$"?4\xbc\x19\xf0\xbe;hd\xd6\xbcY$VZm\x8c-w\xa8^]\x1d\x91A\x02\x0c\xb3\xbb\x1e\x00\x1f\xcf\xd7\xa5~\x5c~\xd6_\xb5<\x9f\x18|@\x9e\x16\xf07\x9bi\xe1M\x22B\x96\x10`\xa1\xbau\xe3\xed"
+DATA ·d+30592(SB)/64,$"R\xaf]\xcd\xfc\x00\xfd\xc5\xff\x00h\x9c{\xaf\xed\xb5\xfbOk\xbf\x15\xe5o\x04\xf86\xde}#\xc2\x10\xb8\x92+s\xf2\xdc\xea2G\xf7g\xbb\xc7@\x0f\xfa\xb8y\x09\xd4\xe5\xfa~`Ih\xe4\xe38\x04|\xd9<"
+DATA ·d+30656(SB)/64,$"\x9fZ\xfdG\x852\x89T\x9cq8\x9d\xfa.\xdf\xf0O\xc4\xbc@\xe2?gNXL&\xdd_\x7f%\xe5\xf9\x9fc\xfe\xcc\x9e/\xf0/\xc4\xff\x00\x05k\xff\x00\xb1\xdf\xc6K\x88\xact?\x18<w\xde\x16\xd7\xee\xb9"
+DATA ·d+30720(SB)/64,$"\x8f\xc3\xde.\x87\xe4\xb3\xbb\xc9\xfb\xb0\xdc\xe4Ap7\x00A\xe7;\xc9\xaf\xc6\x9f\x8eZO\xc4\x7f\x87?\x135o\x85\xbe6\xd2\xe5\xd1u\x8d\x02\xfaM3Q\xd2\xdd\x9f\x11\x5cE\xd5\x97\xee\xf9\x91\xc8\xb8\x92):I"
+DATA ·d+30784(SB)/64,$"\x1b+\x0e\x0d}\xa9k\xa5\xcdp\xebeg\x13K,\xf2,\x10\xc0\x81\x9d\xe6y\x0e\xd5DE\x04\xbb1!B\x80K\x13\x80\x09\xafK\xfd\xaa<y\xe1\xfdg\xc2\xba_\x80\x7fi\x88l\xb5O\x88\x1a\x05\xb0\xb0\xb0\xd5"
+DATA ·d+30848(SB)/64,$"\xacn\x12=gK\xd3\x8c$\xc1g\xaf\x5c\x82\xe9w$LCE\x01\xcc\xf1'\xfa\xc9\x06\xf2\x0f/\x889\x0d._k\x0a\xea<\xdb\xc5\xf5}\xd7\xea|\x8f\x0bf\xf1\x95.\x5cE\x1eiS\xf8e\xe4\xfa?\xd3\xee"
+DATA ·d+30912(SB)/64,$"G\xe3\xba\xbf\x88\xcc\xbekn<\xe7\x1bN+D\x0f\x10\xc7\x1bH\x12E\x0f\x92\xcc\xa8\xbc\xe7\xaex5\xee\xb1Ci\x02c\xc3\xfa\xc5\x95\xc1l\xe6\xdeP\xa5\x97\xf3\x06\xb9\x0dN-If\xd9\xab\xc7$JO\xfa\xeb"
+DATA ·d+30976(SB)/64,$"N\x06>\x9d\x0f\xe7_\x92\xff\x00c\xc6\x0a\xfc\xcf\xf3\xfcO\xa5\xa1\xc4^\xd2v\xf6i}\xe9\xfd\xcd#\xca\xad\xee.\xe2\x985\xccpL@\xc6\xe9!\x1b\x87\xe21]\x9c~ \xd6/,\xc5\x9c!cU\xe8\x02\xf4"
+DATA ·d+31040(SB)/64,$"\xfc\xebP\xf8KG\xd5\x10\xbd\x96\xaa^B2#\x95B8\xf6\xc1\xeb\xf8\x1a\xa4\xde\x18\xf1\x1d\xablHdu?\xc5\xb3n\x7f\x1a\xbc>\x1e\xad+\xd9h\xfb[\xf4:k\xe6\x18z\xcdj\x94\x97{\xaf\xcc\xaf\x05\xbf"
+DATA ·d+31104(SB)/64,$"\x89\xae\x94\xda\xc5\x89\x94\xf5\x08\x8a\xccN;dV\x05\xf7\x87.\xe1}\x97R\xfd\x9c\xe7\x0c\x92\x80\xa5}\xb89\xfd+n\xebJ\xf1Lk\xfb\xb0\xf1\xb0\xfb\xbb\x1dA\xe7\xe8A\xaeb}\x1b\xc6W\x0f\x89\x04\xees\xd7"
+DATA ·d+31168(SB)/64,$"\xcc\x1f\xa9\xddEj\xf0\xe5\xb4b\xd9\xdb\x83\x8c\xaf\x7fi\x14\xbf\xae\xe6\xa5\xa7\x87\xefmd[\x8bk\xb7dc\xb4J\x92\x05A\x81\xc8\xcb0?^*}F\xee\xe3Lu\x8e\xf2I\xe4\xdd\xd1\x93d\xab\xf8\x90\xd5\x9f"
+DATA ·d+31232(SB)/64,$"k\xe0-b\xecfy\xfc\x8f_49\x1f\x98\xcdT\xd4\xbe\x18jv\xa0H\xd2#\x828\xc3\xec\x04z\x8c\x91P\x96*4\xefF\x0d|\xf4\xfb\x8d\xe3S\x0b:\x89U\xac\x9f\xc8\xeba\x7f\x0b\xcfj_P\x8d\x89\xdb"
+DATA ·d+31296(SB)/64,$"\xc3\x84\xe8O\xaa\xa9$\xfe\x15\x87?\x87-M\xa8\xd4\xad\xad\xed$\xb7v\x226&u'\x1c\x13\xb4\xaeEr\x96\xfe\x1e[IBM,q\xb09\xe6t\x07\x9e\x98\xe6\xbdOM\xd3\xf5\xcbKp\xe3\xcf\x9a\x11\xd0H"
+DATA ·d+31360(SB)/64,$"\xa5\xd0\x0f\xaf5\xd3\x86S\xc5>Z\xb0\xd9t\xb5\xfe~F\x18\xb7\x1c*r\xa5Ww\xd5\xd9|\x8a\xbe\x1c\xb6\xf0\xac\x08\xc3T\x92(\x18\x9c*D\xcd\x8cw\xce\xfej\x1f\x12\x7fg\xdb\x87\x97\xc3\xb1\xdb\xc8@\xccj"
+DATA ·d+31424(SB)/64,$"Syr{\x17\x12\x0c}v\xd6\xf3i\xdaN\xa2\xa6K\xbd(\xb7\x1bK\xc1\x91\x83\xeb\x93\xc0\xae^\xf3\xc2\x1aB3M\x04\x17\xf1\x83\xce\x01S\xfa\xd7n2\x8dH\xd0T`\xd7\xf8\x93i\x9en\x1b\x13NX\x8fm"
+DATA ·d+31488(SB)/64,$")K\xd1\xd9\xaf\xce\xe2\x03\xab\xeaZ\x01\x11\xe9\x16\xf1\xdd\xb0_\x99^9H\x1d\xf6\x86 d\xfb\x9c\x8a\xe1\xec\x85\xc8\xd4\x05\x96\xa9\xa7\xcb\x0b\x13\x83%\xc4h\x88\xbe\xf9O\xf15\xbb\x17\x87 \xd4%\x16z`\xb9\x86"
+DATA ·d+31552(SB)/64,$"V<4\xf2\x0f\xd0\x03X\xf3xKW7\xbfa\x9e\xe6B\xe0\x95\x1elR\xed\xcf\xfb\xd8\x22\xbeg\x19F\xac\x9c&\xa6\xdd\xb4\xe9g\xf8-O\xa8\xc1\xd4\xa5\x05R\x0d\xa5}z\xdd~,\xe8m\xbc35\xcbf\x08"
+DATA ·d+31616(SB)/64,$"\xed\xa5\xe3\x0cRf\xf9O\xb8\x1d\x7fJ\xab\x02\xff\x00\xc29s(\xd7l\xe2F\x04\xf9^V\xd6\x04v!\x8b\x13\x9flU\xcb\x0d'S\xf0\x95\xcf\x9b\xa9\xb3\x95*Q\xca\x11\xf7OQ\xcfo\xc6\xb7E\xc7\xc3\x9dV"
+DATA ·d+31680(SB)/64,$"6\x8c\xb6\xc9\xca\xe5D\x99\xe5\xbd=+\xd8\xa3\x86v\x8c\xf9\xf9&\xba6\xad\xf9~\xa7\x9bS\x1b%&\x9as\x83\xea\x93\xba\xfc\x7fC\xcf\xb5\xaf\x17\xd8\xdf\x8f&\x0b\x97\x84nRX\xaa4\x9f/\xa3\xf3\xb4}\x05P"
+DATA ·d+31744(SB)/64,$"mCK\x90\x99$\xbb\x05\x98\xeebd<\x93\xd7\xf8k[]\xf0\x14\x16\xe7\xce\x8a_\x91\xb9\xc1\x88\xb0\x03\xea\x80\x8a\xe6\xbf\xe1\x0a\xb7\xff\x00\x9f\xb8\x7f\xf1\xf1\xfd+\xc3\xc6}a\xd4ni7\xea}>\x0exIR"
+DATA ·d+31808(SB)/64,$"^\xcd\xb4\xbd\x0f\xff\xd2\xfcU\xb7\xd6 \xb2\xbc\xb7\xbb\xb4&\x18\xe5|4\x9bZ\xe2>\x08\xf9\x8b\xb6W\x85\xcf\xf0\xe4zV\xc7\x88|}\xe2M\x17\xc4\x7f\xd9z\xfd\xba%\xb2\x22\x95\x16*#\x90\xdb\xe4\xb2\x992\x8a"
+DATA ·d+31872(SB)/64,$"\x1c\xb09$\x0c\xd7\x9dA\xabkv\xbaL\xa96\xb3gkn\xa3tV\xce\xbf\xb9\x96W\xfe\x16D@\x06\x07\xde\xdd\xc6k\xce5\xaf\x13j\x17\x13\xb6\xb5\xa8\xb1\xb8\x92\x19\x11^\xe2q\xbc\xe5\x87\x18\x8b\x04m\xe3\xae"
+DATA ·d+31936(SB)/64,$"=>\x95\xfd\x05\x1e\x22\xc6s^Sh\xff\x00$p<9\x1a\xb5\x1b\x94\x14\x92\xba\xeb{\xf4\xb3\xb7\xf9\x9e\x99\xae\xfcB\xfe\xd9\xbch\xed\x8b\xc4\x1dHV\xb6\x13\xe1q\xc6\x0a)^\xbcz\xd7\x9a\xdf\xf8\xbb\xc4\xbaU"
+DATA ·d+32000(SB)/64,$"\xd7\x91e4\x0b\xcf\x94\xdetm\x1c\x8aGv,[9\x1d\xebf/\x1a\xe8\xae\xcb\x15\xfd\x94\xca\xd3\x80\x0b\xe9\xee\xab\xbd\x09\xc7*\x80\x1c\x9f~\x9e\x95\xd1B\xfe\x0a\x86\xed\x97L\xd1.\xe1\xc8\x0a.&\x02Y3\xdd"
+DATA ·d+32064(SB)/64,$"\xb1\x9c\xfdx8\xafb\x18\xb9Ujo\x12\x9b=\x9c>\x1e\x18E\xec\xa5\x85n>vk\xef\xbf\xe4s\xd3k\xbe&\xd6\xac\x86m\x9e\xf6>7\x08\x8c\x8es\xef\xc0<v5\x8b4?a\xb8T\xd4\xad\xda5\xdb\xb9"
+DATA ·d+32128(SB)/64,$"L\xd1\xb2\xb1\xcf\xf0\x82\xeb\x9e+\xd6\xadn\xb5\xdbW6\xd6\xcfx\x91?*6F\x9d\xb8'i\x0d\xc7_\xe9Z\x9e\x1a\xf8i\xe2O\x8a\xf7rxf\xd7W{G\x82\xd2{\xd9\x9a\xed\xca\xf9\x91\xc3\x82\xca\xa3\xcac"
+DATA ·d+32192(SB)/64,$"\xbf\x07 \x12\x01\x1dH\xae\xaa\xd3\x9bWw\x97\xad\xacr\xd1\xcdiR\x94\x94\xd4a\x1f&\xff\x00\x1b#\xc3n`\xbd\x10Ay,w\x09ot\xee!\xbah\xd8C?\x96@`\x8c\x06\x0e\xdc\xe0\x81\x9cq\xeb[)"
+DATA ·d+32256(SB)/64,$"qwi\x19k\x08\xa5\x80\x89\x02\x09\x08-\x1b\xff\x00\xdf[x\xcflWA\xa8|<\xb7\xf0\xd6m\xb5-Ji\xe2\x85\x98F\x16B^2O\xce\xca\x88v\x82\xc4\x0c\xe3\xa8\x1e\xd5\xcf\xe8z\x17\x86\x92Y'\x94\xbe"
+DATA ·d+32320(SB)/64,$"\xf1\x99\x22\x92\xf2f\xd9\x8c\xe3\xe5E\xe7w\xbe\xee+\x8a\xaf5?z\xd6=?\xaf\xe1j\xc1\xd4\x83r\x8f\xa3\xb3\xfc\xbf\xae\x87Ee\xe2\x1dL\xe6\xeb\xc4V\x91G\x1b\x86\x5c\x98B\xb0`z\x8e\x07\xe5\xe9]\x0c\xfa"
+DATA ·d+32384(SB)/64,$"\xee\x9f\x05\xb7\x97o=\xbd\xb1\x99B\xc8\x04\x0c\x920=\x8f\x1d\xfdEp\xda\xc4\x96:m\xc2\xda\xc7s\x16\xa6|\x91\xb9m\x7f{\xb9\xf9\x0d\xdf<\x0c|\xc7\x19\xf7\xeb[6\xf3G:\xb5\xef\x88t\xdb\xb4\x02\x12Q"
+DATA ·d+32448(SB)/64,$"\x93\xf7N\xd8\xe7pFo\x98\x0f`qWC\x1f\x88~\xed8\xf3}\xe7\x93\x8a\xc0BV\xa8\xa2\xd2{%\xfeL\xe9\xe2\xd4\x92\xe6u\xb5{\xf8\xc0\x943\x85\x91\x11Q6\x8c\xf5\xceG\x03\xa69>\xf5\xado\xab\xc7"
+DATA ·d+32512(SB)/64,$"&\x90\x0a\xccdM\xe72\xc7\x13)#\xd8\xb0\x19\x03\xb8\x15\xc0hw\xfe\x07\xd45\x06i\xa4H\xd4m\x11\xc1\x1d\xa3I\x891\x80\xa4\x82\x0f=\xf9\xae\x93\xfbS@\xd25_*\xe29\x16\x13\x91\x18\xf3\x90\x04\x5c\xff"
+DATA ·d+32576(SB)/64,$"\x00\xcf6\xc8\x07\xfd\x93\xda\xbd(\xe2\xeai\xcd5\xf7\xff\x00\xc1<ln\x5c\x93\xe4T\xdd\xd5\x9e\xc9\x7f\xc3\x94\xa7\x83^\x05\xae,\xa3g\xfd\xdf\xee\xae!\x93\x04\x9c\xf3\xb87\x5c\xf7\x1d\xbdk8\x7f\xc2Qn\x9b]"
+DATA ·d+32640(SB)/64,$"&A\x81\xbb8\x19\x07\xa7\xdd\xed\xf8Wi>\xa7\xa5\xdf,v\xeeo\x22\x96R\x92\xc1\x03\xc0\xeav\xb1 \x9d\xc9\xf7\x97o#\x03\x93Y\xda\xd4\x9f\x0e\xfe\xc7\x14p^\x5c\xdcN\x00\x8f`%\x1c\xbb\x1eB)!\xf6"
+DATA ·d+32704(SB)/64,$"\x8e\xd9\xfaSU\x13n\xf56\xf3\xff\x00\x80U,ENh\xc2tw\xed\x1b\xdb\xf4Fv\x96\x1e+id\xd4nDM\x19VX\x0cl\xd2I\x93\x82C`\xa8 s\xcf^\xdc\xd7C\x0d\xe4q [\x08\xae\x95\xc1"
+DATA ·d+32768(SB)/64,$"\xc9\x94D\xf8!\xbb\x0e\xc7\xf2\xaf?\xb0\xf1\x96\x8d\xa4\xddb\xc2\xd1X\xfd\xd8\xdaB]\xbf\x1d\xc0\x81\xf9W]\xa8|[\x92\xe5T\x1bK\x89$B\xb8\x9e6\xe0\x22\xf0\x02\x04@\x07\xe9M\xe6\xf4\xa2\xac\xaa~\xa1\x8c"
+DATA ·d+32832(SB)/64,$"\xcb1S\x92\xf6tn\x9f\x9a_\x81\xd3\xc3\xab\xce#\xf3\x16\xdah\xca!\xcbya_\x1f\x88\xcd}\xa7\xff\x00\x04\xdc\xfd\xa8|\x1f\xfb6\xfe\xd2\x83\xc6\xff\x00\x13\xae\xb5O\xf8Fu?\x0d\xea\xde\x1d\xd7\x84Q\xcb}"
+DATA ·d+32896(SB)/64,$",\x90\xddF\xb2[\x04\xb7\x8b%\x9e+\x88\x91\x94\xe3\xe5\x1b\xb9\x199\xfc\xe2\x8b\xc7\xf7:\xa4\xea\x9fe\x9b\xccf\xc3\x06\xe1\xb6\x8fS\xd7\x00z\xf1Xw\xbe!\xbfK\xa6\x11E\x1a+7\xcb\x9f\x9b\x1e\xe7\x15\xc9\x89"
+DATA ·d+32960(SB)/64,$"\xcdiTN\x12\x9bh\xf5xr\x86+/\xc5C\x13N*2\x8e\xba\xbf\xc3\xbe\xa7\xf7U\xf0C\xe3\x87\xc1O\x8e\xb0\xdb\xeb\xbf\x05\xfcY\xa6j\x97\x91\xc6\xb3\x01\xa7\x5cy:\x95\xa9\xc0$Kk&\xc9\xd0\xa9\xea\x8e"
+DATA ·d+33024(SB)/64,$"\x9cW\xe8\xc7\x86?k\x9f\x88^\x07\xb5\x8fM\xf1\xae\x91.\xbf\x1e\x0cq^\xd8\x9d\xb3\x02\xa3#\xed\x03\x04\xaf\x1f\xc4A\x1e\xad_\xe6\x99\xa4\xf8\xdfS\xd1\xf5+}gO\x9a{{\xdbc\x98nm\xe4h%\x8c\xf3"
+DATA ·d+33088(SB)/64,$"\xccr\xc6D\x89\xc1\xc6U\x85~\x99\xfc\x03\xff\x00\x82\xc0\xfe\xd4?\x08\x16\xd7D\xd55\x88\xfcU\xa6\xda\x84\x8d\xb4\xff\x00\x14\xc4o$X\x94\xf2\xa9\x7f\x19K\xa5$p\x1aC1\x1e\x84q\x5c\xb8\xcc\xa3,\xcc#\xcb"
+DATA ·d+33152(SB)/64,$")\xab\xf6\x96\x9ft\x97\xebc\xfa?\x86\xfch\xab\x87\xfe='\x1f8\xea\xbeq\x7f\xa5\xcf\xf4\x0b\xd2?h\xbf\x84?\x11l\xbc\x9bmZ\xde\xce\xe4(\xdfi~\x04n\xacGL\x92T\xfeu\xe0\x7f\x16|\x01w\xad"
+DATA ·d+33216(SB)/64,$"*\xeb\xda3\xa4\xb1\xc22\xf7\x16\xe5\x19\x96!\x9cH\xac\x0e2\x99\xc9\x07\xaa\xe4zW\xf3\xc7\xf0\x8b\xfe\x0bS\xfb$\xfcHak\xf1\xdf\xc2\xda\xbf\x87\xa5\x0aY\xee\xed\x22\x8fZ\xb4#\x8f\x94:\x08\xee\xd3'\xb9\x84"
+DATA ·d+33280(SB)/64,$"\x8cw\xcf\x15\xf7\xb6\x81\xf1\xf7\xf6@\xf8\x85og\xa7\xfc!\xf8\x8b\xa5Y\xc34f\xe6\xdfN\xf0\xb6\xb3s\xf6\xe6\x92nq,\x02X\xf1 \xe9\xb6C\x91\xd3h\xaf\x9f\xc2\xf0|\xb0\xd5-A\xb6\xbf\xf0%\xf7\xc6\xe7"
+DATA ·d+33344(SB)/64,$"\xeb4<G\xc1\xe6\x10\xb4j\xc1\xbf^Y}\xd2>\xc6\xf0\xd6\xad\xe5x\x8e\x0f\x85\xfa\xea.\xa5%\xfcf[\x99\x107\xd8\xc5\xa9\xf9IP\x08\xdc\xd3\x1f\x95x\xc0\xf9\x88\xce\xda\xf3\xdb]\x03T\xf8\xbf\xe3P\xba\xc6"
+DATA ·d+33408(SB)/64,$"\xa3\x1e\x8d\xe0\x9f\x0b\xd8\xc1\xa6\xda]H\x82\x0by$\x5c\x89!\x8bw\x96\x03\x02T)F\xc7PA8\xaf\x1b\xf83\xe1o\x12i\x9e;\xd5\x7f\xe1\x19\xf1O\x8b\xef\xf4\xf9\x02^\xdc\x9f\x15\xea\x11\x13\x04Q\xc6\xd9\x10"
+DATA ·d+33472(SB)/64,$"D\xb1n\x01\x0a\x9d\xa0\xc8v\x06\xe0\x0e\xfd\xb5\xbe\xbb}\xe2\x9f\x0ci:\xa4\xb7\xd766\xba\x5c-m\xa6\xe4\xc4b\x8cG\xc2\xb3\xb4\xa1\xc1v\xc0\xcb0RNK\x1c\xd7\xa5<\xaaq\xa8\xf97\xb2\xd6\xcfK\xf9["
+DATA ·d+33536(SB)/64,$"~\x83\xfe\xd6\x8dJq\xf6\x8bK\xde\xd7Z\xa5\xe7\xff\x00\x04\xf6\x1dG\xe2.\x83\xa2\xdd\xdb\xf8/\xe1G\x86o\xde\x19\xcc\x8b\xff\x00\x09&\xb4[\xca\x89!\x01d\xb9ky\x99%xQ\x8e\x14\x9d\xac\xc7\xa0#\x9a\xfc"
+DATA ·d+33600(SB)/64,$"\xaa\xf8\xf3\xe1O\x1d|O\xf1\xd5\xe6\x9do\x7f\x16\xb9\x05\xb4\xad\x0c\x8a%\xfb-\xc0\x08\xc4oKYBn\x8c\xf6e-\x9f\xa6+\xe9\xdf\x1d|r\xf8Oyd\x96\xff\x00\x11\xbc\x7fg\x0b\xda#mk\xadv\xd2\xdd"
+DATA ·d+33664(SB)/64,$"\x1f<\xb4r\x18\xcf\xca\xc3\xa2\x1e\x87\xa1\xeck\xe0O\x1f\xfe\xdb\xff\x00\xb2\x86\x83\xa9M\x15\x9f\x8c|3\x14\xa5\x18]^\xc7x\xfa\xb5\xcc\xcb\x9e\x88\x13z\xac\x80|\xb9\x00\x12:\xf4\xaf\xb1\xe1\x8c\x8e\xb59\xf3\xd2\xa4"
+DATA ·d+33728(SB)/64,$"\xdb\xeff\xf5\xefw\xfa\x1f#\xc4\x99\xed*\xb1P\x9dT\x97d\xd2\xd3\xb5\x97\xebs\xe6_\x8c\x7f\x02\xbcW\xa7\x5c\xcb\xa3j:\x16\xaa\x93yH\xf0\xaf\xd9%\xdc\xcb&v\xb0!J\xb0\xc8\xc7\x06\xbc;I\xfd\x8f|"
+DATA ·d+33792(SB)/64,$"c\xa9]\xc3o\xe2\x03\x1e\x98%uH\xed\x94\x0b\x8b\xf9\x1d\xba*@\x84\x85'\xb6\xf6\xf7\xc5z\xcf\x8c\xbf\xe0\xa6\x7f\xb3\xd6\x97\xa6\xb6\x9d\xa2k\x1a\xe6\xa0\x100T\xb6\xb2\xb9\x0b\xcf'i\x93b\x80O\xa6\x06y\xaf"
+DATA ·d+33856(SB)/64,$"\xcf?\xdas\xf6\xfc\xbb\xf1\xdf\x82#\xf0W\xc0F\xd64\xb9\xb54\x9d<I\xad](\xb4\xbe[r\xc0Ge`\xd1H\xcd\x1cR\xa6M\xcc\xdb\x96F\xe25\xc2\x92k\xf4L_\x13\xd4\xcbp\xd7\xac\xd4d\xb6K\x7f"
+DATA ·d+33920(SB)/64,$"\xbb\xb1\xf9&a\x82\xcb\xab\xce\xeeWO\xbe\xdf\x81\xd4\xfe\xd0\x1f\xb5?\xc2O\xd9\xc2K\xcf\x83\x7f\xb3&\xfb\x9f\x17\xa9}7\xc4^?\x8d\x96\xe1\xb4\xf7\xc1Y\xac\xb4\xa9\x88*\xd7 \x92\x93\xddD<\xb8N\xe8\xa1-"
+DATA ·d+33984(SB)/64,$"*\xbbG\xf8\xfd.\x88\xb7\x17\xad,^yydyd31\xde\xcf#\x17wvl\x96fbY\x99\x89fbI$\x92k\x7fH\xf8E\xe3I K\xbb;Q\x1c\x08\xaa\xb0\x8c\x85U\x00aB\x04\xe0\x000\x00"
+DATA ·d+34048(SB)/64,$"\x1d1S\xde\xf8[\xc6v\x11yw\x09;\xb1;N\xe4'\x00\x1e\xccG?\xce\xbf)\xc4\xe6\xf8\xdcd\x9dlT\x1bOm4<\xba\xf9\x96\x0a\x13tpu\xd2_\xcb\x7f\xd1h?D\xb2\xf0\xcc\x12\x01\xaeF\xe3o"
+DATA ·d+34112(SB)/64,$"&X\x88V\x04w\xcf\xd2\xb6\xf5\xaf\x19\xd8\xcd\xa5\xc9\xa2\xe97N\x88$\x0d\x0c\xcb\xbd\xa6\x5cv\xcf\x0aA\x1dA\xc8\xaf/o\x0ax\x9e\xee\xe1\xa2\xb8YQ\xb7g\x12\x828=\xeb\xae\xd2\xfe\x11\xdf\xce\xa6\xeaK\x98"
+DATA ·d+34176(SB)/64,$"\x02\x0e\xbf\xbeE\xc1\x1fS\x9f\xd2\x8aU\xebT\x8b\xa5N\x9d\x93\xf9hy\xf8\xbaX(O\xdbW\xc4]\xad\xb5\xba\xb9\x945\xdda\xddv=\xd3\x10A\x12$\x08\xcc\x7fZ\xc9\xf1\x14\xd0\xeaS\x0b\xadf\xe7U\xf3\x06"
+DATA ·d+34240(SB)/64,$"\xd1\xf3\xc6\xca\xbf/\xb6\xfd\xbf\xa5z2h\x0f\xa5\xc2\xd6\xd1^\xa0\xe4\x8f\x94\x86bG\xa6\x05sW\x9aV\xbf\x1b\x06\xb5\xbdI\x94\xf5\x8aR\xa7\xa7N\x0drbr\xe7%j\x97~W\x7f\xe6u`\xb3*2\x9f4"
+DATA ·d+34304(SB)/64,$",\xbc\xf6\xfd\x0f<7v\x9aU\xd7\xdat\xc8g\x05q\x9c\xb2\x81\xc7\xb1SV\xee|k}v\x9eP\xb6\xb7\x19\xe0\x96\x8dX\x91\xef\x80+\xa3}bM07\xf6\x8e\x9d\x04\xc3\x04\x16\x8c~}+.\xd6\xf8^\xcb"
+DATA ·d+34368(SB)/64,$"\xe6hMo\x04\xcd\x8f\xdd\xcf\x1f\x19\xf4\x0csX\xd1\xa3\x08'\x18;_\xa5\x8fr5#?z\xa5+\xdb\xabw2,\xfcC\x0aH\x05\xf5\x99+\xd0\xfd\x9c\x94\xc7=pr+\xb1\x87T\xd0oHd\x91\xe0e\xc9"
+DATA ·d+34432(SB)/64,$"V\x96\x22\xc4}O4\xd7\xb9\xf8\x85\x16R\xe2\xda\x09\x14\x7f\x12\xc2\x84\x1f|\xa8\xad\xbd\x1d|Mu\x93\xa8XF\xf1\x11\x9c\xedQ\x80?\xcfz\xf6\xb0\x14\xb9_*W\xbfx\x9evcV\x94\x93\x9a\xb2\xb7i\xff\x00"
+DATA ·d+34496(SB)/64,$"\x9a+6\x8b\xe1-T\xf9\xfa\xbd\xd5\x95\xc6\xd5,\xa1N\x1c\x9fA\xf7y8\xae\x8b\xfe\x12{\xcb\x88\x12\xca\xc6\xcfS\x11\xa2\x85\xf3\xbc\xd8\xdc\x91\xef\x86l\xfbf\xb9\xdb\xbb\x0f\x0a\xdc\xdc\xf9rIm\x0b\x93\x8d\xb21"
+DATA ·d+34560(SB)/64,$"\xc0\xf6\xdc\x061O\xbc\xf0\xed\x8e\x80Vt\x9aH\x98\x8d\xc8\xd6\xf9#\x1e\xb9\xc6\x0dz\x14_+\x94\xe85\x1e\x8d\xc6\xd7~\xb7\xb9\xe6\xcd\xd3\x9a\x8c*\xdd\xbe\x8aI\xd9zZ\xc6\x1d\xdf\x8f4\xfd:\xe6M\x1a\xee\xf3"
+DATA ·d+34624(SB)/64,$"P\x85\x95\xb1$O\x18+\x9fq\x9ek\xac\xb6\x86\x03`n\xa3))\x90e\x11\x15\x009\xee\xcc\xa1\xf1\xf9f\xb8\xbb\xdb9\xf5\x99\xb7[\xc9\x0b;\x1co\x9a4V\xc9\xf5$w\xf7\xa4>\x13\xf1N\x9c\xbe}\xc4\x0b"
+DATA ·d+34688(SB)/64,$"\xe5\x7f\xcfX\x94c\xfe\xfaC\xc5yt\xf1x\xd5Q\xce\xa4y\xe3\xd3M\xbez\xfeG\xa7[\x0d\x85\xe5\x8ca>Iu\xd7\x7f\xc8\xec\xed\xb4\xe8n\xacn5\x0dU>\xcf\x15\xb2\x87\x06'bOc\x90\xa8\x08\x03\xae"
+DATA ·d+34752(SB)/64,$"k\x90Y\xfc3q0\xfb\x16\xa2#89;\xdb?\xa8\xcd]K\xbd.\xc6\x05\x9e\xe1\xdaIW\x83\x16\xe6*\x06:\xfc\xd9\x07\xf1\x15\x9a\x97\x1aM\xe7\xcf\x0d\xdc\xb0\x1e\xa5Q\xc2\x9f\xe4\x01\xaa\xc4c%xGO;"
+DATA ·d+34816(SB)/64,$"\xef\xf7\xab\x220\xd4>)>kt\xb6\xcb\xef\xb9\xda\xe9Zt\x17\xd0?\xd9\xee\xd2\xfcF2\xd18\xc9\x00\xf7\x05\xb1\xfc\xeb\x9a\xd44{\x09ea\x15\xa9\xb7+\xfcK$\x5c\x9f\xf7pMF\xf1\xb5\xa6&[\xbb\xcd"
+DATA ·d+34880(SB)/64,$"\xbf\xdfUg\x19>\xa5I\xcdj\xd9x\x9a\xc2)\x04\x1a\x94\xf3\xca9\xc3=\xb4\x84\xe7\xeb\xb0\xd7r\xc4\xd1\x9cTjIE\xaf\xeb\xad\xd9\x8a\xa7V\x9c\xa5:7\x95\xfdo\xf8Y\x1c\x8c\xfa\xb5\x9e\x92\xa2\xde\xe62\xe0"
+DATA ·d+34944(SB)/64,$"\x0eL\xec\xc4\x1fl*\x9f\xe7\x5c\xb3\xeb\xd0\xb3\x96_\xb0\xe0\x92G\xfa<\xdf\xfcr\xbdB\xe7\xc6v\x8b0\xb6\x9bJk\x84\xc6D\xaa\xac\xc0{a\x91qUN\xb9\xe1\xf2I\xfe\xc7ny\xfb\x83\xfck\x86\xae&\x84"
+DATA ·d+35008(SB)/64,$"\xdf\xbb\x89\xdb\xfb\xac\xf6(\xd5\x94c\xef\xe1]\xdff\xbfW\xa1\xff\xd3\xfcW\xf0o\xc1\xfb\x8f\x14\xd8/\x89\xbcC~l\xf4IY\xfc\xeb\xb9!.d\x91\x06Lq\x85c\xca\xf0~lw\xe7\x8cW\x9b\x7fe|:"
+DATA ·d+35072(SB)/64,$"\xb5\xb9\xbe\xff\x00\x84\x9amJ\xf5\xd1\xd4Z\xdc\xd9\xc9\x04*\xf1!9%$\x05\xb2F6\x8c\xf5\xeb_D\xa4\xde#\xba\xbd6Z\x8d\xae\x8b)\xb8F\x04\xb1\x10\x82:\x12B\xe1q\xc7'\x04~5\xd3\xaf\x87?g"
+DATA ·d+35136(SB)/64,$"\x1d'@\x06\xefD\x8bQ\xd6go2K\x85\xba\xb8\x8e\x1bb\x06<\xa8\xe1o\x92^y2\x13\x8cp\xa3\xbd\x7fR<\x86\x1e\xf2m?\xc2\xdf\x8d\x8f\xf1\xf3\x0f\xc53\x84\xe5*\xf2|\xafe\x1b~j\xcf\xe6|\xcf"
+DATA ·d+35200(SB)/64,$"m7\xc3M)Z\xfb\xc2\x11^\x5c\x07\x1f4Wqy78\xef\x89\x15\x99\x07l\xe3\x19\xf4\xa8,//\xb5\x0dn\xd5\xfcM\xa7\xac6\xb1\xe0\xdc\x9b\x06\x0bq<;\xb2S\xcc\xc3(\x90\x8e\x14\x9e\x01\xc6Ez\x9d"
+DATA ·d+35264(SB)/64,$"\xf7\x93\x017zh\xb2\xb3\xb7\xce|\x88#\x86$p\xbca\x9eL\xe4\xfd9\xabP\xf8\xaa\xfd\xe3[9\xe1\x8e+/\x99\x02\x97I\x86H\xe0\x84\xda3\xeeA\xa8\xa7\xc3\xd8{9V\xac\xa2\xd6\xc9[\xef\xd1\x9bO5"
+DATA ·d+35328(SB)/64,$"\x9c\x94\xaaR\xa4\xe7~\xaeN\xeb\xf2\xfc\x0f.\xb8\xd4\xf1\xe2\x17\xba\xd1\xac\xe7\xd3mRm\xf0[\xf9\x92J\xd1\x03\xd8\xc8\x06O\x1d7g\xeb]\xd6\x87\xe2\x1dBYe\x12\x83\x02\xc9 2\x5cM\x87\x8c\x86;w\xb7"
+DATA ·d+35392(SB)/64,$"\xcb\xbb\x1e\xa3\x15\xeb^\x13\xd45\xcbK\xc8\x1fI\x16\x10i\xeb\x0b\x8f0b&\x90g-\xe6,\x80\xc6\x18\x13\xc6[\x8e\xd5w\xc5\xda\x9a\xeb\xfeV\x9d\xa7_Y-\xc9;\x96O2\x19\xbc\xc6\x00\x9d\xa3j\x801\x8e"
+DATA ·d+35456(SB)/64,$"\x9c\xf1\xcdu\xe0\xbd\xa5)\xfe\xea\xa3q\xf4\xff\x006\x8f\x035\xcc\x14\xdc!:\x1f;\xbd\x17\x9e\x8c\xe7\xb5_\x86\xd2j1}\x9e\xde}2\xfei\x14y\x11\xda\x87gg\x5c\xe0\xc7\x80\x0e\x1b\xe9^9i\xf0~y"
+DATA ·d+35520(SB)/64,$"\xf5_\xb0jZ]\xf3]\x86$\xc5\xf3\xc4\xa0u;\x9d\x82\x85\x03\x1dI\xab\xd7\xd6_\x1c\xde\xfd\xe0]M/\xad@\xdd\xe5\xa1\x8a\xdf\x91\xc0\x08Q\x0e\x0a\xf7\x1cWi\xe0\xbf\x86\x9f\x17\xaf\xb4MCX\xd7<Yg"
+DATA ·d+35584(SB)/64,$"\xa0\xc1\x0d\xa1\x9eKx\xef\x15.&\x87v\x08*\x80\x16\x19#\x8c\xe4\xd7m\x5c\xe2\x1c\xc9V\xc2\xe8\xfe\xd4\xb6\xff\x00/\xc4\xef\xc0R\xa9\x85\xa3'\xf5\xf8;\xec\xa3{\xfd\xcb_]>g\x9aM\xe1O\x0b\xe8\xba\xb0"
+DATA ·d+35648(SB)/64,$"\xb3\xb7\x83O\x86\x0bS\xfb\xdb\xd9\x19\xdf{1\xcbo*>m\xbd3\xcf\x1d3[\xba\xb7\x88\xbc'\x04\xf0\xb6\x8b\x1f\xdb\xcc<G5\xa8`\xf2>:\xa9\x9d\xb2\xb8\xe88\x15\x9f{\xf0N\xed|5u\xe2\x0d\x1f^"
+DATA ·d+35712(SB)/64,$"\xfbLP\x5c\xa4s\xa4\xd6\xcf\xe5\xab8\xdd\x99\x0e\xfe\x09\xff\x00t\xfa\xfbWI\xa7x\xef\xe2\x0f\x84R;\xfd7]\xd2\xb4\xb1,\x09\xa7\xce\xb6\x96J\xa5\xa2\x89B\x86\x0b\xe4\x11\x9fR>by&\xbc\x5cF\x22\xac"
+DATA ·d+35776(SB)/64,$"S\x95\x18\xfd\xd6\xb7\xe1\xa1\xe8\xb5K\x10\xe0\xd5gQ\xad,\xf9\x97\xdft\xdf\xe4sv\xde(\xd7t\x8f\xb4_Oa\xfd\x9e\x1c\xeeV\xd4V'\xf3\x16A\x8c* %\xc9\xc1\xe3\x8f\xadc\xcb\xacZ^\xcb\x16\xa3/"
+DATA ·d+35840(SB)/64,$"\xd9\xed\x9d!*~\xc8\xb8+\xcfq\xb5J\x92y\xe78\xf5\xa7\xf8\xcf\xc5\x1a\xd6\xbbe4&\x7f\xed\x89\xe7\x7f\xdd\xdcI\x0f\x92\xca\xecAgRv\x9c\x9c`\x1c\x0e\xa7\x8a\xf2\xe8\xf4]Eof\xbe\xb0\x89\xc6\xf3\x89"
+DATA ·d+35904(SB)/64,$"\x12\xe6S\x94'\xb1F\xcf\xbf\xa5sF\xa5U\xef8={\xab\x1e\xae\x07+\xa78\xba\xb3J\x0f\xd5\xfd\xda\xbb\xfd\xe8\xfa\x9f\xc3\xff\x00\x15F\xa3\x0e\x9d\xa3\xf8\x9d\x11\xb4\xfb+\x8f<\xc4cdk\x96 \x80\xb2\x5cE"
+DATA ·d+35968(SB)/64,$"\x8b\x8c\x00x\xda\xf8\x5cp+\xca>'M\xa1k~!\x9e\xf2s\xa6\xdb\xaa\xc0\x12\xd2\x0d6+\x8e\x80e\x1d\xdaRK\xee\xcf\xccN\x0dr\xe9\xf0\xfb\xe2\xff\x00\x884\x98\xa7\xf0v\x89c?\x92\xdbd\x99'2K"
+DATA ·d+36032(SB)/64,$"+\x9eA1\x99\x14.\x07\x00(\xae\x1d<9\xf1Z\xca\xf4\x7fj_\xa5\xb2\x89\x02K\x1a\x05\x00\x8e\x84\x12\xc0\x81\xe9\xcdtR\xc3\xfbT\xfd\xa59[\xca\xdf\xe6ve\xd9\x06\x1a\x95G^\x9e&)\xeb\xa5\xf5\xfc\x13"
+DATA ·d+36096(SB)/64,$"\xdc\xd0\x8f\xc0\xfa\xa5\xdc\xab\x0e\x99u%\xc6T1\xf2`\x94\xe3#8%\x87P;\xf4\xae\xbfL\xf8q\xe2\xed:(\xb5\xcf\xb3\xdf\xbd\xb1\xfb\xed\x12\x17bT\xf3\x94#\x03\x8e\xbcW\xa6i\xd1\xf8\x93\xc3W\x10Yj"
+DATA ·d+36160(SB)/64,$"0\xc9\xa7I}jd\xb6\x13_@\x91\xce\x00\xc2\x90\xeb\xbcc\xb9\x18\x04\xd7Ei\xe3_\x0d\xe8\x1a\x5cz\xcd\xbe\xa1\xab\xae\xa5\x03\xa3j\x16\x96\x8a\xc0\x84P\x0b\x04q\x94`\xcd\x91\x9e\xc0d\xe78\xa3\xea\x18*r"
+DATA ·d+36224(SB)/64,$"J\xaf2ok\xad?3\xc9\xc7\xf1\x0e:^\xe5(\xa9&\xed\xa6\xb7\xbe\x9b\xaf>\xba\xf9\x9cT\x16>\x0c\xbe\xd4\x16=R\xd6\xf2\x22\x14Bm\xda)\xba\xf5\x06NT\xe0\x1ep\x17\xf4\xaf/\xd7\xf4\xad+E\x92x"
+DATA ·d+36288(SB)/64,$"`\xbf\x96V\xde\xc6\x18\xcc&1\xb39\x1bI,\xcd\xb7\xb9\xc5K\xf1\x0b\xe3a\xf1O\x88\x9e\xe2\xd3E\x93L\xf3\xa4VQ$\x8f+\xc8\xad\xd1\xd5H\xc1-\xd7=+\x03G\xf8\x83e\x1cW@\xdc;\xce\x00Eh"
+DATA ·d+36352(SB)/64,$"\xd4\x8d\xab\xdc8U?0\xc7c^F?\x11\x81\x84\xad\x09\xb7\xf2=\xac\xb3%\xcc(\xc3\xdbN.\xcd/v\xe9\xdb\xe7g\xfef4\x9a\x86\x98e0=\xd2\x07R>b\xaf\x86c\x8e\x14\x10\x0eG|\xd5\xcbK\xbb"
+DATA ·d+36416(SB)/64,$"K[\x81-\xd1\xf3\x11\x89eP\x1b\x93\xf8\x01\xc7\xe3]\xe5\xb7\x88\xfc\x15\x7f$w\xbe5\xd4u\x1b\x97\x96\xcb\xcaI\xac\xacz\xa8\xce\xcc\xc8\xe9\xc0\x07\xef\xb2\x8c\xe3\xbdp^%\xd2\xb5-/O-\xa2\xc1w\xf6&"
+DATA ·d+36480(SB)/64,$"\x91D7\x12#I$\xd8\xc1b\x1b\xa0\x19=\x17\xa0\xc6k\xe6\xeac#);/\xc0\xf7)59*U \xe0\xdf}\x13\xf4m/\xc8\xeb\xc4\xa3QI6\x08H\x8c`\x18\xa7_\xdd\x8cd\x02\x8a:\x9a\x92\xdfH"
+DATA ·d+36544(SB)/64,$"\xd7.qqi4\xf2,X\x0c\xa2/9\x10\x9e\x80\xeeR\x06}s\xf4\xaf\x0f\x9fG\xd7\xb5=D\xdc\xf8u$\x92\xd5\x04o,\x813&\xe29L\x021\xcfO\xce\xbe\xa6\xd04\xdd\x07\xeco}{{\xabY\xcc"
+DATA ·d+36608(SB)/64,$"Q\x91\xb4\xd8-\x98\xdb\x89\x02\xaaF\xd3O!\xc3\x97\xcb\x16\xc2\xe5@\xc6I5\x18|\xda\xad\x19\xa9BMy\xae\x9eZ\x1cy\xc6\x0b\xea\x90\xe7\xa54\xef\xd2\xd7\xb7\xaa\xd7\xf2.\xeb\x7f\x1a\xbfh\x1b\xfb\xb5\xb8\xb9\xf1"
+DATA ·d+36672(SB)/64,$"\x9f\x8b$t\xb5\x8e\xca8\x13X\xbcR!\x89v\xach#\x98|\xa1x\xc1\xe7\xd4\x9a\xf3}C\xc4Z\x8e\xaa\xa2\x1d\x7fQ\xd5\xae\x5c\xe1\xde\x0dB\xe6Iq\x82q\x95y\x1f\xf9q\x5c\xf7\x89\xb4\xdd*\xda\xe8M\x02"
+DATA ·d+36736(SB)/64,$"Imp\x8cY&U \x15=;\xe7\xe9\xcds\x17\x97\xef\x0c\xab:b\xe2@9g<\x85\x19\xed\xceG5\xf6\x1f\xeb\xe6e((*\xed[\xb6\x9f\x8d\xaez\x14'V\xb58\xa7Q\xea\xbd?\xe0\x1e\x94|\x1fs"
+DATA ·d+36800(SB)/64,$"\xaaZIy\xa2\xdbG\x0a*\xee\xf9\x12-\xd8\xc7PH\x04\xe7\xd8W\x012^\xdb\xc8m\xe5\x91\xb0\xa4\xf4q\x90\xbe\xf8\xf7\xf6\xadm/^[\x9b'\x9eV\xb5\x82@\xa5`\x17\x0c\xfb\x9b\x8eYr\x08\x07<\x0d\xbf"
+DATA ·d+36864(SB)/64,$"\x95fK5\xf5\xb4+>\x94\x22\xdd\x8d\xd8a\xb5\xa4V\xfd3\xf8\xe2\xbc\xfa\xdcS\x8d\xa8\xfd\xea\xf2\xfb\xd9\x9e\x16\x96\x22\x12\x9cj\xca\xfd\x17\xfc8\xd7\x8fOx\x94Mt\x81\x8e\x0b.\xf2\xac\xdc\xf21\x8e\x83\xd6\xb1"
+DATA ·d+36928(SB)/64,$"\xbcA\x0f\x99,i\xa5)-\xb4y\xa0\x16\x0c\x07\xae\x1b\xae}j\xdc\x16\xfe#\xd6'\x12\xbd\xad\xbd\xbb/\xca\x1aD\xf3$\xc7r\x14T\x13h\x9e*\xbb\x97\xecZE\xc3M!Vg\x90\x0d\x80*\xfd\xe0H\x1d\x07"
+DATA ·d+36992(SB)/64,$"\xd6\xbc\xba\x98\x87+\xb9\xbb\xb6{XxB\x9c\x95\xe6\xae\xbe\xef\xc1~\xa5\x0bMc\xc5\x1e\x1bT\xbb\xd3\xa7\xbc\xb7\x8c1\x06Db#\x1e\xcc\xa7\xe5'\x1e\xd5\xea\xda\x07\xc4\x0dj\x18\x1d\xee\xcd\xb5\xc2\xc8\x81ei\x22"
+DATA ·d+37056(SB)/64,$"\xda\xc1I\xcf\x05H\xe4\xfa\x81\x9a\xa5\xe0o\x87~/\xf1\x1e\x9e`\x8a\xea\x19\xe0f`\xf6\xea\xe2C\xbe1\xc9 \x8c\xf0;\x8a[\xef\x0a\x9bi\xd2\xccL\x1d\x98\x88\xc0\x0c;w9\xc6\x05zX\x0a\x93\xb72\x96\x9d"
+DATA ·d+37120(SB)/64,$"\x8f\x174\xc6`+\xd4\x96\x1eJ.kv\x97O>\xbf\x89\xdf\xdd\xf8\xe2\xc7Q\xd1\xce\x9d2,o\x82\xd0\xaa&\xe2\xceq\xf7\x98\xfc\xc4c\xdf\x8a\xf1\xedSI\xbe\x92a4\x08\xc03\x10>V\xdcFx<z\xd6"
+DATA ·d+37184(SB)/64,$"\xc5\xf7\x84u\x81}\x1d\x8c\x04I;0H\x9e\xd5\xb7d\x7f\x0f\xcaH8o\x7f\xc2\xb0\xaen\xfcK\xa7\xdc\x1bMVi\xa1hHS\x1b\xfc\xa7\x00\xf7\xafz\xbeo*\x96U\x0e,\xaf\x01F\x8d\xfe\xab4\xef\xad\x99"
+DATA ·d+37248(SB)/64,$"\xbf\xa6|$\xf1\x8e\xa2V\xea\xc3\xe5\x05w\xaf\x9c\xea\x99\x1e\xc0\xb15\xb9\x0f\x83-\xf4\xcc[x\xbd\xfe\xcf\xb0\x12\xe6 \x19\xcf\xa7'\x8eMym\xd4\xf6\xe77\xbfm\xbcYr0\xb1\x93\xb7\xf3\xdd\xd3\xf0\xad]'"
+DATA ·d+37312(SB)/64,$"\xc6\xd3\xdbG\xe5]\xda\xdb\xde!\xca\x83>Y\xb3\xdb\x1c\xf1\xf5\xaa\xa3\x8f\xa1\x0dl\xfe\xff\x00\xd2\xc7n'\x03\x8f\xa9\x1b\xaa\x89\xa5\xd1G\x95\xafF\xdb\xbf\xdc]\x16\xda\x0c\x97\xc6\x14\xb81E\x93\x872\xc6\xc3\x1f\xee"
+DATA ·d+37376(SB)/64,$"\x80\x0f4\xddGK\xf0Q\x90\x98g?x\x03 M\x9c\x8e\xe0s\xf9VI\xf1\x06\x9b<\xc5\xbe\xc7n9<)\x03\xf5\x03?\xadc]\xeaPy\xe1\xe2_)\x0b\xf2\xb9\xdd\xc7\xa7\xbdiO1\x82MY;\xf7;"
+DATA ·d+37440(SB)/64,$"\xe1\x84\xad)&\xdc\xa3cNk\xb6\xb5V\x86\xca\xe2m\x8b\xd1\x81<\xfbc\xae+\x8c\xb8\xd6<HK\xac\x1a\x84\x91\xa0\xec[o\xe1\x91\xcdt7\x1b\x01\xf3l\xd8<g\xee\xb0\xfe\x13\xe8G8\xaeV\xe6\xe5\xa7\x9d"
+DATA ·d+37504(SB)/64,$"\xa1\x9d\x0e2F\xe0\xbf7\xe5\xdcVUqrj\xcaM\x1e\xe6]E&\xdc\xa0\x9f\xaaFm\x9c\x9a\x84\x93\x89d\xba\x90\x9c\xe7\x87'\x9f\xce\xbd\x13N\xbc\xb5\xbf!5\x1b\x99\x14\xf6\x0cw\x01\xed\xcdr\x83\xc1\x9a\xd3"
+DATA ·d+37568(SB)/64,$"\xa7\xdbl\xed\xee\x8cX\xc8\x91Q\xb1\xf8V=\xcd\x9e\xb7j\xb9B\xec\x07P\xeb\x83X\xe1\xb1u(;\xb8]?S\xd2\xc5\xd3\xa3\x89\x5c\xb0\xa8\x93^\x87\xb5\xc9\xe1\xbbQn]%(1\x90\xf2\xedT {\x9cz"
+DATA ·d+37632(SB)/64,$"\xd6l~\x19\xbf2\x96\xd2o\x95\x86>\x7f\xb2\xb3\xca\xab\x9e\xcd\xb00\x1fC^3&\xbf\xae\xb5\xb8\xb4yAU9X\xe5P\xe0\x1e\x99\xc3g\xf4\xad\xbd\x03\xc7\x9a\xde\x95u\xbe\x18mc\x93\x1bD\xb0F\xb1\xb1\x1e"
+DATA ·d+37696(SB)/64,$"\x87h\xae\xaf\xed\xcaR\xaa\x92\x87*\xf9\xdc\xf3\xdf\x0e\xe2\xe9RsU\x14\x9fm\x0f^\xbc\xf0|v\xfa\x7f\xdb\xf5x\xdaA\xd1M\xba\x8d\xce~\x8cEy\xe4\x90x~9p\xa2\xee<\xf1\xb5\xe2W#\xea\x11\x89\xaa"
+DATA ·d+37760(SB)/64,$"\xda\x8f\x89\xb5\x0d[\x22\xe6\xe6\xe5wrT\x96\xdaO\xd3\xa5sm\xa6\x09\x9b1>\xf6\xc6y\xc8?\x85^a\x8e\x84\xe4\xbe\xaf\x1f\xbcyv\x0a\xb4`\xd6\x22\xa7\xdd\xa2_\xa1\xec\x1a\x04j\xb2o\xd1\xde\x19\xdf\x1fq"
+DATA ·d+37824(SB)/64,$"K$\xa0\x7f\xbapk\xad\xbc\xd6\xe4\xb3P\x9a\xbc2\xc5\x9ep\xd2\xaa\x13\x8fM\xe4f\xbc\x0e\xda-r\xc9s\xb6u\x00\xfc\xa4\x16\xe0V\x9d\xc4:\xbe\xa6\xa3-p\xce;JK\x7f>k\xd2\xc3gS\x8d.U\x0f"
+DATA ·d+37888(SB)/64,$"{\xf0\xfe\xbeg\x06+\x22\xa7:\xbc\xd3\xa9\xee\xfe?\x83K\xf0;\x0d}4+\xe8\x0d\xef\xdbm \xdcH\xdb<\xaa['\xfd\xd2s\xf5\xe9\x5c(\xf0\xbc,7\x0dSM \xf2\x0f\xda@\xac\xd9%\xd7t\xfc\xda\xcf"
+DATA ·d+37952(SB)/64,$"mo\x22\x1f\xf9\xed\x1a\xb1\xc7\xe5TL0\x13\x9f\xec\xfb~}\x01\xff\x00\x1a\xf1\xf1\x99\xadY\xce\xee\x9a\xff\x00\xc9\x8fs\x05\x80t\xa1\xcb\x0a\xba\x7f\xdb\xa7\xff\xd4\xfcQ\xd6\xae?h a\xba\xf1-\xbd\x9d\x8a\xc9\xb6"
+DATA ·d+38016(SB)/64,$"\xdd$\xf2%\x90\x14\x5c\x90\xa2F\x0eXz\x01\x9cz\x0a\xe1n\xb4_\x8a\xf7\xcd9]XJ\x81K+\x04\x96\x1c\xfb*\x98\xc3dw\xafrO\x13\xdciw+\xa3\xdcDm\x8cn\xb3\xad\xa4\x8cg\x0f\x22\x02\x18\xb4"
+DATA ·d+38080(SB)/64,$"\x87p\x04g\x8cas\xc9\xce\x05`_\xfcT\x11Nb(m\x97\x1edp\x87\xda\x19\x89\xc1\x1b\xce\xe2\xc4\xfdq\xed_\xbc\xe0\xb2\xc96\xa3:\xae\x9a\xbd\x97\xf9\xd9\x1f\xe4d\xb3\x8cU\xf9\xa8\xe1\xa0\xdbI\xdf\x95}"
+DATA ·d+38144(SB)/64,$"\xda\xdf\xef\xb9\xe6^\x1c\xd3<y\xa4\xe9\xdb\x9a][T\xb8\xef\x04q1\x89U\xf23\x99\x14\x10x\xe2\xaa\xddh>2\xbc\xf1\x1c\x1a\xad\xd5\xad\xad\x90\xb7\xd9\x88f\x91_~\x06s \xe7.s\xce@\xf4\xaf{\xd3"
+DATA ·d+38208(SB)/64,$"5\x15\xf10\xb7\xbd\xd4m\xb7\xdb\xf9\xcd\x0d\xc2\xad\xdf\x96\xa5@'\x86\x0c\xbf2\x8e@\xc6\x09\xe3\xbdy\xb5\xcd\x9c\x1a\x87\x89%\xb0\xbc\xbc\x96\x1b{\x86\x22&\xba\x89\xe6\xf3\x80\x19Y\x15 \xcb1\xc2\xf5\x1d\x86}k"
+DATA ·d+38272(SB)/64,$"\xdb\xc4\xf0\xfd,5\xe7R\xb7:\xf5{|\xc7\x82\xcekT\xa97\xec\xd4d\xef}/n\xe9$\xd2\xfc.m\xc3\xf0\xd3_\xf1\x0c>|\x90[:\xdd\xb0hD1\x18\xc2\x94\xe4\xaa6\xe2\xaa\x0fq\x8ek\xa1_\x84"
+DATA ·d+38336(SB)/64,$"\xde)\xd0le\xbc\x96KKHf\x8f\xec\xd1\xdd\xad\xd2\x03\x09\x079Q\x82\xc0\x9e\x84\xe3\x9a\xf1\xd9u-\x1a\xdbRx\xac\xef\x96\xe26m\x82\xf3M\x92EV8\xe9\xe5\xc8\x15\xb8\xf4\xaa\xd2_i\xf2]\xc5w\xe6"
+DATA ·d+38400(SB)/64,$"5\xccq\xa1\x8d\xca\xa1f/\x83\xb5\xa4POr7d\x8c\xf4\xc5x\xb2\xc7S\xc3\xd5\xbf\xd5\xdb\x8f\xf8\x99u\xb0X\x99G\x97\x9e\xcf\xd3O\xeb\xd4\xf5M_R{\x0b\xa6\xcc)x\xccK4\xd0\x5c\xb2y\x85q\x9d"
+DATA ·d+38464(SB)/64,$"\xa16/\xbf\xafs\x9a\xb3\xa3\xf8\xf7\xc3\xd6\xb0H-\xac\x03\x5c\x18\x9e\x19\xe5\xbeCv\x90\x06\xe3tJ\xdf(\x90\x7f\x0b\xb6H\xed^=\xfd\xa8\xba\x16\xa2\xb2O\x11\x8e\xe4C\x19\x02\xfa\xdf\xc9\xb7i\x08\xfd\xe1U|"
+DATA ·d+38528(SB)/64,$"\x91\xc7\xdd\xc1\xc15CR\xd7\x96\xda\xd2MB\x14\xb2\x88\xc8\xd9h\xa0\x97k\xb8\xc6C2\x82\xcb\xd7\x9c\x13\xf8R\xa9\x9c\xe1\xebZ7\x94Wf\xdbFp\xe1\xa6\xda\x8bWzY\xa7k\xfc\xb6=\xae\xdbT\x87\xc6V"
+DATA ·d+38592(SB)/64,$"\xf1\xe8R\xeb\x8fmo\x18\xc46\xd7\x17\x06\x18r;\xec\xd8\xca[\x8e\xad\xd6\xb8\x8f\x1e\xf8\x0bS\xb7\xb1\x87}\xdaO\x04O!\x85m\xe7\x85\xc1$\xfc\xccYI\xe4\xfb\x81\xc7\xadr:F\x87\xa9\xeaZP\xbb\xbe\x86"
+DATA ·d+38656(SB)/64,$"\xd2I&\xdb-\xac\xd0\xdd\xf93\xa2\xf5!\xb6)\xc8>\x8d\x9c~4\xb7\xf6\xba\xdd\xa5\xba\xfd\x92\xddf\x9ceJ\x5cI\x1b\x923\xfd\xf22k\xde\xc1\xe3n\x92Xouu_\x99\xd7G/T+\xc7\xd8\xd7Z="
+DATA ·d+38720(SB)/64,$"\x9d\xbf3\xca\xf5\xbb\x9f\x12i\xbeU\xb4k4f\xdcn\x8c9\xc8\x1c\xe7%\xb3\xcf\xb7?J\xb3\xa4jZ\x95\xfc\x13\x80\xe2{\x99\xd4\x99\xd1>|D1\x97\xf9\xfe\xe9\x1c\xf45\xb2\xfe+\xd4\xec\xef&o\x11\xdc,"
+DATA ·d+38784(SB)/64,$"+\xe55\xbb[\xc7\x0aI\x12+\x0cm\xe4\x1e\xdc\xe7\xb1\xe9^m\xa9\x5ch&\xe4\xdc\xc3}4\xa8\xc0\x02\x91\x0d\xb9\x00`\x03\xcf\xf4\xae\xe9e\xb5\xab\xc6\xeej\xder_\x96\xe7\xe8\xd8<;\xa9\x1eIRW\xde\xe9"
+DATA ·d+38848(SB)/64,$"6\x9f\x97\xf4\xce\xe6y\xaf5=&\xe6}6\x18dh B.\xad\xe6\x16\xd7p\x04'\x12\x05\x91\x80e<o\xdb\xcf\xe3\x5cu\xa6\xaf\xa9\xb4rIw\xabC\x22\xc5\x18\x05.\xe7\xd9#\x96\xe3*\xab\xbf\xcc#\xd3"
+DATA ·d+38912(SB)/64,$"\xb0\xae3T\xf1>\x89\xf6Qo\xa7\xc6m\xa4V\xcb\xdcO\x1aM\x91\xee\xacG\xe6\x0dq\xf7\x1a\xf4\xd2\x11\xe4\xeazs\x0f\xee\xcb\xa7\xb0\xfdc\x90W\x95\x89\xc9\xe9\xd1\x9d\xa3Y\xbfF\xad\xf8\xb4\xff\x00\x03\xecr\xde"
+DATA ·d+38976(SB)/64,$"\x1c\xe7\x83\x8c\xa0\x96\xbdSo\xefI\xd8\xfa#\xc3>#\xf0\x9a\xdf[/\x8co\xa2\xba\xd3\xe3\x95\x1a\xe6\xde\x18%\xf3\xde% \xb2G.\xd1\xb1\x88\xc8\x0d\xd0\x1a\xe8u\x1f\x8c>\x03\xb5\x9e\xf6/\x0ehRAh\xf2"
+DATA ·d+39040(SB)/64,$"\x96\xd3\xc4\xfa\x8d\xc4\x92\xc1\x189P\xccFX\x9e\xa7'\xad|\xb6\xbe%\xb2\x81\x00x\xec\xa5~\xec\x82x\x81\xfc\x0e\xef\xe7Z\xdao\x8a\xbc*\xee_X\xb5\x01v\xf0-]\xdc\xe7\xe8\xe1G\xeb]\x98jxY\xce"
+DATA ·d+39104(SB)/64,$"*\xa5[\xdb\xf9\xb6\xfb\xd5\xccq|\x15\x0de89.\xc9\xdb\xafemOc\xf1/\xc5t\xf1\x7f\xd9\xe7\xd6b\xb0\x92\xe2\xd6\x04\xb5\x8au\xf3\x03y+\x92\x15\x82\x90\xa7\x00\xe3=My\xf4\xde1\xbe\x8b\xe5\xd1\xec"
+DATA ·d+39168(SB)/64,$"\xfc\xd8zI\x1d\xba2!\xf6;y?\x9dsW\xfe\x22\xf0\x0c\xe3m\xad\x95\xea6\x0eN\xf0?C\x9a\xe3\x13Y\xd5m\x91\xa5\xb0w\x85w|\x867\xc3\xfd~\xbe\xe2\xb93\xdc>\x1dE\xc2\x8f+\xf4\xbf\xfc\x03\xd2"
+DATA ·d+39232(SB)/64,$"\xca\xb8b\x9a\x82\x8ci8\xa5\xd2[~\x0c\xf7\x8b]jY \x10.\x934\xaa\xdbB\x88\x00\x8d\x81c\xf7G'\xf0\xe3\xad{l\xbe<\xd4t\xbf\x87\xab\xe0\x0bK]SM\xd44\xfb\x99\xee\xc9\xbd\x91Y\xfc\x89\x13"
+DATA ·d+39296(SB)/64,$"\xf7q\xf9[\x00\xdd\xbb\xf8\x95\xb2\xd9\x1cq_\x16[x\xcb\xc4\xb6m\x11{\xeb\x88\x98\x9c\x000X\xee\xfa\x83\x8c\xfa\xd7i\xe1\x8d?Q\xf1e\xf1\x92\xf7X\x1a|\xad0>}\xd4\x8e\x5c\xb6~\xfey\xe9\xd4\xe4\xd7"
+DATA ·d+39360(SB)/64,$"\xc0\xce\x95wg+$\x8e\x0c\xdb\x85i(\xf3\xe2m\xcb\x17u\xbb\xd5yk\xf8\x1dls_\x5c,\xf7\xd7\xcf\x042\x04Dx\xb9\xde\x19\xb02\xb1\xe5N\x7f\xbd\xe9Q\xeb\x1a\xcd\xe2[\xa6\x9c\xc2hA\xc3:\xbc\x92"
+DATA ·d+39424(SB)/64,$"b^x}\x8c@\xe4t\xc0\xfci\x9e7\xf0\xb7\xfc#W\xf6\xf7zg\x89\xe0\xd7\xae\x98y\x933@\xe8#px]\xf2nY8\xee8\xafd\xb1\xf0\xe7\x8e|I\xe1Kmw\xc5Z\x1d\xc5\xd6\x9d\xa7\xc6\xa2\xf1"
+DATA ·d+39488(SB)/64,$"\xe0\xdb\xe6\xc1\xe61H\x80\x12\x98\xcao\xc6B+\x11\x8e@\xaeZ\xb5}\x9c=\xa3v\xbfu\xd7\xe6p\xe2*R\x87\xb3\xac\xad(\xbf\xfbv\xdaie$\xb4\xf58\xb7\xf06\xbf%\x84S\xe9K%\xc9\x10\x09\xee\x0c"
+DATA ·d+39552(SB)/64,$"\x88\xd1\x18\x87v`\x19\x8b/\xb9\x02\xbd\x97\xe1o\x835\xdb\x1d\x0a\xf7\xc7\xf6\xfa\xce\x9fl\x96\xb0L\xb6\xa3\xc9\x88\xad\xcc\xd1\x01\xba5{\x97\x8d\x1b\x19\xf9\x8a\x06 \xf1\x8c\x91\x5c&\xa7\xe2\xcd{[\x8c\xb7\x86\xa2\xd4"
+DATA ·d+39616(SB)/64,$"\xe4\xfb\x10\x16\x8b\xe6\x09\x04\xce\xa7\x19\x85\xb2\xac\x1c\x80\x07\x01\x89\xf4\xaa\xf6\xda\xff\x00\x88n\xec\x85\x9e\xa7\xa7]\xc7=\xbe\xff\x00 ]\xc3+\x88\x81?0\x8d]@\x8c\x922\xc4/$sYG\x15VJ\xee6\xb9"
+DATA ·d+39680(SB)/64,$"\xf2\x98\xf8b\xea\xd0p\xa9k_]\xafn\xcdy\xe9\xe8l\xf8\x92;\xff\x00\x10$:\xf6\xb5\xe7\xdco\xe2?*\xd5b@\xb9\x1b\xdb\xf7h\xa3\x80xm\xde\xddN*\xf7\x81<?\xac\xca\xb1\x5c\xe9:]\xce\xb4n"
+DATA ·d+39744(SB)/64,$"%k8lV5Y$p\xbb\x8b\x01 !\x09PHa\xe8Oj\xb2u\x0f\x0cxWH\x8fX\xd6o\xaf\xae.\xd8ySiR$\xa1\xa6L\x93\xba9\x83l\x04\x1c\x02\xac\x9c~\xb5FO\x1ex\xbbS\x8c\xeb"
+DATA ·d+39808(SB)/64,$"E?\xb0t\xcb$\xf3\xe2gW\x89\x9bkeDl\x0a\xee\x94\x1e\xe0\x0e\xbds[\xa9\xcawkG\xe7\x7f\xe9\x9es\xa7\x89\x95%\x0apJ\x17I7\xa2\xdfd\x95\x9f\xdc`_%\xe6\x9b3\xea\xf7\xf6\x8e \x86\xe9"
+DATA ·d+39872(SB)/64,$"~\xd9b.K#\xc6x1\xb3F2\x1b>\x83\x03\xbfZ[\x89,4\xbdMu\x0bt\x92\x05(\xdfe\xb7\x9b2\xb0\xdd\xfe\xc6\x14\x1fc\x8a\xe2n\xbe'j\xde(\xd4\xa5\x96qqy$\xd2\x9f\x9aL\xe2]\xe7"
+DATA ·d+39936(SB)/64,$"\xaf\x0aN\xff\x00\x5c\xe6\xa7\x86-g[\xf34\xeb\xab\xeb\xdbI\xede\x12\xbd\xb5\xd7\x03\x0b\xc2\x04\x99\x06\xe5\x00q\xd8W\xa9B\x94\xa4\xfd\xc8\xdd\x9e\xec\xb2\xaa\xb0\x8a\xfa\xcd\xa1e\xad\xae\xf4v\xe9\xbf\xdf\xe8z\xdc\x11"
+DATA ·d+40000(SB)/64,$"\xcb`\xb6\xd7R\x1dN\xde\x1b\x84\xdfs\x05\xbbEnq\xd8\xed\x04\xb1\x1f\xef`\xfbVv\xaa\xfe\x19\xb4\xb8]E\xec\xb5\x17Y\x9b\x10\xc9=\xc22\xbe\xcf\xbd\xf3\x00y\xf4\xc0\x15_\xc7\x16\xc3G\xfe\xce\xb8\x80%\xb5"
+DATA ·d+40064(SB)/64,$"\xbd\xd5\x88\x9a&\x17\xc2\xf5\x9f\x04\x82\xd3\x10\x15\x92Px!\x87Lq\x5c\x8d\xa5\xdc\xedsor%\x84\xaa\xc8\x1a)%!\x94\x12\xd9$\x06\xe3\x19\xeb^\xdd9\xa5\x1b8\xeax4\xf2\xe6\xff\x00}\xcd\xa6\xbb=\xf7"
+DATA ·d+40128(SB)/64,$"\xea\xba\x9e\xb5\xa3]\x04\x0et\xcbK\x84\x05B\x92\x83~\xd5'$\xeec\xc1\x18\xe0\x8a\xd1\xba\xd2\xed|qq<:\x8cF9\x12H\xe2[\xe7\x01\x04FS\x81\xbf\x07\x18\xfa\xe4\x11\xe9\x5cF\xb3\xe2\xab{\xbdj\xe2"
+DATA ·d+40192(SB)/64,$"\xd2\xe6\xce)\xe5\x82\x7f.\xe2\xe6\xc2Ie\x8d\x8e\xde\xa1B\xe0\x0c\x9e?*\xf5\xaf\x05|D\xd0\xb4g\xbc{\xbd\x1e\xff\x00P\x91\xec\x85\xa5\xa3\xc5\x0a,Q\xb60\x19\x96\x5c\x1d\xeb\xce\x08<\xd5\xcf\x14\xa5\x1eT\x8f"
+DATA ·d+40256(SB)/64,$"\x98\xccp\x98\xaa1\xf6\xf4i>{i\xaa\xbfN\xad\xfe}:\x1c$\x7f\x09-<\xf9\x92K\xc1v\xf0\xb1VM8.\x19G\x01\xb2\xcd\x8c\x1fa^O\xe2\xaf\x0d\xda\xe9\xb7\xa6\xdbN\x8e\xe4\x00~a#\x0c\x8fQ"
+DATA ·d+40320(SB)/64,$"\xd8\x1a\xfa\x12\xdb\xc6\x13h\xd30m\x16Eyc\xf2\x94~\xe5d\x90\x91\x9eq\xc8\xcex\xe7\xd6\xbc\xb6\xef\xc3\x9e)\xd7\xaf~kX \x12I\xbb\xcb\x0e\xceP\x1f|\x11\x9czf\xb6\xc3\xe1\xeaM8\xa8;\xfa\x1e"
+DATA ·d+40384(SB)/64,$"\xaeM\x99c!Y\xcf\x19Q(\xa5\xde:\xfd\xcc\xf3\x0d/U\xb4\xd3\x0c\x91\x5cZ\xa5\xccC\xa1n\x18{\x82r3\xfc\xab\xb4\xd1\xef\xfc!\xa9\xc9\xb2\xe6\x18b\x940\xdb\x0c\xc1\x84l\x0fR\xce\x9c\x8a\xe8\x22\xf0\xce"
+DATA ·d+40448(SB)/64,$"\x91\xa5\x06\xb6\x97Q\xd3\x90\xc6\xad#\x05\x85\xee\x1c\x13\xd02\x94?\xca\xba{/\x0e\xf8Jkxe[\xdd6\x17\xc8\x91\xa6{w\x5c\xb1\xfe\xecO\x8cs\xc6Fkx*\x90\xb4n\xbf\x03\xd9\xc7\xe6\x98Y\xc7\x99)"
+DATA ·d+40512(SB)/64,$"+\xf5W\xff\x00/\xf8'q\xa1x{\xc0\x06\xd3\xc9\xd74\xabh\x99\xd9\xa3\x8at\xbb/\x1b\x1d\xa0\x82\x01\xe4\x81\x90y\xc5p\x1e2\xf0f\xa7\xa2\x88e\xf0\xcd\x9d\xa6\xe0\x0a\xb5\xccN\xa7q\xceG\xcb\x9f\x97\x03\xbe"
+DATA ·d+40576(SB)/64,$"kWP\xb7\x8d5y,\x93P\xfbSI\xe5\xc8\xe9\x04D\x16\x05q\xc1\x90.\xd3\x801\x8e\x07j\xe4> |R\xbf\xd4gM#N\x92A\x0d\x9c+j\x82\x13\x14r(N>iU73\x9f\xe29\xfck\xd8"
+DATA ·d+40640(SB)/64,$"\xaf\x98Q\x8d+Ik\xe5c\xe4\xb2\x9c&.x\xa8\xce\x8dG$\xf5j\x5c\xd6]\xb4n\xfa\x9c\x8c\xd7_\x10M\xc9\x93T\x91\xe1\x04|\xa8\x80\x05\xfc\x14~\xb5\xd0X=\xa6\xabq\x15\x86\xa5,\x03\xcd\xff\x00Z\xd2"
+DATA ·d+40704(SB)/64,$")\x18\x1e\xf8\xe7#\xaf\x06\xb8(> j\x1a{\x14v\xbf`y0\xdd\xce\xb3.{cz\x13\xf9\x1a\xd8\xd6>%=\xdd\xa5\xbb\xda\xe8z|/\x10?\xe90\xef\xdf)<\x8d\xe3;2;`\x0a\xe3\xc1\xe6P\xfb"
+DATA ·d+40768(SB)/64,$"M\xaf'\xa9\xf7\x18\x8c\xa7\x17u\x15M+\xf5\x8d\x95\xbf\xaf\x99\xa3\xe2\xbf\x84\xf1\xc4d\x9e\xdd\xe2t\xdb\xe6\x07\x88\xe7\x0azv\xc9\xfck\xc7\xee\xb4)\xf4\x87\x0d\x19\xf9\x0f!\x80\xc8\xcdu\xd0|Q\xf1L\xaes\x04"
+DATA ·d+40832(SB)/64,$"2\x0ca\x83\x16#\x1e\x87\x9a\xbfs\xf1\x1c\xdf\xe1n4+D\xc0\xc3\xf9\x1b\x95_\xdc\x83\x9e{\xf1O\x11<\x1c\xfd\xe8\xbeW\xe8zx/\xedl<T+\xa5Qz\xa3\x06\xc7\xc6\xb1\xd8\x0f\xb3\xea\xbal\x17\xb1\xf6"
+DATA ·d+40896(SB)/64,$"r6\xb8\x1e\xc4q\xf9\xd6\x84\x9a\xde\x81r\x05\xc6\x89i\xf6w\xc9\xdc\xb2\xfc\xc3>\xdcVl\x9e%\x86\x0b\x81;\xe9p\x15\x1f7\x97.@#\xb7`M^\xb5\xf1\xa5\x92\x82\xdf\xd9\x96|\xf6\x0b\x9f\xd0\xe6\x8aX\xd5"
+DATA ·d+40960(SB)/64,$"nGQ\x7f\xe0?\xa9\xd1W\x07&\xb9\xe1A\xfc\xa5\xa7\xdds\x9b\x9b]\xd4\x95\x99\x1f\x00\xe4\xf4\x1d?\xa5P> \xd6\xd1\xf7\xf9\xdcg\x18\xc0\xe9]u\xde\xb7\xa5\xea\x11\xf1d\x916\xec\xe6#\xf2\x91\xe8T\xff\x00"
+DATA ·d+41024(SB)/64,$":\xe6\xda\xfa\xda+\x82%\x88\xb2\x02N\xc5\x00\x1c}k\x19U\x92\xda\xa9\xe8Pq\x94m*\x16\xfb\x88\xaeu\xc9\xaec\x1fjP\xc3\xa0*9\x15\x84u\x16\xc9\xc2\x9cf\xbb\xa8<E\xe1%\x90\x09\xad.\x07\xa8$"
+DATA ·d+41088(SB)/64,$"\x11\x9f\xd2\xb7\x86\xb5\xf0\xed\x80ch\x06y\xc6\x1b\xfckd\xd3\xd5\xd6D\xcb\x10\xe9\xe9\x1c,\xbe_\xf0\xe7\xff\xd5\xfc(\xf1.\xbf\xfd\x86\xcd\x1e\x9f\x1e\xd8\x9c\x11$\x17{e`\x85\x0cn\xcd.7\x06\xcf\xdd\x1b}"
+DATA ·d+41152(SB)/64,$"\x0f\xbdq\x1a\xde\xbf\xa9\xeb\xd05\xc5\xc7\xd9!\x89UdF\x8e\x18\xe2$\x00\x17\x0f\xb0.\xd202q\x83\xd4\xf5\xaa:\xee\x975\xfd\xbc\xb7V\xf0]022\x83u8U2\x93\x9d\xc5O%Tq\xd3\x15[O"
+DATA ·d+41216(SB)/64,$"\xd3|J4\xd0\x8b3\xdc\xca\x80\x00\x91\x22\xb3\x14\x1fx3\xb1\xc8\x00\x1e\xc2\xbf_\x8e^\xeb{\xf4)\xb4\xdfS\xfc\xbe\xc2a\xe8\xd3\xa5\x199\xab\xa7\xd4\xd1'O\xd3\xec\xad<\xd5\x9c\x1b\xa5o7\xcc\xd8\xcb S"
+DATA ·d+41280(SB)/64,$"\x82\xd1$Yb\x07\xae1]\x95\xa6\x97\xa9ZX\xc5#\xcf\x0d\xa5\xd5\xbc\xcb47\x86g\x8da\x88))\x1a\x04\x0d\xfb\xc2N9#\x8e\x0fz\xe3\xaf|K'\x86#:U\xfa\xda$\x93'\x9b#\xc0\xeb$\xed\x8e"
+DATA ·d+41344(SB)/64,$"\x04R\xc8\x0eU\x01\xe7h\xf5\xce\x0dCo\xa9\xcb\xa8X\xb6\xa3\xf6\x9bx\xed\x11\xd424\xe1Af\xe3*\x84|\xcc={Wv\x1b\x0f\xec\xbd\xccKr}5\x0a\xf8j\xd6\x8dHF\xc9\xeb~\xfe\x9f\xd3=\xb5>"
+DATA ·d+41408(SB)/64,$"\x18h~)\xd2\xff\x00\xb6\xf4\xad7\xc4\x17W\xb7L\x86\xf6\xf2+\x8bAi\xb8}\xf6\x8dT\xa3\xee\xf4\xc8\x02\xbd\xf5\xd3\xc2\x1aw\xc3\x05\xf0\xcf\x82\xf4{\xc5\xd7\x1e\xf4\xc3;\xce\xbb\xa4\x92\x18\xe2\xc2\xb2C\x12\xe1\xd9"
+DATA ·d+41472(SB)/64,$"\xceI%\x89\xdd_,xCE\xd7g\xbb\x85\xf4\xfdl,\x17DF\xb1\xf9\xcb\x14\x1b\xcbaVB\xaap\x0f\xf7\xb1\xf8\xd7\xdd~\x19{\xcf\x82wp\xdc\xf8\xabR\xd34m^\x06\x9a\xcfP\xd3\xd7\xcd\xd6d\x9e\x22"
+DATA ·d+41536(SB)/64,$"6\xc8\x0f\x96\xa6\xde3$lLn\x09\xdaH$\x1c\x11^\xa7\xb1\xc2%\x18\xb5$\xd6\xca\xea\xcf\xd4\xf8\x8c\xf3\x13RmRu\x13\x8f\xfd\xbduo\xeb\xd0\xf8\xb7\xc66\xda\x9e\x9c\xd0:&\xa12\x08|\xcb\xbb}B"
+DATA ·d+41600(SB)/64,$"\xdeG>npQ\x19\x86vc\xe6;\x8f\xcb\xeb\xda\xb8\x98_K\xba\x80\xf8{Y\xb0\x86U\x11~\xe1\xd2\xdfl\xea\xed\xca\xfe\xf7pf\xfcs_\x5c\xc7syy\xa9\xdc\x5c\xe9P\xdf\xbf\x98e\x11\xc5,\x91\x04\xf2"
+DATA ·d+41664(SB)/64,$"$\x5c(\x7f/\xe7b8\xdd\x85\xda~\x95\xf3\x7f\x8cn<u\xa1]\xd9jwZv\x95\x14\xbeq\xda\xc0\xab9\xdax\x18\xceH\xed\xd3\xde\xbbj`0q\xba\xaa\x9d\xd7\x95\x8d\xb2\x5c\xca\xa5D\xa9\xa4\x93}y\xba"
+DATA ·d+41728(SB)/64,$"\xfd\xdf\x7f\xe6T\xd2>\x06x\xda\xee\x7f\xb1\xe96\x17\x8aY|\xc5\x8d\xeeR\x09\x0a\x9eWh~\x01>\x99\xe6\xb8\x0f\x13[\xeb\x9a\x0d\xb4\xd6sZ\x5c\x19#g\x8eD\x9e}\xec1\xc3\xf0\xb8\xc8\x1d3^\xcdo\xf1"
+DATA ·d+41792(SB)/64,$"\x97\xe3\xe5\xdd\xb1\xf0\xef\x86\xa1\xd0Zye\xe1\x94\xa3]\x90x\xd9\xbeV\xe9\xed\xd7\xde\xbeu\xf1\xf7\x88\xbe\x22\xdbY^i\x9e!\xb7\xf2\xefb\x9d\xa4h\xe2\x85\x0c\xa8\xc4a\x83g\xe6\x18\xc7 u\xf7\xaf3\x0f\x8b"
+DATA ·d+41856(SB)/64,$"\xc0\xc1\xb78\xc9~?3\xebr\x9c.:\xb5zj\xb4\xa0\xee\xfb\xeb\xfdw<\x92\xf6\xdbQ\xbf\x8f\xec\xfeD\x88\x9f\xc2\xb9 \x0f\xa9\xe6\xb0f\xf0\xc4\xdfy\xa6\x86\x22\xdc`\xc8+\x85\xbc\xf1w\x88n\x98\xc7qw"
+DATA ·d+41920(SB)/64,$"0\x03\x82\x83\xe4\xfd\x06+2d\xbe\xbeR\x22F\x1d\x0e\xf1\x9c\xfdy5\xed\xc7=\xc2;*4\x1b\xfc?\x03\xf7\x8c&G^\x09sMEy/\xf3:\xcdC\xc2\x92N\x09\x17V\xc7\x1c\xe3vO\x1f\xd6\xb8[\x9d"
+DATA ·d+41984(SB)/64,$"4\xd9J\xdei-\x8eT/\xdd>\xd9\x15\x5cA\xab\xdbL\xae\x09\x0c:\xee\x19\xfd\x0dh,z\x84\xf9I\xa6\x1b\x8fR\xdd\x07\xd0\x01\x8a\xf3\xab\xce\x15\xf5\x8d\x07\x16}>\x1a\x9dZJ\xce\xb2\x92\x1fg{\xa5\x10\x12"
+DATA ·d+42048(SB)/64,$"\xf27N0I'\x19\xff\x00\xeb\xd7O\x05\x9e\x8d\xe4\xf9\xd0\x97$\x0c\x85\xc7_\xa7J\xe3g\xd3\xe7Ld\xf9\xc4\x1c\xed\xc1\xe6\x9cf\xbf\x817E\x1c\x9cs\xb7\xa5i\x87\xc4{%j\x94\xd3\xf9\x0b\x13\x85U\x1at"
+DATA ·d+42112(SB)/64,$"\xea?K\x9d\xed\xbf\x8a<;o\x11I,RF\x1c\x06\x90\xed'\xdf\x03\xf9V~\xa1\xe2]:\xfe?\xb3\xdb[\xa5\xa9\xdd\xc4\x91\x8c\x91\xf8\xe4\xe6\xb9\x18\xee\xa79k\xb8\xd8\x0c\xe4p\x00\xfaz\xd43\xdc\xda\xabn"
+DATA ·d+42176(SB)/64,$"\x8d\x18\xf4\x03>\xf5\xd1_7\x9c\xa3\xca\xda\xb7\xa5\x8ca\x93R\x8c\xf9\x92m\xfa\x9a\xcb=\x9aJ\xf3\xc9p\xe6P0\xa5\x97#\x1e\x98\xfeU\x5cjWd\xfe\xeae\xd8\x1b8+\x8e\x0fO^i\xf6\xb7v\xfbv\x84"
+DATA ·d+42240(SB)/64,$"V$`~=\xb1\xde\xadKo\xf6\x86\xd9\x12\xa4l\x07\xcd\xc0\x1e\xd5\xe5\xce\x9f4}\xd7\xf7\x1d5#\x18\xb7\xcd\x1f\xbc\xad\x1b\xdeM.M\xc9\xc61\xc0\xe0g\xe9_I|!\xb6\xf1F\xb9sul\xfe!H "
+DATA ·d+42304(SB)/64,$"\xf2%\xb8\x92;\xd9\xe4X\xa4x\x10\x11\x85\xf9\x95\xa5n\x04`\xa9$\x8e\xa0\x0a\xf9\xe2\xd2\xc2xKmx\xd8\x96'\x00\xff\x00Z\xf7\xef\x87z\xb6\x9b\xa3\xe9S\x1d^\xd9\xee\x1eA\xe4\x82\x92a|\xe5\xcbnn3"
+DATA ·d+42368(SB)/64,$"\x8d\x84\x0c\x03\xeb^V+\x05\x09G\xdeN\xff\x00'\xfa\x9f+\xc55\x9f\xd5\xe6\xa9E6\xfc\x97\xea{\xc4\x17~8\xf1M\xbd\xb6\x9fm\xad\xf8n\xddl\xa32Z\x7fj\xdd[i\xec\xa4\x9e\xa3z\x852\xf1\xd7,"
+DATA ·d+42432(SB)/64,$"s\xcd_\xd5~\x1b|l\xf0\xb2\xc1\xf1:\xf7W\xb1\x8e\xd6g6\xd1>\x99\xaeEz\xf2J\xe8[,\xb13>\x1b\x07'h\x5c\xf1\xd4\xd7\x84\xcd\xaf\x04\xb5\xf2\xcc\x8a\xca\xc4yQ\xbcM,\xb1\xafO\x94\xb8\xda\xa0"
+DATA ·d+42496(SB)/64,$"\xf6\xeaMu^\x12\x96\x7f6\xdbO\x8e\xda\xe5d\xde\xcdi>\xc0\xf9\x91\x87\xca\x19z\x04\xf5'\x18\xf5\xaf3\xea\xea\x0f\x9aU\x12\x8fkk\xf8?\xd0\xfc\xd2\xbe\x1eth\xfe\xee\x94o\xad\xf4\xfe\xbf#\xaa\xd1\xbc\x13"
+DATA ·d+42560(SB)/64,$"\xafj\x9b\xee|I\xa6i\xd7\xf0\xce\x924w\x17\xb7\x93\xe9\xf3\x89O;\xd6V\x8c+m8\xca\xe7\x9e\x99\x15\xea\xfe$\xf8a\xe1\xbf\x07\xfc2\x87Y\xd45=R\xda\xec,ks\xe1\xfbk\xfbmB\xde\xe2)2"
+DATA ·d+42624(SB)/64,$"\x1ax$\xf2\xe4\x11\x80@\xdf\x1b\x10\xc39\x04\x8ek\x17Z\xd5\xfe&\xebWmmwsq41[\x05\xf2-\xb7\x08m7\xa8\xdaX\x0e\x11\xf3\xcb\x0eA\xf6\xebI\xaax\xc3\xc7\x1f\x0d\xec\xec4\xef\x17K\xa7\xf8"
+DATA ·d+42688(SB)/64,$"\x82\xda\xe0\x8f\xb3\xda\xa4\xc2\x0b\xa8\x5c\xf2\x85\xbeF\x0c\x9cr\xac6\xb085\xdf\xec\xb0\xf2\xe5\xa8\xd3}V\xd6\xff\x00?\xb8\xf8\xf9fx\xda\x95)\xd1\xa58&\xdd\xf9Swij\xed{\xc5?\x92\xf2<s\xfe\x10"
+DATA ·d+42752(SB)/64,$"\xbdn\xda\xca?\x16\xd8\xa5\xcf\xf6y!\x92'u\x9aR;`B\x01\x1cw=;\xd6KjV\xfa\xb5\xb4\x9a\xc5\xc2\x08R&U/\xe7n\x94\x0c\xf0\x19F\x19\xbaW\xd3\x97\xb0X\xf8\xa2\xc6?\x17]xoJs"
+DATA ·d+42816(SB)/64,$",\x8d\x15\xfc6\xb6\xd3i\x22\xd5\x9f\x84y.-\xa4\x16\xff\x001\x07h\xc8\xce:W\x1do\xe0\xaf\x81\xf6\xb63\xb6\xb5\xa9\xdd\xc0\xd3\xc4\xc2\xdc\xe8\xd6\xdf\xda\x10\xad\xcer\xb14\xee\xd1\x12=J\xef\x1e\xb9\xef\xeea"
+DATA ·d+42880(SB)/64,$"\x1di\xc1\xfb5~\xfd\x7f\xe1\x8f^\x9eu\x09\xdd\xd4\x8br\xbd\x95\x96\xdeZ=v~\xbd\x8f ]Au\xc8\xec\xf4mE\xe0\xb9,\xd9V\xb8\x22\x13\x1e9\x0b\xe6\x9c\x101\xef\xf4\x19\xe2\xbd\x17K\xf8~\x8b,\xc6"
+DATA ·d+42944(SB)/64,$"\xd2\x1d8\xd8\xa02I\x14z\x88\x94\x85\x93\xe5(\x18\xa9\x19\xc9\xcf \x1c\x0e\xb9\xaa\x96\x1f\x0d\xfc'\xe2F\x9e\xd3C\xd4.\xee'\x8d\xc3H\x85Qd\x5c\xf4S\x10\x19\xe8r\x09\xc0\xebT\xed\xbe\x07\xae\x8f\x1c\x177"
+DATA ·d+43008(SB)/64,$"3\xdd\xc2\xf2\xbb\xac\xb2JU\x22\xdc2W\x868\xe4\x0e~\xbcVr\x93n\xd5)\x5c\xcb\x15\x99a\xac\xe9\xc2\xbb\xa6\xfb8\xbd\xdf]\xd5\x8fS\xf0\xb7\x82~!i\x9aD\xab\xe1M3N\xb9\xf2\xa5\x91^qy\x10"
+DATA ·d+43072(SB)/64,$"\x0aUKr\xb22\xefl\x03\xb4\xe4\xe7\xb6N+\x92\xf0\xa7\x88\xf5Hu\x7f\xb5K\xe5\xc8\xeeNM\xc9&\x22q\xf37\x04t\xea6\xf2=*\xc0\xd0\xbc\x0f\xa3iP\x1dU\xb5'\xbf\x9e5\x9ex\x90\xa1\xb3\x81\xc3"
+DATA ·d+43136(SB)/64,$"\xef\x8dI\x8c7\x9c\x0a`\x91\x95\x00\xf0y\xc8\xae\xbfI\xba\xf0\xdf\x89dkf\xba\x9a\xe1\x8b3\x0b;{ \x12W<\xf2\xd8)\x18Ns\x80\x09\xad)\xce\x8c!~W\x06|\x96a%\xfb\xcfi\x0enk\xdd\xf2"
+DATA ·d+43200(SB)/64,$"\xb5\xa2\xee\xee\xd3\xfc\x0f/\xf1\x96\xbb\xac\xe8\x1e0Y\xa6\x9e\xca\xfe\xda`\xdeQ\xb7\x19\xb7\xdc\xe0\x15\x05\x9c\xff\x00\x0ey\x07\x04\x1a\xc0\x93\xc4\xde%\xb9\x8c\xc7\xa0$\x8c\xeb \x8d\xc3\x00X\xbbq\x98\xb0H\x1bO\x00"
+DATA ·d+43264(SB)/64,$"u\xafH\xf1\x8d\xbe\x83\xa3\xdcO\xe1\xad\x1e{ar\xf1\xa2\xcd\xa6\xca\xa8\xe4<\x9e\xb2\x1e9\x04\x10\x01\xc8\xae\x1bT\xb0\x9a\xc2\xe6\xdf\xc1\xd3<i5\xbc\x81~\xd4\xaf\x88c\x0e\xa1\xb0\xa3\xfd\x96\xc8\xdcq\x93\x5c\xf8"
+DATA ·d+43328(SB)/64,$"\x9c\xc2\xacv\xa9e\xe4\xcf\xa0\xcb\xbd\x8b\xa5M\xca\x92\xe6K\xaa\xb5\xd7v\xbf\xca\xe6_\x89\xa7\xf1'\x87%\xb6\x83\xc56Z\x8d\x80\xb9\x8cbk\x95\x90\xf9\xb9\xcf\xcc\x0ba0Om\xdf\x85s:u\xf0\xd2\xfcUm"
+DATA ·d+43392(SB)/64,$"'\x8bU\x1a9\xa3U\xb4{\x80\x22\x8e5=\x09l6\x01\xf5\xe6\xbdb\xc6[\xcb\xcd\x1eyu\x0dZi\x929|\xa8\xf4\xe9d2+\xe3\x01\x99\x13\x94Q\x9fA\xf55\xa5\xe2\xad\x17\xc3\xd666\x96\x1a\x95\xb5\xbc"
+DATA ·d+43456(SB)/64,$"\xad,b\x5c8o\xdc\xb3\x1e\x22 `\x07\xe8H\x1d\x8f5\xe4\xfdc\x9bVtS\xce\xa8\xc1\xfd]\xd3\xbbwM\xc7O\xba\xfa\xfe-\x1eE\xac^\xf8\x8bN\xd4\xe5\x99m\xe6\x90;\x10\x93\xb9i\xc1\x07\xa6\xd7\xf4\xc6"
+DATA ·d+43520(SB)/64,$"\x05b\xdd\xbe\xa9\xab\x05\xb4\x9e\xc9\xd6v8B\x88\xc1\xdb\xf0\xc7\xf3\xafK\xbb\xd7\xf4\xbbC5\xb2hzm\xa8\x8c\xab \x05\xcb\x8e\xc1r\x92\x0e\xb5\xdd\xeam\xf0\xce\xdfB\x9f\xc4\x0c\xd7v\xda\xa3\xda@\x96\xdaTs"
+DATA ·d+43584(SB)/64,$"I43I#\x11,\x8f$\x84\x84UL\x14\x5c\xf2k\xa2\x15\xd3v\xd8\xddf\xde\xcb\x91}Y\xdd\xe8\x9a\xfdl\xff\x00\xcd\x1e\x017\x82o\x8c+m\xe6D\xf7\x19\xfd\xe41\xb3J\xc9\xcfvPW>\xc0\x9a\xd9\xb6"
+DATA ·d+43648(SB)/64,$"\xf0\xe6\xad\xe1\xa8Tj\xf6w~T\xcd\xe6\xc2\xb70\xc9\x0aI\x91\x8c\x8c\x81\xc0\xf5\x06\xbd\xa3\xe1\x8f\x8e\xfc7\xe1]F\xdbX\xd7t\xb9\xc4#\x11\xa4\xb6\x829\x8c{N\x19\x9e\x13\x82N\x08\xc6\x0f=\xab\xd6\xbfh"
+DATA ·d+43712(SB)/64,$"/\x8d^\x00\xf8\x836\x97/\x87>\xd75\xdd\x93D^\xe2\xe2\xd0\xdb\xc3$Q\x8c\x08\xf6?A\x8e\x02\xe3\x00\xe3>\xb5\xda\xb9c\xd7_\x9f\xf5\xf8\x9e\x1e3\x8as5\x8f\xa7\x82\xfa\x93\x95)^\xf3V\xb2k\xf2\xfb"
+DATA ·d+43776(SB)/64,$"\xef\xe4|\xc1c\xe0y\xf5{C\xa8\xb4\xd1C\x19O0>\xdd\xdecg\x94\xdd\xd9\xbd7pk\x8a\xd5$\x97J\xb8ht\xf2D\xf1\x8c\x00c \xee\xeeF\xe1\xe9\xdb\x15\xeeV\x1af\xaf\xa8\xc9m\xf6\xf0\xfa\x5cS"
+DATA ·d+43840(SB)/64,$"#\xbe\xfd\xa1VB[)\x93\x0f\x03\x0b\xc6z\x9e\xf5\xd4\xdexCK\xd3Qe\xf8\x8a\xf76\xf1Io\xbe\xdeD\xb6\x1f4n\xa7l\x91\xee\xc3\x10\xc4ps\x80s\x8ek\xb1{\xf6\xb2\xb1Q\xe2x\xd1\xabj\xd2\xe7"
+DATA ·d+43904(SB)/64,$"\xbe\xc9k\xd6\xdd\xac\xfe\xf3\xe2k\xbb\xfdBH\xbc\x8b\xc0\x08'q\xf9@#\xf1\xebX\xf1Cyu'\x97j\x1bq\xe8\x13\xf9W\xd4\xd7\xde\x16\xf0\xfd\xfc\xd1B\xca\x8a.\xd5D\x12\xdc\x5c\x14bX\x9f\x9b\x01@="
+DATA ·d+43968(SB)/64,$"0r8\xcdZ\xf1\xc7\x80-<'k\x1f\x88|,\xfak\xb24q\x5c\xe9\x86iZ\xea&\x07\x05\x94\xb0\xdb27r\x98\xdb\x9e\x95\x8dL\x14\xd6\xac\xfb\x1a<e\x86\xe6\x85%\x0bJ[_c\xe7\xab-#Q\x90"
+DATA ·d+44032(SB)/64,$"\xf9:\x90\xf2\x19W!d\xf9Y\xbd0\x0f?\x9dz\x1c\x9f\x08\xf5\x80aIH\x86y\xe2[\x88\xa2\x9b\xa3Dz>\xe1\xc0\x1f\x5c{W\xbd\xbf\x8c>\x12Xx~\xc6\xdb\xc4ZB\x89\x9e2/ \xb6\x89\xa3_0"
+DATA ·d+44096(SB)/64,$"t+6Y\x88=s\xfaW\x9ek\x9e\x0d\xd6\xa7h\xb5\x8f\x0b=\xb4vWK\xbe\xd6\xdd\xef\x90\xcc\xe88\xc0\xdf\xb4\x9c\x1e\xd5\xbd\x17\x15\x14\xe7\xfeL\xf9\xf8\xf1V*\xbd[8:\x0a\xed'$\xad/G\x7f\xeb\xb9"
+DATA ·d+44160(SB)/64,$"\xc4\x9f\x86B\xcd\xc2\xea\xf0\xb3\x03\xc1*\xe8\xa3\x9f\xf6\xb9\xfdi?\xe1\x01\xf0\xe8\xe3\xec\xb7\xdf\x83\xa7\xf8\xd3\x1eo\x10X\xdd\xff\x00g_)\xb4\x90\xf0M\xc9$~`\xe3\x15tj7*\x02\x9b\xab<\x8e\x0f\xef\xa4"
+DATA ·d+44224(SB)/64,$"\x1f\xd6\xb7\x8dz\x0fh\xb3\xb7\xdb\xe3\x1e\xae\xb7\xdd{~g\xff\xd6\xfe{\xbcMm\xa8h\xfa\xc5\xd5\x99he\xfb1\x229\xa1\x93\xcd\x8e@\xbf\xdcn1\x91\xd3\x8c\xf6\xe0\xd7\x17\xa8\xfcW\xbe\xfd\xc6\x9f\xa8Jm\x9e"
+DATA ·d+44288(SB)/64,$"\xd5\x968\x82\xc4\x8a\x02uc\xf2\x85b\xde\xe4\xe4\xfa\xd7\xe86\x8f\xf0\x0f\xc1^>\xf0cC\xad]\xc5i\xab\x5cX\xcb\xa8\xd9\xde\xe9\x13\x89U\xddU\xa4\xf2\xe7\x8eV\xc3\xfc\xd9R\xcaU\xb3_\x15\x5c\xfc-o\x08"
+DATA ·d+44352(SB)/64,$"L\x9a\xa7\xc4\xbb\x0f6\xce;\xcf\xb25\x9cR\xa0\x9d\xf0\x09-\xe6\xab0\x8c\x820\xa1\x87<\xf6\xe6\xbfV\xc1q=z\xd0\x97\xb0\x9e\x9d\xb6\xbf\xa7s\xfc\xc9\xca\xe5\x84\x94\xa5O\x17\x0fz=:\xdf\xf2.Y\xea\x1f"
+DATA ·d+44416(SB)/64,$"\x0b\xb5\xab\xa5\x82\xca\xd29\xe4y\x95\x14\xdc:E$\xa9\x8eZ@3\xb4\xe7\x92Fp;\xf1SZj1\xdfX\xcb\xa5\xc2\xb6\xeb\x15\x9c\xf2%\xb5\xb9\xf9\xc6\x18\x9c\xba\xb3(\x07\xd3\xafN\xd5\x81g\xa9xTH\xaf"
+DATA ·d+44480(SB)/64,$"\x0d\xa5\xbaB'\xc8\xb3\x95\xa6\x05\xd1I\xc24\xe86\xa0~\xf8\xce\x0fz\x8b\xc4\x1e3\xf1l\xd7\xf2\xdd\xe8\xf0Y\xe8\x96e\xf1\x05\x95\x9e\xd1\x14]\x00]\xec\x8d#\x9c\xf2Y\x8eOZ\xddb13\x97+\x8d\xbc\xff"
+DATA ·d+44544(SB)/64,$"\x00\xadM\xff\x00\xb2\x95F\xd56\xd5\xbf\x99\xb7g\xe5\xf9\x1e\xd1\xf0\xe7H\xd6<^e\xb7\xd3-\xb4]&;@e\x9e\xee\xfa\xf28b\x90\x01\x8c\x1f1\x86\x18\xf5\xf9E/\x885o\x0c\xb5\xb4\x96\xaccIY\x1d"
+DATA ·d+44608(SB)/64,$"n\x1a\xceP\xd0\x89\x17\xfd\x5c\xaa\xd9\xceI\xe0\xe3\xa8\xed^\x03\xa4\xf8\xbe\xca\xe6\xe3\xcd\xf1\xe5\xed\xd5\xfca\x8b\x1b[y\x14)\x90\xf6\xc1\xc0\x07\x8e[\x1fJ\xef-\xfe)|0\xb0\xb1k{?\x0a\x99gf\xddk"
+DATA ·d+44672(SB)/64,$"+\xc8\x1eH\xdd\x1b \xf2\xb8q\xea6\xd5\xf2Z\xf1\xad_N\xc9lyx\xce\x15\x92\xad\xed\x14\x1d\xd7m\x9f\xcd\xbf\xcc\xf5\xbf\x04j\xcb\xe1-Q-\x7f\xb5%\x9d\xda<E\x15\xaf\xefU\xde@\x08\x8d\xd8\x81\xb1N"
+DATA ·d+44736(SB)/64,$"~\xf2\x92G\xe9]\x5c\xba'\x86\xbcKx\xbaw\x8d!K\xadA\xee\x88s\x0c\xae\x22\x8a\xd7nD\xa0\xa2\xe4\x95\xe46\x17\x9a\xf1\x9d;\xe2jX[Ii\xaaY\xcb\x0f\x9a\x1a\xefN\xb8\xb3\xb6\x8dn-nd\xf5"
+DATA ·d+44800(SB)/64,$"\xe0f'\xc6\x19N=T\x83Im\x1f\x88\xa6\xd0#\xf1N\xad)\xb6y\xcc\x91\x0f:B\x8c\xcb\x92\x1bc7\x19\xf6\xc8\xe6\xba)c)Rrq\x9b\x97k\xec\xfd\x0f#\x13\x94MMT\xf8|\xd6\xff\x00\xf0|\xec"
+DATA ·d+44864(SB)/64,$"z>\xab\xf0;\xc2Q\xb5\xdd\xdf\x86\xfcE\x0b\xa5\xb3f;\x99\x06\xe4y\x00\x07d2\x9e|\xc1\xd3\x04\x0eGQ\x5c\xaf\x89\xbc\x11\xe2?\x1fK=\xfd\xce\xb5\xa7\xdd]\xbd\xa4j\xcfq\x09\x86\xe6O#\x0a\x822>"
+DATA ·d+44928(SB)/64,$"V\x93\x00e\xb2:\xd7m\xe1+\xeb\xbd\x07\xc3Ri3h\xb6\xd7\x11jl$K\xb8\xee\xa0\x8e\x02\x81@,\x11\x9b\xef\x83\xd7\x91\xcdlk\xba>\x91g\xa9\xcf&\xa3c\x1d\xbd\xacV2\xdc\xe9\xe9\x07\xfa\xdf3\x01"
+DATA ·d+44992(SB)/64,$"\x80\x91\x91\xe4C\xd4e\x81\xc0\x5c\x1e\xb5\xcc\xb3\xac<\xdc\xd5J\x16\x92z>\xeb\xd3Q\xe2\xa5\x8b\xc3N5cS\x9d[\xa2\xbb^\xb7\xff\x00\x86?0\xb5\xaf\x0b\xda\xe8\x1a\x83A\xe2H\xae\xe2q!\xdc\x85v\xbe\x01"
+DATA ·d+45056(SB)/64,$"\xe4\xfc\xfe\xf5u/>\x18 FE\xd5\xd5\xc0;\x8e\xe8\x88\xcf\xb0\xaf\xa1\xfe(\xda\xda\xfcAQu\xa9\xc7ik\xa9\x9b\x99\x03O\x04\xaf \xfb>\x067\x926\x97$g\x8e\xd9\xaf\x8d\xf5\xbf\x0d^\xd9jR\xd9\xd9"
+DATA ·d+45120(SB)/64,$"\x8f=Q\x8e\xc2\x01\xf9\x87\xb6+\xe80\xf9\xca\xa7\xfc(/\xb8\xfd\xc3\x873\x08f4\x92\xc4Tp\x92\xdd'\xa7\xdf\xb3;)\xef\xb4\xab\x9c\xff\x00g\x82\xc9\xce\x0c\xc0g\xe8z\xd7\x1dy\xf6\xf4\x9bu\xa1E\xe3\x9c"
+DATA ·d+45184(SB)/64,$"\x01\xfc\xa8\x87C\xd7<\xa0\xb3\xda\xde\xaa\x82\x03\x04\x8d\x86\xde\xfc\xe6\xbb\xbbo\x86\xda\xad\xed\x9f\x9c\xb7\x89\x14\xcc\x00\x8a\xdap\xcb+\x83\xceB\xe0\xf0\x079$\x0a\xf4\xa3\x8fu\xfd\xe9h\xfc\xb4=\xe7S\x0d\x86\xd6U"
+DATA ·d+45248(SB)/64,$"S^z\x9c5\xbe\xa3\xe2\x07\xc2\x13\x1c\x99\xfe\x1d\x98\xfeUwv\xb1\x06%\xbc\xb3m\xa4\xe3pC\x8flSu\x9f\x0bx\x97A\xb9xo\xc3#\x823\x821\x928\xc6=z\xd5{O\x12x\x8fNo\x9e\xeel"
+DATA ·d+45312(SB)/64,$"\x7fta\x87\xe2\x0ej\xa1\x98r>Z\xce_\x99\xe8N\x11\xab\x05S\x0f\xca\xd7\xcf\xf4&\xb9\xb0\xb7\xbcC0WV=T\x8c}x5\x8b6\x98\x07\xca\xe1q\xd5\x0bb\xba\xb8\xfceep\xe6;\xe8\xc8c\x9c\xb2"
+DATA ·d+45376(SB)/64,$"\x8cr~\xbd\xaa\xac\xcbo\xa8\xc6Z\x0c\xf49=G>\xfc\xe2\xaf\x15<4\xa3\xcdNWf4kW\xa6\xedR.(\xe4R( \x973D\x03\x0e\x84U\xe6\xbb\xb6\x181G\x8e\xc4\x9a5\x0f\x0f\xeb\x8e\x8b,P"
+DATA ·d+45440(SB)/64,$"L\xf1\xee\xc2H\x11\x88'\xf2\xc1\xfc\xea\xad\xa6\x93\xaf[Jw\xdbJ]~a\x95#\x8e\xd9\xce+\xe6\xe7\x89\x94/\xa1\xec{JS\x8f?\xb4_y\xad\x0a\xc7<\xbbcW|\x9e\x02\xf3\xd7\xd0w\xaf~\xf07\x84"
+DATA ·d+45504(SB)/64,$"-\xf5m\x04\x0dBI,\xd3\xed\x97\x05\x95\xa1c\xbaH\xa3\x18\x8c3`+0\xeczu\xae\xb7\xe0\x8e\x93\x17\x86\xf4\x0b\xedo\xc4\xf6\x9e\x1b\xbf\xfe\xd2\xb0{{{[\x99\xd5\xaf,\x9f~E\xc4aH1H\x7f\x87"
+DATA ·d+45568(SB)/64,$"9\xca\xf6\x15\xe8\xed\xf1\xaao\x0bxzm#\xc3\xed\x1f\x95yq\x19\xbc\x85\xa6k\x98_f\x14;\xa4\xd9BG\x03v2\x00\x035\xe4b3\x07'\xee+\xfe\x16?/\xe2L\xf2\xac\xebO\x0b\x86\xa6\xef\x16\xac\xef"
+DATA ·d+45632(SB)/64,$"\xa3\xd0\xf2\xfd#N\xf8_s\x1bMs\xe2\x0b\xabi\xda?\x96\x03n\x5cn\x07\x01L\x98\xf4\xe7=;Ug\xb5\xd0\xf4K\xa6\xbb\xf0\xb6\xbc\xb7~c5\xbb\xc6\x22`\xf8a\x9er@\x00\x9e1\x8e\xb5\xd4C\xe2}"
+DATA ·d+45696(SB)/64,$"+\xc42N\xd7\xba-\x9d\xcbA\x1bKqs\x1f\x96\x828T\xe0\xb8\xc2\xaeXz\x06\xafS\xf8i\xf0\xc3M\xbcO\xb5[\xe8\xdf\xdaw\x13y\x93[E\xe4\xeeD\x8e\x12\x09\x92A)@\xa8A\xc9\xc6x\xa4\xa7\x0b"
+DATA ·d+45760(SB)/64,$"~\xf2+\xe6\xcf\x92\xc4c\x9e\x1dJu\xdc\xb6\xd57\x06\xbf$\xf6\xeex\x8e\x99\xf1o\xc7\xbe\x1a\x82\xeb\xc3\xba+\xe9\xef\x06\xabm%\x9c\xd1Cl\x8f:\xa3\xe03\x09\x18\x16I8\xce\xf0w\x0epk\xe9\x8b+M"
+DATA ·d+45824(SB)/64,$"?\xe3V\x99\xfd\xa9\xf16\xe3\xc1\xdad\xda^\x97\x84\xb9[\x0b\xa5\xbe\x9e;uP\xb1\x95\xb1\x069$`\x06\x19\xd4\x01\xc9c\xc9\xaf?\xf1\xad\xe7\xc3\xef\x0a\xeaq\xda\x5c\xdb\xda\xe9\xba\x88\x88G4v\xd6\xe2$u"
+DATA ·d+45888(SB)/64,$"V\xe1\x95\xb71m\xfc\xee#\x15\xaf\xf0\xe3F\xd6\xbe+]\xdd\xdb\xfc:\xb3\x82\xe6kxL\xae\x80I\x12\x80\xc7\x84R\xcb\xb0\x12z\x02G\xb6qWZ\x8e\x0e\xfc\xf5$\xa3\xe9\xb9\xe6b\xb1\xd1\x9d(b\xb0\xd49"
+DATA ·d+45952(SB)/64,$"/\xaf5\xad{\xf5\xba\xef\xe4K\xac\xfe\xd2\xb0\x1f\x87\xcd\xf0\x87O\xf0\xa2\xe8\xda$\xb76\xf7:\x8d\xed\xb5\xf5\xd5\xf8\xbd\x92\xd4\x96\x81\xe4\xb7`\x12\x1f,\x9c*\xa6\xe0\xbc\xf2s^\x7f\xa6\xcb\xe1\x0db\xf5o4H"
+DATA ·d+46016(SB)/64,$"\xece\x85\x88y\xa1\x82f\x8aX\xd8\xff\x00\xd3=\xcb\xd3\xe8\x08\xaf\xadu\x88\xfe\x0a\x7f\xc2\x88\xd4ou}G\xc5v\xde:\xb5\x95m\xact\x84\xd3l.t\x8b\x96\x18\x0c$\xbaEk\x94 \x86\xc9\xce8\x07\x15\xf1"
+DATA ·d+46080(SB)/64,$"~\x97\xe0Ko\x16\xeam\x1f\x89\xac\xe2\xd1\x12;\x94\xb7\xbb\xd6o\xcb\xda[\xda\x19\x18\x01\xf6\x89\x02\xb1\x87\x82\x0e\xe2\xb8\x03\xadeBt\xa7\x19\xcf\x0dRK\xbd\xd3W\xd3\xa7r\xf0\xf4\xe8\xd5\xa6\xda\x8f\xb2\xff\x00\x04"
+DATA ·d+46144(SB)/64,$"\xaf\xab\xee\xb4m\xb7\xd9\xff\x00\x91\xeb\x9ai\x8f\xc3\x97R\xeb^\x15\x9eX/\xe7\x22)\x1d]\x84\x9eJ\x7f~F=2~Q\xfa\xd6\xad\xf7\x8cu@\xc3O\xbf\xd4e\x9a\xcc\x97\x95\xa1\x9eW1\x17\x11\xfc\xac\xc3k"
+DATA ·d+46208(SB)/64,$"(m\xdf7\x19<c\xa1\xaf<y\xbe\x18x\x0b\xc5\x97\xbe\x14\xd6\xfcCy\xa9h\xb6\xf7-mg\xe2\x1d6$\xb9gP\xbb\xb7\xbc\x01\xc3:vW\x8c\xfc\xdc\x1cs]O\x89m>\x1c^\xf8vMS\xc2>/"
+DATA ·d+46272(SB)/64,$"I\xf3\x08\xfe\xcf\xb6\xb8\xd3\xeft\xe9\x0c\xa0\xe7p2F\xf1\xb6;\x82q\xe8k)\xc9\xf3)\xcdKm\x1aZ\xfd\xfd\x0f\x22\xa6G^\x15\x97\xb6NQz)4\xda\xf4\xdb\xf3/\xf8\x07\xc4z>\x83us\x7f\xe2\xbb"
+DATA ·d+46336(SB)/64,$"+}v\xd2\xf2\xc9\x13O\xb4x\x15nm\xee\xa3fq<\x02t\x11\xc9\x11\xc0Y\xb3\x92F\x05{\xff\x00\x81~\x1f\xfe\xd0\xbe;\xb7\xd2\xfcS\xe0O\x07K5\xad\xd0\x0fat\xd7\xd0[\xd8:\x11\xfe\xablL\x91"
+DATA ·d+46400(SB)/64,$"\x85=\xc1\xc3v5\xf1\xd2\xde|J\xb6\xd1\xb4\xbb\xbdWP\x85\xa0\xd2\xae\x9eM9\xa3\xdb'\x94\xa5\xb71D\xda\x19\xd5\xb2H\x1c\xf3\xd8W\xdd\xbf\xb2/\xed\xabg\xf02\xcbY\xf0w\x8e4\x86\xd4tk\xddN\xe3"
+DATA ·d+46464(SB)/64,$"X\x81ma\x90\x5cy\xb3\xa7\xcc\x15\x18,H\x8e\xf9\x90\x82\xc3\x0cMy\x99\xcd|e\x15\xedp\x94\x9dK\xb5t\xdb\xbd\xb5\xd5zi\xa5\xce\xacVEG\x11N~\xd2II-7qv{;5\xd3\xad\xbb\xeeu"
+DATA ·d+46528(SB)/64,$"z\x9f\xfc\x13\xd3\xf6\xa2\xf1..\xf5\x1d\x0b\xc1\xd6s\xce\xc5\xd2+\x09\x8c\x12\xc2\xec3\x83 G\x0b\x92\x07\x0cvg\xb8\xe6\xbe \xf8\x9d\xf0\x9f\xe2G\x82\xb5\xb9\xfc?\xe3a5\x86\xa9\xa5\x8f2\xea\xd7SkY\x99"
+DATA ·d+46592(SB)/64,$"`~<\xc4\x96&!\x94\xb1\x01P\xe4\x90C\x0a\xfdT\xf1o\xfc\x15\xe7P\xbf\xd0\xe1\xd3\xb4\x1f\x07i\xd6\xcbn\xc4Eq>\xf7b\xb8\xc6\x1dP\xa2s\xdc\x03\x8a\xfc\xd8\xf1\xdf\x8el\xbe>\xf8\xbf[\xf1\xd7\x8ed"
+DATA ·d+46656(SB)/64,$"\xbf\x87P\xd6\x5cL!\xd3\x85\xbaZ \x8dv\xa8a(\xde\xaa\x14\x01\x95l\xf1]\x5c/\x85\xcd\xf1U&\xb1xu\x08\xaf\x87k\xfc\xf5a8C\x0bN\x9bR\xbb\xfbN\xda/D\xd5\xcf\x0f\xb2\xf0\xf6\xa4\x9a\xb1\xd3"
+DATA ·d+46720(SB)/64,$"u{\xad*\xd2\xeb\xec\x02\xea/-\x9e\xcd\xe4\x0c7~\xec\x0c\xab\xbe\x07#\x80I\x1cv\xa8%{\xdb]Z{\xadnK\xe8\xa1\xdc\xc6{\x8dA\x0c\xce8\x182\x03\x9eON\x0f\xa7J\xfag\xc2\xda?\x824-"
+DATA ·d+46784(SB)/64,$"\x15\xbf\xb5<G$:\x88\xb1h,m\xce\x8e\xd7\xad\xf6v}\xcc\xafw\x9cBW\x03a\xdb\xc0%EyM\x86\xb5\xf0\xd3R\xf1\x05\xd6\x9b\xac_k\xaa\xf7\xcc~\xd7<v*l\x9d\x94\xeeQ\x87\x0c@\xc8\xe0\xee"
+DATA ·d+46848(SB)/64,$"\xc8\xeb_N\xb2\xe5\x1a\xde\xcao\xde\xbe\xc64\xb1\xf5+\xf3TT\xf9\xa1m\xd2\xf3\xd5\xad4\xfb\xde\xa5K\x8dGA\xd0\xb4\x84\xb6\xfb\x1d\xd4\xef,D\x07\x82\xde\xd2\xd4H\x8d\xcf\xcc@\x92C\xd7\xa95\xe3\xb3jz"
+DATA ·d+46912(SB)/64,$"\x15\xe5\xfcvQ\xe9WV\x8cX3\xcc\xb3\xab\xaa\xb0\xf9~\xeb*\x80\xbd\xfd\xbd+\xea]/\xc0\x9e\x09\xbd\xbf\xe2\xe6[\xc7;\x8c+#(\xc0^Tm\xe8\xcb\x8fC\x91\xe8kwR\xb5\xd1<7\xa7F\x9ai\xd3"
+DATA ·d+46976(SB)/64,$"\xae.\xae#\x92+\x9b\x0b\x8b7\x0fn\x03``\xc8N\xf2G\xcc\x1e<\x01\xd0\x9c\xd7\xa9\x1c\xba\x9d9*U[\x8b{h\xff\x00\xcbO\x99\xe5`\xf8\x86\x9d7>JnOv\xdc\xad\xe5\xb7[z\x1f-\xdc\xdd\xbc"
+DATA ·d+47040(SB)/64,$"\x00\xda6\xef-IT*\xea~_@K\x05 \xf5\xe3\x07\x9e\xbcV\x05\xcd\xcd\xde\xad\xaa5\x83J \xb6\x5c\xcd\x14\xbeho(\x81\xd5\x82\x86\xe7\xd1A$\xd7\xd5r\xe9\x9aE\xfe\x856\x93am\xe5Om\x0f\xda"
+DATA ·d+47104(SB)/64,$"ch\x17\xe4w\x8c\xe4\x96\xc89l\x1e3\xe9^=s\xa8x\xde\x0f/Q\xba\xd3o\xf6\xcc\x0a}\xa8\xc5\xb29\x15[\x07\x05T)\x00\xf0k\xb3\xfb)A\xb6\xaa\xa6\xbel\xf42|\xfe\x15\x94\xa5\x0aVkM]"
+DATA ·d+47168(SB)/64,$"\xbeks\xb1\xf0n\xbf\xf1\x1e\xefX\xb1\xb5\xb3\xd5\xe2\xb3y\x9a\x0b('\xb9\x86\x08\x84\xb9m\xaa\xfc\x01\xb7\x82NXg\x03&\xbd\xd3\xc6\xdf\x09\xf5\xd4\xf1s\xdb\xfc@\x9f\xc4\x1e#\x96\x08P\xbd\xf9\x8cEb\x8b "
+DATA ·d+47232(SB)/64,$"&0\x8f6\xd5ea\xca\x901\xe9\xeb^#\xa6x\x07\xc5^3\xbd\x84\xac\x11[\xdbK\x13y7\x1a\x8d\xcaZ\xacx\x04\xee\x8eVe\xc0\x1dG<\xfe\x95\xf4O\x8e?j\x7f\x8a\x1a\xc6\x9f\x0e\x87\xe3i\xb4\xeb{"
+DATA ·d+47296(SB)/64,$"\x8b\x0b\x04\xd3\x86\xbb\xa3D\xb3\xcbv\x96\xe9\x88\xbc\xf6\x1b\x94\x8c\xf1\x95_\x943\x10\x06H\xaf3\x12\xb1\x14\xb1\x14\xa7B\x8a\xa9\x0dT\x9fg\xa5\x9e\xfbZ\xf7\xeb{\x1f?\x98\xe1\xe9V\x8c\xa7\x86q\x85m\xb4Ih"
+DATA ·d+47360(SB)/64,$"\xfa&\x95\xdb\xdb\xaaG\x17\xaa\xfc3\xf0_\xfc\x22\xba\x97\x89\xf5[mf\xd4imo\x0c0\xd9\x98\xa4\x8d\xfc\xcc\x8e\x18\x93\xb7\x18$\xe3p\xcfLf\xbc\x7fP\xbc\xf0u\xec\x90YxOO\xd6\xf5+\xb0\xe3r_"
+DATA ·d+47424(SB)/64,$"\xdd\xda\xacx\x1dv\x81\x1e\xe0\x7f\x12+\xca<I\xe3\xdf\x1d\xf8\x8e\xd94\xdb\xeb\xcb\x89\xed\xed\xe5y\x05\xbcO\xf2!\x90\x82[bcw\xae\xe2\x09\x1e\xd5/\x87t\xab{\xe9#\xba\x9bW\xb3\xb7\x8c\xed\xde\xc7\xef!"
+DATA ·d+47488(SB)/64,$">\xdc\x1e;\x9e\x95\xea\xd6\xc7\xd1i\xaa\xfe\xefk-\xbe\xf3\xd4\xc0\xf0\xf5|5\x17S\x17W\x99\xebe\xef4\xbbm\xaf\xe2\x8e\xce\xefI\xfbt\xd7\x1a\x80\xd3\xdbO\x89\x19b\xbd\x91\xaeT\xc5\x16\xf6\xc1\xca\xb2\xaa\x81"
+DATA ·d+47552(SB)/64,$"\xc1\xe0~u\xe8V~\x06\xf0\xa6\xb1o\x0cZo\x8a4d\x18\xe2&X\xed\xc0$}\xd6\x920\xd9\x04\xf1\xbb\x81\xeb\x5c\xb6\x99\xaa\xf8W\xfb&\xea\xd95\x88\xefuYY\xad\xd0>\xfb{y#\xe8K;\x86F8"
+DATA ·d+47616(SB)/64,$"\xfb\xb9\xc6H\xe4\xf45\xe6> \xf0\xf5\xa6\x83l-\xa1)\x01%%\x05Ud*\xac:\x17\x0e\xf8\xe7=\xbaW\x93O\x13J\xf1\xad\x0b\xb5}\xac\x95\xff\x00\x04\xcd%\x85\xa9U\xba\x13\xa8\xe0\xd3\xd3K\xa6\xad\xe6\xdf"
+DATA ·d+47680(SB)/64,$"\xdf\xfeG\xae\xc1\xa7h\xf6\xd3\x5c\xd8M\x19\xb9hx\xb81\xce\x02(\x1d\xd4/\x0d\xf5\xaf=\xba\x87@\x82\xe6H\x1b\xcbB\x922\x14i\xdc\x15 \xe3\x04y\x5c\x11\xe9Y:V\x87\xa8h\xb1\xff\x00n\xcb{\x14\x13"
+DATA ·d+47744(SB)/64,$"p\xd1[\xc8w\x19\xc1\x1c|\xa3=s\xc0\xafE\x11\xf8\xba\xe8\x0b\x97\xd1\xef\x1c\xc9\xfb\xc2\xe6\x17;\x8bs\x9c\xe3\xbd}\x03\xc7\xc2\xa6\xb0\xa4\x929*\xe1\x9d\x17\xee\xd6\xe6\xbf[\xdbU\xbfS\xff\xd7\xfca\xf1&\xa1"
+DATA ·d+47808(SB)/64,$"\xf0\xeb@\xd7\xd3\xc3\xfa\x16\x99$\xb2B\x83\xed7,\xaa\xe5\x9f\xb0d\x8dV(\xf1\xfd\xd4\xe7\xd4\xd7\xad\xc3\xe1\x0d3K\xf0h\xf1w\x894\xbb[\xab\x0b\xc0\xb7QI\x15\xed\xa3\xa2\x81\x85*\xf6Q\xb2\xc8\x1d\x87\xa6"
+DATA ·d+47872(SB)/64,$"\xd6\x02\xbe\x0c\x97\xc6\xd7O \x98Go\x02\x89Kl\x8b\x97o\xab\x9e\xbfR:\xd7]iku\xe3\xfb3cd\xd1C\xa8\xac\xab\x1a@\xf7\x09\x0c\xcc\xcc\xc1\x124\x85\x86^I\x09\xe3?^\x05~\xf3\x88\xce0\x18"
+DATA ·d+47936(SB)/64,$"jP\x8a\xb3\xb6\xef\x97\xf5oO_\xc8\xff\x00.\x7f\xd5\xea\x9e\xd1NWK\xaf\xbd\x7f\xc2\xdf\x93\xfb\xcf\xaf~ x#\xe0\xd7\x86\xfc-o\xe2K\x8d7\xedG[\xd2\x85\xe5\xa5\x86\x9fsw`\xd6\x92\x17\xf2V)"
+DATA ·d+48000(SB)/64,$"\x86\xfb\x84\x90\xb1\xf9\x83#\x1f\xee\xe0\x1a\xf8\xb6\x04\xf0\xd7\xdbf\xd2\xec-\xe7\x98J\xfbm\xed\xdeS;+\x9e\x02\xa9#-\xd7\x80\x06M}=qy\xe3\xaf\x81\xda=\xa5\xaf\x8d\xed\x8d\x91\xb6\x86\xe2\xca\x18\xee\xbc\xa9"
+DATA ·d+48064(SB)/64,$"\xe30\xde\x0d\xb2\xa1D\x95\x86\xec\xae\xf5`\xa0\x86\x1cc<\xfc\xe7\x15\x8f\xc3\xdd\x12\xfa\x07\x82-FH\xeeJ\xde\xda\xc9\x19U$\x068\xda\xc1\x83!\x04\x1e>\xf0\xeb\x8e\x95\xe6\xe13J<\x8aS\xe4\x92\xef\xcd\xbf"
+DATA ·d+48128(SB)/64,$"\xc9^\xdf\xd5\xce\xdc\xbdU\xe6\xa8\x97<\x93o\x96\xcbO\xd3\xa6\xbf\xe4s\x7f\xf0\x8f\xf8d_\x96\xbb\xb3\x91d\xb5\xe6h\xd9Ll\xbd\x82\x1d\xc1X\x13\xd4qZ6\xfa\x8d\x84\x1a\x146\xda}\x8f\x93\x1b/\x9eo]"
+DATA ·d+48192(SB)/64,$"ZFm\xbdYX\xe4r\x0e1\x8a\xee\xaf5\x8f\x85\xda\xa2D\xd6Zu\xf5\xbd\xda\x89>\xd5y%\xd3O\xe7d\xfc\xb8\x8d\x86\xd4\x0a8#q\xdd\x9c\x9c\x1a\xc6\xd4&\xf8c&\xaa\xfa^\x90/c\xb6E\x0a-\xa7"
+DATA ·d+48256(SB)/64,$"\xbehc\x04\x0c\xb1\xfd\xe6\x00'9\x18\xce*gW\x0b){hT\x87\xdf\xb7\xc8\xed\xad\x88\xab\xfc\x1a\xb4\xe6\xf6{hbi:\xc4Z\xb5\xd3[\xdb:\xe3`?\xe91\xb2.\xd1\xe9!\x1f\x8f\xb5v\xb6?\x14/"
+DATA ·d+48320(SB)/64,$"\xf4{hm\xbcEk\x1e\xb5\xa4\xc7u%\x8c\xa8\xb3\x15 \x0e[d\xa8y\x1bO\xca\x18u\xfe +\xc1\xf5\xcb\xfb+\x9dBXt\x08d\x86\xcf\xee\xc6\x92O\xf6\x87`;\x9289\xfab\xbb\xef\x0f\xf8wM\xbc"
+DATA ·d+48384(SB)/64,$"\xd2\xb7I\xa8^@_&{e\xb7\xda\xa5\x87\xdd\xd9\xce\x09\xc7\xf1`z\x1a\xbcN\x0b\xebiEY\xdb\xb6\x9f\x8e\xe2\xaf\x87\xa1E*\xb5v}\x1a\xbf\xdfc\xf4\x93\xe1M\x9d\xbf\xc7)t\xfd#\xc1v\xfa]\xa6\x93"
+DATA ·d+48448(SB)/64,$"\xaa\xc8\xf6VR\xd9\xda\x05\xbf\xb0\xba\xb4\x0a\xd1\xc6\x11\xd8\xc6\x5c\x83\xbc\x0d\xfb\x5cd\x9e\x01\xaf\x1c\xf8\xf3|\xbf\x0e\xf5\xadZ\x0dSQ\xd45I#\xb7\xb8\xb4\x86\xea\xe7J\xb7\xb4s5\xc1\xdb\x10g\x88\x94\xcc\x91!"
+DATA ·d+48512(SB)/64,$"o\x90\xfc\xab\xd7\xef\x0c\xf9\xdf\xec\xd5\x7f\xa1xK\xc6\x8f\xa2\xdc\xf8\xd7\xc6\xde\x16\xbc[\xe8\xe4\xb3\xb9\xf0\xad\x84Z\x80\x9dY\x0a\xb6-\x9f'\xcd\x01\x99r\x08\x18$f\xaf\xfcW\xf0>\xa1\xe2\xdf\x1e]\xc9{\xad\xdd"
+DATA ·d+48576(SB)/64,$"\xdei\xb7\xc5u\x0d,\xeb\x91\x8b;\x97\x99\xa3X\x83][\x03\x98KF\x80\x80K\x00\xb8\x1cf\xbf1\xc3p\xfe3\x0d\x98N\x9co\xc8\xb5wM\xea\xdfw\xe5m\x99\xd5\x98\xd4\xc3M*\x95\x9d\xd4\x96\x96\xd3M,"
+DATA ·d+48640(SB)/64,$"\xb4\xb5\xfa\xee\x8f\x96n\xade\xbe\xd1\xe7\xd5\x05\xdd\xacK\x13\xaa(w\xdc\xec\x18\x80p\x14g\xe5\xcf5\xdf|\x1c\xf0N\x93}\xaa\xc6<\xb3$\xef'\x94\x0c\xa9\xe5\xac\x8e\xc0\x95%\x9c\xe5O\xa2\x9029\xce+\x0a"
+DATA ·d+48704(SB)/64,$"\xf3D\xd7\xf4\x1bd\x87Q\x82\xcd\xe1\xb6/\x13\x0b\x19 \xfd\xe1pQH\x94\x13!\xeb\x90\xd8\xe3\xd3\x9c\xd7#\xa4\xea\xda\xaf\x87f\xb9\xb3\xba\xf3\x12/29-\x8d\xa8\x91w:\x8c\x01&K\x16Ps\xc8=y\xc1"
+DATA ·d+48768(SB)/64,$"\x15\xf4x\xccEH\xd3j\x11\xd7\xb9\xdd\x87\xc3)\xd2\x94!-;y\x7f^g\xd5z\xc6\xaf\xa8iwr\xe9\xaa\xf0\xc7\x1c\x1b\xa1tko\xb5>\xe5;\x0bn\x8dLdu\xc6\x09\xe2\xbcs\xc5\xfe\x12\x9e\xc9`\xd4"
+DATA ·d+48832(SB)/64,$"E\xcd\x8e\xa1\xa6\xddn+ua1S\x1b\x83\x82\x93F\xca\xb2D\xc3\xa0V\x00z\x13\x5c=\xd46P\x22\xf8\x86\xc3P\x9a+\x92v\xf9/y&T\xb79\xf2\xc2\xaa\xb9NGQ\x9e3F\xa3\xa5|S\xf1\xecW"
+DATA ·d+48896(SB)/64,$"\x1a\xe7\x86tMoY\x86\xdd\xe3Y\xb5\x04\xb3\x92Ue\x18P\xb3\xcc\x8a\x02\x8c\x83\xf7\x8f\xb0\xa8\xc1\xe6u \xe2\x9b\xd3\xa9\xc9G#\x8a\x9f4%o]-\xfe~G\x97\xf8\x83\xc3\xfe\x1b\xd5\xb5y,t\xfb\xbb\xb8"
+DATA ·d+48960(SB)/64,$"\xf7\x81\xb1\x9d\x84\x8a\xce8\xf9C\x10q\xf8\xd6m\xef\xec\xf3\xf17O\x8a=R\x1d>\xe6{I\xb2\xf1\xdd\xac\x12\x18\x99Gp\xca\x18}Fx\xaf\xaf,<]\xae\xeb:N\x9f\xe1O\x88>\x19\xf0n\x9d\xfd\x96\x8e"
+DATA ·d+49024(SB)/64,$">\xd6-\xd6\xd3S\xb9\x0f\xf3\x06\xba\x94J\xc6b\x80a\x06\xd1\x81\xc6+V\xff\x00\xe2\xc4\xde\x1f\x82\x0d\x07Fi\xaf\xb4\xd8\xcf\x9b\xe5\x96\x92\xde\xd8\xb19(\x17\xa9\x19<\xe3\x1b\xab\xea\xf0\x98\x9aU\xdaR\xbd\xce\xba"
+DATA ·d+49088(SB)/64,$"\x9cY\x98\xe1f\xa8\xe1\xa2\x9aK[\xbb\xaf\x93\xb2>,\x97\xe0\xed\xecv\xbfh\xd5R\xee6U\x05\x928X\xae\x0f\xb9\x03\x06\xbe\x99\xf0G\xec\xc7\xf0\x96\x1d:\xcbX\xf1\xf7\xc4\x8b_\x0cAw+\x1f\xb3O\xa5\x5c"
+DATA ·d+49152(SB)/64,$"_\xdd,(\xa3\xe7d\xb5|\x8d\xecH@9#\xe6\xc68\xae\xce\xd3\xc5\x16:\xb5\xcc\xcf\xabZK\xf6i0Q4\xf9dE\xb7-\xc6A9\xdd\xd78-\x8a\xf5O\x18|6\xd0\xf4=&\x1dJ\xd6\x09\xb6L\x89"
+DATA ·d+49216(SB)/64,$"\x14F\xf6\x14\xb8F\x93\xae\xe2\x13\xe6\xc1\x1dA\x07\x8e\x98\xc5NiR\x8a\x9d:\x14\xea8J^\x86\xf0\xe2|\xca\xa5)T\xab\xf0\xf9\x7f\xc1E\xedK\xc7\xdf\x0a|\x19\xa6j\x9aW\x86n\xa4\xd6\xa6\x968 \xd3/"
+DATA ·d+49280(SB)/64,$"l,Dv)k\x02\x92\xd0\x05\x9b|\x9b\x9f#.\xbbs\xceq\xd0\xfc\x7f\xe1\xed\x1bH\xf1 \xd4|W\xe2\xf9\xd28\xac\xa3\x13\xcd\x04D}\xae\xe2I\x9fj\xc3\x02\x13\x8c\xb3u$a\x14d\x8a\xfaz\xe3\xc0^"
+DATA ·d+49344(SB)/64,$"\x18\xd6\x0c\x9a\x8f\xc2\x9dsF\xb4\xbf\xb2\x8b\xed\x12\xe9\x977\x82\xda\xe5dE\xcb\xac>hQ&O\xdd\x1dk\xc5u\x9f\x0a\xe9\xfe-\xb9\x91\xbcr\xb3iz\xac\xe4\x98\xb5+xC\xdb\xca\xd8\x00\x09\xd6<\x04\x19\x1c"
+DATA ·d+49408(SB)/64,$"\xc9\x1f99+^\x83\xc8j\xd2\x84\xeaP\xad\xcf\xcdk\xbb^\xdd6\xe9\xfay\x9f'\x92\xe3\xa8\xa9r\xd4\x8b\x85\xf4\xde\xce\xd7o\xe7{\xee\x8e7\xc4\xdf\x0e\xb5\x9f\x0f\xe9\xf6\x1a\xe6\xa9\xe1\x1b\xdd.\x0b\xa3\xe7Y\xdd"
+DATA ·d+49472(SB)/64,$"\xcdw\xb9\xa7G9\x88\x80\x18\xe3o\x1d\x00'\xda\xb1\xf4\x1d\x13D\xf8\x85\xe2\x16\xd2\xadt\xab\x9b\x9dB8n..m\xac\xeeQd\xb8\xfb<{\xd8\xc6\x1c\x05y\x00S\x94?\x7f\xb1\x06\xb0f\xf8e\xe2\xd8.\x05"
+DATA ·d+49536(SB)/64,$"\x96\x9b,\x97'{\x18^\x09\xc3\xa1 \xf6\x19\xe3\xf4\xaavZ'\xc4\x9f\x05x\x9a-b\xc6\xd2\xe2\xdbU\xb2\x95.\x22\x95\x22p\xdb\xd7\x9c\x95\x1c\x1fr8 \x90z\xd7\x85]\xe2])F|\xaez\xed\xa7\xa6\xed"
+DATA ·d+49600(SB)/64,$"\x9f\xa2\xd0\xfa\xa4\xeasB\xaf\xbbm.\xdd\xfeg\xa6]|8\xd546\xb1\xf1G\xc3\x99\x93Z\xd2\xaf\xc1\x8e\xd6\xe6\xd9O\x97q\xbdA\x9a\xca\xe2\x0c\x97\x8eD\xce\xc6V\x5c\x86\x19\x1e\x95\xef~\x14\xf1\x8f\x89\xf4="
+DATA ·d+49664(SB)/64,$".\xdb\xc2\x9e$\xf0\xfe\xae\xe3NY/\xf4\xbb\x91+\xc1q\x15\xa6FC\xf2\x85\xd6>Aem\xc4u\x07\x9a\xf0]\x22o\x89w\xfe(\xb8\xf1w\x86-.4Kg\xbf\x96\xf2f\xb4o\x96\x0b\xa9\x14\x17\xd9\xf7_"
+DATA ·d+49728(SB)/64,$"\x05\xfe`?\x878\x06\xb7\xb5\x8dAwY\xbe\xaf\xe36\xd6\xa7\x16\xc2R-\x1eIf\xb7\x90\xe70\xe2r\x07\x0cy\x0a@\x1c\xd7\xccV\xc2bk\xb5\x0a\x89s.\xa9\xbb\xfc\xd2\xd2\xff\x00\xd2<\x5c\xf2\x8d\x1a\xb6\xa7"
+DATA ·d+49792(SB)/64,$"\x07\xcf\x1d/\xa5\xda\x7f\xe2O\xa7\x9d\xb4=3\xe2\xbf\x8d>\x14|b\xb6\x82\xea\xe7L\xb8\x8fP\xb3m\xd1\x5c\xe9\xd1K=\xbc\xec\x06\x0a\xb6Uv1\x1c\x92\xbb\x818,8\xaf/\xf0g\x8cu\xe6\xbb\x83C\xf0\xcd"
+DATA ·d+49856(SB)/64,$"\xf3Eo<\xe6'\xb1\x91\x9a\xcc!\xfb\x89\xb8nT\x90\x90rY\xbe\xef\x5c\xe2\xae\xf8c\xc6\x8f\xa0\xe9H\xda\x18\xbck\xa7I\x22\x9d5\x19m\xe4\xb4I\xa6\x18\xf3!\x8a2\x18pH\xfd\xe1?J\xdc\xd1\xbe\x14\xf8"
+DATA ·d+49920(SB)/64,$"\xbb\xc4\xc9\x16\xa0\xbaf\x9fs$\x8c|\xd9nn\xc2B\xf1}\xdf\xde\x22\x141\xaa\xe3\xaa\xee\xdd\x93\x9e\x95\xd5O\x87\xeb\xfb;N\xe9\xdfG'\xfevg\x9fF\x8d\x1a*T\x22\x97\x22[_E\xe8\xadc\xdb]>"
+DATA ·d+49984(SB)/64,$"\x03\xfc\x0f\xb6\x17G\xc4\xdau\xee\xba@\xfbz[\xd8\xdcj\xd0\xda\xba\xb8% \xba\x0d\x0d\xbc\x8f \xc8\x94\xed(\xa3\xe5\x07\x92k\x95\xf1/\xed=\xe1\xbdr\xfe[\xdd'@\xd6V\xde\xf2\xd5\xf4\xfb\xfb\x9f\xb4\xa4\x09"
+DATA ·d+50048(SB)/64,$"}o0+,\x06\x1f)\xa3XO\x0c\x88\xa5\xb6\x95\x1c\xf2k\x93\xf1\x1f\xc0\x17\x16m\xa8\xf8\xbbQ\xf0\xfe\x9d\xe4!X\xac\xad\xe7\x9a\xe9\xdf\x9f\xbb\x1f\x96\xaf\xce:\x03\x81\x81\xd6\xbc\xf3G\xf8?\x7f\xac\x94\xfb&\xb3"
+DATA ·d+50112(SB)/64,$"mn\x85\x99!\xfe\xd0\x86H1\x1a\x0c\xeeD\x90\x80\x00\xfdOJ\xf6\xf2\xec\x91\xc6\xd1\xc4T\xe7{\xee\x97\xe5\xfdw<\xbaX\x5c\x12N\xbc\xae\x9e\xd7w\xb7\xcb\xbf\xe5\xd8\xe2\xac\xfe\x10x\x03^\xd5\x9e\xfa-At"
+DATA ·d+50176(SB)/64,$"\xc8#\x0f7\xd8\xe5\xb8W\x9e\x5cd\xa2D<\xbc\x16'\x00\x8c\x8crkOS\xd3u\xeb\x08m$\xbf+!#\xcb\xb0\x0c\xdb\xad\x99\x07\x00#)\x08\xdb1\xc88\xc3u\xe7\x9a\xf4\x0f\xf8U\xbe\x12\xf0\xe6\x93.\xa3"
+DATA ·d+50240(SB)/64,$"}\xe2\x1bk\xab\x85\x9a;D\xb5\xb0e&S1\xc6\xe5ryQ\x9c\x90\x00\xfd+\xe8\xef\x81\xab\xfb;i\xfaU\xc6\x9d\xf1B_\x17\xddY}\xa5l\xe3[\x18\xec\xda\xc9e\x90\x94y\x8b\xcf\x97\x8cg\x82\xc9\xce\x01"
+DATA ·d+50304(SB)/64,$">\x95\xf5S\xc3\xc6\x84\x1dJn\xf6\xe9\xd7M\xed\xdcX\x8e!s\xe5\xf7\xdc\xd2\xb2W]\xfc\xde\xba\xf7w><\xd3\xf4\xef\x0cZH\xa9\xe2\x9b\xd5\xb5\xbb\xdce\x94\x18\xc9R8;\x0f\x96\x0e\x03c\xa8<u\xae\xb0"
+DATA ·d+50368(SB)/64,$"\xf8\xfe\xce\xf7\xc3\x92x7E\xb4\x86cq:\xbcR\xc1\x1c\x92\xdc\x06C\x90\x14\x81\x93\x9f\xee\x9c\xd7\xd4\xff\x00\x1a\xef\xbfe\x0f\x85\xd6v\xba\x8f\x84<=\xa7\xeb\xf7B\xdd\x04\xc6\xde{\x88\xa2\x8ad\xe2@\xd1]\x06"
+DATA ·d+50432(SB)/64,$"g\xc9\xe4>J\x91\xd0\x01_1?\xed\x05\xe1\xadwS2\xf8^\xc14\x04\x85\x92h~\xdf\xab\xcd\x09+\x8f\x99Q- ER\x0f9\x0d\x908\x19\xa9\xaf\xc5T!F3\xf6W\xba\xf3_\xe7\xf9\x13\x80\xcbjc"
+DATA ·d+50496(SB)/64,$"\xafUFM'\xddY?%\xbd\x8fG\xf8i\xaax2\xef\xc3\x97z\x0d\xff\x00\x874\xf6\xd5 \xdf:\xea7\x1b\xc2\xb2\xaa\xfc\xb0\xba\x13\x98\xa4f\xc8\x0d\xdf\xa7Z\xc1\xf1\xf5\x86\xb3\xe1+5\xd45]\x0e\xca\xde\x19"
+DATA ·d+50560(SB)/64,$"\x13\xcdK\xfd6f\x9e\xda]\xfc\x84\xdc>To\xf6\x0e\x0ex\xc5{\xe7\xc3O\xdaK\xc3\xdf\x17|9?\x85\xbcm\xe1+\x9b\xa9t\xa41\xda\xea\x9aM\xca}\xaa9n\x19\x22\x805\xc4\xfb%\x969\x1c\x9cF\xe5"
+DATA ·d+50624(SB)/64,$"\xc1>\xd9\xc6\xff\x00\x83>'\xeb?\x01\xbco\xad\xda\xdb\xb6\x93\x0d\xdd\xce\x99>\x91*k\x0b\x00\x8c\xa4\x84y\x91\xcd\xb1\xae#,\xb8\xf9\x03d\xa1\xe8Eve\x19\xf2j^\xca\xd7}\x1b\xbd\xaf\xd9\xbe\x9eG\xcb\xe6"
+DATA ·d+50688(SB)/64,$"\x18IQ\xc6N5a\xae\x9a]\xea\xad\xd2\xcd\xa5\xf7\x1f\x13h\x1e>\xd5\xf4\xe5\x9bU\xb2HeY\xe3(\xc9#\x1ec\xce\x06H<\x10ON~\x95\xd3>\xb3\xa6\xcfs\xa7\xff\x00n[Z\xc7kv\x22IX\xa1"
+DATA ·d+50752(SB)/64,$"\xdd\x03\xb3\xed\xfd\xe1V\x0a\xd8\xfb\xdc\xe7\xe5\xceFk\x8c\xf1\xe5\xb6\x81q\xab\xad\xe5\x86\x9e,\xa2\x999ki\xdb\xc9f=\xc9\x03\x19\xf5\xae\xc7\xe1\xc7\xfc!V\xda\xa4:N\xb1\x0b\xbd\x84\xce\xbez\xdb\xa8\x9c+\xae"
+DATA ·d+50816(SB)/64,$"Km\x8ePC>>o\x95r=*\xf3|\x0di\xcd\xd4\x9c\x92v\xdf\xfe\x1b\xf4=YP\xa2\xa1\x1cE:n\xef\xb5\xb4_\x7f\xe9\xf25u\x0f\x0c]\xdc\x9b\x9dWA\x114\x16w\xa5R\xde\xd6TpQ3\xfb"
+DATA ·d+50880(SB)/64,$"\xc8\xc3\x1f\xb9\x91\xeb\xca\xd72\xde)[\x97\xb5\xbb\x86GO\xb3\xb1\x12\x1b\x89\x83\xc6\xac[8\x8a6$\x85\xeb\x80\x0fZ\xfaCT\xf8E\xfb9\xe9\x1e?\xb0\xb7\x9b]\xd7\xe5\xd1/\xccrK\xa8Z\xe9\xe9\x0c\xd6f"
+DATA ·d+50944(SB)/64,$"\xe0\x1c\xb7\x93)\xd92B\xf8\xf3\x15U\x0b\xa1\xca\x0d\xcaA\xe6\xfe1~\xce^:\xf83\xe3\x0b\xef\x04\xcdk\x0f\x99mr%\xb6\xd6,\xed\xc1\x86\xfa\xd0\x00s\x18\xc9\xf2\xa5e`\xe07|\xaf\x1c\x1a\xf9\xe7\x9aV"
+DATA ·d+51008(SB)/64,$"\xa7%N\xa5d\xee\x93]\xed\xb6\xcfw\xf8\xa3\x86\x85:\x15c\xa3m]\xab\xec\xbe\xfdS\xf2\xff\x00\x80c\xaf\xc4%\xf0\x92\x1f\x10h\xdaU\x8d\xeaO<\x90Ii|e\x94\x22\xb8\xca\x0e$BA\xed\xc8\x19\x1c\xd7"
+DATA ·d+51072(SB)/64,$"#g\xa1[\xddXK\xab\xf8\xcbU\xff\x00\x84}e\xb9\x8d\xad,\xd2[w\x8b\xca\x91\x89v+\xe7\xb3 A\xca\x82\xb9n\x9dk\xcf\xfcW\xab}\x85n-\xado\xb5\x0dU\x89\xf2Z;\xdb)-\xc3A\xfd\xe2\x1b"
+DATA ·d+51136(SB)/64,$"\xb2\x9c\xf1\xed^as\xaeh\x9aG\x88\xe3\xb8\x9e\xc2\xda\xee\x186\xc6-\xa2f\x87\xcc\xde\xb9\x12\x13\xd7\x1d\xb1^\xa6\x07=\x93W\x9dm<\xe2\xd7\xe9s\xb3\x03\xc2|\x9c\xfc\x8b\xde}U\x9bit\xd5\xd9_\xbd\x8f"
+DATA ·d+51200(SB)/64,$"\xa6\xae\xb4\xdf\x03jP\xebz\x9e\xa9s%\xe2[\xb5\xbf\xf66\xabl\xaf\x0cS\x91'\xef\x14B\x9c\xab\xca\x87\xe4f\x18B\x0f\x5c\xd7*\xd3\xf8\xce\xf5\x9e\xdfD\x83\x5c\xfe\xcd\x922\xb0~\xed.\x1d\xb1\x9d\xaaX\x8e"
+DATA ·d+51264(SB)/64,$"\x9e\xbe\x95\xe5\xb7\xdf\x104\xdb\x08\x16\x0b{\x01\x14\x9f?\xee\xd5G\xf1\x1c\xafPI+\xd2\x915\xfdM\xe3\x86\xe4\xc9%\xa0\xde\x1aO%\x8cNW\xaf\x18\xc61ZQZ\xf3G\x12\xdb}\xbf\xc8\xd6\x96O^\x09J"
+DATA ·d+51328(SB)/64,$"T\xf4[sj\xb4\xebe\xdc\xea\xbc'\xe0\xcf\x15k>,\xb2\xd2<_\xa6\xde\xd8\x89\xae\x11\x96t\x85\xe3\x7f/\xcc\x1eg\x96\xf8\x00\xed\x07'\xae+\xdc~'\xfc\x1b\xf8w\xe1_\x88w^\x1e\xf0\x84\xfa~\xa7\x07"
+DATA ·d+51392(SB)/64,$"\xc8\x96\x97r\xde\x0f\x9c\xc8\x092HF\x15H \x82\x0eGC\x9a\xf2i\xbcy\xad\xde[[\xe9\xc2\xf2\xfeK;g\xf3#{\xa9$p\xa4\xf2J\xe4\x9d\xb9\xef\x823\xde\xba\xab_\x886~ \xdf\xa5\xeb\x16\x9a["
+DATA ·d+51456(SB)/64,$"D$Y\xc5\xc46v\xf0M(\x5c\x00\x0b\xa2\x0c\x81\xceG\x19'-\x9a1XJ\xd2\x94+\xaa\x89%\xba\xb6\xff\x00\xf0\xc7\x16/\x11\x8a\x93u>\x15m\xa2\xde\x9a\xef\xf3\xfc\x07\xf8%<\x11\xab\xea\xeb\xa4j\xcd\x0d"
+DATA ·d+51520(SB)/64,$"\x8aDZ8N\xc3:\x16V\xc1\xf9\xc1(\xaa[?1R+\xb7\xbc\xf1\x05\xff\x00\x85M\xd6\x97=\x85\xacW\xb1n\xfb\x0c\xd7S\x98\xd2/\x9b\x9c$1m\x9bzq\x86 s\x91\xcdw\xda\xde\xb9\xf0\xe3\xc1\xfe\x1c"
+DATA ·d+51584(SB)/64,$"\xb1\xb4\x87Hm^\xed\xa0\x8e\xf2I-\xe1{&\xbb\x92El\xc6%\x85\xd8\xa8\x8b*7`\x13\x82\x0a\x8c\x83^\x03\xa9\x0d]\xf5\x09`\xd6\xb4;\x8b\x09\xc2y\x82\xca\xeaPHV\xe8K\x12[\xf1'4<e\x09"
+DATA ·d+51648(SB)/64,$"\xd3p\x95K\x7f\x85k\xf8\x9f7I\xfbz\x9e\xd9\xd2\xbc{I\xa7\xaf\x92\xbav}n\x99\x81\xa0\xfcE\xd7?\xb5\xe4\xb1\xd64\x1d:+k\xa9\x11Zk8\x99\x9a6\xee\xd9s\x90=\xd7\x8fj\xef\x7f\xe1;\xd5\xa2"
+DATA ·d+51712(SB)/64,$"\xfd\xdc\x17\x13\xaa/\xca\x80I\xc0Q\xd0\x0f\x9f\xd2\xb2M\x97\x80\x17C\x95u\xd8\xb5c\xab\x93\xb6\xda\xd7A_2\x14\xe3;\xa7\x9a\xe3lx\xecV<\xb7C\xd2\xbc:\xe3zN\xe8\xa9 \x01\xc8\x01\xcc[\x86\x0f|"
+DATA ·d+51776(SB)/64,$"\x1e\xbe\xb5\xe8\xe4\xf9\x9d\x0a4\xdc%\xcd;uqK\xfe\x1c\xf7\xaa\xe4\xb41R\xf6\x8a\x97'\x92\xd5?=[\xfd\x0f\xff\xd0\xfe`\xf4\x1f\x0dx\xd7Z\xd4\xff\x00\xb3t\xf8\x22yJ\xee*0\x87\x1d\xced`\xa0W"
+DATA ·d+51840(SB)/64,$"\xa6Y\xa6\xad\xe1\x0b\x87\xb5\x92\xe2X\xe7\xba\x1b/\x08\x9e'8\x5c\x15\xf9\x80nA\x03\xbf\xe3^Qm\xe1{\xfb\xfd]\xb4\xe8\xaed\xbb\x95\xa4\xf2\xd8\x05k\x87'\xd0*\xa1\xce}\x05z~\x99\xe0k\xff\x00\x0f\xea"
+DATA ·d+51904(SB)/64,$"\xb1\xc1$\x91/\x90\x08\xb9[\xbbyPB\xac1\x99\x17f\xe5n~V\x1c\x83_\xa6U\xd3X\xd0\xe6_y\xfe~f<\x92\xb75T\xbc\x92\xf2\xd3\xf1=\xdbD\x9a\xf1\xfc?.\xb7\xaf\xe8\x96\x1a\x8aY;\x19\xf5"
+DATA ·d+51968(SB)/64,$"[\x1b\x98-/\xe4Iq\xff\x00\x1f\x02@\xec\xe3\x9cn@O\xb5p7w\xfe\x16\xf1E\xda\xe9\xf3\xe9\x11\x08\xcb\x99-\xa4\xb5\xbaq$C\xbed1\xfc\xf9\xc7R\x06\x0dw_\x10|\x22\xda7\x86t\xaf\x08\x0b]"
+DATA ·d+52032(SB)/64,$"&(\xa4\x92-Fm^#s5\xc4\xab\x8c$l\xd3G\x1f\x92\xa7%\x88PA\xe0g\x8a\xf4\xcf\x02\xfc\x0f\xd2<Q\xa3\xeaz\xc6\x99\xad\xf8f\xda\xdfN\xb7I\xe1[\xbb\xb8\xe1\xf3Fv\xca\x0b+\xb3!RG"
+DATA ·d+52096(SB)/64,$"2 \x0d\xbb\x8c\x00Mg\x82\xf6\x16\x7fZ\x8f\xb3M\xe9{\xfe\x87\xc5V\xadd\xe7G\xde\x92\xfe[\xd9~\x9f#\xc1\xbc)\xf0\xea\xebW\xd5\xed\xf4\x85\xb3\xd4\xaf\x22\x94\xb0d\x82\xe1\x12ENX\x11(C\x8e9\xc1"
+DATA ·d+52160(SB)/64,$"\x07\xda\xbd?\xc4\x9f\x015\xcf\x87z\x15\xde\xb5\xadi6\xf6\x8b\xe5\xa5\xe5\x9aj\xf7\x10\xc9p`\x9b\x84\x01@\xdc[\x8c\xb6T\x1c\xfa\x0a\xd7\xf0\x86\x9d\xa7]i\xed\xe2kM_J\x17\x16\xb3?\x99g:F\xe4\x00"
+DATA ·d+52224(SB)/64,$"1\x96Y$\x81\xb0\xcax(X\x11\xd0\xd1\xe3o\x88z6\xb9\xa2\x7ff\xeaOca%\xb6\xc8\xad\x93\xc3\xf6\x91\xc4\xaf\x14Jq\xbew2JO$\x81\xbb\x19\xeb\xeb^\xde\x0f$\x84\xa7/\xab\xaf]\xd2\xf5\xd5~"
+DATA ·d+52288(SB)/64,$"G\x95\x98\xe2s\x09J>\xd2\xa5\xa3\xa6\x8bW\xe9\xa3\xf4<\x06\x7f\x8a:\xccw\x90YiZ\x0cc8V}>5\x8e3\x81\x83\xb8I\x1c\x87\x9e\xb9\x07\xde\xbe\x8a\xd04O1-/<x\xd7\x1a*\xdd[\xb5\xdc"
+DATA ·d+52352(SB)/64,$"I\x7faou\x0c\x91\x12\x7f\xd6\xa4h\x93`\x9e\x98 \xe3\x9a\xf9\xae\xf7^\xd7<\x19\xad6\xa1\x1d\xc3\xea\x16\xb7\x16\x19\x8d\xa6\x0c\xeb\x87 \xaev\x95\xda\xcb\x9f\x5cv\xaa\x90\xfcY\xf1u\xc5\xcf\xfa+Y\xa4\xb2\xa8"
+DATA ·d+52416(SB)/64,$"\xc5\xc2[+\xca\x15x\xc8\x92v\x93\x85\x1cq\xd8`SU\xb1\x14\xea%k\xaf\xb8\xf6+\xe5.\xac\x13\xc3\xc61]^\xad\xfc\xef{\x1fd\xeb\x97_\x0b|\x175\x8f\x8a~\x10jV\x17Z\x9c\x22\x07\x95\xad\xd2X"
+DATA ·d+52480(SB)/64,$"\xedc}\xd9tX\xae\xc1\x91\x08#p*\xcc\x07N\x87\x15\xd3\x5c\xf8c\xc2?\xb4n\xbf\x06\xad\xe2/\x10kz%\xf5\xc4\x91\xdb]\xde\xdeLu\xab-\xf2\x10\xb1\x10O\x93,q\x0c\x80\xf8\xdc\xa89\xe7\x06\xbek"
+DATA ·d+52544(SB)/64,$"\xd44\xfdc\xc4\xfe\x10\xd3\xfcq\x15\xcd\xce\xb1y{y*k\x044(\xc8\xea\x02\xa3\x18\xe3Pp\xca\x07#\x02\xbe\x80\xbd\xd5\xfe\x1dx[H\xd2\xb4\xe8\xa1\x11]-\xae\xeb\xe3k\xccB\xe1\x8e\x17i\xe0\xe5q\xf3"
+DATA ·d+52608(SB)/64,$"98\xc9\xc0\xa7<Z\xf6T\xe2\xaa\xfe\xf1\xf5Z\xf7\xdfE\xa2\xf4<\x99\xd2TkI\xf2\xe8\xaf\xa7o\xbe\xef^\x83|G\xfb*7\x83\xfcU\x07\x81\xbcKo\xa6_O\x10\xb9\xb3]CT\xbf6\x1a|\x9eC\x1c"
+DATA ·d+52672(SB)/64,$"O\x0d\xc0\x1bfI\x00\xf99\x1c\x9c\x1c\x1e+\xcc\xbc\x1d\xfb?\xe9\xfe)\xf1\xfd\xbf\x85\xect8\xa4\xb8\xb8\x9eB\xb6\xd0Kv\x90\x91\x0a\x97peVl U\xe1\xb2\x0f5\xe9\x9e\x18\xf1\x8e\xb7\xaeh\x92xwY"
+DATA ·d+52736(SB)/64,$"\xf3n-a-\x0cJ\xb1\xee\xb61\x1c\xa6s\x82\x10\x95<\xf4\xf9\xbd\xcdyO\x88f\xd1\xbc0n\xb5\x7f\x0a\xear\xe9\xf7Vf\x09!\x92\xc6\xe8\xc5:\xc8\xd3\xacn\x09]\xb2q\x19$\xaa\x9f~\x99\xae\x1cE\x5c"
+DATA ·d+52800(SB)/64,$"D#(\xb6\xae\xfc\x9e\x9f\x9f\xe4F\x0eu\xa5RT\xe1V_\xe7w\xf7u\xff\x00\x80z\x87\x8d~\x07\xf8C\xc3\xba\x5c\x96\xbe\x01\x0ba\xa8\x88\x96\xee\xe1\x9aY.\xc5\xbc\xa5\xd9|\xbf\xb5*\xbe\x08<\xe3\xa9S\xf3"
+DATA ·d+52864(SB)/64,$"t\xaf\x94|\x17q}\xa1\xf8\xba\xefI\xd5<Skck\xa8\xc6\xc83w;[<\xa1\xb2\xfb\xd6/\xbb\xf3\x10\xdf2\xf3\x9c\x8e\xf5\xed\xba\x7f\xc4-6\xfbE\xd4\x05\xe6\xbb\x7f\x0e\xa1y\xa8G:\xa8S\x11x\xfc"
+DATA ·d+52928(SB)/64,$"\xbcH\xfel*\xb99\x01Ny\xef\x9e+\xe4=Unt\xe9&\xbd6\xd2\xdb\xb3\x16>lgv\xeezn\x19\x1c\xf5$\x1ek\xc3\xc2a\xebV\x85HV\x9e\xdd\x5cug\xd9\xe5\xb8U\x09J\x9dK\xfb\xeb\xf9\x93"
+DATA ·d+52992(SB)/64,$"\xfb\xaf\xaa\xd5\x1fFx\xc3\xe1\xf6\xb1\xe0\x9b\xf8\xe5\xd6 \x8a\xee\xcbV\xb47v\x17\xfe\x1a\xbd\xb6\x99g\x88\xe1dt\x91\xf9\xc8nJ8F\x07\xdb\x9a\xf0\x9b\xcdr\xe6\xd9\x92-\x1e\xee\xfe7Y0V\xec\xa1\x91\x80"
+DATA ·d+53056(SB)/64,$"<|\xbfw&\xba?\x05x\x87\xc3\xf7\x92.\x8b\xe3\x8f\xb4\xdei\xd7^X\x98A\xb4O\x11f\x0ad\xf3\x08?2\x02\x0a\x93\x9c\x81\xb4\xf1\x8a\xd4\x7f\x08\xe8w~+\x9fH\xd3\xeenu\x0f\xb2J\xd0$\xad\x18\xf2"
+DATA ·d+53120(SB)/64,$"\xe5X\xce#o2\x13\xd1\x87<\xf4<\x0a\xe3\x95\x1fg8\xaa\xd2\xdb\xaa\xdb\xfa\xf2:\xa9Q\x8c\x14\xe3ne\xf8\xfa[\xcb\xd3]\xd1\x9d\xa3\xde\xc3{\xa5Ah\xff\x00m\xb0\x89\xaev\xcd\xaaCz\x91\x82\xd2\x12\xf2"
+DATA ·d+53184(SB)/64,$"\x86FP\xac6\x8e\x02\xf48\xc5}o\xe0/\x11\xfc\x1a\xbb\xd3.t}C\xc6\xfb\xa5d\x11\x18\xf5\x18%\x95\xa6N\xe1e`\x91\xab\x0e\x83\x04\x93\xea+\x8c\xf0\x97\xc2\xef\x88>\x14\xd1\x9f\xe2:xA5\xaf\x0dC"
+DATA ·d+53248(SB)/64,$"(\x17\x97Q,\xab\x02\x9d\xfb|\xb9%\xe5\xe3\x90\x96\xf9N\xd6<\xe7\x18\xafX\xf1\xe7\x82\xbe\x0bx\x9b\xc2v\x1e6\xf0\xf6\x8du\xe1\xfd<\xce\xf6W+\xaa_[Y\xdd4\x80\x17m\x92?\xee\xe6x\xc7\x5c(\xdc"
+DATA ·d+53312(SB)/64,$"0X+q^n7\x15MT\xe4\xbd\xf5\xb6\x8e\xfa\xefg\xd5zm\xd8\xe6\xabRX\x85\x17A;.\xdd\xfd:\xeb\xf7\x0c\x83\xe1\x17\xc3\xef\x8eW\x9f\xd8z\x16\x9f}y\xf2\x03&\xadm,Y\x83\xca\xc0T\x09\x0a"
+DATA ·d+53376(SB)/64,$"\xca6\xb0\xe7t\x9d=k\xc6\xbcu\xfb<\xa7\xc3\x9f\x16\x1f\x0bY\xeb\xda\xa4\xa8\x22W\x96\x1b\xbd9\xe4\xfb+\xb9\x05T\xbd\xbc\x92F\xc0\xa9S\xe6\x0d\xa3\x9eTc\x15\xdaxs\xe1\xef\xec\xe9\xa5\x5c\x89|1\xf1\x86"
+DATA ·d+53440(SB)/64,$"o\x03\xdd\xf9\x84O6\xac\x15\xee\x08\xea|\xb9t\xd6\xfd\xecg\xf8U\x80\xf7\xaf\x18\xd1\xf4\xbf\x85\xf6^(\xd4\x1e\xe3\xc67\xda\xcb_\xdc$\x96\x8fj\x8d\xa7\x19$2\x11\xfe\x93s0\x08\x9b\x94g!Xs\xcfC"
+DATA ·d+53504(SB)/64,$"_G\x1e)q\x8f\xb9t\xe3m\x12\x95\xdf\xe4y\xf9~IUJi\xa6\xe0\xf6OMt\xd9\xbd?S\xe9\xef\x87\xdf\x02<'\x1c\xf6\xd1\xeb\xf0\xcf\xae\xea\xd7\x87\xcb\xd3t\xcb9\xed\xf4\xe1s\xb04\x8d\x1c\xb3M "
+DATA ·d+53568(SB)/64,$")\x94\x04 P3\x8eI\xe9^\x13\xe3/\x13^C\xe2\x09\x05\x8d\x8e\x91\xa0\xda[3[\x0b\x0bA\x15\xcc\xa0\x01\xb5\xc34\x81\x8b7\xabt\xcfJ\xf7\x0d{\xc4\xdf\x11<[\xe1\xa3\xa4x6\x0f\x0ai\x9e\x1fG["
+DATA ·d+53632(SB)/64,$"{\xbbY\xf5k\x1b\x99.%\xb6_$4\x92\x5c\x1131\x03;\x94\x059\xcf\xa1\xaf\x8f<Q\xf0\xab\xe2)\xd6LPi+rL\xa0,\x9aAK\xc8\xc9\x97\x95Q\xf6f\x93/\xd8\x01\xc9\xf4\xafC#\xe2YT"
+DATA ·d+53696(SB)/64,$"r\xab\x8a\xa3\xcc\xfa+\xda\xde\xab`\xa9\x95\xa8\xce\xca\xaf%\xd6\xae\xd7\xd7\xc9\xbb[\xe4\x8fS\xd5\xfe \xcb\xae\xf8o\xfe\x11\x91'\x9b\x1a\xc4\xb1\xed\xba\x8dS\x0a\xa7 )\x8b`<\xf6\x22\xbckY\xf0\xe6\x93\xa8["
+DATA ·d+53760(SB)/64,$"Ft\x13\x1d\x9d\xce\xc0.-\xa6VEY\x14d\xbcr\x05?+\x1e6\xb0\x0c\x09\xf4\xac\x0dsZ\xf1'\x83\xb4{\xaf\x04jz0\x8a\xef\xce\xd9;j\x1at\xa9{\x0c\xc8\xd8(\xaf Y\x22\xdaxe#9\xe3"
+DATA ·d+53824(SB)/64,$"\x02\xb9\xbb/\x10kzLjZ\xe0\xac\x8e\x8a\x1a\xdac\xb6\x0f\x9b\xa31l\x9c\xe7\x9ct\xaf\xaa\xc3\xe7t\xa5\x17\x08\xd2\xf6}\xadk?\x95\x8d(du\xa9?h\xaa\xf37\xde\xe6\x85\xa5\x96\xbd\xa0\xdd]\x5c\xdf\xe9"
+DATA ·d+53888(SB)/64,$"\xedtZ\x05d\x96\xd6@\xc6\x09\x8f\x08\xed\x83\x86^\xcc\xac?Z\xd6\xd4\xbc)u\xe2\xcbH\xb5{=B[g|B\xff\x00\xda\x1b\xe3\x87\xcb$\x97\xd9:\xeeM\xaa\xdfx\x11\xdf\x9ek\xe8\xff\x00\x07\xf8WU\xf1"
+DATA ·d+53952(SB)/64,$"o\xc3_\xf8O\xb5\x1d=t\xef\xb1\x5cG\x1d\xad\xed\xe1\x92\xde\x0dI\xb9v\x16\xf3*l\x93h\x5c`\xe3=\x01\xcd{\xe0\xfd\xbb\xe6\xba\xf0\x07\xfc*\x9f\x17\xf8{A\xd5m\xad@]?Y\xb5W\xb5\xbd\xb3+\xc0"
+DATA ·d+54016(SB)/64,$"um\xa478\x0c$\xe1\x87\x0d\x91_9\x8c\xab\xed*\xfe\xea|\xd6\xdf]\xbeN\xc7\x7f\xd6\xdcW\xb4\xe4\xb4\x96\x9e\xbfu\xd7\xde~}\xdb\xc5\xe2o\x0di\xefi%\xa5\x9c\x8d\x09\xd9\x15\xdd\xb5\xdc2A&8\xdc"
+DATA ·d+54080(SB)/64,$"\x02\x90NG\xafZ\xf6\xbf\x01\xfc3\xd3\xbcO\x0d\xc5\xc7\xc4&Sw\xe7\xc1mb\x96wp}\x91|\xd2\x0b3\xf9\x01\xa4,\x14\x82\xa0\x1c\x1e\x84\x8c\x1a\xe7\xfcO\xe2mC\xc5\xb3I\xe2\x88\xecT\xc6#{\x7f\xb6"
+DATA ·d+54144(SB)/64,$"\x1b(\xa6\x86D9\xc8\x95b\xdc\x80\x81\x9c`\x02\x07J\xe4\xef\xfc_7\x8b\xa1\xb2\xd4\xcd\x85\x84&\xc9|\x88\xaet\x84\x16\xbeb`\x1cH\xa3!\xf2FwpG8\xebEl\xbemrS\xadv\xfbo\xa7\xcc\xe0"
+DATA ·d+54208(SB)/64,$"T\xb9\xf9\xaa\xbaj6\xdd\xf6}\xb6\xeb\xf2>\xaa\xd4\xb4/\x81z\x7f\x86\xee\xdfU\xd6-\xb4w\xfbI\x83G\xb0\xd3\xa3\x17\xd7\x05\xa0;C\x5cB\xa4\x86\xdc\xc0\x90\xdb\x95\x8epM|\xc1\x0d\xb7\x82\xec5\xa6\xd2."
+DATA ·d+54272(SB)/64,$"5\x9b\xe6\xb2\x8eEx$\xb3\xb2\xf9\xb0\xddA\x8egVL\x1e\xc5\x88=\x8d>\xf3\xc4zu\xb6\x96m\xb4x\xa1\x85\xe5\x81\xa0\xbf\x92\xf6(\xdeY\x03\xf2X:\xe7v\x0f\x1ft0\x18=k\xc7\xa7\xd4\xb4\x9b\xc9f"
+DATA ·d+54336(SB)/64,$"\xd5\x1e\xf2[\x8b\xb5\x8cF\xed\xe6;\x16l\xf0\x0b6\x09\x00t\x18\xc7\xbdq*\xf5#\xeebo\xae\x9d\xbf$\xff\x003L\xbf#\x83\x84\xdd&\xe4\xb7\xef\xaf\xe0}\xa5\xa9\xe8\xff\x00\x02<S\xa0\xc1a\xe3}o\xc4"
+DATA ·d+54400(SB)/64,$"\xe9\x05\xa2b\xd6\xfc\xe8\xf1H]N\x07\x92\xd3Er\xc1\x14\x8f\xbadS\xb5\xb0y\x19\x07\xcf<1\xfb2|\x1c\xd6<[\xa9j\xfa\x16\xb5q\xaehp[3YG\x95\xb5\xb9\x86\xe4\xb0\x11E\xa8F\xc7r\xc6N"
+DATA ·d+54464(SB)/64,$"\xe5/\x16T\x90\x0eB\x93_:7\x8e/-,|\xab\x8b\xb9\xde\x1c\xeckHG\x18\xfeF\xbaM:+\x1f\x15i~}\x84\xab\x01h\xce\xe0\x15\x94\xa0^>f<|\xdd\xf8\xe7\xbdv\xe1xj\xa5X\xdf\x0fU"
+DATA ·d+54528(SB)/64,$"\xae\xd7\xb3_\xa0\xea\xd3\xaf\x87\xa5$\xaaJ\x0aZ]_\xa9\xeb2}\xab\xe0\x97\x8a\xaf\xa6\xd2,>\xcdug%\x9d\xcd\xaa\xc6\xcb5\x8a\xcd\x04\xab4bR\x8e\xcb\x220Q\xf2\xe7\xfd\xe1\xcdq\x1f\xb4\xda\xf8O\xc5"
+DATA ·d+54592(SB)/64,$"\x7f\x10'\xf1\xe7\x85\xae\xad#\x93T\xb97\xb7\xb6v\xff\x00\xbb\x89&\xb9\xc4\xb2\x22\x8d\xc4\x05\x0e[\x1br\xb8\xc0\x1d1X~\x1b\xf0\x84z\x9d\xbb\xb5\xb1\xb0\xbb\xb9\x81\x0d\xd4Zn\xc6Ax\x15N\xf1\x13\x03\x81 "
+DATA ·d+54656(SB)/64,$"Q\xb8\x02\xbf68\xe6\xb8\x8f\x19j\xce\xeb\x15\xfd\xdd\x9d\xbd\xbd\xb5\xdc! \x85\xdd\xe7\x8d\xa2\x8c\xe1\x82\xb4\x981\xb0e\xe5p1\xdb\x8a\xe5|9^\x8dII\xc9\xb7\xd4\xec\xcbp\xeaU\xe1R2\xbb\x8e\x97\xea\xfd"
+DATA ·d+54720(SB)/64,$"o{\xf7\xf24\xfc-\xe1\xfdC\xc4\xb6\xd3i):2\x91\xe6\xc5*1\xc8\xd82cx\xc7\xcd\xc0\xce\xd7\x5c\xe7\x1d+Mb\xbb\xf0\xdb\xbc\xd6\xf71J\xb6\xac\x16;\x8by\x0bbh\xff\x00\x84\x93\x82\x18\x11\x8c\xe3"
+DATA ·d+54784(SB)/64,$"\x1f\x85y\xd0\xd4,\x11mu_\x0a_\xcd\x0d\xdc.\x14\xe9\xee\x0e\xe8\xf1\xc8h&\x5cnRx \x90\xc3\xde\xbb\xa8\xb5\x7f\x1eK\xac[j\xb0\xe9Zp\xb8Ic\xba\x05\xedwG+\xc4wn\x9a\x22\xc5[wF"
+DATA ·d+54848(SB)/64,$"\xc8\xf9\x87Q]\xd8j\xf8\xc8\xc3\xdc\x87:\xd7Fu\xe3\xb0N3\xf7\xaa$\x9fG\xa6\xbf\xf0\x7f\xa4z$_\x12<Mq\xafx\x7fR\xd6t\x19V\xea\xd2[k\xbd:K\xd2\xd1\xc5v#\x97z\x1e\xaa\xac\x8d\xf7"
+DATA ·d+54912(SB)/64,$"r\xa7\x18\xe8E{\xaf\xc5\xaf\xdb\xcb\xc6\x1f\x10<e{\xa9\xf8\xd2\xd3@\xb3\xd4.\x93\xec\xf7J\x90K$1\x03\xfcK\x0a\xb1\x1b\x87\xf7\xc3\x1a\xf9\xcf\xc7\xdf\x11>&x\xf1-4\x8f\x88\x0d\xa7\xd9\xd9ngO\xb0"
+DATA ·d+54976(SB)/64,$"G\x12G\x08s\xbb\xe5\x86!\x84\x5c\x80B\x80=\xeb\xa2\xf8u\xe0\x9f\x87\x92\xeb6\xb6\xff\x00\x10\xb5\x9bMwE\x90\xb47\x09ocq\x04\xd6\xc7nU\xda@\xa2UPH\x01\x94\x10\xc7#\x80\x09\xaf\x0e\xb5\x0a\xb5"
+DATA ·d+55040(SB)/64,$"e\x19W\xa0\xb9\x92i+7\xa7\x93M}\xd62\xa5\x83\xc1\xc2\x9d\xe7\x0bk{FZo\xd2\xea\xdf&\xd1J\xc6\xf3Z\xd5\xae[\xc4\x9ag\x8b|):\xc0L\xb2}\x9bP\x06H\x907\x08a\x98\x16PN0\x9c"
+DATA ·d+55104(SB)/64,$"\xf5\xf7\xae\x9fC\xf1G\x83\xec\xf5\xf6\xbb\xbf\xf1\x97\x85\x22\xb8\xb9\xb7\x92\x09\x97\xecMz\x8c\xaey\x8c\xa8\x88\x05l\xe7\x18\x1c\x1a\xf2\x0f\x0c\xf8oG\xd2\xbcZ\xd3h\x9a>\x9f\xa8Cev\xf3\xc7\x05\xe4^`\x92,"
+DATA ·d+55168(SB)/64,$"\x95\xf9\xc1 \xe0\xaf\x04\x80\x0fq\x8a\xf6\x03u\xf0\x8e\xfa\x16\xb3\xd3\xbc\x15yq~\xc5\xe6X\xa3\xb9\x95\x96\x00O\xfa\xb8\xcc*\xc1\x94c\x87p\x1c\x03\xcex5\xee{*\xd8X\xc1\xd4\x84m.\xcbU\xe4\xee\xca\xc6"
+DATA ·d+55232(SB)/64,$"`0u\xa75J2v\xff\x00\x0f\xf9\x7f\x91\xd3\xf8g\xe0\xee\x9d\xa8\xdfCu\xa0\xebZ&\xbfo\x1e\xcf?J\xbce\xb4\x94.\xe0\xa1RK\x90\xa7\x1c\x81\xbb<Uiu\xef\x86\x9e\x03\xf8\x88_^\xf0M\x8d\xad"
+DATA ·d+55296(SB)/64,$"\xc6\x9dx'\xfe\xcb\xbc\x9a\xea\xfdw\xc6r!h\xa7q\x19\x8f\x03;\x8e\xfd\xfd\x8e+\x9c\xd4<]\xf0p\xe9m\x05\xc7\x85u\xad6\xed\x22Te\xfe\xd9y\x96GU!\x9b\xcb\x9e\xdf\x80\xcd\xcf\x0cp:zV]"
+DATA ·d+55360(SB)/64,$"\xee\xb9\xa5x\x93\xc2vz+\xea:L\xf3\xd9\xa1\x91&\xd4o\x10M\x10n<\xb4v\x0a\xc1T\x0c\xed'\x83\xd2\xbb\xb0\xd8\xcc\x16\x22o\x96\xf1\xe9ge\xf9;\x1e=|\xab\x17E'U9\xa7\xdbO\xd1~\xb7="
+DATA ·d+55424(SB)/64,$"\xfb\xc6_\xb4f\x95\xe3]\x1d.F\x81$P\xdb\x86X\x22\xb5\xf2\x8e<\xc3\xd0\xacQ\x01\xb4\xf6\xcfA\xd2\xbc\x22\xdf\xc7\x1f\x0eu+\xb9\xa6\xd7\xf4\xbb\xcbI\x0cdZ\xa5\xad\xae#i:\x13#7<z\x803\xde"
+DATA ·d+55488(SB)/64,$"\xbc\xe5n\xb6D\xf1\xc7}\xa6\xb6]\x03Dg\x0eY\x90\xf5P=G\x15\xd2\xdaK\x7fm:j\xb0\xdbD\x8dx\xafj\xa6{In d\x1f+\xcb\x1cj\xc9\xbaH\xc9\xfe\x1e\x95\xd5[\x1bW\x0fO\xd8a$\xb9"
+DATA ·d+55552(SB)/64,$"c\xd3G\xad\xef\xd5\xf5<xd8h\xca\xf5)\xbdz\xa9J:v\xfc\xfa\x1d\xef\x86~\x22|U\xb1\xd1\xe6\xd5\xfe\x1eGa-\xbe\x9do%\xcd\xe2i\xe1_Q\xb3\x85\xc9\x0c\xf7\xaa\xd1\xb3\x08\xbf\xdb\xc3(\x07\xa8"
+DATA ·d+55616(SB)/64,$"\x02\xb1o\xfcy\xe3\xadk@{\xcb=F\xfe\xca\xe2Gi\x1a\xdc4`\xa9?\xc4\xac\x8a\xa1\xfa}\xde\x08\xe3\x8cW\x1d\xa1\xdd\xfcH\xf0\x9b\xdfi\xfe\x1e\xd5\xaf~\xcfw\x1f\x97z\xae^\xd6;\x98W\x90\xac\xb28"
+DATA ·d+55680(SB)/64,$",\x87<\xa3dz\x83\xc5R\xd45\xbf\x1a\xdd\xc2WZ\xb6W\x85q)\x9a>\x0a\xaa\x1e:\x0d\xa3\x1d\xd8\xf1Z\xf0\xd3\xe5\xab7\x8e\xac\xa5~\x9c\xa9[\xb6\xa9\xef\xf2;\xf1\xb9f\x15U\x8b\xc2P\x8a\xd7{\xdd\xbe"
+DATA ·d+55744(SB)/64,$"\xfa5\xa9\xc4M\x0e\xbb\xa9^\x15\xf1.\xa3\xae_\xc8\xccw\xc1#l\x0d\x93\xc8 \x00EL|\x0b\xa0\xe4\xe7F\xbe\xcey\xcc\x8f\x9f\xe7^\x85\xfd\xb7\x7f\x7f`M\xecr\x98\xdbj$\xa4|\xeb\x83\xc7\xef0N;"
+DATA ·d+55808(SB)/64,$"\x1c\x1c\xe2\xb2\xff\x00\xb4\xa4_\x95-\xaf\xf6\x8e\x07\xfaj\xf4\xff\x00\xbek\xec\xa9`\xb2\xf8\xdf\x9e\x9a\x95\xfa\xb5\x7f\xce\xe7T\xb3\x1cM\xed\x1fw\xd3E\xf8X\xff\xd1\xfext\xbf\x18\xff\x00\xc23\xa1\x85\xd1\xe0\xb8\x80"
+DATA ·d+55872(SB)/64,$"\xb3\xfc\xfa\x96\x95\x12\xdb\xddK\xbd6\x90\xd7\x0c|\xc6V\x07\xa2\x05\x5c\xd5\xdf\x85\x1e$\xb1\x1e){x'\x9b\xec\x9a\x8d\xad\xcd\xae\xab\x0e\xa0\x0b/\xd9\xa4_\x9f\xe6\x18m\xc3\x01\x81\xe7\x04f\xb8\x9dG\xc3qx\x18"
+DATA ·d+55936(SB)/64,$"\x5c\xe8\xda\x94\xb66WQ\xde\x88\xc0K\x91w\xa8\x15`\x1cnX\x9c\xc6\x91\xa8\xee\x09%\xb8\xeb^\xb1\xe1o\x80\xde=\xd6Zk\xab\xfb\x93\xa4\xc7\xb4G\x05\xc2\xc5,\x82\xeb\xcc\x1f1F\xdd\x10T\xda~b\xc4g"
+DATA ·d+56000(SB)/64,$"\x91\x83\xd6\xbe\xf9\xf1s\x84\x5c\x1cc\xcb\xe5\xdb\xf5?\xcf\xfcN[C\x95\xce\x17\x5c\xdd]\xb5v\xed\xe4[\xf1\x8e\x93k\xaa\xf8\x86\xca\xdb\xc1\xef\xabk\xfe\x19\xb1\x8cF\xb2^\xdc}\x90\xcd\x1a\x0c\xca\x15\x14\xf9\x88\x8b"
+DATA ·d+56064(SB)/64,$"\xfc%\xc6x\xcf\x00\x81^Q\xaa\xdaZk\xbe9\x8e\xc3\xe1v\x8f=\xa4\x173(\xd3\xe3\x9a\xe0\x5cJ\xc10I3\x10\x14\xf2\xa7\xd7\x19\xeakw\xc6p\xcd\xf02\xf2\xdbG\xbb\x9a\xcamKP\xb5iV\xee\xd6\xe9"
+DATA ·d+56128(SB)/64,$"n`\x85\x0b\x98\xf1\x88\xc0\x02O\x97%X\x9c\x0a\xb5\x16\x9b\xf0\xd3^\x98Io7\x884\xbb\xa1\x87\xb5\xbc3Gsl%nI(\x88\x8e\x8aOu<z\x1a\xce\xb6u^qo\xa3ZY_\xef8pX\x09R"
+DATA ·d+56192(SB)/64,$"\x84g(\xbe]R}\xfb\xbb_\xbf\x7f\xb8\xea\xf4\x98\xbcMsx\xbe\x22\xd0f\x92\xd9f\x9ae\x8e\xe8\x1bEv\x8a\xdc\xf9r\xa9\x8ef\xd8\x9b\x1b8\xdf\x82\xd8\xc8\xe3\x9a\x9b\x5c\xd4\xfe\x22=\xbc\xf2\xd9x\x96\xca\xf2"
+DATA ·d+56256(SB)/64,$"\xde\x17\xf2o-\xee\x22\xb5Y\xe1\x01\xb6\xe4(R\x1cw\x0c\x8d\xcfl\xd5/\x0e\xf8\x03\xc1Z\x8e\x95\xf6\xedr\xff\x00N\x82\xfbN\x95-\xe7\xb6x\x1ai/\xd5\xb7m\x92)pc\x1b@\x19\xc8%\x81\xecx\xac\xdb"
+DATA ·d+56320(SB)/64,$"\xef\x05\xff\x00\xc2q\x14\xfe0\xb3\x8a{\x88\xedY`\xb8\x91!\x10[\xc6c\xfb\x8a\xfb@T\xf9y\xf9\xb9\x22\xb9\xb2\xac\xfb\x17\x1a\x8e\x15*]w\xda\xdeG-l%\x0d\x5cc\xa7g\x14\xff\x00\xe0\xff\x00\x91\xf46"
+DATA ·d+56384(SB)/64,$"\x99\xf1\x1e\xef\xc1\xb2\xd8\xe8V\x97\xb1\xcf\xcc\x13i\xd2I\xa5\xc34f\x5c\x0d\xeeVG\xdb\x80\xc3h\x1dI\xf4\x15\xeb~=\xf8\xc5\xf0g\xe2\x0d\xbd\xb5\xa7\xc4=\x07E\xb3\xf1Tvoo{\xach\x96V\x96\xb0\x5c"
+DATA ·d+56448(SB)/64,$"\xee}\xc2{\x8b(\xb7\x0f\xb4\x01\xf2\x190\xa5GL\x93\x9a\xf9\x97\xc1\xfe,\xd0\xb4\x0d8h\xbe.\xd0\xad\xb5\xb8%\x0e\x96-\xa9\x5c\xcf\x15\xb5\x9b\xcb\xb4\x19\xed\xc4\x0c\xa3\xcd\xe3\x00\xb6@\xe4\x81\x9a\xf7=wE\xf8"
+DATA ·d+56512(SB)/64,$"g>\xeb_\x16\xc5\x0c\x1a}\xecFm\x22a<\xb2\x09#\xc7\x1ePgF\x0b\xbc\x15\x0e\xc7<w\xafRx\x9cDf\x9dX\xdaK\xad\xef\x7f\xc7_K\x1f2\xe8S\x85\xe5\xcc\xdc:\xd9j\x9f\x97\xf5\xa9\xe4\xd2j"
+DATA ·d+56576(SB)/64,$">\x06\xf8k\xe2\x1f\xed\x8f\x00\xeb\xfa7\x88\xec\xa5\xb3G\xbc\xd25\x8bW0\xb3d\xab\xdb\xb8b\x8d\xbd\x06\x19\x1d\x08\xfc\xc7>\x95\xa6xG\xe1\xef\x8a5\xdf\x0eC\xa6Y]\xe8\xd6\xda\xd2\x88\xda\xee\xe2\xf2[\xabE"
+DATA ·d+56640(SB)/64,$".\xc1d\x9d\xa4\x99U\xbc\x91\x8f\x99Ie\xed\x9c\xd7\x91\xf8\xc3\xf6e\xd0n\xefa\x93@\x92Xo&t\x8e\x0b\x0b\xd4\xd9\xbc\xb0\x0c\x08s\xb0\x91\x83\xc9c\x8e\xfb\xb1^\xad\xe1\xbbK\xa9\xa1\xd0\xbe\x13|Bw\xb6"
+DATA ·d+56704(SB)/64,$"\xbc\xd1\xefZ\xc7J\x8a\xfa\x16+\xf6w\x07}\xb4w\xb0\x16\xf2\x06\xe0B;\x89\x15I\xca\x828\xac\xb3\x0a\xd2\x949\xa9'v\xb5k\xf0v\xd8\xe8\x9dJ3\x94-'+=SV\xd3\xb5\xf7~[\xe9s\xd24\x9d"
+DATA ·d+56768(SB)/64,$"C\xc0>\x1e\x9a\xfeO\x85\x9e7\xd0,\xaf-U\xac\xee4\xdf\x14,p\xdb\xea\x1bX\xa8k\x08\x84D;\x1cn\xcc\xa4\x15\x07pc^\x1d\xf1G\xc4\x9a\xfd\xed\xf5\xed\xc7\x8f\xb4\xfd;S\xd5\xd5U\xe6\x9e\xc8@\xe4"
+DATA ·d+56832(SB)/64,$"\xab\xa8\xd9'\x99\x11\xda\xc0\xae\x02\xb0\xe88<\xe6\xbcs\xe2\xa7\xc1\xedw\xe1\x97\xc4;\xef\x0fjI\xb5\xad\xee\x10\xb7\xd9\xae\x12\xe8y2\x8d\xe8\x03@\xce\xae\xbbz\x90s\xea\x14\xe4\x0c\xb8O\x8a\xe1\xb7\x9bU\xf0\xdd"
+DATA ·d+56896(SB)/64,$"\x82\x9d9]\xad\xe7\xb8\xb9\x225S\x80Yc\xc9\xcfNpz\xf6\xaf_$\xcd\xa5\x86\xe4n<\xd7\xeb\xfe}\xfe\xe3\x0cnO\x0a\xea..\xcb{?w^\xa9uGs\xa0\xcb\xe1{\xe6\x8bR\xb7\x91,&)\xe5"
+DATA ·d+56960(SB)/64,$"\xca\xd1\x86\x8d\xd5I\xee\x8e\x021\x07\xb8\xae\xbb\xc0\x9e\x19\xf0w\x89?\xb5\xdf\xc5\x96\xd7l\xd6\xe9$\xa95\xbc\xb0%\xbc\xc8\x80\xb1.\x0b\xe4\x1227(\xc8=\x8dx&\x89\xa5\xf8\xab\xc4o&\x9f\xa2\xdaKq4"
+DATA ·d+57024(SB)/64,$"D\xbb\xc5\x85\x19Gm\xa1\x94\x96\x00\xfb\x7f\x8dv\xd6^\x10\xd6,G\x976\x91}; \xd9:M\x80W\xfb\xca\x15H8\xc7\xe3^\xde!\xd3\xc5]J\xaa\x83}tDK\x07R\x9bi'%\xd1oo\xf8\x06\xff"
+DATA ·d+57088(SB)/64,$"\x00\xc5O\x02h^\x12Y5\xaf\x86\x9a\xdd\x8e\xad\xa5}\x91\xa4\x8dr\xb0\xdc\xc4\xdbG\xcb$,\x15\xb7.pq\x9ey\xc5x5\xa1\x9a{x\x16\x22\xb92\xc9,\x92\xb2\xab\xfe\xe9\x07\xceNF\xeeOn\xe7\x9a\xf7"
+DATA ·d+57152(SB)/64,$"\x8d;\xc3>!\xd7\xa4[o\x0e\xe8\x1a\x94\xbe}\xc0\xb7\xb4\x8e\xce\xe8J\x1a\xe3\x03\x11\xf9\x17\x08\xcb\xec~a\x81\xde\xbaO\x1a\xfc\x12\x93\xe1\xfd\xf5\xdc\x9e)\xd05\xa3sn\x83\xed\xb6\x86\xe2\xd2\xcb\xec7m\xcb\xc4"
+DATA ·d+57216(SB)/64,$"\xe2\x09[\x04\x0e@\x00\x8cu\xaf\x9c\xc4Rt\xe6\xb0\xb2\xa9\xcd/M\xfc\xfb~6=\xac\x16\x16j\x92\x9fF\xfb\xae\xdbo}<\xff\x00\x13\xb0\xf0\x17\x88\xef/<#o\xa6A{{y`\xfb\xde\xcbA7sZ"
+DATA ·d+57280(SB)/64,$"Yd\xb7\x95\xe6\xdc\xa2\xb0D\xf9\x88`\xc4s\xeb\x5c\x7f\xedQ?\xc6\x7f\x15x_B\x8f\xc4ZW\x87!\xb2\xb1\x8aX\xf4\xd5\xf0\xd5\xfa^D\x0a\xf0\xc2dY\x0e\xd7\x18\xdd\x97@[\xb3\x1e\x95\xe3\x9f\xf0\x90[^"
+DATA ·d+57344(SB)/64,$"[\xb6\x99\xa4hh\xd6\x89\xc3-\xdd\xf4\xd3\xab\x03\xc9\x0c\xb9U\x1c\xf6\xa7\xe8\xde!\xb5\xfe\xda\x86\xc7\xc3\x9a6\x84\xb7H\x14}\x82\xde\xd4I\x1c\xc5\x0e\xe0\x1dwa\xb9\xeb\x90x\xaf#\x09\xc2\x1e\xcf\x14\xb1URM"
+DATA ·d+57408(SB)/64,$"kv\xb5\xff\x00\xd2\xb7\xf5L\xf7)\xe2\x1e\x1e\x9bT\xa7w\xdbF\x97\x96\x88\xe2\xfe\x1e\xf8r\xf29\x15\x0cf\xff\x00\x5c\x9b&\x0b^\x08\x88c<\x81\x9c\xb69\xda\x01&\xbd\xe3F\xf8\x11\xe2\x0f\x18^\xc3y\xe3+"
+DATA ·d+57472(SB)/64,$";\x8d\x1e\xdaeg\xb9\xbe\xbf\xc5\xa4q\xc1\x19\xf9\x9c+\x81\x96\xc0m\x8b\x8eO\x15\xe9>\x1b\xf1/\xc7\xaf\x15]\x9d\x07\xc2\xf6\xf6:L\x91H\xe0[\xe90Z\xd9\xbcE\xb9r\xd2\x91\xe6$j;\x86\x1d:\x9a\xf3"
+DATA ·d+57536(SB)/64,$"\x0f\x10x\x96\xe94\x19.\xfcE\xe2\xdb\xcb\xd9\x1e\xeeKy\xe1\xf0\xfck;1\x85\xb7|\xf7S\xa0VbFUA$\xaf\xcc\x0e8\xaf\xa5y\x95*O\xdf\x92o\xb2i\xb3\xc8\xc4F\xa5Z\x92n\xa7+o\xa5\xff"
+DATA ·d+57600(SB)/64,$"\x00\x0fN\xc7\xb8\xc1\xf0\x9b\xf6]\xd2u\xf6\xd1e\xd5\xb5Il\x8cx\xb6\xd4..\x16,\xb3\xc6\x18\x02\x91\xa6T\x02\x0eK\xe0r9\xe0\xd7\x95j>-\xf0\xf7\xc2;\x98\xb5/\x86\xd7\xb7\xc3\xc9\xbf2Cvd\xb7"
+DATA ·d+57664(SB)/64,$"\x9eX\xda\x1c\x10\xc3f\xdc\x05m\xa5C\x8d\xd9\xcfP\x0dp\x1e\x14\xf8\x99\xa7\xf8\x93R\x1az\xd9k\xb7\xa8\xcev\xdc\xdd\xc3\x14\xf7C\x7f\x0c\xd9\x81A\xce:\x1eH\xe9\x9cW[\xf13\xc3\xba\x87\x81%\xb6:!\x86"
+DATA ·d+57728(SB)/64,$"\xf1/\xe0\x09im}h\xb1\xc8\xa0\xe3\x93l\xecwH\x0fG`\x0ey\x02\xb8\xeb\xe6\xd4\xe6\xd4d\xd5\x9fK~\x7f\xf0\xc7-<;\xa3W\xd9\xc9\xben\xf7\xdf\xee\xbd\xbf\xaf\x22\xa7\xc4_\x8d\xff\x00\x10~+\xcf?"
+DATA ·d+57792(SB)/64,$"\x8c<\x7f\x0d\xd6\xab6\xa3*Mq\xa9\xdd\xc7\x14E\xe6Q\x81/\xee0w\x9f\xe2n7c\x91^S\xa9\xdf\xff\x00f\xdc\xb5\x9b\xc9\x0c\x92\x19\x09E\xd4b\x12 \xde2N\x03\x12z\xf1\xcf\x06\x9b\x1e\xb9\xe2\xf8\xe0"
+DATA ·d+57856(SB)/64,$"\x8e\xc6\xdd\x1a4\x99B\xca\x12\xdb\xec\xc1\xd8}\xe0\xdf/\xcd\x8cr{\xd66\xb3\xe1\xf7\xb5X\xee\x99e\xb6\x98\x15\x92U\xb8R\x04\xcf\xc8\xc2\xb6z7\xae8\xae\xday\x8d(\xa8\xaa0\xbf\xca\xdfu\x8e\xa8R\xe6\xa8"
+DATA ·d+57920(SB)/64,$"\xd4\xdd\x95\xfb\xdf\xef>\x90\xf8M\xae\xf8kT\xb2\x93J\xf8\xb7\xe2\xa8\xec4\xdb{9\x8d\x8d\x9cZs}\x98\xdd\x81\xfb\xa4\x94\xc6Ie=\xc0+\x91\xd4\xd1\xad\xdc|)\xd3>\x15\xcfiu6\x81\xae\xca\xf3\xc6d"
+DATA ·d+57984(SB)/64,$"[->ho\xd6C\xf7TN\xce\x8b$p\xe7\x1b\x998\xe7\x04\xd7\x93\xf8?\xc5\xbe\x11\xf0\xaf\x8f\xad\xb5\x8f\x1eh6\xfa\x96\x94\x10\xc44=^\xeaX\xe3\xc1\xda\xc0\xfd\xa1\x11\x88\x03\x901\xeb\xcdm\xc37\x81\xfe"
+DATA ·d+58048(SB)/64,$"!j\xba\xf1\xd1WL\xf0\xd4?$\xdaN\x9d,\xb3\xce\xd0\xb36\x1b\xcbyLM,;{1$\x1eG\x15\xe0\xd5\xcc\xe1*\xee\x96\x22\x9c\xd4[\xbfK_\xb7G\xa9\xd9W\x03)C\xdb\xda\xdc\xab[]u\xec\xaf"
+DATA ·d+58112(SB)/64,$"\xf7\xa3\xc7\xa5[\xdd*\xdd\xe2\xf0\xd5\xe4\xb6v\x97m\x0c\xf2[[\xee\xdd#&B1b\xcc22Fs\xd3\xb5g\xf8\x8aW\xb0\x8e9\xf6\xdcF\xc1Wu\xc4l\xdbX\x0e\xbb\xd9p\x03\x1e\xdcU\x9dV\xe2\xca\xca"
+DATA ·d+58176(SB)/64,$"I4\xbb\x86\xb1\xbdh2\x1a\xe3O-\x1a\x01\x8eK\xef\xe0\x92{\x0eN3\xef\x5c\x9b_\xdc\xdd\xe9PX\xddbx\xa5\x19\xff\x00IV;B\x9f\x94\xab/<\x01\xf7\x8f\xe7^\xf4\xa5MG\x97\x0f\x05\xa9\xd5F\x15"
+DATA ·d+58240(SB)/64,$"\x9c\xa3Rr\x7f\xe6\xad\xf8\xfc\xcb0x\x9fH\xd5\x81\x82\xfe=O\x0c\xc5\xb7F\xb1\xcf\xd3\xa0\x1e`\xcf\x1e\xb9\xcd_\xd3\xf4\xaf\x0d\xfd\xa5.g\xb5\xf9\xa4\xf9\xbf|\xa6\x1e=~BA\xcf\xd3\xf2\xac]7\xc1\x09 "
+DATA ·d+58304(SB)/64,$"[\xe9o\x04jci\x17~I\xeb\xc0\x04\x9c\x02\xde\xa4q\xde\xbd\xb7\xc0\x96\xff\x00\x0c\xc5\xb1mf\xd6w\x96\xdel\xc9y\x0e\xac\xb0\xa0\x03\xa8D(K\x1erH\xe3\x1d+L5)\xc9~\xf6I/_\xf8r\xb1"
+DATA ·d+58368(SB)/64,$"\xf8\xdc=8\x7f\xb3\xb6\xed\xbd\xbf\xa4ci\xf6>\x1b\xb4\xbbx[Ow\xd8\xbb\xb7\xc7.\xc5d#<oL\x823\xd0\xe3=\xab\x8b\xf1^\x92\xf6\xda\xdd\xbd\x8e\xe9\xa3\xd3fP\xf6\xe1\x0e\xcd\xaay!\xcfv\x07\x83"
+DATA ·d+58432(SB)/64,$"\x9ek\xea}\x13K\xf8a4m>\x91>\x9dv\xb2\xb1v\x85\xe5k\x978?x\x16\x03 z\x8c\xf3^\xb1a\xe2\x1f\x87\xd7\x1a*\xf8S_\xb1\xb6\xbb\xd1oo!\x9e\xf8\xdc\xa0\x89\xa1d \x19\xe0\x96#\xbd\x0e"
+DATA ·d+58496(SB)/64,$"\xd2T\x80\x7f\x0a\xfa\xa8*t\xb0\xe9\xc6W\x92\xed\xfd#\xe0h\xf1T\x96&\xde\xcd\xdb\xad\xefu\xe9{\xebs\xe0\xd8\xae#\xd3p\xda#,\x0fo\x22\xa1\x91\xc1\x9aL\xb66\xb2\xb6\xe5\xdb\x8e\xbb\x87Oj\xd0\xd5\xfc"
+DATA ·d+58560(SB)/64,$"c\x17\x84V9\xf5h\xee\xae\x90\xcc[\x11[\xabC+\x82w\xb1\x90\x86\x04\x93\x82H\xe4\xf55\xd7|O\xf8]{\xe0\xbdh\xeb^\x0a)\xa9xn\xee\xe2Q\xa5\xdfA:\xcf$j\x1f\x0b\x14\xe0\x05elu\xca"
+DATA ·d+58624(SB)/64,$"\x85#\x90z\xd7/\xa3\xe9z\xd5\xd6\x85y\xa3\xdc\xc0\x1fK*/n_\xcf@\xd6\x8e\x17at\x04\xfc\xd9\x07\x1bz\xb7N\xa2\xbew1\xc6\xd5\xbf75\xff\x00\x0f\xe9\x9fc\x84\xa9FQ\x85Gf\x9e\xba\xe8\xfen"
+DATA ·d+58688(SB)/64,$"\xd7\xbf\x97\xfc9\xceC\xf1{@\xb0\xd5\x22\xd6\xb4}&\xf2\x09\xa3\xcc\xa6\xe3e\xb4\x91y\x838\x226@\xa3\xd3\x9c\xd5\xb8~*x\xe7Q\x05\xfe\xcd\x7f2\xcf\x90\x07\x9c\xb1\x16\xdd\xc9\xe25\x1e\xbd\x07j\xd7\xf0?"
+DATA ·d+58752(SB)/64,$"\x84>\x17i\x82{MZ\xfc\xdeI\x80\x91N\x1f\xfd\x0c!<\xb1\x84\x01#\xb0\x1f\xc2H\x19\xafC\xbd\xd7\xfc[\xe2\x8f\x10\x09|5*&\x96\x92\xc5\xa7[=\x85\xbd\xbd\xb2\x88\xa2\x1bG\x97\x1a\x04?w-\xcbn"
+DATA ·d+58816(SB)/64,$"=\xcdyI\xc9\xa7''\xa6\xf7\xbb;3\x0cv\x15\xd4\xe4\xa5Cn\xae\xe9|\xbc\xcf=\xd3\xfcp\xe2\xcd\xed5\x94\x8a\xd6\xdd\x8f6\x90\x81,\x92H\x99\xdaX\x94V\xc6N\x0e\x1c~5\xcf]\xea\x1f\x16\xbcoe"
+DATA ·d+58880(SB)/64,$"\x06\x99\xa1\xe9Z\x8a\xe9\xd6\xad# \xb1\xb5s\x92N\x5c\x99\xd9K7n7\x1c\x0e+\xf6\xd3\xc2~\x0a\xf8\xcd\xf0\xf7\xe1\x8b\xb6\x81\xe0O\x86\xb6\xb6\x88\x82\xd6o\x11\xdf(\x17\xfa\x9d\xbbG\xb8\xbby\x92\xf9E\xdbv"
+DATA ·d+58944(SB)/64,$"\xe7a!#\xda\xbe\x0c\xf8\xa85\x8f\x0c]\xc4\xd6\xbe1\xd2--u8\xe3\xbc\xbd\xb3\xf0\x83.\xa3-\x9c\xf1\x82\x9bfT\x0b\xe5\x8c\xf3\xb5X\xb3d1$W\x91\x94gP\xc4J\x7fVjMmk=>[\x7f"
+DATA ·d+59008(SB)/64,$"[\x1c\x90\xc6:U\x14e\x87\xb2kv\x9d\xaf\xd1Z\xdfs>9\xf0u\x8e\xb0u;\x8f\x0c\xdfE\x15\xae\xa9:\x85_\xed\x89>\xc4T\x92>Ei\x08\xf9\x9f\xa7>\xb5\xed\xb6\xbf\x13\x1f\xe1\xa5\xc9\xf0\xc5\xae\xb5\xa7"
+DATA ·d+59072(SB)/64,$"\xe9\x96\xeb>\xcb\x8b\xdd:\xc1\xe5\xb9o\xe0\x90lR\xccQpx.\x09<\xf7\xe3\x96\xb6\xf8\xab\xe0\x9d\x03\x5cmc[\xd1\xae<\x7fq\x86C\xff\x00\x091d\xb7l\x8d\xa1\x8a!i2\xa3\xa0c^{\xaf\xf8\xb2"
+DATA ·d+59136(SB)/64,$"-J\xf6\x1b\xcb-\x16\xdbH\x22A\x22\xec\x0e\xd1\xc6\xac\xb8)\x1aM\xc0Rp}\xba\x0e+\xd4\xc5\xe01\x95\xb9\xbe$\xbc\xf4\xd7\xd6\xff\x00\x9a=?\xab\xc6\xa3N\xad4\xaf\xbe\xab\xfc\x9b>\x8a\xb5\xf8\x87\xfb9\xad"
+DATA ·d+59200(SB)/64,$"\xac\xed5\xe6\xa7\xe2\x0dE\xf15\xae\xed\x10\xdb\x97+\xc0\x89\xdei\x0a\xaa9<\x9e\xa3\xb1\x06\xb1\xa7\xf0\xe5\xc7\x89\xec\xb5]No\x0b\xd8\xe86\xf7\x8b\x09\x82\xf6\xe5\xbe\xc7\x15\xb1\x5c\x00\xb6\xf1\xc8\x1c\xca]{($"
+DATA ·d+59264(SB)/64,$"\xf5\xa6\xe8_\x1co\xf4\x8f\x1fxs\xe2\xd4\x1e\x19\xf0\xdd\xcd\xef\x87\x99\x22\x86F\xb7\x10X\xdff=\xae\xb7\xb0\x12\xc9)l\x9f\x9f\xe5!\xb0Fp\x05u\xbf\x1c\xbfi\xcf\x89\x7f\x10\xae\xe7\xbe\xf1\x0e\x8f\xe1{t\xb9"
+DATA ·d+59328(SB)/64,$"\x8cM\x0b\xda\xa7\xdaZ0F\xc5>de\x8cm\xc6\x0e\xdd\xa4\xf4\xe2\xbeVT\xf1\xd4\xabB\x0a\x93w\xd5\xb78\xabm\xd1nr\xc7&[P\x93W\xee\xe5+\xbd\xf4\xbd\x95\xb6\xe9\xf2\xd3_\x98\xa3\xf0\xad\xe6\x9f>"
+DATA ·d+59392(SB)/64,$"\xcbY\xec_i\xca\xf9\x84F\x5c\x83\x8c\x00@ \xfb`WJn5\xd61\xe9\xd7V\x12*\xaepaVN\xb8\xce\xd6\xf9\x97\x9cs\x8cg\xbdw~\x0a\xbe\xd0\xfe j\xf6\xe7\xc6:\xee\x8f\xa2\xde9\x8a/\xb7\xde"
+DATA ·d+59456(SB)/64,$"\xc57\x91\x18<d\xb0@\xb0\xaa\xfb)'\xd7\xd7\xe9\x98\xe2\xfd\x9d4\x96\x9bC\xd7\xbcO}\xae^&P^\xd9\x86\xb5\xd3\x19\x17\xfexO*\xb4\x84\x9f\xef8\x0b\xe8=~\xbb\x09\x85\xa3>iT\xa9ko\xdf\xee"
+DATA ·d+59520(SB)/64,$"\xea|\xf6q_\x13J\xa5:ui^O\xae\xb6\xfb\xff\x00\xce\xc7\xcb\xde\x1a\xf1\xc5\xf7\x84\x0c\xafn\xba|rB\xd0^Z\x1d^\xdaY\x8b\xbcd\xe4 \x19\x8cv\x0c\x18a\xab\x03V\xf8\xdd\x1e\xa7\xacK\xae\xebz"
+DATA ·d+59584(SB)/64,$"N\x9dr\xf2\xbbJ\xf1\xdb\x07\x82\x07\xde\xc4\xb6m\xf0\xc3i'\xee\x81\x81\xdb\x15\xef^?\xf8\x7f\xf0\x93\xc3\xb71\xdd\xe8\xde\x22\xba\xbaVX\xee-\xecc\x87\xedryr\x8f\x985\xc4r5\xb6\xe5\xf4\xc0>\xc0\xd6"
+DATA ·d+59648(SB)/64,$"\x97\x80f\xf0\xbc\xa8t\xbf\x11G\x05\xf6\x91\xe6\xabL\xfa\x95\x84R\xc9\x0c{\xbeg@\x99\x91_a\xe0\x06\xc6G\xbd[\xc2\xe0\xe4\x9b\xa1[\x99\xdf\xad\xe2\xff\x00\x13\xcf\x95,=\x19{j\xf8{\xb7\xd5?\xf2\xbf\xe6"
+DATA ·d+59712(SB)/64,$"|K\xae\xeb\xc3\xc4\xfa\xb3^\xe8Ze\xad\x8c}\x0d\xa5\x9d\xd4\x89\x19\xc0\xc7\xcb\xe6\xb7\xcb\xee8\x1e\xd5\x1a\xeb\xde\x1c\x8dDs\xe9\xba\xa6\xf5\x18}\xb7\xe3\x1b\x87\x5cpx\xcf\xbd~\xb1\xf8\xc7\xe0'\xec5\xaa\xf8\xe2"
+DATA ·d+59776(SB)/64,$"H<5\xe2\x1d\x1fO\xf0\xd4\xb6\xf1\x0b_\x11\xdfj3%\xeb<\x88\xbb\x99\xec\x5c\x15\x89\x95\x89\xca8\x00c\x07\x8a\xf97S\xf8\x03\xe0\xc85+\x884\x9d\x7f\xc1W\x16\x89<\x89kp\xda\xd5\xb02\xc4\x18\x84r"
+DATA ·d+59840(SB)/64,$"\x07\x00\xb2\xe0\xf1\x5c\xb2QO\x96U$\xad\xeb\xd7\xfa\xd5t>\x92\x8emBT\xe3jR\xb2\xd3K\xbd\xbb\xb4\xf5\xf5{\x9f\xff\xd2\xfc5\xf8a\xa4h\xb0j7\xd7\xc3N\xd0,\x7f\xb1m\x85\xdczn\xa1v\xdfm"
+DATA ·d+59904(SB)/64,$"\xb9d\xfd\xe1612\xb1\xb8\x7f\xe2(6\xe0+|\xdcb\xbe\x83\xf8\x9d\xa5Xkzsx\xc7\xc6\xda\xd5\x9d\xcd\x8bXBt{\x0b)^\xc2\xdaK\xb9\x10H\x12\xe1e\xdf1\x88  \xca\xa0\x02\xdd\x885\xea\x7f"
+DATA ·d+59968(SB)/64,$"\x02\xbca\xf0a~(x_J\xd3\xbc1\xadIy\xaa\xd9\xeaZ,\xd6\xbbRV\xbd\x92\xe6\x0c\xdb\xb2\xad\xcaH\xe8\xaa\xc1\xfc\xcf)C\x95$\xe7\xe5\xaf\xcb\xaf\x1bKi\xa7\xbd\xf5\xbe\xba&\xba\x96\x09\xa4\xb7\x8f\xca"
+DATA ·d+60032(SB)/64,$"\x94*\xa9\x85\x8a\xb7\x0c\x09\xd8\xac\x08P1\xc7\xa5}\x12\xc3\xd2\x95g'\xa3\x85\xb4\xb5\xf7\xeb\x1dz4\xf5\xbe\xc7\xf9\xe3MU\xad(8\xb6\x94\x96\xb6\xebf\xd3^\x8fM7\xf3=s\xc5^,\xd1\xfe&A/\x85"
+DATA ·d+60096(SB)/64,$"\xfe\x1fxW@\x87J\xb6m\xcb\x02 3O\x90\xb9q<\x98\x93 \xa8\x00/\x03\x93\xde\xbcg\x5c\xd3_M\x81-\xaf\xb4\x7f\xec\xed\xac@\xfb\x22}\x9d\x99T\x7f\x13#\xf2\x7f\xfdu\xc2\xc5p\x1a\xd6\xdfT\xd0\xfe"
+DATA ·d+60160(SB)/64,$"\xc2\xac\xa4G%\xac\xec\x15\x99\x08\xc1b\xdc6\xe2x\xe0\xd7i\xe2C\xa1K\xe1\xcd>h\xb5@\xb7\xc6$\x92\xe2\xc9\x9d\xa5\x8a\x19\x88!\xa3W'\x9d\xbca\xb23\x9e\x83\x15\xf5\x8b7\xc1\xd9R\xa9M\xdf\xbe\xe7\xa1"
+DATA ·d+60224(SB)/64,$"\x1c\xa3\x11BIS\x95\xa2\xde\xda\xdd7\xf3\xfd\x0fF\xf0\xfd\xa5\xf2h\xf6\xbe-\xb8\x82\xd2\xe4]\x16HwH\x1e\xees\x07\x06E\x827\x0e\x19q\x82H\x01\x80\xcf\xbdvV\x9a|z\xa5\xcbK\xe2\x9b\xa7\xb3{\x81"
+DATA ·d+60288(SB)/64,$"\xe6\x90\xdbm\xe2$.p\xc1\x0b1c\xc0\x1b\xba\x9f\xd3\xc2\xfc5&\xb3ch\xb6Z=\x94J\xc8\xc2C\xa8\xa4LnN\x1b;D\x8a\xa4\xed9\xc1R@\xc7\xafJ\xbd\xa0iZ\xc4:\x8c\x89yu\xa5\xac\xaf?"
+DATA ·d+60352(SB)/64,$"\xda\xd5\xb5\x07(\xe9&A\x07\xa2\x96\x03\xfb\xb8\xaf\x17\x19\x8d\x84d\xf9 \xad\xa5\xaf\xfeG\x9d[-\xe6\xe6\x9cd\xd3\xd6\xfd\xdf\xdd\xaa=B\xd7R\xf0\xdc\x97\xcfe\xe4[\xa4p\x05\x89\xae5\x19\xde5\xdes\xcc`"
+DATA ·d+60416(SB)/64,$"n;P\xf59\x1c\xf4\xafH\xf8Wu\xe2\x0dO\xc5\x9aO\x83\xec\xb5G\xd4\xed\xc5\xf0\x93\x16\x11=\xdd\xbc\x8a\xc7|\x8a\x91\x15$\xe4\x0c7\x03#'\x8a\xf0\x7f\x16&\x97k\xbe\xfbX\x98\xde\xc5+n\x95,\xc0\x8a"
+DATA ·d+60480(SB)/64,$"\x09$\xce\xec\x92Y\x9b\x00\xf7\x04}+_\xc2\x9f\x15<ygua'\x80\xee%\xd1\xc5\x83\xb3\xd9KjDB' \xe5\x8er[ \x90wpG\x04b\xb6\xab\x1a\xd8\xeaR\x8d4\xb5V\xe8\xac\xda\xe8pa\xf0"
+DATA ·d+60544(SB)/64,$"Q\xa2\xe3RKD\xd5\xfe]\xd3\xfc\xb4>\xa4\xf8\xb1\xe3o\x8b\xde\x02\xf8\x97\xaak\xff\x00\x0f\x1aKo\x0a\xdd\x09 \xb06\xeb\x1c\x96\xe2\x14P\xb7\x11G\x14\x9b\xda\x11\xf3\x15q\x85<\xe3<W\x03\xaa\xf83V\xd6"
+DATA ·d+60608(SB)/64,$"|;i\xf1\x1d\xb5\xad:w\xbe\x13\xcfm\xe1{v\x13jh\x22`\xa2A\xb1\x9dDlO\xcb\xe6\x14#\xd3\x1c\xd6f\x9f\xe3o\x10\xe9\xba#\xe9\xd7\xfe\x22\xb1\xbf\xfb[J\xb2Z5\xaf\xdad\x0br\xb9\x93l\xaa"
+DATA ·d+60672(SB)/64,$"F\xdf\x9b\x9c\x01\x8d\xd8\xaf\xab\x7fg\xaf\x8e\x9e\x036\x97\x7f\x02>4\xe9^\x11\xd4<=\x7f\xa4\xdd\xda\xe9\x1a\xee\xaf\xa5\xdb\xc1u\xa5k\x02\xd9\x8d\x95\xcd\xc4\xea\x04\xa5$u\xdb&X\x0f\x98d\x0eMva\xf2\x5c"
+DATA ·d+60736(SB)/64,$"n\x1e\x0aQ\x9f-\xad\x17mn\xbb\xbf\xcf\xef<\xda\x98\x8c<U\xa3\x1eg\xba\xe9\x7f\xbdo\xd2\xe7\xc1\xdf\x15\xac&\xf0\xaf\xd9\x8cW\xd6\xb2]F\x88\xb7mm\xb4\x85v\xcf\x01\x83\x1d\xa7i\xf9\xb8\xc19\xaeR\x7f"
+DATA ·d+60800(SB)/64,$"\x897\x97Z2i\xd2Co\x1c`\x225\xdd\xbe\xe8\xa5\x94(\xc0\xf3\x82\x93\x1c\xae\xbf\xc2\xec\xbb\x87L\xd7C\xa0x\x9fC\xf0\x8cR'\x8c~\x1d\xe9\xb7\xd7,<\xc7Y\xae\xefb]\xce\x09S\x94%Y\x06r\xbb"
+DATA ·d+60864(SB)/64,$"\x1bk\xfb\x8e+\xc3\xbco\xe2_\x0a\xdbk\xcd\xa8x\x7fN\xb8\xd1\xe1\x94)KH\xe47*\x8d\x8f\x9b\xe7\x90/\xcb\x9eB\xe3#\xd4\xd7\x5c\xe1\x15\x04\xaaT\xe6i\xbe\x96>\x93\x07\x87\xf6\xb5\x955I\xabl\xd3\x8b"
+DATA ·d+60928(SB)/64,$"\xbe\x9eM\x9d\xf6\x9f\xae\xe9ze\xcb\x1b\xc8u\x19&\xb9R\x96\xcday\xe4\xed\x03\x9d\xae\xb1\xa9\xea\xdc\x90\x06\x1b\xaf\x15\xd7xOM\xb5\xd65e\xd3\xff\x00\xb45\xbd7P\x90\xe6(o\xa3\x95\x91\xb3\xd3\x0e\x8c\x1f"
+DATA ·d+60992(SB)/64,$"=?\x84\x8a\xf3\xb3\xa0\xf8\x93\xc2z\x9cWW\xf0_i\x97\xb1\xc6d\x82\xf1L[c\x89\xb0\xd1\xef\x8c3\xe1\xc8?t\x93\x8e>\x95\xb9{\xe3\xff\x00\x88:\xca&\xab\xa9\x5c_^%\xa48[\xb0R \xa5\x8fP"
+DATA ·d+61056(SB)/64,$"\xe0+/N\x00=k\xcd\xab<C\x9f6\x1e\xd6\xee\xda\x7f\x83M4v\xd4\xa5\x17\xee\xcaZ\xff\x00]o\xa1\xf7\x8f\xec\xf7\xaa\xfcr\xd0<U\xf6o\x04\xe9\xb3jW\x0bo>\x93\x18\xd4t\xf3sf\x0c\xa4\xf9\xb2"
+DATA ·d+61120(SB)/64,$"-\xc6\xcf.\x19\x01]\xcb+?\xcaG \xe3\x15\x7f\xe2\x5cZ\xf6\xb0\xb6\xba\xbe\xb7cg\xe2\xd8\xf5\x18\x13\xce\xbe\xd3\xa4\x8bP\xb9\x82\xe5~Q\x14\xd2\xab\x16\xde:\xb1H\xb6\x15\xe0\x1a\xf1\x1f\x83_\xb4\xbf\xc5\xff\x00"
+DATA ·d+61184(SB)/64,$"\x00i-\xa5\xf8K^\xbb\x8a\xc2`e{K\xbf*H\xdb\xcd\xcf\x981 \xde3\x92r\x0f=\xeb\xa9\xd7\xbe2\xf8\x0e-\x1e\xf7\xc4\x1a\x1d\x8ai\xba\xec\xf8T:L\xd1\x22C8\xe7\xcc!\x9c\x16\x8c7\xce\xab\xb4"
+DATA ·d+61248(SB)/64,$"\x95$\x8c\x91_\x1b\x98f\x18\xf9b\xe5\xfb\x98\xc7\xa747~\xbbYz\x5c\xed\xa3\x92\xc5RQu\x1c\xf5\xbd\x9a\xd1~:\xfa\xeey\x9e\xbf\xa0i\x96w\xad-\xc2M\x22#E#\xc0,\xa7\x86)\x11\xf2\x09yI"
+DATA ·d+61312(SB)/64,$"\xf9U8\xce\xd5%\xb9\xc1\xaeM|I\xe3/\x0e\xde\xa6\x81\xa2\xde\xdaZ\xda\x5c0y-\xf4\xd9m\xe2k\x88\x9d\xb2\xf1\xfd\xa5\x94H\x08\xc0\xc1\xdc\xad\xeb^\x07\xf1\x07\xc6~-\x9e\xf4A\xac\x5c\xea:\x84N\x1aW"
+DATA ·d+61376(SB)/64,$"i'\x91\x1e6bI\xdd\x1a\xe3\x00\xe7#\x8cW\x9f\xae\xb5-\xdd\xb6$2\x8eO\x91$\xec%ePFs\x9e\xfdpz\xd7\xb1<\xae\xac\xa2\xbd\xb5~o\xbd\xdb\xf2\xfb\xce\xcc\x16QQ\xd3uzyu\xf5\xd5\xbf"
+DATA ·d+61440(SB)/64,$"\xc8\xfb\xf6\xcbD\xf8\xdf\xe1\x7f\x15\xda\xf8\xa3@\xb2\xd5\xae\xae\xac\xafb\xba\xb7\xbaF\x86\xec\xb5\xb1;\xbc\xb9\x1a)%b\x0e@\xde1\xc6wb\xbd\x0b\xf6\x80\xf1\x0f\x88\x9b\xc6\x90\xeb\xde\x1f\xd1\xbc7\x0d\xbe\xabe\x1c"
+DATA ·d+61504(SB)/64,$"\xe2\xce\xf7J\x8e+\xf8\xae\x22\x05e\xf3!\x93c\x17\xe4\x1d\xf8\xf9\xb3\x9c\xd7\xe7o\x87<}\xe3\x1d&1\xa7\xe8\xb7W6\xbf\xbcU\xc5\xbc\xac\xb9\x0f\xd5\xb2\x85H\xe3\xb8\xe3\xb9\xafV\xb6\xd7<[\xa6;X\x5c\x5c"
+DATA ·d+61568(SB)/64,$"=\xf4\xb3\x86t\xfbd\xe5\xd9\x1c\x8d\xa4\xa4\xa7q9\xcfF\xe3\xd7\x8e\x9cr\xcb\xb1\x14\xa4\xaa];z\xea\xbf\xaf3\xcf\xc4\xe5\xb1R\x8c';\xe8\xed\xa7\xdf}Y\xe9p\xfcM\xf1\xcf\x88\xe3\xb0\xd2n\xb5\x11\xa6Y"
+DATA ·d+61632(SB)/64,$"\xd8\xdb\xca\x05\x94\x03\xec\xb1F\xad\x82\xf2\x15\x89\x0b\x05\x18\xe7%\x87<c4\xef\x13/\x87\xbc]\xa3\x1f\x120\x81\xa4\xb6\xb9\x06I\xb4\xeb\x82\x08M\xb8\x04\xc3\x81\x8c\x91\x9d\xe0d\xe7\xb5y\xaf\x85<S\xae\xd8\xcfx"
+DATA ·d+61696(SB)/64,$"\xfe\x12\x86\xe5.\xaem\xc4wv\xd0\xda\xad\xf42\xc7\x1b\x8d\xec\x9c\x17\x8e5\x18'\x1b\x81\xe9\xd3\x9a\xe7\xefn_\xc4Z\xd3\xeaZ\x84\xb6\x9fj\x95\x89\xf2\xe3U\xb5@\xdd\xd4 P\x01\xf6\xaf{\x0b\x9aK\x0dS\xde"
+DATA ·d+61760(SB)/64,$"\xc2F1]}\xef\xf3_\xd7C\xce\x9eL\xaakN\xa3\xbfow\xfc\x99\xda\xdfi\x89w\xa2\xb5\xe6\x8f\xa8_\xc5,e|\xc4\xde|\x96\x8d\xc9\x08\xcax\xd8\xe1\xbb\x1eH\xe9M\xf0\xa5\xcf\x87t\xcb}N\xcb\xe2'"
+DATA ·d+61824(SB)/64,$"\x87WT\xb9\xb9\x89.,u\xa9\xcc\xd2\x9bq\xfck \x8d\xc3\x18\xd9H*\xc9\xca\x1e\xa0\x8a\xf2mkK\xd7\xee\xa5\xfe\xcf!\xad\xed\xf2\xa6F\xdd\x96\x5c\x0d\xdc\xa8\xe3\x1c\x8e\xe6\xba=\x0e\x07\xfb|\x1aG\x87\x97U"
+DATA ·d+61888(SB)/64,$"\xd7\xb5\x09\xb1o\x0d\xace\xb0I\xc2)P\xa0\xf1\xc9\x18>\xb5\xeeC\x15J\xb2\xbaVw\xbe\x86UiN\x9d\x17\x1e}\x1a\xdbT\xd7\x9f5\xd5\xbf!M\xcd\x9d\x9c&\xcc@\x8fg*H\xd0f\x7f63\x8eA\xf9"
+DATA ·d+61952(SB)/64,$"\xd4\x1c\x8e\x83\xa1?\x5c\xd7\xa1\xf8\x07\xe1=\xa7\x8d\x96\xda\xe9#\x83\xcd\x92d\x86\xde\xd2G1F\xe0\xcb\xb1\xb7N\xc7\xcb\x8c\xa8\x04\xf5\x07\xb5m\xf8s\xe1sh\xba\xd5\xbd\x8f\xc4\xfb\x8d+I\x16\x9a\x87\x91scr"
+DATA ·d+62016(SB)/64,$"\xc6mB\x17r\x17\x12\xdb\xc2\x18\xed\x19\xce7u\xe0\xe0\xe6\xbd\xbb\xc4\x9f\xb3\xef\x86\xbc\x19s.\x95\xa1k\xa9\xa8i\x96\xe8\x92\xfd\xa1m\xe4D\xb8\x8erJ\xcam\xa6\x91\xc4N\xdc\x82\xa7\xa1\x19\xcf \x0c?\xb5S"
+DATA ·d+62080(SB)/64,$"\xaa\xe9U\xd7\xb5\xd7\xdf\xa9\xcb\x99\xd6\x85:JT\x1bW{\xa3\xc5|_\xf07E\xb3\xb4\x9a\xf2\xf5\xd6\xca;[\xf6\x89\xa2\xb6cp\xae\x10\xede\x05\x1c\x06\xeb\xb8\xb7q\xe8+\xce\xfcQ\xaa\xf8r\x0f\x22\xd2\xd6]"
+DATA ·d+62144(SB)/64,$"\xff\x00g\x88Eo\x17\x90#\x85N\xe0w6\x1d\x8b\x12\x06\x0eN9\xc0\xc5}\x0b\xe2k\xef\x86\xfa/\x86\x16\xce\xee\xfbRE\x80\xbcf\x0b4b\xfb\x5c\xa8fy\x0eA\xca\x808#\x81\x8e\x95\xe0\xba\xbd\xb7\x86\xef"
+DATA ·d+62208(SB)/64,$"\x90\xeb\xbe\x14\xf0\xd5\xc4\xda:(D\xb8\xd4'2L\x08\x1c\xb4\x8b\x0c\xbbT\x1f\xf7q\xda\xae\x96+\x07N\x1c\xed[\xb2L\x9c\x0a\xad[\x95V\x95\xed\xd5\xed}\x8e\xc7\xc2\xde3\xf0d\xd6\x88&\xd5?\xb0\xef\x11\xc6"
+DATA ·d+62272(SB)/64,$"\xf2,!\xbb\xb5*z\x80\x06\x5c\xa9\x1dAQ\xf8\xf5\xaezmo\xe1t\xe2E\xb8\xb0\xb7\xbe\xdbzE\xbaX)\xb3\x90\xc4\xa7\xef,\x802\x90F\x0a\xa3\xc6\x01\xe4\x13\x8a\xe2\xf4\xc9\xec,e\xfbu\xc6\x95`cp"
+DATA ·d+62336(SB)/64,$"\xbb\x16H\x06>Ry\x00\xe7\xafpO\x22\xb4\x9f\xc4\xdaU\xf4\xe6\x0b}6\xde\xc2T!\xd6\xea(\x98cw9 \x10\x0f\xa0\xf4\xae|.3\x07:\x92\xe7\xa9.W\xe9\xfajw\xe6\x18UA{\xb1\xd5y\xbf\xc7"
+DATA ·d+62400(SB)/64,$"\x7f\xf3;\x1dsR\x8bJ\x91\x13\xc0+\x09\xd3\xf7\xa3\xa4\x97\x11\xa4\x17Q\xc8\xca\xa5\xa3\x95\x1c\x8d\xa1X\x95\xdc\xa4\xa3c*q\xc5v^9\xf8\x93\xf07P\xd3t}\x0fB\xb1\xd4l\xeeH\x1f\xda\x9a\x96\xa1\xaa\x0b"
+DATA ·d+62464(SB)/64,$"\x84yJ\xe2T\x16\xf0D\xb1\xc7\x19q\x94`\xc5\xca\xf0\xc3<\xd7\x98iW\x1a\x96\xab;\xe9\xd77:\x8e\xf8\xc8[\xbb\xc3oo\xe5\xaa\xb7\xccv\x1epH\xe4\x12\xa7\x9a&\xb0\xd0\xae|T\xb1\xdb@\xf3\xc7\x04\x00"
+DATA ·d+62528(SB)/64,$"\x99a\x88O2\x9e~r\x5cya\x98d\x9f\x94\x01\xdb\xb5u\xd0\xcd0\xb4\x1f<n\xec\xf6\xe8\xfak\xfdn|\xe4\xb0T'W\xd9\xda\xf2\xb6\xf1\xdf_7\xa7\xa7[z\x9e\x94\x82\xc3@\xd6\x0cWCH\xd6,\xa4"
+DATA ·d+62592(SB)/64,$"\xd8\x8c\xcbq?\x95\x86Q\xb1\xcf\xef\x15\x0e\x17\x85\xc1\xc6k\x87\xd5\xf4\xd1>\xaf5\xb6\x99-\x86\x81o\x12\xb6/^#p\x93.~\xe9e\x0d\xb5\x8e3\xc9\xfa\x1a\xe3'\xf0\xe7\x89\xaf|I\xf6Io$\xd0t\xd8"
+DATA ·d+62656(SB)/64,$"\xa13\xa5\xd6\xa0\xec#0\xfb\xae~v~\x02\xa8\xe4\x93\xd8\x02i\xde\x0b\xf1V\xa5$\xedi\xe0\xc5\xbd\xd4u6\x91E\xbe-\x95\xe6V\x07\xe5\xd8\x91F\xec\xe0\xfa\x10G\xbdu\xd5\xe2\x8fm\xcd\x08G\x95\xbdt\xff"
+DATA ·d+62720(SB)/64,$"\x00\x82\xcfK\x09\x90N\x9d\xb1\x0e\x5c\xd6\xb2w\xe9\xebem=\x0f\xba~\x09\xf8c\xc3?\x10\xf4\x8b\xadS\xe2\x04\xda\x06\xa5\xa5\xc1\xff\x00\x12\xf6m\x02\xdfn\xa9\xf6\xa7\x8ftR\xb41\x00\x1d\x17\x1f18\xfa\xf1_"
+DATA ·d+62784(SB)/64,$"5x\xe6\xd3\xc1\x7f\x0d<O$\xfe\x0a\xd6\x8c\xc8\xf2\x00\xfb\x22\xdb\x0c\xdf)\x04\xba\x9c\x94u\xc9\x5c\x9eH\xf5\xafS\xbd\xf89\xf1\xfb\xc0\xf7\xfa\x07\xc4_\x89~\x1c\xb5\x16\xd3@\xb3\x8d\x1c\xeb\xd0\xd9\xea\x92[J\x1c"
+DATA ·d+62848(SB)/64,$"\x89$\xb7\x88$\xf0\xa6~`\x1b\x91\x8c\x15\x00\x91_>\xfck\xbb\x7f\x0ekS\x9d?I\xb7\x84\x11\x19\xb8\xb9\x87\xca\x93s\xb2\x00K\xf9\x1f\xbb \x1e\x84\x1eO$\x0c\xd7\x99,\xc9\xd4nNNz\xf5QV\xf2\xb2"
+DATA ·d+62912(SB)/64,$"\xba\xd0\xe4\xa3\x92\x7f\xb7\xa4\xaa{\xb3[)i\xea\xee\x95\xef\xba\xb2>\x8e\xf0\xb7\xed\x89\xe3/\x0c|3>\x09\x12.\xb1\x04!>\xcdoy\xab\xdf*B8R>\xc3$\x8fo2\xec\x011\xf2\xe0\x00F:W\xc4"
+DATA ·d+62976(SB)/64,$"\xff\x00\x11o|_}\xac>\xbd\x7f\xa7\xbd\x8a\xde\x83t\x91\xda\xabE\x17\x96\xc4\x80b\x5c\x91\xb0\x11\xd0\x12\x01\xc8\xeb\x5cV\xa1{\x146\xdb X\x9aI\xd1Y\xe7\x90\x86\xc8\xf4\x1d1\x8f\xce\xba\xbd+\xc7\x17\x9a\xa7"
+DATA ·d+63040(SB)/64,$"\x87\x17\xc2\x9a\x8d\xbc\xd7\xb6\xb6\xaf$\x96RF\xd8\x92\xd6YX4\x85\x09\xc8e\x90\x80YX}\xeeA\xcer`\xb0t\xe9K\x9f\x0e\xb5w\xbd\x9e\xd7\xd7C\xea)`#F\xf5!O\xae\xb7\xfe\xad\xe8x\x1c\x9e\x22"
+DATA ·d+63104(SB)/64,$"\xd5l\xf5\x93t\x92\xbcp\xbb\xee\xca\x1c0\x03\xd4w`y\xe6\xbe\x8d\xf8{\xf1S\xc7\x8d\xa5\xdf\xc1g\xe2\x8b\xeb\x19^\x07\x98\x83\xf6U\xb7\x95\x80\xe4K$\xe0\xe0\x15\x18\xc0\xe4\x9e\x00\xcd`\xcd\xf0\x87\xc2^ \xd6"
+DATA ·d+63168(SB)/64,$" \xb7\xb4\xd7\x22\xb1\xb6\xba\x8df\x9emF\x09\x03@\xe7\x87V\x8a-\xecYOM\x87\x0c\x0f\x18\xa3_\xf8-\xe1_\x09\xdc\x9b\x01\xe2D\xd4-\xe3\x8c\x5cO\xe5\xe9w\x10\xa6\xcd\xdc\x7f\xae# \x8ewt\xecy\xae"
+DATA ·d+63232(SB)/64,$"\x5c\xc3\x11Z\xaf\xee\xbd\xa3]l\xff\x00\xe0\xd8\xfb)b\xb03\x84g*z\xff\x00\x85\xbf\xd1\x9c\xde\xb1\xe3\x0d\x7f\xc4\x10\xa5\xc7\x9b\x01i\xb2\xd2f07\x80~\xf0E8\xe7\xe8>\x95\xde|1\xfb7\x8fu\xcb\x7f"
+DATA ·d+63296(SB)/64,$"\x06x\x93W\xd44\xebk\x99\x04y\xb2\x80\xce7\x0c\xf2\xd1!\x04\xf4\xe3\xd2\xaf\xf8:o\x06\xf8k]\x92\xe3\xfb.]r&N`y\xbe\xc9*\xf06m\x925\x95\x8a\xfa\xae\x07\x07\xada\xea~=\xf1\x8d\x95\xfc"
+DATA ·d+63360(SB)/64,$"\xd1iR6\x91k,\xd2\xc8\xd1\xd8\xaa\xae<\xce\xab\xbc\xaf\x9b\xc0\xc0\x19o\xca\xba\xf0X*\xca*\x5c\xa9\xfe_\x82>\x7f\x1d^8\x87*\x14\xa3\xc8\xadt\xf4\xba\xf9k\xaf\x93=Z\xcb\xe0f\x8f\x06\xaf}6\x87"
+DATA ·d+63424(SB)/64,$"\xad\xdc\xcfs\xa4$\xf3'\x95\x12!\xb8\x89Fv\xc0\xcc\x08\x92F8\x1bA\xf5\xf4\xaeJ\xefZ\x8f\xc4>0\x87O\xd0\xb4\x1f\x11[[\x96\x8a8m.\xe4\x12\xca'a\xb5\x86HU+#\xf2\xa1\xb9\x04\x91\x92\x00"
+DATA ·d+63488(SB)/64,$"\xae_\xc2Q]\xea\x91N\xf3\xea\xb1\xdb\xc5i\x17\x9b\xb2\xe9\xd8\xb4\xbb\xb8P\x88\xc4\x02s\xc9<\xed\x1c\xe0\xd6\x96\xbb2[\xc0\xb2\xf8zo\xb6D\xd1\x89&\x92RQ\xa2\x91N\x08\x04}\xefPx\xeb\xd2\xb7\x96["
+DATA ·d+63552(SB)/64,$"JR\xf7\xeb4\xfd4_5\xb9\xe3\xd6\xae\xb9\xfd\x85U\xcc\xd2J\xee\xea\xdf\xa6\xbd\x8fR\x93\xc1\x1a\xb6\xa5\xa8O\xe1\x8da!\xd2\x1a[s+\xb6\xb1\x14\x90\x11\x18\xe5]\x03(\x01\x89\x1f+\x03\xcf8\xae\xb3\xc0\x9a"
+DATA ·d+63616(SB)/64,$"_\xc3\x8f\x0f\xc5q\xa4\xf8\x86[\xc8$\x9a\xe1\x15|A\xa4j\x13\xc2a\x84\x11\x96{7\xc4r\xae\xdc\x9d\xa4\x86\xcf\x1e\xf5\xf3\x1e\x87\xe2}J\x0dHX\xeaww%B\xab\x1d\xb2\x19\x1819\xd9\x96\xdcN\xd0:"
+DATA ·d+63680(SB)/64,$"\x0e\x95\xf4\xc4>&\xb6\xb4\xb1\x8fT\xd1\xf4\xcbO\x14\x99,\xa4\xdf\xa6j\xa4F\x91\x87R\x0b(8%\x87\x0c\xbb\x08l\x8e\xb5\x9b\xc1\xc3\xe1\x94\xef\xd9\xaf#\xe6sl%z\x13\x85\x08\xe9\x09y\xdb\x7f6r\x7f\x16"
+DATA ·d+63744(SB)/64,$"<I\xf0\xfa\x08?\xb1<#\xe3\x1b\xdf\x11\xc6%x\x14k\x1a{\x22\xaa(;<\xb9\xa4\xdc0[\x18\xd8Ex:8\x08\x01\x8a\x1e\x00\xe9\x1a\x91\xf9\x86\xe6\xb7\xb5\x8f\x86\xbe0\xf2m\xb5+\xcd#W\x8dgM\xc6"
+DATA ·d+63808(SB)/64,$"$\xb0\x96\x22Y\x7f\xb8\xcc\xb8l\x0e\xe0\x9fZ\xe6\x7f\xe1\x03\xf1)\xe4x\x7fR \xf4&)9\xad\x96\x17\x95Z\x12r\xf3>\xab\x05\x0c5((B\xa7\xad\xda\xdf\xf0?\xff\xd9\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xd4"
+DATA ·d+63872(SB)/64,$"Y\xdbr\xdb6\xb7\xbe\xae\x9eb\x95\xdd\x93\xb1g$1q\xd2\x1cl\x89{dY\xb6\xd9\xca\x94F\xa2\x9b\x9dK\x88\x5c$\x11\x83\x00\x0b\x80V\xd4=\x9d\xe9\xfc\xcf\x90\xcb\xff\xe9\xf2$\xff\x00\xa4N>\xc8r\xd3\x9b"
+DATA ·d+63936(SB)/64,$"\xff\xc6\x22\x80u\xfc\xd6\x02\xb0\xb0\xdc\xf9\xf1l\xd4\x0f?\x8d\x07\x90\xe9\x9cy\x8d\x8e\xf9\x01\x1aw\x1d\xf3\xe1\x00#<\xed:\xc8[\xd7S\xc7\xac\x22\x89\xbd\x06\x00@'GM \xd3\xbah\xe1\xef%\xbd\xed:\x91\xe0"
+DATA ·d+64000(SB)/64,$"\x1a\xb9n\xe9E\x81\x0e\xd4\xa3\xae\xa3\xf1\x8bv\x8d\xb0\x93(#R\xa1\xee\x96:i\xbdw6\xc5p\x92c\xd7\xb9\xa58/\x84\xd4\x1b\xccs\x1a\xeb\xac\x1b\xe3-\x8d\xb0e\x07\x8e[3j\xaa\x19z\xa9h\xd1|\x86"
+DATA ·d+64064(SB)/64,$"1\xc4\x98\x8b\x8e[M\xde\x93\x1c\xa3\x8a$-4\x15|C\xf8\x03&\x90RgB>@\xc3(\xbf\x01\x89\xac\xeb(\xbd`\xa82D\xed@&1\xe9:\x91R\xae\x9dmGJ9\xe0\xde\x17\x9b\x22GI\xf4\x96\xe4"
+DATA ·d+64128(SB)/64,$"\xcb2\x15\xf0\xb2\xfd\xfa\xa8\xfd\xda\xf2t\xdc\x0a\xdcFg&\xe2E-Cad\x8c\xb6\x01\xb9B^\xd6\xe6\xd85C\x8er=QM\xbe\xba\x0bI\xf6\xea\x0eI\xe1u\xdcbC\x8e{WP\x87\x93\xdb\xf5h\x9b\x99"
+DATA ·d+64192(SB)/64,$"\xd4>\x9b\xc0\xabc\xd7M\xa9\xce\xcaY;\x12\xb9\x9bJ1g(\xdd\xa5~\x074\x91)\xea\xae3c\x84\xdf8k\xc3\x94(e\x84\xaa\xe3\x92\x07\xd4t\xdc-\xf5\xfb\x18\xf3\xd3J\xe5J\xc7\xc3\xb2\xefp\xa1\xd6\x94"
+DATA ·d+64256(SB)/64,$"\xa7-\xa5\x89\xd4\x86\xf9\xa2\x9a\x80i5\xb1\x8f\x0c\xa13\x94--\x04S\x8e72\x03@\xa3?6r\xec\xf4>R\x22\x891\xd5\xaaEx\xdc\xd2\x19\xe17\xca\xf1\xfa\xd5\x1c\x10\x1eCh\xe7\xf6\x91DZ\xdcl\x95"
+DATA ·d+64320(SB)/64,$"\x82F\xba\x94\xe8x\xbf\x94J\x03\x013\xd9\x84\x02I\x84I\xc9\xa0^\xdeG \xa3\x11r\x85\x8e7\xac>\x9e\x0cZ\xc7\xad\x93\xb6\x1a\xde\xf9\xb3\x95\xd1\xeb\xb05\x1a\x9b\x19\xfcT`;*'\x8cm\x90\xd9\xb3\xca;"
+DATA ·d+64384(SB)/64,$"%\xd1\x0dh\x01\xa1(,\x95[\x93\xd9-\xb0\xb6\xb9av@$b\xdc\x90i\x87@\x15\x10P4/\x18\xc2\x85\xa8\xd3\x14V\x9b\xd7\xc8\xb6\xc1\x85\x19\xe5D.@b\x9d\xc9@\xb9\x16\x86\x05\xbf`Tj2c\xd8"
+DATA ·d+64448(SB)/64,$"\xb6\x9b\xac\xd1\xc9\x8e\xac\xab\xf3l\xe1x\x1f\xb3E\xc7\xcd\x8e\xbc\x1d&D$G(\x0b \xc6\x94L0\x1a\x93\x05(\x1a#\x14R|\xc6HC\x22$\x10\xb8E\xb9X\x9a\x1a\x11\x85 \x92\x8d\xc4#0\x19LC\xe8"
+DATA ·d+64512(SB)/64,$"\x8d}\x88ET\xe6\xc85\xa9P7v\xea\x0c7\x0c\x05\x9a\x93\x14\xdb\x10f(\x11\x88\xc4\xc7\xb2\xbb`\xc8\xf5\xc2(Z\xe5\xb55f\xad\xb6\x86\x85(\x85\xba\xc6dC\x0f\x1c\xcc3\x1ae\x101$\x92-@eb"
+DATA ·d+64576(SB)/64,$"\xae\xcc!e2\xdc\x08R\x22G\x9d\x19A\xf3\x8ch\x03'#\xd1\x8d\x02\xa2\xad\xc9\xb90~\x1c6aVj\xc8p\xd1\x84y\xb6\x00.4P~\x8b\x5c\x03\xe1\xd6^\x98g\x88\xec\x7f\xe1\x14\x0dn\xaa\x09J\x97I"
+DATA ·d+64640(SB)/64,$"r\xd7\xbeU\xc8\xea('\x94!(\xc4\x1cc\xe3\x1f\x94\x9c\xa0\xd2\x19j\x1a\x81\x16\x90/\x03\xfa\xec\x1c\xbf{\xc8<\x9a\xea{\x9cF\xdf\x9b\xf8u2R\xae4a\xcc\xa6\x84\xe3\xf9\x1b\xa3UzJ\xac\x12\x14\x22F"
+DATA ·d+64704(SB)/64,$"\x94\xea:\xa6\x0e(I\x8a-s\x9d;\xde\xff@* E\x0d\xad\x12v\x5c\x01\x8d:\xad;\xae\x11\xb86\xa0T$E\xc7\xbb6?K\x95\xc2T\x1f\x8cz\x9dbi\x11<\xb8E\x8e\xeb\x8d\xb52\xd1\xdb\xcf\x94m"
+DATA ·d+64768(SB)/64,$"[:.\xa3^c\xa9\xb0\x17\xc7@@\x99M\x12-oh\xd0\x12\x11\xb4\xa8o1(HtCR\xbc\xaf^\xc9\xa8\xf1\xed\xeb\xd7o_\xff\xfa\xf6\xf5/X\x88R\xd6\xa4\x16\xf9o_\xff]\xaf\x18\xe2v*\xea\xd9"
+DATA ·d+64832(SB)/64,$"%\xbd\xa2\x1aW!Z\x13W\xb6l,\xfck\x9bkYn<\xc0Jy\x8c_\xda&36\x16\x97\x8co^\xbe\xa9\x96\x9eD#\x15\xad\xfa\xe4C\x88D\x9e[HD\x1d\x93\xda\x97Z\x08\x1c\x08\x09\x84/\xa0\xda\x7f"
+DATA ·d+64896(SB)/64,$"\x17\xa2\xdaM\x94\xd7\xd4\x1b\x98\xd4\x1c\x87K\x18cz\xbbL\xb1\x8c\xa6\x19\xa3i\xa6\x1d\xcf\xa0[\xf9h\xcaJ&\xe4\xf1O\xc9\xfb\xe4}rt2#\xd1M*E\xc9\xe3V\xbdp\xf4\xee\xe8\xfd\xd1\xd1I+\x17\x7f"
+DATA ·d+64960(SB)/64,$"\xb44\x99\xb5\x14\xfd\x03\x8f\xdf\x9c\xb4\xc4\xe6h\xfd\xe9<\x92\xd7\xa9p &\x9a\xb4\xaa\x8a7\x15\x8eW\xed\xe5\x82\xf0;\xa6\xbc\xfb\xf9\xdd\xab\x9f\xd1\xf1\x5c7\x15\xc7+\x8c\xd6\xd5\x0d\xd5\xc6w\x8d\x92\x13\xe6\x9aQ\xa3"
+DATA ·d+65024(SB)/64,$"\xe3\x1a1\xde.a5\xc9\xc6!r\x9f6\xf9p\xf4\xf6\xdd\x91\xe3\xad\xb0\xacXv\x91\xb6\xdb\xed\x9a\xac>\xb8\xb6\xa2\x1e\xd3\xdb;\xb1\x9f\x94\x1c6vU\xed\xdb\xfd\x00\x9a\xf0ms\xdac\x0aJe\x8e,\xaa\xff\x9b"
+DATA ·d+65088(SB)/64,$"\xe3\xfb\x0c\x98\xc9[<:B\xc7\xcb\x09\xe5K\xba\xc6\x93\xf1\xa3\xb9y\xe6,\xe9\x0f\xd6\x17\xc2\x03<\xf86\x9e\xbd{\xe3x/~z\xfd\xe6\x84\xa3yJ\xe9\xc2\x0e6\xe3\xba\x17w\x92\xeb\xbf\xc7\xb8\x11~w+\xb1"
+DATA ·d+65152(SB)/64,$"\xef\x89;|\xdc\xf9\xb7o\xe3\x0f\x988^R\xf2\xe8\x99\x90\x1e\x1c\xc2\xff[\xb9?\xecb0\xc0\xd4\x0c\xed]t\x97\x84\xc7\x0c\xcf\xd7f\x1c<\x09\x80\xbb\xe9hs\xa7\xd9\x06\x96}\xac\x98\xa2\xbc\xc5\xcb0\x1c\xd7\xc4"
+DATA ·d+65216(SB)/64,$"\x87\x8f;\xb8D\x8e&\xfb\xe0\x86R\xees0\x1cw\xf7\x11\xb6/\xa6C\xaa4\xf2\x1e\x8f\xad[{\xe3z\xfc\xe1\xe5\x87WOc\xbb\x04\x80S\xb6D\xeb\xe4\x9f\xc0\xe0\xc7\x9d\x18\xdc\xd7\xba\xcc\xc2\x9di\x98\xe4z\x1f"
+DATA ·d+65280(SB)/64,$"\xc4\xc6\x92r\xcd\xf8.\xa8\xee{sho\x87\x1f~\xf8\xd3\xda\xf1\xe7\xee#\xbd\xe3\x9a\x92\xea\xd9\x05\xebV\xcd\xffX\xb1\xba\xdf\xb3\xf7\xbb\xca\xd5N\xe1\xad\x9f$\xeb\xb7\x87\xd5\x5c\xa902\x22\xc1M\x95/a\x86\x89"
+DATA ·d+65344(SB)/64,$"\xb0\x97\x0a\x91\xb6j\xae.\xa2\x9d\x15d\xb9\xac8w45>k\xc4r\x8e\xdc\xd4\x913\xca\xcd\x0d\xe2x\xeb\xef\xca~F\x9f\x16$\xc9\xcd\x821\xd7\xd6u7\x8eW\xfd\xee\xcf\x9e\x7f\xa63%\xb8\x8b*r<T"
+DATA ·d+65408(SB)/64,$"\xd1\xfe\x9c\x17\x88R\xff\x222b|hK\x1a\xa1\xe3\xd5\x1f\xfb\x0bI\xc5\xacL\x12\xc2\x84k\xee\x01Y\xdd\x8dr\x83\xdf-\xffN\xae=\xd4\xf7x,\xe5\xf6\xef\x91|o\xda]\x99R\xb6R\x01\xa9\x00-\x9e\x930"
+DATA ·d+65472(SB)/64,$"Zpu\xb3p\xbc\x80\xdePM`,\xc5\x8d(\xc4\xed\xea\xb5\xac3\x84\xa7\xf9\xdds*I_\xc4\xe8x3T\x1a\x12\x89\x08\x89\xe0\xd5\xf3\xbf\x90\x22\x95$\xcfQ\xaa'B8\x9f\xcf\xdb\x9fQ\xcf$\xa1\x5c\x19\xf9"
+DATA ·d+65536(SB)/64,$"\x8e\xf7\x0b\xeaS;\xdcm\xd2=N7\x15nm\xcd\x85\x00\xffl\xf0\x9c\xec\xc9\xcaTP\xe1\x9a\x1f\xc73\x9d\xcf\x95\xf2\xfa\xd1e\x8b\xe6\xba\xde4\x1b\x17yJ9\xee'\x9e\xa0\x14Y9s\xbc1\xb9E\x06\xbf\x12"
+DATA ·d+65600(SB)/64,$"\xbeP\x19\xae!\xdf\x83\xd7Z\xd6\xaa\xfa)\xb1\x88Z:\xc3\xdc\x5c\x7f\x0f\xcc~w\xd2\xdfm\xd1=\x96\xf0\xcfn\xe5}_w\xa0\x93\xd0\xb4\x94\xab\xc29BS\xe8a\xbca\xe7v\xc7\x8f\xe6)(\x19u\x1d\xdb?"
+DATA ·d+65664(SB)/64,$"R\xee\xb6\xb9\xed\xcfE\xea\x00a\xba\xeb\xf4\xa5 \x9a\x92&\x1c\xbd|\xf5z\xd5\x1e_\xff1\x8a#R\xac\xc1\xab\xb7\xe1\xea{K\xc0\xc3\xfd\xca\x87:\x92\x85W\xcbw\xef*h\xd8\xa9\xd2\xb6$\x9e\x1d\xbeUC\xf4"
+DATA ·d+65728(SB)/64,$"\xb1\xb8=\xd41\xfd\x07nB\xb8\xf2C\xa8E\xc2\xc1\x95\x1f\x1e.;\x11^_\x14\x0bi^V\xf0\x22\x12\xc5\xe2\xc4\x00\xf5\x0ez\x0c\xbf\xe0\x02\x02B\xe3\x85\xe0\xe2vE>F\x99S\xa5\xacC\x0a\xcc\x1d;[@"
+DATA ·d+65792(SB)/64,$"*\x09\xd7\x187\xab\xa3F$`\xfe[\x93b\xd3\x98g\xce\xc3\x02\xa5\x12\x1c\xc4L\x13\xca\xab\x1e\xa3\xd1\x05\x22i\xe8\x8c*P\x22\xd1s\x22\xd1\x9e\xc5D)\x11Q\xa21\xbe\xd3\x7f4}\x01\x05\x07\xe6\xc4y\xc1\xe2"
+DATA ·d+65856(SB)/64,$"\xdfKq2\xad\xf9^H;<\xb4\x0ac$\x0c(o\x18\xba%\x01\xcc\xa9\xceD\xa9A\xa2\xd2\x92\xda\x804\x81\xf2\x88\x95\xb6\xeaX.3\x9a\xd3Z\x9ba\xb7\xb8(\xd0\xa2Q*lZ\x9b\x9b\x90\x8b\x98&\xe6\x17"
+DATA ·d+65920(SB)/64,$"\xad\x8bE9cTeM\x88\xa9\x11=+56A\x99I\x8bv\xd3\xf8\xe4\x9a\xb3\x0a\x193\x12(\xaa\xca\xef\xb5u\x96\xc6\x98^\x18pu\x0d\x97\xd1\x0b\xf3L\xe4\xb0\xe5\x09U\x90\x94\x92S\x95\xd9f#\xc4\x02\x94"
+DATA ·d+65984(SB)/64,$"h6T9\xb3\x1d\xde\xbaE\x9b\x08\xc6\xc4\xdc\xb8\x16\x09\x1eS\xe3\x91Zu\x9flF\x90\x99\xb8E\xebR\x15}.4\x8d\xaa\x08\xd8\x98\x14\xeb@\xd7K*#\x8c\xc1\x0ck\xdc0\x06\xca\x810\xd6Xz%\x8d\xdf"
+DATA ·d+66048(SB)/64,$"J\x13\xae)a`\x9e\xa9F\xadm\xfanx\xd0^\x9bq9\x80\xe9\xe8<\xfc\xd8\x9b\x0c\xc0\x9f\xc2x2\xfa\xcd?\x1b\x9c-\xc3\xdb\x9b\x82?\xadc\xdb\x84\x8f~x9\xba\x0e\xe1co2\xe9\x05\xe1'\x18\x9dC"
+DATA ·d+66112(SB)/64,$"/\xf8\x04\xbf\xfa\xc1Y\x13\x06\xff7\x9e\x0c\xa6S\x18M\x1a\xfe\xd5x\xe8\x0f\xce\x9a\xe0\x07\xfd\xe1\xf5\x99\x1f\x5c\xc0\xe9u\x08\xc1(\x84\xa1\x7f\xe5\x87\x833\x08G`\x94\xd7\xa2\xfc\xc1\xd4\x08\xbb\x1aL\xfa\x97\xbd \xec"
+DATA ·d+66176(SB)/64,$"\x9d\xfaC?\xfc\xd4\x84s?\x0c\x06\xd3i\xe3|4\x81\x1e\x8c{\x93\xd0\xef_\x0f{\x13\x18_O\xc6\xa3\xe9\x00z\xc1\x19\x04\xa3\xc0\x0f\xce'~p1\xb8\x1a\x04a\x1b\xfc\x00\x82\x11\x0c~\x1b\x04!L/{\xc3"
+DATA ·d+66240(SB)/64,$"\xa1U\xd5\xbb\x0e/G\x13k_\x7f4\xfe4\xf1/.C\xb8\x1c\x0d\xcf\x06\x93)\x9c\x0e`\xe8\xf7N\x87\x03\xb0\xaa\x82O\xd0\x1f\xf6\xfc\xab&\x9c\xf5\xaez\x17\xc6\xba\x09\x8c\xc2\xcb\xc1\xc4\x92\xd5\xd6}\xbc\x1c\x98\xa9"
+DATA ·d+66304(SB)/64,$"\x86\x1f@/\x80^?\xf4G\x81q\xa3?\x0a\xc2I\xaf\x1f6!\x1cM\xc2\x15\xebG\x7f:hBo\xe2O\x0d \xe7\x93\xd1U\x13\x0c\x9c\xa3sC\xe2\x07\x8d\xfe(\x08\x06\x95\x14\x035lEg4\xb1\xe3\xeb\xe9"
+DATA ·d+66368(SB)/43,$"`m\xcb\xd9\xa07\xf4\x83\x8b\xa9\xf1x\x93\xf8\xc9fw\xc7\xad\xfeK\xd9\xb1\xff\xda\xf5\xe0?\x00\x00\x00\xff\xff\x03\x00\x13\xfc\x00\x07H\x1e\x00\x00"
+GLOBL ·d(SB),RODATA,$66411
@@ -1,6 +1,8 @@
 // Code generated by go-imbed. DO NOT EDIT.
 
+//go:build (mips64 || mips64le) && !imbed_dev
 // +build mips64 mips64le
+// +build !imbed_dev
 
 #include "textflag.h"
 
@@ -1,6 +1,8 @@
 // Code generated by go-imbed. DO NOT EDIT.
 
+//go:build (mips || mipsle) && !imbed_dev
 // +build mips mipsle
+// +build !imbed_dev
 
 #include "textflag.h"
 
@@ -3,19 +3,29 @@
 package site
 
 import (
-	"encoding/base32"
 	"encoding/binary"
 	"hash/crc64"
 	"testing"
 	"math/rand"
 	"os"
+	"io"
 	"io/ioutil"
 	"path/filepath"
 	"bytes"
 	"fmt"
+	"compress/gzip"
+	"sync"
 	"net/http"
 	"net/http/httptest"
 	"path"
+	"bufio"
+	"encoding/json"
+	"sort"
+	"mime"
+	"net/url"
+	"mime/multipart"
+	"strings"
+	"time"
 	"errors"
 )
 
@@ -26,24 +36,196 @@ var randomName = func() string {
 	return b32Enc.EncodeToString(buf[:])
 }()
 
-var b32Enc = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
-
 func getTag(data []byte) string {
 	var crcBuf [8]byte
-	binary.LittleEndian.PutUint64(crcBuf[:], crc64.Checksum(data, crc64.MakeTable(crc64.ECMA)))
+	binary.LittleEndian.PutUint64(crcBuf[:], crc64.Checksum(data, crcTable))
 	return b32Enc.EncodeToString(crcBuf[:])
 }
 
 func TestBytes(t *testing.T) {
-	for n, a := range fidx {
+	for n, a := range allFiles() {
 		if getTag(a.Bytes()) != a.tag {
 			t.Fatalf("checksum for asset %s doesn't match recorded", n)
 		}
 	}
 }
 
+func TestReadAll(t *testing.T) {
+	for n, a := range allFiles() {
+		data, err := a.ReadAll()
+		if err != nil {
+			t.Fatalf("error reading asset %s: %s", n, err)
+		}
+		if getTag(data) != a.tag {
+			t.Fatalf("checksum for asset %s doesn't match recorded", n)
+		}
+	}
+}
+
+func TestVerifyAll(t *testing.T) {
+	if errs := VerifyAll(); len(errs) != 0 {
+		t.Fatalf("integrity check failed: %v", errs)
+	}
+	for n, a := range allFiles() {
+		tag := a.tag
+		a.tag = getTag([]byte(randomName))
+		err := a.Verify()
+		a.tag = tag
+		if err != ErrCorrupted {
+			t.Fatalf("expected ErrCorrupted for modified tag of %s, got %v", n, err)
+		}
+	}
+}
+
+func TestCompressedReader(t *testing.T) {
+	for n, a := range allFiles() {
+		var r io.Reader = a.CompressedReader()
+		switch a.Encoding() {
+		case "gzip":
+			if !a.IsCompressed() {
+				t.Fatalf("asset %s is not compressed, but reports gzip encoding", n)
+			}
+			gz, err := gzip.NewReader(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r = gz
+		case "":
+			if a.IsCompressed() {
+				t.Fatalf("asset %s is compressed, but reports no encoding", n)
+			}
+		default:
+			t.Fatalf("asset %s reports unexpected encoding %q", n, a.Encoding())
+		}
+		data, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data, a.Bytes()) {
+			t.Fatalf("content of asset %s read from compressed reader differs", n)
+		}
+	}
+}
+
+func TestCache(t *testing.T) {
+	var compressed []*Asset
+	var limit int64
+	for _, a := range allFiles() {
+		if a.isCompressed {
+			compressed = append(compressed, a)
+			if int64(a.size) > limit {
+				limit = int64(a.size)
+			}
+		}
+	}
+	SetCacheLimit(limit)
+	defer SetCacheLimit(0)
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 2; j++ {
+				for _, a := range compressed {
+					if getTag([]byte(a.String())) != a.tag || getTag(a.Bytes()) != a.tag {
+						t.Errorf("cached content of %s does not match the tag", a.name)
+					}
+					var buf bytes.Buffer
+					if _, err := a.WriteTo(&buf); err != nil || getTag(buf.Bytes()) != a.tag {
+						t.Errorf("cached content of %s does not match the tag", a.name)
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	stats := CacheStatistics()
+	if len(compressed) > 0 && stats.Hits == 0 {
+		t.Fatalf("expected cache hits, got %+v", stats)
+	}
+	if stats.Size > limit || stats.Limit != limit || stats.Hits+stats.Misses != uint64(len(compressed)*4*2*3) {
+		t.Fatalf("unexpected cache statistics %+v", stats)
+	}
+	handler := http.HandlerFunc(HTTPHandlerWithPrefix("/"))
+	for p, a := range allFiles() {
+		for i := 0; i < 2; i++ {
+			req, err := http.NewRequest("GET", path.Join("/", p), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+			if getTag(rr.Body.Bytes()) != a.tag || rr.Header().Get("Content-Length") != fmt.Sprint(a.size) {
+				t.Fatalf("handler returned content doesn't match with recorded for %s", p)
+			}
+		}
+	}
+	SetCacheLimit(0)
+	if stats = CacheStatistics(); stats.Entries != 0 || stats.Size != 0 {
+		t.Fatalf("expected empty cache, got %+v", stats)
+	}
+}
+
+func testSeek(t *testing.T, rnd *rand.Rand, name string, data []byte, r interface {
+	io.ReadSeeker
+	io.ReaderAt
+}) {
+	for i := 0; i < 16; i++ {
+		off := rnd.Int63n(int64(len(data)) + 1)
+		buf := make([]byte, rnd.Intn(1<<17)+1)
+		want := data[off:]
+		if len(want) > len(buf) {
+			want = want[:len(buf)]
+		}
+		if pos, err := r.Seek(off, io.SeekStart); err != nil || pos != off {
+			t.Fatalf("%s: seek to %d: %d, %v", name, off, pos, err)
+		}
+		n, err := io.ReadFull(r, buf)
+		if err != nil && n != len(want) {
+			t.Fatalf("%s: read at %d: %v", name, off, err)
+		}
+		if !bytes.Equal(buf[:n], want) {
+			t.Fatalf("%s: content read at %d differs", name, off)
+		}
+		n, err = r.ReadAt(buf, off)
+		if n != len(want) || (err != nil && (err != io.EOF || n == len(buf))) {
+			t.Fatalf("%s: ReadAt %d: %d, %v", name, off, n, err)
+		}
+		if !bytes.Equal(buf[:n], want) {
+			t.Fatalf("%s: content read with ReadAt %d differs", name, off)
+		}
+	}
+	if pos, err := r.Seek(-1, io.SeekEnd); len(data) > 0 && (err != nil || pos != int64(len(data)-1)) {
+		t.Fatalf("%s: seek from end: %d, %v", name, pos, err)
+	}
+}
+
+func TestOpen(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for name, asset := range allFiles() {
+		data := asset.Bytes()
+		r := asset.Open()
+		testSeek(t, rnd, name, data, r)
+		if err := r.Close(); err != nil {
+			t.Fatal(err)
+		}
+		sr := asset.ReaderAt()
+		if sr.Size() != int64(len(data)) {
+			t.Fatalf("%s: expected size %d, got %d", name, len(data), sr.Size())
+		}
+		content, err := ioutil.ReadAll(sr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(content, data) {
+			t.Fatalf("%s: content read from section reader differs", name)
+		}
+		testSeek(t, rnd, name, data, sr)
+	}
+}
+
 func TestString(t *testing.T) {
-	for n, a := range fidx {
+	for n, a := range allFiles() {
 		if getTag([]byte(a.String())) != a.tag {
 			t.Fatalf("checksum for asset %s doesn't match recorded", n)
 		}
@@ -237,8 +419,47 @@ func TestCopyTo(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestSeek(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for name, asset := range allFiles() {
+		f, err := FS().Open(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r, ok := f.(AssetReader)
+		if !ok {
+			t.Fatalf("%s does not implement AssetReader", name)
+		}
+		testSeek(t, rnd, name, asset.Bytes(), r)
+		f.Close()
+	}
+}
+
+func TestHttpFileSystemRange(t *testing.T) {
+	srv := http.FileServer(HttpFileSystem())
+	for name, asset := range allFiles() {
+		data := asset.Bytes()
+		if len(data) < 2 || path.Base(name) == "index.html" {
+			continue
+		}
+		req := httptest.NewRequest("GET", "/"+name, nil)
+		req.Header.Set("Range", fmt.Sprintf("bytes=1-%d", len(data)/2))
+		rec := httptest.NewRecorder()
+		srv.ServeHTTP(rec, req)
+		if rec.Code != http.StatusPartialContent {
+			t.Fatalf("%s: expected status %d, got %d", name, http.StatusPartialContent, rec.Code)
+		}
+		if !bytes.Equal(rec.Body.Bytes(), data[1:len(data)/2+1]) {
+			t.Fatalf("%s: range content differs", name)
+		}
+		if ct := rec.Header().Get("Content-Type"); ct != asset.MimeType() {
+			t.Fatalf("%s: expected content type %s, got %s", name, asset.MimeType(), ct)
+		}
+	}
+}
 func TestHttpHandler(t *testing.T) {
-	for p := range fidx {
+	for p := range allFiles() {
 		asset := Get(p)
 		if asset == nil {
 			t.Fatalf("asset %s nout found", p)
@@ -281,6 +502,719 @@ func TestHttpHandler(t *testing.T) {
 		t.Fatalf("handler returned wrong status code: got %v want %v", status, http.StatusNotFound)
 	}
 }
+
+func TestHttpHandlerConditional(t *testing.T) {
+	handler := http.HandlerFunc(HTTPHandlerWithPrefix("/"))
+	for p, asset := range allFiles() {
+		if path.Base(p) == "404.html" {
+			continue
+		}
+		var (
+			tag     = fmt.Sprintf("%q", asset.Tag())
+			weakTag = "W/" + tag
+			other   = fmt.Sprintf("%q", randomName)
+			lm      = asset.ModTime().UTC().Format(http.TimeFormat)
+			before  = asset.ModTime().Add(-time.Hour).UTC().Format(http.TimeFormat)
+		)
+		for i, tc := range []struct {
+			method string
+			header []string // name, value pairs, added in order
+			code   int
+		}{
+			{"GET", []string{"If-None-Match", tag}, http.StatusNotModified},
+			{"HEAD", []string{"If-None-Match", tag}, http.StatusNotModified},
+			{"GET", []string{"If-None-Match", weakTag}, http.StatusNotModified},
+			{"GET", []string{"If-None-Match", other + ", " + weakTag}, http.StatusNotModified},
+			{"GET", []string{"If-None-Match", other, "If-None-Match", tag}, http.StatusNotModified},
+			{"GET", []string{"If-None-Match", "*"}, http.StatusNotModified},
+			{"GET", []string{"If-None-Match", other}, http.StatusOK},
+			{"GET", []string{"If-None-Match", asset.Tag()}, http.StatusOK},
+			{"GET", []string{"If-None-Match", other, "If-Modified-Since", lm}, http.StatusOK},
+			{"GET", []string{"If-Modified-Since", lm}, http.StatusNotModified},
+			{"GET", []string{"If-Modified-Since", before}, http.StatusOK},
+			{"GET", []string{"If-Modified-Since", "yesterday"}, http.StatusOK},
+			{"GET", []string{"If-Match", tag}, http.StatusOK},
+			{"GET", []string{"If-Match", "*"}, http.StatusOK},
+			{"GET", []string{"If-Match", other + "," + tag}, http.StatusOK},
+			{"GET", []string{"If-Match", weakTag}, http.StatusPreconditionFailed},
+			{"GET", []string{"If-Match", other}, http.StatusPreconditionFailed},
+			{"GET", []string{"If-Unmodified-Since", lm}, http.StatusOK},
+			{"GET", []string{"If-Unmodified-Since", before}, http.StatusPreconditionFailed},
+			{"GET", []string{"If-Match", tag, "If-Unmodified-Since", before}, http.StatusOK},
+			{"GET", []string{"If-Match", other, "If-None-Match", other}, http.StatusPreconditionFailed},
+			{"GET", []string{"If-Match", tag, "If-None-Match", tag}, http.StatusNotModified},
+			{"GET", []string{"If-Unmodified-Since", lm, "If-Modified-Since", lm}, http.StatusNotModified},
+		} {
+			req := httptest.NewRequest(tc.method, path.Join("/", p), nil)
+			for j := 0; j < len(tc.header); j += 2 {
+				req.Header.Add(tc.header[j], tc.header[j+1])
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+			if rr.Code != tc.code {
+				t.Fatalf("%s: case %d %v: expected status %d, got %d", p, i, tc.header, tc.code, rr.Code)
+			}
+			if tc.code == http.StatusNotModified {
+				if rr.Header().Get("Etag") != tag || rr.Header().Get("Last-Modified") != lm {
+					t.Fatalf("%s: case %d: 304 response lacks validators", p, i)
+				}
+				if rr.Body.Len() != 0 {
+					t.Fatalf("%s: case %d: 304 response has a body", p, i)
+				}
+			}
+		}
+	}
+	// preconditions are ignored if the asset is not found
+	req := httptest.NewRequest("GET", path.Join("/", randomName), nil)
+	req.Header.Set("If-None-Match", "*")
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	if rr.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, rr.Code)
+	}
+}
+
+func TestHttpHandlerEncoding(t *testing.T) {
+	handler := http.HandlerFunc(HTTPHandlerWithPrefix("/"))
+	serve := func(p string, header ...string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", path.Join("/", p), nil)
+		for i := 0; i < len(header); i += 2 {
+			req.Header.Set(header[i], header[i+1])
+		}
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+	for p, asset := range allFiles() {
+		if path.Base(p) == "404.html" {
+			continue
+		}
+		etags := make(map[bool]string)
+		for _, tc := range []struct {
+			accept string
+			gzip   bool
+		}{
+			{"", false},
+			{"gzip", true},
+			{"deflate, gzip", true},
+			{"GZIP;q=0.5", true},
+			{"x-gzip", true},
+			{"*", true},
+			{"*;q=0, gzip", true},
+			{"gzip;q=0", false},
+			{"gzip;q=0, *", false},
+			{"br, *;q=0", false},
+			{"deflate", false},
+		} {
+			rr := serve(p, "Accept-Encoding", tc.accept)
+			gzipped := tc.gzip && asset.isCompressed
+			content := asset.Bytes()
+			if gzipped {
+				content = asset.blob
+				if enc := rr.Header().Get("Content-Encoding"); enc != "gzip" {
+					t.Fatalf("%s: Accept-Encoding %q: unexpected Content-Encoding %q", p, tc.accept, enc)
+				}
+			} else if enc := rr.Header().Get("Content-Encoding"); enc != "" {
+				t.Fatalf("%s: Accept-Encoding %q: unexpected Content-Encoding %q", p, tc.accept, enc)
+			}
+			if !bytes.Equal(rr.Body.Bytes(), content) {
+				t.Fatalf("%s: Accept-Encoding %q: content differs", p, tc.accept)
+			}
+			etag := rr.Header().Get("Etag")
+			if prev, ok := etags[gzipped]; ok && prev != etag {
+				t.Fatalf("%s: Etag of the same representation differs: %s, %s", p, prev, etag)
+			}
+			etags[gzipped] = etag
+			vary := rr.Header().Get("Vary")
+			if asset.isCompressed && vary != "Accept-Encoding" {
+				t.Fatalf("%s: expected Vary: Accept-Encoding, got %q", p, vary)
+			}
+			if !asset.isCompressed && vary != "" {
+				t.Fatalf("%s: unexpected Vary: %q", p, vary)
+			}
+		}
+		if len(etags) == 2 && etags[true] == etags[false] {
+			t.Fatalf("%s: gzip and identity representations have the same Etag %s", p, etags[true])
+		}
+		for gzipped, etag := range etags {
+			accept := "identity"
+			if gzipped {
+				accept = "gzip"
+			}
+			if rr := serve(p, "Accept-Encoding", accept, "If-None-Match", etag); rr.Code != http.StatusNotModified {
+				t.Fatalf("%s: revalidation of %s representation: expected status %d, got %d", p, accept, http.StatusNotModified, rr.Code)
+			}
+			if other, ok := etags[!gzipped]; ok && other != etag {
+				if rr := serve(p, "Accept-Encoding", accept, "If-None-Match", other); rr.Code != http.StatusOK {
+					t.Fatalf("%s: revalidation of %s representation with the other tag: expected status %d, got %d", p, accept, http.StatusOK, rr.Code)
+				}
+			}
+		}
+	}
+}
+
+func TestHttpHandlerCachePolicy(t *testing.T) {
+	defer func(policies []CachePolicy) { CachePolicies = policies }(CachePolicies)
+	CachePolicies = []CachePolicy{
+		{Pattern: "type:image/*", Value: "max-age=86400"},
+		{Pattern: "*.html", Value: "no-cache"},
+		{Pattern: "**", Value: "public, max-age=60, s-maxage=3600"},
+	}
+	overridden := HTTPHandlerWithPrefix("/", WithCachePolicies(CachePolicy{Pattern: "**/*.css", Value: "max-age=31536000, immutable"}))
+	disabled := HTTPHandlerWithPrefix("/", WithCachePolicies())
+	for p, asset := range allFiles() {
+		expected := "public, max-age=60, s-maxage=3600"
+		switch {
+		case strings.HasPrefix(asset.MimeType(), "image/"):
+			expected = "max-age=86400"
+		case strings.HasSuffix(p, ".html"):
+			expected = "no-cache"
+		}
+		rr := httptest.NewRecorder()
+		ServeHTTP(rr, httptest.NewRequest("GET", path.Join("/", p), nil))
+		if cc := rr.Header().Get("Cache-Control"); cc != expected {
+			t.Fatalf("%s: expected Cache-Control %q, got %q", p, expected, cc)
+		}
+		req := httptest.NewRequest("GET", path.Join("/", p), nil)
+		req.Header.Set("If-None-Match", rr.Header().Get("Etag"))
+		rr = httptest.NewRecorder()
+		ServeHTTP(rr, req)
+		if cc := rr.Header().Get("Cache-Control"); rr.Code == http.StatusNotModified && cc != expected {
+			t.Fatalf("%s: expected Cache-Control %q in 304 response, got %q", p, expected, cc)
+		}
+		expected = ""
+		if strings.HasSuffix(p, ".css") {
+			expected = "max-age=31536000, immutable"
+		}
+		rr = httptest.NewRecorder()
+		overridden(rr, httptest.NewRequest("GET", path.Join("/", p), nil))
+		if cc := rr.Header().Get("Cache-Control"); cc != expected {
+			t.Fatalf("%s: expected overridden Cache-Control %q, got %q", p, expected, cc)
+		}
+		rr = httptest.NewRecorder()
+		disabled(rr, httptest.NewRequest("GET", path.Join("/", p), nil))
+		if cc := rr.Header().Get("Cache-Control"); cc != "" {
+			t.Fatalf("%s: unexpected Cache-Control %q", p, cc)
+		}
+	}
+}
+
+func TestHttpHandlerSPA(t *testing.T) {
+	var fallback string
+	for p := range allFiles() {
+		if path.Base(p) != "404.html" && (fallback == "" || p < fallback) {
+			fallback = p
+		}
+	}
+	if fallback == "" {
+		return
+	}
+	content := Must(fallback).Bytes()
+	spa := HTTPHandlerWithPrefix("/app", WithSPA(SPA{Fallback: fallback, Exclude: []string{"/app/api/"}}))
+	strict := HTTPHandlerWithPrefix("/app", WithSPA(SPA{Fallback: fallback, StrictExtensions: true}))
+	for _, tc := range []struct {
+		handler func(http.ResponseWriter, *http.Request)
+		method  string
+		path    string
+		spa     bool
+	}{
+		{spa, "GET", "/app/users/42", true},
+		{spa, "HEAD", "/app/users/42", true},
+		{spa, "GET", "/app/users/", true},
+		{spa, "GET", "/app/" + randomName + ".js", true},
+		{spa, "GET", "/app/api/users", false},
+		{strict, "GET", "/app/users/42", true},
+		{strict, "GET", "/app/users.v2/42", true},
+		{strict, "GET", "/app/" + randomName + ".js", false},
+		{strict, "GET", "/app/js/" + randomName + ".js", false},
+		{spa, "POST", "/app/users/42", false},
+	} {
+		rr := httptest.NewRecorder()
+		tc.handler(rr, httptest.NewRequest(tc.method, tc.path, nil))
+		switch {
+		case tc.spa && rr.Code != http.StatusOK:
+			t.Fatalf("%s %s: expected fallback with status %d, got %d", tc.method, tc.path, http.StatusOK, rr.Code)
+		case tc.spa && tc.method == "GET" && !bytes.Equal(rr.Body.Bytes(), content):
+			t.Fatalf("%s %s: expected fallback content", tc.method, tc.path)
+		case !tc.spa && rr.Code == http.StatusOK:
+			t.Fatalf("%s %s: unexpected fallback", tc.method, tc.path)
+		}
+	}
+	// existing assets are served as is
+	for p, asset := range allFiles() {
+		rr := httptest.NewRecorder()
+		spa(rr, httptest.NewRequest("GET", path.Join("/app", p), nil))
+		if rr.Code != http.StatusOK || !bytes.Equal(rr.Body.Bytes(), asset.Bytes()) {
+			t.Fatalf("%s: asset content is not served in SPA mode", p)
+		}
+	}
+}
+
+func TestHttpHandlerCanonical(t *testing.T) {
+	plain := HTTPHandlerWithPrefix("/site")
+	canonical := HTTPHandlerWithPrefix("/site", WithCanonicalRedirects())
+	clean := HTTPHandlerWithPrefix("/site", WithCleanURLs())
+	serve := func(handler func(http.ResponseWriter, *http.Request), reqPath string) *httptest.ResponseRecorder {
+		rr := httptest.NewRecorder()
+		handler(rr, httptest.NewRequest("GET", reqPath, nil))
+		return rr
+	}
+	for p, asset := range allFiles() {
+		assetURL := path.Join("/site", p)
+		if rr := serve(plain, assetURL); rr.Code != http.StatusOK || !bytes.Equal(rr.Body.Bytes(), asset.Bytes()) {
+			t.Fatalf("%s: expected content with status %d, got %d", assetURL, http.StatusOK, rr.Code)
+		}
+		if path.Base(p) == "index.html" {
+			dirURL := path.Dir(assetURL)
+			if location := redirectLocation(serve(canonical, assetURL), assetURL); location != dirURL+"/" {
+				t.Fatalf("%s: expected redirect to %s/, got %q", assetURL, dirURL, location)
+			}
+			if location := redirectLocation(serve(canonical, dirURL), dirURL); location != dirURL+"/" {
+				t.Fatalf("%s: expected redirect to %s/, got %q", dirURL, dirURL, location)
+			}
+			if rr := serve(canonical, dirURL+"/"); rr.Code != http.StatusOK || !bytes.Equal(rr.Body.Bytes(), asset.Bytes()) {
+				t.Fatalf("%s/: expected content with status %d, got %d", dirURL, http.StatusOK, rr.Code)
+			}
+			continue
+		}
+		if rr := serve(canonical, assetURL); rr.Code != http.StatusOK {
+			t.Fatalf("%s: expected status %d, got %d", assetURL, http.StatusOK, rr.Code)
+		}
+		if !strings.HasSuffix(p, ".html") {
+			continue
+		}
+		cleanURL := strings.TrimSuffix(assetURL, ".html")
+		if _, exists := lookupFile(strings.TrimSuffix(p, ".html")); exists {
+			continue
+		}
+		if rr := serve(clean, cleanURL); rr.Code != http.StatusOK || !bytes.Equal(rr.Body.Bytes(), asset.Bytes()) {
+			t.Fatalf("%s: expected %s content with status %d, got %d", cleanURL, p, http.StatusOK, rr.Code)
+		}
+		if location := redirectLocation(serve(clean, assetURL), assetURL); location != cleanURL {
+			t.Fatalf("%s: expected redirect to %s, got %q", assetURL, cleanURL, location)
+		}
+	}
+	// the query is kept
+	rr := httptest.NewRecorder()
+	canonical(rr, httptest.NewRequest("GET", "/site/index.html?q=1", nil))
+	if _, ok := lookupFile("index.html"); ok && rr.Header().Get("Location") != "./?q=1" {
+		t.Fatalf("expected redirect to ./?q=1, got %q", rr.Header().Get("Location"))
+	}
+}
+
+func TestHttpHandlerErrorPages(t *testing.T) {
+	handler := HTTPHandlerWithPrefix("/site")
+	for p, asset := range allFiles() {
+		if path.Base(p) != "404.html" && path.Base(p) != "405.html" {
+			continue
+		}
+		method, status := "GET", http.StatusNotFound
+		if path.Base(p) == "405.html" {
+			method, status = "POST", http.StatusMethodNotAllowed
+		}
+		reqURL := path.Join("/site", path.Dir(p), randomName)
+		rr := httptest.NewRecorder()
+		handler(rr, httptest.NewRequest(method, reqURL, nil))
+		if rr.Code != status || !bytes.Equal(rr.Body.Bytes(), asset.Bytes()) {
+			t.Fatalf("%s %s: expected %s content with status %d, got %d", method, reqURL, p, status, rr.Code)
+		}
+		req := httptest.NewRequest(method, reqURL, nil)
+		req.Header.Set("Accept", "application/json")
+		rr = httptest.NewRecorder()
+		handler(rr, req)
+		if rr.Code != status || strings.HasPrefix(rr.Header().Get("Content-Type"), "text/html") {
+			t.Fatalf("%s %s: expected plain text reply with status %d, got %d %q", method, reqURL, status, rr.Code, rr.Header().Get("Content-Type"))
+		}
+	}
+	rr := httptest.NewRecorder()
+	handler(rr, httptest.NewRequest("DELETE", "/site/", nil))
+	if rr.Code != http.StatusMethodNotAllowed || rr.Header().Get("Allow") != "GET, HEAD, OPTIONS" {
+		t.Fatalf("expected status %d with Allow header, got %d %q", http.StatusMethodNotAllowed, rr.Code, rr.Header().Get("Allow"))
+	}
+	for _, tc := range []struct {
+		accept string
+		html   bool
+	}{
+		{"", true},
+		{"text/html", true},
+		{"text/html;q=0", false},
+		{"application/json", false},
+		{"application/json, */*;q=0.1", true},
+		{"text/*;q=0, */*", false},
+		{"text/html;level=1;q=0.5, application/json", true},
+	} {
+		req := httptest.NewRequest("GET", "/", nil)
+		if tc.accept != "" {
+			req.Header.Set("Accept", tc.accept)
+		}
+		if acceptsHTML(req) != tc.html {
+			t.Fatalf("Accept: %q: expected %v", tc.accept, tc.html)
+		}
+	}
+}
+
+func TestHttpHandlerSecurityHeaders(t *testing.T) {
+	defer func(headers []SecurityHeader) { SecurityHeaders = headers }(SecurityHeaders)
+	SecurityHeaders = nil
+	custom := HTTPHandlerWithPrefix("/", WithSecurityHeaders(
+		SecurityHeader{Pattern: "*.html", Name: "content-security-policy", Value: "default-src 'self'"},
+		SecurityHeader{Pattern: "type:text/*", Name: "X-Frame-Options", Value: ""},
+		SecurityHeader{Name: "Strict-Transport-Security", Value: "max-age=63072000"},
+	))
+	for p, asset := range allFiles() {
+		expected := make(map[string]string)
+		for _, sh := range DefaultSecurityHeaders {
+			expected[sh.Name] = sh.Value
+		}
+		if !matchAsset("", p, asset.MimeType()) {
+			t.Fatalf("%s: empty pattern must match", p)
+		}
+		rr := httptest.NewRecorder()
+		ServeHTTP(rr, httptest.NewRequest("GET", path.Join("/", p), nil))
+		for name, value := range expected {
+			if rr.Header().Get(name) != value {
+				t.Fatalf("%s: expected %s: %q, got %q", p, name, value, rr.Header().Get(name))
+			}
+		}
+		if matchAsset("*.html", p, asset.MimeType()) {
+			expected["Content-Security-Policy"] = "default-src 'self'"
+		}
+		if matchAsset("type:text/*", p, asset.MimeType()) {
+			expected["X-Frame-Options"] = ""
+		}
+		expected["Strict-Transport-Security"] = "max-age=63072000"
+		rr = httptest.NewRecorder()
+		custom(rr, httptest.NewRequest("GET", path.Join("/", p), nil))
+		for name, value := range expected {
+			if v, ok := rr.Header()[name]; (value != "" && rr.Header().Get(name) != value) || (value == "" && ok) {
+				t.Fatalf("%s: expected %s: %q, got %q", p, name, value, v)
+			}
+		}
+	}
+	// headers with empty patterns are sent with any response
+	rr := httptest.NewRecorder()
+	custom(rr, httptest.NewRequest("POST", "/", nil))
+	if rr.Header().Get("Strict-Transport-Security") != "max-age=63072000" || rr.Header().Get("X-Content-Type-Options") != "nosniff" {
+		t.Fatalf("expected security headers with %d reply, got %v", rr.Code, rr.Header())
+	}
+}
+
+func TestHandler(t *testing.T) {
+	var names []string
+	for p := range allFiles() {
+		names = append(names, p)
+	}
+	if len(names) == 0 {
+		return
+	}
+	sort.Strings(names)
+	name, content := names[0], Must(names[0]).Bytes()
+	missing := path.Join("/static", randomName)
+	next := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+	for _, tc := range []struct {
+		handler http.Handler
+		method  string
+		path    string
+		status  int
+		allow   string
+	}{
+		{http.StripPrefix("/static", NewHandler()), "GET", path.Join("/static", name), http.StatusOK, ""},
+		{http.StripPrefix("/static/", NewHandler()), "GET", path.Join("/static", name), http.StatusOK, ""},
+		{NewHandler(WithPrefix("/static")), "GET", path.Join("/static", name), http.StatusOK, ""},
+		{NewHandler(WithPrefix("/static")), "GET", path.Join("/other", name), http.StatusNotFound, ""},
+		{NewHandler(WithPrefix("/static")), "OPTIONS", path.Join("/static", name), http.StatusNoContent, "GET, HEAD, OPTIONS"},
+		{NewHandler(WithPrefix("/static")), "PUT", path.Join("/static", name), http.StatusMethodNotAllowed, "GET, HEAD, OPTIONS"},
+		{NewHandler(WithPrefix("/static"), WithMethods("get", "POST")), "GET", path.Join("/static", name), http.StatusOK, ""},
+		{NewHandler(WithPrefix("/static"), WithMethods("get", "POST")), "HEAD", path.Join("/static", name), http.StatusMethodNotAllowed, "GET"},
+		{NewHandler(WithPrefix("/static"), WithMethods("get", "POST")), "POST", path.Join("/static", name), http.StatusMethodNotAllowed, "GET"},
+		{NewHandler(WithPrefix("/static"), WithMethods("GET", "HEAD")), "OPTIONS", path.Join("/static", name), http.StatusMethodNotAllowed, "GET, HEAD"},
+		{NewHandler(WithPrefix("/static"), WithMethods("POST", "PUT")), "GET", path.Join("/static", name), http.StatusMethodNotAllowed, ""},
+		{NewHandler(WithPrefix("/static"), WithMethods("POST", "PUT")), "PUT", path.Join("/static", name), http.StatusMethodNotAllowed, ""},
+		{NewHandler(WithPrefix("/static"), WithMethods("POST", "PUT")), "OPTIONS", path.Join("/static", name), http.StatusMethodNotAllowed, ""},
+		{NewHandler(WithPrefix("/static"), WithNotFoundHandler(next)), "GET", missing, http.StatusTeapot, ""},
+		{NewHandler(WithPrefix("/static"), WithNotFoundHandler(next)), "GET", path.Join("/other", name), http.StatusTeapot, ""},
+		{NewHandler(WithPrefix("/static"), WithNotFoundHandler(next)), "PUT", missing, http.StatusMethodNotAllowed, "GET, HEAD, OPTIONS"},
+		{NewHandler(WithPrefix("/static"), WithNext(next)), "GET", path.Join("/static", name), http.StatusOK, ""},
+		{NewHandler(WithPrefix("/static"), WithNext(next)), "GET", missing, http.StatusTeapot, ""},
+		{NewHandler(WithPrefix("/static"), WithNext(next)), "GET", path.Join("/other", name), http.StatusTeapot, ""},
+		{NewHandler(WithPrefix("/static"), WithNext(next)), "POST", path.Join("/static", name), http.StatusTeapot, ""},
+	} {
+		rr := httptest.NewRecorder()
+		tc.handler.ServeHTTP(rr, httptest.NewRequest(tc.method, tc.path, nil))
+		if rr.Code != tc.status || rr.Header().Get("Allow") != tc.allow {
+			t.Fatalf("%s %s: expected %d with Allow %q, got %d with Allow %q", tc.method, tc.path, tc.status, tc.allow, rr.Code, rr.Header().Get("Allow"))
+		}
+		if _, ok := rr.Header()["Allow"]; tc.status == http.StatusMethodNotAllowed && !ok {
+			t.Fatalf("%s %s: expected Allow header with %d reply", tc.method, tc.path, tc.status)
+		}
+		if tc.status == http.StatusOK && tc.method == "GET" && !bytes.Equal(rr.Body.Bytes(), content) {
+			t.Fatalf("%s %s: unexpected content", tc.method, tc.path)
+		}
+		if tc.status == http.StatusTeapot && rr.Header().Get("X-Content-Type-Options") != "" {
+			t.Fatalf("%s %s: security headers are sent with the next handler reply", tc.method, tc.path)
+		}
+	}
+}
+
+// redirectLocation returns the URL path the response redirects the request URL path to,
+// or an empty string if it is not a permanent redirect
+func redirectLocation(rr *httptest.ResponseRecorder, reqPath string) string {
+	if rr.Code != http.StatusMovedPermanently {
+		return ""
+	}
+	location, err := url.Parse(rr.Header().Get("Location"))
+	if err != nil {
+		return ""
+	}
+	return (&url.URL{Path: reqPath}).ResolveReference(location).Path
+}
+
+func TestHttpHandlerDirectoryListing(t *testing.T) {
+	dirs := make(map[string]bool)
+	for p := range allFiles() {
+		for dir := path.Dir(p); ; dir = path.Dir(dir) {
+			if dir == "." {
+				dir = ""
+			}
+			if _, ok := allFiles()[path.Join(dir, "index.html")]; !ok {
+				dirs[dir] = true
+			}
+			if dir == "" {
+				break
+			}
+		}
+	}
+	listing := HTTPHandlerWithPrefix("/files", WithDirectoryListing())
+	plain := HTTPHandlerWithPrefix("/files")
+	for dir := range dirs {
+		d, ok := lookupDir(dir)
+		if !ok {
+			t.Fatalf("%q: directory not found", dir)
+		}
+		dirURL := path.Join("/files", dir) + "/"
+		rr := httptest.NewRecorder()
+		plain(rr, httptest.NewRequest("GET", dirURL, nil))
+		if rr.Code != http.StatusNotFound {
+			t.Fatalf("%s: expected status %d without listing, got %d", dirURL, http.StatusNotFound, rr.Code)
+		}
+		rr = httptest.NewRecorder()
+		listing(rr, httptest.NewRequest("GET", strings.TrimSuffix(dirURL, "/"), nil))
+		if location := redirectLocation(rr, strings.TrimSuffix(dirURL, "/")); location != dirURL {
+			t.Fatalf("%s: expected redirect to %s, got %d %q", dirURL, dirURL, rr.Code, location)
+		}
+		rr = httptest.NewRecorder()
+		listing(rr, httptest.NewRequest("GET", dirURL, nil))
+		if rr.Code != http.StatusOK || !strings.HasPrefix(rr.Header().Get("Content-Type"), "text/html") {
+			t.Fatalf("%s: expected HTML listing, got %d %q", dirURL, rr.Code, rr.Header().Get("Content-Type"))
+		}
+		for i := range d.files {
+			if !strings.Contains(rr.Body.String(), ">"+d.files[i].name+"</a>") {
+				t.Fatalf("%s: %s is not listed", dirURL, d.files[i].name)
+			}
+		}
+		req := httptest.NewRequest("GET", dirURL+"?sort=size&order=desc", nil)
+		req.Header.Set("Accept", "application/json")
+		rr = httptest.NewRecorder()
+		listing(rr, req)
+		var result struct {
+			Path    string
+			Entries []struct {
+				Name     string
+				Dir      bool
+				Size     int64
+				MimeType string
+			}
+		}
+		if err := json.Unmarshal(rr.Body.Bytes(), &result); err != nil {
+			t.Fatalf("%s: malformed JSON listing: %s", dirURL, err)
+		}
+		if result.Path != dirURL || len(result.Entries) != len(d.dirs)+len(d.files) {
+			t.Fatalf("%s: unexpected JSON listing %+v", dirURL, result)
+		}
+		for i, e := range result.Entries {
+			if e.Dir {
+				continue
+			}
+			asset := allFiles()[path.Join(dir, e.Name)]
+			if asset == nil || asset.Size() != e.Size || asset.MimeType() != e.MimeType {
+				t.Fatalf("%s: unexpected entry %+v", dirURL, e)
+			}
+			if i > 0 && !result.Entries[i-1].Dir && result.Entries[i-1].Size < e.Size {
+				t.Fatalf("%s: entries are not sorted by size", dirURL)
+			}
+		}
+	}
+	// listing is limited to the prefixes given
+	limited := HTTPHandlerWithPrefix("/files", WithDirectoryListing("/files/"+randomName+"/"))
+	for dir := range dirs {
+		rr := httptest.NewRecorder()
+		limited(rr, httptest.NewRequest("GET", path.Join("/files", dir)+"/", nil))
+		if rr.Code != http.StatusNotFound {
+			t.Fatalf("%s: unexpected listing", dir)
+		}
+	}
+}
+
+func TestHttpHandlerRange(t *testing.T) {
+	handler := http.HandlerFunc(HTTPHandlerWithPrefix("/"))
+	serve := func(p string, header ...string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", path.Join("/", p), nil)
+		for i := 0; i < len(header); i += 2 {
+			req.Header.Set(header[i], header[i+1])
+		}
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+	for p, asset := range allFiles() {
+		if path.Base(p) == "404.html" {
+			continue
+		}
+		for _, enc := range []string{"identity", "gzip"} {
+			// representation which is sent for the encoding
+			content := asset.Bytes()
+			if enc == "gzip" && asset.isCompressed {
+				content = asset.blob
+			}
+			size := len(content)
+			if size < 4 {
+				continue
+			}
+			rr := serve(p, "Accept-Encoding", enc, "Range", "bytes=1-2")
+			if rr.Code != http.StatusPartialContent {
+				t.Fatalf("%s: expected status %d, got %d", p, http.StatusPartialContent, rr.Code)
+			}
+			if cr := rr.Header().Get("Content-Range"); cr != fmt.Sprintf("bytes 1-2/%d", size) {
+				t.Fatalf("%s: unexpected Content-Range %s", p, cr)
+			}
+			if !bytes.Equal(rr.Body.Bytes(), content[1:3]) {
+				t.Fatalf("%s: range content differs", p)
+			}
+			if rr = serve(p, "Accept-Encoding", enc, "Range", "bytes=-2"); !bytes.Equal(rr.Body.Bytes(), content[size-2:]) {
+				t.Fatalf("%s: suffix range content differs", p)
+			}
+			rr = serve(p, "Accept-Encoding", enc, "Range", fmt.Sprintf("bytes=0-0,2-%d", size+10))
+			if rr.Code != http.StatusPartialContent {
+				t.Fatalf("%s: expected status %d, got %d", p, http.StatusPartialContent, rr.Code)
+			}
+			if cl := rr.Header().Get("Content-Length"); cl != fmt.Sprint(rr.Body.Len()) {
+				t.Fatalf("%s: Content-Length %s does not match body length %d", p, cl, rr.Body.Len())
+			}
+			mt, params, err := mime.ParseMediaType(rr.Header().Get("Content-Type"))
+			if err != nil || mt != "multipart/byteranges" {
+				t.Fatalf("%s: unexpected content type %s", p, rr.Header().Get("Content-Type"))
+			}
+			mr := multipart.NewReader(rr.Body, params["boundary"])
+			for _, want := range []struct {
+				cr   string
+				data []byte
+			}{
+				{fmt.Sprintf("bytes 0-0/%d", size), content[:1]},
+				{fmt.Sprintf("bytes 2-%d/%d", size-1, size), content[2:]},
+			} {
+				part, err := mr.NextPart()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if cr := part.Header.Get("Content-Range"); cr != want.cr {
+					t.Fatalf("%s: unexpected part Content-Range %s, expected %s", p, cr, want.cr)
+				}
+				if data, err := ioutil.ReadAll(part); err != nil || !bytes.Equal(data, want.data) {
+					t.Fatalf("%s: part content differs", p)
+				}
+			}
+			if _, err = mr.NextPart(); err != io.EOF {
+				t.Fatalf("%s: unexpected part", p)
+			}
+			rr = serve(p, "Accept-Encoding", enc, "Range", fmt.Sprintf("bytes=%d-", size))
+			if rr.Code != http.StatusRequestedRangeNotSatisfiable {
+				t.Fatalf("%s: expected status %d, got %d", p, http.StatusRequestedRangeNotSatisfiable, rr.Code)
+			}
+			if cr := rr.Header().Get("Content-Range"); cr != fmt.Sprintf("bytes */%d", size) {
+				t.Fatalf("%s: unexpected Content-Range %s", p, cr)
+			}
+			etag := serve(p, "Accept-Encoding", enc).Header().Get("Etag")
+			for ifRange, code := range map[string]int{
+				etag:                                                          http.StatusPartialContent,
+				"W/" + etag:                                                   http.StatusOK,
+				fmt.Sprintf("%q", randomName):                                 http.StatusOK,
+				asset.ModTime().UTC().Format(http.TimeFormat):                 http.StatusPartialContent,
+				asset.ModTime().Add(-time.Hour).UTC().Format(http.TimeFormat): http.StatusOK,
+			} {
+				rr = serve(p, "Accept-Encoding", enc, "Range", "bytes=1-2", "If-Range", ifRange)
+				if rr.Code != code {
+					t.Fatalf("%s: If-Range %s: expected status %d, got %d", p, ifRange, code, rr.Code)
+				}
+			}
+		}
+	}
+}
+
+func TestLiveReload(t *testing.T) {
+	handler := LiveReload(http.HandlerFunc(HTTPHandlerWithPrefix("/")))
+	for p, asset := range allFiles() {
+		req, err := http.NewRequest("GET", path.Join("/", p), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Accept-Encoding", "gzip")
+		req.Header.Set("Range", "bytes=0-0")
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		if req.Header.Get("Accept-Encoding") != "gzip" || req.Header.Get("Range") != "bytes=0-0" {
+			t.Fatalf("handler modified request headers for %s: %v", p, req.Header)
+		}
+		if status := rr.Code; status != http.StatusOK {
+			t.Fatalf("handler returned wrong status code for %s: got %v want %v", p, status, http.StatusOK)
+		}
+		body := rr.Body.String()
+		if l := rr.Header().Get("Content-Length"); l != "" && l != fmt.Sprint(len(body)) {
+			t.Fatalf("handler returned wrong content length for %s", p)
+		}
+		if strings.HasPrefix(asset.mime, "text/html") {
+			if !strings.Contains(body, liveReloadScript) {
+				t.Fatalf("live reload script is not injected into %s", p)
+			}
+			for _, h := range []string{"Etag", "Last-Modified", "Accept-Ranges"} {
+				if v := rr.Header().Get(h); v != "" {
+					t.Fatalf("handler returned %s %q with injected content of %s", h, v, p)
+				}
+			}
+			body = strings.Replace(body, liveReloadScript, "", 1)
+		}
+		if getTag([]byte(body)) != asset.tag {
+			t.Fatalf("handler returned content doesn't match with recorded for %s", p)
+		}
+	}
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+	resp, err := http.Get(srv.URL + LiveReloadPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	events := bufio.NewReader(resp.Body)
+	expect := func(event string) {
+		line, err := events.ReadString('\n')
+		for err == nil && line == "\n" {
+			line, err = events.ReadString('\n')
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if line != "event: "+event+"\n" {
+			t.Fatalf("expected %s event, got %q", event, line)
+		}
+		if line, err = events.ReadString('\n'); err != nil || !strings.HasPrefix(line, "data: ") {
+			t.Fatalf("expected event data, got %q", line)
+		}
+	}
+	expect("boot")
+	notifyLiveReload()
+	expect("reload")
+}
 func TestHttpFileSystem(t *testing.T) {
 	FS().Walk("", func(p string, info os.FileInfo, err error) error {
 		if err != nil || info.IsDir() {
@@ -8,12 +8,14 @@ import (
 	"io"
 	"bytes"
 	"path/filepath"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"hash/crc64"
+	"sort"
 {{- if .Params.BuildHttpHandlerAPI }}
 	"strconv"
 {{- end }}
-{{- if .Params.BuildFsAPI }}
-	"sort"
-{{- end }}
 {{- if or .Params.BuildHttpFsAPI .Params.BuildHttpHandlerAPI }}
 	"net/http"
 {{- end }}
@@ -22,22 +24,46 @@ import (
 {{- end }}
 {{- if .Params.BuildHttpHandlerAPI }}
 	"strings"
+	"log"
+	"mime/multipart"
+	"net/textproto"
+	"encoding/json"
+	"html"
+	"net/url"
 {{- end }}
 {{- if .Params.CompressAssets }}
 	"compress/gzip"
 {{- end }}
-{{- if or .Params.BuildFsAPI .Params.CompressAssets }}
+{{- if .Params.CompressAssets }}
+	"compress/flate"
+{{- end }}
+{{- if or .Params.BuildFsAPI .Params.CompressAssets .Params.BuildHttpHandlerAPI }}
 	"io/ioutil"
 {{- end }}
 {{- if .Params.BuildMain }}
 	"flag"
+{{- end }}
+{{- if or .Encrypted .Params.BuildHttpHandlerAPI .Params.CompressAssets }}
+	"sync"
+{{- end }}
+{{- if .Params.CompressAssets }}
+	"container/list"
+{{- end }}
+{{- if .Encrypted }}
+	"crypto/aes"
+	"crypto/cipher"
+	"sync/atomic"
+{{- end }}
+{{- if .Signed }}
+	"crypto/ed25519"
+	"crypto/sha256"
+{{- end }}
+{{- if or .Signed (and .Params.BuildMain .Encrypted) }}
+	"encoding/hex"
 {{- end }}
 	"time"
 )
 
-func blob_bytes(uint32) []byte
-func blob_string(uint32) string
-
 // Asset represents binary resource stored within Go executable. Asset implements
 // fmt.Stringer and io.WriterTo interfaces, decompressing binary data if necessary.
 type Asset struct {
@@ -47,10 +73,27 @@ type Asset struct {
 	str_blob     string // Resource blob as a string
 {{- if .Params.CompressAssets }}
 	isCompressed bool   // true if resources was compressed with gzip
+	chunks       []uint32 // offsets of independently compressed chunks within the gzip stream
+{{- end}}
+{{- if .Encrypted }}
+	isEncrypted  bool   // true if resource was encrypted with AES-GCM
+{{- end}}
+{{- if and .Encrypted .Signed }}
+	sealed       []byte // Encrypted resource blob, once the asset is unlocked
+{{- end}}
+{{- if .Signed }}
+	digest       string // SHA-256 digest of the resource blob
 {{- end}}
 	mime         string // MIME Type
 	tag          string // Tag is essentially a Tag of resource content and can be used as a value for "Etag" HTTP header
+	modTime      time.Time // Modification time of the source file, set in development build only
 }
+{{- if .Params.CompressAssets }}
+
+// chunkSize is the amount of uncompressed content in every independently
+// compressed chunk of a compressed asset
+const chunkSize = 64 << 10
+{{- end }}
 
 // Name returns the base name of the asset
 func (a *Asset) Name() string       { return a.name }
@@ -62,33 +105,82 @@ func (a *Asset) Tag() string        { return a.tag  }
 // IsCompressed returns true of asset has been compressed
 func (a *Asset) IsCompressed() bool { return a.isCompressed }
 {{- end }}
-// String returns (uncompressed, if necessary) content of asset as a string
+{{- if .Encrypted }}
+// IsEncrypted returns true if asset has been encrypted
+func (a *Asset) IsEncrypted() bool  { return a.isEncrypted }
+{{- end }}
+// String returns (uncompressed, if necessary) content of asset as a string.
+// Use ReadAll to detect corrupted content.
 func (a *Asset) String() string {
+{{- if .Encrypted }}
+	if a.locked() {
+		return ""
+	}
+{{- end }}
 {{- if .Params.CompressAssets }}
 	if a.isCompressed {
-		ungzip, _ := gzip.NewReader(bytes.NewReader(a.blob))
-		ret, _ := ioutil.ReadAll(ungzip)
-		ungzip.Close()
+		if s, ok := cacheGet(a); ok {
+			return s
+		}
+		ret, _ := a.decompress()
 		return string(ret)
 	}
 {{- end }}
 	return a.str_blob
 }
 
-// Bytes returns (uncompressed) content of asset as a []byte
+// Bytes returns (uncompressed) content of asset as a []byte.
+// Use ReadAll to detect corrupted content.
 func (a *Asset) Bytes() []byte {
+	ret, _ := a.ReadAll()
+	return ret
+}
+
+// ReadAll returns (uncompressed) content of asset as a []byte, the same as Bytes,
+// but reports an error if content can not be decompressed.
+func (a *Asset) ReadAll() ([]byte, error) {
+{{- if .Encrypted }}
+	if a.locked() {
+		return nil, ErrLocked
+	}
+{{- end }}
 {{- if .Params.CompressAssets }}
 	if a.isCompressed {
-		ungzip, _ := gzip.NewReader(bytes.NewReader(a.blob))
-		ret, _ := ioutil.ReadAll(ungzip)
-		ungzip.Close()
-		return ret
+		if s, ok := cacheGet(a); ok {
+			return []byte(s), nil
+		}
+		return a.decompress()
 	}
 {{- end }}
 	ret := make([]byte, len(a.blob))
 	copy(ret, a.blob)
+	return ret, nil
+}
+{{- if .Params.CompressAssets }}
+
+// Encoding returns the content coding of the stored content, as used in
+// "Content-Encoding" HTTP header: "gzip" for compressed assets, "" otherwise.
+func (a *Asset) Encoding() string {
+	if a.isCompressed {
+		return "gzip"
+	}
+	return ""
+}
+
+// CompressedReader returns the stored content of the asset as is, without
+// decompression: a standard gzip stream for compressed assets, the plain content
+// otherwise (see Encoding). Content is read straight from the executable.
+func (a *Asset) CompressedReader() io.ReadCloser {
+{{- if .Encrypted }}
+	if a.locked() {
+		return errorReader{ErrLocked}
+	}
+{{- end }}
+	ret := &assetReader{}
+	ret.Reset(a.blob)
 	return ret
 }
+{{- end }}
 {{- if .Params.BuildRawBytesAPI }}
 // RawBytes returns a raw byte slice of the asset. Changing content of slice will result into segfault.
 func (a *Asset) RawBytes() []byte {
@@ -100,8 +192,14 @@ func (a *Asset) RawBytes() []byte {
 func (a *Asset) Size() int64        { return int64(a.size) }
 // Mode implements os.FileInfo and always returns 0444
 func (a *Asset) Mode() os.FileMode  { return 0444 }
-// ModTime implements os.FileInfo and returns the time stamp when this package has been produced (the same value for all the assets)
-func (a *Asset) ModTime() time.Time { return stamp }
+// ModTime implements os.FileInfo and returns the time stamp when this package has been produced (the same value for all the assets),
+// or modification time of the source file in development build
+func (a *Asset) ModTime() time.Time {
+	if !a.modTime.IsZero() {
+		return a.modTime
+	}
+	return stamp
+}
 // IsDir implements os.FileInfo and returns false
 func (a *Asset) IsDir() bool        { return false }
 // Sys implements os.FileInfo and returns nil
@@ -109,9 +207,21 @@ func (a *Asset) Sys() interface{}   { return a }
 
 // WriteTo implements io.WriterTo interface and writes content of the asset to w
 func (a *Asset) WriteTo(w io.Writer) (int64, error) {
+{{- if .Encrypted }}
+	if a.locked() {
+		return 0, ErrLocked
+	}
+{{- end }}
 {{- if .Params.CompressAssets }}
 	if a.isCompressed {
-		ungzip, _ := gzip.NewReader(bytes.NewReader(a.blob))
+		if s, ok := cacheGet(a); ok {
+			n, err := io.WriteString(w, s)
+			return int64(n), err
+		}
+		ungzip, err := gzip.NewReader(bytes.NewReader(a.blob))
+		if err != nil {
+			return 0, err
+		}
 		n, err := io.Copy(w, ungzip)
 		ungzip.Close()
 		return n, err
@@ -121,6 +231,58 @@ func (a *Asset) WriteTo(w io.Writer) (int64, error) {
 	return int64(n), err
 }
 
+// ErrCorrupted is returned by Verify if asset content does not match the recorded tag
+var ErrCorrupted = errors.New("asset content does not match its tag")
+
+var (
+	b32Enc   = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+	crcTable = crc64.MakeTable(crc64.ECMA)
+)
+
+// Verify checks integrity of the asset: decompresses content, if necessary, and
+// compares its checksum with the recorded tag. Verify returns ErrCorrupted if
+// checksum does not match, or decompression error.
+func (a *Asset) Verify() error {
+	crc := crc64.New(crcTable)
+	r := a.Reader()
+	defer r.Close()
+	if _, err := io.Copy(crc, r); err != nil {
+		return err
+	}
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], crc.Sum64())
+	if b32Enc.EncodeToString(buf[:]) != a.tag {
+		return ErrCorrupted
+	}
+	return nil
+}
+
+// VerifyError describes an asset failed integrity check
+type VerifyError struct {
+	Path string // Asset path
+	Err  error  // Error returned by Asset.Verify
+}
+
+func (e *VerifyError) Error() string { return e.Path + ": " + e.Err.Error() }
+
+// VerifyAll checks integrity of all the assets with Asset.Verify and returns
+// a list of *VerifyError, ordered by asset path, for the assets failed the check.
+func VerifyAll() []error {
+	files := allFiles()
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var errs []error
+	for _, name := range names {
+		if err := files[name].Verify(); err != nil {
+			errs = append(errs, &VerifyError{Path: name, Err: err})
+		}
+	}
+	return errs
+}
+
 type assetReader struct {
 	bytes.Reader
 }
@@ -130,11 +292,73 @@ func (r *assetReader) Close() error {
 	return nil
 }
 
+type errorReader struct {
+	err error
+}
+
+func (r errorReader) Read([]byte) (int, error)          { return 0, r.err }
+func (r errorReader) ReadAt([]byte, int64) (int, error) { return 0, r.err }
+func (r errorReader) Seek(int64, int) (int64, error)    { return 0, r.err }
+func (r errorReader) Close() error                      { return nil }
+
+// AssetReader is an opened asset content. Unlike io.ReadCloser returned
+// by Asset.Reader, it can seek and read at arbitrary offsets.
+type AssetReader interface {
+	io.Reader
+	io.ReaderAt
+	io.Seeker
+	io.Closer
+}
+
+// Open returns content of the asset as AssetReader, decompressing it if necessary.
+// Uncompressed content is read straight from the executable without copying.
+func (a *Asset) Open() AssetReader {
+{{- if .Encrypted }}
+	if a.locked() {
+		return errorReader{ErrLocked}
+	}
+{{- end }}
+{{- if .Params.CompressAssets }}
+	if a.isCompressed {
+		return &compressedReader{asset: a}
+	}
+{{- end }}
+	ret := &assetReader{}
+	ret.Reset(a.blob)
+	return ret
+}
+
+// ReaderAt returns content of the asset as io.SectionReader, which can be passed to
+// APIs such as archive/zip.NewReader. Uncompressed content is read straight from
+// the executable without copying, compressed content is decompressed on demand.
+func (a *Asset) ReaderAt() *io.SectionReader {
+{{- if .Encrypted }}
+	if a.locked() {
+		return io.NewSectionReader(errorReader{ErrLocked}, 0, int64(a.size))
+	}
+{{- end }}
+	var r io.ReaderAt = bytes.NewReader(a.blob)
+{{- if .Params.CompressAssets }}
+	if a.isCompressed {
+		r = &compressedReader{asset: a}
+	}
+{{- end }}
+	return io.NewSectionReader(r, 0, int64(a.size))
+}
+
 // Returns content of the asset as io.ReaderCloser.
 func (a *Asset) Reader() io.ReadCloser {
+{{- if .Encrypted }}
+	if a.locked() {
+		return errorReader{ErrLocked}
+	}
+{{- end }}
 {{- if .Params.CompressAssets }}
 	if a.isCompressed {
-		ungzip, _ := gzip.NewReader(bytes.NewReader(a.blob))
+		ungzip, err := gzip.NewReader(bytes.NewReader(a.blob))
+		if err != nil {
+			return errorReader{err}
+		}
 		return ungzip
 	} else {
 {{- end }}
@@ -146,6 +370,358 @@ func (a *Asset) Reader() io.ReadCloser {
 {{- end }}
 }
 
+{{- if .Params.CompressAssets }}
+
+// compressedReader decompresses the asset content starting from the chunk
+// containing the current position, so seeking costs at most one chunk to skip.
+type compressedReader struct {
+	asset  *Asset
+	pos    int64         // current position
+	zr     io.ReadCloser // decompressor positioned at zpos, if any
+	zpos   int64
+	closed bool
+}
+
+// chunkReader returns decompressor of the asset content positioned at offset off,
+// resetting zr, if not nil
+func (a *Asset) chunkReader(zr io.ReadCloser, off int64) (io.ReadCloser, error) {
+	i := int(off / chunkSize)
+	if i >= len(a.chunks) {
+		return nil, io.ErrUnexpectedEOF
+	}
+	src := bytes.NewReader(a.blob[a.chunks[i]:])
+	if zr == nil {
+		zr = flate.NewReader(src)
+	} else if err := zr.(flate.Resetter).Reset(src, nil); err != nil {
+		return nil, err
+	}
+	if _, err := io.CopyN(ioutil.Discard, zr, off-int64(i)*chunkSize); err != nil {
+		return nil, err
+	}
+	return zr, nil
+}
+
+func (r *compressedReader) Read(p []byte) (int, error) {
+	if r.closed {
+		return 0, os.ErrClosed
+	}
+	if r.pos >= int64(r.asset.size) {
+		return 0, io.EOF
+	}
+	if r.zr == nil || r.zpos != r.pos {
+		zr, err := r.asset.chunkReader(r.zr, r.pos)
+		if err != nil {
+			r.zr = nil
+			return 0, err
+		}
+		r.zr, r.zpos = zr, r.pos
+	}
+	if rest := int64(r.asset.size) - r.pos; int64(len(p)) > rest {
+		p = p[:rest]
+	}
+	n, err := io.ReadFull(r.zr, p)
+	r.pos += int64(n)
+	r.zpos = r.pos
+	if err != nil {
+		r.zr = nil
+	}
+	return n, err
+}
+
+// ReadAt implements io.ReaderAt. It neither uses nor changes the current
+// position, so it is safe to call ReadAt concurrently.
+func (r *compressedReader) ReadAt(p []byte, off int64) (int, error) {
+	if r.closed {
+		return 0, os.ErrClosed
+	}
+	if off < 0 {
+		return 0, os.ErrInvalid
+	}
+	size := int64(r.asset.size)
+	if off >= size {
+		return 0, io.EOF
+	}
+	zr, err := r.asset.chunkReader(nil, off)
+	if err != nil {
+		return 0, err
+	}
+	want := len(p)
+	if int64(want) > size-off {
+		p = p[:size-off]
+	}
+	n, err := io.ReadFull(zr, p)
+	if err == nil && n < want {
+		err = io.EOF
+	}
+	return n, err
+}
+
+func (r *compressedReader) Seek(offset int64, whence int) (int64, error) {
+	if r.closed {
+		return 0, os.ErrClosed
+	}
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.pos
+	case io.SeekEnd:
+		offset += int64(r.asset.size)
+	default:
+		return 0, os.ErrInvalid
+	}
+	if offset < 0 {
+		return 0, os.ErrInvalid
+	}
+	r.pos = offset
+	return offset, nil
+}
+
+func (r *compressedReader) Close() error {
+	if r.closed {
+		return os.ErrClosed
+	}
+	r.closed = true
+	r.zr = nil
+	return nil
+}
+
+// decompress returns decompressed content of the asset, adding it to the cache
+func (a *Asset) decompress() ([]byte, error) {
+	ungzip, err := gzip.NewReader(bytes.NewReader(a.blob))
+	if err != nil {
+		return nil, err
+	}
+	defer ungzip.Close()
+	data, err := ioutil.ReadAll(ungzip)
+	if err != nil {
+		return nil, err
+	}
+	cachePut(a, data)
+	return data, nil
+}
+
+// CacheStats holds the decompressed content cache counters
+type CacheStats struct {
+	Hits    uint64 // Number of lookups served from the cache
+	Misses  uint64 // Number of lookups which required decompression
+	Entries int    // Number of cached assets
+	Size    int64  // Total size of cached content
+	Limit   int64  // Cache size limit
+}
+
+type cacheEntry struct {
+	asset   *Asset
+	content string
+}
+
+// cache is an LRU cache of decompressed content of compressed assets
+var cache = struct {
+	sync.Mutex
+	limit   int64
+	size    int64
+	hits    uint64
+	misses  uint64
+	lru     *list.List // cacheEntry values, most recently used first
+	entries map[*Asset]*list.Element
+}{
+	lru:     list.New(),
+	entries: make(map[*Asset]*list.Element),
+}
+
+// SetCacheLimit enables caching of decompressed content of compressed assets,
+// which is then reused by String, Bytes, ReadAll, WriteTo and the HTTP handler,
+// and limits the total size of cached content. The least recently used content
+// is evicted first. Zero limit (the default) disables the cache and frees
+// cached content. SetCacheLimit is safe for concurrent use.
+func SetCacheLimit(limit int64) {
+	cache.Lock()
+	defer cache.Unlock()
+	if limit < 0 {
+		limit = 0
+	}
+	cache.limit = limit
+	cacheEvict()
+}
+
+// CacheStatistics returns the cache counters
+func CacheStatistics() CacheStats {
+	cache.Lock()
+	defer cache.Unlock()
+	return CacheStats{
+		Hits:    cache.hits,
+		Misses:  cache.misses,
+		Entries: len(cache.entries),
+		Size:    cache.size,
+		Limit:   cache.limit,
+	}
+}
+
+func cacheGet(a *Asset) (string, bool) {
+	cache.Lock()
+	defer cache.Unlock()
+	if cache.limit == 0 {
+		return "", false
+	}
+	if e, ok := cache.entries[a]; ok {
+		cache.hits++
+		cache.lru.MoveToFront(e)
+		return e.Value.(*cacheEntry).content, true
+	}
+	cache.misses++
+	return "", false
+}
+
+// cacheFits reports whether content of the asset can be cached
+func cacheFits(a *Asset) bool {
+	cache.Lock()
+	defer cache.Unlock()
+	return cache.limit > 0 && int64(a.size) <= cache.limit
+}
+
+func cachePut(a *Asset, data []byte) {
+	cache.Lock()
+	defer cache.Unlock()
+	if cache.limit == 0 || int64(len(data)) > cache.limit {
+		return
+	}
+	if _, ok := cache.entries[a]; ok {
+		return
+	}
+	cache.entries[a] = cache.lru.PushFront(&cacheEntry{asset: a, content: string(data)})
+	cache.size += int64(len(data))
+	cacheEvict()
+}
+
+func cacheEvict() {
+	for cache.size > cache.limit {
+		e := cache.lru.Back()
+		entry := e.Value.(*cacheEntry)
+		cache.lru.Remove(e)
+		delete(cache.entries, entry.asset)
+		cache.size -= int64(len(entry.content))
+	}
+}
+{{- end }}
+
+{{- if .Encrypted }}
+
+// ErrLocked is returned on attempt to read content of an encrypted asset
+// before Unlock has been called.
+var ErrLocked = errors.New("asset is locked")
+
+var (
+	unlockMu sync.Mutex
+	unlocked int32
+)
+
+// Unlock decrypts encrypted assets with the supplied AES key. Until Unlock
+// succeeds, content of encrypted assets is not available: Open, WriteTo and
+// readers return ErrLocked, the HTTP handler replies with 503 Service Unavailable,
+// while String and Bytes return empty content. Unlock fails without unlocking
+// anything if the key does not match any of encrypted assets, and does nothing
+// once succeeded.
+func Unlock(key []byte) error {
+	unlockMu.Lock()
+	defer unlockMu.Unlock()
+	if atomic.LoadInt32(&unlocked) != 0 {
+		return nil
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return err
+	}
+	plain := make(map[*Asset][]byte)
+	for name, a := range allFiles() {
+		if !a.isEncrypted {
+			continue
+		}
+		if len(a.blob) < aead.NonceSize() {
+			return errors.New("unable to decrypt asset " + name + ": content is corrupted")
+		}
+		nonce := a.blob[:aead.NonceSize()]
+		data, err := aead.Open(nil, nonce, a.blob[len(nonce):], []byte(name))
+		if err != nil {
+			return errors.New("unable to decrypt asset " + name + ": wrong key or corrupted content")
+		}
+		plain[a] = data
+	}
+	for a, data := range plain {
+{{- if .Signed }}
+		a.sealed = a.blob
+{{- end }}
+		a.blob = data
+		a.str_blob = string(data)
+	}
+	atomic.StoreInt32(&unlocked, 1)
+	return nil
+}
+
+func (a *Asset) locked() bool {
+	return a.isEncrypted && atomic.LoadInt32(&unlocked) == 0
+}
+{{- end }}
+
+{{- if .Signed }}
+
+const signature = "{{.Signature}}"
+
+// ErrBadSignature is returned by Verify if the bundle signature does not
+// match the public key or the list of asset digests.
+var ErrBadSignature = errors.New("bundle signature does not match")
+
+// Verify checks the signature of the asset digests list made at generation
+// time with the public key, and then checks stored content of every asset
+// against its digest. Verify returns ErrBadSignature if the signature does
+// not match, or an error naming the first asset with modified content.
+func Verify(pub ed25519.PublicKey) error {
+	if devBuild {
+		return errors.New("assets are served from the source directory and can not be verified")
+	}
+{{- if .Encrypted }}
+	unlockMu.Lock()
+	defer unlockMu.Unlock()
+{{- end }}
+	files := allFiles()
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var manifest bytes.Buffer
+	for _, name := range names {
+		digest, err := hex.DecodeString(files[name].digest)
+		if err != nil {
+			return ErrBadSignature
+		}
+		manifest.WriteString(name)
+		manifest.WriteByte(0)
+		manifest.Write(digest)
+	}
+	sig, _ := hex.DecodeString(signature)
+	if len(pub) != ed25519.PublicKeySize || !ed25519.Verify(pub, manifest.Bytes(), sig) {
+		return ErrBadSignature
+	}
+	for _, name := range names {
+		a := files[name]
+		blob := a.blob
+{{- if .Encrypted }}
+		if a.sealed != nil {
+			blob = a.sealed
+		}
+{{- end }}
+		digest := sha256.Sum256(blob)
+		if hex.EncodeToString(digest[:]) != a.digest {
+			return errors.New("content of asset " + name + " does not match signed digest")
+		}
+	}
+	return nil
+}
+{{- end }}
+
 func cleanPath(path string) string {
 	path = filepath.Clean(path)
 	if filepath.IsAbs(path) {
@@ -167,17 +743,21 @@ func Open(name string) (File, error) {
 {{- else }}
 func Open(name string) (io.ReadCloser, error) {
 	name = cleanPath(name)
-	if asset, ok := fidx[name]; !ok {
+	if asset, ok := lookupFile(name); !ok {
 		return nil, os.ErrNotExist
+{{- if .Encrypted }}
+	} else if asset.locked() {
+		return nil, ErrLocked
+{{- end }}
 	} else {
-		return asset.Reader(), nil
+		return asset.Open(), nil
 	}
 }
 {{- end }}
 
 // Gets asset by name. Returns nil if no asset found.
 func Get(name string) *Asset {
-	if entry, ok := fidx[name]; ok {
+	if entry, ok := lookupFile(name); ok {
 		return entry
 	} else {
 		return nil
@@ -186,7 +766,7 @@ func Get(name string) *Asset {
 
 // Get asset by name. Panics if no asset found.
 func Must(name string) *Asset {
-	if entry, ok := fidx[name]; ok {
+	if entry, ok := lookupFile(name); ok {
 		return entry
 	} else {
 		panic("asset " + name + " not found")
@@ -199,8 +779,6 @@ type directoryAsset struct {
 	files []Asset
 }
 
-var root *directoryAsset
-
 {{- if or .Params.BuildFsAPI }}
 
 // A simple FileSystem abstraction
@@ -360,13 +938,10 @@ func (fs *assetFs) Walk(root string, walkFunc filepath.WalkFunc) error {
 
 func (fs *assetFs) Stat(name string) (os.FileInfo, error) {
 	name = cleanPath(name)
-	if name == "" {
-		return root, nil
-	}
-	if dir, ok := didx[name]; ok {
+	if dir, ok := lookupDir(name); ok {
 		return dir, nil
 	}
-	if asset, ok := fidx[name]; ok {
+	if asset, ok := lookupFile(name); ok {
 		return asset, nil
 	}
 	return nil, os.ErrNotExist
@@ -374,13 +949,15 @@ func (fs *assetFs) Stat(name string) (os.FileInfo, error) {
 
 func (fs *assetFs) Open(name string) (File, error) {
 	name = cleanPath(name)
-	if name == "" {
-		return root.open(""), nil
-	}
-	if dir, ok := didx[name]; ok {
+	if dir, ok := lookupDir(name); ok {
 		return dir.open(name), nil
 	}
-	if asset, ok := fidx[name]; ok {
+	if asset, ok := lookupFile(name); ok {
+{{- if .Encrypted }}
+		if asset.locked() {
+			return nil, ErrLocked
+		}
+{{- end }}
 		return asset.open(name), nil
 	}
 	return nil, os.ErrNotExist
@@ -406,12 +983,10 @@ type File interface {
 func (a *Asset) open(name string) File {
 {{- if .Params.CompressAssets }}
 	if a.isCompressed {
-		ret := &assetCompressedFile{
-			asset: a,
-			name:  name,
+		return &assetCompressedFile{
+			compressedReader: compressedReader{asset: a},
+			name:             name,
 		}
-		ret.Reset(bytes.NewReader(a.blob))
-		return ret
 	} else {
 {{- end }}
 		ret := &assetFile{
@@ -549,9 +1124,8 @@ func (a *assetFile) Readdir(int) ([]os.FileInfo, error) {
 
 {{- if .Params.CompressAssets }}
 type assetCompressedFile struct {
-	gzip.Reader
-	name  string
-	asset *Asset
+	compressedReader
+	name string
 }
 
 func (a *assetCompressedFile) Name() string {
@@ -559,13 +1133,12 @@ func (a *assetCompressedFile) Name() string {
 }
 
 func (a *assetCompressedFile) Stat() (os.FileInfo, error) {
+	if a.closed {
+		return nil, os.ErrClosed
+	}
 	return a.asset, nil
 }
 
-func (a *assetCompressedFile) Seek(int64, int) (int64, error) {
-	return 0, os.ErrInvalid
-}
-
 func (a *assetCompressedFile) Readdir(count int) ([]os.FileInfo, error) {
 	return nil, os.ErrInvalid
 }
@@ -612,7 +1185,7 @@ func (fs *unionFs) Open(name string) (File, error) {
 					file: file,
 				}, nil
 			} else {
-				dir, _ := didx[name]
+				dir, _ := lookupDir(name)
 				return &unionFsDirectoryFile{
 					name:  name,
 					dir:   dir,
@@ -730,6 +1303,11 @@ type httpFileSystem struct {
 	fs FileSystem
 }
 func (fs *httpFileSystem) Open(name string) (http.File, error) {
+{{- if eq .InitCheck "refuse" }}
+	if verifyErr != nil {
+		return nil, verifyErr
+	}
+{{- end }}
 	return fs.fs.Open(name)
 }
 func HttpFileSystem() http.FileSystem {
@@ -737,110 +1315,1176 @@ func HttpFileSystem() http.FileSystem {
 }
 {{- end }}
 
-var fidx = make(map[string]*Asset)
-var didx = make(map[string]*directoryAsset)
-var stamp time.Time
+{{- if eq .InitCheck "refuse" }}
 
-func init() {
-	stamp = time.Unix({{.Date}}).UTC()
-	bb := blob_bytes({{.Size}})
-	bs := blob_string({{.Size}})
-{{ .DirectoryCode -}}
-{{ .IndexCode -}}
-}
+// verifyErr holds the result of the bundle verification at package
+// initialization. Content is not served over HTTP unless it is nil.
+var verifyErr error
+{{- end }}
 
 {{- if .Params.BuildHttpHandlerAPI }}
-{{- if .Has404Asset }}
-var http404Asset *Asset
-{{- end }}
 // ServeHTTP provides a convenience handler whenever embedded content should be served from the root URI.
 var ServeHTTP = HTTPHandlerWithPrefix("")
 
-// HTTPHandlerWithPrefix provides a simple way to serve embedded content via
-// Go standard HTTP server and returns an http handler function. The "prefix"
-// will be stripped from the request URL to serve embedded content from non-root URI
-func HTTPHandlerWithPrefix(prefix string) func(http.ResponseWriter, *http.Request) {
-	return func(w http.ResponseWriter, req *http.Request) {
-		if req.Method != "GET" && req.Method != "HEAD" {
-			w.WriteHeader(http.StatusMethodNotAllowed)
-			return
-		}
-		if !strings.HasPrefix(req.URL.Path, prefix) {
-			http.NotFound(w, req)
-			return
-		}
-		reqPath := req.URL.Path[len(prefix):]
-		if strings.HasPrefix(reqPath, "/") {
-			reqPath = reqPath[1:]
-		}
-		var status = http.StatusOK
-		asset, ok := fidx[reqPath]
-		if !ok {
-			asset, ok = fidx[path.Join(reqPath, "index.html")]
-		}
-{{- if .Has404Asset }}
-		if !ok {
-			asset = http404Asset
-			status = http.StatusNotFound
-		}
-{{- else }}
-		if !ok {
-			http.NotFound(w, req)
-			return
-		}
+// CachePolicy sets "Cache-Control" header the HTTP handler sends for assets matching
+// the pattern. Pattern is either a path pattern, whose elements follow path.Match syntax
+// and where "**" element matches any number of path elements (a pattern without a slash
+// is matched against the base name only), or a MIME type pattern prefixed with "type:",
+// e.g. "type:image/*". An empty pattern matches all the assets, an empty value sends
+// no header.
+type CachePolicy struct {
+	Pattern string
+	Value   string
+}
+
+// CachePolicies are the cache policies set at generation time. They are used by
+// handlers created without WithCachePolicies option, and can be changed before
+// serving. The first policy matching an asset applies.
+var CachePolicies = []CachePolicy{
+{{- range .CachePolicies }}
+	{Pattern: {{ printf "%q" .Pattern }}, Value: {{ printf "%q" .Value }}},
 {{- end }}
-		if tag := req.Header.Get("If-None-Match"); tag != "" {
-			if strings.HasPrefix("W/", tag) || strings.HasPrefix("w/", tag) {
-				tag = tag[2:]
-			}
-			if tag, err := strconv.Unquote(tag); err == nil && tag == asset.tag {
-				w.WriteHeader(http.StatusNotModified)
-				return
-			}
-		}
-		if mtime := req.Header.Get("If-Modified-Since"); mtime != "" {
-			if ts, err := http.ParseTime(mtime); err == nil && !ts.Before(stamp) {
-				w.WriteHeader(http.StatusNotModified)
-				return
-			}
-		}
-{{- if .Params.CompressAssets }}
-		var deflate = asset.isCompressed
-		if encs, ok := req.Header["Accept-Encoding"]; ok {
-			for _, enc := range encs {
-				if strings.Contains(enc, "gzip") {
-					if deflate {
-						w.Header().Set("Content-Encoding", "gzip")
-					}
-					deflate = false
-					break
-				}
-			}
+}
+
+// SecurityHeader sets a security header the HTTP handler sends for assets matching the
+// pattern (see CachePolicy), such as "Content-Security-Policy" or "Strict-Transport-Security".
+// Headers with an empty pattern are also sent with responses other than assets, such as
+// redirects and directory listings. An empty value disables the header.
+type SecurityHeader struct {
+	Pattern string
+	Name    string
+	Value   string
+}
+
+// DefaultSecurityHeaders are sent unless SecurityHeaders (or the headers set with
+// WithSecurityHeaders option) set the same header for the asset.
+var DefaultSecurityHeaders = []SecurityHeader{
+	{Name: "X-Content-Type-Options", Value: "nosniff"},
+	{Name: "X-Frame-Options", Value: "SAMEORIGIN"},
+	{Name: "Content-Security-Policy", Value: "frame-ancestors 'self'"},
+	{Name: "Referrer-Policy", Value: "strict-origin-when-cross-origin"},
+}
+
+// SecurityHeaders are the security headers set at generation time. They are used
+// by handlers created without WithSecurityHeaders option, and can be changed before
+// serving. For every header name, the first entry matching an asset applies.
+var SecurityHeaders = []SecurityHeader{
+{{- range .SecurityHeaders }}
+	{Pattern: {{ printf "%q" .Pattern }}, Name: {{ printf "%q" .Name }}, Value: {{ printf "%q" .Value }}},
+{{- end }}
+}
+
+// HandlerOption configures Handler, or the handler returned by HTTPHandlerWithPrefix
+type HandlerOption func(*handlerConfig)
+
+type handlerConfig struct {
+	cachePolicies      []CachePolicy
+	hasCachePolicies   bool // if false, CachePolicies are used
+	spa                *SPA
+	listing            bool
+	listingPrefixes    []string
+	canonical          bool
+	cleanURLs          bool
+	securityHeaders    []SecurityHeader
+	hasSecurityHeaders bool // if false, SecurityHeaders are used
+	prefix             string
+	methods            []string
+	notFoundHandler    http.Handler
+	next               http.Handler
+	errorLog           *log.Logger
+}
+
+// handlerMethods are the methods the handler allows by default
+var handlerMethods = []string{"GET", "HEAD", "OPTIONS"}
+
+// WithPrefix makes the handler strip the prefix from the request URL path,
+// to serve embedded content from a non-root URL. Requests outside of the prefix
+// are handled as not found.
+func WithPrefix(prefix string) HandlerOption {
+	return func(c *handlerConfig) {
+		c.prefix = prefix
+	}
+}
+
+// WithNotFoundHandler makes the handler pass requests matching no asset
+// to the handler h instead of replying with 404 error page.
+func WithNotFoundHandler(h http.Handler) HandlerOption {
+	return func(c *handlerConfig) {
+		c.notFoundHandler = h
+	}
+}
+
+// WithNext makes the handler fall through to the next handler instead of replying
+// to requests it can not serve: requests matching no asset, requests outside
+// of the prefix and requests with methods it does not allow.
+func WithNext(next http.Handler) HandlerOption {
+	return func(c *handlerConfig) {
+		c.next = next
+	}
+}
+
+// WithErrorLog sets the logger for errors occurred while serving requests,
+// such as corrupted or locked assets. Errors are not logged by default.
+func WithErrorLog(logger *log.Logger) HandlerOption {
+	return func(c *handlerConfig) {
+		c.errorLog = logger
+	}
+}
+
+// WithMethods sets the methods the handler allows, out of GET, HEAD and OPTIONS
+// (all of them by default). Other methods are dropped, so if none of the methods
+// is GET, HEAD or OPTIONS, the handler allows nothing and replies 405 to every
+// request. Requests with methods not allowed get 405 Method Not Allowed reply
+// with "Allow" header listing the allowed methods (empty if there are none), and
+// OPTIONS requests, if allowed, get 204 No Content reply with the same header.
+func WithMethods(methods ...string) HandlerOption {
+	var allowed []string
+	for _, m := range handlerMethods {
+		for _, method := range methods {
+			if strings.EqualFold(method, m) {
+				allowed = append(allowed, m)
+				break
+			}
+		}
+	}
+	return func(c *handlerConfig) {
+		c.methods = allowed
+	}
+}
+
+// allows reports whether the handler allows the method
+func (c *handlerConfig) allows(method string) bool {
+	for _, m := range c.methods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// WithCachePolicies replaces CachePolicies for the handler. The first policy
+// matching an asset applies, no policies at all disable "Cache-Control" header.
+func WithCachePolicies(policies ...CachePolicy) HandlerOption {
+	return func(c *handlerConfig) {
+		c.cachePolicies = policies
+		c.hasCachePolicies = true
+	}
+}
+
+// WithSecurityHeaders replaces SecurityHeaders for the handler. DefaultSecurityHeaders
+// are still sent unless overridden, e.g. with an empty value.
+func WithSecurityHeaders(headers ...SecurityHeader) HandlerOption {
+	return func(c *handlerConfig) {
+		c.securityHeaders = headers
+		c.hasSecurityHeaders = true
+	}
+}
+
+// SPA configures the single page application mode of the handler, see WithSPA
+type SPA struct {
+	// Fallback is the asset served for unknown routes, "index.html" if empty
+	Fallback string
+	// Exclude lists request URL path prefixes, such as "/api/", which never fall back
+	Exclude []string
+	// StrictExtensions makes requests for missing paths with an extension in
+	// the last element, such as "/js/app.js", get 404 rather than the fallback
+	StrictExtensions bool
+}
+
+// WithSPA makes the handler serve the fallback asset with status 200 for GET and
+// HEAD requests matching no asset, so client-side routes of a single page
+// application, such as "/users/42", get the application.
+func WithSPA(spa SPA) HandlerOption {
+	if spa.Fallback == "" {
+		spa.Fallback = "index.html"
+	}
+	return func(c *handlerConfig) {
+		c.spa = &spa
+	}
+}
+
+// fallback returns the SPA fallback asset for the request, if any
+func (c *handlerConfig) fallback(req *http.Request, reqPath string) (string, bool) {
+	if c.spa == nil || (req.Method != "GET" && req.Method != "HEAD") {
+		return "", false
+	}
+	for _, prefix := range c.spa.Exclude {
+		if strings.HasPrefix(req.URL.Path, prefix) {
+			return "", false
+		}
+	}
+	if c.spa.StrictExtensions && path.Ext(path.Base(reqPath)) != "" {
+		return "", false
+	}
+	return c.spa.Fallback, true
+}
+
+// WithCanonicalRedirects makes the handler redirect requests for a directory
+// with index.html but without the trailing slash, e.g. "/dir", and requests for
+// the index.html itself, e.g. "/dir/index.html", to the directory URL "/dir/".
+func WithCanonicalRedirects() HandlerOption {
+	return func(c *handlerConfig) {
+		c.canonical = true
+	}
+}
+
+// WithCleanURLs makes the handler serve HTML assets without the extension, e.g.
+// "about.html" for "/about", and redirect requests with the extension to the
+// URL without it, unless there is an asset with such a name.
+func WithCleanURLs() HandlerOption {
+	return func(c *handlerConfig) {
+		c.cleanURLs = true
+	}
+}
+
+// redirect sends a permanent redirect to the location, a path relative to the
+// request URL, so it stays correct if the request URL was rewritten before
+// reaching the handler, e.g. by http.StripPrefix
+func (c *handlerConfig) redirect(w http.ResponseWriter, req *http.Request, location string) {
+	c.setSecurityHeaders(w.Header(), "", nil)
+	u := url.URL{Path: location, RawQuery: req.URL.RawQuery}
+	w.Header().Set("Location", u.String())
+	w.WriteHeader(http.StatusMovedPermanently)
+}
+
+// WithDirectoryListing makes the handler list content of directories having no
+// index.html, either as HTML, or as JSON if the client accepts "application/json".
+// Listing is enabled for directories with request URL paths starting with any of
+// the prefixes, or for all the directories if no prefixes are given.
+func WithDirectoryListing(prefixes ...string) HandlerOption {
+	return func(c *handlerConfig) {
+		c.listing = true
+		c.listingPrefixes = prefixes
+	}
+}
+
+// lists reports whether the directory requested should be listed
+func (c *handlerConfig) lists(req *http.Request) bool {
+	if !c.listing || (req.Method != "GET" && req.Method != "HEAD") {
+		return false
+	}
+	if len(c.listingPrefixes) == 0 {
+		return true
+	}
+	for _, prefix := range c.listingPrefixes {
+		if strings.HasPrefix(req.URL.Path, prefix) || strings.HasPrefix(req.URL.Path+"/", prefix) {
+			return true
 		}
-		if !deflate {
-			w.Header().Set("Content-Length", strconv.FormatInt(int64(len(asset.blob)), 10))
+	}
+	return false
+}
+
+// listingEntry describes a directory entry in JSON listing
+type listingEntry struct {
+	Name     string `json:"name"`
+	Dir      bool   `json:"dir,omitempty"`
+	Size     int64  `json:"size"`
+	MimeType string `json:"mimeType,omitempty"`
+}
+
+// serveListing lists content of the directory, sorted according to "sort" (name, size
+// or type) and "order" (asc or desc) query parameters, directories first
+func (c *handlerConfig) serveListing(w http.ResponseWriter, req *http.Request, d *directoryAsset) {
+	if !strings.HasSuffix(req.URL.Path, "/") {
+		// relative links need the trailing slash
+		c.redirect(w, req, path.Base(req.URL.Path)+"/")
+		return
+	}
+	c.setSecurityHeaders(w.Header(), "", nil)
+	entries := make([]listingEntry, 0, len(d.dirs)+len(d.files))
+	for i := range d.dirs {
+		entries = append(entries, listingEntry{Name: d.dirs[i].name, Dir: true})
+	}
+	for i := range d.files {
+		entries = append(entries, listingEntry{Name: d.files[i].name, Size: d.files[i].Size(), MimeType: d.files[i].mime})
+	}
+	query := req.URL.Query()
+	key, desc := query.Get("sort"), query.Get("order") == "desc"
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.Dir != b.Dir {
+			return a.Dir
+		}
+		var less, greater bool
+		switch key {
+		case "size":
+			less, greater = a.Size < b.Size, a.Size > b.Size
+		case "type":
+			less, greater = a.MimeType < b.MimeType, a.MimeType > b.MimeType
+		}
+		if !less && !greater {
+			less, greater = a.Name < b.Name, a.Name > b.Name
+		}
+		if desc {
+			return greater
+		}
+		return less
+	})
+	w.Header().Add("Vary", "Accept")
+	var body []byte
+	if strings.Contains(req.Header.Get("Accept"), "application/json") {
+		w.Header().Set("Content-Type", "application/json")
+		body, _ = json.Marshal(struct {
+			Path    string         `json:"path"`
+			Entries []listingEntry `json:"entries"`
+		}{req.URL.Path, entries})
+	} else {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		body = listingHTML(req.URL.Path, entries, key, desc)
+	}
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	w.WriteHeader(http.StatusOK)
+	if req.Method != "HEAD" {
+		w.Write(body)
+	}
+}
+
+func listingHTML(dir string, entries []listingEntry, key string, desc bool) []byte {
+	var buf bytes.Buffer
+	title := html.EscapeString("Index of " + dir)
+	buf.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n<title>" + title + "</title>\n</head>\n<body>\n")
+	buf.WriteString("<h1>" + title + "</h1>\n<table>\n<tr>")
+	for i, col := range []string{"name", "size", "type"} {
+		order := "asc"
+		if (col == key || (key == "" && col == "name")) && !desc {
+			order = "desc"
+		}
+		buf.WriteString("<th><a href=\"?sort=" + col + "&amp;order=" + order + "\">" + []string{"Name", "Size", "Type"}[i] + "</a></th>")
+	}
+	buf.WriteString("</tr>\n")
+	if dir != "/" {
+		buf.WriteString("<tr><td><a href=\"../\">../</a></td><td></td><td></td></tr>\n")
+	}
+	for _, e := range entries {
+		name, href, size, mime := e.Name, (&url.URL{Path: e.Name}).String(), strconv.FormatInt(e.Size, 10), e.MimeType
+		if e.Dir {
+			name, href, size, mime = name+"/", href+"/", "-", "-"
+		}
+		buf.WriteString("<tr><td><a href=\"" + html.EscapeString(href) + "\">" + html.EscapeString(name) + "</a></td><td>" +
+			size + "</td><td>" + html.EscapeString(mime) + "</td></tr>\n")
+	}
+	buf.WriteString("</table>\n</body>\n</html>\n")
+	return buf.Bytes()
+}
+
+// cacheControl returns "Cache-Control" header value for the asset
+func (c *handlerConfig) cacheControl(name string, asset *Asset) string {
+	policies := CachePolicies
+	if c.hasCachePolicies {
+		policies = c.cachePolicies
+	}
+	for _, p := range policies {
+		if matchAsset(p.Pattern, name, asset.mime) {
+			return p.Value
+		}
+	}
+	return ""
+}
+
+// setSecurityHeaders sets security headers for the asset with the path name, or, if
+// the asset is nil, the headers with empty patterns only
+func (c *handlerConfig) setSecurityHeaders(h http.Header, name string, asset *Asset) {
+	headers := SecurityHeaders
+	if c.hasSecurityHeaders {
+		headers = c.securityHeaders
+	}
+	set := make(map[string]bool)
+	for _, list := range [][]SecurityHeader{headers, DefaultSecurityHeaders} {
+		for _, sh := range list {
+			key := http.CanonicalHeaderKey(sh.Name)
+			if set[key] || (sh.Pattern != "" && (asset == nil || !matchAsset(sh.Pattern, name, asset.mime))) {
+				continue
+			}
+			set[key] = true
+			if sh.Value == "" {
+				h.Del(key)
+			} else {
+				h.Set(key, sh.Value)
+			}
+		}
+	}
+}
+
+// matchAsset reports whether the asset with the path name and MIME type matches the pattern
+func matchAsset(pattern, name, mime string) bool {
+	switch {
+	case pattern == "":
+		return true
+	case strings.HasPrefix(pattern, "type:"):
+		if i := strings.IndexByte(mime, ';'); i >= 0 {
+			mime = mime[:i]
+		}
+		ok, _ := path.Match(pattern[len("type:"):], strings.TrimSpace(mime))
+		return ok
+	case !strings.Contains(pattern, "/"):
+		ok, _ := path.Match(pattern, path.Base(name))
+		return ok
+	default:
+		return matchElements(strings.Split(strings.Trim(pattern, "/"), "/"), strings.Split(name, "/"))
+	}
+}
+
+func matchElements(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(name); i++ {
+				if matchElements(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(name) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(pattern[0], name[0]); !ok {
+			return false
+		}
+		pattern = pattern[1:]
+		name = name[1:]
+	}
+	return len(name) == 0
+}
+
+// Handler serves embedded content over HTTP. It is created with NewHandler
+// and configured with HandlerOption functions.
+type Handler struct {
+	cfg handlerConfig
+}
+
+// NewHandler returns the handler configured with the options. Without WithPrefix
+// option the request URL path is served as is, which suits http.StripPrefix and
+// routers mounting handlers at a path:
+//
+//	http.Handle("/static/", http.StripPrefix("/static", NewHandler()))
+func NewHandler(opts ...HandlerOption) *Handler {
+	h := &Handler{cfg: handlerConfig{methods: handlerMethods}}
+	for _, opt := range opts {
+		opt(&h.cfg)
+	}
+	return h
+}
+
+// HTTPHandlerWithPrefix provides a simple way to serve embedded content via
+// Go standard HTTP server and returns an http handler function. The "prefix"
+// will be stripped from the request URL to serve embedded content from non-root URI
+func HTTPHandlerWithPrefix(prefix string, opts ...HandlerOption) func(http.ResponseWriter, *http.Request) {
+	return NewHandler(append(opts[:len(opts):len(opts)], WithPrefix(prefix))...).ServeHTTP
+}
+
+// ServeHTTP implements http.Handler
+func (h *Handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	cfg := &h.cfg
+	reqPath, ok := requestPath(req, cfg.prefix)
+	if !ok {
+		cfg.notFound(w, req, reqPath, false)
+		return
+	}
+	if !cfg.allows(req.Method) {
+		if cfg.next != nil {
+			cfg.next.ServeHTTP(w, req)
+			return
+		}
+		w.Header().Set("Allow", strings.Join(cfg.methods, ", "))
+		cfg.serveError(w, req, reqPath, http.StatusMethodNotAllowed)
+		return
+	}
+	if req.Method == "OPTIONS" {
+		cfg.setSecurityHeaders(w.Header(), "", nil)
+		w.Header().Set("Allow", strings.Join(cfg.methods, ", "))
+		w.Header().Set("Content-Length", "0")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+{{- if eq .InitCheck "refuse" }}
+	if verifyErr != nil {
+		cfg.logf(req, "%s", verifyErr)
+		cfg.serveError(w, req, reqPath, http.StatusInternalServerError)
+		return
+	}
+{{- end }}
+	assetPath := reqPath
+	asset, ok := lookupFile(assetPath)
+	if ok && path.Base(reqPath) == "index.html" {
+		if cfg.canonical {
+			cfg.redirect(w, req, "./")
+			return
+		}
+	} else if ok && cfg.cleanURLs && strings.HasSuffix(reqPath, ".html") {
+		if _, exists := lookupFile(strings.TrimSuffix(reqPath, ".html")); !exists {
+			cfg.redirect(w, req, strings.TrimSuffix(path.Base(reqPath), ".html"))
+			return
+		}
+	}
+	if !ok && cfg.cleanURLs && reqPath != "" && !strings.HasSuffix(reqPath, "/") {
+		assetPath = reqPath + ".html"
+		asset, ok = lookupFile(assetPath)
+	}
+	if !ok {
+		assetPath = path.Join(reqPath, "index.html")
+		asset, ok = lookupFile(assetPath)
+		if ok && cfg.canonical && req.URL.Path != "" && !strings.HasSuffix(req.URL.Path, "/") {
+			cfg.redirect(w, req, path.Base(req.URL.Path)+"/")
+			return
+		}
+	}
+	if !ok && cfg.lists(req) {
+		if d, isDir := lookupDir(strings.TrimSuffix(reqPath, "/")); isDir {
+			cfg.serveListing(w, req, d)
+			return
+		}
+	}
+	if !ok {
+		if fallback, spa := cfg.fallback(req, reqPath); spa {
+			assetPath = fallback
+			asset, ok = lookupFile(assetPath)
+		}
+	}
+	if !ok {
+		cfg.notFound(w, req, reqPath, true)
+		return
+	}
+	cfg.serveAsset(w, req, assetPath, asset, http.StatusOK)
+}
+
+// notFound replies to the request matching no asset, or, unless inPrefix is set,
+// to the request outside of the handler prefix
+func (c *handlerConfig) notFound(w http.ResponseWriter, req *http.Request, reqPath string, inPrefix bool) {
+	switch {
+	case c.next != nil:
+		c.next.ServeHTTP(w, req)
+	case c.notFoundHandler != nil:
+		c.notFoundHandler.ServeHTTP(w, req)
+	case inPrefix:
+		c.serveError(w, req, reqPath, http.StatusNotFound)
+	default:
+		http.NotFound(w, req)
+	}
+}
+
+// logf logs an error occurred while serving the request, if an error log is set
+func (c *handlerConfig) logf(req *http.Request, format string, args ...interface{}) {
+	if c.errorLog != nil {
+		c.errorLog.Printf("%s %s: "+format, append([]interface{}{req.Method, req.URL.Path}, args...)...)
+	}
+}
+
+// requestPath returns the asset path requested, i.e. the request URL path
+// with the prefix and the leading slash removed, and whether the path has the prefix
+func requestPath(req *http.Request, prefix string) (string, bool) {
+	if !strings.HasPrefix(req.URL.Path, prefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(req.URL.Path[len(prefix):], "/"), true
+}
+
+// ServeError replies to the request with the status and the nearest error page
+// for it: the asset named after the status, e.g. "403.html" for http.StatusForbidden,
+// located in the directory of the requested asset or in the closest of its parents.
+// Error pages exist for statuses 403, 404, 405 and 500. A plain text reply is sent
+// if there is no error page, or if the client does not accept HTML. The "prefix"
+// is stripped from the request URL the same way HTTPHandlerWithPrefix does.
+func ServeError(w http.ResponseWriter, req *http.Request, prefix string, status int) {
+	var cfg handlerConfig
+	reqPath, _ := requestPath(req, prefix)
+	cfg.serveError(w, req, reqPath, status)
+}
+
+// serveError replies to the request for the asset path with the status and the
+// nearest error page
+func (c *handlerConfig) serveError(w http.ResponseWriter, req *http.Request, reqPath string, status int) {
+	c.setSecurityHeaders(w.Header(), "", nil)
+	dir := strings.TrimSuffix(reqPath, "/")
+	if !strings.HasSuffix(reqPath, "/") {
+		if dir = path.Dir(reqPath); dir == "." {
+			dir = ""
+		}
+	}
+	pagePath, page, ok := errorPage(dir, status)
+	if ok {
+		w.Header().Add("Vary", "Accept")
+	}
+	if ok && acceptsHTML(req) {
+{{- if .Encrypted }}
+		if !page.locked() {
+			c.serveAsset(w, req, pagePath, page, status)
+			return
 		}
 {{- else }}
-		w.Header().Set("Content-Length", strconv.FormatInt(int64(asset.size), 10))
+		c.serveAsset(w, req, pagePath, page, status)
+		return
 {{- end }}
-		w.Header().Set("Content-Type", asset.mime)
-		w.Header().Set("Etag", strconv.Quote(asset.tag))
-		w.Header().Set("Last-Modified", stamp.Format(http.TimeFormat))
-		w.WriteHeader(status)
-		if req.Method != "HEAD" {
+	}
+	http.Error(w, http.StatusText(status), status)
+}
+
+// errorPage returns the error page for the status located in the directory
+// or in the closest of its parents
+func errorPage(dir string, status int) (string, *Asset, bool) {
+	switch status {
+	case http.StatusForbidden, http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusInternalServerError:
+	default:
+		return "", nil, false
+	}
+	name := strconv.Itoa(status) + ".html"
+	for {
+		p := path.Join(dir, name)
+		if asset, ok := lookupFile(p); ok {
+			return p, asset, true
+		}
+		if dir == "" {
+			return "", nil, false
+		}
+		if dir = path.Dir(dir); dir == "." || dir == "/" {
+			dir = ""
+		}
+	}
+}
+
+// acceptsHTML reports whether "Accept" header of the request allows HTML,
+// taking quality values and specificity of media ranges into account
+func acceptsHTML(req *http.Request) bool {
+	if len(req.Header["Accept"]) == 0 {
+		return true
+	}
+	// quality values of "text/html", "text/*" and "*/*"
+	q := [3]float64{-1, -1, -1}
+	for _, h := range req.Header["Accept"] {
+		for _, mr := range strings.Split(h, ",") {
+			quality := 1.0
+			params := strings.Split(mr, ";")
+			for _, param := range params[1:] {
+				param = strings.TrimSpace(param)
+				if strings.HasPrefix(param, "q=") {
+					var err error
+					if quality, err = strconv.ParseFloat(param[2:], 64); err != nil {
+						quality = 0
+					}
+				}
+			}
+			switch strings.ToLower(strings.TrimSpace(params[0])) {
+			case "text/html":
+				q[0] = quality
+			case "text/*":
+				q[1] = quality
+			case "*/*":
+				q[2] = quality
+			}
+		}
+	}
+	for _, quality := range q {
+		if quality >= 0 {
+			return quality > 0
+		}
+	}
+	return false
+}
+
+// serveAsset replies to the request with the asset content and the status. Conditional
+// and range requests are only evaluated if the status is http.StatusOK.
+func (c *handlerConfig) serveAsset(w http.ResponseWriter, req *http.Request, assetPath string, asset *Asset, status int) {
+{{- if .Encrypted }}
+	if asset.locked() {
+		c.logf(req, "%s", ErrLocked)
+		c.setSecurityHeaders(w.Header(), assetPath, asset)
+		http.Error(w, ErrLocked.Error(), http.StatusServiceUnavailable)
+		return
+	}
+{{- end }}
+	// tag identifies the representation being sent, it differs for the stored
+	// gzip stream and the decompressed content of a compressed asset
+	tag := asset.tag
 {{- if .Params.CompressAssets }}
-			if deflate {
-				ungzip, _ := gzip.NewReader(bytes.NewReader(asset.blob))
-				defer ungzip.Close()
-				io.Copy(w, ungzip)
-			} else {
+	var deflate = asset.isCompressed
+	if asset.isCompressed {
+		w.Header().Add("Vary", "Accept-Encoding")
+		if acceptsGzip(req) {
+			deflate = false
+			tag += "-" + asset.Encoding()
+		}
+	}
 {{- end }}
-				w.Write(asset.blob)
+	w.Header().Set("Etag", strconv.Quote(tag))
+	w.Header().Set("Last-Modified", asset.ModTime().Format(http.TimeFormat))
+	if cc := c.cacheControl(assetPath, asset); cc != "" {
+		w.Header().Set("Cache-Control", cc)
+	}
+	c.setSecurityHeaders(w.Header(), assetPath, asset)
+	if status == http.StatusOK {
+		if code := checkPreconditions(req, tag, asset.ModTime()); code != http.StatusOK {
+			w.WriteHeader(code)
+			return
+		}
+	}
+{{- if .Params.CompressAssets }}
+	// content is the representation being sent: either the stored content,
+	// or the decompressed one
+	var (
+		content io.ReadSeeker
+		size    = int64(len(asset.blob))
+	)
+	if deflate {
+		size = int64(asset.size)
+	}
+	if deflate && req.Method != "HEAD" {
+		if s, cached := cacheGet(asset); cached {
+			content = strings.NewReader(s)
+		} else if cacheFits(asset) {
+			data, err := asset.decompress()
+			if err != nil {
+				c.logf(req, "%s: %s", assetPath, err)
+				w.Header().Del("Etag")
+				w.Header().Del("Last-Modified")
+				if status == http.StatusOK {
+					c.serveError(w, req, assetPath, http.StatusInternalServerError)
+				} else {
+					http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+				}
+				return
+			}
+			content = bytes.NewReader(data)
+		} else {
+			r := asset.Open()
+			defer r.Close()
+			content = r
+		}
+	} else {
+		content = bytes.NewReader(asset.blob)
+	}
+{{- else }}
+	var (
+		content io.ReadSeeker = bytes.NewReader(asset.blob)
+		size                  = int64(len(asset.blob))
+	)
+{{- end }}
+	w.Header().Set("Content-Type", asset.mime)
+	var ranges []httpRange
+	if status == http.StatusOK {
+		w.Header().Set("Accept-Ranges", "bytes")
+		if rh := req.Header.Get("Range"); rh != "" && checkIfRange(req, tag, asset.ModTime()) {
+			var satisfiable bool
+			ranges, satisfiable = parseRange(rh, size)
+			if !satisfiable {
+				w.Header().Set("Content-Range", "bytes */"+strconv.FormatInt(size, 10))
+				http.Error(w, "requested range not satisfiable", http.StatusRequestedRangeNotSatisfiable)
+				return
+			}
+		}
+	}
+{{- if .Params.CompressAssets }}
+	if asset.isCompressed && !deflate {
+		w.Header().Set("Content-Encoding", asset.Encoding())
+	}
+{{- end }}
+	switch len(ranges) {
+	case 0:
+		w.Header().Set("Content-Length", strconv.FormatInt(size, 10))
+		w.WriteHeader(status)
+		if req.Method != "HEAD" {
+			if _, err := io.Copy(w, content); err != nil {
+				// make sure client will not take truncated content as complete
+				panic(http.ErrAbortHandler)
+			}
+		}
+	case 1:
+		w.Header().Set("Content-Range", ranges[0].contentRange(size))
+		w.Header().Set("Content-Length", strconv.FormatInt(ranges[0].length, 10))
+		w.WriteHeader(http.StatusPartialContent)
+		if req.Method != "HEAD" {
+			if _, err := content.Seek(ranges[0].start, io.SeekStart); err != nil {
+				panic(http.ErrAbortHandler)
+			}
+			if _, err := io.CopyN(w, content, ranges[0].length); err != nil {
+				panic(http.ErrAbortHandler)
+			}
+		}
+	default:
+		boundary := multipart.NewWriter(ioutil.Discard).Boundary()
+		var length countingWriter
+		writeRanges(&length, boundary, ranges, asset.mime, size, nil)
+		for _, r := range ranges {
+			length += countingWriter(r.length)
+		}
+		w.Header().Set("Content-Type", "multipart/byteranges; boundary="+boundary)
+		w.Header().Set("Content-Length", strconv.FormatInt(int64(length), 10))
+		w.WriteHeader(http.StatusPartialContent)
+		if req.Method != "HEAD" {
+			if err := writeRanges(w, boundary, ranges, asset.mime, size, content); err != nil {
+				panic(http.ErrAbortHandler)
+			}
+		}
+	}
+}
+
+// checkPreconditions evaluates conditional request headers against the representation
+// tag and modification time as specified by RFC 7232, section 6, and returns http.StatusOK
+// if the request should be served, or the status to respond with otherwise (304 Not
+// Modified or 412 Precondition Failed)
+func checkPreconditions(req *http.Request, tag string, mtime time.Time) int {
+	mtime = mtime.Truncate(time.Second)
+	if im, ok := req.Header["If-Match"]; ok {
+		if !matchTag(strings.Join(im, ","), tag, false) {
+			return http.StatusPreconditionFailed
+		}
+	} else if ius := req.Header.Get("If-Unmodified-Since"); ius != "" {
+		if ts, err := http.ParseTime(ius); err == nil && mtime.After(ts) {
+			return http.StatusPreconditionFailed
+		}
+	}
+	get := req.Method == "GET" || req.Method == "HEAD"
+	if inm, ok := req.Header["If-None-Match"]; ok {
+		if matchTag(strings.Join(inm, ","), tag, true) {
+			if get {
+				return http.StatusNotModified
+			}
+			return http.StatusPreconditionFailed
+		}
+	} else if ims := req.Header.Get("If-Modified-Since"); ims != "" && get {
+		if ts, err := http.ParseTime(ims); err == nil && !mtime.After(ts) {
+			return http.StatusNotModified
+		}
+	}
+	return http.StatusOK
+}
+
+// matchTag reports whether the list of entity tags, the value of "If-Match" or
+// "If-None-Match" header, matches the tag. Weak comparison ignores the weakness
+// indicator of the listed tags, while strong comparison never matches weak tags.
+// A malformed list matches nothing after the malformed entry.
+func matchTag(list, tag string, weak bool) bool {
+	if strings.TrimSpace(list) == "*" {
+		return true
+	}
+	for {
+		list = strings.TrimLeft(list, " \t,")
+		if list == "" {
+			return false
+		}
+		isWeak := strings.HasPrefix(list, "W/")
+		if isWeak {
+			list = list[2:]
+		}
+		if !strings.HasPrefix(list, "\"") {
+			return false
+		}
+		end := strings.IndexByte(list[1:], '"')
+		if end < 0 {
+			return false
+		}
+		if list[1:end+1] == tag && (weak || !isWeak) {
+			return true
+		}
+		list = list[end+2:]
+	}
+}
+
+// httpRange is a range of content bytes requested with "Range" header
+type httpRange struct {
+	start, length int64
+}
+
+func (r httpRange) contentRange(size int64) string {
+	return "bytes " + strconv.FormatInt(r.start, 10) + "-" + strconv.FormatInt(r.start+r.length-1, 10) +
+		"/" + strconv.FormatInt(size, 10)
+}
+
+// parseRange parses "Range" header value for content of the given size. Malformed
+// values, as well as ranges exceeding the content size in total, are ignored (i.e.,
+// nil is returned). If none of the ranges is satisfiable, satisfiable is false.
+func parseRange(s string, size int64) (ranges []httpRange, satisfiable bool) {
+	const prefix = "bytes="
+	if !strings.HasPrefix(s, prefix) {
+		return nil, true
+	}
+	var total int64
+	for _, spec := range strings.Split(s[len(prefix):], ",") {
+		spec = strings.TrimSpace(spec)
+		if spec == "" {
+			continue
+		}
+		i := strings.Index(spec, "-")
+		if i < 0 {
+			return nil, true
+		}
+		first, last := strings.TrimSpace(spec[:i]), strings.TrimSpace(spec[i+1:])
+		var r httpRange
+		if first == "" {
+			// suffix range, the last N bytes
+			n, err := strconv.ParseInt(last, 10, 64)
+			if err != nil || n < 0 {
+				return nil, true
+			}
+			if n == 0 {
+				continue
+			}
+			if n > size {
+				n = size
+			}
+			r = httpRange{start: size - n, length: n}
+		} else {
+			start, err := strconv.ParseInt(first, 10, 64)
+			if err != nil || start < 0 {
+				return nil, true
+			}
+			end := size - 1
+			if last != "" {
+				if end, err = strconv.ParseInt(last, 10, 64); err != nil || end < start {
+					return nil, true
+				}
+				if end >= size {
+					end = size - 1
+				}
+			}
+			if start >= size {
+				continue
+			}
+			r = httpRange{start: start, length: end - start + 1}
+		}
+		if r.length > 0 {
+			ranges = append(ranges, r)
+			total += r.length
+		}
+	}
+	if len(ranges) == 0 {
+		return nil, false
+	}
+	if total > size {
+		return nil, true
+	}
+	return ranges, true
+}
+
+// checkIfRange reports whether "Range" header should be honoured, i.e. there is
+// no "If-Range" header or it matches the representation tag or modification time
+func checkIfRange(req *http.Request, tag string, mtime time.Time) bool {
+	ir := req.Header.Get("If-Range")
+	if ir == "" {
+		return true
+	}
+	if strings.HasPrefix(ir, "\"") {
+		// entity tags are compared with strong comparison
+		t, err := strconv.Unquote(ir)
+		return err == nil && t == tag
+	}
+	if strings.HasPrefix(ir, "W/") {
+		return false
+	}
+	ts, err := http.ParseTime(ir)
+	return err == nil && ts.Equal(mtime.Truncate(time.Second))
+}
 {{- if .Params.CompressAssets }}
+
+// acceptsGzip reports whether "Accept-Encoding" header of the request allows
+// gzip content coding, either explicitly or with "*", taking quality values
+// into account
+func acceptsGzip(req *http.Request) bool {
+	gz, any := -1.0, -1.0
+	for _, h := range req.Header["Accept-Encoding"] {
+		for _, enc := range strings.Split(h, ",") {
+			q := 1.0
+			if i := strings.IndexByte(enc, ';'); i >= 0 {
+				param := strings.TrimSpace(enc[i+1:])
+				enc = enc[:i]
+				if strings.HasPrefix(param, "q=") {
+					var err error
+					if q, err = strconv.ParseFloat(param[2:], 64); err != nil {
+						q = 0
+					}
+				}
+			}
+			switch strings.ToLower(strings.TrimSpace(enc)) {
+			case "gzip", "x-gzip":
+				gz = q
+			case "*":
+				any = q
 			}
+		}
+	}
+	if gz >= 0 {
+		return gz > 0
+	}
+	return any > 0
+}
 {{- end }}
+
+type countingWriter int64
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	*w += countingWriter(len(p))
+	return len(p), nil
+}
+
+// writeRanges writes ranges of content as multipart/byteranges body. If content
+// is nil, only part headers are written.
+func writeRanges(w io.Writer, boundary string, ranges []httpRange, mime string, size int64, content io.ReadSeeker) error {
+	mw := multipart.NewWriter(w)
+	if err := mw.SetBoundary(boundary); err != nil {
+		return err
+	}
+	for _, r := range ranges {
+		part, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":  {mime},
+			"Content-Range": {r.contentRange(size)},
+		})
+		if err != nil {
+			return err
+		}
+		if content == nil {
+			continue
+		}
+		if _, err = content.Seek(r.start, io.SeekStart); err != nil {
+			return err
+		}
+		if _, err = io.CopyN(part, content, r.length); err != nil {
+			return err
 		}
 	}
+	return mw.Close()
+}
+
+// LiveReloadPath is the URI of the Server-Sent Events stream used by LiveReload
+const LiveReloadPath = "/_imbed/livereload"
+
+// liveReloadScript reloads the page on "reload" event, or if the server reports
+// a different boot ID after reconnecting
+const liveReloadScript = `<script>(function(){var boot,es=new EventSource("` + LiveReloadPath + `");` +
+	`es.addEventListener("boot",function(e){if(boot&&boot!==e.data){location.reload()}boot=e.data});` +
+	`es.addEventListener("reload",function(){location.reload()})})()</script>`
+
+var liveReload struct {
+	sync.Mutex
+	once    sync.Once
+	boot    string
+	clients map[chan struct{}]struct{}
+}
+
+// LiveReload wraps the handler for development: it injects a small script into
+// served HTML pages, which reloads the page whenever the server restarts or, in
+// development build, assets change in the source directory. Reload events are
+// pushed with Server-Sent Events at LiveReloadPath, which must be routed to the
+// returned handler. LiveReload is not intended for production use.
+func LiveReload(h http.Handler) http.Handler {
+	liveReload.once.Do(func() {
+		liveReload.boot = strconv.FormatInt(time.Now().UnixNano(), 36)
+		liveReload.clients = make(map[chan struct{}]struct{})
+		go watchAssets(notifyLiveReload)
+	})
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == LiveReloadPath {
+			serveLiveReload(w, req)
+			return
+		}
+		if req.Method != "GET" {
+			h.ServeHTTP(w, req)
+			return
+		}
+		// script is injected into uncompressed and whole content only
+		req = req.Clone(req.Context())
+		req.Header.Del("Accept-Encoding")
+		req.Header.Del("Range")
+		lw := &liveReloadWriter{ResponseWriter: w}
+		h.ServeHTTP(lw, req)
+		lw.finish()
+	})
+}
+
+func notifyLiveReload() {
+	liveReload.Lock()
+	defer liveReload.Unlock()
+	for ch := range liveReload.clients {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func serveLiveReload(w http.ResponseWriter, req *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+	ch := make(chan struct{}, 1)
+	liveReload.Lock()
+	liveReload.clients[ch] = struct{}{}
+	liveReload.Unlock()
+	defer func() {
+		liveReload.Lock()
+		delete(liveReload.clients, ch)
+		liveReload.Unlock()
+	}()
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	io.WriteString(w, "event: boot\ndata: "+liveReload.boot+"\n\n")
+	flusher.Flush()
+	ping := time.NewTicker(15 * time.Second)
+	defer ping.Stop()
+	for {
+		select {
+		case <-req.Context().Done():
+			return
+		case <-ch:
+			io.WriteString(w, "event: reload\ndata: \n\n")
+		case <-ping.C:
+			io.WriteString(w, ": ping\n\n")
+		}
+		flusher.Flush()
+	}
+}
+
+// liveReloadWriter holds back HTML responses to inject the live reload script
+type liveReloadWriter struct {
+	http.ResponseWriter
+	status int
+	html   bool
+	buf    bytes.Buffer
+}
+
+func (w *liveReloadWriter) WriteHeader(status int) {
+	if w.status != 0 {
+		return
+	}
+	w.status = status
+	h := w.Header()
+	if status == http.StatusNotModified || h.Get("Content-Encoding") != "" ||
+		!strings.HasPrefix(h.Get("Content-Type"), "text/html") {
+		w.ResponseWriter.WriteHeader(status)
+		return
+	}
+	w.html = true
+	// validators and ranges of the original content do not apply to the injected one
+	h.Del("Content-Length")
+	h.Del("Etag")
+	h.Del("Last-Modified")
+	h.Del("Accept-Ranges")
+}
+
+func (w *liveReloadWriter) Write(p []byte) (int, error) {
+	if w.status == 0 {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.html {
+		return w.buf.Write(p)
+	}
+	return w.ResponseWriter.Write(p)
+}
+
+func (w *liveReloadWriter) finish() {
+	if !w.html {
+		return
+	}
+	body := w.buf.Bytes()
+	pos := bytes.LastIndex(bytes.ToLower(body), []byte("</body>"))
+	if pos < 0 {
+		pos = len(body)
+	}
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)+len(liveReloadScript)))
+	w.ResponseWriter.WriteHeader(w.status)
+	w.ResponseWriter.Write(body[:pos])
+	io.WriteString(w.ResponseWriter, liveReloadScript)
+	w.ResponseWriter.Write(body[pos:])
 }
 {{- end}}
 
@@ -850,17 +2494,23 @@ var (
 	cert       string
 	key        string
 	extract    string
-	list       bool
+	listOnly   bool
 	help       bool
+{{- if .Encrypted }}
+	unlockFile string
+{{- end }}
 )
 
 func init() {
 	flag.BoolVar(&help, "help", false, "prints help")
-	flag.BoolVar(&list, "list", false, "list contents and exit")
+	flag.BoolVar(&listOnly, "list", false, "list contents and exit")
 	flag.StringVar(&extract, "extract", "", "extract contents to the target `directory` and exit")
 	flag.StringVar(&listenAddr, "listen", ":8080", "socket `address` to listen")
 	flag.StringVar(&cert, "tls-cert", "", "TLS certificate `file` to use")
 	flag.StringVar(&key, "tls-key", "", "TLS key `file` to use")
+{{- if .Encrypted }}
+	flag.StringVar(&unlockFile, "unlock-key-file", "", "unlock encrypted assets with hex-encoded key read from `file`")
+{{- end }}
 }
 
 func main() {
@@ -871,7 +2521,24 @@ func main() {
 		flag.Usage()
 		return
 	}
-	if list {
+{{- if .Encrypted }}
+	if unlockFile != "" {
+		data, err := ioutil.ReadFile(unlockFile)
+		if err == nil {
+			var k []byte
+			if k, err = hex.DecodeString(strings.TrimSpace(string(data))); err == nil {
+				err = Unlock(k)
+			}
+		}
+		if err != nil {
+			os.Stderr.WriteString("error unlocking content: ")
+			os.Stderr.WriteString(err.Error())
+			os.Stderr.WriteString("\n")
+			os.Exit(1)
+		}
+	}
+{{- end }}
+	if listOnly {
 		FS().Walk("", func(path string, info os.FileInfo, err error) error {
 			if info.IsDir() {
 				return nil
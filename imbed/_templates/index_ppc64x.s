@@ -1,6 +1,8 @@
 // Code generated by go-imbed. DO NOT EDIT.
 
+//go:build (ppc64 || ppc64le) && !imbed_dev
 // +build ppc64 ppc64le
+// +build !imbed_dev
 
 #include "textflag.h"
 
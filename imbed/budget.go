@@ -0,0 +1,139 @@
+// Copyright 2017 Alexey Naidyonov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+package imbed
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// Budget limits the stored (i.e., compressed) size of assets
+type Budget struct {
+	// Pattern selects assets the budget applies to (see MatchPattern),
+	// an empty pattern selects all the assets.
+	Pattern string
+	// Limit is the maximum size in bytes.
+	Limit int64
+	// PerFile applies the limit to every asset, rather than to the total
+	// size of all the selected assets.
+	PerFile bool
+}
+
+func (b Budget) String() string {
+	var s string
+	if b.Pattern != "" {
+		s = b.Pattern + " "
+	}
+	if b.PerFile {
+		s += "per file"
+	} else {
+		s += "total"
+	}
+	return s
+}
+
+// BudgetOffender describes an exceeded budget
+type BudgetOffender struct {
+	Budget Budget
+	Path   string // Asset path for per-file budgets, empty for total ones
+	Assets int    // Number of assets counted
+	Size   int64  // Stored size of the counted assets
+}
+
+// BudgetError is returned by Imbed if any of the budgets is exceeded,
+// nothing is generated then.
+type BudgetError struct {
+	Offenders []BudgetOffender
+}
+
+func (e *BudgetError) Error() string {
+	var buf bytes.Buffer
+	buf.WriteString("size budget exceeded:\n")
+	w := tabwriter.NewWriter(&buf, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "BUDGET\tASSETS\tSIZE\tLIMIT")
+	for _, o := range e.Offenders {
+		assets := o.Path
+		if assets == "" && o.Assets == 1 {
+			assets = "1 asset"
+		} else if assets == "" {
+			assets = strconv.Itoa(o.Assets) + " assets"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", o.Budget, assets, FormatSize(o.Size), FormatSize(o.Budget.Limit))
+	}
+	w.Flush()
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// checkBudgets returns *BudgetError listing all the exceeded budgets, if any
+func checkBudgets(budgets []Budget, assets []*fileAsset) error {
+	var offenders []BudgetOffender
+	for _, b := range budgets {
+		total := BudgetOffender{Budget: b}
+		for _, a := range assets {
+			if b.Pattern != "" && !MatchPattern(b.Pattern, a.path) {
+				continue
+			}
+			size := int64(a.offStop - a.offStart)
+			if b.PerFile && size > b.Limit {
+				offenders = append(offenders, BudgetOffender{Budget: b, Path: a.path, Assets: 1, Size: size})
+			}
+			total.Assets++
+			total.Size += size
+		}
+		if !b.PerFile && total.Size > b.Limit {
+			offenders = append(offenders, total)
+		}
+	}
+	if offenders != nil {
+		return &BudgetError{Offenders: offenders}
+	}
+	return nil
+}
+
+var sizeUnits = []struct {
+	suffix string
+	size   int64
+}{
+	{"KiB", 1 << 10}, {"MiB", 1 << 20}, {"GiB", 1 << 30},
+	{"KB", 1e3}, {"MB", 1e6}, {"GB", 1e9},
+	{"K", 1 << 10}, {"M", 1 << 20}, {"G", 1 << 30},
+	{"B", 1},
+}
+
+// ParseSize parses size with an optional unit suffix: B, KB, MB and GB
+// are decimal units, KiB, MiB and GiB (as well as K, M and G) are binary ones.
+func ParseSize(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	unit := int64(1)
+	for _, u := range sizeUnits {
+		if strings.HasSuffix(strings.ToUpper(s), strings.ToUpper(u.suffix)) {
+			s = strings.TrimSpace(s[:len(s)-len(u.suffix)])
+			unit = u.size
+			break
+		}
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil || v < 0 {
+		return 0, fmt.Errorf("invalid size %q", s)
+	}
+	return int64(v * float64(unit)), nil
+}
+
+// FormatSize formats size in binary units
+func FormatSize(size int64) string {
+	switch {
+	case size >= 1<<30:
+		return strconv.FormatFloat(float64(size)/(1<<30), 'f', 1, 64) + " GiB"
+	case size >= 1<<20:
+		return strconv.FormatFloat(float64(size)/(1<<20), 'f', 1, 64) + " MiB"
+	case size >= 1<<10:
+		return strconv.FormatFloat(float64(size)/(1<<10), 'f', 1, 64) + " KiB"
+	default:
+		return strconv.FormatInt(size, 10) + " B"
+	}
+}
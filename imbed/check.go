@@ -0,0 +1,398 @@
+// Copyright 2017 Alexey Naidyonov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+package imbed
+
+import (
+	"crypto/ed25519"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"hash/crc64"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ChangeKind classifies differences between the source directory and the generated package
+type ChangeKind int
+
+const (
+	// Asset is present in the source directory only
+	AssetAdded ChangeKind = iota
+	// Asset is present in the generated package only
+	AssetRemoved
+	// Asset content or the way it is stored has changed
+	AssetChanged
+	// Generation flags or options have changed
+	FlagsChanged
+)
+
+func (k ChangeKind) String() string {
+	switch k {
+	case AssetAdded:
+		return "added"
+	case AssetRemoved:
+		return "removed"
+	case AssetChanged:
+		return "changed"
+	default:
+		return "flags"
+	}
+}
+
+// Change describes a difference between the source directory and the generated package
+type Change struct {
+	Kind   ChangeKind
+	Path   string // Asset path, empty for FlagsChanged
+	Detail string // What has changed
+}
+
+func (c Change) String() string {
+	s := c.Kind.String() + ":"
+	if c.Path != "" {
+		s += " " + c.Path
+	}
+	if c.Detail != "" {
+		s += " (" + c.Detail + ")"
+	}
+	return s
+}
+
+// recordedPackage holds generation parameters and assets read from the generated package
+type recordedPackage struct {
+	name      string
+	flags     ImbedFlag
+	publicKey string // hex encoded, empty unless signed
+	initCheck string
+	assets    map[string]recordedAsset
+}
+
+// recordedAsset holds attributes of an asset read from the generated package
+type recordedAsset struct {
+	mimeType     string
+	tag          string
+	isCompressed bool
+	isEncrypted  bool
+	signed       bool
+}
+
+// Check compares the source directory with the package previously generated
+// into target with the same arguments, and returns the list of changes, ordered
+// by asset path, regeneration would introduce. Check neither compresses content,
+// nor writes anything. An empty list means the package is up to date.
+func Check(source, target, pkgName string, flags ImbedFlag, opts *Options) ([]Change, error) {
+	flags = impliedFlags(pkgName, flags)
+	rec, err := readPackage(filepath.Join(target, "data.go"))
+	if err != nil {
+		return nil, err
+	}
+	recorded := rec.assets
+	var changes []Change
+	if rec.name != pkgName {
+		changes = append(changes, Change{Kind: FlagsChanged, Detail: "package " + rec.name + " -> " + pkgName})
+	}
+	if rec.flags != flags {
+		changes = append(changes, Change{Kind: FlagsChanged, Detail: "[" + rec.flags.String() + "] -> [" + flags.String() + "]"})
+	}
+	signed := opts != nil && opts.SigningKey != nil
+	if len(recorded) > 0 {
+		var recSigned bool
+		for _, a := range recorded {
+			recSigned = a.signed
+			break
+		}
+		if recSigned != signed {
+			changes = append(changes, Change{Kind: FlagsChanged, Detail: fmt.Sprintf("signing: %v -> %v", recSigned, signed)})
+		} else if signed && rec.publicKey != hex.EncodeToString(opts.SigningKey.Public().(ed25519.PublicKey)) {
+			changes = append(changes, Change{Kind: FlagsChanged, Detail: "signing key"})
+		}
+	}
+	var initCheck string
+	if signed {
+		initCheck = opts.InitCheck.String()
+	}
+	if rec.initCheck != initCheck {
+		changes = append(changes, Change{Kind: FlagsChanged, Detail: fmt.Sprintf("verify on init: %q -> %q", rec.initCheck, initCheck)})
+	}
+	var policies []CachePolicy
+	if opts != nil {
+		policies = opts.CachePolicies
+	}
+	if recPolicies, err := readCachePolicies(filepath.Join(target, "index.go")); err != nil {
+		return nil, err
+	} else if !sameCachePolicies(recPolicies, policies) {
+		changes = append(changes, Change{Kind: FlagsChanged, Detail: "cache policies"})
+	}
+	var headers []SecurityHeader
+	if opts != nil {
+		headers = opts.SecurityHeaders
+	}
+	if recHeaders, err := readSecurityHeaders(filepath.Join(target, "index.go")); err != nil {
+		return nil, err
+	} else if !sameSecurityHeaders(recHeaders, headers) {
+		changes = append(changes, Change{Kind: FlagsChanged, Detail: "security headers"})
+	}
+	var assetChanges []Change
+	seen := make(map[string]bool)
+	err = filepath.Walk(source, func(asset string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		assetName, _ := filepath.Rel(source, asset)
+		assetName = filepath.ToSlash(assetName)
+		seen[assetName] = true
+		rec, ok := recorded[assetName]
+		if !ok {
+			assetChanges = append(assetChanges, Change{Kind: AssetAdded, Path: assetName})
+			return nil
+		}
+		tag, err := contentTag(asset)
+		if err != nil {
+			return err
+		}
+		m := mimeType(assetName)
+		var details []string
+		if tag != rec.tag {
+			details = append(details, "content")
+		}
+		if m != rec.mimeType {
+			details = append(details, "mime type "+rec.mimeType+" -> "+m)
+		}
+		if compressed := flags.CompressAssets() && compressible(m); compressed != rec.isCompressed {
+			details = append(details, fmt.Sprintf("compressed %v -> %v", rec.isCompressed, compressed))
+		}
+		if encrypted := opts.encrypts(assetName); encrypted != rec.isEncrypted {
+			details = append(details, fmt.Sprintf("encrypted %v -> %v", rec.isEncrypted, encrypted))
+		}
+		for _, d := range details {
+			assetChanges = append(assetChanges, Change{Kind: AssetChanged, Path: assetName, Detail: d})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for name := range recorded {
+		if !seen[name] {
+			assetChanges = append(assetChanges, Change{Kind: AssetRemoved, Path: name})
+		}
+	}
+	sort.SliceStable(assetChanges, func(i, j int) bool { return assetChanges[i].Path < assetChanges[j].Path })
+	return append(changes, assetChanges...), nil
+}
+
+// contentTag computes the tag of a file content the same way writeObject does
+func contentTag(name string) (string, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	crc := crc64.New(crcTable)
+	if _, err = io.Copy(crc, file); err != nil {
+		return "", err
+	}
+	var crcBuf [8]byte
+	binary.LittleEndian.PutUint64(crcBuf[:], crc.Sum64())
+	return b32Enc.EncodeToString(crcBuf[:]), nil
+}
+
+// readPackage reads the package name, generation parameters and asset
+// definitions from the generated data.go
+func readPackage(name string) (*recordedPackage, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), name, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		rec      = &recordedPackage{name: f.Name.Name}
+		hasFlags bool
+		root     *ast.CompositeLit
+	)
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.ValueSpec:
+			if len(n.Names) != 1 || len(n.Values) != 1 {
+				break
+			}
+			lit, ok := n.Values[0].(*ast.BasicLit)
+			if !ok {
+				break
+			}
+			switch n.Names[0].Name {
+			case "imbedFlags":
+				v, err := strconv.Atoi(lit.Value)
+				rec.flags, hasFlags = ImbedFlag(v), err == nil
+			case "publicKey":
+				rec.publicKey, _ = strconv.Unquote(lit.Value)
+			case "initCheck":
+				rec.initCheck, _ = strconv.Unquote(lit.Value)
+			}
+		case *ast.AssignStmt:
+			if id, ok := n.Lhs[0].(*ast.Ident); ok && id.Name == "root" && len(n.Rhs) == 1 {
+				if u, ok := n.Rhs[0].(*ast.UnaryExpr); ok {
+					root, _ = u.X.(*ast.CompositeLit)
+				}
+			}
+		}
+		return true
+	})
+	if !hasFlags || root == nil {
+		return nil, fmt.Errorf("%s was not generated by this version of go-imbed", name)
+	}
+	rec.assets = make(map[string]recordedAsset)
+	readDirectory(root, "", rec.assets)
+	return rec, nil
+}
+
+// readCachePolicies reads default cache policies from the generated index.go
+func readCachePolicies(name string) ([]CachePolicy, error) {
+	elts, err := readLiteralVar(name, "CachePolicies")
+	if err != nil {
+		return nil, err
+	}
+	var policies []CachePolicy
+	for _, fields := range elts {
+		policies = append(policies, CachePolicy{Pattern: fields["Pattern"], Value: fields["Value"]})
+	}
+	return policies, nil
+}
+
+// readSecurityHeaders reads security headers set at generation time from the generated index.go
+func readSecurityHeaders(name string) ([]SecurityHeader, error) {
+	elts, err := readLiteralVar(name, "SecurityHeaders")
+	if err != nil {
+		return nil, err
+	}
+	var headers []SecurityHeader
+	for _, fields := range elts {
+		headers = append(headers, SecurityHeader{Pattern: fields["Pattern"], Name: fields["Name"], Value: fields["Value"]})
+	}
+	return headers, nil
+}
+
+// readLiteralVar returns fields of the elements of the slice literal assigned to
+// the package variable in the file
+func readLiteralVar(name, varName string) ([]map[string]string, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), name, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	var elts []map[string]string
+	ast.Inspect(f, func(n ast.Node) bool {
+		spec, ok := n.(*ast.ValueSpec)
+		if !ok || len(spec.Names) != 1 || spec.Names[0].Name != varName || len(spec.Values) != 1 {
+			return true
+		}
+		if lit, ok := spec.Values[0].(*ast.CompositeLit); ok {
+			for _, elt := range lit.Elts {
+				if p, ok := elt.(*ast.CompositeLit); ok {
+					elts = append(elts, literalFields(p))
+				}
+			}
+		}
+		return false
+	})
+	return elts, nil
+}
+
+func sameCachePolicies(a, b []CachePolicy) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func sameSecurityHeaders(a, b []SecurityHeader) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Pattern != b[i].Pattern || !strings.EqualFold(a[i].Name, b[i].Name) || a[i].Value != b[i].Value {
+			return false
+		}
+	}
+	return true
+}
+
+func readDirectory(lit *ast.CompositeLit, dir string, assets map[string]recordedAsset) {
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		list, ok := kv.Value.(*ast.CompositeLit)
+		if !ok {
+			continue
+		}
+		switch key.Name {
+		case "dirs":
+			for _, d := range list.Elts {
+				if d, ok := d.(*ast.CompositeLit); ok {
+					readDirectory(d, path.Join(dir, literalFields(d)["name"]), assets)
+				}
+			}
+		case "files":
+			for _, a := range list.Elts {
+				a, ok := a.(*ast.CompositeLit)
+				if !ok {
+					continue
+				}
+				fields := literalFields(a)
+				_, signed := fields["digest"]
+				assets[path.Join(dir, fields["name"])] = recordedAsset{
+					mimeType:     fields["mime"],
+					tag:          fields["tag"],
+					isCompressed: fields["isCompressed"] == "true",
+					isEncrypted:  fields["isEncrypted"] == "true",
+					signed:       signed,
+				}
+			}
+		}
+	}
+}
+
+// literalFields returns values of string and boolean fields of the composite literal
+func literalFields(lit *ast.CompositeLit) map[string]string {
+	fields := make(map[string]string)
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		switch v := kv.Value.(type) {
+		case *ast.BasicLit:
+			if v.Kind == token.STRING {
+				fields[key.Name], _ = strconv.Unquote(v.Value)
+			}
+		case *ast.Ident:
+			fields[key.Name] = v.Name
+		}
+	}
+	return fields
+}
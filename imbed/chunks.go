@@ -0,0 +1,124 @@
+// Copyright 2017 Alexey Naidyonov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+package imbed
+
+import (
+	"compress/flate"
+	"encoding/binary"
+	"hash"
+	"hash/crc32"
+	"io"
+)
+
+// chunkSize is the amount of uncompressed content in every independently
+// compressed chunk of a compressed asset. It must match the generated code.
+const chunkSize = 64 << 10
+
+// gzipHeader is a minimal gzip member header: no name, no modification time,
+// maximum compression, unknown OS. It is the same header compress/gzip writes.
+var gzipHeader = []byte{0x1f, 0x8b, 8, 0, 0, 0, 0, 0, 2, 0xff}
+
+// chunkWriter produces a standard gzip stream made of independently compressed
+// chunks. The compressor is reset at the start of every chunk and sync flushed
+// at its end, so every chunk starts at a byte boundary and refers to no content
+// before it: decompression can start at any chunk with a raw flate reader.
+// Offsets of the chunks within the stream are collected in chunks.
+type chunkWriter struct {
+	w      io.Writer
+	fw     *flate.Writer
+	crc    hash.Hash32
+	buf    []byte   // pending chunk content
+	off    int      // number of bytes written to w
+	size   uint32   // total size of the content, modulo 2^32
+	chunks []uint32 // offsets of the chunks within the stream
+	err    error
+}
+
+func newChunkWriter() *chunkWriter {
+	fw, _ := flate.NewWriter(nil, flate.BestCompression)
+	return &chunkWriter{
+		fw:  fw,
+		crc: crc32.NewIEEE(),
+		buf: make([]byte, 0, chunkSize),
+	}
+}
+
+// Reset starts a new stream written to w
+func (c *chunkWriter) Reset(w io.Writer) {
+	c.w = w
+	c.crc.Reset()
+	c.buf = c.buf[:0]
+	c.off = 0
+	c.size = 0
+	c.chunks = nil
+	c.err = nil
+	c.write(gzipHeader)
+}
+
+// write writes p to the underlying writer, counting the written bytes
+func (c *chunkWriter) write(p []byte) {
+	if c.err != nil {
+		return
+	}
+	var n int
+	n, c.err = c.w.Write(p)
+	c.off += n
+}
+
+func (c *chunkWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 && c.err == nil {
+		n := copy(c.buf[len(c.buf):cap(c.buf)], p)
+		c.buf = c.buf[:len(c.buf)+n]
+		written += n
+		p = p[n:]
+		if len(c.buf) == cap(c.buf) {
+			c.flushChunk()
+		}
+	}
+	return written, c.err
+}
+
+func (c *chunkWriter) flushChunk() {
+	if len(c.buf) == 0 || c.err != nil {
+		return
+	}
+	c.chunks = append(c.chunks, uint32(c.off))
+	c.crc.Write(c.buf)
+	c.size += uint32(len(c.buf))
+	c.fw.Reset(chunkOutput{c})
+	if _, err := c.fw.Write(c.buf); err != nil && c.err == nil {
+		c.err = err
+	}
+	if err := c.fw.Flush(); err != nil && c.err == nil {
+		c.err = err
+	}
+	c.buf = c.buf[:0]
+}
+
+// Close writes the pending chunk, the final (empty) deflate block and the gzip trailer
+func (c *chunkWriter) Close() error {
+	c.flushChunk()
+	var trailer [10]byte
+	// an empty fixed Huffman block with BFINAL set
+	trailer[0], trailer[1] = 0x03, 0x00
+	binary.LittleEndian.PutUint32(trailer[2:], c.crc.Sum32())
+	binary.LittleEndian.PutUint32(trailer[6:], c.size)
+	c.write(trailer[:])
+	return c.err
+}
+
+// chunkOutput directs the compressor output through chunkWriter.write
+type chunkOutput struct {
+	c *chunkWriter
+}
+
+func (o chunkOutput) Write(p []byte) (int, error) {
+	o.c.write(p)
+	if o.c.err != nil {
+		return 0, o.c.err
+	}
+	return len(p), nil
+}
@@ -72,6 +72,9 @@ func (f ImbedFlag) String() string {
 	if f.has(BuildUnionFsAPI) {
 		add("UnionFS")
 	}
+	if f.has(BuildRawBytesAPI) {
+		add("RawBytes")
+	}
 	if f.has(BuildMain) {
 		add("Main")
 	}
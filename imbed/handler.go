@@ -0,0 +1,99 @@
+// Copyright 2017 Alexey Naidyonov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+package imbed
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// CachePolicy sets "Cache-Control" header the generated HTTP handler sends
+// for assets matching the pattern
+type CachePolicy struct {
+	// Pattern selects assets by path (see MatchPattern), or by MIME type
+	// without parameters if prefixed with "type:", e.g. "type:image/*".
+	// An empty pattern selects all the assets.
+	Pattern string
+	// Value of "Cache-Control" header, e.g. "no-cache" or "max-age=31536000, immutable".
+	// An empty value sends no header.
+	Value string
+}
+
+// mimePatternPrefix marks patterns matched against MIME type instead of path
+const mimePatternPrefix = "type:"
+
+// checkAssetPattern returns an error if the pattern is malformed
+func checkAssetPattern(pattern string) error {
+	p := strings.TrimPrefix(pattern, mimePatternPrefix)
+	for _, elt := range strings.Split(p, "/") {
+		if _, err := path.Match(elt, ""); err != nil {
+			return fmt.Errorf("malformed pattern %q", pattern)
+		}
+	}
+	return nil
+}
+
+// checkCachePolicies returns an error if any of the policies can not be used
+func checkCachePolicies(policies []CachePolicy) error {
+	for _, p := range policies {
+		if err := checkAssetPattern(p.Pattern); err != nil {
+			return fmt.Errorf("cache policy: %s", err)
+		}
+		if strings.ContainsAny(p.Value, "\r\n") {
+			return fmt.Errorf("cache policy: invalid header value %q", p.Value)
+		}
+	}
+	return nil
+}
+
+// SecurityHeader sets a security header the generated HTTP handler sends for
+// assets matching the pattern (see CachePolicy), such as "Content-Security-Policy".
+// For every header name, the first matching entry applies. The generated handler
+// sends secure defaults (nosniff, same origin framing, strict referrer policy)
+// unless they are overridden; an empty value disables the header.
+type SecurityHeader struct {
+	Pattern string
+	Name    string // one of SecurityHeaderNames
+	Value   string
+}
+
+// SecurityHeaderNames lists the headers SecurityHeader may set
+var SecurityHeaderNames = []string{
+	"Content-Security-Policy",
+	"Content-Security-Policy-Report-Only",
+	"Permissions-Policy",
+	"Referrer-Policy",
+	"Strict-Transport-Security",
+	"X-Content-Type-Options",
+	"X-Frame-Options",
+}
+
+// securityHeaderName returns the canonical name of the security header, or
+// an empty string if the header is not a security header
+func securityHeaderName(name string) string {
+	for _, n := range SecurityHeaderNames {
+		if strings.EqualFold(n, name) {
+			return n
+		}
+	}
+	return ""
+}
+
+// checkSecurityHeaders returns an error if any of the headers can not be used
+func checkSecurityHeaders(headers []SecurityHeader) error {
+	for _, h := range headers {
+		if err := checkAssetPattern(h.Pattern); err != nil {
+			return fmt.Errorf("security header: %s", err)
+		}
+		if securityHeaderName(h.Name) == "" {
+			return fmt.Errorf("security header: unsupported header %q", h.Name)
+		}
+		if strings.ContainsAny(h.Value, "\r\n") {
+			return fmt.Errorf("security header: invalid %s value %q", h.Name, h.Value)
+		}
+	}
+	return nil
+}
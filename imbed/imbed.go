@@ -7,15 +7,21 @@
 */
 package imbed
 
-//go:generate go run -tags bootstrap ../cmd.go --no-http-handler --fs _templates internal/templates
+//go:generate go run -tags bootstrap .. --no-http-handler --fs _templates internal/templates
 
 import (
+	"bufio"
 	"bytes"
-	"compress/gzip"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
 	"encoding/base32"
 	"encoding/binary"
+	"encoding/hex"
 	"fmt"
-	"go/format"
+	"hash"
 	"hash/crc64"
 	"io"
 	"io/ioutil"
@@ -23,6 +29,8 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -37,24 +45,28 @@ type directoryAsset struct {
 
 type fileAsset struct {
 	name         string
+	path         string
 	mimeType     string
 	tag          string
 	size         int64
 	isCompressed bool
+	isEncrypted  bool
+	chunks       []uint32 // offsets of compressed chunks within the gzip stream
+	digest       []byte
 	offStart     int
 	offStop      int
 }
 
-func buildIndex(d *directoryAsset, flags ImbedFlag) (string, string, bool) {
+func buildIndex(d *directoryAsset, flags ImbedFlag) (string, string) {
 	var dir bytes.Buffer
 	var index bytes.Buffer
 	addIndent(&dir, 1)
 	dir.WriteString("root = &directoryAsset")
 	addIndent(&index, 1)
 	index.WriteString("didx[\"\"] = root\n")
-	has404Asset := buildDirIndex(flags, &dir, &index, d, "", "root", 1)
+	buildDirIndex(flags, &dir, &index, d, "", "root", 1)
 	dir.WriteRune('\n')
-	return dir.String(), index.String(), has404Asset
+	return dir.String(), index.String()
 }
 
 func addIndent(buf *bytes.Buffer, n int) {
@@ -63,8 +75,7 @@ func addIndent(buf *bytes.Buffer, n int) {
 	}
 }
 
-func buildDirIndex(flags ImbedFlag, dir *bytes.Buffer, index *bytes.Buffer, d *directoryAsset, p, indexPrefix string, indent int) bool {
-	var has404Asset bool
+func buildDirIndex(flags ImbedFlag, dir *bytes.Buffer, index *bytes.Buffer, d *directoryAsset, p, indexPrefix string, indent int) {
 	dir.WriteString("{\n")
 	if d.name != "" {
 		addIndent(dir, indent+1)
@@ -93,18 +104,12 @@ func buildDirIndex(flags ImbedFlag, dir *bytes.Buffer, index *bytes.Buffer, d *d
 			dir.WriteString(",\n")
 			addIndent(index, 1)
 			fmt.Fprintf(index, "fidx[\"%s\"] = &%s.files[%d]\n", path.Join(p, fn), indexPrefix, i)
-			if flags.has(BuildHttpHandlerAPI) && p == "" && fn == "404.html" {
-				addIndent(index, 1)
-				fmt.Fprintf(index, "http404Asset = &%s.files[%d]\n", indexPrefix, i)
-				has404Asset = true
-			}
 		}
 		addIndent(dir, indent+1)
 		dir.WriteString("},\n")
 	}
 	addIndent(dir, indent)
 	dir.WriteString("}")
-	return has404Asset
 }
 
 func (f *fileAsset) writeDefinition(w *bytes.Buffer, ind int, flags ImbedFlag) {
@@ -125,6 +130,25 @@ func (f *fileAsset) writeDefinition(w *bytes.Buffer, ind int, flags ImbedFlag) {
 		addIndent(w, ind+1)
 		fmt.Fprintf(w, "isCompressed: %v,\n", f.isCompressed)
 	}
+	if len(f.chunks) > 0 {
+		addIndent(w, ind+1)
+		fmt.Fprint(w, "chunks:       []uint32{")
+		for i, off := range f.chunks {
+			if i > 0 {
+				fmt.Fprint(w, ", ")
+			}
+			fmt.Fprint(w, off)
+		}
+		fmt.Fprint(w, "},\n")
+	}
+	if f.isEncrypted {
+		addIndent(w, ind+1)
+		fmt.Fprint(w, "isEncrypted:  true,\n")
+	}
+	if f.digest != nil {
+		addIndent(w, ind+1)
+		fmt.Fprintf(w, "digest:       \"%x\",\n", f.digest)
+	}
 	addIndent(w, ind)
 	fmt.Fprint(w, "}")
 }
@@ -168,6 +192,9 @@ var b32Enc = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(
 
 const objectFileHeaderTemplate = `// Code generated by go-imbed. DO NOT EDIT.
 
+//go:build !imbed_dev
+// +build !imbed_dev
+
 #include "textflag.h"
 
 `
@@ -175,193 +202,448 @@ const objectFileHeaderTemplate = `// Code generated by go-imbed. DO NOT EDIT.
 const objectFileFooterTemplate = `GLOBL ·d(SB),RODATA,$%d
 `
 
-func writeObjectFileHeader(file *os.File) error {
-	_, err := file.WriteString(objectFileHeaderTemplate)
+// dataLineWidth is the number of bytes emitted with a single DATA directive
+const dataLineWidth = 64
+
+// dataEscapes maps every byte to its representation within assembler
+// string literal: printable ASCII characters are kept as is, everything
+// else (including quote and backslash) is escaped as \xNN.
+var dataEscapes = func() (t [256]string) {
+	const hex = "0123456789abcdef"
+	for i := range t {
+		if i >= 0x20 && i < 0x7f && i != '"' && i != '\\' {
+			t[i] = string(rune(i))
+		} else {
+			t[i] = string([]byte{'\\', 'x', hex[i>>4], hex[i&0x0f]})
+		}
+	}
+	return
+}()
+
+var crcTable = crc64.MakeTable(crc64.ECMA)
+
+// dataWriter encodes a stream of bytes into a sequence of DATA directives
+// filling the ·d symbol. Bytes are accumulated until a full line of
+// dataLineWidth is collected, so assets are laid out back to back.
+type dataWriter struct {
+	w        *bufio.Writer
+	addr     int                 // offset of the pending line
+	n        int                 // number of bytes in the pending line
+	buf      [dataLineWidth]byte // pending line
+	line     []byte              // scratch buffer for the encoded line
+	copy     []byte              // scratch buffer for io.CopyBuffer
+	gz       *chunkWriter        // reusable compressor
+	nonceKey []byte              // HMAC key nonces are derived with, see nonceKey
+	hash     hash.Hash           // if set, digest of stored asset content is computed
+}
+
+func newDataWriter(w io.Writer) *dataWriter {
+	return &dataWriter{
+		w:    bufio.NewWriterSize(w, 1<<16),
+		line: make([]byte, 0, 16+dataLineWidth*4+32),
+		copy: make([]byte, 1<<15),
+	}
+}
+
+// Offset returns the offset of the next byte to be written
+func (d *dataWriter) Offset() int {
+	return d.addr + d.n
+}
+
+func (d *dataWriter) Write(p []byte) (int, error) {
+	if d.hash != nil {
+		d.hash.Write(p)
+	}
+	written := 0
+	for len(p) > 0 {
+		n := copy(d.buf[d.n:], p)
+		d.n += n
+		written += n
+		p = p[n:]
+		if d.n == len(d.buf) {
+			if err := d.flushLine(); err != nil {
+				return written, err
+			}
+		}
+	}
+	return written, nil
+}
+
+func (d *dataWriter) flushLine() error {
+	if d.n == 0 {
+		return nil
+	}
+	line := append(d.line[:0], "DATA ·d+"...)
+	line = strconv.AppendInt(line, int64(d.addr), 10)
+	line = append(line, "(SB)/"...)
+	line = strconv.AppendInt(line, int64(d.n), 10)
+	line = append(line, ",$\""...)
+	for _, c := range d.buf[:d.n] {
+		line = append(line, dataEscapes[c]...)
+	}
+	line = append(line, "\"\n"...)
+	d.line = line
+	d.addr += d.n
+	d.n = 0
+	_, err := d.w.Write(line)
 	return err
 }
 
-func writeObjectFileFooter(file *os.File, size int) error {
-	_, err := fmt.Fprintf(file, objectFileFooterTemplate, size)
+func writeObjectFileHeader(d *dataWriter) error {
+	_, err := d.w.WriteString(objectFileHeaderTemplate)
 	return err
 }
 
-func (a *fileAsset) writeObject(input *os.File, output *os.File, start int, flags ImbedFlag) (int, error) {
-	var compressor *gzip.Writer
-	var err error
-	if _, err := input.Seek(0, 0); err != nil {
-		return 0, err
+func writeObjectFileFooter(d *dataWriter) error {
+	if err := d.flushLine(); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(d.w, objectFileFooterTemplate, d.addr); err != nil {
+		return err
+	}
+	return d.w.Flush()
+}
+
+// writeObject writes (compressed and encrypted, if requested) content of the asset
+// to output. Compressed assets are stored as a gzip stream of independently
+// compressed chunks (see chunkWriter). Encrypted assets are stored as nonce followed by the AES-GCM sealed
+// content, with the asset path as additional data. The nonce is an HMAC of the path
+// and the content keyed with nonceKey, so the output stays reproducible.
+func (a *fileAsset) writeObject(input io.Reader, output *dataWriter, aead cipher.AEAD) error {
+	var (
+		crc       = crc64.New(crcTable)
+		plaintext bytes.Buffer
+		stored    io.Writer = output
+		dst       io.Writer
+	)
+	start := output.Offset()
+	if output.hash != nil {
+		output.hash.Reset()
+	}
+	if a.isEncrypted {
+		// content, compressed if requested, is sealed as a whole
+		stored = &plaintext
 	}
-	crc := uint64(0)
-	crcTable := crc64.MakeTable(crc64.ECMA)
-	pipeIn, pipeOut := io.Pipe()
 	if a.isCompressed {
-		compressor, _ = gzip.NewWriterLevel(pipeOut, gzip.BestCompression)
-	}
-	go func() {
-		buf := make([]byte, 8192)
-		for {
-			n, err := input.Read(buf)
-			if err == io.EOF {
-				if a.isCompressed {
-					compressor.Close()
-				}
-				break
-			} else if err != nil {
-				pipeOut.CloseWithError(err)
-				return
-			}
-			crc = crc64.Update(crc, crcTable, buf[:n])
-			if a.isCompressed {
-				_, err = compressor.Write(buf[:n])
-			} else {
-				_, err = pipeOut.Write(buf[:n])
-			}
-			if err != nil {
-				pipeOut.CloseWithError(err)
-				return
-			}
-		}
-		pipeOut.Close()
-	}()
-	defer pipeIn.Close()
-	var buf [8]byte
-	var _sbuf [32]byte
-	addr := start
-	size := 0
-	read := 0
-	for {
-		if read, err = io.ReadFull(pipeIn, buf[:]); err != nil {
-			if err == io.EOF {
-				break
-			} else if err != io.ErrUnexpectedEOF {
-				return 0, err
-			}
+		if output.gz == nil {
+			output.gz = newChunkWriter()
 		}
-		for i := read; i < 8; i++ {
-			buf[i] = 0
+		output.gz.Reset(stored)
+		dst = io.MultiWriter(crc, output.gz)
+	} else {
+		dst = io.MultiWriter(crc, stored)
+	}
+	if _, err := io.CopyBuffer(dst, input, output.copy); err != nil {
+		return err
+	}
+	if a.isCompressed {
+		if err := output.gz.Close(); err != nil {
+			return err
 		}
-		var sbuf = _sbuf[0:0]
-		for i := range buf {
-			sbuf = append(sbuf, []byte("\\x")...)
-			if buf[i] < 0x10 {
-				sbuf = append(sbuf, '0')
-			}
-			sbuf = strconv.AppendUint(sbuf, uint64(buf[i]), 16)
+		a.chunks = output.gz.chunks
+	}
+	if a.isEncrypted {
+		mac := hmac.New(sha256.New, output.nonceKey)
+		mac.Write([]byte(a.path))
+		mac.Write([]byte{0})
+		mac.Write(plaintext.Bytes())
+		nonce := mac.Sum(nil)[:aead.NonceSize()]
+		if _, err := output.Write(nonce); err != nil {
+			return err
 		}
-		_, err = fmt.Fprintf(output, "DATA ·d+%d(SB)/8,$\"%s\"\n", addr, string(sbuf))
-		if err != nil {
-			return 0, err
+		if _, err := output.Write(aead.Seal(nil, nonce, plaintext.Bytes(), []byte(a.path))); err != nil {
+			return err
 		}
-		size += read
-		addr += 8
 	}
 	var crcBuf [8]byte
-	binary.LittleEndian.PutUint64(crcBuf[:], crc)
+	binary.LittleEndian.PutUint64(crcBuf[:], crc.Sum64())
 	a.tag = b32Enc.EncodeToString(crcBuf[:])
+	if output.hash != nil {
+		a.digest = output.hash.Sum(nil)
+	}
 	a.offStart = start
-	a.offStop = start + size
-	return addr, nil
+	a.offStop = output.Offset()
+	return nil
+}
+
+// nonceKey derives the key of the nonce HMAC from the encryption key, so the
+// AES-GCM key itself is not used with another primitive
+func nonceKey(key []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("go-imbed nonce"))
+	return mac.Sum(nil)
 }
 
-func writeGoIndex(file io.Writer, testFile io.Writer, pkg string, root *directoryAsset, addr int, flags ImbedFlag) error {
-	timestamp := time.Now()
-	dir, index, has404Asset := buildIndex(root, flags)
-	buf := bytes.Buffer{}
+// signManifest signs the list of asset paths along with digests of their
+// stored content. The manifest is a concatenation of the asset path, zero byte
+// and SHA-256 digest for every asset, ordered by path. The assets slice is left
+// in its order.
+func signManifest(assets []*fileAsset, key ed25519.PrivateKey) []byte {
+	assets = append([]*fileAsset(nil), assets...)
+	sort.Slice(assets, func(i, j int) bool { return assets[i].path < assets[j].path })
+	var manifest bytes.Buffer
+	for _, a := range assets {
+		manifest.WriteString(a.path)
+		manifest.WriteByte(0)
+		manifest.Write(a.digest)
+	}
+	return ed25519.Sign(key, manifest.Bytes())
+}
+
+// goFiles lists generated Go files, each rendered from the template of the same name
+var goFiles = []string{"index.go", "data.go", "data_dev.go", "index_test.go"}
+
+// writeGoIndex renders the generated Go files. devSource is the slash-separated
+// location of the source directory relative to the target one.
+func writeGoIndex(pkg string, root *directoryAsset, addr int, flags ImbedFlag, opts *Options, signature []byte, devSource string, timestamp time.Time) (map[string][]byte, error) {
+	dir, index := buildIndex(root, flags)
 	params := map[string]interface{}{
-		"Pkg":           pkg,
-		"Size":          addr,
-		"IndexCode":     index,
-		"DirectoryCode": dir,
-		"Date":          fmt.Sprintf("%d, %d", timestamp.Unix(), timestamp.Nanosecond()),
-		"Params":        flags,
-		"Has404Asset":   flags.BuildHttpHandlerAPI() && has404Asset,
-		"BuildMain":     pkg == "main" && flags.has(BuildMain),
-	}
-	err := iMustHazTemplate("index.go").Execute(&buf, params)
-	if err != nil {
-		return err
+		"Pkg":             pkg,
+		"Size":            addr,
+		"IndexCode":       index,
+		"DirectoryCode":   dir,
+		"Date":            fmt.Sprintf("%d, %d", timestamp.Unix(), timestamp.Nanosecond()),
+		"Params":          flags,
+		"BuildMain":       pkg == "main" && flags.has(BuildMain),
+		"Encrypted":       opts != nil && len(opts.Encrypt) > 0,
+		"Signed":          signature != nil,
+		"Signature":       hex.EncodeToString(signature),
+		"InitCheck":       "",
+		"DevSource":       devSource,
+		"CachePolicies":   []CachePolicy(nil),
+		"SecurityHeaders": []SecurityHeader(nil),
 	}
-	format.Source(buf.Bytes())
-	if err != nil {
-		return err
+	if opts != nil {
+		params["CachePolicies"] = opts.CachePolicies
+		params["SecurityHeaders"] = opts.SecurityHeaders
 	}
-	_, err = file.Write(buf.Bytes())
-	if err != nil {
-		return err
+	if signature != nil {
+		params["PublicKey"] = hex.EncodeToString(opts.SigningKey.Public().(ed25519.PublicKey))
+		params["InitCheck"] = opts.InitCheck.String()
 	}
-	buf.Reset()
-	err = iMustHazTemplate("index_test.go").Execute(&buf, params)
-	if err != nil {
-		return err
+	files := make(map[string][]byte, len(goFiles))
+	for _, name := range goFiles {
+		buf := bytes.Buffer{}
+		err := iMustHazTemplate(name).Execute(&buf, params)
+		if err != nil {
+			return nil, err
+		}
+		files[name] = buf.Bytes()
 	}
-	_, err = testFile.Write(buf.Bytes())
-	return err
+	return files, nil
 }
 
 func writeAsmIndex(target string) error {
 	for _, file := range iMustHazAsmList() {
-		src := iMustHazFile(file)
-		targetFile, err := ioutil.TempFile(target, "indexasm")
-		if err != nil {
+		if err := writeFile(filepath.Join(target, file), []byte(iMustHazFile(file))); err != nil {
 			return err
 		}
-		if _, err = targetFile.WriteString(src); err != nil {
-			targetFile.Close()
-			os.Remove(targetFile.Name())
-			return err
+	}
+	return nil
+}
+
+var stampRe = regexp.MustCompile(`stamp = time\.Unix\((\d+), (\d+)\)`)
+
+// previousStamp returns the time stamp recorded in the previously generated index file
+func previousStamp(name string) (time.Time, bool) {
+	data, err := ioutil.ReadFile(name)
+	if err != nil {
+		return time.Time{}, false
+	}
+	m := stampRe.FindSubmatch(data)
+	if m == nil {
+		return time.Time{}, false
+	}
+	sec, _ := strconv.ParseInt(string(m[1]), 10, 64)
+	nsec, _ := strconv.ParseInt(string(m[2]), 10, 64)
+	return time.Unix(sec, nsec), true
+}
+
+// sameFiles reports whether all the files exist in the target directory with the same content
+func sameFiles(target string, files map[string][]byte) bool {
+	for name, data := range files {
+		old, err := ioutil.ReadFile(filepath.Join(target, name))
+		if err != nil || !bytes.Equal(old, data) {
+			return false
 		}
-		if err = targetFile.Close(); err != nil {
-			os.Remove(targetFile.Name())
-			return err
+	}
+	return true
+}
+
+// relPath returns slash-separated location of the source directory relative to the target one
+func relPath(target, source string) (string, error) {
+	absTarget, err := filepath.Abs(target)
+	if err != nil {
+		return "", err
+	}
+	absSource, err := filepath.Abs(source)
+	if err != nil {
+		return "", err
+	}
+	rel, err := filepath.Rel(absTarget, absSource)
+	if err != nil {
+		return "", err
+	}
+	return filepath.ToSlash(rel), nil
+}
+
+// sameContent reports whether files a and b exist and have the same content
+func sameContent(a, b string) bool {
+	fa, err := os.Open(a)
+	if err != nil {
+		return false
+	}
+	defer fa.Close()
+	fb, err := os.Open(b)
+	if err != nil {
+		return false
+	}
+	defer fb.Close()
+	sa, err := fa.Stat()
+	if err != nil {
+		return false
+	}
+	sb, err := fb.Stat()
+	if err != nil || sa.Size() != sb.Size() {
+		return false
+	}
+	var bufa, bufb [1 << 15]byte
+	for {
+		na, erra := io.ReadFull(fa, bufa[:])
+		nb, errb := io.ReadFull(fb, bufb[:])
+		if na != nb || !bytes.Equal(bufa[:na], bufb[:nb]) {
+			return false
 		}
-		if err = os.Rename(targetFile.Name(), filepath.Join(target, file)); err != nil {
-			os.Remove(targetFile.Name())
-			return err
+		if erra != nil || errb != nil {
+			return (erra == io.EOF || erra == io.ErrUnexpectedEOF) && erra == errb
 		}
 	}
+}
+
+// commitFile moves the temporary file tmp to the target location, unless the
+// target file already has the same content. Unchanged files are left intact,
+// so tools watching modification times will not notice regeneration.
+func commitFile(tmp, target string) error {
+	if sameContent(tmp, target) {
+		return os.Remove(tmp)
+	}
+	return os.Rename(tmp, target)
+}
+
+// writeFile replaces the target file with data, unless it already has the same content.
+func writeFile(target string, data []byte) error {
+	if old, err := ioutil.ReadFile(target); err == nil && bytes.Equal(old, data) {
+		return nil
+	}
+	base := filepath.Base(target)
+	file, err := ioutil.TempFile(filepath.Dir(target), strings.TrimSuffix(base, filepath.Ext(base)))
+	if err != nil {
+		return err
+	}
+	if _, err = file.Write(data); err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return err
+	}
+	if err = file.Close(); err != nil {
+		os.Remove(file.Name())
+		return err
+	}
+	if err = os.Rename(file.Name(), target); err != nil {
+		os.Remove(file.Name())
+		return err
+	}
 	return nil
 }
 
-// Creates a Go package `pkgName` from `source` directory contents and puts code
-// into `target` location.
-func Imbed(source, target, pkgName string, flags ImbedFlag) error {
+// impliedFlags adds flags implied by the requested ones
+func impliedFlags(pkgName string, flags ImbedFlag) ImbedFlag {
 	if flags.has(BuildHttpFsAPI|BuildUnionFsAPI) {
 		flags |= BuildFsAPI
 	}
 	if pkgName == "main" && flags.has(BuildMain) {
 		flags |= BuildFsAPI|BuildHttpHandlerAPI
 	}
-	err := os.MkdirAll(target, 0755)
-	if err != nil {
-		return err
+	return flags
+}
+
+// mimeType returns MIME type of the asset by its name extension
+func mimeType(name string) string {
+	m := mime.TypeByExtension(path.Ext(strings.ToLower(name)))
+	if m == "" {
+		m = "application/binary"
 	}
-	dataFile, err := ioutil.TempFile(target, "data")
-	if err != nil {
-		return err
+	return m
+}
+
+// compressible reports whether content of the MIME type is worth compressing
+func compressible(m string) bool {
+	return strings.HasPrefix(m, "text/") || strings.HasSuffix(m, "+xml") ||
+		strings.Contains(m, "javascript") || m == "application/xml"
+}
+
+// Creates a Go package `pkgName` from `source` directory contents and puts code
+// into `target` location.
+func Imbed(source, target, pkgName string, flags ImbedFlag) error {
+	return ImbedWithOptions(source, target, pkgName, flags, nil)
+}
+
+// ImbedWithOptions is the same as Imbed, but also accepts generation options.
+func ImbedWithOptions(source, target, pkgName string, flags ImbedFlag, opts *Options) error {
+	_, err := ImbedWithReport(source, target, pkgName, flags, opts)
+	return err
+}
+
+// ImbedWithReport is the same as ImbedWithOptions, but also returns the report
+// on the generated package content.
+func ImbedWithReport(source, target, pkgName string, flags ImbedFlag, opts *Options) (*Report, error) {
+	var aead cipher.AEAD
+	if opts != nil && len(opts.Encrypt) > 0 {
+		block, err := aes.NewCipher(opts.EncryptionKey)
+		if err != nil {
+			return nil, fmt.Errorf("encryption key: %s", err)
+		}
+		aead, _ = cipher.NewGCM(block)
+	}
+	if opts != nil && opts.SigningKey != nil && len(opts.SigningKey) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("signing key: invalid key size %d", len(opts.SigningKey))
+	} else if opts != nil && opts.InitCheck != NoInitCheck && opts.SigningKey == nil {
+		return nil, fmt.Errorf("init check requires signing key")
+	}
+	if opts != nil {
+		if err := checkCachePolicies(opts.CachePolicies); err != nil {
+			return nil, err
+		}
+		if err := checkSecurityHeaders(opts.SecurityHeaders); err != nil {
+			return nil, err
+		}
 	}
-	defer os.Remove(dataFile.Name())
-	err = writeObjectFileHeader(dataFile)
+	flags = impliedFlags(pkgName, flags)
+	err := os.MkdirAll(target, 0755)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	indexFile, err := ioutil.TempFile(target, "index")
+	dataFile, err := ioutil.TempFile(target, "data")
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer func() {
-		indexFile.Close()
-		os.Remove(indexFile.Name())
+		dataFile.Close()
+		os.Remove(dataFile.Name())
 	}()
-	testFile, err := ioutil.TempFile(target, "index_test")
+	data := newDataWriter(dataFile)
+	if aead != nil {
+		data.nonceKey = nonceKey(opts.EncryptionKey)
+	}
+	if opts != nil && opts.SigningKey != nil {
+		data.hash = sha256.New()
+	}
+	err = writeObjectFileHeader(data)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	defer func() {
-		testFile.Close()
-		os.Remove(testFile.Name())
-	}()
-	addr := 0
 	root := &directoryAsset{}
+	var assets []*fileAsset
 	err = filepath.Walk(source, func(asset string, info os.FileInfo, err error) error {
 		assetName, _ := filepath.Rel(source, asset)
 		assetName = filepath.ToSlash(assetName)
@@ -378,53 +660,73 @@ func Imbed(source, target, pkgName string, flags ImbedFlag) error {
 		}
 		defer file.Close()
 		fstat, _ := file.Stat()
-		m := mime.TypeByExtension(path.Ext(strings.ToLower(asset)))
-		if m == "" {
-			m = "application/binary"
-		}
-		var compressed = false
-		if flags.CompressAssets() && (strings.HasPrefix(m, "text/") || strings.HasSuffix(m, "+xml") ||
-			strings.Contains(m, "javascript") || m == "application/xml") {
-			compressed = true
-		}
+		m := mimeType(assetName)
 		var entry = fileAsset{
 			name:         path.Base(assetName),
+			path:         assetName,
 			mimeType:     m,
 			size:         fstat.Size(),
-			isCompressed: compressed,
+			isCompressed: flags.CompressAssets() && compressible(m),
+			isEncrypted:  aead != nil && opts.encrypts(assetName),
 		}
-		addr, err = entry.writeObject(file, dataFile, addr, flags)
+		err = entry.writeObject(file, data, aead)
 		if err != nil {
 			return err
 		}
 		root.addFile(assetName, &entry)
+		assets = append(assets, &entry)
 		return nil
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
-	err = writeObjectFileFooter(dataFile, addr)
+	err = writeObjectFileFooter(data)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	err = writeGoIndex(indexFile, testFile, pkgName, root, addr, flags)
-	if err != nil {
-		return err
+	if opts != nil {
+		if err = checkBudgets(opts.Budgets, assets); err != nil {
+			return nil, err
+		}
+	}
+	var signature []byte
+	if opts != nil && opts.SigningKey != nil {
+		signature = signManifest(assets, opts.SigningKey)
 	}
-	dataFile.Close()
-	indexFile.Close()
-	testFile.Close()
-	err = os.Rename(dataFile.Name(), filepath.Join(target, "data.s"))
+	devSource, err := relPath(target, source)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	// Keep the time stamp of the previous generation, unless the package
+	// has changed: otherwise generated files would be rewritten every time.
+	timestamp, ok := previousStamp(filepath.Join(target, "data.go"))
+	if !ok {
+		timestamp = time.Now()
 	}
-	err = os.Rename(indexFile.Name(), filepath.Join(target, "index.go"))
+	files, err := writeGoIndex(pkgName, root, data.Offset(), flags, opts, signature, devSource, timestamp)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if ok && !sameFiles(target, files) {
+		files, err = writeGoIndex(pkgName, root, data.Offset(), flags, opts, signature, devSource, time.Now())
+		if err != nil {
+			return nil, err
+		}
 	}
-	err = os.Rename(testFile.Name(), filepath.Join(target, "index_test.go"))
+	if err = dataFile.Close(); err != nil {
+		return nil, err
+	}
+	err = commitFile(dataFile.Name(), filepath.Join(target, "data.s"))
 	if err != nil {
-		return err
+		return nil, err
+	}
+	for _, name := range goFiles {
+		if err = writeFile(filepath.Join(target, name), files[name]); err != nil {
+			return nil, err
+		}
+	}
+	if err = writeAsmIndex(target); err != nil {
+		return nil, err
 	}
-	return writeAsmIndex(target)
+	return newReport(assets, root, data.Offset(), filepath.Join(target, "data.s"))
 }
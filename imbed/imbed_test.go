@@ -1,12 +1,23 @@
 package imbed
 
 import (
+	"archive/zip"
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"crypto/ed25519"
+	"encoding/hex"
+	"fmt"
+	"hash/crc64"
 	"io"
 	"io/ioutil"
+	"math/rand"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"testing"
+	"time"
 )
 
 func rmtree(name string) {
@@ -31,6 +42,48 @@ func rmtree(name string) {
 	}
 }
 
+// generateAndTest writes files, content by slash-separated path, into the source
+// directory within tmp, embeds it into the "data" package of the GOPATH rooted at
+// tmp, and runs the package tests without build tags, or once with each of tags.
+// testSrc, unless empty, is added to the package as fixture_test.go. It returns
+// the source and the target directories, and the generation report.
+func generateAndTest(t *testing.T, tmp string, files map[string]string, flags ImbedFlag, opts *Options, testSrc string, tags ...string) (string, string, *Report) {
+	source := filepath.Join(tmp, "source")
+	target := filepath.Join(tmp, "src", "data")
+	for name, content := range files {
+		name = filepath.Join(source, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(name), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(name, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	report, err := ImbedWithReport(source, target, "data", flags, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if testSrc != "" {
+		if err = ioutil.WriteFile(filepath.Join(target, "fixture_test.go"), []byte(testSrc), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(tags) == 0 {
+		tags = []string{""}
+	}
+	for _, tag := range tags {
+		cmd := exec.Command("go", "test", "-count=1", "-tags", tag, "data")
+		cmd.Env = append(os.Environ(), "GOPATH="+tmp, "GO111MODULE=off")
+		cmd.Dir = tmp
+		cmd.Stderr = os.Stderr
+		cmd.Stdout = os.Stdout
+		if err = cmd.Run(); err != nil {
+			t.Fatalf("error testing target with tags %q", tag)
+		}
+	}
+	return source, target, report
+}
+
 func TestGenerateNoMain(t *testing.T) {
 	tmp, err := ioutil.TempDir(os.TempDir(), "go-imbed-test")
 	if err != nil {
@@ -63,7 +116,7 @@ func main() {
 			continue
 		}
 		cmd := exec.Command("go", "install", "pkg")
-		cmd.Env = append(os.Environ(), "GOPATH="+tmp)
+		cmd.Env = append(os.Environ(), "GOPATH="+tmp, "GO111MODULE=off")
 		cmd.Dir = tmp
 		flags := ImbedFlag(i)
 		err := Imbed("../example/site", targetPkg, "data", flags)
@@ -77,7 +130,7 @@ func main() {
 			t.Fatalf("error compiling target with flags %s\n", flags.String())
 		}
 		cmd = exec.Command("go", "test", "-v", "pkg/internal/data")
-		cmd.Env = append(os.Environ(), "GOPATH="+tmp)
+		cmd.Env = append(os.Environ(), "GOPATH="+tmp, "GO111MODULE=off")
 		cmd.Dir = tmp
 		cmd.Stderr = os.Stderr
 		cmd.Stdout = os.Stdout
@@ -104,7 +157,7 @@ func TestGenerateMain(t *testing.T) {
 			continue
 		}
 		cmd := exec.Command("go", "install", "main")
-		cmd.Env = append(os.Environ(), "GOPATH="+tmp)
+		cmd.Env = append(os.Environ(), "GOPATH="+tmp, "GO111MODULE=off")
 		cmd.Dir = tmp
 		flags := ImbedFlag(i)
 		err := Imbed("../example/site", pkgDir, "main", flags)
@@ -128,3 +181,765 @@ func TestGenerateMain(t *testing.T) {
 
 }
 
+func TestGenerateEncrypted(t *testing.T) {
+	tmp, err := ioutil.TempDir(os.TempDir(), "go-imbed-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rmtree(tmp)
+	targetPkg := filepath.Join(tmp, "src", "pkg", "internal", "data")
+	key := []byte("0123456789abcdef0123456789abcdef")
+	opts := &Options{
+		Encrypt:       []string{"*.css", "images/**"},
+		EncryptionKey: key,
+	}
+	for _, flags := range []ImbedFlag{0, CompressAssets | BuildHttpHandlerAPI | BuildUnionFsAPI | BuildHttpFsAPI} {
+		err := ImbedWithOptions("../example/site", targetPkg, "data", flags, opts)
+		if err != nil {
+			t.Fatalf("error embedding with flags %s: %s", flags.String(), err)
+		}
+		for _, env := range []string{"", "IMBED_TEST_KEY=" + hex.EncodeToString(key)} {
+			// the key is read before the test log is set up, so results must not be cached
+			cmd := exec.Command("go", "test", "-v", "-count=1", "pkg/internal/data")
+			cmd.Env = append(os.Environ(), "GOPATH="+tmp, "GO111MODULE=off", env)
+			cmd.Dir = tmp
+			cmd.Stderr = os.Stderr
+			cmd.Stdout = os.Stdout
+			err = cmd.Run()
+			if err != nil {
+				t.Fatalf("error testing target with flags %s\n", flags.String())
+			}
+		}
+	}
+	opts.EncryptionKey = key[:7]
+	if err = ImbedWithOptions("../example/site", targetPkg, "data", 0, opts); err == nil {
+		t.Fatalf("expected error for invalid key size")
+	}
+}
+
+func TestGenerateSigned(t *testing.T) {
+	tmp, err := ioutil.TempDir(os.TempDir(), "go-imbed-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rmtree(tmp)
+	targetPkg := filepath.Join(tmp, "src", "pkg", "internal", "data")
+	signingKey := ed25519.NewKeyFromSeed([]byte("0123456789abcdef0123456789abcdef"))
+	key := []byte("0123456789abcdef")
+	for _, opts := range []*Options{
+		{SigningKey: signingKey},
+		{SigningKey: signingKey, InitCheck: PanicOnInitCheck},
+		{SigningKey: signingKey, InitCheck: RefuseOnInitCheck, Encrypt: []string{"*.html"}, EncryptionKey: key},
+	} {
+		for _, flags := range []ImbedFlag{0, CompressAssets | BuildHttpHandlerAPI | BuildHttpFsAPI} {
+			err := ImbedWithOptions("../example/site", targetPkg, "data", flags, opts)
+			if err != nil {
+				t.Fatalf("error embedding with flags %s: %s", flags.String(), err)
+			}
+			cmd := exec.Command("go", "test", "-v", "-count=1", "pkg/internal/data")
+			cmd.Env = append(os.Environ(), "GOPATH="+tmp, "GO111MODULE=off", "IMBED_TEST_KEY="+hex.EncodeToString(key))
+			cmd.Dir = tmp
+			cmd.Stderr = os.Stderr
+			cmd.Stdout = os.Stdout
+			err = cmd.Run()
+			if err != nil {
+				t.Fatalf("error testing target with flags %s\n", flags.String())
+			}
+		}
+	}
+	if err = ImbedWithOptions("../example/site", targetPkg, "data", 0, &Options{InitCheck: PanicOnInitCheck}); err == nil {
+		t.Fatalf("expected error for init check without signing key")
+	}
+}
+
+func TestSignManifest(t *testing.T) {
+	key := ed25519.NewKeyFromSeed([]byte("0123456789abcdef0123456789abcdef"))
+	assets := []*fileAsset{{path: "b.html"}, {path: "a.html"}}
+	sig := signManifest(assets, key)
+	if assets[0].path != "b.html" || assets[1].path != "a.html" {
+		t.Fatalf("assets are reordered: %s, %s", assets[0].path, assets[1].path)
+	}
+	if !bytes.Equal(sig, signManifest([]*fileAsset{assets[1], assets[0]}, key)) {
+		t.Fatal("signature depends on the order of assets")
+	}
+}
+
+func TestRegenerateUnchanged(t *testing.T) {
+	tmp, err := ioutil.TempDir(os.TempDir(), "go-imbed-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rmtree(tmp)
+	source := filepath.Join(tmp, "source")
+	target := filepath.Join(tmp, "target")
+	if err = os.MkdirAll(source, 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(source, "index.html"), []byte("<html></html>"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err = Imbed(source, target, "data", CompressAssets|BuildHttpHandlerAPI); err != nil {
+		t.Fatal(err)
+	}
+	stat := func() map[string]os.FileInfo {
+		fis, err := ioutil.ReadDir(target)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ret := make(map[string]os.FileInfo)
+		for _, fi := range fis {
+			ret[fi.Name()] = fi
+		}
+		return ret
+	}
+	before := stat()
+	// make sure new files would get a different modification time
+	time.Sleep(10 * time.Millisecond)
+	if err = Imbed(source, target, "data", CompressAssets|BuildHttpHandlerAPI); err != nil {
+		t.Fatal(err)
+	}
+	after := stat()
+	if len(before) != len(after) {
+		t.Fatalf("expected %d files, got %d", len(before), len(after))
+	}
+	for name, fi := range before {
+		if !fi.ModTime().Equal(after[name].ModTime()) {
+			t.Fatalf("unchanged file %s has been rewritten", name)
+		}
+	}
+	if err = ioutil.WriteFile(filepath.Join(source, "index.html"), []byte("<html>changed</html>"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err = Imbed(source, target, "data", CompressAssets|BuildHttpHandlerAPI); err != nil {
+		t.Fatal(err)
+	}
+	after = stat()
+	for _, name := range []string{"data.s", "data.go"} {
+		if before[name].ModTime().Equal(after[name].ModTime()) {
+			t.Fatalf("changed file %s has not been rewritten", name)
+		}
+	}
+	for _, name := range []string{"index.go", "data_dev.go", "index_test.go"} {
+		if !before[name].ModTime().Equal(after[name].ModTime()) {
+			t.Fatalf("unchanged file %s has been rewritten", name)
+		}
+	}
+}
+
+func TestGenerateDev(t *testing.T) {
+	tmp, err := ioutil.TempDir(os.TempDir(), "go-imbed-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rmtree(tmp)
+	files := map[string]string{
+		"index.html":    "<html>original</html>",
+		"css/style.css": "body {}",
+		"404.html":      "<html>not found</html>",
+	}
+	var source string
+	for _, flags := range []ImbedFlag{0, CompressAssets | BuildHttpHandlerAPI | BuildUnionFsAPI | BuildHttpFsAPI} {
+		source, _, _ = generateAndTest(t, tmp, files, flags, nil, "", "imbed_dev")
+	}
+	pkgDir := filepath.Join(tmp, "src", "pkg")
+	if err = os.MkdirAll(pkgDir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(pkgDir, "main.go"), []byte(`package main
+
+import (
+	"data"
+	"os"
+)
+
+func main() {
+	os.Stdout.WriteString(data.Must(os.Args[1]).String())
+}
+`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	run := func(name string, args ...string) string {
+		cmd := exec.Command(name, args...)
+		cmd.Env = append(os.Environ(), "GOPATH="+tmp, "GO111MODULE=off")
+		cmd.Dir = tmp
+		cmd.Stderr = os.Stderr
+		out, err := cmd.Output()
+		if err != nil {
+			t.Fatalf("error running %s %v: %s", name, args, err)
+		}
+		return string(out)
+	}
+	run("go", "install", "-tags", "imbed_dev", "pkg")
+	bin := filepath.Join(tmp, "bin", "pkg")
+	if out := run(bin, "index.html"); out != "<html>original</html>" {
+		t.Fatalf("unexpected content %q", out)
+	}
+	// content is read from the source directory, not from the executable
+	if err = ioutil.WriteFile(filepath.Join(source, "index.html"), []byte("<html>changed content</html>"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(source, "css", "new.css"), []byte("p {}"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if out := run(bin, "index.html"); out != "<html>changed content</html>" {
+		t.Fatalf("unexpected content %q", out)
+	}
+	if out := run(bin, "css/new.css"); out != "p {}" {
+		t.Fatalf("unexpected content %q", out)
+	}
+}
+
+func TestChunks(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	data := make([]byte, 5*chunkSize/2)
+	for i := range data {
+		data[i] = "abcdefgh ijklmnop\n"[rnd.Intn(18)]
+	}
+	for _, size := range []int{0, 1, chunkSize, len(data)} {
+		var stream bytes.Buffer
+		c := newChunkWriter()
+		c.Reset(&stream)
+		if _, err := c.Write(data[:size]); err != nil {
+			t.Fatal(err)
+		}
+		if err := c.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if n := (size + chunkSize - 1) / chunkSize; len(c.chunks) != n {
+			t.Fatalf("size %d: expected %d chunks, got %d", size, n, len(c.chunks))
+		}
+		gz, err := gzip.NewReader(bytes.NewReader(stream.Bytes()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, err := ioutil.ReadAll(gz)
+		if err != nil {
+			t.Fatalf("size %d: %s", size, err)
+		}
+		if !bytes.Equal(content, data[:size]) {
+			t.Fatalf("size %d: gzip stream content differs", size)
+		}
+		for i, off := range c.chunks {
+			content, err = ioutil.ReadAll(flate.NewReader(bytes.NewReader(stream.Bytes()[off:])))
+			if err != nil {
+				t.Fatalf("size %d, chunk %d: %s", size, i, err)
+			}
+			if !bytes.Equal(content, data[i*chunkSize:size]) {
+				t.Fatalf("size %d: content decompressed from chunk %d differs", size, i)
+			}
+		}
+	}
+	// generated packages test seeking and serving ranges over multiple chunks
+	tmp, err := ioutil.TempDir(os.TempDir(), "go-imbed-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rmtree(tmp)
+	generateAndTest(t, tmp, map[string]string{
+		"large.txt":  string(data),
+		"index.html": "<html></html>",
+	}, CompressAssets|BuildHttpFsAPI|BuildHttpHandlerAPI, nil, "", "", "imbed_dev")
+}
+
+func TestZipReaderAt(t *testing.T) {
+	tmp, err := ioutil.TempDir(os.TempDir(), "go-imbed-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rmtree(tmp)
+	var archive bytes.Buffer
+	zw := zip.NewWriter(&archive)
+	w, err := zw.Create("fixture.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	io.WriteString(w, "fixture content")
+	if err = zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	generateAndTest(t, tmp, map[string]string{"fixtures.zip": archive.String()}, CompressAssets, nil, `package data
+
+import (
+	"archive/zip"
+	"io/ioutil"
+	"testing"
+)
+
+func TestZip(t *testing.T) {
+	r := Must("fixtures.zip").ReaderAt()
+	zr, err := zip.NewReader(r, r.Size())
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := zr.File[0].Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	data, err := ioutil.ReadAll(f)
+	if err != nil || string(data) != "fixture content" {
+		t.Fatalf("unexpected content %q, %v", data, err)
+	}
+}
+`)
+}
+
+// writeObjectReference is the emitter writeObject replaced, which formats
+// every 8 bytes with fmt.Fprintf. It is kept to compare the two with benchmarks.
+func writeObjectReference(input io.Reader, output io.Writer, start int, compressed bool) (int, error) {
+	var compressor *gzip.Writer
+	var err error
+	crc := uint64(0)
+	crcTable := crc64.MakeTable(crc64.ECMA)
+	pipeIn, pipeOut := io.Pipe()
+	if compressed {
+		compressor, _ = gzip.NewWriterLevel(pipeOut, gzip.BestCompression)
+	}
+	go func() {
+		buf := make([]byte, 8192)
+		for {
+			n, err := input.Read(buf)
+			if err == io.EOF {
+				if compressed {
+					compressor.Close()
+				}
+				break
+			} else if err != nil {
+				pipeOut.CloseWithError(err)
+				return
+			}
+			crc = crc64.Update(crc, crcTable, buf[:n])
+			if compressed {
+				_, err = compressor.Write(buf[:n])
+			} else {
+				_, err = pipeOut.Write(buf[:n])
+			}
+			if err != nil {
+				pipeOut.CloseWithError(err)
+				return
+			}
+		}
+		pipeOut.Close()
+	}()
+	defer pipeIn.Close()
+	var buf [8]byte
+	var _sbuf [32]byte
+	addr := start
+	read := 0
+	for {
+		if read, err = io.ReadFull(pipeIn, buf[:]); err != nil {
+			if err == io.EOF {
+				break
+			} else if err != io.ErrUnexpectedEOF {
+				return 0, err
+			}
+		}
+		for i := read; i < 8; i++ {
+			buf[i] = 0
+		}
+		var sbuf = _sbuf[0:0]
+		for i := range buf {
+			sbuf = append(sbuf, []byte("\\x")...)
+			if buf[i] < 0x10 {
+				sbuf = append(sbuf, '0')
+			}
+			sbuf = strconv.AppendUint(sbuf, uint64(buf[i]), 16)
+		}
+		_, err = fmt.Fprintf(output, "DATA ·d+%d(SB)/8,$\"%s\"\n", addr, string(sbuf))
+		if err != nil {
+			return 0, err
+		}
+		addr += 8
+	}
+	return addr, nil
+}
+
+func benchmarkWriteObject(b *testing.B, compressed, reference bool) {
+	// text-like content, so compression has some work to do
+	data := make([]byte, 4<<20)
+	rnd := rand.New(rand.NewSource(1))
+	for i := range data {
+		data[i] = "abcdefgh ijklmnop\n"[rnd.Intn(18)]
+	}
+	output, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer output.Close()
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if reference {
+			if _, err = writeObjectReference(bytes.NewReader(data), output, 0, compressed); err != nil {
+				b.Fatal(err)
+			}
+			continue
+		}
+		out := newDataWriter(output)
+		asset := fileAsset{isCompressed: compressed}
+		if err = asset.writeObject(bytes.NewReader(data), out, nil); err != nil {
+			b.Fatal(err)
+		}
+		if err = writeObjectFileFooter(out); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkWriteObject(b *testing.B)                    { benchmarkWriteObject(b, false, false) }
+func BenchmarkWriteObjectCompressed(b *testing.B)          { benchmarkWriteObject(b, true, false) }
+func BenchmarkWriteObjectReference(b *testing.B)           { benchmarkWriteObject(b, false, true) }
+func BenchmarkWriteObjectReferenceCompressed(b *testing.B) { benchmarkWriteObject(b, true, true) }
+
+func TestCheck(t *testing.T) {
+	tmp, err := ioutil.TempDir(os.TempDir(), "go-imbed-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rmtree(tmp)
+	source := filepath.Join(tmp, "source")
+	target := filepath.Join(tmp, "target")
+	if err = os.MkdirAll(filepath.Join(source, "css"), 0700); err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range map[string]string{
+		"index.html":     "<html></html>",
+		"css/style.css":  "body {}",
+		"css/remove.css": "p {}",
+	} {
+		if err = ioutil.WriteFile(filepath.Join(source, filepath.FromSlash(name)), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	flags := CompressAssets | BuildHttpHandlerAPI
+	if _, err = Check(source, target, "data", flags, nil); err == nil {
+		t.Fatal("expected error checking missing package")
+	}
+	if err = Imbed(source, target, "data", flags); err != nil {
+		t.Fatal(err)
+	}
+	changes, err := Check(source, target, "data", flags, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 0 {
+		t.Fatalf("expected no changes, got %v", changes)
+	}
+	if err = ioutil.WriteFile(filepath.Join(source, "index.html"), []byte("<html>changed</html>"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(source, "css", "new.css"), []byte("a {}"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Remove(filepath.Join(source, "css", "remove.css")); err != nil {
+		t.Fatal(err)
+	}
+	changes, err = Check(source, target, "data", BuildHttpHandlerAPI, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Change{
+		{Kind: FlagsChanged, Detail: "[Compress,Http] -> [Http]"},
+		{Kind: AssetAdded, Path: "css/new.css"},
+		{Kind: AssetRemoved, Path: "css/remove.css"},
+		{Kind: AssetChanged, Path: "css/style.css", Detail: "compressed true -> false"},
+		{Kind: AssetChanged, Path: "index.html", Detail: "content"},
+		{Kind: AssetChanged, Path: "index.html", Detail: "compressed true -> false"},
+	}
+	if len(changes) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, changes)
+	}
+	for i := range expected {
+		if changes[i] != expected[i] {
+			t.Fatalf("expected %v, got %v", expected[i], changes[i])
+		}
+	}
+	if err = Imbed(source, target, "data", flags); err != nil {
+		t.Fatal(err)
+	}
+	if changes, err = Check(source, target, "site", flags, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 || changes[0] != (Change{Kind: FlagsChanged, Detail: "package data -> site"}) {
+		t.Fatalf("expected package change, got %v", changes)
+	}
+	signingKey := ed25519.NewKeyFromSeed([]byte("0123456789abcdef0123456789abcdef"))
+	otherKey := ed25519.NewKeyFromSeed([]byte("fedcba9876543210fedcba9876543210"))
+	opts := &Options{SigningKey: signingKey, InitCheck: PanicOnInitCheck}
+	if err = ImbedWithOptions(source, target, "data", flags, opts); err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		opts     *Options
+		expected []Change
+	}{
+		{opts, nil},
+		{&Options{SigningKey: otherKey, InitCheck: PanicOnInitCheck}, []Change{{Kind: FlagsChanged, Detail: "signing key"}}},
+		{&Options{SigningKey: signingKey, InitCheck: RefuseOnInitCheck}, []Change{{Kind: FlagsChanged, Detail: `verify on init: "panic" -> "refuse"`}}},
+		{&Options{SigningKey: signingKey}, []Change{{Kind: FlagsChanged, Detail: `verify on init: "panic" -> ""`}}},
+	} {
+		if changes, err = Check(source, target, "data", flags, c.opts); err != nil {
+			t.Fatal(err)
+		}
+		if len(changes) != len(c.expected) || len(changes) > 0 && changes[0] != c.expected[0] {
+			t.Fatalf("expected %v, got %v", c.expected, changes)
+		}
+	}
+}
+
+func TestSecurityHeaders(t *testing.T) {
+	tmp, err := ioutil.TempDir(os.TempDir(), "go-imbed-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rmtree(tmp)
+	flags := BuildHttpHandlerAPI
+	opts := &Options{SecurityHeaders: []SecurityHeader{
+		{Pattern: "embed/**", Name: "X-Frame-Options", Value: ""},
+		{Pattern: "embed/**", Name: "Content-Security-Policy", Value: "frame-ancestors *"},
+		{Name: "Content-Security-Policy", Value: "default-src 'self'; frame-ancestors 'none'"},
+		{Name: "strict-transport-security", Value: "max-age=31536000"},
+	}}
+	source, target, _ := generateAndTest(t, tmp, map[string]string{
+		"index.html":        "<html></html>",
+		"embed/widget.html": "<html></html>",
+	}, flags, opts, "")
+	for _, invalid := range []SecurityHeader{
+		{Pattern: "[", Name: "X-Frame-Options", Value: "DENY"},
+		{Name: "Set-Cookie", Value: "a=b"},
+		{Name: "X-Frame-Options", Value: "DENY\r\nSet-Cookie: a=b"},
+	} {
+		if err = ImbedWithOptions(source, target, "data", flags, &Options{SecurityHeaders: []SecurityHeader{invalid}}); err == nil {
+			t.Fatalf("expected error for %+v", invalid)
+		}
+	}
+	if changes, err := Check(source, target, "data", flags, opts); err != nil || len(changes) != 0 {
+		t.Fatalf("expected no changes, got %v, %v", changes, err)
+	}
+	changes, err := Check(source, target, "data", flags, &Options{SecurityHeaders: opts.SecurityHeaders[1:]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 || changes[0] != (Change{Kind: FlagsChanged, Detail: "security headers"}) {
+		t.Fatalf("expected security headers change, got %v", changes)
+	}
+}
+
+func TestErrorPages(t *testing.T) {
+	tmp, err := ioutil.TempDir(os.TempDir(), "go-imbed-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rmtree(tmp)
+	// pages of the same directory are tested by the generated tests
+	_, _, report := generateAndTest(t, tmp, map[string]string{
+		"index.html":            "<html>index</html>",
+		"404.html":              "<html>root 404</html>",
+		"405.html":              "<html>root 405</html>",
+		"docs/403.html":         "<html>docs 403</html>",
+		"docs/500.html":         "<html>docs 500</html>",
+		"docs/v1/404.html":      "<html>v1 404</html>",
+		"docs/v1/api/page.html": "<html>page</html>",
+	}, CompressAssets|BuildHttpHandlerAPI, nil, `package data
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNearestErrorPages(t *testing.T) {
+	for _, tc := range []struct {
+		method, url string
+		status      int
+		body        string
+	}{
+		{"GET", "/docs/missing", 404, "<html>root 404</html>"},
+		{"GET", "/docs/v1/api/missing/", 404, "<html>v1 404</html>"},
+		{"POST", "/docs/v1/api/page.html", 405, "<html>root 405</html>"},
+	} {
+		rr := httptest.NewRecorder()
+		ServeHTTP(rr, httptest.NewRequest(tc.method, tc.url, nil))
+		if rr.Code != tc.status || rr.Body.String() != tc.body {
+			t.Fatalf("%s %s: expected %d %q, got %d %q", tc.method, tc.url, tc.status, tc.body, rr.Code, rr.Body.String())
+		}
+	}
+	for _, tc := range []struct {
+		url    string
+		status int
+		body   string
+	}{
+		{"/api/docs/v1/secret", 403, "<html>docs 403</html>"},
+		{"/api/docs/v1/api/", 500, "<html>docs 500</html>"},
+		{"/api/secret", 403, "Forbidden\n"},
+		{"/api/docs/missing", 401, "Unauthorized\n"},
+	} {
+		rr := httptest.NewRecorder()
+		ServeError(rr, httptest.NewRequest("GET", tc.url, nil), "/api", tc.status)
+		if rr.Code != tc.status || rr.Body.String() != tc.body {
+			t.Fatalf("%s: expected %d %q, got %d %q", tc.url, tc.status, tc.body, rr.Code, rr.Body.String())
+		}
+	}
+}
+`, "", "imbed_dev")
+	for _, a := range report.Assets {
+		expected := map[string]int{"404.html": 404, "405.html": 405, "docs/403.html": 403, "docs/500.html": 500, "docs/v1/404.html": 404}[a.Path]
+		if a.ErrorPage != expected {
+			t.Fatalf("%s: expected error page status %d, got %d", a.Path, expected, a.ErrorPage)
+		}
+	}
+}
+
+func TestCachePolicies(t *testing.T) {
+	tmp, err := ioutil.TempDir(os.TempDir(), "go-imbed-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rmtree(tmp)
+	flags := CompressAssets | BuildHttpHandlerAPI
+	opts := &Options{CachePolicies: []CachePolicy{
+		{Pattern: "*.html", Value: "no-cache"},
+		{Pattern: "type:image/*", Value: "max-age=86400"},
+		{Pattern: "assets/**", Value: `max-age=31536000, immutable, ext="a\b"`},
+	}}
+	source, target, _ := generateAndTest(t, tmp, map[string]string{
+		"index.html":       "<html></html>",
+		"assets/app.js":    "alert()",
+		"assets/logo.png":  "\x89PNG",
+		"assets/notes.txt": "notes",
+	}, flags, opts, "")
+	if err = ImbedWithOptions(source, target, "data", flags, &Options{CachePolicies: []CachePolicy{{Pattern: "[", Value: "no-cache"}}}); err == nil {
+		t.Fatal("expected error for malformed pattern")
+	}
+	if changes, err := Check(source, target, "data", flags, opts); err != nil || len(changes) != 0 {
+		t.Fatalf("expected no changes, got %v, %v", changes, err)
+	}
+	changes, err := Check(source, target, "data", flags, &Options{CachePolicies: opts.CachePolicies[:1]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 || changes[0] != (Change{Kind: FlagsChanged, Detail: "cache policies"}) {
+		t.Fatalf("expected cache policies change, got %v", changes)
+	}
+}
+
+func TestReport(t *testing.T) {
+	tmp, err := ioutil.TempDir(os.TempDir(), "go-imbed-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rmtree(tmp)
+	source := filepath.Join(tmp, "source")
+	if err = os.MkdirAll(filepath.Join(source, "css", "print"), 0700); err != nil {
+		t.Fatal(err)
+	}
+	html := bytes.Repeat([]byte("<p>paragraph</p>\n"), 100)
+	for name, content := range map[string][]byte{
+		"index.html":          html,
+		"css/print/print.css": []byte("body {}"),
+		"image.png":           {0x89, 'P', 'N', 'G'},
+	} {
+		if err = ioutil.WriteFile(filepath.Join(source, filepath.FromSlash(name)), content, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	report, err := ImbedWithReport(source, filepath.Join(tmp, "target"), "data", CompressAssets, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Assets) != 3 {
+		t.Fatalf("expected 3 assets, got %d", len(report.Assets))
+	}
+	var stored int64
+	for i, p := range []string{"css/print/print.css", "image.png", "index.html"} {
+		if report.Assets[i].Path != p {
+			t.Fatalf("expected asset %s, got %s", p, report.Assets[i].Path)
+		}
+		stored += report.Assets[i].StoredSize
+	}
+	if a := report.Assets[2]; !a.Compressed || a.Size != int64(len(html)) || a.StoredSize >= a.Size || a.MimeType != mimeType("index.html") {
+		t.Fatalf("unexpected report for index.html: %+v", a)
+	}
+	if a := report.Assets[1]; a.Compressed || a.StoredSize != 4 {
+		t.Fatalf("unexpected report for image.png: %+v", a)
+	}
+	if report.DataSize != stored {
+		t.Fatalf("expected data size %d, got %d", stored, report.DataSize)
+	}
+	expected := []DirectoryReport{
+		{Path: ".", Assets: 3, Size: int64(len(html)) + 11, StoredSize: stored},
+		{Path: "css", Assets: 1, Size: 7, StoredSize: report.Assets[0].StoredSize},
+		{Path: "css/print", Assets: 1, Size: 7, StoredSize: report.Assets[0].StoredSize},
+	}
+	if len(report.Directories) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, report.Directories)
+	}
+	for i := range expected {
+		if report.Directories[i] != expected[i] {
+			t.Fatalf("expected %v, got %v", expected[i], report.Directories[i])
+		}
+	}
+	fi, err := os.Stat(filepath.Join(tmp, "target", "data.s"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.DataFile != fi.Size() {
+		t.Fatalf("expected data file size %d, got %d", fi.Size(), report.DataFile)
+	}
+}
+
+func TestBudgets(t *testing.T) {
+	tmp, err := ioutil.TempDir(os.TempDir(), "go-imbed-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rmtree(tmp)
+	source := filepath.Join(tmp, "source")
+	target := filepath.Join(tmp, "target")
+	if err = os.MkdirAll(filepath.Join(source, "js"), 0700); err != nil {
+		t.Fatal(err)
+	}
+	for name, size := range map[string]int{"js/a.js": 300, "js/b.js": 300, "video.mp4": 1000} {
+		if err = ioutil.WriteFile(filepath.Join(source, filepath.FromSlash(name)), make([]byte, size), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	opts := &Options{Budgets: []Budget{
+		{Limit: 2000},
+		{Pattern: "*.js", Limit: 500},
+		{PerFile: true, Limit: 400},
+	}}
+	err = ImbedWithOptions(source, target, "data", 0, opts)
+	budgetErr, ok := err.(*BudgetError)
+	if !ok {
+		t.Fatalf("expected *BudgetError, got %v", err)
+	}
+	expected := []BudgetOffender{
+		{Budget: opts.Budgets[1], Assets: 2, Size: 600},
+		{Budget: opts.Budgets[2], Path: "video.mp4", Assets: 1, Size: 1000},
+	}
+	if len(budgetErr.Offenders) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, budgetErr.Offenders)
+	}
+	for i := range expected {
+		if budgetErr.Offenders[i] != expected[i] {
+			t.Fatalf("expected %v, got %v", expected[i], budgetErr.Offenders[i])
+		}
+	}
+	if _, err = os.Stat(filepath.Join(target, "data.s")); !os.IsNotExist(err) {
+		t.Fatalf("expected no data.s to be written, got %v", err)
+	}
+	opts.Budgets[1].Limit = 600
+	opts.Budgets[2].Limit = 1000
+	if err = ImbedWithOptions(source, target, "data", 0, opts); err != nil {
+		t.Fatal(err)
+	}
+	for s, size := range map[string]int64{"100": 100, "1.5KB": 1500, "2 KiB": 2048, "1M": 1 << 20, "3gb": 3e9} {
+		if v, err := ParseSize(s); err != nil || v != size {
+			t.Fatalf("expected %s to be parsed as %d, got %d (%v)", s, size, v, err)
+		}
+	}
+	if _, err = ParseSize("1XB"); err == nil {
+		t.Fatal("expected error parsing invalid size")
+	}
+}
@@ -1,1232 +1,580 @@
 // Code generated by go-imbed. DO NOT EDIT.
 
+//go:build !imbed_dev
+// +build !imbed_dev
+
 #include "textflag.h"
 
-DATA ·d+0(SB)/8,$"\x1f\x8b\x08\x00\x00\x00\x00\x00"
-DATA ·d+8(SB)/8,$"\x02\xff\xd4\x7c\x7b\x73\xdb\x38"
-DATA ·d+16(SB)/8,$"\x92\xf8\xdf\xe4\xa7\x40\xf8\x87"
-DATA ·d+24(SB)/8,$"\x7f\x64\x22\xd3\xce\x6c\xf6\x51"
-DATA ·d+32(SB)/8,$"\x9e\x51\xaa\x32\x79\xcc\xe4\x77"
-DATA ·d+40(SB)/8,$"\x49\x26\x67\x7b\x76\xea\xca\x97"
-DATA ·d+48(SB)/8,$"\xca\xc0\x22\x68\x61\x4d\x01\x0a"
-DATA ·d+56(SB)/8,$"\x00\x59\xf1\x2a\xfa\xee\x57\xdd"
-DATA ·d+64(SB)/8,$"\x78\x10\xa4\x28\xc9\xce\x64\xf7"
-DATA ·d+72(SB)/8,$"\xea\xb6\x6a\x27\x16\x80\x7e\xa0"
-DATA ·d+80(SB)/8,$"\xd1\xe8\x17\x00\x1e\x1d\x91\xe7"
-DATA ·d+88(SB)/8,$"\xb2\x62\xe4\x8a\x09\xa6\xa8\x61"
-DATA ·d+96(SB)/8,$"\x15\xb9\xbc\x25\x57\xf2\x90\xcf"
-DATA ·d+104(SB)/8,$"\x2e\x59\x55\x92\x17\xbf\x90\x77"
-DATA ·d+112(SB)/8,$"\xbf\x9c\x93\x97\x2f\x5e\x9f\x97"
-DATA ·d+120(SB)/8,$"\x69\x7a\x74\x44\xde\xd3\xc9\x35"
-DATA ·d+128(SB)/8,$"\xbd\x62\x64\xb5\x2a\xdf\x5f\x5f"
-DATA ·d+136(SB)/8,$"\xad\xd7\x64\x2a\x9b\x4a\x93\x4b"
-DATA ·d+144(SB)/8,$"\x2e\xa8\xba\x25\x8a\x69\xb9\x50"
-DATA ·d+152(SB)/8,$"\x13\xa6\x09\x03\xf8\x8a\x55\x84"
-DATA ·d+160(SB)/8,$"\x0b\x23\xc9\x4f\x92\xb0\xcf\x6c"
-DATA ·d+168(SB)/8,$"\xb2\x30\xf4\xb2\x61\xe9\xbc\x87"
-DATA ·d+176(SB)/8,$"\x23\x4d\xf9\x6c\x2e\x95\x21\x79"
-DATA ·d+184(SB)/8,$"\x9a\x64\x52\x67\x69\x92\x71\x09"
-DATA ·d+192(SB)/8,$"\xff\xbd\xbc\x35\x0c\x7f\xce\xa9"
-DATA ·d+200(SB)/8,$"\x99\x1e\xd5\xbc\x61\xf0\x47\x96"
-DATA ·d+208(SB)/8,$"\xae\x56\x87\x84\xd7\xa4\x7c\x4f"
-DATA ·d+216(SB)/8,$"\x15\x9d\xe9\xf2\xc7\x05\x6f\xaa"
-DATA ·d+224(SB)/8,$"\x9f\x8d\x99\xff\x4c\x45\xd5\x30"
-DATA ·d+232(SB)/8,$"\xf5\xec\xfd\x6b\xb2\x5e\xa7\x49"
-DATA ·d+240(SB)/8,$"\xa6\x8d\x9a\x48\x71\x63\x01\x98"
-DATA ·d+248(SB)/8,$"\xa8\xa0\x75\x08\xf6\x95\x6e\x41"
-DATA ·d+256(SB)/8,$"\xa4\x32\x43\xe3\xa5\xda\x24\x67"
-DATA ·d+264(SB)/8,$"\xc1\xf6\x72\x21\x98\x39\x9a\x1a"
-DATA ·d+272(SB)/8,$"\x33\xbf\x2b\xda\x08\x7e\x1b\x97"
-DATA ·d+280(SB)/8,$"\xad\x18\x76\xcc\x6a\x8b\x44\xb8"
-DATA ·d+288(SB)/8,$"\xb8\xd2\xbb\x60\x9f\xcb\xd9\x5c"
-DATA ·d+296(SB)/8,$"\x31\xad\x9f\x69\xcd\x8c\xb6\x60"
-DATA ·d+304(SB)/8,$"\x13\xd7\x76\x74\xf5\x4f\x7e\xa7"
-DATA ·d+312(SB)/8,$"\x79\x74\x45\x33\x84\x92\xcb\x23"
-DATA ·d+320(SB)/8,$"\x2e\x17\x86\x37\x7b\xe7\xf1\x96"
-DATA ·d+328(SB)/8,$"\x72\x61\x61\xea\x86\x5e\x75\x86"
-DATA ·d+336(SB)/8,$"\x27\x99\xe1\x33\x96\xa5\x45\x9a"
-DATA ·d+344(SB)/8,$"\xd6\x0b\x31\x21\x97\x8d\xbc\xfc"
-DATA ·d+352(SB)/8,$"\x88\x5a\x93\x2f\xb8\x30\x7f\xfa"
-DATA ·d+360(SB)/8,$"\xae\x20\x17\x1f\xe0\x77\xd4\x6d"
-DATA ·d+368(SB)/8,$"\x45\x10\xfa\xed\x4f\xd4\x6d\x64"
-DATA ·d+376(SB)/8,$"\x8f\x28\x06\xbc\x32\x61\x36\xb4"
-DATA ·d+384(SB)/8,$"\x9a\x68\x23\x15\xab\xc8\x92\x9b"
-DATA ·d+392(SB)/8,$"\x29\x17\x5d\xa5\x2e\x1d\x34\x9f"
-DATA ·d+400(SB)/8,$"\xcd\x1b\x36\x03\x68\xc0\x58\xcf"
-DATA ·d+408(SB)/8,$"\x4c\x79\x86\x04\x98\x22\x54\x54"
-DATA ·d+416(SB)/8,$"\x84\xcb\xf2\x37\xc5\x0d\x53\xe7"
-DATA ·d+424(SB)/8,$"\x12\x76\x06\x53\x35\x9d\x30\x3d"
-DATA ·d+432(SB)/8,$"\x22\x15\xf3\x22\xe6\xe2\xca\xd3"
-DATA ·d+440(SB)/8,$"\xad\xa8\xa1\x20\x0d\xc1\x26\x4c"
-DATA ·d+448(SB)/8,$"\x6b\xaa\x6e\xcb\xd4\xdc\xce\x99"
-DATA ·d+456(SB)/8,$"\xa3\xa4\x8d\x5a\x4c\x0c\x59\xa5"
-DATA ·d+464(SB)/8,$"\x89\xa0\x33\x46\xfc\xff\xec\x7c"
-DATA ·d+472(SB)/8,$"\xc8\xd1\x11\x79\xc5\x1b\x46\xa0"
-DATA ·d+480(SB)/8,$"\x2f\x4d\x34\xff\x67\x3b\x02\x27"
-DATA ·d+488(SB)/8,$"\x4e\xc2\x08\xec\xcb\x17\xc2\x33"
-DATA ·d+496(SB)/8,$"\xc0\xaa\x22\x4d\x40\x52\x01\xc0"
-DATA ·d+504(SB)/8,$"\x8a\x10\x00\x4e\xbd\x24\xb0\xdf"
-DATA ·d+512(SB)/8,$"\x89\x36\xd1\x46\x7d\x0c\x00\x2d"
-DATA ·d+520(SB)/8,$"\xfd\xee\x60\xaa\x09\xf5\xc2\xde"
-DATA ·d+528(SB)/8,$"\xaf\x71\x5c\x3f\x0f\xec\x90\x4b"
-DATA ·d+536(SB)/8,$"\x29\x1b\x82\x0c\x1b\xb5\x60\x00"
-DATA ·d+544(SB)/8,$"\xd9\xda\x99\x25\xd5\xa4\xe5\x1c"
-DATA ·d+552(SB)/8,$"\x97\x86\x80\x92\x7a\x2d\x01\x64"
-DATA ·d+560(SB)/8,$"\x33\x3e\x28\x9f\xb7\xaf\xdf\xbe"
-DATA ·d+568(SB)/8,$"\x24\xe7\xb7\x73\x96\x26\x86\x5e"
-DATA ·d+576(SB)/8,$"\x91\x81\x11\xe7\xf4\x8a\x70\x4d"
-DATA ·d+584(SB)/8,$"\x00\xb5\x30\x9c\x36\xcd\x2d\xa1"
-DATA ·d+592(SB)/8,$"\xd8\x28\x5b\x16\xc8\x44\x0a\xc3"
-DATA ·d+600(SB)/8,$"\x84\xc1\xe5\x9d\x50\x41\x2e\x19"
-DATA ·d+608(SB)/8,$"\x59\x00\x2b\x38\xe1\x1b\xda\x2c"
-DATA ·d+616(SB)/8,$"\x18\xa9\xa5\x22\xd9\x4b\x43\xaf"
-DATA ·d+624(SB)/8,$"\x32\xf2\xf3\xf9\xf9\x7b\x32\x65"
-DATA ·d+632(SB)/8,$"\xb4\x62\x2a\x5d\xa3\xd6\xbd\x83"
-DATA ·d+640(SB)/8,$"\xd5\x53\xcc\x2c\x94\xd0\xc4\x4c"
-DATA ·d+648(SB)/8,$"\x19\xb9\xa4\xda\xae\x1b\x90\x81"
-DATA ·d+656(SB)/8,$"\x06\x0a\x72\xb1\x1a\x9c\x53\xf2"
-DATA ·d+664(SB)/8,$"\x10\xc5\x54\x20\x5c\xee\xf5\xd7"
-DATA ·d+672(SB)/8,$"\xb1\xbe\x72\x88\x08\x2d\x11\xc1"
-DATA ·d+680(SB)/8,$"\x1a\x08\xbc\xe5\x33\x06\xd3\x0c"
-DATA ·d+688(SB)/8,$"\x44\xc2\xc4\x77\x13\xf0\x70\x31"
-DATA ·d+696(SB)/8,$"\x91\x88\x00\x4a\x15\x09\x80\x44"
-DATA ·d+704(SB)/8,$"\x3c\x6e\xbf\xc6\x64\x39\xe5\x93"
-DATA ·d+712(SB)/8,$"\x29\x0a\x44\x33\x75\xc3\x50\x1c"
-DATA ·d+720(SB)/8,$"\x82\x2c\x04\xff\xb4\x60\xe4\x86"
-DATA ·d+728(SB)/8,$"\x29\xcd\xa5\x20\xbc\x02\xc1\xd6"
-DATA ·d+736(SB)/8,$"\x9c\x29\x94\x51\xe0\x85\xe4\xbc"
-DATA ·d+744(SB)/8,$"\x64\xe5\xc8\x09\xad\xd8\x60\xed"
-DATA ·d+752(SB)/8,$"\x9c\x5e\xf5\xa7\x1e\xb3\x86\xcb"
-DATA ·d+760(SB)/8,$"\x79\x07\xc3\x76\x74\x44\x5e\xc7"
-DATA ·d+768(SB)/8,$"\x8a\x16\x56\x01\xd4\x4c\xd6\x8e"
-DATA ·d+776(SB)/8,$"\x97\x29\xd5\xe4\x92\x31\x11\xe9"
-DATA ·d+784(SB)/8,$"\xd9\x06\x43\x31\x9a\xbc\xb0\x1a"
-DATA ·d+792(SB)/8,$"\x1b\x31\xd4\x51\xe7\x75\x6c\xc1"
-DATA ·d+800(SB)/8,$"\x8e\x8e\x88\x35\x10\x81\x78\x67"
-DATA ·d+808(SB)/8,$"\x2b\x8e\x3a\xfb\xbf\x08\xca\x16"
-DATA ·d+816(SB)/8,$"\x98\x8b\xb7\x55\x9f\x29\x8b\xb8"
-DATA ·d+824(SB)/8,$"\x15\xd4\xea\x2e\x1b\xaf\xee\xb3"
-DATA ·d+832(SB)/8,$"\xbb\x4a\x93\x64\x21\x60\x4f\x8d"
-DATA ·d+840(SB)/8,$"\xc8\x47\x72\x32\xc6\xed\x55\xbe"
-DATA ·d+848(SB)/8,$"\x63\xcb\x53\xd4\xe2\x1c\xcd\x6d"
-DATA ·d+856(SB)/8,$"\xf4\x9b\x96\xb0\xdf\x8b\x22\x4d"
-DATA ·d+864(SB)/8,$"\x12\xc5\x8c\x83\xb1\xa6\xbe\x84"
-DATA ·d+872(SB)/8,$"\x21\xcf\x9a\x26\xb7\xf8\x8a\x80"
-DATA ·d+880(SB)/8,$"\xb9\x7c\xde\x48\xcd\x72\x07\x03"
-DATA ·d+888(SB)/8,$"\x32\x73\x56\x5a\x31\x53\xa4\x49"
-DATA ·d+896(SB)/8,$"\x47\x62\x49\x90\xaa\xb7\x3b\x6e"
-DATA ·d+904(SB)/8,$"\x23\xfd\x08\x8c\x0c\x8b\x71\x9b"
-DATA ·d+912(SB)/8,$"\xe0\x62\xe7\x10\x09\x0e\x31\xe5"
-DATA ·d+920(SB)/8,$"\xde\x77\xfc\x9f\x92\x9b\x62\x66"
-DATA ·d+928(SB)/8,$"\x40\x5e\x80\x6a\x46\xaf\x59\x6e"
-DATA ·d+936(SB)/8,$"\x67\x34\x22\x0d\x13\x11\xc1\x89"
-DATA ·d+944(SB)/8,$"\x9c\xdf\xe6\x48\xd4\xb5\xa5\x31"
-DATA ·d+952(SB)/8,$"\xba\x61\xaf\x7c\x4a\x97\x28\x26"
-DATA ·d+960(SB)/8,$"\x17\x5a\x80\xbd\x77\x2d\x91\x2d"
-DATA ·d+968(SB)/8,$"\x50\x74\x49\x50\x84\xba\xe1\x93"
-DATA ·d+976(SB)/8,$"\xae\xb9\x29\xc9\xf3\x29\x15\x57"
-DATA ·d+984(SB)/8,$"\xa0\x97\xd1\xda\xd8\x71\x4b\xde"
-DATA ·d+992(SB)/8,$"\x34\x60\x60\x17\x8d\xb1\xf1\xa3"
-DATA ·d+1000(SB)/8,$"\x66\x57\x35\x5d\x34\xa6\xdc\x58"
-DATA ·d+1008(SB)/8,$"\x2a\x4f\x34\x5e\xad\x56\x43\x9c"
-DATA ·d+1016(SB)/8,$"\x76\x44\xd2\xc0\x0d\x07\x1e\xaf"
-DATA ·d+1024(SB)/8,$"\x75\xd4\x44\xea\x12\x3c\xe1\x6b"
-DATA ·d+1032(SB)/8,$"\x51\x4b\xb4\xe2\xb1\x31\x46\xef"
-DATA ·d+1040(SB)/8,$"\x18\xf3\x3d\xb0\x3f\xb7\x9a\x89"
-DATA ·d+1048(SB)/8,$"\x4d\xc3\x05\xa4\xf3\x02\x26\xf5"
-DATA ·d+1056(SB)/8,$"\x97\x27\x1b\x86\x0b\x5b\x73\x5a"
-DATA ·d+1064(SB)/8,$"\x02\xcd\xc2\xd9\x6e\x88\xcd\x77"
-DATA ·d+1072(SB)/8,$"\xb0\x4a\x9b\x25\xbd\x6d\x25\x7e"
-DATA ·d+1080(SB)/8,$"\xfc\xe4\xc9\x93\x4d\x3b\x2e\x2b"
-DATA ·d+1088(SB)/8,$"\xa0\xe9\x40\x11\x63\x4b\x13\x20"
-DATA ·d+1096(SB)/8,$"\x02\xa9\x73\x30\xe9\x77\x14\x0c"
-DATA ·d+1104(SB)/8,$"\x04\x5e\x44\x1b\x3a\x9b\x93\xe5"
-DATA ·d+1112(SB)/8,$"\x94\x09\x62\xa6\x5c\x13\x1f\xd9"
-DATA ·d+1120(SB)/8,$"\x07\x59\xcc\x95\xac\x16\x13\x56"
-DATA ·d+1128(SB)/8,$"\x91\x1c\x85\x09\x3e\xa9\x75\x89"
-DATA ·d+1136(SB)/8,$"\xb4\x69\x5a\xb9\xea\x62\x88\x71"
-DATA ·d+1144(SB)/8,$"\xe0\x28\x2f\x90\x58\x89\xdc\x05"
-DATA ·d+1152(SB)/8,$"\xc6\x2d\x69\x67\xc3\x5f\x70\x75"
-DATA ·d+1160(SB)/8,$"\x17\xbe\x6b\xda\x68\x36\x60\xbb"
-DATA ·d+1168(SB)/8,$"\x5f\x70\xe5\x8d\x76\x7f\x4d\x10"
-DATA ·d+1176(SB)/8,$"\xc4\x92\x39\xbb\xd5\x77\x21\x22"
-DATA ·d+1184(SB)/8,$"\x78\xb3\xb9\xec\xb7\xda\xae\xba"
-DATA ·d+1192(SB)/8,$"\x0d\xf8\x56\xeb\x8e\xbf\x22\x56"
-DATA ·d+1200(SB)/8,$"\x2d\x31\x30\x84\xb8\xb0\xa5\x31"
-DATA ·d+1208(SB)/8,$"\x18\x2e\x22\xb5\x25\x34\xeb\x78"
-DATA ·d+1216(SB)/8,$"\xeb\xb4\x1a\x6a\x24\x59\x6e\xb0"
-DATA ·d+1224(SB)/8,$"\xe0\xb0\xe7\xcb\x16\x69\x41\x72"
-DATA ·d+1232(SB)/8,$"\x54\xb9\x11\x61\x4a\x49\x55\xfc"
-DATA ·d+1240(SB)/8,$"\xfb\x0d\x9d\x40\xd2\xd6\xd0\x95"
-DATA ·d+1248(SB)/8,$"\xcf\xc1\x0a\x2d\x47\x64\xbf\x91"
-DATA ·d+1256(SB)/8,$"\xb3\x60\x7d\x3b\xd7\x22\x5b\xda"
-DATA ·d+1264(SB)/8,$"\x09\xe6\x7d\x6b\x66\x37\x98\x28"
-DATA ·d+1272(SB)/8,$"\x2c\xf8\x3a\xb5\xe1\x34\x0a\xcd"
-DATA ·d+1280(SB)/8,$"\xf2\x16\x05\xd5\x96\xeb\xd3\x10"
-DATA ·d+1288(SB)/8,$"\xa9\x59\x79\x2a\xf2\x30\x1a\x5e"
-DATA ·d+1296(SB)/8,$"\x10\xc7\x9a\x15\x20\x9a\x9e\xf2"
-DATA ·d+1304(SB)/8,$"\x94\x69\x66\x72\xc1\x9b\x96\x2e"
-DATA ·d+1312(SB)/8,$"\xa8\x84\x5d\xe3\x53\xa7\x24\x83"
-DATA ·d+1320(SB)/8,$"\xeb\x46\x71\xc1\x2d\x6a\x44\xac"
-DATA ·d+1328(SB)/8,$"\x06\xec\x9d\x95\x61\xe1\x47\xda"
-DATA ·d+1336(SB)/8,$"\x71\xff\x2b\x2e\x0a\x66\x66\xa1"
-DATA ·d+1344(SB)/8,$"\xd3\x64\x4d\x18\xec\x93\x55\x67"
-DATA ·d+1352(SB)/8,$"\x41\xbc\xe7\x39\x88\x44\xb6\x72"
-DATA ·d+1360(SB)/8,$"\xed\x4e\x4c\x61\x85\x62\x87\xb3"
-DATA ·d+1368(SB)/8,$"\x7f\x2a\x9d\x85\xf7\x8b\x33\x69"
-DATA ·d+1376(SB)/8,$"\x18\x15\xef\xa9\x99\xe6\x90\x1e"
-DATA ·d+1384(SB)/8,$"\xbb\x08\x22\x0a\x7e\x12\x6c\x1e"
-DATA ·d+1392(SB)/8,$"\x13\x5f\x47\x28\x9f\x03\x00\x0e"
-DATA ·d+1400(SB)/8,$"\x2e\x50\x3a\xa1\xe3\xb5\x7e\x76"
-DATA ·d+1408(SB)/8,$"\xa9\x6d\x07\xca\xc8\x01\xc2\x3f"
-DATA ·d+1416(SB)/8,$"\x17\xe0\x39\xc3\xc0\xbf\xcb\x66"
-DATA ·d+1424(SB)/8,$"\x31\x63\x18\x89\xe3\xe8\xe2\xe4"
-DATA ·d+1432(SB)/8,$"\x43\x9a\x00\x2a\x18\x65\xe1\x9f"
-DATA ·d+1440(SB)/8,$"\x92\x63\xf2\xe5\x0b\x58\x8b\xd7"
-DATA ·d+1448(SB)/8,$"\x1a\x98\x3b\x63\x73\xaa\xa8\x91"
-DATA ·d+1456(SB)/8,$"\x0a\xfb\x2f\x8e\x3f\x58\x12\x1d"
-DATA ·d+1464(SB)/8,$"\x1a\x8f\x11\xcd\x3a\x88\x95\xd7"
-DATA ·d+1472(SB)/8,$"\xc4\x76\x8f\x49\x56\x66\x38\xde"
-DATA ·d+1480(SB)/8,$"\x89\x2b\xcb\x40\x18\xfe\x57\xe0"
-DATA ·d+1488(SB)/8,$"\xeb\x5c\x9e\x35\x54\x4f\xdd\xdc"
-DATA ·d+1496(SB)/8,$"\xac\xea\xfd\x32\x67\xe0\x93\x43"
-DATA ·d+1504(SB)/8,$"\xf0\x23\xba\x2a\x54\x06\xdd\x94"
-DATA ·d+1512(SB)/8,$"\xba\x7c\xa9\xd4\x3b\x69\x5e\x7e"
-DATA ·d+1520(SB)/8,$"\xe6\xda\x60\x00\x2a\x1d\x1c\xd7"
-DATA ·d+1528(SB)/8,$"\xa4\x96\x0b\x51\x95\xbb\xcb\x28"
-DATA ·d+1536(SB)/8,$"\xb8\x1c\x40\x2f\xc7\x2c\xc4\xaf"
-DATA ·d+1544(SB)/8,$"\x44\x0e\xf6\x32\x32\x36\x9e\xed"
-DATA ·d+1552(SB)/8,$"\x57\x67\x79\x51\x86\xe1\x85\x77"
-DATA ·d+1560(SB)/8,$"\xd8\x68\x78\xb7\x23\xeb\x70\x1f"
-DATA ·d+1568(SB)/8,$"\x63\xc5\x61\xe3\x48\x1d\x2c\xd6"
-DATA ·d+1576(SB)/8,$"\xc4\x7b\xea\x11\x91\xd7\xa0\x96"
-DATA ·d+1584(SB)/8,$"\x35\xaf\x3e\x5f\x40\xdf\x87\xef"
-DATA ·d+1592(SB)/8,$"\xc9\x03\x79\x1d\x8b\x55\xf0\x66"
-DATA ·d+1600(SB)/8,$"\xd4\x93\x43\xa4\xe3\x61\x98\x0d"
-DATA ·d+1608(SB)/8,$"\x64\xfc\x96\x1c\xe1\x3e\x4f\xd6"
-DATA ·d+1616(SB)/8,$"\x9b\x01\xc7\x4f\xa0\xb7\x56\x82"
-DATA ·d+1624(SB)/8,$"\x97\xb7\x98\xda\xb5\xd2\x16\xbc"
-DATA ·d+1632(SB)/8,$"\xe9\x88\xd8\xc9\x17\x67\xfd\x13"
-DATA ·d+1640(SB)/8,$"\x18\x92\x78\xd2\xd6\x0a\x00\x0b"
-DATA ·d+1648(SB)/8,$"\xbc\x26\x4c\x18\x75\x3b\x34\x99"
-DATA ·d+1656(SB)/8,$"\xee\x5c\x70\xd8\x10\xf7\x81\x5b"
-DATA ·d+1664(SB)/8,$"\xc7\x62\x9f\xc3\xf7\x54\xf0\x89"
-DATA ·d+1672(SB)/8,$"\xde\xca\xdc\xdb\x85\xfe\x97\x70"
-DATA ·d+1680(SB)/8,$"\x37\x07\xb2\x79\x66\x09\x66\xe4"
-DATA ·d+1688(SB)/8,$"\x91\x4d\x85\x1f\x91\x8c\x08\xe9"
-DATA ·d+1696(SB)/8,$"\x38\xc8\x0a\xc7\x38\xda\xef\x8a"
-DATA ·d+1704(SB)/8,$"\x2b\x36\x31\x52\xdd\x0e\xd7\x45"
-DATA ·d+1712(SB)/8,$"\x5c\x72\x94\x54\x5c\x69\x42\x2e"
-DATA ·d+1720(SB)/8,$"\x3e\x74\x87\xa7\x09\x6c\x1a\x4d"
-DATA ·d+1728(SB)/8,$"\x2e\x3e\xd8\x9f\xeb\x34\xbd\xa1"
-DATA ·d+1736(SB)/8,$"\x8a\x28\x29\x0d\x79\xd8\x1b\xba"
-DATA ·d+1744(SB)/8,$"\xbb\xd8\xe5\xd6\xfa\x19\xd1\xe8"
-DATA ·d+1752(SB)/8,$"\xc0\xb1\xb8\x72\x76\xab\x0d\x9b"
-DATA ·d+1760(SB)/8,$"\x11\x7a\xa9\x8d\xa2\x13\xc3\xa5"
-DATA ·d+1768(SB)/8,$"\xb0\x2c\x47\x7d\xad\x5b\x5f\xa5"
-DATA ·d+1776(SB)/8,$"\xc9\x9e\x3d\x93\x26\x67\x86\xf6"
-DATA ·d+1784(SB)/8,$"\x84\x9e\x47\x71\x48\x3b\x0e\xab"
-DATA ·d+1792(SB)/8,$"\x59\x84\x47\x26\xe1\x37\xda\x5c"
-DATA ·d+1800(SB)/8,$"\xa7\x09\xfc\x37\xc7\xc9\x59\xf8"
-DATA ·d+1808(SB)/8,$"\x11\x59\xd2\xe6\xfa\x15\xac\x67"
-DATA ·d+1816(SB)/8,$"\x67\x24\xb4\x38\xaf\xb6\xb5\xb6"
-DATA ·d+1824(SB)/8,$"\x18\xa6\x4d\x6c\x71\xc6\x6b\xf4"
-DATA ·d+1832(SB)/8,$"\xd4\x98\x79\x39\x38\x43\x23\xc9"
-DATA ·d+1840(SB)/8,$"\x42\x33\x5b\xa4\xc1\x51\x67\x4c"
-DATA ·d+1848(SB)/8,$"\xdd\x30\x95\x26\x88\x2e\x40\xe4"
-DATA ·d+1856(SB)/8,$"\x45\x1f\x47\xcf\xdc\x1f\x1d\x91"
-DATA ·d+1864(SB)/8,$"\xf3\x29\x23\x10\x30\x9c\x4b\x32"
-DATA ·d+1872(SB)/8,$"\x63\x66\x2a\x2b\xc2\x3e\xa3\x8c"
-DATA ·d+1880(SB)/8,$"\x35\x86\x95\x10\x3e\x71\x29\x58"
-DATA ·d+1888(SB)/8,$"\x85\xd3\xc2\x5a\x9c\x91\x90\x29"
-DATA ·d+1896(SB)/8,$"\xcf\xd9\x84\xd7\x9c\x55\xa4\x91"
-DATA ·d+1904(SB)/8,$"\x13\x0a\x43\x46\xe4\x9a\xb1\x39"
-DATA ·d+1912(SB)/8,$"\x78\x88\xb0\xda\x4e\x85\x16\x8a"
-DATA ·d+1920(SB)/8,$"\x95\x18\x5e\xd6\x44\x2f\xe6\xf3"
-DATA ·d+1928(SB)/8,$"\x86\x3b\x6c\x60\x05\x69\x3b\x7a"
-DATA ·d+1936(SB)/8,$"\x44\xcc\x14\x8c\xa9\xb1\x69\xcb"
-DATA ·d+1944(SB)/8,$"\x25\xf3\x9c\xb0\x0a\xa0\x15\x9b"
-DATA ·d+1952(SB)/8,$"\x2c\x94\xe6\x37\xac\xb9\x2d\x3d"
-DATA ·d+1960(SB)/8,$"\xc7\x28\x00\x21\x2d\xb6\x96\x55"
-DATA ·d+1968(SB)/8,$"\x84\x77\xc0\xc8\xf1\x94\x91\xe5"
-DATA ·d+1976(SB)/8,$"\x54\x36\xac\x1f\x2e\x84\xe2\x3a"
-DATA ·d+1984(SB)/8,$"\x4e\x0e\x25\x84\x9c\x3a\xf4\x3e"
-DATA ·d+1992(SB)/8,$"\x12\xb5\x41\x09\x47\x18\xe5\xd8"
-DATA ·d+2000(SB)/8,$"\x46\x92\x48\x3f\x04\xe4\x58\x22"
-DATA ·d+2008(SB)/8,$"\x3c\x3a\x22\xd4\xd8\xc0\x9e\xaa"
-DATA ·d+2016(SB)/8,$"\x2b\x66\x22\xf9\x2c\x44\xc3\xb4"
-DATA ·d+2024(SB)/8,$"\x26\xf2\x86\x29\x8c\x3a\x01\x91"
-DATA ·d+2032(SB)/8,$"\x0b\x33\x8d\x5a\xb0\x11\x91\x0a"
-DATA ·d+2040(SB)/8,$"\x6b\x9d\x80\x19\x02\xff\x80\x18"
-DATA ·d+2048(SB)/8,$"\x73\x27\x08\x56\x67\xb2\xe2\x35"
-DATA ·d+2056(SB)/8,$"\xb7\xf8\xec\x38\x37\xac\x23\x29"
-DATA ·d+2064(SB)/8,$"\xe8\xc0\x69\xf8\x73\x81\xd2\xce"
-DATA ·d+2072(SB)/8,$"\x27\xcf\xca\x6c\x04\x38\xd8\xc8"
-DATA ·d+2080(SB)/8,$"\x86\xe3\x85\x93\x54\x5d\xb3\x89"
-DATA ·d+2088(SB)/8,$"\x41\xc9\x02\x94\xc3\xd5\x97\x55"
-DATA ·d+2096(SB)/8,$"\x2b\x22\x64\x78\xca\xc8\x64\xa1"
-DATA ·d+2104(SB)/8,$"\x14\x0c\x68\xd7\x3b\xc7\x62\x15"
-DATA ·d+2112(SB)/8,$"\x20\x81\x24\x59\xc3\x5a\xc2\xc0"
-DATA ·d+2120(SB)/8,$"\x99\xd4\x86\xe8\x39\x9d\xb0\xc3"
-DATA ·d+2128(SB)/8,$"\x25\x07\x37\x2c\x58\x5d\xf3\x09"
-DATA ·d+2136(SB)/8,$"\x07\x60\xcd\x9a\xfa\xd0\x91\x04"
-DATA ·d+2144(SB)/8,$"\xe5\xa1\x6a\x32\xe5\x37\x28\x47"
-DATA ·d+2152(SB)/8,$"\x76\xc3\x54\xe1\x8c\xa4\x9b\x81"
-DATA ·d+2160(SB)/8,$"\x93\xa9\xdf\x73\x30\x97\x38\x1f"
-DATA ·d+2168(SB)/8,$"\x1b\x45\xc2\x85\x2c\x64\x64\xb9"
-DATA ·d+2176(SB)/8,$"\x26\x65\x59\xfa\x6d\x1e\x02\x4c"
-DATA ·d+2184(SB)/8,$"\x84\x25\x84\x8c\x09\xa2\x39\x38"
-DATA ·d+2192(SB)/8,$"\xfe\xeb\x5f\xff\x8a\xc6\x0d\x3b"
-DATA ·d+2200(SB)/8,$"\x4e\xc6\x80\x17\x70\xbe\xe0\xea"
-DATA ·d+2208(SB)/8,$"\x4b\x9e\xdb\x21\x4f\x9e\x3c\x29"
-DATA ·d+2216(SB)/8,$"\x9e\x3e\xfd\xae\xf8\x02\x3f\x53"
-DATA ·d+2224(SB)/8,$"\x1f\x9f\x20\x8d\x02\x82\x8a\x63"
-DATA ·d+2232(SB)/8,$"\x34\xbc\x96\xe6\x98\x5c\x7c\xb0"
-DATA ·d+2240(SB)/8,$"\x44\x57\x59\x86\xa1\x56\x02\xb9"
-DATA ·d+2248(SB)/8,$"\xda\x47\xcb\x13\x10\x50\x54\x5c"
-DATA ·d+2256(SB)/8,$"\x39\xb9\x06\xb8\x8e\xbf\x85\x06"
-DATA ·d+2264(SB)/8,$"\x08\xe9\x5c\x34\x8e\xee\x1d\x0d"
-DATA ·d+2272(SB)/8,$"\x4f\x8d\xb6\x0c\x04\x13\x87\x68"
-DATA ·d+2280(SB)/8,$"\x23\xc2\x21\x87\xea\xdb\x31\xef"
-DATA ·d+2288(SB)/8,$"\xd1\xc3\xcc\x31\xb0\x82\x8e\x07"
-DATA ·d+2296(SB)/8,$"\x63\xf4\x9a\xd8\x14\xfc\x08\xe4"
-DATA ·d+2304(SB)/8,$"\x03\x18\x33\x25\x89\x95\x36\xb0"
-DATA ·d+2312(SB)/8,$"\x62\x7d\x8f\xb3\x6b\xff\x5f\x72"
-DATA ·d+2320(SB)/8,$"\xe1\x56\x62\x44\x5c\xd4\x07\xdc"
-DATA ·d+2328(SB)/8,$"\x87\xb4\x41\xea\x12\xed\x6b\x0b"
-DATA ·d+2336(SB)/8,$"\x5f\x44\x54\xc7\x31\x55\x5e\x23"
-DATA ·d+2344(SB)/8,$"\xd3\xa5\xcf\x1d\x0f\x0e\x48\xcd"
-DATA ·d+2352(SB)/8,$"\xc3\x2f\x3b\xa6\xe3\x67\x81\xb7"
-DATA ·d+2360(SB)/8,$"\x10\xcc\x75\x40\x1f\x8c\xb7\x83"
-DATA ·d+2368(SB)/8,$"\xda\x10\xc4\xc5\x1f\x1d\x14\x0f"
-DATA ·d+2376(SB)/8,$"\x5a\x8d\x71\x20\x1e\xaf\x2b\x31"
-DATA ·d+2384(SB)/8,$"\x8c\x11\xad\xfb\x71\x70\x60\xfb"
-DATA ·d+2392(SB)/8,$"\x42\x46\x5d\xbe\xfc\xb4\xa0\x4d"
-DATA ·d+2400(SB)/8,$"\x5e\xf3\xb6\x29\xd0\xee\xf3\x1d"
-DATA ·d+2408(SB)/8,$"\x3b\xe7\x1d\xbc\x59\xd9\xdb\xff"
-DATA ·d+2416(SB)/8,$"\xae\xd3\x01\x19\x75\xd6\x0b\xb4"
-DATA ·d+2424(SB)/8,$"\xf4\xba\xe2\xea\x59\xd3\x44\xf2"
-DATA ·d+2432(SB)/8,$"\x1e\x11\xa7\xc8\x45\xc0\x62\xe3"
-DATA ·d+2440(SB)/8,$"\x80\x93\x31\x06\x43\xf3\x68\x4d"
-DATA ·d+2448(SB)/8,$"\x6c\xc7\x78\x40\x17\xfa\x81\xdb"
-DATA ·d+2456(SB)/8,$"\x86\x5a\xbc\xe0\xaa\xa3\x19\xc0"
-DATA ·d+2464(SB)/8,$"\xdf\x96\x45\xdf\xc2\xe8\x0b\xae"
-DATA ·d+2472(SB)/8,$"\x5a\x5e\xbf\xbf\x93\x56\x56\xda"
-DATA ·d+2480(SB)/8,$"\x44\xd9\x2e\x96\xf5\xce\xd9\x0c"
-DATA ·d+2488(SB)/8,$"\xdd\x5e\x1f\x71\x56\xe2\xf1\x6b"
-DATA ·d+2496(SB)/8,$"\x56\xdc\x43\xe9\x2b\x56\x33\x65"
-DATA ·d+2504(SB)/8,$"\xf7\x96\x17\x75\xa5\x4d\x94\x3b"
-DATA ·d+2512(SB)/8,$"\x27\x89\x84\x7c\x76\x26\x6f\x58"
-DATA ·d+2520(SB)/8,$"\x0e\x3d\xf6\xfc\xc0\x0a\xda\x0e"
-DATA ·d+2528(SB)/8,$"\xf8\x38\x72\x73\xb6\x71\xad\xaf"
-DATA ·d+2536(SB)/8,$"\x18\x54\xda\xdc\x8b\x91\x2e\x55"
-DATA ·d+2544(SB)/8,$"\xa9\xcb\xe7\x53\xc3\x67\x4c\x47"
-DATA ·d+2552(SB)/8,$"\x54\x47\x3d\x75\xec\xff\x6e\x21"
-DATA ·d+2560(SB)/8,$"\x67\xb2\xea\xc0\x05\xe5\x68\xd7"
-DATA ·d+2568(SB)/8,$"\xfa\x94\x81\x07\xeb\x8c\xea\x2e"
-DATA ·d+2576(SB)/8,$"\xe6\xba\x48\x07\xb9\xef\x30\xbf"
-DATA ·d+2584(SB)/8,$"\x8e\x13\x29\x97\xb0\x63\xac\x56"
-DATA ·d+2592(SB)/8,$"\x3b\xa3\x74\x86\xa5\xc9\x8b\x0f"
-DATA ·d+2600(SB)/8,$"\x91\x9d\x72\xd9\x79\xcd\x35\x79"
-DATA ·d+2608(SB)/8,$"\xd8\x19\x56\x90\x37\x4c\xd8\x8a"
-DATA ·d+2616(SB)/8,$"\x4f\x7b\xae\xd4\x56\x7c\xc0\xfa"
-DATA ·d+2624(SB)/8,$"\x3e\xac\xb9\x2e\xc8\x7a\x27\x0a"
-DATA ·d+2632(SB)/8,$"\xad\x73\x3e\x22\xff\x00\x34\xfd"
-DATA ·d+2640(SB)/8,$"\x33\x05\x0b\x7f\xc1\x3f\xb8\x39"
-DATA ·d+2648(SB)/8,$"\x93\x1f\x7c\xd3\x3f\x42\xd3\x2e"
-DATA ·d+2656(SB)/8,$"\xe4\x67\x4b\x3a\x8f\x90\xaf\xd2"
-DATA ·d+2664(SB)/8,$"\x44\x83\x62\x06\xb4\x69\x12\xfe"
-DATA ·d+2672(SB)/8,$"\x24\xe3\x16\x75\x68\xfe\x07\x34"
-DATA ·d+2680(SB)/8,$"\xeb\x90\x7b\x43\x14\x79\xca\x26"
-DATA ·d+2688(SB)/8,$"\x79\xad\xa3\xd8\x76\xc8\xb0\xcf"
-DATA ·d+2696(SB)/8,$"\xbb\x81\xa7\xd8\x1a\x76\x02\x4b"
-DATA ·d+2704(SB)/8,$"\x10\x89\xe7\x69\x02\xde\x0d\xd1"
-DATA ·d+2712(SB)/8,$"\xa2\xb3\xd1\xdd\x15\x71\x7e\xc6"
-DATA ·d+2720(SB)/8,$"\x86\xaa\x49\x91\x26\x56\x85\x2d"
-DATA ·d+2728(SB)/8,$"\xf6\x7c\x6e\x79\xc0\xd4\xcc\xa6"
-DATA ·d+2736(SB)/8,$"\x80\x3d\x25\x18\x30\xe4\xce\xd8"
-DATA ·d+2744(SB)/8,$"\x07\xc6\xce\xae\xf9\x1c\x2c\x46"
-DATA ·d+2752(SB)/8,$"\xac\x33\xd6\x36\xae\xd3\xae\x12"
-DATA ·d+2760(SB)/8,$"\xd9\x22\xcb\x83\x0d\xab\xd7\x4d"
-DATA ·d+2768(SB)/8,$"\xb8\x60\x3a\x7e\xa7\xd5\xda\x26"
-DATA ·d+2776(SB)/8,$"\xbe\xf3\x41\xe6\x1c\x5c\x7f\x2e"
-DATA ·d+2784(SB)/8,$"\x4c\xa9\xc2\x3a\x66\xae\x3d\xa2"
-DATA ·d+2792(SB)/8,$"\x8a\x2b\x4c\x44\x2b\xae\xf2\xc3"
-DATA ·d+2800(SB)/8,$"\xc7\x5f\x85\x4d\x4b\x65\xca\x33"
-DATA ·d+2808(SB)/8,$"\xa9\x4c\x7e\x00\x4b\x6c\xfd\x3e"
-DATA ·d+2816(SB)/8,$"\x8f\x3d\xbe\xf3\xf7\x02\xda\x5a"
-DATA ·d+2824(SB)/8,$"\x97\x3a\x87\xd0\x40\xb7\xaa\xe8"
-DATA ·d+2832(SB)/8,$"\x5d\xff\x38\xd2\x0a\x3f\x64\x44"
-DATA ·d+2840(SB)/8,$"\x6a\xe1\x97\x7e\xcb\xae\x04\x09"
-DATA ·d+2848(SB)/8,$"\x3a\x7c\x5e\x86\x5f\xbe\xf8\x51"
-DATA ·d+2856(SB)/8,$"\xc3\x8b\x32\x60\x86\x86\xb6\x73"
-DATA ·d+2864(SB)/8,$"\xd0\xd4\xbe\x9a\x46\x09\xd5\x1d"
-DATA ·d+2872(SB)/8,$"\x12\x22\xaf\x99\x2a\xd6\x6c\xdb"
-DATA ·d+2880(SB)/8,$"\x14\x69\xe2\xb6\x2a\x44\xbc\xf4"
-DATA ·d+2888(SB)/8,$"\x21\x9b\xdb\xb5\x5e\x5e\xb3\xa2"
-DATA ·d+2896(SB)/8,$"\xe5\xf3\x32\x55\x96\xf5\x96\xe7"
-DATA ·d+2904(SB)/8,$"\xa2\x5b\xd4\x7c\xa5\x5d\x2a\xb3"
-DATA ·d+2912(SB)/8,$"\xea\x56\x1f\x43\xfe\x10\x65\x58"
-DATA ·d+2920(SB)/8,$"\x28\x1c\x08\xd5\xe2\xe4\xb4\xad"
-DATA ·d+2928(SB)/8,$"\xd1\x1c\x38\x84\xab\xb6\xde\x06"
-DATA ·d+2936(SB)/8,$"\x52\x7c\xe8\x9a\x0b\xf2\x15\x99"
-DATA ·d+2944(SB)/8,$"\x65\x84\xde\xad\xca\x08\x13\xef"
-DATA ·d+2952(SB)/8,$"\xde\x7c\x06\x88\xdd\x2d\x0d\xde"
-DATA ·d+2960(SB)/8,$"\x53\x0c\xb2\x5d\x63\x92\x75\x2a"
-DATA ·d+2968(SB)/8,$"\x6a\x96\x01\xbf\x55\x79\x4d\x70"
-DATA ·d+2976(SB)/8,$"\xb7\xda\x32\x46\xb5\xb5\x8c\x81"
-DATA ·d+2984(SB)/8,$"\x83\x22\xa0\xad\x85\xa6\x2e\x98"
-DATA ·d+2992(SB)/8,$"\x1b\xe6\x01\x77\x54\x9f\x86\xe5"
-DATA ·d+3000(SB)/8,$"\x70\x87\x1a\xdb\x57\x09\xa0\x94"
-DATA ·d+3008(SB)/8,$"\x80\x38\xcb\x8a\xaf\x92\x84\x85"
-DATA ·d+3016(SB)/8,$"\x46\x3a\x5f\x2d\x94\x41\x1c\xbb"
-DATA ·d+3024(SB)/8,$"\xe5\xb3\xb7\x4e\x31\x20\xc0\x7d"
-DATA ·d+3032(SB)/8,$"\x85\x87\x78\x07\x4c\x3b\x63\x57"
-DATA ·d+3040(SB)/8,$"\xb5\x3e\x21\xb5\xee\x57\xfb\x6c"
-DATA ·d+3048(SB)/8,$"\x09\xe8\x95\x2b\x13\x58\x50\x7b"
-DATA ·d+3056(SB)/8,$"\xa9\xee\x86\x2b\xb3\xa0\x4d\xb4"
-DATA ·d+3064(SB)/8,$"\xbd\xfe\x9f\xc6\x05\x74\x05\x8c"
-DATA ·d+3072(SB)/8,$"\xd2\x97\x35\xec\x4f\x4d\xf4\x54"
-DATA ·d+3080(SB)/8,$"\x2e\x9a\x8a\x5c\xb2\x29\xbd\x61"
-DATA ·d+3088(SB)/8,$"\x6d\x56\x8d\xa9\xb3\xd4\x8c\x48"
-DATA ·d+3096(SB)/8,$"\x41\xa8\x20\x0f\x9d\xe2\x97\x6d"
-DATA ·d+3104(SB)/8,$"\x65\xa9\x5b\x53\xe2\xd2\x86\x63"
-DATA ·d+3112(SB)/8,$"\x0a\xff\x74\x07\x1a\xf0\xe7\x19"
-DATA ·d+3120(SB)/8,$"\x63\xd7\xf0\xa7\x77\x1b\x13\xb9"
-DATA ·d+3128(SB)/8,$"\x10\xc6\x86\x03\x79\x27\xcc\xe9"
-DATA ·d+3136(SB)/8,$"\x95\x9f\xb6\xd4\x9c\x82\x86\xb6"
-DATA ·d+3144(SB)/8,$"\x87\x15\x72\x43\x3d\x91\xbd\xaf"
-DATA ·d+3152(SB)/8,$"\x3f\xac\xe8\x1c\x28\xb4\x7d\x80"
-DATA ·d+3160(SB)/8,$"\x75\x15\x92\x85\x13\x42\x47\xf0"
-DATA ·d+3168(SB)/8,$"\x03\x08\x9f\x10\x6b\x21\x5b\x67"
-DATA ·d+3176(SB)/8,$"\xed\x0e\x1e\xf6\x1f\x6d\xd8\x93"
-DATA ·d+3184(SB)/8,$"\xf4\xfd\xe7\x1a\x5f\x41\xfc\x5b"
-DATA ·d+3192(SB)/8,$"\x9e\x7a\xe4\x55\xbf\x4e\xb9\x43"
-DATA ·d+3200(SB)/8,$"\xee\x41\x97\xbb\x10\x7e\x0a\x15"
-DATA ·d+3208(SB)/8,$"\x57\x27\x84\x54\xc0\xb0\xe5\xdf"
-DATA ·d+3216(SB)/8,$"\xb3\x3f\x97\xfa\x84\x90\xe3\xd1"
-DATA ·d+3224(SB)/8,$"\xf6\xb2\xab\xbd\x4a\x16\x4a\xaf"
-DATA ·d+3232(SB)/8,$"\x10\xb1\x6d\xd4\x4f\x93\x88\xa5"
-DATA ·d+3240(SB)/8,$"\x14\x70\xe2\x5d\xb4\x1d\x33\x01"
-DATA ·d+3248(SB)/8,$"\xa4\xfd\x8b\x4e\xed\x24\x2a\xbc"
-DATA ·d+3256(SB)/8,$"\xe3\xb4\x17\x7c\x32\x65\x93\x6b"
-DATA ·d+3264(SB)/8,$"\xdc\x01\x55\x7c\x50\x07\xf6\xac"
-DATA ·d+3272(SB)/8,$"\x04\x1e\x7e\x70\xe5\x8f\x4e\xf2"
-DATA ·d+3280(SB)/8,$"\x68\xc7\x6f\x8b\x21\xb6\x91\xda"
-DATA ·d+3288(SB)/8,$"\x38\x0d\x74\x4e\x1d\x0c\x66\xd9"
-DATA ·d+3296(SB)/8,$"\xe1\xe3\xfb\x3d\xce\xde\xb2\x36"
-DATA ·d+3304(SB)/8,$"\x26\x87\x8f\xef\xc5\x00\x28\xb3"
-DATA ·d+3312(SB)/8,$"\xbb\xe3\x61\x0f\x77\x63\x4f\x70"
-DATA ·d+3320(SB)/8,$"\x6f\x66\x8e\x47\xfd\xe0\xe3\x78"
-DATA ·d+3328(SB)/8,$"\x44\xb8\x2c\x5f\xfe\xf2\x6a\x2f"
-DATA ·d+3336(SB)/8,$"\x27\x3b\x2c\xc5\x57\xf1\x82\x36"
-DATA ·d+3344(SB)/8,$"\xbf\xc7\x4d\x55\x06\xb7\xbb\x97"
-DATA ·d+3352(SB)/8,$"\x1d\xc6\xae\x73\x10\xa9\x3b\xf0"
-DATA ·d+3360(SB)/8,$"\x5e\x4e\x99\x98\x30\x67\xec\xfa"
-DATA ·d+3368(SB)/8,$"\x87\xe0\xdf\x48\x52\x56\x93\x5e"
-DATA ·d+3376(SB)/8,$"\x8b\x1b\xda\xf0\xea\x4e\x4b\x77"
-DATA ·d+3384(SB)/8,$"\x27\x2b\xfc\xc7\xc5\xe7\xd3\xaa"
-DATA ·d+3392(SB)/8,$"\x86\x6a\xa4\x94\x26\x89\x91\x86"
-DATA ·d+3400(SB)/8,$"\x36\x64\x8c\x99\x29\x8a\x15\xfe"
-DATA ·d+3408(SB)/8,$"\xaf\x0b\xf2\x28\x6a\xb1\xf5\x42"
-DATA ·d+3416(SB)/8,$"\xcc\xb1\xc2\xe6\x79\x4a\x2c\xa4"
-DATA ·d+3424(SB)/8,$"\x4b\xa5\x2c\xf3\x4f\xdd\x8e\xea"
-DATA ·d+3432(SB)/8,$"\x10\x77\x7a\xd3\xad\x26\xc5\x03"
-DATA ·d+3440(SB)/8,$"\x42\x4a\x65\xad\xbf\x45\xf5\xc3"
-DATA ·d+3448(SB)/8,$"\xd8\x1e\x9f\xe6\x96\xdc\x23\xdb"
-DATA ·d+3456(SB)/8,$"\x5c\x40\x7b\x4b\x18\xe7\xe1\x1a"
-DATA ·d+3464(SB)/8,$"\x3a\x47\x49\xae\xa3\x03\xeb\x17"
-DATA ·d+3472(SB)/8,$"\x29\xba\x12\xd5\x11\xf2\xf1\x88"
-DATA ·d+3480(SB)/8,$"\x20\xd8\xa1\x05\x2b\x3a\x96\xa2"
-DATA ·d+3488(SB)/8,$"\x2f\x1e\x20\x02\xd2\xd4\x46\xce"
-DATA ·d+3496(SB)/8,$"\x9d\x24\x79\x6d\xe1\x9f\x0e\x0e"
-DATA ·d+3504(SB)/8,$"\x4e\x70\xe4\x86\x9c\x7b\x62\xf1"
-DATA ·d+3512(SB)/8,$"\x83\x28\x56\xaf\xc0\x77\x84\x54"
-DATA ·d+3520(SB)/8,$"\x0c\x39\xf9\x9e\x70\xf2\x03\x12"
-DATA ·d+3528(SB)/8,$"\xfd\x9e\xf0\x47\x8f\x82\x2c\xc9"
-DATA ·d+3536(SB)/8,$"\x98\xd0\xf9\x9c\x89\xca\x5e\xe6"
-DATA ·d+3544(SB)/8,$"\x3a\x68\x29\x5c\xf0\x0f\x85\x43"
-DATA ·d+3552(SB)/8,$"\xe5\x4d\x0b\x80\x07\x75\xd0\x86"
-DATA ·d+3560(SB)/8,$"\x2a\x33\x8a\xe6\x81\x0d\x41\x76"
-DATA ·d+3568(SB)/8,$"\x87\x9b\x0c\x47\x3c\x0e\x75\x07"
-DATA ·d+3576(SB)/8,$"\x86\x11\xd1\x10\xc3\x5b\xf9\x45"
-DATA ·d+3584(SB)/8,$"\x3d\xb3\x0c\x47\x96\xd0\x0a\xa3"
-DATA ·d+3592(SB)/8,$"\xf5\x97\xfb\xf6\xfd\x9e\x0b\xb2"
-DATA ·d+3600(SB)/8,$"\x95\xbf\x20\xbb\x15\x7c\xe7\x55"
-DATA ·d+3608(SB)/8,$"\xad\xe3\x5d\x90\x3b\x2f\x5c\xb5"
-DATA ·d+3616(SB)/8,$"\xe5\x79\xf2\x85\x1c\xff\xf9\xcf"
-DATA ·d+3624(SB)/8,$"\x7f\xde\x83\x69\xff\x0d\xa8\xad"
-DATA ·d+3632(SB)/8,$"\xd0\x3b\x2f\x36\xe1\x1d\xd7\x5d"
-DATA ·d+3640(SB)/8,$"\xd3\xdf\x75\x65\xa9\x22\xdd\x3c"
-DATA ·d+3648(SB)/8,$"\xb3\xeb\xfc\xa3\xeb\x1e\x3d\x9f"
-DATA ·d+3656(SB)/8,$"\x6f\x6b\xb6\x0f\xc3\x51\x6b\x08"
-DATA ·d+3664(SB)/8,$"\x18\xe9\x7e\x77\x4f\x7b\xee\xbe"
-DATA ·d+3672(SB)/8,$"\x0b\xb5\xc7\xdf\x04\x1c\x51\xc6"
-DATA ·d+3680(SB)/8,$"\xb5\x05\x93\x37\xc4\x7b\x4c\xf0"
-DATA ·d+3688(SB)/8,$"\x66\x2e\x12\x59\xfa\xbd\xb1\x5c"
-DATA ·d+3696(SB)/8,$"\x2b\xbb\x6e\x14\x1b\x49\x11\xef"
-DATA ·d+3704(SB)/8,$"\xe2\x74\xa4\x78\x47\x31\x76\x31"
-DATA ·d+3712(SB)/8,$"\xde\x5f\xa0\x7d\xf8\x6f\x21\xda"
-DATA ·d+3720(SB)/8,$"\x0d\x9c\xe0\x8f\x9d\xdf\xdd\xe2"
-DATA ·d+3728(SB)/8,$"\x84\xf7\x3a\xd2\x6d\xb8\xef\xe5"
-DATA ·d+3736(SB)/8,$"\x49\xf7\x2e\x63\xf4\x30\xc5\xe7"
-DATA ·d+3744(SB)/8,$"\x78\x43\x89\xe6\xaf\x82\x4b\xd1"
-DATA ·d+3752(SB)/8,$"\x5e\x04\xc0\xf5\x5d\xd8\xb6\x68"
-DATA ·d+3760(SB)/8,$"\x4d\xa3\xfa\x48\x98\xc7\x3b\xb6"
-DATA ·d+3768(SB)/8,$"\xb4\xc0\x67\xb9\x56\x93\x6e\x22"
-DATA ·d+3776(SB)/8,$"\xef\x0b\x54\x2d\xbf\xf4\x52\x87"
-DATA ·d+3784(SB)/8,$"\x53\x84\x50\x57\x79\x76\xa9\x01"
-DATA ·d+3792(SB)/8,$"\x76\x57\x15\x69\x28\x7e\x3a\x70"
-DATA ·d+3800(SB)/8,$"\x0c\xe2\x30\x29\x21\x77\xb9\xd4"
-DATA ·d+3808(SB)/8,$"\x10\xe3\xf7\x16\x11\x32\x66\x37"
-DATA ·d+3816(SB)/8,$"\xf4\x5b\x94\x5e\x6a\xec\xd8\x38"
-DATA ·d+3824(SB)/8,$"\x99\xab\x75\x69\x6b\x2f\xa1\xf9"
-DATA ·d+3832(SB)/8,$"\x95\x92\x33\x7b\x8f\x09\x21\x01"
-DATA ·d+3840(SB)/8,$"\x74\xf3\xb0\xae\xee\xd6\xcf\xc6"
-DATA ·d+3848(SB)/8,$"\x1b\x33\x07\x98\x5e\x01\x01\x4f"
-DATA ·d+3856(SB)/8,$"\x24\xa3\xe2\xdb\xf0\x4c\xff\x40"
-DATA ·d+3864(SB)/8,$"\x71\xe5\xdf\x35\xc5\xda\xf3\xe3"
-DATA ·d+3872(SB)/8,$"\x86\x03\xcb\x78\xa0\x54\xdb\xba"
-DATA ·d+3880(SB)/8,$"\x20\x34\x7d\x3c\x7d\xf1\xcb\xbb"
-DATA ·d+3888(SB)/8,$"\x37\xff\x35\x22\xc7\x51\xc1\x75"
-DATA ·d+3896(SB)/8,$"\xbc\x51\x70\x1d\x3e\xa6\xf3\x2a"
-DATA ·d+3904(SB)/8,$"\x12\x92\xdc\x7e\x66\x98\x58\x26"
-DATA ·d+3912(SB)/8,$"\x4e\x70\x4a\xb6\x61\xed\x63\xb9"
-DATA ·d+3920(SB)/8,$"\xee\xc1\x21\xc6\xea\x1f\xbb\xc5"
-DATA ·d+3928(SB)/8,$"\xa3\x21\x52\x2f\xbc\x23\xda\xa0"
-DATA ·d+3936(SB)/8,$"\x19\x13\xb5\xa9\x2a\x96\xa4\x1c"
-DATA ·d+3944(SB)/8,$"\x17\x00\x18\xb3\xe1\x92\x56\xcc"
-DATA ·d+3952(SB)/8,$"\x5a\xbb\x5c\xf5\xcb\xc3\x9b\xf7"
-DATA ·d+3960(SB)/8,$"\xcf\x86\xd4\xe1\x5f\x57\xe0\xbc"
-DATA ·d+3968(SB)/8,$"\x47\xe1\x2a\x70\xf3\xcd\x0b\x57"
-DATA ·d+3976(SB)/8,$"\xb1\xc5\xea\x79\xa2\x8e\x0b\xc7"
-DATA ·d+3984(SB)/8,$"\xd3\x7e\x5f\x74\x8a\x44\x15\x78"
-DATA ·d+3992(SB)/8,$"\x1b\x74\x3a\xe1\x3a\x75\x37\xec"
-DATA ·d+4000(SB)/8,$"\xea\x43\xf5\x12\xe8\x16\x0a\xef"
-DATA ·d+4008(SB)/8,$"\x86\xf8\xde\x6d\xd0\x98\xfd\x56"
-DATA ·d+4016(SB)/8,$"\x64\x38\xff\xed\xe1\xb2\x63\xb7"
-DATA ·d+4024(SB)/8,$"\xe3\xda\xe9\xf4\x7a\xb8\xdc\xd8"
-DATA ·d+4032(SB)/8,$"\xad\xa8\xee\x91\x7b\xf6\x31\x3b"
-DATA ·d+4040(SB)/8,$"\x50\x0f\xb4\x7b\xee\x77\x74\x7a"
-DATA ·d+4048(SB)/8,$"\x03\x92\x08\x90\x05\xe9\xa9\x42"
-DATA ·d+4056(SB)/8,$"\x67\x37\xee\xba\x5b\x47\x06\xea"
-DATA ·d+4064(SB)/8,$"\x3d\xb8\x27\x5b\x65\xb1\x25\x9f"
-DATA ·d+4072(SB)/8,$"\x8d\x9a\xcf\x10\xa5\xad\x0a\xb4"
-DATA ·d+4080(SB)/8,$"\x19\xb7\x0f\x83\x0f\x95\x62\xaa"
-DATA ·d+4088(SB)/8,$"\xd2\x32\xb4\xe9\x23\x36\x8a\x3e"
-DATA ·d+4096(SB)/8,$"\x21\xbb\x46\x88\xf6\x3c\x3b\xa0"
-DATA ·d+4104(SB)/8,$"\xb0\xc6\x24\xaa\xda\xec\x9f\xd1"
-DATA ·d+4112(SB)/8,$"\xbe\xe2\xcc\x76\xf6\x42\x0c\xb4"
-DATA ·d+4120(SB)/8,$"\x59\x96\x1a\xae\xc8\x0c\x33\xb0"
-DATA ·d+4128(SB)/8,$"\xbf\x26\xb3\x9d\x85\x28\x40\xda"
-DATA ·d+4136(SB)/8,$"\x64\xc2\x8b\xc9\x12\xb8\x0b\x27"
-DATA ·d+4144(SB)/8,$"\xf7\x2d\xc7\x7c\xad\x6c\xfa\x21"
-DATA ·d+4152(SB)/8,$"\xdd\x1d\x96\xe8\x3e\x45\x98\xfb"
-DATA ·d+4160(SB)/8,$"\xca\x6b\xa3\xe8\xf8\x6d\x6b\x26"
-DATA ·d+4168(SB)/8,$"\x98\x3f\x0f\x30\xe3\xd7\xa7\xbb"
-DATA ·d+4176(SB)/8,$"\xd5\xbd\xa8\x46\xa4\xa7\xed\xfd"
-DATA ·d+4184(SB)/8,$"\x61\x1d\x26\xdb\x03\xf2\x1e\x11"
-DATA ·d+4192(SB)/8,$"\x8f\xc9\xee\x1f\x7f\xc2\xb8\xa3"
-DATA ·d+4200(SB)/8,$"\xca\xb2\xb7\xd4\xb4\x79\x04\xdd"
-DATA ·d+4208(SB)/8,$"\x8e\x47\xca\x81\x4a\xa8\x23\xf8"
-DATA ·d+4216(SB)/8,$"\x96\x81\xe2\xc7\x7a\x0b\xb6\xf6"
-DATA ·d+4224(SB)/8,$"\x22\xdb\x1d\xd0\x75\x6b\x13\xe1"
-DATA ·d+4232(SB)/8,$"\x62\x5c\x8b\xd3\x43\x5c\xe0\x3a"
-DATA ·d+4240(SB)/8,$"\x9f\x7c\xd8\x5c\xe5\x83\x03\x9c"
-DATA ·d+4248(SB)/8,$"\xa8\x82\x24\xfb\xe9\xd8\x75\xc4"
-DATA ·d+4256(SB)/8,$"\x2b\x1b\x8a\x1b\x51\xbd\xe6\xd1"
-DATA ·d+4264(SB)/8,$"\x23\x8b\xe6\xe3\x66\x70\xd8\x89"
-DATA ·d+4272(SB)/8,$"\x30\xfd\x12\xfa\x8b\x30\x35\xf7"
-DATA ·d+4280(SB)/8,$"\x87\xf2\xae\x34\x18\x07\x7e\x13"
-DATA ·d+4288(SB)/8,$"\x29\x0c\x17\x0b\xd6\x9e\x4f\xf4"
-DATA ·d+4296(SB)/8,$"\x0a\x32\x35\x2f\xb6\x55\xa3\xe3"
-DATA ·d+4304(SB)/8,$"\x0a\xcc\xbe\xdc\xa8\x13\xcb\xa0"
-DATA ·d+4312(SB)/8,$"\x73\xe9\x46\x24\x91\x5b\xe9\x1c"
-DATA ·d+4320(SB)/8,$"\xcc\xa7\x71\xe4\xd3\x05\x19\x8c"
-DATA ·d+4328(SB)/8,$"\xce\x43\x08\x34\x90\xe0\xd5\xba"
-DATA ·d+4336(SB)/8,$"\xf4\x77\x2d\x7c\x90\x87\xb8\xef"
-DATA ·d+4344(SB)/8,$"\x11\x47\x61\x98\xd8\x1f\xdf\x8b"
-DATA ·d+4352(SB)/8,$"\xa2\x6e\xa8\xc2\x93\x4d\xe2\xd4"
-DATA ·d+4360(SB)/8,$"\x7e\x46\xe7\x17\x96\xbf\x0f\xee"
-DATA ·d+4368(SB)/8,$"\x50\x0c\x87\x54\x5b\x86\xf4\x8a"
-DATA ·d+4376(SB)/8,$"\x30\xa9\x2b\xcd\xcd\xe6\x6d\xfd"
-DATA ·d+4384(SB)/8,$"\xc7\xd9\x56\x2e\xb8\xb1\xc1\xba"
-DATA ·d+4392(SB)/8,$"\xed\x1f\xdb\x11\xbf\x0a\xfe\x39"
-DATA ·d+4400(SB)/8,$"\x5f\xad\xca\x17\xd4\xb0\xf5\xba"
-DATA ·d+4408(SB)/8,$"\x48\x93\xcb\x4b\x50\x96\xe8\xbb"
-DATA ·d+4416(SB)/8,$"\x08\xab\x15\x5e\x0a\xb4\x9d\x3a"
-DATA ·d+4424(SB)/8,$"\x74\xba\xf7\xb6\x51\xef\x6a\x45"
-DATA ·d+4432(SB)/8,$"\xca\x60\x1e\xf1\x1b\x21\x87\x98"
-DATA ·d+4440(SB)/8,$"\x07\x93\xf2\xb5\xa8\xd8\xe7\xd0"
-DATA ·d+4448(SB)/8,$"\xb2\x63\xd1\xbb\x5f\x9f\xf0\xa3"
-DATA ·d+4456(SB)/8,$"\x7e\xa6\xfa\xc9\xf1\x13\x7b\x59"
-DATA ·d+4464(SB)/8,$"\x7f\xbd\xc6\x39\x82\xd0\x43\x9b"
-DATA ·d+4472(SB)/8,$"\xab\x6b\xf4\x1e\x49\x33\x75\xc3"
-DATA ·d+4480(SB)/8,$"\xf0\xf1\xfc\x5c\xc9\x1b\x5e\x31"
-DATA ·d+4488(SB)/8,$"\x4d\x28\x99\x48\x71\xc3\x04\x47"
-DATA ·d+4496(SB)/8,$"\xdf\x31\xb5\xb4\xd0\x95\xb0\x1b"
-DATA ·d+4504(SB)/8,$"\xa6\xda\x4b\x0e\xfe\x42\x70\x38"
-DATA ·d+4512(SB)/8,$"\x4f\xb5\x2f\xd2\x2b\x52\x2b\x39"
-DATA ·d+4520(SB)/8,$"\xc3\x73\x55\x8c\xea\x7f\x3d\x7d"
-DATA ·d+4528(SB)/8,$"\x5d\x22\x33\x2d\xa9\x31\x3e\xd7"
-DATA ·d+4536(SB)/8,$"\x77\xb3\xf8\x8d\x9b\xe9\x7b\xc5"
-DATA ·d+4544(SB)/8,$"\x6a\xfe\x39\xcf\xb2\x02\x0f\x7a"
-DATA ·d+4552(SB)/8,$"\x07\x7b\x63\x06\xdd\x53\x80\x25"
-DATA ·d+4560(SB)/8,$"\xbd\x25\xf8\x8a\x55\xdd\xb0\x4d"
-DATA ·d+4568(SB)/8,$"\xbe\x6e\x38\xc5\x17\x18\x12\x96"
-DATA ·d+4576(SB)/8,$"\x5a\x54\x54\x55\xf6\x2b\x01\x38"
-DATA ·d+4584(SB)/8,$"\x5c\x75\xde\x16\x52\x81\xa2\x0a"
-DATA ·d+4592(SB)/8,$"\x93\x05\x55\x30\x5c\x8a\x12\x0f"
-DATA ·d+4600(SB)/8,$"\x8e\xb3\x39\x32\x90\x01\x36\x7f"
-DATA ·d+4608(SB)/8,$"\x17\x1d\xd6\x75\x3e\xef\x4c\x96"
-DATA ·d+4616(SB)/8,$"\x7d\x5a\x30\x0d\xf3\x7d\xb3\x83"
-DATA ·d+4624(SB)/8,$"\x29\x1c\x2e\xa4\x38\xf4\xb2\x71"
-DATA ·d+4632(SB)/8,$"\xdb\x65\x50\x1e\x96\x6e\xd8\x88"
-DATA ·d+4640(SB)/8,$"\x78\x09\x11\xf7\xd1\x29\xd3\x73"
-DATA ·d+4648(SB)/8,$"\x29\x34\xb3\xef\x0d\x47\x76\x17"
-DATA ·d+4656(SB)/8,$"\x97\xa7\x96\x83\xce\xf6\x04\x90"
-DATA ·d+4664(SB)/8,$"\x25\x19\x04\x52\xec\xd3\x00\x60"
-DATA ·d+4672(SB)/8,$"\x82\x9f\x81\xf8\x54\xbe\xb5\xb7"
-DATA ·d+4680(SB)/8,$"\xff\x1f\x8c\x49\xf6\xd3\xcb\xf3"
-DATA ·d+4688(SB)/8,$"\x0c\xcc\x6b\xaf\xf9\xe7\x97\xcf"
-DATA ·d+4696(SB)/8,$"\x5e\xd8\x1b\x0e\x89\x7b\x18\xf8"
-DATA ·d+4704(SB)/8,$"\xb3\x3d\xf3\xb5\x4f\x10\x0c\x35"
-DATA ·d+4712(SB)/8,$"\x0b\x6d\x87\xbf\x93\xe6\x59\xd3"
-DATA ·d+4720(SB)/8,$"\xc8\x25\x7e\x02\xc3\xdb\x63\x67"
-DATA ·d+4728(SB)/8,$"\x1d\x21\x4b\x76\x5f\x4f\x01\x0d"
-DATA ·d+4736(SB)/8,$"\x76\x33\x07\x52\xbf\x9e\xbe\x29"
-DATA ·d+4744(SB)/8,$"\xed\xe5\x55\x2b\x07\x97\x42\x23"
-DATA ·d+4752(SB)/8,$"\xf6\x77\xd2\xbc\x92\x0b\x51\xe5"
-DATA ·d+4760(SB)/8,$"\x4b\x9c\xc7\x26\x5a\xc5\x3e\xf9"
-DATA ·d+4768(SB)/8,$"\x8b\xca\x31\x2e\x7c\x66\xe6\xd0"
-DATA ·d+4776(SB)/8,$"\xf9\x37\x65\x83\xd4\x2d\xe1\xec"
-DATA ·d+4784(SB)/8,$"\x28\x2b\xbc\x0f\xb1\xf8\x10\xdd"
-DATA ·d+4792(SB)/8,$"\xfb\xce\x63\x32\x5f\xe8\x37\x0b"
-DATA ·d+4800(SB)/8,$"\xb0\xe5\xd1\xe4\x7f\xf9\x8f\xd4"
-DATA ·d+4808(SB)/8,$"\x9d\x5e\x77\x2e\x67\x38\x04\x8e"
-DATA ·d+4816(SB)/8,$"\xba\x7f\x21\x15\x0d\x74\xe3\x5a"
-DATA ·d+4824(SB)/8,$"\xef\xd3\xf2\xc3\xc1\x44\x94\x53"
-DATA ·d+4832(SB)/8,$"\x33\x6b\xb2\xc2\x92\xdf\xb2\xfb"
-DATA ·d+4840(SB)/8,$"\x07\x70\x3b\xe6\xfc\x28\x7b\x20"
-DATA ·d+4848(SB)/8,$"\xb2\xc9\xb5\x17\x6d\xc0\xee\x1f"
-DATA ·d+4856(SB)/8,$"\x8c\x75\x51\xde\x65\x19\x3a\x87"
-DATA ·d+4864(SB)/8,$"\xfb\xbc\x26\x86\x5e\xf9\x05\xb1"
-DATA ·d+4872(SB)/8,$"\xaa\x52\xfe\xc4\x4c\x9e\xbd\xae"
-DATA ·d+4880(SB)/8,$"\x0f\xdf\x49\xc1\x0e\xdf\x52\x33"
-DATA ·d+4888(SB)/8,$"\x99\x66\xc5\xf7\x38\xee\x41\xb8"
-DATA ·d+4896(SB)/8,$"\x3f\x33\xbc\x46\xd9\x6f\x47\xd9"
-DATA ·d+4904(SB)/8,$"\x08\x46\xe2\x35\xb6\x81\xfe\x65"
-DATA ·d+4912(SB)/8,$"\xe8\xb7\xc5\x0e\x40\x3a\x86\x86"
-DATA ·d+4920(SB)/8,$"\x8b\xef\x70\xe5\xc2\x65\x6a\x43"
-DATA ·d+4928(SB)/8,$"\xaf\x82\xf7\x77\x5f\x36\x2a\x7f"
-DATA ·d+4936(SB)/8,$"\x15\x9f\x16\xd2\xb0\x1c\xe0\x3b"
-DATA ·d+4944(SB)/8,$"\x0e\xfe\xe0\x00\xb9\x1b\xfb\xcb"
-DATA ·d+4952(SB)/8,$"\xbc\xf0\xc3\xe2\xdf\xba\x07\xde"
-DATA ·d+4960(SB)/8,$"\x49\xf3\x16\x1f\x6a\x38\xf5\x6f"
-DATA ·d+4968(SB)/8,$"\x25\xe4\x4b\x26\xc0\xc5\x0c\x1f"
-DATA ·d+4976(SB)/8,$"\x7e\x0f\x4b\xc7\x83\x1f\x9e\x71"
-DATA ·d+4984(SB)/8,$"\x31\x61\x20\x21\x3b\xba\x2b\x23"
-DATA ·d+4992(SB)/8,$"\xd3\x56\x30\xd1\x67\xbd\xa7\x4a"
-DATA ·d+5000(SB)/8,$"\xb3\x1c\xff\x3c\x7d\xf5\xfc\xf1"
-DATA ·d+5008(SB)/8,$"\xe3\xef\xfe\x34\xb2\x70\x1b\x33"
-DATA ·d+5016(SB)/8,$"\x7a\x60\x74\xf9\x23\xab\xa5\x62"
-DATA ·d+5024(SB)/8,$"\x39\x7a\xbd\xe2\x0f\x4f\x69\xff"
-DATA ·d+5032(SB)/8,$"\xa5\x0b\xdc\x35\x15\xab\x1b\x6a"
-DATA ·d+5040(SB)/8,$"\x58\xb8\x1a\x1d\x5f\x4c\x71\x45"
-DATA ·d+5048(SB)/8,$"\x35\x31\xd1\x7e\xf7\xb4\x92\xb9"
-DATA ·d+5056(SB)/8,$"\xc8\x9e\x4d\x26\x6c\x6e\x0e\x5f"
-DATA ·d+5064(SB)/8,$"\x8a\x89\xac\xb8\xb8\xca\xda\xdb"
-DATA ·d+5072(SB)/8,$"\x4e\x3e\x7a\x64\x62\x12\x85\x8f"
-DATA ·d+5080(SB)/8,$"\x62\xa2\xdb\x27\x06\x5e\x5b\x9e"
-DATA ·d+5088(SB)/8,$"\x4b\x61\x28\x17\x3a\x67\x62\x32"
-DATA ·d+5096(SB)/8,$"\x22\x19\x7e\x84\xa9\x88\xee\xfd"
-DATA ·d+5104(SB)/8,$"\x7b\xf6\xfc\x0d\xfd\xa5\x23\x9f"
-DATA ·d+5112(SB)/8,$"\x17\xe5\x19\x2c\xcd\x73\x6b\xd4"
-DATA ·d+5120(SB)/8,$"\x5b\x2e\x02\x92\xf8\xe6\x7e\xd2"
-DATA ·d+5128(SB)/8,$"\xce\xd2\x3e\x94\xc7\xc6\x4b\xc5"
-DATA ·d+5136(SB)/8,$"\xe8\x75\xf7\x66\xbf\xdf\x62\x1d"
-DATA ·d+5144(SB)/8,$"\xb2\xdb\x68\xbe\x61\xe2\xca\x4c"
-DATA ·d+5152(SB)/8,$"\xb3\x51\x50\xd9\x57\x52\xcd\xa8"
-DATA ·d+5160(SB)/8,$"\x79\x2d\x8c\x4d\xf4\x72\xfc\x14"
-DATA ·d+5168(SB)/8,$"\x05\x4a\xd5\xde\xc0\x19\x91\xc7"
-DATA ·d+5176(SB)/8,$"\xc7\x45\x31\xb0\xab\xbf\x9a\x82"
-DATA ·d+5184(SB)/8,$"\xc5\x8e\x5f\x55\x70\xc8\x3b\x1b"
-DATA ·d+5192(SB)/8,$"\x7e\x1b\xde\xf3\xdb\x39\xcb\x46"
-DATA ·d+5200(SB)/8,$"\x6e\xc5\x67\x1c\x4b\xb0\x1b\x83"
-DATA ·d+5208(SB)/8,$"\xf1\x03\x35\x2d\xe9\xff\xc4\xdd"
-DATA ·d+5216(SB)/8,$"\x18\xb6\x5c\x31\x04\xf2\x86\x6a"
-DATA ·d+5224(SB)/8,$"\x13\xb6\x0a\xc2\xd2\xd9\xdc\x31"
-DATA ·d+5232(SB)/8,$"\xdd\xd9\x06\x0e\x3a\xd6\x6d\x6b"
-DATA ·d+5240(SB)/8,$"\x08\x8b\x41\xf7\xe7\xfd\xdc\x1d"
-DATA ·d+5248(SB)/8,$"\x74\x7a\x53\x69\xee\xf5\x0c\x3c"
-DATA ·d+5256(SB)/8,$"\x5a\xad\x34\x09\x6f\x0f\x36\x5e"
-DATA ·d+5264(SB)/8,$"\xeb\x27\xc9\xe0\xab\xfe\x2d\x97"
-DATA ·d+5272(SB)/8,$"\xaa\xc2\x54\x63\xfc\x77\x9a\x4c"
-DATA ·d+5280(SB)/8,$"\xcf\x80\xaf\x3b\x6f\x70\xb7\x25"
-DATA ·d+5288(SB)/8,$"\x25\xfe\xab\x62\xee\x32\x44\xc3"
-DATA ·d+5296(SB)/8,$"\xb5\x61\xe2\x59\x55\xa9\x50\xd4"
-DATA ·d+5304(SB)/8,$"\x9a\x30\x65\x3a\x9f\x84\x4a\x93"
-DATA ·d+5312(SB)/8,$"\x6b\x76\x4b\x7a\x4d\xfe\xd9\x59"
-DATA ·d+5320(SB)/8,$"\xd4\x04\xb8\xdc\xa8\x4b\x29\x9b"
-DATA ·d+5328(SB)/8,$"\x34\x99\xb2\x66\x1e\x37\x14\x1b"
-DATA ·d+5336(SB)/8,$"\x41\x7c\xdd\xd0\xab\xf2\x47\x29"
-DATA ·d+5344(SB)/8,$"\x9b\xbf\x53\x95\x1f\xc0\xf8\x11"
-DATA ·d+5352(SB)/8,$"\xc9\xe0\x9f\xcc\xbd\x79\x1b\x41"
-DATA ·d+5360(SB)/8,$"\x74\xc7\x85\xd1\x04\x5b\x8b\x3e"
-DATA ·d+5368(SB)/8,$"\x08\xd0\x1c\x91\x0c\xfe\x89\x40"
-DATA ·d+5376(SB)/8,$"\x90\x13\x17\xd2\x69\x8c\x24\xd9"
-DATA ·d+5384(SB)/8,$"\x67\x6e\x02\xb4\xfd\x40\x10\xc2"
-DATA ·d+5392(SB)/8,$"\xbb\x69\x8c\x48\xe6\xfe\x02\x1b"
-DATA ·d+5400(SB)/8,$"\x91\xb5\x3f\x5b\x2c\xee\x15\x9d"
-DATA ·d+5408(SB)/8,$"\x7b\xd2\xf6\x7b\x48\x59\x7e\xdf"
-DATA ·d+5416(SB)/8,$"\x89\xbf\x95\xaf\x63\x8b\x09\xc0"
-DATA ·d+5424(SB)/8,$"\x7e\xf2\xb7\xe3\xbf\x1d\xc3\x1f"
-DATA ·d+5432(SB)/8,$"\x5a\x4e\xae\x01\x1d\xad\x2a\x58"
-DATA ·d+5440(SB)/8,$"\xdf\xdf\x81\x8c\x1b\x36\x80\x0d"
-DATA ·d+5448(SB)/8,$"\x96\x66\x44\x32\xd3\xe8\x43\xf8"
-DATA ·d+5456(SB)/8,$"\xd3\xf3\x7a\xfe\xe6\x8c\xc0\x6f"
-DATA ·d+5464(SB)/8,$"\xfb\xd8\x90\x91\xdf\x21\x39\xfe"
-DATA ·d+5472(SB)/8,$"\xdd\x3d\x3b\x1d\xc2\x73\xcd\x6e"
-DATA ·d+5480(SB)/8,$"\x1d\x9a\x6b\x76\x1b\x63\x81\x85"
-DATA ·d+5488(SB)/8,$"\xee\x43\xfb\xb2\xd6\x8c\x72\x61"
-DATA ·d+5496(SB)/8,$"\x57\x0d\x74\xc7\x34\xda\xad\x72"
-DATA ·d+5504(SB)/8,$"\xef\x02\x38\xd2\xb2\xbe\xcd\xd6"
-DATA ·d+5512(SB)/8,$"\x50\x50\x0b\xf0\xd4\x06\x7a\x7e"
-DATA ·d+5520(SB)/8,$"\xd5\xf4\x2a\xfe\xa8\x85\xaf\xe1"
-DATA ·d+5528(SB)/8,$"\xe0\x92\xc1\xa8\xf6\xd9\x1c\x70"
-DATA ·d+5536(SB)/8,$"\xf5\x87\x1e\xcd\x6d\x7f\x86\x15"
-DATA ·d+5544(SB)/8,$"\x1d\x89\x24\x58\x51\xa8\xe4\xc2"
-DATA ·d+5552(SB)/8,$"\xbd\xfc\x71\x5f\x8f\x0a\x4f\xae"
-DATA ·d+5560(SB)/8,$"\x86\xbb\xb3\xff\x16\x59\xb1\xf9"
-DATA ·d+5568(SB)/8,$"\x30\x62\x73\x5e\x5e\x8f\xda\x58"
-DATA ·d+5576(SB)/8,$"\x20\x3c\xb1\x72\x8f\x24\x83\x0a"
-DATA ·d+5584(SB)/8,$"\x1e\xff\xe5\xc9\xb1\x7f\xea\xb9"
-DATA ·d+5592(SB)/8,$"\xf9\xb6\xca\xf2\xc1\x94\xea\xf2"
-DATA ·d+5600(SB)/8,$"\x61\x67\x1c\xbd\xc8\x74\x0a\x7b"
-DATA ·d+5608(SB)/8,$"\x42\xb2\x62\x3b\x18\xfc\x7e\x09"
-DATA ·d+5616(SB)/8,$"\x90\xed\x9b\xa3\x21\xe4\x7e\x92"
-DATA ·d+5624(SB)/8,$"\x52\x97\x2f\x3f\x73\x93\x3f\x2e"
-DATA ·d+5632(SB)/8,$"\x3a\xaf\x3f\xc2\x9d\x25\xb0\x18"
-DATA ·d+5640(SB)/8,$"\x76\x82\x07\x07\xa8\x43\xed\x6c"
-DATA ·d+5648(SB)/8,$"\x41\x4f\xc6\x78\xeb\x22\xfe\xac"
-DATA ·d+5656(SB)/8,$"\x43\x04\xf1\xe5\x4b\x0f\x62\x0b"
-DATA ·d+5664(SB)/8,$"\x2f\x97\xd2\x4c\x2d\x1c\xec\x37"
-DATA ·d+5672(SB)/8,$"\x00\x99\x2d\xb4\xc1\x5c\x2f\xbc"
-DATA ·d+5680(SB)/8,$"\x52\x96\x0a\x33\xb5\x33\xc7\x77"
-DATA ·d+5688(SB)/8,$"\xcc\xf6\x3a\xb5\x21\xb1\xcd\xe2"
-DATA ·d+5696(SB)/8,$"\x5e\x81\x5a\x65\x10\x81\x6e\xc9"
-DATA ·d+5704(SB)/8,$"\x74\x8f\xb2\xc2\x6a\x2f\xf0\xbf"
-DATA ·d+5712(SB)/8,$"\x0a\x2f\x3b\x10\xc5\x1b\xbb\xa7"
-DATA ·d+5720(SB)/8,$"\x45\x85\x19\xf3\xf9\x9b\xb3\x3c"
-DATA ·d+5728(SB)/8,$"\xde\xe5\x76\x8f\xe2\x0e\xb3\x8f"
-DATA ·d+5736(SB)/8,$"\x6f\xa2\x22\xe6\x56\x24\x1d\x0c"
-DATA ·d+5744(SB)/8,$"\x0e\x6c\xe8\x54\xfb\x4e\xab\xb9"
-DATA ·d+5752(SB)/8,$"\x7b\x31\xbb\x42\xc1\xca\x86\x73"
-DATA ·d+5760(SB)/8,$"\x21\xff\x13\x00\x00\xff\xff\x44"
-DATA ·d+5768(SB)/8,$"\x6d\xa7\x59\x14\x55\x00\x00\x00"
-DATA ·d+5776(SB)/8,$"\x1f\x8b\x08\x00\x00\x00\x00\x00"
-DATA ·d+5784(SB)/8,$"\x02\xff\xd2\xd7\x57\x70\xce\x4f"
-DATA ·d+5792(SB)/8,$"\x49\x55\x48\x4f\xcd\x4b\x2d\x4a"
-DATA ·d+5800(SB)/8,$"\x2c\x49\x4d\x51\x48\xaa\x54\x48"
-DATA ·d+5808(SB)/8,$"\xcf\xd7\xcd\xcc\x4d\x4a\x4d\xd1"
-DATA ·d+5816(SB)/8,$"\x53\x70\xf1\x57\xf0\xf3\x0f\x51"
-DATA ·d+5824(SB)/8,$"\x70\x75\xf1\x0c\xd1\xe3\xe2\x52"
-DATA ·d+5832(SB)/8,$"\xce\xcc\x4b\xce\x29\x4d\x49\x55"
-DATA ·d+5840(SB)/8,$"\x50\x2a\x49\xad\x28\x49\xcb\x49"
-DATA ·d+5848(SB)/8,$"\x4c\xd7\xcb\x50\xe2\xe2\x0a\x71"
-DATA ·d+5856(SB)/8,$"\x8d\x08\x51\x38\xb4\x3d\x29\x27"
-DATA ·d+5864(SB)/8,$"\x3f\x29\x3e\xa9\xb2\x24\xb5\x58"
-DATA ·d+5872(SB)/8,$"\x23\xd8\x49\x53\xc7\xcf\x3f\x38"
-DATA ·d+5880(SB)/8,$"\xc0\xc7\x33\x44\x47\xc5\x40\xd7"
-DATA ·d+5888(SB)/8,$"\x84\x8b\xd3\xc7\xd5\xd1\x87\xf3"
-DATA ·d+5896(SB)/8,$"\xd0\xf6\x14\xb0\x94\x82\x63\x04"
-DATA ·d+5904(SB)/8,$"\x17\xa7\xaf\x7f\x98\x0f\xa7\x63"
-DATA ·d+5912(SB)/8,$"\x84\x8e\x42\x51\x6a\x89\xb6\x89"
-DATA ·d+5920(SB)/8,$"\x86\x5b\x80\x26\x54\x2c\x27\x35"
-DATA ·d+5928(SB)/8,$"\x4f\xdb\x00\xc4\xc7\xa2\xce\x02"
-DATA ·d+5936(SB)/8,$"\x49\x1d\x4c\xcc\xd0\x08\x22\x18"
-DATA ·d+5944(SB)/8,$"\xe4\x1a\x82\xea\x96\xe2\x92\xa2"
-DATA ·d+5952(SB)/8,$"\xcc\xbc\x74\x9a\x3b\x06\x64\x2f"
-DATA ·d+5960(SB)/8,$"\x20\x00\x00\xff\xff\x07\xe8\x3a"
-DATA ·d+5968(SB)/8,$"\xce\x47\x01\x00\x00\x00\x00\x00"
-DATA ·d+5976(SB)/8,$"\x1f\x8b\x08\x00\x00\x00\x00\x00"
-DATA ·d+5984(SB)/8,$"\x02\xff\xd2\xd7\x57\x70\xce\x4f"
-DATA ·d+5992(SB)/8,$"\x49\x55\x48\x4f\xcd\x4b\x2d\x4a"
-DATA ·d+6000(SB)/8,$"\x2c\x49\x4d\x51\x48\xaa\x54\x48"
-DATA ·d+6008(SB)/8,$"\xcf\xd7\xcd\xcc\x4d\x4a\x4d\xd1"
-DATA ·d+6016(SB)/8,$"\x53\x70\xf1\x57\xf0\xf3\x0f\x51"
-DATA ·d+6024(SB)/8,$"\x70\x75\xf1\x0c\xd1\xe3\xe2\x52"
-DATA ·d+6032(SB)/8,$"\xce\xcc\x4b\xce\x29\x4d\x49\x55"
-DATA ·d+6040(SB)/8,$"\x50\x2a\x49\xad\x28\x49\xcb\x49"
-DATA ·d+6048(SB)/8,$"\x4c\xd7\xcb\x50\xe2\xe2\x0a\x71"
-DATA ·d+6056(SB)/8,$"\x8d\x08\x51\x38\xb4\x3d\x29\x27"
-DATA ·d+6064(SB)/8,$"\x3f\x29\x3e\xa9\xb2\x24\xb5\x58"
-DATA ·d+6072(SB)/8,$"\x23\xd8\x49\x53\xc7\xcf\x3f\x38"
-DATA ·d+6080(SB)/8,$"\xc0\xc7\x33\x44\x47\xc5\x40\xd7"
-DATA ·d+6088(SB)/8,$"\x84\x8b\xd3\xc7\xd5\x31\x90\xf3"
-DATA ·d+6096(SB)/8,$"\xd0\xf6\x14\xb0\x94\x82\x63\x04"
-DATA ·d+6104(SB)/8,$"\x17\xa7\xaf\x7f\x58\x20\xa7\x63"
-DATA ·d+6112(SB)/8,$"\x84\x8e\x42\x51\x6a\x89\xb6\x85"
-DATA ·d+6120(SB)/8,$"\x86\x5b\x80\x26\x58\xcc\x87\x33"
-DATA ·d+6128(SB)/8,$"\x27\x35\x4f\xdb\x00\xc4\x87\xab"
-DATA ·d+6136(SB)/8,$"\xf3\x09\x0c\x8e\x00\x2b\xc5\xd0"
-DATA ·d+6144(SB)/8,$"\x67\x68\x06\xd7\x88\x10\x34\x32"
-DATA ·d+6152(SB)/8,$"\x81\x08\x06\xb9\x86\xa0\x3a\xae"
-DATA ·d+6160(SB)/8,$"\xb8\xa4\x28\x33\x2f\x9d\xfe\xae"
-DATA ·d+6168(SB)/8,$"\x03\x39\x04\x10\x00\x00\xff\xff"
-DATA ·d+6176(SB)/8,$"\xc8\x87\xf2\xe8\x69\x01\x00\x00"
-DATA ·d+6184(SB)/8,$"\x1f\x8b\x08\x00\x00\x00\x00\x00"
-DATA ·d+6192(SB)/8,$"\x02\xff\xd2\xd7\x57\x70\xce\x4f"
-DATA ·d+6200(SB)/8,$"\x49\x55\x48\x4f\xcd\x4b\x2d\x4a"
-DATA ·d+6208(SB)/8,$"\x2c\x49\x4d\x51\x48\xaa\x54\x48"
-DATA ·d+6216(SB)/8,$"\xcf\xd7\xcd\xcc\x4d\x4a\x4d\xd1"
-DATA ·d+6224(SB)/8,$"\x53\x70\xf1\x57\xf0\xf3\x0f\x51"
-DATA ·d+6232(SB)/8,$"\x70\x75\xf1\x0c\xd1\xe3\xe2\x52"
-DATA ·d+6240(SB)/8,$"\xce\xcc\x4b\xce\x29\x4d\x49\x55"
-DATA ·d+6248(SB)/8,$"\x50\x2a\x49\xad\x28\x49\xcb\x49"
-DATA ·d+6256(SB)/8,$"\x4c\xd7\xcb\x50\xe2\xe2\x0a\x71"
-DATA ·d+6264(SB)/8,$"\x8d\x08\x51\x38\xb4\x3d\x29\x27"
-DATA ·d+6272(SB)/8,$"\x3f\x29\x3e\xa9\xb2\x24\xb5\x58"
-DATA ·d+6280(SB)/8,$"\x23\xd8\x49\x53\xc7\xcf\x3f\x38"
-DATA ·d+6288(SB)/8,$"\xc0\xc7\x33\x44\x47\xc5\x40\xd7"
-DATA ·d+6296(SB)/8,$"\x84\x8b\xd3\xd7\x3f\x2c\x9c\x53"
-DATA ·d+6304(SB)/8,$"\xe5\xd0\xf6\x14\xb0\x9c\x42\x90"
-DATA ·d+6312(SB)/8,$"\x01\x54\x28\xc8\x40\x47\xa1\x28"
-DATA ·d+6320(SB)/8,$"\xb5\x44\xdb\x44\xc3\x2d\x40\x13"
-DATA ·d+6328(SB)/8,$"\x2a\x96\x93\x9a\xa7\x6d\x00\xe2"
-DATA ·d+6336(SB)/8,$"\x63\x51\x67\x81\xa4\x0e\x26\x66"
-DATA ·d+6344(SB)/8,$"\x68\x04\x11\x0c\x72\x0d\x41\x75"
-DATA ·d+6352(SB)/8,$"\x4c\x71\x49\x51\x66\x5e\x3a\xed"
-DATA ·d+6360(SB)/8,$"\x5d\x03\xb2\x18\x10\x00\x00\xff"
-DATA ·d+6368(SB)/8,$"\xff\x3d\xce\x05\xf6\x49\x01\x00"
-DATA ·d+6376(SB)/8,$"\x00\x00\x00\x00\x00\x00\x00\x00"
-DATA ·d+6384(SB)/8,$"\x1f\x8b\x08\x00\x00\x00\x00\x00"
-DATA ·d+6392(SB)/8,$"\x02\xff\xd2\xd7\x57\x70\xce\x4f"
-DATA ·d+6400(SB)/8,$"\x49\x55\x48\x4f\xcd\x4b\x2d\x4a"
-DATA ·d+6408(SB)/8,$"\x2c\x49\x4d\x51\x48\xaa\x54\x48"
-DATA ·d+6416(SB)/8,$"\xcf\xd7\xcd\xcc\x4d\x4a\x4d\xd1"
-DATA ·d+6424(SB)/8,$"\x53\x70\xf1\x57\xf0\xf3\x0f\x51"
-DATA ·d+6432(SB)/8,$"\x70\x75\xf1\x0c\xd1\xe3\xe2\x52"
-DATA ·d+6440(SB)/8,$"\xce\xcc\x4b\xce\x29\x4d\x49\x55"
-DATA ·d+6448(SB)/8,$"\x50\x2a\x49\xad\x28\x49\xcb\x49"
-DATA ·d+6456(SB)/8,$"\x4c\xd7\xcb\x50\xe2\xe2\x0a\x71"
-DATA ·d+6464(SB)/8,$"\x8d\x08\x51\x38\xb4\x3d\x29\x27"
-DATA ·d+6472(SB)/8,$"\x3f\x29\x3e\xa9\xb2\x24\xb5\x58"
-DATA ·d+6480(SB)/8,$"\x23\xd8\x49\x53\xc7\xcf\x3f\x38"
-DATA ·d+6488(SB)/8,$"\xc0\xc7\x33\x44\x47\xc5\x40\xd7"
-DATA ·d+6496(SB)/8,$"\x82\x8b\xd3\xd7\x3f\xcc\x85\x53"
-DATA ·d+6504(SB)/8,$"\xe5\xd0\xf6\x14\xb0\x9c\x42\x90"
-DATA ·d+6512(SB)/8,$"\x01\x54\x28\xc8\x40\x47\xa1\x28"
-DATA ·d+6520(SB)/8,$"\xb5\x44\xdb\x42\xc3\x2d\x40\x13"
-DATA ·d+6528(SB)/8,$"\x2c\x16\xce\x99\x93\x9a\xa7\x6d"
-DATA ·d+6536(SB)/8,$"\x00\xe2\x63\x51\x67\x68\x06\x57"
-DATA ·d+6544(SB)/8,$"\x88\x10\x34\x32\x81\x08\x06\xb9"
-DATA ·d+6552(SB)/8,$"\x86\xa0\xba\xa6\xb8\xa4\x28\x33"
-DATA ·d+6560(SB)/8,$"\x2f\x9d\x22\xe7\xb8\x10\xe5\x1c"
-DATA ·d+6568(SB)/8,$"\x90\xcd\x80\x00\x00\x00\xff\xff"
-DATA ·d+6576(SB)/8,$"\x20\x4d\x97\x21\x4b\x01\x00\x00"
-DATA ·d+6584(SB)/8,$"\x1f\x8b\x08\x00\x00\x00\x00\x00"
-DATA ·d+6592(SB)/8,$"\x02\xff\xd2\xd7\x57\x70\xce\x4f"
-DATA ·d+6600(SB)/8,$"\x49\x55\x48\x4f\xcd\x4b\x2d\x4a"
-DATA ·d+6608(SB)/8,$"\x2c\x49\x4d\x51\x48\xaa\x54\x48"
-DATA ·d+6616(SB)/8,$"\xcf\xd7\xcd\xcc\x4d\x4a\x4d\xd1"
-DATA ·d+6624(SB)/8,$"\x53\x70\xf1\x57\xf0\xf3\x0f\x51"
-DATA ·d+6632(SB)/8,$"\x70\x75\xf1\x0c\xd1\xe3\xe2\xd2"
-DATA ·d+6640(SB)/8,$"\xd7\x57\xd0\x4e\x2a\xcd\xcc\x49"
-DATA ·d+6648(SB)/8,$"\x51\xc8\xcd\x2c\x28\x36\x33\x81"
-DATA ·d+6656(SB)/8,$"\x52\x39\xa9\x5c\x5c\xca\x99\x79"
-DATA ·d+6664(SB)/8,$"\xc9\x39\xa5\x29\xa9\x0a\x4a\x25"
-DATA ·d+6672(SB)/8,$"\xa9\x15\x25\x69\x39\x89\xe9\x7a"
-DATA ·d+6680(SB)/8,$"\x19\x4a\x5c\x5c\x21\xae\x11\x21"
-DATA ·d+6688(SB)/8,$"\x0a\x87\xb6\x27\xe5\xe4\x27\xc5"
-DATA ·d+6696(SB)/8,$"\x27\x55\x96\xa4\x16\x6b\x04\x3b"
-DATA ·d+6704(SB)/8,$"\x69\xea\xf8\xf9\x07\x07\xf8\x78"
-DATA ·d+6712(SB)/8,$"\x86\xe8\xa8\x18\xe8\x5a\x70\x71"
-DATA ·d+6720(SB)/8,$"\xfa\xfa\x87\x85\x71\xaa\x1c\xda"
-DATA ·d+6728(SB)/8,$"\x9e\x02\x96\x53\x08\x32\x84\x0a"
-DATA ·d+6736(SB)/8,$"\x05\x19\xea\x28\x14\xa5\x96\x68"
-DATA ·d+6744(SB)/8,$"\x5b\x68\xb8\x05\x68\x42\xc5\x72"
-DATA ·d+6752(SB)/8,$"\x52\xf3\xb4\x0d\x40\x7c\x2c\xea"
-DATA ·d+6760(SB)/8,$"\x0c\xcd\x90\x14\xc2\x04\x8d\x4c"
-DATA ·d+6768(SB)/8,$"\x20\x82\x5e\xbe\x01\x9c\x1a\x41"
-DATA ·d+6776(SB)/8,$"\xc6\x86\x9a\xa8\x6e\x2a\x2e\x29"
-DATA ·d+6784(SB)/8,$"\xca\xcc\x4b\xa7\x83\xa3\x10\xf6"
-DATA ·d+6792(SB)/8,$"\x03\x02\x00\x00\xff\xff\x18\xc2"
-DATA ·d+6800(SB)/8,$"\x79\xd5\x72\x01\x00\x00\x00\x00"
-DATA ·d+6808(SB)/8,$"\x1f\x8b\x08\x00\x00\x00\x00\x00"
-DATA ·d+6816(SB)/8,$"\x02\xff\xd2\xd7\x57\x70\xce\x4f"
-DATA ·d+6824(SB)/8,$"\x49\x55\x48\x4f\xcd\x4b\x2d\x4a"
-DATA ·d+6832(SB)/8,$"\x2c\x49\x4d\x51\x48\xaa\x54\x48"
-DATA ·d+6840(SB)/8,$"\xcf\xd7\xcd\xcc\x4d\x4a\x4d\xd1"
-DATA ·d+6848(SB)/8,$"\x53\x70\xf1\x57\xf0\xf3\x0f\x51"
-DATA ·d+6856(SB)/8,$"\x70\x75\xf1\x0c\xd1\xe3\xe2\xd2"
-DATA ·d+6864(SB)/8,$"\xd7\x57\xd0\x4e\x2a\xcd\xcc\x49"
-DATA ·d+6872(SB)/8,$"\x51\xc8\xcd\x2c\x28\x06\x13\x39"
-DATA ·d+6880(SB)/8,$"\xa9\x5c\x5c\xca\x99\x79\xc9\x39"
-DATA ·d+6888(SB)/8,$"\xa5\x29\xa9\x0a\x4a\x25\xa9\x15"
-DATA ·d+6896(SB)/8,$"\x25\x69\x39\x89\xe9\x7a\x19\x4a"
-DATA ·d+6904(SB)/8,$"\x5c\x5c\x21\xae\x11\x21\x0a\x87"
-DATA ·d+6912(SB)/8,$"\xb6\x27\xe5\xe4\x27\xc5\x27\x55"
-DATA ·d+6920(SB)/8,$"\x96\xa4\x16\x6b\x04\x3b\x69\xea"
-DATA ·d+6928(SB)/8,$"\xf8\xf9\x07\x07\xf8\x78\x86\xe8"
-DATA ·d+6936(SB)/8,$"\xa8\x18\xe8\x9a\x70\x71\xfa\xfa"
-DATA ·d+6944(SB)/8,$"\x87\x85\x73\xaa\x1c\xda\x9e\x02"
-DATA ·d+6952(SB)/8,$"\x96\x53\x08\x32\x84\x0a\x05\x19"
-DATA ·d+6960(SB)/8,$"\xea\x28\x14\xa5\x96\x68\x9b\x68"
-DATA ·d+6968(SB)/8,$"\xb8\x05\x68\x42\xc5\x72\x52\xf3"
-DATA ·d+6976(SB)/8,$"\xb4\x0d\x40\x7c\x2c\xea\x2c\x90"
-DATA ·d+6984(SB)/8,$"\xd4\xc1\xc4\x0c\x8d\x20\x82\x5e"
-DATA ·d+6992(SB)/8,$"\xbe\x01\x9c\x1a\x41\xc6\x86\x9a"
-DATA ·d+7000(SB)/8,$"\xa8\x4e\x2a\x2e\x29\xca\xcc\x4b"
-DATA ·d+7008(SB)/8,$"\xa7\xbd\x9b\x10\xd6\x03\x02\x00"
-DATA ·d+7016(SB)/8,$"\x00\xff\xff\x2e\xcb\x5b\x24\x6c"
-DATA ·d+7024(SB)/8,$"\x01\x00\x00\x00\x00\x00\x00\x00"
-DATA ·d+7032(SB)/8,$"\x1f\x8b\x08\x00\x00\x00\x00\x00"
-DATA ·d+7040(SB)/8,$"\x02\xff\xd2\xd7\x57\x70\xce\x4f"
-DATA ·d+7048(SB)/8,$"\x49\x55\x48\x4f\xcd\x4b\x2d\x4a"
-DATA ·d+7056(SB)/8,$"\x2c\x49\x4d\x51\x48\xaa\x54\x48"
-DATA ·d+7064(SB)/8,$"\xcf\xd7\xcd\xcc\x4d\x4a\x4d\xd1"
-DATA ·d+7072(SB)/8,$"\x53\x70\xf1\x57\xf0\xf3\x0f\x51"
-DATA ·d+7080(SB)/8,$"\x70\x75\xf1\x0c\xd1\xe3\xe2\xd2"
-DATA ·d+7088(SB)/8,$"\xd7\x57\xd0\x4e\x2a\xcd\xcc\x49"
-DATA ·d+7096(SB)/8,$"\x51\x28\x28\x48\x36\x33\x81\x90"
-DATA ·d+7104(SB)/8,$"\x39\xa9\x5c\x5c\xca\x99\x79\xc9"
-DATA ·d+7112(SB)/8,$"\x39\xa5\x29\xa9\x0a\x4a\x25\xa9"
-DATA ·d+7120(SB)/8,$"\x15\x25\x69\x39\x89\xe9\x7a\x19"
-DATA ·d+7128(SB)/8,$"\x4a\x5c\x5c\x21\xae\x11\x21\x0a"
-DATA ·d+7136(SB)/8,$"\x87\xb6\x27\xe5\xe4\x27\xc5\x27"
-DATA ·d+7144(SB)/8,$"\x55\x96\xa4\x16\x6b\x04\x3b\x69"
-DATA ·d+7152(SB)/8,$"\xea\xf8\xf9\x07\x07\xf8\x78\x86"
-DATA ·d+7160(SB)/8,$"\xe8\xa8\x18\xe8\x5a\x70\x71\xfa"
-DATA ·d+7168(SB)/8,$"\xfa\x87\xb9\x70\xaa\x1c\xda\x9e"
-DATA ·d+7176(SB)/8,$"\x02\x96\x53\x08\x32\x86\x0a\x05"
-DATA ·d+7184(SB)/8,$"\x19\xeb\x28\x14\xa5\x96\x68\x5b"
-DATA ·d+7192(SB)/8,$"\x68\xb8\x05\x68\x42\xc5\x72\x52"
-DATA ·d+7200(SB)/8,$"\xf3\xb4\x0d\x40\x7c\x2c\xea\x0c"
-DATA ·d+7208(SB)/8,$"\xcd\x90\x14\xc2\x04\x8d\x4c\x20"
-DATA ·d+7216(SB)/8,$"\x82\x41\xae\x21\xa8\xae\x29\x2e"
-DATA ·d+7224(SB)/8,$"\x29\xca\xcc\x4b\xa7\x83\x73\x40"
-DATA ·d+7232(SB)/8,$"\x36\x03\x02\x00\x00\xff\xff\x97"
-DATA ·d+7240(SB)/8,$"\xf1\xb5\x58\x64\x01\x00\x00\x00"
-DATA ·d+7248(SB)/8,$"\x1f\x8b\x08\x00\x00\x00\x00\x00"
-DATA ·d+7256(SB)/8,$"\x02\xff\xa4\x8e\x41\x4b\xc3\x30"
-DATA ·d+7264(SB)/8,$"\x18\x86\xcf\xc9\xaf\xf8\x98\x3b"
-DATA ·d+7272(SB)/8,$"\x4c\x96\x75\x89\x78\xd8\x55\x6d"
-DATA ·d+7280(SB)/8,$"\x27\x13\xd3\x94\x34\xa8\x37\x59"
-DATA ·d+7288(SB)/8,$"\xcc\x67\x2c\xc4\x14\xba\x08\x0e"
-DATA ·d+7296(SB)/8,$"\xfc\x5f\xbd\xf7\x97\x49\x8b\x20"
-DATA ·d+7304(SB)/8,$"\x82\xb7\x1d\xdf\x97\x07\x9e\x67"
-DATA ·d+7312(SB)/8,$"\xbd\x86\x9b\xd6\x21\x78\x8c\xd8"
-DATA ·d+7320(SB)/8,$"\xed\x13\x3a\xb0\x47\xf0\xed\xaa"
-DATA ·d+7328(SB)/8,$"\x79\xb7\xe8\x32\xc8\x15\x94\xca"
-DATA ·d+7336(SB)/8,$"\x40\x91\xef\x4c\x46\xe9\x59\x13"
-DATA ·d+7344(SB)/8,$"\x5f\xc2\x87\x43\x98\x25\xfc\x4c"
-DATA ·d+7352(SB)/8,$"\xaf\x61\xef\xb3\xb7\x19\xa5\xa6"
-DATA ·d+7360(SB)/8,$"\x78\x32\x30\xf4\x36\xb4\xf6\xd9"
-DATA ·d+7368(SB)/8,$"\x1e\x13\x1e\x16\xf5\xf5\x39\x2b"
-DATA ·d+7376(SB)/8,$"\x55\x5d\xdd\xef\xcc\x57\xa9\xb6"
-DATA ·d+7384(SB)/8,$"\xfa\x4a\x16\x6c\xce\x57\x1b\x4a"
-DATA ·d+7392(SB)/8,$"\xa4\x7a\xc8\xc9\x7c\xe8\xdd\xc4"
-DATA ·d+7400(SB)/8,$"\x80\xe6\xd3\xf5\x48\x02\xc6\x25"
-DATA ·d+7408(SB)/8,$"\x5f\x6c\xab\xf1\x13\x3f\x98\x16"
-DATA ·d+7416(SB)/8,$"\x0c\xf4\x05\x25\xb5\x91\xb7\x44"
-DATA ·d+7424(SB)/8,$"\xf3\x71\x30\xe8\x30\x2d\x37\x23"
-DATA ·d+7432(SB)/8,$"\x48\xc9\x9d\xac\x88\x16\x97\x7f"
-DATA ·d+7440(SB)/8,$"\x03\x0e\xa9\x6b\xa2\x3f\xbd\xe0"
-DATA ·d+7448(SB)/8,$"\x57\x2a\xfe\x95\x7e\x07\x00\x00"
-DATA ·d+7456(SB)/8,$"\xff\xff\x8b\x3f\x80\x51\x39\x01"
-DATA ·d+7464(SB)/8,$"\x00\x00\x00\x00\x00\x00\x00\x00"
-DATA ·d+7472(SB)/8,$"\x1f\x8b\x08\x00\x00\x00\x00\x00"
-DATA ·d+7480(SB)/8,$"\x02\xff\xd4\x5a\x6d\x73\xdb\xb8"
-DATA ·d+7488(SB)/8,$"\x11\xfe\x2c\xfe\x8a\x35\x67\x7c"
-DATA ·d+7496(SB)/8,$"\x25\x7b\x34\xe5\x4b\xd2\x34\xe3"
-DATA ·d+7504(SB)/8,$"\x8c\xda\x49\x6c\xf9\x92\xf6\xa2"
-DATA ·d+7512(SB)/8,$"\x78\x6c\x65\x32\x1d\x5f\xe6\x06"
-DATA ·d+7520(SB)/8,$"\x26\x97\x12\x62\x12\xa0\x41\xc8"
-DATA ·d+7528(SB)/8,$"\x2f\x8d\xf5\xdf\x3b\x78\x21\x45"
-DATA ·d+7536(SB)/8,$"\x4a\xd4\x8b\x1d\xdb\x97\xde\x87"
-DATA ·d+7544(SB)/8,$"\xb3\x08\x02\xbb\x8b\xdd\x67\x77"
-DATA ·d+7552(SB)/8,$"\x81\x87\xe9\x76\x61\x9f\xc7\x08"
-DATA ·d+7560(SB)/8,$"\x23\x64\x28\x88\xc4\x18\xce\x6e"
-DATA ·d+7568(SB)/8,$"\x60\xc4\x77\x68\x76\x86\x71\x08"
-DATA ·d+7576(SB)/8,$"\x07\x1f\x61\xf0\x71\x08\xfd\x83"
-DATA ·d+7584(SB)/8,$"\xf7\xc3\xd0\x71\x72\x12\x9d\x93"
-DATA ·d+7592(SB)/8,$"\x11\xc2\xb7\x6f\xe1\xd1\xf9\x68"
-DATA ·d+7600(SB)/8,$"\x3a\x75\x1c\x9a\xe5\x5c\x48\xf0"
-DATA ·d+7608(SB)/8,$"\x9c\x8e\x8b\x2c\xe2\x31\x65\xa3"
-DATA ·d+7616(SB)/8,$"\xee\x19\x29\xf0\xf9\x33\xb7\x31"
-DATA ·d+7624(SB)/8,$"\x44\x19\x11\x37\x6a\x68\x4c\x8a"
-DATA ·d+7632(SB)/8,$"\x71\x37\x12\xd1\xcb\x17\xea\x49"
-DATA ·d+7640(SB)/8,$"\x62\x21\x29\x1b\xa9\x9f\x19\x91"
-DATA ·d+7648(SB)/8,$"\xe3\xae\x20\x2c\x76\x9d\x6f\xdf"
-DATA ·d+7656(SB)/8,$"\x76\x80\x26\x10\x1e\x11\x41\xb2"
-DATA ·d+7664(SB)/8,$"\x22\x7c\x3b\xa1\x69\x7c\x58\xbc"
-DATA ·d+7672(SB)/8,$"\x39\x7a\x0f\xd3\xa9\xd3\x71\x79"
-DATA ·d+7680(SB)/8,$"\xa1\xe6\x53\xde\xa5\x7c\x22\x69"
-DATA ·d+7688(SB)/8,$"\xaa\x1e\x72\xb5\x38\xa1\x29\xaa"
-DATA ·d+7696(SB)/8,$"\x1f\x6a\xe0\xec\x46\x62\x61\x24"
-DATA ·d+7704(SB)/8,$"\x21\x8b\xd5\x3a\x2b\x94\x8b\x36"
-DATA ·d+7712(SB)/8,$"\xb9\x8d\xa1\x77\x52\xe6\xef\x08"
-DATA ·d+7720(SB)/8,$"\x8b\x53\x14\x95\xce\x24\x93\x9b"
-DATA ·d+7728(SB)/8,$"\x48\x9b\x5b\xba\xf0\xae\xb6\x0b"
-DATA ·d+7736(SB)/8,$"\x86\xb2\x3b\x96\x32\x77\x6b\xbf"
-DATA ·d+7744(SB)/8,$"\xf5\xff\x94\x4f\xca\x3d\xb5\xe9"
-DATA ·d+7752(SB)/8,$"\x6c\x08\xfd\xc4\x28\x67\x35\xa9"
-DATA ·d+7760(SB)/8,$"\x28\x04\x17\xcd\x8d\xfb\x8e\x73"
-DATA ·d+7768(SB)/8,$"\x49\x04\x28\xd7\xf2\x6c\x40\x32"
-DATA ·d+7776(SB)/8,$"\x84\x1e\x24\x13\x16\x79\x3e\x14"
-DATA ·d+7784(SB)/8,$"\x52\x50\x36\x82\x6f\x4e\x47\xcd"
-DATA ·d+7792(SB)/8,$"\x38\x9b\x24\x70\xfa\xcb\xcb\x2f"
-DATA ·d+7800(SB)/8,$"\xca\x77\x4e\xc7\x84\x2c\xfc\x8d"
-DATA ·d+7808(SB)/8,$"\x4a\x99\x62\x9f\xc5\x94\xb0\xf0"
-DATA ·d+7816(SB)/8,$"\x68\x22\x3f\x51\x26\x5f\xbe\xf0"
-DATA ·d+7824(SB)/8,$"\xce\x26\xc9\xe9\xde\xab\x2f\x81"
-DATA ·d+7832(SB)/8,$"\x16\x1b\xda\x41\xdf\xdf\x64\xd9"
-DATA ·d+7840(SB)/8,$"\xab\xbd\x96\x65\x02\xe5\x44\x30"
-DATA ·d+7848(SB)/8,$"\x38\x7b\xfe\xac\xcf\xa2\xb0\xaf"
-DATA ·d+7856(SB)/8,$"\x70\x83\x43\x7e\xa2\xed\x33\xca"
-DATA ·d+7864(SB)/8,$"\xbe\xf8\xce\xd4\xb3\x7b\x31\xd3"
-DATA ·d+7872(SB)/8,$"\xa0\x07\x06\x6a\xe1\x00\xaf\xfa"
-DATA ·d+7880(SB)/8,$"\x16\x6a\x9e\x4b\xce\xa2\x18\x93"
-DATA ·d+7888(SB)/8,$"\xd1\x98\x7e\x3d\x4f\x33\xc6\xf3"
-DATA ·d+7896(SB)/8,$"\x0b\x51\xc8\xc9\xe5\xd5\xf5\xcd"
-DATA ·d+7904(SB)/8,$"\x7f\x9f\x3d\x7f\xf1\xb7\x97\x7f"
-DATA ·d+7912(SB)/8,$"\x77\xfd\xf0\x33\x95\xe3\x23\x12"
-DATA ·d+7920(SB)/8,$"\xeb\xf9\xa5\x08\x6e\x07\x7c\xc7"
-DATA ·d+7928(SB)/8,$"\x51\xde\x81\x11\xca\x21\x19\x79"
-DATA ·d+7936(SB)/8,$"\x31\x91\x04\x4e\xb5\x4f\xe6\xfd"
-DATA ·d+7944(SB)/8,$"\x15\x89\xe8\xad\x72\xd9\xab\x8d"
-DATA ·d+7952(SB)/8,$"\x3c\x66\x66\x9f\xaa\xcd\x6b\xf8"
-DATA ·d+7960(SB)/8,$"\x87\xfb\x63\x8c\xce\x8b\x49\xa6"
-DATA ·d+7968(SB)/8,$"\x55\x94\x83\x1f\xc8\x39\x0e\xc9"
-DATA ·d+7976(SB)/8,$"\x59\x8a\x9e\x79\xee\xef\x7f\x78"
-DATA ·d+7984(SB)/8,$"\xe3\xaf\x75\x50\x25\xdb\x77\xa6"
-DATA ·d+7992(SB)/8,$"\xd6\xfc\x21\x16\xf2\xad\x4a\x02"
-DATA ·d+8000(SB)/8,$"\x4f\xc2\x5f\x6d\x9a\x85\x43\x5f"
-DATA ·d+8008(SB)/8,$"\xd9\x9e\x70\x01\x2c\x00\x02\x7b"
-DATA ·d+8016(SB)/8,$"\x3d\x15\x87\x11\x42\x42\xe3\x6b"
-DATA ·d+8024(SB)/8,$"\xf5\xa6\x43\x93\x72\xdf\x24\x34"
-DATA ·d+8032(SB)/8,$"\xab\x7d\x1f\xb6\x7a\x40\x42\x49"
-DATA ·d+8040(SB)/8,$"\xf4\xbe\x3b\x1d\x19\x1e\x12\x49"
-DATA ·d+8048(SB)/8,$"\xd2\xc4\x73\x23\xbb\x01\x50\x02"
-DATA ·d+8056(SB)/8,$"\x49\x51\xa0\x84\xed\x02\x62\x8e"
-DATA ·d+8064(SB)/8,$"\x05\xfb\x8b\x84\x8c\xc8\x68\x0c"
-DATA ·d+8072(SB)/8,$"\x02\x23\x2e\x62\x8c\xdd\x00\x98"
-DATA ·d+8080(SB)/8,$"\xef\x74\x3a\x53\xa7\x33\x6d\xd8"
-DATA ·d+8088(SB)/8,$"\x68\x77\x70\x3f\x23\x4d\x5c\x3c"
-DATA ·d+8096(SB)/8,$"\x12\x5a\x29\xfe\x23\x59\xbb\xb2"
-DATA ·d+8104(SB)/8,$"\x20\x55\x5b\xf9\x4c\xd2\xf3\x8f"
-DATA ·d+8112(SB)/8,$"\x39\xb2\xc5\xcd\x1c\x9e\x78\x7e"
-DATA ·d+8120(SB)/8,$"\xa8\x5e\x7b\xae\x1b\x98\xe4\x53"
-DATA ·d+8128(SB)/8,$"\xd9\x6d\xf1\x14\x00\x65\x09\x07"
-DATA ·d+8136(SB)/8,$"\x5e\x84\x87\x34\xc5\xf7\x2c\xe1"
-DATA ·d+8144(SB)/8,$"\x01\xa0\x10\xa0\x73\xd9\x37\x7f"
-DATA ·d+8152(SB)/8,$"\xca\x8d\xab\xf1\xad\x1e\x30\x9a"
-DATA ·d+8160(SB)/8,$"\xc2\xed\xad\x5e\x17\xbe\x2f\x0e"
-DATA ·d+8168(SB)/8,$"\xa8\xf0\x7c\xb3\x5f\x0b\x13\x46"
-DATA ·d+8176(SB)/8,$"\x53\x63\x7e\xc7\xec\x74\xaf\x07"
-DATA ·d+8184(SB)/8,$"\xbf\xa2\xd4\x4a\x7d\x23\xc8\x8c"
-DATA ·d+8192(SB)/8,$"\xf7\x8c\xa8\xfa\xd2\x24\x93\x61"
-DATA ·d+8200(SB)/8,$"\x5f\xa9\x4c\x3c\xb7\x72\x13\xe3"
-DATA ·d+8208(SB)/8,$"\x13\x09\x09\x9f\x30\xe5\x9a\x52"
-DATA ·d+8216(SB)/8,$"\xca\xb4\x09\x18\x35\xb7\x09\x1a"
-DATA ·d+8224(SB)/8,$"\x3d\x52\x85\xa2\x45\xfe\x1d\x63"
-DATA ·d+8232(SB)/8,$"\xd2\xae\xb8\x04\x81\xd6\x36\x07"
-DATA ·d+8240(SB)/8,$"\x84\xc7\xb4\x40\xc4\x42\xf9\xd5"
-DATA ·d+8248(SB)/8,$"\xe8\x38\x46\x12\xa3\xf0\xd4\x3b"
-DATA ·d+8256(SB)/8,$"\x93\xce\x2a\x50\x7b\x3d\x30\x2d"
-DATA ·d+8264(SB)/8,$"\x4a\xbf\x7e\x93\xa6\x9e\x88\x85"
-DATA ·d+8272(SB)/8,$"\x6f\x96\x86\xfb\x29\x2f\xd0\xf3"
-DATA ·d+8280(SB)/8,$"\x17\xc2\x5a\xb7\x14\x85\x98\x29"
-DATA ·d+8288(SB)/8,$"\x33\x32\x7b\xa0\xc1\xa4\x71\x56"
-DATA ·d+8296(SB)/8,$"\x0b\xe7\x5a\x01\x33\xab\x1e\xce"
-DATA ·d+8304(SB)/8,$"\xa8\x59\x0c\x94\xf4\x27\xf1\x78"
-DATA ·d+8312(SB)/8,$"\xcd\xc2\x3a\xd4\xa7\xb3\xc2\x27"
-DATA ·d+8320(SB)/8,$"\x32\x29\x10\x3d\xa6\x1a\x9d\xc9"
-DATA ·d+8328(SB)/8,$"\x2f\xbf\x2c\xd8\xea\x80\x50\xc0"
-DATA ·d+8336(SB)/8,$"\xe9\x17\x33\x6c\xc6\x62\x2a\xea"
-DATA ·d+8344(SB)/8,$"\x43\xe5\x19\xc2\x64\xab\x12\xf2"
-DATA ·d+8352(SB)/8,$"\x60\xf9\xda\x9e\x9f\x34\x69\xc9"
-DATA ·d+8360(SB)/8,$"\x62\x6d\x54\x0f\x48\x9e\x23\x8b"
-DATA ·d+8368(SB)/8,$"\x3d\xf5\x54\x73\x04\x60\x5a\xa0"
-DATA ·d+8376(SB)/8,$"\x99\x67\x36\x54\x4d\xd4\x8f\x73"
-DATA ·d+8384(SB)/8,$"\x2e\x6b\x3a\x49\x97\xd5\xaf\x0a"
-DATA ·d+8392(SB)/8,$"\x98\x29\x32\x33\xdf\x87\x1d\xf8"
-DATA ·d+8400(SB)/8,$"\xe5\x35\x7c\x85\x7f\xf4\x60\xf7"
-DATA ·d+8408(SB)/8,$"\x35\x7c\xdd\xd9\xd1\xb2\x79\x11"
-DATA ·d+8416(SB)/8,$"\x1e\x63\xc6\x2f\xd1\xcc\x3a\xfd"
-DATA ·d+8424(SB)/8,$"\xfa\xc5\x57\xc5\xb0\x29\x40\x59"
-DATA ·d+8432(SB)/8,$"\xb6\x76\xbd\x9a\x54\x2e\xaf\x57"
-DATA ·d+8440(SB)/8,$"\xfe\x7d\x9e\xdf\x0c\xf9\x62\xb1"
-DATA ·d+8448(SB)/8,$"\x94\x59\x3e\x9f\x3e\x43\xcc\x72"
-DATA ·d+8456(SB)/8,$"\xe5\x1e\x5e\x54\x3f\xfd\x00\xdc"
-DATA ·d+8464(SB)/8,$"\x50\x2d\xdc\xd1\xe7\x25\xdf\x69"
-DATA ·d+8472(SB)/8,$"\xf1\xb6\x2d\xfe\x1e\x0a\x61\x8c"
-DATA ·d+8480(SB)/8,$"\x8f\x31\x41\x51\x22\x44\x66\xb9"
-DATA ·d+8488(SB)/8,$"\xef\x74\xba\x5d\x20\x70\x35\xe6"
-DATA ·d+8496(SB)/8,$"\x29\x82\x1a\xad\xc4\xf4\xa0\xb4"
-DATA ·d+8504(SB)/8,$"\x4f\x99\xb3\xfb\xf2\xc5\x6e\x00"
-DATA ·d+8512(SB)/8,$"\x09\x49\x0b\xf4\x5f\xaf\x55\xa3"
-DATA ·d+8520(SB)/8,$"\x70\xa5\xac\x3a\xa0\x02\xa0\x0e"
-DATA ·d+8528(SB)/8,$"\x36\x35\xa8\x30\xb3\x30\x78\x30"
-DATA ·d+8536(SB)/8,$"\x3b\x65\x38\x9d\x5a\x9a\x3f\x74"
-DATA ·d+8544(SB)/8,$"\xcf\x58\x9a\xc7\x8b\x18\xa4\x49"
-DATA ·d+8552(SB)/8,$"\xb5\x87\x5e\x0f\x5c\xd7\x8c\x76"
-DATA ·d+8560(SB)/8,$"\xaa\x31\x0d\x33\x35\x34\x6d\xc5"
-DATA ·d+8568(SB)/8,$"\x75\x52\xc5\x90\x17\xba\x5a\x29"
-DATA ·d+8576(SB)/8,$"\x3b\xbd\x2a\xbd\xfe\xc5\x29\x33"
-DATA ·d+8584(SB)/8,$"\xae\xad\x86\x0e\x05\xcf\x4e\x52"
-DATA ·d+8592(SB)/8,$"\x52\x8c\x4d\x5d\xf3\x03\xbd\xf2"
-DATA ·d+8600(SB)/8,$"\x8f\xe3\x83\x8f\x83\xdf\xfe\x13"
-DATA ·d+8608(SB)/8,$"\xc0\xee\xdd\x2b\xdd\x62\xfd\x4d"
-DATA ·d+8616(SB)/8,$"\x94\x90\xe4\xee\x65\xae\x0a\x5c"
-DATA ·d+8624(SB)/8,$"\xcd\x15\xb3\xb1\xca\x15\x55\x28"
-DATA ·d+8632(SB)/8,$"\x7b\xf0\x61\x52\xd8\x7e\x5b\xf6"
-DATA ·d+8640(SB)/8,$"\xc4\x99\x34\x7d\x39\x09\xf7\x79"
-DATA ·d+8648(SB)/8,$"\x96\x13\x81\xf6\xf8\xb7\x38\x5f"
-DATA ·d+8656(SB)/8,$"\xd7\xd3\xdd\xa5\x75\x54\x1f\x4c"
-DATA ·d+8664(SB)/8,$"\x63\x9a\x24\x28\x0a\x5d\x4b\xb7"
-DATA ·d+8672(SB)/8,$"\x0b\x77\x75\xee\x6f\x90\x20\xed"
-DATA ·d+8680(SB)/8,$"\x5b\x55\x39\xc2\x00\xb3\x5c\xde"
-DATA ·d+8688(SB)/8,$"\x00\x11\xd1\x98\x5e\xe2\x3f\x2b"
-DATA ·d+8696(SB)/8,$"\xf9\x7a\x5d\xb7\x0b\x05\x65\xa3"
-DATA ·d+8704(SB)/8,$"\x14\x75\x38\x9d\x8e\x24\x42\x75"
-DATA ·d+8712(SB)/8,$"\x86\x52\xd4\x5e\x0f\x5a\x22\x5f"
-DATA ·d+8720(SB)/8,$"\x6a\xf2\x9d\x5a\xb5\x68\xae\xf4"
-DATA ·d+8728(SB)/8,$"\x97\xe7\xe3\x0b\x9b\x8f\x35\x39"
-DATA ·d+8736(SB)/8,$"\xeb\x33\xb3\x1d\x95\x4d\x9d\x2d"
-DATA ·d+8744(SB)/8,$"\xb8\xdb\xa4\xb4\xac\x41\x5d\x0d"
-DATA ·d+8752(SB)/8,$"\x74\x9b\xc5\xa1\x09\x92\x12\x59"
-DATA ·d+8760(SB)/8,$"\x01\x54\xad\x76\xb7\xbe\xb4\x0d"
-DATA ·d+8768(SB)/8,$"\x10\xb5\x88\x00\x5e\x4b\x41\x22"
-DATA ·d+8776(SB)/8,$"\x5d\x27\xa7\x0f\xe2\xd3\xc4\x73"
-DATA ·d+8784(SB)/8,$"\xf1\x3a\xc7\x48\x62\x0c\x8c\x9b"
-DATA ·d+8792(SB)/8,$"\x82\x13\xc0\x88\x4b\xd8\xbe\x74"
-DATA ·d+8800(SB)/8,$"\xb5\x23\x1a\x1e\xdf\xc0\xe1\x9f"
-DATA ·d+8808(SB)/8,$"\x8f\x95\xc3\xe1\xd6\x3c\xbd\x39"
-DATA ·d+8816(SB)/8,$"\x3a\xea\x0f\x0e\x94\x55\xbb\x1b"
-DATA ·d+8824(SB)/8,$"\x46\xe0\x8f\x52\x53\x12\x7e\x16"
-DATA ·d+8832(SB)/8,$"\x54\xa2\x3d\x0a\xce\xee\xbd\xf7"
-DATA ·d+8840(SB)/8,$"\x89\xc2\x9d\xdd\xc4\x0b\x95\xa1"
-DATA ·d+8848(SB)/8,$"\xfd\x6b\x5a\xc8\x65\xee\xaa\x4d"
-DATA ·d+8856(SB)/8,$"\x69\xf3\xd8\x0a\xad\x52\x4c\xee"
-DATA ·d+8864(SB)/8,$"\x85\xf7\xc7\x84\xfb\x8f\x8f\xf6"
-DATA ·d+8872(SB)/8,$"\xd5\xc5\x65\xb1\xc9\x75\xbb\x0a"
-DATA ·d+8880(SB)/8,$"\xd1\x31\x15\x18\x49\x2e\x28\x16"
-DATA ·d+8888(SB)/8,$"\x40\x59\x59\xf7\x9a\x65\x6f\xae"
-DATA ·d+8896(SB)/8,$"\xcc\xb5\x56\xb9\x06\xfe\x16\x62"
-DATA ·d+8904(SB)/8,$"\x3b\x17\x8a\x05\x70\x1d\x50\xb1"
-DATA ·d+8912(SB)/8,$"\x41\x98\xe7\x4f\x0c\x76\xe5\x93"
-DATA ·d+8920(SB)/8,$"\x5c\x35\x67\x7d\xb2\xea\x7e\x7b"
-DATA ·d+8928(SB)/8,$"\x4b\xda\xdf\x46\x67\x82\x39\x8f"
-DATA ·d+8936(SB)/8,$"\xfc\x5f\x1c\x0f\xda\x1a\x7a\xe9"
-DATA ·d+8944(SB)/8,$"\x8d\x35\x6d\x5c\x1d\x3a\x0b\x0b"
-DATA ·d+8952(SB)/8,$"\x64\x20\x89\x44\x01\x39\x11\x92"
-DATA ·d+8960(SB)/8,$"\x92\xb4\x8e\xe2\x7b\xf6\xf3\x69"
-DATA ·d+8968(SB)/8,$"\x9d\x07\x6c\x65\x31\x16\xb9\xce"
-DATA ·d+8976(SB)/8,$"\xea\x7c\x5e\x7b\xd5\x4e\xcf\xe4"
-DATA ·d+8984(SB)/8,$"\x2d\xdc\x4c\x93\x6e\x58\xca\x35"
-DATA ·d+8992(SB)/8,$"\xcc\xf2\x78\x09\xc5\x50\xee\x79"
-DATA ·d+9000(SB)/8,$"\x6c\x0c\x50\x12\xc7\x52\xe6\xa1"
-DATA ·d+9008(SB)/8,$"\x35\xe8\x50\xe1\xfa\xdd\x70\x78"
-DATA ·d+9016(SB)/8,$"\x64\x9f\x35\xa1\x27\x30\xa1\xd7"
-DATA ·d+9024(SB)/8,$"\x9e\xdb\x75\x7d\x73\x3d\xbc\xa8"
-DATA ·d+9032(SB)/8,$"\xc2\xac\x97\x0e\xf0\xea\x18\x2f"
-DATA ·d+9040(SB)/8,$"\x26\x58\x48\xcf\xfd\xb5\x3f\xb4"
-DATA ·d+9048(SB)/8,$"\x87\x25\x83\x3a\xb7\xab\x95\x06"
-DATA ·d+9056(SB)/8,$"\xca\xc2\x25\x71\x6f\xfa\xd6\x04"
-DATA ·d+9064(SB)/8,$"\xa4\x12\xae\x9c\x63\x14\xe8\x8b"
-DATA ·d+9072(SB)/8,$"\xab\x21\x02\xac\xed\xe1\x09\x8a"
-DATA ·d+9080(SB)/8,$"\x4b\x54\xc6\x7a\x42\x04\x20\xf0"
-DATA ·d+9088(SB)/8,$"\xc2\x6a\x28\x24\x91\x93\x42\x3b"
-DATA ·d+9096(SB)/8,$"\x51\x84\xfb\x3c\xc6\xd7\xe5\xd0"
-DATA ·d+9104(SB)/8,$"\x96\x35\xf9\x44\x3f\x7e\xfc\xf7"
-DATA ·d+9112(SB)/8,$"\xbc\xd3\x4a\xaf\x18\x44\x60\x0c"
-DATA ·d+9120(SB)/8,$"\x57\x82\xb3\x51\xb9\x3a\xe2\x31"
-DATA ·d+9128(SB)/8,$"\xda\x13\xe1\x9e\xed\x2f\x70\x45"
-DATA ·d+9136(SB)/8,$"\x98\xed\x33\x79\x60\xe7\x05\x4d"
-DATA ·d+9144(SB)/8,$"\x1d\x8b\xc4\x8a\x10\xe1\x5b\x1e"
-DATA ·d+9152(SB)/8,$"\xdf\xac\xe2\x74\x56\x98\x14\x71"
-DATA ·d+9160(SB)/8,$"\x26\x91\xc9\xb9\x9b\xfd\x15\x95"
-DATA ·d+9168(SB)/8,$"\xb3\xeb\x7d\xed\xd8\x5a\xd3\x2e"
-DATA ·d+9176(SB)/8,$"\x44\xf8\xce\xb2\x29\xa1\x42\x91"
-DATA ·d+9184(SB)/8,$"\xbb\x6f\x24\xed\x0c\x6f\x72\x74"
-DATA ·d+9192(SB)/8,$"\x6b\x56\x64\x34\xc3\x8d\xcd\x90"
-DATA ·d+9200(SB)/8,$"\x37\x39\x6e\x60\xcb\x9e\x82\xa2"
-DATA ·d+9208(SB)/8,$"\x31\x29\x58\x67\x49\x50\xb3\x63"
-DATA ·d+9216(SB)/8,$"\x96\xa3\x17\x65\x5b\x7c\x2c\xd0"
-DATA ·d+9224(SB)/8,$"\xe1\x85\xb5\x2a\x3c\x51\x36\xbd"
-DATA ·d+9232(SB)/8,$"\x4f\x76\x06\x9c\xe1\xce\x07\xb5"
-DATA ·d+9240(SB)/8,$"\x27\x75\x53\xcc\x64\x78\x92\x0b"
-DATA ·d+9248(SB)/8,$"\xca\x64\xe2\xb9\xbf\xbb\xdb\xc5"
-DATA ·d+9256(SB)/8,$"\xef\xea\xfe\x58\xc5\xcd\xe4\x46"
-DATA ·d+9264(SB)/8,$"\x69\xdf\xa3\xe2\x76\xc0\xe5\x07"
-DATA ·d+9272(SB)/8,$"\x1e\xd3\x84\x62\xfc\xf8\x00\xae"
-DATA ·d+9280(SB)/8,$"\x29\xab\x98\xdb\x7b\xd4\x80\x5a"
-DATA ·d+9288(SB)/8,$"\xb7\x2e\xe3\xb2\xc1\x01\x66\x75"
-DATA ·d+9296(SB)/8,$"\x21\xb8\x77\x0d\x5b\x15\x88\xbb"
-DATA ·d+9304(SB)/8,$"\xc5\xe1\x50\xd5\xd5\xb9\x13\xd4"
-DATA ·d+9312(SB)/8,$"\xfa\x18\xb4\x38\xbf\xdd\xf3\x5a"
-DATA ·d+9320(SB)/8,$"\xfc\x1d\x3a\xce\x22\x77\xae\x47"
-DATA ·d+9328(SB)/8,$"\x69\x8a\x27\x37\x85\xc4\x6c\x33"
-DATA ·d+9336(SB)/8,$"\x06\xfd\xe9\xe9\xf3\x07\xe0\xce"
-DATA ·d+9344(SB)/8,$"\x17\x0a\xc5\x03\xb5\xa7\x45\xc6"
-DATA ·d+9352(SB)/8,$"\x78\xa3\xe6\x54\xa9\xd7\xce\x57"
-DATA ·d+9360(SB)/8,$"\x38\x13\x9e\xf6\xf5\x5c\x40\xfc"
-DATA ·d+9368(SB)/8,$"\xc7\x68\x68\x2d\x5e\xfb\x61\x3a"
-DATA ·d+9376(SB)/8,$"\xdb\x26\xb6\x3d\x65\x8b\xbb\x8b"
-DATA ·d+9384(SB)/8,$"\x3d\x4f\xd6\xeb\xe6\x08\xf8\x75"
-DATA ·d+9392(SB)/8,$"\xa9\xdf\xfc\x58\xad\xbf\xe4\x5a"
-DATA ·d+9400(SB)/8,$"\x93\x0f\xec\x45\xae\x67\xd2\xb5"
-DATA ·d+9408(SB)/8,$"\x50\x70\x2d\x53\xa8\xf2\xb2\x99"
-DATA ·d+9416(SB)/8,$"\xe3\x96\x1f\x68\xf5\x87\x04\x05"
-DATA ·d+9424(SB)/8,$"\x51\x6b\xa9\x97\x14\x30\x43\x6c"
-DATA ·d+9432(SB)/8,$"\x00\xb5\x2f\x00\x41\x25\xa4\xfc"
-DATA ·d+9440(SB)/8,$"\x92\x5b\x55\x85\x44\xdf\x32\x6c"
-DATA ·d+9448(SB)/8,$"\x22\x26\xe6\x5a\xa2\x79\xff\xd6"
-DATA ·d+9456(SB)/8,$"\xca\x5f\xcf\xb2\xa9\xd3\x61\x78"
-DATA ·d+9464(SB)/8,$"\x65\x95\x2f\xbd\x51\x98\x7b\xa6"
-DATA ·d+9472(SB)/8,$"\xfa\xb3\xea\x4a\x3c\x27\x77\xe1"
-DATA ·d+9480(SB)/8,$"\x4a\x11\x95\x5a\x66\x1a\x6b\xf7"
-DATA ·d+9488(SB)/8,$"\x0a\xbb\xba\xe9\x4b\xdb\x00\xab"
-DATA ·d+9496(SB)/8,$"\x10\xd5\xa9\x77\x1b\x88\xef\xe2"
-DATA ·d+9504(SB)/8,$"\xde\xcb\x7f\x03\xf2\x9d\xfc\x7b"
-DATA ·d+9512(SB)/8,$"\x52\x54\x0a\x07\x78\x65\x0c\x3b"
-DATA ·d+9520(SB)/8,$"\xb1\xef\x36\x90\xd8\x60\xd5\xd5"
-DATA ·d+9528(SB)/8,$"\x7f\x6d\x74\x7b\x83\x5d\x8f\x98"
-DATA ·d+9536(SB)/8,$"\xae\xe7\xbb\x7f\x02\xcf\x1e\x31"
-DATA ·d+9544(SB)/8,$"\xf9\xf3\xcf\xad\x84\xf2\x4f\x3f"
-DATA ·d+9552(SB)/8,$"\xc1\xd6\x62\x37\x5a\xc2\x30\x57"
-DATA ·d+9560(SB)/8,$"\x5b\x5a\xc1\x32\xdf\x8f\xfb\xfd"
-DATA ·d+9568(SB)/8,$"\xde\x0f\x1f\x95\x88\x96\xdc\x9c"
-DATA ·d+9576(SB)/8,$"\xf1\x56\x41\x23\x30\x1b\x88\x55"
-DATA ·d+9584(SB)/8,$"\xd3\x87\x9a\x17\x58\xc2\x24\xb7"
-DATA ·d+9592(SB)/8,$"\x90\x04\x15\x49\xe6\xcf\xe5\x78"
-DATA ·d+9600(SB)/8,$"\x83\x09\xab\x04\x5b\x36\x61\xff"
-DATA ·d+9608(SB)/8,$"\xb8\xff\x66\xd8\xbf\xd5\xbf\x87"
-DATA ·d+9616(SB)/8,$"\xc7\x9f\x06\xfb\xb7\x35\x6a\xf2"
-DATA ·d+9624(SB)/8,$"\x7e\x64\xa4\xca\xfc\xe5\x7c\xe4"
-DATA ·d+9632(SB)/8,$"\x9a\xba\xf0\x90\x1e\xee\xb5\x71"
-DATA ·d+9640(SB)/8,$"\xb8\x65\x5d\xa4\xea\x9c\x22\x21"
-DATA ·d+9648(SB)/8,$"\x1a\xab\xeb\x7c\xac\x89\x33\xf3"
-DATA ·d+9656(SB)/8,$"\x9d\x71\x66\xd3\x5c\xa9\x5e\x6d"
-DATA ·d+9664(SB)/8,$"\x5e\x8d\x73\xab\x7c\xfc\x43\x00"
-DATA ·d+9672(SB)/8,$"\x68\x3d\x47\x37\x43\xcb\x9f\x08"
-DATA ·d+9680(SB)/8,$"\x16\xaf\xb1\xc3\x07\x07\xca\x6c"
-DATA ·d+9688(SB)/8,$"\xc3\x77\xf6\xe5\x03\x84\x78\xb6"
-DATA ·d+9696(SB)/8,$"\xdf\x42\x1f\xd2\xea\x39\x31\x47"
-DATA ·d+9704(SB)/8,$"\xa4\x0f\xb8\x6c\xe3\xd2\x25\x66"
-DATA ·d+9712(SB)/8,$"\xb9\xa1\x7a\x2d\x70\x85\xb6\x24"
-DATA ·d+9720(SB)/8,$"\xb6\x8c\xaf\x98\x2f\xf2\x49\xf1"
-DATA ·d+9728(SB)/8,$"\x44\x25\x5e\xd4\x6a\xfc\x56\xeb"
-DATA ·d+9736(SB)/8,$"\x47\xd5\x15\x51\x51\x46\xb5\x7e"
-DATA ·d+9744(SB)/8,$"\x09\x5c\xf0\xea\x9c\x66\xad\xfa"
-DATA ·d+9752(SB)/8,$"\xfe\x65\x5f\x79\x6b\xab\x07\xda"
-DATA ·d+9760(SB)/8,$"\x6b\x4d\x3f\xb3\x49\x76\x86\x02"
-DATA ·d+9768(SB)/8,$"\x78\x02\x57\x24\x3d\xc7\x18\xa8"
-DATA ·d+9776(SB)/8,$"\xc4\xac\xa2\x2b\xbd\xed\x58\xad"
-DATA ·d+9784(SB)/8,$"\xdb\x8e\x7d\x37\x50\x42\x02\x2d"
-DATA ·d+9792(SB)/8,$"\x62\xe1\x1e\xf8\xbf\x00\x00\x00"
-DATA ·d+9800(SB)/8,$"\xff\xff\x41\x50\x95\xd7\x63\x2a"
-DATA ·d+9808(SB)/8,$"\x00\x00\x00\x00\x00\x00\x00\x00"
-GLOBL ·d(SB),RODATA,$9816
+DATA ·d+0(SB)/64,$"\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94T\xc1n\xdc6\x10=\x8b_1\x11P@Jl-\xd0\xde\x5c\xec!\xf5:@P\xa0\x09`\xa7\x17\xc30(q$\x0d\x96\x22U\x92Z\xefZ\xd0\xbf\x17$W\xb2l\xb7\x87\x1c"
+DATA ·d+64(SB)/64,$"\xc9\xf7\xf8\xe6\xcd\x0cg6\x1b\xb8\xd6\x02\xa1A\x85\x86;\x14P\x9e\xa0\xd1\x97\xd4\x95(\x0a\xd8}\x83\xbf\xbe\xdd\xc1\xcd\xee\xeb]\xc1\xd8f\xd3\xe8\xabr )\xe0C <\x0a<\xb0\xcd\x06>\xbd\xbbd=\xaf\xf6\xbc"
+DATA ·d+128(SB)/64,$"A\x18\xc7\xe2\xfb\xbe\x99&\xc6\xa8\xeb\xb5q\x90\xb1q\xbc\x04\xaa\xa1\xf8\xaa\xc8]\xb7X\xeda\x9aX\x92\xa2\xaa\xb4 \xd5lZ<\xa6\x81\x84JD\xc8Q\x87)\xcb\xbd\x03\x10x\xf8#D#\x0b\xce\x0c\xe8\xa5\xb8\xb5"
+DATA ·d+192(SB)/64,$"\xe8,p\x83`\xd1\x1cP@mt\x07\xaeE\xb0z0\x15\x82 \x83\x95\xd3\xe6\xc4*\xad\xac{Q\xd9B\xcd\xa5\xc5 \x1d\xec\x7f\x91\xbc\xb1`\xb0\xd2F\xd8 q\xae\x0di\x05u\x00\x9d\x86*\xf8~j\xd1\xb5h"
+DATA ·d+256(SB)/64,$"\x02kN\x98,\x0c\xbd\xa7\x08\xee\xf0\x1cm%\xbc\x85q\xec\x0d)WC\xfa\x8bH\xa1\xf8\xce\x0d\xef\xec4-e\xb9\xa5FaH\xdc{\xea\x87RR\xf5'\x9e\x80+\x01\xb4\x94,\x1a\x8c)R\xa3H5\xb0?\x93"
+DATA ·d+320(SB)/64,$"\xfc\xdd\x01\x0d\xd5TE\xd7\x9d\xef0wAnv\xa9\xc8\x11\x97\xf4\x1c\x19?\x9dQ\xc6\x92\x17g[H}\x9b\xe7\xf34\xa5,yq\x1a\xd1\xa5\xd9\x1e\xcd\xd7\xede\xf5\xa0*(\xa5.\x1f\xcb\x93C\x9b\x0d\xa4\xdco"
+DATA ·d+384(SB)/64,$"\xbf\xe6p\xff\xe0\xcf+\xd8:C\xaaY\xf0xd\xec\xc0\x0d\x18\xad\x1d|\x5c\xba\xfc\xd9\x7f\x88\x00\xd4$\x8e\xb0\x85\x8e\xef1\xebx\x7f\x1f\x1f=|\x0c\x8c<P\xc4\xffP^\xabE\xaeu\xbc\xeb\xc1\xff\xc7\xe2\x8e:"
+DATA ·d+448(SB)/64,$"<\x9b\xf7\xc9f9\x8c,\x89\xf862~(:f\xe3X\xec\xb8\xc3i\xca\x8b\x1fw\xd7Y\xce\x92\xb2\x84\xab\xed:\xe1q,n\xe9\xd9SXR\xda\x05<\xa7\xbbB\xc7\x11\x8a\xdd\xec*\xcc\xede\xf88~\x96\x04"
+DATA ·d+512(SB)/64,$"\x1e\x97\x9b\xb9\xa8R\xeb\xfd\xd0\x7f!\x89\x99\xe2\x1d\x9e+\x96C\x16\xd3\xbf\x80Rk\x19|\xf3\x0b\xd0{\x1f\xd9\xd7\xeb\xde\x93\x1fXb\xd0\x0dFA\xc0\xdeh\xee\xc8\xbc\x95|]\xae\x95\xb6\x98\xb5\xc5{m\xf1J\x9b"
+DATA ·d+576(SB)/64,$"K\xe9\xcd\xda,\x87w\xbd\x82qy\xe4=\xb28\x1eO\xdcU\xed\xe78\xfe\x15\x97\xd2\x82\xd2\x8e\xea\x93\xff\xc8\x0a\x0fh\xe6\xddP\xb5\x5c5x\x01O-U-D\xa8\xe5}\x8f*\xcc3\xfa\x09\x15(@+\xb4\xd1"
+DATA ·d+640(SB)/64,$"\xccJ:;\x8b\xfa\xfb,\xcfa\x9c\xd8\x7fo\xb1\xb7\xdf\xa1\x1f\xca\x0bx\xf4\xb9\xb7x,vXi\x81\xb7\xb1\xad\xcb\xf8\xe4\xb3\x14\xfe\xb3VK{\xae\xa8J\xc3\x02\xf4\xa01^\xe6o?\xd7'\xff8\xff=\xdc}"
+DATA ·d+704(SB)/64,$"\xd8\x82\x22\xe9c%\xe1A\x96\xce\x1b\xf7\x0a\xcaA\x09\xf9f\x17\xd4\x9c$\x8a+H\xe1\x93\x7f_\xdc\x18\xa3M\x96\xe7,\x89\x1b\x08\xa5\xc5\x103\xbc:\xdd\x18\x03\xaf\xa2\xae'wZ\x1f\xfe\x05\x00\x00\xff\xff\x03\x00\xde"
+DATA ·d+768(SB)/64,$"\x13\xb9&J\x06\x00\x00\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4X{o\xdb8\x12\xff[\xfa\x14\xb3\x06.\x90\xbaZ\xd9MrA\xe1=\x1f\xb0I\xb3\xb8\x1c\xdal\xd1\xa4\xd8?\x82\xa0\xa0%\xca\xe6\x99\x22\xbd$\x95\xc4"
+DATA ·d+832(SB)/64,$"I\xfd\xdd\x0f3\xa4\x1eN\xdcn\xf7\x80+\x0a\xc4$\x87\xf3\xf8\xcd\x93\x1a\x8f\xe1L\x97\x1c\x16\x5cq\xc3\x1c/a\xbe\x81\x85\xfeI\xd4s^\xe6\xf0\xf67\xb8\xfc\xed\x1a\xce\xdf^\x5c\xe7q<\x1e/\xf4t\xde\x08Y"
+DATA ·d+896(SB)/64,$"\x02\x9d\x7f.\xf9]<\x1e\xc3\x8f\xcf\xf7\xe25+Vl\xc1\xe1\xe9)\xff\xb0Zl\xb7q,\xea\xb56\x0e\x92\xf8\xe9\xe9'\x10\x15\xe4\x1f\x98a\xb5\xcd\xcft\xbd6\xdc\xda_\xac\xe5\xce\xc2v\x1bG\xa3\xf9\xc6q;"
+DATA ·d+960(SB)/64,$"\x8a\xa3Q\x11\x0e\xc7\x95d\x8e\xe3\xce\x92\xd9\xe5\xb80\xc5\xd1\xe1\x888qU\xfa;\x5c\x15\xba\x14j1\x9e\x0b\xc5\xccfH{r\x8c+\xa1\xc7B7NH\x5c\xd4\xa2&v\x9a\xc4\xac\x99[\xb6\x7f\xc7\x95\x90\xbc\xdd"
+DATA ·d+1024(SB)/64,$"0\x8dr\x81\xd2:#\xd4\x82\xc8\xedF\x15\xf8\xd7\x1f\xa5\x88\x0b\x94\xfc\xee\xd4\x83`\xc1\x99\x86\xa3\x89\xcc\x9b\xc4\x0c\x07\xcb\xcd\x1d/\xa12\xba\x06\xb7\xe4`uc\x0a\x0e\xa50\xbcp\xdal\xe2B+\xebz.3b"
+DATA ·d+1088(SB)/64,$"\xd2r\xbe\xf2\xd4\xc8z\xc9A\xea\x829\xa1\x15\xe8j//0\x5c2'\xee88\x0dn),\xa0I\xbd\x84\xc0m\x06OOk#\x94\xab`\xf4\xb7?F\x90\xbfm\x8f\xb6\xdbV\xf0G\xad]+\xf6\x85\x18\xdc\x1c"
+DATA ·d+1152(SB)/64,$"\x98h8\xf3\x06\xc6w\xcct\x97=l\xc4\xcf:V\xaf[n\xb5.E%\x82!\x08$\x18\x8e\x01\x82 i\xd3I\x11\xdc\x12;\x7fwF\x94\xf9\xa5\xbeO\xd28v\x9b5G9\xe7\xca\x99\x0d\x0aj\x0a\x07Oq"
+DATA ·d+1216(SB)/64,$"D:\x01\xc0+\x0a\xa98\xb2\xe2\x91\x03\x00\x08\xe5N\x8e\xe3\xa8\xd6\xe55\xca#V\xf8+\xde\xc6$#\x89\xa3\x92\xdf\xbdo\x00\xd0\xc1\xf9\xfb\xc6\xf1\x07\xda\xba(\x1f`\x065[\xf1\xa4f\xeb\x1bo\xd2\xed\xabVt"
+DATA ·d+1280(SB)/64,$"\x8a!P5\xaa\x00\xa1\x84KR\xd4\xe1sF\xa0g\xf09\x03\xbd\x82\xe9\x0cB(\xe5gLJn\x92I\x1aG\xa2\x82\x1f\xf4\x0a\xbe|\x81\x1f\xda\xa0\xcb/\xec/s\x9b\xe0\x92\xf8Dk\xa6D\x91\x8c\xda<\x9aB"
+DATA ·d+1344(SB)/64,$"\xa3\xd8\x5c\x92k)\x0e\xf8~\xdf\xe8\x0a\x91\xe1R\xafk\xae\x1cP~\x8e\xd28\xda\xc6Q\xeb\x99\x19tB\xff\xad\x85J\xba\xd5[a\xbc\x02YO\xf1\xab\xd1\xf5\x95dv\x99t\x01\x94\xa6q\x17'\x1f\x98[\x82\xe1"
+DATA ·d+1408(SB)/64,$"\xae1\xca\xbe\x88O\xef\x0f\xa1\xf6j\x9aA\xa3$\xb7\x16\x19\xe1\xb9b5\x87\xb5\x16\xcaY\xd0\x8d\xb3\xa2\xe4\xc8G8\x8fp\x90\x95\x10\x99wD\x0a\x89\xff\x91\xc1\x5ckI\xb0\x89\xca3\xfaa\x06\xa4\xfe\x99\xe4L\xd1"
+DATA ·d+1472(SB)/64,$"\xa5\x14\x0e\x0e\xba\xb3\xd1\x08\xc1\xa7\xd5l\x06\xa3<\xa7u\xc8\xf4\xfc_\xcc~0\xbc\x12\x0ft1\xc3\xf3\xf1(E\x8a\x81\xa7<O\xf4\x947\x1fF\xa3\x0c*&-'\xb0\xc3\xe6.\xd2\xc1\x03{\xe1%~i\xe6\xd3"
+DATA ·d+1536(SB)/64,$"\xbf\xc3\x97\x22\xb9\x03\xb8\x07\xb5\xcb\xb9!\xb6>\xf0\x0co\xacP\x0b:X\x1b~'tc\xe5\x06\xf9\xd1\xa5B+\x87\x91q\xbf\x14\xd2_\x01\xca\x13\xa6\xca=\xb9i\x1d\xf3\xf9nY\xcd;O\x90VCW\xa0E\xa0"
+DATA ·d+1600(SB)/64,$"m\xfe\xab\x90\xfcBU:\x85\xc4'a\x06\xdc\x18m\x08(\xca\xb1\xfc\x9d.VI\x8a\xab\x8aS\xb5x\xdf\xe4\x9f\x94\x0c\xbb\xa2\x02\xde&\x8eO\xc0\x1b\x14s\xfb3\xee\x1d\x1c\x00\xcfI\xd9\x19\xc6p~%\x1ey\x92"
+DATA ·d+1664(SB)/64,$"\xfa\xed\x90\xdc\xf9\xf9\x1f\x0d\x93I%\xf2\xf7~#Iw\x9c\xc4s\xe6\xd5RB\xfa\xa4`\x8e\x91\x92(\xd1\xb7\x89\xfc#g%Z\x92\xfcU\xef\x05\x03\x8c\xc1\x18SB\x0e%+!I\x0eI\xadQZMuh\xb3"
+DATA ·d+1728(SB)/64,$"\xe6\xa7\x9b\xf3\x07\xc7\x95\x15Z%\xc4\xf7\xfc\xc1%m0^\xebw\xfa\x9e\x9b\x1d\x095E\xed\x88\xd8\xd70\x83\x11[\xafe\xf0[\xdf\x06\xb7q\x84\xd5m\xdeTp\xf3\xe6\x16[k\x1c\xf9\xc3\xfc\x9dpN\xf2sU"
+DATA ·d+1792(SB)/64,$"\x0a\xa6\xf2\x0f\x8d\xfbD%2\x997\xd5\xcd\xf46\x03j\x9d\xf9\xd9\x92\x17+\xdb\xd4\x89\xc7\xa80\xc55V \xd4\x81\xa1\xfe\x07\xe4a\xd4\x01u\x9b\x02\x80\xcf\x8fSf\xb9W7\x8b#*\xc2\xd3P\x85\x8f\x0e\x13\xc9"
+DATA ·d+1856(SB)/64,$"\x15\xf1K\xe9p.\xf5\x9c\x0eI\x04Z#\x02\xa7\x1aW\x8e-h\x01\xf3\xa3\xc3sU\xe4\xe7\xd8\xea\xf9\xb5\xbe\x22l\x82\xba\xc4(\xb8\x7f\x0aC\xcfg\x88\xc1\x9fO\x1d\xa2\xda\x93\xf9u\x06#\xc7\x1f\x5cH\xfc\x01\xc1"
+DATA ·d+1920(SB)/64,$"US\xb5\x04?>\xd4\x92\xce\xd1\xce@q\xa6\x95cBY\x22\xf8\x0f\xbbc\xb60b\xed<\x1b\xef\xb8\xa1\xb7\x90\x03\xf9\x91\xe5\x08F\x06,/\x96\x8dZY\x98A;\x04\x9d\xd1\x86\x87\x8d(\x85m\xcd\xe0\xdd\xc4\x10"
+DATA ·d+1984(SB)/64,$"mw\xc6\x22\x96[g>#K\x98\x05\xed\x13/\x22\x8d\xa3af\xc1\x0c\x0e\xda\x86\xf6D\xc91\x05\x96\x81w[\x97d\x19\xecE\xb8/t\xcc\xa7\xd4w\xe0\x8d\x95h\xd7\xb2n\xc9-\x05\x02\x86\x8a\x06\x06\x8bG\xb1"
+DATA ·d+2048(SB)/64,$"F\xdd9\xab\xa9\x15\xa8\x92\xaf\xb9*\xb9r\xbe\x9e\x15=\x08\x013]\xf9_\xa84\xd00\x99\x81d\xa2\xc4\x8e\xd2\x951\xb8g\xfd\xa4\x0b\xa5\xe66CnX\x00\x87\x856\x08fR\xab\x05\xdc\x0b\xb7\x04]UdD"
+DATA ·d+2112(SB)/64,$"ho^\xa6/\x8a{\x5c\x057\x94s)$\xfeG\x067\xb7\x0d%\x01U\xa40xD\x8f@\xffH\xd9\xfc\xb4\xa9*n\xe2(\x0a\xf6\xb47(\x17\x84\x04\x80\x9b\xd7\x93\x90\xcai\x1c=\xe6\xbf\x1b\xe1x\x10\xf04"
+DATA ·d+2176(SB)/64,$"yx]e0yx3\xcf\xe0M\x06\x93\xdd\xff\xc7xTU\xdb4\x8e\xaa\xfb\x0c>c\x0a\xd3t\x9d_\xf2{\xe2c\x12*R~\xf3\x94[w\xb5\xe6\xbcDzm\xd0x\xbc1\xf9\x99~\xfd\x03\xbaD\xf6\x1b?"
+DATA ·d+2240(SB)/64,$"\xce\x06\xc8c<c Ng\xfe\xac?\x8a#\xaa\x90\xaa\x84\x7f\xf6\x1c\x88\x9e.\xcc\xfa\xcd8\x8a\xb6=\x103`k\xf4}\xe2\xd7\x19x\x5c\x92\xc7\xfc\x1dW\x09\xd5\xc5\xa8\xba\xcf?rlL\x07\x8fa\xe9\xd1Av"
+DATA ·d+2304(SB)/64,$"7\xba\xaa\xa6\x5c\x95\xb7\xe1\xe4W\xd9\xd8e\xe2\xe7\x22D\xf6fr\x9b\x01\xfdx\x8d\x191y\x98\x1c!\x5c\x93\xc97K\xe6\xd1aBw\x0eC\xd1<:\xec\x8a\xe6\xc5\xf9\xf9y(t\xdf\xc5\xe2dz\xdb\x19\xd5\xd7"
+DATA ·d+2368(SB)/64,$"\xc8\x81\x8f\x89j\x8a\xfa\x87\x8c{\xccO1f07C$\xeeT\x00\x1f\x97R\xebU\xb3\xa6f\xb6;9\xb5\xed\xb9\x9b\x9c\xfc\xf0\xd0u\xden\xd8\xea\xc7\xd5\xe7\xcd\xac\x1fv*\xd1\xb5Pm\xf3+\xc7\x9c\x9f#\x9f"
+DATA ·d+2432(SB)/64,$"\xb7\xc3/_\xb0z\x5cX\x1c4\xd3o\xf0\xeb;\xf2\xce\xb0\x81\x9d\xf7O[\xec\x8b\x11\x8c\xf53\xd5\x00\x12T\xe1\x19\x22\xddh\xfa\x1c\x9aR\x98\xff\x1d\x19\xbbo\xba@\xe9\xa50\x7f\xc1\x18J\xa6\x83]\x15\x9f\xb6;"
+DATA ·d+2496(SB)/64,$"\x03o\x18\x08\xca\x9c6f\xcf\x9b\xb1\xd7G\x1b\xa0'\x0a\xb23L-8T\xc2\xd2=Q\x85\xd2\xce\x93\xf4@[\xfau\xb5\xa9\xa5P+\xe4>\xf1YJ\xcdr\xaf\xb7\x07\x83\x12\xe2U\x89\xfc\x92\xd1\xfc\x95\xfeL\xe4"
+DATA ·d+2560(SB)/64,$"\xb3\xde\xc4(\xaa\x04\xb5\xa58\xf2Y\xbe\xed\xe4\x0f\x83#*\xf3R\x98A\xf2\xfbu\x06\xcf`\xf0\xe3G'pK\xb5\x03\xb8\xb4\xfe1\xfe2\x98zU\xdb\xb0ju\xc5\xdf{\xf4-s\xb4pG\x13\xda\xc8\xe0U["
+DATA ·d+2624(SB)/64,$"\xa9\xfa\x80+\x9f\x05\x1c\x93\x12\x13\xd0&)\x0c_\x91\xa4J\x9by\x16\xa6{\x1e\x9aD\x92z\x0a\xd2\xf9w&W\x839\xb4QE\xe2'\xf8\xbdS\xb87<\x0c\xe0\xf4\xa7u\xf47sr\x10\x81\xc15\x1e%\xdf1"
+DATA ·d+2688(SB)/64,$"ZU>r\xb9;\x11\xa7\x81p\xf8\xbe\xbc\xd6\x83\xf1\xd8\x8b\xfeVn\xbf\x04\x9e\xb0\xe9\x06\x15\x16\xf4\x19*\xb8Mw\x1e[v\xf06\xd5R^(\xc7\xcd\x1d\x93\xed\xd7\x8757B\x97_\xfd\x80b\x0b\xa6l\xff\xc1"
+DATA ·d+2752(SB)/64,$"d\x87\xc3\x0c\xfe>\x99\xc0+\xff\xf9\xe0\xbd\x90RX^hU\x92\xb8{\xe6\x8ae\x98q\x0a&\xa5\x05\xa5\x9d\xa86p\xbf\xe4\x8a\xdfq\x03Lm\xdc\x12\xdfe\xc2\x02+K^f`x\xad\xf1\xe3\x906\xe1\xe5\xc5"
+DATA ·d+2816(SB)/64,$"K\xe4\xf5\x95\x07\xb3\x0f\xa6\x81\xa0$\x88\xa00\xf0\xcf\x1c|\xef!\xb4h\x87\x7f\xac'\xa1}\xfb\x5c\x0f\x9f>\x8aU\xf2\xcc:\xef\xf9\xa21/o\xa3\xd3\xf0\x00\x9f\xd4\xc8\x9e\xfc\xe2E'm\x97\xa6\x83\x19\x92a\x22"
+DATA ·d+2880(SB)/64,$"x\x0f\xf4\x5c\xba\xb1\x8aA\x11\xda#z\x00\xbdj\xfd\xa4i\xf7??i\xd4bR\xb6\xdf\x08|\xa6|\x13\x9f\xa1\xee\xd4OO\x8eQ\xe3\xc2\x14h\x99\x7f\xd8\x5c\xf2\xfb\xa4{\xcd\x0c\x1eI\xafO\xc2h\xf5\xff\xcc\xb7"
+DATA ·d+2944(SB)/64,$"\xfd\x19V\x98bg\x96\xf3\xfd\x13\xe1\xfd\x8e\x17\xdb\x9b0:\x9c\x1c'\xdd\xc0\xfe\x9dw\xdfLw\xeev\x83}\xfeI\x89\x87K\xa6t\xe0\xd4\xeb\x17\x1e]_\xcfB$\xbdj\xea\x93\xe3\x04\xbf\x13\xfd\x17\x00\x00\xff\xff"
+DATA ·d+3008(SB)/64,$"\x03\x00-\xf9\xda\xd4g\x16\x00\x00\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\xbd\x7fw\x13\xb9\xb2(\xfa\xb7\xfd)D\xafu\xd8n\xe8\xb4\x03\xc3\xcc\xb9\xcf`\xdeb3a\x86{\x80\xe1\x12\xe6\xecw\x1f\xc3\xda[q\xcb\xb1"
+DATA ·d+3072(SB)/64,$"\x86v\xb7G\x92\x13B\xc8w\x7f\xab\xaa\xf4\xb3\xbb\xed$\xcc\x9c\xf3\xee^k\x0f\xb1Z*\x95J%\xa9\xaaTU\x9aN\xd9\xf3\xb6\x12\xecT4Bq#*vr\xc1N\xdb\x03\xb9>\x11U\xc9~\xfc\x85\xbd\xf9\xe5"
+DATA ·d+3136(SB)/64,$"=;\xfa\xf1\xe5\xfbr<\x9eN\xd9[\xbe\xf8\xc4O\x05\xbb\xbc,\xdf~:\xbd\xbab\xab\xb6\xae4;\x91\x0dW\x17L\x09\xddn\xd5Bh&\xa0}%*&\x1b\xd3\xb2\x9fZ&>\x8b\xc5\xd6\xf0\x93Z\x8c7\x1d"
+DATA ·d+3200(SB)/64,$"\x18\xe3\xb1\x5coZe\xd8d<\xcaZ\x9d\x8dG\x99l\xe1\xbf'\x17F\xe0\xcf\x0d7\xab\xe9R\xd6\x02\xfe\x80\x02\xd1,\xdaJ6\xa7\xd3\x13\xae\xc5w\x0f\xd3\x22\xc4\x05\x8b\x94j\x15\x02Xq\xbd\x9a.\xd4\xe2\x87G"
+DATA ·d+3264(SB)/64,$"\xf0K\xb7\xcad\xe3\xcb\xcb\x03&\x97\xac|\xcb\x15_\xeb\xf2\xef[YW?\x1b\xb3\xf9\x997U-\xd4\xb3\xb7/\xd9\xd5\x15\xd46j\xd16g\xd4@4\x15\x94\xda\xb6\xad\xea7\x7f\xa1\xa1\xe5\xb5P\x1ba\xa6+c"
+DATA ·d+3328(SB)/64,$"67\x05\x1b\xb5O\xbe\xbd\xd0\x1e$\x11\xa7\x0f\xee&#\x94\xcd)\x12\xaanO\xe1\x9f\xb5\x5c\x8b\xe9z[\x1b\xb9\xe1@+\xc2\xd7\x88\xcff\xa3Z\xd3&\xf4\xfe]\xb7\x0d\xd2\xd8\xackWs\xab\xea}\x98<o\xd7"
+DATA ·d+3392(SB)/64,$"\x1b%\xb4~\xa6\xb50\x9a\x90X\xd8\xb2\xe9\xe9\x17\xb9\xf9\xe6\xc6\xcb\x9a\x1bq\x13\x9a\xa6\xd3\xd4\x81y-\xc5d;\x95\xed\xd6\xc8\xfaZz\xbf\xe6\xb2\xa16\xcb\x9a\x9f\xee\xc2\xec\xa8Y\xa8\x8b\x0d,\xbf\x9bL\xfc\x10\x05"
+DATA ·d+3456(SB)/64,$"\xf4E\xb3\xb8=\xd5\x1a\xc3e#\xd4\xb4\x96\xda\x0c\xb6\x0e\x88Q\x0b\xf8\xd1N9-K\xfbk!7+\xa12\x8b\xc4\x94\x9bv-\x87q9\x96\xa7M\x07\x94\xa8\x1e~\xff\xfd\x83\xff+\x02\xa7W\xfc\xe1\xf7?\xec\xa2"
+DATA ·d+3520(SB)/64,$"\x94\x051\xe1M5@\xe8\x80oN\xbdx.]\x89\xcf\x09\xc8Qf\xe4Zd\xe3\x1c\xb75\xa4\x0aS\x02H$\x1a\xd3\xdb\xd0\x986\xad\x12\x15;\x97f%\x9bt?+mk\xb9\xde\xd4b\x0d\xad\x01\xe2rm\xca"
+DATA ·d+3584(SB)/64,$"c\x5cWB1@V\xb6\xe5?\x944B\xbdo\x99l\x8cPK\xbe\x10\xba`\x95p\xcc+\x9bS\xd7o\xc5\x0d\x87\x117b!\xb4\xe6\xea\xa2\x1c\x9b\x8b\x8d\xb0=i\xa3\xb6\x0b\xc3.\xc7\xa3\x86\xaf\x05s\xff\xa3e"
+DATA ·d+3648(SB)/64,$"\xcc\xa6S\xf6B\xd6\x82\xc1\xb7\xf1H\xcb/\xa1\x86l\xccw\x0f\x99\xaf\x81\xdf&\xdb\xc6! \xaa|<:\xa9\xdb\x13\xdf\xe0\xc3G\xd8\x83\xa1\xc1;G\x09\xfcN\xe5\xe3\x916\xea\x9f\xbeA\xe8?\xad\xcc5\xe3\xf6\xe3"
+DATA ·d+3712(SB)/64,$"\x0d\xb8R\xea\xe7\x1e\x1dv\xd2\xb65C\x84\x8d\xda\x0ah\x19\x8e\x98s\xaeY\xc0\x1c\xa7\x86\xc1\xde1\x1e-V\xdb\xe6\x93\xf6C\xd8\xd2\xb0\xa7S\xd6.\x97\xd8O\xbbd\xb2\xa9\xc4F4\x95hL}\x11\xc3\xb1\x8d\xed"
+DATA ·d+3776(SB)/64,$"L\x9b\x95@\xa0\x80\xbf\xe0k\xc7A;W\x88\xd4\xe1\xf7\x1e\xec\x11y\xe1k\x22\xee\xcf\x8e\x8e\x0f~z\xfe\xba\xdf\x05\xb2z\xb4CD\xabH\x0b^\x8b\x8a\x06\x1aMV\xa8\xac\xe2\x99(X\xdb,\x04\x8e\x89\x13\xcbj"
+DATA ·d+3840(SB)/64,$"\xb6m\xeav\xf1IT\x03#\x8b\xfa\xa9\xe4\xa9\xd0\xa6\xc7g\xc7??;x\xf8\xfd\x0f\xcc~n\x97\x08;\xe93\x82;\x82Se\x80[_\xbf|}\xc4\xde_l\xc4xd\xf8)\x1b\xa8\xf1\x9e\x9f\x02\xae0A\x8d"
+DATA ·d+3904(SB)/64,$"\x91\xbc\xae/\x18\xc7\xc26\x22)\xecf\xa21H\xae\x05o\xd8\x89`[\x98Pd\xbf3^o\x05[\xb6\x8aeG\x86\x9ff\xec\xe7\xf7\xef\xdf\xb2\x95\xe0\x95P\xe3\xd1\xba\xad\xde{\xdc`_(\xf1'\xe0\xd6Vr"
+DATA ·d+3968(SB)/64,$")\x17\xdc\xc8\xb6\xc1/n\x90\xb6S\x10J\x0a\x86\xb4lX%\xceD\xddn`\x0f`'\xb0'\xb1\xb6\xa9/\xc67\xd8\x8ba\xc7@\xc6;\x86%)5\xcd\xd1\xba\xdd6H\xd5x\x89\xfaq\xca\x86\x893\xa1.R"
+DATA ·d+4032(SB)/64,$"VFH\x1dn\x06\x10<.\xc5\xd9\x1f/\xdaF\x9b\xa8\xdb9\xfb\xe1\x11{\xf2\x84=8\x8c7J\x00\xf8\x06\xb6\x19%\xccV5\x84\x1a\x88]\xb8\xc18r\x10\xc4\xe5\xb6Y\xb0\x09g\xf7pd9\xb6\x9b\xe4n\x22"
+DATA ·d+4096(SB)/64,$"\xe9\x7f\x97\x16\x10\xe3%\x02\xb8\x82\x0e^\xcb\xb5\x00\x0e\xf0\x9dx\x9e\xd8\xdf\x81k\x17w\x12u\xb0\x96\xae\x03`\x16\x07\xdbmF\xec|%\x17+\xe4\x15-\xd4\x99@Ni\xd8\xb6\x91\x7fl\x05;\x13J\xc3\xa4K\xa0"
+DATA ·d+4160(SB)/64,$"\xab\x5cJ\xa1\x90}\xc2\xe2\x99\xc8R\x94\x85\xe5\xa7\xbc\x87\xda{~\xda\x1dz\x8c\x1ar\xfa\x0d8c:e/\xe3\x1d\xd1\xcf\x02\xec(0\xaf\x88\xcb\x8akv\x22D\x13Mr\x0f\xa1\x18\xcc$\xa7\xcd)B(\xd9w"
+DATA ·d+4224(SB)/64,$"\xaf\xae\x95\x05\x10\xafx\x9f\x89\xd0\x92=\xb4\xfcV7\x80\x95\x07\xe2\x90J\xb1\x8az\x8d\x91\x82\xdd\x87h\xeb\xbaN\x0e\xb2\x229=s\xbfh<\xc5\xa2C\xa9\x04`\xbfj\xc1\xde\x09^=\xabkfZV\x09#\x16"
+DATA ·d+4288(SB)/64,$"\x86-Z\xa5\xb6\xd8\xb9\x05P\xf6\x06@X\x84\xa9\xbe\xdcu2,\x19/i\xaf\x9d\xe4pv\x8f\xec \xb3l<\xba\xba\x9d\xe4&\x97\xdd\x09\x03xr\xc9t\xc1\xdaOl6g\x0b\xbeX\x89\x9f\x84\x99\xf0\xfc1\x14"
+DATA ·d+4352(SB)/64,$"\xc1w\xd7\xa1\x1e\x8fFW\xd4\x7f\xc1\xfe\x09\xb5y\x19\xa4\x90I\x1eP\xa3!M\x940y\x07\xc7\x91\x9f!'\x02\x8ci\xab\xf8;\xe8l\xc3s\xb2k\x16\xe8\xdc\xfas\xb3\x80\xddNr\x0b\x0b\x86\x1b\x8f\xce\x82\x84\xa1"
+DATA ·d+4416(SB)/64,$"Y\xbc\x950\x16a\xd7\xdd7\xa0\x5c\xd0I\x00\xbb\x18\xd7\x84B\x01 O\xb6(K\xb6\xca\xe0\x86\x82j(\xcc\xa8\x83\x05;N\xd3\x1a8\xa1\x02\xddE\xd5\x1f\x95\xc7\x9bM\x5c\x8f\x08,\xbf=\x975\xb2.\xd8\x91R"
+DATA ·d+4480(SB)/64,$"\xaf\xf0\xdb\x7f3\xc7\x11\xf2\x13\x9d\x17\x80G`?\xe2\xa0\x94\xf7zl\x06\xc0\xd7\xfc\x93\xf0$\xa8E3\xe1%\xf0\x5c\x9e\x8fG\x8bvs1\xc1\xc9\xb6e\xf1\x1cS\x7f7<\x80\x8f\xac\xae\x90\x9ct~\xce\xe8\x93;"
+DATA ·d+4544(SB)/64,$"\xfdI\x1d\xb0\x1f\x0b\x98~\x945d\x03\x80\xb2\xe7T~\xe0 &\xe2\xc6\x8ce\xa8\xe2\xe2q\xd2=\x94u\xc1\xb2\x8c\xb5f%\xd4\xb9\xd4\xa2\xcf\x12\x0ed\xbc\xe1\xec\x98\x1d\xb7\xbf`o@\xd8h\xc7\xa1\x11\x87\x06\xef"
+DATA ·d+4608(SB)/64,$"\x10\xb5d\xe4\xe9\x18\x93s\x18\xc6+u\x81rk\xbb5\x00*L\xa2l\x9b\x19\xee\xad\xbc\xa9\xb8\xaab\xf1y\xd7\x90\x01\xf0\xa6\xe6\xb2q\xbd\x01DO\x046\xd1B\xf8\x81\xe7%\xb3\xe4eR3%x\x05\xc0\xb9<"
+DATA ·d+4672(SB)/64,$"]\x19\xb6T\xed\x1a\x81E\xdaY\x8f\x82\xddAOr\xd0\xcd\xe0\xef\xe7u\xab\x85\xba\xfd\xda\xc25I\xc0.\xfd\x12\xbb\xda\xc1\xcawq\xd0\xb66\x15\x97\xef\x84\x86\x85\xd3\xe7\xdf\xf1\xd5u\xf6\x85w\xfc\x1c7\x1ek\x9a"
+DATA ·d+4736(SB)/64,$"\x80\x0d\xcd\x96D\xf2\x8e\xe2\xe7\x0c\xf7E]\xcbE*R\x95\xec\xf9\x8a7\xa7\xc0H\xd1LS\xbds\x89\xfb\xa2\xde\xd6\x86\xecxZ\x9c.\xf9\xb6\x1e\xd8\x7f]\xa7\xdd-\x98V\xb8=\x1f:R%\xc9\xba^kf\xad"
+DATA ·d+4800(SB)/64,$".A-}\xd9,[\x14\xe2\x13f\x94_R\xbc\x07\x8e\xfb\x9d\xa2P_8\x83\xaea\xde\x1b\xf3\xc3\xa3\x9ep\x86\xa5\x13^B\x9f\xb9\x95O\xdbj/\xaa\xbc>\xe7\x17\x81\xe2\x87\x8f\x1e=\xea\xcb\xaam\x05}\xda\xa6"
+DATA ·d+4864(SB)/64,$"\xf0+\xea\x13Z\xf8\xaeP\xf9\xb8!aP'\xd1\x86\xaf7\xec|%@a\x95\x9a9\x0b\xab\xa7\xc5F\xb5\xd5v!*6\xf1'V\xd0\x88x]\x07\xba\xea\x1c\x8f\xb0V\xb1\xf5\x0dT\x9fA\xadgh\xe40\xa4I"
+DATA ·d+4928(SB)/64,$"\x1e\xe9V\xb4k\xdd\xe1\xa5\xd5\xbd\xca\x97\xfa\xff\x15\xaaM\x17\x96\xff\x1a\xef^8\xd8\xb1\x95@\x7f\x94\xea&\x94Z\xf2Z\x8b\x01\xd9\xf3G\xa9\xbc\xd4\xd9\xe1\x02lBSr|\xa1o\xd2\x09\x1c5=F\xbb\xd0\x93<"
+DATA ·d+4992(SB)/64,$"\xd8{.\xaf\xe2.8\xa3\x85\x80v\xa1\xf7m\xdc\xc7\xa0\xb5\x08{;\x87b=\xbc-\x9b\x96\x9d\xf7P\xb0\xd0'\xe7\x01h\xce&\xc8\xe4\xdf.N\x1c\xfe\xff L4\x88.|v\x03\xb1\xb2\xf7y\xc1t\x1e\x89\x1b"
+DATA ·d+5056(SB)/64,$"\xb4\x80\x9b\x1c\xeb[ic\xdb\xc09\xe4!\xc0\x8f\xf2\x8d8\xb7G\x00\xde4D\xbf\x83x\x01hA\x9b;s\x98\xdfD\xaa9\x8c\xe1'\xc8=\x07\x99\xe4\xbc`\xd4i\xee\xbb/\xf1\x84\x89%\xec\xc6\xc2H\x8f\x8a\x00"
+DATA ·d+5120(SB)/64,$"\xec\x9c\x06\xda;\x1b\xd2!Z\xf9E\xa9\xe7^R\x96n7\xa2+\x9d\xff\x14J./\xc2.\xe9\xd8\xa7j\x85FYt\xcd\xcdbe\xcd7\x8bVU\xa2b\x86\x9f\x8e\xcf\xb8J\xe1\xce\x89e\x90X\x93l/0i"
+DATA ·d+5184(SB)/64,$"4#\xdd\x18\xc1L\xc6\xa3\x93\xef\x1e\x1e5\x0b\xc6\xd8\x9c\xd1\xc5\x0d@\xf1\x12M\xc6O\x16\x95X\x9e\xae\xe4\xef\x9f\xeau\xd3n\xfeP\xdal\xcf\xce?_|y\xf8\xdd\xa3\xef\x7f\xf8\xf7,/\xff!\xcd\xea-\xaf\xb0"
+DATA ·d+5248(SB)/64,$"\xbe\x03\xd1\xda\x02\x10\x06\xd5\xe2=\x9c\xfal\xce\xf0\xb6\xa7|\xcd?\x09,\x99\xd0\xef\xa3\xe7\xaf\x9f\xe5\xd6\xe8ki\xb2X\x89\xc5'\x8d\xab\xecTIs\x91,\xa9Y,\xa1\xeb \xf0\xc5\xdae\x01\xeb\xd2\xd9]\xb8\x12"
+DATA ·d+5312(SB)/64,$"\x1aGN`\xb7k2\xeeu\x09[\xba\xde\xdd\xe6\x91N\xde\x92\x0cB\x16BJ\xd7\x82\xb5*\x15\xb7hJ\xfa\x872u1\xc9\xe9;\xf0\xeeB-py!-`\x06\x1d\xc1\x80\xb3\x82\xae\x04B\xd1xT\x89\xa5P"
+DATA ·d+5376(SB)/64,$"L\x05\xa6\x95K\xf6\xcf\x1e\x9b/\xd4\xa2`*\x7f\xdc]%A6\xc2\xcd\x1b8\xe0d\xbbd\x1f\xfe\x875\x1d\x93\xa5\xbb|%\x8d\xa9\xc5QSI\xde\x94o\xb7\xe6W\xe2\xec\x93\xed\xf2\xc3\xecc\x01\x98\x96\xc7\xdb\xf5"
+DATA ·d+5440(SB)/64,$"\x0f\x8f&9!@,T\x22\xd3\x88\xf7\xad\xdd\x01\xa8z\x0e\xfd\x93i%\xc2 \xa6l|\x8e\x90f\x10\x18\xe1\x08\x89T\x09\xbdP\xf2D\xa0\xe6F\xec\xbd\xe4\xb2\x16U\xc4 81d\x90\x8f\x9b\x06\xb3\xfc[nV"
+DATA ·d+5504(SB)/64,$"\x91\xf9\x12\xa7\x83\xc1\xf5\xdcxt\xa4\x14\xb3\xf3\xc1h\xcd\xb6*Y\xa9X\xb9$\xb8\x80\x1fM\xaa`\xf7\xa2\xaerj\x17\xe9\x00\xcc\x91\xbb\xc4\xbe\xef\xb3l\xc62v\x9f\x89\xf2H\xa9\xd2\xd5\x8e\x87\x0b:\xef\x10\xeb\xa7"
+DATA ·d+5568(SB)/64,$"\xd2\x805MG8\xc5G\x1e@\xe3\xac\x96d\xfb\x8d1\x04&\xad\x84\xa2!q?\xfe\x22\xb5\xa1iG[(Ad,\x13{\x14Q\x9e\xf4\xec\x0b\x22\x87F6\xadk8\x82Qa\x04+\xa2\x8e\x94D\x22I\xc1\x0e"
+DATA ·d+5632(SB)/64,$"IS\xc46\xc0<\xd03\xd4\x85\xaa\x8a7\xa7$\xc1hd\x15\x821g|\x03\x86\xd4\x09\xfe,\xb06j\xa4#\xdd*w\x9d\xa3\xe9kN,-\x94\xd2\x0eC\xea\xe2\x9fE\xa7\x17\x82}\x19\x8e\x92\xd9\x9cz\xfe\x00"
+DATA ·d+5696(SB)/64,$"_>\x96n\x95\xf6V\xd0\x08\x81{\xa4\xe0W\xc1\xeeFD\xbe\x84\xb9\x9ea\x07x\x1c\xcf\x00\xc2UN'R`th\x08\x9c\x84\x0c\x1bi\x1f\x11\xc3\xd2\xf9G\xc5\x81\xe9\x14\xbb\x17U\xcf\x99\xdd\x08\xc2v\xa2\xac\xe6"
+DATA ·d+5760(SB)/64,$"\xd2\xc8:\xef\xae+\xec-\xd2\x8c\xa2\xde`\x9cD\xb1\xa8\xab\xa8*\x19>\xac\xbeO\xb2\x8a\x97TXOP;,\x98*\x01\xe4\xd5nX\xcf\x8c\xb7\x1e\xe0\xfe\xd2\x01zcX\xc7B|r\xa2\x93lLO\x8e\xba\x0d"
+DATA ·d+5824(SB)/64,$"^)5\x07\xff\xe7a\x01K\x5c\x85\x9bJKQ\x89\x9bT\xbb\x11\x8d\xd3\xa5\xbd\x81\x8c\xfd\xda\xd4\xf2\x93\xe8h\xb6n\x9fA#\x95\xdbj\x08X\xc1\xa4\xb1\xa6p\xf1\xc9\xaeq^1n\x18W'\xd2(\xb8\x95\xb4\xd7"
+DATA ·d+5888(SB)/64,$"g\xf1]\xa4\xc3\xc4\x8b\xa8 \xd7\xb7\x8e\x93\xc2\x9f\xcf\x0c\xfe\x00\xfa\xd9rB\xc9\xee\xc0\xbflD\xe3\x8f\xc2]\x16\x87\xa8\xc3\xee\xb5\xa94\x9d\xdbR\xb0%\x0e^\x9a\xdc\xc0d\xe0\xcc\x1a\x0cLKh\x1f\xee\x1e\xac\x80"
+DATA ·d+5952(SB)/64,$"\xee$O(\xf0_c4\xf8VY\xda\xf6qw\xd1\xb1u\x5cZ\xa1\x86\xffU\xd6\x09gA\x85\x19\xbev\x02\x91\x01\x16F\xb6\x8d\x9b\xc5p\x03s\x22\xd8\x86#\xfe\xa6E>\x7f\xfbR3\xbd]\xac\xa0!W\x8b\x95"
+DATA ·d+6016(SB)/64,$"<\x13\xd3Dh/o1\xc3\x00q\xff$\x17l\x18Vl\xa0em\xc3*\xb1\xe6\xcd\x0eS-\x10a\x92\xb3{\xddq\xde\x9e9d\x0b\xe3L\x80L\x86\x19\x06\xcf\xb9\xc4b\xd1\xb3\xa1\xc29\xa5X\xb4\x14A\xfc\x1e"
+DATA ·d+6080(SB)/64,$"Vx\xbe\x9d\xe1\xd8\xfc\x96\xdc\xb6k\x98jhD\x8e\xd1\xae\xe5/\x02B{\xcb\xaeI\xfao2\xf8}+)\xff\x0b4\xd5\x18_\x10\x11R\x0b<u8\x1e]1Qk\xc1.\xe3A\x8cvm\x0cC;C\xbc5"
+DATA ·d+6144(SB)/64,$"\x5c?\xfa\x84XW\xe3\x1b^\x92wX,U\xcf\x0278\x16\xd1\x86+\x03'\x84\xdf\xe7\xf1\xbe\x9b@\xa1\xf7\x13|\xc4\xf2\xadR\xd0b\xd3j\x09\xecX0\xdd\xe2aH\xa6Qm4\xe3\x86\xad[mX\xdbX0"
+DATA ·d+6208(SB)/64,$"\xcc\xb4L\x7f\x92\x1b{\x22\xf6\x90\x0b\x22\x0fae\x19q<\xda\xb4\xda\xfa\xe3\x04\xfb#\xa8\x03]$\xc6\xa3/$\x1a\xa4\x0c\x9b\xd8\xdb[\xe5\xab\x0b<\xb0\xbflZM\x06\xd1\xe6b<\xfaB}aW\xe3\xd1\xa2n"
+DATA ·d+6272(SB)/64,$"\x9d_\xcd8r;\xe8\x98\xff\x13\xe0\xc9*stM{$\xc1\x00\xfeA\xe3\xa1\x02\xb6@\xaa\x7fQ\x88H\xd3\x9aA\xebX\xd4\xf7\xe4\x8bJ\x07Y\x00\xb8 \xad\xa5\x9f\xbc\xd9j$Q\x17m\xcc\x04jGN\x14\xa4"
+DATA ·d+6336(SB)/64,$"-J\xf6tn\xef\x8b\xf0\x8b\xee\xdf\x89\xc9\x16\xd4\xa3_\x1b\xf1y#\x16FTG\xbf\xbc \x91\x9fT\xe5\xe1\xf5\xf6\xc1\xc1\xfb ?\xce>R__\x14\x9b\x87\xa5\x07\xbf\x18z#F\x8d\xb5Z\xe4~\x9d\x05m\xe0"
+DATA ·d+6400(SB)/64,$"\x8b*'T\x15\x17\x94\x11*\xa7\xbf\xa0\x01^a\xedT\xafq\x08N\xc7\x1e\xd2\xcf\xdfL\xc8Q\xb1\xfcQ\xea\x05WU\x81s\xd2.\x97\x07\xb4\xc5\xca\xfc^\xa0\xd9\x8dz\xb1e\x00\xc6\x8a\xfa^[\xe8.\x00+\xc8"
+DATA ·d+6464(SB)/64,$"o\xd8\xa0(O&`UZ\x9eL\xad\x8b\xad\x86i\xc1\xf9\xae\xdc\xe0T\x09\xbc\xfctnO\x07U\xd2\x0d\x06\xd9\xe9\xd3\xe60\xab\xbf\xbc\x08\x0d\xc3\xe4|\xfd\x0a?\x01\xd0\x9d\xb9\x85H\xf3\xe5)\xe7\xe0\xc6\xdc\x09\x10"
+DATA ·d+6528(SB)/64,$"\x0a\xaa\xbek\xab\xc5>\xec\xfd\xe6\xa0\x89\xd0\xc1\xc0\xce\xe7\xcc\x03\xf4X\x0am\xd8lxt\x07T\xf5\xb1\xfd\x08,\xbd\xc9s\xf6\x94\x1a\x01\x02\x1b6g\x9b\x0f3\xf8\xfdq<J\x8c\x88v\xf1\xbc\xd8\xd6\xb5\x1d\x08\xd8"
+DATA ·d+6592(SB)/64,$"$i\xec\xf7\xe7\xde\x968\x1ey\xdc,^\xfda\xc6\xa3\x0c\xbc\xd0\xc4fH\xd2\xb7:\xc6l'z\x94\xec\xa5a\x8d\x90p\xb7\xc7\xb6\x1a\xedZ\x8a-\xe0\x0a\xca\xee\xdfv\x0b\x04H\xc9V,Q\x1c\xd3|)\x98i"
+DATA ·d+6656(SB)/64,$"\xd9\x82\xd7\xb5\xebi\xd16\xb6Q}Q^\xc7\x8c\xcf\x8cg\xc7\xce\x0e\xf3g8\x13\x00=a\x87\x835_6g\xbc\x96T\x15&s\xc7\x14{8O\xe7t\xdb\xb5\x93\xa1\xafaU\x5c\xac\xedr\x99\x0f\xce_\xca\x97"
+DATA ·d+6720(SB)/64,$"W\xe3\xd19o\x90\xeb\x88\xa5\xb0\x0da\x07\x1f\x80\xc3\x00\x99\x03@,\xe22W\xb6\x8f\xd3\x1c\x9fY$\xec\xf2\xbb{\x975\xec\x09\xc3^\x01 ~\x8aG\xd7c\xa9=\x13\x8a\xea\xb8=|\xac\x22\x0e\x97`\x0b1\xa8"
+DATA ·d+6784(SB)/64,$"\x9c\xdfnV\xf5\xb9\x04#\xb6\x85\x07\xb6S\xae\x05\xb3:\xec\xb1\xe1\xca\xcc\xd2\xb2\xe7\xc4\x83\xb3\xf1hdQ\xba\xef\x17R\x5c\xef\xa8\xa9\xd2:\x83\xccP\x09\xbci\x9d]\xc3P\xc43\x00\xe8&\xecGK~n\x9bx"
+DATA ·d+6848(SB)/64,$"Z\xd3\xcf\x9bl\xe7=\x0b\xd0\x0e\x82\xf6\xa8\xe9+\xcd\xd1El\x9cl$=\x83l\x90?\x06D\x92\x1d>\x09\x05\xa3[\x00&\xf1\x1e\x0c\xca\xf1\x16\xa9't\xc4\xee&\x03~5\xdf,\x7f\xef\x5cl\xc9\xe9IF\xf5"
+DATA ·d+6912(SB)/64,$"\xeeuP\xc5\x0d\x8f\x16\x11\x1e\xd8\xce\xf9\xc7_\x22\xdd\xac\x03\x1c\xf4\xdb\xad\x99\xf0\x02\xdd\xc8\x83\xc6N\x9d\x04*?\x87\x9a\xc7\x86\x1bm\xa3x\x80f\x83tF\x98l\xd1n\x1b#\x94&a7j\x1d\xc4\xdc\x9f\xa5\xd1"
+DATA ·d+6976(SB)/64,$"\x8c1\xb6%\xc9\x16\x5cG\xb7\xeb\x13\x81Bd\xdd\xb6\x9f\xb6\x1bM\xbe\x96U$\x90\xe3,\x8d^K\x14\xe1\xf76%s\x81\x12\x7fl\xa5\x12Uz\x092\x1e\x1d5FI\x81\xe6l+N\x07\x08\xd8\x89s8\x19\x8f"
+DATA ·d+7040(SB)/64,$"\x8e\xadW<\xf5\x85.\xc6\xad\xe1\xb5w3\xb0\xd5\xed\xf8\xc7\xa3Wr-MR\x1f\x87O\xf5k\xf8\xe8M\x9e\xd8\x14P\xb9\xe8\x8b\xff^\xfe\x0f\xea\x09z\xc5[I\x1cA\x92Q\xef\xd5\xbb_\xed\xefv\xb9\x93\xf7{"
+DATA ·d+7104(SB)/64,$"\x9e4x\xcfF\xcd\xe6Q\xef\x10\x96Q\xbe\xde\x1a\xf1y<\xaa\xe3\x91\x84\xf0\x00\xfbs\x95\xcc\x1fxl\xc7\x932\x1e\xd5j\x0b\x9f\xd9\xbdZjS\xbe\x92\xda\xb0\xe94\x1e2z\x15\xe8\x82\xf4$%\x16x(\x93o"
+DATA ·d+7168(SB)/64,$"\xd4R*m\xc6#agi\xcd7\x1f\x88\x1c\x1f\x09\xda\x11\x89\x0b\xe3\xabK\xecg\x86\x1d\xe1\x17\xb8\xa8\xca\x0b\xdftF\x96\xfe]\x00\xf2\xc2\xd2\xf3X\x18\x9c%\x9a<\xd1\x80\xd9G#\xb2\xd6\x93\xeb\xc6tE\xad\x86"
+DATA ·d+7232(SB)/64,$"\x98\x8f|\xb3\x1b\xa6\x04\x0e\xea\xe4\xc2:\x80\x16\xd6\xff\xcf\xf9\xec\x15\xfeV\x9f7t\xbfA.`\x14\xcd\x83\x00\xe1\x03N\x07\x82df\x0f\x07\x96\xec\xfdJ\xb0Z\xf0\x1eU#\x87)\xa9\x998\x93\x0b\xe3h]2\xf0"
+DATA ·d+7296(SB)/64,$"\xa8\xa0.\xc8\xf1\xc3\x1e*9\xab\xa4&j\xf8\x05\x88\xd8,\x95\x10\xda\xb3b\xd4{JJ'\x87\x917\x97\x13\xbe\x00\x1f+~%\xd5'\x84\x80\x95\xb3.\xed\x0eU\x82\xd1$\xdc2R\xd9\xafMmK\xe5\xd2\xe2\xed"
+DATA ·d+7360(SB)/64,$"\x8e5\xfa5g\x87a\x93+]\x19\xfek\x0b\x8f\x80\x02\x93\xbc\xbb\xc5Im\xe4B\xa7\x9e}\xe9\xa6\x86\x88w\xeaO\xf2P\xa2o\x8a:\xf5\x115\x04\xf4acD\x8e\xa6\xda\xb0\xce\x8a\xf1\xc8nz3WL\xcb\x0d"
+DATA ·d+7424(SB)/64,$">\x1c9V\x07\xb1\x8c>Z\xee\x87\x85\x80\xfbW\x04\x0e\x98\x06\x8a\x91\xde3_\x8ct)\x80`\xeeT\x0f~\x15\xfe8\x9c\xb8\xfb2\xb0\x09\xdcf~\x929\x98\xa7\xd2G\x96\x15\xd6\xe7\xc6\x0a)\x22\xf1\xecpc\xf9"
+DATA ·d+7488(SB)/64,$"\xc0?z\xf7\x8e@\x98\xfb\xf7\xfd\xcfZm\xcb\xd7\xed\x99x\xdf\xbePmc&\x22\xb22\x89\xf2?a\xb3)'\xf7\xc2\xfe\x93\x97\xfe\x86\x9e\xc4\x0c\xcf+DZ\x00\xddC1\xda}_H\xa3\xbd\xeb\xee\xf9J\xa0\x9a"
+DATA ·d+7552(SB)/64,$"2hn\xb46kZ(\x11q\x01BD]\xf2\xb2\xbf\x15\xe3\xc4t}\xca\x0eAbN=\xd2\x9e\xcc\xe3:\xe9\xdc\xe2\xc1o{\xa7\xf3\xdf\xeb\xde\x7fbf\xbf~\x8d\xf4N\x14*@1\x88\xab\x85\xb9\x8f,\x11\xd7"
+DATA ·d+7616(SB)/64,$"\xccxT\xbf[\x87\xf9!\xaam\xf9v\xabW4\xfdw\xc3L{\xdbr\xe1\xe6g\xe6\xdc\xd5\x11A\xb8\x06\x0d\x8b#\x88\xd9a\x04\xfd\x0d#\x90\xd1\x16\xe2\x9ds\xab\xa2U60l\xc1f1\xb6\x7f\xe7DG<\xad"
+DATA ·d+7680(SB)/64,$".\xe0\xdb \xa3&,\xfeN\xac\xdb3A\xdc]\x89Z\x18\x91\xae\xf9\x82!0\xd2\x10BSD\xe8 \x1e\x19U\xb3\x04\xa1\x9b\x80\xd4\xedr\xd0\xd0m\x9d\x87\xc8\x8c\x9dx\x0e\xb5\x0d\xe3\xc6\x88\xf5\x06ej\xbcW\x89"
+DATA ·d+7744(SB)/64,$"\x1d\xe2\xa3\xc0\x0e\x1b\xa0\x03\x17\x8bb\xd9*\xc1\x88\xa3\x22wL^\xd7\xe0\xe3n=\x8algC\xeeDR32\xbaG\x9eC\x14\xa6\xf6z\xcbbY\x86\x0a\xc95\xe3\xbb\x87\xd6\xab\xc7\xf6[\x09DLw1\xd4\xc1"
+DATA ·d+7808(SB)/64,$"\x1fGo7\x9bZ\x8a\x0a\x22\xef\xd8'q\x01\x17JF\xd6\x16\x02\xc0\xd2\xdb\xc5B\x88J\x17\xf1\xa8{\x00%9\xe7\xf03.k8Ugx?\x98\x08\x00d\x15\x05\x85\xc1\x117\xd0\xa0\xe8I\x07\xb0\x03\xd5RX"
+DATA ·d+7872(SB)/64,$"T\xbf?\xfc\x8e\x1d\x0bu&\x17@T\xdf\x8b\x93Ij\xe1\x02a\xe0\x04\x8f=\x7f\x19\xcc\xdbEr%\x0c\x94\x01\x97\x0b\xed\xaf\xbf\x88\x86 \x84\xa2DraP8\x92\xb4\xd5}\x12\x17]\xa7.\xde\x5c\x0c\x11\x01}"
+DATA ·d+7936(SB)/64,$"\xa0|\xdd\x95\x85\x87\xe1\x86\x96\x88>\xbe\xc1n5\x00\xdbmM^\x9dt\xd3\xdc\xd9\xa3|q\xb2MQ\xa0q\xf9\xaa\xe5\xd5K`\x80\xc9]\xc7\x10\xe8\x08t\xd8Q\x91p\xa39\x81\x0a^\xd3\xe2\xa4\xca=\xc7\x00f"
+DATA ·d+8000(SB)/64,$"\xc0h\x9f\x96\xe5\x14,.x\xe5!P\xec3\x00\xf9\xe9\xf9\xeb\x09B\xbf\x09\x0crv\x9f\xcd{\x92\xac\xa5H\xf0V)\x18\x0f\xae$\xc1\xed\xc5\xf9\x93\xdcIC\xa4\xa0\x14\x95\x0b\xd9\xc0\xe97\xba\xa2ZQ\xc4\x04{"
+DATA ·d+8064(SB)/64,$"\xc2`\x00\xe5\x1b\x98\x1b\xeb\x06\xdd\xbb*\xb2\xcbq\x8b\x223\x05\xe2`\x0f\xf6\xec\x03\xc7\x22\xc0\x8d\xdc\x8c\xa2\xcbR\x1f\xa8\x93Y'\x94Q\x03\xdd\x90c\x19\xda\xcdg\xdd\xce?\xc2\x96\x17k\xbfX\x01/\xd8Q\xadE"
+DATA ·d+8128(SB)/64,$"\x00.\xb6\xe3\x03\x0c\x04Krp\x0e\xb3a%\x80\xcaM.\xc0n5\xaas\xd56\xa7\xb8\x00Z\x15\xc6\xe5\x06\xeb\xc7\x87\x13I\x07\x16\x8c\x02'\x17\xa6\xce*\xdfa\xea\xb0\x22\xbb\x1c\x0a\xae\x1d\xf1\xd2\xc6\xf1:2\xa5"
+DATA ·d+8192(SB)/64,$"7pT\xe6{\x18\x85\x98+6O\xce<bOZ\x15\xc7\xa6U\xa2\xb3,\x0a\xf6\xa0\xe7\xa6\xd3\xb5\x8e\xf8\xebN'\xb9\x0c\x86\xe2\xdd\xbd\xbbw\xf5\x81\xd0\xb0\xe3\xe0\x09\xe3\xb6\xf1\xa7Z\x9e6\xdcl\x95`s\x96]"
+DATA ·d+8256(SB)/64,$"^\x96\xc7\xee\xf7\xd5U\xe6N\xa6\xbf\xf3\xca\x17\xef\xf6l\xc5\xc0\xd4-\xec\xa0\x11P\xb7%\x01\xa4\xe0\xe3\xba\xd9\x9e\xd4r\xe1\xa6\x17J\x9c\x13\x1b\xf1\x02\x054k\x7fZ%\x08\xa4g\xd6\xce\x1e\xa9\xbbl\xc8\xd7\xd4\xac"
+DATA ·d+8320(SB)/64,$"\xe2\x06\x89\x5ci{&|\xd6\xbc\x12\x8c\x1b\x97\x9dE\xb6\x18^\x84\xbe\xf8\xfe\x14\x0bC)\x9c\xaa\xd9\xb8~\xfa!<\x141\xec\xcfj~\xca%L\x824\xda\xf6<\xe4\x98\x9a\x92\x7f\xd9A\x1f\xc6\x0b\xb0R\x07U\x1f"
+DATA ·d+8384(SB)/64,$"\xf7\xd6\xf0\xb5\xbbbE\xad\xd4\x8e\x13\xf1\xa7\x10\x83^\x5c\x1fa0\xd9lO\x98M\x0fQ\xbe\xc5Q\xfe\x87\xb8Hm\x8f\x958\xc3(\x98\xde\xe5|,Ph\xc6\x95\xe8\x99\x9dl\x14C%\x95X\x98V]\xf8\xb8q"
+DATA ·d+8448(SB)/64,$"\x1b\x99w\x06HH\xda\xcavE\xfb\xdf\xf8\xb8\x8a\xd7\xf3\xffyN\x8ck\xde\xc8\xa5\xd0\xc6^v\xfe}\xbb\x5c\x8a\xeb\xbd\x19\x89_\xfc\xbe\xbd\x12\x9f\xcb\x1f\xc5\xa2\xad\x9cW~\xec\xe3Hu\xf7\xef\xd1\x1dF\xb3\x1b\xac"
+DATA ·d+8512(SB)/64,$"\xc3-q\xf8\xb7c\xea|\x04\xd9gr\xd8/\x9f\xf8\xde\x81\x08\xf2\xd4\x86\x85\xf6\x10\xf6,m\xed\x0fp!\xb2=AY\xa2\xc7\x86h@\xfc\xfa\x95\xddq_\x02\xcf\x16\x9e\x9e\xa5\x0d\x89*`\xb5\xe4\x1d\x9f\xe4t\xac"
+DATA ·d+8576(SB)/64,$"W\xd7\x92\x9bw\xfcF\xc7#J\xd51K\x0e\x8d>\x8f\x92\x97\x89=`b\xb2\xdb\x03\xc4}Cz'\xe7\x0e\x91\x0d:\xa0\x94,\xe0\x88\xfd\xf0\xfb\x1f&\xce\xdbC.\x91\x86\x1dWlj\x15\xbc\xb1-\x94]\xa7q"
+DATA ·d+8640(SB)/64,$"/\xbe6>\x86\xbb\xc2\xa7\xa6\xc3\x83@f=G\xd7\x10\xeb\xe9N\x1d\xd2\xe8j\xc1\x1bp\x95\x9dl\x82_v\x1c>\x89\xc5D\x5c\xf8\xb3|\x0e\x0d\xb02q\x82\xff\xf0R?;\xd1\xf4\x81n\xc3\xa8!\xfc\xf3\xc1-"
+DATA ·d+8704(SB)/64,$"S\xac\xf8\x9fm\xbd]\x0bL\x7f\x80\xb5\xf3\xd9\xc7 \x89Q\xfb\xa7\xa4W\xb7\xba|\xa9\x01\xb9c\xb1\xe1\x8a\x9bV\xe1\xf7\x0f\x87\x1f\xa9\x8b\xa4\x8f\x07\xb3\x8fv\xcc\xde\x85\x80>\xcfYVf\xfd\xa0r\xf7\xcb\xe3\xf5\xbe"
+DATA ·d+8768(SB)/64,$"=\xae\xb9^\xd9\xb1\x05\xdfK\x1d\x0577\xa9\x1fF\xe9\xdd\xae\xe8R\xe7Mk\x8e>KM\x0e\x97mH$\xb2l\xb7\xe0\x177\x14\xa3\xe8sT\xe1t\x90l\xc7\xd7\xc2\xce@\xce&/0\x8bF\xb8\x82\xb1h\xbf"
+DATA ·d+8832(SB)/64,$"8\x9e\xe4\xa5\xaf\x9e\xbb\xb9\x85\x91\xef\x01\xb6\xd3U\x04\xab\xcd#v\xb0\xdb\x88\x0b\x8aq\x06\x0b\xbam\x00\x9c\xa8\xc6cv'\xb1X\xd0}KJ\x8d\x1d\x8b/\xcc\x13\xf6p\x93\xc8\xecx\x09z\x8f,_\x99\xc0\xe0\xa8"
+DATA ·d+8896(SB)/64,$"]0\xf5U?\xb2\xf2'<\xf4\xa0*HH0\x880\x8b\x8d\xac\x93\xa9\xb3\xf3\x86\xd4\x04\xcb`BL\x92\x0a\xeda\x8b\xc6\x85\xddDJi\x84\x95\x87F\xe0q\xb6\x88v\xf1|\xcb\x1b\xb9\xd0;Q|\xbd\xd5\xff\x85"
+DATA ·d+8960(SB)/64,$"8n\xa0\xf3I6\xb0\x135\xad\xc5#\xb3V\x15\xba\xed\xf1\xe2\xc3pf&\xc2q<\xaa\xa4\xd2\x90\xa0'\xad\xeed\x81\x0f\x1f\xe9\xe7\xd58\xcet5\xb8\x82\xd05\x96it\xae\xc0$N\xc7\x17\xda\x885\xe3'\xda"
+DATA ·d+9024(SB)/64,$"(\x8e~\x94\x84X\xf4-q\xce\xbef\xf5\x8dG`\xb2\xeeT\x88\x02\x1eC=@D3\x19m.\xff\xe0\xf5\xa7\xf1\x08\xfe;Qm\xeb.\xb7\x0av\xce\xebO/`\xee\x92\x9aPb\x85\xb9\x9d\x89\xea\xfc\xb0\xed}"
+DATA ·d+9088(SB)/64,$"\x9e\xe3aH\x9cW\x0e\x8e\xd0\xb4l\xab\xadt\x8c\xb5\xc0r\x02\xc2\x0c\x82\xf3-&y\x17F\xc7\x19\x11n\x04W\x82\x81\x07\xd5\xfb\x96\xad\x85Y\xb5\x15\x13\x9f\x91\xc6\x1acd\xd6\xa2\xb1\x0ep8\x89\xd0\xc2\xb4\x8c3"
+DATA ·d+9152(SB)/64,$"\xbd\x11\x0b\x12j\xeb\x96\x82g\x0b\xf6I\x88\x0d\x9c5~\xfa-\xa3l\x15\xa5\xbdx\xb9\x0c\xc6\xa8%\x86\xd5j\xc6Cm\xb0\x10\xf1\x86IC\x11\xd9'\xc2a\x22\xacai\xb1UZ\x9e\x89\xfa\xa2t\x18#\x01\x9a\x96"
+DATA ·d+9216(SB)/64,$"\xa0\x05T\xb1\xbdm\xec|\xa2\xcfWm-\xbaFn\x9f\xbf\x11\x07\x87\x14*)c\x00\x82w\xca\x81Om\x01\x86r\x8b6v\x19\x8cl\xc0I\xc0N\xd0\x9a\x1b,3\x5c\x9d\x0a\x13\xd1g\xdb\xd4Bk\xd6\x9e\x09\x85"
+DATA ·d+9280(SB)/64,$"\xe1\xad\x00\xc8\xc6\xb3\x1a\xb5\x85\xbb\x03\x05\xcd\x112\x18\x14=`\xb4\x80\xf2\xa6J\x83\x95\xb1\x9e\xad\x96P\x0a>\xe00\x5c\xea\xc9\x92\xc63\xc9\xca\x0c\xee,+a\xef\x04rK\xa9\xe5R,\x0cR\x16ZYX]Z"
+DATA ·d+9344(SB)/64,$"\x05\x12y\xc7\x03{#\x16\xe6{\x82\xb7\x87\xa4\x84~\x12\x9aI\xa2\x04^\x93\xea\x0d_\x88\x03\xcct \x1b\xb1\x5c\xca\x85\x84\xc6Z\xd4\xcb\x03\xdb%\x9a\xf7\xc8\x09\x1e\x119\x03\x17A{eE#\xb04uk\x0e\xc6"
+DATA ·d+9408(SB)/64,$"\x12\x87\x9a\x17\x11qA\xb3/\x08kV\x96\xa5[\xe6^\xaf\xc2\xb6\x8c\xb19C0w\x0f\xff\xfd\xdf\xff\x1d\xb70\xfc0\x9b\x03\x5c\x80\xf9\xa3T_'\x13\xaa\xf2\xe8\xd1\xa3\xfc\xe9\xd3\x87\xf9W\xf8\xe9\xc5gR[\xc2"
+DATA ·d+9472(SB)/64,$"\xed\x10\xf59gN\xbf\xb9\xcc\xb2\xabX\xf6\x85\xefC\x9a\x0d\x96\xc7'7\x14\xe4\xd6{h6'A\x017\x9e%\xeee@\x98X\xd8+\x98l\x96-\xeb\xeecN6\xf0#\x1fTO\x12\xdb\x1d)%#\xa26\xa0"
+DATA ·d+9536(SB)/64,$"\xe2\xa4r\xdc\xd7\xfeg+\x1b;\x13\x05\xb3\xf2#`\xef\x95\xa4V\x97\xb8\xbf\x86\xf6y\xd4\xeb<\xee\x15\xfd\xb0\x96m\xe9\x82\xd4\xef\xdeeK\xe9\x7fQ\x9d\xe4L\x1d\x8d\xdcI\xd6mzg\xbe\xbb)\x891$\xc3\xa4"
+DATA ·d+9600(SB)/64,$" \xee\x04\x8e\xb1M\x1c\x5ck6\x9c#X\xfb\x03o\xa9\x96m\xe9c\xfd\xcb\xa3?\xb6\xbc\x9e,e(\xf2}w\xf1\x8e\x8f\xe0=\xb8\x11\xed\xe9\xbfW\xe3\x01\x1a%\xf3\x05\x5c\xfa\xa9\x92\x0a|h\x02\xbd\x0bf\x199"
+DATA ·d+9664(SB)/64,$"\xf7P\xe8\xb4\x9f\xcdQ\xfc\xd9DsB\x1f\xe6\x03\xbc\xd0\x11\xfe\xfal\xf1\xa3T\x09g\x00~;&}\x07\xa2?J\x15p}|#\xae\xac\xb4\xe9:\x11\xbd\x17k\x12\x80:\x80\xb3\x123\xfcf\xf9-\x98\x9e\x8c\x1b"
+DATA ·d+9728(SB)/64,$"\xb8\xb6\x1c\xa9+m\xa2\x98\xf6\xd1\xa8\xd5\xee.\x0b\xbeP\xfa7\x224U\xb0\xee\xc9s+\xc7\xba\xd4\x04\x956\xb7B$\xed\xb5\xd5\xe5\xf3\x95\x91k\xa1\xa3^\x8b\x0e;v\x7f\x87\x96\xeb\xb6J\xday\xe6\x08s\xfdN"
+DATA ·d+9792(SB)/64,$"\xc0\x09\x96\xd4J'\xf3\xeaZ\xf3\xf3\xb0\xaeJ\xb2\xda\xd2nJ\xc7\x98u\xe5\xc3\xc7h\x9f\xb2\xf6\xd9\xa5\xd4\xec^R-g\xaf0\x06\xcd:;u\x03\x06a\xf7\xbd\xb7\x94:gW{Ah=\x91\x05\xfb\x9d\xfc%"
+DATA ·d+9856(SB)/64,$"\xd3\x94p\xd4\xfe\x83\xfch\xc7\xcc\x9e\xb8\xa2\xdf}\xd1>\xe0\xc7\xe7|\x13\x01\x07?$`L\x0fv<\xf2\x7f\xb2y\x00\xed\x8b\x7f\x87b\xedm\xd4 E\xbe\x13\x8b\xc9RG\xb2\xed\xd0\xc6\xbeI\x05\xcff\xa7\xd8\xc9"
+DATA ·d+9920(SB)/64,$".\xc9\xfc5A[\x87B\xb0x\xd8\xe8tF\xec9cc|s\x8a_\x9d[\xe8\x93\x0d\xe1@n\xfaCW?\x03\x1b\xb9\xdd\xec=b\xc7\x9f\xe4\x06v\x8c\x98gz\x89\xb2\x22/\xff;\xbd]\xafs\xcdUI\xe5"
+DATA ·d+9984(SB)/64,$"V\xdaR\x93\xba\xb8\xd7\x83\xb0;\x16\xa1\x14\x99\xcb\x96R;@\x95T\xa8XWRM\x0e\x1e|\x134\xb2@\xb6\xcaL\xee\xc2\x14\xd3\xb9/\xe3\x13\xdf\x9e\xf7x7\x16\x8e\xd4\x0d\x88\x06:\xb0b\xee\x1d\x87\x03W\xb8"
+DATA ·d+10048(SB)/64,$"*\x05[6n\xeaw\xacJ\xa0\xa0\x85\xe7h\xf8\xf5\xab\xab5<)\x03\xdb\xd0\xd0r\xf6\x9c\xdae\xd3H\xa1\xba\x81B\xe48S\xc5\x9c\xed\x03\xce\x1d'\xee\xb2g\xc4S\xef\xb5\xb9\x9b\xdcJF\xd3\xe7h\xaa\x0a{"
+DATA ·d+10112(SB)/64,$"\x0b\xe9p\xce\xd3H\xf2\x17\xce\xfb\xf32\x0d\xd1\xf3\xfaC\xa4a!q@T\x8b\x0a#k\xcf]\x0b\xf02\xb8+\x01\x15\xef\xd9\xe2\x9c}\x83f\x19\x81\xb7\xb3R0\x80\xd0\x19\xcf@g7S\x83\xaf1+\xe12\x8c"
+DATA ·d+10176(SB)/64,$"m\x11\xc0l\x83\xa6\x08\xac\xe9V\xef\xf5\x06\xa9\xb4\xb1\xad\xdc\x0d\xca\x18\xb0R\x0d\x8f\xf5\x06\x16\xb9\xbfj\x90e\xeb\xfa\xcao=\xde=\xf6\xed\xbeqmW\xde\xc3\xae\x91;\xb1\xab\x0d!\xb7\x9f\x9a\xd7Z.\x06\xc8}"
+DATA ·d+10240(SB)/64,$"\x9d)\x22^\x13\xab\xa4\xee\xe5R\xcf\xd8Rw-~d\x14za\x0d\x07\xf1\xe5\xe8\x99Tf\xcb\xebh\xc1\xfdM\xe3t[\x93F\xe9\x0c\x1d\xf4S3\xbdj\xb7u\xc5N\xc4\x8a\x9f\x89$\xbd\xa5Y\xb5Z\xa0CP"
+DATA ·d+10304(SB)/64,$"\xc3\xee\xd9\xa5P\x06[S/\x05\x80\x8d\xef\xefd\x03p\x09\x00\xdcA\x82\xee\x976Z#\x11|:\x06\xa9\x1dV\xa8\x81\x0b\xec\xb6\xc7\xcc\x88\xde\xe5\x9f\x8e\xa5\xc7\xf9\x0b\xdf\x00\xaau\xb7H\xa3$flw\x1c48"
+DATA ·d+10368(SB)/64,$"i\xe2Z\x9a%y\x1ep\x8bM,\xfb\xfb\x82p}\xcf\x0el\x0c5\x02\xf5\xd7\x87\xe8\x12\xad+v/\xb5c\xee!\xba\xa7^\xda\xc2\x0d\xa1\x92j\xc6XU\x8c\x1d\xfe\x0e\xfdM\xabg\x8c\x1d\x16\xbbm\xad\xd8A\xb0"
+DATA ·d+10432(SB)/64,$"\xb7VR\xb1.^\xe3Q\x84\x92\x0d\xba\x95\x8d\xd93\x12\x00\xdaM[\x1d\x06Qa\xc6\xeak\x9b\xe3\xe5;\xc5\xbetBe*\x8c\xbf\xe9D\xea\xf4ce\x06\x1c4vt5\x14\x8fc\x15\xc0\xaaL\xf0\xb8.?S"
+DATA ·d+10496(SB)/64,$"eC\x83\x0e\x1e\xdc\x0a\x81\xdd\x89Z\xbe\x05\x99\xc3^\x94i\x88|\xbb\x0e\x93=\xdb\xc47\xe12\x14\xf3Z\x95\xfe\x9c\xbe\x16\x1d\x08M\x03\x92\xde4.\xed/\xa0T\x1a\xf0u\x93\xa9\xbb\xd1\x16\xfc\xe7\xc9\xe7\xb4\xac\x9a"
+DATA ·d+10560(SB)/64,$"k\xeci<\x1aQ\xb4\x03\x85\x1d\x22Y\xe1\xff:g\xf7\xa3\x122\x1f\xa2\xca\xe5\x17\xcfS\x1b'a5+B\xfe\xa9]Q\xbd\xc0n\x88)L\x8cKq\x05\xafa]\x8d\x03\xa8'\xd6\xdfyB\xdd\xdd\xa7bt\xba"
+DATA ·d+10624(SB)/64,$"\x0e\x1d\xe38lAr\x7fd?$m\xdd$E\xde\x1d\x09\x91\xc1\xc5\x03\x9a\x1dP\xb3<\xd9)\xba\xe4\x81N\x80\x9a\xda\xb4\x1bKI\xb9\xa4\xf6O\x07+\x8f\xb0f\x8f\xce\x1d\xb2\xb8J\x5c\x1b{vx\xcd\xac\xb2q"
+DATA ·d+10688(SB)/64,$"\xc7\xec\x09v\xfa\x98\xc9\xfb\xf7=-\x83\xb7\x09&[\xbe\x1bz\xf8 ?:\x1f9\xb7\xb5@s\xcf\x0e\x98\x98\xa1\x88\xc6\x81\x05\x9ev\x07}\x84#\x1c\x87>{\x84\x11\xd0\x10\xc2;\xf1%\xaf\x0aD8\xda\x09\x89\x18"
+DATA ·d+10752(SB)/64,$"\xfd\x5c\xd2\xbbO\xc1\xbd\xcf\x1dT\xee\xb9\x83\x9d\xcd\xf7&\xa5=\xdc\xd7roj\xd9`\xadg_\xd9\xe1\xf7\xdf\x7f\x7f\x0d\xa4~\xaaV\x16g^\xdd\xd7zoBU|\x1a`\xdf\xf0\xf7\xa5J\xadX\xaav\xa6\x87\x7f"
+DATA ·d+10816(SB)/64,$"\x94\x9b\xa4s\xe6\xe3\x17\x17v\x17K\x8b\xfc\xfa\xe3\x9ew\x8e\xfb\xb4\xd55\xe7\x8d\x87\x11)g; \xb9\x8d\xf8\x9a-\xb8\xaf\x88D;\xfd\xb5\xb2\x5c\xa0]*\xc2FT\xec\x8a\xad)){\xc8\xa7pnO\xc6n\xfb"
+DATA ·d+10880(SB)/64,$"\xeb\x0fp>\x10i\x1c\x91\xa3/B\xed\xa7~\x17\x81[\x1d\x88\xd7\xceF\x94\x05\xa8\xeb\x03\x1b+\x8b\xbf6\xb2m\xc2\xf5>N\xd3\x96\xca\xa2\xa9\x89\xac\x1e~\x1co\xc495>\x9eh\xb5HUwgv\x0a\xf8\xf2"
+DATA ·d+10944(SB)/64,$"\x13]\xc4\xd9\x07\xd1Z\x02\xdeL\x94\x93\xe4f\xb1\xc5N\x98\xb7\x08b\xb5\xb6\x05\x15\xe4\x04\xa2\xd6\xae:\x84\x06\xad\xd7V\xfd+\x0c*K\xe7\x1a\x97\xde\xb7-uI&\x1d_\xfcB\xb5k\xf2sr\xae\xe1\x03Wp"
+DATA ·d+11008(SB)/64,$"\xcb\xd4*6\xef\x8d|)i8\xd1\xc0\xf1\x9e12\xa9\x0d\x8f\xf4O\x98S\xfe\xbb\x86\xb8t\xf8\xd8\xea\x802\xda[\x96d\xed\x83\xa2\x7f\xbe\xfb\xf1\x977\xaf\xfew\xc1\x0e#3\xea\xbcgF\x1d\xbe|s,\xe2u"
+DATA ·d+11072(SB)/64,$"\xd5\xae\x827\x22$f8$*\xb8r\x22Yz\x1d\x88\x22\xf7?\x07\xccKC\xfd\xfd\xe8\x0e\x95^\xc7q\xcf\xa4v\xa2\xe5\xca\xa2\x02\x0dc\x5c\xac\x02\x8a\x1ah\x8aZ\xd7\xf2\xdbwR\x1b\xe2\x89\xff:\xdb\xe5-,"
+DATA ·d+11136(SB)/64,$"P\x1e\x9b\xbf\xdc\x02\x15o[\x9dS%9\x8ea\xac\xdez\x14\x91\xca\xe36x\x94\xf8\x94\xec\xa9\x08\xd5m\xd5Q\x86C+\xe8\xd5]\x18\xeel\x8d\x9al\xb5#SQ\x07\x16\xd5\xdd\x0dk\xefQ\xd6\x81e\xeb\xee\x04"
+DATA ·d+11200(SB)/64,$"u\x0b=\xb2\x0b\xd96u\x8d\xf6\x8f\xfd\x86'\xdf\x00%|\xcb\x9cuX!Y\x8d\xfb\x9c\xe3\xd8\x80\xed\x06\xd7d`\x96\x91\xcfc\x96\xc8\xe0C=\xedd\xa0\xbe\x0c>\xdc|\xc8\xacR\x95\x84P\xff\xa0\xe8I\x1f^"
+DATA ·d+11264(SB)/64,$"S\xc6\x16Q\x96\x0f\x07\x22I{\x92\xa4\xb9\xd9\x89\xd2u\x86\x96\xdd\xe8\x1d\xee\x96\x8f\x86\xad+\xc3\x08\x5c/\x9e\xedFa\xaf\x90\xe6\xc8D\x1d\xdc\x04\x93\xdb\x9aV\xbe\x956]\xb9\xee\x06St\x1b\x83\xcam\xe9\xd53"
+DATA ·d+11328(SB)/64,$" \xfe\xb5\xf6\x0f\xd4\x85\x07\x90q\xf3\x93.uG\xaa\x82u\xb8\xbd[-A2\xdc}w:q\x90h\xfd\xb8\xcb\xc3=\x16\x93k\xcdF\xfd\xdb\xe5P\x1f{\xf6\xbd\x84\x0c\xdb\xb6d\xc0\x90q\xb5\x03Z\xf0Q\xbb\x01"
+DATA ·d+11392(SB)/64,$"\xb8\xd4\xce\xe0}\xde\x02L\xd7\xe2\x03\xce\xf3\xecc\x7f\x96\xef\xde\xc5\x81*\x01\xa9\xb9\xe6\xf6C<\xb3\xdeP\x11\xd9^0\xa1B\x92\x1c\xd0K\x88\x89\x98\xe9\xa6\xd0\xf9\xb8,\xa5\xfd3\xcf\x1fw\xe7\xad\x1be\xda7\xae"
+DATA ·d+11456(SB)/64,$",e\xbe\xcb\xb2\x1c[S\xaeS\x90\x12Y\x06\x0f\x97T\x22\x89\x8e\x95\xe4\xce}\x1cK>i\x93A\x11\xdd\x8b@\xfdGQ\xc4\x1f\xac|\xd9H\xf3\x1c\x0c\x9f,Sb\xb9\xd5\x22sWFg.!\xfb.\xed\xc9W"
+DATA ·d+11520(SB)/64,$"\xd8\x91\x05w\xa9K\xe7\xa4\xe1DH\xc4\xfc\x16R\x1a\x0a\xa1\xdd\xfa\xc3\x11\x98\xbb\x07\x03w\x81a0!%\x94}\x01\xaaM\x22,\xb1\xa2s\xb8\xe5\xc6=9\x040d#\x8d\xe4\xb5\xfc\x82\x1f\x93\xe7\xba\x9a\xd6\xb8@"
+DATA ·d+11584(SB)/64,$"<\xf0.\xa4\xa8w\xeb\xfdK\x89e\x1aYS\xd4e\xc0$\xf8\x88_\xc7'\xe93\xdd\x98\x02H\x9d\x09\xecd\xa3\xda3Y\x09\x8d\x0f\xa16g\xa2\x91xh\xb8\x88{8C\xc0\xab\xd6/\xc1\x90A\xd6\xdd\x88\xf6\x22"
+DATA ·d+11648(SB)/64,$"\x08Q\x9c\xff\xf5\xddK\xc27t5\xc7aY\x5c\xf0\xc1\x12%\x96\xf2\xf3$\xb3\xb1\xa0\x98\x19\xe6m[\xcb\xc5\x05C\xf3L\x86%\x07@'\xd5\xd6\x99}'\xae\x9f\x16@\x8b\xa6\xd2\xf4d\x13\xd9u0*\xca\xc6\xda"
+DATA ·d+11712(SB)/64,$"C\xed\x0d7F\xa8\x06\x82(\xf0\x0f&5\xb3\xb9\x169E\x0a\xd9\x1aph\xb6Z0\xe1R4.\xdb\xban\xcf\xb1N\xf9\x9ac\xac\xd5Ec\xf8g\x97\xa7\xe8\x1c\xfd\xbb\xb3{\xf72\xd7\x86:\x07\x826\x17\xac\xf1"
+DATA ·d+11776(SB)/64,$"\x89\xb6\xb0\x17\x0fv\xc2]\x8f>\xe1\x00g\x1a\xf4V\x9b\xb0\x88\x80T>\x10\xb5\xf3\xb8lS_\xe4\x14NJ/\xc2\xe2\xfaw\x107HV\xf7|r\x06\xdff\x19\xa6E\x10\xe5ii\x0b\xe4\x9a\x9f\x8a\xe9\xbd\xacd"
+DATA ·d+11840(SB)/64,$"\xcf\x5cB\x04\xd7\xde\x0f y\xf1\xa2`\xdcU\xa4'\xb2\x90\xea\x14\xe0jg\xa6\x8c2\xa0\xb9y\x8c\xdf\xfe@\xe8N\xba\xc5\xdc\x1f\xfe5\xe38/\x116\x95\x82\xc2SCF\xa2\x8d+\xc6\x80\xac8\xf8\x97l\xb2p"
+DATA ·d+11904(SB)/64,$"_\x7f\x81ml\x06*\x00hYD\xb3\x85\x12\xdc=)\x0d\xe4\x06\xfeK{k7\xe4t\x1f\xbd\x93L\xf97+\x9b\xba\x03\xe0\x01\xafC\xea{\xf6\xde\xc7\xednh\xa4\x8e\xe7\xc2\xfb(\x1c#\x17l\xb0t\xda\x17\xb8"
+DATA ·d+11968(SB)/64,$"zGd\xa2\x1d\x95\xce\xba2\xad\x09\xfb\xe1\xa5\xa5\xdd\x8c]^\xb2\x8d\x92\x8dY\xb2\xec\xdf\xfe\xc8Xi?\xb0\xab\xab\x82!A\xfbU\xb0\x98]]]\x15\xfdP\x8ec\xb1\xd8*i.~\xa6\x85E1\xc1L\xdb\xd2"
+DATA ·d+12032(SB)/64,$"\xdb\xaf7\xa8\x0ap\x1d'\xe1\x03\x85\xd18\xf3\xc2\xa7\xae\xf7oA:\x1c\x0e\xa8J\xc6Z\xc52\x88\x99\x5c\x98\x83\xf7\x8a7z\xd3\xaaP+C\xcf\x0c\xc2\xd7\xe6\x01\xe1]\xfe\x05\x16\xe0\xb5n\x01O\x1bP\xad\x84\xde"
+DATA ·d+12096(SB)/64,$"\xb4\x8d\x86Y\xc6U\x8fq#\x8e\xaf-J\x142B\xda\x96F.\x08a\x0a\xb5\xd4F6\xa7:Z-\xb4\x08\x92\x84a\xc9*\xe8\xd2v\xe7Bxc\xdf\xaa\xdf\xbb0~\xa4\x0ce)P\x17\xc0\xdd\x18wVt\xbf"
+DATA ·d+12160(SB)/64,$"OZ\x15aFK\x07\x08\x82\xaf\xabI\xb3\xea\xd6\xa75\x90c=\xef\xe4B\x8d\xd3\x17j\x88\xa9w`\x05\xdc\x9d\x96]\x8eG\x97o\xd0\xdc\x94\xfd?\x07n\xe6\xe1\x81\xea\x83_\xb0G\x9dy\xfe\xcd\x9aV7r\xb9"
+DATA ·d+12224(SB)/64,$"\xcc\xae\x8a\xb8\xd1\x0b\xc5\xd7C\xb5\x8f\x9f\xbd>\xfa\xe5\xdd\xcb\x9f^\xbeI\x1a\xec\xe2\xae\xd0p\x89\x00y\xb3\x10\xda\xb4J\xb3\xbfiQ/\xff\x96\x00y'\x96B)\xa1\xfa\x8d5\xf1g\xab\xe4\xa9l\x0e\xe0\x90<X"
+DATA ·d+12288(SB)/64,$"\xa8Vk[\x02P\x86VX\xd8\xd2:k\xec\x86\x9b\x9a}\x9fd\xef\xa66<\xa57\xdd\xd6^\xb4\xca\xe6B\xb0\xb3Nv\xcf\x90\xa5\x80\xb27]\xb3\xd9\xdd\x84!\xa2\x1d\xaf[\xfd\xe6{\x1eMT\xb7\xc6\x1b4f"
+DATA ·d+12352(SB)/64,$"|\xdb\x96h\xc5\x13b4\xb6h\x9b\xa5<\xdd*\xa1\xdd\x87\xc2%\xc6\x089\x89\x82[\xd9\xa0|C\xdbA\x0a\x17\x83\x05\xeeY\x10\xcf\xb1\x93\xdc\x9a\x88\x92\xc2\xf8\x16*9\x16\xf0\x7f\xc9\x192\x1e\xad\xb8~\xde\xa9\x84"
+DATA ·d+12416(SB)/64,$"\x17\x8e Q,)~\xab\x188a\x91\xb1Fz\xc3\xbb\xaf\xec\xdc;~\xfbl<\xb2[_\xfc\x01\xa0\xfa\x0f4HB\xc9\xc5/\x01\xb6M\xdb\xc8\x05\xaf\xbb\xad\xd0\xb4\xff\xeb\xbbW\xba\xfbAw\x98\x00\xc1\xa5\x9c\x81"
+DATA ·d+12480(SB)/64,$"C\xec2K\x7f\x88Ck\x8e\x06I\x92Q2H\x87\xb0s\xf4\x8b\xfe\x17F\xd3\xb4\xe6\x05\xc4\xb8\xdaI\x84\x8f\xa8s\xd8\xdf\xe3Q#>\x9b\x0e\xf5\xd2\x0a(\xab\xbfjc2\xde\xab\xdb\xd3\xf2U{z\xea_\xfb\xb1"
+DATA ·d+12544(SB)/64,$"S\xff\xda\xa2\xe2\xf6\x0a\x87Z\xccu\x1c\xc4R\x0d<gsW\xe2\xba\xeb\x00\x88\x03\xca~:z\x9f\x15,\xfb\xf9\xe8\xd9\x8f\xf0\xef/o\xdf\xbf\xfc\xe5\xcdqf\x1f\xda\xf4\xccj\xc3\xf0\xe2\xae\x00\xc2\x06K,\xf9\x82"
+DATA ·d+12608(SB)/64,$"\xa0/\xfe\xd8\x0a\x0d\xb2\xfe+z\xc5\xcc\x06{\xa2B\xd0\xd7\x18\xb0\x1dgM\xdb\x1cX\x15\xe1\x15\x84_#\x0c\xcd\xda\xad\xd1\xb2\xf2Y_\xa8/\x00\xc8\x95\xc3\xa5b\x5c\x87\x80c\x1b\xe7\x17)\x12\x16?\xa7\xba\xa6K"
+DATA ·d+12672(SB)/64,$".(\x85\xb8\xf8\x16\xac\xb3\xfc(\xd9bia\xcc\x1d\x02>$\x1b\xfay\xd3\xe1\x83>\xb16\x5ckG\x97H.r!\xdb\x96@q\x8b\x15\x93\x8d6\x82W0r%65\xbc\xbaCR\xcb\xa3\xc3G\xd6\xd4\xba\xe1"
+DATA ·d+12736(SB)/64,$"\xa7\x22\x1ao\x07\x8f\xc9*\xe1\xb6o\x1cy\x97\xc9\xe7l\xd5\x19=0y\x7f\xc8K\xd2\x13T\xbb=]\xb9\xc1\xe1zp\x15\x06\xc6g\xe9\xe0\x09%\xc3\xe3\xef\xc8<\xb3=4,\x98\xea\xf0\x0c@K\xd8\xc6\xbe\xd3e"
+DATA ·d+12800(SB)/64,$"k!-\xdd\x22\x92\xd1s\x9c\xb8\x88b\xb2\x8a\xcffB\xa8\xff\x05\xe4\x048s\xa4DJ\xc6#\xb7\x15\xa0\xe8\x0cH\xd7\xb8\x09\xa0\x80\x85\xd3\xadY\xbb\xc0P\xd9\xca&\xb2\xb3\xc7\xb3\x1fRa\x93\xf0\xa1$\x1d\x92p"
+DATA ·d+12864(SB)/64,$"\xb5\xca\xa6\xa9\xb2\xa2mI\xcf!\xd2N\x02\x03\xc6\x8e\xaah\xd7\x88\x06\xef\xd0\x9aXl\xa2\xed\xe9\x1b)\xe0\xf7\xbc\xb9\x1daJ\x07\xb7Ky2\xec\xde\xe7\x0a\x98i\x98\xe2\x9f\x8e\xde\x17\x0c\xf60\x9ca\xbb\x89\x01\xc0"
+DATA ·d+12928(SB)/64,$"\x090!\xf1\xc0:\x1a_^\xb2_P\xe0_G{j\xa5\xda\xcdFT\xf4z\xc2\x925m\xe3w\x1d[\xcd*\xe1\xa1\xb7V\xb9\xce\x8a\x01\xf4\x5cj?\xcbv(\x08\xb1G\x87\xdf3\xd3\x920E\xaa\x05\xce]\xb4"
+DATA ·d+12992(SB)/64,$"\xe3%|\xe9\xf9QT\xecT\x18lN\x14boZ\xc3\x9e\xd9O\xb8\x80\x00\x1c\xe9\xf6X\xec\x0d#\xee\xa8F)\xdd6p\xf0'\xa4\xb5\xf8\x90tb\x89\x06<\xfam\x1eF;\xc0\xc0dP\xd9\x82)\x10\xa7\x87\x87"
+DATA ·d+13056(SB)/64,$"\x8f\xd8\x9b\xd6\xdb\xad\x10\x97ND\xbbS\x81<[\xd9Y\x9e8<\xa2\xc0\xea\x1eS\xc1\x11\xe6\xf0\x0e\x87\xaf\xb5\x07\xaf\x839\xb8s\xcc]\x8eG\xbe\x12\x16\x85\x9a\xeb\xa8\x0a>\xa4\x8c05\xc5\xe0\xbeh\xeb\xca\xe2U"
+DATA ·d+13120(SB)/64,$"\xb0\xb5\xbb\xa8w\x08x\xa3\xad'\xc1\x9an\xd6O\x94\xe0\x9f\x06\xaf\xbc\xf7\xae\x86\xb5?\x94-\xc0\xb0\x1a,\x17u\xf3\xeb\x0e0Z\xe0Qk\xc4\xed\xf7F5\xed\xb8\xfcY\xe8\xb2\xd6\xf5\x89\x190\xb3F\xf55\x98\xb3"
+DATA ·d+13184(SB)/64,$"m\xf3\xd8\x82N\x89\x83;#\x8e\x12\x05\xf7-*\xc0 |!tG\xe8\x5c\xa6\x12t\xdf\x96\xe2s\xd2\x0di\x18\x05k\xda`\x0b\xe2\xb8j\x9c\x1a\xbe\xc3b\x18\xb1c\x82\xc9\xc4\x83)\xcb26S|\xdb~\xb7\xe8"
+DATA ·d+13248(SB)/64,$"\x98x\x1ct\xfc\xd8\x13\xce\xe7>\x13s _Wr\xf5\x04\xec~\xe8\x91pX\x13w\xe2\x936\xb2\xae\x13C\x01X\x99\x95\xac*\xd1\x14d\x15LM)h\xdc\x88\xc8\xd6\x81;qZkYv\x94\xb7o$\x9d\xee"
+DATA ·d+13312(SB)/64,$")\x8c\xb6\x07G\xbb\xbeF\x99R\xef\xf8\xed\xb3XY\xc3\xfdH6\xa7\xb5@\xd1\x89x\xc7\x1a\xe5)\x05\xc42\xa6_\xc1\xb4\x104\xd0\xb7\xcf\xac\xf9\xe6\xed\xb3H\xf9\x9aN\xd9\x0b^\xd7'|\xf1\xc9f\xba\xb7L\xe9"
+DATA ·d+13376(SB)/64,$"l\xdf\xadb\xdb\xe6S\xd3\x9e7L\xb5[Lx\x9f\xc9\xa6\x12\x9f\xcb\x95Y\xd7\x19^0\x00e\xc7#\x0f\xc7\xedo\x90\xca\xf1\xf3\xa2\xdeV\x94lQ\xf7\xc4jg\xd0\xd5\x91\xf5l\xca7r\x9a\xb9\x87\x22\xc9D\x8f"
+DATA ·d+13440(SB)/64,$"\x82\x18\x80\x1e\x8f\x1c\xc0\xb0\x8b\x02\x8d\xd0hq\xf4\xd9\x88F\xcb\xb6\xd1V\x96\xf3r\x12\x0cb-\xe9\xe1P\xe87\xb2\xaf\xb96L6\x08\x0a\xa5\x16\xae\x8d\xb3h\xc7\x98\xfd\xae\xa7|\xb3)\x7f\xd7\x19\x9d\x1a \xc5*"
+DATA ·d+13504(SB)/64,$"\x1e\xccn\xd0vi\x890\x1e\xf5\x90\x8a\x9e`\xb3\x132\xa4\x93\x00\xd9\x13Hq\xfaDm\xb8\xd9j\xf6\xf0\xf0\x10\xc7\xf4\xd3\xd1{w\xc6\xe1a\xbeO\xb4\xd4-[\xd4\x12,G\xa8\x8c\xd0\x5c\x02\xb7\xf0\x98\x9fp]"
+DATA ·d+13568(SB)/64,$"\x05\x96\x8aG\xbf\xd5B\xe9\xe9\xa3\x87v\xf0\xc8)\xa1f\xbc\xa4\xde>\x9b\x80\xd2}\xfc\xf6\xd9\xc0\xa2\x81\xb3j\xc3K\xcf,\x90\xc9\x8c\x12\x99\xa5\xc5\x09\x97\xdd\xf8,\x82~\xe7\xec\xae\xde\xf0\xb0\x82<!\xe3\xb7\x00\x80"
+DATA ·d+13632(SB)/64,$"\xfa\x1d\x0a\xbb\xad\xc7R\xd1?\xa8\xb7\xeb<r\xcd'J\xfcA\x17\x8e\xe5;\xd7T\x89?\xde\xc6\xa9\xe7\xfa9\xf7\xe5\x92Yt\xfd+e\x00\xa8\xb4\xa2\xd1\x9d9C\xcd\x16\xee\x7f;\xc5\xa8\xe9\xe6\xbb\x93\xef\xdb\x93\xd0"
+DATA ·d+13696(SB)/64,$"*\x0c\xd1q\x08\x04v\xeb\xc7\x1e\x89Nl\xf8\x99k\xabjBg\xa0\xbfR\xea\x0c\x02\x92\x06XF\xbdE\xb1\x14\x08\xbd\xc7\xf3w\xef\xd2\x0d\xd3\xd1gJ\xb4Q\xfe\x9dk1\xb1\xd4\xc91QI\x96\xed\x1e\x8a-\x5c"
+DATA ·d+13760(SB)/64,$"\x941o\xd8L\xff\xf1\xd9lM2\xef\xbc\x91\xbb\xbf\xb0\x9c\x01<\xdd\x15\xa2\x9cK^\xf6\x0c|\xc7N\xb6\xc6\x9b \x01\x98Q\x5c\xd6\xb0\xae\xf0F\xcb\x1e0\xd9\xb4\x92*+R\xd5lI\x09\x8c\xa0Q\x04O\x1a\xb0"
+DATA ·d+13824(SB)/64,$"\xc5\xc6\xed\xa6\x11\x97\x17N\xc9\xf48\xe1NI\xf5\xb2\xe4\xac\xef\x0ex\xf2\xcd'\xbb\x854xj?\xf7&\xad]\x1b\xd5\xcf\xef_\xbf\x8a\xd3\xac;B\xf9}\x95\xc6\x0a\x003~\xd2n\x0d\x8d\x14i\x9fM\xb1\xc4S"
+DATA ·d+13888(SB)/64,$"\xae;?^\xfe\xf6\xc0,}0\xe9\xfb\xbbW\xbeCi|B\xa9\x90\x95\xaaI\xf6M\xdc\xc5(\xdf\x5cDF7\xbco\xa6\x9e\xa7O\x97z~4t\xa3\xc4\xd9F\xa85oH\xb9\xb0\x9f\xecd\x87\xa4X\xf6\xc6V"
+DATA ·d+13952(SB)/64,$"\x89\x9aC\x0a\xaah\xb4\xd1\xc1\xe9\x9e\xc6\xd3\x86_\x90\x8a\x0c\xb0H\xfb\x89\xeb\xb1s\xae\x99\x12\xe7J\x1a#\x9a\xc8\x22\xae\x04\xf7WZAL@\x8e\x04\x0b<\xa6P3Jn\xac\x8dw\xd7\xee\xe7\x8619gv\xeb"
+DATA ·d+14016(SB)/64,$"\xa3\x8b(\xcc\xf0\xa2\x0a6\xb0)\xba\x91\xfa]\x11\x8c\xbf\xa5\x16]\xe1nr^\xfel\xdf\xed-pC\xa0D\x1b[\xd8\xcb\xb6\xaa\x86\xed\xc9>\x84\x1fh\xf7\x8e\x9f\xff\xaf\xadP\x17h_\xc1\x0d\xcc\x95\xc0\x8bw\x1e"
+DATA ·d+14080(SB)/64,$"^y,\xcc${e\x9be\x05\xdb\xda\xfc\xb8\x98b\xe2\x9c\xf2\xd3\xd8\xca\x96\x14p\xe2\xc2\x8b$\xd5[7\x85\xf5E\x1e-\x12\xef\x13\xf6\xca*\xa9\xfd\xb5RK\x9d\xe4\x10s\xeb[\x0a\xcdV\xfc\x8c\x0ejr\xa4p"
+DATA ·d+14144(SB)/64,$"\x9bA\xe1\xaf\xf15.2\xba\x13\xd7\xec\x7f\x1e\xff\xf2\xc6\xcd6\x9d\xe7\x8c/\x16bc4\xcb\xa2\xe3x\xfa\xbbn\x1b\xbaRthIm_D\x22\xa1.\xc6\xc1\xde$\xa6\xc2\x99\x0eO\xe9Zi\x09\xde\x01\xf0\x8e\x07"
+DATA ·d+14208(SB)/64,$"^rk\xc9\xbe\xe3\xee\xd4c\xb8\x94\xd2\xd1UE\x81\xfdT\x9e\x89XX\xe8Ro\xe2k\xef\xd3\xa7o\xb28\x9d\xcd\xc0-\xcdP\xe4\x0d\xfbs\x8f[X\xb9NR\xedk\xacaW\xb6\xa4\x12U\xe4+\x02\xed\xc4n"
+DATA ·d+14272(SB)/64,$"\xe5\x15\xc1\xf6%\x85\xa0\xbf\x82o}@\xfa\xcfH\x03\xc93<\xf8\x9aPw\xe0y\xef\xf9\x1e\xffv\xceN\xe1\xa1K\xbb[\x0a\x10_\xbf^S\xf5~6\xcdB\xf5\xdb\xa8\xe7\x163z\x8e\xac\x12z\xa1\xe4\x89H\xd2"
+DATA ·d+14336(SB)/64,$")\xda\xbb=\xd9\xd0\xf2\xb1\x0dH\x1dJZ\x07\xbd\xc8\xdd][\xac\xd9\xbf`A\xcd28A\xb2\x7f\x8dG\xe0\xc5\xe9\xafx\x18s\x9f!\xbe\xa0]K|?\xe5\x02\xea\xb9g\xe7\x5c\x8c\x9f\xad\x07Y\x0c\xe1\xf3k\xb9"
+DATA ·d+14400(SB)/64,$"\x16pY\xdc\xe9em\x8b\x13X4X<v\xdd\x92&^\xed$'\x8c\x92H\xeaV\x01\x93\xf2\xc5\xa2U\xf8R\xa2iY\x06\x85\x19\x9b\xd0\xf5' \x02`[\x85\x9e69\x1e\xc5Y\xab*\xa126\xe1z\x01_"
+DATA ·d+14464(SB)/64,$"\x80\xa69\xfb\x036S\xb6\x01\x07,a\x84\xd2E\xb2\xd4\xe9\xad\xb7]\xdc\x1fc}\x8b\x03c f\xd0\xae\x94\x88\x97\x8e\xb7\xcb>\xdbeS\xbb&\xa6\xd3p\x9e\xd6\xb2\xf9\xa4Y#D5 \xcc\xe1\x06\x11\x0e5\xc4"
+DATA ·d+14528(SB)/64,$"\xa7`\x89\xcc\xea\xe1\xe7\xc0\xacy\xe7A\xa3\x9b\x1fd\xeeA\xbc\xe0\xec\x1a3a\xea\xed\xaa\xf3\xfb\xf4\xf7^\x1fWZ\x8e\x0en\xf0G\xa5\x82\x22ar\xeb\x03P9_\xd7\x928\x01\xc3a`\xa9]\xedp~\x8d\x1c"
+DATA ·d+14592(SB)/64,$"_o\xd7\x8fs\x82\xb5\x1d\xd1KfQ1E\xc1\x16\xcc-\x86\xe4#,\x05\x8b\x111 `d\xa7\x02\x8fwp\xb3\xc7\xa7\x10\x80K\xe1+V+!\xb3\x1f\xb1z^\xc4E\xc4\xda\xb8\x01f\xd0\x22s9\xaaj\xb9"
+DATA ·d+14656(SB)/64,$"\x80\xc7\xdbNj\x11\x86\x83\xe7K7[\xdax4\xe2\x05\xc34\xec\xb6\x22\xe6\xa0r\x7f\xff\xfe\xd1e`\x87=\xe2\xce\x9c\x9d\x94\xddD_\xf8\xc9z\xd1\x82e\xb8\x16Z\x17\xec\x14]\x1e\x94\xbd4v\xaf\xd1\xc2\x83\x15"
+DATA ·d+14720(SB)/64,$"\xf4X\x9a\x16\x8c\xf6\x0dx!v\x94\xb6\x81\xb4\xeb\xb8\xd5<a'\xf8G\xe1\x0a\x9e\xda\x02\x0f\x02\x96\xf9.\x10~;\x020\xeeG\x11\x7fx\x1a}\x08\xaf\xcd\xdc\x01Pp6\xddq\xd0.\x87;\xc0]\x15\x80\xbfA"
+DATA ·d+14784(SB)/64,$"V\xb0\x05OmA\x00\x88s\x19\x93\xcc\x02I\xf3\xa3\x01\xfc1f\xe2\x8b\x04\xbcgU5\xc9\xfe\x93+pj\xc9\x9e\xa1h\x94\xd9W\x0fN\xda\xca\xbd@4\x8e\xcf.0\xaar\xd9\xe0\xf1l\x01\x11\xb3\xb8\xe6\xc5\x80"
+DATA ·d+14848(SB)/64,$"lE\xdbKW\xb2\x8c=\x80\xb2\xc1f\x90\xc5\xbf\xad. \xc0m\xce\xa0\xa8|\xcd\x95^\xf1z\x12\x0e\x9f\xd1\x08\x8d\x07\xe1\xf4q\xff\xb3\xe7\x03\xecJp|\xf8W\x03Y\xba\x85\xb8z\x96'\xb1\xea\xd5e\xbaC\xda"
+DATA ·d+14912(SB)/64,$"oWy\x92\xce\xe0\xba\xf1\x18\xf1\xd9LA>}\x0c\xbe6J\x0b3\xdf\x9a\xe5\xc1\xff\xf0\xe3\xc2\xc7\x19\x11\x13\x90Z'\x83}\x16\xcc/XZ\xd9\xbbz}%\x9aS\xb3\xca\x0a \x03\xf8\xf0\x96/M\xcb\xf1\xd93"
+DATA ·d+14976(SB)/64,$"\xe8*\xdf+\xb2\xff\xf2\x1f\xb9}c\xbd/.\xd9\xa1bK\x02\x15?\x99\x18\xe3_I\xe5\xe3\xf7\xc4 \xadq0\xbe\x0e2.\xd9x\x88\xd1\xdc\xed\xcf\xc9v\xd9yl\xc3HC\xb9o\x81\x9c\xe5\x91^\xf0\x8d{\x97"
+DATA ·d+15040(SB)/64,$"\x22{\x09z\x00\x1c\xe8\x90\x91\xbc\x92*\x1f\x8fN\xb6\xcb\xe4E\x8c\xec\xc9\x9d\x1f\x7fy\xfe\xfe\x7f\xbf=B\x00O\x7fk\x9e\xf8\x7f\x05\xaf\xe0\xdf\xb50\xdcO\xd3o\x19N\xd4o\x19|\xc1\xbe\x9f\x02p\xfc\x0b\xf2\x9d"
+DATA ·d+15104(SB)/64,$"?\x99R\xe1o\xcd\x93\xa9\x03\x00\xa4y\xfa[\x93\x0dv\xbfz\xd0\x05\xb0z\x80\xb0a\x0f\xc5?\xd4\xd3\xcc\x9dW\x05[\xb4u8M\x82\xf7\x06\x0aT\x85\xdd\xd9\x0a\xbb=]\xe1\x04\xe1V\x0dm2\x8e\xfb4\xcc\xe6"
+DATA ·d+15168(SB)/64,$"\x04\xc0\xcc\xe7Hs\x90\x91\xe1_\xb2 \xde\xbd\xcb\xec7\x82\x99c\xde\xc5;a'!pa\xdb\xc7\x9d\xa4?,\xb3z\xfa\x84\xb3\x95\x12\xcb\xf9o\xd9\xff\x0dg\xc3<\xc3\xc4\x1e5\x0c\xf2._o\x1e#$,\xc5"
+DATA ·d+15232(SB)/64,$"\xbf\xa0\xfc\xb7\x0c\x89\x11\xc6\xf5\xc6\x8e\xeb\xd8\x8e\x0b\x17\xd0\x15d\xbeDR\xf1\xa7O\xa6f\xf5\x94R\xcb\x0f`15\xca\x12\x9e\xf2\xad!\xefN\x89q\x07pVO\x9f\x98*\xc2\xbb,\xa7\xbfeO\xcbrj{\xaa"
+DATA ·d+15296(SB)/64,$"\xe8{\xfaG\xe8#\xc8\xfdQ2f\xc7\xee\xee\xa1\x99\x02\x81\x93\xc8X08\x97\xe9\xa9C\xda\xca'wS\xcd\x9c\xca\xafr\xaf^\x87E\xfc\xa2Ukn^6f\x22\xecY\xf5\xe00\x07\x0bDt\xb0\xc8%\x13\xe1"
+DATA ·d+15360(SB)/64,$"\xdc\xdc\xd1\xfd\x1c\x0d:\xa48\xc0G\xfa+;\xa0\xff\xec\x9e\xe1.\xb5`\xe6\xfa\x8b\x10>\xe6\xd1\xdc\xf6k@\xefy4\x9fD\xdc\x8cA\xf0\x0d\xbd\xb3\x8c\xdfB\xf9\x00\x8c\xb5\xf40zs2\xc4\x16nqM\xed\xda"
+DATA ·d+15424(SB)/64,$"|2\xb5\xab>\x0b\x0f}A;\xfb\x10M\xfc\xd4\xa9\xbd;\xf4f\xf0\x1dA\x08\xe4\xfe\x9b\xf8\xc4\xee\x94\xebc\xb8\x93$\xe3e\x9c\x86#yy\xc5\xdd\x12\xce\xe6\xe9\xe5\xa9\xb5\x22\xf7n\x13\xf1\x91\x86p\xb5\xd8\xb9\x8c"
+DATA ·d+15488(SB)/64,$"Lt\xd6\xc0\xbb\x9b\xb8\xb5\x5c\xd2}\x08\xe22\xd987K\x97\xe7\x121-i\x1eb\x91cC\xde\x94]\xcd3\xcb\xbc&\xd6s\x0fF3h\xcf\x016!e\xf4\x8c\x17\x1c\xf1\x84B\xab\xe0\xb2\xc1\x99V\xfc\x03+"
+DATA ·d+15552(SB)/64,$"\x18K\x14;9c\xdb\xc4%\x5cc\xc4\xc4\x1e\xbd\xab\xa7\x928\x8f*\xfc\xd9IS\x9aN\xda\xe5x\xe4:\x9e\xcd\xbb\x17\xb5a\xba:\x1f\x90\x86+\x7f\x99\xd9\xbb\x01EZja\x92\xb7\x10\x09\x81\x8fxp\xfa\xf9D"
+DATA ·d+15616(SB)/64,$"\xabYtd\xf4<mm/\xc5\x8e;\xe2\xab\xd8\x7fB\xaf\x02(\x04\x8cS\x0d\xc7\x07\x9e\xc0fSz\xa3:5\xff\x0fq1\xd1+\xdc\xc5\x5c\xa6j-\xcc\x87O\xe2\xe2#\x9e<z\xe5\x18\xc9^b\xdc\xbd\x0b\x0a"
+DATA ·d+15680(SB)/64,$"s\x94?\x1c\x1e\x8b\x8a\x18/4\x18\xe0<\x9f)=\x0a\xb2\xa3\xd4\xd7\xbeSo\xc7BTV\xc4\x9d\xd1\xf5\xd9h\xb4*\x7f\x14\xb5}\xd4\xb2\x93Ra\x85\x82\x15\x8a]\xaei\x9exz\x10O\x07t\x07-`\xbb8"
+DATA ·d+15744(SB)/64,$"\x18\xad\x06!\x5c\xc7\x85\xd9D\x01J\xc4\xa1\xf12LI\x81\xfby\xd7\xa5\xc3\xaa@\xe8\x22\xac=(\x1a\xf2\xack\xbb\xc2*}\x13\x93\xef\xc7F\x0b\xe53\xda\x11l\xe2(\xaa\x8e\xe2\x16>&\x06x\x14\xeco\x8f\xff"
+DATA ·d+15808(SB)/64,$"\x96?f\x92=uV\xb2\x91=o\xe0\x9f\x0f3i\xdfd\x1a\xb5\x9fl\x9e\x8a\x10E\xe5:\xc4\xd7\xa1|\x9f\x1f\x0b\xdf\xd7{%\xd7\xc7\x1b\xbe\xa0\xbe\xf2`E`\xed';\x8a;=\xc5$\x8cbJ#\xd8\xd3s"
+DATA ·d+15872(SB)/64,$"l\xb4hx\xbf\x0b\xeb@\x16\x11\x10\xa7\xc5\xbe\xa5\xaf'\xae\xf3\xe3M-\xcd$F;E\xc3\xfd7\xadO\xd3\x09_\x12\x99:\xed\x22\x99|/4\xa5\xbe<\xf6\xdd,\xa8\x97\xfbpgz\xfe\x0a\xe9{\xf8\x11\x19\x01"
+DATA ·d+15936(SB)/64,$"\xe2\xd3p\x86\xbc\xbd\xe2\x103\x81Q\xfe3\x9bL\xd6'0\xf3gA\x17\x15xh\x8b\xd0\xf9 g\x1f\xbbO&\xb8\x85\x17=D\x90\xdaZ\xe3gW\xb1\xcf``\xddQs\x1f\xeb\x1c:T\x0e?FOQ\x0d\x01"
+DATA ·d+16000(SB)/64,$"\xf2K\x82E\xe3\xb0\xf2\x9a\x15\x8f\xa8$\x9c_)\x8ail\x00\xd9\xe9t\xdf\xc1\xd9\x87n\x96\xec%\xbd\xfb\x1a\xc5g@^\x22\x0b\xc0\x05\x0fz\x87\x15[\xa3\x1f#\x00\x7f\xe82\x89 \x88C\x02\x96\xa7i\xb8\x80\xc5"
+DATA ·d+16064(SB)/64,$"3\xf4\x94\x5c\xe6\xdb\xaa\xbdn\xe1\x1bE\x89\xe8\x92\xfd\xc3\xde\x16F\xf1\x0b\xd3\xa9\xfd<\xe8\xf4Mo\xd2\xa0\x17\x0c\xd7Lj\xe7\x93\xa2\xb7\xd2\xe8\xdeE\x99\x7f\xf4\xb9\xdd\x1a\xa14[\xb7\xdb\x06\x8d\xb4>\xa6\x85\x1b{"
+DATA ·d+16128(SB)/64,$"\xc37\x1bO\xa7\xe3\xe9t\x14\xf9\xe2N\xb2\xa96\xdc\xc8\x05\xca\xb2\x1d\xd0\xfecVD\x14\x80 m\x9f\x17\xca\x95\xb5\x1b\x837'\x09\xc1sv\xcf\xfe\xc63\x1d\xf3\xca\xda\x82\xcb\xc5\xf2t\x96\x92\xfa\xd2z\xcd\xcd:"
+DATA ·d+16192(SB)/64,$"\xde\x88WA\xd2j7\xd1\xc1\x8c]\xa2\xbe\xb61\x93\xbb\xabr\xb1<\xcdc\x86[9\x1e\x1b\x0a!\x89\xa3t\xed\x1bW\xe7\xfcb\x8f\xab\xfd\x99\xe4\xf8\x8cX\xcb\xb4\xe1M\xc5U\x85\x80\xa9\xba\xb27\xc9\xc4\x19\xbcA"
+DATA ·d+16256(SB)/64,$"J\x06wn\xcbv\xe4\x96\x97\xd1UCF\xd7\xfe\xf4\xc8\x12\x06\x04l\x92\x88\xdf\x88'\xae\xf1\xff\x8f\xbc\xff_\xdap\xee\xa1\x11\xa7\xbe\xfc\x05\xdb1c\x00`2h'\xef\xdc\x1f\x85\xdb\xb0\x88\x0d\xac]\x16`\x7f\x98"
+DATA ·d+16320(SB)/64,$"\xc1\x8a\x87\xbf\xf2\xf0\xd7\xc7\xa2\x1f]\x90\xe7eY\xe6\xa5\x8fj\xf6q^\xf67\xc3\xe9\xc1]3\x0d\x01!\xe1s\xe5\x99,\x0fmnl\xeb\xcf\xdd\xaa\x07\xdeD\x1e\x1a\x8f\xac\x97\x88\xcb\x11n\xa7\x02\x8a&h\x9b_"
+DATA ·d+16384(SB)/64,$",OmP\x03\xa9\xc9n\xa3\x84r\xe7\xf2\xef\x0d\xf9\x1e\x18\xbd\xf6\x94\xda\xee\xa11\xb4\xb2\x1e\xa6\xc1d\xe4_\xddF\x98\xe0\xf7\x1e\xbf*\xe0\x0a\xcbh\xbc\xd8S\xf4\x98\x88\xdd\xa8\xbb\x16.ro\x0e\x87'fc\x00"
+DATA ·d+16448(SB)/64,$"xv\xf1\x15\x0c\x94X<\xba\xa1\x14\xf9\x0e\xfd\xd8\xfb\x03\x8a\xaf\xa9\xb1\xf1\x9b\xd6X\xa7\xea\xfe0\xc3\xd0\xf0\xf4t\xc12\x9en7\xbe\xc3\xf83#\xba\xd6\xdc\x97\x1dfy\xb0\xd0\xf5m{oZ\xdb$\x1d\xe0\xb7'"
+DATA ·d+16512(SB)/64,$"o\x00<\xeb\xf6tI\x8c\x95\xfd\x1bx\xf8\xf9\x8a\xb7\x9c\x84\x97\x8d\x11\xaa\xe152\x85:\xb29\xcd;h\xba\x84\x10(Q\xbbW\xa5,4[:\x90\x1b\xdf\xd7&\x86o?y\xff\xaa\xc4\xad\x0a\xa76\xf6\xd1\x8c\x98"
+DATA ·d+16576(SB)/64,$"88\xfcx\x16\xee]ze%\xddn%,\x1c\x9e\x89\xa2n\x11\x98\xf7\x7f\xb9{\x97\x0d^\xc9\xd9\xdb8\xc2\xc3\xaf&04}\xc6\xbb\xcbt|\x89\x8c\xbc\x03\x06HD\xb6\xf1\xee\x11\x0c\x00\xea\x93)\x82\xd9\x1b\xac"
+DATA ·d+16640(SB)/64,$"\xdfP\x86Fj\x01\x04\xbd\xef\xce\xbe\xb1\xbb\x9b\xc80\xd5~\xa6\xd9}\x87\xc2x\x14M\xfa\xce9\xbfJ\xf6\xb9\x18`H\xe9\x12:\x8e8 \xbf\x19\xfcQ:\xbb\x9eUh\xcc\xde\x90\x7f\xdd\xc0\x87\xeea\x87\xe7\xe9\x9a"
+DATA ·d+16704(SB)/64,$"\xeb\xd5\xfds\xe2]*<_U\x05\x93\xda>\xc4\x15R\x07\xee\xe5\xaa)r\x14\xb5\xf2x\xa6\xd7\xd5\x16\xd7j\x0fF\xb6\xff\xa5\xf7P\xd4\x1b|\xbd\x19\x80\xc5n\xa2~\xc7\xc8\x1fc\x95\x90\xd4\xdf\xcecp\x1f\xbe\xd9"
+DATA ·d+16768(SB)/64,$"\x84\xf5\xb0\xd8\x7f\xf8\x81\x22\xd3\xbd\xb7v\xe3%\xbd\xdc5\xf1\xbd\xd8?\x0b\xd6\xb9[!\xe9\xc0u\xe5\x83\x83\xacS\x9b\x93\x9b\x06\x1c\x91[\xe5\xbd\xf5dc\xa5@z\xfd\xb1\x88\x82\x08\x1d\x80N\xf0\xa4\x8fF\xdc\xef\x9c"
+DATA ·d+16832(SB)/64,$"\x16(pc_\x83\xd4c\xb7\x08\xb8y\x8f\xdd\x8e9b\x11\x0b\x043\x1f\x197$\x0c\xb8\xfa\x9d8\xc4\xa4i\xfam'\x14\x87\x16\xb5\xba\xe1\x91\xe4\xa2*\xf3D\xfb\xc7\x0aoR^\xc9#G\xa8\xf6t\x09\xff\xd1\xe1"
+DATA ·d+16896(SB)/64,$"e\xff\x1d\xc1{}\xa7i[\xbfnO\xed\xd4\xee\x9c)w\xeav\xa7c\x89\x97\x04\xc12\xa9NQJ\x8e\x92E\x07/j\x1f\x8e\x17\x9f\xe8\xbe\xb4|\x8b\x11\xf2\x93\xec\xdf4\xfb7=c\xd9}\x82]8\xef\x85\x0f"
+DATA ·d+16960(SB)/64,$"\x1f#\xa8\x97AB*\x92M\xef\x8a\x90@\x19\xb9,\xf3\xd8\xd5\xd3\xcb\xa5\x89R\x8a\xec\xee<:\xad\xbfX\xc1d)\xcaAm\xd3\xbb\x1fw\x82=\xe1g-x\xe5\x1dU\x98\xc2\xf7\xf6\xaa\xc2\xa5\xe9\xf1\xe69\xec\xcb"
+DATA ·d+17024(SB)/64,$"\xbd\x96\x1a\xaf\x90\x8e\xe8\xdc\xa5u'\xbax\xd0W\xfd\xcem<\xc5\xf7\xf9r\xc7\xbb\xf1\x00 \xb4\x9bYX\xb3\x8f\xb4A'\x0e\xdf\xc7\x9e\xe5w\xed9\x9e\x8a6Z\xc2Q\xb1\x11\x5c\xc1\xf7\x10x\x0c\xf0\xd0ndf"
+DATA ·d+17088(SB)/64,$"\xd1\x94\x81u\xa4b|i\x84\x8a\xc08\xa7\xedG\x87\xdfE>\xcc\xd1\x22{\xd1\xaa\x13\x8a;\xa2\xd5\xb3@\x03\x89l:\xbe\x83m\xe2\xa9\xeb\x22ZY\xab\x5cU\xccx\xad\xd1\x9dK\x1a\xcd6\x5c\x89\xc6ht\xe5<"
+DATA ·d+17152(SB)/64,$"\xf2\x98k\x12\xa0\x10\x07\xc2\x0f\x033\xbf+ (\xa5\xc0\x18K\x18\xf6\xf7\x87\x87%{\xc665\x07\xe0\xb0]Qh#.\xca\x06\x83\xb6\xe3\xa7|\x9b6\x22N\xc1\xfcC\xbf\xce\xd34\xc4\x17\xa3c\x04:\xa6\xf6\xb5"
+DATA ·d+17216(SB)/64,$"i\xa9\xafS\xa4]L%\xa8\xfa\xc3f\x01\xe8\xc9z\x89\x1eG{\xdc\x8dw\xf3\x8e\x92m9\xc1=K\x08\x17\xf2}\x13SP8\xff9\xa8oz]\xf3:e\x80z\xcbc\x8f\xbd\xbd\x0c\x9b^\x10\xe1*\xde\xc1\xc3"
+DATA ·d+17280(SB)/64,$"\x00o\x80\x8d\xf7\xba\xdd\xdd\x96t\xdd\x83\xb0C\xbb[\xf8\xb9U$\x89]'\x7f\xed\xf1\xe8\xeb\x08\x91\xf6.\xdc\x8a\xbb \xdb\x05q\x0a?\xccYVZ{0U\xcc2/$\x01\xa5\xecVE\xdc\x8d\xda\x15R\xf1-"
+DATA ·d+17344(SB)/64,$"?\x15\x13\xcc:\xed\xa6\xceJ\xc2\x1d\x97\x98\x1d\xbeEW\x916F+C;\xe7\x97|\xef+fw\x00\x93\xce+f\x8b!i\xac\x8b\xbbG3\x11H\xa1#T\xd2\x10\xfe-\x01Y8\xb1rz5&\x19\xc1\xb3y"
+DATA ·d+17408(SB)/64,$"\xb4\xdb\xbd\x87\x8c\x02\xb6u\x97\xe3=M\x93\xf30\xf0\xabgxj\xb6s\xaf\xb4\x8e\xa9{\xf7E\xe2\xfdd\x16\x079\xd7\x1fjt\xbbY\xf4\xc4:[\xd9Iw\x83\x1b\xfb\x90L\xb5\xd7\x00s\x9da`6t\x1dc"
+DATA ·d+17472(SB)/64,$"\x17P|v\xba$\xf1\x89\x1f\x94%z\xacD.\xed\xab\xd6\x9b\xf45Mdm\x97A}\xcf\xdb{\x9b\xf0\x80\x9f\xbf\x05\xf7\xf2\x7fp\xc3\xf6\xeb0\xdc=\xee@=\xa9\x1dVm%U\xbab\xbf~\xf5\xbf\xa6;\xd6"
+DATA ·d+17536(SB)/64,$"\xaf\x0d(\x0f\xcb\xabwC\xe9V\xa4sdHOZ\x17h\x0eMI\xd1\xe0\x9f@\xa6\x82xy\xe9\x02\x83i\xa3\xb5\x0f\xfa/\xa0\xb8]\xb2\xb5\xa8$'c72S\x0bX\xe0;?\xc8{\x9d\x15\xbf\xc7\xbf\x9f\xf2"
+DATA ·d+17600(SB)/64,$"\xb8:\x17\xc3\x0f\x0e\xdf\x8f{\xdc\xf1\xa7\xd3.\x82\xe0\x14\xe6\xbd\xf0\xbcK\xde\xbd\x8c<\xb7\xefM\xefe\xe3\xd1\x1f0\xaf\x1f\xbe\xfb\xb8\xac[n~xty\xf0\xa0`\xf4\xff`\xc3\x8f\xee\xc3\x87pJr\x0f\xa8P"
+DATA ·d+17664(SB)/64,$"7\xbd\xed\x83\xcd\xb9p\x1a\xbeCt6g\x0f\xcaC(Agq\x1d\x9f\x01\xd4l\x0d\xefQ?&\x15\xdf\xf6\x81UC7\xd4\x12\xee\xae\x086\x81b\xf3\x81kT\xfc\x92\xbb\xdb\xbd\xa1\x1b`\xc5\xd7\x05\xcb\xfe\x98g"
+DATA ·d+17728(SB)/64,$"\xfe^\xaf\xf3\xae\xaamk\x07\xe0\x1eSu\x8b\xed-WZ\xbc\x00R\x12\xac\x0f\x0fA0\xfd\xe1\xd1\xd0\x1b\xdd\x81\x0ap\xb96\xf8\x84\xb9\xdfo\xec@\xdaW\xed\xb9P\x93\x1d\x03\xd3p\x09\xe8\x8e\x06r\xe0\xf5\x93\x8f"
+DATA ·d+17792(SB)/64,$"^\xbc\xa3?\xf0*\xd4!\xdf\xa9w\xcfWz0X\xe9^T\xe3a\xa7F\xc8\x02a\xe7(\x9a`\x9a\xa5?\xdc\xa9\xec\xbe<\xed\xdez\xfa\x0f\xec\xd0C\x1b\x08\xe1\x08'\xd5\xb5B=\x09I\xee\xe6\xc5\xc9\xf6\xb4\x17"
+DATA ·d+17856(SB)/64,$"b\xe6\xd9J\x1a\xd96\xbcvw\x91\x9e\xc7\x01\x0e\x05!\x81\xcf\x0c\x13\xb0\xa0\xe8\xdcYF0\x98\xd4\xa9\xa9\xa3\xdc/^\xd9\xf3\xf5\xc6\xe2U\xb0\xf6\x0cy\xddt\xc5\xada\xd9a\xf8\x01\xd4E\xcf\x84\xed_A\xcd\xc7"
+DATA ·d+17920(SB)/64,$"\xa3\xeb\x05\xb7\xae\xc9'w6\x02\x7f\xfe{x\xb6(O\xce78\xd7\xe4B\xfc\xda\xf03.kpT\xdbm\xf7\xc6\xfd\xf7\x94\xc9J4F.\xa5py\x877Jh\xd1\x18\x0a\x13<\x11\xa8\xf4b\x14\xbe4\xac\x92"
+DATA ·d+17984(SB)/64,$"\xcbe\xecO\xa5M\xabD\x85\xb0N\xbfH|\x04\x5c\xf0\xb5g\x89J\x84\xe7\x8d\xe2\x18\x19\x1e\xbd\xd6I\x03\x1d\x8f\x00\x97\x99{\xa3\xde\xf0\xd3\x1b\xbc\x94\x09[H%\x9657\xc2\xbfn\x1f\xbf$\x1a\xcdR\xef\x81\xd1"
+DATA ·d+18048(SB)/64,$"\xfd\x22\xe5\xc1Q\xb3hA\xdf\xcf\xdcq\x8d\xc5\xfa\xa7/r\x13\xcc\x9d\xa3\xd0\xb9;lq\x18\xf7\xe7\xe0\xfc\xc8\xee\xdb\xbe\x1d\xac\x89\xb7\x16\xc6\xd3\xd0\xbd\x8992\xfc4r\xb7\xfe_\xdb\xd6\x88\x89\xe1\xa7y>\x10D"
+DATA ·d+18112(SB)/64,$"\xc9\xb59x\xddV0}U\xe6\xbc\x99\xfcsb\xd6\xcd\x93\xaem\xa0\x88~\xe76A<\x86jXg>\xe7@\xd8c\xc0\xc7P/\x84\x88\xf7\xee\x8d\x12\xff\xc5\x82-\xac;\xf9\xb7p:\x1e\x1d\xb8\xf2\xe6\xf3t\xfd"
+DATA ·d+18176(SB)/64,$"\x87\x94\xec\x15\xca`\xf8\x18\xe2[%\x16n\xab\xd1\xb4\xe4\x0c?\xed\x11!\x7fL\xcd\xee\x0c\xc1\xec\xdcoA\xc5\x01\x13\xf3\xf5\x9c8\x9dz\xf6\x96\xd7\xac\xa3\x99\x8b*\x0d\xeb\xc7\xb5-\x10P\xab\xfak\xa7mDx\xd0"
+DATA ·d+18240(SB)/64,$"\xd1\xf7D\x8f\xfc\xba\xd7}\xc9\xdd\x9516\xa7H7t\xd2'b\xe0;\xb4\xe1MG\xcb\xb5\x97\xae\x8dk@\x95\xa1\xc8kT\xae\xee\x8e\xa0G71\xba ?T\xcc\x8e\x84\x7fA\xf0\x86\xe7 \xfa\xe43\xd8\x03\xf2"
+DATA ·d+18304(SB)/64,$"A\x8cx#\xce\xe9\xe9\xb3I\xfcB\xa2\x5cR\xb3\x17\xd2h\x0b\xc8\xae9n\xb8O\xa9O\x18\x07RM\x9c\x9f`O*\xe8\xec\xcc3\x86\xbbs\xc4\x85\xf4^\xfe(aq\xf0\xe5\xa3\x059\xfc)]\x7f\x91\x00\xb4\x9b"
+DATA ·d+18368(SB)/64,$"\x8bG;l\xc6\x11&\xd7_dv<\x0b\xafQ\x11\xaf\x81w\xc3\x0e\xa3\x87\x9f\xbc\x1c\x15&\x93\xe2*\xc2T\xc2,u\xdf\xbb\x8cf\x0c\xf3\xee\xe7v\x0b\x15\x8aE\xef\xb5\xc4PU\xe7y\xe6}=F\x9c>\xee*"
+DATA ·d+18432(SB)/64,$"\xe2{W\xceu\xb0\xfc\xbaJ\xff\xb7o\x95\xed\xdb\xdf;\xe1<\x91\x03*\xe1i\x95\x9c\x0f\x1faV\xde\xc1\x8fkw\xc6\x9e\x1f\x00\x9da\xd8\x18\xb8<\xc3\xf1\xb9\xa3L\xb9\x8b\xee$\xce\x0a+g\xf9c\xf8\xec\xef\x16"
+DATA ·d+18496(SB)/64,$"q\x9f}\xb9\xc4o{vX\x9a^@^s#\xf5R\x82\xe0\xe1\x82\xe8F4\xa0\x22\xf9\x06\x8a\xa8\xd2\xc2\x02^QH\x81[\xbbw\xe2\x9a\x97\xdd\x85\x97\x10\x91\xb0v#d\xf7\xa6\xd9\xfd~\x88\x83v\x01\x0e\xc4\xc9"
+DATA ·d+18560(SB)/64,$"\xe9b\xc9\x82\x15\x18\x11\xa54\x91\x01\x81,Y\x1e\xef\x5ce\xec\xf8Mk\x8eC\xcd|`\x85\xdc\xf0\xf8\x18\x16S(v&\xec\xd5\xbb\x88\xe0e\x95\xa2'p\xe4]i\xc3j>\xa8\x06\xe3\xbc\xe4\xde\xdcr8\xbb\x89"
+DATA ·d+18624(SB)/64,$"_\xc85\xe4M\xcf\xd3`\xda\xda\x17\x05\x96\xbe\x92\x02\xaf\xd8\xb7\x9b\x0b\x98\x1b\xbb\x5c\x87\x94<t\x94\xfe$\x98\xde*o\x1aG\x172\x98>\x03_\x8c\xda6d\xd3\xf2\x8a\x8aF\xb1\xb3\x16FX}\xb6\x91\xd6\xc1\xeb"
+DATA ·d+18688(SB)/64,$"H\xa9g'\xad2\xce}*\x9a?\xa4\xcd\x83}\xb4qLH\x04\xfdp\xf8\xb1\xb4=\x12{#k\xe7\xdfF\xdb\x00\xb2\xc6J;\xe8\x1cq\xe8[\xae\x8c\xe4u\xe4\x9css\xd2[\xb4\xe9\x0d\xb3\xd0\xb5}5X\xb6"
+DATA ·d+18752(SB)/64,$"\xf8\xe1\x18~\x0dM\xc9\x0d\xc898\xd3o\xa2\xa9.Xw\xc4\xdf\xd8\xd3Ub\xd5;\x01+!\xa7\xc8\xe5\xf5\xb66r\xc3\x95\x81\xfd\x9e\x94\xc4\x89l\xb7F\xd6\xe5\x8fR/\xb8\xaa\xf2\xf2\xef\xb6>\x9eG\x14\x1e\x0c"
+DATA ·d+18816(SB)/64,$"\xb8\xd0\xcb?\xb29\xa5f0\x0d\xf0\x07\xed\xb3\x93\xbbn\x8a\x5con0\xf1&\xef\x82\xa7\xac\x07\x97\xd5\xea#\xe3\x0e5q\xc1\xbb\xd8\xeb\xfdy\xa7\xe3\x89r\xc4\xd9\xe1\xda\xd6\x0d\x19\xf5c\x9e\xc2>I]<\xf6x"
+DATA ·d+18880(SB)/64,$"\xce\xb3\xfb\xee\xcfodS\x7f\x12\x9e\xa2O\xcf_\xcf\xa3\x96cbr\x9f\xdf\x8c\xd0{\xf6\x90\x9b\xb1\x913{\xf6u\x0eo\xbe\xd0\xcc\x17\xf2\xda\x9bK\x5c\x00N\xfc\xcaJ\xaa\x15\x8c\xad\x0a\xce\x9b\x8a\xadQ\x8a\x5c\x84"
+DATA ·d+18944(SB)/64,$"\xec\xfc\x8ckg\x05\xa5\xac\xba\xef^<g\xff\xfe\xf0\xbb\x87\x05\xd3\x02\x9dh\xd9\x0fE\xe2l\x9b\x08\x06\xe1^\xd1#\xd4}T\xc7g\x9b\xb7\xb2\x85i\xedS\x16\xd6u\x1b_\xb38\x97Z\xb0\xc9w\x98\x9c\x15/+"
+DATA ·d+19008(SB)/64,$"\x9d\xb8\x0b\x8d\x1f=x\xc8b\x92\xb0\x17\x5c\xd6`\xea@s\xcd\xb0\x96\xd65\xc6\x00\x01\x9c\x19f\x8d\x03\xf7\x8f`\xe3\xc3\xd40YT>\xa7\xef\xe5{\xbb\xb3O\xf0\xd71\xc2'\xcdF\xae#OUoO}\xb9<"
+DATA ·d+19072(SB)/64,$"@\xe7\xfe\xec\xa37\xad\x83\x94\x81~1\xef\xf9\xe9$q\x97\x94k\xb2\xa7ZQ\x87\x5cU\x13{Z\xcc\xc8\xd1\xe0h\xe8]G=\xb9\xd5C\xd2\xd6\xcb\xe5\xc1\xaf\xcd\xdaR\xf2\xe0X6\x0b\x94\xbd\xa0v\xd0\xb5a\xfa"
+DATA ·d+19136(SB)/64,$"\xc2\xfb\xc1\xd8-\xdaAQ\xea\x92[\x9d\xbe\x0fv\xf7\xae%\xcf\xb3%l\x11F\xdf\x1e\xeb\xf1\xe8T\x18\x87n\xe4\xa2\x8aio\xbe~\xed\x16\xe3\x12%\xba7\xbb\x08\xff\xa6m\xc4\x10\xf5w\x10\xbfI\xa9\x8f\xbeR~"
+DATA ·d+19200(SB)/64,$"\x13\x00\xe4\x92\xf7n\xd3\xab \xc7\x98\xdd\xf8\x90\xdb\xcd\xd7z\xd7|\xbd\xee\xcf\xd6Z\x07Q\xd9!\xb7\x7f\xd2\xd6\xfdI\xbbs\xc3YKG\x98\x98q\xd3\x85\x1f\x85\x91\xbd\xe7\xa7\x83Ad\x18}\xd7.\x99h\x8c4\x17"
+DATA ·d+19264(SB)/64,$"@kMQ\x8e\x14x\x0a\xf7\x1b~\xd10\xca\xa2\xd7\x99L\xbb\xbb\x15I\xa0\x99\xe1\xa7%\xfb\x87\xe0\x9fP\xce\xe2J\xea\xb6a\xf2\xb4i]\xd6\xd4s\xc1?5Bk\x9bg\x0b\xb6\xbb\xd6_\x12Q\xf6&\x8b\x8c\xf5"
+DATA ·d+19328(SB)/64,$"n2\xaamNch\x94\x8c\xd4u\x0a\xf0\xb0\x01\xbaf@.\xcf\x1a\x1c\x8bDE#t\xd5|\x06m\xefO\x12*bZ\xa22\x8a\x90\x02\xa6\x84\xd6\xe9\xce\x84=\xd1}et\x9b\xd4\xbf0\x80\x96\xe4\xfc{/\xdb"
+DATA ·d+19392(SB)/64,$"\x99\xdc\x09\xca\x11\xc1\xf4.\xe5\x95X\x1a\xdbu\xc6~3\x85S\xd8\xa8j\xef\xa2/\xb9\xdf\xd3H\xf6\xd9|\xe0\xf2\xc5B\xfc\xc7\xd4\xc1\xb3\x95\x11\x96E\x03\xfe\x81;\x95(\x9f\xc8N@\xbfeY\xbe\x13\x11\xd1T\xc3"
+DATA ·d+19456(SB)/64,$"Q}\xd8\x03Fx\xfd-\xfb\x9bE\x04*?\xd9\x1f\xa3e\x9b\x89\xa6\xba\xff\x00\xa3\xcd`R \xcc\x13'\x04\xc2;i4\xbb\xd2U%\x03\x04(\x0f)\x14\xcb\xbe\x89\xe1Tl&5\xe3.\x98f\xe95\x06\xd2*"
+DATA ·d+19520(SB)/64,$"\x83\x86Hy\xd1I\xde\xb7+ \xbc\x8e\xf8\xce]\xce\xd9\x00*+6[!\x0eE\xa4\xf0\xce\xaa\x0aMr\xd6S\x17\xa8\xf6\xc0\xb3\xfeV\xcf\x05\xdb\xf2\x80\xb2\xe0$\xf5\x07\x87x#}\xb0\xb7\xda}'D\x1e<\xb0"
+DATA ·d+19584(SB)/64,$"-\xc6\xa3Q6\x1dn\xe3u<K\xb8\xa0\xbd\xd3\x9f\xbaC\x95(\x82\xbd\x93\x1a\x0b\x93\xcf\xa1lV\xb2\xd7n\x15\xe2#\x88\xd0\x02E8v.\xea\x1a\xfe\xb5\xb2\xb0\xf8\xbc\x10\xa2r^\x8e\x0e\x9e\xa5\x133\xad\xe1u"
+DATA ·d+19648(SB)/64,$"\x81wJ\xb4\xd5Tl\x02\x1e~x\xc1\xdc\xc8\x9aI\xed\x9f\xd1\xc9K\xf62\xcd\xc9o\xbb\x90:\xd6\xf9S[\x85\xd4\xc4\x94v\x93\x08C\x9f\xe8\xe0\xe6\x10M\xda\xa4o\xc1)z\x86\x11R\xbb\xdbF\x1b\xe6\x1f\x05\xa1"
+DATA ·d+19712(SB)/64,$"\xc9\x9dg\xbb\x9c\xfd\xf4\xa0\x87\x1fE\xa8\xbb\xed\x05\x94\x15\xa4\x88e8\x1f{\xbd\x11\x8b]7\xc8\xba\xe7\xf0\xe7\xee\x93\xb1\xd5\xd0\x85/|\xb0\x8b\x98\xea\x84\xdd\xa9\xf3Di?\xc8\x17\x1bcz\x08\x0bA\xf66\x81h"
+DATA ·d+19776(SB)/64,$"L\x08\x043\x9b\x15\x94\x14z\xb6\x0b\x1f\x08\x0a\xce\x8b]\x1f\xe5\xfd\x07\xb3\x8fN\x9d\x8bV\x1f\xa1\x80\x1d\xc4\xa3\xc0'0\xc0\x05\x8a(V\x84\xa4\xd4ohW\x80J\x8d?\xe7\x93{jX0P\x15\x16\x0c^R\xf7"
+DATA ·d+19840(SB)/64,$"m\xd2_\xbf\xb2&\x8czh\xd8^_n\xa28\xd6~h:VxJ\xfcGU\x1a\x980\xf9%T\xc1\xc7V\xdcp/q\xed\xcf\xa8\xc1\x01k\xdc\xfe4c\xcdU\xc7\x5ck7\x93]#\xb4s\xb2o\x88\x08\xe1"
+DATA ·d+19904(SB)/64,$"&\xc3t\xa7\x06!\xf5\xc0\x02Cr\x07q\xd8\x1d\x19\x83\xbe\x01=\x9a?\xee\xe0Bg\x0da\x94F\x16'\xe8\x8c\xae\xa2\x9e\xd8S\x8b\x93m\x00E)\x96\x91O\x01\xd9h\x95\xe94\xea\xcd\xd8\xf0t\xc4g\xc5\x0c\xfb"
+DATA ·d+19968(SB)/64,$">\xb0\xe0\xee\xb3\x07W\xe1<t\x9bv\xf4\xfa4\xed6\xe1\x19`\xab\x0a\x93\x12K{\xc1\xfd\xb9o\x18\x07,\xc4V\xc0\xae\xfbK\xc7\xfbI.\xed\xb6\x12\xf1\xda\xe0\x06d\x0b\x1d\x16\x91\xd7plL\xee;\x0e\xa5\xc7"
+DATA ·d+20032(SB)/64,$"GPUWm\xd3nU\xec\xb6\xad\x04\x93\xeeiP\x90J\xd3\x96\xe8J\x9cH\xa5\x9d\x9b8\x10 Z\xd5\xd7\xb7#\x9d5\xb2x\xdfJ[\xf5\x92\xa1\xda\xa1CX#;\xe9L\xb1\xf3VWJ\x1c\xf4\xa2\x91*\x96"
+DATA ·d+20096(SB)/64,$"\xbe\xa6\xd3X|\xc7\xf3\x8f\xe4d'\xa7\xf4\x84\xe7\xf1h\xd4_\xcf\xbf6\x7f\xe0\x0d\xb3\x8c\x22\xe4:\x1a\x8a\xb1r\xd75\x98\xfdc\xba+\x07\xea\x1e\x85H\xe5\xe3\x1d\x9d\xdawT&{t\xfe||\x03\xe3z\xe4\xb2"
+DATA ·d+20160(SB)/64,$"\x06\xb7\xf7\xbb\x5c\xd6\x82\x15}\xaf\xef\xda\xd8y:81\x84\x1a\xf9T\xc1\xe2\xf3\xa6\x96\x0bi\xea\x0b\xd6*+/\xde\xcb\x8aaO7R\x83v8\xb39W\x83]\xcel\xa7_\x0a\xcc\x07<\x83G\xbe\xcb\xc3\x02\xff"
+DATA ·d+20224(SB)/64,$"{3\xd7\xb20\xd6\xc4\xc7L4\x8b\x9b9\x99E\xeee\xbb\xd3x\x88f1\x94\xc5c\xe4\xbd\xcc\xfa\x07\xb5h\xa2s\x1a\xb6[\x10>\xa0\x90\xd2}\xfcy\xe7\xb2?\xe9V\xf6\x178\x94\x89f\x91\xba\x92\x013\x81\x89"
+DATA ·d+20288(SB)/64,$"\xf6\xf3\x01\xfeE\x8e`\xa7_\xc0\x0d,Tr\x0eb0\xdf\xf6\xc3U\xbc\x91\x9f~\x09\x14v\xd9\x1a\xbf\x90\xc3W\xd8\x92\xa1\xf1Svh\xd7\x8c{\xd5\x1b\xd5\x97\xd4\xbclEG\xab\xa9\x9c\xb3{\xe9\xe7\x9c\xe1\xbf\x93"
+DATA ·d+20352(SB)/64,$"\x8dM\xaf\x97\xb3\x89lL\xf4\x80\xfb\xe8\xde\xf9\x80\xcd\x1ae\xcc<O\x92`lr\xff\x22=\x84\xde\x04\xeb.\xfd\xed5\x80H%\xe3\x9a\x0dY\xb31\xb1$J\xf7\xb6\xa2\x8d\x82\xc0\xc3\x09}\xcd\xa0A\xb0\xc9*\xc1"
+DATA ·d+20416(SB)/64,$"lZv+\xd6'\xa6e&\xdb\xd2\xf9\x8f\xf9\xbb\x03\xb7\xed\x0f\x89\xf7Q\xee\x9cX\x19\xf0\xe6\xe7\xf4\xc69'Z\xa1e\xf3|\xd7\x9d\xc49\x1d\x13v\xdf\x5c\x9f\x831\xde\xdfKx{}\x8fQ\xc3f\x1a\xbb\x0d\x0e"
+DATA ·d+20480(SB)/64,$"_0lb\x01o}^>\xc7\x8c\x22`\x9d\x9f\x80\xe7\xe2F\xb5\xa6-!\xa7\x90\x7fgw4J\xef\x16f\x8c]\xc2\xd8\xaf\x8a\xe4\x1b\x9ds3v\xa9\x06\xae\xc2\xb0\xee\x953\x01t\x96Y\x8c\xbe\x93y\xfc\x9d\x7f"
+DATA ·d+20544(SB)/64,$"T\xaf\xabe\xf8{\xa5\xee-\xd6\x0do\xaf\x86\xfa\xf5\x10\xfdE\x15\x11,\xdcU\xed\xbe\xa3\xea\xc2\x0b\xcbp}\xee}\x1d\x88\xed_\xc93\xf1N\xd4-\xaf\xde\xda\x8c'p\xec\xfc\xfa\xee\xa5;\x81\xc8\x17\xe3\xe0\x18h"
+DATA ·d+20608(SB)/64,$"pt&\x1a\xa3\x9d\x9f\x9d}\x8b<\x021&\x9d\xb2\x03\x13|\xba\xff)!e\xc6\xb4\x96gB\xe1\x97\xccf\xeev5\x8f\x17Jn\x0c\xa3\x8f.s\x14\xd8C\x1a\x96Qa\xc6\xc4\x19\x0e<D%\xd9\x9c\x1f\xf6P"
+DATA ·d+20672(SB)/64,$"\xc5\xb3\xd6:\x09\xa2\xfd\xa4m\x0d{\xf9\xa35\xbc\xa1\xf1\xb5\x11\x0bL\xfcMx\xf6z\x9f\xb3\x7f=\xd1\xf8\xe7\xd3\x89K\x162\xc9/)ulk\x0a\xa1\xe7\x8d8'2\x1c\xb7[\xb5\x10\x93\xec_\xec~w\xc0\xf7"
+DATA ·d+20736(SB)/64,$"\xd9\xbf\xb2\xfc\xf1\xbf\xc0\xa6\xf1/\xa1K^U\xd8\x02\xe2\x89E#\xd4$\x03`Y\xe1{\x10\xf9\xa5\x5cN\xa0\xf0\xee]\xf8\xef\x9d\xf9\x5c\x94\xe8\xc3r\xe9\xde7(\x89\x06\x93\xfc\x0a*\xd8\xcfW{;\xb1T+\xa2"
+DATA ·d+20800(SB)/64,$"\x81 \xb4\xff\x0f\x00\x00\xff\xff\xbcX[o\xdb8\x16~\x96~\xc5\x89\x1e\x0a\xa9\xb5\x95\x16\xbb;\x18x\xea\x01zI\xa7\xc1\xa6\x17\xd4\xee\xf6\xa1-PF:\xb2\xb8\x96I\x95\xa4\xe2\x18\x89\xfe\xfb\xe2\x90\xd4\xc5\x8e\x93"
+DATA ·d+20864(SB)/64,$"\x9dy\x99\x97D\xbc\x9c\x8f\xe7\xfa\x1d\xd2\x5c\x8aTa%Y\x1e'm\xd2&q\xf2\xfcTg\x8a\xd7\xe6\xf7\x1fax\xc5\x14T\xfc\x0a?\xd9\x1d\xa0\x8dj2\x037a\xa0w\x22K\xdf5\x06\xaf\xc3@\x8a\x0c\x01\x00"
+DATA ·d+20928(SB)/64,$"\xec\xdc\x07\x91a\x18\x5cJi\xec\x94Q\x5c\xac\xc2 \xab8\x0a\xa3a\xc3\xea\xafY\xc9\x84G\xbai\xbfw\x1fa\x1b\x86\xa7\xa7p1\x1c\xb6U\xac\xd6`J\x84\x92\x89\xbcB\x05\x85T\x90\xe3\x15V\xb2\xde\xa003"
+DATA ·d+20992(SB)/64,$"\xe0\x06\xb8\xf8/fF\x03\x03\xbdaU\x05Nw\xe0\xc2H\xc2\xd3\xa8\xae0\x87\xb7\xcbw\x17P\xb3\x15\xea\x09lK\x9e\x95\xe0lv\xf8\xb4\x00\xdb\x12\x05^\xa1\xb23VL\x81Bm\x982\x1a\xa4\x9a\x00\x17\x048"
+DATA ·d+21056(SB)/64,$":\x1f.\x1b^\xe5\x13`Z\xa3\xd1@v\xad\x10\xb8p\x08\xb2Q\x19B\xce\x15fF\xaa]\x0a\xde,\xbc\xb2\x9e`\x0a\x09\xaent\x899l\xb9)aa\x0f\x9d.\x08\xfa\xcc\xef2#\x8f|d\xa6\xec\xd4\xdf4"
+DATA ·d+21120(SB)/64,$"\xda\xc0%\x82\x92\x8d\xc1\x1c\x8c\xa4C\x09P\xa1i\x94\xc0\xbcsZ:v)\xd7 \xa4u\x0e\x8a\x1cs\xeb\xd0Z\xc9\xbc\xc9\x0c\x97\x02\x1a\x8diX4\x22\x1b\xc9\xc4%\x94\xc6\xd4\xe9[\x07\x97\xec\x8d(\x13\x86\xf4H"
+DATA ·d+21184(SB)/64,$")\x11\xd2\xd72&\x888\xa1\xc5\xf1\xaa\xcd\x899E>\x93\xe2*}#\xd5\x86\x99sab\xc37\x98\xbe\x97\xdb8I?\x0b~\xfd\x9e\x09\x19'\x13\xf8\xc7/\xc9>@\x97Ds\xd8\xb05\xc6\xf7\xe7\x12\xc9\xad$"
+DATA ·d+21248(SB)/64,$"l\x99\xc9\xca\x1768\xb1\x90\x86\x17\xbb\xc1\xac$\x0ch\x9b\xf3\xd6\x9eMoHyk\xc1\xd6\xcd\x7fB]K\xa1\xf1\x8b\xe2\x06\xd5\x04\x14\xfe\x84\xc7~\xe5g\x83\xda8KyA+\xe9\xe7O\x17)\x05\x0a\xe6\xf3\x83"
+DATA ·d+21312(SB)/64,$"\xd0\xd9]\x81\xcd\xac\x91{\xb7\x16\x904\xf6\xca\x84A\xd0\x0ep\xef\xd0\x942\x87\x939D\x7f\x9c-#\x87Q\xa66U\xde.\x97\x1f\xef\x95?=\xedKA\xfb\x22\xc1\xdc\x96\x054\x22\x93\x9bZ\xa1\xd6\x98\x03\x139"
+DATA ·d+21376(SB)/64,$"lKY!d\x92\xd2\xc2\x80\x14\xd5.$\xb4\x9f0\xb7:\xbc\xaa\xa4\xc0\xd8~\xd1\x96k\x13'\x89\xdb\x90\xbeE\x96\xa3J_c\x15G/\xb2\x0ck3=\x13\x99\xcc\xb9XE\xc7\xf6|\xa2\x12\xb1+\xd5\x16fs"
+DATA ·d+21440(SB)/64,$"x4\x84\xd7\xb9\xf7f\xdf\xdb3\xd8\xb6\xe1\xbe\xc1\xd5`q\xb5M\x0b.\xb8.c\x17\xce6t\xd9{\x18l\x97\x8b\xa3L\xba\x90\xd9\x9adr,pLp\xe9gQ\xf9%*\x8d\xac$\x1d\x95-\xeb#yH\xb1"
+DATA ·d+21504(SB)/64,$"\xd0X\xa1c\xc4 c\x1aI\xe4\xf9\xb4\xcf\xc8\x9bv\x16\x06t\x0ak*3s\x91i{5\xef\xa4\xc2_\xc9\xb7\xa2\x22\xe6P\x13\x90kRr\x9b\xc6v\xcb\x1b7\x9d\x84\x94@'rm5\xb3+gJIE\xe9"
+DATA ·d+21568(SB)/64,$"\x12i\xa3\x90m\xb8Xu|\xa0\x9b\xba\x96\xca`\x1eM\x9c\x0a\x0b\xc3L\xa3\xcf\x85A%X\xe5x\xc9\xca'\xe1\x90fm\x188\x07\xd9j\xdc\xab\xc4\x09<K\x8e:\xfc\xae\x17\xbff\xe5w\x98\xf7\x927m\x18\x1c"
+DATA ·d+21632(SB)/64,$"\x8d\x87\x0b\xd5qf\xe9\xd0\x83\x1c+4\x18\xdf=e\x02Yy\xc0&\x03tK\x7f\xb6>O\xe3$]\xa0\x89\xa3W\xae\x1a\xa6\xcb]\x8d\xd1\x04\x22\xca\xfbS\xcb\xddS\xe7\xbf\xe8\x98\x10\xcbJ\x9c\x92\xa8\x92\x15I\x09"
+DATA ·d+21696(SB)/64,$"9\xcdh\xcem\xb6\x11\xf5\x12#?\x7f\xf87\x85K\xba\xe5\x85\xed\x986N\xf6\xb4\x19\x10o~\x1393l\x06\xd1\x93\x03B}\x12}\x13\xdfD\x94\xf4\x09\xe12\x80,\xaa)\xc2\xb398z\xc5\xed\x92gkT"
+DATA ·d+21760(SB)/64,$"\xf1\xb3\x7f\xc1c7\xb7\xc0L\x8a\xbc\xf7-\xedO\x17F\xd6]\xfa\x1f\xcb\xef\xe7\xd3=\x1eH_\x135$\xb3=\xfa\xf1\x1b\xb3\xd2N\xdfo\x98k\xc1\x9di\x9d\x1d\x9d\xb8U\xe7\xd5}\x103\xabn/C$q\xc7"
+DATA ·d+21824(SB)/64,$"\x01\xdd\x9d\xe2\x90c\xa0\x94U\xae\xe1\x92ekw1P\xbe\xe24\xf5Q\xc7\x95\xb6\x87\x93\xa0\xd7\xd2\x93ihv5\xde\x05\x1c\xeeEG*8\x0c\xb4\x0d3pah\xc3\xa6\x02\xa0\xa0Vap\xd9\x14@\x83\x9dA"
+DATA ·d+21888(SB)/64,$"\x9d\xbel\x8a\x02U\xcf\x0e\xf1\x16\x1e\x1f\x1e\x94\xc08\x85\x06X[\x11\xbc\x80m\xea\xe7N\xe6\xf0\x94\xe6\xc6\xc5\xda/\xce\xc1}\x84A\xe9\xa8\xa3Kb\x8b\xd1m\x9a\x8f\xa9\xe0\xbd4\xefd\xce\x0b\x8e9\xdc\xdeB\x99"
+DATA ·d+21952(SB)/64,$"\xfe1\xae\x91\x81\xf2m\xa3\x8a\xe0\xf66\x0c\x82\x13w\xf9\xd3\xe9[\xa6?*,\xf8u| gk+\xe9\x8a\x8b<\x13\xb9\xda\xde\x1e\xb80\xbdkvrh\x9cu\xec\x1c\x8cj0\xa4\xeew\xc5*\x9e3#\x95\xb6"
+DATA ·d+22016(SB)/64,$"=\xce\xb2\xb8\x06Y\xd8\xc8J\xc5W\x5c\xb0\xaaoz\xb9\xb4L\xc8\xea\xba\xda\xf9\xdb\xd4\xd05\xa5\xc00(]\x07\xebt\xbf@\xb12e\x94\xf4\x0bg\x86\xadF\xc3\x0b\xa6\xcd\xb4\xf3\xd9h\xdewI\xdb\x08u\x94\xfc"
+DATA ·d+22080(SB)/64,$"\x99p\xc75|\xfdN9\x92@\xcc\x85\x99\x00Z*>\x8c\xf9\xbc\x8b\xf9\xc3D\xd3z)\xeb\xaf!E`\x9b^6E\xea\x0ft\xfb\xfa\x95c\xd1\x88\xeb\xff\xa7|\xd7\x9b\xbd\xa2'w\xce\xb4\x87\x5c\xca|\xe7\xd2\x90"
+DATA ·d+22144(SB)/64,$"\xce\x7fI\xa5`\xd9Kj\x9au\xa5A\xce<\x179^\xc7n\xbc\x94\x17r\x8b*&\xd9d\xe2\xbd\x13G\xcfOi\xe2\xf7(q\x99L\x10\xcf\xbdO\xe8{\x0e\x15\x0a'\xe3s\xe68\xe5\xfb\xd0N\xfa\xab\xea\xb9\x91"
+DATA ·d+22208(SB)/64,$",\xeee\x9f\xd0\xd7`\xed\xc2\x12C\x92X\x86\x7f m\xbb8\xdd\xbb\xcf\xa2\x7f\x9d\xd5R\x7f?\xd2\x0f\xee\x5c\x0b\xeeh\xf00n-\xf5\xec;E\xec\xe6f\x0a(\xf2\xb6\x0d\xed'/ \xfd\xc8\x14\xdb\x10\x01\xf1*"
+DATA ·d+22272(SB)/64,$"\x7f\xc7\xb8\x80\xb6\xb5/\xbf\x98z\xb16(^\xe4\xb9\x1a\xder\xa8\xec\xdbn\xf4\xbc[\xe3\x0e\x0e\xa6\xf0\xda(\x96\xed=\x02\x09\xeb\x83\xa8v=\xfd\x95X\xd5^\xccNt\xfa\x9c\x89L\xedj\xaa\xbc\xb6\x0d\x83\xc66"
+DATA ·d+22336(SB)/64,$"\xea7\xbc\xc2\x0e\xc9\x9b@\xab\x89\xcf@.\xb8\x89\xfd\xc5\x88\xad\xd2\x97RV\xffa*~DGL \xa2\x7f\xd1\x04\x0aVi\x9c@T+.\x8c\x06;\x9b\x1c\x8atjN \xa2\xcf\x91\x18\x0d;\xc6p\xac\x82\xd7"
+DATA ·d+22400(SB)/64,$"\xdc\xf4\x08.T\x16\xc3[O\xbd\xce}\xd1e \x1a\x86\x03\x8a\xe7\x1a\xc3\xd4\x0a\x0d\xfc\xe8\xdf\x8b?\x1e\xc4\x1f\xc2\xe2\xd5BA\xe8\xb3_\x9f\xfe\xfa\x94>\xb4\xcc\xd6\x04\xc7\xf2\x9c\xee\xf9?\xe8\x18\xbf\xed\x08\x1aE\x94"
+DATA ·d+22464(SB)/64,$"X\xb8\xd2S\xfa\xect]^,\x80\xc6\xbc\xe0\x193\x08?\x0a^\xa1\x85j4\x1e\xc3Y\xe3\xce\xc3\xacq7F\xa1\xfc8\x94>\x1e\xecC\xc8!\xf8\x13\x88\xdc\x80\xc0\xa7\x04\xd6\x9d\xe0\xa6\x01{ \xff \xb7\x8f\xea"
+DATA ·d+22528(SB)/64,$"\x12\xaf\xa7H\xfd\x09s\xab\x86B\x96C\xa1\xe4\xc6+\x14%\xe3t\xea\x08m\xc3\xb8p\xe9Du`*\xed3\x96F\xa8\x94c`\xaf\xedG\xa64\xfa\xe6i3\xfa\xc6^F\xd8*\xfd\xac\xd9\xca\xae\x8c\xf8\xee\xb8\xd9"
+DATA ·d+22592(SB)/64,$"\xbc\x80Q\x9a\xbb&J0t5\xb2|O\x5c\xc8ecx\x95~B\x96\xd3\xb6x\x90H\xdc\x83\x91\xf6\xcd\xe7 \xb8#Y\xab\xed\xda\xb3#\x8dy\x01k\x876'\xbf\xa4\xaf\x91\xfc\xe2\x19\xa6\xeb\xd6K\xc57\x8b\x9a"
+DATA ·d+22656(SB)/64,$"e\xe8gb\xd2!I\x92\xdf\xee\xc0\x07\x0e\xc9_\xa4\xd7\xa4\x84\xbd\x88\xb5\x836'\xa3\xedR\xa7\x0b\x93\xa3R{\xc4\x16YWz\xeb\xe9\xc6\xea+c\x06Qr\xbf\x14\x8d\xdd\x83&y`W\xe4o\x87\xb4|v\xcd"
+DATA ·d+22720(SB)/64,$"M\xfc,\xe9\xde`\xa3\x98\x93\xae=5\x91\xa6o\x16q\x92~a\xd5:\xa6\xf4\xb2O\x8e\x9a\xde\xef\xce\x1f\xf4CP!A\xea\x94<\x7f.\x0a9\x19R\x22q\xff\x9c\xc1\xbc\xb0[\xd3s\xfd\x9a+\xffj\xe9\xdb\xad"
+DATA ·d+22784(SB)/64,$"\xe0U\xe7/\xaf\xbdl\xcc\x9e\xf6tfr\xffro\xdc\x1e`{p/\xa28x\xca\x19\xb2\xaaK\x15x%\xeb\xddR\xc6=[=\xfd\xe5\x9fO=\xdd%\xbf\xfd\xb5\x00z\x8c\xbf+\x82\x076\xda\x9e\xe4\x0c|\xf4"
+DATA ·d+22848(SB)/64,$"\xc8\xd6\xf9`-Uow/l\x01+\x8d\xb0/q{{ q\x8f.\x97\xd2\x94N\x8e\xa8\x99D\xba_\xe1\xe8\x01]q\xff\x83\x1a\xfdJ\xb1\xf0z\x8f\xd5n\xc3`\xf4;\x93\xfd\x99):\x8d&v\xbf\x9bS_"
+DATA ·d+22912(SB)/64,$"\xb8)\xfdU9:\xed\xae1\xa4?i\xe5\xeb\x96 .\x1c\xfd\x8b\xdc>\xcd\x97\x17\x8bx\xdc\x10\x1c\x9d[2\x16\xbcJz\xab\x1f\x04\xd9C\xf0b\xe1\x912\xfeS\xd1|8\x98\xfbN\xa1Z\xecJ\xf1\x7f\x00\x00\x00"
+DATA ·d+22976(SB)/64,$"\xff\xff\x03\x00\x03\xda/\x87\x8d\x16\x01\x00// Code generated by go-imbed. DO NOT EDIT.\x0a\x0a//go:bu"
+DATA ·d+23040(SB)/64,$"ild !imbed_dev\x0a// +build !imbed_dev\x0a\x0a#include \x22textflag.h\x22\x0a\x0aTEXT"
+DATA ·d+23104(SB)/64,$" \xc2\xb7blob_bytes(SB),NOSPLIT,$0-4\x0a\x09LEAL\x09\xc2\xb7d(SB), AX\x0a\x09MOVL\x09AX, ret+4"
+DATA ·d+23168(SB)/64,$"(FP)\x0a\x09MOVL\x09len+0(FP), AX\x0a\x09MOVL\x09AX, ret+8(FP)\x0a\x09MOVL\x09AX, ret+12(FP"
+DATA ·d+23232(SB)/64,$")\x0a\x09RET\x0a\x0aTEXT \xc2\xb7blob_string(SB),NOSPLIT,$0-4\x0a\x09LEAL\x09\xc2\xb7d(SB), AX\x0a\x09M"
+DATA ·d+23296(SB)/64,$"OVL\x09AX, ret+4(FP)\x0a\x09MOVL\x09len+0(FP), AX\x0a\x09MOVL\x09AX, ret+8(FP)\x0a\x09RET\x0a/"
+DATA ·d+23360(SB)/64,$"/ Code generated by go-imbed. DO NOT EDIT.\x0a\x0a//go:build !imbed_de"
+DATA ·d+23424(SB)/64,$"v\x0a// +build !imbed_dev\x0a\x0a#include \x22textflag.h\x22\x0a\x0aTEXT \xc2\xb7blob_bytes"
+DATA ·d+23488(SB)/64,$"(SB),NOSPLIT,$0-4\x0a\x09LEAQ\x09\xc2\xb7d(SB), AX\x0a\x09MOVQ\x09AX, ret+8(FP)\x0a\x09MOVL\x09le"
+DATA ·d+23552(SB)/64,$"n+0(FP), AX\x0a\x09MOVLQSX\x09AX, AX\x0a\x09MOVQ\x09AX, ret+16(FP)\x0a\x09MOVQ\x09AX, ret+2"
+DATA ·d+23616(SB)/64,$"4(FP)\x0a\x09RET\x0a\x0aTEXT \xc2\xb7blob_string(SB),NOSPLIT,$0-4\x0a\x09LEAQ\x09\xc2\xb7d(SB), A"
+DATA ·d+23680(SB)/64,$"X\x0a\x09MOVQ\x09AX, ret+8(FP)\x0a\x09MOVL\x09len+0(FP), AX\x0a\x09MOVLQSX\x09AX, AX\x0a\x09MOVQ\x09"
+DATA ·d+23744(SB)/64,$"AX, ret+16(FP)\x0a\x09RET\x0a// Code generated by go-imbed. DO NOT EDIT.\x0a"
+DATA ·d+23808(SB)/64,$"\x0a//go:build !imbed_dev\x0a// +build !imbed_dev\x0a\x0a#include \x22textflag."
+DATA ·d+23872(SB)/64,$"h\x22\x0a\x0aTEXT \xc2\xb7blob_bytes(SB),NOSPLIT,$0-4\x0a\x09MOVW\x09$\xc2\xb7d(SB), R0\x0a\x09MOVW\x09"
+DATA ·d+23936(SB)/64,$"R0, ret+4(FP)\x0a\x09MOVW\x09len+0(FP), R0\x0a\x09MOVW\x09R0, ret+8(FP)\x0a\x09MOVW\x09R0, "
+DATA ·d+24000(SB)/64,$"ret+12(FP)\x0a\x09RET\x0a\x0aTEXT \xc2\xb7blob_string(SB),NOSPLIT,$0-4\x0a\x09MOVW\x09$\xc2\xb7d("
+DATA ·d+24064(SB)/64,$"SB), R0\x0a\x09MOVW\x09R0, ret+4(FP)\x0a\x09MOVW\x09len+0(FP), R0\x0a\x09MOVW\x09R0, ret+8("
+DATA ·d+24128(SB)/64,$"FP)\x0a\x09RET\x0a// Code generated by go-imbed. DO NOT EDIT.\x0a\x0a//go:build"
+DATA ·d+24192(SB)/64,$" !imbed_dev\x0a// +build !imbed_dev\x0a\x0a#include \x22textflag.h\x22\x0a\x0aTEXT \xc2\xb7"
+DATA ·d+24256(SB)/64,$"blob_bytes(SB),NOSPLIT,$0-8\x0a\x09MOVD\x09$\xc2\xb7d(SB), R0\x0a\x09MOVD\x09R0, ret+8(F"
+DATA ·d+24320(SB)/64,$"P)\x0a\x09MOVW\x09len+0(FP), R0\x0a\x09MOVD\x09R0, ret+16(FP)\x0a\x09MOVD\x09R0, ret+24(FP)"
+DATA ·d+24384(SB)/64,$"\x0a\x09RET\x0a\x0aTEXT \xc2\xb7blob_string(SB),NOSPLIT,$0-8\x0a\x09MOVD\x09$\xc2\xb7d(SB), R0\x0a\x09M"
+DATA ·d+24448(SB)/64,$"OVD\x09R0, ret+8(FP)\x0a\x09MOVD\x09len+0(FP), R0\x0a\x09MOVD\x09R0, ret+16(FP)\x0a\x09RET\x0a"
+DATA ·d+24512(SB)/64,$"// Code generated by go-imbed. DO NOT EDIT.\x0a\x0a//go:build (mips64 "
+DATA ·d+24576(SB)/64,$"|| mips64le) && !imbed_dev\x0a// +build mips64 mips64le\x0a// +build !"
+DATA ·d+24640(SB)/64,$"imbed_dev\x0a\x0a#include \x22textflag.h\x22\x0a\x0aTEXT \xc2\xb7blob_bytes(SB),NOSPLIT,"
+DATA ·d+24704(SB)/64,$"$0-8\x0a\x09MOVV\x09$\xc2\xb7d(SB), R1\x0a\x09MOVV\x09R1, ret+8(FP)\x0a\x09MOVV\x09len+0(FP), R1\x0a"
+DATA ·d+24768(SB)/64,$"\x09MOVV\x09R1, ret+16(FP)\x0a\x09MOVV\x09R1, ret+24(FP)\x0a\x09JMP\x09(R31)\x0a\x0aTEXT \xc2\xb7blo"
+DATA ·d+24832(SB)/64,$"b_string(SB),NOSPLIT,$0-8\x0a\x09MOVV\x09$\xc2\xb7d(SB), R1\x0a\x09MOVV\x09R1, ret+8(FP)"
+DATA ·d+24896(SB)/64,$"\x0a\x09MOVV\x09len+0(FP), R1\x0a\x09MOVV\x09R1, ret+16(FP)\x0a\x09JMP\x09(R31)\x0a// Code gen"
+DATA ·d+24960(SB)/64,$"erated by go-imbed. DO NOT EDIT.\x0a\x0a//go:build (mips || mipsle) &&"
+DATA ·d+25024(SB)/64,$" !imbed_dev\x0a// +build mips mipsle\x0a// +build !imbed_dev\x0a\x0a#include"
+DATA ·d+25088(SB)/64,$" \x22textflag.h\x22\x0a\x0aTEXT \xc2\xb7blob_bytes(SB),NOSPLIT,$0-4\x0a\x09MOVW\x09$\xc2\xb7d(SB)"
+DATA ·d+25152(SB)/64,$", R1\x0a\x09MOVW\x09R1, ret+4(FP)\x0a\x09MOVW\x09len+0(FP), R1\x0a\x09MOVW\x09R1, ret+8(FP)"
+DATA ·d+25216(SB)/64,$"\x0a\x09MOVW\x09R1, ret+12(FP)\x0a\x09JMP\x09(R31)\x0a\x0aTEXT \xc2\xb7blob_string(SB),NOSPLIT"
+DATA ·d+25280(SB)/64,$",$0-4\x0a\x09MOVW\x09$\xc2\xb7d(SB), R1\x0a\x09MOVW\x09R1, ret+4(FP)\x0a\x09MOVW\x09len+0(FP), R1"
+DATA ·d+25344(SB)/64,$"\x0a\x09MOVW\x09R1, ret+8(FP)\x0a\x09JMP\x09(R31)\x0a// Code generated by go-imbed. D"
+DATA ·d+25408(SB)/64,$"O NOT EDIT.\x0a\x0a//go:build (ppc64 || ppc64le) && !imbed_dev\x0a// +bui"
+DATA ·d+25472(SB)/64,$"ld ppc64 ppc64le\x0a// +build !imbed_dev\x0a\x0a#include \x22textflag.h\x22\x0a\x0aTE"
+DATA ·d+25536(SB)/64,$"XT \xc2\xb7blob_bytes(SB),NOSPLIT,$0-8\x0a\x09MOVD\x09$\xc2\xb7d(SB), R3\x0a\x09MOVD\x09R3, re"
+DATA ·d+25600(SB)/64,$"t+8(FP)\x0a\x09MOVD\x09len+0(FP), R3\x0a\x09MOVD\x09R3, ret+16(FP)\x0a\x09MOVD\x09R3, ret+2"
+DATA ·d+25664(SB)/64,$"4(FP)\x0a\x09RET\x0a\x0aTEXT \xc2\xb7blob_string(SB),NOSPLIT,$0-8\x0a\x09MOVD\x09$\xc2\xb7d(SB), "
+DATA ·d+25728(SB)/64,$"R3\x0a\x09MOVD\x09R3, ret+8(FP)\x0a\x09MOVD\x09len+0(FP), R3\x0a\x09MOVD\x09R3, ret+16(FP)\x0a"
+DATA ·d+25792(SB)/64,$"\x09RET\x0a// Code generated by go-imbed. DO NOT EDIT.\x0a\x0a//go:build !im"
+DATA ·d+25856(SB)/64,$"bed_dev\x0a// +build !imbed_dev\x0a\x0a#include \x22textflag.h\x22\x0a\x0aTEXT \xc2\xb7blob"
+DATA ·d+25920(SB)/64,$"_bytes(SB),NOSPLIT|NOFRAME,$0-8\x0a\x09MOVD\x09$\xc2\xb7d(SB), R0\x0a\x09MOVW\x09len+0(F"
+DATA ·d+25984(SB)/64,$"P), R1\x0a\x09MOVD\x09R1, R2\x0a\x09STMG\x09R0, R2, ret+8(FP)\x0a\x09JMP\x09R14\x0a\x0aTEXT \xc2\xb7blo"
+DATA ·d+26048(SB)/64,$"b_string(SB),NOSPLIT|NOFRAME,$0-8\x0a\x09MOVD\x09$\xc2\xb7d(SB), R0\x0a\x09MOVW\x09len+0"
+DATA ·d+26112(SB)/64,$"(FP), R1\x0a\x09STMG\x09R0, R1, ret+8(FP)\x0a\x09JMP\x09R14\x0a\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec}ks\xdb8\xb2\xe8g\xe9W "
+DATA ·d+26176(SB)/64,$"\xacJ\x96\x8ci\xca\xceds\xb6\xe4\xd1ne\x12g&g\xf3p\xd9\xce\xce\xd9\xeb\xf5M\xd1$$cL\x91\x0c\x089\xf18\xfa\xef\xb7\x1a/\x82$\xf8\x90\xadd\xe6T\xdd|\x88E\x12h4\x1a\x8dF\xbf\x00L"
+DATA ·d+26240(SB)/64,$"&\xe8E\x16c\xb4\xc0)\xa6!\xc31\xba\xb8A\x8bl\x97,/p\x1c\xa0\x97\xef\xd1\xbb\xf7\xa7\xe8\xf0\xe5\xeb\xd3`<\xce\xc3\xe8*\x5c`t{\x1b\x1c]-\xd6\xeb\xf1\x98,\xf3\x8c2\xe4\x8eG\x0eN\xa3,&"
+DATA ·d+26304(SB)/64,$"\xe9brA\xd2\x90\xde8\xe3\x91s\x19\x16\x97\x93\x88F\xcf\x9e\xc2\x13\xc3\x05#\xe9\x02~.Cv9\xa1a\x1a;\xe3\xdb\xdb]D\xe6(\xa3(8\x0ai\xb8,\x82\x9fV$\x89_\x15\xcf\x8f^\xa3\xe00\x8d\xe8"
+DATA ·d+26368(SB)/64,$"M\x0eh\xad\xd7\xe3\x91\x93\x15\xa2\x02N\xe5\x0b\x929\xfc\xff\x09\xc9V\x8c$\x1a\x9c\x05\x16/\x9fC\xc3s\x92`\xf8Q\x83uq\xc3p\xd1\x87\x90\xf9\xea\x17\xc6\xf2_\xc24N0\xb5!;_\xb2J\x0b5\xd4^"
+DATA ·d+26432(SB)/64,$"d\xcb\x9c\xe2\xa2x^\x14\x98\x15\xa2J$\xdfM\x16\xbf\x93\x1czV\xdc\xa4\x91\x15H\xad-(7\x09Y\xb6$\xd6\xe2\x195k\x04'd\x91\xaa\x9az\xd8.\xf1\x17kKfa\x0e!\x9b\x14\x97\xe1\x93\xbf>k"
+DATA ·d+26496(SB)/64,$"k\xa8\x8bD\xf5o\xc6\xd0\xa4\x98M.\x19\xcb\x1d\xe37\xff\x0f\xf8\xc6\x91c\xd7EP[\x83b`Ws\xc1'\xba\xaf\xbf\x15Y\xca\xc9\x9bQ\x0ezI\x96X\xb5\xbb\xa2\x89z5Y\xae\x12F\xf2P\x14*\x18%"
+DATA ·d+26560(SB)/64,$"\xe9\xa2\x80\x9f\x8c\x97o\xa2\x12\xa6\xf1F,\x92d\x8b\xde\x1e}HI\x96\x1at\xc2\x94f\xb4:\x0f\xbc\xf1\xf8:\xa4\x08&T\xb6|\x17.1\x9a\xa1\xf9*\x8d\x5c\x0f\x09\xa4\xd1\xedx\x04%.Vst\xb6\xff\xec"
+DATA ·d+26624(SB)/64,$"\x1cX}<\x12\x135xC\x18K\xf0a\x1a\x930\x0d\x8eV\xec\x03I\xd9\xb3\xa7\xee\xc5j~6\xfd\xdb\xb9\xcf\xc1\x06\xf2\xa5\xe7\x0d\xa9\xf6\xb7\xa9\xa5\x1a\xc5lESt\xf1\xc3\x93\xc34\x02Bd1>\xcdN8"
+DATA ·d+26688(SB)/64,$"~\xa2\xb1so\xbcv\xbd\xf1\x18PG\x0b\xccN\xc3\x85\x1b\x87,Dg\x1c\xe1zg\x22\x1a\xfd\x04\xfd\xf9\xdb\xa0\xee\x88\xd2g\x80\x19\x97H\xc1\x8bK\x1c]\x15\xab%o\x82\xbf<\x0d/\x12\xdc\x8b\xaa\x06\xe4\x8d\xd7"
+DATA ·d+26752(SB)/64,$"c\xfb|\x14=8\xc5\x05{\x1b\x92\xd4]\xa2\xc7R\xf6\x05o=\xc0~2AQ\x962\x9c2\x94\xcd\x11\xd6UC!\x0aH\x81\x22@\x0e\xc7(K\x93\x1b\x80\xcf.1\xba\xc27\xf0\xa9X\xe5yBp<\x1e\x91"
+DATA ·d+26816(SB)/64,$"9\x7f7\x9d\xa1\xac\x08~\xc6\x0c\xa7\xd7\xae\xf3\xfa\xedO\x87/?\x9e\x1e\x9e\x9c~\xfc\xe7\xe1\xbf\x1d\xef\x80\x97y0C\x8e\x03M\x8fDo1\xa5P\xef\x12\x7f\x09^b\xe8\x9e\xec\xdc\x15\xbe\xf1\xc6#\x80\x0c%f"
+DATA ·d+26880(SB)/64,$"3\x94\x92\x84W\x1b\xf1g\xf4!M\xb2\xe8\x8a\x93\x0c\xca\xad\xcb\xb2\x0f\x8c\xb2\xf3%\x0b^\xe5\x94\xa4,I\xdd\xac\x08NX\x8c)\xf5\x91\xb3J\x81\xc4\x88eh\xc5\x01\xc9\x1eO\x1d\x8e\x11@\x1ceEp\xf8\x850"
+DATA ·d+26944(SB)/64,$"w_\xc2_\x8f\xf5\xabep\xbcJ\x81\x97\x14\x85\x8b+\x92\xbf\x9e\xbf\xc9\x80T.+\xa9|\xca\xa9\x0c\xf3\x91K\xc4\xe0M\x16\xc6\xafS\xf6\xc3\x13\xf7\x91h\x17\xc7\x1etn\x8f\xa3\xcb\x82\x93+\x92\xbbNc\x1cB"
+DATA ·d+27008(SB)/64,$"\x8a\x91(\xed\xa3\x023T%m\xd9\x0b\xc7\x034\xcda\xbf#J\x0f\xea(\x19\x88\xa8R\xa2\xb1\xd1<\xa3(\xf5Q\x08\xa3H\xc3t\x81Q\x98$\xafH\x82\x0b\x97\xb7\x04M=\x08\x03R\x94\x8c\x09oG\xc0w$"
+DATA ·d+27072(SB)/64,$"]\xe1r\xf0>jn\x08\x83_)a\xf84s\xc5j\x1a\xbc$E\x14\xd2\xd8;P#|H\xa9\xe8\x9a\x00\xc6\x82W!\x0b\x93\xb9\xeb\xe0/9\x8e\xa0\x91\xb2\xc4gJ\xa0\xe7\x82\x98\xe8a\xe1\xa3E\xc6\xd0\xc3k"
+DATA ·d+27136(SB)/64,$"\xc7G\xa9\x1e\xee\x06\x0e\xb2\xe5c\x1c\xc6\xcf\x93\xc4\x0d\xf9/L]\xefnHP\x1c\xc6\x9b#\xa1Z}\xce\x5cO\xa0\xc2\xdcex\x85]!\x88|\xb4\xef\xf9ho;\x18\xa1\x10\x84\xc0\xbc\xc0l\x18n\xefs\x9c\xba"
+DATA ·d+27200(SB)/64,$"\xe9\xdd\xda\xcer\x9c\x0e\xa5\x86&\xc5\xbf0%\xf3\x1b\xf7n-^\xf3\xca\x03\xda\x1c\xb8\x96\x8f(\xfeTJ/\xc6\xf2\xe0\x1d\xfe|\x8c?\xadp\xc1\x5c\xe7\xe7\xc3S\xc7G\xa0'\x04\xff\x9d\x91\xd4u&\xd0\x8a\xe7\x83"
+DATA ·d+27264(SB)/64,$"d\xf2\xec\xa2Jb\xef\x1a\x9d/\x81\xc3\xe4\x15\x0dD\x19\xe5\x5c8\x1e\xf1\xa5\x87\xaf\xc1o\xb2\x05\xe2:c\xf0\xd3j>\xc7t<\x1a\xbd\xc3\x9f%\xc2\xee\xaf\x84]\x1e\xcabn\x92-\x00\x8e\xfbHU\xf4\x91\xe3\x00"
+DATA ·d+27328(SB)/64,$"\x0by^p\x82\xe95\xfe\xe5\xf4\xf4\xc8\x05\x11I\xf1'\x89)\xa5\x01\xd7\xcc\x1f\xc8\x8e\x9e\xb0\x90\xad\x0a(M\x22\xfc!\x0d\xafC\x92piZ\x1b\x85K\x81\x00\x12\x8b\x18\x9f\x88Y\xba@\x05\xaf\x8e@\xd6#\x10\x1e"
+DATA ·d+27392(SB)/64,$"\x0f\x8b\xa9\x1c\x09\xf49L\xcb\x11\x91\xcd\xfa\xdd\x8d\x1a\x8c\xf2@\xeaE\xc1\x8b,e!I\x0bWu2\x90\x8b\x8a\xe7\x97\x1c\x11p\x9a\xb8\x9e\xd7\xca<\x0f?\xa1$[,p,\xd1\x94\x0c\xf3\xc91\xa0(\xde\xa96"
+DATA ·d+27456(SB)/64,$"Sr\x92\xd2\xe9\xd7\xe3\xca\xb3E\xa7\x1dGYZ0\x04#}\xb4\xbaHH\xf4O|\x83f\xc8\x01\x13G=\xaf\xd7\x8e!\xdb\xe5|h\xc8\xf6|u\xe1\xa3\x8f\xd6U\xb5\x02\xdd\xe3\xcb@\x8c\xaf9\x8f+Y-\xa1"
+DATA ·d+27520(SB)/64,$"\xe6\xab\x0b\xaf\xb2\xec*\xfetb|\x8d\x93,_\xe2\x94\xa1\x0b^s\xb9*\x18J3\x86\xf2\xb0(\xc4L#Q\xc8H\x96:\x9a\x959\x0f\xf0\x05\xa3\x9c\xd2FS\x07\xf5\xf9P\x9d\x0e\xeb\xf1\x883\xcf\xd1\xea\x02*"
+DATA ·d+27584(SB)/64,$"VD`\x82S\x0e\xc2\x1b\x8f\xa2,\xbfqUA\x1f\xc1\xdb\xb2\xe2\xd9\xde9\xfa\xbf3\xb4\xf7e>\xb7 \xa1JU\xa4\xcbOa\x0c\x03\x14\xb2\x15\xc5&V5\x11S)\x06\xbc\x12JV\xbf\xc27\x86\x94\xd1]\xe9"
+DATA ·d+27648(SB)/64,$"]2c\xb2\xc0\x05\x13RO\xfc\x1e\x8fD?^\xea/\xc2\xf4\x09NV\xcb'\x7f}&\x89\xe1\x96\x8a7\xe7AU\x1b\x09V\xa8\xe9\x8f\x06@\xaeD\x8eFU\x92\x08\xf2\x99@4.\xa5\xfc\xb2Qi(\x99\x96Y"
+DATA ·d+27712(SB)/64,$"L\xe6\x04\xc7\x12.\xca\xe6\x1dKA}\x06\xe9i\xf0\x13H\xbe\xc6,\xb0\x9b\xa7U=\xcd\xabL\xd1!\x8a\x8c\xb4\x05\xc2@4\xeaqM)\x0cX\xb8\xa8w<\x92J\xbd\xe0\x07\xb5\xc4\xc6\x19.\xd2\xbf0\xb4\x0cY"
+DATA ·d+27776(SB)/64,$"t\x89\xa8\x90\xe61_\x1b\xca^\x96]S\xca\xc7w\xe8\x5cE\x1b\x0f\xb5\xda\xd3\xbdX\xc1\x00\x83\xeck\xe8\x12S\xf4\xb0\xb0\xad\xe5\x86-\xf5\x8dI'xx;\xc4\x13\x04(\xca\xa9\xc1)s\xc0%\x0f|\xa8\xe8\xcb"
+DATA ·d+27840(SB)/64,$"\xaa\x17$exA\x09\xbb\x11&\x14\x9a\x87$\xc1\xf1T\x8b\x82b\xa0,\x00\x02M%\xa5\xf8l\x84\x173EI\xfb\xbco\xa8LFE\x01\xa62\x81_d\x94\xaeJ\xcd\xdc>{\xcbB\x95\xa9\x0b@{\xe7m\xbf"
+DATA ·d+27904(SB)/64,$"\xc7\xa9\x1c8\xf5\x0d\xc7R\xe1\xfe\x0e\xcc\xcf}\x16\x88dR\xdbF@\xba\x06\x1e\xd0\x9f\xe23\x01\xee\x0b\x85(\xe5k=\x07\x10\x85\x05F\x0e\xf7\x95M\xc7#\xa1\x8d\x84\xc1\xeb\xa2\x04\x22\x0b\x9a\xd4\xd5\xacM\x0a\xbez"
+DATA ·d+27968(SB)/64,$"F\xba\xb0\x8f.V\x0cQ\x0c.\xcd\x02\x01X\xa4<F\x8a\xe1\xf9\x8c\x1a-~\xd7s\x16J\x09\x15\x91c+lX\xcb\xd4\xad+\x9a\x02\x10\xf4y\xf1\xbb\xee\x89\xee\xc5F\x9dh\xeb@\x9a\xd9\xd1\x8f\xf1<\x5c%l"
+DATA ·d+28032(SB)/64,$":\xb6CT\xd5W\xa9\xe6C\x05Fha\xa9_\x19\x09%g*\xa2\xacf\xc6\xd1\xe1\xda7\x8c\xa1\xd0\xaa\x0f?\xad\xc2Dzg\x0c\xd1_\x17[\xa5#\xc5\xe8B\x18\xa39\xcd\x96\x06m\xf8KLQL@W/"
+DATA ·d+28096(SB)/64,$"Z%\xd8\x8b0\xba\xc4[\xe0~\xee\xa1*[?;\x7f\xcc\xa7\x9d\xf8\x90\x90%a\x88;\xa6\xc4<\xf9\xd8\xb3\x02\x82%_2\x842\xe5\xf5\xf3\x0c\x85y\x8e\xd3\xd85y!T\xbc\xc8\xdbq\xc3\xa0 \xbfc\x0f\xfd"
+DATA ·d+28160(SB)/64,$"]\xb6.XJ\xfc\x9eU\xcb(N\xe1\xc4\x19\x9d`A\x947P\xd4\xe5\x15\xbc1p\x11\xa6\xa8\xfam\xcf\x13\xdd\xfb\xbc@\xe0\x90\x0e~\x0d\x09\xfb\x99f\xab\x5ct\x92@\x0f\xf7\x0e\x10A?\xa2\xa7\x07\x88\xec\xecp"
+DATA ·d+28224(SB)/64,$"$>/\x82\xe7q,\x1c>\x8bL9.9z\xa2\x91\xcf\x8b\xe0e\x96b.\x0a8\xa0\xdf$\xa0\xdf\xd0\x8f\xe8\xc9\x01\xfaM\x02\x1aYH\x19\xd5\x88f\xae\x87R\x8a\x87\xa5\x01a\xac\x8e_\xbf\xf6\xaa\x1d\x9c\x0f\xb9"
+DATA ·d+28288(SB)/64,$"=\x03|\x08d\x88M\xbf\x9e\x5c=\xb9\x90\x11\xcb'\xbb\xc4 \xb9\x1d\xe0\xe7\x14V\x0d\x01e-\xfe(\x07m\xcd\xa6\x1c\xb5zi\x1e]\xac\xe6U\x15\xbeD\xfab5\xff>h\xaf5\xb3\xb8\xd2bX\xf0q\x87'"
+DATA ·d+28352(SB)/64,$"\xb09\xf9\xfa\xcdy\x04lIR0\x12\x15\xae\xb0\x81`!/\xc7\x078s\x0f=z\xc4-\xd5\x22\xf8\x85\xb0\xc2\xf4\xd15\x16G\x8e9\xba$L\xad\x81;\xb0\x08\xf2\xca\x9e\xb2x\x04\xa8\x13\xf2;\xd6l\xff\xf5\xab"
+DATA ·d+28416(SB)/64,$"|\xcbY\x16HS{\x0f\x0d\xef\x88\x9foIQ\xe0\x02\xca\xac\xc4\xfc\xa8a\xfc\xf8\xe9\xe3'\x8f\x7f\xf0j\x18\xae\xd2\x1a\x8e\x85\xeex\x03\xc9\x81n\x0fe\xd3+\xa7\x87\xfc\xfc\x0a\xa6\x0a\xb8\x0e\xe43\xf8\x1c\x8e(\x9e"
+DATA ·d+28480(SB)/64,$"\x93/\xe0\xfa\x00\xc9\x0c\x13\x22\xef\x92-\xf5y\xf9\xa4\x9c\x97w\xf0\xb5\xe4\xa5\xafe\x83E\xb0\xdb\xdf\xa2:ow\x94\x18\xd3\x99\xd2\xe0\xa7,\xbe\xb1\xb0\xfd\xd7\xaf\x88\xd2\xe0\x17\xa9P\x80\xab\xdcu^\x08\x8e\xdf}\x83"
+DATA ·d+28544(SB)/64,$"\xd3\x05\xbbtxi\xf0[\x9fp\xbf\xb5\x96\x96\xf5\x85\xb7\xe1]Q3\xa7\xaa(\x7f&\xac\xd4\x96\xa5#\x83\xd3\xa7\x22Z\xcd\xf5\xa2)I\x15\xff\x22\xcb\xfc9\x10\x9f\x82\xc3\x94Q\x22Xt\xafda\xce\xf0\x0f:\xe6"
+DATA ·d+28608(SB)/64,$"\x0e^\xe6\xa0!\x03T\xfb\xe4\xb1\x18}08'\x18_U\xd6F\x1f\xd14F\x8fy\xac\xe78L\xc11\x03\xe1'\xe1\x12\xf2\x91\x11\xbd\xf1\x11\x85E\x06\xd3y\x18q{U\xea}\x00\x12S\xfd\x08N\xd7\xf1\x9a\xd3"
+DATA ·d+28672(SB)/64,$"\xbd\xce\x9a\xfb\xcfJ\xde\xcc\xe6s\xf8B\xd38x\x9d\xb2g?\xa4n9A\xb9\x99\xe3\xa1\x1d\xc4W\x14\x90\xa8u\xdf\x85\xac\x96\xba\xfb?\xfe\xb8\xff_\xde\x0e/\xc8\xbd`\xd3\x19\xc7\xf9,\x9b\xcf\xa7\xe7b\xe9\x05\x90"
+DATA ·d+28736(SB)/64,$"\xf0\x8d\xaf\x9c8\x05\xc9*\xd9\x82\xd7\x98q\xf7\xd9\xd9T}:/\xf5\x98<+\xf4\xfc\x01\xf6\xc5Wn6\x9f\xfb\xa0\xf1\xc2\xc3\x09\x0b)k\xc8\xef<\xe3\xa3\x09\x1d\xaci:`\xdf\x15\x18_!\x96\xa1\x87`\xd2\xc4"
+DATA ·d+28800(SB)/64,$"\xbeT\xfc\xc3%\xf6\x11\x07\xad\x9aT\xdaTjhd\x9c\xbe\xafV\xa0\x8e\x81\xa28o\xead\x8f\x1e\xa1\x14~\x97]\xb6\xa0\xc0\x95\xab\x90\x09\x14j\xcdw\xe8q<\xc2\x97\x9e\xfb\xa8\x15\xb0\x9aIe\x03\xa6\xb2\xa6\x1a"
+DATA ·d+28864(SB)/64,$"\xa9\xf5\x0c(+=\xf4\x17\xab\xb9.A\xe6\xf5\x9e|\xfd\x8a\xdcjW\xd5#\xc9\x82\xc3\xf7\xaf\xa0@\x8af\xa2\x0aP\xc7\xb3\x22)\xdaj\xa5\x7f\xbaM\x1ap!\xa2\x1b\xec \x86X\xec,\xec\xb6\xbb\xaf\xb9\xed0\x8d"
+DATA ·d+28928(SB)/64,$"\xa5\xed\xcc\xe7\x87Zl]+\xf7\xd5&\xd3\xee\xbeW_\xe443r5\x1b\xa7Mz\x18\xacX\xd5\xb0y\x04\xe3\xfe\x0a6\x08\x1e\xb1\xaa\xc5\xdc\xb7\xae~\x9cd+\x1aaw_-\x7f\x02\x1ba\x1bt\xb9a\xe0#"
+DATA ·d+28992(SB)/64,$"/\xa5\x16\x90\xf1hD\xcb\x97\x1ckxW\xcaA.HTw\x85\x9db\x1a:|\x18^$Y\x81\xdd\xa6\xa3\xd5f\xfa\x14Fse\x00J\x00,(\x97\xea\xaeg\x19\x1e+'iY\x0f\xeb\x18\x1f\x1b.\xe7c="
+DATA ·d+29056(SB)/64,$">\xba\xba_\x02W\x98H6l3\xe7\x8a{\xd8s\x1a4o\xbbw\x0ep\xf6*p\x04nm\x8b\x09'\xb5\xd1u\xdf\xb8\x14M6T\xbe\xf9\xef\xe9\xc1\xec\xb48\xb6\xe8\x8f\xeb\xcc\x82\xd2\x14\xf85L\xae\xb64\x19"
+DATA ·d+29120(SB)/64,$"_\x9d\xb8^\x00\xf0\x5c\x88kq\x13\x0eTC\xad\x08\x90t\x9e\xa1\xac\x08\x80,\xaf\xd3y&8\x8b{1=\xf1GQ\xaa*\x8f\xa0^\xf0\xbaxI\xa82\x09enFJ\x129\xeezf\x83Z\x07\x8dJ\xde\x14"
+DATA ·d+29184(SB)/64,$"\xef\xcdX\x8a\xac:_\x96\xe6\x8f\xa6k\x9a\xad\x18\x9ag\xab4\x96Zm\xd3}Z\x11\x0eb\xdc\xf8\x1b=v\x16\xf8\x1b\x0e\xa2\xbda\xc55\xbc\xb5\x1a\xe7|K\x0ch\xdc\x10H\x5c\x1cuzzb>\xf3i\xacE"
+DATA ·d+29248(SB)/64,$"\x9f]RHL1\xa5ecjE\xe7\xcc\xc4\x19\xd3\x18\xce^\x00%V\xdbC\xca\xe6;\xff\xc6\x14704Y}]f\xbd\xd0%\xa3\x18\xbb\x86\xa2\xed\xa9\x94(\xc8l,\xd0\xd9\xb9x-\xde\xc5\x84\x9a\xafT\xf2"
+DATA ·d+29312(SB)/64,$"\xa3\x98\xadBFni\xbe\xda\xe7'\x99[f1GJ{\xad\xe0\xc9 \x04\xc2I!CZ\xa2C\xba \x7f\xac\x91\xacJ\xa4\xd2?\x04\xeb\x1b/\xef\xa1]\xb4\x0f\xce\xa2\xbf\x0b\xa7\xd1\xee.\x87\x9d\x15\xc11^f"
+DATA ·d+29376(SB)/64,$"\xd7X\x94:\xfb\xed\xbc\x0c\x0dh\x00\x80Yo}(\xa4\xaaW}\xea\xf9\xcdi\xb6\x05\xe9\xca\x96y}\xbe\x9d\xe2e\x0e\xf4\xcc\x0a\xfd\xd3\xf3\x91\x13@K\xbb\xf0\x9f\xe3\x8d-\xc3\xd3\x88\xef\x0a\x0f\x9bd)\xb6\x04\x03"
+DATA ·d+29440(SB)/64,$"u2\x81H\xeae\x96`\x04o5\x98\x19R\x1d\x02t\xf6\x9e=\xdd\xf3\xd1<L\x0a< \x8c\x0c\x8c\x08X\xbd$\x14!\x93;\xe1%0Y\xe3\xe5\xcb\xd2t\x1c\x8f\x0c\xb9\xb0\xedE\xa6u\xe27\x99\x96\xccu\x1f"
+DATA ·d+29504(SB)/64,$"f:\x9fn4\xd2\xef8_\x96n\x8d\xfaD\x98\xeb1\xcc\x0a.\xde\x00OW\xcfG\xeeE\xe1\xa4\xd5\xaf^\xd1ly\x92\x84\xc5\xa5\x10\x84\x9e\xcfk~<~\xf9\xfe\xdd\x9b\x7fC\xfa\xc8\xc6\xa2\xb1)\xb0\xb9\x0d1"
+DATA ·d+29568(SB)/64,$"\xdf\x5c.\xea\x813HQ\xbe\xd3\xa4\xd0C9CoW\x85\x5c\xa0\x0d\x0d[B\x13\x1a\x22x\xb8C\x8a\xa5\xcf\xbfY\xde\x88\xf8\xd9\x04/TS\xca\xa1\xe1s\xe9\x10\x16\x03&\x88\xbd\xab0GR\xe9F\x09itI"
+DATA ·d+29632(SB)/64,$"\xae\xf1?\xaa\xf9\x16\x93\x09*H\xbaH0\x1f\xce\xf1\x88\x85\x14\x96\x12\x05j:C\x96\x91W-ycC\xbcTkz\xed\xf3\xf1\xa9\x9c\x8f\x06\x9c\xfe\x99i\xe7\xcaj\x9b\x16\xbe\x1b\x22Zz\xb8\xce`\xbaa\xe3P"
+DATA ·d+29696(SB)/64,$"e\x12\xc5Y\xca\x92\xb0\xf8\xbb\x1a\x0ca\x8c\x08\xc2_\x18\x0d#\xe6xfz\xcc}hj:\xd8\xd2L\x08\x1ck\x1e\x8a\xd2Rz\x09\xfe\xeb1\x10\x1c}\x15O\xcf\x8f\x8e\x0e\xdf\xbd\x04\xac\xf6\x06\x8e\xc0G\xd5\xd2\x5c"
+DATA ·d+29760(SB)/64,$"\xc4\x0c\xa4\xeeh\x84\xad\xef0\x0a\x1b\x93\x09\xd2w)=\xfcB\x0a\xd6F.\xa3\x88\x8db\x1d\xad2\xba\xba\x13\xbf\x7fKv\xff\xf3s{\xb7pi.r\x93\x09ptL(\x8eX\xc6\x1d\xce$Ur\xaf*\xf6\xaa"
+DATA ·d+29824(SB)/64,$"\xf0\x90U\xcaU\xf8\xaf1\xb6\xb5\xa1h0\xd7KB\x07\x0cs]c\x905\xbf\x8bmZ\xae\x93z\xf5\x9b\xb6,\x7f\x83t\x82\x1aE\xfeW\xa8\x07\xb6\x05]Q\xa3g\x19g\x14\xe3B22\x0a\xe7\x0cS\x94\x87\x94"
+DATA ·d+29888(SB)/64,$"\x9101\xb9\xf8\x8e\xeby\xc5\x03T\x8ff\xfc\xf1\x8e\xc8\x92\x1fJ#X9\xb9\x06f/\xfb(\xbb\xe2\xeaE\xe0\xf2\x8c\x03a\xb8K\x00\x0f\xb2\xab\xa6\xcb\xad\x8c\xf7\x92e\x9e`\x9ebjT\x1d\xe6g\xabxG\xa4"
+DATA ·d+29952(SB)/64,$"#\xd4\xe0\x1b\x15R\xb2\x05;\xb5g\xaa\x1c\x1a\xfe\x9a$\xf8\xe4\xa6`xy\x0c\xa4\xda\xc2H\x15\xf4Z\xc729t\x88(R\xb7\xda\x98\xbb\x0d\xc7\xb1\x8c\x1b\x09Y\xfd#z\xc2}\xeb0g\x7f\x0a\x0ba\xba\xf34"
+DATA ·d+30016(SB)/64,$"_\x87\xa41\xfe\x12\x5c\xb2e\xe2X\xf7gP\xfc\xa9\x19\x1c\xadD`\x9d\x89\xb3#0\x95\x81W\x8a?\xc9Pgp\x02\x81NN<\xc77\x82\x9bsW\xecu\x9c\xed\xefr\x7f\xb0\xc6t\xf2\xc4\x13\x10\xa2\xce\x88l"
+DATA ·d+30080(SB)/64,$"A\xaf\xcd`,\x8e*i\xeb8\xb2\xe5\xad\x1f\x89\x19,\xa3\xae\xdd\x0ek^\xc1\xe6\xb2n\x85\xe7\xebf\xdb\xdc\xce\xf0\xdd\x8c\x0e\x8bu\xf4l\x7fjt~g\xff\xdc\x1e\xf1\x92\x99$\x02u\xbb\xf7\x99\xccQ$\xd8\x04"
+DATA ·d+30144(SB)/64,$"G-\x91\xe6\xd3\x9b\x1c\xc3~\xac\x88\x95~\xa4\xb7d\x89\xe1\xbd\xdb\xed\xc3Wm\xb3\x9b\x1c\x97I\x7fe(\xa8\x0e\xccG\x11\xb3'\xf0\xda\xb2\xe1\xbb\x93\x0f*sR~\xda\x92\xd7<o\x9dWU\xa7n\xabG\xd7\x92"
+DATA ·d+30208(SB)/64,$"\xbeVu\xe4\xaa\xe1\xb9s\x02\xc5\xfd\x92 \xb6\xb2\xe1\xa43\xffA&\x09\xacx\x9a\x8d\xdc\xbbq\xa0^U\xa7\xe0\xfb\x7fns\xabH\xee\xcbr~\xb5\x8d\xa6\xfb\xda\x9a\x97Q\xf5\xa2n5\xbfb\xad\xf7\xcft\xcd\xc3"
+DATA ·d+30272(SB)/64,$"\x12\x0b\xd8\xe9;\x18\x0d>\x03\xfbq\x81Ll\x89\x92\xdf\x87\x89o\xe0Q*6\x9f\x94-\xf1\xad\x98\xae\xb6L\xbc\x9e\xef\xbe\xcbR\xbc\xfb\x16\xfaT_.\xfe\xe3<,\xfe\xe38\x0aS\x16.\xc4\xdc\xa0\xe8;\xf0\xed"
+DATA ·d+30336(SB)/64,$"\xbb\x8c\xbdUy\xcf\xdf\x9c\x81\x8d\xc6\xca\xe0\xfa\xe62\xc00q\xd4\xb8\x0c\xb0\xfa\xba\x05\xc1\x9deX\xd7@l6\x0e\xaf@\xae\xd6\xcc\xce\xfe1\xb0\x10\xdfNy\x0e\xbe\xa1\xa6\x1b\xeb\xce\x8b,\x8d\x09\x84\x82\xc3ml"
+DATA ·d+30400(SB)/64,$"0\xb8wV]\xa7jH\xe6\x82)\xb8\xc6\x97\x0bu\xef\xe9\xde\xd3\x0ee\x0f\x5c\xdf.\xbc\x07\xb9\x08\xfff\xd5Y\xc8\xb3\xaf\xc5\x0c\x04\xb9\xca\xa7\xe0\xe83\x0e\xafN\xf9\x16\x03\xe7\xd7\x89\x83v\xe4N\x83Q\xc6.1"
+DATA ·d+30464(SB)/64,$"m\x81Q1\xc0G\xa3d\x89dsR\x8f\xc8\xe2S\xb2\xc4\xae\x17|8}\xe1z\xc1\xab\x8c.C\xe6r\x1a\xc1\x07\xf1\xcc\xab^\xe0yF\xb1\xad*\xa4\xf4\xee2\xb2\xc4\xc1/\xd9\x8a\xf6\x83\xf2T2\xa2\x8fXT"
+DATA ·d+30528(SB)/64,$"\x12\x95\x07\xaeV\x91\xd4\x18\x97\x98]f\xb1\x8e\x15\x8cF\x97\x5c\x82\xe9\xf0\x16\x9aL\xa4Ft\x1d&+\x8c\xf2\x90G\x96\xc2\x18$3I\x11\x9fK\x82\xf21F\x08\x91\x94\x01\xed9\xec[9\x93\x15\xac\xdb\x86Hd"
+DATA ·d+30592(SB)/64,$"\xe1b\xdd&,\xd6\xbe\x80\xf1\xcb\xe1\xf3\x97\xf7\x06\xd2\x87\x88\x1c\xf3{\xc3\x11<\xb2\x83\xc0\x8c@;\xdb\x05\xeb\xa3o\xd2u\xe7\xb1\xb3\x1d\xfc\xd65\xbdehec\xfe\xdd\x15\x84A\x1f\x85\xfa\xee\x09I#\xb0\xd2\x92"
+DATA ·d+30656(SB)/64,$"\xe5\x06P{k\x0f#N\x03\x8c\x98\xd5\xf7A\xc4\xb9\xc1\x05\xc34\x0eo\x9cM\xc0\xb41\xca\xa0Z\x0d\xd6\x18T\xab\x9c\x03Rx\xde\x01\x86u\xe2\x1c\x81J(\x97\xabW|\x17\xdaPl\xee\x01\xe7C\xba\xbc\x17G"
+DATA ·d+30720(SB)/64,$"Y\xea\xdb\x98\xe1N}c\xe1\xc2G\x1b42|\xf8,\xb2\xe6\xbe\x84l \xbd\x0dIf\x1d\x9d\x81\x82\xa0\xde\xc2Z\xe7\xe8\xb79\x88X\x14\x88\xf5\xb23M\xbf\xbe\x9f\x06\xfc!,\x0a\xc4\xc2\xea\xc1\xbb\x9d\x19z\x22"
+DATA ·d+30784(SB)/64,$"\x1a3\x8d\x06X\xdfu\xb9\xb3\xdf\xce}d<\x81'e{\xf9\xfd\xc6I\x08,\x0a\xf8\xd2]O\xcb\xe7y\x83a\x01\x89\x8e\xe8\xe1u\x8f3)\xf7\x111\xd0\xf5\x15T}\xf8A\x89;\x99\xeb&g\xddF\x89\xd5\xe4"
+DATA ·d+30848(SB)/64,$"<\x84m3\xdc\xd4l\xdb\x86\xf0&,\x98\x1e|Q4YJ\x88\xf6\xfeM\xd1\x0f{O\x11\xc5E\x9e\xa5\x05FI\x18]\x15\xa0\xee\x908d\x19\x95&'\xf1\xca\xcd9\x123n\x83\xbf\x81$V\xc3\xfd>\xac\x8d"
+DATA ·d+30912(SB)/64,$"\xcb\xb0@!\xba\xc8\xe2\x9b&\xf4r\x9f\xd8d\x82rc\x8a\x89ck\xc8\x22\xcd(\x8e\xd5\x19FBe\x96;/\xb9\x97f<\xc0\xc7\xd9o\x5c\xf5Y\xb3\xcec\x88\x19\x0c\xb2\xaf\xda\xcc$\xfby\x1c-FQ'\xff"
+DATA ·d+30976(SB)/64,$"Y\xaa\x9b\xac\xd7j\xfd\xe8=\x97\x7f\xa0\xe9S\x00q\xa0\x9a\x88\xa3\xe9 \x9aT\xc4\x83 \x10o<\xf4X\xd3\xf9X\xf2\x91\x226\xa7\xd5\xc6\xa3n\x08\xad\xfa\xce\x10\x90YZ`\x11C`\xd5\xd8B\x8a'r\xae\xf0"
+DATA ·d+31040(SB)/64,$"=#RR\xdd\xd7\x0d'\x03Q\x94\xea\x14\xb2o`\x1fb\x16.\x0a\xbd\x99e\x19\xe6g\x17Y\x96\xc8\x05F\xd1\xe5c\x97\xfd\x14F\x11\xce\x99a?\xf1M\xce\x08\x01\x1c\xc3\x10rd\xc0U-eP\xca\x11\xb1v"
+DATA ·d+31104(SB)/64,$"\xf5*\xc6\xf3$d\xb0{\xa8\xf9\xed\xe7\xff\xf3\xfa\xe8\xe0\xd3l/\xf8k\xed\xc3\x97]K\xe9\xc7\xf5g\xa8j\x85\x0b\xaf\xe0\xa3\x0d=Q\xe9q\xfd\xd3\x05\xf5\xd1c[\x1d\x89\x7f\xe5\xb5ZR9\x1fpFws\x1f"
+DATA ·d+31168(SB)/64,$"9\xcf9\xd1v\x0f\xcb\xbd\xd4,\x0a\x04%\xbd\x01\x87:\x0a\x22\xe7\x98\x07\x09Y\x14\xc0\x13\xec\xbc\x10\x86\x84\xb9\xadW\xcc\xd0\xa4\xc0\xcdz\x1c\xc9\xca\x0c\xd6I\xfa\xb6\xf0\x13\xf7\xbe\xca\xdaB\xce\xab\xc2\xaa\xecE\x92]"
+DATA ·d+31232(SB)/64,$"\xa8\xc5\x01\xa7\x91\xf4\xfe\xd8\xdd\x94\xba\xe7\x10wO#~\x98\x1c\x1f\x1e\xfb\x1aR\xa3\x17z\xf8ijn&\xafC\x15\x9b\xcas\x83\xaa>\xb4b.1\x82(w\xc4\xd4\xb1*\x0b[DR)\x0a\xd5x\x13\xad\x85\x9b"
+DATA ·d+31296(SB)/64,$"\xe4\x00x\x83\xb1i\xc6\x9b\xf2\x0a\xe7\xa9\x96\xb1<\xaa\xa2E\xf9\x90\xc8\xe5\x14_\xab\xc80\xd4(\xce$\x7f\x9c\x1f\xc0\xdbG\x8fx\x09\xf4@|\xb5\x22y(\x0f\x9d\x80u\xbc\x08\x97\x18Q\x0c\x8c\x8bS\xc6\x0f\x1dR"
+DATA ·d+31360(SB)/64,$"\x88Ny\x80J\xf9\xbeE\xbb\x00\xb3\x8aq\xd9>\x12M\x8e\xc5\x0e\xe8\x1bkW\xfe\x05\x07\xca\x0e\x9bldn\x99Y\xd0?\x0e\x1c\x18\xa2>\x9f\xad\x9d\xd5\x9c\x00M7\x06\xc88\x93*\xf79\xe4*'\xf4``o"
+DATA ·d+31424(SB)/64,$"r\x95\xd6\x1a\xb5\xc0\xafI\x082\xdf\x1aP%V\xd6e\xf4\x9a\x8f\x13_\x98\x9e\x00\xfab\xd8@ \x9f\xc3;\xf1\xc8\x05\xd3\xb9-x\xc9\xe5\x5c\x98\xc6\x88\xc48e\x84\xdd\xd4\xf8\xa5@\x97\xe15.\xb9\x89\xb3\x97b"
+DATA ·d+31488(SB)/64,$"\x1b\xa3-\xb5<\xc3\xe2&yF|/\x179^\xba\xb2\xc2M!\xb6.\xdbu\xac\x02Q\x16T\xa2\xcc\x1c\xbe\xfe\x05@\x89\x80\x86\x9e\xc9\x19\xfd\xa0]W\xac\xd9\x0c\xf5\x9d\x95R\x8d\x87\xd9$v\xe2WI\xd6o\xd7"
+DATA ·d+31552(SB)/64,$"(\xc4\xec\xad\xda\x0d\x1ciB\x9b\xb2\xe1A]8\xf025\xe9p?Jq\x88\xad\xa4R\xb1\xca\x8dI$\xa2q\xc0T\x02e\x16.\xeeD\xb5\xf7\xff\xac\x12\xabf\xec\xb4\xc5'`G\xf5Q\x96\x90\xe8f\x0bJ\xba"
+DATA ·d+31616(SB)/64,$"\xc8\xcd\x17\x8a6\xc0$|o\x87\xd1\x86\x87nQ\xf9H\xf8N\x09]r\xedV>y\xe3Q\xbdh\x05\x16\x90\xfb\xf6(d\x0c\xd3t\x8a\x1c\x88sN\xc92\x5c\xe0\x09(U\xff\x02w\xfa\x149\xcb\xf0\xcbn\xb8\xc0"
+DATA ·d+31680(SB)/64,$"\xb3\xbf={\xba\xb7\xe7\xac\xfdj\xa5\xc7By-\x8b\xa7\xd9.\xdfd\xde,i\x02\xcd\xf9\xd1x>R\xc0\x9f\xed\xf9\xa8\xd8]\x86_\xe0\xe1\x87g\xb2!H[\xbc\xc6\x94\x928\xc6)\xb0]\xab\x9d\xe2#x\xae\xf4"
+DATA ·d+31744(SB)/64,$"\xd65{j\xa21y\x1cDEa\xe9\xe1\x0f\xfb\x7f\x85\xa6\xf7|D\x96\xcb\x15\x83C\x0f\x9d5X@1)\xe0!\xde\x18\x85\xc1\x91#\xcd\xae\xd3\xd9\x10\xe2\x94\x07$\xe93\x91\xd4\x91\x8c\xbf\x84\x85\xc4\xa9\x99!\xe2"
+DATA ·d+31808(SB)/64,$"\x88\xd1u<~\x10\x90nsV\x1fd\x0b\xcc\x93\xd5\x1c`\xc2\x8c\x17#\xde\x84\xa1G~\x98qU5\xaa67\x0aeH9jQ\x0f\x01\x93]\xd0\xe9h\x96\xf0\x14\x1c\xae\x1aj|\xbbRo*u\xd1\xc3O\xd5"
+DATA ·d+31872(SB)/64,$"\xa5_\x15\xf3Q\x14y\x83s\xb6\xda-\xdb>?F\x8b\x92\xd7\x1f\x8co\x0b\xc2\x0f\xa5\x98\x12\xd3\xed^\xb0G\x8f\xeeCVD\xd2\x8a\xcf\xa9\x9f\xcc&\xb79*\xa3\xc0\xca\xa20\xc1\xa5\xdemcs\xdbL/\xb9\xb6"
+DATA ·d+31936(SB)/64,$"\x83\xa6\xa58\xfa\xd3qm\x89\xda]\x18\xb8\xb3\xd7J\xfe}\xcf>;\x8e\xad\xb7\xa6\xbdV\xeb\xa4\xe8\x9c\xeeR\xeb\x82}r\xf4|Kg}\xcd\xc3$\xb9\x08\xa3+\xed\x5c\xe9Np\xab{\x7f\x1eT\xbc?p0\x83"
+DATA ·d+32000(SB)/64,$"\x06(\xd2\xf0!\x81\x14\xfd\xa8\x9b\x91\xfc\x5c\x16B\xb9y\x16D\xad\xf2m5I\xdf\xf0\x1c\xf0\xact\x0d\xb4t!\x14y\xd8\xb5\xc0\x85y.\xd78\xa0\xe0\xc9\xd1\xf3\xdbW\x12\xc6T\xb7\xed\xa3\xc3/Q\xb2\x8a\xf1\xd4"
+DATA ·d+32064(SB)/64,$"\x88\x82@\xcdI\x98\x93\x89\xb3\xe6\xab)\xbc\x8f\xd8\xfd\x9b:\xe1p\x0e\xbf0\x9c\x16`\x5cL\x85\xe7H\xad\xb9]^1\xe5\x0b\xe5\x8a\x16\x17n\xcaa\xc97\xcd\x80\x07I\xbe\xe5\xfc\x0d,%\xf3\x10\xf4X\x8f`,"
+DATA ·d+32128(SB)/64,$"\x112^\x00\xfd\x90\xf6\xacq\xc7\xdam\x91\x87>\xd2i\xbb@\x88U\x81i1y\xfa\xc4tt\xc9b2\x91\xa0\xb7\x5c\x13\x5cO!\x08s\x96\x1et\x88|\x06\xbf\x15=u`\xc08\xf0\x8a\xbf\xecV\x0c\xde\xa0>"
+DATA ·d+32192(SB)/64,$"\xb5\x96\x0c\xae\x9f\x0c*\xdc\x86v\x1f:\xbf\x15\x83\xaa\xf2.\x1f\xbd?\xb1\xf6C\x17\x14\x1e\xc2\x1e]\x06\x02K2M\xb6MD\x1a\xa19\x16\x05\xc0<\xa5p\xac\xebr,\x0a\x80\x99\x1e=j5\x95\xa6u\xd1\x88*"
+DATA ·d+32256(SB)/64,$"k\x81\x96\x05\xdc4\xb2\x99@6t\xdam\xa1\x1aV\xba2\x975@zx;\xcc\x196\x14sY\xde\x8a\xaaF\xe9A\x93R\xb3A\x94Z\xa5\x8d\x16[[\xd2\xa1.\x0c\x9b\xd5\xf4\xe1\xbb\x22\xd8\xc5\x0d\xe1\x18\x85\x05"
+DATA ·d+32320(SB)/64,$"\x22\xc5@]\xbf\x87\x97\x8a<\xdcd\x9d\x15\xb2\xb2\xb6\xd4\xb6Z\xd8_\xbf\xf6\x0cS\xed\xc4\x0b\xcb\x12,:\xa7\xd6\x13\x19\xd7\x93d ):9z\x8e\x96Y\x8c\x8d\xd4\xdc\x0e\xdb9\xcdR\x12m%\xb3/OB\xd2"
+DATA ·d+32384(SB)/64,$"i#\x16\x84a\xf0\x8dF\xaa\xd1\xde\xc2\xca\xa2\x93\xe5\x8f\xb1\xd8\x0d'\xcc\xba(\xc1a:\x14\x04\x94\xfdp\xfcF\xd4\xac\xc6\xd36]\x8a\xb8&\x7fTnf\xeb\x8f\xba\x0d\x8aq\xf5\xb1\x9cl\xb4\xe4\xb2;\x84\xbf\xf8"
+DATA ·d+32448(SB)/64,$"\xd7\x0f\xc7o\xa0\x80\xc9\xc2\x92R\xb9f^\xc3\xc3\x04\xa3*\xe1~8~\xe3\x1d|S\xd6nl\xbeh\x15\x9f\x0a\xa1.\xa1\xb9n\x89\xf856\x00\xc5\x84\x9aT\x81\xdd\x85\xba\xc3\xd2a\x97d\xe2\x98\x7fN[\xc9\x87"
+DATA ·d+32512(SB)/64,$"o\xe4;W\x90J\xf3\xb5A\xae\x0a\xe54\x8c\x073$\x9a\xdcq&=\xaep\xd5\x16?\x80\xae\x98\x18\x86DI\x01\x01\xcb\xd7\xf0+\x9e\xc6\xcd\x10\x17\xa0<\xfdc\xdbH+T;Q6\xf9\xaf\x81\x1b\xb4\xbeu."
+DATA ·d+32576(SB)/64,$"\xactd\xb2\x09\x1f\xaa\x8et\xb81\xd7\xf6;\x80Zz9d\xa6m\xba\x93k\x83\xc9\xf2\xa0\xd3\xe5d\x0d\x91GR\xb8\xf2\xee\xc8\xda\xa7\x94,e\xf5\xb2q\x05\xa5\xbc^\x07\xd6s\x1e]O\xb2\xecj\xc57\x04\xba"
+DATA ·d+32640(SB)/64,$"\x16\x10\x06\x06\xde\x81\xaau;\x80\xac\x80\x99\x8f\x14\x82\xdfKx=,\xfa\xf9F\xe1\xc4m\xe6\xdeQ\x192\x89E_\xfb%\x8f\x1e\xae\xae.Tg\xb0U\xea\x94=0'\xb1\xd6\xd5\xd8%F\x9fV\x98\xf2\xfb\xd3\xae"
+DATA ·d+32704(SB)/64,$"p\xce\xfaR\x81\xf4\x1c\xe8[\x09\xc5z5)\xa5\xf8?>\xcd\xf6\x1d\xbd0\x0a\xd6\xca\xaejleJ}O\x85X\x9a\xa9a\x99\xba\xcd\x85{\x07\x82\x09\x87\xdd\x96hdRI\x145(\xd5\x01\xbb3\xe1\x88\xd2\x8c"
+DATA ·d+32768(SB)/64,$"\x1e\x85\x8b\xad\x5c\xf4a\xa4\x1c\xf5iew\xca\x9fixP,_\xff\xda\x91]\xa3\x94\xfdr;\x8c\x1ccK\xbaVk\xfaN\xa5\x81\x1a\xc4\x9960\x0d\x88oy\x99w\x19{\x9e$\xd9g\x1c\x97>\xe4\x0e\xddH"
+DATA ·d+32832(SB)/64,$")\x06\xa0\xe8W\xf7r\xdcS\xbfS\x18\x8b\xe6\xdb\xac\x08\xd9\x9f{\x8b)\xb4\xb1\xa4\xaa\xe3g\xec\xe1\xaa\x09\xaa\x0e'\xbc\xad\x93\x16\xd7\xbb\x88e\xc2\x1c\x0f\xe1\xc2E1_\xc4\xcd\xa1\x83\xf7\xbe\xb5_\xe9U\xd2\xb0\x19"
+DATA ·d+32896(SB)/64,$"\xab\xe9\xdd/\xe80\xfc\x85M\x84\xf8\xe8#\xab0\x85\xa0\x02\xa28OnZ\x88+\xe4D\x9d45\xe2\xf6ne,\xa5\xee\xa0T\xcbv\xe9\xfa\xf2\xf0\xcd\xe1\xe9a)`+\x22\xd5\xbep\xd6g\x925\xdd\x96\x7f\x93"
+DATA ·d+32960(SB)/64,$"\x02\xf5\xe7\xc3S\x1f\x81\x9b\xcdG\xef\x8fN_\xbf\x7fw\xe2\xf4fq\x0a\xeaq(He\x0f\x9b\x04\xec\xc0\xa7\x8b\x8a\x12\xad\xf2\x00\xba.\x7fe=\x89\x0f\xf8\xa0\xe6it*\xfe4\x83]\xec\xaf\x1b\x09r\xb7M\x9e"
+DATA ·d+33024(SB)/64,$"\xef\xfe\xec\xa3\xc7\x13\x9eg\x17\xec[\xdax,\xb3\xf3&\x8fk`J\x0c\x12\xb8\xdel\xb6/\xd2\x06}di^\x01]\x0fL\x1fu\x14\xd3\x8cU\x1a\xb7$\x9c\x11Oh\x9d\xf3\x95<+\xa9\xf8\x88\x17\xc5/\xa7o"
+DATA ·d+33088(SB)/64,$"\xdf\xb80\xa7e>:'\x7fm\x12\x0a8S\x9e\xc7U\x0a\xb8k\xa7\x92?&\xeb\xf6\x86(p\xb4\x82\x0b\x8d\x04\x9e\xc5v\xf3\x0a\x04\x0fCZA\xb5\x19\x0f\xdd\xa2Z\xc3 \xee\xe4\xaf\xb5[\xfb\xe6\x8dG\xb57h"
+DATA ·d+33152(SB)/64,$"&Ne\x89V\x05\xcb\x96\x03b\xe5\xf5n\xf2\x98\xa5\xf9\xca\x96g\x00\xcb\xde\x14\xa9;`v\x0bYa\x97'A\xdc\x18Q}y\xe7\xcdnA#\xf4\x97\x02'\xf3\xbf\x88\x94\x84\xd6&x\xfe\x83`\xde\xb2\x9d\xff\xd9"
+DATA ·d+33216(SB)/64,$"}E\xc3%\xde}\x9f\xf3\x04&\x03\xbe\x15\x9a\xac%B\x13\xbb\xa74L\x8b<\xa3lW\x15\xb3d\x1d<\xfba\xef\xbf\x9e\xec\xc9\x8c\x87;%\x0c\xe8\xdc`!\x1f\x9a\xd9\xc1\xc5e\x09\xe7\xa5 K}\xec*\xa1\xd2"
+DATA ·d+33280(SB)/64,$"\xb3\xe22\x80\x9e@\xa2^q\x19p\x8c\x0d\x1b\x8d\xef\x81\xe7\x19x\xfcT\xc6\xbcy\x18\x85\xdd@\xe1G\xe7\xe5\x82\xde\xe2*\xc3\xa5\x8cq\xe7\xde\xf7\xcb\x19(\xcfy\x11\x1bF5e\xaaQV\xcb\xde\x0e\xa8\xc5E\x80"
+DATA ·d+33344(SB)/64,$"\xa8\xd8\xe9\x83\x80\x87z\xe0\xd5h\xd5\xb7\x037\xef\x8a\x00\x0cLJ\xeb\x19\xd0No=~z\x8dV\xc3\xbc+\xf2`\x1c\x18Q\xdb\xcc\xb07Y\x9d\x11C\xda\xadO\x97s\x15\xaa7\xc3\xf7g\x1d\xf3\xe3\x1c\xcd,S"
+DATA ·d+33408(SB)/64,$"\xa3W\xf9\x122\xe7\xbbp\x85\xce\xa95F\xf0\x0c\xaa\x9e\x1f WT\x16\xcb\x8d\xc5\xc0\xabr\x90\xb8\xa1@T\x99\xa9*\xd9\x95wO\xce\xba\xf6\x1a\x9bt\x94\x14\xe7\xaaLe\x1e\xaa(\x86R\xc1\xc3\xf4F'd\xf4"
+DATA ·d+33472(SB)/64,$"\x9a\xcc=D\xd7\xb1\xb5\xba*W\xd5\x84\xda\x99A(m\x0dn\xb0\xaay\xff\xb3k\xea\xa5\x9a\x01\x05\x884+R2\x9f\xb7+{\xb2\xc5*\xa1\x1e\xc6B\x7f6\xce'\xb4\xe9sMszk'\xe6@\x96\x01\x8cm"
+DATA ·d+33536(SB)/64,$"\xe54\xe7\xce$\x03QZ\x9f\x9e\xcc\x1f\x85|]\xeb\x0b\x98\xf8K\xe36t#M\xa0\xc8\xa8:o\xbc\x90\xe5\xc6#\xc1]F\x06\x01\xffp\xb6w.\x0flU\x8fF.\xc1\x92\x14p\x14a\xc3\x9ae!#Q\xfd"
+DATA ·d+33600(SB)/64,$"8\x82\x14,\x15\xdb\xb6$\xae\xaf|F\xd6\xa8\x08h\x83\xd5\xc8\x88\xb8YL\x1c{)\xc7\xc6\xd0\xcdOq\x98g\xcc\x13\x87\xc6\x0d\xcd\x0f01\x1a\x96\x03\xc0\xdbR\xa7\x0c\x84\xdch(?\x0bM]\x22EI^z"
+DATA ·d+33664(SB)/64,$"A\x14]\x8c\xdb\xa4\xe1p?\x8b\xdc\xd2ES\xb1\x19\xae\xe6\xbc\x93jI{#\x93m\xb6R\xbb\xfc\xba\xde\x9f?\x028\xcf\x01\xb6\xc2.7\xdem\xd2\x82\xb2\x13\x07w\xe1]\xa6O(\xb3\xd9\x9b\xc3[>\xfa\xb0\x01"
+DATA ·d+33728(SB)/64,$"\xe1\x9a\xa6\xe7\xdd\x1b\x17\xda\xb9\x80X\xb8\xce\x02s\x97\x08\x97\xe6\xdfvD\xfb\x1a\x96\x092\xf7\x22\xc9\x96P\x91k\xdb\x1f\x80\x8a4u9-\xee\xc6\xa1]\xbcr7\x9c\xd4J\x0f,\xbb9\x8bX\xf0\xd9\x12\x1aG\x1f"
+DATA ·d+33792(SB)/64,$"\xfe\x14hlc\x846BE\x89:U\x14VXc\x5c\xe4\xea\xec\xa3\xc6\xfa\xb8\xe5\x86\x86\x09\xe6-7}\xf4\xa1\xad\x8f\xdb\x17\x92\xef\xf0\x17\xd6\xd5\xe9\xad\x0aF[c[\x1c\xca\x9e\xael}\xfc*\xedm&O\xab"
+DATA ·d+33856(SB)/64,$"-n\x98\xa2\x17\xf4{\x11\xba\x92\xf5\x1a\xa7S\x94~\xfd.\xdf3x\x01\xe1\xa97&Rq8kC\xaf\xf6\xb6%\x83Oc\xe3\xeb\xf6\x86\xf9\xa0\x95\xf5\xff\xd1f\xdc\xcar\xe7\x07Fog\xdd\x8e\xf8G\x8fZ\xce"
+DATA ·d+33920(SB)/64,$"\x1d\xae\xf4\xd5t\xaaWM\xae\xbe\xfe\x19(\xb7\xe1\xf4\xfe\x9f\xf7MUD\xb7}\xb9\x83}I\x8a=(\x0a6\xb6F\x82;\xcdY\xa7\x05\xb3\x86\x19[\xb5\xed\xd9%F\xdc\xd6*\xcf\xefk#\xb5\xe9\xa1\x9eL\x1aA"
+DATA ·d+33984(SB)/64,$"\x7fy\xf2_\xc1AB\xd4\x12\xea\xf0\x07}\x80\x88\xaaR\xc8\xd7|b\x19e3\x1f\x00g\xb4\xbcTCXI\x88\xcc\x11\xd1y\x85!\xca1]\x86\xa9\xb8\x1cN@\x94W\x22\xd5Pr)\xed\xc8\x84kf\xcd\x89\xbf"
+DATA ·d+34048(SB)/64,$"@\xc8\xf6\xf0Rv\x8d\xe3#\xd5~rc\x18\xcb\xdc\xa9\xb5\x1e\x8fT\xee\x81>+rE\x13\xd8\xab\x5c`\xb7;\x00\xdf<\x1a\xb2\x0aX>\xb9\x8f\x00\xe0\x87\xe37\xe0\xa7\xbe\x9c\xaa^\xac=\xe8a\x96\x5c\xe3c<"
+DATA ·d+34112(SB)/64,$"\xc7\x14\xa7\x11v\x15*^\x00%\xdaB\x0b/\xe5\x81\xfe7oD.\xeb6b\x0b\x84\x16674D\xa7\xbc>\x7f\x05|\x8d\x09\xad$\xbf\xe5\xde\x01:\xe0o\x8d\x971\xa1\xe5\x9d<\xb1\xbc\xaa \x903a$\x0a"
+DATA ·d+34176(SB)/64,$";\x95=\xbdZ\x96\x95M\x9e\x95\xebKL\xa8_\xc9\xc5\xf3\xce\x0fJ\x99\xc5;u\x16\x13\x0a\xfeHFW\xd8\x04\x1c\xd7n\x03\xba\xa08\xbc\xaa\xfa\xdc\x12A\xdd\xae \x08\xbf\x82JFB\x1a\xa3\x02,\xd2\x9b\xd1*"
+DATA ·d+34240(SB)/64,$" H\x12K\x22\x0a\x22\xf3!\x01\xe4\xe2j*\x8a\x22d\xfb\xc9\xf0\x9f\xa6\xfa\xce\x87\x9b\xf2\xc4\x1e\x91i\xa6\xaf\xfd\xaf\xe6+\xca\xe5Z\xf5\x07\x0aB\xc2\xfd\xc4\xe9_\x94y\x17\xfb\xbc\xb6*\xc7\xad7\xbf\xb9r0O"
+DATA ·d+34304(SB)/64,$"o\x82\x1a\x97\x89\xd9\x8a!9V\xddYu\xb6S{\x06\xec\x9c\x92\xb0\xfb\xbahI:S8@\xbea\xa5\xef\x9d\xc9X\x94\xf6\x02\xb3&Un\x9e\x8d\x15\xdb3*\xb5\xb2Q\xcb\xca\xda\x0e\x9d\x06\xb3\x82L\xa9\xdbz"
+DATA ·d+34368(SB)/64,$"\xa2\x85A\x15\x08\x0a\xd7y\xa7J\x93\x0dS(\xca3\x8e\xe4$\x0e\xf8\x94\xd2BO\xf7\x06j\x87$-\xb4\xe6\xa2.\x88\xf4\x91\xf3wgG\xd6;#\xe7\xfc\xea\xfe\x1d\xe7\xc7I\xf8w\xc7\x1eUxX\xa8\x95\x16z"
+DATA ·d+34432(SB)/64,$"\x82M\xe6\xaf\x81\xa9\x84\xa6\xfaC\xf1*[\xf6\x1fEF\xd9\x0c.\xa4}\xc4G{\x16\xe3\x22r\xbeM\x06\x8e\xc9D2\x03\x07\x1c\xe8\x14\x17\xab\x84!\xc3\xbd;:\xaa\xfbmG\xeaV\xf5\xea\xe1I#\xbe{\xa8Z"
+DATA ·d+34496(SB)/64,$"r\xc4/\xb4+7z\x8dF#~\xf9:\x12\x87\xcb>{\xca_\xa9\x18\x99Q\xd1\x88\xebIe\x01\xfa\x15|H\x97!-.m\x8a\xe8#\x81z\xfbU\xc2r\x14\x97a2\xcf\xe8\x12\xc7\xe8\xbfO\xde\xbfSL9"
+DATA ·d+34560(SB)/64,$"\x15Gk\xa8\xf1\xac^\xd0+ sU\xc1\x90\x02_\xbf\xf2\x00\x81\xfc(i\xe2\xa9[\xb5\xe3\x80\xdf\x8d\xb8#~\xcb\x8b\x16\xbb7i\x9a\xf8\xc8\x1b\xe7\xf5\xec\x10}3y\xdfGF\xe0\xad\x8a\x84\x9e\x05\x18\xb4\x01\xe3"
+DATA ·d+34624(SB)/64,$"\x84#\x99\xce\xc7\x81\x94w\x08\xb4/\xf9\x98G\xb6\xbds\x09\xaer\xc1\xc0\xd7\xafH\xde\xb8\xaa\xef]\xc6\xfcw\xf9\xa5\x8c}\x8a\xaf\xea\xb9\xef$\x16\x9c2zS\xa3@\xf5L\x0e\xa2\xee\xe7~P\xed\xf9\x19\xd9\xdd?"
+DATA ·d+34688(SB)/64,$"\xe7\x9d\x06;\xc1\xf2\x89#\xf8\xa3\xc2\xd4\x86\x07\x16\xc5\xb99\xc0w\xebd\x14p\xba\xb8\xe17Ek\x8c\x9aqC5r\xa4@\x09Y\x12\xa8\xc42\xae\xd0\xe7\x5c\x9c\xe2\x02-\xc85N\xc7#\xf5\xf9\xae\x0a\x8f\xfc<"
+DATA ·d+34752(SB)/64,$"qv\xca\xd0\xd0\x8eq\xf6\xb6]\xbd\xe9Q/$R\x9b\x84\x85M-f\xc7\x8c\x5c\xdeM\xed08@\xd2\xb2\xa2J\xb5f\x00m\xeb\xfa\x9b\xff\x7f\xe8\xdf\xff\xc2C\xffdtR\x9d|V\x86'\xf9vj}\xc6\x91"
+DATA ·d+34816(SB)/64,$"/\xcf1\x92g\xd8M&\x8dsq.It\x09s\x17^\xf1[\x11`\xe6byRO\xe7yr\x03\x8f\xdd\x02\x14g\xfah8\xeb\x09w=\x87\xd1\xd5\x8e\xa2\x1a\x81@R7\xf1\xca:j\x0fT!\xe4\xdc\xd36"
+DATA ·d+34880(SB)/64,$"\xe1\xdf\x7f2\x11N#\x1f\xe9\xdb\x91\xf4\x8dHO\x1c\xcb\xb1\xb3=\x17\x19m\xb2\xff%\xef\xbe\xc6\xc8r>SD;\xcf\xbc\x13=\x80\xa3\x12\xb8V\xd0\xbc\xe6\x09\xed\xef>\x99\xf0\xb6\x81h^\xdf\xe2T\x81\xab\x0f\xe4"
+DATA ·d+34944(SB)/64,$"\x8a\xe8\xe6g\xdd\x9d\xedO\x7f8\xb7\xb7\xd7v\xa5R^\xdf|u\x871\x84!<\x18\x88!Pd\xf7\xc9\xb4\x05\xcb\x82\x1bLC\x90\xdd\x10\xd3\xe6 \xcd\xf6v\xf7\xfc'\xbbz\x98v\xf6\xf7\xbc?\x1d#&\x9d\x8c"
+DATA ·d+35008(SB)/64,$"\xf8\x06\xa7\x0bv\xc991\xa9r\xa2[9~\xd8N\xeb*\x14d\xdeJ'\xae\xd9\x81\xe3\x87Q\x22\xbf\xca\x0eD\x89_=\xda\xb8\xc4w\xc9`1\x01\x81\xa5\xddqp\xc1\x8e\xf0\xc7\xbd\xc51\x09\xb9\xe66\xc0\x1ak"
+DATA ·d+35072(SB)/64,$"\xde\xfc\xb8\x14I\xc4\xcbU\xc2H\x1eR6\x81!\xe4\x5cR\xf4\x9e\xc2W\xbb\xcak\xd0\x0dAF\xafDGT\xc3b\xbd\xe2\x15%\x19T\xa7\xcf\x9c\x0bPC\xe0\xdc\xc4s}\xce\xf7G_\x5c\xc1\xd2r:\xec(\xa2"
+DATA ·d+35136(SB)/64,$"U\xe3&6\xef\xe0V\xc7\xc3\x8en-\x22fow\xcf\x101\xe5\xfc\x9a\xee\x9f\xaf\xfd\xd6Z\xc0\xefe\xb5\xdd\xfdF\xf5'SY].k#\xe8t9\x9e4\x80\xb8\x11\xb0._\xac\xadW\x1f5.?2N\xc5"
+DATA ·d+35200(SB)/64,$"\x8e\xa4\xab\x912\xa50tIV ]\x10Q\xfb\xc1t\xc6\x08\x03\xbc\x86\x14\xf5\xcd\x9c=%R}\x05\xb3\x86W\xd7\x85\x9c\x00\xbdj\x03\xd6\xf7\xe1\x88\xda\x1cr\xcc\xef\x1f\xb4\x22\xcc\xb1l\x93i\xe5iwjw$"
+DATA ·d+35264(SB)/64,$"\x17p\x15\x82k\x1cH\x16\x1c\xbe\x7f\xd5\xc7\xf7\xd0\xde\xb6e\xe6\xc3xW\xb1\x5c\xb7\xac\x94J&\x8e9\xb0w\x19;\x09\x19)\xe6\x04\x0e2\xba\xaf\xe4\xec\x82\xfd\x8d\x16\xf4\xc7[]\xce\xd5Y\x9a=C\xe1\xb5\x1e/"
+DATA ·d+35328(SB)/64,$"\xcb5\xf39o\xc0\x17\x97ni\x01cD\x00H\xca\x04\xaa\x98\x9f\xc9x\xe7\x7f\xed\x8b\x16\x87.oA\xbak#\xd5\x98<\x87\xd8y\x7f\xd2\xf4.\x107\xbafi\xba)\x05\xeeu\x13\xd3\xd4\x82\xaf\x12\xbewS\xc7"
+DATA ·d+35392(SB)/64,$"@\xa5\x16\xe7\x7f\xaa\x0f\x92W<\xe3r\x025g\x8d\xcb\x1dj,\xad\xea\xa3!\xd3\xb3\xc2\x8d\x83\x0f\xf2|C \x84\x96da\xbc]c\xdb\x80\xbb\x89\xdd=t\xf7\xc7\xf7\xb8\xa1\xd2\xea\x995\x87^X}]\xf7\xcf"
+DATA ·d+35456(SB)/64,$"\x96z\xae\xe3\xdd\xff\xd2K\xa3\x95\x9fm\x18y\xc6)\xe5_\xbf6\x8aK\x19\xcb\x0b\x95x\xb5]/\xa8nm\xd1\x91k\x15R\xd7\x97=\xca\x0b\x05\xcbf\x0c\xef\xea\x9f\xf3~N\xaeN\x0b\x9c*a\x03\x19W\x1a\xa8"
+DATA ·d+35520(SB)/64,$"\xe9'\xe5\xf6\x86\xba\xce\x0fF;4\xe2y\x03\xbb\xa5\x94\x11\xa9\xe3[/\xf5l;\xd8\x14T{[\xb8\xc6\x1a)\xb9\xe0zr\xa2g\xe5IDIn9\x99\x1dJ \xca\x8b\xa0\x82\x97Q\xf1\x11\x92\xfe&\xe4\x0fI"
+DATA ·d+35584(SB)/64,$"Yf`)\x84\x8bT\xb5/m\x0e\x1b\xbej\xfa\xa8v\xefK)M\x8f\x85\x15\xb1.\xcfX\xbe\xb6\x0d\xc5\xa5w\x80\xae+\xc7~w\xd1\x172B>\x89\xb4\x0f\x8d\xb9\xa26?D\x19v\xae\xfa\xe8Z\xf5\xc2P\xff"
+DATA ·d+35648(SB)/64,$"8\x9b\x94gi\x1c\xe3<\x09#\xdcBB\x1f9\x8e\x8f\xf6\x9bW\xc0\x0a\x1bB\xb1\xc3\xb7\xbf\xff\xb5z\xd7\xb7\x120\xf2\xbao\xd9\x84\xa76B\xc2u\xd6\xfa\x9er\x8a\x8b\xbc*M\x81\xdcP\x04B\x22;\x86,?"
+DATA ·d+35712(SB)/64,$"\x12\x991\x03\xae\xf5\x14\xcd\x00d1\xd5tc\xf8\x1a\xa7\xe2\xcc\x91\x8b\xd5\x9cd\xa65\xa7\x0aC)\xbe\xd8i\xf7+\xaf\xa43Wn\xb9g;\xc5\x1ag\x01\x93\x9b\x0brJ\xff\xe5?\xe9_\x94\x03\x15\x0a\xc9\xf8\x06"
+DATA ·d+35776(SB)/64,$"LY\x92\x8a\xcdF\xffI%\x1b\x95\xa0\xba \xad\x07/\x1cd.\x1a\x01N\xe5\xf0\xa6\xc8\xd9\xe1?v\xcaF-{p\x1e\x16\xa2ycg\x93|\x06p5\xe8=\x087\x8c\xa5\xa6\x10\x11P\x1c0\x97\xa6\xc8\xf1Z"
+DATA ·d+35840(SB)/64,$"\xd1\x12\xb4\x17\x06\x96F\xcc\xc0h\xadF\xcbu.\xb2\x8c\xc1B\x97f\x8c\xcco\x0c\x15\xc0+\xcb\x08\xd9\xe2x\xe3a\xf7r\xeb{\xf2[\xae\xc9\xdf\x82\xd6\xf2\xea\xc4\xf5\x82_\xc3\xe4\x8ao\xe6\xac\xb9\xfbI:\xcfP"
+DATA ·d+35904(SB)/64,$"V\xf0\xab\xf3_\xa7\xf3L\xd0\x1dS\x9aQO\xfcQ>\xee*\xc1\xa1^\xf0\xba\x80|\x13O\xb9\xe8av\x8b\x1d\xc2|$\x07\xde\xf7-\xeb\xc1*\xc3\xcf&\xe9\xbf\xf8{\xdb\xca\x91\xc4\x00S\xbaQ\x00A7\xcfG"
+DATA ·d+35968(SB)/64,$"K\xc8!N\xeb\xda\x08z\xdf\xe2\xc2o\x0b\xd5\xfe47\x7f\x0f\xc1\xed{^\x01\xbe\x09>\xdf\xed.\xf0r\xb2\xac\x87\xc8\x8a\x0f)\xdcp\xa8\x84\xc5\x18\xd2\x1e$\xca/\x85\x9f\x07\xcd\xc4t-\x80]\xd5\x14\xaa\xfb\x82"
+DATA ·d+36032(SB)/64,$"<i\x19E\x978\xba\x02\x16\x95\x98\xba\xf3\x02\x95\x1c+\x12\xb2\xb5\x88P@\xc4\x82oH\x05\x08\xa5\xea\x898/\x82\xf7\xb9\xdc{\xd8\x95\xfe\x88eh-\xc5\x9f\xb5c\xda\xee\x15\x03\xf0\x9eh\xa5\x5c^{\xe1\x929"
+DATA ·d+36096(SB)/64,$"\x12\x8e3\x88P\x85\x14\xab \x93\x8f\xca\x16\x8d\xdb\xf2d\xed*-\xcd\x04M\x18\x22\xd3\xa0\x94\x03\xb1\x05\xb9\xcc\x96y\xbd\xeb\xa7x\xc9S\xf8\xb2B\xff\x84\x9c\x9f\xe0\xf668\xbaZ\xac\xd7\xbb\xd0\xa4\xb3\x91\x86\xb2d"
+DATA ·d+36160(SB)/64,$"\x14c\x97-a\x0e\xcdK\xbf\xf9;\xfcY\xf4\xe4D~\x1b\x00\x11\x98\x0e\x10\x00N\xa9\xe4\xcc\x98\x1f^\x9a\xfe\xe5H8\xa6\xf7\xc6#\xb1\x9a\xdb\x96\xa22g\xf7\x8e\xab\x91]\x86G)\xdb\xd9\x11e5\xcez\x0f\xf6"
+DATA ·d+36224(SB)/64,$"\x83\xe6\xf2U\x16\xe2\x8b\x87\xf9\x8awI\x1e\xab\x0d\xdf\x8cm\xb0\xb6\xc9<\x84\x94\xb2\xc8\x0c\xbd\xc8\xf2\x9b\xd3\xcc\xe5\xbc\xb0\xf7\xec\xe9\x9e<K\xc5;\x18\x0e\xc22\x99}\xddg\xbf20\x03\xc0B\xf1\xd3\x90.\xc4\xd2"
+DATA ·d+36288(SB)/64,$"\x0d\x93\xaf\x5cK9\x96\xfa\xd5+\x9a-O\x92\xb0\xb8tU\x13\x9eW\x13\x0a\x99\x10\x0a\xf0\xcd-\x01\xfb\xfc\xfd\xc7\x17\xc7\x87\xcfO\x0f\xbf\xf2\xdf\xa7\xc7\x1f\xde\xbd\x10?\x7f=~\xff\xee\xcd\xbf\x81\x1a{{\xc3\x88\xa9"
+DATA ·d+36352(SB)/64,$"}\xd9\x5cT\xf0\xed\xc1RW\xac\xecB\xee\x13$\xdb\xa4\xf0\xac\x01v\xee:\xb5#u\xa3K\xb0\x0bAU4oC\x138\xd5d{7z\x19\xe8\xc7\xcb\xec\xda\xa4\xf1\x9f\x82\x81\xac\xfc\xd3\x18\x13\xbf\xec\xc6\x1f\xc3,"
+DATA ·d+36416(SB)/64,$"n\xa5\x87[g\x94\xb2\xc3\x1b\xd3r\x0bC\x5c\xf6\xb7\xe0Z\x9d9'4\x80\xac\x00\xcd\xe8]\xc6\x0e\xe1\xec\xcb\x1a\xdb2\xbc\xcc9\xb5\x14\xe3R\x8e\x89`\xdc\xf1\x88\xd6\x85\xfc\xbc\xf8N\x22\x9e\x1a2\xde\x22\xd1\xbbG"
+DATA ·d+36480(SB)/64,$"E\xec7j\xca\xf4&Uk-\x1b9\xb2w\x11\xfb@\xad\x073\xc4\xa9V\xa5s\xbaZ^`\x8a\xb29\xfa\x1c&W8F\x84\xe1e!u7\xe4>\x8c\xa1\xde\xc3\xd8s|\x00\xe2s\x10\xf2h\x8aR\xad\xf8\x7f"
+DATA ·d+36544(SB)/15,$"\x00\x00\x00\xff\xff\x03\x00\x97x\xabD\xfc\xc5\x00\x00"
+GLOBL ·d(SB),RODATA,$36559
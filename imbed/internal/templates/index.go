@@ -8,16 +8,20 @@ import (
 	"io"
 	"bytes"
 	"path/filepath"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"hash/crc64"
 	"sort"
 	"path"
 	"compress/gzip"
+	"compress/flate"
 	"io/ioutil"
+	"sync"
+	"container/list"
 	"time"
 )
 
-func blob_bytes(uint32) []byte
-func blob_string(uint32) string
-
 // Asset represents binary resource stored within Go executable. Asset implements
 // fmt.Stringer and io.WriterTo interfaces, decompressing binary data if necessary.
 type Asset struct {
@@ -26,10 +30,16 @@ type Asset struct {
 	blob         []byte // Resource blob []byte
 	str_blob     string // Resource blob as a string
 	isCompressed bool   // true if resources was compressed with gzip
+	chunks       []uint32 // offsets of independently compressed chunks within the gzip stream
 	mime         string // MIME Type
 	tag          string // Tag is essentially a Tag of resource content and can be used as a value for "Etag" HTTP header
+	modTime      time.Time // Modification time of the source file, set in development build only
 }
 
+// chunkSize is the amount of uncompressed content in every independently
+// compressed chunk of a compressed asset
+const chunkSize = 64 << 10
+
 // Name returns the base name of the asset
 func (a *Asset) Name() string       { return a.name }
 // MimeType returns MIME Type of the asset
@@ -38,27 +48,55 @@ func (a *Asset) MimeType() string   { return a.mime }
 func (a *Asset) Tag() string        { return a.tag  }
 // IsCompressed returns true of asset has been compressed
 func (a *Asset) IsCompressed() bool { return a.isCompressed }
-// String returns (uncompressed, if necessary) content of asset as a string
+// String returns (uncompressed, if necessary) content of asset as a string.
+// Use ReadAll to detect corrupted content.
 func (a *Asset) String() string {
 	if a.isCompressed {
-		ungzip, _ := gzip.NewReader(bytes.NewReader(a.blob))
-		ret, _ := ioutil.ReadAll(ungzip)
-		ungzip.Close()
+		if s, ok := cacheGet(a); ok {
+			return s
+		}
+		ret, _ := a.decompress()
 		return string(ret)
 	}
 	return a.str_blob
 }
 
-// Bytes returns (uncompressed) content of asset as a []byte
+// Bytes returns (uncompressed) content of asset as a []byte.
+// Use ReadAll to detect corrupted content.
 func (a *Asset) Bytes() []byte {
+	ret, _ := a.ReadAll()
+	return ret
+}
+
+// ReadAll returns (uncompressed) content of asset as a []byte, the same as Bytes,
+// but reports an error if content can not be decompressed.
+func (a *Asset) ReadAll() ([]byte, error) {
 	if a.isCompressed {
-		ungzip, _ := gzip.NewReader(bytes.NewReader(a.blob))
-		ret, _ := ioutil.ReadAll(ungzip)
-		ungzip.Close()
-		return ret
+		if s, ok := cacheGet(a); ok {
+			return []byte(s), nil
+		}
+		return a.decompress()
 	}
 	ret := make([]byte, len(a.blob))
 	copy(ret, a.blob)
+	return ret, nil
+}
+
+// Encoding returns the content coding of the stored content, as used in
+// "Content-Encoding" HTTP header: "gzip" for compressed assets, "" otherwise.
+func (a *Asset) Encoding() string {
+	if a.isCompressed {
+		return "gzip"
+	}
+	return ""
+}
+
+// CompressedReader returns the stored content of the asset as is, without
+// decompression: a standard gzip stream for compressed assets, the plain content
+// otherwise (see Encoding). Content is read straight from the executable.
+func (a *Asset) CompressedReader() io.ReadCloser {
+	ret := &assetReader{}
+	ret.Reset(a.blob)
 	return ret
 }
 
@@ -66,8 +104,14 @@ func (a *Asset) Bytes() []byte {
 func (a *Asset) Size() int64        { return int64(a.size) }
 // Mode implements os.FileInfo and always returns 0444
 func (a *Asset) Mode() os.FileMode  { return 0444 }
-// ModTime implements os.FileInfo and returns the time stamp when this package has been produced (the same value for all the assets)
-func (a *Asset) ModTime() time.Time { return stamp }
+// ModTime implements os.FileInfo and returns the time stamp when this package has been produced (the same value for all the assets),
+// or modification time of the source file in development build
+func (a *Asset) ModTime() time.Time {
+	if !a.modTime.IsZero() {
+		return a.modTime
+	}
+	return stamp
+}
 // IsDir implements os.FileInfo and returns false
 func (a *Asset) IsDir() bool        { return false }
 // Sys implements os.FileInfo and returns nil
@@ -76,7 +120,14 @@ func (a *Asset) Sys() interface{}   { return a }
 // WriteTo implements io.WriterTo interface and writes content of the asset to w
 func (a *Asset) WriteTo(w io.Writer) (int64, error) {
 	if a.isCompressed {
-		ungzip, _ := gzip.NewReader(bytes.NewReader(a.blob))
+		if s, ok := cacheGet(a); ok {
+			n, err := io.WriteString(w, s)
+			return int64(n), err
+		}
+		ungzip, err := gzip.NewReader(bytes.NewReader(a.blob))
+		if err != nil {
+			return 0, err
+		}
 		n, err := io.Copy(w, ungzip)
 		ungzip.Close()
 		return n, err
@@ -85,6 +136,58 @@ func (a *Asset) WriteTo(w io.Writer) (int64, error) {
 	return int64(n), err
 }
 
+// ErrCorrupted is returned by Verify if asset content does not match the recorded tag
+var ErrCorrupted = errors.New("asset content does not match its tag")
+
+var (
+	b32Enc   = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+	crcTable = crc64.MakeTable(crc64.ECMA)
+)
+
+// Verify checks integrity of the asset: decompresses content, if necessary, and
+// compares its checksum with the recorded tag. Verify returns ErrCorrupted if
+// checksum does not match, or decompression error.
+func (a *Asset) Verify() error {
+	crc := crc64.New(crcTable)
+	r := a.Reader()
+	defer r.Close()
+	if _, err := io.Copy(crc, r); err != nil {
+		return err
+	}
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], crc.Sum64())
+	if b32Enc.EncodeToString(buf[:]) != a.tag {
+		return ErrCorrupted
+	}
+	return nil
+}
+
+// VerifyError describes an asset failed integrity check
+type VerifyError struct {
+	Path string // Asset path
+	Err  error  // Error returned by Asset.Verify
+}
+
+func (e *VerifyError) Error() string { return e.Path + ": " + e.Err.Error() }
+
+// VerifyAll checks integrity of all the assets with Asset.Verify and returns
+// a list of *VerifyError, ordered by asset path, for the assets failed the check.
+func VerifyAll() []error {
+	files := allFiles()
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var errs []error
+	for _, name := range names {
+		if err := files[name].Verify(); err != nil {
+			errs = append(errs, &VerifyError{Path: name, Err: err})
+		}
+	}
+	return errs
+}
+
 type assetReader struct {
 	bytes.Reader
 }
@@ -94,10 +197,53 @@ func (r *assetReader) Close() error {
 	return nil
 }
 
+type errorReader struct {
+	err error
+}
+
+func (r errorReader) Read([]byte) (int, error)          { return 0, r.err }
+func (r errorReader) ReadAt([]byte, int64) (int, error) { return 0, r.err }
+func (r errorReader) Seek(int64, int) (int64, error)    { return 0, r.err }
+func (r errorReader) Close() error                      { return nil }
+
+// AssetReader is an opened asset content. Unlike io.ReadCloser returned
+// by Asset.Reader, it can seek and read at arbitrary offsets.
+type AssetReader interface {
+	io.Reader
+	io.ReaderAt
+	io.Seeker
+	io.Closer
+}
+
+// Open returns content of the asset as AssetReader, decompressing it if necessary.
+// Uncompressed content is read straight from the executable without copying.
+func (a *Asset) Open() AssetReader {
+	if a.isCompressed {
+		return &compressedReader{asset: a}
+	}
+	ret := &assetReader{}
+	ret.Reset(a.blob)
+	return ret
+}
+
+// ReaderAt returns content of the asset as io.SectionReader, which can be passed to
+// APIs such as archive/zip.NewReader. Uncompressed content is read straight from
+// the executable without copying, compressed content is decompressed on demand.
+func (a *Asset) ReaderAt() *io.SectionReader {
+	var r io.ReaderAt = bytes.NewReader(a.blob)
+	if a.isCompressed {
+		r = &compressedReader{asset: a}
+	}
+	return io.NewSectionReader(r, 0, int64(a.size))
+}
+
 // Returns content of the asset as io.ReaderCloser.
 func (a *Asset) Reader() io.ReadCloser {
 	if a.isCompressed {
-		ungzip, _ := gzip.NewReader(bytes.NewReader(a.blob))
+		ungzip, err := gzip.NewReader(bytes.NewReader(a.blob))
+		if err != nil {
+			return errorReader{err}
+		}
 		return ungzip
 	} else {
 		ret := &assetReader{}
@@ -106,6 +252,236 @@ func (a *Asset) Reader() io.ReadCloser {
 	}
 }
 
+// compressedReader decompresses the asset content starting from the chunk
+// containing the current position, so seeking costs at most one chunk to skip.
+type compressedReader struct {
+	asset  *Asset
+	pos    int64         // current position
+	zr     io.ReadCloser // decompressor positioned at zpos, if any
+	zpos   int64
+	closed bool
+}
+
+// chunkReader returns decompressor of the asset content positioned at offset off,
+// resetting zr, if not nil
+func (a *Asset) chunkReader(zr io.ReadCloser, off int64) (io.ReadCloser, error) {
+	i := int(off / chunkSize)
+	if i >= len(a.chunks) {
+		return nil, io.ErrUnexpectedEOF
+	}
+	src := bytes.NewReader(a.blob[a.chunks[i]:])
+	if zr == nil {
+		zr = flate.NewReader(src)
+	} else if err := zr.(flate.Resetter).Reset(src, nil); err != nil {
+		return nil, err
+	}
+	if _, err := io.CopyN(ioutil.Discard, zr, off-int64(i)*chunkSize); err != nil {
+		return nil, err
+	}
+	return zr, nil
+}
+
+func (r *compressedReader) Read(p []byte) (int, error) {
+	if r.closed {
+		return 0, os.ErrClosed
+	}
+	if r.pos >= int64(r.asset.size) {
+		return 0, io.EOF
+	}
+	if r.zr == nil || r.zpos != r.pos {
+		zr, err := r.asset.chunkReader(r.zr, r.pos)
+		if err != nil {
+			r.zr = nil
+			return 0, err
+		}
+		r.zr, r.zpos = zr, r.pos
+	}
+	if rest := int64(r.asset.size) - r.pos; int64(len(p)) > rest {
+		p = p[:rest]
+	}
+	n, err := io.ReadFull(r.zr, p)
+	r.pos += int64(n)
+	r.zpos = r.pos
+	if err != nil {
+		r.zr = nil
+	}
+	return n, err
+}
+
+// ReadAt implements io.ReaderAt. It neither uses nor changes the current
+// position, so it is safe to call ReadAt concurrently.
+func (r *compressedReader) ReadAt(p []byte, off int64) (int, error) {
+	if r.closed {
+		return 0, os.ErrClosed
+	}
+	if off < 0 {
+		return 0, os.ErrInvalid
+	}
+	size := int64(r.asset.size)
+	if off >= size {
+		return 0, io.EOF
+	}
+	zr, err := r.asset.chunkReader(nil, off)
+	if err != nil {
+		return 0, err
+	}
+	want := len(p)
+	if int64(want) > size-off {
+		p = p[:size-off]
+	}
+	n, err := io.ReadFull(zr, p)
+	if err == nil && n < want {
+		err = io.EOF
+	}
+	return n, err
+}
+
+func (r *compressedReader) Seek(offset int64, whence int) (int64, error) {
+	if r.closed {
+		return 0, os.ErrClosed
+	}
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.pos
+	case io.SeekEnd:
+		offset += int64(r.asset.size)
+	default:
+		return 0, os.ErrInvalid
+	}
+	if offset < 0 {
+		return 0, os.ErrInvalid
+	}
+	r.pos = offset
+	return offset, nil
+}
+
+func (r *compressedReader) Close() error {
+	if r.closed {
+		return os.ErrClosed
+	}
+	r.closed = true
+	r.zr = nil
+	return nil
+}
+
+// decompress returns decompressed content of the asset, adding it to the cache
+func (a *Asset) decompress() ([]byte, error) {
+	ungzip, err := gzip.NewReader(bytes.NewReader(a.blob))
+	if err != nil {
+		return nil, err
+	}
+	defer ungzip.Close()
+	data, err := ioutil.ReadAll(ungzip)
+	if err != nil {
+		return nil, err
+	}
+	cachePut(a, data)
+	return data, nil
+}
+
+// CacheStats holds the decompressed content cache counters
+type CacheStats struct {
+	Hits    uint64 // Number of lookups served from the cache
+	Misses  uint64 // Number of lookups which required decompression
+	Entries int    // Number of cached assets
+	Size    int64  // Total size of cached content
+	Limit   int64  // Cache size limit
+}
+
+type cacheEntry struct {
+	asset   *Asset
+	content string
+}
+
+// cache is an LRU cache of decompressed content of compressed assets
+var cache = struct {
+	sync.Mutex
+	limit   int64
+	size    int64
+	hits    uint64
+	misses  uint64
+	lru     *list.List // cacheEntry values, most recently used first
+	entries map[*Asset]*list.Element
+}{
+	lru:     list.New(),
+	entries: make(map[*Asset]*list.Element),
+}
+
+// SetCacheLimit enables caching of decompressed content of compressed assets,
+// which is then reused by String, Bytes, ReadAll, WriteTo and the HTTP handler,
+// and limits the total size of cached content. The least recently used content
+// is evicted first. Zero limit (the default) disables the cache and frees
+// cached content. SetCacheLimit is safe for concurrent use.
+func SetCacheLimit(limit int64) {
+	cache.Lock()
+	defer cache.Unlock()
+	if limit < 0 {
+		limit = 0
+	}
+	cache.limit = limit
+	cacheEvict()
+}
+
+// CacheStatistics returns the cache counters
+func CacheStatistics() CacheStats {
+	cache.Lock()
+	defer cache.Unlock()
+	return CacheStats{
+		Hits:    cache.hits,
+		Misses:  cache.misses,
+		Entries: len(cache.entries),
+		Size:    cache.size,
+		Limit:   cache.limit,
+	}
+}
+
+func cacheGet(a *Asset) (string, bool) {
+	cache.Lock()
+	defer cache.Unlock()
+	if cache.limit == 0 {
+		return "", false
+	}
+	if e, ok := cache.entries[a]; ok {
+		cache.hits++
+		cache.lru.MoveToFront(e)
+		return e.Value.(*cacheEntry).content, true
+	}
+	cache.misses++
+	return "", false
+}
+
+// cacheFits reports whether content of the asset can be cached
+func cacheFits(a *Asset) bool {
+	cache.Lock()
+	defer cache.Unlock()
+	return cache.limit > 0 && int64(a.size) <= cache.limit
+}
+
+func cachePut(a *Asset, data []byte) {
+	cache.Lock()
+	defer cache.Unlock()
+	if cache.limit == 0 || int64(len(data)) > cache.limit {
+		return
+	}
+	if _, ok := cache.entries[a]; ok {
+		return
+	}
+	cache.entries[a] = cache.lru.PushFront(&cacheEntry{asset: a, content: string(data)})
+	cache.size += int64(len(data))
+	cacheEvict()
+}
+
+func cacheEvict() {
+	for cache.size > cache.limit {
+		e := cache.lru.Back()
+		entry := e.Value.(*cacheEntry)
+		cache.lru.Remove(e)
+		delete(cache.entries, entry.asset)
+		cache.size -= int64(len(entry.content))
+	}
+}
+
 func cleanPath(path string) string {
 	path = filepath.Clean(path)
 	if filepath.IsAbs(path) {
@@ -126,7 +502,7 @@ func Open(name string) (File, error) {
 
 // Gets asset by name. Returns nil if no asset found.
 func Get(name string) *Asset {
-	if entry, ok := fidx[name]; ok {
+	if entry, ok := lookupFile(name); ok {
 		return entry
 	} else {
 		return nil
@@ -135,7 +511,7 @@ func Get(name string) *Asset {
 
 // Get asset by name. Panics if no asset found.
 func Must(name string) *Asset {
-	if entry, ok := fidx[name]; ok {
+	if entry, ok := lookupFile(name); ok {
 		return entry
 	} else {
 		panic("asset " + name + " not found")
@@ -148,8 +524,6 @@ type directoryAsset struct {
 	files []Asset
 }
 
-var root *directoryAsset
-
 // A simple FileSystem abstraction
 type FileSystem interface {
 	Open(name string) (File, error)
@@ -303,13 +677,10 @@ func (fs *assetFs) Walk(root string, walkFunc filepath.WalkFunc) error {
 
 func (fs *assetFs) Stat(name string) (os.FileInfo, error) {
 	name = cleanPath(name)
-	if name == "" {
-		return root, nil
-	}
-	if dir, ok := didx[name]; ok {
+	if dir, ok := lookupDir(name); ok {
 		return dir, nil
 	}
-	if asset, ok := fidx[name]; ok {
+	if asset, ok := lookupFile(name); ok {
 		return asset, nil
 	}
 	return nil, os.ErrNotExist
@@ -317,13 +688,10 @@ func (fs *assetFs) Stat(name string) (os.FileInfo, error) {
 
 func (fs *assetFs) Open(name string) (File, error) {
 	name = cleanPath(name)
-	if name == "" {
-		return root.open(""), nil
-	}
-	if dir, ok := didx[name]; ok {
+	if dir, ok := lookupDir(name); ok {
 		return dir.open(name), nil
 	}
-	if asset, ok := fidx[name]; ok {
+	if asset, ok := lookupFile(name); ok {
 		return asset.open(name), nil
 	}
 	return nil, os.ErrNotExist
@@ -342,12 +710,10 @@ type File interface {
 
 func (a *Asset) open(name string) File {
 	if a.isCompressed {
-		ret := &assetCompressedFile{
-			asset: a,
-			name:  name,
+		return &assetCompressedFile{
+			compressedReader: compressedReader{asset: a},
+			name:             name,
 		}
-		ret.Reset(bytes.NewReader(a.blob))
-		return ret
 	} else {
 		ret := &assetFile{
 			asset: a,
@@ -480,9 +846,8 @@ func (a *assetFile) Readdir(int) ([]os.FileInfo, error) {
 	return nil, os.ErrInvalid
 }
 type assetCompressedFile struct {
-	gzip.Reader
-	name  string
-	asset *Asset
+	compressedReader
+	name string
 }
 
 func (a *assetCompressedFile) Name() string {
@@ -490,128 +855,12 @@ func (a *assetCompressedFile) Name() string {
 }
 
 func (a *assetCompressedFile) Stat() (os.FileInfo, error) {
+	if a.closed {
+		return nil, os.ErrClosed
+	}
 	return a.asset, nil
 }
 
-func (a *assetCompressedFile) Seek(int64, int) (int64, error) {
-	return 0, os.ErrInvalid
-}
-
 func (a *assetCompressedFile) Readdir(count int) ([]os.FileInfo, error) {
 	return nil, os.ErrInvalid
 }
-
-var fidx = make(map[string]*Asset)
-var didx = make(map[string]*directoryAsset)
-var stamp time.Time
-
-func init() {
-	stamp = time.Unix(1571908442, 807852000)
-	bb := blob_bytes(9816)
-	bs := blob_string(9816)
-	root = &directoryAsset{
-		files: []Asset{
-			{
-				name:         "index.go",
-				blob:         bb[0:5775],
-				str_blob:     bs[0:5775],
-				mime:         "text/x-golang; charset=utf-8",
-				tag:          "xomyf7cjnm7zq",
-				size:         21780,
-				isCompressed: true,
-			},
-			{
-				name:         "index_386.s",
-				blob:         bb[5776:5973],
-				str_blob:     bs[5776:5973],
-				mime:         "text/x-asm; charset=utf-8",
-				tag:          "ihibzfvzsneuc",
-				size:         327,
-				isCompressed: true,
-			},
-			{
-				name:         "index_amd64.s",
-				blob:         bb[5976:6184],
-				str_blob:     bs[5976:6184],
-				mime:         "text/x-asm; charset=utf-8",
-				tag:          "dcfwghvd5ccho",
-				size:         361,
-				isCompressed: true,
-			},
-			{
-				name:         "index_arm.s",
-				blob:         bb[6184:6377],
-				str_blob:     bs[6184:6377],
-				mime:         "text/x-asm; charset=utf-8",
-				tag:          "2kykitl4umpta",
-				size:         329,
-				isCompressed: true,
-			},
-			{
-				name:         "index_arm64.s",
-				blob:         bb[6384:6584],
-				str_blob:     bs[6384:6584],
-				mime:         "text/x-asm; charset=utf-8",
-				tag:          "xsewb4p6f52nu",
-				size:         331,
-				isCompressed: true,
-			},
-			{
-				name:         "index_mips64x.s",
-				blob:         bb[6584:6806],
-				str_blob:     bs[6584:6806],
-				mime:         "text/x-asm; charset=utf-8",
-				tag:          "srpac746plm5g",
-				size:         370,
-				isCompressed: true,
-			},
-			{
-				name:         "index_mipsx.s",
-				blob:         bb[6808:7027],
-				str_blob:     bs[6808:7027],
-				mime:         "text/x-asm; charset=utf-8",
-				tag:          "itgddt77jfjk6",
-				size:         364,
-				isCompressed: true,
-			},
-			{
-				name:         "index_ppc64x.s",
-				blob:         bb[7032:7247],
-				str_blob:     bs[7032:7247],
-				mime:         "text/x-asm; charset=utf-8",
-				tag:          "aatq7i5ygiqoy",
-				size:         356,
-				isCompressed: true,
-			},
-			{
-				name:         "index_s390x.s",
-				blob:         bb[7248:7466],
-				str_blob:     bs[7248:7466],
-				mime:         "text/x-asm; charset=utf-8",
-				tag:          "ml3wgknpogqt6",
-				size:         313,
-				isCompressed: true,
-			},
-			{
-				name:         "index_test.go",
-				blob:         bb[7472:9810],
-				str_blob:     bs[7472:9810],
-				mime:         "text/x-golang; charset=utf-8",
-				tag:          "3nfezi3r4tdnc",
-				size:         10851,
-				isCompressed: true,
-			},
-		},
-	}
-	didx[""] = root
-	fidx["index.go"] = &root.files[0]
-	fidx["index_386.s"] = &root.files[1]
-	fidx["index_amd64.s"] = &root.files[2]
-	fidx["index_arm.s"] = &root.files[3]
-	fidx["index_arm64.s"] = &root.files[4]
-	fidx["index_mips64x.s"] = &root.files[5]
-	fidx["index_mipsx.s"] = &root.files[6]
-	fidx["index_ppc64x.s"] = &root.files[7]
-	fidx["index_s390x.s"] = &root.files[8]
-	fidx["index_test.go"] = &root.files[9]
-}
@@ -1,5 +1,8 @@
 // Code generated by go-imbed. DO NOT EDIT.
 
+//go:build !imbed_dev
+// +build !imbed_dev
+
 #include "textflag.h"
 
 TEXT ·blob_bytes(SB),NOSPLIT|NOFRAME,$0-8
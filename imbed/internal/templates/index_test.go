@@ -3,16 +3,18 @@
 package templates
 
 import (
-	"encoding/base32"
 	"encoding/binary"
 	"hash/crc64"
 	"testing"
 	"math/rand"
 	"os"
+	"io"
 	"io/ioutil"
 	"path/filepath"
 	"bytes"
 	"fmt"
+	"compress/gzip"
+	"sync"
 )
 
 var randomName = func() string {
@@ -22,24 +24,182 @@ var randomName = func() string {
 	return b32Enc.EncodeToString(buf[:])
 }()
 
-var b32Enc = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
-
 func getTag(data []byte) string {
 	var crcBuf [8]byte
-	binary.LittleEndian.PutUint64(crcBuf[:], crc64.Checksum(data, crc64.MakeTable(crc64.ECMA)))
+	binary.LittleEndian.PutUint64(crcBuf[:], crc64.Checksum(data, crcTable))
 	return b32Enc.EncodeToString(crcBuf[:])
 }
 
 func TestBytes(t *testing.T) {
-	for n, a := range fidx {
+	for n, a := range allFiles() {
 		if getTag(a.Bytes()) != a.tag {
 			t.Fatalf("checksum for asset %s doesn't match recorded", n)
 		}
 	}
 }
 
+func TestReadAll(t *testing.T) {
+	for n, a := range allFiles() {
+		data, err := a.ReadAll()
+		if err != nil {
+			t.Fatalf("error reading asset %s: %s", n, err)
+		}
+		if getTag(data) != a.tag {
+			t.Fatalf("checksum for asset %s doesn't match recorded", n)
+		}
+	}
+}
+
+func TestVerifyAll(t *testing.T) {
+	if errs := VerifyAll(); len(errs) != 0 {
+		t.Fatalf("integrity check failed: %v", errs)
+	}
+	for n, a := range allFiles() {
+		tag := a.tag
+		a.tag = getTag([]byte(randomName))
+		err := a.Verify()
+		a.tag = tag
+		if err != ErrCorrupted {
+			t.Fatalf("expected ErrCorrupted for modified tag of %s, got %v", n, err)
+		}
+	}
+}
+
+func TestCompressedReader(t *testing.T) {
+	for n, a := range allFiles() {
+		var r io.Reader = a.CompressedReader()
+		switch a.Encoding() {
+		case "gzip":
+			if !a.IsCompressed() {
+				t.Fatalf("asset %s is not compressed, but reports gzip encoding", n)
+			}
+			gz, err := gzip.NewReader(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r = gz
+		case "":
+			if a.IsCompressed() {
+				t.Fatalf("asset %s is compressed, but reports no encoding", n)
+			}
+		default:
+			t.Fatalf("asset %s reports unexpected encoding %q", n, a.Encoding())
+		}
+		data, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data, a.Bytes()) {
+			t.Fatalf("content of asset %s read from compressed reader differs", n)
+		}
+	}
+}
+
+func TestCache(t *testing.T) {
+	var compressed []*Asset
+	var limit int64
+	for _, a := range allFiles() {
+		if a.isCompressed {
+			compressed = append(compressed, a)
+			if int64(a.size) > limit {
+				limit = int64(a.size)
+			}
+		}
+	}
+	SetCacheLimit(limit)
+	defer SetCacheLimit(0)
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 2; j++ {
+				for _, a := range compressed {
+					if getTag([]byte(a.String())) != a.tag || getTag(a.Bytes()) != a.tag {
+						t.Errorf("cached content of %s does not match the tag", a.name)
+					}
+					var buf bytes.Buffer
+					if _, err := a.WriteTo(&buf); err != nil || getTag(buf.Bytes()) != a.tag {
+						t.Errorf("cached content of %s does not match the tag", a.name)
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	stats := CacheStatistics()
+	if len(compressed) > 0 && stats.Hits == 0 {
+		t.Fatalf("expected cache hits, got %+v", stats)
+	}
+	if stats.Size > limit || stats.Limit != limit || stats.Hits+stats.Misses != uint64(len(compressed)*4*2*3) {
+		t.Fatalf("unexpected cache statistics %+v", stats)
+	}
+	SetCacheLimit(0)
+	if stats = CacheStatistics(); stats.Entries != 0 || stats.Size != 0 {
+		t.Fatalf("expected empty cache, got %+v", stats)
+	}
+}
+
+func testSeek(t *testing.T, rnd *rand.Rand, name string, data []byte, r interface {
+	io.ReadSeeker
+	io.ReaderAt
+}) {
+	for i := 0; i < 16; i++ {
+		off := rnd.Int63n(int64(len(data)) + 1)
+		buf := make([]byte, rnd.Intn(1<<17)+1)
+		want := data[off:]
+		if len(want) > len(buf) {
+			want = want[:len(buf)]
+		}
+		if pos, err := r.Seek(off, io.SeekStart); err != nil || pos != off {
+			t.Fatalf("%s: seek to %d: %d, %v", name, off, pos, err)
+		}
+		n, err := io.ReadFull(r, buf)
+		if err != nil && n != len(want) {
+			t.Fatalf("%s: read at %d: %v", name, off, err)
+		}
+		if !bytes.Equal(buf[:n], want) {
+			t.Fatalf("%s: content read at %d differs", name, off)
+		}
+		n, err = r.ReadAt(buf, off)
+		if n != len(want) || (err != nil && (err != io.EOF || n == len(buf))) {
+			t.Fatalf("%s: ReadAt %d: %d, %v", name, off, n, err)
+		}
+		if !bytes.Equal(buf[:n], want) {
+			t.Fatalf("%s: content read with ReadAt %d differs", name, off)
+		}
+	}
+	if pos, err := r.Seek(-1, io.SeekEnd); len(data) > 0 && (err != nil || pos != int64(len(data)-1)) {
+		t.Fatalf("%s: seek from end: %d, %v", name, pos, err)
+	}
+}
+
+func TestOpen(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for name, asset := range allFiles() {
+		data := asset.Bytes()
+		r := asset.Open()
+		testSeek(t, rnd, name, data, r)
+		if err := r.Close(); err != nil {
+			t.Fatal(err)
+		}
+		sr := asset.ReaderAt()
+		if sr.Size() != int64(len(data)) {
+			t.Fatalf("%s: expected size %d, got %d", name, len(data), sr.Size())
+		}
+		content, err := ioutil.ReadAll(sr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(content, data) {
+			t.Fatalf("%s: content read from section reader differs", name)
+		}
+		testSeek(t, rnd, name, data, sr)
+	}
+}
+
 func TestString(t *testing.T) {
-	for n, a := range fidx {
+	for n, a := range allFiles() {
 		if getTag([]byte(a.String())) != a.tag {
 			t.Fatalf("checksum for asset %s doesn't match recorded", n)
 		}
@@ -232,4 +392,20 @@ func TestCopyTo(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+}
+
+func TestSeek(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for name, asset := range allFiles() {
+		f, err := FS().Open(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r, ok := f.(AssetReader)
+		if !ok {
+			t.Fatalf("%s does not implement AssetReader", name)
+		}
+		testSeek(t, rnd, name, asset.Bytes(), r)
+		f.Close()
+	}
 }
\ No newline at end of file
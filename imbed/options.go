@@ -0,0 +1,110 @@
+// Copyright 2017 Alexey Naidyonov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+package imbed
+
+import (
+	"crypto/ed25519"
+	"path"
+	"strings"
+)
+
+// Options holds generation parameters which can not be expressed with ImbedFlag.
+// A nil *Options is equivalent to the zero value.
+type Options struct {
+	// Encrypt lists patterns of assets to be encrypted with AES-GCM
+	// (see MatchPattern for the syntax).
+	Encrypt []string
+	// EncryptionKey is an AES key (16, 24 or 32 bytes long) used to encrypt
+	// assets matching Encrypt patterns.
+	EncryptionKey []byte
+	// SigningKey, if set, is used to sign the manifest of all the asset
+	// digests, and the generated package gets the Verify function.
+	SigningKey ed25519.PrivateKey
+	// InitCheck sets up verification of the bundle at package initialization
+	// (requires SigningKey).
+	InitCheck InitCheck
+	// Budgets limit stored sizes of the assets, generation fails with
+	// *BudgetError if any of them is exceeded.
+	Budgets []Budget
+	// CachePolicies become the default cache policies of the generated HTTP
+	// handler; the first policy matching an asset applies.
+	CachePolicies []CachePolicy
+	// SecurityHeaders are sent by the generated HTTP handler in addition
+	// to (or instead of) its defaults; the first entry matching an asset
+	// applies for every header name.
+	SecurityHeaders []SecurityHeader
+}
+
+// InitCheck defines what the generated package does if the bundle signature
+// or any of the asset digests does not match at package initialization.
+type InitCheck int
+
+const (
+	// No verification at package initialization
+	NoInitCheck InitCheck = iota
+	// Panic if bundle verification fails
+	PanicOnInitCheck
+	// Refuse to serve content over HTTP if bundle verification fails
+	RefuseOnInitCheck
+)
+
+func (c InitCheck) String() string {
+	switch c {
+	case PanicOnInitCheck:
+		return "panic"
+	case RefuseOnInitCheck:
+		return "refuse"
+	default:
+		return ""
+	}
+}
+
+func (o *Options) encrypts(name string) bool {
+	return o != nil && matchAny(o.Encrypt, name)
+}
+
+// MatchPattern reports whether slash-separated asset path name matches the pattern.
+// Pattern elements follow path.Match syntax, and an element "**" matches any
+// number of path elements, including none. A pattern without a slash is matched
+// against the base name of the asset only, i.e. "*.js" matches any JavaScript
+// file in the tree, while "js/*.js" matches only files in the top "js" directory.
+func MatchPattern(pattern, name string) bool {
+	if !strings.Contains(pattern, "/") {
+		ok, _ := path.Match(pattern, path.Base(name))
+		return ok
+	}
+	return matchElements(strings.Split(strings.Trim(pattern, "/"), "/"), strings.Split(name, "/"))
+}
+
+func matchElements(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(name); i++ {
+				if matchElements(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(name) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(pattern[0], name[0]); !ok {
+			return false
+		}
+		pattern = pattern[1:]
+		name = name[1:]
+	}
+	return len(name) == 0
+}
+
+func matchAny(patterns []string, name string) bool {
+	for _, p := range patterns {
+		if MatchPattern(p, name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,101 @@
+// Copyright 2017 Alexey Naidyonov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+package imbed
+
+import (
+	"os"
+	"path"
+	"sort"
+)
+
+// Report describes content of the generated package
+type Report struct {
+	Assets      []AssetReport     `json:"assets"`      // All the assets, ordered by path
+	Directories []DirectoryReport `json:"directories"` // All the directories, ordered by path
+	DataSize    int64             `json:"dataSize"`    // Size of the embedded data, i.e. the executable footprint
+	DataFile    int64             `json:"dataFile"`    // Size of the data.s file
+}
+
+// AssetReport describes an embedded asset
+type AssetReport struct {
+	Path       string `json:"path"`
+	MimeType   string `json:"mimeType"`
+	Size       int64  `json:"size"`       // Original size
+	StoredSize int64  `json:"storedSize"` // Size of the stored (compressed and/or encrypted) content
+	Compressed bool   `json:"compressed"`
+	Encrypted  bool   `json:"encrypted"`
+	Tag        string `json:"tag"`
+	ErrorPage  int    `json:"errorPage,omitempty"` // HTTP status the asset is served with as an error page
+}
+
+// DirectoryReport holds totals of a directory, including all of its subdirectories
+type DirectoryReport struct {
+	Path       string `json:"path"` // "." for the root directory
+	Assets     int    `json:"assets"`
+	Size       int64  `json:"size"`
+	StoredSize int64  `json:"storedSize"`
+}
+
+func newReport(assets []*fileAsset, root *directoryAsset, dataSize int, dataFile string) (*Report, error) {
+	fi, err := os.Stat(dataFile)
+	if err != nil {
+		return nil, err
+	}
+	r := &Report{
+		Assets:   make([]AssetReport, 0, len(assets)),
+		DataSize: int64(dataSize),
+		DataFile: fi.Size(),
+	}
+	for _, a := range assets {
+		r.Assets = append(r.Assets, AssetReport{
+			Path:       a.path,
+			MimeType:   a.mimeType,
+			Size:       a.size,
+			StoredSize: int64(a.offStop - a.offStart),
+			Compressed: a.isCompressed,
+			Encrypted:  a.isEncrypted,
+			Tag:        a.tag,
+			ErrorPage:  errorPageStatus(a.path),
+		})
+	}
+	sort.Slice(r.Assets, func(i, j int) bool { return r.Assets[i].Path < r.Assets[j].Path })
+	r.addDirectory(root, ".")
+	sort.Slice(r.Directories, func(i, j int) bool { return r.Directories[i].Path < r.Directories[j].Path })
+	return r, nil
+}
+
+// errorPageStatus returns the HTTP status the generated HTTP handler serves the
+// asset with as an error page, or 0 if the asset is not an error page
+func errorPageStatus(name string) int {
+	switch path.Base(name) {
+	case "403.html":
+		return 403
+	case "404.html":
+		return 404
+	case "405.html":
+		return 405
+	case "500.html":
+		return 500
+	}
+	return 0
+}
+
+// addDirectory adds the report of directory d along with its subdirectories and returns its totals
+func (r *Report) addDirectory(d *directoryAsset, p string) DirectoryReport {
+	total := DirectoryReport{Path: p}
+	for _, f := range d.files {
+		total.Assets++
+		total.Size += f.size
+		total.StoredSize += int64(f.offStop - f.offStart)
+	}
+	for i := range d.dirs {
+		sub := r.addDirectory(&d.dirs[i], path.Join(p, d.dirs[i].name))
+		total.Assets += sub.Assets
+		total.Size += sub.Size
+		total.StoredSize += sub.StoredSize
+	}
+	r.Directories = append(r.Directories, total)
+	return total
+}
@@ -0,0 +1,207 @@
+// Copyright 2017 Alexey Naidyonov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/growler/go-imbed/imbed"
+)
+
+const serveUsage = `Serves source content over HTTP exactly as the embedded content would be served.
+The server is built with the go command, which must be in PATH.
+
+Usage:
+    %s serve [options] <source-content-path>
+
+Options:
+`
+
+// previewServer runs the self-contained http server built from the source content
+type previewServer struct {
+	sync.Mutex
+	buildDir   string
+	source     string
+	listen     string
+	flags      imbed.ImbedFlag
+	opts       *imbed.Options
+	generation int
+	proc       *previewProcess
+	exited     chan error // exit statuses of servers which were not stopped
+}
+
+// previewProcess is a running preview server
+type previewProcess struct {
+	cmd     *exec.Cmd
+	stopped int32
+	done    chan struct{}
+}
+
+// serve builds the source content the same way -binary does, with the same
+// generation options, and runs the resulting server, so the content is served
+// with the generated handler. Building requires the Go toolchain. With -watch,
+// the server is rebuilt and restarted on every change.
+func serve(args []string) error {
+	fs, err := parseServeArgs(args)
+	if err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	opts, err := options()
+	if err != nil {
+		return err
+	}
+	buildDir, err := ioutil.TempDir(os.TempDir(), ".go-imbed")
+	if err != nil {
+		return err
+	}
+	defer rmtree(buildDir)
+	srv := newPreviewServer(buildDir, fs.Arg(0), listenAddr, imbed.ImbedFlag(0).Set(imbed.CompressAssets, !disableCompression), opts)
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-sig
+		srv.stop()
+		rmtree(buildDir)
+		os.Exit(1)
+	}()
+	if err = srv.restart(); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "serving %s at %s\n", srv.source, listenAddr)
+	if !watchSource {
+		return <-srv.exited
+	}
+	go func() {
+		for err := range srv.exited {
+			if err == nil {
+				err = fmt.Errorf("exit status 0")
+			}
+			fmt.Fprintf(os.Stderr, "preview server exited: %s, waiting for changes\n", err)
+		}
+	}()
+	return watch(srv.source, buildDir, watchDelay, srv.restart)
+}
+
+var (
+	listenAddr  string
+	watchSource bool
+)
+
+// parseServeArgs parses serve subcommand arguments, which include generation options
+func parseServeArgs(args []string) (*flag.FlagSet, error) {
+	fs := flag.NewFlagSet(os.Args[0]+" serve", flag.ContinueOnError)
+	fs.StringVar(&listenAddr, "listen", ":8080", "socket `address` to listen")
+	fs.BoolVar(&watchSource, "watch", false, "rebuild and restart the server whenever source content changes")
+	fs.DurationVar(&watchDelay, "watch-delay", 200*time.Millisecond, "wait for `duration` of quiet after a change before rebuilding in -watch mode")
+	generationFlags(fs)
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, serveUsage, os.Args[0])
+		fs.PrintDefaults()
+	}
+	return fs, fs.Parse(args)
+}
+
+func newPreviewServer(buildDir, source, listen string, flags imbed.ImbedFlag, opts *imbed.Options) *previewServer {
+	return &previewServer{
+		buildDir: buildDir,
+		source:   source,
+		listen:   listen,
+		flags:    flags,
+		opts:     opts,
+		exited:   make(chan error, 1),
+	}
+}
+
+// restart builds a new server binary and replaces the running server with it.
+// The running server is kept if the build fails.
+func (s *previewServer) restart() error {
+	s.Lock()
+	defer s.Unlock()
+	s.generation++
+	binary := filepath.Join(s.buildDir, "bin", "serve-"+strconv.Itoa(s.generation))
+	if err := buildBinary(s.buildDir, s.source, binary, s.flags, s.opts); err != nil {
+		return err
+	}
+	s.stopLocked()
+	cmd := exec.Command(binary, "-listen", s.listen)
+	if s.opts != nil && len(s.opts.Encrypt) > 0 {
+		keyFile, err := s.writeKey()
+		if err != nil {
+			return err
+		}
+		cmd.Args = append(cmd.Args, "-unlock-key-file", keyFile)
+	}
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	p := &previewProcess{cmd: cmd, done: make(chan struct{})}
+	go func() {
+		err := cmd.Wait()
+		if atomic.LoadInt32(&p.stopped) == 0 {
+			s.exited <- err
+		}
+		close(p.done)
+	}()
+	s.proc = p
+	return nil
+}
+
+// writeKey writes the hex-encoded encryption key to a file readable by the
+// owner only, which the preview server unlocks encrypted assets with. Unlike
+// environment, the file is not visible to other users' processes.
+func (s *previewServer) writeKey() (string, error) {
+	name := filepath.Join(s.buildDir, "key")
+	if _, err := os.Stat(name); err == nil {
+		return name, nil
+	}
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return "", err
+	}
+	_, err = f.WriteString(hex.EncodeToString(s.opts.EncryptionKey))
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(name)
+		return "", err
+	}
+	return name, nil
+}
+
+func (s *previewServer) stop() {
+	s.Lock()
+	defer s.Unlock()
+	s.stopLocked()
+}
+
+func (s *previewServer) stopLocked() {
+	if s.proc == nil {
+		return
+	}
+	atomic.StoreInt32(&s.proc.stopped, 1)
+	s.proc.cmd.Process.Kill()
+	<-s.proc.done
+	os.Remove(s.proc.cmd.Path)
+	s.proc = nil
+}
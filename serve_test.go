@@ -0,0 +1,127 @@
+// Copyright 2017 Alexey Naidyonov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/growler/go-imbed/imbed"
+)
+
+func TestServeArgs(t *testing.T) {
+	fs, err := parseServeArgs([]string{
+		"-listen", "localhost:0",
+		"-no-compression",
+		"-cache-control", "*.html=no-cache",
+		"-security-header", "embed/**=X-Frame-Options:",
+		"-max-file-size", "1MB",
+		"site",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts, err := options()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fs.Arg(0) != "site" || listenAddr != "localhost:0" || !disableCompression {
+		t.Fatalf("unexpected arguments %v, listen %q, no compression %v", fs.Args(), listenAddr, disableCompression)
+	}
+	if len(opts.CachePolicies) != 1 || opts.CachePolicies[0] != (imbed.CachePolicy{Pattern: "*.html", Value: "no-cache"}) {
+		t.Fatalf("unexpected cache policies %v", opts.CachePolicies)
+	}
+	if len(opts.SecurityHeaders) != 1 || opts.SecurityHeaders[0] != (imbed.SecurityHeader{Pattern: "embed/**", Name: "X-Frame-Options"}) {
+		t.Fatalf("unexpected security headers %v", opts.SecurityHeaders)
+	}
+	if len(opts.Budgets) != 1 || !opts.Budgets[0].PerFile {
+		t.Fatalf("unexpected budgets %v", opts.Budgets)
+	}
+}
+
+func TestPreviewServer(t *testing.T) {
+	tmp, err := ioutil.TempDir(os.TempDir(), "go-imbed-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rmtree(tmp)
+	source := filepath.Join(tmp, "source")
+	if err = os.MkdirAll(source, 0700); err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range map[string]string{
+		"index.html": "<html></html>",
+		"secret.txt": "secret",
+	} {
+		if err = ioutil.WriteFile(filepath.Join(source, name), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	l, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := l.Addr().String()
+	l.Close()
+	opts := &imbed.Options{
+		Encrypt:         []string{"secret.txt"},
+		EncryptionKey:   make([]byte, 16),
+		CachePolicies:   []imbed.CachePolicy{{Pattern: "*.html", Value: "no-cache"}},
+		SecurityHeaders: []imbed.SecurityHeader{{Name: "X-Frame-Options", Value: "DENY"}},
+	}
+	srv := newPreviewServer(filepath.Join(tmp, "build"), source, addr, imbed.CompressAssets, opts)
+	if err = srv.restart(); err != nil {
+		t.Fatal(err)
+	}
+	defer srv.stop()
+	var resp *http.Response
+	for deadline := time.Now().Add(10 * time.Second); ; time.Sleep(50 * time.Millisecond) {
+		if resp, err = http.Get("http://" + addr + "/"); err == nil || time.Now().After(deadline) {
+			break
+		}
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.Header.Get("Cache-Control") != "no-cache" || resp.Header.Get("X-Frame-Options") != "DENY" {
+		t.Fatalf("generation options are not applied: %v", resp.Header)
+	}
+	if resp, err = http.Get("http://" + addr + "/secret.txt"); err != nil {
+		t.Fatal(err)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || string(body) != "secret" {
+		t.Fatalf("expected unlocked content, got %d %q", resp.StatusCode, body)
+	}
+	if info, err := os.Stat(filepath.Join(tmp, "build", "key")); err != nil || info.Mode().Perm() != 0600 {
+		t.Fatalf("expected the key file readable by the owner only: %v, %v", info, err)
+	}
+	// the second server can not listen the same address
+	busy := newPreviewServer(filepath.Join(tmp, "build2"), source, addr, imbed.CompressAssets, nil)
+	if err = busy.restart(); err != nil {
+		t.Fatal(err)
+	}
+	defer busy.stop()
+	select {
+	case err = <-busy.exited:
+		if err == nil {
+			t.Fatal("expected exit error")
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("exit is not reported")
+	}
+	select {
+	case err = <-srv.exited:
+		t.Fatalf("unexpected exit of the running server: %v", err)
+	default:
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright 2017 Alexey Naidyonov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// pollInterval is the period of source tree scans when no better
+// notification mechanism is available
+const pollInterval = time.Second
+
+// watch calls regenerate every time the source tree changes, ignoring changes
+// within the skip directory. Changes are debounced: regeneration starts once
+// no more changes were reported within the delay.
+func watch(source, skip string, delay time.Duration, regenerate func() error) error {
+	changes, err := newWatcher(source, skip)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "watching %s for changes\n", source)
+	for range changes {
+		timer := time.NewTimer(delay)
+	debounce:
+		for {
+			select {
+			case <-changes:
+				if !timer.Stop() {
+					<-timer.C
+				}
+				timer.Reset(delay)
+			case <-timer.C:
+				break debounce
+			}
+		}
+		if err := regenerate(); err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+		}
+	}
+	return nil
+}
+
+// notify sends a change notification unless there is one pending already
+func notify(changes chan<- struct{}) {
+	select {
+	case changes <- struct{}{}:
+	default:
+	}
+}
+
+// skipPath reports whether path lies within the skip directory, so changes
+// of the generated files do not trigger regeneration if the target is
+// located inside the source tree.
+func skipPath(path, skip string) bool {
+	return path == skip || strings.HasPrefix(path, skip+string(filepath.Separator))
+}
+
+type fileState struct {
+	size    int64
+	mode    os.FileMode
+	modTime time.Time
+}
+
+func scanTree(root, skip string) map[string]fileState {
+	state := make(map[string]fileState)
+	filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+		if skipPath(path, skip) {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		state[path] = fileState{size: info.Size(), mode: info.Mode(), modTime: info.ModTime()}
+		return nil
+	})
+	return state
+}
+
+// pollTree scans the source tree periodically and reports any added,
+// removed or modified file.
+func pollTree(root, skip string, changes chan<- struct{}) {
+	prev := scanTree(root, skip)
+	for range time.Tick(pollInterval) {
+		cur := scanTree(root, skip)
+		changed := len(cur) != len(prev)
+		for path, st := range cur {
+			if changed {
+				break
+			}
+			if old, ok := prev[path]; !ok || old != st {
+				changed = true
+			}
+		}
+		if changed {
+			notify(changes)
+		}
+		prev = cur
+	}
+}
+
+func newPollingWatcher(root, skip string) <-chan struct{} {
+	changes := make(chan struct{}, 1)
+	go pollTree(root, skip, changes)
+	return changes
+}
@@ -0,0 +1,122 @@
+// Copyright 2017 Alexey Naidyonov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+//+build linux
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"unsafe"
+)
+
+const inotifyMask = syscall.IN_CREATE | syscall.IN_DELETE | syscall.IN_MODIFY | syscall.IN_ATTRIB |
+	syscall.IN_CLOSE_WRITE | syscall.IN_MOVED_FROM | syscall.IN_MOVED_TO | syscall.IN_DELETE_SELF |
+	syscall.IN_ONLYDIR
+
+type inotifyWatcher struct {
+	fd   int
+	root string
+	skip string
+	dirs map[int]string // watched directories by watch descriptor
+}
+
+// newWatcher returns a channel notified whenever anything changes under
+// the root directory, except the skip location. It uses inotify, falling
+// back to polling if inotify is not available or the limit of watches
+// has been reached.
+func newWatcher(root, skip string) (<-chan struct{}, error) {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+	if skip, err = filepath.Abs(skip); err != nil {
+		return nil, err
+	}
+	fd, err := syscall.InotifyInit1(syscall.IN_CLOEXEC)
+	if err != nil {
+		return newPollingWatcher(root, skip), nil
+	}
+	w := &inotifyWatcher{
+		fd:   fd,
+		root: root,
+		skip: skip,
+		dirs: make(map[int]string),
+	}
+	if err = w.addTree(root); err != nil {
+		syscall.Close(fd)
+		return newPollingWatcher(root, skip), nil
+	}
+	changes := make(chan struct{}, 1)
+	go w.run(changes)
+	return changes, nil
+}
+
+func (w *inotifyWatcher) addTree(root string) error {
+	return filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil || !info.IsDir() {
+			// the file might have gone already
+			return nil
+		}
+		if skipPath(path, w.skip) {
+			return filepath.SkipDir
+		}
+		wd, err := syscall.InotifyAddWatch(w.fd, path, inotifyMask)
+		if err != nil {
+			return err
+		}
+		w.dirs[wd] = path
+		return nil
+	})
+}
+
+func (w *inotifyWatcher) run(changes chan<- struct{}) {
+	buf := make([]byte, 1<<16)
+	for {
+		n, err := syscall.Read(w.fd, buf)
+		if err == syscall.EINTR {
+			continue
+		} else if err != nil || n <= 0 {
+			fmt.Fprintf(os.Stderr, "inotify failed (%v), falling back to polling\n", err)
+			syscall.Close(w.fd)
+			pollTree(w.root, w.skip, changes)
+			return
+		}
+		changed := false
+		for off := 0; off+syscall.SizeofInotifyEvent <= n; {
+			ev := (*syscall.InotifyEvent)(unsafe.Pointer(&buf[off]))
+			name := buf[off+syscall.SizeofInotifyEvent : off+syscall.SizeofInotifyEvent+int(ev.Len)]
+			off += syscall.SizeofInotifyEvent + int(ev.Len)
+			if ev.Mask&syscall.IN_Q_OVERFLOW != 0 {
+				changed = true
+				continue
+			}
+			dir, ok := w.dirs[int(ev.Wd)]
+			if !ok {
+				continue
+			}
+			if ev.Mask&syscall.IN_IGNORED != 0 {
+				delete(w.dirs, int(ev.Wd))
+				continue
+			}
+			path := filepath.Join(dir, strings.TrimRight(string(name), "\x00"))
+			if skipPath(path, w.skip) {
+				continue
+			}
+			if ev.Mask&syscall.IN_ISDIR != 0 && ev.Mask&(syscall.IN_CREATE|syscall.IN_MOVED_TO) != 0 {
+				if err := w.addTree(path); err != nil {
+					fmt.Fprintf(os.Stderr, "unable to watch %s: %s\n", path, err)
+				}
+			}
+			changed = true
+		}
+		if changed {
+			notify(changes)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+// Copyright 2017 Alexey Naidyonov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+//+build !linux
+
+package main
+
+import "path/filepath"
+
+// newWatcher returns a channel notified whenever anything changes under
+// the root directory, except the skip location.
+func newWatcher(root, skip string) (<-chan struct{}, error) {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+	if skip, err = filepath.Abs(skip); err != nil {
+		return nil, err
+	}
+	return newPollingWatcher(root, skip), nil
+}